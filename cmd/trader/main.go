@@ -57,6 +57,15 @@ func initializeApp() *router.Router {
 		dependencies.Modules.Portfolio.Controller,
 		dependencies.Modules.Auth.Controller,
 		dependencies.Modules.User.Controller,
+		dependencies.Modules.Order.Controller,
+		dependencies.Modules.Backtest.Controller,
+		dependencies.Modules.Paper.Controller,
+		dependencies.Modules.Template.Controller,
+		dependencies.Modules.Market.Controller,
+		dependencies.Modules.Symbol.Controller,
+		dependencies.Modules.Brokerage.Controller,
+		dependencies.Modules.Risk.Controller,
+		dependencies.Modules.Protection.Controller,
 		cfg,
 	)
 
@@ -105,6 +114,36 @@ func initializeDependencies(cfg *config.Config) *Dependencies {
 func startBackgroundTasks(deps *Dependencies) {
 	logrus.Info("🔄 백그라운드 서비스 시작 중...")
 
+	// KIS 접근토큰 발급 및 만료 전 자동 갱신
+	deps.Modules.KIS.StartTokenRenewal()
+
+	// 실시간 시세/체결통보 수집 시작
+	deps.Modules.Stream.Connect()
+
+	// 사용자 계좌별 리스크 상태 재구성 (당일 손익은 체결 원장, 포지션은 증권사 잔고 기준)
+	// 긴급 중지/거래 중지가 복구되기 전에 재시작 전 주문이 제출되지 않도록 주문 실행기보다 먼저 수행한다.
+	if err := deps.Modules.Risk.Rebuilder.Rebuild(); err != nil {
+		logrus.Fatalf("❌ 리스크 상태 재구성 실패: %v", err)
+	}
+
+	// 체결 원장 기록 시작 (환율 조회는 주문 실행기 체결 동기화와 분리)
+	deps.Modules.Portfolio.Ledger.Start()
+
+	// 주문 실행기 체결 추적 시작
+	deps.Modules.Order.Executor.Start()
+
+	// 모의투자 미체결 주문 복구 후 대기 주문 체결 루프 시작
+	deps.Modules.Paper.Broker.Start()
+
+	// 증권사 잔고 → 포트폴리오 주기 동기화 시작
+	deps.Modules.Portfolio.Syncer.Start()
+
+	// 계좌 평가금액 최대 낙폭 감시 시작
+	deps.Modules.Risk.Monitor.Start()
+
+	// 보유 포지션 손절/익절/추적 손절 감시 시작 (리스크 상태 재구성 이후)
+	deps.Modules.Protection.Monitor.Start()
+
 	// 전략 서비스 시작 (비동기)
 	go func() {
 		if err := deps.Modules.Strategy.Service.Start(); err != nil {
@@ -133,6 +172,16 @@ func startServer(mainRouter *router.Router) {
 	logrus.Infof("📊 API: http://localhost%s/api/v1", port)
 	logrus.Infof("🎯 전략: http://localhost%s/api/v1/strategies", port)
 	logrus.Infof("💼 포트폴리오: http://localhost%s/api/v1/portfolio", port)
+	logrus.Infof("📒 손익 내역: http://localhost%s/api/v1/portfolio/pnl", port)
+	logrus.Infof("🧾 주문: http://localhost%s/api/v1/orders", port)
+	logrus.Infof("🧪 모의투자: http://localhost%s/api/v1/paper", port)
+	logrus.Infof("🧩 전략 템플릿: http://localhost%s/api/v1/strategy-templates", port)
+	logrus.Infof("📇 종목 마스터: http://localhost%s/api/v1/symbols", port)
+	logrus.Infof("🔐 증권 계좌: http://localhost%s/api/v1/brokerage/account", port)
+	logrus.Infof("🛡️ 리스크 상태: http://localhost%s/api/v1/risk/state", port)
+	logrus.Infof("🚨 긴급 중지: http://localhost%s/api/v1/risk/kill-switch", port)
+	logrus.Infof("⛑️  포지션 보호: http://localhost%s/api/v1/protection/rules", port)
+	logrus.Infof("🕘 장 운영 시간: http://localhost%s/api/v1/market/clock", port)
 	logrus.Infof("📚 Swagger: http://localhost%s/docs/", port)
 	logrus.Infof("📖 Docs: http://localhost%s/docs", port)
 	logrus.Info("🌟 ================================")
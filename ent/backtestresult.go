@@ -0,0 +1,358 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/strategy"
+	"encoding/json"
+	"encoding/json/jsontext"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BacktestResult is the model entity for the BacktestResult schema.
+type BacktestResult struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID uuid.UUID `json:"strategy_id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Symbols holds the value of the "symbols" field.
+	Symbols []string `json:"symbols,omitempty"`
+	// Interval holds the value of the "interval" field.
+	Interval string `json:"interval,omitempty"`
+	// PeriodStart holds the value of the "period_start" field.
+	PeriodStart time.Time `json:"period_start,omitempty"`
+	// PeriodEnd holds the value of the "period_end" field.
+	PeriodEnd time.Time `json:"period_end,omitempty"`
+	// Parameters holds the value of the "parameters" field.
+	Parameters map[string]interface{} `json:"parameters,omitempty"`
+	// Settings holds the value of the "settings" field.
+	Settings map[string]interface{} `json:"settings,omitempty"`
+	// InitialCapital holds the value of the "initial_capital" field.
+	InitialCapital decimal.Decimal `json:"initial_capital,omitempty"`
+	// FinalEquity holds the value of the "final_equity" field.
+	FinalEquity decimal.Decimal `json:"final_equity,omitempty"`
+	// TotalReturn holds the value of the "total_return" field.
+	TotalReturn float64 `json:"total_return,omitempty"`
+	// MaxDrawdown holds the value of the "max_drawdown" field.
+	MaxDrawdown float64 `json:"max_drawdown,omitempty"`
+	// SharpeRatio holds the value of the "sharpe_ratio" field.
+	SharpeRatio float64 `json:"sharpe_ratio,omitempty"`
+	// WinRate holds the value of the "win_rate" field.
+	WinRate float64 `json:"win_rate,omitempty"`
+	// TradeCount holds the value of the "trade_count" field.
+	TradeCount int `json:"trade_count,omitempty"`
+	// RejectedOrders holds the value of the "rejected_orders" field.
+	RejectedOrders int `json:"rejected_orders,omitempty"`
+	// EquityCurve holds the value of the "equity_curve" field.
+	EquityCurve jsontext.Value `json:"equity_curve,omitempty"`
+	// Trades holds the value of the "trades" field.
+	Trades jsontext.Value `json:"trades,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the BacktestResultQuery when eager-loading is set.
+	Edges        BacktestResultEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// BacktestResultEdges holds the relations/edges for other nodes in the graph.
+type BacktestResultEdges struct {
+	// Strategy holds the value of the strategy edge.
+	Strategy *Strategy `json:"strategy,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// StrategyOrErr returns the Strategy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e BacktestResultEdges) StrategyOrErr() (*Strategy, error) {
+	if e.Strategy != nil {
+		return e.Strategy, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: strategy.Label}
+	}
+	return nil, &NotLoadedError{edge: "strategy"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BacktestResult) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case backtestresult.FieldSymbols, backtestresult.FieldParameters, backtestresult.FieldSettings, backtestresult.FieldEquityCurve, backtestresult.FieldTrades:
+			values[i] = new([]byte)
+		case backtestresult.FieldInitialCapital, backtestresult.FieldFinalEquity:
+			values[i] = new(decimal.Decimal)
+		case backtestresult.FieldTotalReturn, backtestresult.FieldMaxDrawdown, backtestresult.FieldSharpeRatio, backtestresult.FieldWinRate:
+			values[i] = new(sql.NullFloat64)
+		case backtestresult.FieldTradeCount, backtestresult.FieldRejectedOrders:
+			values[i] = new(sql.NullInt64)
+		case backtestresult.FieldInterval:
+			values[i] = new(sql.NullString)
+		case backtestresult.FieldPeriodStart, backtestresult.FieldPeriodEnd, backtestresult.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case backtestresult.FieldID, backtestresult.FieldStrategyID, backtestresult.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BacktestResult fields.
+func (_m *BacktestResult) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case backtestresult.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case backtestresult.FieldStrategyID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value != nil {
+				_m.StrategyID = *value
+			}
+		case backtestresult.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case backtestresult.FieldSymbols:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field symbols", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Symbols); err != nil {
+					return fmt.Errorf("unmarshal field symbols: %w", err)
+				}
+			}
+		case backtestresult.FieldInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				_m.Interval = value.String
+			}
+		case backtestresult.FieldPeriodStart:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_start", values[i])
+			} else if value.Valid {
+				_m.PeriodStart = value.Time
+			}
+		case backtestresult.FieldPeriodEnd:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field period_end", values[i])
+			} else if value.Valid {
+				_m.PeriodEnd = value.Time
+			}
+		case backtestresult.FieldParameters:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field parameters", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Parameters); err != nil {
+					return fmt.Errorf("unmarshal field parameters: %w", err)
+				}
+			}
+		case backtestresult.FieldSettings:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field settings", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Settings); err != nil {
+					return fmt.Errorf("unmarshal field settings: %w", err)
+				}
+			}
+		case backtestresult.FieldInitialCapital:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field initial_capital", values[i])
+			} else if value != nil {
+				_m.InitialCapital = *value
+			}
+		case backtestresult.FieldFinalEquity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field final_equity", values[i])
+			} else if value != nil {
+				_m.FinalEquity = *value
+			}
+		case backtestresult.FieldTotalReturn:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field total_return", values[i])
+			} else if value.Valid {
+				_m.TotalReturn = value.Float64
+			}
+		case backtestresult.FieldMaxDrawdown:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_drawdown", values[i])
+			} else if value.Valid {
+				_m.MaxDrawdown = value.Float64
+			}
+		case backtestresult.FieldSharpeRatio:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field sharpe_ratio", values[i])
+			} else if value.Valid {
+				_m.SharpeRatio = value.Float64
+			}
+		case backtestresult.FieldWinRate:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field win_rate", values[i])
+			} else if value.Valid {
+				_m.WinRate = value.Float64
+			}
+		case backtestresult.FieldTradeCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field trade_count", values[i])
+			} else if value.Valid {
+				_m.TradeCount = int(value.Int64)
+			}
+		case backtestresult.FieldRejectedOrders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field rejected_orders", values[i])
+			} else if value.Valid {
+				_m.RejectedOrders = int(value.Int64)
+			}
+		case backtestresult.FieldEquityCurve:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field equity_curve", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.EquityCurve); err != nil {
+					return fmt.Errorf("unmarshal field equity_curve: %w", err)
+				}
+			}
+		case backtestresult.FieldTrades:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field trades", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Trades); err != nil {
+					return fmt.Errorf("unmarshal field trades: %w", err)
+				}
+			}
+		case backtestresult.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BacktestResult.
+// This includes values selected through modifiers, order, etc.
+func (_m *BacktestResult) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryStrategy queries the "strategy" edge of the BacktestResult entity.
+func (_m *BacktestResult) QueryStrategy() *StrategyQuery {
+	return NewBacktestResultClient(_m.config).QueryStrategy(_m)
+}
+
+// Update returns a builder for updating this BacktestResult.
+// Note that you need to call BacktestResult.Unwrap() before calling this method if this BacktestResult
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BacktestResult) Update() *BacktestResultUpdateOne {
+	return NewBacktestResultClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BacktestResult entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BacktestResult) Unwrap() *BacktestResult {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BacktestResult is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BacktestResult) String() string {
+	var builder strings.Builder
+	builder.WriteString("BacktestResult(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("strategy_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StrategyID))
+	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("symbols=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Symbols))
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(_m.Interval)
+	builder.WriteString(", ")
+	builder.WriteString("period_start=")
+	builder.WriteString(_m.PeriodStart.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("period_end=")
+	builder.WriteString(_m.PeriodEnd.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("parameters=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Parameters))
+	builder.WriteString(", ")
+	builder.WriteString("settings=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Settings))
+	builder.WriteString(", ")
+	builder.WriteString("initial_capital=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InitialCapital))
+	builder.WriteString(", ")
+	builder.WriteString("final_equity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FinalEquity))
+	builder.WriteString(", ")
+	builder.WriteString("total_return=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TotalReturn))
+	builder.WriteString(", ")
+	builder.WriteString("max_drawdown=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxDrawdown))
+	builder.WriteString(", ")
+	builder.WriteString("sharpe_ratio=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SharpeRatio))
+	builder.WriteString(", ")
+	builder.WriteString("win_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WinRate))
+	builder.WriteString(", ")
+	builder.WriteString("trade_count=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TradeCount))
+	builder.WriteString(", ")
+	builder.WriteString("rejected_orders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RejectedOrders))
+	builder.WriteString(", ")
+	builder.WriteString("equity_curve=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EquityCurve))
+	builder.WriteString(", ")
+	builder.WriteString("trades=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Trades))
+	builder.WriteString(", ")
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BacktestResults is a parsable slice of BacktestResult.
+type BacktestResults []*BacktestResult
@@ -0,0 +1,220 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backtestresult
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the backtestresult type in the database.
+	Label = "backtest_result"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldSymbols holds the string denoting the symbols field in the database.
+	FieldSymbols = "symbols"
+	// FieldInterval holds the string denoting the interval field in the database.
+	FieldInterval = "interval"
+	// FieldPeriodStart holds the string denoting the period_start field in the database.
+	FieldPeriodStart = "period_start"
+	// FieldPeriodEnd holds the string denoting the period_end field in the database.
+	FieldPeriodEnd = "period_end"
+	// FieldParameters holds the string denoting the parameters field in the database.
+	FieldParameters = "parameters"
+	// FieldSettings holds the string denoting the settings field in the database.
+	FieldSettings = "settings"
+	// FieldInitialCapital holds the string denoting the initial_capital field in the database.
+	FieldInitialCapital = "initial_capital"
+	// FieldFinalEquity holds the string denoting the final_equity field in the database.
+	FieldFinalEquity = "final_equity"
+	// FieldTotalReturn holds the string denoting the total_return field in the database.
+	FieldTotalReturn = "total_return"
+	// FieldMaxDrawdown holds the string denoting the max_drawdown field in the database.
+	FieldMaxDrawdown = "max_drawdown"
+	// FieldSharpeRatio holds the string denoting the sharpe_ratio field in the database.
+	FieldSharpeRatio = "sharpe_ratio"
+	// FieldWinRate holds the string denoting the win_rate field in the database.
+	FieldWinRate = "win_rate"
+	// FieldTradeCount holds the string denoting the trade_count field in the database.
+	FieldTradeCount = "trade_count"
+	// FieldRejectedOrders holds the string denoting the rejected_orders field in the database.
+	FieldRejectedOrders = "rejected_orders"
+	// FieldEquityCurve holds the string denoting the equity_curve field in the database.
+	FieldEquityCurve = "equity_curve"
+	// FieldTrades holds the string denoting the trades field in the database.
+	FieldTrades = "trades"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// EdgeStrategy holds the string denoting the strategy edge name in mutations.
+	EdgeStrategy = "strategy"
+	// Table holds the table name of the backtestresult in the database.
+	Table = "backtest_results"
+	// StrategyTable is the table that holds the strategy relation/edge.
+	StrategyTable = "backtest_results"
+	// StrategyInverseTable is the table name for the Strategy entity.
+	// It exists in this package in order to avoid circular dependency with the "strategy" package.
+	StrategyInverseTable = "strategies"
+	// StrategyColumn is the table column denoting the strategy relation/edge.
+	StrategyColumn = "strategy_id"
+)
+
+// Columns holds all SQL columns for backtestresult fields.
+var Columns = []string{
+	FieldID,
+	FieldStrategyID,
+	FieldUserID,
+	FieldSymbols,
+	FieldInterval,
+	FieldPeriodStart,
+	FieldPeriodEnd,
+	FieldParameters,
+	FieldSettings,
+	FieldInitialCapital,
+	FieldFinalEquity,
+	FieldTotalReturn,
+	FieldMaxDrawdown,
+	FieldSharpeRatio,
+	FieldWinRate,
+	FieldTradeCount,
+	FieldRejectedOrders,
+	FieldEquityCurve,
+	FieldTrades,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultSymbols holds the default value on creation for the "symbols" field.
+	DefaultSymbols []string
+	// IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	IntervalValidator func(string) error
+	// DefaultParameters holds the default value on creation for the "parameters" field.
+	DefaultParameters map[string]interface{}
+	// DefaultSettings holds the default value on creation for the "settings" field.
+	DefaultSettings map[string]interface{}
+	// DefaultTotalReturn holds the default value on creation for the "total_return" field.
+	DefaultTotalReturn float64
+	// DefaultMaxDrawdown holds the default value on creation for the "max_drawdown" field.
+	DefaultMaxDrawdown float64
+	// DefaultSharpeRatio holds the default value on creation for the "sharpe_ratio" field.
+	DefaultSharpeRatio float64
+	// DefaultWinRate holds the default value on creation for the "win_rate" field.
+	DefaultWinRate float64
+	// DefaultTradeCount holds the default value on creation for the "trade_count" field.
+	DefaultTradeCount int
+	// DefaultRejectedOrders holds the default value on creation for the "rejected_orders" field.
+	DefaultRejectedOrders int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the BacktestResult queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByInterval orders the results by the interval field.
+func ByInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterval, opts...).ToFunc()
+}
+
+// ByPeriodStart orders the results by the period_start field.
+func ByPeriodStart(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodStart, opts...).ToFunc()
+}
+
+// ByPeriodEnd orders the results by the period_end field.
+func ByPeriodEnd(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeriodEnd, opts...).ToFunc()
+}
+
+// ByInitialCapital orders the results by the initial_capital field.
+func ByInitialCapital(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInitialCapital, opts...).ToFunc()
+}
+
+// ByFinalEquity orders the results by the final_equity field.
+func ByFinalEquity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFinalEquity, opts...).ToFunc()
+}
+
+// ByTotalReturn orders the results by the total_return field.
+func ByTotalReturn(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTotalReturn, opts...).ToFunc()
+}
+
+// ByMaxDrawdown orders the results by the max_drawdown field.
+func ByMaxDrawdown(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxDrawdown, opts...).ToFunc()
+}
+
+// BySharpeRatio orders the results by the sharpe_ratio field.
+func BySharpeRatio(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSharpeRatio, opts...).ToFunc()
+}
+
+// ByWinRate orders the results by the win_rate field.
+func ByWinRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWinRate, opts...).ToFunc()
+}
+
+// ByTradeCount orders the results by the trade_count field.
+func ByTradeCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradeCount, opts...).ToFunc()
+}
+
+// ByRejectedOrders orders the results by the rejected_orders field.
+func ByRejectedOrders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectedOrders, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByStrategyField orders the results by strategy field.
+func ByStrategyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStrategyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newStrategyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StrategyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StrategyTable, StrategyColumn),
+	)
+}
@@ -0,0 +1,761 @@
+// Code generated by ent, DO NOT EDIT.
+
+package backtestresult
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldID, id))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldUserID, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldInterval, v))
+}
+
+// PeriodStart applies equality check predicate on the "period_start" field. It's identical to PeriodStartEQ.
+func PeriodStart(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodEnd applies equality check predicate on the "period_end" field. It's identical to PeriodEndEQ.
+func PeriodEnd(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// InitialCapital applies equality check predicate on the "initial_capital" field. It's identical to InitialCapitalEQ.
+func InitialCapital(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldInitialCapital, v))
+}
+
+// FinalEquity applies equality check predicate on the "final_equity" field. It's identical to FinalEquityEQ.
+func FinalEquity(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldFinalEquity, v))
+}
+
+// TotalReturn applies equality check predicate on the "total_return" field. It's identical to TotalReturnEQ.
+func TotalReturn(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldTotalReturn, v))
+}
+
+// MaxDrawdown applies equality check predicate on the "max_drawdown" field. It's identical to MaxDrawdownEQ.
+func MaxDrawdown(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldMaxDrawdown, v))
+}
+
+// SharpeRatio applies equality check predicate on the "sharpe_ratio" field. It's identical to SharpeRatioEQ.
+func SharpeRatio(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldSharpeRatio, v))
+}
+
+// WinRate applies equality check predicate on the "win_rate" field. It's identical to WinRateEQ.
+func WinRate(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldWinRate, v))
+}
+
+// TradeCount applies equality check predicate on the "trade_count" field. It's identical to TradeCountEQ.
+func TradeCount(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldTradeCount, v))
+}
+
+// RejectedOrders applies equality check predicate on the "rejected_orders" field. It's identical to RejectedOrdersEQ.
+func RejectedOrders(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldRejectedOrders, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldUserID, v))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldInterval, v))
+}
+
+// IntervalContains applies the Contains predicate on the "interval" field.
+func IntervalContains(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldContains(FieldInterval, v))
+}
+
+// IntervalHasPrefix applies the HasPrefix predicate on the "interval" field.
+func IntervalHasPrefix(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldHasPrefix(FieldInterval, v))
+}
+
+// IntervalHasSuffix applies the HasSuffix predicate on the "interval" field.
+func IntervalHasSuffix(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldHasSuffix(FieldInterval, v))
+}
+
+// IntervalEqualFold applies the EqualFold predicate on the "interval" field.
+func IntervalEqualFold(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEqualFold(FieldInterval, v))
+}
+
+// IntervalContainsFold applies the ContainsFold predicate on the "interval" field.
+func IntervalContainsFold(v string) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldContainsFold(FieldInterval, v))
+}
+
+// PeriodStartEQ applies the EQ predicate on the "period_start" field.
+func PeriodStartEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartNEQ applies the NEQ predicate on the "period_start" field.
+func PeriodStartNEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldPeriodStart, v))
+}
+
+// PeriodStartIn applies the In predicate on the "period_start" field.
+func PeriodStartIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartNotIn applies the NotIn predicate on the "period_start" field.
+func PeriodStartNotIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldPeriodStart, vs...))
+}
+
+// PeriodStartGT applies the GT predicate on the "period_start" field.
+func PeriodStartGT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldPeriodStart, v))
+}
+
+// PeriodStartGTE applies the GTE predicate on the "period_start" field.
+func PeriodStartGTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldPeriodStart, v))
+}
+
+// PeriodStartLT applies the LT predicate on the "period_start" field.
+func PeriodStartLT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldPeriodStart, v))
+}
+
+// PeriodStartLTE applies the LTE predicate on the "period_start" field.
+func PeriodStartLTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldPeriodStart, v))
+}
+
+// PeriodEndEQ applies the EQ predicate on the "period_end" field.
+func PeriodEndEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndNEQ applies the NEQ predicate on the "period_end" field.
+func PeriodEndNEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldPeriodEnd, v))
+}
+
+// PeriodEndIn applies the In predicate on the "period_end" field.
+func PeriodEndIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndNotIn applies the NotIn predicate on the "period_end" field.
+func PeriodEndNotIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldPeriodEnd, vs...))
+}
+
+// PeriodEndGT applies the GT predicate on the "period_end" field.
+func PeriodEndGT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldPeriodEnd, v))
+}
+
+// PeriodEndGTE applies the GTE predicate on the "period_end" field.
+func PeriodEndGTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldPeriodEnd, v))
+}
+
+// PeriodEndLT applies the LT predicate on the "period_end" field.
+func PeriodEndLT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldPeriodEnd, v))
+}
+
+// PeriodEndLTE applies the LTE predicate on the "period_end" field.
+func PeriodEndLTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldPeriodEnd, v))
+}
+
+// InitialCapitalEQ applies the EQ predicate on the "initial_capital" field.
+func InitialCapitalEQ(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldInitialCapital, v))
+}
+
+// InitialCapitalNEQ applies the NEQ predicate on the "initial_capital" field.
+func InitialCapitalNEQ(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldInitialCapital, v))
+}
+
+// InitialCapitalIn applies the In predicate on the "initial_capital" field.
+func InitialCapitalIn(vs ...decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldInitialCapital, vs...))
+}
+
+// InitialCapitalNotIn applies the NotIn predicate on the "initial_capital" field.
+func InitialCapitalNotIn(vs ...decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldInitialCapital, vs...))
+}
+
+// InitialCapitalGT applies the GT predicate on the "initial_capital" field.
+func InitialCapitalGT(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldInitialCapital, v))
+}
+
+// InitialCapitalGTE applies the GTE predicate on the "initial_capital" field.
+func InitialCapitalGTE(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldInitialCapital, v))
+}
+
+// InitialCapitalLT applies the LT predicate on the "initial_capital" field.
+func InitialCapitalLT(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldInitialCapital, v))
+}
+
+// InitialCapitalLTE applies the LTE predicate on the "initial_capital" field.
+func InitialCapitalLTE(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldInitialCapital, v))
+}
+
+// FinalEquityEQ applies the EQ predicate on the "final_equity" field.
+func FinalEquityEQ(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldFinalEquity, v))
+}
+
+// FinalEquityNEQ applies the NEQ predicate on the "final_equity" field.
+func FinalEquityNEQ(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldFinalEquity, v))
+}
+
+// FinalEquityIn applies the In predicate on the "final_equity" field.
+func FinalEquityIn(vs ...decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldFinalEquity, vs...))
+}
+
+// FinalEquityNotIn applies the NotIn predicate on the "final_equity" field.
+func FinalEquityNotIn(vs ...decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldFinalEquity, vs...))
+}
+
+// FinalEquityGT applies the GT predicate on the "final_equity" field.
+func FinalEquityGT(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldFinalEquity, v))
+}
+
+// FinalEquityGTE applies the GTE predicate on the "final_equity" field.
+func FinalEquityGTE(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldFinalEquity, v))
+}
+
+// FinalEquityLT applies the LT predicate on the "final_equity" field.
+func FinalEquityLT(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldFinalEquity, v))
+}
+
+// FinalEquityLTE applies the LTE predicate on the "final_equity" field.
+func FinalEquityLTE(v decimal.Decimal) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldFinalEquity, v))
+}
+
+// TotalReturnEQ applies the EQ predicate on the "total_return" field.
+func TotalReturnEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldTotalReturn, v))
+}
+
+// TotalReturnNEQ applies the NEQ predicate on the "total_return" field.
+func TotalReturnNEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldTotalReturn, v))
+}
+
+// TotalReturnIn applies the In predicate on the "total_return" field.
+func TotalReturnIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldTotalReturn, vs...))
+}
+
+// TotalReturnNotIn applies the NotIn predicate on the "total_return" field.
+func TotalReturnNotIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldTotalReturn, vs...))
+}
+
+// TotalReturnGT applies the GT predicate on the "total_return" field.
+func TotalReturnGT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldTotalReturn, v))
+}
+
+// TotalReturnGTE applies the GTE predicate on the "total_return" field.
+func TotalReturnGTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldTotalReturn, v))
+}
+
+// TotalReturnLT applies the LT predicate on the "total_return" field.
+func TotalReturnLT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldTotalReturn, v))
+}
+
+// TotalReturnLTE applies the LTE predicate on the "total_return" field.
+func TotalReturnLTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldTotalReturn, v))
+}
+
+// MaxDrawdownEQ applies the EQ predicate on the "max_drawdown" field.
+func MaxDrawdownEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldMaxDrawdown, v))
+}
+
+// MaxDrawdownNEQ applies the NEQ predicate on the "max_drawdown" field.
+func MaxDrawdownNEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldMaxDrawdown, v))
+}
+
+// MaxDrawdownIn applies the In predicate on the "max_drawdown" field.
+func MaxDrawdownIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldMaxDrawdown, vs...))
+}
+
+// MaxDrawdownNotIn applies the NotIn predicate on the "max_drawdown" field.
+func MaxDrawdownNotIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldMaxDrawdown, vs...))
+}
+
+// MaxDrawdownGT applies the GT predicate on the "max_drawdown" field.
+func MaxDrawdownGT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldMaxDrawdown, v))
+}
+
+// MaxDrawdownGTE applies the GTE predicate on the "max_drawdown" field.
+func MaxDrawdownGTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldMaxDrawdown, v))
+}
+
+// MaxDrawdownLT applies the LT predicate on the "max_drawdown" field.
+func MaxDrawdownLT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldMaxDrawdown, v))
+}
+
+// MaxDrawdownLTE applies the LTE predicate on the "max_drawdown" field.
+func MaxDrawdownLTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldMaxDrawdown, v))
+}
+
+// SharpeRatioEQ applies the EQ predicate on the "sharpe_ratio" field.
+func SharpeRatioEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldSharpeRatio, v))
+}
+
+// SharpeRatioNEQ applies the NEQ predicate on the "sharpe_ratio" field.
+func SharpeRatioNEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldSharpeRatio, v))
+}
+
+// SharpeRatioIn applies the In predicate on the "sharpe_ratio" field.
+func SharpeRatioIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldSharpeRatio, vs...))
+}
+
+// SharpeRatioNotIn applies the NotIn predicate on the "sharpe_ratio" field.
+func SharpeRatioNotIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldSharpeRatio, vs...))
+}
+
+// SharpeRatioGT applies the GT predicate on the "sharpe_ratio" field.
+func SharpeRatioGT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldSharpeRatio, v))
+}
+
+// SharpeRatioGTE applies the GTE predicate on the "sharpe_ratio" field.
+func SharpeRatioGTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldSharpeRatio, v))
+}
+
+// SharpeRatioLT applies the LT predicate on the "sharpe_ratio" field.
+func SharpeRatioLT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldSharpeRatio, v))
+}
+
+// SharpeRatioLTE applies the LTE predicate on the "sharpe_ratio" field.
+func SharpeRatioLTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldSharpeRatio, v))
+}
+
+// WinRateEQ applies the EQ predicate on the "win_rate" field.
+func WinRateEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldWinRate, v))
+}
+
+// WinRateNEQ applies the NEQ predicate on the "win_rate" field.
+func WinRateNEQ(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldWinRate, v))
+}
+
+// WinRateIn applies the In predicate on the "win_rate" field.
+func WinRateIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldWinRate, vs...))
+}
+
+// WinRateNotIn applies the NotIn predicate on the "win_rate" field.
+func WinRateNotIn(vs ...float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldWinRate, vs...))
+}
+
+// WinRateGT applies the GT predicate on the "win_rate" field.
+func WinRateGT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldWinRate, v))
+}
+
+// WinRateGTE applies the GTE predicate on the "win_rate" field.
+func WinRateGTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldWinRate, v))
+}
+
+// WinRateLT applies the LT predicate on the "win_rate" field.
+func WinRateLT(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldWinRate, v))
+}
+
+// WinRateLTE applies the LTE predicate on the "win_rate" field.
+func WinRateLTE(v float64) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldWinRate, v))
+}
+
+// TradeCountEQ applies the EQ predicate on the "trade_count" field.
+func TradeCountEQ(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldTradeCount, v))
+}
+
+// TradeCountNEQ applies the NEQ predicate on the "trade_count" field.
+func TradeCountNEQ(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldTradeCount, v))
+}
+
+// TradeCountIn applies the In predicate on the "trade_count" field.
+func TradeCountIn(vs ...int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldTradeCount, vs...))
+}
+
+// TradeCountNotIn applies the NotIn predicate on the "trade_count" field.
+func TradeCountNotIn(vs ...int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldTradeCount, vs...))
+}
+
+// TradeCountGT applies the GT predicate on the "trade_count" field.
+func TradeCountGT(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldTradeCount, v))
+}
+
+// TradeCountGTE applies the GTE predicate on the "trade_count" field.
+func TradeCountGTE(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldTradeCount, v))
+}
+
+// TradeCountLT applies the LT predicate on the "trade_count" field.
+func TradeCountLT(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldTradeCount, v))
+}
+
+// TradeCountLTE applies the LTE predicate on the "trade_count" field.
+func TradeCountLTE(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldTradeCount, v))
+}
+
+// RejectedOrdersEQ applies the EQ predicate on the "rejected_orders" field.
+func RejectedOrdersEQ(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldRejectedOrders, v))
+}
+
+// RejectedOrdersNEQ applies the NEQ predicate on the "rejected_orders" field.
+func RejectedOrdersNEQ(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldRejectedOrders, v))
+}
+
+// RejectedOrdersIn applies the In predicate on the "rejected_orders" field.
+func RejectedOrdersIn(vs ...int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldRejectedOrders, vs...))
+}
+
+// RejectedOrdersNotIn applies the NotIn predicate on the "rejected_orders" field.
+func RejectedOrdersNotIn(vs ...int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldRejectedOrders, vs...))
+}
+
+// RejectedOrdersGT applies the GT predicate on the "rejected_orders" field.
+func RejectedOrdersGT(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldRejectedOrders, v))
+}
+
+// RejectedOrdersGTE applies the GTE predicate on the "rejected_orders" field.
+func RejectedOrdersGTE(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldRejectedOrders, v))
+}
+
+// RejectedOrdersLT applies the LT predicate on the "rejected_orders" field.
+func RejectedOrdersLT(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldRejectedOrders, v))
+}
+
+// RejectedOrdersLTE applies the LTE predicate on the "rejected_orders" field.
+func RejectedOrdersLTE(v int) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldRejectedOrders, v))
+}
+
+// EquityCurveIsNil applies the IsNil predicate on the "equity_curve" field.
+func EquityCurveIsNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIsNull(FieldEquityCurve))
+}
+
+// EquityCurveNotNil applies the NotNil predicate on the "equity_curve" field.
+func EquityCurveNotNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotNull(FieldEquityCurve))
+}
+
+// TradesIsNil applies the IsNil predicate on the "trades" field.
+func TradesIsNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIsNull(FieldTrades))
+}
+
+// TradesNotNil applies the NotNil predicate on the "trades" field.
+func TradesNotNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotNull(FieldTrades))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.BacktestResult {
+	return predicate.BacktestResult(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// HasStrategy applies the HasEdge predicate on the "strategy" edge.
+func HasStrategy() predicate.BacktestResult {
+	return predicate.BacktestResult(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StrategyTable, StrategyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStrategyWith applies the HasEdge predicate on the "strategy" edge with a given conditions (other predicates).
+func HasStrategyWith(preds ...predicate.Strategy) predicate.BacktestResult {
+	return predicate.BacktestResult(func(s *sql.Selector) {
+		step := newStrategyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BacktestResult) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BacktestResult) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BacktestResult) predicate.BacktestResult {
+	return predicate.BacktestResult(sql.NotPredicates(p))
+}
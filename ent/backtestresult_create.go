@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/strategy"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BacktestResultCreate is the builder for creating a BacktestResult entity.
+type BacktestResultCreate struct {
+	config
+	mutation *BacktestResultMutation
+	hooks    []Hook
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *BacktestResultCreate) SetStrategyID(v uuid.UUID) *BacktestResultCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BacktestResultCreate) SetUserID(v uuid.UUID) *BacktestResultCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetSymbols sets the "symbols" field.
+func (_c *BacktestResultCreate) SetSymbols(v []string) *BacktestResultCreate {
+	_c.mutation.SetSymbols(v)
+	return _c
+}
+
+// SetInterval sets the "interval" field.
+func (_c *BacktestResultCreate) SetInterval(v string) *BacktestResultCreate {
+	_c.mutation.SetInterval(v)
+	return _c
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_c *BacktestResultCreate) SetPeriodStart(v time.Time) *BacktestResultCreate {
+	_c.mutation.SetPeriodStart(v)
+	return _c
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_c *BacktestResultCreate) SetPeriodEnd(v time.Time) *BacktestResultCreate {
+	_c.mutation.SetPeriodEnd(v)
+	return _c
+}
+
+// SetParameters sets the "parameters" field.
+func (_c *BacktestResultCreate) SetParameters(v map[string]interface{}) *BacktestResultCreate {
+	_c.mutation.SetParameters(v)
+	return _c
+}
+
+// SetSettings sets the "settings" field.
+func (_c *BacktestResultCreate) SetSettings(v map[string]interface{}) *BacktestResultCreate {
+	_c.mutation.SetSettings(v)
+	return _c
+}
+
+// SetInitialCapital sets the "initial_capital" field.
+func (_c *BacktestResultCreate) SetInitialCapital(v decimal.Decimal) *BacktestResultCreate {
+	_c.mutation.SetInitialCapital(v)
+	return _c
+}
+
+// SetFinalEquity sets the "final_equity" field.
+func (_c *BacktestResultCreate) SetFinalEquity(v decimal.Decimal) *BacktestResultCreate {
+	_c.mutation.SetFinalEquity(v)
+	return _c
+}
+
+// SetTotalReturn sets the "total_return" field.
+func (_c *BacktestResultCreate) SetTotalReturn(v float64) *BacktestResultCreate {
+	_c.mutation.SetTotalReturn(v)
+	return _c
+}
+
+// SetNillableTotalReturn sets the "total_return" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableTotalReturn(v *float64) *BacktestResultCreate {
+	if v != nil {
+		_c.SetTotalReturn(*v)
+	}
+	return _c
+}
+
+// SetMaxDrawdown sets the "max_drawdown" field.
+func (_c *BacktestResultCreate) SetMaxDrawdown(v float64) *BacktestResultCreate {
+	_c.mutation.SetMaxDrawdown(v)
+	return _c
+}
+
+// SetNillableMaxDrawdown sets the "max_drawdown" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableMaxDrawdown(v *float64) *BacktestResultCreate {
+	if v != nil {
+		_c.SetMaxDrawdown(*v)
+	}
+	return _c
+}
+
+// SetSharpeRatio sets the "sharpe_ratio" field.
+func (_c *BacktestResultCreate) SetSharpeRatio(v float64) *BacktestResultCreate {
+	_c.mutation.SetSharpeRatio(v)
+	return _c
+}
+
+// SetNillableSharpeRatio sets the "sharpe_ratio" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableSharpeRatio(v *float64) *BacktestResultCreate {
+	if v != nil {
+		_c.SetSharpeRatio(*v)
+	}
+	return _c
+}
+
+// SetWinRate sets the "win_rate" field.
+func (_c *BacktestResultCreate) SetWinRate(v float64) *BacktestResultCreate {
+	_c.mutation.SetWinRate(v)
+	return _c
+}
+
+// SetNillableWinRate sets the "win_rate" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableWinRate(v *float64) *BacktestResultCreate {
+	if v != nil {
+		_c.SetWinRate(*v)
+	}
+	return _c
+}
+
+// SetTradeCount sets the "trade_count" field.
+func (_c *BacktestResultCreate) SetTradeCount(v int) *BacktestResultCreate {
+	_c.mutation.SetTradeCount(v)
+	return _c
+}
+
+// SetNillableTradeCount sets the "trade_count" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableTradeCount(v *int) *BacktestResultCreate {
+	if v != nil {
+		_c.SetTradeCount(*v)
+	}
+	return _c
+}
+
+// SetRejectedOrders sets the "rejected_orders" field.
+func (_c *BacktestResultCreate) SetRejectedOrders(v int) *BacktestResultCreate {
+	_c.mutation.SetRejectedOrders(v)
+	return _c
+}
+
+// SetNillableRejectedOrders sets the "rejected_orders" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableRejectedOrders(v *int) *BacktestResultCreate {
+	if v != nil {
+		_c.SetRejectedOrders(*v)
+	}
+	return _c
+}
+
+// SetEquityCurve sets the "equity_curve" field.
+func (_c *BacktestResultCreate) SetEquityCurve(v jsontext.Value) *BacktestResultCreate {
+	_c.mutation.SetEquityCurve(v)
+	return _c
+}
+
+// SetTrades sets the "trades" field.
+func (_c *BacktestResultCreate) SetTrades(v jsontext.Value) *BacktestResultCreate {
+	_c.mutation.SetTrades(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BacktestResultCreate) SetCreatedAt(v time.Time) *BacktestResultCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableCreatedAt(v *time.Time) *BacktestResultCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BacktestResultCreate) SetID(v uuid.UUID) *BacktestResultCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BacktestResultCreate) SetNillableID(v *uuid.UUID) *BacktestResultCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_c *BacktestResultCreate) SetStrategy(v *Strategy) *BacktestResultCreate {
+	return _c.SetStrategyID(v.ID)
+}
+
+// Mutation returns the BacktestResultMutation object of the builder.
+func (_c *BacktestResultCreate) Mutation() *BacktestResultMutation {
+	return _c.mutation
+}
+
+// Save creates the BacktestResult in the database.
+func (_c *BacktestResultCreate) Save(ctx context.Context) (*BacktestResult, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BacktestResultCreate) SaveX(ctx context.Context) *BacktestResult {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BacktestResultCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BacktestResultCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BacktestResultCreate) defaults() {
+	if _, ok := _c.mutation.Symbols(); !ok {
+		v := backtestresult.DefaultSymbols
+		_c.mutation.SetSymbols(v)
+	}
+	if _, ok := _c.mutation.Parameters(); !ok {
+		v := backtestresult.DefaultParameters
+		_c.mutation.SetParameters(v)
+	}
+	if _, ok := _c.mutation.Settings(); !ok {
+		v := backtestresult.DefaultSettings
+		_c.mutation.SetSettings(v)
+	}
+	if _, ok := _c.mutation.TotalReturn(); !ok {
+		v := backtestresult.DefaultTotalReturn
+		_c.mutation.SetTotalReturn(v)
+	}
+	if _, ok := _c.mutation.MaxDrawdown(); !ok {
+		v := backtestresult.DefaultMaxDrawdown
+		_c.mutation.SetMaxDrawdown(v)
+	}
+	if _, ok := _c.mutation.SharpeRatio(); !ok {
+		v := backtestresult.DefaultSharpeRatio
+		_c.mutation.SetSharpeRatio(v)
+	}
+	if _, ok := _c.mutation.WinRate(); !ok {
+		v := backtestresult.DefaultWinRate
+		_c.mutation.SetWinRate(v)
+	}
+	if _, ok := _c.mutation.TradeCount(); !ok {
+		v := backtestresult.DefaultTradeCount
+		_c.mutation.SetTradeCount(v)
+	}
+	if _, ok := _c.mutation.RejectedOrders(); !ok {
+		v := backtestresult.DefaultRejectedOrders
+		_c.mutation.SetRejectedOrders(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := backtestresult.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := backtestresult.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BacktestResultCreate) check() error {
+	if _, ok := _c.mutation.StrategyID(); !ok {
+		return &ValidationError{Name: "strategy_id", err: errors.New(`ent: missing required field "BacktestResult.strategy_id"`)}
+	}
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BacktestResult.user_id"`)}
+	}
+	if _, ok := _c.mutation.Symbols(); !ok {
+		return &ValidationError{Name: "symbols", err: errors.New(`ent: missing required field "BacktestResult.symbols"`)}
+	}
+	if _, ok := _c.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "BacktestResult.interval"`)}
+	}
+	if v, ok := _c.mutation.Interval(); ok {
+		if err := backtestresult.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "BacktestResult.interval": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.PeriodStart(); !ok {
+		return &ValidationError{Name: "period_start", err: errors.New(`ent: missing required field "BacktestResult.period_start"`)}
+	}
+	if _, ok := _c.mutation.PeriodEnd(); !ok {
+		return &ValidationError{Name: "period_end", err: errors.New(`ent: missing required field "BacktestResult.period_end"`)}
+	}
+	if _, ok := _c.mutation.Parameters(); !ok {
+		return &ValidationError{Name: "parameters", err: errors.New(`ent: missing required field "BacktestResult.parameters"`)}
+	}
+	if _, ok := _c.mutation.Settings(); !ok {
+		return &ValidationError{Name: "settings", err: errors.New(`ent: missing required field "BacktestResult.settings"`)}
+	}
+	if _, ok := _c.mutation.InitialCapital(); !ok {
+		return &ValidationError{Name: "initial_capital", err: errors.New(`ent: missing required field "BacktestResult.initial_capital"`)}
+	}
+	if _, ok := _c.mutation.FinalEquity(); !ok {
+		return &ValidationError{Name: "final_equity", err: errors.New(`ent: missing required field "BacktestResult.final_equity"`)}
+	}
+	if _, ok := _c.mutation.TotalReturn(); !ok {
+		return &ValidationError{Name: "total_return", err: errors.New(`ent: missing required field "BacktestResult.total_return"`)}
+	}
+	if _, ok := _c.mutation.MaxDrawdown(); !ok {
+		return &ValidationError{Name: "max_drawdown", err: errors.New(`ent: missing required field "BacktestResult.max_drawdown"`)}
+	}
+	if _, ok := _c.mutation.SharpeRatio(); !ok {
+		return &ValidationError{Name: "sharpe_ratio", err: errors.New(`ent: missing required field "BacktestResult.sharpe_ratio"`)}
+	}
+	if _, ok := _c.mutation.WinRate(); !ok {
+		return &ValidationError{Name: "win_rate", err: errors.New(`ent: missing required field "BacktestResult.win_rate"`)}
+	}
+	if _, ok := _c.mutation.TradeCount(); !ok {
+		return &ValidationError{Name: "trade_count", err: errors.New(`ent: missing required field "BacktestResult.trade_count"`)}
+	}
+	if _, ok := _c.mutation.RejectedOrders(); !ok {
+		return &ValidationError{Name: "rejected_orders", err: errors.New(`ent: missing required field "BacktestResult.rejected_orders"`)}
+	}
+	if len(_c.mutation.StrategyIDs()) == 0 {
+		return &ValidationError{Name: "strategy", err: errors.New(`ent: missing required edge "BacktestResult.strategy"`)}
+	}
+	return nil
+}
+
+func (_c *BacktestResultCreate) sqlSave(ctx context.Context) (*BacktestResult, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BacktestResultCreate) createSpec() (*BacktestResult, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BacktestResult{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(backtestresult.Table, sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(backtestresult.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Symbols(); ok {
+		_spec.SetField(backtestresult.FieldSymbols, field.TypeJSON, value)
+		_node.Symbols = value
+	}
+	if value, ok := _c.mutation.Interval(); ok {
+		_spec.SetField(backtestresult.FieldInterval, field.TypeString, value)
+		_node.Interval = value
+	}
+	if value, ok := _c.mutation.PeriodStart(); ok {
+		_spec.SetField(backtestresult.FieldPeriodStart, field.TypeTime, value)
+		_node.PeriodStart = value
+	}
+	if value, ok := _c.mutation.PeriodEnd(); ok {
+		_spec.SetField(backtestresult.FieldPeriodEnd, field.TypeTime, value)
+		_node.PeriodEnd = value
+	}
+	if value, ok := _c.mutation.Parameters(); ok {
+		_spec.SetField(backtestresult.FieldParameters, field.TypeJSON, value)
+		_node.Parameters = value
+	}
+	if value, ok := _c.mutation.Settings(); ok {
+		_spec.SetField(backtestresult.FieldSettings, field.TypeJSON, value)
+		_node.Settings = value
+	}
+	if value, ok := _c.mutation.InitialCapital(); ok {
+		_spec.SetField(backtestresult.FieldInitialCapital, field.TypeOther, value)
+		_node.InitialCapital = value
+	}
+	if value, ok := _c.mutation.FinalEquity(); ok {
+		_spec.SetField(backtestresult.FieldFinalEquity, field.TypeOther, value)
+		_node.FinalEquity = value
+	}
+	if value, ok := _c.mutation.TotalReturn(); ok {
+		_spec.SetField(backtestresult.FieldTotalReturn, field.TypeFloat64, value)
+		_node.TotalReturn = value
+	}
+	if value, ok := _c.mutation.MaxDrawdown(); ok {
+		_spec.SetField(backtestresult.FieldMaxDrawdown, field.TypeFloat64, value)
+		_node.MaxDrawdown = value
+	}
+	if value, ok := _c.mutation.SharpeRatio(); ok {
+		_spec.SetField(backtestresult.FieldSharpeRatio, field.TypeFloat64, value)
+		_node.SharpeRatio = value
+	}
+	if value, ok := _c.mutation.WinRate(); ok {
+		_spec.SetField(backtestresult.FieldWinRate, field.TypeFloat64, value)
+		_node.WinRate = value
+	}
+	if value, ok := _c.mutation.TradeCount(); ok {
+		_spec.SetField(backtestresult.FieldTradeCount, field.TypeInt, value)
+		_node.TradeCount = value
+	}
+	if value, ok := _c.mutation.RejectedOrders(); ok {
+		_spec.SetField(backtestresult.FieldRejectedOrders, field.TypeInt, value)
+		_node.RejectedOrders = value
+	}
+	if value, ok := _c.mutation.EquityCurve(); ok {
+		_spec.SetField(backtestresult.FieldEquityCurve, field.TypeJSON, value)
+		_node.EquityCurve = value
+	}
+	if value, ok := _c.mutation.Trades(); ok {
+		_spec.SetField(backtestresult.FieldTrades, field.TypeJSON, value)
+		_node.Trades = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(backtestresult.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if nodes := _c.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backtestresult.StrategyTable,
+			Columns: []string{backtestresult.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StrategyID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// BacktestResultCreateBulk is the builder for creating many BacktestResult entities in bulk.
+type BacktestResultCreateBulk struct {
+	config
+	err      error
+	builders []*BacktestResultCreate
+}
+
+// Save creates the BacktestResult entities in the database.
+func (_c *BacktestResultCreateBulk) Save(ctx context.Context) ([]*BacktestResult, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BacktestResult, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BacktestResultMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BacktestResultCreateBulk) SaveX(ctx context.Context) []*BacktestResult {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BacktestResultCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BacktestResultCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
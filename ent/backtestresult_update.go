@@ -0,0 +1,1047 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/strategy"
+	"context"
+	"encoding/json/jsontext"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BacktestResultUpdate is the builder for updating BacktestResult entities.
+type BacktestResultUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BacktestResultMutation
+}
+
+// Where appends a list predicates to the BacktestResultUpdate builder.
+func (_u *BacktestResultUpdate) Where(ps ...predicate.BacktestResult) *BacktestResultUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *BacktestResultUpdate) SetStrategyID(v uuid.UUID) *BacktestResultUpdate {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableStrategyID(v *uuid.UUID) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BacktestResultUpdate) SetUserID(v uuid.UUID) *BacktestResultUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableUserID(v *uuid.UUID) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSymbols sets the "symbols" field.
+func (_u *BacktestResultUpdate) SetSymbols(v []string) *BacktestResultUpdate {
+	_u.mutation.SetSymbols(v)
+	return _u
+}
+
+// AppendSymbols appends value to the "symbols" field.
+func (_u *BacktestResultUpdate) AppendSymbols(v []string) *BacktestResultUpdate {
+	_u.mutation.AppendSymbols(v)
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *BacktestResultUpdate) SetInterval(v string) *BacktestResultUpdate {
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableInterval(v *string) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BacktestResultUpdate) SetPeriodStart(v time.Time) *BacktestResultUpdate {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillablePeriodStart(v *time.Time) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BacktestResultUpdate) SetPeriodEnd(v time.Time) *BacktestResultUpdate {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillablePeriodEnd(v *time.Time) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *BacktestResultUpdate) SetParameters(v map[string]interface{}) *BacktestResultUpdate {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// SetSettings sets the "settings" field.
+func (_u *BacktestResultUpdate) SetSettings(v map[string]interface{}) *BacktestResultUpdate {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetInitialCapital sets the "initial_capital" field.
+func (_u *BacktestResultUpdate) SetInitialCapital(v decimal.Decimal) *BacktestResultUpdate {
+	_u.mutation.SetInitialCapital(v)
+	return _u
+}
+
+// SetNillableInitialCapital sets the "initial_capital" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableInitialCapital(v *decimal.Decimal) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetInitialCapital(*v)
+	}
+	return _u
+}
+
+// SetFinalEquity sets the "final_equity" field.
+func (_u *BacktestResultUpdate) SetFinalEquity(v decimal.Decimal) *BacktestResultUpdate {
+	_u.mutation.SetFinalEquity(v)
+	return _u
+}
+
+// SetNillableFinalEquity sets the "final_equity" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableFinalEquity(v *decimal.Decimal) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetFinalEquity(*v)
+	}
+	return _u
+}
+
+// SetTotalReturn sets the "total_return" field.
+func (_u *BacktestResultUpdate) SetTotalReturn(v float64) *BacktestResultUpdate {
+	_u.mutation.ResetTotalReturn()
+	_u.mutation.SetTotalReturn(v)
+	return _u
+}
+
+// SetNillableTotalReturn sets the "total_return" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableTotalReturn(v *float64) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetTotalReturn(*v)
+	}
+	return _u
+}
+
+// AddTotalReturn adds value to the "total_return" field.
+func (_u *BacktestResultUpdate) AddTotalReturn(v float64) *BacktestResultUpdate {
+	_u.mutation.AddTotalReturn(v)
+	return _u
+}
+
+// SetMaxDrawdown sets the "max_drawdown" field.
+func (_u *BacktestResultUpdate) SetMaxDrawdown(v float64) *BacktestResultUpdate {
+	_u.mutation.ResetMaxDrawdown()
+	_u.mutation.SetMaxDrawdown(v)
+	return _u
+}
+
+// SetNillableMaxDrawdown sets the "max_drawdown" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableMaxDrawdown(v *float64) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetMaxDrawdown(*v)
+	}
+	return _u
+}
+
+// AddMaxDrawdown adds value to the "max_drawdown" field.
+func (_u *BacktestResultUpdate) AddMaxDrawdown(v float64) *BacktestResultUpdate {
+	_u.mutation.AddMaxDrawdown(v)
+	return _u
+}
+
+// SetSharpeRatio sets the "sharpe_ratio" field.
+func (_u *BacktestResultUpdate) SetSharpeRatio(v float64) *BacktestResultUpdate {
+	_u.mutation.ResetSharpeRatio()
+	_u.mutation.SetSharpeRatio(v)
+	return _u
+}
+
+// SetNillableSharpeRatio sets the "sharpe_ratio" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableSharpeRatio(v *float64) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetSharpeRatio(*v)
+	}
+	return _u
+}
+
+// AddSharpeRatio adds value to the "sharpe_ratio" field.
+func (_u *BacktestResultUpdate) AddSharpeRatio(v float64) *BacktestResultUpdate {
+	_u.mutation.AddSharpeRatio(v)
+	return _u
+}
+
+// SetWinRate sets the "win_rate" field.
+func (_u *BacktestResultUpdate) SetWinRate(v float64) *BacktestResultUpdate {
+	_u.mutation.ResetWinRate()
+	_u.mutation.SetWinRate(v)
+	return _u
+}
+
+// SetNillableWinRate sets the "win_rate" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableWinRate(v *float64) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetWinRate(*v)
+	}
+	return _u
+}
+
+// AddWinRate adds value to the "win_rate" field.
+func (_u *BacktestResultUpdate) AddWinRate(v float64) *BacktestResultUpdate {
+	_u.mutation.AddWinRate(v)
+	return _u
+}
+
+// SetTradeCount sets the "trade_count" field.
+func (_u *BacktestResultUpdate) SetTradeCount(v int) *BacktestResultUpdate {
+	_u.mutation.ResetTradeCount()
+	_u.mutation.SetTradeCount(v)
+	return _u
+}
+
+// SetNillableTradeCount sets the "trade_count" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableTradeCount(v *int) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetTradeCount(*v)
+	}
+	return _u
+}
+
+// AddTradeCount adds value to the "trade_count" field.
+func (_u *BacktestResultUpdate) AddTradeCount(v int) *BacktestResultUpdate {
+	_u.mutation.AddTradeCount(v)
+	return _u
+}
+
+// SetRejectedOrders sets the "rejected_orders" field.
+func (_u *BacktestResultUpdate) SetRejectedOrders(v int) *BacktestResultUpdate {
+	_u.mutation.ResetRejectedOrders()
+	_u.mutation.SetRejectedOrders(v)
+	return _u
+}
+
+// SetNillableRejectedOrders sets the "rejected_orders" field if the given value is not nil.
+func (_u *BacktestResultUpdate) SetNillableRejectedOrders(v *int) *BacktestResultUpdate {
+	if v != nil {
+		_u.SetRejectedOrders(*v)
+	}
+	return _u
+}
+
+// AddRejectedOrders adds value to the "rejected_orders" field.
+func (_u *BacktestResultUpdate) AddRejectedOrders(v int) *BacktestResultUpdate {
+	_u.mutation.AddRejectedOrders(v)
+	return _u
+}
+
+// SetEquityCurve sets the "equity_curve" field.
+func (_u *BacktestResultUpdate) SetEquityCurve(v jsontext.Value) *BacktestResultUpdate {
+	_u.mutation.SetEquityCurve(v)
+	return _u
+}
+
+// AppendEquityCurve appends value to the "equity_curve" field.
+func (_u *BacktestResultUpdate) AppendEquityCurve(v jsontext.Value) *BacktestResultUpdate {
+	_u.mutation.AppendEquityCurve(v)
+	return _u
+}
+
+// ClearEquityCurve clears the value of the "equity_curve" field.
+func (_u *BacktestResultUpdate) ClearEquityCurve() *BacktestResultUpdate {
+	_u.mutation.ClearEquityCurve()
+	return _u
+}
+
+// SetTrades sets the "trades" field.
+func (_u *BacktestResultUpdate) SetTrades(v jsontext.Value) *BacktestResultUpdate {
+	_u.mutation.SetTrades(v)
+	return _u
+}
+
+// AppendTrades appends value to the "trades" field.
+func (_u *BacktestResultUpdate) AppendTrades(v jsontext.Value) *BacktestResultUpdate {
+	_u.mutation.AppendTrades(v)
+	return _u
+}
+
+// ClearTrades clears the value of the "trades" field.
+func (_u *BacktestResultUpdate) ClearTrades() *BacktestResultUpdate {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_u *BacktestResultUpdate) SetStrategy(v *Strategy) *BacktestResultUpdate {
+	return _u.SetStrategyID(v.ID)
+}
+
+// Mutation returns the BacktestResultMutation object of the builder.
+func (_u *BacktestResultUpdate) Mutation() *BacktestResultMutation {
+	return _u.mutation
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (_u *BacktestResultUpdate) ClearStrategy() *BacktestResultUpdate {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BacktestResultUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BacktestResultUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BacktestResultUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BacktestResultUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BacktestResultUpdate) check() error {
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := backtestresult.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "BacktestResult.interval": %w`, err)}
+		}
+	}
+	if _u.mutation.StrategyCleared() && len(_u.mutation.StrategyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BacktestResult.strategy"`)
+	}
+	return nil
+}
+
+func (_u *BacktestResultUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backtestresult.Table, backtestresult.Columns, sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(backtestresult.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Symbols(); ok {
+		_spec.SetField(backtestresult.FieldSymbols, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSymbols(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldSymbols, value)
+		})
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(backtestresult.FieldInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(backtestresult.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(backtestresult.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(backtestresult.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(backtestresult.FieldSettings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.InitialCapital(); ok {
+		_spec.SetField(backtestresult.FieldInitialCapital, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FinalEquity(); ok {
+		_spec.SetField(backtestresult.FieldFinalEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TotalReturn(); ok {
+		_spec.SetField(backtestresult.FieldTotalReturn, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalReturn(); ok {
+		_spec.AddField(backtestresult.FieldTotalReturn, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxDrawdown(); ok {
+		_spec.SetField(backtestresult.FieldMaxDrawdown, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDrawdown(); ok {
+		_spec.AddField(backtestresult.FieldMaxDrawdown, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.SharpeRatio(); ok {
+		_spec.SetField(backtestresult.FieldSharpeRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSharpeRatio(); ok {
+		_spec.AddField(backtestresult.FieldSharpeRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.WinRate(); ok {
+		_spec.SetField(backtestresult.FieldWinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWinRate(); ok {
+		_spec.AddField(backtestresult.FieldWinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TradeCount(); ok {
+		_spec.SetField(backtestresult.FieldTradeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTradeCount(); ok {
+		_spec.AddField(backtestresult.FieldTradeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RejectedOrders(); ok {
+		_spec.SetField(backtestresult.FieldRejectedOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRejectedOrders(); ok {
+		_spec.AddField(backtestresult.FieldRejectedOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EquityCurve(); ok {
+		_spec.SetField(backtestresult.FieldEquityCurve, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEquityCurve(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldEquityCurve, value)
+		})
+	}
+	if _u.mutation.EquityCurveCleared() {
+		_spec.ClearField(backtestresult.FieldEquityCurve, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Trades(); ok {
+		_spec.SetField(backtestresult.FieldTrades, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrades(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldTrades, value)
+		})
+	}
+	if _u.mutation.TradesCleared() {
+		_spec.ClearField(backtestresult.FieldTrades, field.TypeJSON)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(backtestresult.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.StrategyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backtestresult.StrategyTable,
+			Columns: []string{backtestresult.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backtestresult.StrategyTable,
+			Columns: []string{backtestresult.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backtestresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BacktestResultUpdateOne is the builder for updating a single BacktestResult entity.
+type BacktestResultUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BacktestResultMutation
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *BacktestResultUpdateOne) SetStrategyID(v uuid.UUID) *BacktestResultUpdateOne {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableStrategyID(v *uuid.UUID) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BacktestResultUpdateOne) SetUserID(v uuid.UUID) *BacktestResultUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableUserID(v *uuid.UUID) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetSymbols sets the "symbols" field.
+func (_u *BacktestResultUpdateOne) SetSymbols(v []string) *BacktestResultUpdateOne {
+	_u.mutation.SetSymbols(v)
+	return _u
+}
+
+// AppendSymbols appends value to the "symbols" field.
+func (_u *BacktestResultUpdateOne) AppendSymbols(v []string) *BacktestResultUpdateOne {
+	_u.mutation.AppendSymbols(v)
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *BacktestResultUpdateOne) SetInterval(v string) *BacktestResultUpdateOne {
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableInterval(v *string) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// SetPeriodStart sets the "period_start" field.
+func (_u *BacktestResultUpdateOne) SetPeriodStart(v time.Time) *BacktestResultUpdateOne {
+	_u.mutation.SetPeriodStart(v)
+	return _u
+}
+
+// SetNillablePeriodStart sets the "period_start" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillablePeriodStart(v *time.Time) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetPeriodStart(*v)
+	}
+	return _u
+}
+
+// SetPeriodEnd sets the "period_end" field.
+func (_u *BacktestResultUpdateOne) SetPeriodEnd(v time.Time) *BacktestResultUpdateOne {
+	_u.mutation.SetPeriodEnd(v)
+	return _u
+}
+
+// SetNillablePeriodEnd sets the "period_end" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillablePeriodEnd(v *time.Time) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetPeriodEnd(*v)
+	}
+	return _u
+}
+
+// SetParameters sets the "parameters" field.
+func (_u *BacktestResultUpdateOne) SetParameters(v map[string]interface{}) *BacktestResultUpdateOne {
+	_u.mutation.SetParameters(v)
+	return _u
+}
+
+// SetSettings sets the "settings" field.
+func (_u *BacktestResultUpdateOne) SetSettings(v map[string]interface{}) *BacktestResultUpdateOne {
+	_u.mutation.SetSettings(v)
+	return _u
+}
+
+// SetInitialCapital sets the "initial_capital" field.
+func (_u *BacktestResultUpdateOne) SetInitialCapital(v decimal.Decimal) *BacktestResultUpdateOne {
+	_u.mutation.SetInitialCapital(v)
+	return _u
+}
+
+// SetNillableInitialCapital sets the "initial_capital" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableInitialCapital(v *decimal.Decimal) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetInitialCapital(*v)
+	}
+	return _u
+}
+
+// SetFinalEquity sets the "final_equity" field.
+func (_u *BacktestResultUpdateOne) SetFinalEquity(v decimal.Decimal) *BacktestResultUpdateOne {
+	_u.mutation.SetFinalEquity(v)
+	return _u
+}
+
+// SetNillableFinalEquity sets the "final_equity" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableFinalEquity(v *decimal.Decimal) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetFinalEquity(*v)
+	}
+	return _u
+}
+
+// SetTotalReturn sets the "total_return" field.
+func (_u *BacktestResultUpdateOne) SetTotalReturn(v float64) *BacktestResultUpdateOne {
+	_u.mutation.ResetTotalReturn()
+	_u.mutation.SetTotalReturn(v)
+	return _u
+}
+
+// SetNillableTotalReturn sets the "total_return" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableTotalReturn(v *float64) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetTotalReturn(*v)
+	}
+	return _u
+}
+
+// AddTotalReturn adds value to the "total_return" field.
+func (_u *BacktestResultUpdateOne) AddTotalReturn(v float64) *BacktestResultUpdateOne {
+	_u.mutation.AddTotalReturn(v)
+	return _u
+}
+
+// SetMaxDrawdown sets the "max_drawdown" field.
+func (_u *BacktestResultUpdateOne) SetMaxDrawdown(v float64) *BacktestResultUpdateOne {
+	_u.mutation.ResetMaxDrawdown()
+	_u.mutation.SetMaxDrawdown(v)
+	return _u
+}
+
+// SetNillableMaxDrawdown sets the "max_drawdown" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableMaxDrawdown(v *float64) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetMaxDrawdown(*v)
+	}
+	return _u
+}
+
+// AddMaxDrawdown adds value to the "max_drawdown" field.
+func (_u *BacktestResultUpdateOne) AddMaxDrawdown(v float64) *BacktestResultUpdateOne {
+	_u.mutation.AddMaxDrawdown(v)
+	return _u
+}
+
+// SetSharpeRatio sets the "sharpe_ratio" field.
+func (_u *BacktestResultUpdateOne) SetSharpeRatio(v float64) *BacktestResultUpdateOne {
+	_u.mutation.ResetSharpeRatio()
+	_u.mutation.SetSharpeRatio(v)
+	return _u
+}
+
+// SetNillableSharpeRatio sets the "sharpe_ratio" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableSharpeRatio(v *float64) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetSharpeRatio(*v)
+	}
+	return _u
+}
+
+// AddSharpeRatio adds value to the "sharpe_ratio" field.
+func (_u *BacktestResultUpdateOne) AddSharpeRatio(v float64) *BacktestResultUpdateOne {
+	_u.mutation.AddSharpeRatio(v)
+	return _u
+}
+
+// SetWinRate sets the "win_rate" field.
+func (_u *BacktestResultUpdateOne) SetWinRate(v float64) *BacktestResultUpdateOne {
+	_u.mutation.ResetWinRate()
+	_u.mutation.SetWinRate(v)
+	return _u
+}
+
+// SetNillableWinRate sets the "win_rate" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableWinRate(v *float64) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetWinRate(*v)
+	}
+	return _u
+}
+
+// AddWinRate adds value to the "win_rate" field.
+func (_u *BacktestResultUpdateOne) AddWinRate(v float64) *BacktestResultUpdateOne {
+	_u.mutation.AddWinRate(v)
+	return _u
+}
+
+// SetTradeCount sets the "trade_count" field.
+func (_u *BacktestResultUpdateOne) SetTradeCount(v int) *BacktestResultUpdateOne {
+	_u.mutation.ResetTradeCount()
+	_u.mutation.SetTradeCount(v)
+	return _u
+}
+
+// SetNillableTradeCount sets the "trade_count" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableTradeCount(v *int) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetTradeCount(*v)
+	}
+	return _u
+}
+
+// AddTradeCount adds value to the "trade_count" field.
+func (_u *BacktestResultUpdateOne) AddTradeCount(v int) *BacktestResultUpdateOne {
+	_u.mutation.AddTradeCount(v)
+	return _u
+}
+
+// SetRejectedOrders sets the "rejected_orders" field.
+func (_u *BacktestResultUpdateOne) SetRejectedOrders(v int) *BacktestResultUpdateOne {
+	_u.mutation.ResetRejectedOrders()
+	_u.mutation.SetRejectedOrders(v)
+	return _u
+}
+
+// SetNillableRejectedOrders sets the "rejected_orders" field if the given value is not nil.
+func (_u *BacktestResultUpdateOne) SetNillableRejectedOrders(v *int) *BacktestResultUpdateOne {
+	if v != nil {
+		_u.SetRejectedOrders(*v)
+	}
+	return _u
+}
+
+// AddRejectedOrders adds value to the "rejected_orders" field.
+func (_u *BacktestResultUpdateOne) AddRejectedOrders(v int) *BacktestResultUpdateOne {
+	_u.mutation.AddRejectedOrders(v)
+	return _u
+}
+
+// SetEquityCurve sets the "equity_curve" field.
+func (_u *BacktestResultUpdateOne) SetEquityCurve(v jsontext.Value) *BacktestResultUpdateOne {
+	_u.mutation.SetEquityCurve(v)
+	return _u
+}
+
+// AppendEquityCurve appends value to the "equity_curve" field.
+func (_u *BacktestResultUpdateOne) AppendEquityCurve(v jsontext.Value) *BacktestResultUpdateOne {
+	_u.mutation.AppendEquityCurve(v)
+	return _u
+}
+
+// ClearEquityCurve clears the value of the "equity_curve" field.
+func (_u *BacktestResultUpdateOne) ClearEquityCurve() *BacktestResultUpdateOne {
+	_u.mutation.ClearEquityCurve()
+	return _u
+}
+
+// SetTrades sets the "trades" field.
+func (_u *BacktestResultUpdateOne) SetTrades(v jsontext.Value) *BacktestResultUpdateOne {
+	_u.mutation.SetTrades(v)
+	return _u
+}
+
+// AppendTrades appends value to the "trades" field.
+func (_u *BacktestResultUpdateOne) AppendTrades(v jsontext.Value) *BacktestResultUpdateOne {
+	_u.mutation.AppendTrades(v)
+	return _u
+}
+
+// ClearTrades clears the value of the "trades" field.
+func (_u *BacktestResultUpdateOne) ClearTrades() *BacktestResultUpdateOne {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_u *BacktestResultUpdateOne) SetStrategy(v *Strategy) *BacktestResultUpdateOne {
+	return _u.SetStrategyID(v.ID)
+}
+
+// Mutation returns the BacktestResultMutation object of the builder.
+func (_u *BacktestResultUpdateOne) Mutation() *BacktestResultMutation {
+	return _u.mutation
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (_u *BacktestResultUpdateOne) ClearStrategy() *BacktestResultUpdateOne {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// Where appends a list predicates to the BacktestResultUpdate builder.
+func (_u *BacktestResultUpdateOne) Where(ps ...predicate.BacktestResult) *BacktestResultUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BacktestResultUpdateOne) Select(field string, fields ...string) *BacktestResultUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BacktestResult entity.
+func (_u *BacktestResultUpdateOne) Save(ctx context.Context) (*BacktestResult, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BacktestResultUpdateOne) SaveX(ctx context.Context) *BacktestResult {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BacktestResultUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BacktestResultUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BacktestResultUpdateOne) check() error {
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := backtestresult.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "BacktestResult.interval": %w`, err)}
+		}
+	}
+	if _u.mutation.StrategyCleared() && len(_u.mutation.StrategyIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "BacktestResult.strategy"`)
+	}
+	return nil
+}
+
+func (_u *BacktestResultUpdateOne) sqlSave(ctx context.Context) (_node *BacktestResult, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(backtestresult.Table, backtestresult.Columns, sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BacktestResult.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, backtestresult.FieldID)
+		for _, f := range fields {
+			if !backtestresult.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != backtestresult.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(backtestresult.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Symbols(); ok {
+		_spec.SetField(backtestresult.FieldSymbols, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedSymbols(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldSymbols, value)
+		})
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(backtestresult.FieldInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.PeriodStart(); ok {
+		_spec.SetField(backtestresult.FieldPeriodStart, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.PeriodEnd(); ok {
+		_spec.SetField(backtestresult.FieldPeriodEnd, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Parameters(); ok {
+		_spec.SetField(backtestresult.FieldParameters, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.Settings(); ok {
+		_spec.SetField(backtestresult.FieldSettings, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.InitialCapital(); ok {
+		_spec.SetField(backtestresult.FieldInitialCapital, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FinalEquity(); ok {
+		_spec.SetField(backtestresult.FieldFinalEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.TotalReturn(); ok {
+		_spec.SetField(backtestresult.FieldTotalReturn, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTotalReturn(); ok {
+		_spec.AddField(backtestresult.FieldTotalReturn, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.MaxDrawdown(); ok {
+		_spec.SetField(backtestresult.FieldMaxDrawdown, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedMaxDrawdown(); ok {
+		_spec.AddField(backtestresult.FieldMaxDrawdown, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.SharpeRatio(); ok {
+		_spec.SetField(backtestresult.FieldSharpeRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedSharpeRatio(); ok {
+		_spec.AddField(backtestresult.FieldSharpeRatio, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.WinRate(); ok {
+		_spec.SetField(backtestresult.FieldWinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedWinRate(); ok {
+		_spec.AddField(backtestresult.FieldWinRate, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TradeCount(); ok {
+		_spec.SetField(backtestresult.FieldTradeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedTradeCount(); ok {
+		_spec.AddField(backtestresult.FieldTradeCount, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.RejectedOrders(); ok {
+		_spec.SetField(backtestresult.FieldRejectedOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedRejectedOrders(); ok {
+		_spec.AddField(backtestresult.FieldRejectedOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.EquityCurve(); ok {
+		_spec.SetField(backtestresult.FieldEquityCurve, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedEquityCurve(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldEquityCurve, value)
+		})
+	}
+	if _u.mutation.EquityCurveCleared() {
+		_spec.ClearField(backtestresult.FieldEquityCurve, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.Trades(); ok {
+		_spec.SetField(backtestresult.FieldTrades, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedTrades(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, backtestresult.FieldTrades, value)
+		})
+	}
+	if _u.mutation.TradesCleared() {
+		_spec.ClearField(backtestresult.FieldTrades, field.TypeJSON)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(backtestresult.FieldCreatedAt, field.TypeTime)
+	}
+	if _u.mutation.StrategyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backtestresult.StrategyTable,
+			Columns: []string{backtestresult.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   backtestresult.StrategyTable,
+			Columns: []string{backtestresult.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &BacktestResult{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{backtestresult.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,235 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/brokeraccount"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// BrokerAccount is the model entity for the BrokerAccount schema.
+type BrokerAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Broker holds the value of the "broker" field.
+	Broker brokeraccount.Broker `json:"broker,omitempty"`
+	// AccountNo holds the value of the "account_no" field.
+	AccountNo string `json:"account_no,omitempty"`
+	// ProductCode holds the value of the "product_code" field.
+	ProductCode string `json:"product_code,omitempty"`
+	// IsDemo holds the value of the "is_demo" field.
+	IsDemo bool `json:"is_demo,omitempty"`
+	// AppKeyCipher holds the value of the "app_key_cipher" field.
+	AppKeyCipher []byte `json:"-"`
+	// AppSecretCipher holds the value of the "app_secret_cipher" field.
+	AppSecretCipher []byte `json:"-"`
+	// DataKey holds the value of the "data_key" field.
+	DataKey []byte `json:"-"`
+	// KeyID holds the value of the "key_id" field.
+	KeyID string `json:"key_id,omitempty"`
+	// AppKeyHint holds the value of the "app_key_hint" field.
+	AppKeyHint string `json:"app_key_hint,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    *time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*BrokerAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case brokeraccount.FieldAppKeyCipher, brokeraccount.FieldAppSecretCipher, brokeraccount.FieldDataKey:
+			values[i] = new([]byte)
+		case brokeraccount.FieldIsDemo:
+			values[i] = new(sql.NullBool)
+		case brokeraccount.FieldBroker, brokeraccount.FieldAccountNo, brokeraccount.FieldProductCode, brokeraccount.FieldKeyID, brokeraccount.FieldAppKeyHint:
+			values[i] = new(sql.NullString)
+		case brokeraccount.FieldCreatedAt, brokeraccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case brokeraccount.FieldID, brokeraccount.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the BrokerAccount fields.
+func (_m *BrokerAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case brokeraccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case brokeraccount.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case brokeraccount.FieldBroker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field broker", values[i])
+			} else if value.Valid {
+				_m.Broker = brokeraccount.Broker(value.String)
+			}
+		case brokeraccount.FieldAccountNo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account_no", values[i])
+			} else if value.Valid {
+				_m.AccountNo = value.String
+			}
+		case brokeraccount.FieldProductCode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field product_code", values[i])
+			} else if value.Valid {
+				_m.ProductCode = value.String
+			}
+		case brokeraccount.FieldIsDemo:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_demo", values[i])
+			} else if value.Valid {
+				_m.IsDemo = value.Bool
+			}
+		case brokeraccount.FieldAppKeyCipher:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field app_key_cipher", values[i])
+			} else if value != nil {
+				_m.AppKeyCipher = *value
+			}
+		case brokeraccount.FieldAppSecretCipher:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field app_secret_cipher", values[i])
+			} else if value != nil {
+				_m.AppSecretCipher = *value
+			}
+		case brokeraccount.FieldDataKey:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data_key", values[i])
+			} else if value != nil {
+				_m.DataKey = *value
+			}
+		case brokeraccount.FieldKeyID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field key_id", values[i])
+			} else if value.Valid {
+				_m.KeyID = value.String
+			}
+		case brokeraccount.FieldAppKeyHint:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field app_key_hint", values[i])
+			} else if value.Valid {
+				_m.AppKeyHint = value.String
+			}
+		case brokeraccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case brokeraccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the BrokerAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *BrokerAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this BrokerAccount.
+// Note that you need to call BrokerAccount.Unwrap() before calling this method if this BrokerAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *BrokerAccount) Update() *BrokerAccountUpdateOne {
+	return NewBrokerAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the BrokerAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *BrokerAccount) Unwrap() *BrokerAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: BrokerAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *BrokerAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("BrokerAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("broker=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Broker))
+	builder.WriteString(", ")
+	builder.WriteString("account_no=")
+	builder.WriteString(_m.AccountNo)
+	builder.WriteString(", ")
+	builder.WriteString("product_code=")
+	builder.WriteString(_m.ProductCode)
+	builder.WriteString(", ")
+	builder.WriteString("is_demo=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsDemo))
+	builder.WriteString(", ")
+	builder.WriteString("app_key_cipher=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("app_secret_cipher=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("data_key=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("key_id=")
+	builder.WriteString(_m.KeyID)
+	builder.WriteString(", ")
+	builder.WriteString("app_key_hint=")
+	builder.WriteString(_m.AppKeyHint)
+	builder.WriteString(", ")
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// BrokerAccounts is a parsable slice of BrokerAccount.
+type BrokerAccounts []*BrokerAccount
@@ -0,0 +1,174 @@
+// Code generated by ent, DO NOT EDIT.
+
+package brokeraccount
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the brokeraccount type in the database.
+	Label = "broker_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldBroker holds the string denoting the broker field in the database.
+	FieldBroker = "broker"
+	// FieldAccountNo holds the string denoting the account_no field in the database.
+	FieldAccountNo = "account_no"
+	// FieldProductCode holds the string denoting the product_code field in the database.
+	FieldProductCode = "product_code"
+	// FieldIsDemo holds the string denoting the is_demo field in the database.
+	FieldIsDemo = "is_demo"
+	// FieldAppKeyCipher holds the string denoting the app_key_cipher field in the database.
+	FieldAppKeyCipher = "app_key_cipher"
+	// FieldAppSecretCipher holds the string denoting the app_secret_cipher field in the database.
+	FieldAppSecretCipher = "app_secret_cipher"
+	// FieldDataKey holds the string denoting the data_key field in the database.
+	FieldDataKey = "data_key"
+	// FieldKeyID holds the string denoting the key_id field in the database.
+	FieldKeyID = "key_id"
+	// FieldAppKeyHint holds the string denoting the app_key_hint field in the database.
+	FieldAppKeyHint = "app_key_hint"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the brokeraccount in the database.
+	Table = "broker_accounts"
+)
+
+// Columns holds all SQL columns for brokeraccount fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldBroker,
+	FieldAccountNo,
+	FieldProductCode,
+	FieldIsDemo,
+	FieldAppKeyCipher,
+	FieldAppSecretCipher,
+	FieldDataKey,
+	FieldKeyID,
+	FieldAppKeyHint,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// AccountNoValidator is a validator for the "account_no" field. It is called by the builders before save.
+	AccountNoValidator func(string) error
+	// DefaultProductCode holds the default value on creation for the "product_code" field.
+	DefaultProductCode string
+	// ProductCodeValidator is a validator for the "product_code" field. It is called by the builders before save.
+	ProductCodeValidator func(string) error
+	// DefaultIsDemo holds the default value on creation for the "is_demo" field.
+	DefaultIsDemo bool
+	// KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	KeyIDValidator func(string) error
+	// DefaultAppKeyHint holds the default value on creation for the "app_key_hint" field.
+	DefaultAppKeyHint string
+	// AppKeyHintValidator is a validator for the "app_key_hint" field. It is called by the builders before save.
+	AppKeyHintValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Broker defines the type for the "broker" enum field.
+type Broker string
+
+// BrokerKis is the default value of the Broker enum.
+const DefaultBroker = BrokerKis
+
+// Broker values.
+const (
+	BrokerKis Broker = "kis"
+)
+
+func (b Broker) String() string {
+	return string(b)
+}
+
+// BrokerValidator is a validator for the "broker" field enum values. It is called by the builders before save.
+func BrokerValidator(b Broker) error {
+	switch b {
+	case BrokerKis:
+		return nil
+	default:
+		return fmt.Errorf("brokeraccount: invalid enum value for broker field: %q", b)
+	}
+}
+
+// OrderOption defines the ordering options for the BrokerAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByBroker orders the results by the broker field.
+func ByBroker(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBroker, opts...).ToFunc()
+}
+
+// ByAccountNo orders the results by the account_no field.
+func ByAccountNo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountNo, opts...).ToFunc()
+}
+
+// ByProductCode orders the results by the product_code field.
+func ByProductCode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProductCode, opts...).ToFunc()
+}
+
+// ByIsDemo orders the results by the is_demo field.
+func ByIsDemo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldIsDemo, opts...).ToFunc()
+}
+
+// ByKeyID orders the results by the key_id field.
+func ByKeyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldKeyID, opts...).ToFunc()
+}
+
+// ByAppKeyHint orders the results by the app_key_hint field.
+func ByAppKeyHint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAppKeyHint, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,676 @@
+// Code generated by ent, DO NOT EDIT.
+
+package brokeraccount
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// AccountNo applies equality check predicate on the "account_no" field. It's identical to AccountNoEQ.
+func AccountNo(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAccountNo, v))
+}
+
+// ProductCode applies equality check predicate on the "product_code" field. It's identical to ProductCodeEQ.
+func ProductCode(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldProductCode, v))
+}
+
+// IsDemo applies equality check predicate on the "is_demo" field. It's identical to IsDemoEQ.
+func IsDemo(v bool) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldIsDemo, v))
+}
+
+// AppKeyCipher applies equality check predicate on the "app_key_cipher" field. It's identical to AppKeyCipherEQ.
+func AppKeyCipher(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppKeyCipher, v))
+}
+
+// AppSecretCipher applies equality check predicate on the "app_secret_cipher" field. It's identical to AppSecretCipherEQ.
+func AppSecretCipher(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppSecretCipher, v))
+}
+
+// DataKey applies equality check predicate on the "data_key" field. It's identical to DataKeyEQ.
+func DataKey(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldDataKey, v))
+}
+
+// KeyID applies equality check predicate on the "key_id" field. It's identical to KeyIDEQ.
+func KeyID(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldKeyID, v))
+}
+
+// AppKeyHint applies equality check predicate on the "app_key_hint" field. It's identical to AppKeyHintEQ.
+func AppKeyHint(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppKeyHint, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldUserID, v))
+}
+
+// BrokerEQ applies the EQ predicate on the "broker" field.
+func BrokerEQ(v Broker) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldBroker, v))
+}
+
+// BrokerNEQ applies the NEQ predicate on the "broker" field.
+func BrokerNEQ(v Broker) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldBroker, v))
+}
+
+// BrokerIn applies the In predicate on the "broker" field.
+func BrokerIn(vs ...Broker) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldBroker, vs...))
+}
+
+// BrokerNotIn applies the NotIn predicate on the "broker" field.
+func BrokerNotIn(vs ...Broker) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldBroker, vs...))
+}
+
+// AccountNoEQ applies the EQ predicate on the "account_no" field.
+func AccountNoEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAccountNo, v))
+}
+
+// AccountNoNEQ applies the NEQ predicate on the "account_no" field.
+func AccountNoNEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldAccountNo, v))
+}
+
+// AccountNoIn applies the In predicate on the "account_no" field.
+func AccountNoIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldAccountNo, vs...))
+}
+
+// AccountNoNotIn applies the NotIn predicate on the "account_no" field.
+func AccountNoNotIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldAccountNo, vs...))
+}
+
+// AccountNoGT applies the GT predicate on the "account_no" field.
+func AccountNoGT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldAccountNo, v))
+}
+
+// AccountNoGTE applies the GTE predicate on the "account_no" field.
+func AccountNoGTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldAccountNo, v))
+}
+
+// AccountNoLT applies the LT predicate on the "account_no" field.
+func AccountNoLT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldAccountNo, v))
+}
+
+// AccountNoLTE applies the LTE predicate on the "account_no" field.
+func AccountNoLTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldAccountNo, v))
+}
+
+// AccountNoContains applies the Contains predicate on the "account_no" field.
+func AccountNoContains(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContains(FieldAccountNo, v))
+}
+
+// AccountNoHasPrefix applies the HasPrefix predicate on the "account_no" field.
+func AccountNoHasPrefix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasPrefix(FieldAccountNo, v))
+}
+
+// AccountNoHasSuffix applies the HasSuffix predicate on the "account_no" field.
+func AccountNoHasSuffix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasSuffix(FieldAccountNo, v))
+}
+
+// AccountNoEqualFold applies the EqualFold predicate on the "account_no" field.
+func AccountNoEqualFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEqualFold(FieldAccountNo, v))
+}
+
+// AccountNoContainsFold applies the ContainsFold predicate on the "account_no" field.
+func AccountNoContainsFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContainsFold(FieldAccountNo, v))
+}
+
+// ProductCodeEQ applies the EQ predicate on the "product_code" field.
+func ProductCodeEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldProductCode, v))
+}
+
+// ProductCodeNEQ applies the NEQ predicate on the "product_code" field.
+func ProductCodeNEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldProductCode, v))
+}
+
+// ProductCodeIn applies the In predicate on the "product_code" field.
+func ProductCodeIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldProductCode, vs...))
+}
+
+// ProductCodeNotIn applies the NotIn predicate on the "product_code" field.
+func ProductCodeNotIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldProductCode, vs...))
+}
+
+// ProductCodeGT applies the GT predicate on the "product_code" field.
+func ProductCodeGT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldProductCode, v))
+}
+
+// ProductCodeGTE applies the GTE predicate on the "product_code" field.
+func ProductCodeGTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldProductCode, v))
+}
+
+// ProductCodeLT applies the LT predicate on the "product_code" field.
+func ProductCodeLT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldProductCode, v))
+}
+
+// ProductCodeLTE applies the LTE predicate on the "product_code" field.
+func ProductCodeLTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldProductCode, v))
+}
+
+// ProductCodeContains applies the Contains predicate on the "product_code" field.
+func ProductCodeContains(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContains(FieldProductCode, v))
+}
+
+// ProductCodeHasPrefix applies the HasPrefix predicate on the "product_code" field.
+func ProductCodeHasPrefix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasPrefix(FieldProductCode, v))
+}
+
+// ProductCodeHasSuffix applies the HasSuffix predicate on the "product_code" field.
+func ProductCodeHasSuffix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasSuffix(FieldProductCode, v))
+}
+
+// ProductCodeEqualFold applies the EqualFold predicate on the "product_code" field.
+func ProductCodeEqualFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEqualFold(FieldProductCode, v))
+}
+
+// ProductCodeContainsFold applies the ContainsFold predicate on the "product_code" field.
+func ProductCodeContainsFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContainsFold(FieldProductCode, v))
+}
+
+// IsDemoEQ applies the EQ predicate on the "is_demo" field.
+func IsDemoEQ(v bool) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldIsDemo, v))
+}
+
+// IsDemoNEQ applies the NEQ predicate on the "is_demo" field.
+func IsDemoNEQ(v bool) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldIsDemo, v))
+}
+
+// AppKeyCipherEQ applies the EQ predicate on the "app_key_cipher" field.
+func AppKeyCipherEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppKeyCipher, v))
+}
+
+// AppKeyCipherNEQ applies the NEQ predicate on the "app_key_cipher" field.
+func AppKeyCipherNEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldAppKeyCipher, v))
+}
+
+// AppKeyCipherIn applies the In predicate on the "app_key_cipher" field.
+func AppKeyCipherIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldAppKeyCipher, vs...))
+}
+
+// AppKeyCipherNotIn applies the NotIn predicate on the "app_key_cipher" field.
+func AppKeyCipherNotIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldAppKeyCipher, vs...))
+}
+
+// AppKeyCipherGT applies the GT predicate on the "app_key_cipher" field.
+func AppKeyCipherGT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldAppKeyCipher, v))
+}
+
+// AppKeyCipherGTE applies the GTE predicate on the "app_key_cipher" field.
+func AppKeyCipherGTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldAppKeyCipher, v))
+}
+
+// AppKeyCipherLT applies the LT predicate on the "app_key_cipher" field.
+func AppKeyCipherLT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldAppKeyCipher, v))
+}
+
+// AppKeyCipherLTE applies the LTE predicate on the "app_key_cipher" field.
+func AppKeyCipherLTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldAppKeyCipher, v))
+}
+
+// AppSecretCipherEQ applies the EQ predicate on the "app_secret_cipher" field.
+func AppSecretCipherEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppSecretCipher, v))
+}
+
+// AppSecretCipherNEQ applies the NEQ predicate on the "app_secret_cipher" field.
+func AppSecretCipherNEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldAppSecretCipher, v))
+}
+
+// AppSecretCipherIn applies the In predicate on the "app_secret_cipher" field.
+func AppSecretCipherIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldAppSecretCipher, vs...))
+}
+
+// AppSecretCipherNotIn applies the NotIn predicate on the "app_secret_cipher" field.
+func AppSecretCipherNotIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldAppSecretCipher, vs...))
+}
+
+// AppSecretCipherGT applies the GT predicate on the "app_secret_cipher" field.
+func AppSecretCipherGT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldAppSecretCipher, v))
+}
+
+// AppSecretCipherGTE applies the GTE predicate on the "app_secret_cipher" field.
+func AppSecretCipherGTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldAppSecretCipher, v))
+}
+
+// AppSecretCipherLT applies the LT predicate on the "app_secret_cipher" field.
+func AppSecretCipherLT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldAppSecretCipher, v))
+}
+
+// AppSecretCipherLTE applies the LTE predicate on the "app_secret_cipher" field.
+func AppSecretCipherLTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldAppSecretCipher, v))
+}
+
+// DataKeyEQ applies the EQ predicate on the "data_key" field.
+func DataKeyEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldDataKey, v))
+}
+
+// DataKeyNEQ applies the NEQ predicate on the "data_key" field.
+func DataKeyNEQ(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldDataKey, v))
+}
+
+// DataKeyIn applies the In predicate on the "data_key" field.
+func DataKeyIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldDataKey, vs...))
+}
+
+// DataKeyNotIn applies the NotIn predicate on the "data_key" field.
+func DataKeyNotIn(vs ...[]byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldDataKey, vs...))
+}
+
+// DataKeyGT applies the GT predicate on the "data_key" field.
+func DataKeyGT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldDataKey, v))
+}
+
+// DataKeyGTE applies the GTE predicate on the "data_key" field.
+func DataKeyGTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldDataKey, v))
+}
+
+// DataKeyLT applies the LT predicate on the "data_key" field.
+func DataKeyLT(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldDataKey, v))
+}
+
+// DataKeyLTE applies the LTE predicate on the "data_key" field.
+func DataKeyLTE(v []byte) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldDataKey, v))
+}
+
+// KeyIDEQ applies the EQ predicate on the "key_id" field.
+func KeyIDEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldKeyID, v))
+}
+
+// KeyIDNEQ applies the NEQ predicate on the "key_id" field.
+func KeyIDNEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldKeyID, v))
+}
+
+// KeyIDIn applies the In predicate on the "key_id" field.
+func KeyIDIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldKeyID, vs...))
+}
+
+// KeyIDNotIn applies the NotIn predicate on the "key_id" field.
+func KeyIDNotIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldKeyID, vs...))
+}
+
+// KeyIDGT applies the GT predicate on the "key_id" field.
+func KeyIDGT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldKeyID, v))
+}
+
+// KeyIDGTE applies the GTE predicate on the "key_id" field.
+func KeyIDGTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldKeyID, v))
+}
+
+// KeyIDLT applies the LT predicate on the "key_id" field.
+func KeyIDLT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldKeyID, v))
+}
+
+// KeyIDLTE applies the LTE predicate on the "key_id" field.
+func KeyIDLTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldKeyID, v))
+}
+
+// KeyIDContains applies the Contains predicate on the "key_id" field.
+func KeyIDContains(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContains(FieldKeyID, v))
+}
+
+// KeyIDHasPrefix applies the HasPrefix predicate on the "key_id" field.
+func KeyIDHasPrefix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasPrefix(FieldKeyID, v))
+}
+
+// KeyIDHasSuffix applies the HasSuffix predicate on the "key_id" field.
+func KeyIDHasSuffix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasSuffix(FieldKeyID, v))
+}
+
+// KeyIDEqualFold applies the EqualFold predicate on the "key_id" field.
+func KeyIDEqualFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEqualFold(FieldKeyID, v))
+}
+
+// KeyIDContainsFold applies the ContainsFold predicate on the "key_id" field.
+func KeyIDContainsFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContainsFold(FieldKeyID, v))
+}
+
+// AppKeyHintEQ applies the EQ predicate on the "app_key_hint" field.
+func AppKeyHintEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldAppKeyHint, v))
+}
+
+// AppKeyHintNEQ applies the NEQ predicate on the "app_key_hint" field.
+func AppKeyHintNEQ(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldAppKeyHint, v))
+}
+
+// AppKeyHintIn applies the In predicate on the "app_key_hint" field.
+func AppKeyHintIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldAppKeyHint, vs...))
+}
+
+// AppKeyHintNotIn applies the NotIn predicate on the "app_key_hint" field.
+func AppKeyHintNotIn(vs ...string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldAppKeyHint, vs...))
+}
+
+// AppKeyHintGT applies the GT predicate on the "app_key_hint" field.
+func AppKeyHintGT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldAppKeyHint, v))
+}
+
+// AppKeyHintGTE applies the GTE predicate on the "app_key_hint" field.
+func AppKeyHintGTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldAppKeyHint, v))
+}
+
+// AppKeyHintLT applies the LT predicate on the "app_key_hint" field.
+func AppKeyHintLT(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldAppKeyHint, v))
+}
+
+// AppKeyHintLTE applies the LTE predicate on the "app_key_hint" field.
+func AppKeyHintLTE(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldAppKeyHint, v))
+}
+
+// AppKeyHintContains applies the Contains predicate on the "app_key_hint" field.
+func AppKeyHintContains(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContains(FieldAppKeyHint, v))
+}
+
+// AppKeyHintHasPrefix applies the HasPrefix predicate on the "app_key_hint" field.
+func AppKeyHintHasPrefix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasPrefix(FieldAppKeyHint, v))
+}
+
+// AppKeyHintHasSuffix applies the HasSuffix predicate on the "app_key_hint" field.
+func AppKeyHintHasSuffix(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldHasSuffix(FieldAppKeyHint, v))
+}
+
+// AppKeyHintEqualFold applies the EqualFold predicate on the "app_key_hint" field.
+func AppKeyHintEqualFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEqualFold(FieldAppKeyHint, v))
+}
+
+// AppKeyHintContainsFold applies the ContainsFold predicate on the "app_key_hint" field.
+func AppKeyHintContainsFold(v string) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldContainsFold(FieldAppKeyHint, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.BrokerAccount) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.BrokerAccount) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.BrokerAccount) predicate.BrokerAccount {
+	return predicate.BrokerAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,448 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/brokeraccount"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BrokerAccountCreate is the builder for creating a BrokerAccount entity.
+type BrokerAccountCreate struct {
+	config
+	mutation *BrokerAccountMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *BrokerAccountCreate) SetUserID(v uuid.UUID) *BrokerAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetBroker sets the "broker" field.
+func (_c *BrokerAccountCreate) SetBroker(v brokeraccount.Broker) *BrokerAccountCreate {
+	_c.mutation.SetBroker(v)
+	return _c
+}
+
+// SetNillableBroker sets the "broker" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableBroker(v *brokeraccount.Broker) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetBroker(*v)
+	}
+	return _c
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_c *BrokerAccountCreate) SetAccountNo(v string) *BrokerAccountCreate {
+	_c.mutation.SetAccountNo(v)
+	return _c
+}
+
+// SetProductCode sets the "product_code" field.
+func (_c *BrokerAccountCreate) SetProductCode(v string) *BrokerAccountCreate {
+	_c.mutation.SetProductCode(v)
+	return _c
+}
+
+// SetNillableProductCode sets the "product_code" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableProductCode(v *string) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetProductCode(*v)
+	}
+	return _c
+}
+
+// SetIsDemo sets the "is_demo" field.
+func (_c *BrokerAccountCreate) SetIsDemo(v bool) *BrokerAccountCreate {
+	_c.mutation.SetIsDemo(v)
+	return _c
+}
+
+// SetNillableIsDemo sets the "is_demo" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableIsDemo(v *bool) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetIsDemo(*v)
+	}
+	return _c
+}
+
+// SetAppKeyCipher sets the "app_key_cipher" field.
+func (_c *BrokerAccountCreate) SetAppKeyCipher(v []byte) *BrokerAccountCreate {
+	_c.mutation.SetAppKeyCipher(v)
+	return _c
+}
+
+// SetAppSecretCipher sets the "app_secret_cipher" field.
+func (_c *BrokerAccountCreate) SetAppSecretCipher(v []byte) *BrokerAccountCreate {
+	_c.mutation.SetAppSecretCipher(v)
+	return _c
+}
+
+// SetDataKey sets the "data_key" field.
+func (_c *BrokerAccountCreate) SetDataKey(v []byte) *BrokerAccountCreate {
+	_c.mutation.SetDataKey(v)
+	return _c
+}
+
+// SetKeyID sets the "key_id" field.
+func (_c *BrokerAccountCreate) SetKeyID(v string) *BrokerAccountCreate {
+	_c.mutation.SetKeyID(v)
+	return _c
+}
+
+// SetAppKeyHint sets the "app_key_hint" field.
+func (_c *BrokerAccountCreate) SetAppKeyHint(v string) *BrokerAccountCreate {
+	_c.mutation.SetAppKeyHint(v)
+	return _c
+}
+
+// SetNillableAppKeyHint sets the "app_key_hint" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableAppKeyHint(v *string) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetAppKeyHint(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *BrokerAccountCreate) SetCreatedAt(v time.Time) *BrokerAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableCreatedAt(v *time.Time) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *BrokerAccountCreate) SetUpdatedAt(v time.Time) *BrokerAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableUpdatedAt(v *time.Time) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *BrokerAccountCreate) SetID(v uuid.UUID) *BrokerAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *BrokerAccountCreate) SetNillableID(v *uuid.UUID) *BrokerAccountCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the BrokerAccountMutation object of the builder.
+func (_c *BrokerAccountCreate) Mutation() *BrokerAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the BrokerAccount in the database.
+func (_c *BrokerAccountCreate) Save(ctx context.Context) (*BrokerAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *BrokerAccountCreate) SaveX(ctx context.Context) *BrokerAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BrokerAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BrokerAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *BrokerAccountCreate) defaults() {
+	if _, ok := _c.mutation.Broker(); !ok {
+		v := brokeraccount.DefaultBroker
+		_c.mutation.SetBroker(v)
+	}
+	if _, ok := _c.mutation.ProductCode(); !ok {
+		v := brokeraccount.DefaultProductCode
+		_c.mutation.SetProductCode(v)
+	}
+	if _, ok := _c.mutation.IsDemo(); !ok {
+		v := brokeraccount.DefaultIsDemo
+		_c.mutation.SetIsDemo(v)
+	}
+	if _, ok := _c.mutation.AppKeyHint(); !ok {
+		v := brokeraccount.DefaultAppKeyHint
+		_c.mutation.SetAppKeyHint(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := brokeraccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := brokeraccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := brokeraccount.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *BrokerAccountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "BrokerAccount.user_id"`)}
+	}
+	if _, ok := _c.mutation.Broker(); !ok {
+		return &ValidationError{Name: "broker", err: errors.New(`ent: missing required field "BrokerAccount.broker"`)}
+	}
+	if v, ok := _c.mutation.Broker(); ok {
+		if err := brokeraccount.BrokerValidator(v); err != nil {
+			return &ValidationError{Name: "broker", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.broker": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AccountNo(); !ok {
+		return &ValidationError{Name: "account_no", err: errors.New(`ent: missing required field "BrokerAccount.account_no"`)}
+	}
+	if v, ok := _c.mutation.AccountNo(); ok {
+		if err := brokeraccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.account_no": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.ProductCode(); !ok {
+		return &ValidationError{Name: "product_code", err: errors.New(`ent: missing required field "BrokerAccount.product_code"`)}
+	}
+	if v, ok := _c.mutation.ProductCode(); ok {
+		if err := brokeraccount.ProductCodeValidator(v); err != nil {
+			return &ValidationError{Name: "product_code", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.product_code": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.IsDemo(); !ok {
+		return &ValidationError{Name: "is_demo", err: errors.New(`ent: missing required field "BrokerAccount.is_demo"`)}
+	}
+	if _, ok := _c.mutation.AppKeyCipher(); !ok {
+		return &ValidationError{Name: "app_key_cipher", err: errors.New(`ent: missing required field "BrokerAccount.app_key_cipher"`)}
+	}
+	if _, ok := _c.mutation.AppSecretCipher(); !ok {
+		return &ValidationError{Name: "app_secret_cipher", err: errors.New(`ent: missing required field "BrokerAccount.app_secret_cipher"`)}
+	}
+	if _, ok := _c.mutation.DataKey(); !ok {
+		return &ValidationError{Name: "data_key", err: errors.New(`ent: missing required field "BrokerAccount.data_key"`)}
+	}
+	if _, ok := _c.mutation.KeyID(); !ok {
+		return &ValidationError{Name: "key_id", err: errors.New(`ent: missing required field "BrokerAccount.key_id"`)}
+	}
+	if v, ok := _c.mutation.KeyID(); ok {
+		if err := brokeraccount.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.key_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.AppKeyHint(); !ok {
+		return &ValidationError{Name: "app_key_hint", err: errors.New(`ent: missing required field "BrokerAccount.app_key_hint"`)}
+	}
+	if v, ok := _c.mutation.AppKeyHint(); ok {
+		if err := brokeraccount.AppKeyHintValidator(v); err != nil {
+			return &ValidationError{Name: "app_key_hint", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.app_key_hint": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_c *BrokerAccountCreate) sqlSave(ctx context.Context) (*BrokerAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *BrokerAccountCreate) createSpec() (*BrokerAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &BrokerAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(brokeraccount.Table, sqlgraph.NewFieldSpec(brokeraccount.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(brokeraccount.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Broker(); ok {
+		_spec.SetField(brokeraccount.FieldBroker, field.TypeEnum, value)
+		_node.Broker = value
+	}
+	if value, ok := _c.mutation.AccountNo(); ok {
+		_spec.SetField(brokeraccount.FieldAccountNo, field.TypeString, value)
+		_node.AccountNo = value
+	}
+	if value, ok := _c.mutation.ProductCode(); ok {
+		_spec.SetField(brokeraccount.FieldProductCode, field.TypeString, value)
+		_node.ProductCode = value
+	}
+	if value, ok := _c.mutation.IsDemo(); ok {
+		_spec.SetField(brokeraccount.FieldIsDemo, field.TypeBool, value)
+		_node.IsDemo = value
+	}
+	if value, ok := _c.mutation.AppKeyCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyCipher, field.TypeBytes, value)
+		_node.AppKeyCipher = value
+	}
+	if value, ok := _c.mutation.AppSecretCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppSecretCipher, field.TypeBytes, value)
+		_node.AppSecretCipher = value
+	}
+	if value, ok := _c.mutation.DataKey(); ok {
+		_spec.SetField(brokeraccount.FieldDataKey, field.TypeBytes, value)
+		_node.DataKey = value
+	}
+	if value, ok := _c.mutation.KeyID(); ok {
+		_spec.SetField(brokeraccount.FieldKeyID, field.TypeString, value)
+		_node.KeyID = value
+	}
+	if value, ok := _c.mutation.AppKeyHint(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyHint, field.TypeString, value)
+		_node.AppKeyHint = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(brokeraccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(brokeraccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	return _node, _spec
+}
+
+// BrokerAccountCreateBulk is the builder for creating many BrokerAccount entities in bulk.
+type BrokerAccountCreateBulk struct {
+	config
+	err      error
+	builders []*BrokerAccountCreate
+}
+
+// Save creates the BrokerAccount entities in the database.
+func (_c *BrokerAccountCreateBulk) Save(ctx context.Context) ([]*BrokerAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*BrokerAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*BrokerAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *BrokerAccountCreateBulk) SaveX(ctx context.Context) []*BrokerAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *BrokerAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *BrokerAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
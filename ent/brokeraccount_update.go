@@ -0,0 +1,595 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/brokeraccount"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BrokerAccountUpdate is the builder for updating BrokerAccount entities.
+type BrokerAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *BrokerAccountMutation
+}
+
+// Where appends a list predicates to the BrokerAccountUpdate builder.
+func (_u *BrokerAccountUpdate) Where(ps ...predicate.BrokerAccount) *BrokerAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BrokerAccountUpdate) SetUserID(v uuid.UUID) *BrokerAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableUserID(v *uuid.UUID) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetBroker sets the "broker" field.
+func (_u *BrokerAccountUpdate) SetBroker(v brokeraccount.Broker) *BrokerAccountUpdate {
+	_u.mutation.SetBroker(v)
+	return _u
+}
+
+// SetNillableBroker sets the "broker" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableBroker(v *brokeraccount.Broker) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetBroker(*v)
+	}
+	return _u
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_u *BrokerAccountUpdate) SetAccountNo(v string) *BrokerAccountUpdate {
+	_u.mutation.SetAccountNo(v)
+	return _u
+}
+
+// SetNillableAccountNo sets the "account_no" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableAccountNo(v *string) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetAccountNo(*v)
+	}
+	return _u
+}
+
+// SetProductCode sets the "product_code" field.
+func (_u *BrokerAccountUpdate) SetProductCode(v string) *BrokerAccountUpdate {
+	_u.mutation.SetProductCode(v)
+	return _u
+}
+
+// SetNillableProductCode sets the "product_code" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableProductCode(v *string) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetProductCode(*v)
+	}
+	return _u
+}
+
+// SetIsDemo sets the "is_demo" field.
+func (_u *BrokerAccountUpdate) SetIsDemo(v bool) *BrokerAccountUpdate {
+	_u.mutation.SetIsDemo(v)
+	return _u
+}
+
+// SetNillableIsDemo sets the "is_demo" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableIsDemo(v *bool) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetIsDemo(*v)
+	}
+	return _u
+}
+
+// SetAppKeyCipher sets the "app_key_cipher" field.
+func (_u *BrokerAccountUpdate) SetAppKeyCipher(v []byte) *BrokerAccountUpdate {
+	_u.mutation.SetAppKeyCipher(v)
+	return _u
+}
+
+// SetAppSecretCipher sets the "app_secret_cipher" field.
+func (_u *BrokerAccountUpdate) SetAppSecretCipher(v []byte) *BrokerAccountUpdate {
+	_u.mutation.SetAppSecretCipher(v)
+	return _u
+}
+
+// SetDataKey sets the "data_key" field.
+func (_u *BrokerAccountUpdate) SetDataKey(v []byte) *BrokerAccountUpdate {
+	_u.mutation.SetDataKey(v)
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *BrokerAccountUpdate) SetKeyID(v string) *BrokerAccountUpdate {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableKeyID(v *string) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// SetAppKeyHint sets the "app_key_hint" field.
+func (_u *BrokerAccountUpdate) SetAppKeyHint(v string) *BrokerAccountUpdate {
+	_u.mutation.SetAppKeyHint(v)
+	return _u
+}
+
+// SetNillableAppKeyHint sets the "app_key_hint" field if the given value is not nil.
+func (_u *BrokerAccountUpdate) SetNillableAppKeyHint(v *string) *BrokerAccountUpdate {
+	if v != nil {
+		_u.SetAppKeyHint(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BrokerAccountUpdate) SetUpdatedAt(v time.Time) *BrokerAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BrokerAccountUpdate) ClearUpdatedAt() *BrokerAccountUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BrokerAccountMutation object of the builder.
+func (_u *BrokerAccountUpdate) Mutation() *BrokerAccountMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *BrokerAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *BrokerAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BrokerAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := brokeraccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BrokerAccountUpdate) check() error {
+	if v, ok := _u.mutation.Broker(); ok {
+		if err := brokeraccount.BrokerValidator(v); err != nil {
+			return &ValidationError{Name: "broker", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.broker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNo(); ok {
+		if err := brokeraccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.account_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProductCode(); ok {
+		if err := brokeraccount.ProductCodeValidator(v); err != nil {
+			return &ValidationError{Name: "product_code", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.product_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KeyID(); ok {
+		if err := brokeraccount.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppKeyHint(); ok {
+		if err := brokeraccount.AppKeyHintValidator(v); err != nil {
+			return &ValidationError{Name: "app_key_hint", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.app_key_hint": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BrokerAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(brokeraccount.Table, brokeraccount.Columns, sqlgraph.NewFieldSpec(brokeraccount.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(brokeraccount.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Broker(); ok {
+		_spec.SetField(brokeraccount.FieldBroker, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccountNo(); ok {
+		_spec.SetField(brokeraccount.FieldAccountNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProductCode(); ok {
+		_spec.SetField(brokeraccount.FieldProductCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsDemo(); ok {
+		_spec.SetField(brokeraccount.FieldIsDemo, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AppKeyCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyCipher, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AppSecretCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppSecretCipher, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.DataKey(); ok {
+		_spec.SetField(brokeraccount.FieldDataKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(brokeraccount.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AppKeyHint(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyHint, field.TypeString, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(brokeraccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(brokeraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(brokeraccount.FieldUpdatedAt, field.TypeTime)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// BrokerAccountUpdateOne is the builder for updating a single BrokerAccount entity.
+type BrokerAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *BrokerAccountMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *BrokerAccountUpdateOne) SetUserID(v uuid.UUID) *BrokerAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableUserID(v *uuid.UUID) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetBroker sets the "broker" field.
+func (_u *BrokerAccountUpdateOne) SetBroker(v brokeraccount.Broker) *BrokerAccountUpdateOne {
+	_u.mutation.SetBroker(v)
+	return _u
+}
+
+// SetNillableBroker sets the "broker" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableBroker(v *brokeraccount.Broker) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetBroker(*v)
+	}
+	return _u
+}
+
+// SetAccountNo sets the "account_no" field.
+func (_u *BrokerAccountUpdateOne) SetAccountNo(v string) *BrokerAccountUpdateOne {
+	_u.mutation.SetAccountNo(v)
+	return _u
+}
+
+// SetNillableAccountNo sets the "account_no" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableAccountNo(v *string) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetAccountNo(*v)
+	}
+	return _u
+}
+
+// SetProductCode sets the "product_code" field.
+func (_u *BrokerAccountUpdateOne) SetProductCode(v string) *BrokerAccountUpdateOne {
+	_u.mutation.SetProductCode(v)
+	return _u
+}
+
+// SetNillableProductCode sets the "product_code" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableProductCode(v *string) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetProductCode(*v)
+	}
+	return _u
+}
+
+// SetIsDemo sets the "is_demo" field.
+func (_u *BrokerAccountUpdateOne) SetIsDemo(v bool) *BrokerAccountUpdateOne {
+	_u.mutation.SetIsDemo(v)
+	return _u
+}
+
+// SetNillableIsDemo sets the "is_demo" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableIsDemo(v *bool) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetIsDemo(*v)
+	}
+	return _u
+}
+
+// SetAppKeyCipher sets the "app_key_cipher" field.
+func (_u *BrokerAccountUpdateOne) SetAppKeyCipher(v []byte) *BrokerAccountUpdateOne {
+	_u.mutation.SetAppKeyCipher(v)
+	return _u
+}
+
+// SetAppSecretCipher sets the "app_secret_cipher" field.
+func (_u *BrokerAccountUpdateOne) SetAppSecretCipher(v []byte) *BrokerAccountUpdateOne {
+	_u.mutation.SetAppSecretCipher(v)
+	return _u
+}
+
+// SetDataKey sets the "data_key" field.
+func (_u *BrokerAccountUpdateOne) SetDataKey(v []byte) *BrokerAccountUpdateOne {
+	_u.mutation.SetDataKey(v)
+	return _u
+}
+
+// SetKeyID sets the "key_id" field.
+func (_u *BrokerAccountUpdateOne) SetKeyID(v string) *BrokerAccountUpdateOne {
+	_u.mutation.SetKeyID(v)
+	return _u
+}
+
+// SetNillableKeyID sets the "key_id" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableKeyID(v *string) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetKeyID(*v)
+	}
+	return _u
+}
+
+// SetAppKeyHint sets the "app_key_hint" field.
+func (_u *BrokerAccountUpdateOne) SetAppKeyHint(v string) *BrokerAccountUpdateOne {
+	_u.mutation.SetAppKeyHint(v)
+	return _u
+}
+
+// SetNillableAppKeyHint sets the "app_key_hint" field if the given value is not nil.
+func (_u *BrokerAccountUpdateOne) SetNillableAppKeyHint(v *string) *BrokerAccountUpdateOne {
+	if v != nil {
+		_u.SetAppKeyHint(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *BrokerAccountUpdateOne) SetUpdatedAt(v time.Time) *BrokerAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *BrokerAccountUpdateOne) ClearUpdatedAt() *BrokerAccountUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// Mutation returns the BrokerAccountMutation object of the builder.
+func (_u *BrokerAccountUpdateOne) Mutation() *BrokerAccountMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the BrokerAccountUpdate builder.
+func (_u *BrokerAccountUpdateOne) Where(ps ...predicate.BrokerAccount) *BrokerAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *BrokerAccountUpdateOne) Select(field string, fields ...string) *BrokerAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated BrokerAccount entity.
+func (_u *BrokerAccountUpdateOne) Save(ctx context.Context) (*BrokerAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *BrokerAccountUpdateOne) SaveX(ctx context.Context) *BrokerAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *BrokerAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *BrokerAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *BrokerAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := brokeraccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *BrokerAccountUpdateOne) check() error {
+	if v, ok := _u.mutation.Broker(); ok {
+		if err := brokeraccount.BrokerValidator(v); err != nil {
+			return &ValidationError{Name: "broker", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.broker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AccountNo(); ok {
+		if err := brokeraccount.AccountNoValidator(v); err != nil {
+			return &ValidationError{Name: "account_no", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.account_no": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ProductCode(); ok {
+		if err := brokeraccount.ProductCodeValidator(v); err != nil {
+			return &ValidationError{Name: "product_code", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.product_code": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.KeyID(); ok {
+		if err := brokeraccount.KeyIDValidator(v); err != nil {
+			return &ValidationError{Name: "key_id", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.key_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.AppKeyHint(); ok {
+		if err := brokeraccount.AppKeyHintValidator(v); err != nil {
+			return &ValidationError{Name: "app_key_hint", err: fmt.Errorf(`ent: validator failed for field "BrokerAccount.app_key_hint": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *BrokerAccountUpdateOne) sqlSave(ctx context.Context) (_node *BrokerAccount, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(brokeraccount.Table, brokeraccount.Columns, sqlgraph.NewFieldSpec(brokeraccount.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "BrokerAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, brokeraccount.FieldID)
+		for _, f := range fields {
+			if !brokeraccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != brokeraccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(brokeraccount.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Broker(); ok {
+		_spec.SetField(brokeraccount.FieldBroker, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.AccountNo(); ok {
+		_spec.SetField(brokeraccount.FieldAccountNo, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ProductCode(); ok {
+		_spec.SetField(brokeraccount.FieldProductCode, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.IsDemo(); ok {
+		_spec.SetField(brokeraccount.FieldIsDemo, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.AppKeyCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyCipher, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.AppSecretCipher(); ok {
+		_spec.SetField(brokeraccount.FieldAppSecretCipher, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.DataKey(); ok {
+		_spec.SetField(brokeraccount.FieldDataKey, field.TypeBytes, value)
+	}
+	if value, ok := _u.mutation.KeyID(); ok {
+		_spec.SetField(brokeraccount.FieldKeyID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.AppKeyHint(); ok {
+		_spec.SetField(brokeraccount.FieldAppKeyHint, field.TypeString, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(brokeraccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(brokeraccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(brokeraccount.FieldUpdatedAt, field.TypeTime)
+	}
+	_node = &BrokerAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{brokeraccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
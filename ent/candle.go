@@ -0,0 +1,211 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/candle"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Candle is the model entity for the Candle schema.
+type Candle struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Interval holds the value of the "interval" field.
+	Interval string `json:"interval,omitempty"`
+	// Timestamp holds the value of the "timestamp" field.
+	Timestamp time.Time `json:"timestamp,omitempty"`
+	// Open holds the value of the "open" field.
+	Open decimal.Decimal `json:"open,omitempty"`
+	// High holds the value of the "high" field.
+	High decimal.Decimal `json:"high,omitempty"`
+	// Low holds the value of the "low" field.
+	Low decimal.Decimal `json:"low,omitempty"`
+	// Close holds the value of the "close" field.
+	Close decimal.Decimal `json:"close,omitempty"`
+	// Volume holds the value of the "volume" field.
+	Volume int64 `json:"volume,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Candle) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case candle.FieldOpen, candle.FieldHigh, candle.FieldLow, candle.FieldClose:
+			values[i] = new(decimal.Decimal)
+		case candle.FieldVolume:
+			values[i] = new(sql.NullInt64)
+		case candle.FieldSymbol, candle.FieldExchange, candle.FieldInterval:
+			values[i] = new(sql.NullString)
+		case candle.FieldTimestamp, candle.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case candle.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Candle fields.
+func (_m *Candle) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case candle.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case candle.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case candle.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case candle.FieldInterval:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field interval", values[i])
+			} else if value.Valid {
+				_m.Interval = value.String
+			}
+		case candle.FieldTimestamp:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field timestamp", values[i])
+			} else if value.Valid {
+				_m.Timestamp = value.Time
+			}
+		case candle.FieldOpen:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field open", values[i])
+			} else if value != nil {
+				_m.Open = *value
+			}
+		case candle.FieldHigh:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field high", values[i])
+			} else if value != nil {
+				_m.High = *value
+			}
+		case candle.FieldLow:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field low", values[i])
+			} else if value != nil {
+				_m.Low = *value
+			}
+		case candle.FieldClose:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field close", values[i])
+			} else if value != nil {
+				_m.Close = *value
+			}
+		case candle.FieldVolume:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field volume", values[i])
+			} else if value.Valid {
+				_m.Volume = value.Int64
+			}
+		case candle.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Candle.
+// This includes values selected through modifiers, order, etc.
+func (_m *Candle) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Candle.
+// Note that you need to call Candle.Unwrap() before calling this method if this Candle
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Candle) Update() *CandleUpdateOne {
+	return NewCandleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Candle entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Candle) Unwrap() *Candle {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Candle is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Candle) String() string {
+	var builder strings.Builder
+	builder.WriteString("Candle(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("interval=")
+	builder.WriteString(_m.Interval)
+	builder.WriteString(", ")
+	builder.WriteString("timestamp=")
+	builder.WriteString(_m.Timestamp.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("open=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Open))
+	builder.WriteString(", ")
+	builder.WriteString("high=")
+	builder.WriteString(fmt.Sprintf("%v", _m.High))
+	builder.WriteString(", ")
+	builder.WriteString("low=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Low))
+	builder.WriteString(", ")
+	builder.WriteString("close=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Close))
+	builder.WriteString(", ")
+	builder.WriteString("volume=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Volume))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Candles is a parsable slice of Candle.
+type Candles []*Candle
@@ -0,0 +1,137 @@
+// Code generated by ent, DO NOT EDIT.
+
+package candle
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the candle type in the database.
+	Label = "candle"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldInterval holds the string denoting the interval field in the database.
+	FieldInterval = "interval"
+	// FieldTimestamp holds the string denoting the timestamp field in the database.
+	FieldTimestamp = "timestamp"
+	// FieldOpen holds the string denoting the open field in the database.
+	FieldOpen = "open"
+	// FieldHigh holds the string denoting the high field in the database.
+	FieldHigh = "high"
+	// FieldLow holds the string denoting the low field in the database.
+	FieldLow = "low"
+	// FieldClose holds the string denoting the close field in the database.
+	FieldClose = "close"
+	// FieldVolume holds the string denoting the volume field in the database.
+	FieldVolume = "volume"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the candle in the database.
+	Table = "candles"
+)
+
+// Columns holds all SQL columns for candle fields.
+var Columns = []string{
+	FieldID,
+	FieldSymbol,
+	FieldExchange,
+	FieldInterval,
+	FieldTimestamp,
+	FieldOpen,
+	FieldHigh,
+	FieldLow,
+	FieldClose,
+	FieldVolume,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	IntervalValidator func(string) error
+	// DefaultVolume holds the default value on creation for the "volume" field.
+	DefaultVolume int64
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the Candle queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByInterval orders the results by the interval field.
+func ByInterval(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInterval, opts...).ToFunc()
+}
+
+// ByTimestamp orders the results by the timestamp field.
+func ByTimestamp(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimestamp, opts...).ToFunc()
+}
+
+// ByOpen orders the results by the open field.
+func ByOpen(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpen, opts...).ToFunc()
+}
+
+// ByHigh orders the results by the high field.
+func ByHigh(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldHigh, opts...).ToFunc()
+}
+
+// ByLow orders the results by the low field.
+func ByLow(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLow, opts...).ToFunc()
+}
+
+// ByClose orders the results by the close field.
+func ByClose(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClose, opts...).ToFunc()
+}
+
+// ByVolume orders the results by the volume field.
+func ByVolume(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldVolume, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,597 @@
+// Code generated by ent, DO NOT EDIT.
+
+package candle
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldID, id))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldExchange, v))
+}
+
+// Interval applies equality check predicate on the "interval" field. It's identical to IntervalEQ.
+func Interval(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldInterval, v))
+}
+
+// Timestamp applies equality check predicate on the "timestamp" field. It's identical to TimestampEQ.
+func Timestamp(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// Open applies equality check predicate on the "open" field. It's identical to OpenEQ.
+func Open(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldOpen, v))
+}
+
+// High applies equality check predicate on the "high" field. It's identical to HighEQ.
+func High(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldHigh, v))
+}
+
+// Low applies equality check predicate on the "low" field. It's identical to LowEQ.
+func Low(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldLow, v))
+}
+
+// Close applies equality check predicate on the "close" field. It's identical to CloseEQ.
+func Close(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldClose, v))
+}
+
+// Volume applies equality check predicate on the "volume" field. It's identical to VolumeEQ.
+func Volume(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldVolume, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// IntervalEQ applies the EQ predicate on the "interval" field.
+func IntervalEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldInterval, v))
+}
+
+// IntervalNEQ applies the NEQ predicate on the "interval" field.
+func IntervalNEQ(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldInterval, v))
+}
+
+// IntervalIn applies the In predicate on the "interval" field.
+func IntervalIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldInterval, vs...))
+}
+
+// IntervalNotIn applies the NotIn predicate on the "interval" field.
+func IntervalNotIn(vs ...string) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldInterval, vs...))
+}
+
+// IntervalGT applies the GT predicate on the "interval" field.
+func IntervalGT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldInterval, v))
+}
+
+// IntervalGTE applies the GTE predicate on the "interval" field.
+func IntervalGTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldInterval, v))
+}
+
+// IntervalLT applies the LT predicate on the "interval" field.
+func IntervalLT(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldInterval, v))
+}
+
+// IntervalLTE applies the LTE predicate on the "interval" field.
+func IntervalLTE(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldInterval, v))
+}
+
+// IntervalContains applies the Contains predicate on the "interval" field.
+func IntervalContains(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContains(FieldInterval, v))
+}
+
+// IntervalHasPrefix applies the HasPrefix predicate on the "interval" field.
+func IntervalHasPrefix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasPrefix(FieldInterval, v))
+}
+
+// IntervalHasSuffix applies the HasSuffix predicate on the "interval" field.
+func IntervalHasSuffix(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldHasSuffix(FieldInterval, v))
+}
+
+// IntervalEqualFold applies the EqualFold predicate on the "interval" field.
+func IntervalEqualFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldEqualFold(FieldInterval, v))
+}
+
+// IntervalContainsFold applies the ContainsFold predicate on the "interval" field.
+func IntervalContainsFold(v string) predicate.Candle {
+	return predicate.Candle(sql.FieldContainsFold(FieldInterval, v))
+}
+
+// TimestampEQ applies the EQ predicate on the "timestamp" field.
+func TimestampEQ(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldTimestamp, v))
+}
+
+// TimestampNEQ applies the NEQ predicate on the "timestamp" field.
+func TimestampNEQ(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldTimestamp, v))
+}
+
+// TimestampIn applies the In predicate on the "timestamp" field.
+func TimestampIn(vs ...time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldTimestamp, vs...))
+}
+
+// TimestampNotIn applies the NotIn predicate on the "timestamp" field.
+func TimestampNotIn(vs ...time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldTimestamp, vs...))
+}
+
+// TimestampGT applies the GT predicate on the "timestamp" field.
+func TimestampGT(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldTimestamp, v))
+}
+
+// TimestampGTE applies the GTE predicate on the "timestamp" field.
+func TimestampGTE(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldTimestamp, v))
+}
+
+// TimestampLT applies the LT predicate on the "timestamp" field.
+func TimestampLT(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldTimestamp, v))
+}
+
+// TimestampLTE applies the LTE predicate on the "timestamp" field.
+func TimestampLTE(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldTimestamp, v))
+}
+
+// OpenEQ applies the EQ predicate on the "open" field.
+func OpenEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldOpen, v))
+}
+
+// OpenNEQ applies the NEQ predicate on the "open" field.
+func OpenNEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldOpen, v))
+}
+
+// OpenIn applies the In predicate on the "open" field.
+func OpenIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldOpen, vs...))
+}
+
+// OpenNotIn applies the NotIn predicate on the "open" field.
+func OpenNotIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldOpen, vs...))
+}
+
+// OpenGT applies the GT predicate on the "open" field.
+func OpenGT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldOpen, v))
+}
+
+// OpenGTE applies the GTE predicate on the "open" field.
+func OpenGTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldOpen, v))
+}
+
+// OpenLT applies the LT predicate on the "open" field.
+func OpenLT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldOpen, v))
+}
+
+// OpenLTE applies the LTE predicate on the "open" field.
+func OpenLTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldOpen, v))
+}
+
+// HighEQ applies the EQ predicate on the "high" field.
+func HighEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldHigh, v))
+}
+
+// HighNEQ applies the NEQ predicate on the "high" field.
+func HighNEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldHigh, v))
+}
+
+// HighIn applies the In predicate on the "high" field.
+func HighIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldHigh, vs...))
+}
+
+// HighNotIn applies the NotIn predicate on the "high" field.
+func HighNotIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldHigh, vs...))
+}
+
+// HighGT applies the GT predicate on the "high" field.
+func HighGT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldHigh, v))
+}
+
+// HighGTE applies the GTE predicate on the "high" field.
+func HighGTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldHigh, v))
+}
+
+// HighLT applies the LT predicate on the "high" field.
+func HighLT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldHigh, v))
+}
+
+// HighLTE applies the LTE predicate on the "high" field.
+func HighLTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldHigh, v))
+}
+
+// LowEQ applies the EQ predicate on the "low" field.
+func LowEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldLow, v))
+}
+
+// LowNEQ applies the NEQ predicate on the "low" field.
+func LowNEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldLow, v))
+}
+
+// LowIn applies the In predicate on the "low" field.
+func LowIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldLow, vs...))
+}
+
+// LowNotIn applies the NotIn predicate on the "low" field.
+func LowNotIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldLow, vs...))
+}
+
+// LowGT applies the GT predicate on the "low" field.
+func LowGT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldLow, v))
+}
+
+// LowGTE applies the GTE predicate on the "low" field.
+func LowGTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldLow, v))
+}
+
+// LowLT applies the LT predicate on the "low" field.
+func LowLT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldLow, v))
+}
+
+// LowLTE applies the LTE predicate on the "low" field.
+func LowLTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldLow, v))
+}
+
+// CloseEQ applies the EQ predicate on the "close" field.
+func CloseEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldClose, v))
+}
+
+// CloseNEQ applies the NEQ predicate on the "close" field.
+func CloseNEQ(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldClose, v))
+}
+
+// CloseIn applies the In predicate on the "close" field.
+func CloseIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldClose, vs...))
+}
+
+// CloseNotIn applies the NotIn predicate on the "close" field.
+func CloseNotIn(vs ...decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldClose, vs...))
+}
+
+// CloseGT applies the GT predicate on the "close" field.
+func CloseGT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldClose, v))
+}
+
+// CloseGTE applies the GTE predicate on the "close" field.
+func CloseGTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldClose, v))
+}
+
+// CloseLT applies the LT predicate on the "close" field.
+func CloseLT(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldClose, v))
+}
+
+// CloseLTE applies the LTE predicate on the "close" field.
+func CloseLTE(v decimal.Decimal) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldClose, v))
+}
+
+// VolumeEQ applies the EQ predicate on the "volume" field.
+func VolumeEQ(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldVolume, v))
+}
+
+// VolumeNEQ applies the NEQ predicate on the "volume" field.
+func VolumeNEQ(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldVolume, v))
+}
+
+// VolumeIn applies the In predicate on the "volume" field.
+func VolumeIn(vs ...int64) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldVolume, vs...))
+}
+
+// VolumeNotIn applies the NotIn predicate on the "volume" field.
+func VolumeNotIn(vs ...int64) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldVolume, vs...))
+}
+
+// VolumeGT applies the GT predicate on the "volume" field.
+func VolumeGT(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldVolume, v))
+}
+
+// VolumeGTE applies the GTE predicate on the "volume" field.
+func VolumeGTE(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldVolume, v))
+}
+
+// VolumeLT applies the LT predicate on the "volume" field.
+func VolumeLT(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldVolume, v))
+}
+
+// VolumeLTE applies the LTE predicate on the "volume" field.
+func VolumeLTE(v int64) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldVolume, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Candle {
+	return predicate.Candle(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Candle) predicate.Candle {
+	return predicate.Candle(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Candle) predicate.Candle {
+	return predicate.Candle(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Candle) predicate.Candle {
+	return predicate.Candle(sql.NotPredicates(p))
+}
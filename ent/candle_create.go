@@ -0,0 +1,371 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/candle"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// CandleCreate is the builder for creating a Candle entity.
+type CandleCreate struct {
+	config
+	mutation *CandleMutation
+	hooks    []Hook
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *CandleCreate) SetSymbol(v string) *CandleCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *CandleCreate) SetExchange(v string) *CandleCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetInterval sets the "interval" field.
+func (_c *CandleCreate) SetInterval(v string) *CandleCreate {
+	_c.mutation.SetInterval(v)
+	return _c
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_c *CandleCreate) SetTimestamp(v time.Time) *CandleCreate {
+	_c.mutation.SetTimestamp(v)
+	return _c
+}
+
+// SetOpen sets the "open" field.
+func (_c *CandleCreate) SetOpen(v decimal.Decimal) *CandleCreate {
+	_c.mutation.SetOpen(v)
+	return _c
+}
+
+// SetHigh sets the "high" field.
+func (_c *CandleCreate) SetHigh(v decimal.Decimal) *CandleCreate {
+	_c.mutation.SetHigh(v)
+	return _c
+}
+
+// SetLow sets the "low" field.
+func (_c *CandleCreate) SetLow(v decimal.Decimal) *CandleCreate {
+	_c.mutation.SetLow(v)
+	return _c
+}
+
+// SetClose sets the "close" field.
+func (_c *CandleCreate) SetClose(v decimal.Decimal) *CandleCreate {
+	_c.mutation.SetClose(v)
+	return _c
+}
+
+// SetVolume sets the "volume" field.
+func (_c *CandleCreate) SetVolume(v int64) *CandleCreate {
+	_c.mutation.SetVolume(v)
+	return _c
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_c *CandleCreate) SetNillableVolume(v *int64) *CandleCreate {
+	if v != nil {
+		_c.SetVolume(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *CandleCreate) SetCreatedAt(v time.Time) *CandleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *CandleCreate) SetNillableCreatedAt(v *time.Time) *CandleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *CandleCreate) SetID(v uuid.UUID) *CandleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *CandleCreate) SetNillableID(v *uuid.UUID) *CandleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the CandleMutation object of the builder.
+func (_c *CandleCreate) Mutation() *CandleMutation {
+	return _c.mutation
+}
+
+// Save creates the Candle in the database.
+func (_c *CandleCreate) Save(ctx context.Context) (*Candle, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *CandleCreate) SaveX(ctx context.Context) *Candle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CandleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CandleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *CandleCreate) defaults() {
+	if _, ok := _c.mutation.Volume(); !ok {
+		v := candle.DefaultVolume
+		_c.mutation.SetVolume(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := candle.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := candle.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *CandleCreate) check() error {
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "Candle.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := candle.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Candle.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "Candle.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := candle.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Candle.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Interval(); !ok {
+		return &ValidationError{Name: "interval", err: errors.New(`ent: missing required field "Candle.interval"`)}
+	}
+	if v, ok := _c.mutation.Interval(); ok {
+		if err := candle.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Candle.interval": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Timestamp(); !ok {
+		return &ValidationError{Name: "timestamp", err: errors.New(`ent: missing required field "Candle.timestamp"`)}
+	}
+	if _, ok := _c.mutation.Open(); !ok {
+		return &ValidationError{Name: "open", err: errors.New(`ent: missing required field "Candle.open"`)}
+	}
+	if _, ok := _c.mutation.High(); !ok {
+		return &ValidationError{Name: "high", err: errors.New(`ent: missing required field "Candle.high"`)}
+	}
+	if _, ok := _c.mutation.Low(); !ok {
+		return &ValidationError{Name: "low", err: errors.New(`ent: missing required field "Candle.low"`)}
+	}
+	if _, ok := _c.mutation.Close(); !ok {
+		return &ValidationError{Name: "close", err: errors.New(`ent: missing required field "Candle.close"`)}
+	}
+	if _, ok := _c.mutation.Volume(); !ok {
+		return &ValidationError{Name: "volume", err: errors.New(`ent: missing required field "Candle.volume"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Candle.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *CandleCreate) sqlSave(ctx context.Context) (*Candle, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *CandleCreate) createSpec() (*Candle, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Candle{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(candle.Table, sqlgraph.NewFieldSpec(candle.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(candle.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(candle.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Interval(); ok {
+		_spec.SetField(candle.FieldInterval, field.TypeString, value)
+		_node.Interval = value
+	}
+	if value, ok := _c.mutation.Timestamp(); ok {
+		_spec.SetField(candle.FieldTimestamp, field.TypeTime, value)
+		_node.Timestamp = value
+	}
+	if value, ok := _c.mutation.Open(); ok {
+		_spec.SetField(candle.FieldOpen, field.TypeOther, value)
+		_node.Open = value
+	}
+	if value, ok := _c.mutation.High(); ok {
+		_spec.SetField(candle.FieldHigh, field.TypeOther, value)
+		_node.High = value
+	}
+	if value, ok := _c.mutation.Low(); ok {
+		_spec.SetField(candle.FieldLow, field.TypeOther, value)
+		_node.Low = value
+	}
+	if value, ok := _c.mutation.Close(); ok {
+		_spec.SetField(candle.FieldClose, field.TypeOther, value)
+		_node.Close = value
+	}
+	if value, ok := _c.mutation.Volume(); ok {
+		_spec.SetField(candle.FieldVolume, field.TypeInt64, value)
+		_node.Volume = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(candle.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// CandleCreateBulk is the builder for creating many Candle entities in bulk.
+type CandleCreateBulk struct {
+	config
+	err      error
+	builders []*CandleCreate
+}
+
+// Save creates the Candle entities in the database.
+func (_c *CandleCreateBulk) Save(ctx context.Context) ([]*Candle, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Candle, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*CandleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *CandleCreateBulk) SaveX(ctx context.Context) []*Candle {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *CandleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *CandleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
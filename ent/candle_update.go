@@ -0,0 +1,549 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/candle"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// CandleUpdate is the builder for updating Candle entities.
+type CandleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *CandleMutation
+}
+
+// Where appends a list predicates to the CandleUpdate builder.
+func (_u *CandleUpdate) Where(ps ...predicate.Candle) *CandleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *CandleUpdate) SetSymbol(v string) *CandleUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableSymbol(v *string) *CandleUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *CandleUpdate) SetExchange(v string) *CandleUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableExchange(v *string) *CandleUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *CandleUpdate) SetInterval(v string) *CandleUpdate {
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableInterval(v *string) *CandleUpdate {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *CandleUpdate) SetTimestamp(v time.Time) *CandleUpdate {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableTimestamp(v *time.Time) *CandleUpdate {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetOpen sets the "open" field.
+func (_u *CandleUpdate) SetOpen(v decimal.Decimal) *CandleUpdate {
+	_u.mutation.SetOpen(v)
+	return _u
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableOpen(v *decimal.Decimal) *CandleUpdate {
+	if v != nil {
+		_u.SetOpen(*v)
+	}
+	return _u
+}
+
+// SetHigh sets the "high" field.
+func (_u *CandleUpdate) SetHigh(v decimal.Decimal) *CandleUpdate {
+	_u.mutation.SetHigh(v)
+	return _u
+}
+
+// SetNillableHigh sets the "high" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableHigh(v *decimal.Decimal) *CandleUpdate {
+	if v != nil {
+		_u.SetHigh(*v)
+	}
+	return _u
+}
+
+// SetLow sets the "low" field.
+func (_u *CandleUpdate) SetLow(v decimal.Decimal) *CandleUpdate {
+	_u.mutation.SetLow(v)
+	return _u
+}
+
+// SetNillableLow sets the "low" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableLow(v *decimal.Decimal) *CandleUpdate {
+	if v != nil {
+		_u.SetLow(*v)
+	}
+	return _u
+}
+
+// SetClose sets the "close" field.
+func (_u *CandleUpdate) SetClose(v decimal.Decimal) *CandleUpdate {
+	_u.mutation.SetClose(v)
+	return _u
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableClose(v *decimal.Decimal) *CandleUpdate {
+	if v != nil {
+		_u.SetClose(*v)
+	}
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *CandleUpdate) SetVolume(v int64) *CandleUpdate {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *CandleUpdate) SetNillableVolume(v *int64) *CandleUpdate {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *CandleUpdate) AddVolume(v int64) *CandleUpdate {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// Mutation returns the CandleMutation object of the builder.
+func (_u *CandleUpdate) Mutation() *CandleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *CandleUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CandleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *CandleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CandleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CandleUpdate) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := candle.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Candle.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := candle.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Candle.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := candle.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Candle.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CandleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(candle.Table, candle.Columns, sqlgraph.NewFieldSpec(candle.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(candle.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(candle.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(candle.FieldInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(candle.FieldTimestamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Open(); ok {
+		_spec.SetField(candle.FieldOpen, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.High(); ok {
+		_spec.SetField(candle.FieldHigh, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Low(); ok {
+		_spec.SetField(candle.FieldLow, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Close(); ok {
+		_spec.SetField(candle.FieldClose, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(candle.FieldVolume, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(candle.FieldVolume, field.TypeInt64, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{candle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// CandleUpdateOne is the builder for updating a single Candle entity.
+type CandleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *CandleMutation
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *CandleUpdateOne) SetSymbol(v string) *CandleUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableSymbol(v *string) *CandleUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *CandleUpdateOne) SetExchange(v string) *CandleUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableExchange(v *string) *CandleUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetInterval sets the "interval" field.
+func (_u *CandleUpdateOne) SetInterval(v string) *CandleUpdateOne {
+	_u.mutation.SetInterval(v)
+	return _u
+}
+
+// SetNillableInterval sets the "interval" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableInterval(v *string) *CandleUpdateOne {
+	if v != nil {
+		_u.SetInterval(*v)
+	}
+	return _u
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (_u *CandleUpdateOne) SetTimestamp(v time.Time) *CandleUpdateOne {
+	_u.mutation.SetTimestamp(v)
+	return _u
+}
+
+// SetNillableTimestamp sets the "timestamp" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableTimestamp(v *time.Time) *CandleUpdateOne {
+	if v != nil {
+		_u.SetTimestamp(*v)
+	}
+	return _u
+}
+
+// SetOpen sets the "open" field.
+func (_u *CandleUpdateOne) SetOpen(v decimal.Decimal) *CandleUpdateOne {
+	_u.mutation.SetOpen(v)
+	return _u
+}
+
+// SetNillableOpen sets the "open" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableOpen(v *decimal.Decimal) *CandleUpdateOne {
+	if v != nil {
+		_u.SetOpen(*v)
+	}
+	return _u
+}
+
+// SetHigh sets the "high" field.
+func (_u *CandleUpdateOne) SetHigh(v decimal.Decimal) *CandleUpdateOne {
+	_u.mutation.SetHigh(v)
+	return _u
+}
+
+// SetNillableHigh sets the "high" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableHigh(v *decimal.Decimal) *CandleUpdateOne {
+	if v != nil {
+		_u.SetHigh(*v)
+	}
+	return _u
+}
+
+// SetLow sets the "low" field.
+func (_u *CandleUpdateOne) SetLow(v decimal.Decimal) *CandleUpdateOne {
+	_u.mutation.SetLow(v)
+	return _u
+}
+
+// SetNillableLow sets the "low" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableLow(v *decimal.Decimal) *CandleUpdateOne {
+	if v != nil {
+		_u.SetLow(*v)
+	}
+	return _u
+}
+
+// SetClose sets the "close" field.
+func (_u *CandleUpdateOne) SetClose(v decimal.Decimal) *CandleUpdateOne {
+	_u.mutation.SetClose(v)
+	return _u
+}
+
+// SetNillableClose sets the "close" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableClose(v *decimal.Decimal) *CandleUpdateOne {
+	if v != nil {
+		_u.SetClose(*v)
+	}
+	return _u
+}
+
+// SetVolume sets the "volume" field.
+func (_u *CandleUpdateOne) SetVolume(v int64) *CandleUpdateOne {
+	_u.mutation.ResetVolume()
+	_u.mutation.SetVolume(v)
+	return _u
+}
+
+// SetNillableVolume sets the "volume" field if the given value is not nil.
+func (_u *CandleUpdateOne) SetNillableVolume(v *int64) *CandleUpdateOne {
+	if v != nil {
+		_u.SetVolume(*v)
+	}
+	return _u
+}
+
+// AddVolume adds value to the "volume" field.
+func (_u *CandleUpdateOne) AddVolume(v int64) *CandleUpdateOne {
+	_u.mutation.AddVolume(v)
+	return _u
+}
+
+// Mutation returns the CandleMutation object of the builder.
+func (_u *CandleUpdateOne) Mutation() *CandleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the CandleUpdate builder.
+func (_u *CandleUpdateOne) Where(ps ...predicate.Candle) *CandleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *CandleUpdateOne) Select(field string, fields ...string) *CandleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Candle entity.
+func (_u *CandleUpdateOne) Save(ctx context.Context) (*Candle, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *CandleUpdateOne) SaveX(ctx context.Context) *Candle {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *CandleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *CandleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *CandleUpdateOne) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := candle.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Candle.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := candle.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Candle.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Interval(); ok {
+		if err := candle.IntervalValidator(v); err != nil {
+			return &ValidationError{Name: "interval", err: fmt.Errorf(`ent: validator failed for field "Candle.interval": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *CandleUpdateOne) sqlSave(ctx context.Context) (_node *Candle, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(candle.Table, candle.Columns, sqlgraph.NewFieldSpec(candle.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Candle.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, candle.FieldID)
+		for _, f := range fields {
+			if !candle.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != candle.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(candle.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(candle.FieldExchange, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Interval(); ok {
+		_spec.SetField(candle.FieldInterval, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Timestamp(); ok {
+		_spec.SetField(candle.FieldTimestamp, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.Open(); ok {
+		_spec.SetField(candle.FieldOpen, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.High(); ok {
+		_spec.SetField(candle.FieldHigh, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Low(); ok {
+		_spec.SetField(candle.FieldLow, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Close(); ok {
+		_spec.SetField(candle.FieldClose, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Volume(); ok {
+		_spec.SetField(candle.FieldVolume, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedVolume(); ok {
+		_spec.AddField(candle.FieldVolume, field.TypeInt64, value)
+	}
+	_node = &Candle{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{candle.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -11,12 +11,26 @@ import (
 
 	"auto-trader/ent/migrate"
 
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/brokeraccount"
+	"auto-trader/ent/candle"
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/order"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
 	"auto-trader/ent/portfolio"
+	"auto-trader/ent/protectionrule"
+	"auto-trader/ent/riskevent"
+	"auto-trader/ent/riskstate"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
 	"auto-trader/ent/strategyperformance"
 	"auto-trader/ent/strategystatus"
 	"auto-trader/ent/strategytemplate"
+	"auto-trader/ent/symbol"
+	"auto-trader/ent/taxlot"
+	"auto-trader/ent/trade"
 	"auto-trader/ent/user"
 
 	"entgo.io/ent"
@@ -31,8 +45,30 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// BacktestResult is the client for interacting with the BacktestResult builders.
+	BacktestResult *BacktestResultClient
+	// BrokerAccount is the client for interacting with the BrokerAccount builders.
+	BrokerAccount *BrokerAccountClient
+	// Candle is the client for interacting with the Candle builders.
+	Candle *CandleClient
+	// KillSwitch is the client for interacting with the KillSwitch builders.
+	KillSwitch *KillSwitchClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
+	// PaperAccount is the client for interacting with the PaperAccount builders.
+	PaperAccount *PaperAccountClient
+	// PaperPosition is the client for interacting with the PaperPosition builders.
+	PaperPosition *PaperPositionClient
+	// PaperTrade is the client for interacting with the PaperTrade builders.
+	PaperTrade *PaperTradeClient
 	// Portfolio is the client for interacting with the Portfolio builders.
 	Portfolio *PortfolioClient
+	// ProtectionRule is the client for interacting with the ProtectionRule builders.
+	ProtectionRule *ProtectionRuleClient
+	// RiskEvent is the client for interacting with the RiskEvent builders.
+	RiskEvent *RiskEventClient
+	// RiskState is the client for interacting with the RiskState builders.
+	RiskState *RiskStateClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
 	// StrategyExecution is the client for interacting with the StrategyExecution builders.
@@ -43,6 +79,12 @@ type Client struct {
 	StrategyStatus *StrategyStatusClient
 	// StrategyTemplate is the client for interacting with the StrategyTemplate builders.
 	StrategyTemplate *StrategyTemplateClient
+	// Symbol is the client for interacting with the Symbol builders.
+	Symbol *SymbolClient
+	// TaxLot is the client for interacting with the TaxLot builders.
+	TaxLot *TaxLotClient
+	// Trade is the client for interacting with the Trade builders.
+	Trade *TradeClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 }
@@ -56,12 +98,26 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.BacktestResult = NewBacktestResultClient(c.config)
+	c.BrokerAccount = NewBrokerAccountClient(c.config)
+	c.Candle = NewCandleClient(c.config)
+	c.KillSwitch = NewKillSwitchClient(c.config)
+	c.Order = NewOrderClient(c.config)
+	c.PaperAccount = NewPaperAccountClient(c.config)
+	c.PaperPosition = NewPaperPositionClient(c.config)
+	c.PaperTrade = NewPaperTradeClient(c.config)
 	c.Portfolio = NewPortfolioClient(c.config)
+	c.ProtectionRule = NewProtectionRuleClient(c.config)
+	c.RiskEvent = NewRiskEventClient(c.config)
+	c.RiskState = NewRiskStateClient(c.config)
 	c.Strategy = NewStrategyClient(c.config)
 	c.StrategyExecution = NewStrategyExecutionClient(c.config)
 	c.StrategyPerformance = NewStrategyPerformanceClient(c.config)
 	c.StrategyStatus = NewStrategyStatusClient(c.config)
 	c.StrategyTemplate = NewStrategyTemplateClient(c.config)
+	c.Symbol = NewSymbolClient(c.config)
+	c.TaxLot = NewTaxLotClient(c.config)
+	c.Trade = NewTradeClient(c.config)
 	c.User = NewUserClient(c.config)
 }
 
@@ -155,12 +211,26 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		BacktestResult:      NewBacktestResultClient(cfg),
+		BrokerAccount:       NewBrokerAccountClient(cfg),
+		Candle:              NewCandleClient(cfg),
+		KillSwitch:          NewKillSwitchClient(cfg),
+		Order:               NewOrderClient(cfg),
+		PaperAccount:        NewPaperAccountClient(cfg),
+		PaperPosition:       NewPaperPositionClient(cfg),
+		PaperTrade:          NewPaperTradeClient(cfg),
 		Portfolio:           NewPortfolioClient(cfg),
+		ProtectionRule:      NewProtectionRuleClient(cfg),
+		RiskEvent:           NewRiskEventClient(cfg),
+		RiskState:           NewRiskStateClient(cfg),
 		Strategy:            NewStrategyClient(cfg),
 		StrategyExecution:   NewStrategyExecutionClient(cfg),
 		StrategyPerformance: NewStrategyPerformanceClient(cfg),
 		StrategyStatus:      NewStrategyStatusClient(cfg),
 		StrategyTemplate:    NewStrategyTemplateClient(cfg),
+		Symbol:              NewSymbolClient(cfg),
+		TaxLot:              NewTaxLotClient(cfg),
+		Trade:               NewTradeClient(cfg),
 		User:                NewUserClient(cfg),
 	}, nil
 }
@@ -181,12 +251,26 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	return &Tx{
 		ctx:                 ctx,
 		config:              cfg,
+		BacktestResult:      NewBacktestResultClient(cfg),
+		BrokerAccount:       NewBrokerAccountClient(cfg),
+		Candle:              NewCandleClient(cfg),
+		KillSwitch:          NewKillSwitchClient(cfg),
+		Order:               NewOrderClient(cfg),
+		PaperAccount:        NewPaperAccountClient(cfg),
+		PaperPosition:       NewPaperPositionClient(cfg),
+		PaperTrade:          NewPaperTradeClient(cfg),
 		Portfolio:           NewPortfolioClient(cfg),
+		ProtectionRule:      NewProtectionRuleClient(cfg),
+		RiskEvent:           NewRiskEventClient(cfg),
+		RiskState:           NewRiskStateClient(cfg),
 		Strategy:            NewStrategyClient(cfg),
 		StrategyExecution:   NewStrategyExecutionClient(cfg),
 		StrategyPerformance: NewStrategyPerformanceClient(cfg),
 		StrategyStatus:      NewStrategyStatusClient(cfg),
 		StrategyTemplate:    NewStrategyTemplateClient(cfg),
+		Symbol:              NewSymbolClient(cfg),
+		TaxLot:              NewTaxLotClient(cfg),
+		Trade:               NewTradeClient(cfg),
 		User:                NewUserClient(cfg),
 	}, nil
 }
@@ -194,7 +278,7 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Portfolio.
+//		BacktestResult.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -217,8 +301,11 @@ func (c *Client) Close() error {
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
 	for _, n := range []interface{ Use(...Hook) }{
-		c.Portfolio, c.Strategy, c.StrategyExecution, c.StrategyPerformance,
-		c.StrategyStatus, c.StrategyTemplate, c.User,
+		c.BacktestResult, c.BrokerAccount, c.Candle, c.KillSwitch, c.Order,
+		c.PaperAccount, c.PaperPosition, c.PaperTrade, c.Portfolio, c.ProtectionRule,
+		c.RiskEvent, c.RiskState, c.Strategy, c.StrategyExecution,
+		c.StrategyPerformance, c.StrategyStatus, c.StrategyTemplate, c.Symbol,
+		c.TaxLot, c.Trade, c.User,
 	} {
 		n.Use(hooks...)
 	}
@@ -228,8 +315,11 @@ func (c *Client) Use(hooks ...Hook) {
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
 	for _, n := range []interface{ Intercept(...Interceptor) }{
-		c.Portfolio, c.Strategy, c.StrategyExecution, c.StrategyPerformance,
-		c.StrategyStatus, c.StrategyTemplate, c.User,
+		c.BacktestResult, c.BrokerAccount, c.Candle, c.KillSwitch, c.Order,
+		c.PaperAccount, c.PaperPosition, c.PaperTrade, c.Portfolio, c.ProtectionRule,
+		c.RiskEvent, c.RiskState, c.Strategy, c.StrategyExecution,
+		c.StrategyPerformance, c.StrategyStatus, c.StrategyTemplate, c.Symbol,
+		c.TaxLot, c.Trade, c.User,
 	} {
 		n.Intercept(interceptors...)
 	}
@@ -238,8 +328,30 @@ func (c *Client) Intercept(interceptors ...Interceptor) {
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *BacktestResultMutation:
+		return c.BacktestResult.mutate(ctx, m)
+	case *BrokerAccountMutation:
+		return c.BrokerAccount.mutate(ctx, m)
+	case *CandleMutation:
+		return c.Candle.mutate(ctx, m)
+	case *KillSwitchMutation:
+		return c.KillSwitch.mutate(ctx, m)
+	case *OrderMutation:
+		return c.Order.mutate(ctx, m)
+	case *PaperAccountMutation:
+		return c.PaperAccount.mutate(ctx, m)
+	case *PaperPositionMutation:
+		return c.PaperPosition.mutate(ctx, m)
+	case *PaperTradeMutation:
+		return c.PaperTrade.mutate(ctx, m)
 	case *PortfolioMutation:
 		return c.Portfolio.mutate(ctx, m)
+	case *ProtectionRuleMutation:
+		return c.ProtectionRule.mutate(ctx, m)
+	case *RiskEventMutation:
+		return c.RiskEvent.mutate(ctx, m)
+	case *RiskStateMutation:
+		return c.RiskState.mutate(ctx, m)
 	case *StrategyMutation:
 		return c.Strategy.mutate(ctx, m)
 	case *StrategyExecutionMutation:
@@ -250,6 +362,12 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.StrategyStatus.mutate(ctx, m)
 	case *StrategyTemplateMutation:
 		return c.StrategyTemplate.mutate(ctx, m)
+	case *SymbolMutation:
+		return c.Symbol.mutate(ctx, m)
+	case *TaxLotMutation:
+		return c.TaxLot.mutate(ctx, m)
+	case *TradeMutation:
+		return c.Trade.mutate(ctx, m)
 	case *UserMutation:
 		return c.User.mutate(ctx, m)
 	default:
@@ -257,107 +375,1432 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	}
 }
 
+// BacktestResultClient is a client for the BacktestResult schema.
+type BacktestResultClient struct {
+	config
+}
+
+// NewBacktestResultClient returns a client for the BacktestResult from the given config.
+func NewBacktestResultClient(c config) *BacktestResultClient {
+	return &BacktestResultClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `backtestresult.Hooks(f(g(h())))`.
+func (c *BacktestResultClient) Use(hooks ...Hook) {
+	c.hooks.BacktestResult = append(c.hooks.BacktestResult, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `backtestresult.Intercept(f(g(h())))`.
+func (c *BacktestResultClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BacktestResult = append(c.inters.BacktestResult, interceptors...)
+}
+
+// Create returns a builder for creating a BacktestResult entity.
+func (c *BacktestResultClient) Create() *BacktestResultCreate {
+	mutation := newBacktestResultMutation(c.config, OpCreate)
+	return &BacktestResultCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BacktestResult entities.
+func (c *BacktestResultClient) CreateBulk(builders ...*BacktestResultCreate) *BacktestResultCreateBulk {
+	return &BacktestResultCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BacktestResultClient) MapCreateBulk(slice any, setFunc func(*BacktestResultCreate, int)) *BacktestResultCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BacktestResultCreateBulk{err: fmt.Errorf("calling to BacktestResultClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BacktestResultCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BacktestResultCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BacktestResult.
+func (c *BacktestResultClient) Update() *BacktestResultUpdate {
+	mutation := newBacktestResultMutation(c.config, OpUpdate)
+	return &BacktestResultUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BacktestResultClient) UpdateOne(_m *BacktestResult) *BacktestResultUpdateOne {
+	mutation := newBacktestResultMutation(c.config, OpUpdateOne, withBacktestResult(_m))
+	return &BacktestResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BacktestResultClient) UpdateOneID(id uuid.UUID) *BacktestResultUpdateOne {
+	mutation := newBacktestResultMutation(c.config, OpUpdateOne, withBacktestResultID(id))
+	return &BacktestResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BacktestResult.
+func (c *BacktestResultClient) Delete() *BacktestResultDelete {
+	mutation := newBacktestResultMutation(c.config, OpDelete)
+	return &BacktestResultDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BacktestResultClient) DeleteOne(_m *BacktestResult) *BacktestResultDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BacktestResultClient) DeleteOneID(id uuid.UUID) *BacktestResultDeleteOne {
+	builder := c.Delete().Where(backtestresult.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BacktestResultDeleteOne{builder}
+}
+
+// Query returns a query builder for BacktestResult.
+func (c *BacktestResultClient) Query() *BacktestResultQuery {
+	return &BacktestResultQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBacktestResult},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BacktestResult entity by its id.
+func (c *BacktestResultClient) Get(ctx context.Context, id uuid.UUID) (*BacktestResult, error) {
+	return c.Query().Where(backtestresult.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BacktestResultClient) GetX(ctx context.Context, id uuid.UUID) *BacktestResult {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryStrategy queries the strategy edge of a BacktestResult.
+func (c *BacktestResultClient) QueryStrategy(_m *BacktestResult) *StrategyQuery {
+	query := (&StrategyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(backtestresult.Table, backtestresult.FieldID, id),
+			sqlgraph.To(strategy.Table, strategy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, backtestresult.StrategyTable, backtestresult.StrategyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *BacktestResultClient) Hooks() []Hook {
+	return c.hooks.BacktestResult
+}
+
+// Interceptors returns the client interceptors.
+func (c *BacktestResultClient) Interceptors() []Interceptor {
+	return c.inters.BacktestResult
+}
+
+func (c *BacktestResultClient) mutate(ctx context.Context, m *BacktestResultMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BacktestResultCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BacktestResultUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BacktestResultUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BacktestResultDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BacktestResult mutation op: %q", m.Op())
+	}
+}
+
+// BrokerAccountClient is a client for the BrokerAccount schema.
+type BrokerAccountClient struct {
+	config
+}
+
+// NewBrokerAccountClient returns a client for the BrokerAccount from the given config.
+func NewBrokerAccountClient(c config) *BrokerAccountClient {
+	return &BrokerAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `brokeraccount.Hooks(f(g(h())))`.
+func (c *BrokerAccountClient) Use(hooks ...Hook) {
+	c.hooks.BrokerAccount = append(c.hooks.BrokerAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `brokeraccount.Intercept(f(g(h())))`.
+func (c *BrokerAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.BrokerAccount = append(c.inters.BrokerAccount, interceptors...)
+}
+
+// Create returns a builder for creating a BrokerAccount entity.
+func (c *BrokerAccountClient) Create() *BrokerAccountCreate {
+	mutation := newBrokerAccountMutation(c.config, OpCreate)
+	return &BrokerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of BrokerAccount entities.
+func (c *BrokerAccountClient) CreateBulk(builders ...*BrokerAccountCreate) *BrokerAccountCreateBulk {
+	return &BrokerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *BrokerAccountClient) MapCreateBulk(slice any, setFunc func(*BrokerAccountCreate, int)) *BrokerAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &BrokerAccountCreateBulk{err: fmt.Errorf("calling to BrokerAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*BrokerAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &BrokerAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for BrokerAccount.
+func (c *BrokerAccountClient) Update() *BrokerAccountUpdate {
+	mutation := newBrokerAccountMutation(c.config, OpUpdate)
+	return &BrokerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *BrokerAccountClient) UpdateOne(_m *BrokerAccount) *BrokerAccountUpdateOne {
+	mutation := newBrokerAccountMutation(c.config, OpUpdateOne, withBrokerAccount(_m))
+	return &BrokerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *BrokerAccountClient) UpdateOneID(id uuid.UUID) *BrokerAccountUpdateOne {
+	mutation := newBrokerAccountMutation(c.config, OpUpdateOne, withBrokerAccountID(id))
+	return &BrokerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for BrokerAccount.
+func (c *BrokerAccountClient) Delete() *BrokerAccountDelete {
+	mutation := newBrokerAccountMutation(c.config, OpDelete)
+	return &BrokerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *BrokerAccountClient) DeleteOne(_m *BrokerAccount) *BrokerAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *BrokerAccountClient) DeleteOneID(id uuid.UUID) *BrokerAccountDeleteOne {
+	builder := c.Delete().Where(brokeraccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &BrokerAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for BrokerAccount.
+func (c *BrokerAccountClient) Query() *BrokerAccountQuery {
+	return &BrokerAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeBrokerAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a BrokerAccount entity by its id.
+func (c *BrokerAccountClient) Get(ctx context.Context, id uuid.UUID) (*BrokerAccount, error) {
+	return c.Query().Where(brokeraccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *BrokerAccountClient) GetX(ctx context.Context, id uuid.UUID) *BrokerAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *BrokerAccountClient) Hooks() []Hook {
+	return c.hooks.BrokerAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *BrokerAccountClient) Interceptors() []Interceptor {
+	return c.inters.BrokerAccount
+}
+
+func (c *BrokerAccountClient) mutate(ctx context.Context, m *BrokerAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&BrokerAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&BrokerAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&BrokerAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&BrokerAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown BrokerAccount mutation op: %q", m.Op())
+	}
+}
+
+// CandleClient is a client for the Candle schema.
+type CandleClient struct {
+	config
+}
+
+// NewCandleClient returns a client for the Candle from the given config.
+func NewCandleClient(c config) *CandleClient {
+	return &CandleClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `candle.Hooks(f(g(h())))`.
+func (c *CandleClient) Use(hooks ...Hook) {
+	c.hooks.Candle = append(c.hooks.Candle, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `candle.Intercept(f(g(h())))`.
+func (c *CandleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Candle = append(c.inters.Candle, interceptors...)
+}
+
+// Create returns a builder for creating a Candle entity.
+func (c *CandleClient) Create() *CandleCreate {
+	mutation := newCandleMutation(c.config, OpCreate)
+	return &CandleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Candle entities.
+func (c *CandleClient) CreateBulk(builders ...*CandleCreate) *CandleCreateBulk {
+	return &CandleCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *CandleClient) MapCreateBulk(slice any, setFunc func(*CandleCreate, int)) *CandleCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &CandleCreateBulk{err: fmt.Errorf("calling to CandleClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*CandleCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &CandleCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Candle.
+func (c *CandleClient) Update() *CandleUpdate {
+	mutation := newCandleMutation(c.config, OpUpdate)
+	return &CandleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *CandleClient) UpdateOne(_m *Candle) *CandleUpdateOne {
+	mutation := newCandleMutation(c.config, OpUpdateOne, withCandle(_m))
+	return &CandleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *CandleClient) UpdateOneID(id uuid.UUID) *CandleUpdateOne {
+	mutation := newCandleMutation(c.config, OpUpdateOne, withCandleID(id))
+	return &CandleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Candle.
+func (c *CandleClient) Delete() *CandleDelete {
+	mutation := newCandleMutation(c.config, OpDelete)
+	return &CandleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *CandleClient) DeleteOne(_m *Candle) *CandleDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *CandleClient) DeleteOneID(id uuid.UUID) *CandleDeleteOne {
+	builder := c.Delete().Where(candle.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &CandleDeleteOne{builder}
+}
+
+// Query returns a query builder for Candle.
+func (c *CandleClient) Query() *CandleQuery {
+	return &CandleQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeCandle},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Candle entity by its id.
+func (c *CandleClient) Get(ctx context.Context, id uuid.UUID) (*Candle, error) {
+	return c.Query().Where(candle.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *CandleClient) GetX(ctx context.Context, id uuid.UUID) *Candle {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *CandleClient) Hooks() []Hook {
+	return c.hooks.Candle
+}
+
+// Interceptors returns the client interceptors.
+func (c *CandleClient) Interceptors() []Interceptor {
+	return c.inters.Candle
+}
+
+func (c *CandleClient) mutate(ctx context.Context, m *CandleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&CandleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&CandleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&CandleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&CandleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Candle mutation op: %q", m.Op())
+	}
+}
+
+// KillSwitchClient is a client for the KillSwitch schema.
+type KillSwitchClient struct {
+	config
+}
+
+// NewKillSwitchClient returns a client for the KillSwitch from the given config.
+func NewKillSwitchClient(c config) *KillSwitchClient {
+	return &KillSwitchClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `killswitch.Hooks(f(g(h())))`.
+func (c *KillSwitchClient) Use(hooks ...Hook) {
+	c.hooks.KillSwitch = append(c.hooks.KillSwitch, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `killswitch.Intercept(f(g(h())))`.
+func (c *KillSwitchClient) Intercept(interceptors ...Interceptor) {
+	c.inters.KillSwitch = append(c.inters.KillSwitch, interceptors...)
+}
+
+// Create returns a builder for creating a KillSwitch entity.
+func (c *KillSwitchClient) Create() *KillSwitchCreate {
+	mutation := newKillSwitchMutation(c.config, OpCreate)
+	return &KillSwitchCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of KillSwitch entities.
+func (c *KillSwitchClient) CreateBulk(builders ...*KillSwitchCreate) *KillSwitchCreateBulk {
+	return &KillSwitchCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *KillSwitchClient) MapCreateBulk(slice any, setFunc func(*KillSwitchCreate, int)) *KillSwitchCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &KillSwitchCreateBulk{err: fmt.Errorf("calling to KillSwitchClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*KillSwitchCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &KillSwitchCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for KillSwitch.
+func (c *KillSwitchClient) Update() *KillSwitchUpdate {
+	mutation := newKillSwitchMutation(c.config, OpUpdate)
+	return &KillSwitchUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *KillSwitchClient) UpdateOne(_m *KillSwitch) *KillSwitchUpdateOne {
+	mutation := newKillSwitchMutation(c.config, OpUpdateOne, withKillSwitch(_m))
+	return &KillSwitchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *KillSwitchClient) UpdateOneID(id uuid.UUID) *KillSwitchUpdateOne {
+	mutation := newKillSwitchMutation(c.config, OpUpdateOne, withKillSwitchID(id))
+	return &KillSwitchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for KillSwitch.
+func (c *KillSwitchClient) Delete() *KillSwitchDelete {
+	mutation := newKillSwitchMutation(c.config, OpDelete)
+	return &KillSwitchDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *KillSwitchClient) DeleteOne(_m *KillSwitch) *KillSwitchDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *KillSwitchClient) DeleteOneID(id uuid.UUID) *KillSwitchDeleteOne {
+	builder := c.Delete().Where(killswitch.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &KillSwitchDeleteOne{builder}
+}
+
+// Query returns a query builder for KillSwitch.
+func (c *KillSwitchClient) Query() *KillSwitchQuery {
+	return &KillSwitchQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeKillSwitch},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a KillSwitch entity by its id.
+func (c *KillSwitchClient) Get(ctx context.Context, id uuid.UUID) (*KillSwitch, error) {
+	return c.Query().Where(killswitch.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *KillSwitchClient) GetX(ctx context.Context, id uuid.UUID) *KillSwitch {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *KillSwitchClient) Hooks() []Hook {
+	return c.hooks.KillSwitch
+}
+
+// Interceptors returns the client interceptors.
+func (c *KillSwitchClient) Interceptors() []Interceptor {
+	return c.inters.KillSwitch
+}
+
+func (c *KillSwitchClient) mutate(ctx context.Context, m *KillSwitchMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&KillSwitchCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&KillSwitchUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&KillSwitchUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&KillSwitchDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown KillSwitch mutation op: %q", m.Op())
+	}
+}
+
+// OrderClient is a client for the Order schema.
+type OrderClient struct {
+	config
+}
+
+// NewOrderClient returns a client for the Order from the given config.
+func NewOrderClient(c config) *OrderClient {
+	return &OrderClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `order.Hooks(f(g(h())))`.
+func (c *OrderClient) Use(hooks ...Hook) {
+	c.hooks.Order = append(c.hooks.Order, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `order.Intercept(f(g(h())))`.
+func (c *OrderClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Order = append(c.inters.Order, interceptors...)
+}
+
+// Create returns a builder for creating a Order entity.
+func (c *OrderClient) Create() *OrderCreate {
+	mutation := newOrderMutation(c.config, OpCreate)
+	return &OrderCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Order entities.
+func (c *OrderClient) CreateBulk(builders ...*OrderCreate) *OrderCreateBulk {
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *OrderClient) MapCreateBulk(slice any, setFunc func(*OrderCreate, int)) *OrderCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &OrderCreateBulk{err: fmt.Errorf("calling to OrderClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*OrderCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &OrderCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Order.
+func (c *OrderClient) Update() *OrderUpdate {
+	mutation := newOrderMutation(c.config, OpUpdate)
+	return &OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *OrderClient) UpdateOne(_m *Order) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrder(_m))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *OrderClient) UpdateOneID(id uuid.UUID) *OrderUpdateOne {
+	mutation := newOrderMutation(c.config, OpUpdateOne, withOrderID(id))
+	return &OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Order.
+func (c *OrderClient) Delete() *OrderDelete {
+	mutation := newOrderMutation(c.config, OpDelete)
+	return &OrderDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *OrderClient) DeleteOne(_m *Order) *OrderDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *OrderClient) DeleteOneID(id uuid.UUID) *OrderDeleteOne {
+	builder := c.Delete().Where(order.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &OrderDeleteOne{builder}
+}
+
+// Query returns a query builder for Order.
+func (c *OrderClient) Query() *OrderQuery {
+	return &OrderQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeOrder},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Order entity by its id.
+func (c *OrderClient) Get(ctx context.Context, id uuid.UUID) (*Order, error) {
+	return c.Query().Where(order.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *OrderClient) GetX(ctx context.Context, id uuid.UUID) *Order {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Order.
+func (c *OrderClient) QueryUser(_m *Order) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.UserTable, order.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryStrategy queries the strategy edge of a Order.
+func (c *OrderClient) QueryStrategy(_m *Order) *StrategyQuery {
+	query := (&StrategyClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(order.Table, order.FieldID, id),
+			sqlgraph.To(strategy.Table, strategy.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, order.StrategyTable, order.StrategyColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *OrderClient) Hooks() []Hook {
+	return c.hooks.Order
+}
+
+// Interceptors returns the client interceptors.
+func (c *OrderClient) Interceptors() []Interceptor {
+	return c.inters.Order
+}
+
+func (c *OrderClient) mutate(ctx context.Context, m *OrderMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&OrderCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&OrderUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&OrderUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&OrderDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Order mutation op: %q", m.Op())
+	}
+}
+
+// PaperAccountClient is a client for the PaperAccount schema.
+type PaperAccountClient struct {
+	config
+}
+
+// NewPaperAccountClient returns a client for the PaperAccount from the given config.
+func NewPaperAccountClient(c config) *PaperAccountClient {
+	return &PaperAccountClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paperaccount.Hooks(f(g(h())))`.
+func (c *PaperAccountClient) Use(hooks ...Hook) {
+	c.hooks.PaperAccount = append(c.hooks.PaperAccount, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paperaccount.Intercept(f(g(h())))`.
+func (c *PaperAccountClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaperAccount = append(c.inters.PaperAccount, interceptors...)
+}
+
+// Create returns a builder for creating a PaperAccount entity.
+func (c *PaperAccountClient) Create() *PaperAccountCreate {
+	mutation := newPaperAccountMutation(c.config, OpCreate)
+	return &PaperAccountCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaperAccount entities.
+func (c *PaperAccountClient) CreateBulk(builders ...*PaperAccountCreate) *PaperAccountCreateBulk {
+	return &PaperAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaperAccountClient) MapCreateBulk(slice any, setFunc func(*PaperAccountCreate, int)) *PaperAccountCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaperAccountCreateBulk{err: fmt.Errorf("calling to PaperAccountClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaperAccountCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaperAccountCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaperAccount.
+func (c *PaperAccountClient) Update() *PaperAccountUpdate {
+	mutation := newPaperAccountMutation(c.config, OpUpdate)
+	return &PaperAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaperAccountClient) UpdateOne(_m *PaperAccount) *PaperAccountUpdateOne {
+	mutation := newPaperAccountMutation(c.config, OpUpdateOne, withPaperAccount(_m))
+	return &PaperAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaperAccountClient) UpdateOneID(id uuid.UUID) *PaperAccountUpdateOne {
+	mutation := newPaperAccountMutation(c.config, OpUpdateOne, withPaperAccountID(id))
+	return &PaperAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaperAccount.
+func (c *PaperAccountClient) Delete() *PaperAccountDelete {
+	mutation := newPaperAccountMutation(c.config, OpDelete)
+	return &PaperAccountDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaperAccountClient) DeleteOne(_m *PaperAccount) *PaperAccountDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaperAccountClient) DeleteOneID(id uuid.UUID) *PaperAccountDeleteOne {
+	builder := c.Delete().Where(paperaccount.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaperAccountDeleteOne{builder}
+}
+
+// Query returns a query builder for PaperAccount.
+func (c *PaperAccountClient) Query() *PaperAccountQuery {
+	return &PaperAccountQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaperAccount},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaperAccount entity by its id.
+func (c *PaperAccountClient) Get(ctx context.Context, id uuid.UUID) (*PaperAccount, error) {
+	return c.Query().Where(paperaccount.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaperAccountClient) GetX(ctx context.Context, id uuid.UUID) *PaperAccount {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryPositions queries the positions edge of a PaperAccount.
+func (c *PaperAccountClient) QueryPositions(_m *PaperAccount) *PaperPositionQuery {
+	query := (&PaperPositionClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paperaccount.Table, paperaccount.FieldID, id),
+			sqlgraph.To(paperposition.Table, paperposition.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paperaccount.PositionsTable, paperaccount.PositionsColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTrades queries the trades edge of a PaperAccount.
+func (c *PaperAccountClient) QueryTrades(_m *PaperAccount) *PaperTradeQuery {
+	query := (&PaperTradeClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paperaccount.Table, paperaccount.FieldID, id),
+			sqlgraph.To(papertrade.Table, papertrade.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, paperaccount.TradesTable, paperaccount.TradesColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaperAccountClient) Hooks() []Hook {
+	return c.hooks.PaperAccount
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaperAccountClient) Interceptors() []Interceptor {
+	return c.inters.PaperAccount
+}
+
+func (c *PaperAccountClient) mutate(ctx context.Context, m *PaperAccountMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaperAccountCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaperAccountUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaperAccountUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaperAccountDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaperAccount mutation op: %q", m.Op())
+	}
+}
+
+// PaperPositionClient is a client for the PaperPosition schema.
+type PaperPositionClient struct {
+	config
+}
+
+// NewPaperPositionClient returns a client for the PaperPosition from the given config.
+func NewPaperPositionClient(c config) *PaperPositionClient {
+	return &PaperPositionClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `paperposition.Hooks(f(g(h())))`.
+func (c *PaperPositionClient) Use(hooks ...Hook) {
+	c.hooks.PaperPosition = append(c.hooks.PaperPosition, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `paperposition.Intercept(f(g(h())))`.
+func (c *PaperPositionClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaperPosition = append(c.inters.PaperPosition, interceptors...)
+}
+
+// Create returns a builder for creating a PaperPosition entity.
+func (c *PaperPositionClient) Create() *PaperPositionCreate {
+	mutation := newPaperPositionMutation(c.config, OpCreate)
+	return &PaperPositionCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaperPosition entities.
+func (c *PaperPositionClient) CreateBulk(builders ...*PaperPositionCreate) *PaperPositionCreateBulk {
+	return &PaperPositionCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaperPositionClient) MapCreateBulk(slice any, setFunc func(*PaperPositionCreate, int)) *PaperPositionCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaperPositionCreateBulk{err: fmt.Errorf("calling to PaperPositionClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaperPositionCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaperPositionCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaperPosition.
+func (c *PaperPositionClient) Update() *PaperPositionUpdate {
+	mutation := newPaperPositionMutation(c.config, OpUpdate)
+	return &PaperPositionUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaperPositionClient) UpdateOne(_m *PaperPosition) *PaperPositionUpdateOne {
+	mutation := newPaperPositionMutation(c.config, OpUpdateOne, withPaperPosition(_m))
+	return &PaperPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaperPositionClient) UpdateOneID(id uuid.UUID) *PaperPositionUpdateOne {
+	mutation := newPaperPositionMutation(c.config, OpUpdateOne, withPaperPositionID(id))
+	return &PaperPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaperPosition.
+func (c *PaperPositionClient) Delete() *PaperPositionDelete {
+	mutation := newPaperPositionMutation(c.config, OpDelete)
+	return &PaperPositionDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaperPositionClient) DeleteOne(_m *PaperPosition) *PaperPositionDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaperPositionClient) DeleteOneID(id uuid.UUID) *PaperPositionDeleteOne {
+	builder := c.Delete().Where(paperposition.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaperPositionDeleteOne{builder}
+}
+
+// Query returns a query builder for PaperPosition.
+func (c *PaperPositionClient) Query() *PaperPositionQuery {
+	return &PaperPositionQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaperPosition},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaperPosition entity by its id.
+func (c *PaperPositionClient) Get(ctx context.Context, id uuid.UUID) (*PaperPosition, error) {
+	return c.Query().Where(paperposition.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaperPositionClient) GetX(ctx context.Context, id uuid.UUID) *PaperPosition {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a PaperPosition.
+func (c *PaperPositionClient) QueryAccount(_m *PaperPosition) *PaperAccountQuery {
+	query := (&PaperAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(paperposition.Table, paperposition.FieldID, id),
+			sqlgraph.To(paperaccount.Table, paperaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, paperposition.AccountTable, paperposition.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaperPositionClient) Hooks() []Hook {
+	return c.hooks.PaperPosition
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaperPositionClient) Interceptors() []Interceptor {
+	return c.inters.PaperPosition
+}
+
+func (c *PaperPositionClient) mutate(ctx context.Context, m *PaperPositionMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaperPositionCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaperPositionUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaperPositionUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaperPositionDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaperPosition mutation op: %q", m.Op())
+	}
+}
+
+// PaperTradeClient is a client for the PaperTrade schema.
+type PaperTradeClient struct {
+	config
+}
+
+// NewPaperTradeClient returns a client for the PaperTrade from the given config.
+func NewPaperTradeClient(c config) *PaperTradeClient {
+	return &PaperTradeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `papertrade.Hooks(f(g(h())))`.
+func (c *PaperTradeClient) Use(hooks ...Hook) {
+	c.hooks.PaperTrade = append(c.hooks.PaperTrade, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `papertrade.Intercept(f(g(h())))`.
+func (c *PaperTradeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.PaperTrade = append(c.inters.PaperTrade, interceptors...)
+}
+
+// Create returns a builder for creating a PaperTrade entity.
+func (c *PaperTradeClient) Create() *PaperTradeCreate {
+	mutation := newPaperTradeMutation(c.config, OpCreate)
+	return &PaperTradeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of PaperTrade entities.
+func (c *PaperTradeClient) CreateBulk(builders ...*PaperTradeCreate) *PaperTradeCreateBulk {
+	return &PaperTradeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PaperTradeClient) MapCreateBulk(slice any, setFunc func(*PaperTradeCreate, int)) *PaperTradeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PaperTradeCreateBulk{err: fmt.Errorf("calling to PaperTradeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PaperTradeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PaperTradeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for PaperTrade.
+func (c *PaperTradeClient) Update() *PaperTradeUpdate {
+	mutation := newPaperTradeMutation(c.config, OpUpdate)
+	return &PaperTradeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PaperTradeClient) UpdateOne(_m *PaperTrade) *PaperTradeUpdateOne {
+	mutation := newPaperTradeMutation(c.config, OpUpdateOne, withPaperTrade(_m))
+	return &PaperTradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PaperTradeClient) UpdateOneID(id uuid.UUID) *PaperTradeUpdateOne {
+	mutation := newPaperTradeMutation(c.config, OpUpdateOne, withPaperTradeID(id))
+	return &PaperTradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for PaperTrade.
+func (c *PaperTradeClient) Delete() *PaperTradeDelete {
+	mutation := newPaperTradeMutation(c.config, OpDelete)
+	return &PaperTradeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PaperTradeClient) DeleteOne(_m *PaperTrade) *PaperTradeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PaperTradeClient) DeleteOneID(id uuid.UUID) *PaperTradeDeleteOne {
+	builder := c.Delete().Where(papertrade.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PaperTradeDeleteOne{builder}
+}
+
+// Query returns a query builder for PaperTrade.
+func (c *PaperTradeClient) Query() *PaperTradeQuery {
+	return &PaperTradeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePaperTrade},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a PaperTrade entity by its id.
+func (c *PaperTradeClient) Get(ctx context.Context, id uuid.UUID) (*PaperTrade, error) {
+	return c.Query().Where(papertrade.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PaperTradeClient) GetX(ctx context.Context, id uuid.UUID) *PaperTrade {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryAccount queries the account edge of a PaperTrade.
+func (c *PaperTradeClient) QueryAccount(_m *PaperTrade) *PaperAccountQuery {
+	query := (&PaperAccountClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(papertrade.Table, papertrade.FieldID, id),
+			sqlgraph.To(paperaccount.Table, paperaccount.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, papertrade.AccountTable, papertrade.AccountColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PaperTradeClient) Hooks() []Hook {
+	return c.hooks.PaperTrade
+}
+
+// Interceptors returns the client interceptors.
+func (c *PaperTradeClient) Interceptors() []Interceptor {
+	return c.inters.PaperTrade
+}
+
+func (c *PaperTradeClient) mutate(ctx context.Context, m *PaperTradeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PaperTradeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PaperTradeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PaperTradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PaperTradeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown PaperTrade mutation op: %q", m.Op())
+	}
+}
+
 // PortfolioClient is a client for the Portfolio schema.
 type PortfolioClient struct {
 	config
 }
 
-// NewPortfolioClient returns a client for the Portfolio from the given config.
-func NewPortfolioClient(c config) *PortfolioClient {
-	return &PortfolioClient{config: c}
+// NewPortfolioClient returns a client for the Portfolio from the given config.
+func NewPortfolioClient(c config) *PortfolioClient {
+	return &PortfolioClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `portfolio.Hooks(f(g(h())))`.
+func (c *PortfolioClient) Use(hooks ...Hook) {
+	c.hooks.Portfolio = append(c.hooks.Portfolio, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `portfolio.Intercept(f(g(h())))`.
+func (c *PortfolioClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Portfolio = append(c.inters.Portfolio, interceptors...)
+}
+
+// Create returns a builder for creating a Portfolio entity.
+func (c *PortfolioClient) Create() *PortfolioCreate {
+	mutation := newPortfolioMutation(c.config, OpCreate)
+	return &PortfolioCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Portfolio entities.
+func (c *PortfolioClient) CreateBulk(builders ...*PortfolioCreate) *PortfolioCreateBulk {
+	return &PortfolioCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *PortfolioClient) MapCreateBulk(slice any, setFunc func(*PortfolioCreate, int)) *PortfolioCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &PortfolioCreateBulk{err: fmt.Errorf("calling to PortfolioClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*PortfolioCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &PortfolioCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Portfolio.
+func (c *PortfolioClient) Update() *PortfolioUpdate {
+	mutation := newPortfolioMutation(c.config, OpUpdate)
+	return &PortfolioUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *PortfolioClient) UpdateOne(_m *Portfolio) *PortfolioUpdateOne {
+	mutation := newPortfolioMutation(c.config, OpUpdateOne, withPortfolio(_m))
+	return &PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *PortfolioClient) UpdateOneID(id uuid.UUID) *PortfolioUpdateOne {
+	mutation := newPortfolioMutation(c.config, OpUpdateOne, withPortfolioID(id))
+	return &PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Portfolio.
+func (c *PortfolioClient) Delete() *PortfolioDelete {
+	mutation := newPortfolioMutation(c.config, OpDelete)
+	return &PortfolioDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *PortfolioClient) DeleteOne(_m *Portfolio) *PortfolioDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *PortfolioClient) DeleteOneID(id uuid.UUID) *PortfolioDeleteOne {
+	builder := c.Delete().Where(portfolio.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &PortfolioDeleteOne{builder}
+}
+
+// Query returns a query builder for Portfolio.
+func (c *PortfolioClient) Query() *PortfolioQuery {
+	return &PortfolioQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypePortfolio},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Portfolio entity by its id.
+func (c *PortfolioClient) Get(ctx context.Context, id uuid.UUID) (*Portfolio, error) {
+	return c.Query().Where(portfolio.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *PortfolioClient) GetX(ctx context.Context, id uuid.UUID) *Portfolio {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// QueryUser queries the user edge of a Portfolio.
+func (c *PortfolioClient) QueryUser(_m *Portfolio) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(portfolio.Table, portfolio.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, portfolio.UserTable, portfolio.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// Hooks returns the client hooks.
+func (c *PortfolioClient) Hooks() []Hook {
+	return c.hooks.Portfolio
+}
+
+// Interceptors returns the client interceptors.
+func (c *PortfolioClient) Interceptors() []Interceptor {
+	return c.inters.Portfolio
+}
+
+func (c *PortfolioClient) mutate(ctx context.Context, m *PortfolioMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&PortfolioCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&PortfolioUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&PortfolioDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Portfolio mutation op: %q", m.Op())
+	}
+}
+
+// ProtectionRuleClient is a client for the ProtectionRule schema.
+type ProtectionRuleClient struct {
+	config
+}
+
+// NewProtectionRuleClient returns a client for the ProtectionRule from the given config.
+func NewProtectionRuleClient(c config) *ProtectionRuleClient {
+	return &ProtectionRuleClient{config: c}
 }
 
 // Use adds a list of mutation hooks to the hooks stack.
-// A call to `Use(f, g, h)` equals to `portfolio.Hooks(f(g(h())))`.
-func (c *PortfolioClient) Use(hooks ...Hook) {
-	c.hooks.Portfolio = append(c.hooks.Portfolio, hooks...)
+// A call to `Use(f, g, h)` equals to `protectionrule.Hooks(f(g(h())))`.
+func (c *ProtectionRuleClient) Use(hooks ...Hook) {
+	c.hooks.ProtectionRule = append(c.hooks.ProtectionRule, hooks...)
 }
 
 // Intercept adds a list of query interceptors to the interceptors stack.
-// A call to `Intercept(f, g, h)` equals to `portfolio.Intercept(f(g(h())))`.
-func (c *PortfolioClient) Intercept(interceptors ...Interceptor) {
-	c.inters.Portfolio = append(c.inters.Portfolio, interceptors...)
+// A call to `Intercept(f, g, h)` equals to `protectionrule.Intercept(f(g(h())))`.
+func (c *ProtectionRuleClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ProtectionRule = append(c.inters.ProtectionRule, interceptors...)
 }
 
-// Create returns a builder for creating a Portfolio entity.
-func (c *PortfolioClient) Create() *PortfolioCreate {
-	mutation := newPortfolioMutation(c.config, OpCreate)
-	return &PortfolioCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Create returns a builder for creating a ProtectionRule entity.
+func (c *ProtectionRuleClient) Create() *ProtectionRuleCreate {
+	mutation := newProtectionRuleMutation(c.config, OpCreate)
+	return &ProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// CreateBulk returns a builder for creating a bulk of Portfolio entities.
-func (c *PortfolioClient) CreateBulk(builders ...*PortfolioCreate) *PortfolioCreateBulk {
-	return &PortfolioCreateBulk{config: c.config, builders: builders}
+// CreateBulk returns a builder for creating a bulk of ProtectionRule entities.
+func (c *ProtectionRuleClient) CreateBulk(builders ...*ProtectionRuleCreate) *ProtectionRuleCreateBulk {
+	return &ProtectionRuleCreateBulk{config: c.config, builders: builders}
 }
 
 // MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
 // a builder and applies setFunc on it.
-func (c *PortfolioClient) MapCreateBulk(slice any, setFunc func(*PortfolioCreate, int)) *PortfolioCreateBulk {
+func (c *ProtectionRuleClient) MapCreateBulk(slice any, setFunc func(*ProtectionRuleCreate, int)) *ProtectionRuleCreateBulk {
 	rv := reflect.ValueOf(slice)
 	if rv.Kind() != reflect.Slice {
-		return &PortfolioCreateBulk{err: fmt.Errorf("calling to PortfolioClient.MapCreateBulk with wrong type %T, need slice", slice)}
+		return &ProtectionRuleCreateBulk{err: fmt.Errorf("calling to ProtectionRuleClient.MapCreateBulk with wrong type %T, need slice", slice)}
 	}
-	builders := make([]*PortfolioCreate, rv.Len())
+	builders := make([]*ProtectionRuleCreate, rv.Len())
 	for i := 0; i < rv.Len(); i++ {
 		builders[i] = c.Create()
 		setFunc(builders[i], i)
 	}
-	return &PortfolioCreateBulk{config: c.config, builders: builders}
+	return &ProtectionRuleCreateBulk{config: c.config, builders: builders}
 }
 
-// Update returns an update builder for Portfolio.
-func (c *PortfolioClient) Update() *PortfolioUpdate {
-	mutation := newPortfolioMutation(c.config, OpUpdate)
-	return &PortfolioUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Update returns an update builder for ProtectionRule.
+func (c *ProtectionRuleClient) Update() *ProtectionRuleUpdate {
+	mutation := newProtectionRuleMutation(c.config, OpUpdate)
+	return &ProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOne returns an update builder for the given entity.
-func (c *PortfolioClient) UpdateOne(_m *Portfolio) *PortfolioUpdateOne {
-	mutation := newPortfolioMutation(c.config, OpUpdateOne, withPortfolio(_m))
-	return &PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProtectionRuleClient) UpdateOne(_m *ProtectionRule) *ProtectionRuleUpdateOne {
+	mutation := newProtectionRuleMutation(c.config, OpUpdateOne, withProtectionRule(_m))
+	return &ProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // UpdateOneID returns an update builder for the given id.
-func (c *PortfolioClient) UpdateOneID(id uuid.UUID) *PortfolioUpdateOne {
-	mutation := newPortfolioMutation(c.config, OpUpdateOne, withPortfolioID(id))
-	return &PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+func (c *ProtectionRuleClient) UpdateOneID(id uuid.UUID) *ProtectionRuleUpdateOne {
+	mutation := newProtectionRuleMutation(c.config, OpUpdateOne, withProtectionRuleID(id))
+	return &ProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
-// Delete returns a delete builder for Portfolio.
-func (c *PortfolioClient) Delete() *PortfolioDelete {
-	mutation := newPortfolioMutation(c.config, OpDelete)
-	return &PortfolioDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+// Delete returns a delete builder for ProtectionRule.
+func (c *ProtectionRuleClient) Delete() *ProtectionRuleDelete {
+	mutation := newProtectionRuleMutation(c.config, OpDelete)
+	return &ProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
 }
 
 // DeleteOne returns a builder for deleting the given entity.
-func (c *PortfolioClient) DeleteOne(_m *Portfolio) *PortfolioDeleteOne {
+func (c *ProtectionRuleClient) DeleteOne(_m *ProtectionRule) *ProtectionRuleDeleteOne {
 	return c.DeleteOneID(_m.ID)
 }
 
 // DeleteOneID returns a builder for deleting the given entity by its id.
-func (c *PortfolioClient) DeleteOneID(id uuid.UUID) *PortfolioDeleteOne {
-	builder := c.Delete().Where(portfolio.ID(id))
+func (c *ProtectionRuleClient) DeleteOneID(id uuid.UUID) *ProtectionRuleDeleteOne {
+	builder := c.Delete().Where(protectionrule.ID(id))
 	builder.mutation.id = &id
 	builder.mutation.op = OpDeleteOne
-	return &PortfolioDeleteOne{builder}
+	return &ProtectionRuleDeleteOne{builder}
 }
 
-// Query returns a query builder for Portfolio.
-func (c *PortfolioClient) Query() *PortfolioQuery {
-	return &PortfolioQuery{
+// Query returns a query builder for ProtectionRule.
+func (c *ProtectionRuleClient) Query() *ProtectionRuleQuery {
+	return &ProtectionRuleQuery{
 		config: c.config,
-		ctx:    &QueryContext{Type: TypePortfolio},
+		ctx:    &QueryContext{Type: TypeProtectionRule},
 		inters: c.Interceptors(),
 	}
 }
 
-// Get returns a Portfolio entity by its id.
-func (c *PortfolioClient) Get(ctx context.Context, id uuid.UUID) (*Portfolio, error) {
-	return c.Query().Where(portfolio.ID(id)).Only(ctx)
+// Get returns a ProtectionRule entity by its id.
+func (c *ProtectionRuleClient) Get(ctx context.Context, id uuid.UUID) (*ProtectionRule, error) {
+	return c.Query().Where(protectionrule.ID(id)).Only(ctx)
 }
 
 // GetX is like Get, but panics if an error occurs.
-func (c *PortfolioClient) GetX(ctx context.Context, id uuid.UUID) *Portfolio {
+func (c *ProtectionRuleClient) GetX(ctx context.Context, id uuid.UUID) *ProtectionRule {
 	obj, err := c.Get(ctx, id)
 	if err != nil {
 		panic(err)
@@ -365,44 +1808,294 @@ func (c *PortfolioClient) GetX(ctx context.Context, id uuid.UUID) *Portfolio {
 	return obj
 }
 
-// QueryUser queries the user edge of a Portfolio.
-func (c *PortfolioClient) QueryUser(_m *Portfolio) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
-	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
-		id := _m.ID
-		step := sqlgraph.NewStep(
-			sqlgraph.From(portfolio.Table, portfolio.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, portfolio.UserTable, portfolio.UserColumn),
-		)
-		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
-		return fromV, nil
+// Hooks returns the client hooks.
+func (c *ProtectionRuleClient) Hooks() []Hook {
+	return c.hooks.ProtectionRule
+}
+
+// Interceptors returns the client interceptors.
+func (c *ProtectionRuleClient) Interceptors() []Interceptor {
+	return c.inters.ProtectionRule
+}
+
+func (c *ProtectionRuleClient) mutate(ctx context.Context, m *ProtectionRuleMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ProtectionRuleCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ProtectionRuleUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ProtectionRuleUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ProtectionRuleDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown ProtectionRule mutation op: %q", m.Op())
 	}
-	return query
+}
+
+// RiskEventClient is a client for the RiskEvent schema.
+type RiskEventClient struct {
+	config
+}
+
+// NewRiskEventClient returns a client for the RiskEvent from the given config.
+func NewRiskEventClient(c config) *RiskEventClient {
+	return &RiskEventClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `riskevent.Hooks(f(g(h())))`.
+func (c *RiskEventClient) Use(hooks ...Hook) {
+	c.hooks.RiskEvent = append(c.hooks.RiskEvent, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `riskevent.Intercept(f(g(h())))`.
+func (c *RiskEventClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RiskEvent = append(c.inters.RiskEvent, interceptors...)
+}
+
+// Create returns a builder for creating a RiskEvent entity.
+func (c *RiskEventClient) Create() *RiskEventCreate {
+	mutation := newRiskEventMutation(c.config, OpCreate)
+	return &RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RiskEvent entities.
+func (c *RiskEventClient) CreateBulk(builders ...*RiskEventCreate) *RiskEventCreateBulk {
+	return &RiskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RiskEventClient) MapCreateBulk(slice any, setFunc func(*RiskEventCreate, int)) *RiskEventCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RiskEventCreateBulk{err: fmt.Errorf("calling to RiskEventClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RiskEventCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RiskEventCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RiskEvent.
+func (c *RiskEventClient) Update() *RiskEventUpdate {
+	mutation := newRiskEventMutation(c.config, OpUpdate)
+	return &RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RiskEventClient) UpdateOne(_m *RiskEvent) *RiskEventUpdateOne {
+	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEvent(_m))
+	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RiskEventClient) UpdateOneID(id uuid.UUID) *RiskEventUpdateOne {
+	mutation := newRiskEventMutation(c.config, OpUpdateOne, withRiskEventID(id))
+	return &RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RiskEvent.
+func (c *RiskEventClient) Delete() *RiskEventDelete {
+	mutation := newRiskEventMutation(c.config, OpDelete)
+	return &RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RiskEventClient) DeleteOne(_m *RiskEvent) *RiskEventDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RiskEventClient) DeleteOneID(id uuid.UUID) *RiskEventDeleteOne {
+	builder := c.Delete().Where(riskevent.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RiskEventDeleteOne{builder}
+}
+
+// Query returns a query builder for RiskEvent.
+func (c *RiskEventClient) Query() *RiskEventQuery {
+	return &RiskEventQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRiskEvent},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RiskEvent entity by its id.
+func (c *RiskEventClient) Get(ctx context.Context, id uuid.UUID) (*RiskEvent, error) {
+	return c.Query().Where(riskevent.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RiskEventClient) GetX(ctx context.Context, id uuid.UUID) *RiskEvent {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
 }
 
 // Hooks returns the client hooks.
-func (c *PortfolioClient) Hooks() []Hook {
-	return c.hooks.Portfolio
+func (c *RiskEventClient) Hooks() []Hook {
+	return c.hooks.RiskEvent
 }
 
 // Interceptors returns the client interceptors.
-func (c *PortfolioClient) Interceptors() []Interceptor {
-	return c.inters.Portfolio
+func (c *RiskEventClient) Interceptors() []Interceptor {
+	return c.inters.RiskEvent
 }
 
-func (c *PortfolioClient) mutate(ctx context.Context, m *PortfolioMutation) (Value, error) {
+func (c *RiskEventClient) mutate(ctx context.Context, m *RiskEventMutation) (Value, error) {
 	switch m.Op() {
 	case OpCreate:
-		return (&PortfolioCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RiskEventCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdate:
-		return (&PortfolioUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RiskEventUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpUpdateOne:
-		return (&PortfolioUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+		return (&RiskEventUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
 	case OpDelete, OpDeleteOne:
-		return (&PortfolioDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+		return (&RiskEventDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
 	default:
-		return nil, fmt.Errorf("ent: unknown Portfolio mutation op: %q", m.Op())
+		return nil, fmt.Errorf("ent: unknown RiskEvent mutation op: %q", m.Op())
+	}
+}
+
+// RiskStateClient is a client for the RiskState schema.
+type RiskStateClient struct {
+	config
+}
+
+// NewRiskStateClient returns a client for the RiskState from the given config.
+func NewRiskStateClient(c config) *RiskStateClient {
+	return &RiskStateClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `riskstate.Hooks(f(g(h())))`.
+func (c *RiskStateClient) Use(hooks ...Hook) {
+	c.hooks.RiskState = append(c.hooks.RiskState, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `riskstate.Intercept(f(g(h())))`.
+func (c *RiskStateClient) Intercept(interceptors ...Interceptor) {
+	c.inters.RiskState = append(c.inters.RiskState, interceptors...)
+}
+
+// Create returns a builder for creating a RiskState entity.
+func (c *RiskStateClient) Create() *RiskStateCreate {
+	mutation := newRiskStateMutation(c.config, OpCreate)
+	return &RiskStateCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of RiskState entities.
+func (c *RiskStateClient) CreateBulk(builders ...*RiskStateCreate) *RiskStateCreateBulk {
+	return &RiskStateCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *RiskStateClient) MapCreateBulk(slice any, setFunc func(*RiskStateCreate, int)) *RiskStateCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &RiskStateCreateBulk{err: fmt.Errorf("calling to RiskStateClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*RiskStateCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &RiskStateCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for RiskState.
+func (c *RiskStateClient) Update() *RiskStateUpdate {
+	mutation := newRiskStateMutation(c.config, OpUpdate)
+	return &RiskStateUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *RiskStateClient) UpdateOne(_m *RiskState) *RiskStateUpdateOne {
+	mutation := newRiskStateMutation(c.config, OpUpdateOne, withRiskState(_m))
+	return &RiskStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *RiskStateClient) UpdateOneID(id uuid.UUID) *RiskStateUpdateOne {
+	mutation := newRiskStateMutation(c.config, OpUpdateOne, withRiskStateID(id))
+	return &RiskStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for RiskState.
+func (c *RiskStateClient) Delete() *RiskStateDelete {
+	mutation := newRiskStateMutation(c.config, OpDelete)
+	return &RiskStateDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *RiskStateClient) DeleteOne(_m *RiskState) *RiskStateDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *RiskStateClient) DeleteOneID(id uuid.UUID) *RiskStateDeleteOne {
+	builder := c.Delete().Where(riskstate.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &RiskStateDeleteOne{builder}
+}
+
+// Query returns a query builder for RiskState.
+func (c *RiskStateClient) Query() *RiskStateQuery {
+	return &RiskStateQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeRiskState},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a RiskState entity by its id.
+func (c *RiskStateClient) Get(ctx context.Context, id uuid.UUID) (*RiskState, error) {
+	return c.Query().Where(riskstate.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *RiskStateClient) GetX(ctx context.Context, id uuid.UUID) *RiskState {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *RiskStateClient) Hooks() []Hook {
+	return c.hooks.RiskState
+}
+
+// Interceptors returns the client interceptors.
+func (c *RiskStateClient) Interceptors() []Interceptor {
+	return c.inters.RiskState
+}
+
+func (c *RiskStateClient) mutate(ctx context.Context, m *RiskStateMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&RiskStateCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&RiskStateUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&RiskStateUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&RiskStateDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown RiskState mutation op: %q", m.Op())
 	}
 }
 
@@ -514,15 +2207,47 @@ func (c *StrategyClient) GetX(ctx context.Context, id uuid.UUID) *Strategy {
 	return obj
 }
 
-// QueryUser queries the user edge of a Strategy.
-func (c *StrategyClient) QueryUser(_m *Strategy) *UserQuery {
-	query := (&UserClient{config: c.config}).Query()
+// QueryUser queries the user edge of a Strategy.
+func (c *StrategyClient) QueryUser(_m *Strategy) *UserQuery {
+	query := (&UserClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, id),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, strategy.UserTable, strategy.UserColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryTemplate queries the template edge of a Strategy.
+func (c *StrategyClient) QueryTemplate(_m *Strategy) *StrategyTemplateQuery {
+	query := (&StrategyTemplateClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, id),
+			sqlgraph.To(strategytemplate.Table, strategytemplate.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, strategy.TemplateTable, strategy.TemplateColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
+// QueryExecutions queries the executions edge of a Strategy.
+func (c *StrategyClient) QueryExecutions(_m *Strategy) *StrategyExecutionQuery {
+	query := (&StrategyExecutionClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(strategy.Table, strategy.FieldID, id),
-			sqlgraph.To(user.Table, user.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, strategy.UserTable, strategy.UserColumn),
+			sqlgraph.To(strategyexecution.Table, strategyexecution.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, strategy.ExecutionsTable, strategy.ExecutionsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -530,15 +2255,15 @@ func (c *StrategyClient) QueryUser(_m *Strategy) *UserQuery {
 	return query
 }
 
-// QueryTemplate queries the template edge of a Strategy.
-func (c *StrategyClient) QueryTemplate(_m *Strategy) *StrategyTemplateQuery {
-	query := (&StrategyTemplateClient{config: c.config}).Query()
+// QueryOrders queries the orders edge of a Strategy.
+func (c *StrategyClient) QueryOrders(_m *Strategy) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(strategy.Table, strategy.FieldID, id),
-			sqlgraph.To(strategytemplate.Table, strategytemplate.FieldID),
-			sqlgraph.Edge(sqlgraph.M2O, true, strategy.TemplateTable, strategy.TemplateColumn),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, strategy.OrdersTable, strategy.OrdersColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -546,15 +2271,15 @@ func (c *StrategyClient) QueryTemplate(_m *Strategy) *StrategyTemplateQuery {
 	return query
 }
 
-// QueryExecutions queries the executions edge of a Strategy.
-func (c *StrategyClient) QueryExecutions(_m *Strategy) *StrategyExecutionQuery {
-	query := (&StrategyExecutionClient{config: c.config}).Query()
+// QueryBacktests queries the backtests edge of a Strategy.
+func (c *StrategyClient) QueryBacktests(_m *Strategy) *BacktestResultQuery {
+	query := (&BacktestResultClient{config: c.config}).Query()
 	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
 		id := _m.ID
 		step := sqlgraph.NewStep(
 			sqlgraph.From(strategy.Table, strategy.FieldID, id),
-			sqlgraph.To(strategyexecution.Table, strategyexecution.FieldID),
-			sqlgraph.Edge(sqlgraph.O2M, false, strategy.ExecutionsTable, strategy.ExecutionsColumn),
+			sqlgraph.To(backtestresult.Table, backtestresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, strategy.BacktestsTable, strategy.BacktestsColumn),
 		)
 		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
 		return fromV, nil
@@ -1215,6 +2940,405 @@ func (c *StrategyTemplateClient) mutate(ctx context.Context, m *StrategyTemplate
 	}
 }
 
+// SymbolClient is a client for the Symbol schema.
+type SymbolClient struct {
+	config
+}
+
+// NewSymbolClient returns a client for the Symbol from the given config.
+func NewSymbolClient(c config) *SymbolClient {
+	return &SymbolClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `symbol.Hooks(f(g(h())))`.
+func (c *SymbolClient) Use(hooks ...Hook) {
+	c.hooks.Symbol = append(c.hooks.Symbol, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `symbol.Intercept(f(g(h())))`.
+func (c *SymbolClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Symbol = append(c.inters.Symbol, interceptors...)
+}
+
+// Create returns a builder for creating a Symbol entity.
+func (c *SymbolClient) Create() *SymbolCreate {
+	mutation := newSymbolMutation(c.config, OpCreate)
+	return &SymbolCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Symbol entities.
+func (c *SymbolClient) CreateBulk(builders ...*SymbolCreate) *SymbolCreateBulk {
+	return &SymbolCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *SymbolClient) MapCreateBulk(slice any, setFunc func(*SymbolCreate, int)) *SymbolCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &SymbolCreateBulk{err: fmt.Errorf("calling to SymbolClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*SymbolCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &SymbolCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Symbol.
+func (c *SymbolClient) Update() *SymbolUpdate {
+	mutation := newSymbolMutation(c.config, OpUpdate)
+	return &SymbolUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *SymbolClient) UpdateOne(_m *Symbol) *SymbolUpdateOne {
+	mutation := newSymbolMutation(c.config, OpUpdateOne, withSymbol(_m))
+	return &SymbolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *SymbolClient) UpdateOneID(id uuid.UUID) *SymbolUpdateOne {
+	mutation := newSymbolMutation(c.config, OpUpdateOne, withSymbolID(id))
+	return &SymbolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Symbol.
+func (c *SymbolClient) Delete() *SymbolDelete {
+	mutation := newSymbolMutation(c.config, OpDelete)
+	return &SymbolDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *SymbolClient) DeleteOne(_m *Symbol) *SymbolDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *SymbolClient) DeleteOneID(id uuid.UUID) *SymbolDeleteOne {
+	builder := c.Delete().Where(symbol.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &SymbolDeleteOne{builder}
+}
+
+// Query returns a query builder for Symbol.
+func (c *SymbolClient) Query() *SymbolQuery {
+	return &SymbolQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeSymbol},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Symbol entity by its id.
+func (c *SymbolClient) Get(ctx context.Context, id uuid.UUID) (*Symbol, error) {
+	return c.Query().Where(symbol.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *SymbolClient) GetX(ctx context.Context, id uuid.UUID) *Symbol {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *SymbolClient) Hooks() []Hook {
+	return c.hooks.Symbol
+}
+
+// Interceptors returns the client interceptors.
+func (c *SymbolClient) Interceptors() []Interceptor {
+	return c.inters.Symbol
+}
+
+func (c *SymbolClient) mutate(ctx context.Context, m *SymbolMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&SymbolCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&SymbolUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&SymbolUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&SymbolDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Symbol mutation op: %q", m.Op())
+	}
+}
+
+// TaxLotClient is a client for the TaxLot schema.
+type TaxLotClient struct {
+	config
+}
+
+// NewTaxLotClient returns a client for the TaxLot from the given config.
+func NewTaxLotClient(c config) *TaxLotClient {
+	return &TaxLotClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `taxlot.Hooks(f(g(h())))`.
+func (c *TaxLotClient) Use(hooks ...Hook) {
+	c.hooks.TaxLot = append(c.hooks.TaxLot, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `taxlot.Intercept(f(g(h())))`.
+func (c *TaxLotClient) Intercept(interceptors ...Interceptor) {
+	c.inters.TaxLot = append(c.inters.TaxLot, interceptors...)
+}
+
+// Create returns a builder for creating a TaxLot entity.
+func (c *TaxLotClient) Create() *TaxLotCreate {
+	mutation := newTaxLotMutation(c.config, OpCreate)
+	return &TaxLotCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of TaxLot entities.
+func (c *TaxLotClient) CreateBulk(builders ...*TaxLotCreate) *TaxLotCreateBulk {
+	return &TaxLotCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TaxLotClient) MapCreateBulk(slice any, setFunc func(*TaxLotCreate, int)) *TaxLotCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TaxLotCreateBulk{err: fmt.Errorf("calling to TaxLotClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TaxLotCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TaxLotCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for TaxLot.
+func (c *TaxLotClient) Update() *TaxLotUpdate {
+	mutation := newTaxLotMutation(c.config, OpUpdate)
+	return &TaxLotUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TaxLotClient) UpdateOne(_m *TaxLot) *TaxLotUpdateOne {
+	mutation := newTaxLotMutation(c.config, OpUpdateOne, withTaxLot(_m))
+	return &TaxLotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TaxLotClient) UpdateOneID(id uuid.UUID) *TaxLotUpdateOne {
+	mutation := newTaxLotMutation(c.config, OpUpdateOne, withTaxLotID(id))
+	return &TaxLotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for TaxLot.
+func (c *TaxLotClient) Delete() *TaxLotDelete {
+	mutation := newTaxLotMutation(c.config, OpDelete)
+	return &TaxLotDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TaxLotClient) DeleteOne(_m *TaxLot) *TaxLotDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TaxLotClient) DeleteOneID(id uuid.UUID) *TaxLotDeleteOne {
+	builder := c.Delete().Where(taxlot.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TaxLotDeleteOne{builder}
+}
+
+// Query returns a query builder for TaxLot.
+func (c *TaxLotClient) Query() *TaxLotQuery {
+	return &TaxLotQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTaxLot},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a TaxLot entity by its id.
+func (c *TaxLotClient) Get(ctx context.Context, id uuid.UUID) (*TaxLot, error) {
+	return c.Query().Where(taxlot.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TaxLotClient) GetX(ctx context.Context, id uuid.UUID) *TaxLot {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TaxLotClient) Hooks() []Hook {
+	return c.hooks.TaxLot
+}
+
+// Interceptors returns the client interceptors.
+func (c *TaxLotClient) Interceptors() []Interceptor {
+	return c.inters.TaxLot
+}
+
+func (c *TaxLotClient) mutate(ctx context.Context, m *TaxLotMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TaxLotCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TaxLotUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TaxLotUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TaxLotDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown TaxLot mutation op: %q", m.Op())
+	}
+}
+
+// TradeClient is a client for the Trade schema.
+type TradeClient struct {
+	config
+}
+
+// NewTradeClient returns a client for the Trade from the given config.
+func NewTradeClient(c config) *TradeClient {
+	return &TradeClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `trade.Hooks(f(g(h())))`.
+func (c *TradeClient) Use(hooks ...Hook) {
+	c.hooks.Trade = append(c.hooks.Trade, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `trade.Intercept(f(g(h())))`.
+func (c *TradeClient) Intercept(interceptors ...Interceptor) {
+	c.inters.Trade = append(c.inters.Trade, interceptors...)
+}
+
+// Create returns a builder for creating a Trade entity.
+func (c *TradeClient) Create() *TradeCreate {
+	mutation := newTradeMutation(c.config, OpCreate)
+	return &TradeCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of Trade entities.
+func (c *TradeClient) CreateBulk(builders ...*TradeCreate) *TradeCreateBulk {
+	return &TradeCreateBulk{config: c.config, builders: builders}
+}
+
+// MapCreateBulk creates a bulk creation builder from the given slice. For each item in the slice, the function creates
+// a builder and applies setFunc on it.
+func (c *TradeClient) MapCreateBulk(slice any, setFunc func(*TradeCreate, int)) *TradeCreateBulk {
+	rv := reflect.ValueOf(slice)
+	if rv.Kind() != reflect.Slice {
+		return &TradeCreateBulk{err: fmt.Errorf("calling to TradeClient.MapCreateBulk with wrong type %T, need slice", slice)}
+	}
+	builders := make([]*TradeCreate, rv.Len())
+	for i := 0; i < rv.Len(); i++ {
+		builders[i] = c.Create()
+		setFunc(builders[i], i)
+	}
+	return &TradeCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for Trade.
+func (c *TradeClient) Update() *TradeUpdate {
+	mutation := newTradeMutation(c.config, OpUpdate)
+	return &TradeUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *TradeClient) UpdateOne(_m *Trade) *TradeUpdateOne {
+	mutation := newTradeMutation(c.config, OpUpdateOne, withTrade(_m))
+	return &TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *TradeClient) UpdateOneID(id uuid.UUID) *TradeUpdateOne {
+	mutation := newTradeMutation(c.config, OpUpdateOne, withTradeID(id))
+	return &TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for Trade.
+func (c *TradeClient) Delete() *TradeDelete {
+	mutation := newTradeMutation(c.config, OpDelete)
+	return &TradeDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *TradeClient) DeleteOne(_m *Trade) *TradeDeleteOne {
+	return c.DeleteOneID(_m.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *TradeClient) DeleteOneID(id uuid.UUID) *TradeDeleteOne {
+	builder := c.Delete().Where(trade.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &TradeDeleteOne{builder}
+}
+
+// Query returns a query builder for Trade.
+func (c *TradeClient) Query() *TradeQuery {
+	return &TradeQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeTrade},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a Trade entity by its id.
+func (c *TradeClient) Get(ctx context.Context, id uuid.UUID) (*Trade, error) {
+	return c.Query().Where(trade.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *TradeClient) GetX(ctx context.Context, id uuid.UUID) *Trade {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *TradeClient) Hooks() []Hook {
+	return c.hooks.Trade
+}
+
+// Interceptors returns the client interceptors.
+func (c *TradeClient) Interceptors() []Interceptor {
+	return c.inters.Trade
+}
+
+func (c *TradeClient) mutate(ctx context.Context, m *TradeMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&TradeCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&TradeUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&TradeUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&TradeDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("ent: unknown Trade mutation op: %q", m.Op())
+	}
+}
+
 // UserClient is a client for the User schema.
 type UserClient struct {
 	config
@@ -1355,6 +3479,22 @@ func (c *UserClient) QueryPortfolios(_m *User) *PortfolioQuery {
 	return query
 }
 
+// QueryOrders queries the orders edge of a User.
+func (c *UserClient) QueryOrders(_m *User) *OrderQuery {
+	query := (&OrderClient{config: c.config}).Query()
+	query.path = func(context.Context) (fromV *sql.Selector, _ error) {
+		id := _m.ID
+		step := sqlgraph.NewStep(
+			sqlgraph.From(user.Table, user.FieldID, id),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, user.OrdersTable, user.OrdersColumn),
+		)
+		fromV = sqlgraph.Neighbors(_m.driver.Dialect(), step)
+		return fromV, nil
+	}
+	return query
+}
+
 // Hooks returns the client hooks.
 func (c *UserClient) Hooks() []Hook {
 	return c.hooks.User
@@ -1383,11 +3523,15 @@ func (c *UserClient) mutate(ctx context.Context, m *UserMutation) (Value, error)
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Portfolio, Strategy, StrategyExecution, StrategyPerformance, StrategyStatus,
-		StrategyTemplate, User []ent.Hook
+		BacktestResult, BrokerAccount, Candle, KillSwitch, Order, PaperAccount,
+		PaperPosition, PaperTrade, Portfolio, ProtectionRule, RiskEvent, RiskState,
+		Strategy, StrategyExecution, StrategyPerformance, StrategyStatus,
+		StrategyTemplate, Symbol, TaxLot, Trade, User []ent.Hook
 	}
 	inters struct {
-		Portfolio, Strategy, StrategyExecution, StrategyPerformance, StrategyStatus,
-		StrategyTemplate, User []ent.Interceptor
+		BacktestResult, BrokerAccount, Candle, KillSwitch, Order, PaperAccount,
+		PaperPosition, PaperTrade, Portfolio, ProtectionRule, RiskEvent, RiskState,
+		Strategy, StrategyExecution, StrategyPerformance, StrategyStatus,
+		StrategyTemplate, Symbol, TaxLot, Trade, User []ent.Interceptor
 	}
 )
@@ -3,12 +3,26 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/brokeraccount"
+	"auto-trader/ent/candle"
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/order"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
 	"auto-trader/ent/portfolio"
+	"auto-trader/ent/protectionrule"
+	"auto-trader/ent/riskevent"
+	"auto-trader/ent/riskstate"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
 	"auto-trader/ent/strategyperformance"
 	"auto-trader/ent/strategystatus"
 	"auto-trader/ent/strategytemplate"
+	"auto-trader/ent/symbol"
+	"auto-trader/ent/taxlot"
+	"auto-trader/ent/trade"
 	"auto-trader/ent/user"
 	"context"
 	"errors"
@@ -79,12 +93,26 @@ var (
 func checkColumn(t, c string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
+			backtestresult.Table:      backtestresult.ValidColumn,
+			brokeraccount.Table:       brokeraccount.ValidColumn,
+			candle.Table:              candle.ValidColumn,
+			killswitch.Table:          killswitch.ValidColumn,
+			order.Table:               order.ValidColumn,
+			paperaccount.Table:        paperaccount.ValidColumn,
+			paperposition.Table:       paperposition.ValidColumn,
+			papertrade.Table:          papertrade.ValidColumn,
 			portfolio.Table:           portfolio.ValidColumn,
+			protectionrule.Table:      protectionrule.ValidColumn,
+			riskevent.Table:           riskevent.ValidColumn,
+			riskstate.Table:           riskstate.ValidColumn,
 			strategy.Table:            strategy.ValidColumn,
 			strategyexecution.Table:   strategyexecution.ValidColumn,
 			strategyperformance.Table: strategyperformance.ValidColumn,
 			strategystatus.Table:      strategystatus.ValidColumn,
 			strategytemplate.Table:    strategytemplate.ValidColumn,
+			symbol.Table:              symbol.ValidColumn,
+			taxlot.Table:              taxlot.ValidColumn,
+			trade.Table:               trade.ValidColumn,
 			user.Table:                user.ValidColumn,
 		})
 	})
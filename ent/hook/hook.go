@@ -8,6 +8,102 @@ import (
 	"fmt"
 )
 
+// The BacktestResultFunc type is an adapter to allow the use of ordinary
+// function as BacktestResult mutator.
+type BacktestResultFunc func(context.Context, *ent.BacktestResultMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BacktestResultFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BacktestResultMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BacktestResultMutation", m)
+}
+
+// The BrokerAccountFunc type is an adapter to allow the use of ordinary
+// function as BrokerAccount mutator.
+type BrokerAccountFunc func(context.Context, *ent.BrokerAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f BrokerAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.BrokerAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.BrokerAccountMutation", m)
+}
+
+// The CandleFunc type is an adapter to allow the use of ordinary
+// function as Candle mutator.
+type CandleFunc func(context.Context, *ent.CandleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f CandleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.CandleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.CandleMutation", m)
+}
+
+// The KillSwitchFunc type is an adapter to allow the use of ordinary
+// function as KillSwitch mutator.
+type KillSwitchFunc func(context.Context, *ent.KillSwitchMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f KillSwitchFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.KillSwitchMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.KillSwitchMutation", m)
+}
+
+// The OrderFunc type is an adapter to allow the use of ordinary
+// function as Order mutator.
+type OrderFunc func(context.Context, *ent.OrderMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f OrderFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.OrderMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.OrderMutation", m)
+}
+
+// The PaperAccountFunc type is an adapter to allow the use of ordinary
+// function as PaperAccount mutator.
+type PaperAccountFunc func(context.Context, *ent.PaperAccountMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaperAccountFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaperAccountMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaperAccountMutation", m)
+}
+
+// The PaperPositionFunc type is an adapter to allow the use of ordinary
+// function as PaperPosition mutator.
+type PaperPositionFunc func(context.Context, *ent.PaperPositionMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaperPositionFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaperPositionMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaperPositionMutation", m)
+}
+
+// The PaperTradeFunc type is an adapter to allow the use of ordinary
+// function as PaperTrade mutator.
+type PaperTradeFunc func(context.Context, *ent.PaperTradeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f PaperTradeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.PaperTradeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PaperTradeMutation", m)
+}
+
 // The PortfolioFunc type is an adapter to allow the use of ordinary
 // function as Portfolio mutator.
 type PortfolioFunc func(context.Context, *ent.PortfolioMutation) (ent.Value, error)
@@ -20,6 +116,42 @@ func (f PortfolioFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, e
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.PortfolioMutation", m)
 }
 
+// The ProtectionRuleFunc type is an adapter to allow the use of ordinary
+// function as ProtectionRule mutator.
+type ProtectionRuleFunc func(context.Context, *ent.ProtectionRuleMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ProtectionRuleFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.ProtectionRuleMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.ProtectionRuleMutation", m)
+}
+
+// The RiskEventFunc type is an adapter to allow the use of ordinary
+// function as RiskEvent mutator.
+type RiskEventFunc func(context.Context, *ent.RiskEventMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RiskEventFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RiskEventMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RiskEventMutation", m)
+}
+
+// The RiskStateFunc type is an adapter to allow the use of ordinary
+// function as RiskState mutator.
+type RiskStateFunc func(context.Context, *ent.RiskStateMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f RiskStateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.RiskStateMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.RiskStateMutation", m)
+}
+
 // The StrategyFunc type is an adapter to allow the use of ordinary
 // function as Strategy mutator.
 type StrategyFunc func(context.Context, *ent.StrategyMutation) (ent.Value, error)
@@ -80,6 +212,42 @@ func (f StrategyTemplateFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.V
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.StrategyTemplateMutation", m)
 }
 
+// The SymbolFunc type is an adapter to allow the use of ordinary
+// function as Symbol mutator.
+type SymbolFunc func(context.Context, *ent.SymbolMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f SymbolFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.SymbolMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.SymbolMutation", m)
+}
+
+// The TaxLotFunc type is an adapter to allow the use of ordinary
+// function as TaxLot mutator.
+type TaxLotFunc func(context.Context, *ent.TaxLotMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TaxLotFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TaxLotMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TaxLotMutation", m)
+}
+
+// The TradeFunc type is an adapter to allow the use of ordinary
+// function as Trade mutator.
+type TradeFunc func(context.Context, *ent.TradeMutation) (ent.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f TradeFunc) Mutate(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+	if mv, ok := m.(*ent.TradeMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *ent.TradeMutation", m)
+}
+
 // The UserFunc type is an adapter to allow the use of ordinary
 // function as User mutator.
 type UserFunc func(context.Context, *ent.UserMutation) (ent.Value, error)
@@ -0,0 +1,248 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/killswitch"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// KillSwitch is the model entity for the KillSwitch schema.
+type KillSwitch struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID *uuid.UUID `json:"user_id,omitempty"`
+	// ActivatedBy holds the value of the "activated_by" field.
+	ActivatedBy uuid.UUID `json:"activated_by,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// Flatten holds the value of the "flatten" field.
+	Flatten bool `json:"flatten,omitempty"`
+	// StoppedStrategies holds the value of the "stopped_strategies" field.
+	StoppedStrategies int `json:"stopped_strategies,omitempty"`
+	// CanceledOrders holds the value of the "canceled_orders" field.
+	CanceledOrders int `json:"canceled_orders,omitempty"`
+	// FlattenOrders holds the value of the "flatten_orders" field.
+	FlattenOrders int `json:"flatten_orders,omitempty"`
+	// FailedCloses holds the value of the "failed_closes" field.
+	FailedCloses int `json:"failed_closes,omitempty"`
+	// Errors holds the value of the "errors" field.
+	Errors []string `json:"errors,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// ReleasedAt holds the value of the "released_at" field.
+	ReleasedAt *time.Time `json:"released_at,omitempty"`
+	// ReleasedBy holds the value of the "released_by" field.
+	ReleasedBy   *uuid.UUID `json:"released_by,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*KillSwitch) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case killswitch.FieldUserID, killswitch.FieldReleasedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case killswitch.FieldErrors:
+			values[i] = new([]byte)
+		case killswitch.FieldFlatten:
+			values[i] = new(sql.NullBool)
+		case killswitch.FieldStoppedStrategies, killswitch.FieldCanceledOrders, killswitch.FieldFlattenOrders, killswitch.FieldFailedCloses:
+			values[i] = new(sql.NullInt64)
+		case killswitch.FieldReason:
+			values[i] = new(sql.NullString)
+		case killswitch.FieldCreatedAt, killswitch.FieldReleasedAt:
+			values[i] = new(sql.NullTime)
+		case killswitch.FieldID, killswitch.FieldActivatedBy:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the KillSwitch fields.
+func (_m *KillSwitch) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case killswitch.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case killswitch.FieldUserID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				_m.UserID = new(uuid.UUID)
+				*_m.UserID = *value.S.(*uuid.UUID)
+			}
+		case killswitch.FieldActivatedBy:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field activated_by", values[i])
+			} else if value != nil {
+				_m.ActivatedBy = *value
+			}
+		case killswitch.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case killswitch.FieldFlatten:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field flatten", values[i])
+			} else if value.Valid {
+				_m.Flatten = value.Bool
+			}
+		case killswitch.FieldStoppedStrategies:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field stopped_strategies", values[i])
+			} else if value.Valid {
+				_m.StoppedStrategies = int(value.Int64)
+			}
+		case killswitch.FieldCanceledOrders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field canceled_orders", values[i])
+			} else if value.Valid {
+				_m.CanceledOrders = int(value.Int64)
+			}
+		case killswitch.FieldFlattenOrders:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field flatten_orders", values[i])
+			} else if value.Valid {
+				_m.FlattenOrders = int(value.Int64)
+			}
+		case killswitch.FieldFailedCloses:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field failed_closes", values[i])
+			} else if value.Valid {
+				_m.FailedCloses = int(value.Int64)
+			}
+		case killswitch.FieldErrors:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field errors", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Errors); err != nil {
+					return fmt.Errorf("unmarshal field errors: %w", err)
+				}
+			}
+		case killswitch.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case killswitch.FieldReleasedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field released_at", values[i])
+			} else if value.Valid {
+				_m.ReleasedAt = new(time.Time)
+				*_m.ReleasedAt = value.Time
+			}
+		case killswitch.FieldReleasedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field released_by", values[i])
+			} else if value.Valid {
+				_m.ReleasedBy = new(uuid.UUID)
+				*_m.ReleasedBy = *value.S.(*uuid.UUID)
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the KillSwitch.
+// This includes values selected through modifiers, order, etc.
+func (_m *KillSwitch) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this KillSwitch.
+// Note that you need to call KillSwitch.Unwrap() before calling this method if this KillSwitch
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *KillSwitch) Update() *KillSwitchUpdateOne {
+	return NewKillSwitchClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the KillSwitch entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *KillSwitch) Unwrap() *KillSwitch {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: KillSwitch is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *KillSwitch) String() string {
+	var builder strings.Builder
+	builder.WriteString("KillSwitch(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	if v := _m.UserID; v != nil {
+		builder.WriteString("user_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("activated_by=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ActivatedBy))
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("flatten=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Flatten))
+	builder.WriteString(", ")
+	builder.WriteString("stopped_strategies=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StoppedStrategies))
+	builder.WriteString(", ")
+	builder.WriteString("canceled_orders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CanceledOrders))
+	builder.WriteString(", ")
+	builder.WriteString("flatten_orders=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FlattenOrders))
+	builder.WriteString(", ")
+	builder.WriteString("failed_closes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FailedCloses))
+	builder.WriteString(", ")
+	builder.WriteString("errors=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Errors))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ReleasedAt; v != nil {
+		builder.WriteString("released_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.ReleasedBy; v != nil {
+		builder.WriteString("released_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// KillSwitches is a parsable slice of KillSwitch.
+type KillSwitches []*KillSwitch
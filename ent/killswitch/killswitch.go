@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package killswitch
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the killswitch type in the database.
+	Label = "kill_switch"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldActivatedBy holds the string denoting the activated_by field in the database.
+	FieldActivatedBy = "activated_by"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldFlatten holds the string denoting the flatten field in the database.
+	FieldFlatten = "flatten"
+	// FieldStoppedStrategies holds the string denoting the stopped_strategies field in the database.
+	FieldStoppedStrategies = "stopped_strategies"
+	// FieldCanceledOrders holds the string denoting the canceled_orders field in the database.
+	FieldCanceledOrders = "canceled_orders"
+	// FieldFlattenOrders holds the string denoting the flatten_orders field in the database.
+	FieldFlattenOrders = "flatten_orders"
+	// FieldFailedCloses holds the string denoting the failed_closes field in the database.
+	FieldFailedCloses = "failed_closes"
+	// FieldErrors holds the string denoting the errors field in the database.
+	FieldErrors = "errors"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldReleasedAt holds the string denoting the released_at field in the database.
+	FieldReleasedAt = "released_at"
+	// FieldReleasedBy holds the string denoting the released_by field in the database.
+	FieldReleasedBy = "released_by"
+	// Table holds the table name of the killswitch in the database.
+	Table = "kill_switches"
+)
+
+// Columns holds all SQL columns for killswitch fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldActivatedBy,
+	FieldReason,
+	FieldFlatten,
+	FieldStoppedStrategies,
+	FieldCanceledOrders,
+	FieldFlattenOrders,
+	FieldFailedCloses,
+	FieldErrors,
+	FieldCreatedAt,
+	FieldReleasedAt,
+	FieldReleasedBy,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultFlatten holds the default value on creation for the "flatten" field.
+	DefaultFlatten bool
+	// DefaultStoppedStrategies holds the default value on creation for the "stopped_strategies" field.
+	DefaultStoppedStrategies int
+	// DefaultCanceledOrders holds the default value on creation for the "canceled_orders" field.
+	DefaultCanceledOrders int
+	// DefaultFlattenOrders holds the default value on creation for the "flatten_orders" field.
+	DefaultFlattenOrders int
+	// DefaultFailedCloses holds the default value on creation for the "failed_closes" field.
+	DefaultFailedCloses int
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the KillSwitch queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByActivatedBy orders the results by the activated_by field.
+func ByActivatedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActivatedBy, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByFlatten orders the results by the flatten field.
+func ByFlatten(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlatten, opts...).ToFunc()
+}
+
+// ByStoppedStrategies orders the results by the stopped_strategies field.
+func ByStoppedStrategies(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStoppedStrategies, opts...).ToFunc()
+}
+
+// ByCanceledOrders orders the results by the canceled_orders field.
+func ByCanceledOrders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanceledOrders, opts...).ToFunc()
+}
+
+// ByFlattenOrders orders the results by the flatten_orders field.
+func ByFlattenOrders(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFlattenOrders, opts...).ToFunc()
+}
+
+// ByFailedCloses orders the results by the failed_closes field.
+func ByFailedCloses(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFailedCloses, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByReleasedAt orders the results by the released_at field.
+func ByReleasedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedAt, opts...).ToFunc()
+}
+
+// ByReleasedBy orders the results by the released_by field.
+func ByReleasedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReleasedBy, opts...).ToFunc()
+}
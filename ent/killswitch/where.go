@@ -0,0 +1,611 @@
+// Code generated by ent, DO NOT EDIT.
+
+package killswitch
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldUserID, v))
+}
+
+// ActivatedBy applies equality check predicate on the "activated_by" field. It's identical to ActivatedByEQ.
+func ActivatedBy(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldActivatedBy, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReason, v))
+}
+
+// Flatten applies equality check predicate on the "flatten" field. It's identical to FlattenEQ.
+func Flatten(v bool) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFlatten, v))
+}
+
+// StoppedStrategies applies equality check predicate on the "stopped_strategies" field. It's identical to StoppedStrategiesEQ.
+func StoppedStrategies(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldStoppedStrategies, v))
+}
+
+// CanceledOrders applies equality check predicate on the "canceled_orders" field. It's identical to CanceledOrdersEQ.
+func CanceledOrders(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldCanceledOrders, v))
+}
+
+// FlattenOrders applies equality check predicate on the "flatten_orders" field. It's identical to FlattenOrdersEQ.
+func FlattenOrders(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFlattenOrders, v))
+}
+
+// FailedCloses applies equality check predicate on the "failed_closes" field. It's identical to FailedClosesEQ.
+func FailedCloses(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFailedCloses, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ReleasedAt applies equality check predicate on the "released_at" field. It's identical to ReleasedAtEQ.
+func ReleasedAt(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedBy applies equality check predicate on the "released_by" field. It's identical to ReleasedByEQ.
+func ReleasedBy(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldUserID, v))
+}
+
+// UserIDIsNil applies the IsNil predicate on the "user_id" field.
+func UserIDIsNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIsNull(FieldUserID))
+}
+
+// UserIDNotNil applies the NotNil predicate on the "user_id" field.
+func UserIDNotNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotNull(FieldUserID))
+}
+
+// ActivatedByEQ applies the EQ predicate on the "activated_by" field.
+func ActivatedByEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldActivatedBy, v))
+}
+
+// ActivatedByNEQ applies the NEQ predicate on the "activated_by" field.
+func ActivatedByNEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldActivatedBy, v))
+}
+
+// ActivatedByIn applies the In predicate on the "activated_by" field.
+func ActivatedByIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldActivatedBy, vs...))
+}
+
+// ActivatedByNotIn applies the NotIn predicate on the "activated_by" field.
+func ActivatedByNotIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldActivatedBy, vs...))
+}
+
+// ActivatedByGT applies the GT predicate on the "activated_by" field.
+func ActivatedByGT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldActivatedBy, v))
+}
+
+// ActivatedByGTE applies the GTE predicate on the "activated_by" field.
+func ActivatedByGTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldActivatedBy, v))
+}
+
+// ActivatedByLT applies the LT predicate on the "activated_by" field.
+func ActivatedByLT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldActivatedBy, v))
+}
+
+// ActivatedByLTE applies the LTE predicate on the "activated_by" field.
+func ActivatedByLTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldActivatedBy, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonIsNil applies the IsNil predicate on the "reason" field.
+func ReasonIsNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIsNull(FieldReason))
+}
+
+// ReasonNotNil applies the NotNil predicate on the "reason" field.
+func ReasonNotNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotNull(FieldReason))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldContainsFold(FieldReason, v))
+}
+
+// FlattenEQ applies the EQ predicate on the "flatten" field.
+func FlattenEQ(v bool) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFlatten, v))
+}
+
+// FlattenNEQ applies the NEQ predicate on the "flatten" field.
+func FlattenNEQ(v bool) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldFlatten, v))
+}
+
+// StoppedStrategiesEQ applies the EQ predicate on the "stopped_strategies" field.
+func StoppedStrategiesEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldStoppedStrategies, v))
+}
+
+// StoppedStrategiesNEQ applies the NEQ predicate on the "stopped_strategies" field.
+func StoppedStrategiesNEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldStoppedStrategies, v))
+}
+
+// StoppedStrategiesIn applies the In predicate on the "stopped_strategies" field.
+func StoppedStrategiesIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldStoppedStrategies, vs...))
+}
+
+// StoppedStrategiesNotIn applies the NotIn predicate on the "stopped_strategies" field.
+func StoppedStrategiesNotIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldStoppedStrategies, vs...))
+}
+
+// StoppedStrategiesGT applies the GT predicate on the "stopped_strategies" field.
+func StoppedStrategiesGT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldStoppedStrategies, v))
+}
+
+// StoppedStrategiesGTE applies the GTE predicate on the "stopped_strategies" field.
+func StoppedStrategiesGTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldStoppedStrategies, v))
+}
+
+// StoppedStrategiesLT applies the LT predicate on the "stopped_strategies" field.
+func StoppedStrategiesLT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldStoppedStrategies, v))
+}
+
+// StoppedStrategiesLTE applies the LTE predicate on the "stopped_strategies" field.
+func StoppedStrategiesLTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldStoppedStrategies, v))
+}
+
+// CanceledOrdersEQ applies the EQ predicate on the "canceled_orders" field.
+func CanceledOrdersEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldCanceledOrders, v))
+}
+
+// CanceledOrdersNEQ applies the NEQ predicate on the "canceled_orders" field.
+func CanceledOrdersNEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldCanceledOrders, v))
+}
+
+// CanceledOrdersIn applies the In predicate on the "canceled_orders" field.
+func CanceledOrdersIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldCanceledOrders, vs...))
+}
+
+// CanceledOrdersNotIn applies the NotIn predicate on the "canceled_orders" field.
+func CanceledOrdersNotIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldCanceledOrders, vs...))
+}
+
+// CanceledOrdersGT applies the GT predicate on the "canceled_orders" field.
+func CanceledOrdersGT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldCanceledOrders, v))
+}
+
+// CanceledOrdersGTE applies the GTE predicate on the "canceled_orders" field.
+func CanceledOrdersGTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldCanceledOrders, v))
+}
+
+// CanceledOrdersLT applies the LT predicate on the "canceled_orders" field.
+func CanceledOrdersLT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldCanceledOrders, v))
+}
+
+// CanceledOrdersLTE applies the LTE predicate on the "canceled_orders" field.
+func CanceledOrdersLTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldCanceledOrders, v))
+}
+
+// FlattenOrdersEQ applies the EQ predicate on the "flatten_orders" field.
+func FlattenOrdersEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFlattenOrders, v))
+}
+
+// FlattenOrdersNEQ applies the NEQ predicate on the "flatten_orders" field.
+func FlattenOrdersNEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldFlattenOrders, v))
+}
+
+// FlattenOrdersIn applies the In predicate on the "flatten_orders" field.
+func FlattenOrdersIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldFlattenOrders, vs...))
+}
+
+// FlattenOrdersNotIn applies the NotIn predicate on the "flatten_orders" field.
+func FlattenOrdersNotIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldFlattenOrders, vs...))
+}
+
+// FlattenOrdersGT applies the GT predicate on the "flatten_orders" field.
+func FlattenOrdersGT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldFlattenOrders, v))
+}
+
+// FlattenOrdersGTE applies the GTE predicate on the "flatten_orders" field.
+func FlattenOrdersGTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldFlattenOrders, v))
+}
+
+// FlattenOrdersLT applies the LT predicate on the "flatten_orders" field.
+func FlattenOrdersLT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldFlattenOrders, v))
+}
+
+// FlattenOrdersLTE applies the LTE predicate on the "flatten_orders" field.
+func FlattenOrdersLTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldFlattenOrders, v))
+}
+
+// FailedClosesEQ applies the EQ predicate on the "failed_closes" field.
+func FailedClosesEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldFailedCloses, v))
+}
+
+// FailedClosesNEQ applies the NEQ predicate on the "failed_closes" field.
+func FailedClosesNEQ(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldFailedCloses, v))
+}
+
+// FailedClosesIn applies the In predicate on the "failed_closes" field.
+func FailedClosesIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldFailedCloses, vs...))
+}
+
+// FailedClosesNotIn applies the NotIn predicate on the "failed_closes" field.
+func FailedClosesNotIn(vs ...int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldFailedCloses, vs...))
+}
+
+// FailedClosesGT applies the GT predicate on the "failed_closes" field.
+func FailedClosesGT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldFailedCloses, v))
+}
+
+// FailedClosesGTE applies the GTE predicate on the "failed_closes" field.
+func FailedClosesGTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldFailedCloses, v))
+}
+
+// FailedClosesLT applies the LT predicate on the "failed_closes" field.
+func FailedClosesLT(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldFailedCloses, v))
+}
+
+// FailedClosesLTE applies the LTE predicate on the "failed_closes" field.
+func FailedClosesLTE(v int) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldFailedCloses, v))
+}
+
+// ErrorsIsNil applies the IsNil predicate on the "errors" field.
+func ErrorsIsNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIsNull(FieldErrors))
+}
+
+// ErrorsNotNil applies the NotNil predicate on the "errors" field.
+func ErrorsNotNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotNull(FieldErrors))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// ReleasedAtEQ applies the EQ predicate on the "released_at" field.
+func ReleasedAtEQ(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtNEQ applies the NEQ predicate on the "released_at" field.
+func ReleasedAtNEQ(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldReleasedAt, v))
+}
+
+// ReleasedAtIn applies the In predicate on the "released_at" field.
+func ReleasedAtIn(vs ...time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtNotIn applies the NotIn predicate on the "released_at" field.
+func ReleasedAtNotIn(vs ...time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldReleasedAt, vs...))
+}
+
+// ReleasedAtGT applies the GT predicate on the "released_at" field.
+func ReleasedAtGT(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldReleasedAt, v))
+}
+
+// ReleasedAtGTE applies the GTE predicate on the "released_at" field.
+func ReleasedAtGTE(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtLT applies the LT predicate on the "released_at" field.
+func ReleasedAtLT(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldReleasedAt, v))
+}
+
+// ReleasedAtLTE applies the LTE predicate on the "released_at" field.
+func ReleasedAtLTE(v time.Time) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldReleasedAt, v))
+}
+
+// ReleasedAtIsNil applies the IsNil predicate on the "released_at" field.
+func ReleasedAtIsNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIsNull(FieldReleasedAt))
+}
+
+// ReleasedAtNotNil applies the NotNil predicate on the "released_at" field.
+func ReleasedAtNotNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotNull(FieldReleasedAt))
+}
+
+// ReleasedByEQ applies the EQ predicate on the "released_by" field.
+func ReleasedByEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByNEQ applies the NEQ predicate on the "released_by" field.
+func ReleasedByNEQ(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNEQ(FieldReleasedBy, v))
+}
+
+// ReleasedByIn applies the In predicate on the "released_by" field.
+func ReleasedByIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByNotIn applies the NotIn predicate on the "released_by" field.
+func ReleasedByNotIn(vs ...uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotIn(FieldReleasedBy, vs...))
+}
+
+// ReleasedByGT applies the GT predicate on the "released_by" field.
+func ReleasedByGT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGT(FieldReleasedBy, v))
+}
+
+// ReleasedByGTE applies the GTE predicate on the "released_by" field.
+func ReleasedByGTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldGTE(FieldReleasedBy, v))
+}
+
+// ReleasedByLT applies the LT predicate on the "released_by" field.
+func ReleasedByLT(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLT(FieldReleasedBy, v))
+}
+
+// ReleasedByLTE applies the LTE predicate on the "released_by" field.
+func ReleasedByLTE(v uuid.UUID) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldLTE(FieldReleasedBy, v))
+}
+
+// ReleasedByIsNil applies the IsNil predicate on the "released_by" field.
+func ReleasedByIsNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldIsNull(FieldReleasedBy))
+}
+
+// ReleasedByNotNil applies the NotNil predicate on the "released_by" field.
+func ReleasedByNotNil() predicate.KillSwitch {
+	return predicate.KillSwitch(sql.FieldNotNull(FieldReleasedBy))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.KillSwitch) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.KillSwitch) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.KillSwitch) predicate.KillSwitch {
+	return predicate.KillSwitch(sql.NotPredicates(p))
+}
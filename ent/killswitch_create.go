@@ -0,0 +1,446 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/killswitch"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// KillSwitchCreate is the builder for creating a KillSwitch entity.
+type KillSwitchCreate struct {
+	config
+	mutation *KillSwitchMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *KillSwitchCreate) SetUserID(v uuid.UUID) *KillSwitchCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableUserID(v *uuid.UUID) *KillSwitchCreate {
+	if v != nil {
+		_c.SetUserID(*v)
+	}
+	return _c
+}
+
+// SetActivatedBy sets the "activated_by" field.
+func (_c *KillSwitchCreate) SetActivatedBy(v uuid.UUID) *KillSwitchCreate {
+	_c.mutation.SetActivatedBy(v)
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *KillSwitchCreate) SetReason(v string) *KillSwitchCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableReason(v *string) *KillSwitchCreate {
+	if v != nil {
+		_c.SetReason(*v)
+	}
+	return _c
+}
+
+// SetFlatten sets the "flatten" field.
+func (_c *KillSwitchCreate) SetFlatten(v bool) *KillSwitchCreate {
+	_c.mutation.SetFlatten(v)
+	return _c
+}
+
+// SetNillableFlatten sets the "flatten" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableFlatten(v *bool) *KillSwitchCreate {
+	if v != nil {
+		_c.SetFlatten(*v)
+	}
+	return _c
+}
+
+// SetStoppedStrategies sets the "stopped_strategies" field.
+func (_c *KillSwitchCreate) SetStoppedStrategies(v int) *KillSwitchCreate {
+	_c.mutation.SetStoppedStrategies(v)
+	return _c
+}
+
+// SetNillableStoppedStrategies sets the "stopped_strategies" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableStoppedStrategies(v *int) *KillSwitchCreate {
+	if v != nil {
+		_c.SetStoppedStrategies(*v)
+	}
+	return _c
+}
+
+// SetCanceledOrders sets the "canceled_orders" field.
+func (_c *KillSwitchCreate) SetCanceledOrders(v int) *KillSwitchCreate {
+	_c.mutation.SetCanceledOrders(v)
+	return _c
+}
+
+// SetNillableCanceledOrders sets the "canceled_orders" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableCanceledOrders(v *int) *KillSwitchCreate {
+	if v != nil {
+		_c.SetCanceledOrders(*v)
+	}
+	return _c
+}
+
+// SetFlattenOrders sets the "flatten_orders" field.
+func (_c *KillSwitchCreate) SetFlattenOrders(v int) *KillSwitchCreate {
+	_c.mutation.SetFlattenOrders(v)
+	return _c
+}
+
+// SetNillableFlattenOrders sets the "flatten_orders" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableFlattenOrders(v *int) *KillSwitchCreate {
+	if v != nil {
+		_c.SetFlattenOrders(*v)
+	}
+	return _c
+}
+
+// SetFailedCloses sets the "failed_closes" field.
+func (_c *KillSwitchCreate) SetFailedCloses(v int) *KillSwitchCreate {
+	_c.mutation.SetFailedCloses(v)
+	return _c
+}
+
+// SetNillableFailedCloses sets the "failed_closes" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableFailedCloses(v *int) *KillSwitchCreate {
+	if v != nil {
+		_c.SetFailedCloses(*v)
+	}
+	return _c
+}
+
+// SetErrors sets the "errors" field.
+func (_c *KillSwitchCreate) SetErrors(v []string) *KillSwitchCreate {
+	_c.mutation.SetErrors(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *KillSwitchCreate) SetCreatedAt(v time.Time) *KillSwitchCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableCreatedAt(v *time.Time) *KillSwitchCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_c *KillSwitchCreate) SetReleasedAt(v time.Time) *KillSwitchCreate {
+	_c.mutation.SetReleasedAt(v)
+	return _c
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableReleasedAt(v *time.Time) *KillSwitchCreate {
+	if v != nil {
+		_c.SetReleasedAt(*v)
+	}
+	return _c
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (_c *KillSwitchCreate) SetReleasedBy(v uuid.UUID) *KillSwitchCreate {
+	_c.mutation.SetReleasedBy(v)
+	return _c
+}
+
+// SetNillableReleasedBy sets the "released_by" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableReleasedBy(v *uuid.UUID) *KillSwitchCreate {
+	if v != nil {
+		_c.SetReleasedBy(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *KillSwitchCreate) SetID(v uuid.UUID) *KillSwitchCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *KillSwitchCreate) SetNillableID(v *uuid.UUID) *KillSwitchCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the KillSwitchMutation object of the builder.
+func (_c *KillSwitchCreate) Mutation() *KillSwitchMutation {
+	return _c.mutation
+}
+
+// Save creates the KillSwitch in the database.
+func (_c *KillSwitchCreate) Save(ctx context.Context) (*KillSwitch, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *KillSwitchCreate) SaveX(ctx context.Context) *KillSwitch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KillSwitchCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KillSwitchCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *KillSwitchCreate) defaults() {
+	if _, ok := _c.mutation.Flatten(); !ok {
+		v := killswitch.DefaultFlatten
+		_c.mutation.SetFlatten(v)
+	}
+	if _, ok := _c.mutation.StoppedStrategies(); !ok {
+		v := killswitch.DefaultStoppedStrategies
+		_c.mutation.SetStoppedStrategies(v)
+	}
+	if _, ok := _c.mutation.CanceledOrders(); !ok {
+		v := killswitch.DefaultCanceledOrders
+		_c.mutation.SetCanceledOrders(v)
+	}
+	if _, ok := _c.mutation.FlattenOrders(); !ok {
+		v := killswitch.DefaultFlattenOrders
+		_c.mutation.SetFlattenOrders(v)
+	}
+	if _, ok := _c.mutation.FailedCloses(); !ok {
+		v := killswitch.DefaultFailedCloses
+		_c.mutation.SetFailedCloses(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := killswitch.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := killswitch.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *KillSwitchCreate) check() error {
+	if _, ok := _c.mutation.ActivatedBy(); !ok {
+		return &ValidationError{Name: "activated_by", err: errors.New(`ent: missing required field "KillSwitch.activated_by"`)}
+	}
+	if _, ok := _c.mutation.Flatten(); !ok {
+		return &ValidationError{Name: "flatten", err: errors.New(`ent: missing required field "KillSwitch.flatten"`)}
+	}
+	if _, ok := _c.mutation.StoppedStrategies(); !ok {
+		return &ValidationError{Name: "stopped_strategies", err: errors.New(`ent: missing required field "KillSwitch.stopped_strategies"`)}
+	}
+	if _, ok := _c.mutation.CanceledOrders(); !ok {
+		return &ValidationError{Name: "canceled_orders", err: errors.New(`ent: missing required field "KillSwitch.canceled_orders"`)}
+	}
+	if _, ok := _c.mutation.FlattenOrders(); !ok {
+		return &ValidationError{Name: "flatten_orders", err: errors.New(`ent: missing required field "KillSwitch.flatten_orders"`)}
+	}
+	if _, ok := _c.mutation.FailedCloses(); !ok {
+		return &ValidationError{Name: "failed_closes", err: errors.New(`ent: missing required field "KillSwitch.failed_closes"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "KillSwitch.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *KillSwitchCreate) sqlSave(ctx context.Context) (*KillSwitch, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *KillSwitchCreate) createSpec() (*KillSwitch, *sqlgraph.CreateSpec) {
+	var (
+		_node = &KillSwitch{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(killswitch.Table, sqlgraph.NewFieldSpec(killswitch.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(killswitch.FieldUserID, field.TypeUUID, value)
+		_node.UserID = &value
+	}
+	if value, ok := _c.mutation.ActivatedBy(); ok {
+		_spec.SetField(killswitch.FieldActivatedBy, field.TypeUUID, value)
+		_node.ActivatedBy = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(killswitch.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.Flatten(); ok {
+		_spec.SetField(killswitch.FieldFlatten, field.TypeBool, value)
+		_node.Flatten = value
+	}
+	if value, ok := _c.mutation.StoppedStrategies(); ok {
+		_spec.SetField(killswitch.FieldStoppedStrategies, field.TypeInt, value)
+		_node.StoppedStrategies = value
+	}
+	if value, ok := _c.mutation.CanceledOrders(); ok {
+		_spec.SetField(killswitch.FieldCanceledOrders, field.TypeInt, value)
+		_node.CanceledOrders = value
+	}
+	if value, ok := _c.mutation.FlattenOrders(); ok {
+		_spec.SetField(killswitch.FieldFlattenOrders, field.TypeInt, value)
+		_node.FlattenOrders = value
+	}
+	if value, ok := _c.mutation.FailedCloses(); ok {
+		_spec.SetField(killswitch.FieldFailedCloses, field.TypeInt, value)
+		_node.FailedCloses = value
+	}
+	if value, ok := _c.mutation.Errors(); ok {
+		_spec.SetField(killswitch.FieldErrors, field.TypeJSON, value)
+		_node.Errors = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(killswitch.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.ReleasedAt(); ok {
+		_spec.SetField(killswitch.FieldReleasedAt, field.TypeTime, value)
+		_node.ReleasedAt = &value
+	}
+	if value, ok := _c.mutation.ReleasedBy(); ok {
+		_spec.SetField(killswitch.FieldReleasedBy, field.TypeUUID, value)
+		_node.ReleasedBy = &value
+	}
+	return _node, _spec
+}
+
+// KillSwitchCreateBulk is the builder for creating many KillSwitch entities in bulk.
+type KillSwitchCreateBulk struct {
+	config
+	err      error
+	builders []*KillSwitchCreate
+}
+
+// Save creates the KillSwitch entities in the database.
+func (_c *KillSwitchCreateBulk) Save(ctx context.Context) ([]*KillSwitch, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*KillSwitch, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*KillSwitchMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *KillSwitchCreateBulk) SaveX(ctx context.Context) []*KillSwitch {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *KillSwitchCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *KillSwitchCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
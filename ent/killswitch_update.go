@@ -0,0 +1,728 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// KillSwitchUpdate is the builder for updating KillSwitch entities.
+type KillSwitchUpdate struct {
+	config
+	hooks    []Hook
+	mutation *KillSwitchMutation
+}
+
+// Where appends a list predicates to the KillSwitchUpdate builder.
+func (_u *KillSwitchUpdate) Where(ps ...predicate.KillSwitch) *KillSwitchUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *KillSwitchUpdate) SetUserID(v uuid.UUID) *KillSwitchUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableUserID(v *uuid.UUID) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *KillSwitchUpdate) ClearUserID() *KillSwitchUpdate {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetActivatedBy sets the "activated_by" field.
+func (_u *KillSwitchUpdate) SetActivatedBy(v uuid.UUID) *KillSwitchUpdate {
+	_u.mutation.SetActivatedBy(v)
+	return _u
+}
+
+// SetNillableActivatedBy sets the "activated_by" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableActivatedBy(v *uuid.UUID) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetActivatedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *KillSwitchUpdate) SetReason(v string) *KillSwitchUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableReason(v *string) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *KillSwitchUpdate) ClearReason() *KillSwitchUpdate {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFlatten sets the "flatten" field.
+func (_u *KillSwitchUpdate) SetFlatten(v bool) *KillSwitchUpdate {
+	_u.mutation.SetFlatten(v)
+	return _u
+}
+
+// SetNillableFlatten sets the "flatten" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableFlatten(v *bool) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetFlatten(*v)
+	}
+	return _u
+}
+
+// SetStoppedStrategies sets the "stopped_strategies" field.
+func (_u *KillSwitchUpdate) SetStoppedStrategies(v int) *KillSwitchUpdate {
+	_u.mutation.ResetStoppedStrategies()
+	_u.mutation.SetStoppedStrategies(v)
+	return _u
+}
+
+// SetNillableStoppedStrategies sets the "stopped_strategies" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableStoppedStrategies(v *int) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetStoppedStrategies(*v)
+	}
+	return _u
+}
+
+// AddStoppedStrategies adds value to the "stopped_strategies" field.
+func (_u *KillSwitchUpdate) AddStoppedStrategies(v int) *KillSwitchUpdate {
+	_u.mutation.AddStoppedStrategies(v)
+	return _u
+}
+
+// SetCanceledOrders sets the "canceled_orders" field.
+func (_u *KillSwitchUpdate) SetCanceledOrders(v int) *KillSwitchUpdate {
+	_u.mutation.ResetCanceledOrders()
+	_u.mutation.SetCanceledOrders(v)
+	return _u
+}
+
+// SetNillableCanceledOrders sets the "canceled_orders" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableCanceledOrders(v *int) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetCanceledOrders(*v)
+	}
+	return _u
+}
+
+// AddCanceledOrders adds value to the "canceled_orders" field.
+func (_u *KillSwitchUpdate) AddCanceledOrders(v int) *KillSwitchUpdate {
+	_u.mutation.AddCanceledOrders(v)
+	return _u
+}
+
+// SetFlattenOrders sets the "flatten_orders" field.
+func (_u *KillSwitchUpdate) SetFlattenOrders(v int) *KillSwitchUpdate {
+	_u.mutation.ResetFlattenOrders()
+	_u.mutation.SetFlattenOrders(v)
+	return _u
+}
+
+// SetNillableFlattenOrders sets the "flatten_orders" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableFlattenOrders(v *int) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetFlattenOrders(*v)
+	}
+	return _u
+}
+
+// AddFlattenOrders adds value to the "flatten_orders" field.
+func (_u *KillSwitchUpdate) AddFlattenOrders(v int) *KillSwitchUpdate {
+	_u.mutation.AddFlattenOrders(v)
+	return _u
+}
+
+// SetFailedCloses sets the "failed_closes" field.
+func (_u *KillSwitchUpdate) SetFailedCloses(v int) *KillSwitchUpdate {
+	_u.mutation.ResetFailedCloses()
+	_u.mutation.SetFailedCloses(v)
+	return _u
+}
+
+// SetNillableFailedCloses sets the "failed_closes" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableFailedCloses(v *int) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetFailedCloses(*v)
+	}
+	return _u
+}
+
+// AddFailedCloses adds value to the "failed_closes" field.
+func (_u *KillSwitchUpdate) AddFailedCloses(v int) *KillSwitchUpdate {
+	_u.mutation.AddFailedCloses(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *KillSwitchUpdate) SetErrors(v []string) *KillSwitchUpdate {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *KillSwitchUpdate) AppendErrors(v []string) *KillSwitchUpdate {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *KillSwitchUpdate) ClearErrors() *KillSwitchUpdate {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *KillSwitchUpdate) SetReleasedAt(v time.Time) *KillSwitchUpdate {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableReleasedAt(v *time.Time) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *KillSwitchUpdate) ClearReleasedAt() *KillSwitchUpdate {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (_u *KillSwitchUpdate) SetReleasedBy(v uuid.UUID) *KillSwitchUpdate {
+	_u.mutation.SetReleasedBy(v)
+	return _u
+}
+
+// SetNillableReleasedBy sets the "released_by" field if the given value is not nil.
+func (_u *KillSwitchUpdate) SetNillableReleasedBy(v *uuid.UUID) *KillSwitchUpdate {
+	if v != nil {
+		_u.SetReleasedBy(*v)
+	}
+	return _u
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (_u *KillSwitchUpdate) ClearReleasedBy() *KillSwitchUpdate {
+	_u.mutation.ClearReleasedBy()
+	return _u
+}
+
+// Mutation returns the KillSwitchMutation object of the builder.
+func (_u *KillSwitchUpdate) Mutation() *KillSwitchMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *KillSwitchUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KillSwitchUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *KillSwitchUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KillSwitchUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KillSwitchUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(killswitch.Table, killswitch.Columns, sqlgraph.NewFieldSpec(killswitch.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(killswitch.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(killswitch.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActivatedBy(); ok {
+		_spec.SetField(killswitch.FieldActivatedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(killswitch.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(killswitch.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flatten(); ok {
+		_spec.SetField(killswitch.FieldFlatten, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StoppedStrategies(); ok {
+		_spec.SetField(killswitch.FieldStoppedStrategies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStoppedStrategies(); ok {
+		_spec.AddField(killswitch.FieldStoppedStrategies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanceledOrders(); ok {
+		_spec.SetField(killswitch.FieldCanceledOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanceledOrders(); ok {
+		_spec.AddField(killswitch.FieldCanceledOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FlattenOrders(); ok {
+		_spec.SetField(killswitch.FieldFlattenOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFlattenOrders(); ok {
+		_spec.AddField(killswitch.FieldFlattenOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCloses(); ok {
+		_spec.SetField(killswitch.FieldFailedCloses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCloses(); ok {
+		_spec.AddField(killswitch.FieldFailedCloses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(killswitch.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, killswitch.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(killswitch.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(killswitch.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(killswitch.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedBy(); ok {
+		_spec.SetField(killswitch.FieldReleasedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReleasedByCleared() {
+		_spec.ClearField(killswitch.FieldReleasedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{killswitch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// KillSwitchUpdateOne is the builder for updating a single KillSwitch entity.
+type KillSwitchUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *KillSwitchMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *KillSwitchUpdateOne) SetUserID(v uuid.UUID) *KillSwitchUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableUserID(v *uuid.UUID) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (_u *KillSwitchUpdateOne) ClearUserID() *KillSwitchUpdateOne {
+	_u.mutation.ClearUserID()
+	return _u
+}
+
+// SetActivatedBy sets the "activated_by" field.
+func (_u *KillSwitchUpdateOne) SetActivatedBy(v uuid.UUID) *KillSwitchUpdateOne {
+	_u.mutation.SetActivatedBy(v)
+	return _u
+}
+
+// SetNillableActivatedBy sets the "activated_by" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableActivatedBy(v *uuid.UUID) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetActivatedBy(*v)
+	}
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *KillSwitchUpdateOne) SetReason(v string) *KillSwitchUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableReason(v *string) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// ClearReason clears the value of the "reason" field.
+func (_u *KillSwitchUpdateOne) ClearReason() *KillSwitchUpdateOne {
+	_u.mutation.ClearReason()
+	return _u
+}
+
+// SetFlatten sets the "flatten" field.
+func (_u *KillSwitchUpdateOne) SetFlatten(v bool) *KillSwitchUpdateOne {
+	_u.mutation.SetFlatten(v)
+	return _u
+}
+
+// SetNillableFlatten sets the "flatten" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableFlatten(v *bool) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetFlatten(*v)
+	}
+	return _u
+}
+
+// SetStoppedStrategies sets the "stopped_strategies" field.
+func (_u *KillSwitchUpdateOne) SetStoppedStrategies(v int) *KillSwitchUpdateOne {
+	_u.mutation.ResetStoppedStrategies()
+	_u.mutation.SetStoppedStrategies(v)
+	return _u
+}
+
+// SetNillableStoppedStrategies sets the "stopped_strategies" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableStoppedStrategies(v *int) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetStoppedStrategies(*v)
+	}
+	return _u
+}
+
+// AddStoppedStrategies adds value to the "stopped_strategies" field.
+func (_u *KillSwitchUpdateOne) AddStoppedStrategies(v int) *KillSwitchUpdateOne {
+	_u.mutation.AddStoppedStrategies(v)
+	return _u
+}
+
+// SetCanceledOrders sets the "canceled_orders" field.
+func (_u *KillSwitchUpdateOne) SetCanceledOrders(v int) *KillSwitchUpdateOne {
+	_u.mutation.ResetCanceledOrders()
+	_u.mutation.SetCanceledOrders(v)
+	return _u
+}
+
+// SetNillableCanceledOrders sets the "canceled_orders" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableCanceledOrders(v *int) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetCanceledOrders(*v)
+	}
+	return _u
+}
+
+// AddCanceledOrders adds value to the "canceled_orders" field.
+func (_u *KillSwitchUpdateOne) AddCanceledOrders(v int) *KillSwitchUpdateOne {
+	_u.mutation.AddCanceledOrders(v)
+	return _u
+}
+
+// SetFlattenOrders sets the "flatten_orders" field.
+func (_u *KillSwitchUpdateOne) SetFlattenOrders(v int) *KillSwitchUpdateOne {
+	_u.mutation.ResetFlattenOrders()
+	_u.mutation.SetFlattenOrders(v)
+	return _u
+}
+
+// SetNillableFlattenOrders sets the "flatten_orders" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableFlattenOrders(v *int) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetFlattenOrders(*v)
+	}
+	return _u
+}
+
+// AddFlattenOrders adds value to the "flatten_orders" field.
+func (_u *KillSwitchUpdateOne) AddFlattenOrders(v int) *KillSwitchUpdateOne {
+	_u.mutation.AddFlattenOrders(v)
+	return _u
+}
+
+// SetFailedCloses sets the "failed_closes" field.
+func (_u *KillSwitchUpdateOne) SetFailedCloses(v int) *KillSwitchUpdateOne {
+	_u.mutation.ResetFailedCloses()
+	_u.mutation.SetFailedCloses(v)
+	return _u
+}
+
+// SetNillableFailedCloses sets the "failed_closes" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableFailedCloses(v *int) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetFailedCloses(*v)
+	}
+	return _u
+}
+
+// AddFailedCloses adds value to the "failed_closes" field.
+func (_u *KillSwitchUpdateOne) AddFailedCloses(v int) *KillSwitchUpdateOne {
+	_u.mutation.AddFailedCloses(v)
+	return _u
+}
+
+// SetErrors sets the "errors" field.
+func (_u *KillSwitchUpdateOne) SetErrors(v []string) *KillSwitchUpdateOne {
+	_u.mutation.SetErrors(v)
+	return _u
+}
+
+// AppendErrors appends value to the "errors" field.
+func (_u *KillSwitchUpdateOne) AppendErrors(v []string) *KillSwitchUpdateOne {
+	_u.mutation.AppendErrors(v)
+	return _u
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (_u *KillSwitchUpdateOne) ClearErrors() *KillSwitchUpdateOne {
+	_u.mutation.ClearErrors()
+	return _u
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (_u *KillSwitchUpdateOne) SetReleasedAt(v time.Time) *KillSwitchUpdateOne {
+	_u.mutation.SetReleasedAt(v)
+	return _u
+}
+
+// SetNillableReleasedAt sets the "released_at" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableReleasedAt(v *time.Time) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetReleasedAt(*v)
+	}
+	return _u
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (_u *KillSwitchUpdateOne) ClearReleasedAt() *KillSwitchUpdateOne {
+	_u.mutation.ClearReleasedAt()
+	return _u
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (_u *KillSwitchUpdateOne) SetReleasedBy(v uuid.UUID) *KillSwitchUpdateOne {
+	_u.mutation.SetReleasedBy(v)
+	return _u
+}
+
+// SetNillableReleasedBy sets the "released_by" field if the given value is not nil.
+func (_u *KillSwitchUpdateOne) SetNillableReleasedBy(v *uuid.UUID) *KillSwitchUpdateOne {
+	if v != nil {
+		_u.SetReleasedBy(*v)
+	}
+	return _u
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (_u *KillSwitchUpdateOne) ClearReleasedBy() *KillSwitchUpdateOne {
+	_u.mutation.ClearReleasedBy()
+	return _u
+}
+
+// Mutation returns the KillSwitchMutation object of the builder.
+func (_u *KillSwitchUpdateOne) Mutation() *KillSwitchMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the KillSwitchUpdate builder.
+func (_u *KillSwitchUpdateOne) Where(ps ...predicate.KillSwitch) *KillSwitchUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *KillSwitchUpdateOne) Select(field string, fields ...string) *KillSwitchUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated KillSwitch entity.
+func (_u *KillSwitchUpdateOne) Save(ctx context.Context) (*KillSwitch, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *KillSwitchUpdateOne) SaveX(ctx context.Context) *KillSwitch {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *KillSwitchUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *KillSwitchUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (_u *KillSwitchUpdateOne) sqlSave(ctx context.Context) (_node *KillSwitch, err error) {
+	_spec := sqlgraph.NewUpdateSpec(killswitch.Table, killswitch.Columns, sqlgraph.NewFieldSpec(killswitch.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "KillSwitch.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, killswitch.FieldID)
+		for _, f := range fields {
+			if !killswitch.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != killswitch.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(killswitch.FieldUserID, field.TypeUUID, value)
+	}
+	if _u.mutation.UserIDCleared() {
+		_spec.ClearField(killswitch.FieldUserID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ActivatedBy(); ok {
+		_spec.SetField(killswitch.FieldActivatedBy, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(killswitch.FieldReason, field.TypeString, value)
+	}
+	if _u.mutation.ReasonCleared() {
+		_spec.ClearField(killswitch.FieldReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.Flatten(); ok {
+		_spec.SetField(killswitch.FieldFlatten, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.StoppedStrategies(); ok {
+		_spec.SetField(killswitch.FieldStoppedStrategies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedStoppedStrategies(); ok {
+		_spec.AddField(killswitch.FieldStoppedStrategies, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.CanceledOrders(); ok {
+		_spec.SetField(killswitch.FieldCanceledOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedCanceledOrders(); ok {
+		_spec.AddField(killswitch.FieldCanceledOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FlattenOrders(); ok {
+		_spec.SetField(killswitch.FieldFlattenOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFlattenOrders(); ok {
+		_spec.AddField(killswitch.FieldFlattenOrders, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.FailedCloses(); ok {
+		_spec.SetField(killswitch.FieldFailedCloses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedFailedCloses(); ok {
+		_spec.AddField(killswitch.FieldFailedCloses, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Errors(); ok {
+		_spec.SetField(killswitch.FieldErrors, field.TypeJSON, value)
+	}
+	if value, ok := _u.mutation.AppendedErrors(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, killswitch.FieldErrors, value)
+		})
+	}
+	if _u.mutation.ErrorsCleared() {
+		_spec.ClearField(killswitch.FieldErrors, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.ReleasedAt(); ok {
+		_spec.SetField(killswitch.FieldReleasedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ReleasedAtCleared() {
+		_spec.ClearField(killswitch.FieldReleasedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.ReleasedBy(); ok {
+		_spec.SetField(killswitch.FieldReleasedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.ReleasedByCleared() {
+		_spec.ClearField(killswitch.FieldReleasedBy, field.TypeUUID)
+	}
+	_node = &KillSwitch{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{killswitch.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -8,10 +8,309 @@ import (
 )
 
 var (
+	// BacktestResultsColumns holds the columns for the "backtest_results" table.
+	BacktestResultsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "symbols", Type: field.TypeJSON},
+		{Name: "interval", Type: field.TypeString, Size: 8},
+		{Name: "period_start", Type: field.TypeTime},
+		{Name: "period_end", Type: field.TypeTime},
+		{Name: "parameters", Type: field.TypeJSON},
+		{Name: "settings", Type: field.TypeJSON},
+		{Name: "initial_capital", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "final_equity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "total_return", Type: field.TypeFloat64, Default: 0},
+		{Name: "max_drawdown", Type: field.TypeFloat64, Default: 0},
+		{Name: "sharpe_ratio", Type: field.TypeFloat64, Default: 0},
+		{Name: "win_rate", Type: field.TypeFloat64, Default: 0},
+		{Name: "trade_count", Type: field.TypeInt, Default: 0},
+		{Name: "rejected_orders", Type: field.TypeInt, Default: 0},
+		{Name: "equity_curve", Type: field.TypeJSON, Nullable: true},
+		{Name: "trades", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "strategy_id", Type: field.TypeUUID},
+	}
+	// BacktestResultsTable holds the schema information for the "backtest_results" table.
+	BacktestResultsTable = &schema.Table{
+		Name:       "backtest_results",
+		Columns:    BacktestResultsColumns,
+		PrimaryKey: []*schema.Column{BacktestResultsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "backtest_results_strategies_backtests",
+				Columns:    []*schema.Column{BacktestResultsColumns[19]},
+				RefColumns: []*schema.Column{StrategiesColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "backtestresult_strategy_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{BacktestResultsColumns[19], BacktestResultsColumns[18]},
+			},
+			{
+				Name:    "backtestresult_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{BacktestResultsColumns[1]},
+			},
+		},
+	}
+	// BrokerAccountsColumns holds the columns for the "broker_accounts" table.
+	BrokerAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+		{Name: "broker", Type: field.TypeEnum, Enums: []string{"kis"}, Default: "kis"},
+		{Name: "account_no", Type: field.TypeString, Size: 8},
+		{Name: "product_code", Type: field.TypeString, Size: 2, Default: "01"},
+		{Name: "is_demo", Type: field.TypeBool, Default: false},
+		{Name: "app_key_cipher", Type: field.TypeBytes},
+		{Name: "app_secret_cipher", Type: field.TypeBytes},
+		{Name: "data_key", Type: field.TypeBytes},
+		{Name: "key_id", Type: field.TypeString, Size: 32},
+		{Name: "app_key_hint", Type: field.TypeString, Size: 8, Default: ""},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// BrokerAccountsTable holds the schema information for the "broker_accounts" table.
+	BrokerAccountsTable = &schema.Table{
+		Name:       "broker_accounts",
+		Columns:    BrokerAccountsColumns,
+		PrimaryKey: []*schema.Column{BrokerAccountsColumns[0]},
+	}
+	// CandlesColumns holds the columns for the "candles" table.
+	CandlesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Size: 10},
+		{Name: "interval", Type: field.TypeString, Size: 8},
+		{Name: "timestamp", Type: field.TypeTime},
+		{Name: "open", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "high", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "low", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "close", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "volume", Type: field.TypeInt64, Default: 0},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// CandlesTable holds the schema information for the "candles" table.
+	CandlesTable = &schema.Table{
+		Name:       "candles",
+		Columns:    CandlesColumns,
+		PrimaryKey: []*schema.Column{CandlesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "candle_symbol_exchange_interval_timestamp",
+				Unique:  true,
+				Columns: []*schema.Column{CandlesColumns[1], CandlesColumns[2], CandlesColumns[3], CandlesColumns[4]},
+			},
+			{
+				Name:    "candle_symbol_interval_timestamp",
+				Unique:  false,
+				Columns: []*schema.Column{CandlesColumns[1], CandlesColumns[3], CandlesColumns[4]},
+			},
+		},
+	}
+	// KillSwitchesColumns holds the columns for the "kill_switches" table.
+	KillSwitchesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "activated_by", Type: field.TypeUUID},
+		{Name: "reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "flatten", Type: field.TypeBool, Default: false},
+		{Name: "stopped_strategies", Type: field.TypeInt, Default: 0},
+		{Name: "canceled_orders", Type: field.TypeInt, Default: 0},
+		{Name: "flatten_orders", Type: field.TypeInt, Default: 0},
+		{Name: "failed_closes", Type: field.TypeInt, Default: 0},
+		{Name: "errors", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "released_at", Type: field.TypeTime, Nullable: true},
+		{Name: "released_by", Type: field.TypeUUID, Nullable: true},
+	}
+	// KillSwitchesTable holds the schema information for the "kill_switches" table.
+	KillSwitchesTable = &schema.Table{
+		Name:       "kill_switches",
+		Columns:    KillSwitchesColumns,
+		PrimaryKey: []*schema.Column{KillSwitchesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "killswitch_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{KillSwitchesColumns[1], KillSwitchesColumns[10]},
+			},
+			{
+				Name:    "killswitch_released_at",
+				Unique:  false,
+				Columns: []*schema.Column{KillSwitchesColumns[11]},
+			},
+		},
+	}
+	// OrdersColumns holds the columns for the "orders" table.
+	OrdersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "client_order_id", Type: field.TypeString, Unique: true, Size: 64},
+		{Name: "broker_order_id", Type: field.TypeString, Nullable: true, Size: 32},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "mode", Type: field.TypeEnum, Enums: []string{"LIVE", "PAPER"}, Default: "LIVE"},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"BUY", "SELL"}},
+		{Name: "order_type", Type: field.TypeEnum, Enums: []string{"MARKET", "LIMIT", "MOO", "LOO", "MOC", "LOC"}},
+		{Name: "time_in_force", Type: field.TypeEnum, Enums: []string{"DAY", "IOC"}, Default: "DAY"},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "filled_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "avg_fill_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"NEW", "SUBMITTED", "PARTIALLY_FILLED", "FILLED", "CANCELED", "REJECTED"}, Default: "NEW"},
+		{Name: "reject_reason", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "submitted_at", Type: field.TypeTime, Nullable: true},
+		{Name: "completed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "strategy_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "user_id", Type: field.TypeUUID},
+	}
+	// OrdersTable holds the schema information for the "orders" table.
+	OrdersTable = &schema.Table{
+		Name:       "orders",
+		Columns:    OrdersColumns,
+		PrimaryKey: []*schema.Column{OrdersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "orders_strategies_orders",
+				Columns:    []*schema.Column{OrdersColumns[19]},
+				RefColumns: []*schema.Column{StrategiesColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
+			{
+				Symbol:     "orders_users_orders",
+				Columns:    []*schema.Column{OrdersColumns[20]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "order_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[20]},
+			},
+			{
+				Name:    "order_strategy_id",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[19]},
+			},
+			{
+				Name:    "order_symbol",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[3]},
+			},
+			{
+				Name:    "order_status",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[13]},
+			},
+			{
+				Name:    "order_mode",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[5]},
+			},
+			{
+				Name:    "order_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{OrdersColumns[17]},
+			},
+		},
+	}
+	// PaperAccountsColumns holds the columns for the "paper_accounts" table.
+	PaperAccountsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID, Unique: true},
+		{Name: "initial_cash", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "cash", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "created_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+	}
+	// PaperAccountsTable holds the schema information for the "paper_accounts" table.
+	PaperAccountsTable = &schema.Table{
+		Name:       "paper_accounts",
+		Columns:    PaperAccountsColumns,
+		PrimaryKey: []*schema.Column{PaperAccountsColumns[0]},
+	}
+	// PaperPositionsColumns holds the columns for the "paper_positions" table.
+	PaperPositionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "avg_cost", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// PaperPositionsTable holds the schema information for the "paper_positions" table.
+	PaperPositionsTable = &schema.Table{
+		Name:       "paper_positions",
+		Columns:    PaperPositionsColumns,
+		PrimaryKey: []*schema.Column{PaperPositionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paper_positions_paper_accounts_positions",
+				Columns:    []*schema.Column{PaperPositionsColumns[5]},
+				RefColumns: []*schema.Column{PaperAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "paperposition_account_id_symbol",
+				Unique:  true,
+				Columns: []*schema.Column{PaperPositionsColumns[5], PaperPositionsColumns[1]},
+			},
+		},
+	}
+	// PaperTradesColumns holds the columns for the "paper_trades" table.
+	PaperTradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "strategy_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "client_order_id", Type: field.TypeString, Size: 64},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"BUY", "SELL"}},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "commission", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "realized_pnl", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "executed_at", Type: field.TypeTime},
+		{Name: "account_id", Type: field.TypeUUID},
+	}
+	// PaperTradesTable holds the schema information for the "paper_trades" table.
+	PaperTradesTable = &schema.Table{
+		Name:       "paper_trades",
+		Columns:    PaperTradesColumns,
+		PrimaryKey: []*schema.Column{PaperTradesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "paper_trades_paper_accounts_trades",
+				Columns:    []*schema.Column{PaperTradesColumns[10]},
+				RefColumns: []*schema.Column{PaperAccountsColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "papertrade_account_id_executed_at",
+				Unique:  false,
+				Columns: []*schema.Column{PaperTradesColumns[10], PaperTradesColumns[9]},
+			},
+			{
+				Name:    "papertrade_client_order_id",
+				Unique:  false,
+				Columns: []*schema.Column{PaperTradesColumns[2]},
+			},
+		},
+	}
 	// PortfoliosColumns holds the columns for the "portfolios" table.
 	PortfoliosColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
-		{Name: "symbol", Type: field.TypeString, Size: 10},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Nullable: true, Size: 10},
 		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
 		{Name: "average_price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
 		{Name: "current_price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
@@ -32,7 +331,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "portfolios_users_portfolios",
-				Columns:    []*schema.Column{PortfoliosColumns[12]},
+				Columns:    []*schema.Column{PortfoliosColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -41,7 +340,7 @@ var (
 			{
 				Name:    "portfolio_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{PortfoliosColumns[12]},
+				Columns: []*schema.Column{PortfoliosColumns[13]},
 			},
 			{
 				Name:    "portfolio_symbol",
@@ -51,7 +350,116 @@ var (
 			{
 				Name:    "portfolio_user_id_symbol",
 				Unique:  true,
-				Columns: []*schema.Column{PortfoliosColumns[12], PortfoliosColumns[1]},
+				Columns: []*schema.Column{PortfoliosColumns[13], PortfoliosColumns[1]},
+			},
+		},
+	}
+	// ProtectionRulesColumns holds the columns for the "protection_rules" table.
+	ProtectionRulesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeEnum, Enums: []string{"LIVE", "PAPER"}},
+		{Name: "strategy_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "stop_loss", Type: field.TypeFloat64, Default: 0},
+		{Name: "take_profit", Type: field.TypeFloat64, Default: 0},
+		{Name: "trailing_percent", Type: field.TypeFloat64, Default: 0},
+		{Name: "trailing_atr", Type: field.TypeFloat64, Default: 0},
+		{Name: "atr_period", Type: field.TypeInt, Default: 14},
+		{Name: "max_hold_minutes", Type: field.TypeInt, Default: 0},
+		{Name: "active", Type: field.TypeBool, Default: true},
+		{Name: "water_mark", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "opened_at", Type: field.TypeTime, Nullable: true},
+		{Name: "triggered_at", Type: field.TypeTime, Nullable: true},
+		{Name: "trigger_reason", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "trigger_price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "exit_client_order_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// ProtectionRulesTable holds the schema information for the "protection_rules" table.
+	ProtectionRulesTable = &schema.Table{
+		Name:       "protection_rules",
+		Columns:    ProtectionRulesColumns,
+		PrimaryKey: []*schema.Column{ProtectionRulesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "protectionrule_user_id_account_symbol",
+				Unique:  false,
+				Columns: []*schema.Column{ProtectionRulesColumns[1], ProtectionRulesColumns[2], ProtectionRulesColumns[4]},
+			},
+			{
+				Name:    "protectionrule_strategy_id",
+				Unique:  false,
+				Columns: []*schema.Column{ProtectionRulesColumns[3]},
+			},
+			{
+				Name:    "protectionrule_active",
+				Unique:  false,
+				Columns: []*schema.Column{ProtectionRulesColumns[12]},
+			},
+		},
+	}
+	// RiskEventsColumns holds the columns for the "risk_events" table.
+	RiskEventsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeEnum, Enums: []string{"LIVE", "PAPER"}},
+		{Name: "type", Type: field.TypeEnum, Enums: []string{"ORDER_REJECTED", "DRAWDOWN_HALT"}},
+		{Name: "rule", Type: field.TypeString, Nullable: true, Size: 50},
+		{Name: "reason", Type: field.TypeString, Size: 2147483647},
+		{Name: "client_order_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "strategy_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "symbol", Type: field.TypeString, Nullable: true, Size: 20},
+		{Name: "side", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "details", Type: field.TypeJSON, Nullable: true},
+		{Name: "acknowledged_at", Type: field.TypeTime, Nullable: true},
+		{Name: "acknowledged_by", Type: field.TypeUUID, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// RiskEventsTable holds the schema information for the "risk_events" table.
+	RiskEventsTable = &schema.Table{
+		Name:       "risk_events",
+		Columns:    RiskEventsColumns,
+		PrimaryKey: []*schema.Column{RiskEventsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "riskevent_user_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RiskEventsColumns[1], RiskEventsColumns[15]},
+			},
+			{
+				Name:    "riskevent_user_id_type_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{RiskEventsColumns[1], RiskEventsColumns[3], RiskEventsColumns[15]},
+			},
+		},
+	}
+	// RiskStatesColumns holds the columns for the "risk_states" table.
+	RiskStatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "account", Type: field.TypeEnum, Enums: []string{"LIVE", "PAPER"}},
+		{Name: "trading_day", Type: field.TypeString, Size: 10},
+		{Name: "daily_realized_pnl", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "open_exposure", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "equity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "peak_equity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// RiskStatesTable holds the schema information for the "risk_states" table.
+	RiskStatesTable = &schema.Table{
+		Name:       "risk_states",
+		Columns:    RiskStatesColumns,
+		PrimaryKey: []*schema.Column{RiskStatesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "riskstate_user_id_account",
+				Unique:  true,
+				Columns: []*schema.Column{RiskStatesColumns[1], RiskStatesColumns[2]},
 			},
 		},
 	}
@@ -61,10 +469,12 @@ var (
 		{Name: "strategy_id", Type: field.TypeString, Size: 50},
 		{Name: "name", Type: field.TypeString, Size: 100},
 		{Name: "description", Type: field.TypeString, Nullable: true, Size: 2147483647},
-		{Name: "symbol", Type: field.TypeString, Size: 10},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Nullable: true, Size: 10},
 		{Name: "user_inputs", Type: field.TypeJSON},
 		{Name: "settings", Type: field.TypeJSON},
 		{Name: "active", Type: field.TypeBool, Default: false},
+		{Name: "trading_mode", Type: field.TypeEnum, Enums: []string{"LIVE", "PAPER"}, Default: "LIVE"},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 		{Name: "template_id", Type: field.TypeUUID, Nullable: true},
@@ -78,13 +488,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "strategies_strategy_templates_strategies",
-				Columns:    []*schema.Column{StrategiesColumns[10]},
+				Columns:    []*schema.Column{StrategiesColumns[12]},
 				RefColumns: []*schema.Column{StrategyTemplatesColumns[0]},
 				OnDelete:   schema.SetNull,
 			},
 			{
 				Symbol:     "strategies_users_strategies",
-				Columns:    []*schema.Column{StrategiesColumns[11]},
+				Columns:    []*schema.Column{StrategiesColumns[13]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -93,7 +503,7 @@ var (
 			{
 				Name:    "strategy_user_id",
 				Unique:  false,
-				Columns: []*schema.Column{StrategiesColumns[11]},
+				Columns: []*schema.Column{StrategiesColumns[13]},
 			},
 			{
 				Name:    "strategy_symbol",
@@ -103,24 +513,24 @@ var (
 			{
 				Name:    "strategy_template_id",
 				Unique:  false,
-				Columns: []*schema.Column{StrategiesColumns[10]},
+				Columns: []*schema.Column{StrategiesColumns[12]},
 			},
 			{
 				Name:    "strategy_active",
 				Unique:  false,
-				Columns: []*schema.Column{StrategiesColumns[7]},
+				Columns: []*schema.Column{StrategiesColumns[8]},
 			},
 			{
 				Name:    "strategy_user_id_strategy_id",
 				Unique:  true,
-				Columns: []*schema.Column{StrategiesColumns[11], StrategiesColumns[1]},
+				Columns: []*schema.Column{StrategiesColumns[13], StrategiesColumns[1]},
 			},
 		},
 	}
 	// StrategyExecutionsColumns holds the columns for the "strategy_executions" table.
 	StrategyExecutionsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeInt64, Increment: true},
-		{Name: "symbol", Type: field.TypeString, Size: 10},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
 		{Name: "action", Type: field.TypeEnum, Enums: []string{"BUY", "SELL", "HOLD"}},
 		{Name: "price", Type: field.TypeOther, Nullable: true, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
 		{Name: "quantity", Type: field.TypeInt, Nullable: true},
@@ -169,6 +579,13 @@ var (
 		{Name: "last_trade_time", Type: field.TypeTime, Nullable: true},
 		{Name: "max_drawdown", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(8,4)"}},
 		{Name: "sharpe_ratio", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(8,4)"}},
+		{Name: "closed_trades", Type: field.TypeInt64, Default: 0},
+		{Name: "winning_trades", Type: field.TypeInt64, Default: 0},
+		{Name: "return_sum", Type: field.TypeFloat64, Default: 0},
+		{Name: "return_sq_sum", Type: field.TypeFloat64, Default: 0},
+		{Name: "equity_index", Type: field.TypeFloat64, Default: 1},
+		{Name: "peak_equity_index", Type: field.TypeFloat64, Default: 1},
+		{Name: "open_positions", Type: field.TypeJSON, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 		{Name: "strategy_id", Type: field.TypeUUID, Unique: true},
@@ -181,7 +598,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "strategy_performances_strategies_performance",
-				Columns:    []*schema.Column{StrategyPerformancesColumns[10]},
+				Columns:    []*schema.Column{StrategyPerformancesColumns[17]},
 				RefColumns: []*schema.Column{StrategiesColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -231,6 +648,126 @@ var (
 		Columns:    StrategyTemplatesColumns,
 		PrimaryKey: []*schema.Column{StrategyTemplatesColumns[0]},
 	}
+	// SymbolsColumns holds the columns for the "symbols" table.
+	SymbolsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "ticker", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeEnum, Enums: []string{"NASD", "NYSE", "AMEX"}},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
+		{Name: "name", Type: field.TypeString, Size: 200, Default: ""},
+		{Name: "security_type", Type: field.TypeEnum, Enums: []string{"STOCK", "ETF", "INDEX", "WARRANT", "OTHER"}, Default: "STOCK"},
+		{Name: "lot_size", Type: field.TypeInt, Default: 1},
+		{Name: "tick_size", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,6)"}},
+		{Name: "tradable", Type: field.TypeBool, Default: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// SymbolsTable holds the schema information for the "symbols" table.
+	SymbolsTable = &schema.Table{
+		Name:       "symbols",
+		Columns:    SymbolsColumns,
+		PrimaryKey: []*schema.Column{SymbolsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "symbol_ticker_exchange",
+				Unique:  true,
+				Columns: []*schema.Column{SymbolsColumns[1], SymbolsColumns[2]},
+			},
+			{
+				Name:    "symbol_ticker",
+				Unique:  false,
+				Columns: []*schema.Column{SymbolsColumns[1]},
+			},
+		},
+	}
+	// TaxLotsColumns holds the columns for the "tax_lots" table.
+	TaxLotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "trade_id", Type: field.TypeUUID},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "remaining_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "cost_per_share", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(16,6)"}},
+		{Name: "fx_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "opened_at", Type: field.TypeTime},
+		{Name: "closed_at", Type: field.TypeTime, Nullable: true},
+		{Name: "updated_at", Type: field.TypeTime},
+	}
+	// TaxLotsTable holds the schema information for the "tax_lots" table.
+	TaxLotsTable = &schema.Table{
+		Name:       "tax_lots",
+		Columns:    TaxLotsColumns,
+		PrimaryKey: []*schema.Column{TaxLotsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "taxlot_user_id_symbol_opened_at",
+				Unique:  false,
+				Columns: []*schema.Column{TaxLotsColumns[1], TaxLotsColumns[3], TaxLotsColumns[8]},
+			},
+			{
+				Name:    "taxlot_trade_id",
+				Unique:  false,
+				Columns: []*schema.Column{TaxLotsColumns[2]},
+			},
+		},
+	}
+	// TradesColumns holds the columns for the "trades" table.
+	TradesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "user_id", Type: field.TypeUUID},
+		{Name: "client_order_id", Type: field.TypeString, Size: 64},
+		{Name: "broker_order_id", Type: field.TypeString, Nullable: true, Size: 64},
+		{Name: "symbol", Type: field.TypeString, Size: 20},
+		{Name: "exchange", Type: field.TypeString, Nullable: true, Size: 10},
+		{Name: "side", Type: field.TypeEnum, Enums: []string{"BUY", "SELL"}},
+		{Name: "quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "price", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "fee", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "currency", Type: field.TypeString, Size: 3, Default: "USD"},
+		{Name: "fx_rate", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(12,4)"}},
+		{Name: "filled_quantity", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "proceeds", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "cost_basis_fifo", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "cost_basis_fifo_krw", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(18,2)"}},
+		{Name: "cost_basis_avg", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "cost_basis_avg_krw", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(18,2)"}},
+		{Name: "estimated_basis", Type: field.TypeBool, Default: false},
+		{Name: "position_after", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(15,4)"}},
+		{Name: "avg_cost_after", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(16,6)"}},
+		{Name: "avg_cost_krw_after", Type: field.TypeOther, SchemaType: map[string]string{"postgres": "numeric(18,4)"}},
+		{Name: "fx_pending", Type: field.TypeBool, Default: false},
+		{Name: "executed_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// TradesTable holds the schema information for the "trades" table.
+	TradesTable = &schema.Table{
+		Name:       "trades",
+		Columns:    TradesColumns,
+		PrimaryKey: []*schema.Column{TradesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "trade_user_id_executed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[1], TradesColumns[23]},
+			},
+			{
+				Name:    "trade_user_id_symbol_executed_at",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[1], TradesColumns[4], TradesColumns[23]},
+			},
+			{
+				Name:    "trade_client_order_id_filled_quantity",
+				Unique:  true,
+				Columns: []*schema.Column{TradesColumns[2], TradesColumns[12]},
+			},
+			{
+				Name:    "trade_fx_pending",
+				Unique:  false,
+				Columns: []*schema.Column{TradesColumns[22]},
+			},
+		},
+	}
 	// UsersColumns holds the columns for the "users" table.
 	UsersColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -239,6 +776,7 @@ var (
 		{Name: "email", Type: field.TypeString, Unique: true, Size: 255},
 		{Name: "password", Type: field.TypeString, Size: 255, Default: ""},
 		{Name: "is_valid", Type: field.TypeBool, Default: true},
+		{Name: "is_admin", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime, Nullable: true},
 		{Name: "updated_at", Type: field.TypeTime, Nullable: true},
 	}
@@ -267,17 +805,36 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		BacktestResultsTable,
+		BrokerAccountsTable,
+		CandlesTable,
+		KillSwitchesTable,
+		OrdersTable,
+		PaperAccountsTable,
+		PaperPositionsTable,
+		PaperTradesTable,
 		PortfoliosTable,
+		ProtectionRulesTable,
+		RiskEventsTable,
+		RiskStatesTable,
 		StrategiesTable,
 		StrategyExecutionsTable,
 		StrategyPerformancesTable,
 		StrategyStatusTable,
 		StrategyTemplatesTable,
+		SymbolsTable,
+		TaxLotsTable,
+		TradesTable,
 		UsersTable,
 	}
 )
 
 func init() {
+	BacktestResultsTable.ForeignKeys[0].RefTable = StrategiesTable
+	OrdersTable.ForeignKeys[0].RefTable = StrategiesTable
+	OrdersTable.ForeignKeys[1].RefTable = UsersTable
+	PaperPositionsTable.ForeignKeys[0].RefTable = PaperAccountsTable
+	PaperTradesTable.ForeignKeys[0].RefTable = PaperAccountsTable
 	PortfoliosTable.ForeignKeys[0].RefTable = UsersTable
 	StrategiesTable.ForeignKeys[0].RefTable = StrategyTemplatesTable
 	StrategiesTable.ForeignKeys[1].RefTable = UsersTable
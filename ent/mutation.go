@@ -3,15 +3,31 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/brokeraccount"
+	"auto-trader/ent/candle"
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/order"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
 	"auto-trader/ent/portfolio"
 	"auto-trader/ent/predicate"
+	"auto-trader/ent/protectionrule"
+	"auto-trader/ent/riskevent"
+	"auto-trader/ent/riskstate"
+	"auto-trader/ent/schema"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
 	"auto-trader/ent/strategyperformance"
 	"auto-trader/ent/strategystatus"
 	"auto-trader/ent/strategytemplate"
+	"auto-trader/ent/symbol"
+	"auto-trader/ent/taxlot"
+	"auto-trader/ent/trade"
 	"auto-trader/ent/user"
 	"context"
+	"encoding/json/jsontext"
 	"errors"
 	"fmt"
 	"sync"
@@ -32,51 +48,81 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
+	TypeBacktestResult      = "BacktestResult"
+	TypeBrokerAccount       = "BrokerAccount"
+	TypeCandle              = "Candle"
+	TypeKillSwitch          = "KillSwitch"
+	TypeOrder               = "Order"
+	TypePaperAccount        = "PaperAccount"
+	TypePaperPosition       = "PaperPosition"
+	TypePaperTrade          = "PaperTrade"
 	TypePortfolio           = "Portfolio"
+	TypeProtectionRule      = "ProtectionRule"
+	TypeRiskEvent           = "RiskEvent"
+	TypeRiskState           = "RiskState"
 	TypeStrategy            = "Strategy"
 	TypeStrategyExecution   = "StrategyExecution"
 	TypeStrategyPerformance = "StrategyPerformance"
 	TypeStrategyStatus      = "StrategyStatus"
 	TypeStrategyTemplate    = "StrategyTemplate"
+	TypeSymbol              = "Symbol"
+	TypeTaxLot              = "TaxLot"
+	TypeTrade               = "Trade"
 	TypeUser                = "User"
 )
 
-// PortfolioMutation represents an operation that mutates the Portfolio nodes in the graph.
-type PortfolioMutation struct {
+// BacktestResultMutation represents an operation that mutates the BacktestResult nodes in the graph.
+type BacktestResultMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *uuid.UUID
-	symbol         *string
-	quantity       *decimal.Decimal
-	average_price  *decimal.Decimal
-	current_price  *decimal.Decimal
-	market_value   *decimal.Decimal
-	total_cost     *decimal.Decimal
-	unrealized_pnl *decimal.Decimal
-	realized_pnl   *decimal.Decimal
-	last_updated   *time.Time
-	created_at     *time.Time
-	updated_at     *time.Time
-	clearedFields  map[string]struct{}
-	user           *uuid.UUID
-	cleareduser    bool
-	done           bool
-	oldValue       func(context.Context) (*Portfolio, error)
-	predicates     []predicate.Portfolio
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	symbols            *[]string
+	appendsymbols      []string
+	interval           *string
+	period_start       *time.Time
+	period_end         *time.Time
+	parameters         *map[string]interface{}
+	settings           *map[string]interface{}
+	initial_capital    *decimal.Decimal
+	final_equity       *decimal.Decimal
+	total_return       *float64
+	addtotal_return    *float64
+	max_drawdown       *float64
+	addmax_drawdown    *float64
+	sharpe_ratio       *float64
+	addsharpe_ratio    *float64
+	win_rate           *float64
+	addwin_rate        *float64
+	trade_count        *int
+	addtrade_count     *int
+	rejected_orders    *int
+	addrejected_orders *int
+	equity_curve       *jsontext.Value
+	appendequity_curve jsontext.Value
+	trades             *jsontext.Value
+	appendtrades       jsontext.Value
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	strategy           *uuid.UUID
+	clearedstrategy    bool
+	done               bool
+	oldValue           func(context.Context) (*BacktestResult, error)
+	predicates         []predicate.BacktestResult
 }
 
-var _ ent.Mutation = (*PortfolioMutation)(nil)
+var _ ent.Mutation = (*BacktestResultMutation)(nil)
 
-// portfolioOption allows management of the mutation configuration using functional options.
-type portfolioOption func(*PortfolioMutation)
+// backtestresultOption allows management of the mutation configuration using functional options.
+type backtestresultOption func(*BacktestResultMutation)
 
-// newPortfolioMutation creates new mutation for the Portfolio entity.
-func newPortfolioMutation(c config, op Op, opts ...portfolioOption) *PortfolioMutation {
-	m := &PortfolioMutation{
+// newBacktestResultMutation creates new mutation for the BacktestResult entity.
+func newBacktestResultMutation(c config, op Op, opts ...backtestresultOption) *BacktestResultMutation {
+	m := &BacktestResultMutation{
 		config:        c,
 		op:            op,
-		typ:           TypePortfolio,
+		typ:           TypeBacktestResult,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -85,20 +131,20 @@ func newPortfolioMutation(c config, op Op, opts ...portfolioOption) *PortfolioMu
 	return m
 }
 
-// withPortfolioID sets the ID field of the mutation.
-func withPortfolioID(id uuid.UUID) portfolioOption {
-	return func(m *PortfolioMutation) {
+// withBacktestResultID sets the ID field of the mutation.
+func withBacktestResultID(id uuid.UUID) backtestresultOption {
+	return func(m *BacktestResultMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Portfolio
+			value *BacktestResult
 		)
-		m.oldValue = func(ctx context.Context) (*Portfolio, error) {
+		m.oldValue = func(ctx context.Context) (*BacktestResult, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Portfolio.Get(ctx, id)
+					value, err = m.Client().BacktestResult.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -107,10 +153,10 @@ func withPortfolioID(id uuid.UUID) portfolioOption {
 	}
 }
 
-// withPortfolio sets the old Portfolio of the mutation.
-func withPortfolio(node *Portfolio) portfolioOption {
-	return func(m *PortfolioMutation) {
-		m.oldValue = func(context.Context) (*Portfolio, error) {
+// withBacktestResult sets the old BacktestResult of the mutation.
+func withBacktestResult(node *BacktestResult) backtestresultOption {
+	return func(m *BacktestResultMutation) {
+		m.oldValue = func(context.Context) (*BacktestResult, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -119,7 +165,7 @@ func withPortfolio(node *Portfolio) portfolioOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m PortfolioMutation) Client() *Client {
+func (m BacktestResultMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -127,7 +173,7 @@ func (m PortfolioMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m PortfolioMutation) Tx() (*Tx, error) {
+func (m BacktestResultMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -137,14 +183,14 @@ func (m PortfolioMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Portfolio entities.
-func (m *PortfolioMutation) SetID(id uuid.UUID) {
+// operation is only accepted on creation of BacktestResult entities.
+func (m *BacktestResultMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *PortfolioMutation) ID() (id uuid.UUID, exists bool) {
+func (m *BacktestResultMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -155,7 +201,7 @@ func (m *PortfolioMutation) ID() (id uuid.UUID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *PortfolioMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+func (m *BacktestResultMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
@@ -164,1702 +210,17631 @@ func (m *PortfolioMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Portfolio.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().BacktestResult.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetUserID sets the "user_id" field.
-func (m *PortfolioMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// SetStrategyID sets the "strategy_id" field.
+func (m *BacktestResultMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy = &u
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *PortfolioMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *BacktestResultMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUserID returns the old "user_id" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldStrategyID returns the old "strategy_id" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *BacktestResultMutation) OldStrategyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
 	}
-	return oldValue.UserID, nil
+	return oldValue.StrategyID, nil
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *PortfolioMutation) ResetUserID() {
-	m.user = nil
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *BacktestResultMutation) ResetStrategyID() {
+	m.strategy = nil
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *PortfolioMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetUserID sets the "user_id" field.
+func (m *BacktestResultMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *PortfolioMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BacktestResultMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *BacktestResultMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.UserID, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *PortfolioMutation) ResetSymbol() {
-	m.symbol = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BacktestResultMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *PortfolioMutation) SetQuantity(d decimal.Decimal) {
-	m.quantity = &d
+// SetSymbols sets the "symbols" field.
+func (m *BacktestResultMutation) SetSymbols(s []string) {
+	m.symbols = &s
+	m.appendsymbols = nil
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *PortfolioMutation) Quantity() (r decimal.Decimal, exists bool) {
-	v := m.quantity
+// Symbols returns the value of the "symbols" field in the mutation.
+func (m *BacktestResultMutation) Symbols() (r []string, exists bool) {
+	v := m.symbols
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldSymbols returns the old "symbols" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldSymbols(ctx context.Context) (v []string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldSymbols is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldSymbols requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+		return v, fmt.Errorf("querying old value for OldSymbols: %w", err)
 	}
-	return oldValue.Quantity, nil
+	return oldValue.Symbols, nil
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *PortfolioMutation) ResetQuantity() {
-	m.quantity = nil
+// AppendSymbols adds s to the "symbols" field.
+func (m *BacktestResultMutation) AppendSymbols(s []string) {
+	m.appendsymbols = append(m.appendsymbols, s...)
 }
 
-// SetAveragePrice sets the "average_price" field.
-func (m *PortfolioMutation) SetAveragePrice(d decimal.Decimal) {
-	m.average_price = &d
+// AppendedSymbols returns the list of values that were appended to the "symbols" field in this mutation.
+func (m *BacktestResultMutation) AppendedSymbols() ([]string, bool) {
+	if len(m.appendsymbols) == 0 {
+		return nil, false
+	}
+	return m.appendsymbols, true
 }
 
-// AveragePrice returns the value of the "average_price" field in the mutation.
-func (m *PortfolioMutation) AveragePrice() (r decimal.Decimal, exists bool) {
-	v := m.average_price
+// ResetSymbols resets all changes to the "symbols" field.
+func (m *BacktestResultMutation) ResetSymbols() {
+	m.symbols = nil
+	m.appendsymbols = nil
+}
+
+// SetInterval sets the "interval" field.
+func (m *BacktestResultMutation) SetInterval(s string) {
+	m.interval = &s
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *BacktestResultMutation) Interval() (r string, exists bool) {
+	v := m.interval
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAveragePrice returns the old "average_price" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldInterval returns the old "interval" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldAveragePrice(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldInterval(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAveragePrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAveragePrice requires an ID field in the mutation")
+		return v, errors.New("OldInterval requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAveragePrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
 	}
-	return oldValue.AveragePrice, nil
+	return oldValue.Interval, nil
 }
 
-// ResetAveragePrice resets all changes to the "average_price" field.
-func (m *PortfolioMutation) ResetAveragePrice() {
-	m.average_price = nil
+// ResetInterval resets all changes to the "interval" field.
+func (m *BacktestResultMutation) ResetInterval() {
+	m.interval = nil
 }
 
-// SetCurrentPrice sets the "current_price" field.
-func (m *PortfolioMutation) SetCurrentPrice(d decimal.Decimal) {
-	m.current_price = &d
+// SetPeriodStart sets the "period_start" field.
+func (m *BacktestResultMutation) SetPeriodStart(t time.Time) {
+	m.period_start = &t
 }
 
-// CurrentPrice returns the value of the "current_price" field in the mutation.
-func (m *PortfolioMutation) CurrentPrice() (r decimal.Decimal, exists bool) {
-	v := m.current_price
+// PeriodStart returns the value of the "period_start" field in the mutation.
+func (m *BacktestResultMutation) PeriodStart() (r time.Time, exists bool) {
+	v := m.period_start
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCurrentPrice returns the old "current_price" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodStart returns the old "period_start" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldCurrentPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldPeriodStart(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCurrentPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodStart is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCurrentPrice requires an ID field in the mutation")
+		return v, errors.New("OldPeriodStart requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCurrentPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodStart: %w", err)
 	}
-	return oldValue.CurrentPrice, nil
-}
-
-// ClearCurrentPrice clears the value of the "current_price" field.
-func (m *PortfolioMutation) ClearCurrentPrice() {
-	m.current_price = nil
-	m.clearedFields[portfolio.FieldCurrentPrice] = struct{}{}
+	return oldValue.PeriodStart, nil
 }
 
-// CurrentPriceCleared returns if the "current_price" field was cleared in this mutation.
-func (m *PortfolioMutation) CurrentPriceCleared() bool {
-	_, ok := m.clearedFields[portfolio.FieldCurrentPrice]
-	return ok
-}
-
-// ResetCurrentPrice resets all changes to the "current_price" field.
-func (m *PortfolioMutation) ResetCurrentPrice() {
-	m.current_price = nil
-	delete(m.clearedFields, portfolio.FieldCurrentPrice)
+// ResetPeriodStart resets all changes to the "period_start" field.
+func (m *BacktestResultMutation) ResetPeriodStart() {
+	m.period_start = nil
 }
 
-// SetMarketValue sets the "market_value" field.
-func (m *PortfolioMutation) SetMarketValue(d decimal.Decimal) {
-	m.market_value = &d
+// SetPeriodEnd sets the "period_end" field.
+func (m *BacktestResultMutation) SetPeriodEnd(t time.Time) {
+	m.period_end = &t
 }
 
-// MarketValue returns the value of the "market_value" field in the mutation.
-func (m *PortfolioMutation) MarketValue() (r decimal.Decimal, exists bool) {
-	v := m.market_value
+// PeriodEnd returns the value of the "period_end" field in the mutation.
+func (m *BacktestResultMutation) PeriodEnd() (r time.Time, exists bool) {
+	v := m.period_end
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMarketValue returns the old "market_value" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldPeriodEnd returns the old "period_end" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldMarketValue(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldPeriodEnd(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMarketValue is only allowed on UpdateOne operations")
+		return v, errors.New("OldPeriodEnd is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMarketValue requires an ID field in the mutation")
+		return v, errors.New("OldPeriodEnd requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMarketValue: %w", err)
+		return v, fmt.Errorf("querying old value for OldPeriodEnd: %w", err)
 	}
-	return oldValue.MarketValue, nil
+	return oldValue.PeriodEnd, nil
 }
 
-// ResetMarketValue resets all changes to the "market_value" field.
-func (m *PortfolioMutation) ResetMarketValue() {
-	m.market_value = nil
+// ResetPeriodEnd resets all changes to the "period_end" field.
+func (m *BacktestResultMutation) ResetPeriodEnd() {
+	m.period_end = nil
 }
 
-// SetTotalCost sets the "total_cost" field.
-func (m *PortfolioMutation) SetTotalCost(d decimal.Decimal) {
-	m.total_cost = &d
+// SetParameters sets the "parameters" field.
+func (m *BacktestResultMutation) SetParameters(value map[string]interface{}) {
+	m.parameters = &value
 }
 
-// TotalCost returns the value of the "total_cost" field in the mutation.
-func (m *PortfolioMutation) TotalCost() (r decimal.Decimal, exists bool) {
-	v := m.total_cost
+// Parameters returns the value of the "parameters" field in the mutation.
+func (m *BacktestResultMutation) Parameters() (r map[string]interface{}, exists bool) {
+	v := m.parameters
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalCost returns the old "total_cost" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldParameters returns the old "parameters" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldTotalCost(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldParameters(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalCost is only allowed on UpdateOne operations")
+		return v, errors.New("OldParameters is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalCost requires an ID field in the mutation")
+		return v, errors.New("OldParameters requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalCost: %w", err)
+		return v, fmt.Errorf("querying old value for OldParameters: %w", err)
 	}
-	return oldValue.TotalCost, nil
+	return oldValue.Parameters, nil
 }
 
-// ResetTotalCost resets all changes to the "total_cost" field.
-func (m *PortfolioMutation) ResetTotalCost() {
-	m.total_cost = nil
+// ResetParameters resets all changes to the "parameters" field.
+func (m *BacktestResultMutation) ResetParameters() {
+	m.parameters = nil
 }
 
-// SetUnrealizedPnl sets the "unrealized_pnl" field.
-func (m *PortfolioMutation) SetUnrealizedPnl(d decimal.Decimal) {
-	m.unrealized_pnl = &d
+// SetSettings sets the "settings" field.
+func (m *BacktestResultMutation) SetSettings(value map[string]interface{}) {
+	m.settings = &value
 }
 
-// UnrealizedPnl returns the value of the "unrealized_pnl" field in the mutation.
-func (m *PortfolioMutation) UnrealizedPnl() (r decimal.Decimal, exists bool) {
-	v := m.unrealized_pnl
+// Settings returns the value of the "settings" field in the mutation.
+func (m *BacktestResultMutation) Settings() (r map[string]interface{}, exists bool) {
+	v := m.settings
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUnrealizedPnl returns the old "unrealized_pnl" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldSettings returns the old "settings" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldUnrealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUnrealizedPnl is only allowed on UpdateOne operations")
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUnrealizedPnl requires an ID field in the mutation")
+		return v, errors.New("OldSettings requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUnrealizedPnl: %w", err)
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
 	}
-	return oldValue.UnrealizedPnl, nil
+	return oldValue.Settings, nil
 }
 
-// ResetUnrealizedPnl resets all changes to the "unrealized_pnl" field.
-func (m *PortfolioMutation) ResetUnrealizedPnl() {
-	m.unrealized_pnl = nil
+// ResetSettings resets all changes to the "settings" field.
+func (m *BacktestResultMutation) ResetSettings() {
+	m.settings = nil
 }
 
-// SetRealizedPnl sets the "realized_pnl" field.
-func (m *PortfolioMutation) SetRealizedPnl(d decimal.Decimal) {
-	m.realized_pnl = &d
+// SetInitialCapital sets the "initial_capital" field.
+func (m *BacktestResultMutation) SetInitialCapital(d decimal.Decimal) {
+	m.initial_capital = &d
 }
 
-// RealizedPnl returns the value of the "realized_pnl" field in the mutation.
-func (m *PortfolioMutation) RealizedPnl() (r decimal.Decimal, exists bool) {
-	v := m.realized_pnl
+// InitialCapital returns the value of the "initial_capital" field in the mutation.
+func (m *BacktestResultMutation) InitialCapital() (r decimal.Decimal, exists bool) {
+	v := m.initial_capital
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldRealizedPnl returns the old "realized_pnl" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldInitialCapital returns the old "initial_capital" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldRealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *BacktestResultMutation) OldInitialCapital(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldRealizedPnl is only allowed on UpdateOne operations")
+		return v, errors.New("OldInitialCapital is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldRealizedPnl requires an ID field in the mutation")
+		return v, errors.New("OldInitialCapital requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldRealizedPnl: %w", err)
+		return v, fmt.Errorf("querying old value for OldInitialCapital: %w", err)
 	}
-	return oldValue.RealizedPnl, nil
+	return oldValue.InitialCapital, nil
 }
 
-// ResetRealizedPnl resets all changes to the "realized_pnl" field.
-func (m *PortfolioMutation) ResetRealizedPnl() {
-	m.realized_pnl = nil
+// ResetInitialCapital resets all changes to the "initial_capital" field.
+func (m *BacktestResultMutation) ResetInitialCapital() {
+	m.initial_capital = nil
 }
 
-// SetLastUpdated sets the "last_updated" field.
-func (m *PortfolioMutation) SetLastUpdated(t time.Time) {
-	m.last_updated = &t
+// SetFinalEquity sets the "final_equity" field.
+func (m *BacktestResultMutation) SetFinalEquity(d decimal.Decimal) {
+	m.final_equity = &d
 }
 
-// LastUpdated returns the value of the "last_updated" field in the mutation.
-func (m *PortfolioMutation) LastUpdated() (r time.Time, exists bool) {
-	v := m.last_updated
+// FinalEquity returns the value of the "final_equity" field in the mutation.
+func (m *BacktestResultMutation) FinalEquity() (r decimal.Decimal, exists bool) {
+	v := m.final_equity
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastUpdated returns the old "last_updated" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldFinalEquity returns the old "final_equity" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
+func (m *BacktestResultMutation) OldFinalEquity(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+		return v, errors.New("OldFinalEquity is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+		return v, errors.New("OldFinalEquity requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+		return v, fmt.Errorf("querying old value for OldFinalEquity: %w", err)
 	}
-	return oldValue.LastUpdated, nil
+	return oldValue.FinalEquity, nil
 }
 
-// ResetLastUpdated resets all changes to the "last_updated" field.
-func (m *PortfolioMutation) ResetLastUpdated() {
-	m.last_updated = nil
+// ResetFinalEquity resets all changes to the "final_equity" field.
+func (m *BacktestResultMutation) ResetFinalEquity() {
+	m.final_equity = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *PortfolioMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetTotalReturn sets the "total_return" field.
+func (m *BacktestResultMutation) SetTotalReturn(f float64) {
+	m.total_return = &f
+	m.addtotal_return = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *PortfolioMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// TotalReturn returns the value of the "total_return" field in the mutation.
+func (m *BacktestResultMutation) TotalReturn() (r float64, exists bool) {
+	v := m.total_return
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldTotalReturn returns the old "total_return" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *BacktestResultMutation) OldTotalReturn(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldTotalReturn is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldTotalReturn requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldTotalReturn: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.TotalReturn, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *PortfolioMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[portfolio.FieldCreatedAt] = struct{}{}
+// AddTotalReturn adds f to the "total_return" field.
+func (m *BacktestResultMutation) AddTotalReturn(f float64) {
+	if m.addtotal_return != nil {
+		*m.addtotal_return += f
+	} else {
+		m.addtotal_return = &f
+	}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *PortfolioMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[portfolio.FieldCreatedAt]
-	return ok
+// AddedTotalReturn returns the value that was added to the "total_return" field in this mutation.
+func (m *BacktestResultMutation) AddedTotalReturn() (r float64, exists bool) {
+	v := m.addtotal_return
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *PortfolioMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, portfolio.FieldCreatedAt)
+// ResetTotalReturn resets all changes to the "total_return" field.
+func (m *BacktestResultMutation) ResetTotalReturn() {
+	m.total_return = nil
+	m.addtotal_return = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *PortfolioMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetMaxDrawdown sets the "max_drawdown" field.
+func (m *BacktestResultMutation) SetMaxDrawdown(f float64) {
+	m.max_drawdown = &f
+	m.addmax_drawdown = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *PortfolioMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// MaxDrawdown returns the value of the "max_drawdown" field in the mutation.
+func (m *BacktestResultMutation) MaxDrawdown() (r float64, exists bool) {
+	v := m.max_drawdown
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Portfolio entity.
-// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// OldMaxDrawdown returns the old "max_drawdown" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *PortfolioMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *BacktestResultMutation) OldMaxDrawdown(ctx context.Context) (v float64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldMaxDrawdown is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldMaxDrawdown requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldMaxDrawdown: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *PortfolioMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[portfolio.FieldUpdatedAt] = struct{}{}
+	return oldValue.MaxDrawdown, nil
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *PortfolioMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[portfolio.FieldUpdatedAt]
-	return ok
+// AddMaxDrawdown adds f to the "max_drawdown" field.
+func (m *BacktestResultMutation) AddMaxDrawdown(f float64) {
+	if m.addmax_drawdown != nil {
+		*m.addmax_drawdown += f
+	} else {
+		m.addmax_drawdown = &f
+	}
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *PortfolioMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, portfolio.FieldUpdatedAt)
+// AddedMaxDrawdown returns the value that was added to the "max_drawdown" field in this mutation.
+func (m *BacktestResultMutation) AddedMaxDrawdown() (r float64, exists bool) {
+	v := m.addmax_drawdown
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *PortfolioMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[portfolio.FieldUserID] = struct{}{}
+// ResetMaxDrawdown resets all changes to the "max_drawdown" field.
+func (m *BacktestResultMutation) ResetMaxDrawdown() {
+	m.max_drawdown = nil
+	m.addmax_drawdown = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *PortfolioMutation) UserCleared() bool {
-	return m.cleareduser
+// SetSharpeRatio sets the "sharpe_ratio" field.
+func (m *BacktestResultMutation) SetSharpeRatio(f float64) {
+	m.sharpe_ratio = &f
+	m.addsharpe_ratio = nil
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *PortfolioMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// SharpeRatio returns the value of the "sharpe_ratio" field in the mutation.
+func (m *BacktestResultMutation) SharpeRatio() (r float64, exists bool) {
+	v := m.sharpe_ratio
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *PortfolioMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldSharpeRatio returns the old "sharpe_ratio" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldSharpeRatio(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharpeRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharpeRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharpeRatio: %w", err)
+	}
+	return oldValue.SharpeRatio, nil
 }
 
-// Where appends a list predicates to the PortfolioMutation builder.
-func (m *PortfolioMutation) Where(ps ...predicate.Portfolio) {
-	m.predicates = append(m.predicates, ps...)
+// AddSharpeRatio adds f to the "sharpe_ratio" field.
+func (m *BacktestResultMutation) AddSharpeRatio(f float64) {
+	if m.addsharpe_ratio != nil {
+		*m.addsharpe_ratio += f
+	} else {
+		m.addsharpe_ratio = &f
+	}
 }
 
-// WhereP appends storage-level predicates to the PortfolioMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *PortfolioMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Portfolio, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// AddedSharpeRatio returns the value that was added to the "sharpe_ratio" field in this mutation.
+func (m *BacktestResultMutation) AddedSharpeRatio() (r float64, exists bool) {
+	v := m.addsharpe_ratio
+	if v == nil {
+		return
 	}
-	m.Where(p...)
+	return *v, true
 }
 
-// Op returns the operation name.
-func (m *PortfolioMutation) Op() Op {
-	return m.op
+// ResetSharpeRatio resets all changes to the "sharpe_ratio" field.
+func (m *BacktestResultMutation) ResetSharpeRatio() {
+	m.sharpe_ratio = nil
+	m.addsharpe_ratio = nil
 }
 
-// SetOp allows setting the mutation operation.
-func (m *PortfolioMutation) SetOp(op Op) {
-	m.op = op
+// SetWinRate sets the "win_rate" field.
+func (m *BacktestResultMutation) SetWinRate(f float64) {
+	m.win_rate = &f
+	m.addwin_rate = nil
 }
 
-// Type returns the node type of this mutation (Portfolio).
-func (m *PortfolioMutation) Type() string {
-	return m.typ
+// WinRate returns the value of the "win_rate" field in the mutation.
+func (m *BacktestResultMutation) WinRate() (r float64, exists bool) {
+	v := m.win_rate
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *PortfolioMutation) Fields() []string {
-	fields := make([]string, 0, 12)
-	if m.user != nil {
-		fields = append(fields, portfolio.FieldUserID)
+// OldWinRate returns the old "win_rate" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldWinRate(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinRate is only allowed on UpdateOne operations")
 	}
-	if m.symbol != nil {
-		fields = append(fields, portfolio.FieldSymbol)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinRate requires an ID field in the mutation")
 	}
-	if m.quantity != nil {
-		fields = append(fields, portfolio.FieldQuantity)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinRate: %w", err)
 	}
-	if m.average_price != nil {
-		fields = append(fields, portfolio.FieldAveragePrice)
+	return oldValue.WinRate, nil
+}
+
+// AddWinRate adds f to the "win_rate" field.
+func (m *BacktestResultMutation) AddWinRate(f float64) {
+	if m.addwin_rate != nil {
+		*m.addwin_rate += f
+	} else {
+		m.addwin_rate = &f
 	}
-	if m.current_price != nil {
-		fields = append(fields, portfolio.FieldCurrentPrice)
+}
+
+// AddedWinRate returns the value that was added to the "win_rate" field in this mutation.
+func (m *BacktestResultMutation) AddedWinRate() (r float64, exists bool) {
+	v := m.addwin_rate
+	if v == nil {
+		return
 	}
-	if m.market_value != nil {
-		fields = append(fields, portfolio.FieldMarketValue)
+	return *v, true
+}
+
+// ResetWinRate resets all changes to the "win_rate" field.
+func (m *BacktestResultMutation) ResetWinRate() {
+	m.win_rate = nil
+	m.addwin_rate = nil
+}
+
+// SetTradeCount sets the "trade_count" field.
+func (m *BacktestResultMutation) SetTradeCount(i int) {
+	m.trade_count = &i
+	m.addtrade_count = nil
+}
+
+// TradeCount returns the value of the "trade_count" field in the mutation.
+func (m *BacktestResultMutation) TradeCount() (r int, exists bool) {
+	v := m.trade_count
+	if v == nil {
+		return
 	}
-	if m.total_cost != nil {
-		fields = append(fields, portfolio.FieldTotalCost)
+	return *v, true
+}
+
+// OldTradeCount returns the old "trade_count" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldTradeCount(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradeCount is only allowed on UpdateOne operations")
 	}
-	if m.unrealized_pnl != nil {
-		fields = append(fields, portfolio.FieldUnrealizedPnl)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradeCount requires an ID field in the mutation")
 	}
-	if m.realized_pnl != nil {
-		fields = append(fields, portfolio.FieldRealizedPnl)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradeCount: %w", err)
 	}
-	if m.last_updated != nil {
-		fields = append(fields, portfolio.FieldLastUpdated)
+	return oldValue.TradeCount, nil
+}
+
+// AddTradeCount adds i to the "trade_count" field.
+func (m *BacktestResultMutation) AddTradeCount(i int) {
+	if m.addtrade_count != nil {
+		*m.addtrade_count += i
+	} else {
+		m.addtrade_count = &i
 	}
-	if m.created_at != nil {
-		fields = append(fields, portfolio.FieldCreatedAt)
+}
+
+// AddedTradeCount returns the value that was added to the "trade_count" field in this mutation.
+func (m *BacktestResultMutation) AddedTradeCount() (r int, exists bool) {
+	v := m.addtrade_count
+	if v == nil {
+		return
 	}
-	if m.updated_at != nil {
-		fields = append(fields, portfolio.FieldUpdatedAt)
+	return *v, true
+}
+
+// ResetTradeCount resets all changes to the "trade_count" field.
+func (m *BacktestResultMutation) ResetTradeCount() {
+	m.trade_count = nil
+	m.addtrade_count = nil
+}
+
+// SetRejectedOrders sets the "rejected_orders" field.
+func (m *BacktestResultMutation) SetRejectedOrders(i int) {
+	m.rejected_orders = &i
+	m.addrejected_orders = nil
+}
+
+// RejectedOrders returns the value of the "rejected_orders" field in the mutation.
+func (m *BacktestResultMutation) RejectedOrders() (r int, exists bool) {
+	v := m.rejected_orders
+	if v == nil {
+		return
 	}
-	return fields
+	return *v, true
 }
 
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *PortfolioMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case portfolio.FieldUserID:
-		return m.UserID()
-	case portfolio.FieldSymbol:
-		return m.Symbol()
-	case portfolio.FieldQuantity:
-		return m.Quantity()
-	case portfolio.FieldAveragePrice:
-		return m.AveragePrice()
-	case portfolio.FieldCurrentPrice:
-		return m.CurrentPrice()
-	case portfolio.FieldMarketValue:
-		return m.MarketValue()
-	case portfolio.FieldTotalCost:
-		return m.TotalCost()
-	case portfolio.FieldUnrealizedPnl:
-		return m.UnrealizedPnl()
-	case portfolio.FieldRealizedPnl:
-		return m.RealizedPnl()
-	case portfolio.FieldLastUpdated:
-		return m.LastUpdated()
-	case portfolio.FieldCreatedAt:
-		return m.CreatedAt()
-	case portfolio.FieldUpdatedAt:
-		return m.UpdatedAt()
+// OldRejectedOrders returns the old "rejected_orders" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldRejectedOrders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRejectedOrders is only allowed on UpdateOne operations")
 	}
-	return nil, false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRejectedOrders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejectedOrders: %w", err)
+	}
+	return oldValue.RejectedOrders, nil
 }
 
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *PortfolioMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case portfolio.FieldUserID:
-		return m.OldUserID(ctx)
-	case portfolio.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case portfolio.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case portfolio.FieldAveragePrice:
-		return m.OldAveragePrice(ctx)
-	case portfolio.FieldCurrentPrice:
-		return m.OldCurrentPrice(ctx)
-	case portfolio.FieldMarketValue:
-		return m.OldMarketValue(ctx)
-	case portfolio.FieldTotalCost:
-		return m.OldTotalCost(ctx)
-	case portfolio.FieldUnrealizedPnl:
-		return m.OldUnrealizedPnl(ctx)
-	case portfolio.FieldRealizedPnl:
-		return m.OldRealizedPnl(ctx)
-	case portfolio.FieldLastUpdated:
-		return m.OldLastUpdated(ctx)
-	case portfolio.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case portfolio.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+// AddRejectedOrders adds i to the "rejected_orders" field.
+func (m *BacktestResultMutation) AddRejectedOrders(i int) {
+	if m.addrejected_orders != nil {
+		*m.addrejected_orders += i
+	} else {
+		m.addrejected_orders = &i
 	}
-	return nil, fmt.Errorf("unknown Portfolio field %s", name)
 }
 
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PortfolioMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case portfolio.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case portfolio.FieldSymbol:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSymbol(v)
-		return nil
-	case portfolio.FieldQuantity:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetQuantity(v)
-		return nil
-	case portfolio.FieldAveragePrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAveragePrice(v)
-		return nil
-	case portfolio.FieldCurrentPrice:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCurrentPrice(v)
-		return nil
-	case portfolio.FieldMarketValue:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetMarketValue(v)
-		return nil
-	case portfolio.FieldTotalCost:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetTotalCost(v)
-		return nil
-	case portfolio.FieldUnrealizedPnl:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUnrealizedPnl(v)
-		return nil
-	case portfolio.FieldRealizedPnl:
-		v, ok := value.(decimal.Decimal)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetRealizedPnl(v)
-		return nil
-	case portfolio.FieldLastUpdated:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastUpdated(v)
-		return nil
-	case portfolio.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case portfolio.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+// AddedRejectedOrders returns the value that was added to the "rejected_orders" field in this mutation.
+func (m *BacktestResultMutation) AddedRejectedOrders() (r int, exists bool) {
+	v := m.addrejected_orders
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Portfolio field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *PortfolioMutation) AddedFields() []string {
-	return nil
+// ResetRejectedOrders resets all changes to the "rejected_orders" field.
+func (m *BacktestResultMutation) ResetRejectedOrders() {
+	m.rejected_orders = nil
+	m.addrejected_orders = nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *PortfolioMutation) AddedField(name string) (ent.Value, bool) {
-	return nil, false
+// SetEquityCurve sets the "equity_curve" field.
+func (m *BacktestResultMutation) SetEquityCurve(j jsontext.Value) {
+	m.equity_curve = &j
+	m.appendequity_curve = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *PortfolioMutation) AddField(name string, value ent.Value) error {
-	switch name {
+// EquityCurve returns the value of the "equity_curve" field in the mutation.
+func (m *BacktestResultMutation) EquityCurve() (r jsontext.Value, exists bool) {
+	v := m.equity_curve
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown Portfolio numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *PortfolioMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(portfolio.FieldCurrentPrice) {
-		fields = append(fields, portfolio.FieldCurrentPrice)
+// OldEquityCurve returns the old "equity_curve" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldEquityCurve(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEquityCurve is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(portfolio.FieldCreatedAt) {
-		fields = append(fields, portfolio.FieldCreatedAt)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEquityCurve requires an ID field in the mutation")
 	}
-	if m.FieldCleared(portfolio.FieldUpdatedAt) {
-		fields = append(fields, portfolio.FieldUpdatedAt)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEquityCurve: %w", err)
 	}
-	return fields
+	return oldValue.EquityCurve, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *PortfolioMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
+// AppendEquityCurve adds j to the "equity_curve" field.
+func (m *BacktestResultMutation) AppendEquityCurve(j jsontext.Value) {
+	m.appendequity_curve = append(m.appendequity_curve, j...)
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *PortfolioMutation) ClearField(name string) error {
-	switch name {
-	case portfolio.FieldCurrentPrice:
-		m.ClearCurrentPrice()
-		return nil
-	case portfolio.FieldCreatedAt:
-		m.ClearCreatedAt()
-		return nil
-	case portfolio.FieldUpdatedAt:
-		m.ClearUpdatedAt()
-		return nil
+// AppendedEquityCurve returns the list of values that were appended to the "equity_curve" field in this mutation.
+func (m *BacktestResultMutation) AppendedEquityCurve() (jsontext.Value, bool) {
+	if len(m.appendequity_curve) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown Portfolio nullable field %s", name)
+	return m.appendequity_curve, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *PortfolioMutation) ResetField(name string) error {
-	switch name {
-	case portfolio.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case portfolio.FieldSymbol:
-		m.ResetSymbol()
-		return nil
-	case portfolio.FieldQuantity:
-		m.ResetQuantity()
-		return nil
-	case portfolio.FieldAveragePrice:
-		m.ResetAveragePrice()
-		return nil
-	case portfolio.FieldCurrentPrice:
-		m.ResetCurrentPrice()
-		return nil
-	case portfolio.FieldMarketValue:
-		m.ResetMarketValue()
-		return nil
-	case portfolio.FieldTotalCost:
-		m.ResetTotalCost()
-		return nil
-	case portfolio.FieldUnrealizedPnl:
-		m.ResetUnrealizedPnl()
-		return nil
-	case portfolio.FieldRealizedPnl:
-		m.ResetRealizedPnl()
-		return nil
-	case portfolio.FieldLastUpdated:
-		m.ResetLastUpdated()
-		return nil
-	case portfolio.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case portfolio.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Portfolio field %s", name)
+// ClearEquityCurve clears the value of the "equity_curve" field.
+func (m *BacktestResultMutation) ClearEquityCurve() {
+	m.equity_curve = nil
+	m.appendequity_curve = nil
+	m.clearedFields[backtestresult.FieldEquityCurve] = struct{}{}
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *PortfolioMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.user != nil {
-		edges = append(edges, portfolio.EdgeUser)
-	}
-	return edges
+// EquityCurveCleared returns if the "equity_curve" field was cleared in this mutation.
+func (m *BacktestResultMutation) EquityCurveCleared() bool {
+	_, ok := m.clearedFields[backtestresult.FieldEquityCurve]
+	return ok
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *PortfolioMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case portfolio.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	}
-	return nil
+// ResetEquityCurve resets all changes to the "equity_curve" field.
+func (m *BacktestResultMutation) ResetEquityCurve() {
+	m.equity_curve = nil
+	m.appendequity_curve = nil
+	delete(m.clearedFields, backtestresult.FieldEquityCurve)
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *PortfolioMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// SetTrades sets the "trades" field.
+func (m *BacktestResultMutation) SetTrades(j jsontext.Value) {
+	m.trades = &j
+	m.appendtrades = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *PortfolioMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// Trades returns the value of the "trades" field in the mutation.
+func (m *BacktestResultMutation) Trades() (r jsontext.Value, exists bool) {
+	v := m.trades
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *PortfolioMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.cleareduser {
-		edges = append(edges, portfolio.EdgeUser)
+// OldTrades returns the old "trades" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldTrades(ctx context.Context) (v jsontext.Value, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrades is only allowed on UpdateOne operations")
 	}
-	return edges
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrades requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrades: %w", err)
+	}
+	return oldValue.Trades, nil
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *PortfolioMutation) EdgeCleared(name string) bool {
-	switch name {
-	case portfolio.EdgeUser:
-		return m.cleareduser
-	}
-	return false
+// AppendTrades adds j to the "trades" field.
+func (m *BacktestResultMutation) AppendTrades(j jsontext.Value) {
+	m.appendtrades = append(m.appendtrades, j...)
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *PortfolioMutation) ClearEdge(name string) error {
-	switch name {
-	case portfolio.EdgeUser:
-		m.ClearUser()
-		return nil
+// AppendedTrades returns the list of values that were appended to the "trades" field in this mutation.
+func (m *BacktestResultMutation) AppendedTrades() (jsontext.Value, bool) {
+	if len(m.appendtrades) == 0 {
+		return nil, false
 	}
-	return fmt.Errorf("unknown Portfolio unique edge %s", name)
+	return m.appendtrades, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *PortfolioMutation) ResetEdge(name string) error {
-	switch name {
-	case portfolio.EdgeUser:
-		m.ResetUser()
-		return nil
-	}
-	return fmt.Errorf("unknown Portfolio edge %s", name)
+// ClearTrades clears the value of the "trades" field.
+func (m *BacktestResultMutation) ClearTrades() {
+	m.trades = nil
+	m.appendtrades = nil
+	m.clearedFields[backtestresult.FieldTrades] = struct{}{}
 }
 
-// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
-type StrategyMutation struct {
-	config
-	op                 Op
-	typ                string
-	id                 *uuid.UUID
-	strategy_id        *string
-	name               *string
-	description        *string
-	symbol             *string
-	user_inputs        *map[string]interface{}
-	settings           *map[string]interface{}
-	active             *bool
-	created_at         *time.Time
-	updated_at         *time.Time
-	clearedFields      map[string]struct{}
-	user               *uuid.UUID
-	cleareduser        bool
-	template           *uuid.UUID
-	clearedtemplate    bool
-	executions         map[int64]struct{}
-	removedexecutions  map[int64]struct{}
-	clearedexecutions  bool
-	performance        *int
-	clearedperformance bool
-	status             *int
-	clearedstatus      bool
-	done               bool
-	oldValue           func(context.Context) (*Strategy, error)
-	predicates         []predicate.Strategy
+// TradesCleared returns if the "trades" field was cleared in this mutation.
+func (m *BacktestResultMutation) TradesCleared() bool {
+	_, ok := m.clearedFields[backtestresult.FieldTrades]
+	return ok
 }
 
-var _ ent.Mutation = (*StrategyMutation)(nil)
+// ResetTrades resets all changes to the "trades" field.
+func (m *BacktestResultMutation) ResetTrades() {
+	m.trades = nil
+	m.appendtrades = nil
+	delete(m.clearedFields, backtestresult.FieldTrades)
+}
 
-// strategyOption allows management of the mutation configuration using functional options.
-type strategyOption func(*StrategyMutation)
+// SetCreatedAt sets the "created_at" field.
+func (m *BacktestResultMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
 
-// newStrategyMutation creates new mutation for the Strategy entity.
-func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
-	m := &StrategyMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeStrategy,
-		clearedFields: make(map[string]struct{}),
-	}
-	for _, opt := range opts {
-		opt(m)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BacktestResultMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return m
+	return *v, true
 }
 
-// withStrategyID sets the ID field of the mutation.
-func withStrategyID(id uuid.UUID) strategyOption {
-	return func(m *StrategyMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *Strategy
-		)
-		m.oldValue = func(ctx context.Context) (*Strategy, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().Strategy.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
+// OldCreatedAt returns the old "created_at" field's value of the BacktestResult entity.
+// If the BacktestResult object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BacktestResultMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
+	return oldValue.CreatedAt, nil
 }
 
-// withStrategy sets the old Strategy of the mutation.
-func withStrategy(node *Strategy) strategyOption {
-	return func(m *StrategyMutation) {
-		m.oldValue = func(context.Context) (*Strategy, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *BacktestResultMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[backtestresult.FieldCreatedAt] = struct{}{}
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *BacktestResultMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[backtestresult.FieldCreatedAt]
+	return ok
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StrategyMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BacktestResultMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, backtestresult.FieldCreatedAt)
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Strategy entities.
-func (m *StrategyMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (m *BacktestResultMutation) ClearStrategy() {
+	m.clearedstrategy = true
+	m.clearedFields[backtestresult.FieldStrategyID] = struct{}{}
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StrategyMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
-		return
-	}
-	return *m.id, true
+// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
+func (m *BacktestResultMutation) StrategyCleared() bool {
+	return m.clearedstrategy
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StrategyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// StrategyIDs returns the "strategy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StrategyID instead. It exists only for internal usage by the builders.
+func (m *BacktestResultMutation) StrategyIDs() (ids []uuid.UUID) {
+	if id := m.strategy; id != nil {
+		ids = append(ids, *id)
 	}
+	return
 }
 
-// SetUserID sets the "user_id" field.
-func (m *StrategyMutation) SetUserID(u uuid.UUID) {
-	m.user = &u
+// ResetStrategy resets all changes to the "strategy" edge.
+func (m *BacktestResultMutation) ResetStrategy() {
+	m.strategy = nil
+	m.clearedstrategy = false
 }
 
-// UserID returns the value of the "user_id" field in the mutation.
-func (m *StrategyMutation) UserID() (r uuid.UUID, exists bool) {
-	v := m.user
-	if v == nil {
-		return
-	}
-	return *v, true
+// Where appends a list predicates to the BacktestResultMutation builder.
+func (m *BacktestResultMutation) Where(ps ...predicate.BacktestResult) {
+	m.predicates = append(m.predicates, ps...)
 }
 
-// OldUserID returns the old "user_id" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserID requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+// WhereP appends storage-level predicates to the BacktestResultMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BacktestResultMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BacktestResult, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
 	}
-	return oldValue.UserID, nil
+	m.Where(p...)
 }
 
-// ResetUserID resets all changes to the "user_id" field.
-func (m *StrategyMutation) ResetUserID() {
-	m.user = nil
+// Op returns the operation name.
+func (m *BacktestResultMutation) Op() Op {
+	return m.op
 }
 
-// SetStrategyID sets the "strategy_id" field.
-func (m *StrategyMutation) SetStrategyID(s string) {
+// SetOp allows setting the mutation operation.
+func (m *BacktestResultMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BacktestResult).
+func (m *BacktestResultMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BacktestResultMutation) Fields() []string {
+	fields := make([]string, 0, 19)
+	if m.strategy != nil {
+		fields = append(fields, backtestresult.FieldStrategyID)
+	}
+	if m.user_id != nil {
+		fields = append(fields, backtestresult.FieldUserID)
+	}
+	if m.symbols != nil {
+		fields = append(fields, backtestresult.FieldSymbols)
+	}
+	if m.interval != nil {
+		fields = append(fields, backtestresult.FieldInterval)
+	}
+	if m.period_start != nil {
+		fields = append(fields, backtestresult.FieldPeriodStart)
+	}
+	if m.period_end != nil {
+		fields = append(fields, backtestresult.FieldPeriodEnd)
+	}
+	if m.parameters != nil {
+		fields = append(fields, backtestresult.FieldParameters)
+	}
+	if m.settings != nil {
+		fields = append(fields, backtestresult.FieldSettings)
+	}
+	if m.initial_capital != nil {
+		fields = append(fields, backtestresult.FieldInitialCapital)
+	}
+	if m.final_equity != nil {
+		fields = append(fields, backtestresult.FieldFinalEquity)
+	}
+	if m.total_return != nil {
+		fields = append(fields, backtestresult.FieldTotalReturn)
+	}
+	if m.max_drawdown != nil {
+		fields = append(fields, backtestresult.FieldMaxDrawdown)
+	}
+	if m.sharpe_ratio != nil {
+		fields = append(fields, backtestresult.FieldSharpeRatio)
+	}
+	if m.win_rate != nil {
+		fields = append(fields, backtestresult.FieldWinRate)
+	}
+	if m.trade_count != nil {
+		fields = append(fields, backtestresult.FieldTradeCount)
+	}
+	if m.rejected_orders != nil {
+		fields = append(fields, backtestresult.FieldRejectedOrders)
+	}
+	if m.equity_curve != nil {
+		fields = append(fields, backtestresult.FieldEquityCurve)
+	}
+	if m.trades != nil {
+		fields = append(fields, backtestresult.FieldTrades)
+	}
+	if m.created_at != nil {
+		fields = append(fields, backtestresult.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BacktestResultMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case backtestresult.FieldStrategyID:
+		return m.StrategyID()
+	case backtestresult.FieldUserID:
+		return m.UserID()
+	case backtestresult.FieldSymbols:
+		return m.Symbols()
+	case backtestresult.FieldInterval:
+		return m.Interval()
+	case backtestresult.FieldPeriodStart:
+		return m.PeriodStart()
+	case backtestresult.FieldPeriodEnd:
+		return m.PeriodEnd()
+	case backtestresult.FieldParameters:
+		return m.Parameters()
+	case backtestresult.FieldSettings:
+		return m.Settings()
+	case backtestresult.FieldInitialCapital:
+		return m.InitialCapital()
+	case backtestresult.FieldFinalEquity:
+		return m.FinalEquity()
+	case backtestresult.FieldTotalReturn:
+		return m.TotalReturn()
+	case backtestresult.FieldMaxDrawdown:
+		return m.MaxDrawdown()
+	case backtestresult.FieldSharpeRatio:
+		return m.SharpeRatio()
+	case backtestresult.FieldWinRate:
+		return m.WinRate()
+	case backtestresult.FieldTradeCount:
+		return m.TradeCount()
+	case backtestresult.FieldRejectedOrders:
+		return m.RejectedOrders()
+	case backtestresult.FieldEquityCurve:
+		return m.EquityCurve()
+	case backtestresult.FieldTrades:
+		return m.Trades()
+	case backtestresult.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BacktestResultMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case backtestresult.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case backtestresult.FieldUserID:
+		return m.OldUserID(ctx)
+	case backtestresult.FieldSymbols:
+		return m.OldSymbols(ctx)
+	case backtestresult.FieldInterval:
+		return m.OldInterval(ctx)
+	case backtestresult.FieldPeriodStart:
+		return m.OldPeriodStart(ctx)
+	case backtestresult.FieldPeriodEnd:
+		return m.OldPeriodEnd(ctx)
+	case backtestresult.FieldParameters:
+		return m.OldParameters(ctx)
+	case backtestresult.FieldSettings:
+		return m.OldSettings(ctx)
+	case backtestresult.FieldInitialCapital:
+		return m.OldInitialCapital(ctx)
+	case backtestresult.FieldFinalEquity:
+		return m.OldFinalEquity(ctx)
+	case backtestresult.FieldTotalReturn:
+		return m.OldTotalReturn(ctx)
+	case backtestresult.FieldMaxDrawdown:
+		return m.OldMaxDrawdown(ctx)
+	case backtestresult.FieldSharpeRatio:
+		return m.OldSharpeRatio(ctx)
+	case backtestresult.FieldWinRate:
+		return m.OldWinRate(ctx)
+	case backtestresult.FieldTradeCount:
+		return m.OldTradeCount(ctx)
+	case backtestresult.FieldRejectedOrders:
+		return m.OldRejectedOrders(ctx)
+	case backtestresult.FieldEquityCurve:
+		return m.OldEquityCurve(ctx)
+	case backtestresult.FieldTrades:
+		return m.OldTrades(ctx)
+	case backtestresult.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BacktestResult field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BacktestResultMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case backtestresult.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case backtestresult.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case backtestresult.FieldSymbols:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbols(v)
+		return nil
+	case backtestresult.FieldInterval:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case backtestresult.FieldPeriodStart:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodStart(v)
+		return nil
+	case backtestresult.FieldPeriodEnd:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeriodEnd(v)
+		return nil
+	case backtestresult.FieldParameters:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetParameters(v)
+		return nil
+	case backtestresult.FieldSettings:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case backtestresult.FieldInitialCapital:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialCapital(v)
+		return nil
+	case backtestresult.FieldFinalEquity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFinalEquity(v)
+		return nil
+	case backtestresult.FieldTotalReturn:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalReturn(v)
+		return nil
+	case backtestresult.FieldMaxDrawdown:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxDrawdown(v)
+		return nil
+	case backtestresult.FieldSharpeRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharpeRatio(v)
+		return nil
+	case backtestresult.FieldWinRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinRate(v)
+		return nil
+	case backtestresult.FieldTradeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradeCount(v)
+		return nil
+	case backtestresult.FieldRejectedOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejectedOrders(v)
+		return nil
+	case backtestresult.FieldEquityCurve:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEquityCurve(v)
+		return nil
+	case backtestresult.FieldTrades:
+		v, ok := value.(jsontext.Value)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrades(v)
+		return nil
+	case backtestresult.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BacktestResultMutation) AddedFields() []string {
+	var fields []string
+	if m.addtotal_return != nil {
+		fields = append(fields, backtestresult.FieldTotalReturn)
+	}
+	if m.addmax_drawdown != nil {
+		fields = append(fields, backtestresult.FieldMaxDrawdown)
+	}
+	if m.addsharpe_ratio != nil {
+		fields = append(fields, backtestresult.FieldSharpeRatio)
+	}
+	if m.addwin_rate != nil {
+		fields = append(fields, backtestresult.FieldWinRate)
+	}
+	if m.addtrade_count != nil {
+		fields = append(fields, backtestresult.FieldTradeCount)
+	}
+	if m.addrejected_orders != nil {
+		fields = append(fields, backtestresult.FieldRejectedOrders)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BacktestResultMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case backtestresult.FieldTotalReturn:
+		return m.AddedTotalReturn()
+	case backtestresult.FieldMaxDrawdown:
+		return m.AddedMaxDrawdown()
+	case backtestresult.FieldSharpeRatio:
+		return m.AddedSharpeRatio()
+	case backtestresult.FieldWinRate:
+		return m.AddedWinRate()
+	case backtestresult.FieldTradeCount:
+		return m.AddedTradeCount()
+	case backtestresult.FieldRejectedOrders:
+		return m.AddedRejectedOrders()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BacktestResultMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case backtestresult.FieldTotalReturn:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTotalReturn(v)
+		return nil
+	case backtestresult.FieldMaxDrawdown:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxDrawdown(v)
+		return nil
+	case backtestresult.FieldSharpeRatio:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddSharpeRatio(v)
+		return nil
+	case backtestresult.FieldWinRate:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWinRate(v)
+		return nil
+	case backtestresult.FieldTradeCount:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTradeCount(v)
+		return nil
+	case backtestresult.FieldRejectedOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddRejectedOrders(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BacktestResultMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(backtestresult.FieldEquityCurve) {
+		fields = append(fields, backtestresult.FieldEquityCurve)
+	}
+	if m.FieldCleared(backtestresult.FieldTrades) {
+		fields = append(fields, backtestresult.FieldTrades)
+	}
+	if m.FieldCleared(backtestresult.FieldCreatedAt) {
+		fields = append(fields, backtestresult.FieldCreatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BacktestResultMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BacktestResultMutation) ClearField(name string) error {
+	switch name {
+	case backtestresult.FieldEquityCurve:
+		m.ClearEquityCurve()
+		return nil
+	case backtestresult.FieldTrades:
+		m.ClearTrades()
+		return nil
+	case backtestresult.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BacktestResultMutation) ResetField(name string) error {
+	switch name {
+	case backtestresult.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case backtestresult.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case backtestresult.FieldSymbols:
+		m.ResetSymbols()
+		return nil
+	case backtestresult.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case backtestresult.FieldPeriodStart:
+		m.ResetPeriodStart()
+		return nil
+	case backtestresult.FieldPeriodEnd:
+		m.ResetPeriodEnd()
+		return nil
+	case backtestresult.FieldParameters:
+		m.ResetParameters()
+		return nil
+	case backtestresult.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case backtestresult.FieldInitialCapital:
+		m.ResetInitialCapital()
+		return nil
+	case backtestresult.FieldFinalEquity:
+		m.ResetFinalEquity()
+		return nil
+	case backtestresult.FieldTotalReturn:
+		m.ResetTotalReturn()
+		return nil
+	case backtestresult.FieldMaxDrawdown:
+		m.ResetMaxDrawdown()
+		return nil
+	case backtestresult.FieldSharpeRatio:
+		m.ResetSharpeRatio()
+		return nil
+	case backtestresult.FieldWinRate:
+		m.ResetWinRate()
+		return nil
+	case backtestresult.FieldTradeCount:
+		m.ResetTradeCount()
+		return nil
+	case backtestresult.FieldRejectedOrders:
+		m.ResetRejectedOrders()
+		return nil
+	case backtestresult.FieldEquityCurve:
+		m.ResetEquityCurve()
+		return nil
+	case backtestresult.FieldTrades:
+		m.ResetTrades()
+		return nil
+	case backtestresult.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BacktestResultMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.strategy != nil {
+		edges = append(edges, backtestresult.EdgeStrategy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BacktestResultMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case backtestresult.EdgeStrategy:
+		if id := m.strategy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BacktestResultMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BacktestResultMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BacktestResultMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstrategy {
+		edges = append(edges, backtestresult.EdgeStrategy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BacktestResultMutation) EdgeCleared(name string) bool {
+	switch name {
+	case backtestresult.EdgeStrategy:
+		return m.clearedstrategy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BacktestResultMutation) ClearEdge(name string) error {
+	switch name {
+	case backtestresult.EdgeStrategy:
+		m.ClearStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BacktestResultMutation) ResetEdge(name string) error {
+	switch name {
+	case backtestresult.EdgeStrategy:
+		m.ResetStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown BacktestResult edge %s", name)
+}
+
+// BrokerAccountMutation represents an operation that mutates the BrokerAccount nodes in the graph.
+type BrokerAccountMutation struct {
+	config
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	user_id           *uuid.UUID
+	broker            *brokeraccount.Broker
+	account_no        *string
+	product_code      *string
+	is_demo           *bool
+	app_key_cipher    *[]byte
+	app_secret_cipher *[]byte
+	data_key          *[]byte
+	key_id            *string
+	app_key_hint      *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	done              bool
+	oldValue          func(context.Context) (*BrokerAccount, error)
+	predicates        []predicate.BrokerAccount
+}
+
+var _ ent.Mutation = (*BrokerAccountMutation)(nil)
+
+// brokeraccountOption allows management of the mutation configuration using functional options.
+type brokeraccountOption func(*BrokerAccountMutation)
+
+// newBrokerAccountMutation creates new mutation for the BrokerAccount entity.
+func newBrokerAccountMutation(c config, op Op, opts ...brokeraccountOption) *BrokerAccountMutation {
+	m := &BrokerAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeBrokerAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withBrokerAccountID sets the ID field of the mutation.
+func withBrokerAccountID(id uuid.UUID) brokeraccountOption {
+	return func(m *BrokerAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *BrokerAccount
+		)
+		m.oldValue = func(ctx context.Context) (*BrokerAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().BrokerAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withBrokerAccount sets the old BrokerAccount of the mutation.
+func withBrokerAccount(node *BrokerAccount) brokeraccountOption {
+	return func(m *BrokerAccountMutation) {
+		m.oldValue = func(context.Context) (*BrokerAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m BrokerAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m BrokerAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of BrokerAccount entities.
+func (m *BrokerAccountMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *BrokerAccountMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *BrokerAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().BrokerAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *BrokerAccountMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *BrokerAccountMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *BrokerAccountMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetBroker sets the "broker" field.
+func (m *BrokerAccountMutation) SetBroker(b brokeraccount.Broker) {
+	m.broker = &b
+}
+
+// Broker returns the value of the "broker" field in the mutation.
+func (m *BrokerAccountMutation) Broker() (r brokeraccount.Broker, exists bool) {
+	v := m.broker
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBroker returns the old "broker" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldBroker(ctx context.Context) (v brokeraccount.Broker, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBroker is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBroker requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBroker: %w", err)
+	}
+	return oldValue.Broker, nil
+}
+
+// ResetBroker resets all changes to the "broker" field.
+func (m *BrokerAccountMutation) ResetBroker() {
+	m.broker = nil
+}
+
+// SetAccountNo sets the "account_no" field.
+func (m *BrokerAccountMutation) SetAccountNo(s string) {
+	m.account_no = &s
+}
+
+// AccountNo returns the value of the "account_no" field in the mutation.
+func (m *BrokerAccountMutation) AccountNo() (r string, exists bool) {
+	v := m.account_no
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountNo returns the old "account_no" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldAccountNo(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountNo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountNo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountNo: %w", err)
+	}
+	return oldValue.AccountNo, nil
+}
+
+// ResetAccountNo resets all changes to the "account_no" field.
+func (m *BrokerAccountMutation) ResetAccountNo() {
+	m.account_no = nil
+}
+
+// SetProductCode sets the "product_code" field.
+func (m *BrokerAccountMutation) SetProductCode(s string) {
+	m.product_code = &s
+}
+
+// ProductCode returns the value of the "product_code" field in the mutation.
+func (m *BrokerAccountMutation) ProductCode() (r string, exists bool) {
+	v := m.product_code
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProductCode returns the old "product_code" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldProductCode(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProductCode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProductCode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProductCode: %w", err)
+	}
+	return oldValue.ProductCode, nil
+}
+
+// ResetProductCode resets all changes to the "product_code" field.
+func (m *BrokerAccountMutation) ResetProductCode() {
+	m.product_code = nil
+}
+
+// SetIsDemo sets the "is_demo" field.
+func (m *BrokerAccountMutation) SetIsDemo(b bool) {
+	m.is_demo = &b
+}
+
+// IsDemo returns the value of the "is_demo" field in the mutation.
+func (m *BrokerAccountMutation) IsDemo() (r bool, exists bool) {
+	v := m.is_demo
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsDemo returns the old "is_demo" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldIsDemo(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsDemo is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsDemo requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsDemo: %w", err)
+	}
+	return oldValue.IsDemo, nil
+}
+
+// ResetIsDemo resets all changes to the "is_demo" field.
+func (m *BrokerAccountMutation) ResetIsDemo() {
+	m.is_demo = nil
+}
+
+// SetAppKeyCipher sets the "app_key_cipher" field.
+func (m *BrokerAccountMutation) SetAppKeyCipher(b []byte) {
+	m.app_key_cipher = &b
+}
+
+// AppKeyCipher returns the value of the "app_key_cipher" field in the mutation.
+func (m *BrokerAccountMutation) AppKeyCipher() (r []byte, exists bool) {
+	v := m.app_key_cipher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppKeyCipher returns the old "app_key_cipher" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldAppKeyCipher(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppKeyCipher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppKeyCipher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppKeyCipher: %w", err)
+	}
+	return oldValue.AppKeyCipher, nil
+}
+
+// ResetAppKeyCipher resets all changes to the "app_key_cipher" field.
+func (m *BrokerAccountMutation) ResetAppKeyCipher() {
+	m.app_key_cipher = nil
+}
+
+// SetAppSecretCipher sets the "app_secret_cipher" field.
+func (m *BrokerAccountMutation) SetAppSecretCipher(b []byte) {
+	m.app_secret_cipher = &b
+}
+
+// AppSecretCipher returns the value of the "app_secret_cipher" field in the mutation.
+func (m *BrokerAccountMutation) AppSecretCipher() (r []byte, exists bool) {
+	v := m.app_secret_cipher
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppSecretCipher returns the old "app_secret_cipher" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldAppSecretCipher(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppSecretCipher is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppSecretCipher requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppSecretCipher: %w", err)
+	}
+	return oldValue.AppSecretCipher, nil
+}
+
+// ResetAppSecretCipher resets all changes to the "app_secret_cipher" field.
+func (m *BrokerAccountMutation) ResetAppSecretCipher() {
+	m.app_secret_cipher = nil
+}
+
+// SetDataKey sets the "data_key" field.
+func (m *BrokerAccountMutation) SetDataKey(b []byte) {
+	m.data_key = &b
+}
+
+// DataKey returns the value of the "data_key" field in the mutation.
+func (m *BrokerAccountMutation) DataKey() (r []byte, exists bool) {
+	v := m.data_key
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDataKey returns the old "data_key" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldDataKey(ctx context.Context) (v []byte, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDataKey is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDataKey requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDataKey: %w", err)
+	}
+	return oldValue.DataKey, nil
+}
+
+// ResetDataKey resets all changes to the "data_key" field.
+func (m *BrokerAccountMutation) ResetDataKey() {
+	m.data_key = nil
+}
+
+// SetKeyID sets the "key_id" field.
+func (m *BrokerAccountMutation) SetKeyID(s string) {
+	m.key_id = &s
+}
+
+// KeyID returns the value of the "key_id" field in the mutation.
+func (m *BrokerAccountMutation) KeyID() (r string, exists bool) {
+	v := m.key_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldKeyID returns the old "key_id" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldKeyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldKeyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldKeyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldKeyID: %w", err)
+	}
+	return oldValue.KeyID, nil
+}
+
+// ResetKeyID resets all changes to the "key_id" field.
+func (m *BrokerAccountMutation) ResetKeyID() {
+	m.key_id = nil
+}
+
+// SetAppKeyHint sets the "app_key_hint" field.
+func (m *BrokerAccountMutation) SetAppKeyHint(s string) {
+	m.app_key_hint = &s
+}
+
+// AppKeyHint returns the value of the "app_key_hint" field in the mutation.
+func (m *BrokerAccountMutation) AppKeyHint() (r string, exists bool) {
+	v := m.app_key_hint
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAppKeyHint returns the old "app_key_hint" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldAppKeyHint(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAppKeyHint is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAppKeyHint requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAppKeyHint: %w", err)
+	}
+	return oldValue.AppKeyHint, nil
+}
+
+// ResetAppKeyHint resets all changes to the "app_key_hint" field.
+func (m *BrokerAccountMutation) ResetAppKeyHint() {
+	m.app_key_hint = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *BrokerAccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *BrokerAccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *BrokerAccountMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[brokeraccount.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *BrokerAccountMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[brokeraccount.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *BrokerAccountMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, brokeraccount.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *BrokerAccountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *BrokerAccountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the BrokerAccount entity.
+// If the BrokerAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *BrokerAccountMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *BrokerAccountMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[brokeraccount.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *BrokerAccountMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[brokeraccount.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *BrokerAccountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, brokeraccount.FieldUpdatedAt)
+}
+
+// Where appends a list predicates to the BrokerAccountMutation builder.
+func (m *BrokerAccountMutation) Where(ps ...predicate.BrokerAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the BrokerAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *BrokerAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.BrokerAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *BrokerAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *BrokerAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (BrokerAccount).
+func (m *BrokerAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *BrokerAccountMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user_id != nil {
+		fields = append(fields, brokeraccount.FieldUserID)
+	}
+	if m.broker != nil {
+		fields = append(fields, brokeraccount.FieldBroker)
+	}
+	if m.account_no != nil {
+		fields = append(fields, brokeraccount.FieldAccountNo)
+	}
+	if m.product_code != nil {
+		fields = append(fields, brokeraccount.FieldProductCode)
+	}
+	if m.is_demo != nil {
+		fields = append(fields, brokeraccount.FieldIsDemo)
+	}
+	if m.app_key_cipher != nil {
+		fields = append(fields, brokeraccount.FieldAppKeyCipher)
+	}
+	if m.app_secret_cipher != nil {
+		fields = append(fields, brokeraccount.FieldAppSecretCipher)
+	}
+	if m.data_key != nil {
+		fields = append(fields, brokeraccount.FieldDataKey)
+	}
+	if m.key_id != nil {
+		fields = append(fields, brokeraccount.FieldKeyID)
+	}
+	if m.app_key_hint != nil {
+		fields = append(fields, brokeraccount.FieldAppKeyHint)
+	}
+	if m.created_at != nil {
+		fields = append(fields, brokeraccount.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, brokeraccount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *BrokerAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case brokeraccount.FieldUserID:
+		return m.UserID()
+	case brokeraccount.FieldBroker:
+		return m.Broker()
+	case brokeraccount.FieldAccountNo:
+		return m.AccountNo()
+	case brokeraccount.FieldProductCode:
+		return m.ProductCode()
+	case brokeraccount.FieldIsDemo:
+		return m.IsDemo()
+	case brokeraccount.FieldAppKeyCipher:
+		return m.AppKeyCipher()
+	case brokeraccount.FieldAppSecretCipher:
+		return m.AppSecretCipher()
+	case brokeraccount.FieldDataKey:
+		return m.DataKey()
+	case brokeraccount.FieldKeyID:
+		return m.KeyID()
+	case brokeraccount.FieldAppKeyHint:
+		return m.AppKeyHint()
+	case brokeraccount.FieldCreatedAt:
+		return m.CreatedAt()
+	case brokeraccount.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *BrokerAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case brokeraccount.FieldUserID:
+		return m.OldUserID(ctx)
+	case brokeraccount.FieldBroker:
+		return m.OldBroker(ctx)
+	case brokeraccount.FieldAccountNo:
+		return m.OldAccountNo(ctx)
+	case brokeraccount.FieldProductCode:
+		return m.OldProductCode(ctx)
+	case brokeraccount.FieldIsDemo:
+		return m.OldIsDemo(ctx)
+	case brokeraccount.FieldAppKeyCipher:
+		return m.OldAppKeyCipher(ctx)
+	case brokeraccount.FieldAppSecretCipher:
+		return m.OldAppSecretCipher(ctx)
+	case brokeraccount.FieldDataKey:
+		return m.OldDataKey(ctx)
+	case brokeraccount.FieldKeyID:
+		return m.OldKeyID(ctx)
+	case brokeraccount.FieldAppKeyHint:
+		return m.OldAppKeyHint(ctx)
+	case brokeraccount.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case brokeraccount.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown BrokerAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case brokeraccount.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case brokeraccount.FieldBroker:
+		v, ok := value.(brokeraccount.Broker)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBroker(v)
+		return nil
+	case brokeraccount.FieldAccountNo:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountNo(v)
+		return nil
+	case brokeraccount.FieldProductCode:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProductCode(v)
+		return nil
+	case brokeraccount.FieldIsDemo:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsDemo(v)
+		return nil
+	case brokeraccount.FieldAppKeyCipher:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppKeyCipher(v)
+		return nil
+	case brokeraccount.FieldAppSecretCipher:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppSecretCipher(v)
+		return nil
+	case brokeraccount.FieldDataKey:
+		v, ok := value.([]byte)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDataKey(v)
+		return nil
+	case brokeraccount.FieldKeyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetKeyID(v)
+		return nil
+	case brokeraccount.FieldAppKeyHint:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAppKeyHint(v)
+		return nil
+	case brokeraccount.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case brokeraccount.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown BrokerAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *BrokerAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *BrokerAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *BrokerAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown BrokerAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *BrokerAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(brokeraccount.FieldCreatedAt) {
+		fields = append(fields, brokeraccount.FieldCreatedAt)
+	}
+	if m.FieldCleared(brokeraccount.FieldUpdatedAt) {
+		fields = append(fields, brokeraccount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *BrokerAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *BrokerAccountMutation) ClearField(name string) error {
+	switch name {
+	case brokeraccount.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case brokeraccount.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BrokerAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *BrokerAccountMutation) ResetField(name string) error {
+	switch name {
+	case brokeraccount.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case brokeraccount.FieldBroker:
+		m.ResetBroker()
+		return nil
+	case brokeraccount.FieldAccountNo:
+		m.ResetAccountNo()
+		return nil
+	case brokeraccount.FieldProductCode:
+		m.ResetProductCode()
+		return nil
+	case brokeraccount.FieldIsDemo:
+		m.ResetIsDemo()
+		return nil
+	case brokeraccount.FieldAppKeyCipher:
+		m.ResetAppKeyCipher()
+		return nil
+	case brokeraccount.FieldAppSecretCipher:
+		m.ResetAppSecretCipher()
+		return nil
+	case brokeraccount.FieldDataKey:
+		m.ResetDataKey()
+		return nil
+	case brokeraccount.FieldKeyID:
+		m.ResetKeyID()
+		return nil
+	case brokeraccount.FieldAppKeyHint:
+		m.ResetAppKeyHint()
+		return nil
+	case brokeraccount.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case brokeraccount.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown BrokerAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *BrokerAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *BrokerAccountMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *BrokerAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *BrokerAccountMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *BrokerAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *BrokerAccountMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *BrokerAccountMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown BrokerAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *BrokerAccountMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown BrokerAccount edge %s", name)
+}
+
+// CandleMutation represents an operation that mutates the Candle nodes in the graph.
+type CandleMutation struct {
+	config
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	symbol        *string
+	exchange      *string
+	interval      *string
+	timestamp     *time.Time
+	open          *decimal.Decimal
+	high          *decimal.Decimal
+	low           *decimal.Decimal
+	close         *decimal.Decimal
+	volume        *int64
+	addvolume     *int64
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Candle, error)
+	predicates    []predicate.Candle
+}
+
+var _ ent.Mutation = (*CandleMutation)(nil)
+
+// candleOption allows management of the mutation configuration using functional options.
+type candleOption func(*CandleMutation)
+
+// newCandleMutation creates new mutation for the Candle entity.
+func newCandleMutation(c config, op Op, opts ...candleOption) *CandleMutation {
+	m := &CandleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeCandle,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withCandleID sets the ID field of the mutation.
+func withCandleID(id uuid.UUID) candleOption {
+	return func(m *CandleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Candle
+		)
+		m.oldValue = func(ctx context.Context) (*Candle, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Candle.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withCandle sets the old Candle of the mutation.
+func withCandle(node *Candle) candleOption {
+	return func(m *CandleMutation) {
+		m.oldValue = func(context.Context) (*Candle, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m CandleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m CandleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Candle entities.
+func (m *CandleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *CandleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *CandleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Candle.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *CandleMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *CandleMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *CandleMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *CandleMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *CandleMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *CandleMutation) ResetExchange() {
+	m.exchange = nil
+}
+
+// SetInterval sets the "interval" field.
+func (m *CandleMutation) SetInterval(s string) {
+	m.interval = &s
+}
+
+// Interval returns the value of the "interval" field in the mutation.
+func (m *CandleMutation) Interval() (r string, exists bool) {
+	v := m.interval
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInterval returns the old "interval" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldInterval(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInterval is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInterval requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInterval: %w", err)
+	}
+	return oldValue.Interval, nil
+}
+
+// ResetInterval resets all changes to the "interval" field.
+func (m *CandleMutation) ResetInterval() {
+	m.interval = nil
+}
+
+// SetTimestamp sets the "timestamp" field.
+func (m *CandleMutation) SetTimestamp(t time.Time) {
+	m.timestamp = &t
+}
+
+// Timestamp returns the value of the "timestamp" field in the mutation.
+func (m *CandleMutation) Timestamp() (r time.Time, exists bool) {
+	v := m.timestamp
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimestamp returns the old "timestamp" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldTimestamp(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimestamp is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimestamp requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimestamp: %w", err)
+	}
+	return oldValue.Timestamp, nil
+}
+
+// ResetTimestamp resets all changes to the "timestamp" field.
+func (m *CandleMutation) ResetTimestamp() {
+	m.timestamp = nil
+}
+
+// SetOpen sets the "open" field.
+func (m *CandleMutation) SetOpen(d decimal.Decimal) {
+	m.open = &d
+}
+
+// Open returns the value of the "open" field in the mutation.
+func (m *CandleMutation) Open() (r decimal.Decimal, exists bool) {
+	v := m.open
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpen returns the old "open" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldOpen(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpen is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpen requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpen: %w", err)
+	}
+	return oldValue.Open, nil
+}
+
+// ResetOpen resets all changes to the "open" field.
+func (m *CandleMutation) ResetOpen() {
+	m.open = nil
+}
+
+// SetHigh sets the "high" field.
+func (m *CandleMutation) SetHigh(d decimal.Decimal) {
+	m.high = &d
+}
+
+// High returns the value of the "high" field in the mutation.
+func (m *CandleMutation) High() (r decimal.Decimal, exists bool) {
+	v := m.high
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldHigh returns the old "high" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldHigh(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldHigh is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldHigh requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldHigh: %w", err)
+	}
+	return oldValue.High, nil
+}
+
+// ResetHigh resets all changes to the "high" field.
+func (m *CandleMutation) ResetHigh() {
+	m.high = nil
+}
+
+// SetLow sets the "low" field.
+func (m *CandleMutation) SetLow(d decimal.Decimal) {
+	m.low = &d
+}
+
+// Low returns the value of the "low" field in the mutation.
+func (m *CandleMutation) Low() (r decimal.Decimal, exists bool) {
+	v := m.low
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLow returns the old "low" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldLow(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLow is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLow requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLow: %w", err)
+	}
+	return oldValue.Low, nil
+}
+
+// ResetLow resets all changes to the "low" field.
+func (m *CandleMutation) ResetLow() {
+	m.low = nil
+}
+
+// SetClose sets the "close" field.
+func (m *CandleMutation) SetClose(d decimal.Decimal) {
+	m.close = &d
+}
+
+// Close returns the value of the "close" field in the mutation.
+func (m *CandleMutation) Close() (r decimal.Decimal, exists bool) {
+	v := m.close
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClose returns the old "close" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldClose(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClose is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClose requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClose: %w", err)
+	}
+	return oldValue.Close, nil
+}
+
+// ResetClose resets all changes to the "close" field.
+func (m *CandleMutation) ResetClose() {
+	m.close = nil
+}
+
+// SetVolume sets the "volume" field.
+func (m *CandleMutation) SetVolume(i int64) {
+	m.volume = &i
+	m.addvolume = nil
+}
+
+// Volume returns the value of the "volume" field in the mutation.
+func (m *CandleMutation) Volume() (r int64, exists bool) {
+	v := m.volume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldVolume returns the old "volume" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldVolume(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldVolume is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldVolume requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldVolume: %w", err)
+	}
+	return oldValue.Volume, nil
+}
+
+// AddVolume adds i to the "volume" field.
+func (m *CandleMutation) AddVolume(i int64) {
+	if m.addvolume != nil {
+		*m.addvolume += i
+	} else {
+		m.addvolume = &i
+	}
+}
+
+// AddedVolume returns the value that was added to the "volume" field in this mutation.
+func (m *CandleMutation) AddedVolume() (r int64, exists bool) {
+	v := m.addvolume
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetVolume resets all changes to the "volume" field.
+func (m *CandleMutation) ResetVolume() {
+	m.volume = nil
+	m.addvolume = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *CandleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *CandleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Candle entity.
+// If the Candle object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *CandleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *CandleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the CandleMutation builder.
+func (m *CandleMutation) Where(ps ...predicate.Candle) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the CandleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *CandleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Candle, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *CandleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *CandleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Candle).
+func (m *CandleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *CandleMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.symbol != nil {
+		fields = append(fields, candle.FieldSymbol)
+	}
+	if m.exchange != nil {
+		fields = append(fields, candle.FieldExchange)
+	}
+	if m.interval != nil {
+		fields = append(fields, candle.FieldInterval)
+	}
+	if m.timestamp != nil {
+		fields = append(fields, candle.FieldTimestamp)
+	}
+	if m.open != nil {
+		fields = append(fields, candle.FieldOpen)
+	}
+	if m.high != nil {
+		fields = append(fields, candle.FieldHigh)
+	}
+	if m.low != nil {
+		fields = append(fields, candle.FieldLow)
+	}
+	if m.close != nil {
+		fields = append(fields, candle.FieldClose)
+	}
+	if m.volume != nil {
+		fields = append(fields, candle.FieldVolume)
+	}
+	if m.created_at != nil {
+		fields = append(fields, candle.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *CandleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case candle.FieldSymbol:
+		return m.Symbol()
+	case candle.FieldExchange:
+		return m.Exchange()
+	case candle.FieldInterval:
+		return m.Interval()
+	case candle.FieldTimestamp:
+		return m.Timestamp()
+	case candle.FieldOpen:
+		return m.Open()
+	case candle.FieldHigh:
+		return m.High()
+	case candle.FieldLow:
+		return m.Low()
+	case candle.FieldClose:
+		return m.Close()
+	case candle.FieldVolume:
+		return m.Volume()
+	case candle.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *CandleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case candle.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case candle.FieldExchange:
+		return m.OldExchange(ctx)
+	case candle.FieldInterval:
+		return m.OldInterval(ctx)
+	case candle.FieldTimestamp:
+		return m.OldTimestamp(ctx)
+	case candle.FieldOpen:
+		return m.OldOpen(ctx)
+	case candle.FieldHigh:
+		return m.OldHigh(ctx)
+	case candle.FieldLow:
+		return m.OldLow(ctx)
+	case candle.FieldClose:
+		return m.OldClose(ctx)
+	case candle.FieldVolume:
+		return m.OldVolume(ctx)
+	case candle.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Candle field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CandleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case candle.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case candle.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case candle.FieldInterval:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInterval(v)
+		return nil
+	case candle.FieldTimestamp:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimestamp(v)
+		return nil
+	case candle.FieldOpen:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpen(v)
+		return nil
+	case candle.FieldHigh:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetHigh(v)
+		return nil
+	case candle.FieldLow:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLow(v)
+		return nil
+	case candle.FieldClose:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClose(v)
+		return nil
+	case candle.FieldVolume:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetVolume(v)
+		return nil
+	case candle.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Candle field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *CandleMutation) AddedFields() []string {
+	var fields []string
+	if m.addvolume != nil {
+		fields = append(fields, candle.FieldVolume)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *CandleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case candle.FieldVolume:
+		return m.AddedVolume()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *CandleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case candle.FieldVolume:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddVolume(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Candle numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *CandleMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *CandleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *CandleMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Candle nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *CandleMutation) ResetField(name string) error {
+	switch name {
+	case candle.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case candle.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case candle.FieldInterval:
+		m.ResetInterval()
+		return nil
+	case candle.FieldTimestamp:
+		m.ResetTimestamp()
+		return nil
+	case candle.FieldOpen:
+		m.ResetOpen()
+		return nil
+	case candle.FieldHigh:
+		m.ResetHigh()
+		return nil
+	case candle.FieldLow:
+		m.ResetLow()
+		return nil
+	case candle.FieldClose:
+		m.ResetClose()
+		return nil
+	case candle.FieldVolume:
+		m.ResetVolume()
+		return nil
+	case candle.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Candle field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *CandleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *CandleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *CandleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *CandleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *CandleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *CandleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *CandleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Candle unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *CandleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Candle edge %s", name)
+}
+
+// KillSwitchMutation represents an operation that mutates the KillSwitch nodes in the graph.
+type KillSwitchMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *uuid.UUID
+	user_id               *uuid.UUID
+	activated_by          *uuid.UUID
+	reason                *string
+	flatten               *bool
+	stopped_strategies    *int
+	addstopped_strategies *int
+	canceled_orders       *int
+	addcanceled_orders    *int
+	flatten_orders        *int
+	addflatten_orders     *int
+	failed_closes         *int
+	addfailed_closes      *int
+	errors                *[]string
+	appenderrors          []string
+	created_at            *time.Time
+	released_at           *time.Time
+	released_by           *uuid.UUID
+	clearedFields         map[string]struct{}
+	done                  bool
+	oldValue              func(context.Context) (*KillSwitch, error)
+	predicates            []predicate.KillSwitch
+}
+
+var _ ent.Mutation = (*KillSwitchMutation)(nil)
+
+// killswitchOption allows management of the mutation configuration using functional options.
+type killswitchOption func(*KillSwitchMutation)
+
+// newKillSwitchMutation creates new mutation for the KillSwitch entity.
+func newKillSwitchMutation(c config, op Op, opts ...killswitchOption) *KillSwitchMutation {
+	m := &KillSwitchMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeKillSwitch,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withKillSwitchID sets the ID field of the mutation.
+func withKillSwitchID(id uuid.UUID) killswitchOption {
+	return func(m *KillSwitchMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *KillSwitch
+		)
+		m.oldValue = func(ctx context.Context) (*KillSwitch, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().KillSwitch.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withKillSwitch sets the old KillSwitch of the mutation.
+func withKillSwitch(node *KillSwitch) killswitchOption {
+	return func(m *KillSwitchMutation) {
+		m.oldValue = func(context.Context) (*KillSwitch, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m KillSwitchMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m KillSwitchMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of KillSwitch entities.
+func (m *KillSwitchMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *KillSwitchMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *KillSwitchMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().KillSwitch.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *KillSwitchMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *KillSwitchMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldUserID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ClearUserID clears the value of the "user_id" field.
+func (m *KillSwitchMutation) ClearUserID() {
+	m.user_id = nil
+	m.clearedFields[killswitch.FieldUserID] = struct{}{}
+}
+
+// UserIDCleared returns if the "user_id" field was cleared in this mutation.
+func (m *KillSwitchMutation) UserIDCleared() bool {
+	_, ok := m.clearedFields[killswitch.FieldUserID]
+	return ok
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *KillSwitchMutation) ResetUserID() {
+	m.user_id = nil
+	delete(m.clearedFields, killswitch.FieldUserID)
+}
+
+// SetActivatedBy sets the "activated_by" field.
+func (m *KillSwitchMutation) SetActivatedBy(u uuid.UUID) {
+	m.activated_by = &u
+}
+
+// ActivatedBy returns the value of the "activated_by" field in the mutation.
+func (m *KillSwitchMutation) ActivatedBy() (r uuid.UUID, exists bool) {
+	v := m.activated_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActivatedBy returns the old "activated_by" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldActivatedBy(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActivatedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActivatedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActivatedBy: %w", err)
+	}
+	return oldValue.ActivatedBy, nil
+}
+
+// ResetActivatedBy resets all changes to the "activated_by" field.
+func (m *KillSwitchMutation) ResetActivatedBy() {
+	m.activated_by = nil
+}
+
+// SetReason sets the "reason" field.
+func (m *KillSwitchMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *KillSwitchMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ClearReason clears the value of the "reason" field.
+func (m *KillSwitchMutation) ClearReason() {
+	m.reason = nil
+	m.clearedFields[killswitch.FieldReason] = struct{}{}
+}
+
+// ReasonCleared returns if the "reason" field was cleared in this mutation.
+func (m *KillSwitchMutation) ReasonCleared() bool {
+	_, ok := m.clearedFields[killswitch.FieldReason]
+	return ok
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *KillSwitchMutation) ResetReason() {
+	m.reason = nil
+	delete(m.clearedFields, killswitch.FieldReason)
+}
+
+// SetFlatten sets the "flatten" field.
+func (m *KillSwitchMutation) SetFlatten(b bool) {
+	m.flatten = &b
+}
+
+// Flatten returns the value of the "flatten" field in the mutation.
+func (m *KillSwitchMutation) Flatten() (r bool, exists bool) {
+	v := m.flatten
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlatten returns the old "flatten" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldFlatten(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlatten is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlatten requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlatten: %w", err)
+	}
+	return oldValue.Flatten, nil
+}
+
+// ResetFlatten resets all changes to the "flatten" field.
+func (m *KillSwitchMutation) ResetFlatten() {
+	m.flatten = nil
+}
+
+// SetStoppedStrategies sets the "stopped_strategies" field.
+func (m *KillSwitchMutation) SetStoppedStrategies(i int) {
+	m.stopped_strategies = &i
+	m.addstopped_strategies = nil
+}
+
+// StoppedStrategies returns the value of the "stopped_strategies" field in the mutation.
+func (m *KillSwitchMutation) StoppedStrategies() (r int, exists bool) {
+	v := m.stopped_strategies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStoppedStrategies returns the old "stopped_strategies" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldStoppedStrategies(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStoppedStrategies is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStoppedStrategies requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStoppedStrategies: %w", err)
+	}
+	return oldValue.StoppedStrategies, nil
+}
+
+// AddStoppedStrategies adds i to the "stopped_strategies" field.
+func (m *KillSwitchMutation) AddStoppedStrategies(i int) {
+	if m.addstopped_strategies != nil {
+		*m.addstopped_strategies += i
+	} else {
+		m.addstopped_strategies = &i
+	}
+}
+
+// AddedStoppedStrategies returns the value that was added to the "stopped_strategies" field in this mutation.
+func (m *KillSwitchMutation) AddedStoppedStrategies() (r int, exists bool) {
+	v := m.addstopped_strategies
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStoppedStrategies resets all changes to the "stopped_strategies" field.
+func (m *KillSwitchMutation) ResetStoppedStrategies() {
+	m.stopped_strategies = nil
+	m.addstopped_strategies = nil
+}
+
+// SetCanceledOrders sets the "canceled_orders" field.
+func (m *KillSwitchMutation) SetCanceledOrders(i int) {
+	m.canceled_orders = &i
+	m.addcanceled_orders = nil
+}
+
+// CanceledOrders returns the value of the "canceled_orders" field in the mutation.
+func (m *KillSwitchMutation) CanceledOrders() (r int, exists bool) {
+	v := m.canceled_orders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCanceledOrders returns the old "canceled_orders" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldCanceledOrders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCanceledOrders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCanceledOrders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCanceledOrders: %w", err)
+	}
+	return oldValue.CanceledOrders, nil
+}
+
+// AddCanceledOrders adds i to the "canceled_orders" field.
+func (m *KillSwitchMutation) AddCanceledOrders(i int) {
+	if m.addcanceled_orders != nil {
+		*m.addcanceled_orders += i
+	} else {
+		m.addcanceled_orders = &i
+	}
+}
+
+// AddedCanceledOrders returns the value that was added to the "canceled_orders" field in this mutation.
+func (m *KillSwitchMutation) AddedCanceledOrders() (r int, exists bool) {
+	v := m.addcanceled_orders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetCanceledOrders resets all changes to the "canceled_orders" field.
+func (m *KillSwitchMutation) ResetCanceledOrders() {
+	m.canceled_orders = nil
+	m.addcanceled_orders = nil
+}
+
+// SetFlattenOrders sets the "flatten_orders" field.
+func (m *KillSwitchMutation) SetFlattenOrders(i int) {
+	m.flatten_orders = &i
+	m.addflatten_orders = nil
+}
+
+// FlattenOrders returns the value of the "flatten_orders" field in the mutation.
+func (m *KillSwitchMutation) FlattenOrders() (r int, exists bool) {
+	v := m.flatten_orders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFlattenOrders returns the old "flatten_orders" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldFlattenOrders(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFlattenOrders is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFlattenOrders requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFlattenOrders: %w", err)
+	}
+	return oldValue.FlattenOrders, nil
+}
+
+// AddFlattenOrders adds i to the "flatten_orders" field.
+func (m *KillSwitchMutation) AddFlattenOrders(i int) {
+	if m.addflatten_orders != nil {
+		*m.addflatten_orders += i
+	} else {
+		m.addflatten_orders = &i
+	}
+}
+
+// AddedFlattenOrders returns the value that was added to the "flatten_orders" field in this mutation.
+func (m *KillSwitchMutation) AddedFlattenOrders() (r int, exists bool) {
+	v := m.addflatten_orders
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFlattenOrders resets all changes to the "flatten_orders" field.
+func (m *KillSwitchMutation) ResetFlattenOrders() {
+	m.flatten_orders = nil
+	m.addflatten_orders = nil
+}
+
+// SetFailedCloses sets the "failed_closes" field.
+func (m *KillSwitchMutation) SetFailedCloses(i int) {
+	m.failed_closes = &i
+	m.addfailed_closes = nil
+}
+
+// FailedCloses returns the value of the "failed_closes" field in the mutation.
+func (m *KillSwitchMutation) FailedCloses() (r int, exists bool) {
+	v := m.failed_closes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFailedCloses returns the old "failed_closes" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldFailedCloses(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFailedCloses is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFailedCloses requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFailedCloses: %w", err)
+	}
+	return oldValue.FailedCloses, nil
+}
+
+// AddFailedCloses adds i to the "failed_closes" field.
+func (m *KillSwitchMutation) AddFailedCloses(i int) {
+	if m.addfailed_closes != nil {
+		*m.addfailed_closes += i
+	} else {
+		m.addfailed_closes = &i
+	}
+}
+
+// AddedFailedCloses returns the value that was added to the "failed_closes" field in this mutation.
+func (m *KillSwitchMutation) AddedFailedCloses() (r int, exists bool) {
+	v := m.addfailed_closes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetFailedCloses resets all changes to the "failed_closes" field.
+func (m *KillSwitchMutation) ResetFailedCloses() {
+	m.failed_closes = nil
+	m.addfailed_closes = nil
+}
+
+// SetErrors sets the "errors" field.
+func (m *KillSwitchMutation) SetErrors(s []string) {
+	m.errors = &s
+	m.appenderrors = nil
+}
+
+// Errors returns the value of the "errors" field in the mutation.
+func (m *KillSwitchMutation) Errors() (r []string, exists bool) {
+	v := m.errors
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldErrors returns the old "errors" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldErrors(ctx context.Context) (v []string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrors is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrors requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrors: %w", err)
+	}
+	return oldValue.Errors, nil
+}
+
+// AppendErrors adds s to the "errors" field.
+func (m *KillSwitchMutation) AppendErrors(s []string) {
+	m.appenderrors = append(m.appenderrors, s...)
+}
+
+// AppendedErrors returns the list of values that were appended to the "errors" field in this mutation.
+func (m *KillSwitchMutation) AppendedErrors() ([]string, bool) {
+	if len(m.appenderrors) == 0 {
+		return nil, false
+	}
+	return m.appenderrors, true
+}
+
+// ClearErrors clears the value of the "errors" field.
+func (m *KillSwitchMutation) ClearErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	m.clearedFields[killswitch.FieldErrors] = struct{}{}
+}
+
+// ErrorsCleared returns if the "errors" field was cleared in this mutation.
+func (m *KillSwitchMutation) ErrorsCleared() bool {
+	_, ok := m.clearedFields[killswitch.FieldErrors]
+	return ok
+}
+
+// ResetErrors resets all changes to the "errors" field.
+func (m *KillSwitchMutation) ResetErrors() {
+	m.errors = nil
+	m.appenderrors = nil
+	delete(m.clearedFields, killswitch.FieldErrors)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *KillSwitchMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *KillSwitchMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *KillSwitchMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetReleasedAt sets the "released_at" field.
+func (m *KillSwitchMutation) SetReleasedAt(t time.Time) {
+	m.released_at = &t
+}
+
+// ReleasedAt returns the value of the "released_at" field in the mutation.
+func (m *KillSwitchMutation) ReleasedAt() (r time.Time, exists bool) {
+	v := m.released_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedAt returns the old "released_at" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldReleasedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedAt: %w", err)
+	}
+	return oldValue.ReleasedAt, nil
+}
+
+// ClearReleasedAt clears the value of the "released_at" field.
+func (m *KillSwitchMutation) ClearReleasedAt() {
+	m.released_at = nil
+	m.clearedFields[killswitch.FieldReleasedAt] = struct{}{}
+}
+
+// ReleasedAtCleared returns if the "released_at" field was cleared in this mutation.
+func (m *KillSwitchMutation) ReleasedAtCleared() bool {
+	_, ok := m.clearedFields[killswitch.FieldReleasedAt]
+	return ok
+}
+
+// ResetReleasedAt resets all changes to the "released_at" field.
+func (m *KillSwitchMutation) ResetReleasedAt() {
+	m.released_at = nil
+	delete(m.clearedFields, killswitch.FieldReleasedAt)
+}
+
+// SetReleasedBy sets the "released_by" field.
+func (m *KillSwitchMutation) SetReleasedBy(u uuid.UUID) {
+	m.released_by = &u
+}
+
+// ReleasedBy returns the value of the "released_by" field in the mutation.
+func (m *KillSwitchMutation) ReleasedBy() (r uuid.UUID, exists bool) {
+	v := m.released_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReleasedBy returns the old "released_by" field's value of the KillSwitch entity.
+// If the KillSwitch object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *KillSwitchMutation) OldReleasedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReleasedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReleasedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReleasedBy: %w", err)
+	}
+	return oldValue.ReleasedBy, nil
+}
+
+// ClearReleasedBy clears the value of the "released_by" field.
+func (m *KillSwitchMutation) ClearReleasedBy() {
+	m.released_by = nil
+	m.clearedFields[killswitch.FieldReleasedBy] = struct{}{}
+}
+
+// ReleasedByCleared returns if the "released_by" field was cleared in this mutation.
+func (m *KillSwitchMutation) ReleasedByCleared() bool {
+	_, ok := m.clearedFields[killswitch.FieldReleasedBy]
+	return ok
+}
+
+// ResetReleasedBy resets all changes to the "released_by" field.
+func (m *KillSwitchMutation) ResetReleasedBy() {
+	m.released_by = nil
+	delete(m.clearedFields, killswitch.FieldReleasedBy)
+}
+
+// Where appends a list predicates to the KillSwitchMutation builder.
+func (m *KillSwitchMutation) Where(ps ...predicate.KillSwitch) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the KillSwitchMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *KillSwitchMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.KillSwitch, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *KillSwitchMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *KillSwitchMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (KillSwitch).
+func (m *KillSwitchMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *KillSwitchMutation) Fields() []string {
+	fields := make([]string, 0, 12)
+	if m.user_id != nil {
+		fields = append(fields, killswitch.FieldUserID)
+	}
+	if m.activated_by != nil {
+		fields = append(fields, killswitch.FieldActivatedBy)
+	}
+	if m.reason != nil {
+		fields = append(fields, killswitch.FieldReason)
+	}
+	if m.flatten != nil {
+		fields = append(fields, killswitch.FieldFlatten)
+	}
+	if m.stopped_strategies != nil {
+		fields = append(fields, killswitch.FieldStoppedStrategies)
+	}
+	if m.canceled_orders != nil {
+		fields = append(fields, killswitch.FieldCanceledOrders)
+	}
+	if m.flatten_orders != nil {
+		fields = append(fields, killswitch.FieldFlattenOrders)
+	}
+	if m.failed_closes != nil {
+		fields = append(fields, killswitch.FieldFailedCloses)
+	}
+	if m.errors != nil {
+		fields = append(fields, killswitch.FieldErrors)
+	}
+	if m.created_at != nil {
+		fields = append(fields, killswitch.FieldCreatedAt)
+	}
+	if m.released_at != nil {
+		fields = append(fields, killswitch.FieldReleasedAt)
+	}
+	if m.released_by != nil {
+		fields = append(fields, killswitch.FieldReleasedBy)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *KillSwitchMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case killswitch.FieldUserID:
+		return m.UserID()
+	case killswitch.FieldActivatedBy:
+		return m.ActivatedBy()
+	case killswitch.FieldReason:
+		return m.Reason()
+	case killswitch.FieldFlatten:
+		return m.Flatten()
+	case killswitch.FieldStoppedStrategies:
+		return m.StoppedStrategies()
+	case killswitch.FieldCanceledOrders:
+		return m.CanceledOrders()
+	case killswitch.FieldFlattenOrders:
+		return m.FlattenOrders()
+	case killswitch.FieldFailedCloses:
+		return m.FailedCloses()
+	case killswitch.FieldErrors:
+		return m.Errors()
+	case killswitch.FieldCreatedAt:
+		return m.CreatedAt()
+	case killswitch.FieldReleasedAt:
+		return m.ReleasedAt()
+	case killswitch.FieldReleasedBy:
+		return m.ReleasedBy()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *KillSwitchMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case killswitch.FieldUserID:
+		return m.OldUserID(ctx)
+	case killswitch.FieldActivatedBy:
+		return m.OldActivatedBy(ctx)
+	case killswitch.FieldReason:
+		return m.OldReason(ctx)
+	case killswitch.FieldFlatten:
+		return m.OldFlatten(ctx)
+	case killswitch.FieldStoppedStrategies:
+		return m.OldStoppedStrategies(ctx)
+	case killswitch.FieldCanceledOrders:
+		return m.OldCanceledOrders(ctx)
+	case killswitch.FieldFlattenOrders:
+		return m.OldFlattenOrders(ctx)
+	case killswitch.FieldFailedCloses:
+		return m.OldFailedCloses(ctx)
+	case killswitch.FieldErrors:
+		return m.OldErrors(ctx)
+	case killswitch.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case killswitch.FieldReleasedAt:
+		return m.OldReleasedAt(ctx)
+	case killswitch.FieldReleasedBy:
+		return m.OldReleasedBy(ctx)
+	}
+	return nil, fmt.Errorf("unknown KillSwitch field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KillSwitchMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case killswitch.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case killswitch.FieldActivatedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActivatedBy(v)
+		return nil
+	case killswitch.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case killswitch.FieldFlatten:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlatten(v)
+		return nil
+	case killswitch.FieldStoppedStrategies:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStoppedStrategies(v)
+		return nil
+	case killswitch.FieldCanceledOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCanceledOrders(v)
+		return nil
+	case killswitch.FieldFlattenOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFlattenOrders(v)
+		return nil
+	case killswitch.FieldFailedCloses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFailedCloses(v)
+		return nil
+	case killswitch.FieldErrors:
+		v, ok := value.([]string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetErrors(v)
+		return nil
+	case killswitch.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case killswitch.FieldReleasedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedAt(v)
+		return nil
+	case killswitch.FieldReleasedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReleasedBy(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KillSwitch field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *KillSwitchMutation) AddedFields() []string {
+	var fields []string
+	if m.addstopped_strategies != nil {
+		fields = append(fields, killswitch.FieldStoppedStrategies)
+	}
+	if m.addcanceled_orders != nil {
+		fields = append(fields, killswitch.FieldCanceledOrders)
+	}
+	if m.addflatten_orders != nil {
+		fields = append(fields, killswitch.FieldFlattenOrders)
+	}
+	if m.addfailed_closes != nil {
+		fields = append(fields, killswitch.FieldFailedCloses)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *KillSwitchMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case killswitch.FieldStoppedStrategies:
+		return m.AddedStoppedStrategies()
+	case killswitch.FieldCanceledOrders:
+		return m.AddedCanceledOrders()
+	case killswitch.FieldFlattenOrders:
+		return m.AddedFlattenOrders()
+	case killswitch.FieldFailedCloses:
+		return m.AddedFailedCloses()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *KillSwitchMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case killswitch.FieldStoppedStrategies:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStoppedStrategies(v)
+		return nil
+	case killswitch.FieldCanceledOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddCanceledOrders(v)
+		return nil
+	case killswitch.FieldFlattenOrders:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFlattenOrders(v)
+		return nil
+	case killswitch.FieldFailedCloses:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddFailedCloses(v)
+		return nil
+	}
+	return fmt.Errorf("unknown KillSwitch numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *KillSwitchMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(killswitch.FieldUserID) {
+		fields = append(fields, killswitch.FieldUserID)
+	}
+	if m.FieldCleared(killswitch.FieldReason) {
+		fields = append(fields, killswitch.FieldReason)
+	}
+	if m.FieldCleared(killswitch.FieldErrors) {
+		fields = append(fields, killswitch.FieldErrors)
+	}
+	if m.FieldCleared(killswitch.FieldReleasedAt) {
+		fields = append(fields, killswitch.FieldReleasedAt)
+	}
+	if m.FieldCleared(killswitch.FieldReleasedBy) {
+		fields = append(fields, killswitch.FieldReleasedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *KillSwitchMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *KillSwitchMutation) ClearField(name string) error {
+	switch name {
+	case killswitch.FieldUserID:
+		m.ClearUserID()
+		return nil
+	case killswitch.FieldReason:
+		m.ClearReason()
+		return nil
+	case killswitch.FieldErrors:
+		m.ClearErrors()
+		return nil
+	case killswitch.FieldReleasedAt:
+		m.ClearReleasedAt()
+		return nil
+	case killswitch.FieldReleasedBy:
+		m.ClearReleasedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown KillSwitch nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *KillSwitchMutation) ResetField(name string) error {
+	switch name {
+	case killswitch.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case killswitch.FieldActivatedBy:
+		m.ResetActivatedBy()
+		return nil
+	case killswitch.FieldReason:
+		m.ResetReason()
+		return nil
+	case killswitch.FieldFlatten:
+		m.ResetFlatten()
+		return nil
+	case killswitch.FieldStoppedStrategies:
+		m.ResetStoppedStrategies()
+		return nil
+	case killswitch.FieldCanceledOrders:
+		m.ResetCanceledOrders()
+		return nil
+	case killswitch.FieldFlattenOrders:
+		m.ResetFlattenOrders()
+		return nil
+	case killswitch.FieldFailedCloses:
+		m.ResetFailedCloses()
+		return nil
+	case killswitch.FieldErrors:
+		m.ResetErrors()
+		return nil
+	case killswitch.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case killswitch.FieldReleasedAt:
+		m.ResetReleasedAt()
+		return nil
+	case killswitch.FieldReleasedBy:
+		m.ResetReleasedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown KillSwitch field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *KillSwitchMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *KillSwitchMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *KillSwitchMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *KillSwitchMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *KillSwitchMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *KillSwitchMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *KillSwitchMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown KillSwitch unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *KillSwitchMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown KillSwitch edge %s", name)
+}
+
+// OrderMutation represents an operation that mutates the Order nodes in the graph.
+type OrderMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	client_order_id *string
+	broker_order_id *string
+	symbol          *string
+	exchange        *string
+	mode            *order.Mode
+	side            *order.Side
+	order_type      *order.OrderType
+	time_in_force   *order.TimeInForce
+	quantity        *decimal.Decimal
+	price           *decimal.Decimal
+	filled_quantity *decimal.Decimal
+	avg_fill_price  *decimal.Decimal
+	status          *order.Status
+	reject_reason   *string
+	submitted_at    *time.Time
+	completed_at    *time.Time
+	created_at      *time.Time
+	updated_at      *time.Time
+	clearedFields   map[string]struct{}
+	user            *uuid.UUID
+	cleareduser     bool
+	strategy        *uuid.UUID
+	clearedstrategy bool
+	done            bool
+	oldValue        func(context.Context) (*Order, error)
+	predicates      []predicate.Order
+}
+
+var _ ent.Mutation = (*OrderMutation)(nil)
+
+// orderOption allows management of the mutation configuration using functional options.
+type orderOption func(*OrderMutation)
+
+// newOrderMutation creates new mutation for the Order entity.
+func newOrderMutation(c config, op Op, opts ...orderOption) *OrderMutation {
+	m := &OrderMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeOrder,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withOrderID sets the ID field of the mutation.
+func withOrderID(id uuid.UUID) orderOption {
+	return func(m *OrderMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Order
+		)
+		m.oldValue = func(ctx context.Context) (*Order, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Order.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withOrder sets the old Order of the mutation.
+func withOrder(node *Order) orderOption {
+	return func(m *OrderMutation) {
+		m.oldValue = func(context.Context) (*Order, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m OrderMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m OrderMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Order entities.
+func (m *OrderMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *OrderMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *OrderMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Order.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *OrderMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *OrderMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *OrderMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *OrderMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *OrderMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (m *OrderMutation) ClearStrategyID() {
+	m.strategy = nil
+	m.clearedFields[order.FieldStrategyID] = struct{}{}
+}
+
+// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
+func (m *OrderMutation) StrategyIDCleared() bool {
+	_, ok := m.clearedFields[order.FieldStrategyID]
+	return ok
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *OrderMutation) ResetStrategyID() {
+	m.strategy = nil
+	delete(m.clearedFields, order.FieldStrategyID)
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (m *OrderMutation) SetClientOrderID(s string) {
+	m.client_order_id = &s
+}
+
+// ClientOrderID returns the value of the "client_order_id" field in the mutation.
+func (m *OrderMutation) ClientOrderID() (r string, exists bool) {
+	v := m.client_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientOrderID returns the old "client_order_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldClientOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientOrderID: %w", err)
+	}
+	return oldValue.ClientOrderID, nil
+}
+
+// ResetClientOrderID resets all changes to the "client_order_id" field.
+func (m *OrderMutation) ResetClientOrderID() {
+	m.client_order_id = nil
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (m *OrderMutation) SetBrokerOrderID(s string) {
+	m.broker_order_id = &s
+}
+
+// BrokerOrderID returns the value of the "broker_order_id" field in the mutation.
+func (m *OrderMutation) BrokerOrderID() (r string, exists bool) {
+	v := m.broker_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBrokerOrderID returns the old "broker_order_id" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldBrokerOrderID(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrokerOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrokerOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrokerOrderID: %w", err)
+	}
+	return oldValue.BrokerOrderID, nil
+}
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (m *OrderMutation) ClearBrokerOrderID() {
+	m.broker_order_id = nil
+	m.clearedFields[order.FieldBrokerOrderID] = struct{}{}
+}
+
+// BrokerOrderIDCleared returns if the "broker_order_id" field was cleared in this mutation.
+func (m *OrderMutation) BrokerOrderIDCleared() bool {
+	_, ok := m.clearedFields[order.FieldBrokerOrderID]
+	return ok
+}
+
+// ResetBrokerOrderID resets all changes to the "broker_order_id" field.
+func (m *OrderMutation) ResetBrokerOrderID() {
+	m.broker_order_id = nil
+	delete(m.clearedFields, order.FieldBrokerOrderID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *OrderMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *OrderMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *OrderMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *OrderMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *OrderMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (m *OrderMutation) ClearExchange() {
+	m.exchange = nil
+	m.clearedFields[order.FieldExchange] = struct{}{}
+}
+
+// ExchangeCleared returns if the "exchange" field was cleared in this mutation.
+func (m *OrderMutation) ExchangeCleared() bool {
+	_, ok := m.clearedFields[order.FieldExchange]
+	return ok
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *OrderMutation) ResetExchange() {
+	m.exchange = nil
+	delete(m.clearedFields, order.FieldExchange)
+}
+
+// SetMode sets the "mode" field.
+func (m *OrderMutation) SetMode(o order.Mode) {
+	m.mode = &o
+}
+
+// Mode returns the value of the "mode" field in the mutation.
+func (m *OrderMutation) Mode() (r order.Mode, exists bool) {
+	v := m.mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMode returns the old "mode" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldMode(ctx context.Context) (v order.Mode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMode: %w", err)
+	}
+	return oldValue.Mode, nil
+}
+
+// ResetMode resets all changes to the "mode" field.
+func (m *OrderMutation) ResetMode() {
+	m.mode = nil
+}
+
+// SetSide sets the "side" field.
+func (m *OrderMutation) SetSide(o order.Side) {
+	m.side = &o
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *OrderMutation) Side() (r order.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldSide(ctx context.Context) (v order.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *OrderMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetOrderType sets the "order_type" field.
+func (m *OrderMutation) SetOrderType(ot order.OrderType) {
+	m.order_type = &ot
+}
+
+// OrderType returns the value of the "order_type" field in the mutation.
+func (m *OrderMutation) OrderType() (r order.OrderType, exists bool) {
+	v := m.order_type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOrderType returns the old "order_type" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldOrderType(ctx context.Context) (v order.OrderType, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOrderType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOrderType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOrderType: %w", err)
+	}
+	return oldValue.OrderType, nil
+}
+
+// ResetOrderType resets all changes to the "order_type" field.
+func (m *OrderMutation) ResetOrderType() {
+	m.order_type = nil
+}
+
+// SetTimeInForce sets the "time_in_force" field.
+func (m *OrderMutation) SetTimeInForce(oif order.TimeInForce) {
+	m.time_in_force = &oif
+}
+
+// TimeInForce returns the value of the "time_in_force" field in the mutation.
+func (m *OrderMutation) TimeInForce() (r order.TimeInForce, exists bool) {
+	v := m.time_in_force
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTimeInForce returns the old "time_in_force" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldTimeInForce(ctx context.Context) (v order.TimeInForce, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTimeInForce is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTimeInForce requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTimeInForce: %w", err)
+	}
+	return oldValue.TimeInForce, nil
+}
+
+// ResetTimeInForce resets all changes to the "time_in_force" field.
+func (m *OrderMutation) ResetTimeInForce() {
+	m.time_in_force = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *OrderMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *OrderMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *OrderMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *OrderMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *OrderMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *OrderMutation) ClearPrice() {
+	m.price = nil
+	m.clearedFields[order.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *OrderMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[order.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *OrderMutation) ResetPrice() {
+	m.price = nil
+	delete(m.clearedFields, order.FieldPrice)
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (m *OrderMutation) SetFilledQuantity(d decimal.Decimal) {
+	m.filled_quantity = &d
+}
+
+// FilledQuantity returns the value of the "filled_quantity" field in the mutation.
+func (m *OrderMutation) FilledQuantity() (r decimal.Decimal, exists bool) {
+	v := m.filled_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFilledQuantity returns the old "filled_quantity" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldFilledQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilledQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilledQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilledQuantity: %w", err)
+	}
+	return oldValue.FilledQuantity, nil
+}
+
+// ResetFilledQuantity resets all changes to the "filled_quantity" field.
+func (m *OrderMutation) ResetFilledQuantity() {
+	m.filled_quantity = nil
+}
+
+// SetAvgFillPrice sets the "avg_fill_price" field.
+func (m *OrderMutation) SetAvgFillPrice(d decimal.Decimal) {
+	m.avg_fill_price = &d
+}
+
+// AvgFillPrice returns the value of the "avg_fill_price" field in the mutation.
+func (m *OrderMutation) AvgFillPrice() (r decimal.Decimal, exists bool) {
+	v := m.avg_fill_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgFillPrice returns the old "avg_fill_price" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldAvgFillPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgFillPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgFillPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgFillPrice: %w", err)
+	}
+	return oldValue.AvgFillPrice, nil
+}
+
+// ClearAvgFillPrice clears the value of the "avg_fill_price" field.
+func (m *OrderMutation) ClearAvgFillPrice() {
+	m.avg_fill_price = nil
+	m.clearedFields[order.FieldAvgFillPrice] = struct{}{}
+}
+
+// AvgFillPriceCleared returns if the "avg_fill_price" field was cleared in this mutation.
+func (m *OrderMutation) AvgFillPriceCleared() bool {
+	_, ok := m.clearedFields[order.FieldAvgFillPrice]
+	return ok
+}
+
+// ResetAvgFillPrice resets all changes to the "avg_fill_price" field.
+func (m *OrderMutation) ResetAvgFillPrice() {
+	m.avg_fill_price = nil
+	delete(m.clearedFields, order.FieldAvgFillPrice)
+}
+
+// SetStatus sets the "status" field.
+func (m *OrderMutation) SetStatus(o order.Status) {
+	m.status = &o
+}
+
+// Status returns the value of the "status" field in the mutation.
+func (m *OrderMutation) Status() (r order.Status, exists bool) {
+	v := m.status
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStatus returns the old "status" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldStatus(ctx context.Context) (v order.Status, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStatus requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+	}
+	return oldValue.Status, nil
+}
+
+// ResetStatus resets all changes to the "status" field.
+func (m *OrderMutation) ResetStatus() {
+	m.status = nil
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (m *OrderMutation) SetRejectReason(s string) {
+	m.reject_reason = &s
+}
+
+// RejectReason returns the value of the "reject_reason" field in the mutation.
+func (m *OrderMutation) RejectReason() (r string, exists bool) {
+	v := m.reject_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRejectReason returns the old "reject_reason" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldRejectReason(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRejectReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRejectReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRejectReason: %w", err)
+	}
+	return oldValue.RejectReason, nil
+}
+
+// ClearRejectReason clears the value of the "reject_reason" field.
+func (m *OrderMutation) ClearRejectReason() {
+	m.reject_reason = nil
+	m.clearedFields[order.FieldRejectReason] = struct{}{}
+}
+
+// RejectReasonCleared returns if the "reject_reason" field was cleared in this mutation.
+func (m *OrderMutation) RejectReasonCleared() bool {
+	_, ok := m.clearedFields[order.FieldRejectReason]
+	return ok
+}
+
+// ResetRejectReason resets all changes to the "reject_reason" field.
+func (m *OrderMutation) ResetRejectReason() {
+	m.reject_reason = nil
+	delete(m.clearedFields, order.FieldRejectReason)
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (m *OrderMutation) SetSubmittedAt(t time.Time) {
+	m.submitted_at = &t
+}
+
+// SubmittedAt returns the value of the "submitted_at" field in the mutation.
+func (m *OrderMutation) SubmittedAt() (r time.Time, exists bool) {
+	v := m.submitted_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSubmittedAt returns the old "submitted_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldSubmittedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSubmittedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSubmittedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSubmittedAt: %w", err)
+	}
+	return oldValue.SubmittedAt, nil
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (m *OrderMutation) ClearSubmittedAt() {
+	m.submitted_at = nil
+	m.clearedFields[order.FieldSubmittedAt] = struct{}{}
+}
+
+// SubmittedAtCleared returns if the "submitted_at" field was cleared in this mutation.
+func (m *OrderMutation) SubmittedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldSubmittedAt]
+	return ok
+}
+
+// ResetSubmittedAt resets all changes to the "submitted_at" field.
+func (m *OrderMutation) ResetSubmittedAt() {
+	m.submitted_at = nil
+	delete(m.clearedFields, order.FieldSubmittedAt)
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (m *OrderMutation) SetCompletedAt(t time.Time) {
+	m.completed_at = &t
+}
+
+// CompletedAt returns the value of the "completed_at" field in the mutation.
+func (m *OrderMutation) CompletedAt() (r time.Time, exists bool) {
+	v := m.completed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCompletedAt returns the old "completed_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCompletedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCompletedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCompletedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCompletedAt: %w", err)
+	}
+	return oldValue.CompletedAt, nil
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (m *OrderMutation) ClearCompletedAt() {
+	m.completed_at = nil
+	m.clearedFields[order.FieldCompletedAt] = struct{}{}
+}
+
+// CompletedAtCleared returns if the "completed_at" field was cleared in this mutation.
+func (m *OrderMutation) CompletedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldCompletedAt]
+	return ok
+}
+
+// ResetCompletedAt resets all changes to the "completed_at" field.
+func (m *OrderMutation) ResetCompletedAt() {
+	m.completed_at = nil
+	delete(m.clearedFields, order.FieldCompletedAt)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *OrderMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *OrderMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *OrderMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[order.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *OrderMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *OrderMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, order.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *OrderMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *OrderMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Order entity.
+// If the Order object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *OrderMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *OrderMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[order.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *OrderMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[order.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *OrderMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, order.FieldUpdatedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *OrderMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[order.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *OrderMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *OrderMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (m *OrderMutation) ClearStrategy() {
+	m.clearedstrategy = true
+	m.clearedFields[order.FieldStrategyID] = struct{}{}
+}
+
+// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
+func (m *OrderMutation) StrategyCleared() bool {
+	return m.StrategyIDCleared() || m.clearedstrategy
+}
+
+// StrategyIDs returns the "strategy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StrategyID instead. It exists only for internal usage by the builders.
+func (m *OrderMutation) StrategyIDs() (ids []uuid.UUID) {
+	if id := m.strategy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStrategy resets all changes to the "strategy" edge.
+func (m *OrderMutation) ResetStrategy() {
+	m.strategy = nil
+	m.clearedstrategy = false
+}
+
+// Where appends a list predicates to the OrderMutation builder.
+func (m *OrderMutation) Where(ps ...predicate.Order) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the OrderMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *OrderMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Order, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *OrderMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *OrderMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Order).
+func (m *OrderMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *OrderMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.user != nil {
+		fields = append(fields, order.FieldUserID)
+	}
+	if m.strategy != nil {
+		fields = append(fields, order.FieldStrategyID)
+	}
+	if m.client_order_id != nil {
+		fields = append(fields, order.FieldClientOrderID)
+	}
+	if m.broker_order_id != nil {
+		fields = append(fields, order.FieldBrokerOrderID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, order.FieldSymbol)
+	}
+	if m.exchange != nil {
+		fields = append(fields, order.FieldExchange)
+	}
+	if m.mode != nil {
+		fields = append(fields, order.FieldMode)
+	}
+	if m.side != nil {
+		fields = append(fields, order.FieldSide)
+	}
+	if m.order_type != nil {
+		fields = append(fields, order.FieldOrderType)
+	}
+	if m.time_in_force != nil {
+		fields = append(fields, order.FieldTimeInForce)
+	}
+	if m.quantity != nil {
+		fields = append(fields, order.FieldQuantity)
+	}
+	if m.price != nil {
+		fields = append(fields, order.FieldPrice)
+	}
+	if m.filled_quantity != nil {
+		fields = append(fields, order.FieldFilledQuantity)
+	}
+	if m.avg_fill_price != nil {
+		fields = append(fields, order.FieldAvgFillPrice)
+	}
+	if m.status != nil {
+		fields = append(fields, order.FieldStatus)
+	}
+	if m.reject_reason != nil {
+		fields = append(fields, order.FieldRejectReason)
+	}
+	if m.submitted_at != nil {
+		fields = append(fields, order.FieldSubmittedAt)
+	}
+	if m.completed_at != nil {
+		fields = append(fields, order.FieldCompletedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, order.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, order.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *OrderMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case order.FieldUserID:
+		return m.UserID()
+	case order.FieldStrategyID:
+		return m.StrategyID()
+	case order.FieldClientOrderID:
+		return m.ClientOrderID()
+	case order.FieldBrokerOrderID:
+		return m.BrokerOrderID()
+	case order.FieldSymbol:
+		return m.Symbol()
+	case order.FieldExchange:
+		return m.Exchange()
+	case order.FieldMode:
+		return m.Mode()
+	case order.FieldSide:
+		return m.Side()
+	case order.FieldOrderType:
+		return m.OrderType()
+	case order.FieldTimeInForce:
+		return m.TimeInForce()
+	case order.FieldQuantity:
+		return m.Quantity()
+	case order.FieldPrice:
+		return m.Price()
+	case order.FieldFilledQuantity:
+		return m.FilledQuantity()
+	case order.FieldAvgFillPrice:
+		return m.AvgFillPrice()
+	case order.FieldStatus:
+		return m.Status()
+	case order.FieldRejectReason:
+		return m.RejectReason()
+	case order.FieldSubmittedAt:
+		return m.SubmittedAt()
+	case order.FieldCompletedAt:
+		return m.CompletedAt()
+	case order.FieldCreatedAt:
+		return m.CreatedAt()
+	case order.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *OrderMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case order.FieldUserID:
+		return m.OldUserID(ctx)
+	case order.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case order.FieldClientOrderID:
+		return m.OldClientOrderID(ctx)
+	case order.FieldBrokerOrderID:
+		return m.OldBrokerOrderID(ctx)
+	case order.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case order.FieldExchange:
+		return m.OldExchange(ctx)
+	case order.FieldMode:
+		return m.OldMode(ctx)
+	case order.FieldSide:
+		return m.OldSide(ctx)
+	case order.FieldOrderType:
+		return m.OldOrderType(ctx)
+	case order.FieldTimeInForce:
+		return m.OldTimeInForce(ctx)
+	case order.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case order.FieldPrice:
+		return m.OldPrice(ctx)
+	case order.FieldFilledQuantity:
+		return m.OldFilledQuantity(ctx)
+	case order.FieldAvgFillPrice:
+		return m.OldAvgFillPrice(ctx)
+	case order.FieldStatus:
+		return m.OldStatus(ctx)
+	case order.FieldRejectReason:
+		return m.OldRejectReason(ctx)
+	case order.FieldSubmittedAt:
+		return m.OldSubmittedAt(ctx)
+	case order.FieldCompletedAt:
+		return m.OldCompletedAt(ctx)
+	case order.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case order.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Order field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case order.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case order.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case order.FieldClientOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrderID(v)
+		return nil
+	case order.FieldBrokerOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrokerOrderID(v)
+		return nil
+	case order.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case order.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case order.FieldMode:
+		v, ok := value.(order.Mode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMode(v)
+		return nil
+	case order.FieldSide:
+		v, ok := value.(order.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case order.FieldOrderType:
+		v, ok := value.(order.OrderType)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOrderType(v)
+		return nil
+	case order.FieldTimeInForce:
+		v, ok := value.(order.TimeInForce)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTimeInForce(v)
+		return nil
+	case order.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case order.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case order.FieldFilledQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFilledQuantity(v)
+		return nil
+	case order.FieldAvgFillPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgFillPrice(v)
+		return nil
+	case order.FieldStatus:
+		v, ok := value.(order.Status)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStatus(v)
+		return nil
+	case order.FieldRejectReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRejectReason(v)
+		return nil
+	case order.FieldSubmittedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSubmittedAt(v)
+		return nil
+	case order.FieldCompletedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCompletedAt(v)
+		return nil
+	case order.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case order.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *OrderMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *OrderMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *OrderMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Order numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *OrderMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(order.FieldStrategyID) {
+		fields = append(fields, order.FieldStrategyID)
+	}
+	if m.FieldCleared(order.FieldBrokerOrderID) {
+		fields = append(fields, order.FieldBrokerOrderID)
+	}
+	if m.FieldCleared(order.FieldExchange) {
+		fields = append(fields, order.FieldExchange)
+	}
+	if m.FieldCleared(order.FieldPrice) {
+		fields = append(fields, order.FieldPrice)
+	}
+	if m.FieldCleared(order.FieldAvgFillPrice) {
+		fields = append(fields, order.FieldAvgFillPrice)
+	}
+	if m.FieldCleared(order.FieldRejectReason) {
+		fields = append(fields, order.FieldRejectReason)
+	}
+	if m.FieldCleared(order.FieldSubmittedAt) {
+		fields = append(fields, order.FieldSubmittedAt)
+	}
+	if m.FieldCleared(order.FieldCompletedAt) {
+		fields = append(fields, order.FieldCompletedAt)
+	}
+	if m.FieldCleared(order.FieldCreatedAt) {
+		fields = append(fields, order.FieldCreatedAt)
+	}
+	if m.FieldCleared(order.FieldUpdatedAt) {
+		fields = append(fields, order.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *OrderMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *OrderMutation) ClearField(name string) error {
+	switch name {
+	case order.FieldStrategyID:
+		m.ClearStrategyID()
+		return nil
+	case order.FieldBrokerOrderID:
+		m.ClearBrokerOrderID()
+		return nil
+	case order.FieldExchange:
+		m.ClearExchange()
+		return nil
+	case order.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case order.FieldAvgFillPrice:
+		m.ClearAvgFillPrice()
+		return nil
+	case order.FieldRejectReason:
+		m.ClearRejectReason()
+		return nil
+	case order.FieldSubmittedAt:
+		m.ClearSubmittedAt()
+		return nil
+	case order.FieldCompletedAt:
+		m.ClearCompletedAt()
+		return nil
+	case order.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case order.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Order nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *OrderMutation) ResetField(name string) error {
+	switch name {
+	case order.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case order.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case order.FieldClientOrderID:
+		m.ResetClientOrderID()
+		return nil
+	case order.FieldBrokerOrderID:
+		m.ResetBrokerOrderID()
+		return nil
+	case order.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case order.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case order.FieldMode:
+		m.ResetMode()
+		return nil
+	case order.FieldSide:
+		m.ResetSide()
+		return nil
+	case order.FieldOrderType:
+		m.ResetOrderType()
+		return nil
+	case order.FieldTimeInForce:
+		m.ResetTimeInForce()
+		return nil
+	case order.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case order.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case order.FieldFilledQuantity:
+		m.ResetFilledQuantity()
+		return nil
+	case order.FieldAvgFillPrice:
+		m.ResetAvgFillPrice()
+		return nil
+	case order.FieldStatus:
+		m.ResetStatus()
+		return nil
+	case order.FieldRejectReason:
+		m.ResetRejectReason()
+		return nil
+	case order.FieldSubmittedAt:
+		m.ResetSubmittedAt()
+		return nil
+	case order.FieldCompletedAt:
+		m.ResetCompletedAt()
+		return nil
+	case order.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case order.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Order field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *OrderMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.user != nil {
+		edges = append(edges, order.EdgeUser)
+	}
+	if m.strategy != nil {
+		edges = append(edges, order.EdgeStrategy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *OrderMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case order.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case order.EdgeStrategy:
+		if id := m.strategy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *OrderMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *OrderMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *OrderMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.cleareduser {
+		edges = append(edges, order.EdgeUser)
+	}
+	if m.clearedstrategy {
+		edges = append(edges, order.EdgeStrategy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *OrderMutation) EdgeCleared(name string) bool {
+	switch name {
+	case order.EdgeUser:
+		return m.cleareduser
+	case order.EdgeStrategy:
+		return m.clearedstrategy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *OrderMutation) ClearEdge(name string) error {
+	switch name {
+	case order.EdgeUser:
+		m.ClearUser()
+		return nil
+	case order.EdgeStrategy:
+		m.ClearStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown Order unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *OrderMutation) ResetEdge(name string) error {
+	switch name {
+	case order.EdgeUser:
+		m.ResetUser()
+		return nil
+	case order.EdgeStrategy:
+		m.ResetStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown Order edge %s", name)
+}
+
+// PaperAccountMutation represents an operation that mutates the PaperAccount nodes in the graph.
+type PaperAccountMutation struct {
+	config
+	op               Op
+	typ              string
+	id               *uuid.UUID
+	user_id          *uuid.UUID
+	initial_cash     *decimal.Decimal
+	cash             *decimal.Decimal
+	created_at       *time.Time
+	updated_at       *time.Time
+	clearedFields    map[string]struct{}
+	positions        map[uuid.UUID]struct{}
+	removedpositions map[uuid.UUID]struct{}
+	clearedpositions bool
+	trades           map[uuid.UUID]struct{}
+	removedtrades    map[uuid.UUID]struct{}
+	clearedtrades    bool
+	done             bool
+	oldValue         func(context.Context) (*PaperAccount, error)
+	predicates       []predicate.PaperAccount
+}
+
+var _ ent.Mutation = (*PaperAccountMutation)(nil)
+
+// paperaccountOption allows management of the mutation configuration using functional options.
+type paperaccountOption func(*PaperAccountMutation)
+
+// newPaperAccountMutation creates new mutation for the PaperAccount entity.
+func newPaperAccountMutation(c config, op Op, opts ...paperaccountOption) *PaperAccountMutation {
+	m := &PaperAccountMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaperAccount,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaperAccountID sets the ID field of the mutation.
+func withPaperAccountID(id uuid.UUID) paperaccountOption {
+	return func(m *PaperAccountMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaperAccount
+		)
+		m.oldValue = func(ctx context.Context) (*PaperAccount, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaperAccount.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaperAccount sets the old PaperAccount of the mutation.
+func withPaperAccount(node *PaperAccount) paperaccountOption {
+	return func(m *PaperAccountMutation) {
+		m.oldValue = func(context.Context) (*PaperAccount, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaperAccountMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaperAccountMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PaperAccount entities.
+func (m *PaperAccountMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaperAccountMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaperAccountMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaperAccount.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PaperAccountMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PaperAccountMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the PaperAccount entity.
+// If the PaperAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperAccountMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PaperAccountMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetInitialCash sets the "initial_cash" field.
+func (m *PaperAccountMutation) SetInitialCash(d decimal.Decimal) {
+	m.initial_cash = &d
+}
+
+// InitialCash returns the value of the "initial_cash" field in the mutation.
+func (m *PaperAccountMutation) InitialCash() (r decimal.Decimal, exists bool) {
+	v := m.initial_cash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialCash returns the old "initial_cash" field's value of the PaperAccount entity.
+// If the PaperAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperAccountMutation) OldInitialCash(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialCash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialCash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialCash: %w", err)
+	}
+	return oldValue.InitialCash, nil
+}
+
+// ResetInitialCash resets all changes to the "initial_cash" field.
+func (m *PaperAccountMutation) ResetInitialCash() {
+	m.initial_cash = nil
+}
+
+// SetCash sets the "cash" field.
+func (m *PaperAccountMutation) SetCash(d decimal.Decimal) {
+	m.cash = &d
+}
+
+// Cash returns the value of the "cash" field in the mutation.
+func (m *PaperAccountMutation) Cash() (r decimal.Decimal, exists bool) {
+	v := m.cash
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCash returns the old "cash" field's value of the PaperAccount entity.
+// If the PaperAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperAccountMutation) OldCash(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCash is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCash requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCash: %w", err)
+	}
+	return oldValue.Cash, nil
+}
+
+// ResetCash resets all changes to the "cash" field.
+func (m *PaperAccountMutation) ResetCash() {
+	m.cash = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PaperAccountMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PaperAccountMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the PaperAccount entity.
+// If the PaperAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperAccountMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *PaperAccountMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[paperaccount.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *PaperAccountMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[paperaccount.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PaperAccountMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, paperaccount.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaperAccountMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaperAccountMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PaperAccount entity.
+// If the PaperAccount object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperAccountMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *PaperAccountMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[paperaccount.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *PaperAccountMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[paperaccount.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaperAccountMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, paperaccount.FieldUpdatedAt)
+}
+
+// AddPositionIDs adds the "positions" edge to the PaperPosition entity by ids.
+func (m *PaperAccountMutation) AddPositionIDs(ids ...uuid.UUID) {
+	if m.positions == nil {
+		m.positions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.positions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearPositions clears the "positions" edge to the PaperPosition entity.
+func (m *PaperAccountMutation) ClearPositions() {
+	m.clearedpositions = true
+}
+
+// PositionsCleared reports if the "positions" edge to the PaperPosition entity was cleared.
+func (m *PaperAccountMutation) PositionsCleared() bool {
+	return m.clearedpositions
+}
+
+// RemovePositionIDs removes the "positions" edge to the PaperPosition entity by IDs.
+func (m *PaperAccountMutation) RemovePositionIDs(ids ...uuid.UUID) {
+	if m.removedpositions == nil {
+		m.removedpositions = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.positions, ids[i])
+		m.removedpositions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedPositions returns the removed IDs of the "positions" edge to the PaperPosition entity.
+func (m *PaperAccountMutation) RemovedPositionsIDs() (ids []uuid.UUID) {
+	for id := range m.removedpositions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// PositionsIDs returns the "positions" edge IDs in the mutation.
+func (m *PaperAccountMutation) PositionsIDs() (ids []uuid.UUID) {
+	for id := range m.positions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetPositions resets all changes to the "positions" edge.
+func (m *PaperAccountMutation) ResetPositions() {
+	m.positions = nil
+	m.clearedpositions = false
+	m.removedpositions = nil
+}
+
+// AddTradeIDs adds the "trades" edge to the PaperTrade entity by ids.
+func (m *PaperAccountMutation) AddTradeIDs(ids ...uuid.UUID) {
+	if m.trades == nil {
+		m.trades = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.trades[ids[i]] = struct{}{}
+	}
+}
+
+// ClearTrades clears the "trades" edge to the PaperTrade entity.
+func (m *PaperAccountMutation) ClearTrades() {
+	m.clearedtrades = true
+}
+
+// TradesCleared reports if the "trades" edge to the PaperTrade entity was cleared.
+func (m *PaperAccountMutation) TradesCleared() bool {
+	return m.clearedtrades
+}
+
+// RemoveTradeIDs removes the "trades" edge to the PaperTrade entity by IDs.
+func (m *PaperAccountMutation) RemoveTradeIDs(ids ...uuid.UUID) {
+	if m.removedtrades == nil {
+		m.removedtrades = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.trades, ids[i])
+		m.removedtrades[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedTrades returns the removed IDs of the "trades" edge to the PaperTrade entity.
+func (m *PaperAccountMutation) RemovedTradesIDs() (ids []uuid.UUID) {
+	for id := range m.removedtrades {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// TradesIDs returns the "trades" edge IDs in the mutation.
+func (m *PaperAccountMutation) TradesIDs() (ids []uuid.UUID) {
+	for id := range m.trades {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetTrades resets all changes to the "trades" edge.
+func (m *PaperAccountMutation) ResetTrades() {
+	m.trades = nil
+	m.clearedtrades = false
+	m.removedtrades = nil
+}
+
+// Where appends a list predicates to the PaperAccountMutation builder.
+func (m *PaperAccountMutation) Where(ps ...predicate.PaperAccount) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaperAccountMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaperAccountMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaperAccount, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaperAccountMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaperAccountMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaperAccount).
+func (m *PaperAccountMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaperAccountMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.user_id != nil {
+		fields = append(fields, paperaccount.FieldUserID)
+	}
+	if m.initial_cash != nil {
+		fields = append(fields, paperaccount.FieldInitialCash)
+	}
+	if m.cash != nil {
+		fields = append(fields, paperaccount.FieldCash)
+	}
+	if m.created_at != nil {
+		fields = append(fields, paperaccount.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paperaccount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaperAccountMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case paperaccount.FieldUserID:
+		return m.UserID()
+	case paperaccount.FieldInitialCash:
+		return m.InitialCash()
+	case paperaccount.FieldCash:
+		return m.Cash()
+	case paperaccount.FieldCreatedAt:
+		return m.CreatedAt()
+	case paperaccount.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaperAccountMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case paperaccount.FieldUserID:
+		return m.OldUserID(ctx)
+	case paperaccount.FieldInitialCash:
+		return m.OldInitialCash(ctx)
+	case paperaccount.FieldCash:
+		return m.OldCash(ctx)
+	case paperaccount.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case paperaccount.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaperAccount field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperAccountMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case paperaccount.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case paperaccount.FieldInitialCash:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialCash(v)
+		return nil
+	case paperaccount.FieldCash:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCash(v)
+		return nil
+	case paperaccount.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case paperaccount.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaperAccount field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaperAccountMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaperAccountMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperAccountMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PaperAccount numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaperAccountMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(paperaccount.FieldCreatedAt) {
+		fields = append(fields, paperaccount.FieldCreatedAt)
+	}
+	if m.FieldCleared(paperaccount.FieldUpdatedAt) {
+		fields = append(fields, paperaccount.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaperAccountMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaperAccountMutation) ClearField(name string) error {
+	switch name {
+	case paperaccount.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case paperaccount.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperAccount nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaperAccountMutation) ResetField(name string) error {
+	switch name {
+	case paperaccount.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case paperaccount.FieldInitialCash:
+		m.ResetInitialCash()
+		return nil
+	case paperaccount.FieldCash:
+		m.ResetCash()
+		return nil
+	case paperaccount.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case paperaccount.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperAccount field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaperAccountMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.positions != nil {
+		edges = append(edges, paperaccount.EdgePositions)
+	}
+	if m.trades != nil {
+		edges = append(edges, paperaccount.EdgeTrades)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaperAccountMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case paperaccount.EdgePositions:
+		ids := make([]ent.Value, 0, len(m.positions))
+		for id := range m.positions {
+			ids = append(ids, id)
+		}
+		return ids
+	case paperaccount.EdgeTrades:
+		ids := make([]ent.Value, 0, len(m.trades))
+		for id := range m.trades {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaperAccountMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedpositions != nil {
+		edges = append(edges, paperaccount.EdgePositions)
+	}
+	if m.removedtrades != nil {
+		edges = append(edges, paperaccount.EdgeTrades)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaperAccountMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case paperaccount.EdgePositions:
+		ids := make([]ent.Value, 0, len(m.removedpositions))
+		for id := range m.removedpositions {
+			ids = append(ids, id)
+		}
+		return ids
+	case paperaccount.EdgeTrades:
+		ids := make([]ent.Value, 0, len(m.removedtrades))
+		for id := range m.removedtrades {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaperAccountMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedpositions {
+		edges = append(edges, paperaccount.EdgePositions)
+	}
+	if m.clearedtrades {
+		edges = append(edges, paperaccount.EdgeTrades)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaperAccountMutation) EdgeCleared(name string) bool {
+	switch name {
+	case paperaccount.EdgePositions:
+		return m.clearedpositions
+	case paperaccount.EdgeTrades:
+		return m.clearedtrades
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaperAccountMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PaperAccount unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaperAccountMutation) ResetEdge(name string) error {
+	switch name {
+	case paperaccount.EdgePositions:
+		m.ResetPositions()
+		return nil
+	case paperaccount.EdgeTrades:
+		m.ResetTrades()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperAccount edge %s", name)
+}
+
+// PaperPositionMutation represents an operation that mutates the PaperPosition nodes in the graph.
+type PaperPositionMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	symbol         *string
+	quantity       *decimal.Decimal
+	avg_cost       *decimal.Decimal
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	account        *uuid.UUID
+	clearedaccount bool
+	done           bool
+	oldValue       func(context.Context) (*PaperPosition, error)
+	predicates     []predicate.PaperPosition
+}
+
+var _ ent.Mutation = (*PaperPositionMutation)(nil)
+
+// paperpositionOption allows management of the mutation configuration using functional options.
+type paperpositionOption func(*PaperPositionMutation)
+
+// newPaperPositionMutation creates new mutation for the PaperPosition entity.
+func newPaperPositionMutation(c config, op Op, opts ...paperpositionOption) *PaperPositionMutation {
+	m := &PaperPositionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaperPosition,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaperPositionID sets the ID field of the mutation.
+func withPaperPositionID(id uuid.UUID) paperpositionOption {
+	return func(m *PaperPositionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaperPosition
+		)
+		m.oldValue = func(ctx context.Context) (*PaperPosition, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaperPosition.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaperPosition sets the old PaperPosition of the mutation.
+func withPaperPosition(node *PaperPosition) paperpositionOption {
+	return func(m *PaperPositionMutation) {
+		m.oldValue = func(context.Context) (*PaperPosition, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaperPositionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaperPositionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PaperPosition entities.
+func (m *PaperPositionMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaperPositionMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaperPositionMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaperPosition.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *PaperPositionMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *PaperPositionMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the PaperPosition entity.
+// If the PaperPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperPositionMutation) OldAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *PaperPositionMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *PaperPositionMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *PaperPositionMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the PaperPosition entity.
+// If the PaperPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperPositionMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *PaperPositionMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *PaperPositionMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *PaperPositionMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the PaperPosition entity.
+// If the PaperPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperPositionMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *PaperPositionMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetAvgCost sets the "avg_cost" field.
+func (m *PaperPositionMutation) SetAvgCost(d decimal.Decimal) {
+	m.avg_cost = &d
+}
+
+// AvgCost returns the value of the "avg_cost" field in the mutation.
+func (m *PaperPositionMutation) AvgCost() (r decimal.Decimal, exists bool) {
+	v := m.avg_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAvgCost returns the old "avg_cost" field's value of the PaperPosition entity.
+// If the PaperPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperPositionMutation) OldAvgCost(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAvgCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAvgCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAvgCost: %w", err)
+	}
+	return oldValue.AvgCost, nil
+}
+
+// ResetAvgCost resets all changes to the "avg_cost" field.
+func (m *PaperPositionMutation) ResetAvgCost() {
+	m.avg_cost = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PaperPositionMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PaperPositionMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the PaperPosition entity.
+// If the PaperPosition object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperPositionMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *PaperPositionMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[paperposition.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *PaperPositionMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[paperposition.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PaperPositionMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, paperposition.FieldUpdatedAt)
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (m *PaperPositionMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[paperposition.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the PaperAccount entity was cleared.
+func (m *PaperPositionMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *PaperPositionMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *PaperPositionMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the PaperPositionMutation builder.
+func (m *PaperPositionMutation) Where(ps ...predicate.PaperPosition) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaperPositionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaperPositionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaperPosition, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaperPositionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaperPositionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaperPosition).
+func (m *PaperPositionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaperPositionMutation) Fields() []string {
+	fields := make([]string, 0, 5)
+	if m.account != nil {
+		fields = append(fields, paperposition.FieldAccountID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, paperposition.FieldSymbol)
+	}
+	if m.quantity != nil {
+		fields = append(fields, paperposition.FieldQuantity)
+	}
+	if m.avg_cost != nil {
+		fields = append(fields, paperposition.FieldAvgCost)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, paperposition.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaperPositionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case paperposition.FieldAccountID:
+		return m.AccountID()
+	case paperposition.FieldSymbol:
+		return m.Symbol()
+	case paperposition.FieldQuantity:
+		return m.Quantity()
+	case paperposition.FieldAvgCost:
+		return m.AvgCost()
+	case paperposition.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaperPositionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case paperposition.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case paperposition.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case paperposition.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case paperposition.FieldAvgCost:
+		return m.OldAvgCost(ctx)
+	case paperposition.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaperPosition field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperPositionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case paperposition.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case paperposition.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case paperposition.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case paperposition.FieldAvgCost:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgCost(v)
+		return nil
+	case paperposition.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaperPosition field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaperPositionMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaperPositionMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperPositionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PaperPosition numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaperPositionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(paperposition.FieldUpdatedAt) {
+		fields = append(fields, paperposition.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaperPositionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaperPositionMutation) ClearField(name string) error {
+	switch name {
+	case paperposition.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperPosition nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaperPositionMutation) ResetField(name string) error {
+	switch name {
+	case paperposition.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case paperposition.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case paperposition.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case paperposition.FieldAvgCost:
+		m.ResetAvgCost()
+		return nil
+	case paperposition.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperPosition field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaperPositionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.account != nil {
+		edges = append(edges, paperposition.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaperPositionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case paperposition.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaperPositionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaperPositionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaperPositionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccount {
+		edges = append(edges, paperposition.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaperPositionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case paperposition.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaperPositionMutation) ClearEdge(name string) error {
+	switch name {
+	case paperposition.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperPosition unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaperPositionMutation) ResetEdge(name string) error {
+	switch name {
+	case paperposition.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperPosition edge %s", name)
+}
+
+// PaperTradeMutation represents an operation that mutates the PaperTrade nodes in the graph.
+type PaperTradeMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	strategy_id     *uuid.UUID
+	client_order_id *string
+	symbol          *string
+	side            *papertrade.Side
+	quantity        *decimal.Decimal
+	price           *decimal.Decimal
+	commission      *decimal.Decimal
+	realized_pnl    *decimal.Decimal
+	executed_at     *time.Time
+	clearedFields   map[string]struct{}
+	account         *uuid.UUID
+	clearedaccount  bool
+	done            bool
+	oldValue        func(context.Context) (*PaperTrade, error)
+	predicates      []predicate.PaperTrade
+}
+
+var _ ent.Mutation = (*PaperTradeMutation)(nil)
+
+// papertradeOption allows management of the mutation configuration using functional options.
+type papertradeOption func(*PaperTradeMutation)
+
+// newPaperTradeMutation creates new mutation for the PaperTrade entity.
+func newPaperTradeMutation(c config, op Op, opts ...papertradeOption) *PaperTradeMutation {
+	m := &PaperTradeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePaperTrade,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPaperTradeID sets the ID field of the mutation.
+func withPaperTradeID(id uuid.UUID) papertradeOption {
+	return func(m *PaperTradeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *PaperTrade
+		)
+		m.oldValue = func(ctx context.Context) (*PaperTrade, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().PaperTrade.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPaperTrade sets the old PaperTrade of the mutation.
+func withPaperTrade(node *PaperTrade) papertradeOption {
+	return func(m *PaperTradeMutation) {
+		m.oldValue = func(context.Context) (*PaperTrade, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PaperTradeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PaperTradeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of PaperTrade entities.
+func (m *PaperTradeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PaperTradeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PaperTradeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().PaperTrade.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetAccountID sets the "account_id" field.
+func (m *PaperTradeMutation) SetAccountID(u uuid.UUID) {
+	m.account = &u
+}
+
+// AccountID returns the value of the "account_id" field in the mutation.
+func (m *PaperTradeMutation) AccountID() (r uuid.UUID, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccountID returns the old "account_id" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldAccountID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccountID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccountID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccountID: %w", err)
+	}
+	return oldValue.AccountID, nil
+}
+
+// ResetAccountID resets all changes to the "account_id" field.
+func (m *PaperTradeMutation) ResetAccountID() {
+	m.account = nil
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *PaperTradeMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy_id = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *PaperTradeMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (m *PaperTradeMutation) ClearStrategyID() {
+	m.strategy_id = nil
+	m.clearedFields[papertrade.FieldStrategyID] = struct{}{}
+}
+
+// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
+func (m *PaperTradeMutation) StrategyIDCleared() bool {
+	_, ok := m.clearedFields[papertrade.FieldStrategyID]
+	return ok
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *PaperTradeMutation) ResetStrategyID() {
+	m.strategy_id = nil
+	delete(m.clearedFields, papertrade.FieldStrategyID)
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (m *PaperTradeMutation) SetClientOrderID(s string) {
+	m.client_order_id = &s
+}
+
+// ClientOrderID returns the value of the "client_order_id" field in the mutation.
+func (m *PaperTradeMutation) ClientOrderID() (r string, exists bool) {
+	v := m.client_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientOrderID returns the old "client_order_id" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldClientOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientOrderID: %w", err)
+	}
+	return oldValue.ClientOrderID, nil
+}
+
+// ResetClientOrderID resets all changes to the "client_order_id" field.
+func (m *PaperTradeMutation) ResetClientOrderID() {
+	m.client_order_id = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *PaperTradeMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *PaperTradeMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *PaperTradeMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetSide sets the "side" field.
+func (m *PaperTradeMutation) SetSide(pa papertrade.Side) {
+	m.side = &pa
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *PaperTradeMutation) Side() (r papertrade.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldSide(ctx context.Context) (v papertrade.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *PaperTradeMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *PaperTradeMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *PaperTradeMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *PaperTradeMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *PaperTradeMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *PaperTradeMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *PaperTradeMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetCommission sets the "commission" field.
+func (m *PaperTradeMutation) SetCommission(d decimal.Decimal) {
+	m.commission = &d
+}
+
+// Commission returns the value of the "commission" field in the mutation.
+func (m *PaperTradeMutation) Commission() (r decimal.Decimal, exists bool) {
+	v := m.commission
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCommission returns the old "commission" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldCommission(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCommission is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCommission requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCommission: %w", err)
+	}
+	return oldValue.Commission, nil
+}
+
+// ResetCommission resets all changes to the "commission" field.
+func (m *PaperTradeMutation) ResetCommission() {
+	m.commission = nil
+}
+
+// SetRealizedPnl sets the "realized_pnl" field.
+func (m *PaperTradeMutation) SetRealizedPnl(d decimal.Decimal) {
+	m.realized_pnl = &d
+}
+
+// RealizedPnl returns the value of the "realized_pnl" field in the mutation.
+func (m *PaperTradeMutation) RealizedPnl() (r decimal.Decimal, exists bool) {
+	v := m.realized_pnl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealizedPnl returns the old "realized_pnl" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldRealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealizedPnl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealizedPnl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealizedPnl: %w", err)
+	}
+	return oldValue.RealizedPnl, nil
+}
+
+// ResetRealizedPnl resets all changes to the "realized_pnl" field.
+func (m *PaperTradeMutation) ResetRealizedPnl() {
+	m.realized_pnl = nil
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (m *PaperTradeMutation) SetExecutedAt(t time.Time) {
+	m.executed_at = &t
+}
+
+// ExecutedAt returns the value of the "executed_at" field in the mutation.
+func (m *PaperTradeMutation) ExecutedAt() (r time.Time, exists bool) {
+	v := m.executed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutedAt returns the old "executed_at" field's value of the PaperTrade entity.
+// If the PaperTrade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PaperTradeMutation) OldExecutedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+	}
+	return oldValue.ExecutedAt, nil
+}
+
+// ResetExecutedAt resets all changes to the "executed_at" field.
+func (m *PaperTradeMutation) ResetExecutedAt() {
+	m.executed_at = nil
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (m *PaperTradeMutation) ClearAccount() {
+	m.clearedaccount = true
+	m.clearedFields[papertrade.FieldAccountID] = struct{}{}
+}
+
+// AccountCleared reports if the "account" edge to the PaperAccount entity was cleared.
+func (m *PaperTradeMutation) AccountCleared() bool {
+	return m.clearedaccount
+}
+
+// AccountIDs returns the "account" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// AccountID instead. It exists only for internal usage by the builders.
+func (m *PaperTradeMutation) AccountIDs() (ids []uuid.UUID) {
+	if id := m.account; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetAccount resets all changes to the "account" edge.
+func (m *PaperTradeMutation) ResetAccount() {
+	m.account = nil
+	m.clearedaccount = false
+}
+
+// Where appends a list predicates to the PaperTradeMutation builder.
+func (m *PaperTradeMutation) Where(ps ...predicate.PaperTrade) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PaperTradeMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PaperTradeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.PaperTrade, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PaperTradeMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PaperTradeMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (PaperTrade).
+func (m *PaperTradeMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PaperTradeMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.account != nil {
+		fields = append(fields, papertrade.FieldAccountID)
+	}
+	if m.strategy_id != nil {
+		fields = append(fields, papertrade.FieldStrategyID)
+	}
+	if m.client_order_id != nil {
+		fields = append(fields, papertrade.FieldClientOrderID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, papertrade.FieldSymbol)
+	}
+	if m.side != nil {
+		fields = append(fields, papertrade.FieldSide)
+	}
+	if m.quantity != nil {
+		fields = append(fields, papertrade.FieldQuantity)
+	}
+	if m.price != nil {
+		fields = append(fields, papertrade.FieldPrice)
+	}
+	if m.commission != nil {
+		fields = append(fields, papertrade.FieldCommission)
+	}
+	if m.realized_pnl != nil {
+		fields = append(fields, papertrade.FieldRealizedPnl)
+	}
+	if m.executed_at != nil {
+		fields = append(fields, papertrade.FieldExecutedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PaperTradeMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case papertrade.FieldAccountID:
+		return m.AccountID()
+	case papertrade.FieldStrategyID:
+		return m.StrategyID()
+	case papertrade.FieldClientOrderID:
+		return m.ClientOrderID()
+	case papertrade.FieldSymbol:
+		return m.Symbol()
+	case papertrade.FieldSide:
+		return m.Side()
+	case papertrade.FieldQuantity:
+		return m.Quantity()
+	case papertrade.FieldPrice:
+		return m.Price()
+	case papertrade.FieldCommission:
+		return m.Commission()
+	case papertrade.FieldRealizedPnl:
+		return m.RealizedPnl()
+	case papertrade.FieldExecutedAt:
+		return m.ExecutedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PaperTradeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case papertrade.FieldAccountID:
+		return m.OldAccountID(ctx)
+	case papertrade.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case papertrade.FieldClientOrderID:
+		return m.OldClientOrderID(ctx)
+	case papertrade.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case papertrade.FieldSide:
+		return m.OldSide(ctx)
+	case papertrade.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case papertrade.FieldPrice:
+		return m.OldPrice(ctx)
+	case papertrade.FieldCommission:
+		return m.OldCommission(ctx)
+	case papertrade.FieldRealizedPnl:
+		return m.OldRealizedPnl(ctx)
+	case papertrade.FieldExecutedAt:
+		return m.OldExecutedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown PaperTrade field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperTradeMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case papertrade.FieldAccountID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccountID(v)
+		return nil
+	case papertrade.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case papertrade.FieldClientOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrderID(v)
+		return nil
+	case papertrade.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case papertrade.FieldSide:
+		v, ok := value.(papertrade.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case papertrade.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case papertrade.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case papertrade.FieldCommission:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCommission(v)
+		return nil
+	case papertrade.FieldRealizedPnl:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealizedPnl(v)
+		return nil
+	case papertrade.FieldExecutedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown PaperTrade field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PaperTradeMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PaperTradeMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PaperTradeMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown PaperTrade numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PaperTradeMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(papertrade.FieldStrategyID) {
+		fields = append(fields, papertrade.FieldStrategyID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PaperTradeMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PaperTradeMutation) ClearField(name string) error {
+	switch name {
+	case papertrade.FieldStrategyID:
+		m.ClearStrategyID()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperTrade nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PaperTradeMutation) ResetField(name string) error {
+	switch name {
+	case papertrade.FieldAccountID:
+		m.ResetAccountID()
+		return nil
+	case papertrade.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case papertrade.FieldClientOrderID:
+		m.ResetClientOrderID()
+		return nil
+	case papertrade.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case papertrade.FieldSide:
+		m.ResetSide()
+		return nil
+	case papertrade.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case papertrade.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case papertrade.FieldCommission:
+		m.ResetCommission()
+		return nil
+	case papertrade.FieldRealizedPnl:
+		m.ResetRealizedPnl()
+		return nil
+	case papertrade.FieldExecutedAt:
+		m.ResetExecutedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperTrade field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PaperTradeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.account != nil {
+		edges = append(edges, papertrade.EdgeAccount)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PaperTradeMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case papertrade.EdgeAccount:
+		if id := m.account; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PaperTradeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PaperTradeMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PaperTradeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedaccount {
+		edges = append(edges, papertrade.EdgeAccount)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PaperTradeMutation) EdgeCleared(name string) bool {
+	switch name {
+	case papertrade.EdgeAccount:
+		return m.clearedaccount
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PaperTradeMutation) ClearEdge(name string) error {
+	switch name {
+	case papertrade.EdgeAccount:
+		m.ClearAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperTrade unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PaperTradeMutation) ResetEdge(name string) error {
+	switch name {
+	case papertrade.EdgeAccount:
+		m.ResetAccount()
+		return nil
+	}
+	return fmt.Errorf("unknown PaperTrade edge %s", name)
+}
+
+// PortfolioMutation represents an operation that mutates the Portfolio nodes in the graph.
+type PortfolioMutation struct {
+	config
+	op             Op
+	typ            string
+	id             *uuid.UUID
+	symbol         *string
+	exchange       *string
+	quantity       *decimal.Decimal
+	average_price  *decimal.Decimal
+	current_price  *decimal.Decimal
+	market_value   *decimal.Decimal
+	total_cost     *decimal.Decimal
+	unrealized_pnl *decimal.Decimal
+	realized_pnl   *decimal.Decimal
+	last_updated   *time.Time
+	created_at     *time.Time
+	updated_at     *time.Time
+	clearedFields  map[string]struct{}
+	user           *uuid.UUID
+	cleareduser    bool
+	done           bool
+	oldValue       func(context.Context) (*Portfolio, error)
+	predicates     []predicate.Portfolio
+}
+
+var _ ent.Mutation = (*PortfolioMutation)(nil)
+
+// portfolioOption allows management of the mutation configuration using functional options.
+type portfolioOption func(*PortfolioMutation)
+
+// newPortfolioMutation creates new mutation for the Portfolio entity.
+func newPortfolioMutation(c config, op Op, opts ...portfolioOption) *PortfolioMutation {
+	m := &PortfolioMutation{
+		config:        c,
+		op:            op,
+		typ:           TypePortfolio,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withPortfolioID sets the ID field of the mutation.
+func withPortfolioID(id uuid.UUID) portfolioOption {
+	return func(m *PortfolioMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Portfolio
+		)
+		m.oldValue = func(ctx context.Context) (*Portfolio, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Portfolio.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withPortfolio sets the old Portfolio of the mutation.
+func withPortfolio(node *Portfolio) portfolioOption {
+	return func(m *PortfolioMutation) {
+		m.oldValue = func(context.Context) (*Portfolio, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m PortfolioMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m PortfolioMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Portfolio entities.
+func (m *PortfolioMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *PortfolioMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *PortfolioMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Portfolio.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *PortfolioMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *PortfolioMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *PortfolioMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *PortfolioMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *PortfolioMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *PortfolioMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *PortfolioMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *PortfolioMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (m *PortfolioMutation) ClearExchange() {
+	m.exchange = nil
+	m.clearedFields[portfolio.FieldExchange] = struct{}{}
+}
+
+// ExchangeCleared returns if the "exchange" field was cleared in this mutation.
+func (m *PortfolioMutation) ExchangeCleared() bool {
+	_, ok := m.clearedFields[portfolio.FieldExchange]
+	return ok
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *PortfolioMutation) ResetExchange() {
+	m.exchange = nil
+	delete(m.clearedFields, portfolio.FieldExchange)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *PortfolioMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *PortfolioMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *PortfolioMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetAveragePrice sets the "average_price" field.
+func (m *PortfolioMutation) SetAveragePrice(d decimal.Decimal) {
+	m.average_price = &d
+}
+
+// AveragePrice returns the value of the "average_price" field in the mutation.
+func (m *PortfolioMutation) AveragePrice() (r decimal.Decimal, exists bool) {
+	v := m.average_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAveragePrice returns the old "average_price" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldAveragePrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAveragePrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAveragePrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAveragePrice: %w", err)
+	}
+	return oldValue.AveragePrice, nil
+}
+
+// ResetAveragePrice resets all changes to the "average_price" field.
+func (m *PortfolioMutation) ResetAveragePrice() {
+	m.average_price = nil
+}
+
+// SetCurrentPrice sets the "current_price" field.
+func (m *PortfolioMutation) SetCurrentPrice(d decimal.Decimal) {
+	m.current_price = &d
+}
+
+// CurrentPrice returns the value of the "current_price" field in the mutation.
+func (m *PortfolioMutation) CurrentPrice() (r decimal.Decimal, exists bool) {
+	v := m.current_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCurrentPrice returns the old "current_price" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldCurrentPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrentPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrentPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrentPrice: %w", err)
+	}
+	return oldValue.CurrentPrice, nil
+}
+
+// ClearCurrentPrice clears the value of the "current_price" field.
+func (m *PortfolioMutation) ClearCurrentPrice() {
+	m.current_price = nil
+	m.clearedFields[portfolio.FieldCurrentPrice] = struct{}{}
+}
+
+// CurrentPriceCleared returns if the "current_price" field was cleared in this mutation.
+func (m *PortfolioMutation) CurrentPriceCleared() bool {
+	_, ok := m.clearedFields[portfolio.FieldCurrentPrice]
+	return ok
+}
+
+// ResetCurrentPrice resets all changes to the "current_price" field.
+func (m *PortfolioMutation) ResetCurrentPrice() {
+	m.current_price = nil
+	delete(m.clearedFields, portfolio.FieldCurrentPrice)
+}
+
+// SetMarketValue sets the "market_value" field.
+func (m *PortfolioMutation) SetMarketValue(d decimal.Decimal) {
+	m.market_value = &d
+}
+
+// MarketValue returns the value of the "market_value" field in the mutation.
+func (m *PortfolioMutation) MarketValue() (r decimal.Decimal, exists bool) {
+	v := m.market_value
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMarketValue returns the old "market_value" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldMarketValue(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMarketValue is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMarketValue requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMarketValue: %w", err)
+	}
+	return oldValue.MarketValue, nil
+}
+
+// ResetMarketValue resets all changes to the "market_value" field.
+func (m *PortfolioMutation) ResetMarketValue() {
+	m.market_value = nil
+}
+
+// SetTotalCost sets the "total_cost" field.
+func (m *PortfolioMutation) SetTotalCost(d decimal.Decimal) {
+	m.total_cost = &d
+}
+
+// TotalCost returns the value of the "total_cost" field in the mutation.
+func (m *PortfolioMutation) TotalCost() (r decimal.Decimal, exists bool) {
+	v := m.total_cost
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalCost returns the old "total_cost" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldTotalCost(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalCost is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalCost requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalCost: %w", err)
+	}
+	return oldValue.TotalCost, nil
+}
+
+// ResetTotalCost resets all changes to the "total_cost" field.
+func (m *PortfolioMutation) ResetTotalCost() {
+	m.total_cost = nil
+}
+
+// SetUnrealizedPnl sets the "unrealized_pnl" field.
+func (m *PortfolioMutation) SetUnrealizedPnl(d decimal.Decimal) {
+	m.unrealized_pnl = &d
+}
+
+// UnrealizedPnl returns the value of the "unrealized_pnl" field in the mutation.
+func (m *PortfolioMutation) UnrealizedPnl() (r decimal.Decimal, exists bool) {
+	v := m.unrealized_pnl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUnrealizedPnl returns the old "unrealized_pnl" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldUnrealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUnrealizedPnl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUnrealizedPnl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUnrealizedPnl: %w", err)
+	}
+	return oldValue.UnrealizedPnl, nil
+}
+
+// ResetUnrealizedPnl resets all changes to the "unrealized_pnl" field.
+func (m *PortfolioMutation) ResetUnrealizedPnl() {
+	m.unrealized_pnl = nil
+}
+
+// SetRealizedPnl sets the "realized_pnl" field.
+func (m *PortfolioMutation) SetRealizedPnl(d decimal.Decimal) {
+	m.realized_pnl = &d
+}
+
+// RealizedPnl returns the value of the "realized_pnl" field in the mutation.
+func (m *PortfolioMutation) RealizedPnl() (r decimal.Decimal, exists bool) {
+	v := m.realized_pnl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRealizedPnl returns the old "realized_pnl" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldRealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRealizedPnl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRealizedPnl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRealizedPnl: %w", err)
+	}
+	return oldValue.RealizedPnl, nil
+}
+
+// ResetRealizedPnl resets all changes to the "realized_pnl" field.
+func (m *PortfolioMutation) ResetRealizedPnl() {
+	m.realized_pnl = nil
+}
+
+// SetLastUpdated sets the "last_updated" field.
+func (m *PortfolioMutation) SetLastUpdated(t time.Time) {
+	m.last_updated = &t
+}
+
+// LastUpdated returns the value of the "last_updated" field in the mutation.
+func (m *PortfolioMutation) LastUpdated() (r time.Time, exists bool) {
+	v := m.last_updated
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastUpdated returns the old "last_updated" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldLastUpdated(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastUpdated is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastUpdated requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastUpdated: %w", err)
+	}
+	return oldValue.LastUpdated, nil
+}
+
+// ResetLastUpdated resets all changes to the "last_updated" field.
+func (m *PortfolioMutation) ResetLastUpdated() {
+	m.last_updated = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *PortfolioMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *PortfolioMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *PortfolioMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[portfolio.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *PortfolioMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[portfolio.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *PortfolioMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, portfolio.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *PortfolioMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *PortfolioMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Portfolio entity.
+// If the Portfolio object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *PortfolioMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *PortfolioMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[portfolio.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *PortfolioMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[portfolio.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *PortfolioMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, portfolio.FieldUpdatedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *PortfolioMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[portfolio.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *PortfolioMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *PortfolioMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *PortfolioMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// Where appends a list predicates to the PortfolioMutation builder.
+func (m *PortfolioMutation) Where(ps ...predicate.Portfolio) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the PortfolioMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *PortfolioMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Portfolio, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *PortfolioMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *PortfolioMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Portfolio).
+func (m *PortfolioMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *PortfolioMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.user != nil {
+		fields = append(fields, portfolio.FieldUserID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, portfolio.FieldSymbol)
+	}
+	if m.exchange != nil {
+		fields = append(fields, portfolio.FieldExchange)
+	}
+	if m.quantity != nil {
+		fields = append(fields, portfolio.FieldQuantity)
+	}
+	if m.average_price != nil {
+		fields = append(fields, portfolio.FieldAveragePrice)
+	}
+	if m.current_price != nil {
+		fields = append(fields, portfolio.FieldCurrentPrice)
+	}
+	if m.market_value != nil {
+		fields = append(fields, portfolio.FieldMarketValue)
+	}
+	if m.total_cost != nil {
+		fields = append(fields, portfolio.FieldTotalCost)
+	}
+	if m.unrealized_pnl != nil {
+		fields = append(fields, portfolio.FieldUnrealizedPnl)
+	}
+	if m.realized_pnl != nil {
+		fields = append(fields, portfolio.FieldRealizedPnl)
+	}
+	if m.last_updated != nil {
+		fields = append(fields, portfolio.FieldLastUpdated)
+	}
+	if m.created_at != nil {
+		fields = append(fields, portfolio.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, portfolio.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *PortfolioMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case portfolio.FieldUserID:
+		return m.UserID()
+	case portfolio.FieldSymbol:
+		return m.Symbol()
+	case portfolio.FieldExchange:
+		return m.Exchange()
+	case portfolio.FieldQuantity:
+		return m.Quantity()
+	case portfolio.FieldAveragePrice:
+		return m.AveragePrice()
+	case portfolio.FieldCurrentPrice:
+		return m.CurrentPrice()
+	case portfolio.FieldMarketValue:
+		return m.MarketValue()
+	case portfolio.FieldTotalCost:
+		return m.TotalCost()
+	case portfolio.FieldUnrealizedPnl:
+		return m.UnrealizedPnl()
+	case portfolio.FieldRealizedPnl:
+		return m.RealizedPnl()
+	case portfolio.FieldLastUpdated:
+		return m.LastUpdated()
+	case portfolio.FieldCreatedAt:
+		return m.CreatedAt()
+	case portfolio.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *PortfolioMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case portfolio.FieldUserID:
+		return m.OldUserID(ctx)
+	case portfolio.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case portfolio.FieldExchange:
+		return m.OldExchange(ctx)
+	case portfolio.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case portfolio.FieldAveragePrice:
+		return m.OldAveragePrice(ctx)
+	case portfolio.FieldCurrentPrice:
+		return m.OldCurrentPrice(ctx)
+	case portfolio.FieldMarketValue:
+		return m.OldMarketValue(ctx)
+	case portfolio.FieldTotalCost:
+		return m.OldTotalCost(ctx)
+	case portfolio.FieldUnrealizedPnl:
+		return m.OldUnrealizedPnl(ctx)
+	case portfolio.FieldRealizedPnl:
+		return m.OldRealizedPnl(ctx)
+	case portfolio.FieldLastUpdated:
+		return m.OldLastUpdated(ctx)
+	case portfolio.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case portfolio.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Portfolio field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortfolioMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case portfolio.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case portfolio.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case portfolio.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case portfolio.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case portfolio.FieldAveragePrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAveragePrice(v)
+		return nil
+	case portfolio.FieldCurrentPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCurrentPrice(v)
+		return nil
+	case portfolio.FieldMarketValue:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMarketValue(v)
+		return nil
+	case portfolio.FieldTotalCost:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalCost(v)
+		return nil
+	case portfolio.FieldUnrealizedPnl:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUnrealizedPnl(v)
+		return nil
+	case portfolio.FieldRealizedPnl:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRealizedPnl(v)
+		return nil
+	case portfolio.FieldLastUpdated:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastUpdated(v)
+		return nil
+	case portfolio.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case portfolio.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Portfolio field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *PortfolioMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *PortfolioMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *PortfolioMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Portfolio numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *PortfolioMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(portfolio.FieldExchange) {
+		fields = append(fields, portfolio.FieldExchange)
+	}
+	if m.FieldCleared(portfolio.FieldCurrentPrice) {
+		fields = append(fields, portfolio.FieldCurrentPrice)
+	}
+	if m.FieldCleared(portfolio.FieldCreatedAt) {
+		fields = append(fields, portfolio.FieldCreatedAt)
+	}
+	if m.FieldCleared(portfolio.FieldUpdatedAt) {
+		fields = append(fields, portfolio.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *PortfolioMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *PortfolioMutation) ClearField(name string) error {
+	switch name {
+	case portfolio.FieldExchange:
+		m.ClearExchange()
+		return nil
+	case portfolio.FieldCurrentPrice:
+		m.ClearCurrentPrice()
+		return nil
+	case portfolio.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case portfolio.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Portfolio nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *PortfolioMutation) ResetField(name string) error {
+	switch name {
+	case portfolio.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case portfolio.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case portfolio.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case portfolio.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case portfolio.FieldAveragePrice:
+		m.ResetAveragePrice()
+		return nil
+	case portfolio.FieldCurrentPrice:
+		m.ResetCurrentPrice()
+		return nil
+	case portfolio.FieldMarketValue:
+		m.ResetMarketValue()
+		return nil
+	case portfolio.FieldTotalCost:
+		m.ResetTotalCost()
+		return nil
+	case portfolio.FieldUnrealizedPnl:
+		m.ResetUnrealizedPnl()
+		return nil
+	case portfolio.FieldRealizedPnl:
+		m.ResetRealizedPnl()
+		return nil
+	case portfolio.FieldLastUpdated:
+		m.ResetLastUpdated()
+		return nil
+	case portfolio.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case portfolio.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Portfolio field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *PortfolioMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.user != nil {
+		edges = append(edges, portfolio.EdgeUser)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *PortfolioMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case portfolio.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *PortfolioMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *PortfolioMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *PortfolioMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.cleareduser {
+		edges = append(edges, portfolio.EdgeUser)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *PortfolioMutation) EdgeCleared(name string) bool {
+	switch name {
+	case portfolio.EdgeUser:
+		return m.cleareduser
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *PortfolioMutation) ClearEdge(name string) error {
+	switch name {
+	case portfolio.EdgeUser:
+		m.ClearUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Portfolio unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *PortfolioMutation) ResetEdge(name string) error {
+	switch name {
+	case portfolio.EdgeUser:
+		m.ResetUser()
+		return nil
+	}
+	return fmt.Errorf("unknown Portfolio edge %s", name)
+}
+
+// ProtectionRuleMutation represents an operation that mutates the ProtectionRule nodes in the graph.
+type ProtectionRuleMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *uuid.UUID
+	user_id              *uuid.UUID
+	account              *protectionrule.Account
+	strategy_id          *uuid.UUID
+	symbol               *string
+	exchange             *string
+	stop_loss            *float64
+	addstop_loss         *float64
+	take_profit          *float64
+	addtake_profit       *float64
+	trailing_percent     *float64
+	addtrailing_percent  *float64
+	trailing_atr         *float64
+	addtrailing_atr      *float64
+	atr_period           *int
+	addatr_period        *int
+	max_hold_minutes     *int
+	addmax_hold_minutes  *int
+	active               *bool
+	water_mark           *decimal.Decimal
+	opened_at            *time.Time
+	triggered_at         *time.Time
+	trigger_reason       *string
+	trigger_price        *decimal.Decimal
+	exit_client_order_id *string
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	done                 bool
+	oldValue             func(context.Context) (*ProtectionRule, error)
+	predicates           []predicate.ProtectionRule
+}
+
+var _ ent.Mutation = (*ProtectionRuleMutation)(nil)
+
+// protectionruleOption allows management of the mutation configuration using functional options.
+type protectionruleOption func(*ProtectionRuleMutation)
+
+// newProtectionRuleMutation creates new mutation for the ProtectionRule entity.
+func newProtectionRuleMutation(c config, op Op, opts ...protectionruleOption) *ProtectionRuleMutation {
+	m := &ProtectionRuleMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProtectionRule,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProtectionRuleID sets the ID field of the mutation.
+func withProtectionRuleID(id uuid.UUID) protectionruleOption {
+	return func(m *ProtectionRuleMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *ProtectionRule
+		)
+		m.oldValue = func(ctx context.Context) (*ProtectionRule, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().ProtectionRule.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProtectionRule sets the old ProtectionRule of the mutation.
+func withProtectionRule(node *ProtectionRule) protectionruleOption {
+	return func(m *ProtectionRuleMutation) {
+		m.oldValue = func(context.Context) (*ProtectionRule, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProtectionRuleMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProtectionRuleMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of ProtectionRule entities.
+func (m *ProtectionRuleMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProtectionRuleMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProtectionRuleMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().ProtectionRule.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *ProtectionRuleMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *ProtectionRuleMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *ProtectionRuleMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *ProtectionRuleMutation) SetAccount(pr protectionrule.Account) {
+	m.account = &pr
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *ProtectionRuleMutation) Account() (r protectionrule.Account, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldAccount(ctx context.Context) (v protectionrule.Account, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *ProtectionRuleMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *ProtectionRuleMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy_id = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *ProtectionRuleMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (m *ProtectionRuleMutation) ClearStrategyID() {
+	m.strategy_id = nil
+	m.clearedFields[protectionrule.FieldStrategyID] = struct{}{}
+}
+
+// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) StrategyIDCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldStrategyID]
+	return ok
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *ProtectionRuleMutation) ResetStrategyID() {
+	m.strategy_id = nil
+	delete(m.clearedFields, protectionrule.FieldStrategyID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *ProtectionRuleMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *ProtectionRuleMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *ProtectionRuleMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *ProtectionRuleMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *ProtectionRuleMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (m *ProtectionRuleMutation) ClearExchange() {
+	m.exchange = nil
+	m.clearedFields[protectionrule.FieldExchange] = struct{}{}
+}
+
+// ExchangeCleared returns if the "exchange" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) ExchangeCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldExchange]
+	return ok
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *ProtectionRuleMutation) ResetExchange() {
+	m.exchange = nil
+	delete(m.clearedFields, protectionrule.FieldExchange)
+}
+
+// SetStopLoss sets the "stop_loss" field.
+func (m *ProtectionRuleMutation) SetStopLoss(f float64) {
+	m.stop_loss = &f
+	m.addstop_loss = nil
+}
+
+// StopLoss returns the value of the "stop_loss" field in the mutation.
+func (m *ProtectionRuleMutation) StopLoss() (r float64, exists bool) {
+	v := m.stop_loss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStopLoss returns the old "stop_loss" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldStopLoss(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStopLoss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStopLoss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStopLoss: %w", err)
+	}
+	return oldValue.StopLoss, nil
+}
+
+// AddStopLoss adds f to the "stop_loss" field.
+func (m *ProtectionRuleMutation) AddStopLoss(f float64) {
+	if m.addstop_loss != nil {
+		*m.addstop_loss += f
+	} else {
+		m.addstop_loss = &f
+	}
+}
+
+// AddedStopLoss returns the value that was added to the "stop_loss" field in this mutation.
+func (m *ProtectionRuleMutation) AddedStopLoss() (r float64, exists bool) {
+	v := m.addstop_loss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetStopLoss resets all changes to the "stop_loss" field.
+func (m *ProtectionRuleMutation) ResetStopLoss() {
+	m.stop_loss = nil
+	m.addstop_loss = nil
+}
+
+// SetTakeProfit sets the "take_profit" field.
+func (m *ProtectionRuleMutation) SetTakeProfit(f float64) {
+	m.take_profit = &f
+	m.addtake_profit = nil
+}
+
+// TakeProfit returns the value of the "take_profit" field in the mutation.
+func (m *ProtectionRuleMutation) TakeProfit() (r float64, exists bool) {
+	v := m.take_profit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTakeProfit returns the old "take_profit" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTakeProfit(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTakeProfit is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTakeProfit requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTakeProfit: %w", err)
+	}
+	return oldValue.TakeProfit, nil
+}
+
+// AddTakeProfit adds f to the "take_profit" field.
+func (m *ProtectionRuleMutation) AddTakeProfit(f float64) {
+	if m.addtake_profit != nil {
+		*m.addtake_profit += f
+	} else {
+		m.addtake_profit = &f
+	}
+}
+
+// AddedTakeProfit returns the value that was added to the "take_profit" field in this mutation.
+func (m *ProtectionRuleMutation) AddedTakeProfit() (r float64, exists bool) {
+	v := m.addtake_profit
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTakeProfit resets all changes to the "take_profit" field.
+func (m *ProtectionRuleMutation) ResetTakeProfit() {
+	m.take_profit = nil
+	m.addtake_profit = nil
+}
+
+// SetTrailingPercent sets the "trailing_percent" field.
+func (m *ProtectionRuleMutation) SetTrailingPercent(f float64) {
+	m.trailing_percent = &f
+	m.addtrailing_percent = nil
+}
+
+// TrailingPercent returns the value of the "trailing_percent" field in the mutation.
+func (m *ProtectionRuleMutation) TrailingPercent() (r float64, exists bool) {
+	v := m.trailing_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingPercent returns the old "trailing_percent" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTrailingPercent(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingPercent is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingPercent requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingPercent: %w", err)
+	}
+	return oldValue.TrailingPercent, nil
+}
+
+// AddTrailingPercent adds f to the "trailing_percent" field.
+func (m *ProtectionRuleMutation) AddTrailingPercent(f float64) {
+	if m.addtrailing_percent != nil {
+		*m.addtrailing_percent += f
+	} else {
+		m.addtrailing_percent = &f
+	}
+}
+
+// AddedTrailingPercent returns the value that was added to the "trailing_percent" field in this mutation.
+func (m *ProtectionRuleMutation) AddedTrailingPercent() (r float64, exists bool) {
+	v := m.addtrailing_percent
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrailingPercent resets all changes to the "trailing_percent" field.
+func (m *ProtectionRuleMutation) ResetTrailingPercent() {
+	m.trailing_percent = nil
+	m.addtrailing_percent = nil
+}
+
+// SetTrailingAtr sets the "trailing_atr" field.
+func (m *ProtectionRuleMutation) SetTrailingAtr(f float64) {
+	m.trailing_atr = &f
+	m.addtrailing_atr = nil
+}
+
+// TrailingAtr returns the value of the "trailing_atr" field in the mutation.
+func (m *ProtectionRuleMutation) TrailingAtr() (r float64, exists bool) {
+	v := m.trailing_atr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTrailingAtr returns the old "trailing_atr" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTrailingAtr(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTrailingAtr is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTrailingAtr requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTrailingAtr: %w", err)
+	}
+	return oldValue.TrailingAtr, nil
+}
+
+// AddTrailingAtr adds f to the "trailing_atr" field.
+func (m *ProtectionRuleMutation) AddTrailingAtr(f float64) {
+	if m.addtrailing_atr != nil {
+		*m.addtrailing_atr += f
+	} else {
+		m.addtrailing_atr = &f
+	}
+}
+
+// AddedTrailingAtr returns the value that was added to the "trailing_atr" field in this mutation.
+func (m *ProtectionRuleMutation) AddedTrailingAtr() (r float64, exists bool) {
+	v := m.addtrailing_atr
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTrailingAtr resets all changes to the "trailing_atr" field.
+func (m *ProtectionRuleMutation) ResetTrailingAtr() {
+	m.trailing_atr = nil
+	m.addtrailing_atr = nil
+}
+
+// SetAtrPeriod sets the "atr_period" field.
+func (m *ProtectionRuleMutation) SetAtrPeriod(i int) {
+	m.atr_period = &i
+	m.addatr_period = nil
+}
+
+// AtrPeriod returns the value of the "atr_period" field in the mutation.
+func (m *ProtectionRuleMutation) AtrPeriod() (r int, exists bool) {
+	v := m.atr_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAtrPeriod returns the old "atr_period" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldAtrPeriod(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAtrPeriod is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAtrPeriod requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAtrPeriod: %w", err)
+	}
+	return oldValue.AtrPeriod, nil
+}
+
+// AddAtrPeriod adds i to the "atr_period" field.
+func (m *ProtectionRuleMutation) AddAtrPeriod(i int) {
+	if m.addatr_period != nil {
+		*m.addatr_period += i
+	} else {
+		m.addatr_period = &i
+	}
+}
+
+// AddedAtrPeriod returns the value that was added to the "atr_period" field in this mutation.
+func (m *ProtectionRuleMutation) AddedAtrPeriod() (r int, exists bool) {
+	v := m.addatr_period
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAtrPeriod resets all changes to the "atr_period" field.
+func (m *ProtectionRuleMutation) ResetAtrPeriod() {
+	m.atr_period = nil
+	m.addatr_period = nil
+}
+
+// SetMaxHoldMinutes sets the "max_hold_minutes" field.
+func (m *ProtectionRuleMutation) SetMaxHoldMinutes(i int) {
+	m.max_hold_minutes = &i
+	m.addmax_hold_minutes = nil
+}
+
+// MaxHoldMinutes returns the value of the "max_hold_minutes" field in the mutation.
+func (m *ProtectionRuleMutation) MaxHoldMinutes() (r int, exists bool) {
+	v := m.max_hold_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxHoldMinutes returns the old "max_hold_minutes" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldMaxHoldMinutes(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxHoldMinutes is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxHoldMinutes requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxHoldMinutes: %w", err)
+	}
+	return oldValue.MaxHoldMinutes, nil
+}
+
+// AddMaxHoldMinutes adds i to the "max_hold_minutes" field.
+func (m *ProtectionRuleMutation) AddMaxHoldMinutes(i int) {
+	if m.addmax_hold_minutes != nil {
+		*m.addmax_hold_minutes += i
+	} else {
+		m.addmax_hold_minutes = &i
+	}
+}
+
+// AddedMaxHoldMinutes returns the value that was added to the "max_hold_minutes" field in this mutation.
+func (m *ProtectionRuleMutation) AddedMaxHoldMinutes() (r int, exists bool) {
+	v := m.addmax_hold_minutes
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetMaxHoldMinutes resets all changes to the "max_hold_minutes" field.
+func (m *ProtectionRuleMutation) ResetMaxHoldMinutes() {
+	m.max_hold_minutes = nil
+	m.addmax_hold_minutes = nil
+}
+
+// SetActive sets the "active" field.
+func (m *ProtectionRuleMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *ProtectionRuleMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *ProtectionRuleMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetWaterMark sets the "water_mark" field.
+func (m *ProtectionRuleMutation) SetWaterMark(d decimal.Decimal) {
+	m.water_mark = &d
+}
+
+// WaterMark returns the value of the "water_mark" field in the mutation.
+func (m *ProtectionRuleMutation) WaterMark() (r decimal.Decimal, exists bool) {
+	v := m.water_mark
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWaterMark returns the old "water_mark" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldWaterMark(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWaterMark is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWaterMark requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWaterMark: %w", err)
+	}
+	return oldValue.WaterMark, nil
+}
+
+// ResetWaterMark resets all changes to the "water_mark" field.
+func (m *ProtectionRuleMutation) ResetWaterMark() {
+	m.water_mark = nil
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (m *ProtectionRuleMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *ProtectionRuleMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenedAt returns the old "opened_at" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldOpenedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
+	}
+	return oldValue.OpenedAt, nil
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (m *ProtectionRuleMutation) ClearOpenedAt() {
+	m.opened_at = nil
+	m.clearedFields[protectionrule.FieldOpenedAt] = struct{}{}
+}
+
+// OpenedAtCleared returns if the "opened_at" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) OpenedAtCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldOpenedAt]
+	return ok
+}
+
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *ProtectionRuleMutation) ResetOpenedAt() {
+	m.opened_at = nil
+	delete(m.clearedFields, protectionrule.FieldOpenedAt)
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (m *ProtectionRuleMutation) SetTriggeredAt(t time.Time) {
+	m.triggered_at = &t
+}
+
+// TriggeredAt returns the value of the "triggered_at" field in the mutation.
+func (m *ProtectionRuleMutation) TriggeredAt() (r time.Time, exists bool) {
+	v := m.triggered_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggeredAt returns the old "triggered_at" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTriggeredAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggeredAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggeredAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggeredAt: %w", err)
+	}
+	return oldValue.TriggeredAt, nil
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (m *ProtectionRuleMutation) ClearTriggeredAt() {
+	m.triggered_at = nil
+	m.clearedFields[protectionrule.FieldTriggeredAt] = struct{}{}
+}
+
+// TriggeredAtCleared returns if the "triggered_at" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) TriggeredAtCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldTriggeredAt]
+	return ok
+}
+
+// ResetTriggeredAt resets all changes to the "triggered_at" field.
+func (m *ProtectionRuleMutation) ResetTriggeredAt() {
+	m.triggered_at = nil
+	delete(m.clearedFields, protectionrule.FieldTriggeredAt)
+}
+
+// SetTriggerReason sets the "trigger_reason" field.
+func (m *ProtectionRuleMutation) SetTriggerReason(s string) {
+	m.trigger_reason = &s
+}
+
+// TriggerReason returns the value of the "trigger_reason" field in the mutation.
+func (m *ProtectionRuleMutation) TriggerReason() (r string, exists bool) {
+	v := m.trigger_reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerReason returns the old "trigger_reason" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTriggerReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerReason: %w", err)
+	}
+	return oldValue.TriggerReason, nil
+}
+
+// ClearTriggerReason clears the value of the "trigger_reason" field.
+func (m *ProtectionRuleMutation) ClearTriggerReason() {
+	m.trigger_reason = nil
+	m.clearedFields[protectionrule.FieldTriggerReason] = struct{}{}
+}
+
+// TriggerReasonCleared returns if the "trigger_reason" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) TriggerReasonCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldTriggerReason]
+	return ok
+}
+
+// ResetTriggerReason resets all changes to the "trigger_reason" field.
+func (m *ProtectionRuleMutation) ResetTriggerReason() {
+	m.trigger_reason = nil
+	delete(m.clearedFields, protectionrule.FieldTriggerReason)
+}
+
+// SetTriggerPrice sets the "trigger_price" field.
+func (m *ProtectionRuleMutation) SetTriggerPrice(d decimal.Decimal) {
+	m.trigger_price = &d
+}
+
+// TriggerPrice returns the value of the "trigger_price" field in the mutation.
+func (m *ProtectionRuleMutation) TriggerPrice() (r decimal.Decimal, exists bool) {
+	v := m.trigger_price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTriggerPrice returns the old "trigger_price" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldTriggerPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTriggerPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTriggerPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTriggerPrice: %w", err)
+	}
+	return oldValue.TriggerPrice, nil
+}
+
+// ResetTriggerPrice resets all changes to the "trigger_price" field.
+func (m *ProtectionRuleMutation) ResetTriggerPrice() {
+	m.trigger_price = nil
+}
+
+// SetExitClientOrderID sets the "exit_client_order_id" field.
+func (m *ProtectionRuleMutation) SetExitClientOrderID(s string) {
+	m.exit_client_order_id = &s
+}
+
+// ExitClientOrderID returns the value of the "exit_client_order_id" field in the mutation.
+func (m *ProtectionRuleMutation) ExitClientOrderID() (r string, exists bool) {
+	v := m.exit_client_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExitClientOrderID returns the old "exit_client_order_id" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldExitClientOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExitClientOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExitClientOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExitClientOrderID: %w", err)
+	}
+	return oldValue.ExitClientOrderID, nil
+}
+
+// ClearExitClientOrderID clears the value of the "exit_client_order_id" field.
+func (m *ProtectionRuleMutation) ClearExitClientOrderID() {
+	m.exit_client_order_id = nil
+	m.clearedFields[protectionrule.FieldExitClientOrderID] = struct{}{}
+}
+
+// ExitClientOrderIDCleared returns if the "exit_client_order_id" field was cleared in this mutation.
+func (m *ProtectionRuleMutation) ExitClientOrderIDCleared() bool {
+	_, ok := m.clearedFields[protectionrule.FieldExitClientOrderID]
+	return ok
+}
+
+// ResetExitClientOrderID resets all changes to the "exit_client_order_id" field.
+func (m *ProtectionRuleMutation) ResetExitClientOrderID() {
+	m.exit_client_order_id = nil
+	delete(m.clearedFields, protectionrule.FieldExitClientOrderID)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *ProtectionRuleMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ProtectionRuleMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ProtectionRuleMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *ProtectionRuleMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *ProtectionRuleMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the ProtectionRule entity.
+// If the ProtectionRule object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ProtectionRuleMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *ProtectionRuleMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the ProtectionRuleMutation builder.
+func (m *ProtectionRuleMutation) Where(ps ...predicate.ProtectionRule) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the ProtectionRuleMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *ProtectionRuleMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ProtectionRule, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *ProtectionRuleMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *ProtectionRuleMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (ProtectionRule).
+func (m *ProtectionRuleMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *ProtectionRuleMutation) Fields() []string {
+	fields := make([]string, 0, 20)
+	if m.user_id != nil {
+		fields = append(fields, protectionrule.FieldUserID)
+	}
+	if m.account != nil {
+		fields = append(fields, protectionrule.FieldAccount)
+	}
+	if m.strategy_id != nil {
+		fields = append(fields, protectionrule.FieldStrategyID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, protectionrule.FieldSymbol)
+	}
+	if m.exchange != nil {
+		fields = append(fields, protectionrule.FieldExchange)
+	}
+	if m.stop_loss != nil {
+		fields = append(fields, protectionrule.FieldStopLoss)
+	}
+	if m.take_profit != nil {
+		fields = append(fields, protectionrule.FieldTakeProfit)
+	}
+	if m.trailing_percent != nil {
+		fields = append(fields, protectionrule.FieldTrailingPercent)
+	}
+	if m.trailing_atr != nil {
+		fields = append(fields, protectionrule.FieldTrailingAtr)
+	}
+	if m.atr_period != nil {
+		fields = append(fields, protectionrule.FieldAtrPeriod)
+	}
+	if m.max_hold_minutes != nil {
+		fields = append(fields, protectionrule.FieldMaxHoldMinutes)
+	}
+	if m.active != nil {
+		fields = append(fields, protectionrule.FieldActive)
+	}
+	if m.water_mark != nil {
+		fields = append(fields, protectionrule.FieldWaterMark)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, protectionrule.FieldOpenedAt)
+	}
+	if m.triggered_at != nil {
+		fields = append(fields, protectionrule.FieldTriggeredAt)
+	}
+	if m.trigger_reason != nil {
+		fields = append(fields, protectionrule.FieldTriggerReason)
+	}
+	if m.trigger_price != nil {
+		fields = append(fields, protectionrule.FieldTriggerPrice)
+	}
+	if m.exit_client_order_id != nil {
+		fields = append(fields, protectionrule.FieldExitClientOrderID)
+	}
+	if m.created_at != nil {
+		fields = append(fields, protectionrule.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, protectionrule.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *ProtectionRuleMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case protectionrule.FieldUserID:
+		return m.UserID()
+	case protectionrule.FieldAccount:
+		return m.Account()
+	case protectionrule.FieldStrategyID:
+		return m.StrategyID()
+	case protectionrule.FieldSymbol:
+		return m.Symbol()
+	case protectionrule.FieldExchange:
+		return m.Exchange()
+	case protectionrule.FieldStopLoss:
+		return m.StopLoss()
+	case protectionrule.FieldTakeProfit:
+		return m.TakeProfit()
+	case protectionrule.FieldTrailingPercent:
+		return m.TrailingPercent()
+	case protectionrule.FieldTrailingAtr:
+		return m.TrailingAtr()
+	case protectionrule.FieldAtrPeriod:
+		return m.AtrPeriod()
+	case protectionrule.FieldMaxHoldMinutes:
+		return m.MaxHoldMinutes()
+	case protectionrule.FieldActive:
+		return m.Active()
+	case protectionrule.FieldWaterMark:
+		return m.WaterMark()
+	case protectionrule.FieldOpenedAt:
+		return m.OpenedAt()
+	case protectionrule.FieldTriggeredAt:
+		return m.TriggeredAt()
+	case protectionrule.FieldTriggerReason:
+		return m.TriggerReason()
+	case protectionrule.FieldTriggerPrice:
+		return m.TriggerPrice()
+	case protectionrule.FieldExitClientOrderID:
+		return m.ExitClientOrderID()
+	case protectionrule.FieldCreatedAt:
+		return m.CreatedAt()
+	case protectionrule.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *ProtectionRuleMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case protectionrule.FieldUserID:
+		return m.OldUserID(ctx)
+	case protectionrule.FieldAccount:
+		return m.OldAccount(ctx)
+	case protectionrule.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case protectionrule.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case protectionrule.FieldExchange:
+		return m.OldExchange(ctx)
+	case protectionrule.FieldStopLoss:
+		return m.OldStopLoss(ctx)
+	case protectionrule.FieldTakeProfit:
+		return m.OldTakeProfit(ctx)
+	case protectionrule.FieldTrailingPercent:
+		return m.OldTrailingPercent(ctx)
+	case protectionrule.FieldTrailingAtr:
+		return m.OldTrailingAtr(ctx)
+	case protectionrule.FieldAtrPeriod:
+		return m.OldAtrPeriod(ctx)
+	case protectionrule.FieldMaxHoldMinutes:
+		return m.OldMaxHoldMinutes(ctx)
+	case protectionrule.FieldActive:
+		return m.OldActive(ctx)
+	case protectionrule.FieldWaterMark:
+		return m.OldWaterMark(ctx)
+	case protectionrule.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case protectionrule.FieldTriggeredAt:
+		return m.OldTriggeredAt(ctx)
+	case protectionrule.FieldTriggerReason:
+		return m.OldTriggerReason(ctx)
+	case protectionrule.FieldTriggerPrice:
+		return m.OldTriggerPrice(ctx)
+	case protectionrule.FieldExitClientOrderID:
+		return m.OldExitClientOrderID(ctx)
+	case protectionrule.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case protectionrule.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown ProtectionRule field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProtectionRuleMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case protectionrule.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case protectionrule.FieldAccount:
+		v, ok := value.(protectionrule.Account)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case protectionrule.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case protectionrule.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case protectionrule.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case protectionrule.FieldStopLoss:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStopLoss(v)
+		return nil
+	case protectionrule.FieldTakeProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTakeProfit(v)
+		return nil
+	case protectionrule.FieldTrailingPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingPercent(v)
+		return nil
+	case protectionrule.FieldTrailingAtr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTrailingAtr(v)
+		return nil
+	case protectionrule.FieldAtrPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAtrPeriod(v)
+		return nil
+	case protectionrule.FieldMaxHoldMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxHoldMinutes(v)
+		return nil
+	case protectionrule.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case protectionrule.FieldWaterMark:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWaterMark(v)
+		return nil
+	case protectionrule.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case protectionrule.FieldTriggeredAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggeredAt(v)
+		return nil
+	case protectionrule.FieldTriggerReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerReason(v)
+		return nil
+	case protectionrule.FieldTriggerPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTriggerPrice(v)
+		return nil
+	case protectionrule.FieldExitClientOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExitClientOrderID(v)
+		return nil
+	case protectionrule.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case protectionrule.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProtectionRule field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *ProtectionRuleMutation) AddedFields() []string {
+	var fields []string
+	if m.addstop_loss != nil {
+		fields = append(fields, protectionrule.FieldStopLoss)
+	}
+	if m.addtake_profit != nil {
+		fields = append(fields, protectionrule.FieldTakeProfit)
+	}
+	if m.addtrailing_percent != nil {
+		fields = append(fields, protectionrule.FieldTrailingPercent)
+	}
+	if m.addtrailing_atr != nil {
+		fields = append(fields, protectionrule.FieldTrailingAtr)
+	}
+	if m.addatr_period != nil {
+		fields = append(fields, protectionrule.FieldAtrPeriod)
+	}
+	if m.addmax_hold_minutes != nil {
+		fields = append(fields, protectionrule.FieldMaxHoldMinutes)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *ProtectionRuleMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case protectionrule.FieldStopLoss:
+		return m.AddedStopLoss()
+	case protectionrule.FieldTakeProfit:
+		return m.AddedTakeProfit()
+	case protectionrule.FieldTrailingPercent:
+		return m.AddedTrailingPercent()
+	case protectionrule.FieldTrailingAtr:
+		return m.AddedTrailingAtr()
+	case protectionrule.FieldAtrPeriod:
+		return m.AddedAtrPeriod()
+	case protectionrule.FieldMaxHoldMinutes:
+		return m.AddedMaxHoldMinutes()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *ProtectionRuleMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case protectionrule.FieldStopLoss:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddStopLoss(v)
+		return nil
+	case protectionrule.FieldTakeProfit:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTakeProfit(v)
+		return nil
+	case protectionrule.FieldTrailingPercent:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingPercent(v)
+		return nil
+	case protectionrule.FieldTrailingAtr:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTrailingAtr(v)
+		return nil
+	case protectionrule.FieldAtrPeriod:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAtrPeriod(v)
+		return nil
+	case protectionrule.FieldMaxHoldMinutes:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddMaxHoldMinutes(v)
+		return nil
+	}
+	return fmt.Errorf("unknown ProtectionRule numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *ProtectionRuleMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(protectionrule.FieldStrategyID) {
+		fields = append(fields, protectionrule.FieldStrategyID)
+	}
+	if m.FieldCleared(protectionrule.FieldExchange) {
+		fields = append(fields, protectionrule.FieldExchange)
+	}
+	if m.FieldCleared(protectionrule.FieldOpenedAt) {
+		fields = append(fields, protectionrule.FieldOpenedAt)
+	}
+	if m.FieldCleared(protectionrule.FieldTriggeredAt) {
+		fields = append(fields, protectionrule.FieldTriggeredAt)
+	}
+	if m.FieldCleared(protectionrule.FieldTriggerReason) {
+		fields = append(fields, protectionrule.FieldTriggerReason)
+	}
+	if m.FieldCleared(protectionrule.FieldExitClientOrderID) {
+		fields = append(fields, protectionrule.FieldExitClientOrderID)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *ProtectionRuleMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *ProtectionRuleMutation) ClearField(name string) error {
+	switch name {
+	case protectionrule.FieldStrategyID:
+		m.ClearStrategyID()
+		return nil
+	case protectionrule.FieldExchange:
+		m.ClearExchange()
+		return nil
+	case protectionrule.FieldOpenedAt:
+		m.ClearOpenedAt()
+		return nil
+	case protectionrule.FieldTriggeredAt:
+		m.ClearTriggeredAt()
+		return nil
+	case protectionrule.FieldTriggerReason:
+		m.ClearTriggerReason()
+		return nil
+	case protectionrule.FieldExitClientOrderID:
+		m.ClearExitClientOrderID()
+		return nil
+	}
+	return fmt.Errorf("unknown ProtectionRule nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProtectionRuleMutation) ResetField(name string) error {
+	switch name {
+	case protectionrule.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case protectionrule.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case protectionrule.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case protectionrule.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case protectionrule.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case protectionrule.FieldStopLoss:
+		m.ResetStopLoss()
+		return nil
+	case protectionrule.FieldTakeProfit:
+		m.ResetTakeProfit()
+		return nil
+	case protectionrule.FieldTrailingPercent:
+		m.ResetTrailingPercent()
+		return nil
+	case protectionrule.FieldTrailingAtr:
+		m.ResetTrailingAtr()
+		return nil
+	case protectionrule.FieldAtrPeriod:
+		m.ResetAtrPeriod()
+		return nil
+	case protectionrule.FieldMaxHoldMinutes:
+		m.ResetMaxHoldMinutes()
+		return nil
+	case protectionrule.FieldActive:
+		m.ResetActive()
+		return nil
+	case protectionrule.FieldWaterMark:
+		m.ResetWaterMark()
+		return nil
+	case protectionrule.FieldOpenedAt:
+		m.ResetOpenedAt()
+		return nil
+	case protectionrule.FieldTriggeredAt:
+		m.ResetTriggeredAt()
+		return nil
+	case protectionrule.FieldTriggerReason:
+		m.ResetTriggerReason()
+		return nil
+	case protectionrule.FieldTriggerPrice:
+		m.ResetTriggerPrice()
+		return nil
+	case protectionrule.FieldExitClientOrderID:
+		m.ResetExitClientOrderID()
+		return nil
+	case protectionrule.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case protectionrule.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown ProtectionRule field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *ProtectionRuleMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *ProtectionRuleMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *ProtectionRuleMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *ProtectionRuleMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *ProtectionRuleMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *ProtectionRuleMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *ProtectionRuleMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ProtectionRule unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *ProtectionRuleMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ProtectionRule edge %s", name)
+}
+
+// RiskEventMutation represents an operation that mutates the RiskEvent nodes in the graph.
+type RiskEventMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *uuid.UUID
+	user_id         *uuid.UUID
+	account         *riskevent.Account
+	_type           *riskevent.Type
+	rule            *string
+	reason          *string
+	client_order_id *string
+	strategy_id     *uuid.UUID
+	symbol          *string
+	side            *string
+	quantity        *decimal.Decimal
+	price           *decimal.Decimal
+	details         *map[string]interface{}
+	acknowledged_at *time.Time
+	acknowledged_by *uuid.UUID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	done            bool
+	oldValue        func(context.Context) (*RiskEvent, error)
+	predicates      []predicate.RiskEvent
+}
+
+var _ ent.Mutation = (*RiskEventMutation)(nil)
+
+// riskeventOption allows management of the mutation configuration using functional options.
+type riskeventOption func(*RiskEventMutation)
+
+// newRiskEventMutation creates new mutation for the RiskEvent entity.
+func newRiskEventMutation(c config, op Op, opts ...riskeventOption) *RiskEventMutation {
+	m := &RiskEventMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRiskEvent,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRiskEventID sets the ID field of the mutation.
+func withRiskEventID(id uuid.UUID) riskeventOption {
+	return func(m *RiskEventMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RiskEvent
+		)
+		m.oldValue = func(ctx context.Context) (*RiskEvent, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RiskEvent.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRiskEvent sets the old RiskEvent of the mutation.
+func withRiskEvent(node *RiskEvent) riskeventOption {
+	return func(m *RiskEventMutation) {
+		m.oldValue = func(context.Context) (*RiskEvent, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RiskEventMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RiskEventMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RiskEvent entities.
+func (m *RiskEventMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RiskEventMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RiskEventMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RiskEvent.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RiskEventMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RiskEventMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RiskEventMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *RiskEventMutation) SetAccount(r riskevent.Account) {
+	m.account = &r
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *RiskEventMutation) Account() (r riskevent.Account, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldAccount(ctx context.Context) (v riskevent.Account, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *RiskEventMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetType sets the "type" field.
+func (m *RiskEventMutation) SetType(r riskevent.Type) {
+	m._type = &r
+}
+
+// GetType returns the value of the "type" field in the mutation.
+func (m *RiskEventMutation) GetType() (r riskevent.Type, exists bool) {
+	v := m._type
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldType returns the old "type" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldType(ctx context.Context) (v riskevent.Type, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldType is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldType requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldType: %w", err)
+	}
+	return oldValue.Type, nil
+}
+
+// ResetType resets all changes to the "type" field.
+func (m *RiskEventMutation) ResetType() {
+	m._type = nil
+}
+
+// SetRule sets the "rule" field.
+func (m *RiskEventMutation) SetRule(s string) {
+	m.rule = &s
+}
+
+// Rule returns the value of the "rule" field in the mutation.
+func (m *RiskEventMutation) Rule() (r string, exists bool) {
+	v := m.rule
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRule returns the old "rule" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldRule(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRule is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRule requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRule: %w", err)
+	}
+	return oldValue.Rule, nil
+}
+
+// ClearRule clears the value of the "rule" field.
+func (m *RiskEventMutation) ClearRule() {
+	m.rule = nil
+	m.clearedFields[riskevent.FieldRule] = struct{}{}
+}
+
+// RuleCleared returns if the "rule" field was cleared in this mutation.
+func (m *RiskEventMutation) RuleCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldRule]
+	return ok
+}
+
+// ResetRule resets all changes to the "rule" field.
+func (m *RiskEventMutation) ResetRule() {
+	m.rule = nil
+	delete(m.clearedFields, riskevent.FieldRule)
+}
+
+// SetReason sets the "reason" field.
+func (m *RiskEventMutation) SetReason(s string) {
+	m.reason = &s
+}
+
+// Reason returns the value of the "reason" field in the mutation.
+func (m *RiskEventMutation) Reason() (r string, exists bool) {
+	v := m.reason
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReason returns the old "reason" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldReason(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReason requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+	}
+	return oldValue.Reason, nil
+}
+
+// ResetReason resets all changes to the "reason" field.
+func (m *RiskEventMutation) ResetReason() {
+	m.reason = nil
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (m *RiskEventMutation) SetClientOrderID(s string) {
+	m.client_order_id = &s
+}
+
+// ClientOrderID returns the value of the "client_order_id" field in the mutation.
+func (m *RiskEventMutation) ClientOrderID() (r string, exists bool) {
+	v := m.client_order_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClientOrderID returns the old "client_order_id" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldClientOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClientOrderID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClientOrderID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClientOrderID: %w", err)
+	}
+	return oldValue.ClientOrderID, nil
+}
+
+// ClearClientOrderID clears the value of the "client_order_id" field.
+func (m *RiskEventMutation) ClearClientOrderID() {
+	m.client_order_id = nil
+	m.clearedFields[riskevent.FieldClientOrderID] = struct{}{}
+}
+
+// ClientOrderIDCleared returns if the "client_order_id" field was cleared in this mutation.
+func (m *RiskEventMutation) ClientOrderIDCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldClientOrderID]
+	return ok
+}
+
+// ResetClientOrderID resets all changes to the "client_order_id" field.
+func (m *RiskEventMutation) ResetClientOrderID() {
+	m.client_order_id = nil
+	delete(m.clearedFields, riskevent.FieldClientOrderID)
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *RiskEventMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy_id = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *RiskEventMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (m *RiskEventMutation) ClearStrategyID() {
+	m.strategy_id = nil
+	m.clearedFields[riskevent.FieldStrategyID] = struct{}{}
+}
+
+// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
+func (m *RiskEventMutation) StrategyIDCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldStrategyID]
+	return ok
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *RiskEventMutation) ResetStrategyID() {
+	m.strategy_id = nil
+	delete(m.clearedFields, riskevent.FieldStrategyID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *RiskEventMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *RiskEventMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (m *RiskEventMutation) ClearSymbol() {
+	m.symbol = nil
+	m.clearedFields[riskevent.FieldSymbol] = struct{}{}
+}
+
+// SymbolCleared returns if the "symbol" field was cleared in this mutation.
+func (m *RiskEventMutation) SymbolCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldSymbol]
+	return ok
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *RiskEventMutation) ResetSymbol() {
+	m.symbol = nil
+	delete(m.clearedFields, riskevent.FieldSymbol)
+}
+
+// SetSide sets the "side" field.
+func (m *RiskEventMutation) SetSide(s string) {
+	m.side = &s
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *RiskEventMutation) Side() (r string, exists bool) {
+	v := m.side
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSide returns the old "side" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldSide(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
+}
+
+// ClearSide clears the value of the "side" field.
+func (m *RiskEventMutation) ClearSide() {
+	m.side = nil
+	m.clearedFields[riskevent.FieldSide] = struct{}{}
+}
+
+// SideCleared returns if the "side" field was cleared in this mutation.
+func (m *RiskEventMutation) SideCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldSide]
+	return ok
+}
+
+// ResetSide resets all changes to the "side" field.
+func (m *RiskEventMutation) ResetSide() {
+	m.side = nil
+	delete(m.clearedFields, riskevent.FieldSide)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *RiskEventMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *RiskEventMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *RiskEventMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *RiskEventMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *RiskEventMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *RiskEventMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetDetails sets the "details" field.
+func (m *RiskEventMutation) SetDetails(value map[string]interface{}) {
+	m.details = &value
+}
+
+// Details returns the value of the "details" field in the mutation.
+func (m *RiskEventMutation) Details() (r map[string]interface{}, exists bool) {
+	v := m.details
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDetails returns the old "details" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldDetails(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDetails is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDetails requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDetails: %w", err)
+	}
+	return oldValue.Details, nil
+}
+
+// ClearDetails clears the value of the "details" field.
+func (m *RiskEventMutation) ClearDetails() {
+	m.details = nil
+	m.clearedFields[riskevent.FieldDetails] = struct{}{}
+}
+
+// DetailsCleared returns if the "details" field was cleared in this mutation.
+func (m *RiskEventMutation) DetailsCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldDetails]
+	return ok
+}
+
+// ResetDetails resets all changes to the "details" field.
+func (m *RiskEventMutation) ResetDetails() {
+	m.details = nil
+	delete(m.clearedFields, riskevent.FieldDetails)
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (m *RiskEventMutation) SetAcknowledgedAt(t time.Time) {
+	m.acknowledged_at = &t
+}
+
+// AcknowledgedAt returns the value of the "acknowledged_at" field in the mutation.
+func (m *RiskEventMutation) AcknowledgedAt() (r time.Time, exists bool) {
+	v := m.acknowledged_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedAt returns the old "acknowledged_at" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldAcknowledgedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedAt: %w", err)
+	}
+	return oldValue.AcknowledgedAt, nil
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (m *RiskEventMutation) ClearAcknowledgedAt() {
+	m.acknowledged_at = nil
+	m.clearedFields[riskevent.FieldAcknowledgedAt] = struct{}{}
+}
+
+// AcknowledgedAtCleared returns if the "acknowledged_at" field was cleared in this mutation.
+func (m *RiskEventMutation) AcknowledgedAtCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldAcknowledgedAt]
+	return ok
+}
+
+// ResetAcknowledgedAt resets all changes to the "acknowledged_at" field.
+func (m *RiskEventMutation) ResetAcknowledgedAt() {
+	m.acknowledged_at = nil
+	delete(m.clearedFields, riskevent.FieldAcknowledgedAt)
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (m *RiskEventMutation) SetAcknowledgedBy(u uuid.UUID) {
+	m.acknowledged_by = &u
+}
+
+// AcknowledgedBy returns the value of the "acknowledged_by" field in the mutation.
+func (m *RiskEventMutation) AcknowledgedBy() (r uuid.UUID, exists bool) {
+	v := m.acknowledged_by
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAcknowledgedBy returns the old "acknowledged_by" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldAcknowledgedBy(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAcknowledgedBy is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAcknowledgedBy requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAcknowledgedBy: %w", err)
+	}
+	return oldValue.AcknowledgedBy, nil
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (m *RiskEventMutation) ClearAcknowledgedBy() {
+	m.acknowledged_by = nil
+	m.clearedFields[riskevent.FieldAcknowledgedBy] = struct{}{}
+}
+
+// AcknowledgedByCleared returns if the "acknowledged_by" field was cleared in this mutation.
+func (m *RiskEventMutation) AcknowledgedByCleared() bool {
+	_, ok := m.clearedFields[riskevent.FieldAcknowledgedBy]
+	return ok
+}
+
+// ResetAcknowledgedBy resets all changes to the "acknowledged_by" field.
+func (m *RiskEventMutation) ResetAcknowledgedBy() {
+	m.acknowledged_by = nil
+	delete(m.clearedFields, riskevent.FieldAcknowledgedBy)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *RiskEventMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *RiskEventMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the RiskEvent entity.
+// If the RiskEvent object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskEventMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *RiskEventMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the RiskEventMutation builder.
+func (m *RiskEventMutation) Where(ps ...predicate.RiskEvent) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RiskEventMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RiskEventMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RiskEvent, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RiskEventMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RiskEventMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RiskEvent).
+func (m *RiskEventMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RiskEventMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.user_id != nil {
+		fields = append(fields, riskevent.FieldUserID)
+	}
+	if m.account != nil {
+		fields = append(fields, riskevent.FieldAccount)
+	}
+	if m._type != nil {
+		fields = append(fields, riskevent.FieldType)
+	}
+	if m.rule != nil {
+		fields = append(fields, riskevent.FieldRule)
+	}
+	if m.reason != nil {
+		fields = append(fields, riskevent.FieldReason)
+	}
+	if m.client_order_id != nil {
+		fields = append(fields, riskevent.FieldClientOrderID)
+	}
+	if m.strategy_id != nil {
+		fields = append(fields, riskevent.FieldStrategyID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, riskevent.FieldSymbol)
+	}
+	if m.side != nil {
+		fields = append(fields, riskevent.FieldSide)
+	}
+	if m.quantity != nil {
+		fields = append(fields, riskevent.FieldQuantity)
+	}
+	if m.price != nil {
+		fields = append(fields, riskevent.FieldPrice)
+	}
+	if m.details != nil {
+		fields = append(fields, riskevent.FieldDetails)
+	}
+	if m.acknowledged_at != nil {
+		fields = append(fields, riskevent.FieldAcknowledgedAt)
+	}
+	if m.acknowledged_by != nil {
+		fields = append(fields, riskevent.FieldAcknowledgedBy)
+	}
+	if m.created_at != nil {
+		fields = append(fields, riskevent.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RiskEventMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case riskevent.FieldUserID:
+		return m.UserID()
+	case riskevent.FieldAccount:
+		return m.Account()
+	case riskevent.FieldType:
+		return m.GetType()
+	case riskevent.FieldRule:
+		return m.Rule()
+	case riskevent.FieldReason:
+		return m.Reason()
+	case riskevent.FieldClientOrderID:
+		return m.ClientOrderID()
+	case riskevent.FieldStrategyID:
+		return m.StrategyID()
+	case riskevent.FieldSymbol:
+		return m.Symbol()
+	case riskevent.FieldSide:
+		return m.Side()
+	case riskevent.FieldQuantity:
+		return m.Quantity()
+	case riskevent.FieldPrice:
+		return m.Price()
+	case riskevent.FieldDetails:
+		return m.Details()
+	case riskevent.FieldAcknowledgedAt:
+		return m.AcknowledgedAt()
+	case riskevent.FieldAcknowledgedBy:
+		return m.AcknowledgedBy()
+	case riskevent.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RiskEventMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case riskevent.FieldUserID:
+		return m.OldUserID(ctx)
+	case riskevent.FieldAccount:
+		return m.OldAccount(ctx)
+	case riskevent.FieldType:
+		return m.OldType(ctx)
+	case riskevent.FieldRule:
+		return m.OldRule(ctx)
+	case riskevent.FieldReason:
+		return m.OldReason(ctx)
+	case riskevent.FieldClientOrderID:
+		return m.OldClientOrderID(ctx)
+	case riskevent.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case riskevent.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case riskevent.FieldSide:
+		return m.OldSide(ctx)
+	case riskevent.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case riskevent.FieldPrice:
+		return m.OldPrice(ctx)
+	case riskevent.FieldDetails:
+		return m.OldDetails(ctx)
+	case riskevent.FieldAcknowledgedAt:
+		return m.OldAcknowledgedAt(ctx)
+	case riskevent.FieldAcknowledgedBy:
+		return m.OldAcknowledgedBy(ctx)
+	case riskevent.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RiskEvent field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskEventMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case riskevent.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case riskevent.FieldAccount:
+		v, ok := value.(riskevent.Account)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case riskevent.FieldType:
+		v, ok := value.(riskevent.Type)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetType(v)
+		return nil
+	case riskevent.FieldRule:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRule(v)
+		return nil
+	case riskevent.FieldReason:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReason(v)
+		return nil
+	case riskevent.FieldClientOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrderID(v)
+		return nil
+	case riskevent.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case riskevent.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case riskevent.FieldSide:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
+		return nil
+	case riskevent.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case riskevent.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case riskevent.FieldDetails:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDetails(v)
+		return nil
+	case riskevent.FieldAcknowledgedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedAt(v)
+		return nil
+	case riskevent.FieldAcknowledgedBy:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAcknowledgedBy(v)
+		return nil
+	case riskevent.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RiskEvent field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RiskEventMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RiskEventMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskEventMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RiskEvent numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RiskEventMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(riskevent.FieldRule) {
+		fields = append(fields, riskevent.FieldRule)
+	}
+	if m.FieldCleared(riskevent.FieldClientOrderID) {
+		fields = append(fields, riskevent.FieldClientOrderID)
+	}
+	if m.FieldCleared(riskevent.FieldStrategyID) {
+		fields = append(fields, riskevent.FieldStrategyID)
+	}
+	if m.FieldCleared(riskevent.FieldSymbol) {
+		fields = append(fields, riskevent.FieldSymbol)
+	}
+	if m.FieldCleared(riskevent.FieldSide) {
+		fields = append(fields, riskevent.FieldSide)
+	}
+	if m.FieldCleared(riskevent.FieldDetails) {
+		fields = append(fields, riskevent.FieldDetails)
+	}
+	if m.FieldCleared(riskevent.FieldAcknowledgedAt) {
+		fields = append(fields, riskevent.FieldAcknowledgedAt)
+	}
+	if m.FieldCleared(riskevent.FieldAcknowledgedBy) {
+		fields = append(fields, riskevent.FieldAcknowledgedBy)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RiskEventMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RiskEventMutation) ClearField(name string) error {
+	switch name {
+	case riskevent.FieldRule:
+		m.ClearRule()
+		return nil
+	case riskevent.FieldClientOrderID:
+		m.ClearClientOrderID()
+		return nil
+	case riskevent.FieldStrategyID:
+		m.ClearStrategyID()
+		return nil
+	case riskevent.FieldSymbol:
+		m.ClearSymbol()
+		return nil
+	case riskevent.FieldSide:
+		m.ClearSide()
+		return nil
+	case riskevent.FieldDetails:
+		m.ClearDetails()
+		return nil
+	case riskevent.FieldAcknowledgedAt:
+		m.ClearAcknowledgedAt()
+		return nil
+	case riskevent.FieldAcknowledgedBy:
+		m.ClearAcknowledgedBy()
+		return nil
+	}
+	return fmt.Errorf("unknown RiskEvent nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RiskEventMutation) ResetField(name string) error {
+	switch name {
+	case riskevent.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case riskevent.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case riskevent.FieldType:
+		m.ResetType()
+		return nil
+	case riskevent.FieldRule:
+		m.ResetRule()
+		return nil
+	case riskevent.FieldReason:
+		m.ResetReason()
+		return nil
+	case riskevent.FieldClientOrderID:
+		m.ResetClientOrderID()
+		return nil
+	case riskevent.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case riskevent.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case riskevent.FieldSide:
+		m.ResetSide()
+		return nil
+	case riskevent.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case riskevent.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case riskevent.FieldDetails:
+		m.ResetDetails()
+		return nil
+	case riskevent.FieldAcknowledgedAt:
+		m.ResetAcknowledgedAt()
+		return nil
+	case riskevent.FieldAcknowledgedBy:
+		m.ResetAcknowledgedBy()
+		return nil
+	case riskevent.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RiskEvent field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RiskEventMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RiskEventMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RiskEventMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RiskEventMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RiskEventMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RiskEventMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RiskEventMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RiskEvent unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RiskEventMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RiskEvent edge %s", name)
+}
+
+// RiskStateMutation represents an operation that mutates the RiskState nodes in the graph.
+type RiskStateMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	account            *riskstate.Account
+	trading_day        *string
+	daily_realized_pnl *decimal.Decimal
+	open_exposure      *decimal.Decimal
+	equity             *decimal.Decimal
+	peak_equity        *decimal.Decimal
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*RiskState, error)
+	predicates         []predicate.RiskState
+}
+
+var _ ent.Mutation = (*RiskStateMutation)(nil)
+
+// riskstateOption allows management of the mutation configuration using functional options.
+type riskstateOption func(*RiskStateMutation)
+
+// newRiskStateMutation creates new mutation for the RiskState entity.
+func newRiskStateMutation(c config, op Op, opts ...riskstateOption) *RiskStateMutation {
+	m := &RiskStateMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeRiskState,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withRiskStateID sets the ID field of the mutation.
+func withRiskStateID(id uuid.UUID) riskstateOption {
+	return func(m *RiskStateMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *RiskState
+		)
+		m.oldValue = func(ctx context.Context) (*RiskState, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().RiskState.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withRiskState sets the old RiskState of the mutation.
+func withRiskState(node *RiskState) riskstateOption {
+	return func(m *RiskStateMutation) {
+		m.oldValue = func(context.Context) (*RiskState, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m RiskStateMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m RiskStateMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of RiskState entities.
+func (m *RiskStateMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *RiskStateMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *RiskStateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().RiskState.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *RiskStateMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *RiskStateMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *RiskStateMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetAccount sets the "account" field.
+func (m *RiskStateMutation) SetAccount(r riskstate.Account) {
+	m.account = &r
+}
+
+// Account returns the value of the "account" field in the mutation.
+func (m *RiskStateMutation) Account() (r riskstate.Account, exists bool) {
+	v := m.account
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAccount returns the old "account" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldAccount(ctx context.Context) (v riskstate.Account, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAccount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAccount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAccount: %w", err)
+	}
+	return oldValue.Account, nil
+}
+
+// ResetAccount resets all changes to the "account" field.
+func (m *RiskStateMutation) ResetAccount() {
+	m.account = nil
+}
+
+// SetTradingDay sets the "trading_day" field.
+func (m *RiskStateMutation) SetTradingDay(s string) {
+	m.trading_day = &s
+}
+
+// TradingDay returns the value of the "trading_day" field in the mutation.
+func (m *RiskStateMutation) TradingDay() (r string, exists bool) {
+	v := m.trading_day
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTradingDay returns the old "trading_day" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldTradingDay(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradingDay is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradingDay requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradingDay: %w", err)
+	}
+	return oldValue.TradingDay, nil
+}
+
+// ResetTradingDay resets all changes to the "trading_day" field.
+func (m *RiskStateMutation) ResetTradingDay() {
+	m.trading_day = nil
+}
+
+// SetDailyRealizedPnl sets the "daily_realized_pnl" field.
+func (m *RiskStateMutation) SetDailyRealizedPnl(d decimal.Decimal) {
+	m.daily_realized_pnl = &d
+}
+
+// DailyRealizedPnl returns the value of the "daily_realized_pnl" field in the mutation.
+func (m *RiskStateMutation) DailyRealizedPnl() (r decimal.Decimal, exists bool) {
+	v := m.daily_realized_pnl
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDailyRealizedPnl returns the old "daily_realized_pnl" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldDailyRealizedPnl(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDailyRealizedPnl is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDailyRealizedPnl requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDailyRealizedPnl: %w", err)
+	}
+	return oldValue.DailyRealizedPnl, nil
+}
+
+// ResetDailyRealizedPnl resets all changes to the "daily_realized_pnl" field.
+func (m *RiskStateMutation) ResetDailyRealizedPnl() {
+	m.daily_realized_pnl = nil
+}
+
+// SetOpenExposure sets the "open_exposure" field.
+func (m *RiskStateMutation) SetOpenExposure(d decimal.Decimal) {
+	m.open_exposure = &d
+}
+
+// OpenExposure returns the value of the "open_exposure" field in the mutation.
+func (m *RiskStateMutation) OpenExposure() (r decimal.Decimal, exists bool) {
+	v := m.open_exposure
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenExposure returns the old "open_exposure" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldOpenExposure(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenExposure is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenExposure requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenExposure: %w", err)
+	}
+	return oldValue.OpenExposure, nil
+}
+
+// ResetOpenExposure resets all changes to the "open_exposure" field.
+func (m *RiskStateMutation) ResetOpenExposure() {
+	m.open_exposure = nil
+}
+
+// SetEquity sets the "equity" field.
+func (m *RiskStateMutation) SetEquity(d decimal.Decimal) {
+	m.equity = &d
+}
+
+// Equity returns the value of the "equity" field in the mutation.
+func (m *RiskStateMutation) Equity() (r decimal.Decimal, exists bool) {
+	v := m.equity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEquity returns the old "equity" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldEquity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEquity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEquity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEquity: %w", err)
+	}
+	return oldValue.Equity, nil
+}
+
+// ResetEquity resets all changes to the "equity" field.
+func (m *RiskStateMutation) ResetEquity() {
+	m.equity = nil
+}
+
+// SetPeakEquity sets the "peak_equity" field.
+func (m *RiskStateMutation) SetPeakEquity(d decimal.Decimal) {
+	m.peak_equity = &d
+}
+
+// PeakEquity returns the value of the "peak_equity" field in the mutation.
+func (m *RiskStateMutation) PeakEquity() (r decimal.Decimal, exists bool) {
+	v := m.peak_equity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakEquity returns the old "peak_equity" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldPeakEquity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakEquity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakEquity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakEquity: %w", err)
+	}
+	return oldValue.PeakEquity, nil
+}
+
+// ResetPeakEquity resets all changes to the "peak_equity" field.
+func (m *RiskStateMutation) ResetPeakEquity() {
+	m.peak_equity = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *RiskStateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *RiskStateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the RiskState entity.
+// If the RiskState object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *RiskStateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *RiskStateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the RiskStateMutation builder.
+func (m *RiskStateMutation) Where(ps ...predicate.RiskState) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the RiskStateMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *RiskStateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.RiskState, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *RiskStateMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *RiskStateMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (RiskState).
+func (m *RiskStateMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *RiskStateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.user_id != nil {
+		fields = append(fields, riskstate.FieldUserID)
+	}
+	if m.account != nil {
+		fields = append(fields, riskstate.FieldAccount)
+	}
+	if m.trading_day != nil {
+		fields = append(fields, riskstate.FieldTradingDay)
+	}
+	if m.daily_realized_pnl != nil {
+		fields = append(fields, riskstate.FieldDailyRealizedPnl)
+	}
+	if m.open_exposure != nil {
+		fields = append(fields, riskstate.FieldOpenExposure)
+	}
+	if m.equity != nil {
+		fields = append(fields, riskstate.FieldEquity)
+	}
+	if m.peak_equity != nil {
+		fields = append(fields, riskstate.FieldPeakEquity)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, riskstate.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *RiskStateMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case riskstate.FieldUserID:
+		return m.UserID()
+	case riskstate.FieldAccount:
+		return m.Account()
+	case riskstate.FieldTradingDay:
+		return m.TradingDay()
+	case riskstate.FieldDailyRealizedPnl:
+		return m.DailyRealizedPnl()
+	case riskstate.FieldOpenExposure:
+		return m.OpenExposure()
+	case riskstate.FieldEquity:
+		return m.Equity()
+	case riskstate.FieldPeakEquity:
+		return m.PeakEquity()
+	case riskstate.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *RiskStateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case riskstate.FieldUserID:
+		return m.OldUserID(ctx)
+	case riskstate.FieldAccount:
+		return m.OldAccount(ctx)
+	case riskstate.FieldTradingDay:
+		return m.OldTradingDay(ctx)
+	case riskstate.FieldDailyRealizedPnl:
+		return m.OldDailyRealizedPnl(ctx)
+	case riskstate.FieldOpenExposure:
+		return m.OldOpenExposure(ctx)
+	case riskstate.FieldEquity:
+		return m.OldEquity(ctx)
+	case riskstate.FieldPeakEquity:
+		return m.OldPeakEquity(ctx)
+	case riskstate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown RiskState field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskStateMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case riskstate.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case riskstate.FieldAccount:
+		v, ok := value.(riskstate.Account)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAccount(v)
+		return nil
+	case riskstate.FieldTradingDay:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradingDay(v)
+		return nil
+	case riskstate.FieldDailyRealizedPnl:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDailyRealizedPnl(v)
+		return nil
+	case riskstate.FieldOpenExposure:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenExposure(v)
+		return nil
+	case riskstate.FieldEquity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEquity(v)
+		return nil
+	case riskstate.FieldPeakEquity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakEquity(v)
+		return nil
+	case riskstate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown RiskState field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *RiskStateMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *RiskStateMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *RiskStateMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown RiskState numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *RiskStateMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *RiskStateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *RiskStateMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown RiskState nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *RiskStateMutation) ResetField(name string) error {
+	switch name {
+	case riskstate.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case riskstate.FieldAccount:
+		m.ResetAccount()
+		return nil
+	case riskstate.FieldTradingDay:
+		m.ResetTradingDay()
+		return nil
+	case riskstate.FieldDailyRealizedPnl:
+		m.ResetDailyRealizedPnl()
+		return nil
+	case riskstate.FieldOpenExposure:
+		m.ResetOpenExposure()
+		return nil
+	case riskstate.FieldEquity:
+		m.ResetEquity()
+		return nil
+	case riskstate.FieldPeakEquity:
+		m.ResetPeakEquity()
+		return nil
+	case riskstate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown RiskState field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *RiskStateMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *RiskStateMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *RiskStateMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *RiskStateMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *RiskStateMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *RiskStateMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *RiskStateMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown RiskState unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *RiskStateMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown RiskState edge %s", name)
+}
+
+// StrategyMutation represents an operation that mutates the Strategy nodes in the graph.
+type StrategyMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *uuid.UUID
+	strategy_id        *string
+	name               *string
+	description        *string
+	symbol             *string
+	exchange           *string
+	user_inputs        *map[string]interface{}
+	settings           *map[string]interface{}
+	active             *bool
+	trading_mode       *strategy.TradingMode
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	user               *uuid.UUID
+	cleareduser        bool
+	template           *uuid.UUID
+	clearedtemplate    bool
+	executions         map[int64]struct{}
+	removedexecutions  map[int64]struct{}
+	clearedexecutions  bool
+	orders             map[uuid.UUID]struct{}
+	removedorders      map[uuid.UUID]struct{}
+	clearedorders      bool
+	backtests          map[uuid.UUID]struct{}
+	removedbacktests   map[uuid.UUID]struct{}
+	clearedbacktests   bool
+	performance        *int
+	clearedperformance bool
+	status             *int
+	clearedstatus      bool
+	done               bool
+	oldValue           func(context.Context) (*Strategy, error)
+	predicates         []predicate.Strategy
+}
+
+var _ ent.Mutation = (*StrategyMutation)(nil)
+
+// strategyOption allows management of the mutation configuration using functional options.
+type strategyOption func(*StrategyMutation)
+
+// newStrategyMutation creates new mutation for the Strategy entity.
+func newStrategyMutation(c config, op Op, opts ...strategyOption) *StrategyMutation {
+	m := &StrategyMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategy,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyID sets the ID field of the mutation.
+func withStrategyID(id uuid.UUID) strategyOption {
+	return func(m *StrategyMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Strategy
+		)
+		m.oldValue = func(ctx context.Context) (*Strategy, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Strategy.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategy sets the old Strategy of the mutation.
+func withStrategy(node *Strategy) strategyOption {
+	return func(m *StrategyMutation) {
+		m.oldValue = func(context.Context) (*Strategy, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Strategy entities.
+func (m *StrategyMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Strategy.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *StrategyMutation) SetUserID(u uuid.UUID) {
+	m.user = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *StrategyMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *StrategyMutation) ResetUserID() {
+	m.user = nil
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *StrategyMutation) SetStrategyID(s string) {
 	m.strategy_id = &s
 }
 
-// StrategyID returns the value of the "strategy_id" field in the mutation.
-func (m *StrategyMutation) StrategyID() (r string, exists bool) {
-	v := m.strategy_id
-	if v == nil {
-		return
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *StrategyMutation) StrategyID() (r string, exists bool) {
+	v := m.strategy_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldStrategyID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *StrategyMutation) ResetStrategyID() {
+	m.strategy_id = nil
+}
+
+// SetTemplateID sets the "template_id" field.
+func (m *StrategyMutation) SetTemplateID(u uuid.UUID) {
+	m.template = &u
+}
+
+// TemplateID returns the value of the "template_id" field in the mutation.
+func (m *StrategyMutation) TemplateID() (r uuid.UUID, exists bool) {
+	v := m.template
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTemplateID returns the old "template_id" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTemplateID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTemplateID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
+	}
+	return oldValue.TemplateID, nil
+}
+
+// ClearTemplateID clears the value of the "template_id" field.
+func (m *StrategyMutation) ClearTemplateID() {
+	m.template = nil
+	m.clearedFields[strategy.FieldTemplateID] = struct{}{}
+}
+
+// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
+func (m *StrategyMutation) TemplateIDCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldTemplateID]
+	return ok
+}
+
+// ResetTemplateID resets all changes to the "template_id" field.
+func (m *StrategyMutation) ResetTemplateID() {
+	m.template = nil
+	delete(m.clearedFields, strategy.FieldTemplateID)
+}
+
+// SetName sets the "name" field.
+func (m *StrategyMutation) SetName(s string) {
+	m.name = &s
+}
+
+// Name returns the value of the "name" field in the mutation.
+func (m *StrategyMutation) Name() (r string, exists bool) {
+	v := m.name
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldName returns the old "name" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldName(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldName requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
+	}
+	return oldValue.Name, nil
+}
+
+// ResetName resets all changes to the "name" field.
+func (m *StrategyMutation) ResetName() {
+	m.name = nil
+}
+
+// SetDescription sets the "description" field.
+func (m *StrategyMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *StrategyMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *StrategyMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[strategy.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *StrategyMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldDescription]
+	return ok
+}
+
+// ResetDescription resets all changes to the "description" field.
+func (m *StrategyMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, strategy.FieldDescription)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *StrategyMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetExchange sets the "exchange" field.
+func (m *StrategyMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *StrategyMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExchange returns the old "exchange" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (m *StrategyMutation) ClearExchange() {
+	m.exchange = nil
+	m.clearedFields[strategy.FieldExchange] = struct{}{}
+}
+
+// ExchangeCleared returns if the "exchange" field was cleared in this mutation.
+func (m *StrategyMutation) ExchangeCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldExchange]
+	return ok
+}
+
+// ResetExchange resets all changes to the "exchange" field.
+func (m *StrategyMutation) ResetExchange() {
+	m.exchange = nil
+	delete(m.clearedFields, strategy.FieldExchange)
+}
+
+// SetUserInputs sets the "user_inputs" field.
+func (m *StrategyMutation) SetUserInputs(value map[string]interface{}) {
+	m.user_inputs = &value
+}
+
+// UserInputs returns the value of the "user_inputs" field in the mutation.
+func (m *StrategyMutation) UserInputs() (r map[string]interface{}, exists bool) {
+	v := m.user_inputs
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserInputs returns the old "user_inputs" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUserInputs(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserInputs is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserInputs requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserInputs: %w", err)
+	}
+	return oldValue.UserInputs, nil
+}
+
+// ResetUserInputs resets all changes to the "user_inputs" field.
+func (m *StrategyMutation) ResetUserInputs() {
+	m.user_inputs = nil
+}
+
+// SetSettings sets the "settings" field.
+func (m *StrategyMutation) SetSettings(value map[string]interface{}) {
+	m.settings = &value
+}
+
+// Settings returns the value of the "settings" field in the mutation.
+func (m *StrategyMutation) Settings() (r map[string]interface{}, exists bool) {
+	v := m.settings
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSettings returns the old "settings" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSettings requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+	}
+	return oldValue.Settings, nil
+}
+
+// ResetSettings resets all changes to the "settings" field.
+func (m *StrategyMutation) ResetSettings() {
+	m.settings = nil
+}
+
+// SetActive sets the "active" field.
+func (m *StrategyMutation) SetActive(b bool) {
+	m.active = &b
+}
+
+// Active returns the value of the "active" field in the mutation.
+func (m *StrategyMutation) Active() (r bool, exists bool) {
+	v := m.active
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldActive returns the old "active" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldActive(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldActive requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+	}
+	return oldValue.Active, nil
+}
+
+// ResetActive resets all changes to the "active" field.
+func (m *StrategyMutation) ResetActive() {
+	m.active = nil
+}
+
+// SetTradingMode sets the "trading_mode" field.
+func (m *StrategyMutation) SetTradingMode(sm strategy.TradingMode) {
+	m.trading_mode = &sm
+}
+
+// TradingMode returns the value of the "trading_mode" field in the mutation.
+func (m *StrategyMutation) TradingMode() (r strategy.TradingMode, exists bool) {
+	v := m.trading_mode
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTradingMode returns the old "trading_mode" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldTradingMode(ctx context.Context) (v strategy.TradingMode, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradingMode is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradingMode requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradingMode: %w", err)
+	}
+	return oldValue.TradingMode, nil
+}
+
+// ResetTradingMode resets all changes to the "trading_mode" field.
+func (m *StrategyMutation) ResetTradingMode() {
+	m.trading_mode = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ClearCreatedAt clears the value of the "created_at" field.
+func (m *StrategyMutation) ClearCreatedAt() {
+	m.created_at = nil
+	m.clearedFields[strategy.FieldCreatedAt] = struct{}{}
+}
+
+// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
+func (m *StrategyMutation) CreatedAtCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldCreatedAt]
+	return ok
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyMutation) ResetCreatedAt() {
+	m.created_at = nil
+	delete(m.clearedFields, strategy.FieldCreatedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the Strategy entity.
+// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (m *StrategyMutation) ClearUpdatedAt() {
+	m.updated_at = nil
+	m.clearedFields[strategy.FieldUpdatedAt] = struct{}{}
+}
+
+// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
+func (m *StrategyMutation) UpdatedAtCleared() bool {
+	_, ok := m.clearedFields[strategy.FieldUpdatedAt]
+	return ok
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+	delete(m.clearedFields, strategy.FieldUpdatedAt)
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (m *StrategyMutation) ClearUser() {
+	m.cleareduser = true
+	m.clearedFields[strategy.FieldUserID] = struct{}{}
+}
+
+// UserCleared reports if the "user" edge to the User entity was cleared.
+func (m *StrategyMutation) UserCleared() bool {
+	return m.cleareduser
+}
+
+// UserIDs returns the "user" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// UserID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) UserIDs() (ids []uuid.UUID) {
+	if id := m.user; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetUser resets all changes to the "user" edge.
+func (m *StrategyMutation) ResetUser() {
+	m.user = nil
+	m.cleareduser = false
+}
+
+// ClearTemplate clears the "template" edge to the StrategyTemplate entity.
+func (m *StrategyMutation) ClearTemplate() {
+	m.clearedtemplate = true
+	m.clearedFields[strategy.FieldTemplateID] = struct{}{}
+}
+
+// TemplateCleared reports if the "template" edge to the StrategyTemplate entity was cleared.
+func (m *StrategyMutation) TemplateCleared() bool {
+	return m.TemplateIDCleared() || m.clearedtemplate
+}
+
+// TemplateIDs returns the "template" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// TemplateID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) TemplateIDs() (ids []uuid.UUID) {
+	if id := m.template; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetTemplate resets all changes to the "template" edge.
+func (m *StrategyMutation) ResetTemplate() {
+	m.template = nil
+	m.clearedtemplate = false
+}
+
+// AddExecutionIDs adds the "executions" edge to the StrategyExecution entity by ids.
+func (m *StrategyMutation) AddExecutionIDs(ids ...int64) {
+	if m.executions == nil {
+		m.executions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		m.executions[ids[i]] = struct{}{}
+	}
+}
+
+// ClearExecutions clears the "executions" edge to the StrategyExecution entity.
+func (m *StrategyMutation) ClearExecutions() {
+	m.clearedexecutions = true
+}
+
+// ExecutionsCleared reports if the "executions" edge to the StrategyExecution entity was cleared.
+func (m *StrategyMutation) ExecutionsCleared() bool {
+	return m.clearedexecutions
+}
+
+// RemoveExecutionIDs removes the "executions" edge to the StrategyExecution entity by IDs.
+func (m *StrategyMutation) RemoveExecutionIDs(ids ...int64) {
+	if m.removedexecutions == nil {
+		m.removedexecutions = make(map[int64]struct{})
+	}
+	for i := range ids {
+		delete(m.executions, ids[i])
+		m.removedexecutions[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedExecutions returns the removed IDs of the "executions" edge to the StrategyExecution entity.
+func (m *StrategyMutation) RemovedExecutionsIDs() (ids []int64) {
+	for id := range m.removedexecutions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ExecutionsIDs returns the "executions" edge IDs in the mutation.
+func (m *StrategyMutation) ExecutionsIDs() (ids []int64) {
+	for id := range m.executions {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetExecutions resets all changes to the "executions" edge.
+func (m *StrategyMutation) ResetExecutions() {
+	m.executions = nil
+	m.clearedexecutions = false
+	m.removedexecutions = nil
+}
+
+// AddOrderIDs adds the "orders" edge to the Order entity by ids.
+func (m *StrategyMutation) AddOrderIDs(ids ...uuid.UUID) {
+	if m.orders == nil {
+		m.orders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.orders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrders clears the "orders" edge to the Order entity.
+func (m *StrategyMutation) ClearOrders() {
+	m.clearedorders = true
+}
+
+// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
+func (m *StrategyMutation) OrdersCleared() bool {
+	return m.clearedorders
+}
+
+// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
+func (m *StrategyMutation) RemoveOrderIDs(ids ...uuid.UUID) {
+	if m.removedorders == nil {
+		m.removedorders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.orders, ids[i])
+		m.removedorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
+func (m *StrategyMutation) RemovedOrdersIDs() (ids []uuid.UUID) {
+	for id := range m.removedorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrdersIDs returns the "orders" edge IDs in the mutation.
+func (m *StrategyMutation) OrdersIDs() (ids []uuid.UUID) {
+	for id := range m.orders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrders resets all changes to the "orders" edge.
+func (m *StrategyMutation) ResetOrders() {
+	m.orders = nil
+	m.clearedorders = false
+	m.removedorders = nil
+}
+
+// AddBacktestIDs adds the "backtests" edge to the BacktestResult entity by ids.
+func (m *StrategyMutation) AddBacktestIDs(ids ...uuid.UUID) {
+	if m.backtests == nil {
+		m.backtests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.backtests[ids[i]] = struct{}{}
+	}
+}
+
+// ClearBacktests clears the "backtests" edge to the BacktestResult entity.
+func (m *StrategyMutation) ClearBacktests() {
+	m.clearedbacktests = true
+}
+
+// BacktestsCleared reports if the "backtests" edge to the BacktestResult entity was cleared.
+func (m *StrategyMutation) BacktestsCleared() bool {
+	return m.clearedbacktests
+}
+
+// RemoveBacktestIDs removes the "backtests" edge to the BacktestResult entity by IDs.
+func (m *StrategyMutation) RemoveBacktestIDs(ids ...uuid.UUID) {
+	if m.removedbacktests == nil {
+		m.removedbacktests = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.backtests, ids[i])
+		m.removedbacktests[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedBacktests returns the removed IDs of the "backtests" edge to the BacktestResult entity.
+func (m *StrategyMutation) RemovedBacktestsIDs() (ids []uuid.UUID) {
+	for id := range m.removedbacktests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// BacktestsIDs returns the "backtests" edge IDs in the mutation.
+func (m *StrategyMutation) BacktestsIDs() (ids []uuid.UUID) {
+	for id := range m.backtests {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetBacktests resets all changes to the "backtests" edge.
+func (m *StrategyMutation) ResetBacktests() {
+	m.backtests = nil
+	m.clearedbacktests = false
+	m.removedbacktests = nil
+}
+
+// SetPerformanceID sets the "performance" edge to the StrategyPerformance entity by id.
+func (m *StrategyMutation) SetPerformanceID(id int) {
+	m.performance = &id
+}
+
+// ClearPerformance clears the "performance" edge to the StrategyPerformance entity.
+func (m *StrategyMutation) ClearPerformance() {
+	m.clearedperformance = true
+}
+
+// PerformanceCleared reports if the "performance" edge to the StrategyPerformance entity was cleared.
+func (m *StrategyMutation) PerformanceCleared() bool {
+	return m.clearedperformance
+}
+
+// PerformanceID returns the "performance" edge ID in the mutation.
+func (m *StrategyMutation) PerformanceID() (id int, exists bool) {
+	if m.performance != nil {
+		return *m.performance, true
+	}
+	return
+}
+
+// PerformanceIDs returns the "performance" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// PerformanceID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) PerformanceIDs() (ids []int) {
+	if id := m.performance; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetPerformance resets all changes to the "performance" edge.
+func (m *StrategyMutation) ResetPerformance() {
+	m.performance = nil
+	m.clearedperformance = false
+}
+
+// SetStatusID sets the "status" edge to the StrategyStatus entity by id.
+func (m *StrategyMutation) SetStatusID(id int) {
+	m.status = &id
+}
+
+// ClearStatus clears the "status" edge to the StrategyStatus entity.
+func (m *StrategyMutation) ClearStatus() {
+	m.clearedstatus = true
+}
+
+// StatusCleared reports if the "status" edge to the StrategyStatus entity was cleared.
+func (m *StrategyMutation) StatusCleared() bool {
+	return m.clearedstatus
+}
+
+// StatusID returns the "status" edge ID in the mutation.
+func (m *StrategyMutation) StatusID() (id int, exists bool) {
+	if m.status != nil {
+		return *m.status, true
+	}
+	return
+}
+
+// StatusIDs returns the "status" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StatusID instead. It exists only for internal usage by the builders.
+func (m *StrategyMutation) StatusIDs() (ids []int) {
+	if id := m.status; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStatus resets all changes to the "status" edge.
+func (m *StrategyMutation) ResetStatus() {
+	m.status = nil
+	m.clearedstatus = false
+}
+
+// Where appends a list predicates to the StrategyMutation builder.
+func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Strategy, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StrategyMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StrategyMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Strategy).
+func (m *StrategyMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StrategyMutation) Fields() []string {
+	fields := make([]string, 0, 13)
+	if m.user != nil {
+		fields = append(fields, strategy.FieldUserID)
+	}
+	if m.strategy_id != nil {
+		fields = append(fields, strategy.FieldStrategyID)
+	}
+	if m.template != nil {
+		fields = append(fields, strategy.FieldTemplateID)
+	}
+	if m.name != nil {
+		fields = append(fields, strategy.FieldName)
+	}
+	if m.description != nil {
+		fields = append(fields, strategy.FieldDescription)
+	}
+	if m.symbol != nil {
+		fields = append(fields, strategy.FieldSymbol)
+	}
+	if m.exchange != nil {
+		fields = append(fields, strategy.FieldExchange)
+	}
+	if m.user_inputs != nil {
+		fields = append(fields, strategy.FieldUserInputs)
+	}
+	if m.settings != nil {
+		fields = append(fields, strategy.FieldSettings)
+	}
+	if m.active != nil {
+		fields = append(fields, strategy.FieldActive)
+	}
+	if m.trading_mode != nil {
+		fields = append(fields, strategy.FieldTradingMode)
+	}
+	if m.created_at != nil {
+		fields = append(fields, strategy.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, strategy.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case strategy.FieldUserID:
+		return m.UserID()
+	case strategy.FieldStrategyID:
+		return m.StrategyID()
+	case strategy.FieldTemplateID:
+		return m.TemplateID()
+	case strategy.FieldName:
+		return m.Name()
+	case strategy.FieldDescription:
+		return m.Description()
+	case strategy.FieldSymbol:
+		return m.Symbol()
+	case strategy.FieldExchange:
+		return m.Exchange()
+	case strategy.FieldUserInputs:
+		return m.UserInputs()
+	case strategy.FieldSettings:
+		return m.Settings()
+	case strategy.FieldActive:
+		return m.Active()
+	case strategy.FieldTradingMode:
+		return m.TradingMode()
+	case strategy.FieldCreatedAt:
+		return m.CreatedAt()
+	case strategy.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case strategy.FieldUserID:
+		return m.OldUserID(ctx)
+	case strategy.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case strategy.FieldTemplateID:
+		return m.OldTemplateID(ctx)
+	case strategy.FieldName:
+		return m.OldName(ctx)
+	case strategy.FieldDescription:
+		return m.OldDescription(ctx)
+	case strategy.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case strategy.FieldExchange:
+		return m.OldExchange(ctx)
+	case strategy.FieldUserInputs:
+		return m.OldUserInputs(ctx)
+	case strategy.FieldSettings:
+		return m.OldSettings(ctx)
+	case strategy.FieldActive:
+		return m.OldActive(ctx)
+	case strategy.FieldTradingMode:
+		return m.OldTradingMode(ctx)
+	case strategy.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case strategy.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Strategy field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case strategy.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case strategy.FieldStrategyID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case strategy.FieldTemplateID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTemplateID(v)
+		return nil
+	case strategy.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case strategy.FieldDescription:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetDescription(v)
+		return nil
+	case strategy.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case strategy.FieldExchange:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExchange(v)
+		return nil
+	case strategy.FieldUserInputs:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserInputs(v)
+		return nil
+	case strategy.FieldSettings:
+		v, ok := value.(map[string]interface{})
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSettings(v)
+		return nil
+	case strategy.FieldActive:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetActive(v)
+		return nil
+	case strategy.FieldTradingMode:
+		v, ok := value.(strategy.TradingMode)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradingMode(v)
+		return nil
+	case strategy.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case strategy.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StrategyMutation) AddedFields() []string {
+	return nil
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Strategy numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StrategyMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategy.FieldTemplateID) {
+		fields = append(fields, strategy.FieldTemplateID)
+	}
+	if m.FieldCleared(strategy.FieldDescription) {
+		fields = append(fields, strategy.FieldDescription)
+	}
+	if m.FieldCleared(strategy.FieldExchange) {
+		fields = append(fields, strategy.FieldExchange)
+	}
+	if m.FieldCleared(strategy.FieldCreatedAt) {
+		fields = append(fields, strategy.FieldCreatedAt)
+	}
+	if m.FieldCleared(strategy.FieldUpdatedAt) {
+		fields = append(fields, strategy.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StrategyMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyMutation) ClearField(name string) error {
+	switch name {
+	case strategy.FieldTemplateID:
+		m.ClearTemplateID()
+		return nil
+	case strategy.FieldDescription:
+		m.ClearDescription()
+		return nil
+	case strategy.FieldExchange:
+		m.ClearExchange()
+		return nil
+	case strategy.FieldCreatedAt:
+		m.ClearCreatedAt()
+		return nil
+	case strategy.FieldUpdatedAt:
+		m.ClearUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StrategyMutation) ResetField(name string) error {
+	switch name {
+	case strategy.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case strategy.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case strategy.FieldTemplateID:
+		m.ResetTemplateID()
+		return nil
+	case strategy.FieldName:
+		m.ResetName()
+		return nil
+	case strategy.FieldDescription:
+		m.ResetDescription()
+		return nil
+	case strategy.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case strategy.FieldExchange:
+		m.ResetExchange()
+		return nil
+	case strategy.FieldUserInputs:
+		m.ResetUserInputs()
+		return nil
+	case strategy.FieldSettings:
+		m.ResetSettings()
+		return nil
+	case strategy.FieldActive:
+		m.ResetActive()
+		return nil
+	case strategy.FieldTradingMode:
+		m.ResetTradingMode()
+		return nil
+	case strategy.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case strategy.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StrategyMutation) AddedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.user != nil {
+		edges = append(edges, strategy.EdgeUser)
+	}
+	if m.template != nil {
+		edges = append(edges, strategy.EdgeTemplate)
+	}
+	if m.executions != nil {
+		edges = append(edges, strategy.EdgeExecutions)
+	}
+	if m.orders != nil {
+		edges = append(edges, strategy.EdgeOrders)
+	}
+	if m.backtests != nil {
+		edges = append(edges, strategy.EdgeBacktests)
+	}
+	if m.performance != nil {
+		edges = append(edges, strategy.EdgePerformance)
+	}
+	if m.status != nil {
+		edges = append(edges, strategy.EdgeStatus)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case strategy.EdgeUser:
+		if id := m.user; id != nil {
+			return []ent.Value{*id}
+		}
+	case strategy.EdgeTemplate:
+		if id := m.template; id != nil {
+			return []ent.Value{*id}
+		}
+	case strategy.EdgeExecutions:
+		ids := make([]ent.Value, 0, len(m.executions))
+		for id := range m.executions {
+			ids = append(ids, id)
+		}
+		return ids
+	case strategy.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.orders))
+		for id := range m.orders {
+			ids = append(ids, id)
+		}
+		return ids
+	case strategy.EdgeBacktests:
+		ids := make([]ent.Value, 0, len(m.backtests))
+		for id := range m.backtests {
+			ids = append(ids, id)
+		}
+		return ids
+	case strategy.EdgePerformance:
+		if id := m.performance; id != nil {
+			return []ent.Value{*id}
+		}
+	case strategy.EdgeStatus:
+		if id := m.status; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StrategyMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.removedexecutions != nil {
+		edges = append(edges, strategy.EdgeExecutions)
+	}
+	if m.removedorders != nil {
+		edges = append(edges, strategy.EdgeOrders)
+	}
+	if m.removedbacktests != nil {
+		edges = append(edges, strategy.EdgeBacktests)
+	}
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case strategy.EdgeExecutions:
+		ids := make([]ent.Value, 0, len(m.removedexecutions))
+		for id := range m.removedexecutions {
+			ids = append(ids, id)
+		}
+		return ids
+	case strategy.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.removedorders))
+		for id := range m.removedorders {
+			ids = append(ids, id)
+		}
+		return ids
+	case strategy.EdgeBacktests:
+		ids := make([]ent.Value, 0, len(m.removedbacktests))
+		for id := range m.removedbacktests {
+			ids = append(ids, id)
+		}
+		return ids
+	}
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StrategyMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 7)
+	if m.cleareduser {
+		edges = append(edges, strategy.EdgeUser)
+	}
+	if m.clearedtemplate {
+		edges = append(edges, strategy.EdgeTemplate)
+	}
+	if m.clearedexecutions {
+		edges = append(edges, strategy.EdgeExecutions)
+	}
+	if m.clearedorders {
+		edges = append(edges, strategy.EdgeOrders)
+	}
+	if m.clearedbacktests {
+		edges = append(edges, strategy.EdgeBacktests)
+	}
+	if m.clearedperformance {
+		edges = append(edges, strategy.EdgePerformance)
+	}
+	if m.clearedstatus {
+		edges = append(edges, strategy.EdgeStatus)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyMutation) EdgeCleared(name string) bool {
+	switch name {
+	case strategy.EdgeUser:
+		return m.cleareduser
+	case strategy.EdgeTemplate:
+		return m.clearedtemplate
+	case strategy.EdgeExecutions:
+		return m.clearedexecutions
+	case strategy.EdgeOrders:
+		return m.clearedorders
+	case strategy.EdgeBacktests:
+		return m.clearedbacktests
+	case strategy.EdgePerformance:
+		return m.clearedperformance
+	case strategy.EdgeStatus:
+		return m.clearedstatus
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StrategyMutation) ClearEdge(name string) error {
+	switch name {
+	case strategy.EdgeUser:
+		m.ClearUser()
+		return nil
+	case strategy.EdgeTemplate:
+		m.ClearTemplate()
+		return nil
+	case strategy.EdgePerformance:
+		m.ClearPerformance()
+		return nil
+	case strategy.EdgeStatus:
+		m.ClearStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StrategyMutation) ResetEdge(name string) error {
+	switch name {
+	case strategy.EdgeUser:
+		m.ResetUser()
+		return nil
+	case strategy.EdgeTemplate:
+		m.ResetTemplate()
+		return nil
+	case strategy.EdgeExecutions:
+		m.ResetExecutions()
+		return nil
+	case strategy.EdgeOrders:
+		m.ResetOrders()
+		return nil
+	case strategy.EdgeBacktests:
+		m.ResetBacktests()
+		return nil
+	case strategy.EdgePerformance:
+		m.ResetPerformance()
+		return nil
+	case strategy.EdgeStatus:
+		m.ResetStatus()
+		return nil
+	}
+	return fmt.Errorf("unknown Strategy edge %s", name)
+}
+
+// StrategyExecutionMutation represents an operation that mutates the StrategyExecution nodes in the graph.
+type StrategyExecutionMutation struct {
+	config
+	op              Op
+	typ             string
+	id              *int64
+	symbol          *string
+	action          *strategyexecution.Action
+	price           *decimal.Decimal
+	quantity        *int
+	addquantity     *int
+	reasoning       *string
+	executed_at     *time.Time
+	clearedFields   map[string]struct{}
+	strategy        *uuid.UUID
+	clearedstrategy bool
+	done            bool
+	oldValue        func(context.Context) (*StrategyExecution, error)
+	predicates      []predicate.StrategyExecution
+}
+
+var _ ent.Mutation = (*StrategyExecutionMutation)(nil)
+
+// strategyexecutionOption allows management of the mutation configuration using functional options.
+type strategyexecutionOption func(*StrategyExecutionMutation)
+
+// newStrategyExecutionMutation creates new mutation for the StrategyExecution entity.
+func newStrategyExecutionMutation(c config, op Op, opts ...strategyexecutionOption) *StrategyExecutionMutation {
+	m := &StrategyExecutionMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategyExecution,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyExecutionID sets the ID field of the mutation.
+func withStrategyExecutionID(id int64) strategyexecutionOption {
+	return func(m *StrategyExecutionMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StrategyExecution
+		)
+		m.oldValue = func(ctx context.Context) (*StrategyExecution, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StrategyExecution.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategyExecution sets the old StrategyExecution of the mutation.
+func withStrategyExecution(node *StrategyExecution) strategyexecutionOption {
+	return func(m *StrategyExecutionMutation) {
+		m.oldValue = func(context.Context) (*StrategyExecution, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyExecutionMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyExecutionMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of StrategyExecution entities.
+func (m *StrategyExecutionMutation) SetID(id int64) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyExecutionMutation) ID() (id int64, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyExecutionMutation) IDs(ctx context.Context) ([]int64, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int64{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StrategyExecution.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *StrategyExecutionMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *StrategyExecutionMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (m *StrategyExecutionMutation) ClearStrategyID() {
+	m.strategy = nil
+	m.clearedFields[strategyexecution.FieldStrategyID] = struct{}{}
+}
+
+// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
+func (m *StrategyExecutionMutation) StrategyIDCleared() bool {
+	_, ok := m.clearedFields[strategyexecution.FieldStrategyID]
+	return ok
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *StrategyExecutionMutation) ResetStrategyID() {
+	m.strategy = nil
+	delete(m.clearedFields, strategyexecution.FieldStrategyID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *StrategyExecutionMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *StrategyExecutionMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *StrategyExecutionMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetAction sets the "action" field.
+func (m *StrategyExecutionMutation) SetAction(s strategyexecution.Action) {
+	m.action = &s
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *StrategyExecutionMutation) Action() (r strategyexecution.Action, exists bool) {
+	v := m.action
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAction returns the old "action" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldAction(ctx context.Context) (v strategyexecution.Action, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAction requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+	}
+	return oldValue.Action, nil
+}
+
+// ResetAction resets all changes to the "action" field.
+func (m *StrategyExecutionMutation) ResetAction() {
+	m.action = nil
+}
+
+// SetPrice sets the "price" field.
+func (m *StrategyExecutionMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
+}
+
+// Price returns the value of the "price" field in the mutation.
+func (m *StrategyExecutionMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPrice returns the old "price" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
+}
+
+// ClearPrice clears the value of the "price" field.
+func (m *StrategyExecutionMutation) ClearPrice() {
+	m.price = nil
+	m.clearedFields[strategyexecution.FieldPrice] = struct{}{}
+}
+
+// PriceCleared returns if the "price" field was cleared in this mutation.
+func (m *StrategyExecutionMutation) PriceCleared() bool {
+	_, ok := m.clearedFields[strategyexecution.FieldPrice]
+	return ok
+}
+
+// ResetPrice resets all changes to the "price" field.
+func (m *StrategyExecutionMutation) ResetPrice() {
+	m.price = nil
+	delete(m.clearedFields, strategyexecution.FieldPrice)
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *StrategyExecutionMutation) SetQuantity(i int) {
+	m.quantity = &i
+	m.addquantity = nil
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *StrategyExecutionMutation) Quantity() (r int, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldQuantity(ctx context.Context) (v *int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// AddQuantity adds i to the "quantity" field.
+func (m *StrategyExecutionMutation) AddQuantity(i int) {
+	if m.addquantity != nil {
+		*m.addquantity += i
+	} else {
+		m.addquantity = &i
+	}
+}
+
+// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
+func (m *StrategyExecutionMutation) AddedQuantity() (r int, exists bool) {
+	v := m.addquantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ClearQuantity clears the value of the "quantity" field.
+func (m *StrategyExecutionMutation) ClearQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+	m.clearedFields[strategyexecution.FieldQuantity] = struct{}{}
+}
+
+// QuantityCleared returns if the "quantity" field was cleared in this mutation.
+func (m *StrategyExecutionMutation) QuantityCleared() bool {
+	_, ok := m.clearedFields[strategyexecution.FieldQuantity]
+	return ok
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *StrategyExecutionMutation) ResetQuantity() {
+	m.quantity = nil
+	m.addquantity = nil
+	delete(m.clearedFields, strategyexecution.FieldQuantity)
+}
+
+// SetReasoning sets the "reasoning" field.
+func (m *StrategyExecutionMutation) SetReasoning(s string) {
+	m.reasoning = &s
+}
+
+// Reasoning returns the value of the "reasoning" field in the mutation.
+func (m *StrategyExecutionMutation) Reasoning() (r string, exists bool) {
+	v := m.reasoning
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReasoning returns the old "reasoning" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldReasoning(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReasoning is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReasoning requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReasoning: %w", err)
+	}
+	return oldValue.Reasoning, nil
+}
+
+// ClearReasoning clears the value of the "reasoning" field.
+func (m *StrategyExecutionMutation) ClearReasoning() {
+	m.reasoning = nil
+	m.clearedFields[strategyexecution.FieldReasoning] = struct{}{}
+}
+
+// ReasoningCleared returns if the "reasoning" field was cleared in this mutation.
+func (m *StrategyExecutionMutation) ReasoningCleared() bool {
+	_, ok := m.clearedFields[strategyexecution.FieldReasoning]
+	return ok
+}
+
+// ResetReasoning resets all changes to the "reasoning" field.
+func (m *StrategyExecutionMutation) ResetReasoning() {
+	m.reasoning = nil
+	delete(m.clearedFields, strategyexecution.FieldReasoning)
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (m *StrategyExecutionMutation) SetExecutedAt(t time.Time) {
+	m.executed_at = &t
+}
+
+// ExecutedAt returns the value of the "executed_at" field in the mutation.
+func (m *StrategyExecutionMutation) ExecutedAt() (r time.Time, exists bool) {
+	v := m.executed_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldExecutedAt returns the old "executed_at" field's value of the StrategyExecution entity.
+// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyExecutionMutation) OldExecutedAt(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+	}
+	return oldValue.ExecutedAt, nil
+}
+
+// ClearExecutedAt clears the value of the "executed_at" field.
+func (m *StrategyExecutionMutation) ClearExecutedAt() {
+	m.executed_at = nil
+	m.clearedFields[strategyexecution.FieldExecutedAt] = struct{}{}
+}
+
+// ExecutedAtCleared returns if the "executed_at" field was cleared in this mutation.
+func (m *StrategyExecutionMutation) ExecutedAtCleared() bool {
+	_, ok := m.clearedFields[strategyexecution.FieldExecutedAt]
+	return ok
+}
+
+// ResetExecutedAt resets all changes to the "executed_at" field.
+func (m *StrategyExecutionMutation) ResetExecutedAt() {
+	m.executed_at = nil
+	delete(m.clearedFields, strategyexecution.FieldExecutedAt)
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (m *StrategyExecutionMutation) ClearStrategy() {
+	m.clearedstrategy = true
+	m.clearedFields[strategyexecution.FieldStrategyID] = struct{}{}
+}
+
+// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
+func (m *StrategyExecutionMutation) StrategyCleared() bool {
+	return m.StrategyIDCleared() || m.clearedstrategy
+}
+
+// StrategyIDs returns the "strategy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StrategyID instead. It exists only for internal usage by the builders.
+func (m *StrategyExecutionMutation) StrategyIDs() (ids []uuid.UUID) {
+	if id := m.strategy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStrategy resets all changes to the "strategy" edge.
+func (m *StrategyExecutionMutation) ResetStrategy() {
+	m.strategy = nil
+	m.clearedstrategy = false
+}
+
+// Where appends a list predicates to the StrategyExecutionMutation builder.
+func (m *StrategyExecutionMutation) Where(ps ...predicate.StrategyExecution) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StrategyExecutionMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StrategyExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyExecution, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StrategyExecutionMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StrategyExecutionMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StrategyExecution).
+func (m *StrategyExecutionMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StrategyExecutionMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.strategy != nil {
+		fields = append(fields, strategyexecution.FieldStrategyID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, strategyexecution.FieldSymbol)
+	}
+	if m.action != nil {
+		fields = append(fields, strategyexecution.FieldAction)
+	}
+	if m.price != nil {
+		fields = append(fields, strategyexecution.FieldPrice)
+	}
+	if m.quantity != nil {
+		fields = append(fields, strategyexecution.FieldQuantity)
+	}
+	if m.reasoning != nil {
+		fields = append(fields, strategyexecution.FieldReasoning)
+	}
+	if m.executed_at != nil {
+		fields = append(fields, strategyexecution.FieldExecutedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StrategyExecutionMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case strategyexecution.FieldStrategyID:
+		return m.StrategyID()
+	case strategyexecution.FieldSymbol:
+		return m.Symbol()
+	case strategyexecution.FieldAction:
+		return m.Action()
+	case strategyexecution.FieldPrice:
+		return m.Price()
+	case strategyexecution.FieldQuantity:
+		return m.Quantity()
+	case strategyexecution.FieldReasoning:
+		return m.Reasoning()
+	case strategyexecution.FieldExecutedAt:
+		return m.ExecutedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StrategyExecutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case strategyexecution.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case strategyexecution.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case strategyexecution.FieldAction:
+		return m.OldAction(ctx)
+	case strategyexecution.FieldPrice:
+		return m.OldPrice(ctx)
+	case strategyexecution.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case strategyexecution.FieldReasoning:
+		return m.OldReasoning(ctx)
+	case strategyexecution.FieldExecutedAt:
+		return m.OldExecutedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StrategyExecution field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyExecutionMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case strategyexecution.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case strategyexecution.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case strategyexecution.FieldAction:
+		v, ok := value.(strategyexecution.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case strategyexecution.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case strategyexecution.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case strategyexecution.FieldReasoning:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReasoning(v)
+		return nil
+	case strategyexecution.FieldExecutedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetExecutedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StrategyExecutionMutation) AddedFields() []string {
+	var fields []string
+	if m.addquantity != nil {
+		fields = append(fields, strategyexecution.FieldQuantity)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StrategyExecutionMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case strategyexecution.FieldQuantity:
+		return m.AddedQuantity()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyExecutionMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case strategyexecution.FieldQuantity:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddQuantity(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StrategyExecutionMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategyexecution.FieldStrategyID) {
+		fields = append(fields, strategyexecution.FieldStrategyID)
+	}
+	if m.FieldCleared(strategyexecution.FieldPrice) {
+		fields = append(fields, strategyexecution.FieldPrice)
+	}
+	if m.FieldCleared(strategyexecution.FieldQuantity) {
+		fields = append(fields, strategyexecution.FieldQuantity)
+	}
+	if m.FieldCleared(strategyexecution.FieldReasoning) {
+		fields = append(fields, strategyexecution.FieldReasoning)
+	}
+	if m.FieldCleared(strategyexecution.FieldExecutedAt) {
+		fields = append(fields, strategyexecution.FieldExecutedAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StrategyExecutionMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyExecutionMutation) ClearField(name string) error {
+	switch name {
+	case strategyexecution.FieldStrategyID:
+		m.ClearStrategyID()
+		return nil
+	case strategyexecution.FieldPrice:
+		m.ClearPrice()
+		return nil
+	case strategyexecution.FieldQuantity:
+		m.ClearQuantity()
+		return nil
+	case strategyexecution.FieldReasoning:
+		m.ClearReasoning()
+		return nil
+	case strategyexecution.FieldExecutedAt:
+		m.ClearExecutedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StrategyExecutionMutation) ResetField(name string) error {
+	switch name {
+	case strategyexecution.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case strategyexecution.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case strategyexecution.FieldAction:
+		m.ResetAction()
+		return nil
+	case strategyexecution.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case strategyexecution.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case strategyexecution.FieldReasoning:
+		m.ResetReasoning()
+		return nil
+	case strategyexecution.FieldExecutedAt:
+		m.ResetExecutedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StrategyExecutionMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.strategy != nil {
+		edges = append(edges, strategyexecution.EdgeStrategy)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StrategyExecutionMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case strategyexecution.EdgeStrategy:
+		if id := m.strategy; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StrategyExecutionMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StrategyExecutionMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StrategyExecutionMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstrategy {
+		edges = append(edges, strategyexecution.EdgeStrategy)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyExecutionMutation) EdgeCleared(name string) bool {
+	switch name {
+	case strategyexecution.EdgeStrategy:
+		return m.clearedstrategy
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StrategyExecutionMutation) ClearEdge(name string) error {
+	switch name {
+	case strategyexecution.EdgeStrategy:
+		m.ClearStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StrategyExecutionMutation) ResetEdge(name string) error {
+	switch name {
+	case strategyexecution.EdgeStrategy:
+		m.ResetStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyExecution edge %s", name)
+}
+
+// StrategyPerformanceMutation represents an operation that mutates the StrategyPerformance nodes in the graph.
+type StrategyPerformanceMutation struct {
+	config
+	op                   Op
+	typ                  string
+	id                   *int
+	total_return         *decimal.Decimal
+	win_rate             *decimal.Decimal
+	profit_loss          *decimal.Decimal
+	trade_count          *int64
+	addtrade_count       *int64
+	last_trade_time      *time.Time
+	max_drawdown         *decimal.Decimal
+	sharpe_ratio         *decimal.Decimal
+	closed_trades        *int64
+	addclosed_trades     *int64
+	winning_trades       *int64
+	addwinning_trades    *int64
+	return_sum           *float64
+	addreturn_sum        *float64
+	return_sq_sum        *float64
+	addreturn_sq_sum     *float64
+	equity_index         *float64
+	addequity_index      *float64
+	peak_equity_index    *float64
+	addpeak_equity_index *float64
+	open_positions       *map[string]schema.OpenPosition
+	created_at           *time.Time
+	updated_at           *time.Time
+	clearedFields        map[string]struct{}
+	strategy             *uuid.UUID
+	clearedstrategy      bool
+	done                 bool
+	oldValue             func(context.Context) (*StrategyPerformance, error)
+	predicates           []predicate.StrategyPerformance
+}
+
+var _ ent.Mutation = (*StrategyPerformanceMutation)(nil)
+
+// strategyperformanceOption allows management of the mutation configuration using functional options.
+type strategyperformanceOption func(*StrategyPerformanceMutation)
+
+// newStrategyPerformanceMutation creates new mutation for the StrategyPerformance entity.
+func newStrategyPerformanceMutation(c config, op Op, opts ...strategyperformanceOption) *StrategyPerformanceMutation {
+	m := &StrategyPerformanceMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategyPerformance,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withStrategyPerformanceID sets the ID field of the mutation.
+func withStrategyPerformanceID(id int) strategyperformanceOption {
+	return func(m *StrategyPerformanceMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StrategyPerformance
+		)
+		m.oldValue = func(ctx context.Context) (*StrategyPerformance, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StrategyPerformance.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withStrategyPerformance sets the old StrategyPerformance of the mutation.
+func withStrategyPerformance(node *StrategyPerformance) strategyperformanceOption {
+	return func(m *StrategyPerformanceMutation) {
+		m.oldValue = func(context.Context) (*StrategyPerformance, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyPerformanceMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyPerformanceMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyPerformanceMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyPerformanceMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StrategyPerformance.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (m *StrategyPerformanceMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy = &u
+}
+
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *StrategyPerformanceMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldStrategyID returns the old "strategy_id" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldStrategyID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	}
+	return oldValue.StrategyID, nil
+}
+
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *StrategyPerformanceMutation) ResetStrategyID() {
+	m.strategy = nil
+}
+
+// SetTotalReturn sets the "total_return" field.
+func (m *StrategyPerformanceMutation) SetTotalReturn(d decimal.Decimal) {
+	m.total_return = &d
+}
+
+// TotalReturn returns the value of the "total_return" field in the mutation.
+func (m *StrategyPerformanceMutation) TotalReturn() (r decimal.Decimal, exists bool) {
+	v := m.total_return
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTotalReturn returns the old "total_return" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldTotalReturn(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTotalReturn is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTotalReturn requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTotalReturn: %w", err)
+	}
+	return oldValue.TotalReturn, nil
+}
+
+// ResetTotalReturn resets all changes to the "total_return" field.
+func (m *StrategyPerformanceMutation) ResetTotalReturn() {
+	m.total_return = nil
+}
+
+// SetWinRate sets the "win_rate" field.
+func (m *StrategyPerformanceMutation) SetWinRate(d decimal.Decimal) {
+	m.win_rate = &d
+}
+
+// WinRate returns the value of the "win_rate" field in the mutation.
+func (m *StrategyPerformanceMutation) WinRate() (r decimal.Decimal, exists bool) {
+	v := m.win_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinRate returns the old "win_rate" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldWinRate(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinRate: %w", err)
+	}
+	return oldValue.WinRate, nil
+}
+
+// ResetWinRate resets all changes to the "win_rate" field.
+func (m *StrategyPerformanceMutation) ResetWinRate() {
+	m.win_rate = nil
+}
+
+// SetProfitLoss sets the "profit_loss" field.
+func (m *StrategyPerformanceMutation) SetProfitLoss(d decimal.Decimal) {
+	m.profit_loss = &d
+}
+
+// ProfitLoss returns the value of the "profit_loss" field in the mutation.
+func (m *StrategyPerformanceMutation) ProfitLoss() (r decimal.Decimal, exists bool) {
+	v := m.profit_loss
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProfitLoss returns the old "profit_loss" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldProfitLoss(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProfitLoss is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProfitLoss requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProfitLoss: %w", err)
+	}
+	return oldValue.ProfitLoss, nil
+}
+
+// ResetProfitLoss resets all changes to the "profit_loss" field.
+func (m *StrategyPerformanceMutation) ResetProfitLoss() {
+	m.profit_loss = nil
+}
+
+// SetTradeCount sets the "trade_count" field.
+func (m *StrategyPerformanceMutation) SetTradeCount(i int64) {
+	m.trade_count = &i
+	m.addtrade_count = nil
+}
+
+// TradeCount returns the value of the "trade_count" field in the mutation.
+func (m *StrategyPerformanceMutation) TradeCount() (r int64, exists bool) {
+	v := m.trade_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTradeCount returns the old "trade_count" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldTradeCount(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradeCount is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradeCount requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradeCount: %w", err)
+	}
+	return oldValue.TradeCount, nil
+}
+
+// AddTradeCount adds i to the "trade_count" field.
+func (m *StrategyPerformanceMutation) AddTradeCount(i int64) {
+	if m.addtrade_count != nil {
+		*m.addtrade_count += i
+	} else {
+		m.addtrade_count = &i
+	}
+}
+
+// AddedTradeCount returns the value that was added to the "trade_count" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedTradeCount() (r int64, exists bool) {
+	v := m.addtrade_count
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetTradeCount resets all changes to the "trade_count" field.
+func (m *StrategyPerformanceMutation) ResetTradeCount() {
+	m.trade_count = nil
+	m.addtrade_count = nil
+}
+
+// SetLastTradeTime sets the "last_trade_time" field.
+func (m *StrategyPerformanceMutation) SetLastTradeTime(t time.Time) {
+	m.last_trade_time = &t
+}
+
+// LastTradeTime returns the value of the "last_trade_time" field in the mutation.
+func (m *StrategyPerformanceMutation) LastTradeTime() (r time.Time, exists bool) {
+	v := m.last_trade_time
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldLastTradeTime returns the old "last_trade_time" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldLastTradeTime(ctx context.Context) (v *time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldLastTradeTime is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldLastTradeTime requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldLastTradeTime: %w", err)
+	}
+	return oldValue.LastTradeTime, nil
+}
+
+// ClearLastTradeTime clears the value of the "last_trade_time" field.
+func (m *StrategyPerformanceMutation) ClearLastTradeTime() {
+	m.last_trade_time = nil
+	m.clearedFields[strategyperformance.FieldLastTradeTime] = struct{}{}
+}
+
+// LastTradeTimeCleared returns if the "last_trade_time" field was cleared in this mutation.
+func (m *StrategyPerformanceMutation) LastTradeTimeCleared() bool {
+	_, ok := m.clearedFields[strategyperformance.FieldLastTradeTime]
+	return ok
+}
+
+// ResetLastTradeTime resets all changes to the "last_trade_time" field.
+func (m *StrategyPerformanceMutation) ResetLastTradeTime() {
+	m.last_trade_time = nil
+	delete(m.clearedFields, strategyperformance.FieldLastTradeTime)
+}
+
+// SetMaxDrawdown sets the "max_drawdown" field.
+func (m *StrategyPerformanceMutation) SetMaxDrawdown(d decimal.Decimal) {
+	m.max_drawdown = &d
+}
+
+// MaxDrawdown returns the value of the "max_drawdown" field in the mutation.
+func (m *StrategyPerformanceMutation) MaxDrawdown() (r decimal.Decimal, exists bool) {
+	v := m.max_drawdown
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldMaxDrawdown returns the old "max_drawdown" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldMaxDrawdown(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMaxDrawdown is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMaxDrawdown requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMaxDrawdown: %w", err)
+	}
+	return oldValue.MaxDrawdown, nil
+}
+
+// ResetMaxDrawdown resets all changes to the "max_drawdown" field.
+func (m *StrategyPerformanceMutation) ResetMaxDrawdown() {
+	m.max_drawdown = nil
+}
+
+// SetSharpeRatio sets the "sharpe_ratio" field.
+func (m *StrategyPerformanceMutation) SetSharpeRatio(d decimal.Decimal) {
+	m.sharpe_ratio = &d
+}
+
+// SharpeRatio returns the value of the "sharpe_ratio" field in the mutation.
+func (m *StrategyPerformanceMutation) SharpeRatio() (r decimal.Decimal, exists bool) {
+	v := m.sharpe_ratio
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSharpeRatio returns the old "sharpe_ratio" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldSharpeRatio(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSharpeRatio is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSharpeRatio requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSharpeRatio: %w", err)
+	}
+	return oldValue.SharpeRatio, nil
+}
+
+// ResetSharpeRatio resets all changes to the "sharpe_ratio" field.
+func (m *StrategyPerformanceMutation) ResetSharpeRatio() {
+	m.sharpe_ratio = nil
+}
+
+// SetClosedTrades sets the "closed_trades" field.
+func (m *StrategyPerformanceMutation) SetClosedTrades(i int64) {
+	m.closed_trades = &i
+	m.addclosed_trades = nil
+}
+
+// ClosedTrades returns the value of the "closed_trades" field in the mutation.
+func (m *StrategyPerformanceMutation) ClosedTrades() (r int64, exists bool) {
+	v := m.closed_trades
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldClosedTrades returns the old "closed_trades" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldClosedTrades(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldClosedTrades is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldClosedTrades requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldClosedTrades: %w", err)
+	}
+	return oldValue.ClosedTrades, nil
+}
+
+// AddClosedTrades adds i to the "closed_trades" field.
+func (m *StrategyPerformanceMutation) AddClosedTrades(i int64) {
+	if m.addclosed_trades != nil {
+		*m.addclosed_trades += i
+	} else {
+		m.addclosed_trades = &i
+	}
+}
+
+// AddedClosedTrades returns the value that was added to the "closed_trades" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedClosedTrades() (r int64, exists bool) {
+	v := m.addclosed_trades
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetClosedTrades resets all changes to the "closed_trades" field.
+func (m *StrategyPerformanceMutation) ResetClosedTrades() {
+	m.closed_trades = nil
+	m.addclosed_trades = nil
+}
+
+// SetWinningTrades sets the "winning_trades" field.
+func (m *StrategyPerformanceMutation) SetWinningTrades(i int64) {
+	m.winning_trades = &i
+	m.addwinning_trades = nil
+}
+
+// WinningTrades returns the value of the "winning_trades" field in the mutation.
+func (m *StrategyPerformanceMutation) WinningTrades() (r int64, exists bool) {
+	v := m.winning_trades
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldWinningTrades returns the old "winning_trades" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldWinningTrades(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldWinningTrades is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldWinningTrades requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldWinningTrades: %w", err)
+	}
+	return oldValue.WinningTrades, nil
+}
+
+// AddWinningTrades adds i to the "winning_trades" field.
+func (m *StrategyPerformanceMutation) AddWinningTrades(i int64) {
+	if m.addwinning_trades != nil {
+		*m.addwinning_trades += i
+	} else {
+		m.addwinning_trades = &i
+	}
+}
+
+// AddedWinningTrades returns the value that was added to the "winning_trades" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedWinningTrades() (r int64, exists bool) {
+	v := m.addwinning_trades
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetWinningTrades resets all changes to the "winning_trades" field.
+func (m *StrategyPerformanceMutation) ResetWinningTrades() {
+	m.winning_trades = nil
+	m.addwinning_trades = nil
+}
+
+// SetReturnSum sets the "return_sum" field.
+func (m *StrategyPerformanceMutation) SetReturnSum(f float64) {
+	m.return_sum = &f
+	m.addreturn_sum = nil
+}
+
+// ReturnSum returns the value of the "return_sum" field in the mutation.
+func (m *StrategyPerformanceMutation) ReturnSum() (r float64, exists bool) {
+	v := m.return_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnSum returns the old "return_sum" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldReturnSum(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnSum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnSum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnSum: %w", err)
+	}
+	return oldValue.ReturnSum, nil
+}
+
+// AddReturnSum adds f to the "return_sum" field.
+func (m *StrategyPerformanceMutation) AddReturnSum(f float64) {
+	if m.addreturn_sum != nil {
+		*m.addreturn_sum += f
+	} else {
+		m.addreturn_sum = &f
+	}
+}
+
+// AddedReturnSum returns the value that was added to the "return_sum" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedReturnSum() (r float64, exists bool) {
+	v := m.addreturn_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReturnSum resets all changes to the "return_sum" field.
+func (m *StrategyPerformanceMutation) ResetReturnSum() {
+	m.return_sum = nil
+	m.addreturn_sum = nil
+}
+
+// SetReturnSqSum sets the "return_sq_sum" field.
+func (m *StrategyPerformanceMutation) SetReturnSqSum(f float64) {
+	m.return_sq_sum = &f
+	m.addreturn_sq_sum = nil
+}
+
+// ReturnSqSum returns the value of the "return_sq_sum" field in the mutation.
+func (m *StrategyPerformanceMutation) ReturnSqSum() (r float64, exists bool) {
+	v := m.return_sq_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReturnSqSum returns the old "return_sq_sum" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldReturnSqSum(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReturnSqSum is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReturnSqSum requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReturnSqSum: %w", err)
+	}
+	return oldValue.ReturnSqSum, nil
+}
+
+// AddReturnSqSum adds f to the "return_sq_sum" field.
+func (m *StrategyPerformanceMutation) AddReturnSqSum(f float64) {
+	if m.addreturn_sq_sum != nil {
+		*m.addreturn_sq_sum += f
+	} else {
+		m.addreturn_sq_sum = &f
+	}
+}
+
+// AddedReturnSqSum returns the value that was added to the "return_sq_sum" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedReturnSqSum() (r float64, exists bool) {
+	v := m.addreturn_sq_sum
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetReturnSqSum resets all changes to the "return_sq_sum" field.
+func (m *StrategyPerformanceMutation) ResetReturnSqSum() {
+	m.return_sq_sum = nil
+	m.addreturn_sq_sum = nil
+}
+
+// SetEquityIndex sets the "equity_index" field.
+func (m *StrategyPerformanceMutation) SetEquityIndex(f float64) {
+	m.equity_index = &f
+	m.addequity_index = nil
+}
+
+// EquityIndex returns the value of the "equity_index" field in the mutation.
+func (m *StrategyPerformanceMutation) EquityIndex() (r float64, exists bool) {
+	v := m.equity_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldEquityIndex returns the old "equity_index" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldEquityIndex(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldEquityIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldEquityIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldEquityIndex: %w", err)
+	}
+	return oldValue.EquityIndex, nil
+}
+
+// AddEquityIndex adds f to the "equity_index" field.
+func (m *StrategyPerformanceMutation) AddEquityIndex(f float64) {
+	if m.addequity_index != nil {
+		*m.addequity_index += f
+	} else {
+		m.addequity_index = &f
+	}
+}
+
+// AddedEquityIndex returns the value that was added to the "equity_index" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedEquityIndex() (r float64, exists bool) {
+	v := m.addequity_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetEquityIndex resets all changes to the "equity_index" field.
+func (m *StrategyPerformanceMutation) ResetEquityIndex() {
+	m.equity_index = nil
+	m.addequity_index = nil
+}
+
+// SetPeakEquityIndex sets the "peak_equity_index" field.
+func (m *StrategyPerformanceMutation) SetPeakEquityIndex(f float64) {
+	m.peak_equity_index = &f
+	m.addpeak_equity_index = nil
+}
+
+// PeakEquityIndex returns the value of the "peak_equity_index" field in the mutation.
+func (m *StrategyPerformanceMutation) PeakEquityIndex() (r float64, exists bool) {
+	v := m.peak_equity_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldPeakEquityIndex returns the old "peak_equity_index" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldPeakEquityIndex(ctx context.Context) (v float64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPeakEquityIndex is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPeakEquityIndex requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPeakEquityIndex: %w", err)
+	}
+	return oldValue.PeakEquityIndex, nil
+}
+
+// AddPeakEquityIndex adds f to the "peak_equity_index" field.
+func (m *StrategyPerformanceMutation) AddPeakEquityIndex(f float64) {
+	if m.addpeak_equity_index != nil {
+		*m.addpeak_equity_index += f
+	} else {
+		m.addpeak_equity_index = &f
+	}
+}
+
+// AddedPeakEquityIndex returns the value that was added to the "peak_equity_index" field in this mutation.
+func (m *StrategyPerformanceMutation) AddedPeakEquityIndex() (r float64, exists bool) {
+	v := m.addpeak_equity_index
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetPeakEquityIndex resets all changes to the "peak_equity_index" field.
+func (m *StrategyPerformanceMutation) ResetPeakEquityIndex() {
+	m.peak_equity_index = nil
+	m.addpeak_equity_index = nil
+}
+
+// SetOpenPositions sets the "open_positions" field.
+func (m *StrategyPerformanceMutation) SetOpenPositions(mp map[string]schema.OpenPosition) {
+	m.open_positions = &mp
+}
+
+// OpenPositions returns the value of the "open_positions" field in the mutation.
+func (m *StrategyPerformanceMutation) OpenPositions() (r map[string]schema.OpenPosition, exists bool) {
+	v := m.open_positions
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldOpenPositions returns the old "open_positions" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldOpenPositions(ctx context.Context) (v map[string]schema.OpenPosition, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldOpenPositions is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldOpenPositions requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldOpenPositions: %w", err)
+	}
+	return oldValue.OpenPositions, nil
+}
+
+// ClearOpenPositions clears the value of the "open_positions" field.
+func (m *StrategyPerformanceMutation) ClearOpenPositions() {
+	m.open_positions = nil
+	m.clearedFields[strategyperformance.FieldOpenPositions] = struct{}{}
+}
+
+// OpenPositionsCleared returns if the "open_positions" field was cleared in this mutation.
+func (m *StrategyPerformanceMutation) OpenPositionsCleared() bool {
+	_, ok := m.clearedFields[strategyperformance.FieldOpenPositions]
+	return ok
+}
+
+// ResetOpenPositions resets all changes to the "open_positions" field.
+func (m *StrategyPerformanceMutation) ResetOpenPositions() {
+	m.open_positions = nil
+	delete(m.clearedFields, strategyperformance.FieldOpenPositions)
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyPerformanceMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyPerformanceMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyPerformanceMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyPerformanceMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyPerformanceMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the StrategyPerformance entity.
+// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyPerformanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyPerformanceMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (m *StrategyPerformanceMutation) ClearStrategy() {
+	m.clearedstrategy = true
+	m.clearedFields[strategyperformance.FieldStrategyID] = struct{}{}
+}
+
+// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
+func (m *StrategyPerformanceMutation) StrategyCleared() bool {
+	return m.clearedstrategy
+}
+
+// StrategyIDs returns the "strategy" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// StrategyID instead. It exists only for internal usage by the builders.
+func (m *StrategyPerformanceMutation) StrategyIDs() (ids []uuid.UUID) {
+	if id := m.strategy; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetStrategy resets all changes to the "strategy" edge.
+func (m *StrategyPerformanceMutation) ResetStrategy() {
+	m.strategy = nil
+	m.clearedstrategy = false
+}
+
+// Where appends a list predicates to the StrategyPerformanceMutation builder.
+func (m *StrategyPerformanceMutation) Where(ps ...predicate.StrategyPerformance) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the StrategyPerformanceMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *StrategyPerformanceMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyPerformance, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *StrategyPerformanceMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *StrategyPerformanceMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (StrategyPerformance).
+func (m *StrategyPerformanceMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *StrategyPerformanceMutation) Fields() []string {
+	fields := make([]string, 0, 17)
+	if m.strategy != nil {
+		fields = append(fields, strategyperformance.FieldStrategyID)
+	}
+	if m.total_return != nil {
+		fields = append(fields, strategyperformance.FieldTotalReturn)
+	}
+	if m.win_rate != nil {
+		fields = append(fields, strategyperformance.FieldWinRate)
+	}
+	if m.profit_loss != nil {
+		fields = append(fields, strategyperformance.FieldProfitLoss)
+	}
+	if m.trade_count != nil {
+		fields = append(fields, strategyperformance.FieldTradeCount)
+	}
+	if m.last_trade_time != nil {
+		fields = append(fields, strategyperformance.FieldLastTradeTime)
+	}
+	if m.max_drawdown != nil {
+		fields = append(fields, strategyperformance.FieldMaxDrawdown)
+	}
+	if m.sharpe_ratio != nil {
+		fields = append(fields, strategyperformance.FieldSharpeRatio)
+	}
+	if m.closed_trades != nil {
+		fields = append(fields, strategyperformance.FieldClosedTrades)
+	}
+	if m.winning_trades != nil {
+		fields = append(fields, strategyperformance.FieldWinningTrades)
+	}
+	if m.return_sum != nil {
+		fields = append(fields, strategyperformance.FieldReturnSum)
+	}
+	if m.return_sq_sum != nil {
+		fields = append(fields, strategyperformance.FieldReturnSqSum)
+	}
+	if m.equity_index != nil {
+		fields = append(fields, strategyperformance.FieldEquityIndex)
+	}
+	if m.peak_equity_index != nil {
+		fields = append(fields, strategyperformance.FieldPeakEquityIndex)
+	}
+	if m.open_positions != nil {
+		fields = append(fields, strategyperformance.FieldOpenPositions)
+	}
+	if m.created_at != nil {
+		fields = append(fields, strategyperformance.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, strategyperformance.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *StrategyPerformanceMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case strategyperformance.FieldStrategyID:
+		return m.StrategyID()
+	case strategyperformance.FieldTotalReturn:
+		return m.TotalReturn()
+	case strategyperformance.FieldWinRate:
+		return m.WinRate()
+	case strategyperformance.FieldProfitLoss:
+		return m.ProfitLoss()
+	case strategyperformance.FieldTradeCount:
+		return m.TradeCount()
+	case strategyperformance.FieldLastTradeTime:
+		return m.LastTradeTime()
+	case strategyperformance.FieldMaxDrawdown:
+		return m.MaxDrawdown()
+	case strategyperformance.FieldSharpeRatio:
+		return m.SharpeRatio()
+	case strategyperformance.FieldClosedTrades:
+		return m.ClosedTrades()
+	case strategyperformance.FieldWinningTrades:
+		return m.WinningTrades()
+	case strategyperformance.FieldReturnSum:
+		return m.ReturnSum()
+	case strategyperformance.FieldReturnSqSum:
+		return m.ReturnSqSum()
+	case strategyperformance.FieldEquityIndex:
+		return m.EquityIndex()
+	case strategyperformance.FieldPeakEquityIndex:
+		return m.PeakEquityIndex()
+	case strategyperformance.FieldOpenPositions:
+		return m.OpenPositions()
+	case strategyperformance.FieldCreatedAt:
+		return m.CreatedAt()
+	case strategyperformance.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *StrategyPerformanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case strategyperformance.FieldStrategyID:
+		return m.OldStrategyID(ctx)
+	case strategyperformance.FieldTotalReturn:
+		return m.OldTotalReturn(ctx)
+	case strategyperformance.FieldWinRate:
+		return m.OldWinRate(ctx)
+	case strategyperformance.FieldProfitLoss:
+		return m.OldProfitLoss(ctx)
+	case strategyperformance.FieldTradeCount:
+		return m.OldTradeCount(ctx)
+	case strategyperformance.FieldLastTradeTime:
+		return m.OldLastTradeTime(ctx)
+	case strategyperformance.FieldMaxDrawdown:
+		return m.OldMaxDrawdown(ctx)
+	case strategyperformance.FieldSharpeRatio:
+		return m.OldSharpeRatio(ctx)
+	case strategyperformance.FieldClosedTrades:
+		return m.OldClosedTrades(ctx)
+	case strategyperformance.FieldWinningTrades:
+		return m.OldWinningTrades(ctx)
+	case strategyperformance.FieldReturnSum:
+		return m.OldReturnSum(ctx)
+	case strategyperformance.FieldReturnSqSum:
+		return m.OldReturnSqSum(ctx)
+	case strategyperformance.FieldEquityIndex:
+		return m.OldEquityIndex(ctx)
+	case strategyperformance.FieldPeakEquityIndex:
+		return m.OldPeakEquityIndex(ctx)
+	case strategyperformance.FieldOpenPositions:
+		return m.OldOpenPositions(ctx)
+	case strategyperformance.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case strategyperformance.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown StrategyPerformance field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyPerformanceMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case strategyperformance.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetStrategyID(v)
+		return nil
+	case strategyperformance.FieldTotalReturn:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTotalReturn(v)
+		return nil
+	case strategyperformance.FieldWinRate:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinRate(v)
+		return nil
+	case strategyperformance.FieldProfitLoss:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProfitLoss(v)
+		return nil
+	case strategyperformance.FieldTradeCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradeCount(v)
+		return nil
+	case strategyperformance.FieldLastTradeTime:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetLastTradeTime(v)
+		return nil
+	case strategyperformance.FieldMaxDrawdown:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMaxDrawdown(v)
+		return nil
+	case strategyperformance.FieldSharpeRatio:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSharpeRatio(v)
+		return nil
+	case strategyperformance.FieldClosedTrades:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedTrades(v)
+		return nil
+	case strategyperformance.FieldWinningTrades:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetWinningTrades(v)
+		return nil
+	case strategyperformance.FieldReturnSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnSum(v)
+		return nil
+	case strategyperformance.FieldReturnSqSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReturnSqSum(v)
+		return nil
+	case strategyperformance.FieldEquityIndex:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEquityIndex(v)
+		return nil
+	case strategyperformance.FieldPeakEquityIndex:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPeakEquityIndex(v)
+		return nil
+	case strategyperformance.FieldOpenPositions:
+		v, ok := value.(map[string]schema.OpenPosition)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenPositions(v)
+		return nil
+	case strategyperformance.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	case strategyperformance.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyPerformance field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *StrategyPerformanceMutation) AddedFields() []string {
+	var fields []string
+	if m.addtrade_count != nil {
+		fields = append(fields, strategyperformance.FieldTradeCount)
+	}
+	if m.addclosed_trades != nil {
+		fields = append(fields, strategyperformance.FieldClosedTrades)
+	}
+	if m.addwinning_trades != nil {
+		fields = append(fields, strategyperformance.FieldWinningTrades)
 	}
-	return *v, true
-}
-
-// OldStrategyID returns the old "strategy_id" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldStrategyID(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+	if m.addreturn_sum != nil {
+		fields = append(fields, strategyperformance.FieldReturnSum)
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+	if m.addreturn_sq_sum != nil {
+		fields = append(fields, strategyperformance.FieldReturnSqSum)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+	if m.addequity_index != nil {
+		fields = append(fields, strategyperformance.FieldEquityIndex)
 	}
-	return oldValue.StrategyID, nil
-}
-
-// ResetStrategyID resets all changes to the "strategy_id" field.
-func (m *StrategyMutation) ResetStrategyID() {
-	m.strategy_id = nil
+	if m.addpeak_equity_index != nil {
+		fields = append(fields, strategyperformance.FieldPeakEquityIndex)
+	}
+	return fields
 }
 
-// SetTemplateID sets the "template_id" field.
-func (m *StrategyMutation) SetTemplateID(u uuid.UUID) {
-	m.template = &u
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *StrategyPerformanceMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case strategyperformance.FieldTradeCount:
+		return m.AddedTradeCount()
+	case strategyperformance.FieldClosedTrades:
+		return m.AddedClosedTrades()
+	case strategyperformance.FieldWinningTrades:
+		return m.AddedWinningTrades()
+	case strategyperformance.FieldReturnSum:
+		return m.AddedReturnSum()
+	case strategyperformance.FieldReturnSqSum:
+		return m.AddedReturnSqSum()
+	case strategyperformance.FieldEquityIndex:
+		return m.AddedEquityIndex()
+	case strategyperformance.FieldPeakEquityIndex:
+		return m.AddedPeakEquityIndex()
+	}
+	return nil, false
 }
 
-// TemplateID returns the value of the "template_id" field in the mutation.
-func (m *StrategyMutation) TemplateID() (r uuid.UUID, exists bool) {
-	v := m.template
-	if v == nil {
-		return
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *StrategyPerformanceMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case strategyperformance.FieldTradeCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddTradeCount(v)
+		return nil
+	case strategyperformance.FieldClosedTrades:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddClosedTrades(v)
+		return nil
+	case strategyperformance.FieldWinningTrades:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddWinningTrades(v)
+		return nil
+	case strategyperformance.FieldReturnSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReturnSum(v)
+		return nil
+	case strategyperformance.FieldReturnSqSum:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddReturnSqSum(v)
+		return nil
+	case strategyperformance.FieldEquityIndex:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddEquityIndex(v)
+		return nil
+	case strategyperformance.FieldPeakEquityIndex:
+		v, ok := value.(float64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddPeakEquityIndex(v)
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown StrategyPerformance numeric field %s", name)
 }
 
-// OldTemplateID returns the old "template_id" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldTemplateID(ctx context.Context) (v *uuid.UUID, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTemplateID is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTemplateID requires an ID field in the mutation")
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *StrategyPerformanceMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(strategyperformance.FieldLastTradeTime) {
+		fields = append(fields, strategyperformance.FieldLastTradeTime)
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTemplateID: %w", err)
+	if m.FieldCleared(strategyperformance.FieldOpenPositions) {
+		fields = append(fields, strategyperformance.FieldOpenPositions)
 	}
-	return oldValue.TemplateID, nil
-}
-
-// ClearTemplateID clears the value of the "template_id" field.
-func (m *StrategyMutation) ClearTemplateID() {
-	m.template = nil
-	m.clearedFields[strategy.FieldTemplateID] = struct{}{}
+	return fields
 }
 
-// TemplateIDCleared returns if the "template_id" field was cleared in this mutation.
-func (m *StrategyMutation) TemplateIDCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldTemplateID]
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *StrategyPerformanceMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
 	return ok
 }
 
-// ResetTemplateID resets all changes to the "template_id" field.
-func (m *StrategyMutation) ResetTemplateID() {
-	m.template = nil
-	delete(m.clearedFields, strategy.FieldTemplateID)
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyPerformanceMutation) ClearField(name string) error {
+	switch name {
+	case strategyperformance.FieldLastTradeTime:
+		m.ClearLastTradeTime()
+		return nil
+	case strategyperformance.FieldOpenPositions:
+		m.ClearOpenPositions()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyPerformance nullable field %s", name)
 }
 
-// SetName sets the "name" field.
-func (m *StrategyMutation) SetName(s string) {
-	m.name = &s
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *StrategyPerformanceMutation) ResetField(name string) error {
+	switch name {
+	case strategyperformance.FieldStrategyID:
+		m.ResetStrategyID()
+		return nil
+	case strategyperformance.FieldTotalReturn:
+		m.ResetTotalReturn()
+		return nil
+	case strategyperformance.FieldWinRate:
+		m.ResetWinRate()
+		return nil
+	case strategyperformance.FieldProfitLoss:
+		m.ResetProfitLoss()
+		return nil
+	case strategyperformance.FieldTradeCount:
+		m.ResetTradeCount()
+		return nil
+	case strategyperformance.FieldLastTradeTime:
+		m.ResetLastTradeTime()
+		return nil
+	case strategyperformance.FieldMaxDrawdown:
+		m.ResetMaxDrawdown()
+		return nil
+	case strategyperformance.FieldSharpeRatio:
+		m.ResetSharpeRatio()
+		return nil
+	case strategyperformance.FieldClosedTrades:
+		m.ResetClosedTrades()
+		return nil
+	case strategyperformance.FieldWinningTrades:
+		m.ResetWinningTrades()
+		return nil
+	case strategyperformance.FieldReturnSum:
+		m.ResetReturnSum()
+		return nil
+	case strategyperformance.FieldReturnSqSum:
+		m.ResetReturnSqSum()
+		return nil
+	case strategyperformance.FieldEquityIndex:
+		m.ResetEquityIndex()
+		return nil
+	case strategyperformance.FieldPeakEquityIndex:
+		m.ResetPeakEquityIndex()
+		return nil
+	case strategyperformance.FieldOpenPositions:
+		m.ResetOpenPositions()
+		return nil
+	case strategyperformance.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case strategyperformance.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyPerformance field %s", name)
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *StrategyMutation) Name() (r string, exists bool) {
-	v := m.name
-	if v == nil {
-		return
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *StrategyPerformanceMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.strategy != nil {
+		edges = append(edges, strategyperformance.EdgeStrategy)
 	}
-	return *v, true
+	return edges
 }
 
-// OldName returns the old "name" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldName(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *StrategyPerformanceMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case strategyperformance.EdgeStrategy:
+		if id := m.strategy; id != nil {
+			return []ent.Value{*id}
+		}
 	}
-	return oldValue.Name, nil
+	return nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *StrategyMutation) ResetName() {
-	m.name = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *StrategyPerformanceMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
+	return edges
 }
 
-// SetDescription sets the "description" field.
-func (m *StrategyMutation) SetDescription(s string) {
-	m.description = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *StrategyPerformanceMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *StrategyMutation) Description() (r string, exists bool) {
-	v := m.description
-	if v == nil {
-		return
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *StrategyPerformanceMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstrategy {
+		edges = append(edges, strategyperformance.EdgeStrategy)
 	}
-	return *v, true
+	return edges
 }
 
-// OldDescription returns the old "description" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldDescription(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyPerformanceMutation) EdgeCleared(name string) bool {
+	switch name {
+	case strategyperformance.EdgeStrategy:
+		return m.clearedstrategy
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *StrategyPerformanceMutation) ClearEdge(name string) error {
+	switch name {
+	case strategyperformance.EdgeStrategy:
+		m.ClearStrategy()
+		return nil
 	}
-	return oldValue.Description, nil
+	return fmt.Errorf("unknown StrategyPerformance unique edge %s", name)
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *StrategyMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[strategy.FieldDescription] = struct{}{}
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *StrategyPerformanceMutation) ResetEdge(name string) error {
+	switch name {
+	case strategyperformance.EdgeStrategy:
+		m.ResetStrategy()
+		return nil
+	}
+	return fmt.Errorf("unknown StrategyPerformance edge %s", name)
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *StrategyMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldDescription]
-	return ok
+// StrategyStatusMutation represents an operation that mutates the StrategyStatus nodes in the graph.
+type StrategyStatusMutation struct {
+	config
+	op                 Op
+	typ                string
+	id                 *int
+	status             *strategystatus.Status
+	last_execution     *time.Time
+	execution_count    *int64
+	addexecution_count *int64
+	error_message      *string
+	uptime_seconds     *int64
+	adduptime_seconds  *int64
+	created_at         *time.Time
+	updated_at         *time.Time
+	clearedFields      map[string]struct{}
+	strategy           *uuid.UUID
+	clearedstrategy    bool
+	done               bool
+	oldValue           func(context.Context) (*StrategyStatus, error)
+	predicates         []predicate.StrategyStatus
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *StrategyMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, strategy.FieldDescription)
-}
+var _ ent.Mutation = (*StrategyStatusMutation)(nil)
 
-// SetSymbol sets the "symbol" field.
-func (m *StrategyMutation) SetSymbol(s string) {
-	m.symbol = &s
-}
+// strategystatusOption allows management of the mutation configuration using functional options.
+type strategystatusOption func(*StrategyStatusMutation)
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *StrategyMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
-	if v == nil {
-		return
+// newStrategyStatusMutation creates new mutation for the StrategyStatus entity.
+func newStrategyStatusMutation(c config, op Op, opts ...strategystatusOption) *StrategyStatusMutation {
+	m := &StrategyStatusMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeStrategyStatus,
+		clearedFields: make(map[string]struct{}),
 	}
-	return *v, true
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// OldSymbol returns the old "symbol" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSymbol(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+// withStrategyStatusID sets the ID field of the mutation.
+func withStrategyStatusID(id int) strategystatusOption {
+	return func(m *StrategyStatusMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *StrategyStatus
+		)
+		m.oldValue = func(ctx context.Context) (*StrategyStatus, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().StrategyStatus.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
 	}
-	return oldValue.Symbol, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *StrategyMutation) ResetSymbol() {
-	m.symbol = nil
+// withStrategyStatus sets the old StrategyStatus of the mutation.
+func withStrategyStatus(node *StrategyStatus) strategystatusOption {
+	return func(m *StrategyStatusMutation) {
+		m.oldValue = func(context.Context) (*StrategyStatus, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
 }
 
-// SetUserInputs sets the "user_inputs" field.
-func (m *StrategyMutation) SetUserInputs(value map[string]interface{}) {
-	m.user_inputs = &value
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m StrategyStatusMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// UserInputs returns the value of the "user_inputs" field in the mutation.
-func (m *StrategyMutation) UserInputs() (r map[string]interface{}, exists bool) {
-	v := m.user_inputs
-	if v == nil {
-		return
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m StrategyStatusMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
-	return *v, true
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// OldUserInputs returns the old "user_inputs" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUserInputs(ctx context.Context) (v map[string]interface{}, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUserInputs is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUserInputs requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUserInputs: %w", err)
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *StrategyStatusMutation) ID() (id int, exists bool) {
+	if m.id == nil {
+		return
 	}
-	return oldValue.UserInputs, nil
+	return *m.id, true
 }
 
-// ResetUserInputs resets all changes to the "user_inputs" field.
-func (m *StrategyMutation) ResetUserInputs() {
-	m.user_inputs = nil
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *StrategyStatusMutation) IDs(ctx context.Context) ([]int, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []int{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().StrategyStatus.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetSettings sets the "settings" field.
-func (m *StrategyMutation) SetSettings(value map[string]interface{}) {
-	m.settings = &value
+// SetStrategyID sets the "strategy_id" field.
+func (m *StrategyStatusMutation) SetStrategyID(u uuid.UUID) {
+	m.strategy = &u
 }
 
-// Settings returns the value of the "settings" field in the mutation.
-func (m *StrategyMutation) Settings() (r map[string]interface{}, exists bool) {
-	v := m.settings
+// StrategyID returns the value of the "strategy_id" field in the mutation.
+func (m *StrategyStatusMutation) StrategyID() (r uuid.UUID, exists bool) {
+	v := m.strategy
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSettings returns the old "settings" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStrategyID returns the old "strategy_id" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldSettings(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *StrategyStatusMutation) OldStrategyID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSettings is only allowed on UpdateOne operations")
+		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSettings requires an ID field in the mutation")
+		return v, errors.New("OldStrategyID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSettings: %w", err)
+		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
 	}
-	return oldValue.Settings, nil
+	return oldValue.StrategyID, nil
 }
 
-// ResetSettings resets all changes to the "settings" field.
-func (m *StrategyMutation) ResetSettings() {
-	m.settings = nil
+// ResetStrategyID resets all changes to the "strategy_id" field.
+func (m *StrategyStatusMutation) ResetStrategyID() {
+	m.strategy = nil
 }
 
-// SetActive sets the "active" field.
-func (m *StrategyMutation) SetActive(b bool) {
-	m.active = &b
+// SetStatus sets the "status" field.
+func (m *StrategyStatusMutation) SetStatus(s strategystatus.Status) {
+	m.status = &s
 }
 
-// Active returns the value of the "active" field in the mutation.
-func (m *StrategyMutation) Active() (r bool, exists bool) {
-	v := m.active
+// Status returns the value of the "status" field in the mutation.
+func (m *StrategyStatusMutation) Status() (r strategystatus.Status, exists bool) {
+	v := m.status
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldActive returns the old "active" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldStatus returns the old "status" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldActive(ctx context.Context) (v bool, err error) {
+func (m *StrategyStatusMutation) OldStatus(ctx context.Context) (v strategystatus.Status, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldActive is only allowed on UpdateOne operations")
+		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldActive requires an ID field in the mutation")
+		return v, errors.New("OldStatus requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldActive: %w", err)
+		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
 	}
-	return oldValue.Active, nil
+	return oldValue.Status, nil
 }
 
-// ResetActive resets all changes to the "active" field.
-func (m *StrategyMutation) ResetActive() {
-	m.active = nil
+// ResetStatus resets all changes to the "status" field.
+func (m *StrategyStatusMutation) ResetStatus() {
+	m.status = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StrategyMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetLastExecution sets the "last_execution" field.
+func (m *StrategyStatusMutation) SetLastExecution(t time.Time) {
+	m.last_execution = &t
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StrategyMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// LastExecution returns the value of the "last_execution" field in the mutation.
+func (m *StrategyStatusMutation) LastExecution() (r time.Time, exists bool) {
+	v := m.last_execution
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldLastExecution returns the old "last_execution" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldCreatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyStatusMutation) OldLastExecution(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldLastExecution is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldLastExecution requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldLastExecution: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.LastExecution, nil
 }
 
-// ClearCreatedAt clears the value of the "created_at" field.
-func (m *StrategyMutation) ClearCreatedAt() {
-	m.created_at = nil
-	m.clearedFields[strategy.FieldCreatedAt] = struct{}{}
+// ClearLastExecution clears the value of the "last_execution" field.
+func (m *StrategyStatusMutation) ClearLastExecution() {
+	m.last_execution = nil
+	m.clearedFields[strategystatus.FieldLastExecution] = struct{}{}
 }
 
-// CreatedAtCleared returns if the "created_at" field was cleared in this mutation.
-func (m *StrategyMutation) CreatedAtCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldCreatedAt]
+// LastExecutionCleared returns if the "last_execution" field was cleared in this mutation.
+func (m *StrategyStatusMutation) LastExecutionCleared() bool {
+	_, ok := m.clearedFields[strategystatus.FieldLastExecution]
 	return ok
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StrategyMutation) ResetCreatedAt() {
-	m.created_at = nil
-	delete(m.clearedFields, strategy.FieldCreatedAt)
+// ResetLastExecution resets all changes to the "last_execution" field.
+func (m *StrategyStatusMutation) ResetLastExecution() {
+	m.last_execution = nil
+	delete(m.clearedFields, strategystatus.FieldLastExecution)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StrategyMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetExecutionCount sets the "execution_count" field.
+func (m *StrategyStatusMutation) SetExecutionCount(i int64) {
+	m.execution_count = &i
+	m.addexecution_count = nil
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StrategyMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ExecutionCount returns the value of the "execution_count" field in the mutation.
+func (m *StrategyStatusMutation) ExecutionCount() (r int64, exists bool) {
+	v := m.execution_count
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the Strategy entity.
-// If the Strategy object wasn't provided to the builder, the object is fetched from the database.
+// OldExecutionCount returns the old "execution_count" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyMutation) OldUpdatedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyStatusMutation) OldExecutionCount(ctx context.Context) (v int64, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldExecutionCount is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldExecutionCount requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldExecutionCount: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ClearUpdatedAt clears the value of the "updated_at" field.
-func (m *StrategyMutation) ClearUpdatedAt() {
-	m.updated_at = nil
-	m.clearedFields[strategy.FieldUpdatedAt] = struct{}{}
+	return oldValue.ExecutionCount, nil
 }
 
-// UpdatedAtCleared returns if the "updated_at" field was cleared in this mutation.
-func (m *StrategyMutation) UpdatedAtCleared() bool {
-	_, ok := m.clearedFields[strategy.FieldUpdatedAt]
-	return ok
+// AddExecutionCount adds i to the "execution_count" field.
+func (m *StrategyStatusMutation) AddExecutionCount(i int64) {
+	if m.addexecution_count != nil {
+		*m.addexecution_count += i
+	} else {
+		m.addexecution_count = &i
+	}
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StrategyMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-	delete(m.clearedFields, strategy.FieldUpdatedAt)
+// AddedExecutionCount returns the value that was added to the "execution_count" field in this mutation.
+func (m *StrategyStatusMutation) AddedExecutionCount() (r int64, exists bool) {
+	v := m.addexecution_count
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (m *StrategyMutation) ClearUser() {
-	m.cleareduser = true
-	m.clearedFields[strategy.FieldUserID] = struct{}{}
+// ResetExecutionCount resets all changes to the "execution_count" field.
+func (m *StrategyStatusMutation) ResetExecutionCount() {
+	m.execution_count = nil
+	m.addexecution_count = nil
 }
 
-// UserCleared reports if the "user" edge to the User entity was cleared.
-func (m *StrategyMutation) UserCleared() bool {
-	return m.cleareduser
+// SetErrorMessage sets the "error_message" field.
+func (m *StrategyStatusMutation) SetErrorMessage(s string) {
+	m.error_message = &s
 }
 
-// UserIDs returns the "user" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// UserID instead. It exists only for internal usage by the builders.
-func (m *StrategyMutation) UserIDs() (ids []uuid.UUID) {
-	if id := m.user; id != nil {
-		ids = append(ids, *id)
+// ErrorMessage returns the value of the "error_message" field in the mutation.
+func (m *StrategyStatusMutation) ErrorMessage() (r string, exists bool) {
+	v := m.error_message
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// ResetUser resets all changes to the "user" edge.
-func (m *StrategyMutation) ResetUser() {
-	m.user = nil
-	m.cleareduser = false
+// OldErrorMessage returns the old "error_message" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyStatusMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
+	}
+	return oldValue.ErrorMessage, nil
 }
 
-// ClearTemplate clears the "template" edge to the StrategyTemplate entity.
-func (m *StrategyMutation) ClearTemplate() {
-	m.clearedtemplate = true
-	m.clearedFields[strategy.FieldTemplateID] = struct{}{}
+// ClearErrorMessage clears the value of the "error_message" field.
+func (m *StrategyStatusMutation) ClearErrorMessage() {
+	m.error_message = nil
+	m.clearedFields[strategystatus.FieldErrorMessage] = struct{}{}
 }
 
-// TemplateCleared reports if the "template" edge to the StrategyTemplate entity was cleared.
-func (m *StrategyMutation) TemplateCleared() bool {
-	return m.TemplateIDCleared() || m.clearedtemplate
+// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
+func (m *StrategyStatusMutation) ErrorMessageCleared() bool {
+	_, ok := m.clearedFields[strategystatus.FieldErrorMessage]
+	return ok
 }
 
-// TemplateIDs returns the "template" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// TemplateID instead. It exists only for internal usage by the builders.
-func (m *StrategyMutation) TemplateIDs() (ids []uuid.UUID) {
-	if id := m.template; id != nil {
-		ids = append(ids, *id)
-	}
-	return
+// ResetErrorMessage resets all changes to the "error_message" field.
+func (m *StrategyStatusMutation) ResetErrorMessage() {
+	m.error_message = nil
+	delete(m.clearedFields, strategystatus.FieldErrorMessage)
 }
 
-// ResetTemplate resets all changes to the "template" edge.
-func (m *StrategyMutation) ResetTemplate() {
-	m.template = nil
-	m.clearedtemplate = false
+// SetUptimeSeconds sets the "uptime_seconds" field.
+func (m *StrategyStatusMutation) SetUptimeSeconds(i int64) {
+	m.uptime_seconds = &i
+	m.adduptime_seconds = nil
 }
 
-// AddExecutionIDs adds the "executions" edge to the StrategyExecution entity by ids.
-func (m *StrategyMutation) AddExecutionIDs(ids ...int64) {
-	if m.executions == nil {
-		m.executions = make(map[int64]struct{})
-	}
-	for i := range ids {
-		m.executions[ids[i]] = struct{}{}
+// UptimeSeconds returns the value of the "uptime_seconds" field in the mutation.
+func (m *StrategyStatusMutation) UptimeSeconds() (r int64, exists bool) {
+	v := m.uptime_seconds
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearExecutions clears the "executions" edge to the StrategyExecution entity.
-func (m *StrategyMutation) ClearExecutions() {
-	m.clearedexecutions = true
-}
-
-// ExecutionsCleared reports if the "executions" edge to the StrategyExecution entity was cleared.
-func (m *StrategyMutation) ExecutionsCleared() bool {
-	return m.clearedexecutions
-}
-
-// RemoveExecutionIDs removes the "executions" edge to the StrategyExecution entity by IDs.
-func (m *StrategyMutation) RemoveExecutionIDs(ids ...int64) {
-	if m.removedexecutions == nil {
-		m.removedexecutions = make(map[int64]struct{})
+// OldUptimeSeconds returns the old "uptime_seconds" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyStatusMutation) OldUptimeSeconds(ctx context.Context) (v int64, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUptimeSeconds is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		delete(m.executions, ids[i])
-		m.removedexecutions[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUptimeSeconds requires an ID field in the mutation")
 	}
-}
-
-// RemovedExecutions returns the removed IDs of the "executions" edge to the StrategyExecution entity.
-func (m *StrategyMutation) RemovedExecutionsIDs() (ids []int64) {
-	for id := range m.removedexecutions {
-		ids = append(ids, id)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUptimeSeconds: %w", err)
 	}
-	return
+	return oldValue.UptimeSeconds, nil
 }
 
-// ExecutionsIDs returns the "executions" edge IDs in the mutation.
-func (m *StrategyMutation) ExecutionsIDs() (ids []int64) {
-	for id := range m.executions {
-		ids = append(ids, id)
+// AddUptimeSeconds adds i to the "uptime_seconds" field.
+func (m *StrategyStatusMutation) AddUptimeSeconds(i int64) {
+	if m.adduptime_seconds != nil {
+		*m.adduptime_seconds += i
+	} else {
+		m.adduptime_seconds = &i
 	}
-	return
-}
-
-// ResetExecutions resets all changes to the "executions" edge.
-func (m *StrategyMutation) ResetExecutions() {
-	m.executions = nil
-	m.clearedexecutions = false
-	m.removedexecutions = nil
 }
 
-// SetPerformanceID sets the "performance" edge to the StrategyPerformance entity by id.
-func (m *StrategyMutation) SetPerformanceID(id int) {
-	m.performance = &id
+// AddedUptimeSeconds returns the value that was added to the "uptime_seconds" field in this mutation.
+func (m *StrategyStatusMutation) AddedUptimeSeconds() (r int64, exists bool) {
+	v := m.adduptime_seconds
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ClearPerformance clears the "performance" edge to the StrategyPerformance entity.
-func (m *StrategyMutation) ClearPerformance() {
-	m.clearedperformance = true
+// ResetUptimeSeconds resets all changes to the "uptime_seconds" field.
+func (m *StrategyStatusMutation) ResetUptimeSeconds() {
+	m.uptime_seconds = nil
+	m.adduptime_seconds = nil
 }
 
-// PerformanceCleared reports if the "performance" edge to the StrategyPerformance entity was cleared.
-func (m *StrategyMutation) PerformanceCleared() bool {
-	return m.clearedperformance
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyStatusMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// PerformanceID returns the "performance" edge ID in the mutation.
-func (m *StrategyMutation) PerformanceID() (id int, exists bool) {
-	if m.performance != nil {
-		return *m.performance, true
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyStatusMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// PerformanceIDs returns the "performance" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// PerformanceID instead. It exists only for internal usage by the builders.
-func (m *StrategyMutation) PerformanceIDs() (ids []int) {
-	if id := m.performance; id != nil {
-		ids = append(ids, *id)
+// OldCreatedAt returns the old "created_at" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPerformance resets all changes to the "performance" edge.
-func (m *StrategyMutation) ResetPerformance() {
-	m.performance = nil
-	m.clearedperformance = false
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyStatusMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetStatusID sets the "status" edge to the StrategyStatus entity by id.
-func (m *StrategyMutation) SetStatusID(id int) {
-	m.status = &id
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyStatusMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ClearStatus clears the "status" edge to the StrategyStatus entity.
-func (m *StrategyMutation) ClearStatus() {
-	m.clearedstatus = true
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyStatusMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// StatusCleared reports if the "status" edge to the StrategyStatus entity was cleared.
-func (m *StrategyMutation) StatusCleared() bool {
-	return m.clearedstatus
+// OldUpdatedAt returns the old "updated_at" field's value of the StrategyStatus entity.
+// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
 }
 
-// StatusID returns the "status" edge ID in the mutation.
-func (m *StrategyMutation) StatusID() (id int, exists bool) {
-	if m.status != nil {
-		return *m.status, true
-	}
-	return
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyStatusMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// StatusIDs returns the "status" edge IDs in the mutation.
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (m *StrategyStatusMutation) ClearStrategy() {
+	m.clearedstrategy = true
+	m.clearedFields[strategystatus.FieldStrategyID] = struct{}{}
+}
+
+// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
+func (m *StrategyStatusMutation) StrategyCleared() bool {
+	return m.clearedstrategy
+}
+
+// StrategyIDs returns the "strategy" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StatusID instead. It exists only for internal usage by the builders.
-func (m *StrategyMutation) StatusIDs() (ids []int) {
-	if id := m.status; id != nil {
+// StrategyID instead. It exists only for internal usage by the builders.
+func (m *StrategyStatusMutation) StrategyIDs() (ids []uuid.UUID) {
+	if id := m.strategy; id != nil {
 		ids = append(ids, *id)
 	}
 	return
 }
 
-// ResetStatus resets all changes to the "status" edge.
-func (m *StrategyMutation) ResetStatus() {
-	m.status = nil
-	m.clearedstatus = false
+// ResetStrategy resets all changes to the "strategy" edge.
+func (m *StrategyStatusMutation) ResetStrategy() {
+	m.strategy = nil
+	m.clearedstrategy = false
 }
 
-// Where appends a list predicates to the StrategyMutation builder.
-func (m *StrategyMutation) Where(ps ...predicate.Strategy) {
+// Where appends a list predicates to the StrategyStatusMutation builder.
+func (m *StrategyStatusMutation) Where(ps ...predicate.StrategyStatus) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyStatusMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Strategy, len(ps))
+func (m *StrategyStatusMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyStatus, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1867,57 +17842,48 @@ func (m *StrategyMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyMutation) Op() Op {
+func (m *StrategyStatusMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyMutation) SetOp(op Op) {
+func (m *StrategyStatusMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Strategy).
-func (m *StrategyMutation) Type() string {
+// Type returns the node type of this mutation (StrategyStatus).
+func (m *StrategyStatusMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyMutation) Fields() []string {
-	fields := make([]string, 0, 11)
-	if m.user != nil {
-		fields = append(fields, strategy.FieldUserID)
-	}
-	if m.strategy_id != nil {
-		fields = append(fields, strategy.FieldStrategyID)
-	}
-	if m.template != nil {
-		fields = append(fields, strategy.FieldTemplateID)
-	}
-	if m.name != nil {
-		fields = append(fields, strategy.FieldName)
+func (m *StrategyStatusMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.strategy != nil {
+		fields = append(fields, strategystatus.FieldStrategyID)
 	}
-	if m.description != nil {
-		fields = append(fields, strategy.FieldDescription)
+	if m.status != nil {
+		fields = append(fields, strategystatus.FieldStatus)
 	}
-	if m.symbol != nil {
-		fields = append(fields, strategy.FieldSymbol)
+	if m.last_execution != nil {
+		fields = append(fields, strategystatus.FieldLastExecution)
 	}
-	if m.user_inputs != nil {
-		fields = append(fields, strategy.FieldUserInputs)
+	if m.execution_count != nil {
+		fields = append(fields, strategystatus.FieldExecutionCount)
 	}
-	if m.settings != nil {
-		fields = append(fields, strategy.FieldSettings)
+	if m.error_message != nil {
+		fields = append(fields, strategystatus.FieldErrorMessage)
 	}
-	if m.active != nil {
-		fields = append(fields, strategy.FieldActive)
+	if m.uptime_seconds != nil {
+		fields = append(fields, strategystatus.FieldUptimeSeconds)
 	}
 	if m.created_at != nil {
-		fields = append(fields, strategy.FieldCreatedAt)
+		fields = append(fields, strategystatus.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, strategy.FieldUpdatedAt)
+		fields = append(fields, strategystatus.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -1925,29 +17891,23 @@ func (m *StrategyMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyStatusMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategy.FieldUserID:
-		return m.UserID()
-	case strategy.FieldStrategyID:
+	case strategystatus.FieldStrategyID:
 		return m.StrategyID()
-	case strategy.FieldTemplateID:
-		return m.TemplateID()
-	case strategy.FieldName:
-		return m.Name()
-	case strategy.FieldDescription:
-		return m.Description()
-	case strategy.FieldSymbol:
-		return m.Symbol()
-	case strategy.FieldUserInputs:
-		return m.UserInputs()
-	case strategy.FieldSettings:
-		return m.Settings()
-	case strategy.FieldActive:
-		return m.Active()
-	case strategy.FieldCreatedAt:
+	case strategystatus.FieldStatus:
+		return m.Status()
+	case strategystatus.FieldLastExecution:
+		return m.LastExecution()
+	case strategystatus.FieldExecutionCount:
+		return m.ExecutionCount()
+	case strategystatus.FieldErrorMessage:
+		return m.ErrorMessage()
+	case strategystatus.FieldUptimeSeconds:
+		return m.UptimeSeconds()
+	case strategystatus.FieldCreatedAt:
 		return m.CreatedAt()
-	case strategy.FieldUpdatedAt:
+	case strategystatus.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -1956,110 +17916,83 @@ func (m *StrategyMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategy.FieldUserID:
-		return m.OldUserID(ctx)
-	case strategy.FieldStrategyID:
+	case strategystatus.FieldStrategyID:
 		return m.OldStrategyID(ctx)
-	case strategy.FieldTemplateID:
-		return m.OldTemplateID(ctx)
-	case strategy.FieldName:
-		return m.OldName(ctx)
-	case strategy.FieldDescription:
-		return m.OldDescription(ctx)
-	case strategy.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case strategy.FieldUserInputs:
-		return m.OldUserInputs(ctx)
-	case strategy.FieldSettings:
-		return m.OldSettings(ctx)
-	case strategy.FieldActive:
-		return m.OldActive(ctx)
-	case strategy.FieldCreatedAt:
+	case strategystatus.FieldStatus:
+		return m.OldStatus(ctx)
+	case strategystatus.FieldLastExecution:
+		return m.OldLastExecution(ctx)
+	case strategystatus.FieldExecutionCount:
+		return m.OldExecutionCount(ctx)
+	case strategystatus.FieldErrorMessage:
+		return m.OldErrorMessage(ctx)
+	case strategystatus.FieldUptimeSeconds:
+		return m.OldUptimeSeconds(ctx)
+	case strategystatus.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case strategy.FieldUpdatedAt:
+	case strategystatus.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Strategy field %s", name)
+	return nil, fmt.Errorf("unknown StrategyStatus field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyStatusMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategy.FieldUserID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserID(v)
-		return nil
-	case strategy.FieldStrategyID:
-		v, ok := value.(string)
+	case strategystatus.FieldStrategyID:
+		v, ok := value.(uuid.UUID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetStrategyID(v)
 		return nil
-	case strategy.FieldTemplateID:
-		v, ok := value.(uuid.UUID)
+	case strategystatus.FieldStatus:
+		v, ok := value.(strategystatus.Status)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTemplateID(v)
+		m.SetStatus(v)
 		return nil
-	case strategy.FieldName:
-		v, ok := value.(string)
+	case strategystatus.FieldLastExecution:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetLastExecution(v)
 		return nil
-	case strategy.FieldDescription:
-		v, ok := value.(string)
+	case strategystatus.FieldExecutionCount:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetExecutionCount(v)
 		return nil
-	case strategy.FieldSymbol:
+	case strategystatus.FieldErrorMessage:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
-		return nil
-	case strategy.FieldUserInputs:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUserInputs(v)
-		return nil
-	case strategy.FieldSettings:
-		v, ok := value.(map[string]interface{})
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetSettings(v)
+		m.SetErrorMessage(v)
 		return nil
-	case strategy.FieldActive:
-		v, ok := value.(bool)
+	case strategystatus.FieldUptimeSeconds:
+		v, ok := value.(int64)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetActive(v)
+		m.SetUptimeSeconds(v)
 		return nil
-	case strategy.FieldCreatedAt:
+	case strategystatus.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case strategy.FieldUpdatedAt:
+	case strategystatus.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2067,163 +18000,139 @@ func (m *StrategyMutation) SetField(name string, value ent.Value) error {
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown StrategyStatus field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyMutation) AddedFields() []string {
-	return nil
+func (m *StrategyStatusMutation) AddedFields() []string {
+	var fields []string
+	if m.addexecution_count != nil {
+		fields = append(fields, strategystatus.FieldExecutionCount)
+	}
+	if m.adduptime_seconds != nil {
+		fields = append(fields, strategystatus.FieldUptimeSeconds)
+	}
+	return fields
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyMutation) AddedField(name string) (ent.Value, bool) {
+func (m *StrategyStatusMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case strategystatus.FieldExecutionCount:
+		return m.AddedExecutionCount()
+	case strategystatus.FieldUptimeSeconds:
+		return m.AddedUptimeSeconds()
+	}
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyMutation) AddField(name string, value ent.Value) error {
+func (m *StrategyStatusMutation) AddField(name string, value ent.Value) error {
 	switch name {
+	case strategystatus.FieldExecutionCount:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddExecutionCount(v)
+		return nil
+	case strategystatus.FieldUptimeSeconds:
+		v, ok := value.(int64)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddUptimeSeconds(v)
+		return nil
 	}
-	return fmt.Errorf("unknown Strategy numeric field %s", name)
+	return fmt.Errorf("unknown StrategyStatus numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyMutation) ClearedFields() []string {
+func (m *StrategyStatusMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(strategy.FieldTemplateID) {
-		fields = append(fields, strategy.FieldTemplateID)
-	}
-	if m.FieldCleared(strategy.FieldDescription) {
-		fields = append(fields, strategy.FieldDescription)
-	}
-	if m.FieldCleared(strategy.FieldCreatedAt) {
-		fields = append(fields, strategy.FieldCreatedAt)
+	if m.FieldCleared(strategystatus.FieldLastExecution) {
+		fields = append(fields, strategystatus.FieldLastExecution)
 	}
-	if m.FieldCleared(strategy.FieldUpdatedAt) {
-		fields = append(fields, strategy.FieldUpdatedAt)
+	if m.FieldCleared(strategystatus.FieldErrorMessage) {
+		fields = append(fields, strategystatus.FieldErrorMessage)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyMutation) FieldCleared(name string) bool {
+func (m *StrategyStatusMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyMutation) ClearField(name string) error {
+func (m *StrategyStatusMutation) ClearField(name string) error {
 	switch name {
-	case strategy.FieldTemplateID:
-		m.ClearTemplateID()
-		return nil
-	case strategy.FieldDescription:
-		m.ClearDescription()
-		return nil
-	case strategy.FieldCreatedAt:
-		m.ClearCreatedAt()
+	case strategystatus.FieldLastExecution:
+		m.ClearLastExecution()
 		return nil
-	case strategy.FieldUpdatedAt:
-		m.ClearUpdatedAt()
+	case strategystatus.FieldErrorMessage:
+		m.ClearErrorMessage()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy nullable field %s", name)
+	return fmt.Errorf("unknown StrategyStatus nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyMutation) ResetField(name string) error {
+func (m *StrategyStatusMutation) ResetField(name string) error {
 	switch name {
-	case strategy.FieldUserID:
-		m.ResetUserID()
-		return nil
-	case strategy.FieldStrategyID:
+	case strategystatus.FieldStrategyID:
 		m.ResetStrategyID()
 		return nil
-	case strategy.FieldTemplateID:
-		m.ResetTemplateID()
-		return nil
-	case strategy.FieldName:
-		m.ResetName()
-		return nil
-	case strategy.FieldDescription:
-		m.ResetDescription()
+	case strategystatus.FieldStatus:
+		m.ResetStatus()
 		return nil
-	case strategy.FieldSymbol:
-		m.ResetSymbol()
+	case strategystatus.FieldLastExecution:
+		m.ResetLastExecution()
 		return nil
-	case strategy.FieldUserInputs:
-		m.ResetUserInputs()
+	case strategystatus.FieldExecutionCount:
+		m.ResetExecutionCount()
 		return nil
-	case strategy.FieldSettings:
-		m.ResetSettings()
+	case strategystatus.FieldErrorMessage:
+		m.ResetErrorMessage()
 		return nil
-	case strategy.FieldActive:
-		m.ResetActive()
+	case strategystatus.FieldUptimeSeconds:
+		m.ResetUptimeSeconds()
 		return nil
-	case strategy.FieldCreatedAt:
+	case strategystatus.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case strategy.FieldUpdatedAt:
+	case strategystatus.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy field %s", name)
+	return fmt.Errorf("unknown StrategyStatus field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyMutation) AddedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.user != nil {
-		edges = append(edges, strategy.EdgeUser)
-	}
-	if m.template != nil {
-		edges = append(edges, strategy.EdgeTemplate)
-	}
-	if m.executions != nil {
-		edges = append(edges, strategy.EdgeExecutions)
-	}
-	if m.performance != nil {
-		edges = append(edges, strategy.EdgePerformance)
-	}
-	if m.status != nil {
-		edges = append(edges, strategy.EdgeStatus)
+func (m *StrategyStatusMutation) AddedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.strategy != nil {
+		edges = append(edges, strategystatus.EdgeStrategy)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
+func (m *StrategyStatusMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case strategy.EdgeUser:
-		if id := m.user; id != nil {
-			return []ent.Value{*id}
-		}
-	case strategy.EdgeTemplate:
-		if id := m.template; id != nil {
-			return []ent.Value{*id}
-		}
-	case strategy.EdgeExecutions:
-		ids := make([]ent.Value, 0, len(m.executions))
-		for id := range m.executions {
-			ids = append(ids, id)
-		}
-		return ids
-	case strategy.EdgePerformance:
-		if id := m.performance; id != nil {
-			return []ent.Value{*id}
-		}
-	case strategy.EdgeStatus:
-		if id := m.status; id != nil {
+	case strategystatus.EdgeStrategy:
+		if id := m.strategy; id != nil {
 			return []ent.Value{*id}
 		}
 	}
@@ -2231,142 +18140,92 @@ func (m *StrategyMutation) AddedIDs(name string) []ent.Value {
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.removedexecutions != nil {
-		edges = append(edges, strategy.EdgeExecutions)
-	}
+func (m *StrategyStatusMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 1)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case strategy.EdgeExecutions:
-		ids := make([]ent.Value, 0, len(m.removedexecutions))
-		for id := range m.removedexecutions {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *StrategyStatusMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 5)
-	if m.cleareduser {
-		edges = append(edges, strategy.EdgeUser)
-	}
-	if m.clearedtemplate {
-		edges = append(edges, strategy.EdgeTemplate)
-	}
-	if m.clearedexecutions {
-		edges = append(edges, strategy.EdgeExecutions)
-	}
-	if m.clearedperformance {
-		edges = append(edges, strategy.EdgePerformance)
-	}
-	if m.clearedstatus {
-		edges = append(edges, strategy.EdgeStatus)
+func (m *StrategyStatusMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 1)
+	if m.clearedstrategy {
+		edges = append(edges, strategystatus.EdgeStrategy)
 	}
-	return edges
-}
-
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *StrategyMutation) EdgeCleared(name string) bool {
-	switch name {
-	case strategy.EdgeUser:
-		return m.cleareduser
-	case strategy.EdgeTemplate:
-		return m.clearedtemplate
-	case strategy.EdgeExecutions:
-		return m.clearedexecutions
-	case strategy.EdgePerformance:
-		return m.clearedperformance
-	case strategy.EdgeStatus:
-		return m.clearedstatus
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *StrategyStatusMutation) EdgeCleared(name string) bool {
+	switch name {
+	case strategystatus.EdgeStrategy:
+		return m.clearedstrategy
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyMutation) ClearEdge(name string) error {
+func (m *StrategyStatusMutation) ClearEdge(name string) error {
 	switch name {
-	case strategy.EdgeUser:
-		m.ClearUser()
-		return nil
-	case strategy.EdgeTemplate:
-		m.ClearTemplate()
-		return nil
-	case strategy.EdgePerformance:
-		m.ClearPerformance()
-		return nil
-	case strategy.EdgeStatus:
-		m.ClearStatus()
+	case strategystatus.EdgeStrategy:
+		m.ClearStrategy()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy unique edge %s", name)
+	return fmt.Errorf("unknown StrategyStatus unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyMutation) ResetEdge(name string) error {
+func (m *StrategyStatusMutation) ResetEdge(name string) error {
 	switch name {
-	case strategy.EdgeUser:
-		m.ResetUser()
-		return nil
-	case strategy.EdgeTemplate:
-		m.ResetTemplate()
-		return nil
-	case strategy.EdgeExecutions:
-		m.ResetExecutions()
-		return nil
-	case strategy.EdgePerformance:
-		m.ResetPerformance()
-		return nil
-	case strategy.EdgeStatus:
-		m.ResetStatus()
+	case strategystatus.EdgeStrategy:
+		m.ResetStrategy()
 		return nil
 	}
-	return fmt.Errorf("unknown Strategy edge %s", name)
+	return fmt.Errorf("unknown StrategyStatus edge %s", name)
 }
 
-// StrategyExecutionMutation represents an operation that mutates the StrategyExecution nodes in the graph.
-type StrategyExecutionMutation struct {
+// StrategyTemplateMutation represents an operation that mutates the StrategyTemplate nodes in the graph.
+type StrategyTemplateMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int64
-	symbol          *string
-	action          *strategyexecution.Action
-	price           *decimal.Decimal
-	quantity        *int
-	addquantity     *int
-	reasoning       *string
-	executed_at     *time.Time
-	clearedFields   map[string]struct{}
-	strategy        *uuid.UUID
-	clearedstrategy bool
-	done            bool
-	oldValue        func(context.Context) (*StrategyExecution, error)
-	predicates      []predicate.StrategyExecution
+	op                Op
+	typ               string
+	id                *uuid.UUID
+	name              *string
+	description       *string
+	category          *string
+	template_config   *map[string]interface{}
+	input_schema      *map[string]interface{}
+	version           *string
+	created_at        *time.Time
+	updated_at        *time.Time
+	clearedFields     map[string]struct{}
+	strategies        map[uuid.UUID]struct{}
+	removedstrategies map[uuid.UUID]struct{}
+	clearedstrategies bool
+	done              bool
+	oldValue          func(context.Context) (*StrategyTemplate, error)
+	predicates        []predicate.StrategyTemplate
 }
 
-var _ ent.Mutation = (*StrategyExecutionMutation)(nil)
+var _ ent.Mutation = (*StrategyTemplateMutation)(nil)
 
-// strategyexecutionOption allows management of the mutation configuration using functional options.
-type strategyexecutionOption func(*StrategyExecutionMutation)
+// strategytemplateOption allows management of the mutation configuration using functional options.
+type strategytemplateOption func(*StrategyTemplateMutation)
 
-// newStrategyExecutionMutation creates new mutation for the StrategyExecution entity.
-func newStrategyExecutionMutation(c config, op Op, opts ...strategyexecutionOption) *StrategyExecutionMutation {
-	m := &StrategyExecutionMutation{
+// newStrategyTemplateMutation creates new mutation for the StrategyTemplate entity.
+func newStrategyTemplateMutation(c config, op Op, opts ...strategytemplateOption) *StrategyTemplateMutation {
+	m := &StrategyTemplateMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStrategyExecution,
+		typ:           TypeStrategyTemplate,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2375,20 +18234,20 @@ func newStrategyExecutionMutation(c config, op Op, opts ...strategyexecutionOpti
 	return m
 }
 
-// withStrategyExecutionID sets the ID field of the mutation.
-func withStrategyExecutionID(id int64) strategyexecutionOption {
-	return func(m *StrategyExecutionMutation) {
+// withStrategyTemplateID sets the ID field of the mutation.
+func withStrategyTemplateID(id uuid.UUID) strategytemplateOption {
+	return func(m *StrategyTemplateMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StrategyExecution
+			value *StrategyTemplate
 		)
-		m.oldValue = func(ctx context.Context) (*StrategyExecution, error) {
+		m.oldValue = func(ctx context.Context) (*StrategyTemplate, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StrategyExecution.Get(ctx, id)
+					value, err = m.Client().StrategyTemplate.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2397,10 +18256,10 @@ func withStrategyExecutionID(id int64) strategyexecutionOption {
 	}
 }
 
-// withStrategyExecution sets the old StrategyExecution of the mutation.
-func withStrategyExecution(node *StrategyExecution) strategyexecutionOption {
-	return func(m *StrategyExecutionMutation) {
-		m.oldValue = func(context.Context) (*StrategyExecution, error) {
+// withStrategyTemplate sets the old StrategyTemplate of the mutation.
+func withStrategyTemplate(node *StrategyTemplate) strategytemplateOption {
+	return func(m *StrategyTemplateMutation) {
+		m.oldValue = func(context.Context) (*StrategyTemplate, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2409,7 +18268,7 @@ func withStrategyExecution(node *StrategyExecution) strategyexecutionOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyExecutionMutation) Client() *Client {
+func (m StrategyTemplateMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2417,7 +18276,7 @@ func (m StrategyExecutionMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StrategyExecutionMutation) Tx() (*Tx, error) {
+func (m StrategyTemplateMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -2427,14 +18286,14 @@ func (m StrategyExecutionMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of StrategyExecution entities.
-func (m *StrategyExecutionMutation) SetID(id int64) {
+// operation is only accepted on creation of StrategyTemplate entities.
+func (m *StrategyTemplateMutation) SetID(id uuid.UUID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StrategyExecutionMutation) ID() (id int64, exists bool) {
+func (m *StrategyTemplateMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2445,395 +18304,385 @@ func (m *StrategyExecutionMutation) ID() (id int64, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StrategyExecutionMutation) IDs(ctx context.Context) ([]int64, error) {
+func (m *StrategyTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int64{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StrategyExecution.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().StrategyTemplate.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStrategyID sets the "strategy_id" field.
-func (m *StrategyExecutionMutation) SetStrategyID(u uuid.UUID) {
-	m.strategy = &u
+// SetName sets the "name" field.
+func (m *StrategyTemplateMutation) SetName(s string) {
+	m.name = &s
 }
 
-// StrategyID returns the value of the "strategy_id" field in the mutation.
-func (m *StrategyExecutionMutation) StrategyID() (r uuid.UUID, exists bool) {
-	v := m.strategy
+// Name returns the value of the "name" field in the mutation.
+func (m *StrategyTemplateMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrategyID returns the old "strategy_id" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldStrategyID(ctx context.Context) (v *uuid.UUID, err error) {
+func (m *StrategyTemplateMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.StrategyID, nil
+	return oldValue.Name, nil
 }
 
-// ClearStrategyID clears the value of the "strategy_id" field.
-func (m *StrategyExecutionMutation) ClearStrategyID() {
-	m.strategy = nil
-	m.clearedFields[strategyexecution.FieldStrategyID] = struct{}{}
+// ResetName resets all changes to the "name" field.
+func (m *StrategyTemplateMutation) ResetName() {
+	m.name = nil
 }
 
-// StrategyIDCleared returns if the "strategy_id" field was cleared in this mutation.
-func (m *StrategyExecutionMutation) StrategyIDCleared() bool {
-	_, ok := m.clearedFields[strategyexecution.FieldStrategyID]
+// SetDescription sets the "description" field.
+func (m *StrategyTemplateMutation) SetDescription(s string) {
+	m.description = &s
+}
+
+// Description returns the value of the "description" field in the mutation.
+func (m *StrategyTemplateMutation) Description() (r string, exists bool) {
+	v := m.description
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldDescription returns the old "description" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *StrategyTemplateMutation) OldDescription(ctx context.Context) (v *string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDescription requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+	}
+	return oldValue.Description, nil
+}
+
+// ClearDescription clears the value of the "description" field.
+func (m *StrategyTemplateMutation) ClearDescription() {
+	m.description = nil
+	m.clearedFields[strategytemplate.FieldDescription] = struct{}{}
+}
+
+// DescriptionCleared returns if the "description" field was cleared in this mutation.
+func (m *StrategyTemplateMutation) DescriptionCleared() bool {
+	_, ok := m.clearedFields[strategytemplate.FieldDescription]
 	return ok
 }
 
-// ResetStrategyID resets all changes to the "strategy_id" field.
-func (m *StrategyExecutionMutation) ResetStrategyID() {
-	m.strategy = nil
-	delete(m.clearedFields, strategyexecution.FieldStrategyID)
+// ResetDescription resets all changes to the "description" field.
+func (m *StrategyTemplateMutation) ResetDescription() {
+	m.description = nil
+	delete(m.clearedFields, strategytemplate.FieldDescription)
 }
 
-// SetSymbol sets the "symbol" field.
-func (m *StrategyExecutionMutation) SetSymbol(s string) {
-	m.symbol = &s
+// SetCategory sets the "category" field.
+func (m *StrategyTemplateMutation) SetCategory(s string) {
+	m.category = &s
 }
 
-// Symbol returns the value of the "symbol" field in the mutation.
-func (m *StrategyExecutionMutation) Symbol() (r string, exists bool) {
-	v := m.symbol
+// Category returns the value of the "category" field in the mutation.
+func (m *StrategyTemplateMutation) Category() (r string, exists bool) {
+	v := m.category
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSymbol returns the old "symbol" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldCategory returns the old "category" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldSymbol(ctx context.Context) (v string, err error) {
+func (m *StrategyTemplateMutation) OldCategory(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSymbol requires an ID field in the mutation")
+		return v, errors.New("OldCategory requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
 	}
-	return oldValue.Symbol, nil
+	return oldValue.Category, nil
 }
 
-// ResetSymbol resets all changes to the "symbol" field.
-func (m *StrategyExecutionMutation) ResetSymbol() {
-	m.symbol = nil
+// ResetCategory resets all changes to the "category" field.
+func (m *StrategyTemplateMutation) ResetCategory() {
+	m.category = nil
 }
 
-// SetAction sets the "action" field.
-func (m *StrategyExecutionMutation) SetAction(s strategyexecution.Action) {
-	m.action = &s
+// SetTemplateConfig sets the "template_config" field.
+func (m *StrategyTemplateMutation) SetTemplateConfig(value map[string]interface{}) {
+	m.template_config = &value
 }
 
-// Action returns the value of the "action" field in the mutation.
-func (m *StrategyExecutionMutation) Action() (r strategyexecution.Action, exists bool) {
-	v := m.action
+// TemplateConfig returns the value of the "template_config" field in the mutation.
+func (m *StrategyTemplateMutation) TemplateConfig() (r map[string]interface{}, exists bool) {
+	v := m.template_config
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAction returns the old "action" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldTemplateConfig returns the old "template_config" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldAction(ctx context.Context) (v strategyexecution.Action, err error) {
+func (m *StrategyTemplateMutation) OldTemplateConfig(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAction is only allowed on UpdateOne operations")
+		return v, errors.New("OldTemplateConfig is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAction requires an ID field in the mutation")
+		return v, errors.New("OldTemplateConfig requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAction: %w", err)
+		return v, fmt.Errorf("querying old value for OldTemplateConfig: %w", err)
 	}
-	return oldValue.Action, nil
+	return oldValue.TemplateConfig, nil
 }
 
-// ResetAction resets all changes to the "action" field.
-func (m *StrategyExecutionMutation) ResetAction() {
-	m.action = nil
+// ResetTemplateConfig resets all changes to the "template_config" field.
+func (m *StrategyTemplateMutation) ResetTemplateConfig() {
+	m.template_config = nil
 }
 
-// SetPrice sets the "price" field.
-func (m *StrategyExecutionMutation) SetPrice(d decimal.Decimal) {
-	m.price = &d
+// SetInputSchema sets the "input_schema" field.
+func (m *StrategyTemplateMutation) SetInputSchema(value map[string]interface{}) {
+	m.input_schema = &value
 }
 
-// Price returns the value of the "price" field in the mutation.
-func (m *StrategyExecutionMutation) Price() (r decimal.Decimal, exists bool) {
-	v := m.price
+// InputSchema returns the value of the "input_schema" field in the mutation.
+func (m *StrategyTemplateMutation) InputSchema() (r map[string]interface{}, exists bool) {
+	v := m.input_schema
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPrice returns the old "price" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldInputSchema returns the old "input_schema" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldPrice(ctx context.Context) (v *decimal.Decimal, err error) {
+func (m *StrategyTemplateMutation) OldInputSchema(ctx context.Context) (v map[string]interface{}, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
+		return v, errors.New("OldInputSchema is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPrice requires an ID field in the mutation")
+		return v, errors.New("OldInputSchema requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+		return v, fmt.Errorf("querying old value for OldInputSchema: %w", err)
 	}
-	return oldValue.Price, nil
-}
-
-// ClearPrice clears the value of the "price" field.
-func (m *StrategyExecutionMutation) ClearPrice() {
-	m.price = nil
-	m.clearedFields[strategyexecution.FieldPrice] = struct{}{}
-}
-
-// PriceCleared returns if the "price" field was cleared in this mutation.
-func (m *StrategyExecutionMutation) PriceCleared() bool {
-	_, ok := m.clearedFields[strategyexecution.FieldPrice]
-	return ok
+	return oldValue.InputSchema, nil
 }
 
-// ResetPrice resets all changes to the "price" field.
-func (m *StrategyExecutionMutation) ResetPrice() {
-	m.price = nil
-	delete(m.clearedFields, strategyexecution.FieldPrice)
+// ResetInputSchema resets all changes to the "input_schema" field.
+func (m *StrategyTemplateMutation) ResetInputSchema() {
+	m.input_schema = nil
 }
 
-// SetQuantity sets the "quantity" field.
-func (m *StrategyExecutionMutation) SetQuantity(i int) {
-	m.quantity = &i
-	m.addquantity = nil
+// SetVersion sets the "version" field.
+func (m *StrategyTemplateMutation) SetVersion(s string) {
+	m.version = &s
 }
 
-// Quantity returns the value of the "quantity" field in the mutation.
-func (m *StrategyExecutionMutation) Quantity() (r int, exists bool) {
-	v := m.quantity
+// Version returns the value of the "version" field in the mutation.
+func (m *StrategyTemplateMutation) Version() (r string, exists bool) {
+	v := m.version
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldQuantity returns the old "quantity" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldVersion returns the old "version" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldQuantity(ctx context.Context) (v *int, err error) {
+func (m *StrategyTemplateMutation) OldVersion(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldQuantity requires an ID field in the mutation")
+		return v, errors.New("OldVersion requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
-	}
-	return oldValue.Quantity, nil
-}
-
-// AddQuantity adds i to the "quantity" field.
-func (m *StrategyExecutionMutation) AddQuantity(i int) {
-	if m.addquantity != nil {
-		*m.addquantity += i
-	} else {
-		m.addquantity = &i
-	}
-}
-
-// AddedQuantity returns the value that was added to the "quantity" field in this mutation.
-func (m *StrategyExecutionMutation) AddedQuantity() (r int, exists bool) {
-	v := m.addquantity
-	if v == nil {
-		return
+		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
 	}
-	return *v, true
-}
-
-// ClearQuantity clears the value of the "quantity" field.
-func (m *StrategyExecutionMutation) ClearQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
-	m.clearedFields[strategyexecution.FieldQuantity] = struct{}{}
-}
-
-// QuantityCleared returns if the "quantity" field was cleared in this mutation.
-func (m *StrategyExecutionMutation) QuantityCleared() bool {
-	_, ok := m.clearedFields[strategyexecution.FieldQuantity]
-	return ok
+	return oldValue.Version, nil
 }
 
-// ResetQuantity resets all changes to the "quantity" field.
-func (m *StrategyExecutionMutation) ResetQuantity() {
-	m.quantity = nil
-	m.addquantity = nil
-	delete(m.clearedFields, strategyexecution.FieldQuantity)
+// ResetVersion resets all changes to the "version" field.
+func (m *StrategyTemplateMutation) ResetVersion() {
+	m.version = nil
 }
 
-// SetReasoning sets the "reasoning" field.
-func (m *StrategyExecutionMutation) SetReasoning(s string) {
-	m.reasoning = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *StrategyTemplateMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Reasoning returns the value of the "reasoning" field in the mutation.
-func (m *StrategyExecutionMutation) Reasoning() (r string, exists bool) {
-	v := m.reasoning
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *StrategyTemplateMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReasoning returns the old "reasoning" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldReasoning(ctx context.Context) (v *string, err error) {
+func (m *StrategyTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReasoning is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReasoning requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReasoning: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Reasoning, nil
-}
-
-// ClearReasoning clears the value of the "reasoning" field.
-func (m *StrategyExecutionMutation) ClearReasoning() {
-	m.reasoning = nil
-	m.clearedFields[strategyexecution.FieldReasoning] = struct{}{}
-}
-
-// ReasoningCleared returns if the "reasoning" field was cleared in this mutation.
-func (m *StrategyExecutionMutation) ReasoningCleared() bool {
-	_, ok := m.clearedFields[strategyexecution.FieldReasoning]
-	return ok
+	return oldValue.CreatedAt, nil
 }
 
-// ResetReasoning resets all changes to the "reasoning" field.
-func (m *StrategyExecutionMutation) ResetReasoning() {
-	m.reasoning = nil
-	delete(m.clearedFields, strategyexecution.FieldReasoning)
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *StrategyTemplateMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetExecutedAt sets the "executed_at" field.
-func (m *StrategyExecutionMutation) SetExecutedAt(t time.Time) {
-	m.executed_at = &t
+// SetUpdatedAt sets the "updated_at" field.
+func (m *StrategyTemplateMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
 }
 
-// ExecutedAt returns the value of the "executed_at" field in the mutation.
-func (m *StrategyExecutionMutation) ExecutedAt() (r time.Time, exists bool) {
-	v := m.executed_at
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *StrategyTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldExecutedAt returns the old "executed_at" field's value of the StrategyExecution entity.
-// If the StrategyExecution object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the StrategyTemplate entity.
+// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyExecutionMutation) OldExecutedAt(ctx context.Context) (v *time.Time, err error) {
+func (m *StrategyTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
 	}
-	return oldValue.ExecutedAt, nil
+	return oldValue.UpdatedAt, nil
 }
 
-// ClearExecutedAt clears the value of the "executed_at" field.
-func (m *StrategyExecutionMutation) ClearExecutedAt() {
-	m.executed_at = nil
-	m.clearedFields[strategyexecution.FieldExecutedAt] = struct{}{}
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *StrategyTemplateMutation) ResetUpdatedAt() {
+	m.updated_at = nil
 }
 
-// ExecutedAtCleared returns if the "executed_at" field was cleared in this mutation.
-func (m *StrategyExecutionMutation) ExecutedAtCleared() bool {
-	_, ok := m.clearedFields[strategyexecution.FieldExecutedAt]
-	return ok
+// AddStrategyIDs adds the "strategies" edge to the Strategy entity by ids.
+func (m *StrategyTemplateMutation) AddStrategyIDs(ids ...uuid.UUID) {
+	if m.strategies == nil {
+		m.strategies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.strategies[ids[i]] = struct{}{}
+	}
 }
 
-// ResetExecutedAt resets all changes to the "executed_at" field.
-func (m *StrategyExecutionMutation) ResetExecutedAt() {
-	m.executed_at = nil
-	delete(m.clearedFields, strategyexecution.FieldExecutedAt)
+// ClearStrategies clears the "strategies" edge to the Strategy entity.
+func (m *StrategyTemplateMutation) ClearStrategies() {
+	m.clearedstrategies = true
 }
 
-// ClearStrategy clears the "strategy" edge to the Strategy entity.
-func (m *StrategyExecutionMutation) ClearStrategy() {
-	m.clearedstrategy = true
-	m.clearedFields[strategyexecution.FieldStrategyID] = struct{}{}
+// StrategiesCleared reports if the "strategies" edge to the Strategy entity was cleared.
+func (m *StrategyTemplateMutation) StrategiesCleared() bool {
+	return m.clearedstrategies
 }
 
-// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
-func (m *StrategyExecutionMutation) StrategyCleared() bool {
-	return m.StrategyIDCleared() || m.clearedstrategy
+// RemoveStrategyIDs removes the "strategies" edge to the Strategy entity by IDs.
+func (m *StrategyTemplateMutation) RemoveStrategyIDs(ids ...uuid.UUID) {
+	if m.removedstrategies == nil {
+		m.removedstrategies = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.strategies, ids[i])
+		m.removedstrategies[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedStrategies returns the removed IDs of the "strategies" edge to the Strategy entity.
+func (m *StrategyTemplateMutation) RemovedStrategiesIDs() (ids []uuid.UUID) {
+	for id := range m.removedstrategies {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// StrategyIDs returns the "strategy" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StrategyID instead. It exists only for internal usage by the builders.
-func (m *StrategyExecutionMutation) StrategyIDs() (ids []uuid.UUID) {
-	if id := m.strategy; id != nil {
-		ids = append(ids, *id)
+// StrategiesIDs returns the "strategies" edge IDs in the mutation.
+func (m *StrategyTemplateMutation) StrategiesIDs() (ids []uuid.UUID) {
+	for id := range m.strategies {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetStrategy resets all changes to the "strategy" edge.
-func (m *StrategyExecutionMutation) ResetStrategy() {
-	m.strategy = nil
-	m.clearedstrategy = false
+// ResetStrategies resets all changes to the "strategies" edge.
+func (m *StrategyTemplateMutation) ResetStrategies() {
+	m.strategies = nil
+	m.clearedstrategies = false
+	m.removedstrategies = nil
 }
 
-// Where appends a list predicates to the StrategyExecutionMutation builder.
-func (m *StrategyExecutionMutation) Where(ps ...predicate.StrategyExecution) {
+// Where appends a list predicates to the StrategyTemplateMutation builder.
+func (m *StrategyTemplateMutation) Where(ps ...predicate.StrategyTemplate) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyExecutionMutation builder. Using this method,
+// WhereP appends storage-level predicates to the StrategyTemplateMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StrategyExecution, len(ps))
+func (m *StrategyTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.StrategyTemplate, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2841,45 +18690,48 @@ func (m *StrategyExecutionMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyExecutionMutation) Op() Op {
+func (m *StrategyTemplateMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyExecutionMutation) SetOp(op Op) {
+func (m *StrategyTemplateMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (StrategyExecution).
-func (m *StrategyExecutionMutation) Type() string {
+// Type returns the node type of this mutation (StrategyTemplate).
+func (m *StrategyTemplateMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyExecutionMutation) Fields() []string {
-	fields := make([]string, 0, 7)
-	if m.strategy != nil {
-		fields = append(fields, strategyexecution.FieldStrategyID)
+func (m *StrategyTemplateMutation) Fields() []string {
+	fields := make([]string, 0, 8)
+	if m.name != nil {
+		fields = append(fields, strategytemplate.FieldName)
 	}
-	if m.symbol != nil {
-		fields = append(fields, strategyexecution.FieldSymbol)
+	if m.description != nil {
+		fields = append(fields, strategytemplate.FieldDescription)
 	}
-	if m.action != nil {
-		fields = append(fields, strategyexecution.FieldAction)
+	if m.category != nil {
+		fields = append(fields, strategytemplate.FieldCategory)
 	}
-	if m.price != nil {
-		fields = append(fields, strategyexecution.FieldPrice)
+	if m.template_config != nil {
+		fields = append(fields, strategytemplate.FieldTemplateConfig)
 	}
-	if m.quantity != nil {
-		fields = append(fields, strategyexecution.FieldQuantity)
+	if m.input_schema != nil {
+		fields = append(fields, strategytemplate.FieldInputSchema)
 	}
-	if m.reasoning != nil {
-		fields = append(fields, strategyexecution.FieldReasoning)
+	if m.version != nil {
+		fields = append(fields, strategytemplate.FieldVersion)
 	}
-	if m.executed_at != nil {
-		fields = append(fields, strategyexecution.FieldExecutedAt)
+	if m.created_at != nil {
+		fields = append(fields, strategytemplate.FieldCreatedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, strategytemplate.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -2887,22 +18739,24 @@ func (m *StrategyExecutionMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyExecutionMutation) Field(name string) (ent.Value, bool) {
+func (m *StrategyTemplateMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategyexecution.FieldStrategyID:
-		return m.StrategyID()
-	case strategyexecution.FieldSymbol:
-		return m.Symbol()
-	case strategyexecution.FieldAction:
-		return m.Action()
-	case strategyexecution.FieldPrice:
-		return m.Price()
-	case strategyexecution.FieldQuantity:
-		return m.Quantity()
-	case strategyexecution.FieldReasoning:
-		return m.Reasoning()
-	case strategyexecution.FieldExecutedAt:
-		return m.ExecutedAt()
+	case strategytemplate.FieldName:
+		return m.Name()
+	case strategytemplate.FieldDescription:
+		return m.Description()
+	case strategytemplate.FieldCategory:
+		return m.Category()
+	case strategytemplate.FieldTemplateConfig:
+		return m.TemplateConfig()
+	case strategytemplate.FieldInputSchema:
+		return m.InputSchema()
+	case strategytemplate.FieldVersion:
+		return m.Version()
+	case strategytemplate.FieldCreatedAt:
+		return m.CreatedAt()
+	case strategytemplate.FieldUpdatedAt:
+		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -2910,311 +18764,293 @@ func (m *StrategyExecutionMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyExecutionMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *StrategyTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategyexecution.FieldStrategyID:
-		return m.OldStrategyID(ctx)
-	case strategyexecution.FieldSymbol:
-		return m.OldSymbol(ctx)
-	case strategyexecution.FieldAction:
-		return m.OldAction(ctx)
-	case strategyexecution.FieldPrice:
-		return m.OldPrice(ctx)
-	case strategyexecution.FieldQuantity:
-		return m.OldQuantity(ctx)
-	case strategyexecution.FieldReasoning:
-		return m.OldReasoning(ctx)
-	case strategyexecution.FieldExecutedAt:
-		return m.OldExecutedAt(ctx)
+	case strategytemplate.FieldName:
+		return m.OldName(ctx)
+	case strategytemplate.FieldDescription:
+		return m.OldDescription(ctx)
+	case strategytemplate.FieldCategory:
+		return m.OldCategory(ctx)
+	case strategytemplate.FieldTemplateConfig:
+		return m.OldTemplateConfig(ctx)
+	case strategytemplate.FieldInputSchema:
+		return m.OldInputSchema(ctx)
+	case strategytemplate.FieldVersion:
+		return m.OldVersion(ctx)
+	case strategytemplate.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	case strategytemplate.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown StrategyExecution field %s", name)
+	return nil, fmt.Errorf("unknown StrategyTemplate field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyExecutionMutation) SetField(name string, value ent.Value) error {
+func (m *StrategyTemplateMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategyexecution.FieldStrategyID:
-		v, ok := value.(uuid.UUID)
+	case strategytemplate.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrategyID(v)
+		m.SetName(v)
 		return nil
-	case strategyexecution.FieldSymbol:
+	case strategytemplate.FieldDescription:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSymbol(v)
+		m.SetDescription(v)
 		return nil
-	case strategyexecution.FieldAction:
-		v, ok := value.(strategyexecution.Action)
+	case strategytemplate.FieldCategory:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAction(v)
+		m.SetCategory(v)
 		return nil
-	case strategyexecution.FieldPrice:
-		v, ok := value.(decimal.Decimal)
+	case strategytemplate.FieldTemplateConfig:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetPrice(v)
+		m.SetTemplateConfig(v)
 		return nil
-	case strategyexecution.FieldQuantity:
-		v, ok := value.(int)
+	case strategytemplate.FieldInputSchema:
+		v, ok := value.(map[string]interface{})
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetQuantity(v)
+		m.SetInputSchema(v)
 		return nil
-	case strategyexecution.FieldReasoning:
+	case strategytemplate.FieldVersion:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReasoning(v)
+		m.SetVersion(v)
 		return nil
-	case strategyexecution.FieldExecutedAt:
+	case strategytemplate.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetExecutedAt(v)
+		m.SetCreatedAt(v)
+		return nil
+	case strategytemplate.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution field %s", name)
+	return fmt.Errorf("unknown StrategyTemplate field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyExecutionMutation) AddedFields() []string {
-	var fields []string
-	if m.addquantity != nil {
-		fields = append(fields, strategyexecution.FieldQuantity)
-	}
-	return fields
+func (m *StrategyTemplateMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyExecutionMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case strategyexecution.FieldQuantity:
-		return m.AddedQuantity()
-	}
+func (m *StrategyTemplateMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyExecutionMutation) AddField(name string, value ent.Value) error {
+func (m *StrategyTemplateMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case strategyexecution.FieldQuantity:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddQuantity(v)
-		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution numeric field %s", name)
+	return fmt.Errorf("unknown StrategyTemplate numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyExecutionMutation) ClearedFields() []string {
+func (m *StrategyTemplateMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(strategyexecution.FieldStrategyID) {
-		fields = append(fields, strategyexecution.FieldStrategyID)
-	}
-	if m.FieldCleared(strategyexecution.FieldPrice) {
-		fields = append(fields, strategyexecution.FieldPrice)
-	}
-	if m.FieldCleared(strategyexecution.FieldQuantity) {
-		fields = append(fields, strategyexecution.FieldQuantity)
-	}
-	if m.FieldCleared(strategyexecution.FieldReasoning) {
-		fields = append(fields, strategyexecution.FieldReasoning)
-	}
-	if m.FieldCleared(strategyexecution.FieldExecutedAt) {
-		fields = append(fields, strategyexecution.FieldExecutedAt)
+	if m.FieldCleared(strategytemplate.FieldDescription) {
+		fields = append(fields, strategytemplate.FieldDescription)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyExecutionMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
-	return ok
-}
-
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *StrategyExecutionMutation) ClearField(name string) error {
-	switch name {
-	case strategyexecution.FieldStrategyID:
-		m.ClearStrategyID()
-		return nil
-	case strategyexecution.FieldPrice:
-		m.ClearPrice()
-		return nil
-	case strategyexecution.FieldQuantity:
-		m.ClearQuantity()
-		return nil
-	case strategyexecution.FieldReasoning:
-		m.ClearReasoning()
-		return nil
-	case strategyexecution.FieldExecutedAt:
-		m.ClearExecutedAt()
+func (m *StrategyTemplateMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *StrategyTemplateMutation) ClearField(name string) error {
+	switch name {
+	case strategytemplate.FieldDescription:
+		m.ClearDescription()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution nullable field %s", name)
+	return fmt.Errorf("unknown StrategyTemplate nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyExecutionMutation) ResetField(name string) error {
+func (m *StrategyTemplateMutation) ResetField(name string) error {
 	switch name {
-	case strategyexecution.FieldStrategyID:
-		m.ResetStrategyID()
+	case strategytemplate.FieldName:
+		m.ResetName()
 		return nil
-	case strategyexecution.FieldSymbol:
-		m.ResetSymbol()
+	case strategytemplate.FieldDescription:
+		m.ResetDescription()
 		return nil
-	case strategyexecution.FieldAction:
-		m.ResetAction()
+	case strategytemplate.FieldCategory:
+		m.ResetCategory()
 		return nil
-	case strategyexecution.FieldPrice:
-		m.ResetPrice()
+	case strategytemplate.FieldTemplateConfig:
+		m.ResetTemplateConfig()
 		return nil
-	case strategyexecution.FieldQuantity:
-		m.ResetQuantity()
+	case strategytemplate.FieldInputSchema:
+		m.ResetInputSchema()
 		return nil
-	case strategyexecution.FieldReasoning:
-		m.ResetReasoning()
+	case strategytemplate.FieldVersion:
+		m.ResetVersion()
 		return nil
-	case strategyexecution.FieldExecutedAt:
-		m.ResetExecutedAt()
+	case strategytemplate.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	case strategytemplate.FieldUpdatedAt:
+		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution field %s", name)
+	return fmt.Errorf("unknown StrategyTemplate field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyExecutionMutation) AddedEdges() []string {
+func (m *StrategyTemplateMutation) AddedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.strategy != nil {
-		edges = append(edges, strategyexecution.EdgeStrategy)
+	if m.strategies != nil {
+		edges = append(edges, strategytemplate.EdgeStrategies)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyExecutionMutation) AddedIDs(name string) []ent.Value {
+func (m *StrategyTemplateMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case strategyexecution.EdgeStrategy:
-		if id := m.strategy; id != nil {
-			return []ent.Value{*id}
+	case strategytemplate.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.strategies))
+		for id := range m.strategies {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyExecutionMutation) RemovedEdges() []string {
+func (m *StrategyTemplateMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 1)
+	if m.removedstrategies != nil {
+		edges = append(edges, strategytemplate.EdgeStrategies)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyExecutionMutation) RemovedIDs(name string) []ent.Value {
+func (m *StrategyTemplateMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case strategytemplate.EdgeStrategies:
+		ids := make([]ent.Value, 0, len(m.removedstrategies))
+		for id := range m.removedstrategies {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyExecutionMutation) ClearedEdges() []string {
+func (m *StrategyTemplateMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 1)
-	if m.clearedstrategy {
-		edges = append(edges, strategyexecution.EdgeStrategy)
+	if m.clearedstrategies {
+		edges = append(edges, strategytemplate.EdgeStrategies)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyExecutionMutation) EdgeCleared(name string) bool {
+func (m *StrategyTemplateMutation) EdgeCleared(name string) bool {
 	switch name {
-	case strategyexecution.EdgeStrategy:
-		return m.clearedstrategy
+	case strategytemplate.EdgeStrategies:
+		return m.clearedstrategies
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyExecutionMutation) ClearEdge(name string) error {
+func (m *StrategyTemplateMutation) ClearEdge(name string) error {
 	switch name {
-	case strategyexecution.EdgeStrategy:
-		m.ClearStrategy()
-		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution unique edge %s", name)
+	return fmt.Errorf("unknown StrategyTemplate unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyExecutionMutation) ResetEdge(name string) error {
+func (m *StrategyTemplateMutation) ResetEdge(name string) error {
 	switch name {
-	case strategyexecution.EdgeStrategy:
-		m.ResetStrategy()
+	case strategytemplate.EdgeStrategies:
+		m.ResetStrategies()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyExecution edge %s", name)
+	return fmt.Errorf("unknown StrategyTemplate edge %s", name)
 }
 
-// StrategyPerformanceMutation represents an operation that mutates the StrategyPerformance nodes in the graph.
-type StrategyPerformanceMutation struct {
+// SymbolMutation represents an operation that mutates the Symbol nodes in the graph.
+type SymbolMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *int
-	total_return    *decimal.Decimal
-	win_rate        *decimal.Decimal
-	profit_loss     *decimal.Decimal
-	trade_count     *int64
-	addtrade_count  *int64
-	last_trade_time *time.Time
-	max_drawdown    *decimal.Decimal
-	sharpe_ratio    *decimal.Decimal
-	created_at      *time.Time
-	updated_at      *time.Time
-	clearedFields   map[string]struct{}
-	strategy        *uuid.UUID
-	clearedstrategy bool
-	done            bool
-	oldValue        func(context.Context) (*StrategyPerformance, error)
-	predicates      []predicate.StrategyPerformance
-}
-
-var _ ent.Mutation = (*StrategyPerformanceMutation)(nil)
-
-// strategyperformanceOption allows management of the mutation configuration using functional options.
-type strategyperformanceOption func(*StrategyPerformanceMutation)
-
-// newStrategyPerformanceMutation creates new mutation for the StrategyPerformance entity.
-func newStrategyPerformanceMutation(c config, op Op, opts ...strategyperformanceOption) *StrategyPerformanceMutation {
-	m := &StrategyPerformanceMutation{
+	op            Op
+	typ           string
+	id            *uuid.UUID
+	ticker        *string
+	exchange      *symbol.Exchange
+	currency      *string
+	name          *string
+	security_type *symbol.SecurityType
+	lot_size      *int
+	addlot_size   *int
+	tick_size     *decimal.Decimal
+	tradable      *bool
+	created_at    *time.Time
+	updated_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*Symbol, error)
+	predicates    []predicate.Symbol
+}
+
+var _ ent.Mutation = (*SymbolMutation)(nil)
+
+// symbolOption allows management of the mutation configuration using functional options.
+type symbolOption func(*SymbolMutation)
+
+// newSymbolMutation creates new mutation for the Symbol entity.
+func newSymbolMutation(c config, op Op, opts ...symbolOption) *SymbolMutation {
+	m := &SymbolMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStrategyPerformance,
+		typ:           TypeSymbol,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -3223,20 +19059,20 @@ func newStrategyPerformanceMutation(c config, op Op, opts ...strategyperformance
 	return m
 }
 
-// withStrategyPerformanceID sets the ID field of the mutation.
-func withStrategyPerformanceID(id int) strategyperformanceOption {
-	return func(m *StrategyPerformanceMutation) {
+// withSymbolID sets the ID field of the mutation.
+func withSymbolID(id uuid.UUID) symbolOption {
+	return func(m *SymbolMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StrategyPerformance
+			value *Symbol
 		)
-		m.oldValue = func(ctx context.Context) (*StrategyPerformance, error) {
+		m.oldValue = func(ctx context.Context) (*Symbol, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StrategyPerformance.Get(ctx, id)
+					value, err = m.Client().Symbol.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -3245,10 +19081,10 @@ func withStrategyPerformanceID(id int) strategyperformanceOption {
 	}
 }
 
-// withStrategyPerformance sets the old StrategyPerformance of the mutation.
-func withStrategyPerformance(node *StrategyPerformance) strategyperformanceOption {
-	return func(m *StrategyPerformanceMutation) {
-		m.oldValue = func(context.Context) (*StrategyPerformance, error) {
+// withSymbol sets the old Symbol of the mutation.
+func withSymbol(node *Symbol) symbolOption {
+	return func(m *SymbolMutation) {
+		m.oldValue = func(context.Context) (*Symbol, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -3257,7 +19093,7 @@ func withStrategyPerformance(node *StrategyPerformance) strategyperformanceOptio
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyPerformanceMutation) Client() *Client {
+func (m SymbolMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -3265,7 +19101,7 @@ func (m StrategyPerformanceMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m StrategyPerformanceMutation) Tx() (*Tx, error) {
+func (m SymbolMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
@@ -3274,9 +19110,15 @@ func (m StrategyPerformanceMutation) Tx() (*Tx, error) {
 	return tx, nil
 }
 
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Symbol entities.
+func (m *SymbolMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *StrategyPerformanceMutation) ID() (id int, exists bool) {
+func (m *SymbolMutation) ID() (id uuid.UUID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -3287,349 +19129,336 @@ func (m *StrategyPerformanceMutation) ID() (id int, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *StrategyPerformanceMutation) IDs(ctx context.Context) ([]int, error) {
+func (m *SymbolMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []int{id}, nil
+			return []uuid.UUID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StrategyPerformance.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Symbol.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetStrategyID sets the "strategy_id" field.
-func (m *StrategyPerformanceMutation) SetStrategyID(u uuid.UUID) {
-	m.strategy = &u
+// SetTicker sets the "ticker" field.
+func (m *SymbolMutation) SetTicker(s string) {
+	m.ticker = &s
 }
 
-// StrategyID returns the value of the "strategy_id" field in the mutation.
-func (m *StrategyPerformanceMutation) StrategyID() (r uuid.UUID, exists bool) {
-	v := m.strategy
+// Ticker returns the value of the "ticker" field in the mutation.
+func (m *SymbolMutation) Ticker() (r string, exists bool) {
+	v := m.ticker
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrategyID returns the old "strategy_id" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldTicker returns the old "ticker" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldStrategyID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *SymbolMutation) OldTicker(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldTicker is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+		return v, errors.New("OldTicker requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldTicker: %w", err)
 	}
-	return oldValue.StrategyID, nil
+	return oldValue.Ticker, nil
 }
 
-// ResetStrategyID resets all changes to the "strategy_id" field.
-func (m *StrategyPerformanceMutation) ResetStrategyID() {
-	m.strategy = nil
+// ResetTicker resets all changes to the "ticker" field.
+func (m *SymbolMutation) ResetTicker() {
+	m.ticker = nil
 }
 
-// SetTotalReturn sets the "total_return" field.
-func (m *StrategyPerformanceMutation) SetTotalReturn(d decimal.Decimal) {
-	m.total_return = &d
+// SetExchange sets the "exchange" field.
+func (m *SymbolMutation) SetExchange(s symbol.Exchange) {
+	m.exchange = &s
 }
 
-// TotalReturn returns the value of the "total_return" field in the mutation.
-func (m *StrategyPerformanceMutation) TotalReturn() (r decimal.Decimal, exists bool) {
-	v := m.total_return
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *SymbolMutation) Exchange() (r symbol.Exchange, exists bool) {
+	v := m.exchange
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTotalReturn returns the old "total_return" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldExchange returns the old "exchange" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldTotalReturn(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *SymbolMutation) OldExchange(ctx context.Context) (v symbol.Exchange, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTotalReturn is only allowed on UpdateOne operations")
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTotalReturn requires an ID field in the mutation")
+		return v, errors.New("OldExchange requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTotalReturn: %w", err)
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
 	}
-	return oldValue.TotalReturn, nil
+	return oldValue.Exchange, nil
 }
 
-// ResetTotalReturn resets all changes to the "total_return" field.
-func (m *StrategyPerformanceMutation) ResetTotalReturn() {
-	m.total_return = nil
+// ResetExchange resets all changes to the "exchange" field.
+func (m *SymbolMutation) ResetExchange() {
+	m.exchange = nil
 }
 
-// SetWinRate sets the "win_rate" field.
-func (m *StrategyPerformanceMutation) SetWinRate(d decimal.Decimal) {
-	m.win_rate = &d
+// SetCurrency sets the "currency" field.
+func (m *SymbolMutation) SetCurrency(s string) {
+	m.currency = &s
 }
 
-// WinRate returns the value of the "win_rate" field in the mutation.
-func (m *StrategyPerformanceMutation) WinRate() (r decimal.Decimal, exists bool) {
-	v := m.win_rate
+// Currency returns the value of the "currency" field in the mutation.
+func (m *SymbolMutation) Currency() (r string, exists bool) {
+	v := m.currency
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldWinRate returns the old "win_rate" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldCurrency returns the old "currency" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldWinRate(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *SymbolMutation) OldCurrency(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldWinRate is only allowed on UpdateOne operations")
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldWinRate requires an ID field in the mutation")
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldWinRate: %w", err)
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
 	}
-	return oldValue.WinRate, nil
+	return oldValue.Currency, nil
 }
 
-// ResetWinRate resets all changes to the "win_rate" field.
-func (m *StrategyPerformanceMutation) ResetWinRate() {
-	m.win_rate = nil
+// ResetCurrency resets all changes to the "currency" field.
+func (m *SymbolMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-// SetProfitLoss sets the "profit_loss" field.
-func (m *StrategyPerformanceMutation) SetProfitLoss(d decimal.Decimal) {
-	m.profit_loss = &d
+// SetName sets the "name" field.
+func (m *SymbolMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ProfitLoss returns the value of the "profit_loss" field in the mutation.
-func (m *StrategyPerformanceMutation) ProfitLoss() (r decimal.Decimal, exists bool) {
-	v := m.profit_loss
+// Name returns the value of the "name" field in the mutation.
+func (m *SymbolMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProfitLoss returns the old "profit_loss" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldProfitLoss(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *SymbolMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProfitLoss is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProfitLoss requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProfitLoss: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ProfitLoss, nil
+	return oldValue.Name, nil
 }
 
-// ResetProfitLoss resets all changes to the "profit_loss" field.
-func (m *StrategyPerformanceMutation) ResetProfitLoss() {
-	m.profit_loss = nil
+// ResetName resets all changes to the "name" field.
+func (m *SymbolMutation) ResetName() {
+	m.name = nil
 }
 
-// SetTradeCount sets the "trade_count" field.
-func (m *StrategyPerformanceMutation) SetTradeCount(i int64) {
-	m.trade_count = &i
-	m.addtrade_count = nil
+// SetSecurityType sets the "security_type" field.
+func (m *SymbolMutation) SetSecurityType(st symbol.SecurityType) {
+	m.security_type = &st
 }
 
-// TradeCount returns the value of the "trade_count" field in the mutation.
-func (m *StrategyPerformanceMutation) TradeCount() (r int64, exists bool) {
-	v := m.trade_count
+// SecurityType returns the value of the "security_type" field in the mutation.
+func (m *SymbolMutation) SecurityType() (r symbol.SecurityType, exists bool) {
+	v := m.security_type
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTradeCount returns the old "trade_count" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldSecurityType returns the old "security_type" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldTradeCount(ctx context.Context) (v int64, err error) {
+func (m *SymbolMutation) OldSecurityType(ctx context.Context) (v symbol.SecurityType, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTradeCount is only allowed on UpdateOne operations")
+		return v, errors.New("OldSecurityType is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTradeCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTradeCount: %w", err)
-	}
-	return oldValue.TradeCount, nil
-}
-
-// AddTradeCount adds i to the "trade_count" field.
-func (m *StrategyPerformanceMutation) AddTradeCount(i int64) {
-	if m.addtrade_count != nil {
-		*m.addtrade_count += i
-	} else {
-		m.addtrade_count = &i
-	}
-}
-
-// AddedTradeCount returns the value that was added to the "trade_count" field in this mutation.
-func (m *StrategyPerformanceMutation) AddedTradeCount() (r int64, exists bool) {
-	v := m.addtrade_count
-	if v == nil {
-		return
+		return v, errors.New("OldSecurityType requires an ID field in the mutation")
 	}
-	return *v, true
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSecurityType: %w", err)
+	}
+	return oldValue.SecurityType, nil
 }
 
-// ResetTradeCount resets all changes to the "trade_count" field.
-func (m *StrategyPerformanceMutation) ResetTradeCount() {
-	m.trade_count = nil
-	m.addtrade_count = nil
+// ResetSecurityType resets all changes to the "security_type" field.
+func (m *SymbolMutation) ResetSecurityType() {
+	m.security_type = nil
 }
 
-// SetLastTradeTime sets the "last_trade_time" field.
-func (m *StrategyPerformanceMutation) SetLastTradeTime(t time.Time) {
-	m.last_trade_time = &t
+// SetLotSize sets the "lot_size" field.
+func (m *SymbolMutation) SetLotSize(i int) {
+	m.lot_size = &i
+	m.addlot_size = nil
 }
 
-// LastTradeTime returns the value of the "last_trade_time" field in the mutation.
-func (m *StrategyPerformanceMutation) LastTradeTime() (r time.Time, exists bool) {
-	v := m.last_trade_time
+// LotSize returns the value of the "lot_size" field in the mutation.
+func (m *SymbolMutation) LotSize() (r int, exists bool) {
+	v := m.lot_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldLastTradeTime returns the old "last_trade_time" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldLotSize returns the old "lot_size" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldLastTradeTime(ctx context.Context) (v *time.Time, err error) {
+func (m *SymbolMutation) OldLotSize(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastTradeTime is only allowed on UpdateOne operations")
+		return v, errors.New("OldLotSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastTradeTime requires an ID field in the mutation")
+		return v, errors.New("OldLotSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastTradeTime: %w", err)
+		return v, fmt.Errorf("querying old value for OldLotSize: %w", err)
 	}
-	return oldValue.LastTradeTime, nil
+	return oldValue.LotSize, nil
 }
 
-// ClearLastTradeTime clears the value of the "last_trade_time" field.
-func (m *StrategyPerformanceMutation) ClearLastTradeTime() {
-	m.last_trade_time = nil
-	m.clearedFields[strategyperformance.FieldLastTradeTime] = struct{}{}
+// AddLotSize adds i to the "lot_size" field.
+func (m *SymbolMutation) AddLotSize(i int) {
+	if m.addlot_size != nil {
+		*m.addlot_size += i
+	} else {
+		m.addlot_size = &i
+	}
 }
 
-// LastTradeTimeCleared returns if the "last_trade_time" field was cleared in this mutation.
-func (m *StrategyPerformanceMutation) LastTradeTimeCleared() bool {
-	_, ok := m.clearedFields[strategyperformance.FieldLastTradeTime]
-	return ok
+// AddedLotSize returns the value that was added to the "lot_size" field in this mutation.
+func (m *SymbolMutation) AddedLotSize() (r int, exists bool) {
+	v := m.addlot_size
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetLastTradeTime resets all changes to the "last_trade_time" field.
-func (m *StrategyPerformanceMutation) ResetLastTradeTime() {
-	m.last_trade_time = nil
-	delete(m.clearedFields, strategyperformance.FieldLastTradeTime)
+// ResetLotSize resets all changes to the "lot_size" field.
+func (m *SymbolMutation) ResetLotSize() {
+	m.lot_size = nil
+	m.addlot_size = nil
 }
 
-// SetMaxDrawdown sets the "max_drawdown" field.
-func (m *StrategyPerformanceMutation) SetMaxDrawdown(d decimal.Decimal) {
-	m.max_drawdown = &d
+// SetTickSize sets the "tick_size" field.
+func (m *SymbolMutation) SetTickSize(d decimal.Decimal) {
+	m.tick_size = &d
 }
 
-// MaxDrawdown returns the value of the "max_drawdown" field in the mutation.
-func (m *StrategyPerformanceMutation) MaxDrawdown() (r decimal.Decimal, exists bool) {
-	v := m.max_drawdown
+// TickSize returns the value of the "tick_size" field in the mutation.
+func (m *SymbolMutation) TickSize() (r decimal.Decimal, exists bool) {
+	v := m.tick_size
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldMaxDrawdown returns the old "max_drawdown" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldTickSize returns the old "tick_size" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldMaxDrawdown(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *SymbolMutation) OldTickSize(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldMaxDrawdown is only allowed on UpdateOne operations")
+		return v, errors.New("OldTickSize is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldMaxDrawdown requires an ID field in the mutation")
+		return v, errors.New("OldTickSize requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldMaxDrawdown: %w", err)
+		return v, fmt.Errorf("querying old value for OldTickSize: %w", err)
 	}
-	return oldValue.MaxDrawdown, nil
+	return oldValue.TickSize, nil
 }
 
-// ResetMaxDrawdown resets all changes to the "max_drawdown" field.
-func (m *StrategyPerformanceMutation) ResetMaxDrawdown() {
-	m.max_drawdown = nil
+// ResetTickSize resets all changes to the "tick_size" field.
+func (m *SymbolMutation) ResetTickSize() {
+	m.tick_size = nil
 }
 
-// SetSharpeRatio sets the "sharpe_ratio" field.
-func (m *StrategyPerformanceMutation) SetSharpeRatio(d decimal.Decimal) {
-	m.sharpe_ratio = &d
+// SetTradable sets the "tradable" field.
+func (m *SymbolMutation) SetTradable(b bool) {
+	m.tradable = &b
 }
 
-// SharpeRatio returns the value of the "sharpe_ratio" field in the mutation.
-func (m *StrategyPerformanceMutation) SharpeRatio() (r decimal.Decimal, exists bool) {
-	v := m.sharpe_ratio
+// Tradable returns the value of the "tradable" field in the mutation.
+func (m *SymbolMutation) Tradable() (r bool, exists bool) {
+	v := m.tradable
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldSharpeRatio returns the old "sharpe_ratio" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldTradable returns the old "tradable" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldSharpeRatio(ctx context.Context) (v decimal.Decimal, err error) {
+func (m *SymbolMutation) OldTradable(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldSharpeRatio is only allowed on UpdateOne operations")
+		return v, errors.New("OldTradable is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldSharpeRatio requires an ID field in the mutation")
+		return v, errors.New("OldTradable requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldSharpeRatio: %w", err)
+		return v, fmt.Errorf("querying old value for OldTradable: %w", err)
 	}
-	return oldValue.SharpeRatio, nil
+	return oldValue.Tradable, nil
 }
 
-// ResetSharpeRatio resets all changes to the "sharpe_ratio" field.
-func (m *StrategyPerformanceMutation) ResetSharpeRatio() {
-	m.sharpe_ratio = nil
+// ResetTradable resets all changes to the "tradable" field.
+func (m *SymbolMutation) ResetTradable() {
+	m.tradable = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *StrategyPerformanceMutation) SetCreatedAt(t time.Time) {
+func (m *SymbolMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StrategyPerformanceMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *SymbolMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -3637,10 +19466,10 @@ func (m *StrategyPerformanceMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SymbolMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -3655,17 +19484,17 @@ func (m *StrategyPerformanceMutation) OldCreatedAt(ctx context.Context) (v time.
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StrategyPerformanceMutation) ResetCreatedAt() {
+func (m *SymbolMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // SetUpdatedAt sets the "updated_at" field.
-func (m *StrategyPerformanceMutation) SetUpdatedAt(t time.Time) {
+func (m *SymbolMutation) SetUpdatedAt(t time.Time) {
 	m.updated_at = &t
 }
 
 // UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StrategyPerformanceMutation) UpdatedAt() (r time.Time, exists bool) {
+func (m *SymbolMutation) UpdatedAt() (r time.Time, exists bool) {
 	v := m.updated_at
 	if v == nil {
 		return
@@ -3673,10 +19502,10 @@ func (m *StrategyPerformanceMutation) UpdatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the StrategyPerformance entity.
-// If the StrategyPerformance object wasn't provided to the builder, the object is fetched from the database.
+// OldUpdatedAt returns the old "updated_at" field's value of the Symbol entity.
+// If the Symbol object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyPerformanceMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *SymbolMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
 	}
@@ -3691,46 +19520,19 @@ func (m *StrategyPerformanceMutation) OldUpdatedAt(ctx context.Context) (v time.
 }
 
 // ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StrategyPerformanceMutation) ResetUpdatedAt() {
+func (m *SymbolMutation) ResetUpdatedAt() {
 	m.updated_at = nil
 }
 
-// ClearStrategy clears the "strategy" edge to the Strategy entity.
-func (m *StrategyPerformanceMutation) ClearStrategy() {
-	m.clearedstrategy = true
-	m.clearedFields[strategyperformance.FieldStrategyID] = struct{}{}
-}
-
-// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
-func (m *StrategyPerformanceMutation) StrategyCleared() bool {
-	return m.clearedstrategy
-}
-
-// StrategyIDs returns the "strategy" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StrategyID instead. It exists only for internal usage by the builders.
-func (m *StrategyPerformanceMutation) StrategyIDs() (ids []uuid.UUID) {
-	if id := m.strategy; id != nil {
-		ids = append(ids, *id)
-	}
-	return
-}
-
-// ResetStrategy resets all changes to the "strategy" edge.
-func (m *StrategyPerformanceMutation) ResetStrategy() {
-	m.strategy = nil
-	m.clearedstrategy = false
-}
-
-// Where appends a list predicates to the StrategyPerformanceMutation builder.
-func (m *StrategyPerformanceMutation) Where(ps ...predicate.StrategyPerformance) {
+// Where appends a list predicates to the SymbolMutation builder.
+func (m *SymbolMutation) Where(ps ...predicate.Symbol) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyPerformanceMutation builder. Using this method,
+// WhereP appends storage-level predicates to the SymbolMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyPerformanceMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StrategyPerformance, len(ps))
+func (m *SymbolMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Symbol, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3738,54 +19540,54 @@ func (m *StrategyPerformanceMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyPerformanceMutation) Op() Op {
+func (m *SymbolMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyPerformanceMutation) SetOp(op Op) {
+func (m *SymbolMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (StrategyPerformance).
-func (m *StrategyPerformanceMutation) Type() string {
+// Type returns the node type of this mutation (Symbol).
+func (m *SymbolMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyPerformanceMutation) Fields() []string {
+func (m *SymbolMutation) Fields() []string {
 	fields := make([]string, 0, 10)
-	if m.strategy != nil {
-		fields = append(fields, strategyperformance.FieldStrategyID)
+	if m.ticker != nil {
+		fields = append(fields, symbol.FieldTicker)
 	}
-	if m.total_return != nil {
-		fields = append(fields, strategyperformance.FieldTotalReturn)
+	if m.exchange != nil {
+		fields = append(fields, symbol.FieldExchange)
 	}
-	if m.win_rate != nil {
-		fields = append(fields, strategyperformance.FieldWinRate)
+	if m.currency != nil {
+		fields = append(fields, symbol.FieldCurrency)
 	}
-	if m.profit_loss != nil {
-		fields = append(fields, strategyperformance.FieldProfitLoss)
+	if m.name != nil {
+		fields = append(fields, symbol.FieldName)
 	}
-	if m.trade_count != nil {
-		fields = append(fields, strategyperformance.FieldTradeCount)
+	if m.security_type != nil {
+		fields = append(fields, symbol.FieldSecurityType)
 	}
-	if m.last_trade_time != nil {
-		fields = append(fields, strategyperformance.FieldLastTradeTime)
+	if m.lot_size != nil {
+		fields = append(fields, symbol.FieldLotSize)
 	}
-	if m.max_drawdown != nil {
-		fields = append(fields, strategyperformance.FieldMaxDrawdown)
+	if m.tick_size != nil {
+		fields = append(fields, symbol.FieldTickSize)
 	}
-	if m.sharpe_ratio != nil {
-		fields = append(fields, strategyperformance.FieldSharpeRatio)
+	if m.tradable != nil {
+		fields = append(fields, symbol.FieldTradable)
 	}
 	if m.created_at != nil {
-		fields = append(fields, strategyperformance.FieldCreatedAt)
+		fields = append(fields, symbol.FieldCreatedAt)
 	}
 	if m.updated_at != nil {
-		fields = append(fields, strategyperformance.FieldUpdatedAt)
+		fields = append(fields, symbol.FieldUpdatedAt)
 	}
 	return fields
 }
@@ -3793,27 +19595,27 @@ func (m *StrategyPerformanceMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyPerformanceMutation) Field(name string) (ent.Value, bool) {
+func (m *SymbolMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategyperformance.FieldStrategyID:
-		return m.StrategyID()
-	case strategyperformance.FieldTotalReturn:
-		return m.TotalReturn()
-	case strategyperformance.FieldWinRate:
-		return m.WinRate()
-	case strategyperformance.FieldProfitLoss:
-		return m.ProfitLoss()
-	case strategyperformance.FieldTradeCount:
-		return m.TradeCount()
-	case strategyperformance.FieldLastTradeTime:
-		return m.LastTradeTime()
-	case strategyperformance.FieldMaxDrawdown:
-		return m.MaxDrawdown()
-	case strategyperformance.FieldSharpeRatio:
-		return m.SharpeRatio()
-	case strategyperformance.FieldCreatedAt:
+	case symbol.FieldTicker:
+		return m.Ticker()
+	case symbol.FieldExchange:
+		return m.Exchange()
+	case symbol.FieldCurrency:
+		return m.Currency()
+	case symbol.FieldName:
+		return m.Name()
+	case symbol.FieldSecurityType:
+		return m.SecurityType()
+	case symbol.FieldLotSize:
+		return m.LotSize()
+	case symbol.FieldTickSize:
+		return m.TickSize()
+	case symbol.FieldTradable:
+		return m.Tradable()
+	case symbol.FieldCreatedAt:
 		return m.CreatedAt()
-	case strategyperformance.FieldUpdatedAt:
+	case symbol.FieldUpdatedAt:
 		return m.UpdatedAt()
 	}
 	return nil, false
@@ -3822,101 +19624,101 @@ func (m *StrategyPerformanceMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyPerformanceMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *SymbolMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategyperformance.FieldStrategyID:
-		return m.OldStrategyID(ctx)
-	case strategyperformance.FieldTotalReturn:
-		return m.OldTotalReturn(ctx)
-	case strategyperformance.FieldWinRate:
-		return m.OldWinRate(ctx)
-	case strategyperformance.FieldProfitLoss:
-		return m.OldProfitLoss(ctx)
-	case strategyperformance.FieldTradeCount:
-		return m.OldTradeCount(ctx)
-	case strategyperformance.FieldLastTradeTime:
-		return m.OldLastTradeTime(ctx)
-	case strategyperformance.FieldMaxDrawdown:
-		return m.OldMaxDrawdown(ctx)
-	case strategyperformance.FieldSharpeRatio:
-		return m.OldSharpeRatio(ctx)
-	case strategyperformance.FieldCreatedAt:
+	case symbol.FieldTicker:
+		return m.OldTicker(ctx)
+	case symbol.FieldExchange:
+		return m.OldExchange(ctx)
+	case symbol.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case symbol.FieldName:
+		return m.OldName(ctx)
+	case symbol.FieldSecurityType:
+		return m.OldSecurityType(ctx)
+	case symbol.FieldLotSize:
+		return m.OldLotSize(ctx)
+	case symbol.FieldTickSize:
+		return m.OldTickSize(ctx)
+	case symbol.FieldTradable:
+		return m.OldTradable(ctx)
+	case symbol.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case strategyperformance.FieldUpdatedAt:
+	case symbol.FieldUpdatedAt:
 		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown StrategyPerformance field %s", name)
+	return nil, fmt.Errorf("unknown Symbol field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyPerformanceMutation) SetField(name string, value ent.Value) error {
+func (m *SymbolMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategyperformance.FieldStrategyID:
-		v, ok := value.(uuid.UUID)
+	case symbol.FieldTicker:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetStrategyID(v)
+		m.SetTicker(v)
 		return nil
-	case strategyperformance.FieldTotalReturn:
-		v, ok := value.(decimal.Decimal)
+	case symbol.FieldExchange:
+		v, ok := value.(symbol.Exchange)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTotalReturn(v)
+		m.SetExchange(v)
 		return nil
-	case strategyperformance.FieldWinRate:
-		v, ok := value.(decimal.Decimal)
+	case symbol.FieldCurrency:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetWinRate(v)
+		m.SetCurrency(v)
 		return nil
-	case strategyperformance.FieldProfitLoss:
-		v, ok := value.(decimal.Decimal)
+	case symbol.FieldName:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetProfitLoss(v)
+		m.SetName(v)
 		return nil
-	case strategyperformance.FieldTradeCount:
-		v, ok := value.(int64)
+	case symbol.FieldSecurityType:
+		v, ok := value.(symbol.SecurityType)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTradeCount(v)
+		m.SetSecurityType(v)
 		return nil
-	case strategyperformance.FieldLastTradeTime:
-		v, ok := value.(time.Time)
+	case symbol.FieldLotSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetLastTradeTime(v)
+		m.SetLotSize(v)
 		return nil
-	case strategyperformance.FieldMaxDrawdown:
+	case symbol.FieldTickSize:
 		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetMaxDrawdown(v)
+		m.SetTickSize(v)
 		return nil
-	case strategyperformance.FieldSharpeRatio:
-		v, ok := value.(decimal.Decimal)
+	case symbol.FieldTradable:
+		v, ok := value.(bool)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetSharpeRatio(v)
+		m.SetTradable(v)
 		return nil
-	case strategyperformance.FieldCreatedAt:
+	case symbol.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetCreatedAt(v)
 		return nil
-	case strategyperformance.FieldUpdatedAt:
+	case symbol.FieldUpdatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3924,15 +19726,15 @@ func (m *StrategyPerformanceMutation) SetField(name string, value ent.Value) err
 		m.SetUpdatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyPerformance field %s", name)
+	return fmt.Errorf("unknown Symbol field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyPerformanceMutation) AddedFields() []string {
+func (m *SymbolMutation) AddedFields() []string {
 	var fields []string
-	if m.addtrade_count != nil {
-		fields = append(fields, strategyperformance.FieldTradeCount)
+	if m.addlot_size != nil {
+		fields = append(fields, symbol.FieldLotSize)
 	}
 	return fields
 }
@@ -3940,10 +19742,10 @@ func (m *StrategyPerformanceMutation) AddedFields() []string {
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyPerformanceMutation) AddedField(name string) (ent.Value, bool) {
+func (m *SymbolMutation) AddedField(name string) (ent.Value, bool) {
 	switch name {
-	case strategyperformance.FieldTradeCount:
-		return m.AddedTradeCount()
+	case symbol.FieldLotSize:
+		return m.AddedLotSize()
 	}
 	return nil, false
 }
@@ -3951,193 +19753,157 @@ func (m *StrategyPerformanceMutation) AddedField(name string) (ent.Value, bool)
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyPerformanceMutation) AddField(name string, value ent.Value) error {
+func (m *SymbolMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case strategyperformance.FieldTradeCount:
-		v, ok := value.(int64)
+	case symbol.FieldLotSize:
+		v, ok := value.(int)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.AddTradeCount(v)
+		m.AddLotSize(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyPerformance numeric field %s", name)
+	return fmt.Errorf("unknown Symbol numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyPerformanceMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(strategyperformance.FieldLastTradeTime) {
-		fields = append(fields, strategyperformance.FieldLastTradeTime)
-	}
-	return fields
+func (m *SymbolMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyPerformanceMutation) FieldCleared(name string) bool {
+func (m *SymbolMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyPerformanceMutation) ClearField(name string) error {
-	switch name {
-	case strategyperformance.FieldLastTradeTime:
-		m.ClearLastTradeTime()
-		return nil
-	}
-	return fmt.Errorf("unknown StrategyPerformance nullable field %s", name)
+func (m *SymbolMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Symbol nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyPerformanceMutation) ResetField(name string) error {
+func (m *SymbolMutation) ResetField(name string) error {
 	switch name {
-	case strategyperformance.FieldStrategyID:
-		m.ResetStrategyID()
+	case symbol.FieldTicker:
+		m.ResetTicker()
 		return nil
-	case strategyperformance.FieldTotalReturn:
-		m.ResetTotalReturn()
+	case symbol.FieldExchange:
+		m.ResetExchange()
 		return nil
-	case strategyperformance.FieldWinRate:
-		m.ResetWinRate()
+	case symbol.FieldCurrency:
+		m.ResetCurrency()
 		return nil
-	case strategyperformance.FieldProfitLoss:
-		m.ResetProfitLoss()
+	case symbol.FieldName:
+		m.ResetName()
 		return nil
-	case strategyperformance.FieldTradeCount:
-		m.ResetTradeCount()
+	case symbol.FieldSecurityType:
+		m.ResetSecurityType()
 		return nil
-	case strategyperformance.FieldLastTradeTime:
-		m.ResetLastTradeTime()
+	case symbol.FieldLotSize:
+		m.ResetLotSize()
 		return nil
-	case strategyperformance.FieldMaxDrawdown:
-		m.ResetMaxDrawdown()
+	case symbol.FieldTickSize:
+		m.ResetTickSize()
 		return nil
-	case strategyperformance.FieldSharpeRatio:
-		m.ResetSharpeRatio()
+	case symbol.FieldTradable:
+		m.ResetTradable()
 		return nil
-	case strategyperformance.FieldCreatedAt:
+	case symbol.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
-	case strategyperformance.FieldUpdatedAt:
+	case symbol.FieldUpdatedAt:
 		m.ResetUpdatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyPerformance field %s", name)
+	return fmt.Errorf("unknown Symbol field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyPerformanceMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.strategy != nil {
-		edges = append(edges, strategyperformance.EdgeStrategy)
-	}
+func (m *SymbolMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyPerformanceMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case strategyperformance.EdgeStrategy:
-		if id := m.strategy; id != nil {
-			return []ent.Value{*id}
-		}
-	}
+func (m *SymbolMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyPerformanceMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
+func (m *SymbolMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyPerformanceMutation) RemovedIDs(name string) []ent.Value {
+func (m *SymbolMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyPerformanceMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedstrategy {
-		edges = append(edges, strategyperformance.EdgeStrategy)
-	}
+func (m *SymbolMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyPerformanceMutation) EdgeCleared(name string) bool {
-	switch name {
-	case strategyperformance.EdgeStrategy:
-		return m.clearedstrategy
-	}
+func (m *SymbolMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyPerformanceMutation) ClearEdge(name string) error {
-	switch name {
-	case strategyperformance.EdgeStrategy:
-		m.ClearStrategy()
-		return nil
-	}
-	return fmt.Errorf("unknown StrategyPerformance unique edge %s", name)
+func (m *SymbolMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Symbol unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyPerformanceMutation) ResetEdge(name string) error {
-	switch name {
-	case strategyperformance.EdgeStrategy:
-		m.ResetStrategy()
-		return nil
-	}
-	return fmt.Errorf("unknown StrategyPerformance edge %s", name)
+func (m *SymbolMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Symbol edge %s", name)
 }
 
-// StrategyStatusMutation represents an operation that mutates the StrategyStatus nodes in the graph.
-type StrategyStatusMutation struct {
+// TaxLotMutation represents an operation that mutates the TaxLot nodes in the graph.
+type TaxLotMutation struct {
 	config
 	op                 Op
 	typ                string
-	id                 *int
-	status             *strategystatus.Status
-	last_execution     *time.Time
-	execution_count    *int64
-	addexecution_count *int64
-	error_message      *string
-	uptime_seconds     *int64
-	adduptime_seconds  *int64
-	created_at         *time.Time
+	id                 *uuid.UUID
+	user_id            *uuid.UUID
+	trade_id           *uuid.UUID
+	symbol             *string
+	quantity           *decimal.Decimal
+	remaining_quantity *decimal.Decimal
+	cost_per_share     *decimal.Decimal
+	fx_rate            *decimal.Decimal
+	opened_at          *time.Time
+	closed_at          *time.Time
 	updated_at         *time.Time
 	clearedFields      map[string]struct{}
-	strategy           *uuid.UUID
-	clearedstrategy    bool
 	done               bool
-	oldValue           func(context.Context) (*StrategyStatus, error)
-	predicates         []predicate.StrategyStatus
+	oldValue           func(context.Context) (*TaxLot, error)
+	predicates         []predicate.TaxLot
 }
 
-var _ ent.Mutation = (*StrategyStatusMutation)(nil)
+var _ ent.Mutation = (*TaxLotMutation)(nil)
 
-// strategystatusOption allows management of the mutation configuration using functional options.
-type strategystatusOption func(*StrategyStatusMutation)
+// taxlotOption allows management of the mutation configuration using functional options.
+type taxlotOption func(*TaxLotMutation)
 
-// newStrategyStatusMutation creates new mutation for the StrategyStatus entity.
-func newStrategyStatusMutation(c config, op Op, opts ...strategystatusOption) *StrategyStatusMutation {
-	m := &StrategyStatusMutation{
+// newTaxLotMutation creates new mutation for the TaxLot entity.
+func newTaxLotMutation(c config, op Op, opts ...taxlotOption) *TaxLotMutation {
+	m := &TaxLotMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeStrategyStatus,
+		typ:           TypeTaxLot,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -4146,20 +19912,20 @@ func newStrategyStatusMutation(c config, op Op, opts ...strategystatusOption) *S
 	return m
 }
 
-// withStrategyStatusID sets the ID field of the mutation.
-func withStrategyStatusID(id int) strategystatusOption {
-	return func(m *StrategyStatusMutation) {
+// withTaxLotID sets the ID field of the mutation.
+func withTaxLotID(id uuid.UUID) taxlotOption {
+	return func(m *TaxLotMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *StrategyStatus
+			value *TaxLot
 		)
-		m.oldValue = func(ctx context.Context) (*StrategyStatus, error) {
+		m.oldValue = func(ctx context.Context) (*TaxLot, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().StrategyStatus.Get(ctx, id)
+					value, err = m.Client().TaxLot.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -4168,1301 +19934,1822 @@ func withStrategyStatusID(id int) strategystatusOption {
 	}
 }
 
-// withStrategyStatus sets the old StrategyStatus of the mutation.
-func withStrategyStatus(node *StrategyStatus) strategystatusOption {
-	return func(m *StrategyStatusMutation) {
-		m.oldValue = func(context.Context) (*StrategyStatus, error) {
-			return node, nil
-		}
-		m.id = &node.ID
+// withTaxLot sets the old TaxLot of the mutation.
+func withTaxLot(node *TaxLot) taxlotOption {
+	return func(m *TaxLotMutation) {
+		m.oldValue = func(context.Context) (*TaxLot, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TaxLotMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TaxLotMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of TaxLot entities.
+func (m *TaxLotMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TaxLotMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TaxLotMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().TaxLot.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetUserID sets the "user_id" field.
+func (m *TaxLotMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
+}
+
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TaxLotMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldUserID returns the old "user_id" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUserID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
+	}
+	return oldValue.UserID, nil
+}
+
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TaxLotMutation) ResetUserID() {
+	m.user_id = nil
+}
+
+// SetTradeID sets the "trade_id" field.
+func (m *TaxLotMutation) SetTradeID(u uuid.UUID) {
+	m.trade_id = &u
+}
+
+// TradeID returns the value of the "trade_id" field in the mutation.
+func (m *TaxLotMutation) TradeID() (r uuid.UUID, exists bool) {
+	v := m.trade_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldTradeID returns the old "trade_id" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldTradeID(ctx context.Context) (v uuid.UUID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldTradeID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldTradeID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldTradeID: %w", err)
+	}
+	return oldValue.TradeID, nil
+}
+
+// ResetTradeID resets all changes to the "trade_id" field.
+func (m *TaxLotMutation) ResetTradeID() {
+	m.trade_id = nil
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *TaxLotMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *TaxLotMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldSymbol returns the old "symbol" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
+}
+
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *TaxLotMutation) ResetSymbol() {
+	m.symbol = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *TaxLotMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *TaxLotMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldQuantity returns the old "quantity" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
+}
+
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *TaxLotMutation) ResetQuantity() {
+	m.quantity = nil
+}
+
+// SetRemainingQuantity sets the "remaining_quantity" field.
+func (m *TaxLotMutation) SetRemainingQuantity(d decimal.Decimal) {
+	m.remaining_quantity = &d
+}
+
+// RemainingQuantity returns the value of the "remaining_quantity" field in the mutation.
+func (m *TaxLotMutation) RemainingQuantity() (r decimal.Decimal, exists bool) {
+	v := m.remaining_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldRemainingQuantity returns the old "remaining_quantity" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldRemainingQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRemainingQuantity is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRemainingQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRemainingQuantity: %w", err)
+	}
+	return oldValue.RemainingQuantity, nil
+}
+
+// ResetRemainingQuantity resets all changes to the "remaining_quantity" field.
+func (m *TaxLotMutation) ResetRemainingQuantity() {
+	m.remaining_quantity = nil
+}
+
+// SetCostPerShare sets the "cost_per_share" field.
+func (m *TaxLotMutation) SetCostPerShare(d decimal.Decimal) {
+	m.cost_per_share = &d
+}
+
+// CostPerShare returns the value of the "cost_per_share" field in the mutation.
+func (m *TaxLotMutation) CostPerShare() (r decimal.Decimal, exists bool) {
+	v := m.cost_per_share
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCostPerShare returns the old "cost_per_share" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldCostPerShare(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostPerShare is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostPerShare requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostPerShare: %w", err)
+	}
+	return oldValue.CostPerShare, nil
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyStatusMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// ResetCostPerShare resets all changes to the "cost_per_share" field.
+func (m *TaxLotMutation) ResetCostPerShare() {
+	m.cost_per_share = nil
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StrategyStatusMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
-	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+// SetFxRate sets the "fx_rate" field.
+func (m *TaxLotMutation) SetFxRate(d decimal.Decimal) {
+	m.fx_rate = &d
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StrategyStatusMutation) ID() (id int, exists bool) {
-	if m.id == nil {
+// FxRate returns the value of the "fx_rate" field in the mutation.
+func (m *TaxLotMutation) FxRate() (r decimal.Decimal, exists bool) {
+	v := m.fx_rate
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StrategyStatusMutation) IDs(ctx context.Context) ([]int, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []int{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StrategyStatus.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldFxRate returns the old "fx_rate" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldFxRate(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxRate is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxRate requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxRate: %w", err)
 	}
+	return oldValue.FxRate, nil
 }
 
-// SetStrategyID sets the "strategy_id" field.
-func (m *StrategyStatusMutation) SetStrategyID(u uuid.UUID) {
-	m.strategy = &u
+// ResetFxRate resets all changes to the "fx_rate" field.
+func (m *TaxLotMutation) ResetFxRate() {
+	m.fx_rate = nil
 }
 
-// StrategyID returns the value of the "strategy_id" field in the mutation.
-func (m *StrategyStatusMutation) StrategyID() (r uuid.UUID, exists bool) {
-	v := m.strategy
+// SetOpenedAt sets the "opened_at" field.
+func (m *TaxLotMutation) SetOpenedAt(t time.Time) {
+	m.opened_at = &t
+}
+
+// OpenedAt returns the value of the "opened_at" field in the mutation.
+func (m *TaxLotMutation) OpenedAt() (r time.Time, exists bool) {
+	v := m.opened_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStrategyID returns the old "strategy_id" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldOpenedAt returns the old "opened_at" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldStrategyID(ctx context.Context) (v uuid.UUID, err error) {
+func (m *TaxLotMutation) OldOpenedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStrategyID is only allowed on UpdateOne operations")
+		return v, errors.New("OldOpenedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStrategyID requires an ID field in the mutation")
+		return v, errors.New("OldOpenedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStrategyID: %w", err)
+		return v, fmt.Errorf("querying old value for OldOpenedAt: %w", err)
 	}
-	return oldValue.StrategyID, nil
+	return oldValue.OpenedAt, nil
 }
 
-// ResetStrategyID resets all changes to the "strategy_id" field.
-func (m *StrategyStatusMutation) ResetStrategyID() {
-	m.strategy = nil
+// ResetOpenedAt resets all changes to the "opened_at" field.
+func (m *TaxLotMutation) ResetOpenedAt() {
+	m.opened_at = nil
 }
 
-// SetStatus sets the "status" field.
-func (m *StrategyStatusMutation) SetStatus(s strategystatus.Status) {
-	m.status = &s
+// SetClosedAt sets the "closed_at" field.
+func (m *TaxLotMutation) SetClosedAt(t time.Time) {
+	m.closed_at = &t
 }
 
-// Status returns the value of the "status" field in the mutation.
-func (m *StrategyStatusMutation) Status() (r strategystatus.Status, exists bool) {
-	v := m.status
+// ClosedAt returns the value of the "closed_at" field in the mutation.
+func (m *TaxLotMutation) ClosedAt() (r time.Time, exists bool) {
+	v := m.closed_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldStatus returns the old "status" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldClosedAt returns the old "closed_at" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldStatus(ctx context.Context) (v strategystatus.Status, err error) {
+func (m *TaxLotMutation) OldClosedAt(ctx context.Context) (v *time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldStatus is only allowed on UpdateOne operations")
+		return v, errors.New("OldClosedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldStatus requires an ID field in the mutation")
+		return v, errors.New("OldClosedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldStatus: %w", err)
+		return v, fmt.Errorf("querying old value for OldClosedAt: %w", err)
 	}
-	return oldValue.Status, nil
+	return oldValue.ClosedAt, nil
 }
 
-// ResetStatus resets all changes to the "status" field.
-func (m *StrategyStatusMutation) ResetStatus() {
-	m.status = nil
+// ClearClosedAt clears the value of the "closed_at" field.
+func (m *TaxLotMutation) ClearClosedAt() {
+	m.closed_at = nil
+	m.clearedFields[taxlot.FieldClosedAt] = struct{}{}
 }
 
-// SetLastExecution sets the "last_execution" field.
-func (m *StrategyStatusMutation) SetLastExecution(t time.Time) {
-	m.last_execution = &t
+// ClosedAtCleared returns if the "closed_at" field was cleared in this mutation.
+func (m *TaxLotMutation) ClosedAtCleared() bool {
+	_, ok := m.clearedFields[taxlot.FieldClosedAt]
+	return ok
 }
 
-// LastExecution returns the value of the "last_execution" field in the mutation.
-func (m *StrategyStatusMutation) LastExecution() (r time.Time, exists bool) {
-	v := m.last_execution
+// ResetClosedAt resets all changes to the "closed_at" field.
+func (m *TaxLotMutation) ResetClosedAt() {
+	m.closed_at = nil
+	delete(m.clearedFields, taxlot.FieldClosedAt)
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (m *TaxLotMutation) SetUpdatedAt(t time.Time) {
+	m.updated_at = &t
+}
+
+// UpdatedAt returns the value of the "updated_at" field in the mutation.
+func (m *TaxLotMutation) UpdatedAt() (r time.Time, exists bool) {
+	v := m.updated_at
 	if v == nil {
 		return
 	}
-	return *v, true
+	return *v, true
+}
+
+// OldUpdatedAt returns the old "updated_at" field's value of the TaxLot entity.
+// If the TaxLot object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TaxLotMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+	}
+	return oldValue.UpdatedAt, nil
+}
+
+// ResetUpdatedAt resets all changes to the "updated_at" field.
+func (m *TaxLotMutation) ResetUpdatedAt() {
+	m.updated_at = nil
+}
+
+// Where appends a list predicates to the TaxLotMutation builder.
+func (m *TaxLotMutation) Where(ps ...predicate.TaxLot) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the TaxLotMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *TaxLotMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.TaxLot, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *TaxLotMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *TaxLotMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (TaxLot).
+func (m *TaxLotMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *TaxLotMutation) Fields() []string {
+	fields := make([]string, 0, 10)
+	if m.user_id != nil {
+		fields = append(fields, taxlot.FieldUserID)
+	}
+	if m.trade_id != nil {
+		fields = append(fields, taxlot.FieldTradeID)
+	}
+	if m.symbol != nil {
+		fields = append(fields, taxlot.FieldSymbol)
+	}
+	if m.quantity != nil {
+		fields = append(fields, taxlot.FieldQuantity)
+	}
+	if m.remaining_quantity != nil {
+		fields = append(fields, taxlot.FieldRemainingQuantity)
+	}
+	if m.cost_per_share != nil {
+		fields = append(fields, taxlot.FieldCostPerShare)
+	}
+	if m.fx_rate != nil {
+		fields = append(fields, taxlot.FieldFxRate)
+	}
+	if m.opened_at != nil {
+		fields = append(fields, taxlot.FieldOpenedAt)
+	}
+	if m.closed_at != nil {
+		fields = append(fields, taxlot.FieldClosedAt)
+	}
+	if m.updated_at != nil {
+		fields = append(fields, taxlot.FieldUpdatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *TaxLotMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case taxlot.FieldUserID:
+		return m.UserID()
+	case taxlot.FieldTradeID:
+		return m.TradeID()
+	case taxlot.FieldSymbol:
+		return m.Symbol()
+	case taxlot.FieldQuantity:
+		return m.Quantity()
+	case taxlot.FieldRemainingQuantity:
+		return m.RemainingQuantity()
+	case taxlot.FieldCostPerShare:
+		return m.CostPerShare()
+	case taxlot.FieldFxRate:
+		return m.FxRate()
+	case taxlot.FieldOpenedAt:
+		return m.OpenedAt()
+	case taxlot.FieldClosedAt:
+		return m.ClosedAt()
+	case taxlot.FieldUpdatedAt:
+		return m.UpdatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *TaxLotMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case taxlot.FieldUserID:
+		return m.OldUserID(ctx)
+	case taxlot.FieldTradeID:
+		return m.OldTradeID(ctx)
+	case taxlot.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case taxlot.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case taxlot.FieldRemainingQuantity:
+		return m.OldRemainingQuantity(ctx)
+	case taxlot.FieldCostPerShare:
+		return m.OldCostPerShare(ctx)
+	case taxlot.FieldFxRate:
+		return m.OldFxRate(ctx)
+	case taxlot.FieldOpenedAt:
+		return m.OldOpenedAt(ctx)
+	case taxlot.FieldClosedAt:
+		return m.OldClosedAt(ctx)
+	case taxlot.FieldUpdatedAt:
+		return m.OldUpdatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown TaxLot field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaxLotMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case taxlot.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case taxlot.FieldTradeID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetTradeID(v)
+		return nil
+	case taxlot.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case taxlot.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetQuantity(v)
+		return nil
+	case taxlot.FieldRemainingQuantity:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRemainingQuantity(v)
+		return nil
+	case taxlot.FieldCostPerShare:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostPerShare(v)
+		return nil
+	case taxlot.FieldFxRate:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxRate(v)
+		return nil
+	case taxlot.FieldOpenedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetOpenedAt(v)
+		return nil
+	case taxlot.FieldClosedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClosedAt(v)
+		return nil
+	case taxlot.FieldUpdatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUpdatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown TaxLot field %s", name)
 }
 
-// OldLastExecution returns the old "last_execution" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldLastExecution(ctx context.Context) (v *time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldLastExecution is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldLastExecution requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldLastExecution: %w", err)
-	}
-	return oldValue.LastExecution, nil
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *TaxLotMutation) AddedFields() []string {
+	return nil
 }
 
-// ClearLastExecution clears the value of the "last_execution" field.
-func (m *StrategyStatusMutation) ClearLastExecution() {
-	m.last_execution = nil
-	m.clearedFields[strategystatus.FieldLastExecution] = struct{}{}
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *TaxLotMutation) AddedField(name string) (ent.Value, bool) {
+	return nil, false
 }
 
-// LastExecutionCleared returns if the "last_execution" field was cleared in this mutation.
-func (m *StrategyStatusMutation) LastExecutionCleared() bool {
-	_, ok := m.clearedFields[strategystatus.FieldLastExecution]
-	return ok
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *TaxLotMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown TaxLot numeric field %s", name)
 }
 
-// ResetLastExecution resets all changes to the "last_execution" field.
-func (m *StrategyStatusMutation) ResetLastExecution() {
-	m.last_execution = nil
-	delete(m.clearedFields, strategystatus.FieldLastExecution)
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *TaxLotMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(taxlot.FieldClosedAt) {
+		fields = append(fields, taxlot.FieldClosedAt)
+	}
+	return fields
 }
 
-// SetExecutionCount sets the "execution_count" field.
-func (m *StrategyStatusMutation) SetExecutionCount(i int64) {
-	m.execution_count = &i
-	m.addexecution_count = nil
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *TaxLotMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
 }
 
-// ExecutionCount returns the value of the "execution_count" field in the mutation.
-func (m *StrategyStatusMutation) ExecutionCount() (r int64, exists bool) {
-	v := m.execution_count
-	if v == nil {
-		return
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *TaxLotMutation) ClearField(name string) error {
+	switch name {
+	case taxlot.FieldClosedAt:
+		m.ClearClosedAt()
+		return nil
 	}
-	return *v, true
+	return fmt.Errorf("unknown TaxLot nullable field %s", name)
 }
 
-// OldExecutionCount returns the old "execution_count" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldExecutionCount(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldExecutionCount is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldExecutionCount requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldExecutionCount: %w", err)
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *TaxLotMutation) ResetField(name string) error {
+	switch name {
+	case taxlot.FieldUserID:
+		m.ResetUserID()
+		return nil
+	case taxlot.FieldTradeID:
+		m.ResetTradeID()
+		return nil
+	case taxlot.FieldSymbol:
+		m.ResetSymbol()
+		return nil
+	case taxlot.FieldQuantity:
+		m.ResetQuantity()
+		return nil
+	case taxlot.FieldRemainingQuantity:
+		m.ResetRemainingQuantity()
+		return nil
+	case taxlot.FieldCostPerShare:
+		m.ResetCostPerShare()
+		return nil
+	case taxlot.FieldFxRate:
+		m.ResetFxRate()
+		return nil
+	case taxlot.FieldOpenedAt:
+		m.ResetOpenedAt()
+		return nil
+	case taxlot.FieldClosedAt:
+		m.ResetClosedAt()
+		return nil
+	case taxlot.FieldUpdatedAt:
+		m.ResetUpdatedAt()
+		return nil
 	}
-	return oldValue.ExecutionCount, nil
+	return fmt.Errorf("unknown TaxLot field %s", name)
 }
 
-// AddExecutionCount adds i to the "execution_count" field.
-func (m *StrategyStatusMutation) AddExecutionCount(i int64) {
-	if m.addexecution_count != nil {
-		*m.addexecution_count += i
-	} else {
-		m.addexecution_count = &i
-	}
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *TaxLotMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// AddedExecutionCount returns the value that was added to the "execution_count" field in this mutation.
-func (m *StrategyStatusMutation) AddedExecutionCount() (r int64, exists bool) {
-	v := m.addexecution_count
-	if v == nil {
-		return
-	}
-	return *v, true
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *TaxLotMutation) AddedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ResetExecutionCount resets all changes to the "execution_count" field.
-func (m *StrategyStatusMutation) ResetExecutionCount() {
-	m.execution_count = nil
-	m.addexecution_count = nil
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *TaxLotMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// SetErrorMessage sets the "error_message" field.
-func (m *StrategyStatusMutation) SetErrorMessage(s string) {
-	m.error_message = &s
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *TaxLotMutation) RemovedIDs(name string) []ent.Value {
+	return nil
 }
 
-// ErrorMessage returns the value of the "error_message" field in the mutation.
-func (m *StrategyStatusMutation) ErrorMessage() (r string, exists bool) {
-	v := m.error_message
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *TaxLotMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
 }
 
-// OldErrorMessage returns the old "error_message" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldErrorMessage(ctx context.Context) (v *string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldErrorMessage is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldErrorMessage requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldErrorMessage: %w", err)
-	}
-	return oldValue.ErrorMessage, nil
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *TaxLotMutation) EdgeCleared(name string) bool {
+	return false
 }
 
-// ClearErrorMessage clears the value of the "error_message" field.
-func (m *StrategyStatusMutation) ClearErrorMessage() {
-	m.error_message = nil
-	m.clearedFields[strategystatus.FieldErrorMessage] = struct{}{}
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *TaxLotMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown TaxLot unique edge %s", name)
 }
 
-// ErrorMessageCleared returns if the "error_message" field was cleared in this mutation.
-func (m *StrategyStatusMutation) ErrorMessageCleared() bool {
-	_, ok := m.clearedFields[strategystatus.FieldErrorMessage]
-	return ok
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *TaxLotMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown TaxLot edge %s", name)
 }
 
-// ResetErrorMessage resets all changes to the "error_message" field.
-func (m *StrategyStatusMutation) ResetErrorMessage() {
-	m.error_message = nil
-	delete(m.clearedFields, strategystatus.FieldErrorMessage)
+// TradeMutation represents an operation that mutates the Trade nodes in the graph.
+type TradeMutation struct {
+	config
+	op                  Op
+	typ                 string
+	id                  *uuid.UUID
+	user_id             *uuid.UUID
+	client_order_id     *string
+	broker_order_id     *string
+	symbol              *string
+	exchange            *string
+	side                *trade.Side
+	quantity            *decimal.Decimal
+	price               *decimal.Decimal
+	fee                 *decimal.Decimal
+	currency            *string
+	fx_rate             *decimal.Decimal
+	filled_quantity     *decimal.Decimal
+	proceeds            *decimal.Decimal
+	cost_basis_fifo     *decimal.Decimal
+	cost_basis_fifo_krw *decimal.Decimal
+	cost_basis_avg      *decimal.Decimal
+	cost_basis_avg_krw  *decimal.Decimal
+	estimated_basis     *bool
+	position_after      *decimal.Decimal
+	avg_cost_after      *decimal.Decimal
+	avg_cost_krw_after  *decimal.Decimal
+	fx_pending          *bool
+	executed_at         *time.Time
+	created_at          *time.Time
+	clearedFields       map[string]struct{}
+	done                bool
+	oldValue            func(context.Context) (*Trade, error)
+	predicates          []predicate.Trade
+}
+
+var _ ent.Mutation = (*TradeMutation)(nil)
+
+// tradeOption allows management of the mutation configuration using functional options.
+type tradeOption func(*TradeMutation)
+
+// newTradeMutation creates new mutation for the Trade entity.
+func newTradeMutation(c config, op Op, opts ...tradeOption) *TradeMutation {
+	m := &TradeMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeTrade,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
 }
 
-// SetUptimeSeconds sets the "uptime_seconds" field.
-func (m *StrategyStatusMutation) SetUptimeSeconds(i int64) {
-	m.uptime_seconds = &i
-	m.adduptime_seconds = nil
+// withTradeID sets the ID field of the mutation.
+func withTradeID(id uuid.UUID) tradeOption {
+	return func(m *TradeMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Trade
+		)
+		m.oldValue = func(ctx context.Context) (*Trade, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Trade.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
 }
 
-// UptimeSeconds returns the value of the "uptime_seconds" field in the mutation.
-func (m *StrategyStatusMutation) UptimeSeconds() (r int64, exists bool) {
-	v := m.uptime_seconds
-	if v == nil {
-		return
+// withTrade sets the old Trade of the mutation.
+func withTrade(node *Trade) tradeOption {
+	return func(m *TradeMutation) {
+		m.oldValue = func(context.Context) (*Trade, error) {
+			return node, nil
+		}
+		m.id = &node.ID
 	}
-	return *v, true
 }
 
-// OldUptimeSeconds returns the old "uptime_seconds" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldUptimeSeconds(ctx context.Context) (v int64, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUptimeSeconds is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUptimeSeconds requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUptimeSeconds: %w", err)
-	}
-	return oldValue.UptimeSeconds, nil
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m TradeMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
 }
 
-// AddUptimeSeconds adds i to the "uptime_seconds" field.
-func (m *StrategyStatusMutation) AddUptimeSeconds(i int64) {
-	if m.adduptime_seconds != nil {
-		*m.adduptime_seconds += i
-	} else {
-		m.adduptime_seconds = &i
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m TradeMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("ent: mutation is not running in a transaction")
 	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// AddedUptimeSeconds returns the value that was added to the "uptime_seconds" field in this mutation.
-func (m *StrategyStatusMutation) AddedUptimeSeconds() (r int64, exists bool) {
-	v := m.adduptime_seconds
-	if v == nil {
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Trade entities.
+func (m *TradeMutation) SetID(id uuid.UUID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *TradeMutation) ID() (id uuid.UUID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
-}
-
-// ResetUptimeSeconds resets all changes to the "uptime_seconds" field.
-func (m *StrategyStatusMutation) ResetUptimeSeconds() {
-	m.uptime_seconds = nil
-	m.adduptime_seconds = nil
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *TradeMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []uuid.UUID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Trade.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StrategyStatusMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetUserID sets the "user_id" field.
+func (m *TradeMutation) SetUserID(u uuid.UUID) {
+	m.user_id = &u
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StrategyStatusMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// UserID returns the value of the "user_id" field in the mutation.
+func (m *TradeMutation) UserID() (r uuid.UUID, exists bool) {
+	v := m.user_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldUserID returns the old "user_id" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TradeMutation) OldUserID(ctx context.Context) (v uuid.UUID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldUserID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldUserID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldUserID: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.UserID, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StrategyStatusMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetUserID resets all changes to the "user_id" field.
+func (m *TradeMutation) ResetUserID() {
+	m.user_id = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StrategyStatusMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetClientOrderID sets the "client_order_id" field.
+func (m *TradeMutation) SetClientOrderID(s string) {
+	m.client_order_id = &s
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StrategyStatusMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// ClientOrderID returns the value of the "client_order_id" field in the mutation.
+func (m *TradeMutation) ClientOrderID() (r string, exists bool) {
+	v := m.client_order_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the StrategyStatus entity.
-// If the StrategyStatus object wasn't provided to the builder, the object is fetched from the database.
+// OldClientOrderID returns the old "client_order_id" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyStatusMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TradeMutation) OldClientOrderID(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientOrderID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldClientOrderID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
-	}
-	return oldValue.UpdatedAt, nil
-}
-
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StrategyStatusMutation) ResetUpdatedAt() {
-	m.updated_at = nil
-}
-
-// ClearStrategy clears the "strategy" edge to the Strategy entity.
-func (m *StrategyStatusMutation) ClearStrategy() {
-	m.clearedstrategy = true
-	m.clearedFields[strategystatus.FieldStrategyID] = struct{}{}
-}
-
-// StrategyCleared reports if the "strategy" edge to the Strategy entity was cleared.
-func (m *StrategyStatusMutation) StrategyCleared() bool {
-	return m.clearedstrategy
-}
-
-// StrategyIDs returns the "strategy" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// StrategyID instead. It exists only for internal usage by the builders.
-func (m *StrategyStatusMutation) StrategyIDs() (ids []uuid.UUID) {
-	if id := m.strategy; id != nil {
-		ids = append(ids, *id)
+		return v, fmt.Errorf("querying old value for OldClientOrderID: %w", err)
 	}
-	return
+	return oldValue.ClientOrderID, nil
 }
 
-// ResetStrategy resets all changes to the "strategy" edge.
-func (m *StrategyStatusMutation) ResetStrategy() {
-	m.strategy = nil
-	m.clearedstrategy = false
+// ResetClientOrderID resets all changes to the "client_order_id" field.
+func (m *TradeMutation) ResetClientOrderID() {
+	m.client_order_id = nil
 }
 
-// Where appends a list predicates to the StrategyStatusMutation builder.
-func (m *StrategyStatusMutation) Where(ps ...predicate.StrategyStatus) {
-	m.predicates = append(m.predicates, ps...)
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (m *TradeMutation) SetBrokerOrderID(s string) {
+	m.broker_order_id = &s
 }
 
-// WhereP appends storage-level predicates to the StrategyStatusMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyStatusMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StrategyStatus, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
+// BrokerOrderID returns the value of the "broker_order_id" field in the mutation.
+func (m *TradeMutation) BrokerOrderID() (r string, exists bool) {
+	v := m.broker_order_id
+	if v == nil {
+		return
 	}
-	m.Where(p...)
-}
-
-// Op returns the operation name.
-func (m *StrategyStatusMutation) Op() Op {
-	return m.op
-}
-
-// SetOp allows setting the mutation operation.
-func (m *StrategyStatusMutation) SetOp(op Op) {
-	m.op = op
-}
-
-// Type returns the node type of this mutation (StrategyStatus).
-func (m *StrategyStatusMutation) Type() string {
-	return m.typ
+	return *v, true
 }
 
-// Fields returns all fields that were changed during this mutation. Note that in
-// order to get all numeric fields that were incremented/decremented, call
-// AddedFields().
-func (m *StrategyStatusMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.strategy != nil {
-		fields = append(fields, strategystatus.FieldStrategyID)
-	}
-	if m.status != nil {
-		fields = append(fields, strategystatus.FieldStatus)
-	}
-	if m.last_execution != nil {
-		fields = append(fields, strategystatus.FieldLastExecution)
-	}
-	if m.execution_count != nil {
-		fields = append(fields, strategystatus.FieldExecutionCount)
-	}
-	if m.error_message != nil {
-		fields = append(fields, strategystatus.FieldErrorMessage)
-	}
-	if m.uptime_seconds != nil {
-		fields = append(fields, strategystatus.FieldUptimeSeconds)
-	}
-	if m.created_at != nil {
-		fields = append(fields, strategystatus.FieldCreatedAt)
-	}
-	if m.updated_at != nil {
-		fields = append(fields, strategystatus.FieldUpdatedAt)
+// OldBrokerOrderID returns the old "broker_order_id" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldBrokerOrderID(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBrokerOrderID is only allowed on UpdateOne operations")
 	}
-	return fields
-}
-
-// Field returns the value of a field with the given name. The second boolean
-// return value indicates that this field was not set, or was not defined in the
-// schema.
-func (m *StrategyStatusMutation) Field(name string) (ent.Value, bool) {
-	switch name {
-	case strategystatus.FieldStrategyID:
-		return m.StrategyID()
-	case strategystatus.FieldStatus:
-		return m.Status()
-	case strategystatus.FieldLastExecution:
-		return m.LastExecution()
-	case strategystatus.FieldExecutionCount:
-		return m.ExecutionCount()
-	case strategystatus.FieldErrorMessage:
-		return m.ErrorMessage()
-	case strategystatus.FieldUptimeSeconds:
-		return m.UptimeSeconds()
-	case strategystatus.FieldCreatedAt:
-		return m.CreatedAt()
-	case strategystatus.FieldUpdatedAt:
-		return m.UpdatedAt()
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBrokerOrderID requires an ID field in the mutation")
 	}
-	return nil, false
-}
-
-// OldField returns the old value of the field from the database. An error is
-// returned if the mutation operation is not UpdateOne, or the query to the
-// database failed.
-func (m *StrategyStatusMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
-	switch name {
-	case strategystatus.FieldStrategyID:
-		return m.OldStrategyID(ctx)
-	case strategystatus.FieldStatus:
-		return m.OldStatus(ctx)
-	case strategystatus.FieldLastExecution:
-		return m.OldLastExecution(ctx)
-	case strategystatus.FieldExecutionCount:
-		return m.OldExecutionCount(ctx)
-	case strategystatus.FieldErrorMessage:
-		return m.OldErrorMessage(ctx)
-	case strategystatus.FieldUptimeSeconds:
-		return m.OldUptimeSeconds(ctx)
-	case strategystatus.FieldCreatedAt:
-		return m.OldCreatedAt(ctx)
-	case strategystatus.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBrokerOrderID: %w", err)
 	}
-	return nil, fmt.Errorf("unknown StrategyStatus field %s", name)
+	return oldValue.BrokerOrderID, nil
 }
-
-// SetField sets the value of a field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *StrategyStatusMutation) SetField(name string, value ent.Value) error {
-	switch name {
-	case strategystatus.FieldStrategyID:
-		v, ok := value.(uuid.UUID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStrategyID(v)
-		return nil
-	case strategystatus.FieldStatus:
-		v, ok := value.(strategystatus.Status)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetStatus(v)
-		return nil
-	case strategystatus.FieldLastExecution:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetLastExecution(v)
-		return nil
-	case strategystatus.FieldExecutionCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetExecutionCount(v)
-		return nil
-	case strategystatus.FieldErrorMessage:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetErrorMessage(v)
-		return nil
-	case strategystatus.FieldUptimeSeconds:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUptimeSeconds(v)
-		return nil
-	case strategystatus.FieldCreatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetCreatedAt(v)
-		return nil
-	case strategystatus.FieldUpdatedAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetUpdatedAt(v)
-		return nil
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (m *TradeMutation) ClearBrokerOrderID() {
+	m.broker_order_id = nil
+	m.clearedFields[trade.FieldBrokerOrderID] = struct{}{}
+}
+
+// BrokerOrderIDCleared returns if the "broker_order_id" field was cleared in this mutation.
+func (m *TradeMutation) BrokerOrderIDCleared() bool {
+	_, ok := m.clearedFields[trade.FieldBrokerOrderID]
+	return ok
+}
+
+// ResetBrokerOrderID resets all changes to the "broker_order_id" field.
+func (m *TradeMutation) ResetBrokerOrderID() {
+	m.broker_order_id = nil
+	delete(m.clearedFields, trade.FieldBrokerOrderID)
+}
+
+// SetSymbol sets the "symbol" field.
+func (m *TradeMutation) SetSymbol(s string) {
+	m.symbol = &s
+}
+
+// Symbol returns the value of the "symbol" field in the mutation.
+func (m *TradeMutation) Symbol() (r string, exists bool) {
+	v := m.symbol
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StrategyStatus field %s", name)
+	return *v, true
 }
 
-// AddedFields returns all numeric fields that were incremented/decremented during
-// this mutation.
-func (m *StrategyStatusMutation) AddedFields() []string {
-	var fields []string
-	if m.addexecution_count != nil {
-		fields = append(fields, strategystatus.FieldExecutionCount)
+// OldSymbol returns the old "symbol" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldSymbol(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSymbol is only allowed on UpdateOne operations")
 	}
-	if m.adduptime_seconds != nil {
-		fields = append(fields, strategystatus.FieldUptimeSeconds)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSymbol requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSymbol: %w", err)
+	}
+	return oldValue.Symbol, nil
 }
 
-// AddedField returns the numeric value that was incremented/decremented on a field
-// with the given name. The second boolean return value indicates that this field
-// was not set, or was not defined in the schema.
-func (m *StrategyStatusMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case strategystatus.FieldExecutionCount:
-		return m.AddedExecutionCount()
-	case strategystatus.FieldUptimeSeconds:
-		return m.AddedUptimeSeconds()
-	}
-	return nil, false
+// ResetSymbol resets all changes to the "symbol" field.
+func (m *TradeMutation) ResetSymbol() {
+	m.symbol = nil
 }
 
-// AddField adds the value to the field with the given name. It returns an error if
-// the field is not defined in the schema, or if the type mismatched the field
-// type.
-func (m *StrategyStatusMutation) AddField(name string, value ent.Value) error {
-	switch name {
-	case strategystatus.FieldExecutionCount:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddExecutionCount(v)
-		return nil
-	case strategystatus.FieldUptimeSeconds:
-		v, ok := value.(int64)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddUptimeSeconds(v)
-		return nil
+// SetExchange sets the "exchange" field.
+func (m *TradeMutation) SetExchange(s string) {
+	m.exchange = &s
+}
+
+// Exchange returns the value of the "exchange" field in the mutation.
+func (m *TradeMutation) Exchange() (r string, exists bool) {
+	v := m.exchange
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StrategyStatus numeric field %s", name)
+	return *v, true
 }
 
-// ClearedFields returns all nullable fields that were cleared during this
-// mutation.
-func (m *StrategyStatusMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(strategystatus.FieldLastExecution) {
-		fields = append(fields, strategystatus.FieldLastExecution)
+// OldExchange returns the old "exchange" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldExchange(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExchange is only allowed on UpdateOne operations")
 	}
-	if m.FieldCleared(strategystatus.FieldErrorMessage) {
-		fields = append(fields, strategystatus.FieldErrorMessage)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExchange requires an ID field in the mutation")
 	}
-	return fields
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExchange: %w", err)
+	}
+	return oldValue.Exchange, nil
 }
 
-// FieldCleared returns a boolean indicating if a field with the given name was
-// cleared in this mutation.
-func (m *StrategyStatusMutation) FieldCleared(name string) bool {
-	_, ok := m.clearedFields[name]
+// ClearExchange clears the value of the "exchange" field.
+func (m *TradeMutation) ClearExchange() {
+	m.exchange = nil
+	m.clearedFields[trade.FieldExchange] = struct{}{}
+}
+
+// ExchangeCleared returns if the "exchange" field was cleared in this mutation.
+func (m *TradeMutation) ExchangeCleared() bool {
+	_, ok := m.clearedFields[trade.FieldExchange]
 	return ok
 }
 
-// ClearField clears the value of the field with the given name. It returns an
-// error if the field is not defined in the schema.
-func (m *StrategyStatusMutation) ClearField(name string) error {
-	switch name {
-	case strategystatus.FieldLastExecution:
-		m.ClearLastExecution()
-		return nil
-	case strategystatus.FieldErrorMessage:
-		m.ClearErrorMessage()
-		return nil
+// ResetExchange resets all changes to the "exchange" field.
+func (m *TradeMutation) ResetExchange() {
+	m.exchange = nil
+	delete(m.clearedFields, trade.FieldExchange)
+}
+
+// SetSide sets the "side" field.
+func (m *TradeMutation) SetSide(t trade.Side) {
+	m.side = &t
+}
+
+// Side returns the value of the "side" field in the mutation.
+func (m *TradeMutation) Side() (r trade.Side, exists bool) {
+	v := m.side
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StrategyStatus nullable field %s", name)
+	return *v, true
 }
 
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *StrategyStatusMutation) ResetField(name string) error {
-	switch name {
-	case strategystatus.FieldStrategyID:
-		m.ResetStrategyID()
-		return nil
-	case strategystatus.FieldStatus:
-		m.ResetStatus()
-		return nil
-	case strategystatus.FieldLastExecution:
-		m.ResetLastExecution()
-		return nil
-	case strategystatus.FieldExecutionCount:
-		m.ResetExecutionCount()
-		return nil
-	case strategystatus.FieldErrorMessage:
-		m.ResetErrorMessage()
-		return nil
-	case strategystatus.FieldUptimeSeconds:
-		m.ResetUptimeSeconds()
-		return nil
-	case strategystatus.FieldCreatedAt:
-		m.ResetCreatedAt()
-		return nil
-	case strategystatus.FieldUpdatedAt:
-		m.ResetUpdatedAt()
-		return nil
+// OldSide returns the old "side" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldSide(ctx context.Context) (v trade.Side, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldSide is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown StrategyStatus field %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldSide requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldSide: %w", err)
+	}
+	return oldValue.Side, nil
 }
 
-// AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyStatusMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.strategy != nil {
-		edges = append(edges, strategystatus.EdgeStrategy)
+// ResetSide resets all changes to the "side" field.
+func (m *TradeMutation) ResetSide() {
+	m.side = nil
+}
+
+// SetQuantity sets the "quantity" field.
+func (m *TradeMutation) SetQuantity(d decimal.Decimal) {
+	m.quantity = &d
+}
+
+// Quantity returns the value of the "quantity" field in the mutation.
+func (m *TradeMutation) Quantity() (r decimal.Decimal, exists bool) {
+	v := m.quantity
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// AddedIDs returns all IDs (to other nodes) that were added for the given edge
-// name in this mutation.
-func (m *StrategyStatusMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case strategystatus.EdgeStrategy:
-		if id := m.strategy; id != nil {
-			return []ent.Value{*id}
-		}
+// OldQuantity returns the old "quantity" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldQuantity is only allowed on UpdateOne operations")
 	}
-	return nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldQuantity: %w", err)
+	}
+	return oldValue.Quantity, nil
 }
 
-// RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyStatusMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	return edges
+// ResetQuantity resets all changes to the "quantity" field.
+func (m *TradeMutation) ResetQuantity() {
+	m.quantity = nil
 }
 
-// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
-// the given name in this mutation.
-func (m *StrategyStatusMutation) RemovedIDs(name string) []ent.Value {
-	return nil
+// SetPrice sets the "price" field.
+func (m *TradeMutation) SetPrice(d decimal.Decimal) {
+	m.price = &d
 }
 
-// ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyStatusMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedstrategy {
-		edges = append(edges, strategystatus.EdgeStrategy)
+// Price returns the value of the "price" field in the mutation.
+func (m *TradeMutation) Price() (r decimal.Decimal, exists bool) {
+	v := m.price
+	if v == nil {
+		return
 	}
-	return edges
+	return *v, true
 }
 
-// EdgeCleared returns a boolean which indicates if the edge with the given name
-// was cleared in this mutation.
-func (m *StrategyStatusMutation) EdgeCleared(name string) bool {
-	switch name {
-	case strategystatus.EdgeStrategy:
-		return m.clearedstrategy
+// OldPrice returns the old "price" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldPrice(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldPrice is only allowed on UpdateOne operations")
 	}
-	return false
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldPrice requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldPrice: %w", err)
+	}
+	return oldValue.Price, nil
 }
 
-// ClearEdge clears the value of the edge with the given name. It returns an error
-// if that edge is not defined in the schema.
-func (m *StrategyStatusMutation) ClearEdge(name string) error {
-	switch name {
-	case strategystatus.EdgeStrategy:
-		m.ClearStrategy()
-		return nil
+// ResetPrice resets all changes to the "price" field.
+func (m *TradeMutation) ResetPrice() {
+	m.price = nil
+}
+
+// SetFee sets the "fee" field.
+func (m *TradeMutation) SetFee(d decimal.Decimal) {
+	m.fee = &d
+}
+
+// Fee returns the value of the "fee" field in the mutation.
+func (m *TradeMutation) Fee() (r decimal.Decimal, exists bool) {
+	v := m.fee
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldFee returns the old "fee" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldFee(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFee is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFee requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFee: %w", err)
+	}
+	return oldValue.Fee, nil
+}
+
+// ResetFee resets all changes to the "fee" field.
+func (m *TradeMutation) ResetFee() {
+	m.fee = nil
+}
+
+// SetCurrency sets the "currency" field.
+func (m *TradeMutation) SetCurrency(s string) {
+	m.currency = &s
+}
+
+// Currency returns the value of the "currency" field in the mutation.
+func (m *TradeMutation) Currency() (r string, exists bool) {
+	v := m.currency
+	if v == nil {
+		return
 	}
-	return fmt.Errorf("unknown StrategyStatus unique edge %s", name)
+	return *v, true
 }
 
-// ResetEdge resets all changes to the edge with the given name in this mutation.
-// It returns an error if the edge is not defined in the schema.
-func (m *StrategyStatusMutation) ResetEdge(name string) error {
-	switch name {
-	case strategystatus.EdgeStrategy:
-		m.ResetStrategy()
-		return nil
+// OldCurrency returns the old "currency" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldCurrency(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCurrency is only allowed on UpdateOne operations")
 	}
-	return fmt.Errorf("unknown StrategyStatus edge %s", name)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCurrency requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCurrency: %w", err)
+	}
+	return oldValue.Currency, nil
 }
 
-// StrategyTemplateMutation represents an operation that mutates the StrategyTemplate nodes in the graph.
-type StrategyTemplateMutation struct {
-	config
-	op                Op
-	typ               string
-	id                *uuid.UUID
-	name              *string
-	description       *string
-	category          *string
-	template_config   *map[string]interface{}
-	input_schema      *map[string]interface{}
-	version           *string
-	created_at        *time.Time
-	updated_at        *time.Time
-	clearedFields     map[string]struct{}
-	strategies        map[uuid.UUID]struct{}
-	removedstrategies map[uuid.UUID]struct{}
-	clearedstrategies bool
-	done              bool
-	oldValue          func(context.Context) (*StrategyTemplate, error)
-	predicates        []predicate.StrategyTemplate
+// ResetCurrency resets all changes to the "currency" field.
+func (m *TradeMutation) ResetCurrency() {
+	m.currency = nil
 }
 
-var _ ent.Mutation = (*StrategyTemplateMutation)(nil)
+// SetFxRate sets the "fx_rate" field.
+func (m *TradeMutation) SetFxRate(d decimal.Decimal) {
+	m.fx_rate = &d
+}
 
-// strategytemplateOption allows management of the mutation configuration using functional options.
-type strategytemplateOption func(*StrategyTemplateMutation)
+// FxRate returns the value of the "fx_rate" field in the mutation.
+func (m *TradeMutation) FxRate() (r decimal.Decimal, exists bool) {
+	v := m.fx_rate
+	if v == nil {
+		return
+	}
+	return *v, true
+}
 
-// newStrategyTemplateMutation creates new mutation for the StrategyTemplate entity.
-func newStrategyTemplateMutation(c config, op Op, opts ...strategytemplateOption) *StrategyTemplateMutation {
-	m := &StrategyTemplateMutation{
-		config:        c,
-		op:            op,
-		typ:           TypeStrategyTemplate,
-		clearedFields: make(map[string]struct{}),
+// OldFxRate returns the old "fx_rate" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldFxRate(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFxRate is only allowed on UpdateOne operations")
 	}
-	for _, opt := range opts {
-		opt(m)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFxRate requires an ID field in the mutation")
 	}
-	return m
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFxRate: %w", err)
+	}
+	return oldValue.FxRate, nil
 }
 
-// withStrategyTemplateID sets the ID field of the mutation.
-func withStrategyTemplateID(id uuid.UUID) strategytemplateOption {
-	return func(m *StrategyTemplateMutation) {
-		var (
-			err   error
-			once  sync.Once
-			value *StrategyTemplate
-		)
-		m.oldValue = func(ctx context.Context) (*StrategyTemplate, error) {
-			once.Do(func() {
-				if m.done {
-					err = errors.New("querying old values post mutation is not allowed")
-				} else {
-					value, err = m.Client().StrategyTemplate.Get(ctx, id)
-				}
-			})
-			return value, err
-		}
-		m.id = &id
-	}
+// ResetFxRate resets all changes to the "fx_rate" field.
+func (m *TradeMutation) ResetFxRate() {
+	m.fx_rate = nil
 }
 
-// withStrategyTemplate sets the old StrategyTemplate of the mutation.
-func withStrategyTemplate(node *StrategyTemplate) strategytemplateOption {
-	return func(m *StrategyTemplateMutation) {
-		m.oldValue = func(context.Context) (*StrategyTemplate, error) {
-			return node, nil
-		}
-		m.id = &node.ID
-	}
+// SetFilledQuantity sets the "filled_quantity" field.
+func (m *TradeMutation) SetFilledQuantity(d decimal.Decimal) {
+	m.filled_quantity = &d
 }
 
-// Client returns a new `ent.Client` from the mutation. If the mutation was
-// executed in a transaction (ent.Tx), a transactional client is returned.
-func (m StrategyTemplateMutation) Client() *Client {
-	client := &Client{config: m.config}
-	client.init()
-	return client
+// FilledQuantity returns the value of the "filled_quantity" field in the mutation.
+func (m *TradeMutation) FilledQuantity() (r decimal.Decimal, exists bool) {
+	v := m.filled_quantity
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// Tx returns an `ent.Tx` for mutations that were executed in transactions;
-// it returns an error otherwise.
-func (m StrategyTemplateMutation) Tx() (*Tx, error) {
-	if _, ok := m.driver.(*txDriver); !ok {
-		return nil, errors.New("ent: mutation is not running in a transaction")
+// OldFilledQuantity returns the old "filled_quantity" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldFilledQuantity(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldFilledQuantity is only allowed on UpdateOne operations")
 	}
-	tx := &Tx{config: m.config}
-	tx.init()
-	return tx, nil
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldFilledQuantity requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldFilledQuantity: %w", err)
+	}
+	return oldValue.FilledQuantity, nil
 }
 
-// SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of StrategyTemplate entities.
-func (m *StrategyTemplateMutation) SetID(id uuid.UUID) {
-	m.id = &id
+// ResetFilledQuantity resets all changes to the "filled_quantity" field.
+func (m *TradeMutation) ResetFilledQuantity() {
+	m.filled_quantity = nil
 }
 
-// ID returns the ID value in the mutation. Note that the ID is only available
-// if it was provided to the builder or after it was returned from the database.
-func (m *StrategyTemplateMutation) ID() (id uuid.UUID, exists bool) {
-	if m.id == nil {
+// SetProceeds sets the "proceeds" field.
+func (m *TradeMutation) SetProceeds(d decimal.Decimal) {
+	m.proceeds = &d
+}
+
+// Proceeds returns the value of the "proceeds" field in the mutation.
+func (m *TradeMutation) Proceeds() (r decimal.Decimal, exists bool) {
+	v := m.proceeds
+	if v == nil {
 		return
 	}
-	return *m.id, true
+	return *v, true
 }
 
-// IDs queries the database and returns the entity ids that match the mutation's predicate.
-// That means, if the mutation is applied within a transaction with an isolation level such
-// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
-// or updated by the mutation.
-func (m *StrategyTemplateMutation) IDs(ctx context.Context) ([]uuid.UUID, error) {
-	switch {
-	case m.op.Is(OpUpdateOne | OpDeleteOne):
-		id, exists := m.ID()
-		if exists {
-			return []uuid.UUID{id}, nil
-		}
-		fallthrough
-	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().StrategyTemplate.Query().Where(m.predicates...).IDs(ctx)
-	default:
-		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+// OldProceeds returns the old "proceeds" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldProceeds(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProceeds is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProceeds requires an ID field in the mutation")
 	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProceeds: %w", err)
+	}
+	return oldValue.Proceeds, nil
 }
 
-// SetName sets the "name" field.
-func (m *StrategyTemplateMutation) SetName(s string) {
-	m.name = &s
+// ResetProceeds resets all changes to the "proceeds" field.
+func (m *TradeMutation) ResetProceeds() {
+	m.proceeds = nil
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *StrategyTemplateMutation) Name() (r string, exists bool) {
-	v := m.name
+// SetCostBasisFifo sets the "cost_basis_fifo" field.
+func (m *TradeMutation) SetCostBasisFifo(d decimal.Decimal) {
+	m.cost_basis_fifo = &d
+}
+
+// CostBasisFifo returns the value of the "cost_basis_fifo" field in the mutation.
+func (m *TradeMutation) CostBasisFifo() (r decimal.Decimal, exists bool) {
+	v := m.cost_basis_fifo
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldCostBasisFifo returns the old "cost_basis_fifo" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *TradeMutation) OldCostBasisFifo(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostBasisFifo is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldCostBasisFifo requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostBasisFifo: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.CostBasisFifo, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *StrategyTemplateMutation) ResetName() {
-	m.name = nil
+// ResetCostBasisFifo resets all changes to the "cost_basis_fifo" field.
+func (m *TradeMutation) ResetCostBasisFifo() {
+	m.cost_basis_fifo = nil
 }
 
-// SetDescription sets the "description" field.
-func (m *StrategyTemplateMutation) SetDescription(s string) {
-	m.description = &s
+// SetCostBasisFifoKrw sets the "cost_basis_fifo_krw" field.
+func (m *TradeMutation) SetCostBasisFifoKrw(d decimal.Decimal) {
+	m.cost_basis_fifo_krw = &d
 }
 
-// Description returns the value of the "description" field in the mutation.
-func (m *StrategyTemplateMutation) Description() (r string, exists bool) {
-	v := m.description
+// CostBasisFifoKrw returns the value of the "cost_basis_fifo_krw" field in the mutation.
+func (m *TradeMutation) CostBasisFifoKrw() (r decimal.Decimal, exists bool) {
+	v := m.cost_basis_fifo_krw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldDescription returns the old "description" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldCostBasisFifoKrw returns the old "cost_basis_fifo_krw" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldDescription(ctx context.Context) (v *string, err error) {
+func (m *TradeMutation) OldCostBasisFifoKrw(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldDescription is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostBasisFifoKrw is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldDescription requires an ID field in the mutation")
+		return v, errors.New("OldCostBasisFifoKrw requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldDescription: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostBasisFifoKrw: %w", err)
 	}
-	return oldValue.Description, nil
+	return oldValue.CostBasisFifoKrw, nil
 }
 
-// ClearDescription clears the value of the "description" field.
-func (m *StrategyTemplateMutation) ClearDescription() {
-	m.description = nil
-	m.clearedFields[strategytemplate.FieldDescription] = struct{}{}
+// ResetCostBasisFifoKrw resets all changes to the "cost_basis_fifo_krw" field.
+func (m *TradeMutation) ResetCostBasisFifoKrw() {
+	m.cost_basis_fifo_krw = nil
 }
 
-// DescriptionCleared returns if the "description" field was cleared in this mutation.
-func (m *StrategyTemplateMutation) DescriptionCleared() bool {
-	_, ok := m.clearedFields[strategytemplate.FieldDescription]
-	return ok
+// SetCostBasisAvg sets the "cost_basis_avg" field.
+func (m *TradeMutation) SetCostBasisAvg(d decimal.Decimal) {
+	m.cost_basis_avg = &d
 }
 
-// ResetDescription resets all changes to the "description" field.
-func (m *StrategyTemplateMutation) ResetDescription() {
-	m.description = nil
-	delete(m.clearedFields, strategytemplate.FieldDescription)
+// CostBasisAvg returns the value of the "cost_basis_avg" field in the mutation.
+func (m *TradeMutation) CostBasisAvg() (r decimal.Decimal, exists bool) {
+	v := m.cost_basis_avg
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetCategory sets the "category" field.
-func (m *StrategyTemplateMutation) SetCategory(s string) {
-	m.category = &s
+// OldCostBasisAvg returns the old "cost_basis_avg" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldCostBasisAvg(ctx context.Context) (v decimal.Decimal, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCostBasisAvg is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCostBasisAvg requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCostBasisAvg: %w", err)
+	}
+	return oldValue.CostBasisAvg, nil
 }
 
-// Category returns the value of the "category" field in the mutation.
-func (m *StrategyTemplateMutation) Category() (r string, exists bool) {
-	v := m.category
+// ResetCostBasisAvg resets all changes to the "cost_basis_avg" field.
+func (m *TradeMutation) ResetCostBasisAvg() {
+	m.cost_basis_avg = nil
+}
+
+// SetCostBasisAvgKrw sets the "cost_basis_avg_krw" field.
+func (m *TradeMutation) SetCostBasisAvgKrw(d decimal.Decimal) {
+	m.cost_basis_avg_krw = &d
+}
+
+// CostBasisAvgKrw returns the value of the "cost_basis_avg_krw" field in the mutation.
+func (m *TradeMutation) CostBasisAvgKrw() (r decimal.Decimal, exists bool) {
+	v := m.cost_basis_avg_krw
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCategory returns the old "category" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldCostBasisAvgKrw returns the old "cost_basis_avg_krw" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldCategory(ctx context.Context) (v string, err error) {
+func (m *TradeMutation) OldCostBasisAvgKrw(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCategory is only allowed on UpdateOne operations")
+		return v, errors.New("OldCostBasisAvgKrw is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCategory requires an ID field in the mutation")
+		return v, errors.New("OldCostBasisAvgKrw requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCategory: %w", err)
+		return v, fmt.Errorf("querying old value for OldCostBasisAvgKrw: %w", err)
 	}
-	return oldValue.Category, nil
+	return oldValue.CostBasisAvgKrw, nil
 }
 
-// ResetCategory resets all changes to the "category" field.
-func (m *StrategyTemplateMutation) ResetCategory() {
-	m.category = nil
+// ResetCostBasisAvgKrw resets all changes to the "cost_basis_avg_krw" field.
+func (m *TradeMutation) ResetCostBasisAvgKrw() {
+	m.cost_basis_avg_krw = nil
 }
 
-// SetTemplateConfig sets the "template_config" field.
-func (m *StrategyTemplateMutation) SetTemplateConfig(value map[string]interface{}) {
-	m.template_config = &value
+// SetEstimatedBasis sets the "estimated_basis" field.
+func (m *TradeMutation) SetEstimatedBasis(b bool) {
+	m.estimated_basis = &b
 }
 
-// TemplateConfig returns the value of the "template_config" field in the mutation.
-func (m *StrategyTemplateMutation) TemplateConfig() (r map[string]interface{}, exists bool) {
-	v := m.template_config
+// EstimatedBasis returns the value of the "estimated_basis" field in the mutation.
+func (m *TradeMutation) EstimatedBasis() (r bool, exists bool) {
+	v := m.estimated_basis
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldTemplateConfig returns the old "template_config" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldEstimatedBasis returns the old "estimated_basis" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldTemplateConfig(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *TradeMutation) OldEstimatedBasis(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldTemplateConfig is only allowed on UpdateOne operations")
+		return v, errors.New("OldEstimatedBasis is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldTemplateConfig requires an ID field in the mutation")
+		return v, errors.New("OldEstimatedBasis requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldTemplateConfig: %w", err)
+		return v, fmt.Errorf("querying old value for OldEstimatedBasis: %w", err)
 	}
-	return oldValue.TemplateConfig, nil
+	return oldValue.EstimatedBasis, nil
 }
 
-// ResetTemplateConfig resets all changes to the "template_config" field.
-func (m *StrategyTemplateMutation) ResetTemplateConfig() {
-	m.template_config = nil
+// ResetEstimatedBasis resets all changes to the "estimated_basis" field.
+func (m *TradeMutation) ResetEstimatedBasis() {
+	m.estimated_basis = nil
 }
 
-// SetInputSchema sets the "input_schema" field.
-func (m *StrategyTemplateMutation) SetInputSchema(value map[string]interface{}) {
-	m.input_schema = &value
+// SetPositionAfter sets the "position_after" field.
+func (m *TradeMutation) SetPositionAfter(d decimal.Decimal) {
+	m.position_after = &d
 }
 
-// InputSchema returns the value of the "input_schema" field in the mutation.
-func (m *StrategyTemplateMutation) InputSchema() (r map[string]interface{}, exists bool) {
-	v := m.input_schema
+// PositionAfter returns the value of the "position_after" field in the mutation.
+func (m *TradeMutation) PositionAfter() (r decimal.Decimal, exists bool) {
+	v := m.position_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInputSchema returns the old "input_schema" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldPositionAfter returns the old "position_after" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldInputSchema(ctx context.Context) (v map[string]interface{}, err error) {
+func (m *TradeMutation) OldPositionAfter(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInputSchema is only allowed on UpdateOne operations")
+		return v, errors.New("OldPositionAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInputSchema requires an ID field in the mutation")
+		return v, errors.New("OldPositionAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInputSchema: %w", err)
+		return v, fmt.Errorf("querying old value for OldPositionAfter: %w", err)
 	}
-	return oldValue.InputSchema, nil
+	return oldValue.PositionAfter, nil
 }
 
-// ResetInputSchema resets all changes to the "input_schema" field.
-func (m *StrategyTemplateMutation) ResetInputSchema() {
-	m.input_schema = nil
+// ResetPositionAfter resets all changes to the "position_after" field.
+func (m *TradeMutation) ResetPositionAfter() {
+	m.position_after = nil
 }
 
-// SetVersion sets the "version" field.
-func (m *StrategyTemplateMutation) SetVersion(s string) {
-	m.version = &s
+// SetAvgCostAfter sets the "avg_cost_after" field.
+func (m *TradeMutation) SetAvgCostAfter(d decimal.Decimal) {
+	m.avg_cost_after = &d
 }
 
-// Version returns the value of the "version" field in the mutation.
-func (m *StrategyTemplateMutation) Version() (r string, exists bool) {
-	v := m.version
+// AvgCostAfter returns the value of the "avg_cost_after" field in the mutation.
+func (m *TradeMutation) AvgCostAfter() (r decimal.Decimal, exists bool) {
+	v := m.avg_cost_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldVersion returns the old "version" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldAvgCostAfter returns the old "avg_cost_after" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldVersion(ctx context.Context) (v string, err error) {
+func (m *TradeMutation) OldAvgCostAfter(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldVersion is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvgCostAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldVersion requires an ID field in the mutation")
+		return v, errors.New("OldAvgCostAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldVersion: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvgCostAfter: %w", err)
 	}
-	return oldValue.Version, nil
+	return oldValue.AvgCostAfter, nil
 }
 
-// ResetVersion resets all changes to the "version" field.
-func (m *StrategyTemplateMutation) ResetVersion() {
-	m.version = nil
+// ResetAvgCostAfter resets all changes to the "avg_cost_after" field.
+func (m *TradeMutation) ResetAvgCostAfter() {
+	m.avg_cost_after = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *StrategyTemplateMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetAvgCostKrwAfter sets the "avg_cost_krw_after" field.
+func (m *TradeMutation) SetAvgCostKrwAfter(d decimal.Decimal) {
+	m.avg_cost_krw_after = &d
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *StrategyTemplateMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// AvgCostKrwAfter returns the value of the "avg_cost_krw_after" field in the mutation.
+func (m *TradeMutation) AvgCostKrwAfter() (r decimal.Decimal, exists bool) {
+	v := m.avg_cost_krw_after
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldAvgCostKrwAfter returns the old "avg_cost_krw_after" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TradeMutation) OldAvgCostKrwAfter(ctx context.Context) (v decimal.Decimal, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvgCostKrwAfter is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAvgCostKrwAfter requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvgCostKrwAfter: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.AvgCostKrwAfter, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *StrategyTemplateMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAvgCostKrwAfter resets all changes to the "avg_cost_krw_after" field.
+func (m *TradeMutation) ResetAvgCostKrwAfter() {
+	m.avg_cost_krw_after = nil
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (m *StrategyTemplateMutation) SetUpdatedAt(t time.Time) {
-	m.updated_at = &t
+// SetFxPending sets the "fx_pending" field.
+func (m *TradeMutation) SetFxPending(b bool) {
+	m.fx_pending = &b
 }
 
-// UpdatedAt returns the value of the "updated_at" field in the mutation.
-func (m *StrategyTemplateMutation) UpdatedAt() (r time.Time, exists bool) {
-	v := m.updated_at
+// FxPending returns the value of the "fx_pending" field in the mutation.
+func (m *TradeMutation) FxPending() (r bool, exists bool) {
+	v := m.fx_pending
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldUpdatedAt returns the old "updated_at" field's value of the StrategyTemplate entity.
-// If the StrategyTemplate object wasn't provided to the builder, the object is fetched from the database.
+// OldFxPending returns the old "fx_pending" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *StrategyTemplateMutation) OldUpdatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *TradeMutation) OldFxPending(ctx context.Context) (v bool, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldUpdatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldFxPending is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldUpdatedAt requires an ID field in the mutation")
+		return v, errors.New("OldFxPending requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldUpdatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldFxPending: %w", err)
 	}
-	return oldValue.UpdatedAt, nil
+	return oldValue.FxPending, nil
 }
 
-// ResetUpdatedAt resets all changes to the "updated_at" field.
-func (m *StrategyTemplateMutation) ResetUpdatedAt() {
-	m.updated_at = nil
+// ResetFxPending resets all changes to the "fx_pending" field.
+func (m *TradeMutation) ResetFxPending() {
+	m.fx_pending = nil
 }
 
-// AddStrategyIDs adds the "strategies" edge to the Strategy entity by ids.
-func (m *StrategyTemplateMutation) AddStrategyIDs(ids ...uuid.UUID) {
-	if m.strategies == nil {
-		m.strategies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		m.strategies[ids[i]] = struct{}{}
+// SetExecutedAt sets the "executed_at" field.
+func (m *TradeMutation) SetExecutedAt(t time.Time) {
+	m.executed_at = &t
+}
+
+// ExecutedAt returns the value of the "executed_at" field in the mutation.
+func (m *TradeMutation) ExecutedAt() (r time.Time, exists bool) {
+	v := m.executed_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// ClearStrategies clears the "strategies" edge to the Strategy entity.
-func (m *StrategyTemplateMutation) ClearStrategies() {
-	m.clearedstrategies = true
+// OldExecutedAt returns the old "executed_at" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldExecutedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldExecutedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldExecutedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldExecutedAt: %w", err)
+	}
+	return oldValue.ExecutedAt, nil
 }
 
-// StrategiesCleared reports if the "strategies" edge to the Strategy entity was cleared.
-func (m *StrategyTemplateMutation) StrategiesCleared() bool {
-	return m.clearedstrategies
+// ResetExecutedAt resets all changes to the "executed_at" field.
+func (m *TradeMutation) ResetExecutedAt() {
+	m.executed_at = nil
 }
 
-// RemoveStrategyIDs removes the "strategies" edge to the Strategy entity by IDs.
-func (m *StrategyTemplateMutation) RemoveStrategyIDs(ids ...uuid.UUID) {
-	if m.removedstrategies == nil {
-		m.removedstrategies = make(map[uuid.UUID]struct{})
-	}
-	for i := range ids {
-		delete(m.strategies, ids[i])
-		m.removedstrategies[ids[i]] = struct{}{}
-	}
+// SetCreatedAt sets the "created_at" field.
+func (m *TradeMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemovedStrategies returns the removed IDs of the "strategies" edge to the Strategy entity.
-func (m *StrategyTemplateMutation) RemovedStrategiesIDs() (ids []uuid.UUID) {
-	for id := range m.removedstrategies {
-		ids = append(ids, id)
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *TradeMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// StrategiesIDs returns the "strategies" edge IDs in the mutation.
-func (m *StrategyTemplateMutation) StrategiesIDs() (ids []uuid.UUID) {
-	for id := range m.strategies {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the Trade entity.
+// If the Trade object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *TradeMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetStrategies resets all changes to the "strategies" edge.
-func (m *StrategyTemplateMutation) ResetStrategies() {
-	m.strategies = nil
-	m.clearedstrategies = false
-	m.removedstrategies = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *TradeMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the StrategyTemplateMutation builder.
-func (m *StrategyTemplateMutation) Where(ps ...predicate.StrategyTemplate) {
+// Where appends a list predicates to the TradeMutation builder.
+func (m *TradeMutation) Where(ps ...predicate.Trade) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the StrategyTemplateMutation builder. Using this method,
+// WhereP appends storage-level predicates to the TradeMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *StrategyTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.StrategyTemplate, len(ps))
+func (m *TradeMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Trade, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -5470,48 +21757,96 @@ func (m *StrategyTemplateMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *StrategyTemplateMutation) Op() Op {
+func (m *TradeMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *StrategyTemplateMutation) SetOp(op Op) {
+func (m *TradeMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (StrategyTemplate).
-func (m *StrategyTemplateMutation) Type() string {
+// Type returns the node type of this mutation (Trade).
+func (m *TradeMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *StrategyTemplateMutation) Fields() []string {
-	fields := make([]string, 0, 8)
-	if m.name != nil {
-		fields = append(fields, strategytemplate.FieldName)
+func (m *TradeMutation) Fields() []string {
+	fields := make([]string, 0, 24)
+	if m.user_id != nil {
+		fields = append(fields, trade.FieldUserID)
 	}
-	if m.description != nil {
-		fields = append(fields, strategytemplate.FieldDescription)
+	if m.client_order_id != nil {
+		fields = append(fields, trade.FieldClientOrderID)
 	}
-	if m.category != nil {
-		fields = append(fields, strategytemplate.FieldCategory)
+	if m.broker_order_id != nil {
+		fields = append(fields, trade.FieldBrokerOrderID)
 	}
-	if m.template_config != nil {
-		fields = append(fields, strategytemplate.FieldTemplateConfig)
+	if m.symbol != nil {
+		fields = append(fields, trade.FieldSymbol)
 	}
-	if m.input_schema != nil {
-		fields = append(fields, strategytemplate.FieldInputSchema)
+	if m.exchange != nil {
+		fields = append(fields, trade.FieldExchange)
 	}
-	if m.version != nil {
-		fields = append(fields, strategytemplate.FieldVersion)
+	if m.side != nil {
+		fields = append(fields, trade.FieldSide)
 	}
-	if m.created_at != nil {
-		fields = append(fields, strategytemplate.FieldCreatedAt)
+	if m.quantity != nil {
+		fields = append(fields, trade.FieldQuantity)
 	}
-	if m.updated_at != nil {
-		fields = append(fields, strategytemplate.FieldUpdatedAt)
+	if m.price != nil {
+		fields = append(fields, trade.FieldPrice)
+	}
+	if m.fee != nil {
+		fields = append(fields, trade.FieldFee)
+	}
+	if m.currency != nil {
+		fields = append(fields, trade.FieldCurrency)
+	}
+	if m.fx_rate != nil {
+		fields = append(fields, trade.FieldFxRate)
+	}
+	if m.filled_quantity != nil {
+		fields = append(fields, trade.FieldFilledQuantity)
+	}
+	if m.proceeds != nil {
+		fields = append(fields, trade.FieldProceeds)
+	}
+	if m.cost_basis_fifo != nil {
+		fields = append(fields, trade.FieldCostBasisFifo)
+	}
+	if m.cost_basis_fifo_krw != nil {
+		fields = append(fields, trade.FieldCostBasisFifoKrw)
+	}
+	if m.cost_basis_avg != nil {
+		fields = append(fields, trade.FieldCostBasisAvg)
+	}
+	if m.cost_basis_avg_krw != nil {
+		fields = append(fields, trade.FieldCostBasisAvgKrw)
+	}
+	if m.estimated_basis != nil {
+		fields = append(fields, trade.FieldEstimatedBasis)
+	}
+	if m.position_after != nil {
+		fields = append(fields, trade.FieldPositionAfter)
+	}
+	if m.avg_cost_after != nil {
+		fields = append(fields, trade.FieldAvgCostAfter)
+	}
+	if m.avg_cost_krw_after != nil {
+		fields = append(fields, trade.FieldAvgCostKrwAfter)
+	}
+	if m.fx_pending != nil {
+		fields = append(fields, trade.FieldFxPending)
+	}
+	if m.executed_at != nil {
+		fields = append(fields, trade.FieldExecutedAt)
+	}
+	if m.created_at != nil {
+		fields = append(fields, trade.FieldCreatedAt)
 	}
 	return fields
 }
@@ -5519,24 +21854,56 @@ func (m *StrategyTemplateMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *StrategyTemplateMutation) Field(name string) (ent.Value, bool) {
+func (m *TradeMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case strategytemplate.FieldName:
-		return m.Name()
-	case strategytemplate.FieldDescription:
-		return m.Description()
-	case strategytemplate.FieldCategory:
-		return m.Category()
-	case strategytemplate.FieldTemplateConfig:
-		return m.TemplateConfig()
-	case strategytemplate.FieldInputSchema:
-		return m.InputSchema()
-	case strategytemplate.FieldVersion:
-		return m.Version()
-	case strategytemplate.FieldCreatedAt:
+	case trade.FieldUserID:
+		return m.UserID()
+	case trade.FieldClientOrderID:
+		return m.ClientOrderID()
+	case trade.FieldBrokerOrderID:
+		return m.BrokerOrderID()
+	case trade.FieldSymbol:
+		return m.Symbol()
+	case trade.FieldExchange:
+		return m.Exchange()
+	case trade.FieldSide:
+		return m.Side()
+	case trade.FieldQuantity:
+		return m.Quantity()
+	case trade.FieldPrice:
+		return m.Price()
+	case trade.FieldFee:
+		return m.Fee()
+	case trade.FieldCurrency:
+		return m.Currency()
+	case trade.FieldFxRate:
+		return m.FxRate()
+	case trade.FieldFilledQuantity:
+		return m.FilledQuantity()
+	case trade.FieldProceeds:
+		return m.Proceeds()
+	case trade.FieldCostBasisFifo:
+		return m.CostBasisFifo()
+	case trade.FieldCostBasisFifoKrw:
+		return m.CostBasisFifoKrw()
+	case trade.FieldCostBasisAvg:
+		return m.CostBasisAvg()
+	case trade.FieldCostBasisAvgKrw:
+		return m.CostBasisAvgKrw()
+	case trade.FieldEstimatedBasis:
+		return m.EstimatedBasis()
+	case trade.FieldPositionAfter:
+		return m.PositionAfter()
+	case trade.FieldAvgCostAfter:
+		return m.AvgCostAfter()
+	case trade.FieldAvgCostKrwAfter:
+		return m.AvgCostKrwAfter()
+	case trade.FieldFxPending:
+		return m.FxPending()
+	case trade.FieldExecutedAt:
+		return m.ExecutedAt()
+	case trade.FieldCreatedAt:
 		return m.CreatedAt()
-	case strategytemplate.FieldUpdatedAt:
-		return m.UpdatedAt()
 	}
 	return nil, false
 }
@@ -5544,257 +21911,419 @@ func (m *StrategyTemplateMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *StrategyTemplateMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *TradeMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case strategytemplate.FieldName:
-		return m.OldName(ctx)
-	case strategytemplate.FieldDescription:
-		return m.OldDescription(ctx)
-	case strategytemplate.FieldCategory:
-		return m.OldCategory(ctx)
-	case strategytemplate.FieldTemplateConfig:
-		return m.OldTemplateConfig(ctx)
-	case strategytemplate.FieldInputSchema:
-		return m.OldInputSchema(ctx)
-	case strategytemplate.FieldVersion:
-		return m.OldVersion(ctx)
-	case strategytemplate.FieldCreatedAt:
+	case trade.FieldUserID:
+		return m.OldUserID(ctx)
+	case trade.FieldClientOrderID:
+		return m.OldClientOrderID(ctx)
+	case trade.FieldBrokerOrderID:
+		return m.OldBrokerOrderID(ctx)
+	case trade.FieldSymbol:
+		return m.OldSymbol(ctx)
+	case trade.FieldExchange:
+		return m.OldExchange(ctx)
+	case trade.FieldSide:
+		return m.OldSide(ctx)
+	case trade.FieldQuantity:
+		return m.OldQuantity(ctx)
+	case trade.FieldPrice:
+		return m.OldPrice(ctx)
+	case trade.FieldFee:
+		return m.OldFee(ctx)
+	case trade.FieldCurrency:
+		return m.OldCurrency(ctx)
+	case trade.FieldFxRate:
+		return m.OldFxRate(ctx)
+	case trade.FieldFilledQuantity:
+		return m.OldFilledQuantity(ctx)
+	case trade.FieldProceeds:
+		return m.OldProceeds(ctx)
+	case trade.FieldCostBasisFifo:
+		return m.OldCostBasisFifo(ctx)
+	case trade.FieldCostBasisFifoKrw:
+		return m.OldCostBasisFifoKrw(ctx)
+	case trade.FieldCostBasisAvg:
+		return m.OldCostBasisAvg(ctx)
+	case trade.FieldCostBasisAvgKrw:
+		return m.OldCostBasisAvgKrw(ctx)
+	case trade.FieldEstimatedBasis:
+		return m.OldEstimatedBasis(ctx)
+	case trade.FieldPositionAfter:
+		return m.OldPositionAfter(ctx)
+	case trade.FieldAvgCostAfter:
+		return m.OldAvgCostAfter(ctx)
+	case trade.FieldAvgCostKrwAfter:
+		return m.OldAvgCostKrwAfter(ctx)
+	case trade.FieldFxPending:
+		return m.OldFxPending(ctx)
+	case trade.FieldExecutedAt:
+		return m.OldExecutedAt(ctx)
+	case trade.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
-	case strategytemplate.FieldUpdatedAt:
-		return m.OldUpdatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown StrategyTemplate field %s", name)
+	return nil, fmt.Errorf("unknown Trade field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyTemplateMutation) SetField(name string, value ent.Value) error {
+func (m *TradeMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case strategytemplate.FieldName:
+	case trade.FieldUserID:
+		v, ok := value.(uuid.UUID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetUserID(v)
+		return nil
+	case trade.FieldClientOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetClientOrderID(v)
+		return nil
+	case trade.FieldBrokerOrderID:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBrokerOrderID(v)
+		return nil
+	case trade.FieldSymbol:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSymbol(v)
+		return nil
+	case trade.FieldExchange:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetName(v)
+		m.SetExchange(v)
+		return nil
+	case trade.FieldSide:
+		v, ok := value.(trade.Side)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetSide(v)
 		return nil
-	case strategytemplate.FieldDescription:
-		v, ok := value.(string)
+	case trade.FieldQuantity:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetDescription(v)
+		m.SetQuantity(v)
 		return nil
-	case strategytemplate.FieldCategory:
+	case trade.FieldPrice:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPrice(v)
+		return nil
+	case trade.FieldFee:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFee(v)
+		return nil
+	case trade.FieldCurrency:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCategory(v)
+		m.SetCurrency(v)
 		return nil
-	case strategytemplate.FieldTemplateConfig:
-		v, ok := value.(map[string]interface{})
+	case trade.FieldFxRate:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetTemplateConfig(v)
+		m.SetFxRate(v)
 		return nil
-	case strategytemplate.FieldInputSchema:
-		v, ok := value.(map[string]interface{})
+	case trade.FieldFilledQuantity:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetInputSchema(v)
+		m.SetFilledQuantity(v)
 		return nil
-	case strategytemplate.FieldVersion:
-		v, ok := value.(string)
+	case trade.FieldProceeds:
+		v, ok := value.(decimal.Decimal)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetVersion(v)
+		m.SetProceeds(v)
 		return nil
-	case strategytemplate.FieldCreatedAt:
+	case trade.FieldCostBasisFifo:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostBasisFifo(v)
+		return nil
+	case trade.FieldCostBasisFifoKrw:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostBasisFifoKrw(v)
+		return nil
+	case trade.FieldCostBasisAvg:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostBasisAvg(v)
+		return nil
+	case trade.FieldCostBasisAvgKrw:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCostBasisAvgKrw(v)
+		return nil
+	case trade.FieldEstimatedBasis:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetEstimatedBasis(v)
+		return nil
+	case trade.FieldPositionAfter:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPositionAfter(v)
+		return nil
+	case trade.FieldAvgCostAfter:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgCostAfter(v)
+		return nil
+	case trade.FieldAvgCostKrwAfter:
+		v, ok := value.(decimal.Decimal)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvgCostKrwAfter(v)
+		return nil
+	case trade.FieldFxPending:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetFxPending(v)
+		return nil
+	case trade.FieldExecutedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCreatedAt(v)
+		m.SetExecutedAt(v)
 		return nil
-	case strategytemplate.FieldUpdatedAt:
+	case trade.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetUpdatedAt(v)
+		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyTemplate field %s", name)
+	return fmt.Errorf("unknown Trade field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *StrategyTemplateMutation) AddedFields() []string {
+func (m *TradeMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *StrategyTemplateMutation) AddedField(name string) (ent.Value, bool) {
+func (m *TradeMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *StrategyTemplateMutation) AddField(name string, value ent.Value) error {
+func (m *TradeMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown StrategyTemplate numeric field %s", name)
+	return fmt.Errorf("unknown Trade numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *StrategyTemplateMutation) ClearedFields() []string {
+func (m *TradeMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(strategytemplate.FieldDescription) {
-		fields = append(fields, strategytemplate.FieldDescription)
+	if m.FieldCleared(trade.FieldBrokerOrderID) {
+		fields = append(fields, trade.FieldBrokerOrderID)
+	}
+	if m.FieldCleared(trade.FieldExchange) {
+		fields = append(fields, trade.FieldExchange)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *StrategyTemplateMutation) FieldCleared(name string) bool {
+func (m *TradeMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *StrategyTemplateMutation) ClearField(name string) error {
+func (m *TradeMutation) ClearField(name string) error {
 	switch name {
-	case strategytemplate.FieldDescription:
-		m.ClearDescription()
+	case trade.FieldBrokerOrderID:
+		m.ClearBrokerOrderID()
+		return nil
+	case trade.FieldExchange:
+		m.ClearExchange()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyTemplate nullable field %s", name)
+	return fmt.Errorf("unknown Trade nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *StrategyTemplateMutation) ResetField(name string) error {
+func (m *TradeMutation) ResetField(name string) error {
 	switch name {
-	case strategytemplate.FieldName:
-		m.ResetName()
+	case trade.FieldUserID:
+		m.ResetUserID()
 		return nil
-	case strategytemplate.FieldDescription:
-		m.ResetDescription()
+	case trade.FieldClientOrderID:
+		m.ResetClientOrderID()
 		return nil
-	case strategytemplate.FieldCategory:
-		m.ResetCategory()
+	case trade.FieldBrokerOrderID:
+		m.ResetBrokerOrderID()
 		return nil
-	case strategytemplate.FieldTemplateConfig:
-		m.ResetTemplateConfig()
+	case trade.FieldSymbol:
+		m.ResetSymbol()
 		return nil
-	case strategytemplate.FieldInputSchema:
-		m.ResetInputSchema()
+	case trade.FieldExchange:
+		m.ResetExchange()
 		return nil
-	case strategytemplate.FieldVersion:
-		m.ResetVersion()
+	case trade.FieldSide:
+		m.ResetSide()
 		return nil
-	case strategytemplate.FieldCreatedAt:
-		m.ResetCreatedAt()
+	case trade.FieldQuantity:
+		m.ResetQuantity()
 		return nil
-	case strategytemplate.FieldUpdatedAt:
-		m.ResetUpdatedAt()
+	case trade.FieldPrice:
+		m.ResetPrice()
+		return nil
+	case trade.FieldFee:
+		m.ResetFee()
+		return nil
+	case trade.FieldCurrency:
+		m.ResetCurrency()
+		return nil
+	case trade.FieldFxRate:
+		m.ResetFxRate()
+		return nil
+	case trade.FieldFilledQuantity:
+		m.ResetFilledQuantity()
+		return nil
+	case trade.FieldProceeds:
+		m.ResetProceeds()
+		return nil
+	case trade.FieldCostBasisFifo:
+		m.ResetCostBasisFifo()
+		return nil
+	case trade.FieldCostBasisFifoKrw:
+		m.ResetCostBasisFifoKrw()
+		return nil
+	case trade.FieldCostBasisAvg:
+		m.ResetCostBasisAvg()
+		return nil
+	case trade.FieldCostBasisAvgKrw:
+		m.ResetCostBasisAvgKrw()
+		return nil
+	case trade.FieldEstimatedBasis:
+		m.ResetEstimatedBasis()
+		return nil
+	case trade.FieldPositionAfter:
+		m.ResetPositionAfter()
+		return nil
+	case trade.FieldAvgCostAfter:
+		m.ResetAvgCostAfter()
+		return nil
+	case trade.FieldAvgCostKrwAfter:
+		m.ResetAvgCostKrwAfter()
+		return nil
+	case trade.FieldFxPending:
+		m.ResetFxPending()
+		return nil
+	case trade.FieldExecutedAt:
+		m.ResetExecutedAt()
+		return nil
+	case trade.FieldCreatedAt:
+		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown StrategyTemplate field %s", name)
+	return fmt.Errorf("unknown Trade field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *StrategyTemplateMutation) AddedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.strategies != nil {
-		edges = append(edges, strategytemplate.EdgeStrategies)
-	}
+func (m *TradeMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *StrategyTemplateMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case strategytemplate.EdgeStrategies:
-		ids := make([]ent.Value, 0, len(m.strategies))
-		for id := range m.strategies {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TradeMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *StrategyTemplateMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.removedstrategies != nil {
-		edges = append(edges, strategytemplate.EdgeStrategies)
-	}
+func (m *TradeMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *StrategyTemplateMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case strategytemplate.EdgeStrategies:
-		ids := make([]ent.Value, 0, len(m.removedstrategies))
-		for id := range m.removedstrategies {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *TradeMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *StrategyTemplateMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 1)
-	if m.clearedstrategies {
-		edges = append(edges, strategytemplate.EdgeStrategies)
-	}
+func (m *TradeMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *StrategyTemplateMutation) EdgeCleared(name string) bool {
-	switch name {
-	case strategytemplate.EdgeStrategies:
-		return m.clearedstrategies
-	}
+func (m *TradeMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *StrategyTemplateMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown StrategyTemplate unique edge %s", name)
+func (m *TradeMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Trade unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *StrategyTemplateMutation) ResetEdge(name string) error {
-	switch name {
-	case strategytemplate.EdgeStrategies:
-		m.ResetStrategies()
-		return nil
-	}
-	return fmt.Errorf("unknown StrategyTemplate edge %s", name)
+func (m *TradeMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Trade edge %s", name)
 }
 
 // UserMutation represents an operation that mutates the User nodes in the graph.
@@ -5808,6 +22337,7 @@ type UserMutation struct {
 	email             *string
 	password          *string
 	is_valid          *bool
+	is_admin          *bool
 	created_at        *time.Time
 	updated_at        *time.Time
 	clearedFields     map[string]struct{}
@@ -5817,6 +22347,9 @@ type UserMutation struct {
 	portfolios        map[uuid.UUID]struct{}
 	removedportfolios map[uuid.UUID]struct{}
 	clearedportfolios bool
+	orders            map[uuid.UUID]struct{}
+	removedorders     map[uuid.UUID]struct{}
+	clearedorders     bool
 	done              bool
 	oldValue          func(context.Context) (*User, error)
 	predicates        []predicate.User
@@ -6106,6 +22639,42 @@ func (m *UserMutation) ResetIsValid() {
 	m.is_valid = nil
 }
 
+// SetIsAdmin sets the "is_admin" field.
+func (m *UserMutation) SetIsAdmin(b bool) {
+	m.is_admin = &b
+}
+
+// IsAdmin returns the value of the "is_admin" field in the mutation.
+func (m *UserMutation) IsAdmin() (r bool, exists bool) {
+	v := m.is_admin
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsAdmin returns the old "is_admin" field's value of the User entity.
+// If the User object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *UserMutation) OldIsAdmin(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsAdmin is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsAdmin requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsAdmin: %w", err)
+	}
+	return oldValue.IsAdmin, nil
+}
+
+// ResetIsAdmin resets all changes to the "is_admin" field.
+func (m *UserMutation) ResetIsAdmin() {
+	m.is_admin = nil
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (m *UserMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
@@ -6312,6 +22881,60 @@ func (m *UserMutation) ResetPortfolios() {
 	m.removedportfolios = nil
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by ids.
+func (m *UserMutation) AddOrderIDs(ids ...uuid.UUID) {
+	if m.orders == nil {
+		m.orders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		m.orders[ids[i]] = struct{}{}
+	}
+}
+
+// ClearOrders clears the "orders" edge to the Order entity.
+func (m *UserMutation) ClearOrders() {
+	m.clearedorders = true
+}
+
+// OrdersCleared reports if the "orders" edge to the Order entity was cleared.
+func (m *UserMutation) OrdersCleared() bool {
+	return m.clearedorders
+}
+
+// RemoveOrderIDs removes the "orders" edge to the Order entity by IDs.
+func (m *UserMutation) RemoveOrderIDs(ids ...uuid.UUID) {
+	if m.removedorders == nil {
+		m.removedorders = make(map[uuid.UUID]struct{})
+	}
+	for i := range ids {
+		delete(m.orders, ids[i])
+		m.removedorders[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedOrders returns the removed IDs of the "orders" edge to the Order entity.
+func (m *UserMutation) RemovedOrdersIDs() (ids []uuid.UUID) {
+	for id := range m.removedorders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// OrdersIDs returns the "orders" edge IDs in the mutation.
+func (m *UserMutation) OrdersIDs() (ids []uuid.UUID) {
+	for id := range m.orders {
+		ids = append(ids, id)
+	}
+	return
+}
+
+// ResetOrders resets all changes to the "orders" edge.
+func (m *UserMutation) ResetOrders() {
+	m.orders = nil
+	m.clearedorders = false
+	m.removedorders = nil
+}
+
 // Where appends a list predicates to the UserMutation builder.
 func (m *UserMutation) Where(ps ...predicate.User) {
 	m.predicates = append(m.predicates, ps...)
@@ -6346,7 +22969,7 @@ func (m *UserMutation) Type() string {
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
 func (m *UserMutation) Fields() []string {
-	fields := make([]string, 0, 7)
+	fields := make([]string, 0, 8)
 	if m.name != nil {
 		fields = append(fields, user.FieldName)
 	}
@@ -6362,6 +22985,9 @@ func (m *UserMutation) Fields() []string {
 	if m.is_valid != nil {
 		fields = append(fields, user.FieldIsValid)
 	}
+	if m.is_admin != nil {
+		fields = append(fields, user.FieldIsAdmin)
+	}
 	if m.created_at != nil {
 		fields = append(fields, user.FieldCreatedAt)
 	}
@@ -6386,6 +23012,8 @@ func (m *UserMutation) Field(name string) (ent.Value, bool) {
 		return m.Password()
 	case user.FieldIsValid:
 		return m.IsValid()
+	case user.FieldIsAdmin:
+		return m.IsAdmin()
 	case user.FieldCreatedAt:
 		return m.CreatedAt()
 	case user.FieldUpdatedAt:
@@ -6409,6 +23037,8 @@ func (m *UserMutation) OldField(ctx context.Context, name string) (ent.Value, er
 		return m.OldPassword(ctx)
 	case user.FieldIsValid:
 		return m.OldIsValid(ctx)
+	case user.FieldIsAdmin:
+		return m.OldIsAdmin(ctx)
 	case user.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	case user.FieldUpdatedAt:
@@ -6457,6 +23087,13 @@ func (m *UserMutation) SetField(name string, value ent.Value) error {
 		}
 		m.SetIsValid(v)
 		return nil
+	case user.FieldIsAdmin:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsAdmin(v)
+		return nil
 	case user.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
@@ -6550,6 +23187,9 @@ func (m *UserMutation) ResetField(name string) error {
 	case user.FieldIsValid:
 		m.ResetIsValid()
 		return nil
+	case user.FieldIsAdmin:
+		m.ResetIsAdmin()
+		return nil
 	case user.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
@@ -6562,13 +23202,16 @@ func (m *UserMutation) ResetField(name string) error {
 
 // AddedEdges returns all edge names that were set/added in this mutation.
 func (m *UserMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.strategies != nil {
 		edges = append(edges, user.EdgeStrategies)
 	}
 	if m.portfolios != nil {
 		edges = append(edges, user.EdgePortfolios)
 	}
+	if m.orders != nil {
+		edges = append(edges, user.EdgeOrders)
+	}
 	return edges
 }
 
@@ -6588,19 +23231,28 @@ func (m *UserMutation) AddedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.orders))
+		for id := range m.orders {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
 func (m *UserMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.removedstrategies != nil {
 		edges = append(edges, user.EdgeStrategies)
 	}
 	if m.removedportfolios != nil {
 		edges = append(edges, user.EdgePortfolios)
 	}
+	if m.removedorders != nil {
+		edges = append(edges, user.EdgeOrders)
+	}
 	return edges
 }
 
@@ -6620,19 +23272,28 @@ func (m *UserMutation) RemovedIDs(name string) []ent.Value {
 			ids = append(ids, id)
 		}
 		return ids
+	case user.EdgeOrders:
+		ids := make([]ent.Value, 0, len(m.removedorders))
+		for id := range m.removedorders {
+			ids = append(ids, id)
+		}
+		return ids
 	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
 func (m *UserMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
+	edges := make([]string, 0, 3)
 	if m.clearedstrategies {
 		edges = append(edges, user.EdgeStrategies)
 	}
 	if m.clearedportfolios {
 		edges = append(edges, user.EdgePortfolios)
 	}
+	if m.clearedorders {
+		edges = append(edges, user.EdgeOrders)
+	}
 	return edges
 }
 
@@ -6644,6 +23305,8 @@ func (m *UserMutation) EdgeCleared(name string) bool {
 		return m.clearedstrategies
 	case user.EdgePortfolios:
 		return m.clearedportfolios
+	case user.EdgeOrders:
+		return m.clearedorders
 	}
 	return false
 }
@@ -6666,6 +23329,9 @@ func (m *UserMutation) ResetEdge(name string) error {
 	case user.EdgePortfolios:
 		m.ResetPortfolios()
 		return nil
+	case user.EdgeOrders:
+		m.ResetOrders()
+		return nil
 	}
 	return fmt.Errorf("unknown User edge %s", name)
 }
@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/order"
+	"auto-trader/ent/strategy"
+	"auto-trader/ent/user"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Order is the model entity for the Order schema.
+type Order struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID *uuid.UUID `json:"strategy_id,omitempty"`
+	// ClientOrderID holds the value of the "client_order_id" field.
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	// BrokerOrderID holds the value of the "broker_order_id" field.
+	BrokerOrderID *string `json:"broker_order_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Mode holds the value of the "mode" field.
+	Mode order.Mode `json:"mode,omitempty"`
+	// Side holds the value of the "side" field.
+	Side order.Side `json:"side,omitempty"`
+	// OrderType holds the value of the "order_type" field.
+	OrderType order.OrderType `json:"order_type,omitempty"`
+	// TimeInForce holds the value of the "time_in_force" field.
+	TimeInForce order.TimeInForce `json:"time_in_force,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Price holds the value of the "price" field.
+	Price *decimal.Decimal `json:"price,omitempty"`
+	// FilledQuantity holds the value of the "filled_quantity" field.
+	FilledQuantity decimal.Decimal `json:"filled_quantity,omitempty"`
+	// AvgFillPrice holds the value of the "avg_fill_price" field.
+	AvgFillPrice *decimal.Decimal `json:"avg_fill_price,omitempty"`
+	// Status holds the value of the "status" field.
+	Status order.Status `json:"status,omitempty"`
+	// RejectReason holds the value of the "reject_reason" field.
+	RejectReason *string `json:"reject_reason,omitempty"`
+	// SubmittedAt holds the value of the "submitted_at" field.
+	SubmittedAt *time.Time `json:"submitted_at,omitempty"`
+	// CompletedAt holds the value of the "completed_at" field.
+	CompletedAt *time.Time `json:"completed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the OrderQuery when eager-loading is set.
+	Edges        OrderEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// OrderEdges holds the relations/edges for other nodes in the graph.
+type OrderEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Strategy holds the value of the strategy edge.
+	Strategy *Strategy `json:"strategy,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// StrategyOrErr returns the Strategy value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e OrderEdges) StrategyOrErr() (*Strategy, error) {
+	if e.Strategy != nil {
+		return e.Strategy, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: strategy.Label}
+	}
+	return nil, &NotLoadedError{edge: "strategy"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Order) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case order.FieldPrice, order.FieldAvgFillPrice:
+			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
+		case order.FieldStrategyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case order.FieldQuantity, order.FieldFilledQuantity:
+			values[i] = new(decimal.Decimal)
+		case order.FieldClientOrderID, order.FieldBrokerOrderID, order.FieldSymbol, order.FieldExchange, order.FieldMode, order.FieldSide, order.FieldOrderType, order.FieldTimeInForce, order.FieldStatus, order.FieldRejectReason:
+			values[i] = new(sql.NullString)
+		case order.FieldSubmittedAt, order.FieldCompletedAt, order.FieldCreatedAt, order.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case order.FieldID, order.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Order fields.
+func (_m *Order) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case order.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case order.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case order.FieldStrategyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value.Valid {
+				_m.StrategyID = new(uuid.UUID)
+				*_m.StrategyID = *value.S.(*uuid.UUID)
+			}
+		case order.FieldClientOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_order_id", values[i])
+			} else if value.Valid {
+				_m.ClientOrderID = value.String
+			}
+		case order.FieldBrokerOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field broker_order_id", values[i])
+			} else if value.Valid {
+				_m.BrokerOrderID = new(string)
+				*_m.BrokerOrderID = value.String
+			}
+		case order.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case order.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case order.FieldMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mode", values[i])
+			} else if value.Valid {
+				_m.Mode = order.Mode(value.String)
+			}
+		case order.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = order.Side(value.String)
+			}
+		case order.FieldOrderType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field order_type", values[i])
+			} else if value.Valid {
+				_m.OrderType = order.OrderType(value.String)
+			}
+		case order.FieldTimeInForce:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field time_in_force", values[i])
+			} else if value.Valid {
+				_m.TimeInForce = order.TimeInForce(value.String)
+			}
+		case order.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case order.FieldPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value.Valid {
+				_m.Price = new(decimal.Decimal)
+				*_m.Price = *value.S.(*decimal.Decimal)
+			}
+		case order.FieldFilledQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field filled_quantity", values[i])
+			} else if value != nil {
+				_m.FilledQuantity = *value
+			}
+		case order.FieldAvgFillPrice:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_fill_price", values[i])
+			} else if value.Valid {
+				_m.AvgFillPrice = new(decimal.Decimal)
+				*_m.AvgFillPrice = *value.S.(*decimal.Decimal)
+			}
+		case order.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				_m.Status = order.Status(value.String)
+			}
+		case order.FieldRejectReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reject_reason", values[i])
+			} else if value.Valid {
+				_m.RejectReason = new(string)
+				*_m.RejectReason = value.String
+			}
+		case order.FieldSubmittedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field submitted_at", values[i])
+			} else if value.Valid {
+				_m.SubmittedAt = new(time.Time)
+				*_m.SubmittedAt = value.Time
+			}
+		case order.FieldCompletedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field completed_at", values[i])
+			} else if value.Valid {
+				_m.CompletedAt = new(time.Time)
+				*_m.CompletedAt = value.Time
+			}
+		case order.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case order.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Order.
+// This includes values selected through modifiers, order, etc.
+func (_m *Order) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Order entity.
+func (_m *Order) QueryUser() *UserQuery {
+	return NewOrderClient(_m.config).QueryUser(_m)
+}
+
+// QueryStrategy queries the "strategy" edge of the Order entity.
+func (_m *Order) QueryStrategy() *StrategyQuery {
+	return NewOrderClient(_m.config).QueryStrategy(_m)
+}
+
+// Update returns a builder for updating this Order.
+// Note that you need to call Order.Unwrap() before calling this method if this Order
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Order) Update() *OrderUpdateOne {
+	return NewOrderClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Order entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Order) Unwrap() *Order {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Order is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Order) String() string {
+	var builder strings.Builder
+	builder.WriteString("Order(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	if v := _m.StrategyID; v != nil {
+		builder.WriteString("strategy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_order_id=")
+	builder.WriteString(_m.ClientOrderID)
+	builder.WriteString(", ")
+	if v := _m.BrokerOrderID; v != nil {
+		builder.WriteString("broker_order_id=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Mode))
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Side))
+	builder.WriteString(", ")
+	builder.WriteString("order_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OrderType))
+	builder.WriteString(", ")
+	builder.WriteString("time_in_force=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TimeInForce))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	if v := _m.Price; v != nil {
+		builder.WriteString("price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("filled_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilledQuantity))
+	builder.WriteString(", ")
+	if v := _m.AvgFillPrice; v != nil {
+		builder.WriteString("avg_fill_price=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Status))
+	builder.WriteString(", ")
+	if v := _m.RejectReason; v != nil {
+		builder.WriteString("reject_reason=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := _m.SubmittedAt; v != nil {
+		builder.WriteString("submitted_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CompletedAt; v != nil {
+		builder.WriteString("completed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Orders is a parsable slice of Order.
+type Orders []*Order
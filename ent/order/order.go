@@ -0,0 +1,404 @@
+// Code generated by ent, DO NOT EDIT.
+
+package order
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the order type in the database.
+	Label = "order"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldClientOrderID holds the string denoting the client_order_id field in the database.
+	FieldClientOrderID = "client_order_id"
+	// FieldBrokerOrderID holds the string denoting the broker_order_id field in the database.
+	FieldBrokerOrderID = "broker_order_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldMode holds the string denoting the mode field in the database.
+	FieldMode = "mode"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldOrderType holds the string denoting the order_type field in the database.
+	FieldOrderType = "order_type"
+	// FieldTimeInForce holds the string denoting the time_in_force field in the database.
+	FieldTimeInForce = "time_in_force"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldFilledQuantity holds the string denoting the filled_quantity field in the database.
+	FieldFilledQuantity = "filled_quantity"
+	// FieldAvgFillPrice holds the string denoting the avg_fill_price field in the database.
+	FieldAvgFillPrice = "avg_fill_price"
+	// FieldStatus holds the string denoting the status field in the database.
+	FieldStatus = "status"
+	// FieldRejectReason holds the string denoting the reject_reason field in the database.
+	FieldRejectReason = "reject_reason"
+	// FieldSubmittedAt holds the string denoting the submitted_at field in the database.
+	FieldSubmittedAt = "submitted_at"
+	// FieldCompletedAt holds the string denoting the completed_at field in the database.
+	FieldCompletedAt = "completed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgeUser holds the string denoting the user edge name in mutations.
+	EdgeUser = "user"
+	// EdgeStrategy holds the string denoting the strategy edge name in mutations.
+	EdgeStrategy = "strategy"
+	// Table holds the table name of the order in the database.
+	Table = "orders"
+	// UserTable is the table that holds the user relation/edge.
+	UserTable = "orders"
+	// UserInverseTable is the table name for the User entity.
+	// It exists in this package in order to avoid circular dependency with the "user" package.
+	UserInverseTable = "users"
+	// UserColumn is the table column denoting the user relation/edge.
+	UserColumn = "user_id"
+	// StrategyTable is the table that holds the strategy relation/edge.
+	StrategyTable = "orders"
+	// StrategyInverseTable is the table name for the Strategy entity.
+	// It exists in this package in order to avoid circular dependency with the "strategy" package.
+	StrategyInverseTable = "strategies"
+	// StrategyColumn is the table column denoting the strategy relation/edge.
+	StrategyColumn = "strategy_id"
+)
+
+// Columns holds all SQL columns for order fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldStrategyID,
+	FieldClientOrderID,
+	FieldBrokerOrderID,
+	FieldSymbol,
+	FieldExchange,
+	FieldMode,
+	FieldSide,
+	FieldOrderType,
+	FieldTimeInForce,
+	FieldQuantity,
+	FieldPrice,
+	FieldFilledQuantity,
+	FieldAvgFillPrice,
+	FieldStatus,
+	FieldRejectReason,
+	FieldSubmittedAt,
+	FieldCompletedAt,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	ClientOrderIDValidator func(string) error
+	// BrokerOrderIDValidator is a validator for the "broker_order_id" field. It is called by the builders before save.
+	BrokerOrderIDValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// DefaultFilledQuantity holds the default value on creation for the "filled_quantity" field.
+	DefaultFilledQuantity decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Mode defines the type for the "mode" enum field.
+type Mode string
+
+// ModeLIVE is the default value of the Mode enum.
+const DefaultMode = ModeLIVE
+
+// Mode values.
+const (
+	ModeLIVE  Mode = "LIVE"
+	ModePAPER Mode = "PAPER"
+)
+
+func (m Mode) String() string {
+	return string(m)
+}
+
+// ModeValidator is a validator for the "mode" field enum values. It is called by the builders before save.
+func ModeValidator(m Mode) error {
+	switch m {
+	case ModeLIVE, ModePAPER:
+		return nil
+	default:
+		return fmt.Errorf("order: invalid enum value for mode field: %q", m)
+	}
+}
+
+// Side defines the type for the "side" enum field.
+type Side string
+
+// Side values.
+const (
+	SideBUY  Side = "BUY"
+	SideSELL Side = "SELL"
+)
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// SideValidator is a validator for the "side" field enum values. It is called by the builders before save.
+func SideValidator(s Side) error {
+	switch s {
+	case SideBUY, SideSELL:
+		return nil
+	default:
+		return fmt.Errorf("order: invalid enum value for side field: %q", s)
+	}
+}
+
+// OrderType defines the type for the "order_type" enum field.
+type OrderType string
+
+// OrderType values.
+const (
+	OrderTypeMARKET OrderType = "MARKET"
+	OrderTypeLIMIT  OrderType = "LIMIT"
+	OrderTypeMOO    OrderType = "MOO"
+	OrderTypeLOO    OrderType = "LOO"
+	OrderTypeMOC    OrderType = "MOC"
+	OrderTypeLOC    OrderType = "LOC"
+)
+
+func (ot OrderType) String() string {
+	return string(ot)
+}
+
+// OrderTypeValidator is a validator for the "order_type" field enum values. It is called by the builders before save.
+func OrderTypeValidator(ot OrderType) error {
+	switch ot {
+	case OrderTypeMARKET, OrderTypeLIMIT, OrderTypeMOO, OrderTypeLOO, OrderTypeMOC, OrderTypeLOC:
+		return nil
+	default:
+		return fmt.Errorf("order: invalid enum value for order_type field: %q", ot)
+	}
+}
+
+// TimeInForce defines the type for the "time_in_force" enum field.
+type TimeInForce string
+
+// TimeInForceDAY is the default value of the TimeInForce enum.
+const DefaultTimeInForce = TimeInForceDAY
+
+// TimeInForce values.
+const (
+	TimeInForceDAY TimeInForce = "DAY"
+	TimeInForceIOC TimeInForce = "IOC"
+)
+
+func (tif TimeInForce) String() string {
+	return string(tif)
+}
+
+// TimeInForceValidator is a validator for the "time_in_force" field enum values. It is called by the builders before save.
+func TimeInForceValidator(tif TimeInForce) error {
+	switch tif {
+	case TimeInForceDAY, TimeInForceIOC:
+		return nil
+	default:
+		return fmt.Errorf("order: invalid enum value for time_in_force field: %q", tif)
+	}
+}
+
+// Status defines the type for the "status" enum field.
+type Status string
+
+// StatusNEW is the default value of the Status enum.
+const DefaultStatus = StatusNEW
+
+// Status values.
+const (
+	StatusNEW              Status = "NEW"
+	StatusSUBMITTED        Status = "SUBMITTED"
+	StatusPARTIALLY_FILLED Status = "PARTIALLY_FILLED"
+	StatusFILLED           Status = "FILLED"
+	StatusCANCELED         Status = "CANCELED"
+	StatusREJECTED         Status = "REJECTED"
+)
+
+func (s Status) String() string {
+	return string(s)
+}
+
+// StatusValidator is a validator for the "status" field enum values. It is called by the builders before save.
+func StatusValidator(s Status) error {
+	switch s {
+	case StatusNEW, StatusSUBMITTED, StatusPARTIALLY_FILLED, StatusFILLED, StatusCANCELED, StatusREJECTED:
+		return nil
+	default:
+		return fmt.Errorf("order: invalid enum value for status field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Order queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// ByClientOrderID orders the results by the client_order_id field.
+func ByClientOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientOrderID, opts...).ToFunc()
+}
+
+// ByBrokerOrderID orders the results by the broker_order_id field.
+func ByBrokerOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBrokerOrderID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByMode orders the results by the mode field.
+func ByMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMode, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByOrderType orders the results by the order_type field.
+func ByOrderType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOrderType, opts...).ToFunc()
+}
+
+// ByTimeInForce orders the results by the time_in_force field.
+func ByTimeInForce(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTimeInForce, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByFilledQuantity orders the results by the filled_quantity field.
+func ByFilledQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilledQuantity, opts...).ToFunc()
+}
+
+// ByAvgFillPrice orders the results by the avg_fill_price field.
+func ByAvgFillPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgFillPrice, opts...).ToFunc()
+}
+
+// ByStatus orders the results by the status field.
+func ByStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStatus, opts...).ToFunc()
+}
+
+// ByRejectReason orders the results by the reject_reason field.
+func ByRejectReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRejectReason, opts...).ToFunc()
+}
+
+// BySubmittedAt orders the results by the submitted_at field.
+func BySubmittedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSubmittedAt, opts...).ToFunc()
+}
+
+// ByCompletedAt orders the results by the completed_at field.
+func ByCompletedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCompletedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByUserField orders the results by user field.
+func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByStrategyField orders the results by strategy field.
+func ByStrategyField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newStrategyStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newUserStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(UserInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+	)
+}
+func newStrategyStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(StrategyInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, StrategyTable, StrategyColumn),
+	)
+}
@@ -0,0 +1,1079 @@
+// Code generated by ent, DO NOT EDIT.
+
+package order
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUserID, v))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// ClientOrderID applies equality check predicate on the "client_order_id" field. It's identical to ClientOrderIDEQ.
+func ClientOrderID(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// BrokerOrderID applies equality check predicate on the "broker_order_id" field. It's identical to BrokerOrderIDEQ.
+func BrokerOrderID(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldBrokerOrderID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldExchange, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPrice, v))
+}
+
+// FilledQuantity applies equality check predicate on the "filled_quantity" field. It's identical to FilledQuantityEQ.
+func FilledQuantity(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFilledQuantity, v))
+}
+
+// AvgFillPrice applies equality check predicate on the "avg_fill_price" field. It's identical to AvgFillPriceEQ.
+func AvgFillPrice(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAvgFillPrice, v))
+}
+
+// RejectReason applies equality check predicate on the "reject_reason" field. It's identical to RejectReasonEQ.
+func RejectReason(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// SubmittedAt applies equality check predicate on the "submitted_at" field. It's identical to SubmittedAtEQ.
+func SubmittedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// CompletedAt applies equality check predicate on the "completed_at" field. It's identical to CompletedAtEQ.
+func CompletedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...uuid.UUID) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDIsNil applies the IsNil predicate on the "strategy_id" field.
+func StrategyIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldStrategyID))
+}
+
+// StrategyIDNotNil applies the NotNil predicate on the "strategy_id" field.
+func StrategyIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldStrategyID))
+}
+
+// ClientOrderIDEQ applies the EQ predicate on the "client_order_id" field.
+func ClientOrderIDEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDNEQ applies the NEQ predicate on the "client_order_id" field.
+func ClientOrderIDNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDIn applies the In predicate on the "client_order_id" field.
+func ClientOrderIDIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDNotIn applies the NotIn predicate on the "client_order_id" field.
+func ClientOrderIDNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDGT applies the GT predicate on the "client_order_id" field.
+func ClientOrderIDGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDGTE applies the GTE predicate on the "client_order_id" field.
+func ClientOrderIDGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLT applies the LT predicate on the "client_order_id" field.
+func ClientOrderIDLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLTE applies the LTE predicate on the "client_order_id" field.
+func ClientOrderIDLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContains applies the Contains predicate on the "client_order_id" field.
+func ClientOrderIDContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasPrefix applies the HasPrefix predicate on the "client_order_id" field.
+func ClientOrderIDHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasSuffix applies the HasSuffix predicate on the "client_order_id" field.
+func ClientOrderIDHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDEqualFold applies the EqualFold predicate on the "client_order_id" field.
+func ClientOrderIDEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContainsFold applies the ContainsFold predicate on the "client_order_id" field.
+func ClientOrderIDContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldClientOrderID, v))
+}
+
+// BrokerOrderIDEQ applies the EQ predicate on the "broker_order_id" field.
+func BrokerOrderIDEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDNEQ applies the NEQ predicate on the "broker_order_id" field.
+func BrokerOrderIDNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDIn applies the In predicate on the "broker_order_id" field.
+func BrokerOrderIDIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldBrokerOrderID, vs...))
+}
+
+// BrokerOrderIDNotIn applies the NotIn predicate on the "broker_order_id" field.
+func BrokerOrderIDNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldBrokerOrderID, vs...))
+}
+
+// BrokerOrderIDGT applies the GT predicate on the "broker_order_id" field.
+func BrokerOrderIDGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDGTE applies the GTE predicate on the "broker_order_id" field.
+func BrokerOrderIDGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDLT applies the LT predicate on the "broker_order_id" field.
+func BrokerOrderIDLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDLTE applies the LTE predicate on the "broker_order_id" field.
+func BrokerOrderIDLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDContains applies the Contains predicate on the "broker_order_id" field.
+func BrokerOrderIDContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDHasPrefix applies the HasPrefix predicate on the "broker_order_id" field.
+func BrokerOrderIDHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDHasSuffix applies the HasSuffix predicate on the "broker_order_id" field.
+func BrokerOrderIDHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDIsNil applies the IsNil predicate on the "broker_order_id" field.
+func BrokerOrderIDIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldBrokerOrderID))
+}
+
+// BrokerOrderIDNotNil applies the NotNil predicate on the "broker_order_id" field.
+func BrokerOrderIDNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldBrokerOrderID))
+}
+
+// BrokerOrderIDEqualFold applies the EqualFold predicate on the "broker_order_id" field.
+func BrokerOrderIDEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDContainsFold applies the ContainsFold predicate on the "broker_order_id" field.
+func BrokerOrderIDContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldBrokerOrderID, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeIsNil applies the IsNil predicate on the "exchange" field.
+func ExchangeIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldExchange))
+}
+
+// ExchangeNotNil applies the NotNil predicate on the "exchange" field.
+func ExchangeNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldExchange))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// ModeEQ applies the EQ predicate on the "mode" field.
+func ModeEQ(v Mode) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldMode, v))
+}
+
+// ModeNEQ applies the NEQ predicate on the "mode" field.
+func ModeNEQ(v Mode) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldMode, v))
+}
+
+// ModeIn applies the In predicate on the "mode" field.
+func ModeIn(vs ...Mode) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldMode, vs...))
+}
+
+// ModeNotIn applies the NotIn predicate on the "mode" field.
+func ModeNotIn(vs ...Mode) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldMode, vs...))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// OrderTypeEQ applies the EQ predicate on the "order_type" field.
+func OrderTypeEQ(v OrderType) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldOrderType, v))
+}
+
+// OrderTypeNEQ applies the NEQ predicate on the "order_type" field.
+func OrderTypeNEQ(v OrderType) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldOrderType, v))
+}
+
+// OrderTypeIn applies the In predicate on the "order_type" field.
+func OrderTypeIn(vs ...OrderType) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldOrderType, vs...))
+}
+
+// OrderTypeNotIn applies the NotIn predicate on the "order_type" field.
+func OrderTypeNotIn(vs ...OrderType) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldOrderType, vs...))
+}
+
+// TimeInForceEQ applies the EQ predicate on the "time_in_force" field.
+func TimeInForceEQ(v TimeInForce) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldTimeInForce, v))
+}
+
+// TimeInForceNEQ applies the NEQ predicate on the "time_in_force" field.
+func TimeInForceNEQ(v TimeInForce) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldTimeInForce, v))
+}
+
+// TimeInForceIn applies the In predicate on the "time_in_force" field.
+func TimeInForceIn(vs ...TimeInForce) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldTimeInForce, vs...))
+}
+
+// TimeInForceNotIn applies the NotIn predicate on the "time_in_force" field.
+func TimeInForceNotIn(vs ...TimeInForce) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldTimeInForce, vs...))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldPrice, v))
+}
+
+// PriceIsNil applies the IsNil predicate on the "price" field.
+func PriceIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldPrice))
+}
+
+// PriceNotNil applies the NotNil predicate on the "price" field.
+func PriceNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldPrice))
+}
+
+// FilledQuantityEQ applies the EQ predicate on the "filled_quantity" field.
+func FilledQuantityEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldFilledQuantity, v))
+}
+
+// FilledQuantityNEQ applies the NEQ predicate on the "filled_quantity" field.
+func FilledQuantityNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldFilledQuantity, v))
+}
+
+// FilledQuantityIn applies the In predicate on the "filled_quantity" field.
+func FilledQuantityIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldFilledQuantity, vs...))
+}
+
+// FilledQuantityNotIn applies the NotIn predicate on the "filled_quantity" field.
+func FilledQuantityNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldFilledQuantity, vs...))
+}
+
+// FilledQuantityGT applies the GT predicate on the "filled_quantity" field.
+func FilledQuantityGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldFilledQuantity, v))
+}
+
+// FilledQuantityGTE applies the GTE predicate on the "filled_quantity" field.
+func FilledQuantityGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldFilledQuantity, v))
+}
+
+// FilledQuantityLT applies the LT predicate on the "filled_quantity" field.
+func FilledQuantityLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldFilledQuantity, v))
+}
+
+// FilledQuantityLTE applies the LTE predicate on the "filled_quantity" field.
+func FilledQuantityLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldFilledQuantity, v))
+}
+
+// AvgFillPriceEQ applies the EQ predicate on the "avg_fill_price" field.
+func AvgFillPriceEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceNEQ applies the NEQ predicate on the "avg_fill_price" field.
+func AvgFillPriceNEQ(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceIn applies the In predicate on the "avg_fill_price" field.
+func AvgFillPriceIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldAvgFillPrice, vs...))
+}
+
+// AvgFillPriceNotIn applies the NotIn predicate on the "avg_fill_price" field.
+func AvgFillPriceNotIn(vs ...decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldAvgFillPrice, vs...))
+}
+
+// AvgFillPriceGT applies the GT predicate on the "avg_fill_price" field.
+func AvgFillPriceGT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceGTE applies the GTE predicate on the "avg_fill_price" field.
+func AvgFillPriceGTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceLT applies the LT predicate on the "avg_fill_price" field.
+func AvgFillPriceLT(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceLTE applies the LTE predicate on the "avg_fill_price" field.
+func AvgFillPriceLTE(v decimal.Decimal) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldAvgFillPrice, v))
+}
+
+// AvgFillPriceIsNil applies the IsNil predicate on the "avg_fill_price" field.
+func AvgFillPriceIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldAvgFillPrice))
+}
+
+// AvgFillPriceNotNil applies the NotNil predicate on the "avg_fill_price" field.
+func AvgFillPriceNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldAvgFillPrice))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// RejectReasonEQ applies the EQ predicate on the "reject_reason" field.
+func RejectReasonEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldRejectReason, v))
+}
+
+// RejectReasonNEQ applies the NEQ predicate on the "reject_reason" field.
+func RejectReasonNEQ(v string) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldRejectReason, v))
+}
+
+// RejectReasonIn applies the In predicate on the "reject_reason" field.
+func RejectReasonIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonNotIn applies the NotIn predicate on the "reject_reason" field.
+func RejectReasonNotIn(vs ...string) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldRejectReason, vs...))
+}
+
+// RejectReasonGT applies the GT predicate on the "reject_reason" field.
+func RejectReasonGT(v string) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldRejectReason, v))
+}
+
+// RejectReasonGTE applies the GTE predicate on the "reject_reason" field.
+func RejectReasonGTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldRejectReason, v))
+}
+
+// RejectReasonLT applies the LT predicate on the "reject_reason" field.
+func RejectReasonLT(v string) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldRejectReason, v))
+}
+
+// RejectReasonLTE applies the LTE predicate on the "reject_reason" field.
+func RejectReasonLTE(v string) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldRejectReason, v))
+}
+
+// RejectReasonContains applies the Contains predicate on the "reject_reason" field.
+func RejectReasonContains(v string) predicate.Order {
+	return predicate.Order(sql.FieldContains(FieldRejectReason, v))
+}
+
+// RejectReasonHasPrefix applies the HasPrefix predicate on the "reject_reason" field.
+func RejectReasonHasPrefix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasPrefix(FieldRejectReason, v))
+}
+
+// RejectReasonHasSuffix applies the HasSuffix predicate on the "reject_reason" field.
+func RejectReasonHasSuffix(v string) predicate.Order {
+	return predicate.Order(sql.FieldHasSuffix(FieldRejectReason, v))
+}
+
+// RejectReasonIsNil applies the IsNil predicate on the "reject_reason" field.
+func RejectReasonIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldRejectReason))
+}
+
+// RejectReasonNotNil applies the NotNil predicate on the "reject_reason" field.
+func RejectReasonNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldRejectReason))
+}
+
+// RejectReasonEqualFold applies the EqualFold predicate on the "reject_reason" field.
+func RejectReasonEqualFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldEqualFold(FieldRejectReason, v))
+}
+
+// RejectReasonContainsFold applies the ContainsFold predicate on the "reject_reason" field.
+func RejectReasonContainsFold(v string) predicate.Order {
+	return predicate.Order(sql.FieldContainsFold(FieldRejectReason, v))
+}
+
+// SubmittedAtEQ applies the EQ predicate on the "submitted_at" field.
+func SubmittedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtNEQ applies the NEQ predicate on the "submitted_at" field.
+func SubmittedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIn applies the In predicate on the "submitted_at" field.
+func SubmittedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtNotIn applies the NotIn predicate on the "submitted_at" field.
+func SubmittedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldSubmittedAt, vs...))
+}
+
+// SubmittedAtGT applies the GT predicate on the "submitted_at" field.
+func SubmittedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtGTE applies the GTE predicate on the "submitted_at" field.
+func SubmittedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLT applies the LT predicate on the "submitted_at" field.
+func SubmittedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldSubmittedAt, v))
+}
+
+// SubmittedAtLTE applies the LTE predicate on the "submitted_at" field.
+func SubmittedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldSubmittedAt, v))
+}
+
+// SubmittedAtIsNil applies the IsNil predicate on the "submitted_at" field.
+func SubmittedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldSubmittedAt))
+}
+
+// SubmittedAtNotNil applies the NotNil predicate on the "submitted_at" field.
+func SubmittedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldSubmittedAt))
+}
+
+// CompletedAtEQ applies the EQ predicate on the "completed_at" field.
+func CompletedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtNEQ applies the NEQ predicate on the "completed_at" field.
+func CompletedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCompletedAt, v))
+}
+
+// CompletedAtIn applies the In predicate on the "completed_at" field.
+func CompletedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtNotIn applies the NotIn predicate on the "completed_at" field.
+func CompletedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCompletedAt, vs...))
+}
+
+// CompletedAtGT applies the GT predicate on the "completed_at" field.
+func CompletedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCompletedAt, v))
+}
+
+// CompletedAtGTE applies the GTE predicate on the "completed_at" field.
+func CompletedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCompletedAt, v))
+}
+
+// CompletedAtLT applies the LT predicate on the "completed_at" field.
+func CompletedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCompletedAt, v))
+}
+
+// CompletedAtLTE applies the LTE predicate on the "completed_at" field.
+func CompletedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCompletedAt, v))
+}
+
+// CompletedAtIsNil applies the IsNil predicate on the "completed_at" field.
+func CompletedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldCompletedAt))
+}
+
+// CompletedAtNotNil applies the NotNil predicate on the "completed_at" field.
+func CompletedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldCompletedAt))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Order {
+	return predicate.Order(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Order {
+	return predicate.Order(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.Order {
+	return predicate.Order(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.Order {
+	return predicate.Order(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasStrategy applies the HasEdge predicate on the "strategy" edge.
+func HasStrategy() predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, StrategyTable, StrategyColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasStrategyWith applies the HasEdge predicate on the "strategy" edge with a given conditions (other predicates).
+func HasStrategyWith(preds ...predicate.Strategy) predicate.Order {
+	return predicate.Order(func(s *sql.Selector) {
+		step := newStrategyStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Order) predicate.Order {
+	return predicate.Order(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Order) predicate.Order {
+	return predicate.Order(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Order) predicate.Order {
+	return predicate.Order(sql.NotPredicates(p))
+}
@@ -0,0 +1,654 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/order"
+	"auto-trader/ent/strategy"
+	"auto-trader/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrderCreate is the builder for creating a Order entity.
+type OrderCreate struct {
+	config
+	mutation *OrderMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *OrderCreate) SetUserID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *OrderCreate) SetStrategyID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableStrategyID(v *uuid.UUID) *OrderCreate {
+	if v != nil {
+		_c.SetStrategyID(*v)
+	}
+	return _c
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_c *OrderCreate) SetClientOrderID(v string) *OrderCreate {
+	_c.mutation.SetClientOrderID(v)
+	return _c
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_c *OrderCreate) SetBrokerOrderID(v string) *OrderCreate {
+	_c.mutation.SetBrokerOrderID(v)
+	return _c
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableBrokerOrderID(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetBrokerOrderID(*v)
+	}
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *OrderCreate) SetSymbol(v string) *OrderCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *OrderCreate) SetExchange(v string) *OrderCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableExchange(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetExchange(*v)
+	}
+	return _c
+}
+
+// SetMode sets the "mode" field.
+func (_c *OrderCreate) SetMode(v order.Mode) *OrderCreate {
+	_c.mutation.SetMode(v)
+	return _c
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableMode(v *order.Mode) *OrderCreate {
+	if v != nil {
+		_c.SetMode(*v)
+	}
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *OrderCreate) SetSide(v order.Side) *OrderCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetOrderType sets the "order_type" field.
+func (_c *OrderCreate) SetOrderType(v order.OrderType) *OrderCreate {
+	_c.mutation.SetOrderType(v)
+	return _c
+}
+
+// SetTimeInForce sets the "time_in_force" field.
+func (_c *OrderCreate) SetTimeInForce(v order.TimeInForce) *OrderCreate {
+	_c.mutation.SetTimeInForce(v)
+	return _c
+}
+
+// SetNillableTimeInForce sets the "time_in_force" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableTimeInForce(v *order.TimeInForce) *OrderCreate {
+	if v != nil {
+		_c.SetTimeInForce(*v)
+	}
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *OrderCreate) SetQuantity(v decimal.Decimal) *OrderCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *OrderCreate) SetPrice(v decimal.Decimal) *OrderCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_c *OrderCreate) SetNillablePrice(v *decimal.Decimal) *OrderCreate {
+	if v != nil {
+		_c.SetPrice(*v)
+	}
+	return _c
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_c *OrderCreate) SetFilledQuantity(v decimal.Decimal) *OrderCreate {
+	_c.mutation.SetFilledQuantity(v)
+	return _c
+}
+
+// SetNillableFilledQuantity sets the "filled_quantity" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableFilledQuantity(v *decimal.Decimal) *OrderCreate {
+	if v != nil {
+		_c.SetFilledQuantity(*v)
+	}
+	return _c
+}
+
+// SetAvgFillPrice sets the "avg_fill_price" field.
+func (_c *OrderCreate) SetAvgFillPrice(v decimal.Decimal) *OrderCreate {
+	_c.mutation.SetAvgFillPrice(v)
+	return _c
+}
+
+// SetNillableAvgFillPrice sets the "avg_fill_price" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableAvgFillPrice(v *decimal.Decimal) *OrderCreate {
+	if v != nil {
+		_c.SetAvgFillPrice(*v)
+	}
+	return _c
+}
+
+// SetStatus sets the "status" field.
+func (_c *OrderCreate) SetStatus(v order.Status) *OrderCreate {
+	_c.mutation.SetStatus(v)
+	return _c
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableStatus(v *order.Status) *OrderCreate {
+	if v != nil {
+		_c.SetStatus(*v)
+	}
+	return _c
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_c *OrderCreate) SetRejectReason(v string) *OrderCreate {
+	_c.mutation.SetRejectReason(v)
+	return _c
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableRejectReason(v *string) *OrderCreate {
+	if v != nil {
+		_c.SetRejectReason(*v)
+	}
+	return _c
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_c *OrderCreate) SetSubmittedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetSubmittedAt(v)
+	return _c
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableSubmittedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetSubmittedAt(*v)
+	}
+	return _c
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_c *OrderCreate) SetCompletedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetCompletedAt(v)
+	return _c
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableCompletedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetCompletedAt(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *OrderCreate) SetCreatedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableCreatedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *OrderCreate) SetUpdatedAt(v time.Time) *OrderCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableUpdatedAt(v *time.Time) *OrderCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *OrderCreate) SetID(v uuid.UUID) *OrderCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *OrderCreate) SetNillableID(v *uuid.UUID) *OrderCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_c *OrderCreate) SetUser(v *User) *OrderCreate {
+	return _c.SetUserID(v.ID)
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_c *OrderCreate) SetStrategy(v *Strategy) *OrderCreate {
+	return _c.SetStrategyID(v.ID)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (_c *OrderCreate) Mutation() *OrderMutation {
+	return _c.mutation
+}
+
+// Save creates the Order in the database.
+func (_c *OrderCreate) Save(ctx context.Context) (*Order, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *OrderCreate) SaveX(ctx context.Context) *Order {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *OrderCreate) defaults() {
+	if _, ok := _c.mutation.Mode(); !ok {
+		v := order.DefaultMode
+		_c.mutation.SetMode(v)
+	}
+	if _, ok := _c.mutation.TimeInForce(); !ok {
+		v := order.DefaultTimeInForce
+		_c.mutation.SetTimeInForce(v)
+	}
+	if _, ok := _c.mutation.FilledQuantity(); !ok {
+		v := order.DefaultFilledQuantity
+		_c.mutation.SetFilledQuantity(v)
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		v := order.DefaultStatus
+		_c.mutation.SetStatus(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := order.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := order.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := order.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *OrderCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Order.user_id"`)}
+	}
+	if _, ok := _c.mutation.ClientOrderID(); !ok {
+		return &ValidationError{Name: "client_order_id", err: errors.New(`ent: missing required field "Order.client_order_id"`)}
+	}
+	if v, ok := _c.mutation.ClientOrderID(); ok {
+		if err := order.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BrokerOrderID(); ok {
+		if err := order.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.broker_order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "Order.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := order.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Order.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := order.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Order.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Mode(); !ok {
+		return &ValidationError{Name: "mode", err: errors.New(`ent: missing required field "Order.mode"`)}
+	}
+	if v, ok := _c.mutation.Mode(); ok {
+		if err := order.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Order.mode": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "Order.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := order.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Order.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.OrderType(); !ok {
+		return &ValidationError{Name: "order_type", err: errors.New(`ent: missing required field "Order.order_type"`)}
+	}
+	if v, ok := _c.mutation.OrderType(); ok {
+		if err := order.OrderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "order_type", err: fmt.Errorf(`ent: validator failed for field "Order.order_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TimeInForce(); !ok {
+		return &ValidationError{Name: "time_in_force", err: errors.New(`ent: missing required field "Order.time_in_force"`)}
+	}
+	if v, ok := _c.mutation.TimeInForce(); ok {
+		if err := order.TimeInForceValidator(v); err != nil {
+			return &ValidationError{Name: "time_in_force", err: fmt.Errorf(`ent: validator failed for field "Order.time_in_force": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Order.quantity"`)}
+	}
+	if _, ok := _c.mutation.FilledQuantity(); !ok {
+		return &ValidationError{Name: "filled_quantity", err: errors.New(`ent: missing required field "Order.filled_quantity"`)}
+	}
+	if _, ok := _c.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Order.status"`)}
+	}
+	if v, ok := _c.mutation.Status(); ok {
+		if err := order.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
+		}
+	}
+	if len(_c.mutation.UserIDs()) == 0 {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Order.user"`)}
+	}
+	return nil
+}
+
+func (_c *OrderCreate) sqlSave(ctx context.Context) (*Order, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *OrderCreate) createSpec() (*Order, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Order{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(order.Table, sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.ClientOrderID(); ok {
+		_spec.SetField(order.FieldClientOrderID, field.TypeString, value)
+		_node.ClientOrderID = value
+	}
+	if value, ok := _c.mutation.BrokerOrderID(); ok {
+		_spec.SetField(order.FieldBrokerOrderID, field.TypeString, value)
+		_node.BrokerOrderID = &value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(order.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(order.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Mode(); ok {
+		_spec.SetField(order.FieldMode, field.TypeEnum, value)
+		_node.Mode = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(order.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.OrderType(); ok {
+		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
+		_node.OrderType = value
+	}
+	if value, ok := _c.mutation.TimeInForce(); ok {
+		_spec.SetField(order.FieldTimeInForce, field.TypeEnum, value)
+		_node.TimeInForce = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(order.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(order.FieldPrice, field.TypeOther, value)
+		_node.Price = &value
+	}
+	if value, ok := _c.mutation.FilledQuantity(); ok {
+		_spec.SetField(order.FieldFilledQuantity, field.TypeOther, value)
+		_node.FilledQuantity = value
+	}
+	if value, ok := _c.mutation.AvgFillPrice(); ok {
+		_spec.SetField(order.FieldAvgFillPrice, field.TypeOther, value)
+		_node.AvgFillPrice = &value
+	}
+	if value, ok := _c.mutation.Status(); ok {
+		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := _c.mutation.RejectReason(); ok {
+		_spec.SetField(order.FieldRejectReason, field.TypeString, value)
+		_node.RejectReason = &value
+	}
+	if value, ok := _c.mutation.SubmittedAt(); ok {
+		_spec.SetField(order.FieldSubmittedAt, field.TypeTime, value)
+		_node.SubmittedAt = &value
+	}
+	if value, ok := _c.mutation.CompletedAt(); ok {
+		_spec.SetField(order.FieldCompletedAt, field.TypeTime, value)
+		_node.CompletedAt = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(order.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := _c.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.StrategyTable,
+			Columns: []string{order.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.StrategyID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// OrderCreateBulk is the builder for creating many Order entities in bulk.
+type OrderCreateBulk struct {
+	config
+	err      error
+	builders []*OrderCreate
+}
+
+// Save creates the Order entities in the database.
+func (_c *OrderCreateBulk) Save(ctx context.Context) ([]*Order, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Order, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*OrderMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *OrderCreateBulk) SaveX(ctx context.Context) []*Order {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *OrderCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *OrderCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
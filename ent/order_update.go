@@ -0,0 +1,1250 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/order"
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/strategy"
+	"auto-trader/ent/user"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrderUpdate is the builder for updating Order entities.
+type OrderUpdate struct {
+	config
+	hooks    []Hook
+	mutation *OrderMutation
+}
+
+// Where appends a list predicates to the OrderUpdate builder.
+func (_u *OrderUpdate) Where(ps ...predicate.Order) *OrderUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OrderUpdate) SetUserID(v uuid.UUID) *OrderUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableUserID(v *uuid.UUID) *OrderUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *OrderUpdate) SetStrategyID(v uuid.UUID) *OrderUpdate {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableStrategyID(v *uuid.UUID) *OrderUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *OrderUpdate) ClearStrategyID() *OrderUpdate {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *OrderUpdate) SetClientOrderID(v string) *OrderUpdate {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableClientOrderID(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_u *OrderUpdate) SetBrokerOrderID(v string) *OrderUpdate {
+	_u.mutation.SetBrokerOrderID(v)
+	return _u
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableBrokerOrderID(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetBrokerOrderID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (_u *OrderUpdate) ClearBrokerOrderID() *OrderUpdate {
+	_u.mutation.ClearBrokerOrderID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *OrderUpdate) SetSymbol(v string) *OrderUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableSymbol(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *OrderUpdate) SetExchange(v string) *OrderUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableExchange(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *OrderUpdate) ClearExchange() *OrderUpdate {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *OrderUpdate) SetMode(v order.Mode) *OrderUpdate {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableMode(v *order.Mode) *OrderUpdate {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OrderUpdate) SetSide(v order.Side) *OrderUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableSide(v *order.Side) *OrderUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetOrderType sets the "order_type" field.
+func (_u *OrderUpdate) SetOrderType(v order.OrderType) *OrderUpdate {
+	_u.mutation.SetOrderType(v)
+	return _u
+}
+
+// SetNillableOrderType sets the "order_type" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableOrderType(v *order.OrderType) *OrderUpdate {
+	if v != nil {
+		_u.SetOrderType(*v)
+	}
+	return _u
+}
+
+// SetTimeInForce sets the "time_in_force" field.
+func (_u *OrderUpdate) SetTimeInForce(v order.TimeInForce) *OrderUpdate {
+	_u.mutation.SetTimeInForce(v)
+	return _u
+}
+
+// SetNillableTimeInForce sets the "time_in_force" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableTimeInForce(v *order.TimeInForce) *OrderUpdate {
+	if v != nil {
+		_u.SetTimeInForce(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *OrderUpdate) SetQuantity(v decimal.Decimal) *OrderUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableQuantity(v *decimal.Decimal) *OrderUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *OrderUpdate) SetPrice(v decimal.Decimal) *OrderUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillablePrice(v *decimal.Decimal) *OrderUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (_u *OrderUpdate) ClearPrice() *OrderUpdate {
+	_u.mutation.ClearPrice()
+	return _u
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_u *OrderUpdate) SetFilledQuantity(v decimal.Decimal) *OrderUpdate {
+	_u.mutation.SetFilledQuantity(v)
+	return _u
+}
+
+// SetNillableFilledQuantity sets the "filled_quantity" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableFilledQuantity(v *decimal.Decimal) *OrderUpdate {
+	if v != nil {
+		_u.SetFilledQuantity(*v)
+	}
+	return _u
+}
+
+// SetAvgFillPrice sets the "avg_fill_price" field.
+func (_u *OrderUpdate) SetAvgFillPrice(v decimal.Decimal) *OrderUpdate {
+	_u.mutation.SetAvgFillPrice(v)
+	return _u
+}
+
+// SetNillableAvgFillPrice sets the "avg_fill_price" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableAvgFillPrice(v *decimal.Decimal) *OrderUpdate {
+	if v != nil {
+		_u.SetAvgFillPrice(*v)
+	}
+	return _u
+}
+
+// ClearAvgFillPrice clears the value of the "avg_fill_price" field.
+func (_u *OrderUpdate) ClearAvgFillPrice() *OrderUpdate {
+	_u.mutation.ClearAvgFillPrice()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrderUpdate) SetStatus(v order.Status) *OrderUpdate {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableStatus(v *order.Status) *OrderUpdate {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_u *OrderUpdate) SetRejectReason(v string) *OrderUpdate {
+	_u.mutation.SetRejectReason(v)
+	return _u
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableRejectReason(v *string) *OrderUpdate {
+	if v != nil {
+		_u.SetRejectReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectReason clears the value of the "reject_reason" field.
+func (_u *OrderUpdate) ClearRejectReason() *OrderUpdate {
+	_u.mutation.ClearRejectReason()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *OrderUpdate) SetSubmittedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableSubmittedAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *OrderUpdate) ClearSubmittedAt() *OrderUpdate {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *OrderUpdate) SetCompletedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *OrderUpdate) SetNillableCompletedAt(v *time.Time) *OrderUpdate {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *OrderUpdate) ClearCompletedAt() *OrderUpdate {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrderUpdate) SetUpdatedAt(v time.Time) *OrderUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OrderUpdate) ClearUpdatedAt() *OrderUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OrderUpdate) SetUser(v *User) *OrderUpdate {
+	return _u.SetUserID(v.ID)
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_u *OrderUpdate) SetStrategy(v *Strategy) *OrderUpdate {
+	return _u.SetStrategyID(v.ID)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (_u *OrderUpdate) Mutation() *OrderMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OrderUpdate) ClearUser() *OrderUpdate {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (_u *OrderUpdate) ClearStrategy() *OrderUpdate {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *OrderUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *OrderUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := order.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderUpdate) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := order.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BrokerOrderID(); ok {
+		if err := order.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.broker_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := order.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Order.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := order.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Order.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := order.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Order.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := order.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Order.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderType(); ok {
+		if err := order.OrderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "order_type", err: fmt.Errorf(`ent: validator failed for field "Order.order_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeInForce(); ok {
+		if err := order.TimeInForceValidator(v); err != nil {
+			return &ValidationError{Name: "time_in_force", err: fmt.Errorf(`ent: validator failed for field "Order.time_in_force": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := order.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Order.user"`)
+	}
+	return nil
+}
+
+func (_u *OrderUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(order.Table, order.Columns, sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(order.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BrokerOrderID(); ok {
+		_spec.SetField(order.FieldBrokerOrderID, field.TypeString, value)
+	}
+	if _u.mutation.BrokerOrderIDCleared() {
+		_spec.ClearField(order.FieldBrokerOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(order.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(order.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(order.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(order.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(order.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OrderType(); ok {
+		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TimeInForce(); ok {
+		_spec.SetField(order.FieldTimeInForce, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(order.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(order.FieldPrice, field.TypeOther, value)
+	}
+	if _u.mutation.PriceCleared() {
+		_spec.ClearField(order.FieldPrice, field.TypeOther)
+	}
+	if value, ok := _u.mutation.FilledQuantity(); ok {
+		_spec.SetField(order.FieldFilledQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgFillPrice(); ok {
+		_spec.SetField(order.FieldAvgFillPrice, field.TypeOther, value)
+	}
+	if _u.mutation.AvgFillPriceCleared() {
+		_spec.ClearField(order.FieldAvgFillPrice, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectReason(); ok {
+		_spec.SetField(order.FieldRejectReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectReasonCleared() {
+		_spec.ClearField(order.FieldRejectReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(order.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(order.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(order.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(order.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(order.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(order.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StrategyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.StrategyTable,
+			Columns: []string{order.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.StrategyTable,
+			Columns: []string{order.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{order.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// OrderUpdateOne is the builder for updating a single Order entity.
+type OrderUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *OrderMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *OrderUpdateOne) SetUserID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableUserID(v *uuid.UUID) *OrderUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *OrderUpdateOne) SetStrategyID(v uuid.UUID) *OrderUpdateOne {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableStrategyID(v *uuid.UUID) *OrderUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *OrderUpdateOne) ClearStrategyID() *OrderUpdateOne {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *OrderUpdateOne) SetClientOrderID(v string) *OrderUpdateOne {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableClientOrderID(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_u *OrderUpdateOne) SetBrokerOrderID(v string) *OrderUpdateOne {
+	_u.mutation.SetBrokerOrderID(v)
+	return _u
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableBrokerOrderID(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetBrokerOrderID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (_u *OrderUpdateOne) ClearBrokerOrderID() *OrderUpdateOne {
+	_u.mutation.ClearBrokerOrderID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *OrderUpdateOne) SetSymbol(v string) *OrderUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableSymbol(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *OrderUpdateOne) SetExchange(v string) *OrderUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableExchange(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *OrderUpdateOne) ClearExchange() *OrderUpdateOne {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetMode sets the "mode" field.
+func (_u *OrderUpdateOne) SetMode(v order.Mode) *OrderUpdateOne {
+	_u.mutation.SetMode(v)
+	return _u
+}
+
+// SetNillableMode sets the "mode" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableMode(v *order.Mode) *OrderUpdateOne {
+	if v != nil {
+		_u.SetMode(*v)
+	}
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *OrderUpdateOne) SetSide(v order.Side) *OrderUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableSide(v *order.Side) *OrderUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetOrderType sets the "order_type" field.
+func (_u *OrderUpdateOne) SetOrderType(v order.OrderType) *OrderUpdateOne {
+	_u.mutation.SetOrderType(v)
+	return _u
+}
+
+// SetNillableOrderType sets the "order_type" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableOrderType(v *order.OrderType) *OrderUpdateOne {
+	if v != nil {
+		_u.SetOrderType(*v)
+	}
+	return _u
+}
+
+// SetTimeInForce sets the "time_in_force" field.
+func (_u *OrderUpdateOne) SetTimeInForce(v order.TimeInForce) *OrderUpdateOne {
+	_u.mutation.SetTimeInForce(v)
+	return _u
+}
+
+// SetNillableTimeInForce sets the "time_in_force" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableTimeInForce(v *order.TimeInForce) *OrderUpdateOne {
+	if v != nil {
+		_u.SetTimeInForce(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *OrderUpdateOne) SetQuantity(v decimal.Decimal) *OrderUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableQuantity(v *decimal.Decimal) *OrderUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *OrderUpdateOne) SetPrice(v decimal.Decimal) *OrderUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillablePrice(v *decimal.Decimal) *OrderUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// ClearPrice clears the value of the "price" field.
+func (_u *OrderUpdateOne) ClearPrice() *OrderUpdateOne {
+	_u.mutation.ClearPrice()
+	return _u
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_u *OrderUpdateOne) SetFilledQuantity(v decimal.Decimal) *OrderUpdateOne {
+	_u.mutation.SetFilledQuantity(v)
+	return _u
+}
+
+// SetNillableFilledQuantity sets the "filled_quantity" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableFilledQuantity(v *decimal.Decimal) *OrderUpdateOne {
+	if v != nil {
+		_u.SetFilledQuantity(*v)
+	}
+	return _u
+}
+
+// SetAvgFillPrice sets the "avg_fill_price" field.
+func (_u *OrderUpdateOne) SetAvgFillPrice(v decimal.Decimal) *OrderUpdateOne {
+	_u.mutation.SetAvgFillPrice(v)
+	return _u
+}
+
+// SetNillableAvgFillPrice sets the "avg_fill_price" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableAvgFillPrice(v *decimal.Decimal) *OrderUpdateOne {
+	if v != nil {
+		_u.SetAvgFillPrice(*v)
+	}
+	return _u
+}
+
+// ClearAvgFillPrice clears the value of the "avg_fill_price" field.
+func (_u *OrderUpdateOne) ClearAvgFillPrice() *OrderUpdateOne {
+	_u.mutation.ClearAvgFillPrice()
+	return _u
+}
+
+// SetStatus sets the "status" field.
+func (_u *OrderUpdateOne) SetStatus(v order.Status) *OrderUpdateOne {
+	_u.mutation.SetStatus(v)
+	return _u
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableStatus(v *order.Status) *OrderUpdateOne {
+	if v != nil {
+		_u.SetStatus(*v)
+	}
+	return _u
+}
+
+// SetRejectReason sets the "reject_reason" field.
+func (_u *OrderUpdateOne) SetRejectReason(v string) *OrderUpdateOne {
+	_u.mutation.SetRejectReason(v)
+	return _u
+}
+
+// SetNillableRejectReason sets the "reject_reason" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableRejectReason(v *string) *OrderUpdateOne {
+	if v != nil {
+		_u.SetRejectReason(*v)
+	}
+	return _u
+}
+
+// ClearRejectReason clears the value of the "reject_reason" field.
+func (_u *OrderUpdateOne) ClearRejectReason() *OrderUpdateOne {
+	_u.mutation.ClearRejectReason()
+	return _u
+}
+
+// SetSubmittedAt sets the "submitted_at" field.
+func (_u *OrderUpdateOne) SetSubmittedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetSubmittedAt(v)
+	return _u
+}
+
+// SetNillableSubmittedAt sets the "submitted_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableSubmittedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetSubmittedAt(*v)
+	}
+	return _u
+}
+
+// ClearSubmittedAt clears the value of the "submitted_at" field.
+func (_u *OrderUpdateOne) ClearSubmittedAt() *OrderUpdateOne {
+	_u.mutation.ClearSubmittedAt()
+	return _u
+}
+
+// SetCompletedAt sets the "completed_at" field.
+func (_u *OrderUpdateOne) SetCompletedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetCompletedAt(v)
+	return _u
+}
+
+// SetNillableCompletedAt sets the "completed_at" field if the given value is not nil.
+func (_u *OrderUpdateOne) SetNillableCompletedAt(v *time.Time) *OrderUpdateOne {
+	if v != nil {
+		_u.SetCompletedAt(*v)
+	}
+	return _u
+}
+
+// ClearCompletedAt clears the value of the "completed_at" field.
+func (_u *OrderUpdateOne) ClearCompletedAt() *OrderUpdateOne {
+	_u.mutation.ClearCompletedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *OrderUpdateOne) SetUpdatedAt(v time.Time) *OrderUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *OrderUpdateOne) ClearUpdatedAt() *OrderUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (_u *OrderUpdateOne) SetUser(v *User) *OrderUpdateOne {
+	return _u.SetUserID(v.ID)
+}
+
+// SetStrategy sets the "strategy" edge to the Strategy entity.
+func (_u *OrderUpdateOne) SetStrategy(v *Strategy) *OrderUpdateOne {
+	return _u.SetStrategyID(v.ID)
+}
+
+// Mutation returns the OrderMutation object of the builder.
+func (_u *OrderUpdateOne) Mutation() *OrderMutation {
+	return _u.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (_u *OrderUpdateOne) ClearUser() *OrderUpdateOne {
+	_u.mutation.ClearUser()
+	return _u
+}
+
+// ClearStrategy clears the "strategy" edge to the Strategy entity.
+func (_u *OrderUpdateOne) ClearStrategy() *OrderUpdateOne {
+	_u.mutation.ClearStrategy()
+	return _u
+}
+
+// Where appends a list predicates to the OrderUpdate builder.
+func (_u *OrderUpdateOne) Where(ps ...predicate.Order) *OrderUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *OrderUpdateOne) Select(field string, fields ...string) *OrderUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Order entity.
+func (_u *OrderUpdateOne) Save(ctx context.Context) (*Order, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *OrderUpdateOne) SaveX(ctx context.Context) *Order {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *OrderUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *OrderUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *OrderUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := order.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *OrderUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := order.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BrokerOrderID(); ok {
+		if err := order.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Order.broker_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := order.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Order.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := order.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Order.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Mode(); ok {
+		if err := order.ModeValidator(v); err != nil {
+			return &ValidationError{Name: "mode", err: fmt.Errorf(`ent: validator failed for field "Order.mode": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := order.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Order.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.OrderType(); ok {
+		if err := order.OrderTypeValidator(v); err != nil {
+			return &ValidationError{Name: "order_type", err: fmt.Errorf(`ent: validator failed for field "Order.order_type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TimeInForce(); ok {
+		if err := order.TimeInForceValidator(v); err != nil {
+			return &ValidationError{Name: "time_in_force", err: fmt.Errorf(`ent: validator failed for field "Order.time_in_force": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Status(); ok {
+		if err := order.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Order.status": %w`, err)}
+		}
+	}
+	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "Order.user"`)
+	}
+	return nil
+}
+
+func (_u *OrderUpdateOne) sqlSave(ctx context.Context) (_node *Order, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(order.Table, order.Columns, sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Order.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, order.FieldID)
+		for _, f := range fields {
+			if !order.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != order.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(order.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BrokerOrderID(); ok {
+		_spec.SetField(order.FieldBrokerOrderID, field.TypeString, value)
+	}
+	if _u.mutation.BrokerOrderIDCleared() {
+		_spec.ClearField(order.FieldBrokerOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(order.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(order.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(order.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.Mode(); ok {
+		_spec.SetField(order.FieldMode, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(order.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.OrderType(); ok {
+		_spec.SetField(order.FieldOrderType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TimeInForce(); ok {
+		_spec.SetField(order.FieldTimeInForce, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(order.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(order.FieldPrice, field.TypeOther, value)
+	}
+	if _u.mutation.PriceCleared() {
+		_spec.ClearField(order.FieldPrice, field.TypeOther)
+	}
+	if value, ok := _u.mutation.FilledQuantity(); ok {
+		_spec.SetField(order.FieldFilledQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgFillPrice(); ok {
+		_spec.SetField(order.FieldAvgFillPrice, field.TypeOther, value)
+	}
+	if _u.mutation.AvgFillPriceCleared() {
+		_spec.ClearField(order.FieldAvgFillPrice, field.TypeOther)
+	}
+	if value, ok := _u.mutation.Status(); ok {
+		_spec.SetField(order.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.RejectReason(); ok {
+		_spec.SetField(order.FieldRejectReason, field.TypeString, value)
+	}
+	if _u.mutation.RejectReasonCleared() {
+		_spec.ClearField(order.FieldRejectReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.SubmittedAt(); ok {
+		_spec.SetField(order.FieldSubmittedAt, field.TypeTime, value)
+	}
+	if _u.mutation.SubmittedAtCleared() {
+		_spec.ClearField(order.FieldSubmittedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.CompletedAt(); ok {
+		_spec.SetField(order.FieldCompletedAt, field.TypeTime, value)
+	}
+	if _u.mutation.CompletedAtCleared() {
+		_spec.ClearField(order.FieldCompletedAt, field.TypeTime)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(order.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(order.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(order.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.UserTable,
+			Columns: []string{order.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.StrategyCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.StrategyTable,
+			Columns: []string{order.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.StrategyIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   order.StrategyTable,
+			Columns: []string{order.StrategyColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(strategy.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Order{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{order.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,200 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperAccount is the model entity for the PaperAccount schema.
+type PaperAccount struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// InitialCash holds the value of the "initial_cash" field.
+	InitialCash decimal.Decimal `json:"initial_cash,omitempty"`
+	// Cash holds the value of the "cash" field.
+	Cash decimal.Decimal `json:"cash,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt *time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaperAccountQuery when eager-loading is set.
+	Edges        PaperAccountEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaperAccountEdges holds the relations/edges for other nodes in the graph.
+type PaperAccountEdges struct {
+	// Positions holds the value of the positions edge.
+	Positions []*PaperPosition `json:"positions,omitempty"`
+	// Trades holds the value of the trades edge.
+	Trades []*PaperTrade `json:"trades,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// PositionsOrErr returns the Positions value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaperAccountEdges) PositionsOrErr() ([]*PaperPosition, error) {
+	if e.loadedTypes[0] {
+		return e.Positions, nil
+	}
+	return nil, &NotLoadedError{edge: "positions"}
+}
+
+// TradesOrErr returns the Trades value or an error if the edge
+// was not loaded in eager-loading.
+func (e PaperAccountEdges) TradesOrErr() ([]*PaperTrade, error) {
+	if e.loadedTypes[1] {
+		return e.Trades, nil
+	}
+	return nil, &NotLoadedError{edge: "trades"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaperAccount) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paperaccount.FieldInitialCash, paperaccount.FieldCash:
+			values[i] = new(decimal.Decimal)
+		case paperaccount.FieldCreatedAt, paperaccount.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case paperaccount.FieldID, paperaccount.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaperAccount fields.
+func (_m *PaperAccount) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paperaccount.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case paperaccount.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case paperaccount.FieldInitialCash:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field initial_cash", values[i])
+			} else if value != nil {
+				_m.InitialCash = *value
+			}
+		case paperaccount.FieldCash:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cash", values[i])
+			} else if value != nil {
+				_m.Cash = *value
+			}
+		case paperaccount.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = new(time.Time)
+				*_m.CreatedAt = value.Time
+			}
+		case paperaccount.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaperAccount.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaperAccount) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryPositions queries the "positions" edge of the PaperAccount entity.
+func (_m *PaperAccount) QueryPositions() *PaperPositionQuery {
+	return NewPaperAccountClient(_m.config).QueryPositions(_m)
+}
+
+// QueryTrades queries the "trades" edge of the PaperAccount entity.
+func (_m *PaperAccount) QueryTrades() *PaperTradeQuery {
+	return NewPaperAccountClient(_m.config).QueryTrades(_m)
+}
+
+// Update returns a builder for updating this PaperAccount.
+// Note that you need to call PaperAccount.Unwrap() before calling this method if this PaperAccount
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaperAccount) Update() *PaperAccountUpdateOne {
+	return NewPaperAccountClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaperAccount entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaperAccount) Unwrap() *PaperAccount {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaperAccount is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaperAccount) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaperAccount(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("initial_cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.InitialCash))
+	builder.WriteString(", ")
+	builder.WriteString("cash=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Cash))
+	builder.WriteString(", ")
+	if v := _m.CreatedAt; v != nil {
+		builder.WriteString("created_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaperAccounts is a parsable slice of PaperAccount.
+type PaperAccounts []*PaperAccount
@@ -0,0 +1,154 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paperaccount
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+)
+
+const (
+	// Label holds the string label denoting the paperaccount type in the database.
+	Label = "paper_account"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldInitialCash holds the string denoting the initial_cash field in the database.
+	FieldInitialCash = "initial_cash"
+	// FieldCash holds the string denoting the cash field in the database.
+	FieldCash = "cash"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// EdgePositions holds the string denoting the positions edge name in mutations.
+	EdgePositions = "positions"
+	// EdgeTrades holds the string denoting the trades edge name in mutations.
+	EdgeTrades = "trades"
+	// Table holds the table name of the paperaccount in the database.
+	Table = "paper_accounts"
+	// PositionsTable is the table that holds the positions relation/edge.
+	PositionsTable = "paper_positions"
+	// PositionsInverseTable is the table name for the PaperPosition entity.
+	// It exists in this package in order to avoid circular dependency with the "paperposition" package.
+	PositionsInverseTable = "paper_positions"
+	// PositionsColumn is the table column denoting the positions relation/edge.
+	PositionsColumn = "account_id"
+	// TradesTable is the table that holds the trades relation/edge.
+	TradesTable = "paper_trades"
+	// TradesInverseTable is the table name for the PaperTrade entity.
+	// It exists in this package in order to avoid circular dependency with the "papertrade" package.
+	TradesInverseTable = "paper_trades"
+	// TradesColumn is the table column denoting the trades relation/edge.
+	TradesColumn = "account_id"
+)
+
+// Columns holds all SQL columns for paperaccount fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldInitialCash,
+	FieldCash,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the PaperAccount queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByInitialCash orders the results by the initial_cash field.
+func ByInitialCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldInitialCash, opts...).ToFunc()
+}
+
+// ByCash orders the results by the cash field.
+func ByCash(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCash, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
+
+// ByPositionsCount orders the results by positions count.
+func ByPositionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newPositionsStep(), opts...)
+	}
+}
+
+// ByPositions orders the results by positions terms.
+func ByPositions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newPositionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTradesCount orders the results by trades count.
+func ByTradesCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTradesStep(), opts...)
+	}
+}
+
+// ByTrades orders the results by trades terms.
+func ByTrades(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTradesStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+func newPositionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(PositionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, PositionsTable, PositionsColumn),
+	)
+}
+func newTradesStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TradesInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TradesTable, TradesColumn),
+	)
+}
@@ -0,0 +1,364 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paperaccount
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// InitialCash applies equality check predicate on the "initial_cash" field. It's identical to InitialCashEQ.
+func InitialCash(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldInitialCash, v))
+}
+
+// Cash applies equality check predicate on the "cash" field. It's identical to CashEQ.
+func Cash(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldCash, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldUserID, v))
+}
+
+// InitialCashEQ applies the EQ predicate on the "initial_cash" field.
+func InitialCashEQ(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldInitialCash, v))
+}
+
+// InitialCashNEQ applies the NEQ predicate on the "initial_cash" field.
+func InitialCashNEQ(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldInitialCash, v))
+}
+
+// InitialCashIn applies the In predicate on the "initial_cash" field.
+func InitialCashIn(vs ...decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldInitialCash, vs...))
+}
+
+// InitialCashNotIn applies the NotIn predicate on the "initial_cash" field.
+func InitialCashNotIn(vs ...decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldInitialCash, vs...))
+}
+
+// InitialCashGT applies the GT predicate on the "initial_cash" field.
+func InitialCashGT(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldInitialCash, v))
+}
+
+// InitialCashGTE applies the GTE predicate on the "initial_cash" field.
+func InitialCashGTE(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldInitialCash, v))
+}
+
+// InitialCashLT applies the LT predicate on the "initial_cash" field.
+func InitialCashLT(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldInitialCash, v))
+}
+
+// InitialCashLTE applies the LTE predicate on the "initial_cash" field.
+func InitialCashLTE(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldInitialCash, v))
+}
+
+// CashEQ applies the EQ predicate on the "cash" field.
+func CashEQ(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldCash, v))
+}
+
+// CashNEQ applies the NEQ predicate on the "cash" field.
+func CashNEQ(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldCash, v))
+}
+
+// CashIn applies the In predicate on the "cash" field.
+func CashIn(vs ...decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldCash, vs...))
+}
+
+// CashNotIn applies the NotIn predicate on the "cash" field.
+func CashNotIn(vs ...decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldCash, vs...))
+}
+
+// CashGT applies the GT predicate on the "cash" field.
+func CashGT(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldCash, v))
+}
+
+// CashGTE applies the GTE predicate on the "cash" field.
+func CashGTE(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldCash, v))
+}
+
+// CashLT applies the LT predicate on the "cash" field.
+func CashLT(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldCash, v))
+}
+
+// CashLTE applies the LTE predicate on the "cash" field.
+func CashLTE(v decimal.Decimal) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldCash, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// CreatedAtIsNil applies the IsNil predicate on the "created_at" field.
+func CreatedAtIsNil() predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIsNull(FieldCreatedAt))
+}
+
+// CreatedAtNotNil applies the NotNil predicate on the "created_at" field.
+func CreatedAtNotNil() predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotNull(FieldCreatedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.PaperAccount {
+	return predicate.PaperAccount(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasPositions applies the HasEdge predicate on the "positions" edge.
+func HasPositions() predicate.PaperAccount {
+	return predicate.PaperAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PositionsTable, PositionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPositionsWith applies the HasEdge predicate on the "positions" edge with a given conditions (other predicates).
+func HasPositionsWith(preds ...predicate.PaperPosition) predicate.PaperAccount {
+	return predicate.PaperAccount(func(s *sql.Selector) {
+		step := newPositionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTrades applies the HasEdge predicate on the "trades" edge.
+func HasTrades() predicate.PaperAccount {
+	return predicate.PaperAccount(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TradesTable, TradesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTradesWith applies the HasEdge predicate on the "trades" edge with a given conditions (other predicates).
+func HasTradesWith(preds ...predicate.PaperTrade) predicate.PaperAccount {
+	return predicate.PaperAccount(func(s *sql.Selector) {
+		step := newTradesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaperAccount) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaperAccount) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaperAccount) predicate.PaperAccount {
+	return predicate.PaperAccount(sql.NotPredicates(p))
+}
@@ -0,0 +1,349 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperAccountCreate is the builder for creating a PaperAccount entity.
+type PaperAccountCreate struct {
+	config
+	mutation *PaperAccountMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *PaperAccountCreate) SetUserID(v uuid.UUID) *PaperAccountCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetInitialCash sets the "initial_cash" field.
+func (_c *PaperAccountCreate) SetInitialCash(v decimal.Decimal) *PaperAccountCreate {
+	_c.mutation.SetInitialCash(v)
+	return _c
+}
+
+// SetCash sets the "cash" field.
+func (_c *PaperAccountCreate) SetCash(v decimal.Decimal) *PaperAccountCreate {
+	_c.mutation.SetCash(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *PaperAccountCreate) SetCreatedAt(v time.Time) *PaperAccountCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *PaperAccountCreate) SetNillableCreatedAt(v *time.Time) *PaperAccountCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaperAccountCreate) SetUpdatedAt(v time.Time) *PaperAccountCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaperAccountCreate) SetNillableUpdatedAt(v *time.Time) *PaperAccountCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaperAccountCreate) SetID(v uuid.UUID) *PaperAccountCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaperAccountCreate) SetNillableID(v *uuid.UUID) *PaperAccountCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// AddPositionIDs adds the "positions" edge to the PaperPosition entity by IDs.
+func (_c *PaperAccountCreate) AddPositionIDs(ids ...uuid.UUID) *PaperAccountCreate {
+	_c.mutation.AddPositionIDs(ids...)
+	return _c
+}
+
+// AddPositions adds the "positions" edges to the PaperPosition entity.
+func (_c *PaperAccountCreate) AddPositions(v ...*PaperPosition) *PaperAccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddPositionIDs(ids...)
+}
+
+// AddTradeIDs adds the "trades" edge to the PaperTrade entity by IDs.
+func (_c *PaperAccountCreate) AddTradeIDs(ids ...uuid.UUID) *PaperAccountCreate {
+	_c.mutation.AddTradeIDs(ids...)
+	return _c
+}
+
+// AddTrades adds the "trades" edges to the PaperTrade entity.
+func (_c *PaperAccountCreate) AddTrades(v ...*PaperTrade) *PaperAccountCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddTradeIDs(ids...)
+}
+
+// Mutation returns the PaperAccountMutation object of the builder.
+func (_c *PaperAccountCreate) Mutation() *PaperAccountMutation {
+	return _c.mutation
+}
+
+// Save creates the PaperAccount in the database.
+func (_c *PaperAccountCreate) Save(ctx context.Context) (*PaperAccount, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaperAccountCreate) SaveX(ctx context.Context) *PaperAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperAccountCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperAccountCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaperAccountCreate) defaults() {
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := paperaccount.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paperaccount.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := paperaccount.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaperAccountCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PaperAccount.user_id"`)}
+	}
+	if _, ok := _c.mutation.InitialCash(); !ok {
+		return &ValidationError{Name: "initial_cash", err: errors.New(`ent: missing required field "PaperAccount.initial_cash"`)}
+	}
+	if _, ok := _c.mutation.Cash(); !ok {
+		return &ValidationError{Name: "cash", err: errors.New(`ent: missing required field "PaperAccount.cash"`)}
+	}
+	return nil
+}
+
+func (_c *PaperAccountCreate) sqlSave(ctx context.Context) (*PaperAccount, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaperAccountCreate) createSpec() (*PaperAccount, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaperAccount{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paperaccount.Table, sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(paperaccount.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.InitialCash(); ok {
+		_spec.SetField(paperaccount.FieldInitialCash, field.TypeOther, value)
+		_node.InitialCash = value
+	}
+	if value, ok := _c.mutation.Cash(); ok {
+		_spec.SetField(paperaccount.FieldCash, field.TypeOther, value)
+		_node.Cash = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(paperaccount.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperaccount.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := _c.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaperAccountCreateBulk is the builder for creating many PaperAccount entities in bulk.
+type PaperAccountCreateBulk struct {
+	config
+	err      error
+	builders []*PaperAccountCreate
+}
+
+// Save creates the PaperAccount entities in the database.
+func (_c *PaperAccountCreateBulk) Save(ctx context.Context) ([]*PaperAccount, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaperAccount, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaperAccountMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaperAccountCreateBulk) SaveX(ctx context.Context) []*PaperAccount {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperAccountCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperAccountCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,666 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperAccountUpdate is the builder for updating PaperAccount entities.
+type PaperAccountUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaperAccountMutation
+}
+
+// Where appends a list predicates to the PaperAccountUpdate builder.
+func (_u *PaperAccountUpdate) Where(ps ...predicate.PaperAccount) *PaperAccountUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PaperAccountUpdate) SetUserID(v uuid.UUID) *PaperAccountUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PaperAccountUpdate) SetNillableUserID(v *uuid.UUID) *PaperAccountUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetInitialCash sets the "initial_cash" field.
+func (_u *PaperAccountUpdate) SetInitialCash(v decimal.Decimal) *PaperAccountUpdate {
+	_u.mutation.SetInitialCash(v)
+	return _u
+}
+
+// SetNillableInitialCash sets the "initial_cash" field if the given value is not nil.
+func (_u *PaperAccountUpdate) SetNillableInitialCash(v *decimal.Decimal) *PaperAccountUpdate {
+	if v != nil {
+		_u.SetInitialCash(*v)
+	}
+	return _u
+}
+
+// SetCash sets the "cash" field.
+func (_u *PaperAccountUpdate) SetCash(v decimal.Decimal) *PaperAccountUpdate {
+	_u.mutation.SetCash(v)
+	return _u
+}
+
+// SetNillableCash sets the "cash" field if the given value is not nil.
+func (_u *PaperAccountUpdate) SetNillableCash(v *decimal.Decimal) *PaperAccountUpdate {
+	if v != nil {
+		_u.SetCash(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaperAccountUpdate) SetUpdatedAt(v time.Time) *PaperAccountUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PaperAccountUpdate) ClearUpdatedAt() *PaperAccountUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddPositionIDs adds the "positions" edge to the PaperPosition entity by IDs.
+func (_u *PaperAccountUpdate) AddPositionIDs(ids ...uuid.UUID) *PaperAccountUpdate {
+	_u.mutation.AddPositionIDs(ids...)
+	return _u
+}
+
+// AddPositions adds the "positions" edges to the PaperPosition entity.
+func (_u *PaperAccountUpdate) AddPositions(v ...*PaperPosition) *PaperAccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPositionIDs(ids...)
+}
+
+// AddTradeIDs adds the "trades" edge to the PaperTrade entity by IDs.
+func (_u *PaperAccountUpdate) AddTradeIDs(ids ...uuid.UUID) *PaperAccountUpdate {
+	_u.mutation.AddTradeIDs(ids...)
+	return _u
+}
+
+// AddTrades adds the "trades" edges to the PaperTrade entity.
+func (_u *PaperAccountUpdate) AddTrades(v ...*PaperTrade) *PaperAccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTradeIDs(ids...)
+}
+
+// Mutation returns the PaperAccountMutation object of the builder.
+func (_u *PaperAccountUpdate) Mutation() *PaperAccountMutation {
+	return _u.mutation
+}
+
+// ClearPositions clears all "positions" edges to the PaperPosition entity.
+func (_u *PaperAccountUpdate) ClearPositions() *PaperAccountUpdate {
+	_u.mutation.ClearPositions()
+	return _u
+}
+
+// RemovePositionIDs removes the "positions" edge to PaperPosition entities by IDs.
+func (_u *PaperAccountUpdate) RemovePositionIDs(ids ...uuid.UUID) *PaperAccountUpdate {
+	_u.mutation.RemovePositionIDs(ids...)
+	return _u
+}
+
+// RemovePositions removes "positions" edges to PaperPosition entities.
+func (_u *PaperAccountUpdate) RemovePositions(v ...*PaperPosition) *PaperAccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePositionIDs(ids...)
+}
+
+// ClearTrades clears all "trades" edges to the PaperTrade entity.
+func (_u *PaperAccountUpdate) ClearTrades() *PaperAccountUpdate {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// RemoveTradeIDs removes the "trades" edge to PaperTrade entities by IDs.
+func (_u *PaperAccountUpdate) RemoveTradeIDs(ids ...uuid.UUID) *PaperAccountUpdate {
+	_u.mutation.RemoveTradeIDs(ids...)
+	return _u
+}
+
+// RemoveTrades removes "trades" edges to PaperTrade entities.
+func (_u *PaperAccountUpdate) RemoveTrades(v ...*PaperTrade) *PaperAccountUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTradeIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaperAccountUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperAccountUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaperAccountUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperAccountUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaperAccountUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := paperaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PaperAccountUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paperaccount.Table, paperaccount.Columns, sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(paperaccount.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InitialCash(); ok {
+		_spec.SetField(paperaccount.FieldInitialCash, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Cash(); ok {
+		_spec.SetField(paperaccount.FieldCash, field.TypeOther, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(paperaccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(paperaccount.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPositionsIDs(); len(nodes) > 0 && !_u.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTradesIDs(); len(nodes) > 0 && !_u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paperaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaperAccountUpdateOne is the builder for updating a single PaperAccount entity.
+type PaperAccountUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaperAccountMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *PaperAccountUpdateOne) SetUserID(v uuid.UUID) *PaperAccountUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *PaperAccountUpdateOne) SetNillableUserID(v *uuid.UUID) *PaperAccountUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetInitialCash sets the "initial_cash" field.
+func (_u *PaperAccountUpdateOne) SetInitialCash(v decimal.Decimal) *PaperAccountUpdateOne {
+	_u.mutation.SetInitialCash(v)
+	return _u
+}
+
+// SetNillableInitialCash sets the "initial_cash" field if the given value is not nil.
+func (_u *PaperAccountUpdateOne) SetNillableInitialCash(v *decimal.Decimal) *PaperAccountUpdateOne {
+	if v != nil {
+		_u.SetInitialCash(*v)
+	}
+	return _u
+}
+
+// SetCash sets the "cash" field.
+func (_u *PaperAccountUpdateOne) SetCash(v decimal.Decimal) *PaperAccountUpdateOne {
+	_u.mutation.SetCash(v)
+	return _u
+}
+
+// SetNillableCash sets the "cash" field if the given value is not nil.
+func (_u *PaperAccountUpdateOne) SetNillableCash(v *decimal.Decimal) *PaperAccountUpdateOne {
+	if v != nil {
+		_u.SetCash(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaperAccountUpdateOne) SetUpdatedAt(v time.Time) *PaperAccountUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PaperAccountUpdateOne) ClearUpdatedAt() *PaperAccountUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// AddPositionIDs adds the "positions" edge to the PaperPosition entity by IDs.
+func (_u *PaperAccountUpdateOne) AddPositionIDs(ids ...uuid.UUID) *PaperAccountUpdateOne {
+	_u.mutation.AddPositionIDs(ids...)
+	return _u
+}
+
+// AddPositions adds the "positions" edges to the PaperPosition entity.
+func (_u *PaperAccountUpdateOne) AddPositions(v ...*PaperPosition) *PaperAccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddPositionIDs(ids...)
+}
+
+// AddTradeIDs adds the "trades" edge to the PaperTrade entity by IDs.
+func (_u *PaperAccountUpdateOne) AddTradeIDs(ids ...uuid.UUID) *PaperAccountUpdateOne {
+	_u.mutation.AddTradeIDs(ids...)
+	return _u
+}
+
+// AddTrades adds the "trades" edges to the PaperTrade entity.
+func (_u *PaperAccountUpdateOne) AddTrades(v ...*PaperTrade) *PaperAccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddTradeIDs(ids...)
+}
+
+// Mutation returns the PaperAccountMutation object of the builder.
+func (_u *PaperAccountUpdateOne) Mutation() *PaperAccountMutation {
+	return _u.mutation
+}
+
+// ClearPositions clears all "positions" edges to the PaperPosition entity.
+func (_u *PaperAccountUpdateOne) ClearPositions() *PaperAccountUpdateOne {
+	_u.mutation.ClearPositions()
+	return _u
+}
+
+// RemovePositionIDs removes the "positions" edge to PaperPosition entities by IDs.
+func (_u *PaperAccountUpdateOne) RemovePositionIDs(ids ...uuid.UUID) *PaperAccountUpdateOne {
+	_u.mutation.RemovePositionIDs(ids...)
+	return _u
+}
+
+// RemovePositions removes "positions" edges to PaperPosition entities.
+func (_u *PaperAccountUpdateOne) RemovePositions(v ...*PaperPosition) *PaperAccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemovePositionIDs(ids...)
+}
+
+// ClearTrades clears all "trades" edges to the PaperTrade entity.
+func (_u *PaperAccountUpdateOne) ClearTrades() *PaperAccountUpdateOne {
+	_u.mutation.ClearTrades()
+	return _u
+}
+
+// RemoveTradeIDs removes the "trades" edge to PaperTrade entities by IDs.
+func (_u *PaperAccountUpdateOne) RemoveTradeIDs(ids ...uuid.UUID) *PaperAccountUpdateOne {
+	_u.mutation.RemoveTradeIDs(ids...)
+	return _u
+}
+
+// RemoveTrades removes "trades" edges to PaperTrade entities.
+func (_u *PaperAccountUpdateOne) RemoveTrades(v ...*PaperTrade) *PaperAccountUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveTradeIDs(ids...)
+}
+
+// Where appends a list predicates to the PaperAccountUpdate builder.
+func (_u *PaperAccountUpdateOne) Where(ps ...predicate.PaperAccount) *PaperAccountUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaperAccountUpdateOne) Select(field string, fields ...string) *PaperAccountUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaperAccount entity.
+func (_u *PaperAccountUpdateOne) Save(ctx context.Context) (*PaperAccount, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperAccountUpdateOne) SaveX(ctx context.Context) *PaperAccount {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaperAccountUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperAccountUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaperAccountUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := paperaccount.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+func (_u *PaperAccountUpdateOne) sqlSave(ctx context.Context) (_node *PaperAccount, err error) {
+	_spec := sqlgraph.NewUpdateSpec(paperaccount.Table, paperaccount.Columns, sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaperAccount.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paperaccount.FieldID)
+		for _, f := range fields {
+			if !paperaccount.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paperaccount.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(paperaccount.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.InitialCash(); ok {
+		_spec.SetField(paperaccount.FieldInitialCash, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Cash(); ok {
+		_spec.SetField(paperaccount.FieldCash, field.TypeOther, value)
+	}
+	if _u.mutation.CreatedAtCleared() {
+		_spec.ClearField(paperaccount.FieldCreatedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperaccount.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(paperaccount.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedPositionsIDs(); len(nodes) > 0 && !_u.mutation.PositionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.PositionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.PositionsTable,
+			Columns: []string{paperaccount.PositionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedTradesIDs(); len(nodes) > 0 && !_u.mutation.TradesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.TradesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   paperaccount.TradesTable,
+			Columns: []string{paperaccount.TradesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaperAccount{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paperaccount.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
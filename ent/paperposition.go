@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperPosition is the model entity for the PaperPosition schema.
+type PaperPosition struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// AvgCost holds the value of the "avg_cost" field.
+	AvgCost decimal.Decimal `json:"avg_cost,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaperPositionQuery when eager-loading is set.
+	Edges        PaperPositionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaperPositionEdges holds the relations/edges for other nodes in the graph.
+type PaperPositionEdges struct {
+	// Account holds the value of the account edge.
+	Account *PaperAccount `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaperPositionEdges) AccountOrErr() (*PaperAccount, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paperaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaperPosition) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case paperposition.FieldQuantity, paperposition.FieldAvgCost:
+			values[i] = new(decimal.Decimal)
+		case paperposition.FieldSymbol:
+			values[i] = new(sql.NullString)
+		case paperposition.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case paperposition.FieldID, paperposition.FieldAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaperPosition fields.
+func (_m *PaperPosition) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case paperposition.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case paperposition.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case paperposition.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case paperposition.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case paperposition.FieldAvgCost:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_cost", values[i])
+			} else if value != nil {
+				_m.AvgCost = *value
+			}
+		case paperposition.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = new(time.Time)
+				*_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaperPosition.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaperPosition) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the PaperPosition entity.
+func (_m *PaperPosition) QueryAccount() *PaperAccountQuery {
+	return NewPaperPositionClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this PaperPosition.
+// Note that you need to call PaperPosition.Unwrap() before calling this method if this PaperPosition
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaperPosition) Update() *PaperPositionUpdateOne {
+	return NewPaperPositionClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaperPosition entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaperPosition) Unwrap() *PaperPosition {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaperPosition is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaperPosition) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaperPosition(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("avg_cost=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AvgCost))
+	builder.WriteString(", ")
+	if v := _m.UpdatedAt; v != nil {
+		builder.WriteString("updated_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaperPositions is a parsable slice of PaperPosition.
+type PaperPositions []*PaperPosition
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package paperposition
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldAccountID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldQuantity, v))
+}
+
+// AvgCost applies equality check predicate on the "avg_cost" field. It's identical to AvgCostEQ.
+func AvgCost(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldAvgCost, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLTE(FieldQuantity, v))
+}
+
+// AvgCostEQ applies the EQ predicate on the "avg_cost" field.
+func AvgCostEQ(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldAvgCost, v))
+}
+
+// AvgCostNEQ applies the NEQ predicate on the "avg_cost" field.
+func AvgCostNEQ(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldAvgCost, v))
+}
+
+// AvgCostIn applies the In predicate on the "avg_cost" field.
+func AvgCostIn(vs ...decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldAvgCost, vs...))
+}
+
+// AvgCostNotIn applies the NotIn predicate on the "avg_cost" field.
+func AvgCostNotIn(vs ...decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldAvgCost, vs...))
+}
+
+// AvgCostGT applies the GT predicate on the "avg_cost" field.
+func AvgCostGT(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGT(FieldAvgCost, v))
+}
+
+// AvgCostGTE applies the GTE predicate on the "avg_cost" field.
+func AvgCostGTE(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGTE(FieldAvgCost, v))
+}
+
+// AvgCostLT applies the LT predicate on the "avg_cost" field.
+func AvgCostLT(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLT(FieldAvgCost, v))
+}
+
+// AvgCostLTE applies the LTE predicate on the "avg_cost" field.
+func AvgCostLTE(v decimal.Decimal) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLTE(FieldAvgCost, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIsNil applies the IsNil predicate on the "updated_at" field.
+func UpdatedAtIsNil() predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldIsNull(FieldUpdatedAt))
+}
+
+// UpdatedAtNotNil applies the NotNil predicate on the "updated_at" field.
+func UpdatedAtNotNil() predicate.PaperPosition {
+	return predicate.PaperPosition(sql.FieldNotNull(FieldUpdatedAt))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.PaperPosition {
+	return predicate.PaperPosition(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.PaperAccount) predicate.PaperPosition {
+	return predicate.PaperPosition(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaperPosition) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaperPosition) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaperPosition) predicate.PaperPosition {
+	return predicate.PaperPosition(sql.NotPredicates(p))
+}
@@ -0,0 +1,303 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperPositionCreate is the builder for creating a PaperPosition entity.
+type PaperPositionCreate struct {
+	config
+	mutation *PaperPositionMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *PaperPositionCreate) SetAccountID(v uuid.UUID) *PaperPositionCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *PaperPositionCreate) SetSymbol(v string) *PaperPositionCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *PaperPositionCreate) SetQuantity(v decimal.Decimal) *PaperPositionCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetAvgCost sets the "avg_cost" field.
+func (_c *PaperPositionCreate) SetAvgCost(v decimal.Decimal) *PaperPositionCreate {
+	_c.mutation.SetAvgCost(v)
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *PaperPositionCreate) SetUpdatedAt(v time.Time) *PaperPositionCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *PaperPositionCreate) SetNillableUpdatedAt(v *time.Time) *PaperPositionCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaperPositionCreate) SetID(v uuid.UUID) *PaperPositionCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaperPositionCreate) SetNillableID(v *uuid.UUID) *PaperPositionCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_c *PaperPositionCreate) SetAccount(v *PaperAccount) *PaperPositionCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperPositionMutation object of the builder.
+func (_c *PaperPositionCreate) Mutation() *PaperPositionMutation {
+	return _c.mutation
+}
+
+// Save creates the PaperPosition in the database.
+func (_c *PaperPositionCreate) Save(ctx context.Context) (*PaperPosition, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaperPositionCreate) SaveX(ctx context.Context) *PaperPosition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperPositionCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperPositionCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaperPositionCreate) defaults() {
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := paperposition.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := paperposition.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaperPositionCreate) check() error {
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "PaperPosition.account_id"`)}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "PaperPosition.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := paperposition.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperPosition.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "PaperPosition.quantity"`)}
+	}
+	if _, ok := _c.mutation.AvgCost(); !ok {
+		return &ValidationError{Name: "avg_cost", err: errors.New(`ent: missing required field "PaperPosition.avg_cost"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "PaperPosition.account"`)}
+	}
+	return nil
+}
+
+func (_c *PaperPositionCreate) sqlSave(ctx context.Context) (*PaperPosition, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaperPositionCreate) createSpec() (*PaperPosition, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaperPosition{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(paperposition.Table, sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(paperposition.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(paperposition.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.AvgCost(); ok {
+		_spec.SetField(paperposition.FieldAvgCost, field.TypeOther, value)
+		_node.AvgCost = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperposition.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = &value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paperposition.AccountTable,
+			Columns: []string{paperposition.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaperPositionCreateBulk is the builder for creating many PaperPosition entities in bulk.
+type PaperPositionCreateBulk struct {
+	config
+	err      error
+	builders []*PaperPositionCreate
+}
+
+// Save creates the PaperPosition entities in the database.
+func (_c *PaperPositionCreateBulk) Save(ctx context.Context) ([]*PaperPosition, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaperPosition, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaperPositionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaperPositionCreateBulk) SaveX(ctx context.Context) []*PaperPosition {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperPositionCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperPositionCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,475 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperPositionUpdate is the builder for updating PaperPosition entities.
+type PaperPositionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaperPositionMutation
+}
+
+// Where appends a list predicates to the PaperPositionUpdate builder.
+func (_u *PaperPositionUpdate) Where(ps ...predicate.PaperPosition) *PaperPositionUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *PaperPositionUpdate) SetAccountID(v uuid.UUID) *PaperPositionUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *PaperPositionUpdate) SetNillableAccountID(v *uuid.UUID) *PaperPositionUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *PaperPositionUpdate) SetSymbol(v string) *PaperPositionUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *PaperPositionUpdate) SetNillableSymbol(v *string) *PaperPositionUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PaperPositionUpdate) SetQuantity(v decimal.Decimal) *PaperPositionUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PaperPositionUpdate) SetNillableQuantity(v *decimal.Decimal) *PaperPositionUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetAvgCost sets the "avg_cost" field.
+func (_u *PaperPositionUpdate) SetAvgCost(v decimal.Decimal) *PaperPositionUpdate {
+	_u.mutation.SetAvgCost(v)
+	return _u
+}
+
+// SetNillableAvgCost sets the "avg_cost" field if the given value is not nil.
+func (_u *PaperPositionUpdate) SetNillableAvgCost(v *decimal.Decimal) *PaperPositionUpdate {
+	if v != nil {
+		_u.SetAvgCost(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaperPositionUpdate) SetUpdatedAt(v time.Time) *PaperPositionUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PaperPositionUpdate) ClearUpdatedAt() *PaperPositionUpdate {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_u *PaperPositionUpdate) SetAccount(v *PaperAccount) *PaperPositionUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperPositionMutation object of the builder.
+func (_u *PaperPositionUpdate) Mutation() *PaperPositionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (_u *PaperPositionUpdate) ClearAccount() *PaperPositionUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaperPositionUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperPositionUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaperPositionUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperPositionUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaperPositionUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := paperposition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaperPositionUpdate) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := paperposition.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperPosition.symbol": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaperPosition.account"`)
+	}
+	return nil
+}
+
+func (_u *PaperPositionUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paperposition.Table, paperposition.Columns, sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(paperposition.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(paperposition.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCost(); ok {
+		_spec.SetField(paperposition.FieldAvgCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperposition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(paperposition.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paperposition.AccountTable,
+			Columns: []string{paperposition.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paperposition.AccountTable,
+			Columns: []string{paperposition.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paperposition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaperPositionUpdateOne is the builder for updating a single PaperPosition entity.
+type PaperPositionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaperPositionMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *PaperPositionUpdateOne) SetAccountID(v uuid.UUID) *PaperPositionUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *PaperPositionUpdateOne) SetNillableAccountID(v *uuid.UUID) *PaperPositionUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *PaperPositionUpdateOne) SetSymbol(v string) *PaperPositionUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *PaperPositionUpdateOne) SetNillableSymbol(v *string) *PaperPositionUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PaperPositionUpdateOne) SetQuantity(v decimal.Decimal) *PaperPositionUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PaperPositionUpdateOne) SetNillableQuantity(v *decimal.Decimal) *PaperPositionUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetAvgCost sets the "avg_cost" field.
+func (_u *PaperPositionUpdateOne) SetAvgCost(v decimal.Decimal) *PaperPositionUpdateOne {
+	_u.mutation.SetAvgCost(v)
+	return _u
+}
+
+// SetNillableAvgCost sets the "avg_cost" field if the given value is not nil.
+func (_u *PaperPositionUpdateOne) SetNillableAvgCost(v *decimal.Decimal) *PaperPositionUpdateOne {
+	if v != nil {
+		_u.SetAvgCost(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *PaperPositionUpdateOne) SetUpdatedAt(v time.Time) *PaperPositionUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// ClearUpdatedAt clears the value of the "updated_at" field.
+func (_u *PaperPositionUpdateOne) ClearUpdatedAt() *PaperPositionUpdateOne {
+	_u.mutation.ClearUpdatedAt()
+	return _u
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_u *PaperPositionUpdateOne) SetAccount(v *PaperAccount) *PaperPositionUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperPositionMutation object of the builder.
+func (_u *PaperPositionUpdateOne) Mutation() *PaperPositionMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (_u *PaperPositionUpdateOne) ClearAccount() *PaperPositionUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the PaperPositionUpdate builder.
+func (_u *PaperPositionUpdateOne) Where(ps ...predicate.PaperPosition) *PaperPositionUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaperPositionUpdateOne) Select(field string, fields ...string) *PaperPositionUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaperPosition entity.
+func (_u *PaperPositionUpdateOne) Save(ctx context.Context) (*PaperPosition, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperPositionUpdateOne) SaveX(ctx context.Context) *PaperPosition {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaperPositionUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperPositionUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *PaperPositionUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok && !_u.mutation.UpdatedAtCleared() {
+		v := paperposition.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaperPositionUpdateOne) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := paperposition.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperPosition.symbol": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaperPosition.account"`)
+	}
+	return nil
+}
+
+func (_u *PaperPositionUpdateOne) sqlSave(ctx context.Context) (_node *PaperPosition, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(paperposition.Table, paperposition.Columns, sqlgraph.NewFieldSpec(paperposition.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaperPosition.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, paperposition.FieldID)
+		for _, f := range fields {
+			if !paperposition.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != paperposition.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(paperposition.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(paperposition.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCost(); ok {
+		_spec.SetField(paperposition.FieldAvgCost, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(paperposition.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _u.mutation.UpdatedAtCleared() {
+		_spec.ClearField(paperposition.FieldUpdatedAt, field.TypeTime)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paperposition.AccountTable,
+			Columns: []string{paperposition.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   paperposition.AccountTable,
+			Columns: []string{paperposition.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaperPosition{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{paperposition.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,243 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/papertrade"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperTrade is the model entity for the PaperTrade schema.
+type PaperTrade struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// AccountID holds the value of the "account_id" field.
+	AccountID uuid.UUID `json:"account_id,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID *uuid.UUID `json:"strategy_id,omitempty"`
+	// ClientOrderID holds the value of the "client_order_id" field.
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Side holds the value of the "side" field.
+	Side papertrade.Side `json:"side,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Price holds the value of the "price" field.
+	Price decimal.Decimal `json:"price,omitempty"`
+	// Commission holds the value of the "commission" field.
+	Commission decimal.Decimal `json:"commission,omitempty"`
+	// RealizedPnl holds the value of the "realized_pnl" field.
+	RealizedPnl decimal.Decimal `json:"realized_pnl,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PaperTradeQuery when eager-loading is set.
+	Edges        PaperTradeEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PaperTradeEdges holds the relations/edges for other nodes in the graph.
+type PaperTradeEdges struct {
+	// Account holds the value of the account edge.
+	Account *PaperAccount `json:"account,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// AccountOrErr returns the Account value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PaperTradeEdges) AccountOrErr() (*PaperAccount, error) {
+	if e.Account != nil {
+		return e.Account, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: paperaccount.Label}
+	}
+	return nil, &NotLoadedError{edge: "account"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PaperTrade) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case papertrade.FieldStrategyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case papertrade.FieldQuantity, papertrade.FieldPrice, papertrade.FieldCommission, papertrade.FieldRealizedPnl:
+			values[i] = new(decimal.Decimal)
+		case papertrade.FieldClientOrderID, papertrade.FieldSymbol, papertrade.FieldSide:
+			values[i] = new(sql.NullString)
+		case papertrade.FieldExecutedAt:
+			values[i] = new(sql.NullTime)
+		case papertrade.FieldID, papertrade.FieldAccountID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PaperTrade fields.
+func (_m *PaperTrade) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case papertrade.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case papertrade.FieldAccountID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field account_id", values[i])
+			} else if value != nil {
+				_m.AccountID = *value
+			}
+		case papertrade.FieldStrategyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value.Valid {
+				_m.StrategyID = new(uuid.UUID)
+				*_m.StrategyID = *value.S.(*uuid.UUID)
+			}
+		case papertrade.FieldClientOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_order_id", values[i])
+			} else if value.Valid {
+				_m.ClientOrderID = value.String
+			}
+		case papertrade.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case papertrade.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = papertrade.Side(value.String)
+			}
+		case papertrade.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case papertrade.FieldPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case papertrade.FieldCommission:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field commission", values[i])
+			} else if value != nil {
+				_m.Commission = *value
+			}
+		case papertrade.FieldRealizedPnl:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field realized_pnl", values[i])
+			} else if value != nil {
+				_m.RealizedPnl = *value
+			}
+		case papertrade.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				_m.ExecutedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PaperTrade.
+// This includes values selected through modifiers, order, etc.
+func (_m *PaperTrade) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// QueryAccount queries the "account" edge of the PaperTrade entity.
+func (_m *PaperTrade) QueryAccount() *PaperAccountQuery {
+	return NewPaperTradeClient(_m.config).QueryAccount(_m)
+}
+
+// Update returns a builder for updating this PaperTrade.
+// Note that you need to call PaperTrade.Unwrap() before calling this method if this PaperTrade
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *PaperTrade) Update() *PaperTradeUpdateOne {
+	return NewPaperTradeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the PaperTrade entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *PaperTrade) Unwrap() *PaperTrade {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PaperTrade is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *PaperTrade) String() string {
+	var builder strings.Builder
+	builder.WriteString("PaperTrade(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("account_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AccountID))
+	builder.WriteString(", ")
+	if v := _m.StrategyID; v != nil {
+		builder.WriteString("strategy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("client_order_id=")
+	builder.WriteString(_m.ClientOrderID)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Side))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("commission=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Commission))
+	builder.WriteString(", ")
+	builder.WriteString("realized_pnl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RealizedPnl))
+	builder.WriteString(", ")
+	builder.WriteString("executed_at=")
+	builder.WriteString(_m.ExecutedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PaperTrades is a parsable slice of PaperTrade.
+type PaperTrades []*PaperTrade
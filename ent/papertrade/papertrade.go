@@ -0,0 +1,186 @@
+// Code generated by ent, DO NOT EDIT.
+
+package papertrade
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the papertrade type in the database.
+	Label = "paper_trade"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldAccountID holds the string denoting the account_id field in the database.
+	FieldAccountID = "account_id"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldClientOrderID holds the string denoting the client_order_id field in the database.
+	FieldClientOrderID = "client_order_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldCommission holds the string denoting the commission field in the database.
+	FieldCommission = "commission"
+	// FieldRealizedPnl holds the string denoting the realized_pnl field in the database.
+	FieldRealizedPnl = "realized_pnl"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// EdgeAccount holds the string denoting the account edge name in mutations.
+	EdgeAccount = "account"
+	// Table holds the table name of the papertrade in the database.
+	Table = "paper_trades"
+	// AccountTable is the table that holds the account relation/edge.
+	AccountTable = "paper_trades"
+	// AccountInverseTable is the table name for the PaperAccount entity.
+	// It exists in this package in order to avoid circular dependency with the "paperaccount" package.
+	AccountInverseTable = "paper_accounts"
+	// AccountColumn is the table column denoting the account relation/edge.
+	AccountColumn = "account_id"
+)
+
+// Columns holds all SQL columns for papertrade fields.
+var Columns = []string{
+	FieldID,
+	FieldAccountID,
+	FieldStrategyID,
+	FieldClientOrderID,
+	FieldSymbol,
+	FieldSide,
+	FieldQuantity,
+	FieldPrice,
+	FieldCommission,
+	FieldRealizedPnl,
+	FieldExecutedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	ClientOrderIDValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// DefaultCommission holds the default value on creation for the "commission" field.
+	DefaultCommission decimal.Decimal
+	// DefaultRealizedPnl holds the default value on creation for the "realized_pnl" field.
+	DefaultRealizedPnl decimal.Decimal
+	// DefaultExecutedAt holds the default value on creation for the "executed_at" field.
+	DefaultExecutedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Side defines the type for the "side" enum field.
+type Side string
+
+// Side values.
+const (
+	SideBUY  Side = "BUY"
+	SideSELL Side = "SELL"
+)
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// SideValidator is a validator for the "side" field enum values. It is called by the builders before save.
+func SideValidator(s Side) error {
+	switch s {
+	case SideBUY, SideSELL:
+		return nil
+	default:
+		return fmt.Errorf("papertrade: invalid enum value for side field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the PaperTrade queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByAccountID orders the results by the account_id field.
+func ByAccountID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccountID, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// ByClientOrderID orders the results by the client_order_id field.
+func ByClientOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientOrderID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByCommission orders the results by the commission field.
+func ByCommission(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCommission, opts...).ToFunc()
+}
+
+// ByRealizedPnl orders the results by the realized_pnl field.
+func ByRealizedPnl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRealizedPnl, opts...).ToFunc()
+}
+
+// ByExecutedAt orders the results by the executed_at field.
+func ByExecutedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedAt, opts...).ToFunc()
+}
+
+// ByAccountField orders the results by account field.
+func ByAccountField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newAccountStep(), sql.OrderByField(field, opts...))
+	}
+}
+func newAccountStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(AccountInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+	)
+}
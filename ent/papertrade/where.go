@@ -0,0 +1,561 @@
+// Code generated by ent, DO NOT EDIT.
+
+package papertrade
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldID, id))
+}
+
+// AccountID applies equality check predicate on the "account_id" field. It's identical to AccountIDEQ.
+func AccountID(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldAccountID, v))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// ClientOrderID applies equality check predicate on the "client_order_id" field. It's identical to ClientOrderIDEQ.
+func ClientOrderID(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldPrice, v))
+}
+
+// Commission applies equality check predicate on the "commission" field. It's identical to CommissionEQ.
+func Commission(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldCommission, v))
+}
+
+// RealizedPnl applies equality check predicate on the "realized_pnl" field. It's identical to RealizedPnlEQ.
+func RealizedPnl(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldRealizedPnl, v))
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// AccountIDEQ applies the EQ predicate on the "account_id" field.
+func AccountIDEQ(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldAccountID, v))
+}
+
+// AccountIDNEQ applies the NEQ predicate on the "account_id" field.
+func AccountIDNEQ(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldAccountID, v))
+}
+
+// AccountIDIn applies the In predicate on the "account_id" field.
+func AccountIDIn(vs ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldAccountID, vs...))
+}
+
+// AccountIDNotIn applies the NotIn predicate on the "account_id" field.
+func AccountIDNotIn(vs ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldAccountID, vs...))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDGT applies the GT predicate on the "strategy_id" field.
+func StrategyIDGT(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldStrategyID, v))
+}
+
+// StrategyIDGTE applies the GTE predicate on the "strategy_id" field.
+func StrategyIDGTE(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldStrategyID, v))
+}
+
+// StrategyIDLT applies the LT predicate on the "strategy_id" field.
+func StrategyIDLT(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldStrategyID, v))
+}
+
+// StrategyIDLTE applies the LTE predicate on the "strategy_id" field.
+func StrategyIDLTE(v uuid.UUID) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldStrategyID, v))
+}
+
+// StrategyIDIsNil applies the IsNil predicate on the "strategy_id" field.
+func StrategyIDIsNil() predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIsNull(FieldStrategyID))
+}
+
+// StrategyIDNotNil applies the NotNil predicate on the "strategy_id" field.
+func StrategyIDNotNil() predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotNull(FieldStrategyID))
+}
+
+// ClientOrderIDEQ applies the EQ predicate on the "client_order_id" field.
+func ClientOrderIDEQ(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDNEQ applies the NEQ predicate on the "client_order_id" field.
+func ClientOrderIDNEQ(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDIn applies the In predicate on the "client_order_id" field.
+func ClientOrderIDIn(vs ...string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDNotIn applies the NotIn predicate on the "client_order_id" field.
+func ClientOrderIDNotIn(vs ...string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDGT applies the GT predicate on the "client_order_id" field.
+func ClientOrderIDGT(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDGTE applies the GTE predicate on the "client_order_id" field.
+func ClientOrderIDGTE(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLT applies the LT predicate on the "client_order_id" field.
+func ClientOrderIDLT(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLTE applies the LTE predicate on the "client_order_id" field.
+func ClientOrderIDLTE(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContains applies the Contains predicate on the "client_order_id" field.
+func ClientOrderIDContains(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldContains(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasPrefix applies the HasPrefix predicate on the "client_order_id" field.
+func ClientOrderIDHasPrefix(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldHasPrefix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasSuffix applies the HasSuffix predicate on the "client_order_id" field.
+func ClientOrderIDHasSuffix(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldHasSuffix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDEqualFold applies the EqualFold predicate on the "client_order_id" field.
+func ClientOrderIDEqualFold(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEqualFold(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContainsFold applies the ContainsFold predicate on the "client_order_id" field.
+func ClientOrderIDContainsFold(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldContainsFold(FieldClientOrderID, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldPrice, v))
+}
+
+// CommissionEQ applies the EQ predicate on the "commission" field.
+func CommissionEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldCommission, v))
+}
+
+// CommissionNEQ applies the NEQ predicate on the "commission" field.
+func CommissionNEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldCommission, v))
+}
+
+// CommissionIn applies the In predicate on the "commission" field.
+func CommissionIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldCommission, vs...))
+}
+
+// CommissionNotIn applies the NotIn predicate on the "commission" field.
+func CommissionNotIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldCommission, vs...))
+}
+
+// CommissionGT applies the GT predicate on the "commission" field.
+func CommissionGT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldCommission, v))
+}
+
+// CommissionGTE applies the GTE predicate on the "commission" field.
+func CommissionGTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldCommission, v))
+}
+
+// CommissionLT applies the LT predicate on the "commission" field.
+func CommissionLT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldCommission, v))
+}
+
+// CommissionLTE applies the LTE predicate on the "commission" field.
+func CommissionLTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldCommission, v))
+}
+
+// RealizedPnlEQ applies the EQ predicate on the "realized_pnl" field.
+func RealizedPnlEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldRealizedPnl, v))
+}
+
+// RealizedPnlNEQ applies the NEQ predicate on the "realized_pnl" field.
+func RealizedPnlNEQ(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldRealizedPnl, v))
+}
+
+// RealizedPnlIn applies the In predicate on the "realized_pnl" field.
+func RealizedPnlIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldRealizedPnl, vs...))
+}
+
+// RealizedPnlNotIn applies the NotIn predicate on the "realized_pnl" field.
+func RealizedPnlNotIn(vs ...decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldRealizedPnl, vs...))
+}
+
+// RealizedPnlGT applies the GT predicate on the "realized_pnl" field.
+func RealizedPnlGT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldRealizedPnl, v))
+}
+
+// RealizedPnlGTE applies the GTE predicate on the "realized_pnl" field.
+func RealizedPnlGTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldRealizedPnl, v))
+}
+
+// RealizedPnlLT applies the LT predicate on the "realized_pnl" field.
+func RealizedPnlLT(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldRealizedPnl, v))
+}
+
+// RealizedPnlLTE applies the LTE predicate on the "realized_pnl" field.
+func RealizedPnlLTE(v decimal.Decimal) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldRealizedPnl, v))
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldNotIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGT(FieldExecutedAt, v))
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldGTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLT(FieldExecutedAt, v))
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.FieldLTE(FieldExecutedAt, v))
+}
+
+// HasAccount applies the HasEdge predicate on the "account" edge.
+func HasAccount() predicate.PaperTrade {
+	return predicate.PaperTrade(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, AccountTable, AccountColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccountWith applies the HasEdge predicate on the "account" edge with a given conditions (other predicates).
+func HasAccountWith(preds ...predicate.PaperAccount) predicate.PaperTrade {
+	return predicate.PaperTrade(func(s *sql.Selector) {
+		step := newAccountStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.PaperTrade) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.PaperTrade) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.PaperTrade) predicate.PaperTrade {
+	return predicate.PaperTrade(sql.NotPredicates(p))
+}
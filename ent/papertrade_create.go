@@ -0,0 +1,410 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/papertrade"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperTradeCreate is the builder for creating a PaperTrade entity.
+type PaperTradeCreate struct {
+	config
+	mutation *PaperTradeMutation
+	hooks    []Hook
+}
+
+// SetAccountID sets the "account_id" field.
+func (_c *PaperTradeCreate) SetAccountID(v uuid.UUID) *PaperTradeCreate {
+	_c.mutation.SetAccountID(v)
+	return _c
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *PaperTradeCreate) SetStrategyID(v uuid.UUID) *PaperTradeCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_c *PaperTradeCreate) SetNillableStrategyID(v *uuid.UUID) *PaperTradeCreate {
+	if v != nil {
+		_c.SetStrategyID(*v)
+	}
+	return _c
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_c *PaperTradeCreate) SetClientOrderID(v string) *PaperTradeCreate {
+	_c.mutation.SetClientOrderID(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *PaperTradeCreate) SetSymbol(v string) *PaperTradeCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *PaperTradeCreate) SetSide(v papertrade.Side) *PaperTradeCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *PaperTradeCreate) SetQuantity(v decimal.Decimal) *PaperTradeCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *PaperTradeCreate) SetPrice(v decimal.Decimal) *PaperTradeCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetCommission sets the "commission" field.
+func (_c *PaperTradeCreate) SetCommission(v decimal.Decimal) *PaperTradeCreate {
+	_c.mutation.SetCommission(v)
+	return _c
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (_c *PaperTradeCreate) SetNillableCommission(v *decimal.Decimal) *PaperTradeCreate {
+	if v != nil {
+		_c.SetCommission(*v)
+	}
+	return _c
+}
+
+// SetRealizedPnl sets the "realized_pnl" field.
+func (_c *PaperTradeCreate) SetRealizedPnl(v decimal.Decimal) *PaperTradeCreate {
+	_c.mutation.SetRealizedPnl(v)
+	return _c
+}
+
+// SetNillableRealizedPnl sets the "realized_pnl" field if the given value is not nil.
+func (_c *PaperTradeCreate) SetNillableRealizedPnl(v *decimal.Decimal) *PaperTradeCreate {
+	if v != nil {
+		_c.SetRealizedPnl(*v)
+	}
+	return _c
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_c *PaperTradeCreate) SetExecutedAt(v time.Time) *PaperTradeCreate {
+	_c.mutation.SetExecutedAt(v)
+	return _c
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_c *PaperTradeCreate) SetNillableExecutedAt(v *time.Time) *PaperTradeCreate {
+	if v != nil {
+		_c.SetExecutedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *PaperTradeCreate) SetID(v uuid.UUID) *PaperTradeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *PaperTradeCreate) SetNillableID(v *uuid.UUID) *PaperTradeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_c *PaperTradeCreate) SetAccount(v *PaperAccount) *PaperTradeCreate {
+	return _c.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperTradeMutation object of the builder.
+func (_c *PaperTradeCreate) Mutation() *PaperTradeMutation {
+	return _c.mutation
+}
+
+// Save creates the PaperTrade in the database.
+func (_c *PaperTradeCreate) Save(ctx context.Context) (*PaperTrade, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *PaperTradeCreate) SaveX(ctx context.Context) *PaperTrade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperTradeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperTradeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *PaperTradeCreate) defaults() {
+	if _, ok := _c.mutation.Commission(); !ok {
+		v := papertrade.DefaultCommission
+		_c.mutation.SetCommission(v)
+	}
+	if _, ok := _c.mutation.RealizedPnl(); !ok {
+		v := papertrade.DefaultRealizedPnl
+		_c.mutation.SetRealizedPnl(v)
+	}
+	if _, ok := _c.mutation.ExecutedAt(); !ok {
+		v := papertrade.DefaultExecutedAt()
+		_c.mutation.SetExecutedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := papertrade.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *PaperTradeCreate) check() error {
+	if _, ok := _c.mutation.AccountID(); !ok {
+		return &ValidationError{Name: "account_id", err: errors.New(`ent: missing required field "PaperTrade.account_id"`)}
+	}
+	if _, ok := _c.mutation.ClientOrderID(); !ok {
+		return &ValidationError{Name: "client_order_id", err: errors.New(`ent: missing required field "PaperTrade.client_order_id"`)}
+	}
+	if v, ok := _c.mutation.ClientOrderID(); ok {
+		if err := papertrade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.client_order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "PaperTrade.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := papertrade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "PaperTrade.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := papertrade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "PaperTrade.quantity"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "PaperTrade.price"`)}
+	}
+	if _, ok := _c.mutation.Commission(); !ok {
+		return &ValidationError{Name: "commission", err: errors.New(`ent: missing required field "PaperTrade.commission"`)}
+	}
+	if _, ok := _c.mutation.RealizedPnl(); !ok {
+		return &ValidationError{Name: "realized_pnl", err: errors.New(`ent: missing required field "PaperTrade.realized_pnl"`)}
+	}
+	if _, ok := _c.mutation.ExecutedAt(); !ok {
+		return &ValidationError{Name: "executed_at", err: errors.New(`ent: missing required field "PaperTrade.executed_at"`)}
+	}
+	if len(_c.mutation.AccountIDs()) == 0 {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required edge "PaperTrade.account"`)}
+	}
+	return nil
+}
+
+func (_c *PaperTradeCreate) sqlSave(ctx context.Context) (*PaperTrade, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *PaperTradeCreate) createSpec() (*PaperTrade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PaperTrade{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(papertrade.Table, sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.StrategyID(); ok {
+		_spec.SetField(papertrade.FieldStrategyID, field.TypeUUID, value)
+		_node.StrategyID = &value
+	}
+	if value, ok := _c.mutation.ClientOrderID(); ok {
+		_spec.SetField(papertrade.FieldClientOrderID, field.TypeString, value)
+		_node.ClientOrderID = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(papertrade.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(papertrade.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(papertrade.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(papertrade.FieldPrice, field.TypeOther, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Commission(); ok {
+		_spec.SetField(papertrade.FieldCommission, field.TypeOther, value)
+		_node.Commission = value
+	}
+	if value, ok := _c.mutation.RealizedPnl(); ok {
+		_spec.SetField(papertrade.FieldRealizedPnl, field.TypeOther, value)
+		_node.RealizedPnl = value
+	}
+	if value, ok := _c.mutation.ExecutedAt(); ok {
+		_spec.SetField(papertrade.FieldExecutedAt, field.TypeTime, value)
+		_node.ExecutedAt = value
+	}
+	if nodes := _c.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   papertrade.AccountTable,
+			Columns: []string{papertrade.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.AccountID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PaperTradeCreateBulk is the builder for creating many PaperTrade entities in bulk.
+type PaperTradeCreateBulk struct {
+	config
+	err      error
+	builders []*PaperTradeCreate
+}
+
+// Save creates the PaperTrade entities in the database.
+func (_c *PaperTradeCreateBulk) Save(ctx context.Context) ([]*PaperTrade, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*PaperTrade, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PaperTradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *PaperTradeCreateBulk) SaveX(ctx context.Context) []*PaperTrade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *PaperTradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *PaperTradeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
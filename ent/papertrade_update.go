@@ -0,0 +1,663 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/papertrade"
+	"auto-trader/ent/predicate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperTradeUpdate is the builder for updating PaperTrade entities.
+type PaperTradeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PaperTradeMutation
+}
+
+// Where appends a list predicates to the PaperTradeUpdate builder.
+func (_u *PaperTradeUpdate) Where(ps ...predicate.PaperTrade) *PaperTradeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *PaperTradeUpdate) SetAccountID(v uuid.UUID) *PaperTradeUpdate {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableAccountID(v *uuid.UUID) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *PaperTradeUpdate) SetStrategyID(v uuid.UUID) *PaperTradeUpdate {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableStrategyID(v *uuid.UUID) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *PaperTradeUpdate) ClearStrategyID() *PaperTradeUpdate {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *PaperTradeUpdate) SetClientOrderID(v string) *PaperTradeUpdate {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableClientOrderID(v *string) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *PaperTradeUpdate) SetSymbol(v string) *PaperTradeUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableSymbol(v *string) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *PaperTradeUpdate) SetSide(v papertrade.Side) *PaperTradeUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableSide(v *papertrade.Side) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PaperTradeUpdate) SetQuantity(v decimal.Decimal) *PaperTradeUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableQuantity(v *decimal.Decimal) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *PaperTradeUpdate) SetPrice(v decimal.Decimal) *PaperTradeUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillablePrice(v *decimal.Decimal) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetCommission sets the "commission" field.
+func (_u *PaperTradeUpdate) SetCommission(v decimal.Decimal) *PaperTradeUpdate {
+	_u.mutation.SetCommission(v)
+	return _u
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableCommission(v *decimal.Decimal) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetCommission(*v)
+	}
+	return _u
+}
+
+// SetRealizedPnl sets the "realized_pnl" field.
+func (_u *PaperTradeUpdate) SetRealizedPnl(v decimal.Decimal) *PaperTradeUpdate {
+	_u.mutation.SetRealizedPnl(v)
+	return _u
+}
+
+// SetNillableRealizedPnl sets the "realized_pnl" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableRealizedPnl(v *decimal.Decimal) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetRealizedPnl(*v)
+	}
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *PaperTradeUpdate) SetExecutedAt(v time.Time) *PaperTradeUpdate {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *PaperTradeUpdate) SetNillableExecutedAt(v *time.Time) *PaperTradeUpdate {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_u *PaperTradeUpdate) SetAccount(v *PaperAccount) *PaperTradeUpdate {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperTradeMutation object of the builder.
+func (_u *PaperTradeUpdate) Mutation() *PaperTradeMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (_u *PaperTradeUpdate) ClearAccount() *PaperTradeUpdate {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *PaperTradeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperTradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *PaperTradeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperTradeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaperTradeUpdate) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := papertrade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := papertrade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := papertrade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.side": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaperTrade.account"`)
+	}
+	return nil
+}
+
+func (_u *PaperTradeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(papertrade.Table, papertrade.Columns, sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(papertrade.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(papertrade.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(papertrade.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(papertrade.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(papertrade.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(papertrade.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(papertrade.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Commission(); ok {
+		_spec.SetField(papertrade.FieldCommission, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.RealizedPnl(); ok {
+		_spec.SetField(papertrade.FieldRealizedPnl, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(papertrade.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   papertrade.AccountTable,
+			Columns: []string{papertrade.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   papertrade.AccountTable,
+			Columns: []string{papertrade.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{papertrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// PaperTradeUpdateOne is the builder for updating a single PaperTrade entity.
+type PaperTradeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PaperTradeMutation
+}
+
+// SetAccountID sets the "account_id" field.
+func (_u *PaperTradeUpdateOne) SetAccountID(v uuid.UUID) *PaperTradeUpdateOne {
+	_u.mutation.SetAccountID(v)
+	return _u
+}
+
+// SetNillableAccountID sets the "account_id" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableAccountID(v *uuid.UUID) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetAccountID(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *PaperTradeUpdateOne) SetStrategyID(v uuid.UUID) *PaperTradeUpdateOne {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableStrategyID(v *uuid.UUID) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *PaperTradeUpdateOne) ClearStrategyID() *PaperTradeUpdateOne {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *PaperTradeUpdateOne) SetClientOrderID(v string) *PaperTradeUpdateOne {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableClientOrderID(v *string) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *PaperTradeUpdateOne) SetSymbol(v string) *PaperTradeUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableSymbol(v *string) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *PaperTradeUpdateOne) SetSide(v papertrade.Side) *PaperTradeUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableSide(v *papertrade.Side) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *PaperTradeUpdateOne) SetQuantity(v decimal.Decimal) *PaperTradeUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableQuantity(v *decimal.Decimal) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *PaperTradeUpdateOne) SetPrice(v decimal.Decimal) *PaperTradeUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillablePrice(v *decimal.Decimal) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetCommission sets the "commission" field.
+func (_u *PaperTradeUpdateOne) SetCommission(v decimal.Decimal) *PaperTradeUpdateOne {
+	_u.mutation.SetCommission(v)
+	return _u
+}
+
+// SetNillableCommission sets the "commission" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableCommission(v *decimal.Decimal) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetCommission(*v)
+	}
+	return _u
+}
+
+// SetRealizedPnl sets the "realized_pnl" field.
+func (_u *PaperTradeUpdateOne) SetRealizedPnl(v decimal.Decimal) *PaperTradeUpdateOne {
+	_u.mutation.SetRealizedPnl(v)
+	return _u
+}
+
+// SetNillableRealizedPnl sets the "realized_pnl" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableRealizedPnl(v *decimal.Decimal) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetRealizedPnl(*v)
+	}
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *PaperTradeUpdateOne) SetExecutedAt(v time.Time) *PaperTradeUpdateOne {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *PaperTradeUpdateOne) SetNillableExecutedAt(v *time.Time) *PaperTradeUpdateOne {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" edge to the PaperAccount entity.
+func (_u *PaperTradeUpdateOne) SetAccount(v *PaperAccount) *PaperTradeUpdateOne {
+	return _u.SetAccountID(v.ID)
+}
+
+// Mutation returns the PaperTradeMutation object of the builder.
+func (_u *PaperTradeUpdateOne) Mutation() *PaperTradeMutation {
+	return _u.mutation
+}
+
+// ClearAccount clears the "account" edge to the PaperAccount entity.
+func (_u *PaperTradeUpdateOne) ClearAccount() *PaperTradeUpdateOne {
+	_u.mutation.ClearAccount()
+	return _u
+}
+
+// Where appends a list predicates to the PaperTradeUpdate builder.
+func (_u *PaperTradeUpdateOne) Where(ps ...predicate.PaperTrade) *PaperTradeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *PaperTradeUpdateOne) Select(field string, fields ...string) *PaperTradeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated PaperTrade entity.
+func (_u *PaperTradeUpdateOne) Save(ctx context.Context) (*PaperTrade, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *PaperTradeUpdateOne) SaveX(ctx context.Context) *PaperTrade {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *PaperTradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *PaperTradeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *PaperTradeUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := papertrade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := papertrade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := papertrade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "PaperTrade.side": %w`, err)}
+		}
+	}
+	if _u.mutation.AccountCleared() && len(_u.mutation.AccountIDs()) > 0 {
+		return errors.New(`ent: clearing a required unique edge "PaperTrade.account"`)
+	}
+	return nil
+}
+
+func (_u *PaperTradeUpdateOne) sqlSave(ctx context.Context) (_node *PaperTrade, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(papertrade.Table, papertrade.Columns, sqlgraph.NewFieldSpec(papertrade.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PaperTrade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, papertrade.FieldID)
+		for _, f := range fields {
+			if !papertrade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != papertrade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(papertrade.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(papertrade.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(papertrade.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(papertrade.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(papertrade.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(papertrade.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(papertrade.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Commission(); ok {
+		_spec.SetField(papertrade.FieldCommission, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.RealizedPnl(); ok {
+		_spec.SetField(papertrade.FieldRealizedPnl, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(papertrade.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AccountCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   papertrade.AccountTable,
+			Columns: []string{papertrade.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.AccountIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   papertrade.AccountTable,
+			Columns: []string{papertrade.AccountColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(paperaccount.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &PaperTrade{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{papertrade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
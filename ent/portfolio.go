@@ -24,6 +24,8 @@ type Portfolio struct {
 	UserID uuid.UUID `json:"user_id,omitempty"`
 	// Symbol holds the value of the "symbol" field.
 	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
 	// Quantity holds the value of the "quantity" field.
 	Quantity decimal.Decimal `json:"quantity,omitempty"`
 	// AveragePrice holds the value of the "average_price" field.
@@ -79,7 +81,7 @@ func (*Portfolio) scanValues(columns []string) ([]any, error) {
 			values[i] = &sql.NullScanner{S: new(decimal.Decimal)}
 		case portfolio.FieldQuantity, portfolio.FieldAveragePrice, portfolio.FieldMarketValue, portfolio.FieldTotalCost, portfolio.FieldUnrealizedPnl, portfolio.FieldRealizedPnl:
 			values[i] = new(decimal.Decimal)
-		case portfolio.FieldSymbol:
+		case portfolio.FieldSymbol, portfolio.FieldExchange:
 			values[i] = new(sql.NullString)
 		case portfolio.FieldLastUpdated, portfolio.FieldCreatedAt, portfolio.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -118,6 +120,12 @@ func (_m *Portfolio) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Symbol = value.String
 			}
+		case portfolio.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
 		case portfolio.FieldQuantity:
 			if value, ok := values[i].(*decimal.Decimal); !ok {
 				return fmt.Errorf("unexpected type %T for field quantity", values[i])
@@ -228,6 +236,9 @@ func (_m *Portfolio) String() string {
 	builder.WriteString("symbol=")
 	builder.WriteString(_m.Symbol)
 	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
 	builder.WriteString("quantity=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
 	builder.WriteString(", ")
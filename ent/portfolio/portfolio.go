@@ -20,6 +20,8 @@ const (
 	FieldUserID = "user_id"
 	// FieldSymbol holds the string denoting the symbol field in the database.
 	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
 	// FieldQuantity holds the string denoting the quantity field in the database.
 	FieldQuantity = "quantity"
 	// FieldAveragePrice holds the string denoting the average_price field in the database.
@@ -58,6 +60,7 @@ var Columns = []string{
 	FieldID,
 	FieldUserID,
 	FieldSymbol,
+	FieldExchange,
 	FieldQuantity,
 	FieldAveragePrice,
 	FieldCurrentPrice,
@@ -83,6 +86,8 @@ func ValidColumn(column string) bool {
 var (
 	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
 	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
 	// DefaultQuantity holds the default value on creation for the "quantity" field.
 	DefaultQuantity decimal.Decimal
 	// DefaultAveragePrice holds the default value on creation for the "average_price" field.
@@ -127,6 +132,11 @@ func BySymbol(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
 }
 
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
 // ByQuantity orders the results by the quantity field.
 func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
@@ -67,6 +67,11 @@ func Symbol(v string) predicate.Portfolio {
 	return predicate.Portfolio(sql.FieldEQ(FieldSymbol, v))
 }
 
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldEQ(FieldExchange, v))
+}
+
 // Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
 func Quantity(v decimal.Decimal) predicate.Portfolio {
 	return predicate.Portfolio(sql.FieldEQ(FieldQuantity, v))
@@ -202,6 +207,81 @@ func SymbolContainsFold(v string) predicate.Portfolio {
 	return predicate.Portfolio(sql.FieldContainsFold(FieldSymbol, v))
 }
 
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeIsNil applies the IsNil predicate on the "exchange" field.
+func ExchangeIsNil() predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldIsNull(FieldExchange))
+}
+
+// ExchangeNotNil applies the NotNil predicate on the "exchange" field.
+func ExchangeNotNil() predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldNotNull(FieldExchange))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Portfolio {
+	return predicate.Portfolio(sql.FieldContainsFold(FieldExchange, v))
+}
+
 // QuantityEQ applies the EQ predicate on the "quantity" field.
 func QuantityEQ(v decimal.Decimal) predicate.Portfolio {
 	return predicate.Portfolio(sql.FieldEQ(FieldQuantity, v))
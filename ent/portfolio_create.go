@@ -35,6 +35,20 @@ func (_c *PortfolioCreate) SetSymbol(v string) *PortfolioCreate {
 	return _c
 }
 
+// SetExchange sets the "exchange" field.
+func (_c *PortfolioCreate) SetExchange(v string) *PortfolioCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_c *PortfolioCreate) SetNillableExchange(v *string) *PortfolioCreate {
+	if v != nil {
+		_c.SetExchange(*v)
+	}
+	return _c
+}
+
 // SetQuantity sets the "quantity" field.
 func (_c *PortfolioCreate) SetQuantity(v decimal.Decimal) *PortfolioCreate {
 	_c.mutation.SetQuantity(v)
@@ -284,6 +298,11 @@ func (_c *PortfolioCreate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Portfolio.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := portfolio.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Portfolio.exchange": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.Quantity(); !ok {
 		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Portfolio.quantity"`)}
 	}
@@ -347,6 +366,10 @@ func (_c *PortfolioCreate) createSpec() (*Portfolio, *sqlgraph.CreateSpec) {
 		_spec.SetField(portfolio.FieldSymbol, field.TypeString, value)
 		_node.Symbol = value
 	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(portfolio.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
 	if value, ok := _c.mutation.Quantity(); ok {
 		_spec.SetField(portfolio.FieldQuantity, field.TypeOther, value)
 		_node.Quantity = value
@@ -59,6 +59,26 @@ func (_u *PortfolioUpdate) SetNillableSymbol(v *string) *PortfolioUpdate {
 	return _u
 }
 
+// SetExchange sets the "exchange" field.
+func (_u *PortfolioUpdate) SetExchange(v string) *PortfolioUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *PortfolioUpdate) SetNillableExchange(v *string) *PortfolioUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *PortfolioUpdate) ClearExchange() *PortfolioUpdate {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
 // SetQuantity sets the "quantity" field.
 func (_u *PortfolioUpdate) SetQuantity(v decimal.Decimal) *PortfolioUpdate {
 	_u.mutation.SetQuantity(v)
@@ -244,6 +264,11 @@ func (_u *PortfolioUpdate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Portfolio.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := portfolio.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Portfolio.exchange": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Portfolio.user"`)
 	}
@@ -265,6 +290,12 @@ func (_u *PortfolioUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Symbol(); ok {
 		_spec.SetField(portfolio.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(portfolio.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(portfolio.FieldExchange, field.TypeString)
+	}
 	if value, ok := _u.mutation.Quantity(); ok {
 		_spec.SetField(portfolio.FieldQuantity, field.TypeOther, value)
 	}
@@ -378,6 +409,26 @@ func (_u *PortfolioUpdateOne) SetNillableSymbol(v *string) *PortfolioUpdateOne {
 	return _u
 }
 
+// SetExchange sets the "exchange" field.
+func (_u *PortfolioUpdateOne) SetExchange(v string) *PortfolioUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *PortfolioUpdateOne) SetNillableExchange(v *string) *PortfolioUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *PortfolioUpdateOne) ClearExchange() *PortfolioUpdateOne {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
 // SetQuantity sets the "quantity" field.
 func (_u *PortfolioUpdateOne) SetQuantity(v decimal.Decimal) *PortfolioUpdateOne {
 	_u.mutation.SetQuantity(v)
@@ -576,6 +627,11 @@ func (_u *PortfolioUpdateOne) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Portfolio.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := portfolio.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Portfolio.exchange": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Portfolio.user"`)
 	}
@@ -614,6 +670,12 @@ func (_u *PortfolioUpdateOne) sqlSave(ctx context.Context) (_node *Portfolio, er
 	if value, ok := _u.mutation.Symbol(); ok {
 		_spec.SetField(portfolio.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(portfolio.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(portfolio.FieldExchange, field.TypeString)
+	}
 	if value, ok := _u.mutation.Quantity(); ok {
 		_spec.SetField(portfolio.FieldQuantity, field.TypeOther, value)
 	}
@@ -6,9 +6,42 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// BacktestResult is the predicate function for backtestresult builders.
+type BacktestResult func(*sql.Selector)
+
+// BrokerAccount is the predicate function for brokeraccount builders.
+type BrokerAccount func(*sql.Selector)
+
+// Candle is the predicate function for candle builders.
+type Candle func(*sql.Selector)
+
+// KillSwitch is the predicate function for killswitch builders.
+type KillSwitch func(*sql.Selector)
+
+// Order is the predicate function for order builders.
+type Order func(*sql.Selector)
+
+// PaperAccount is the predicate function for paperaccount builders.
+type PaperAccount func(*sql.Selector)
+
+// PaperPosition is the predicate function for paperposition builders.
+type PaperPosition func(*sql.Selector)
+
+// PaperTrade is the predicate function for papertrade builders.
+type PaperTrade func(*sql.Selector)
+
 // Portfolio is the predicate function for portfolio builders.
 type Portfolio func(*sql.Selector)
 
+// ProtectionRule is the predicate function for protectionrule builders.
+type ProtectionRule func(*sql.Selector)
+
+// RiskEvent is the predicate function for riskevent builders.
+type RiskEvent func(*sql.Selector)
+
+// RiskState is the predicate function for riskstate builders.
+type RiskState func(*sql.Selector)
+
 // Strategy is the predicate function for strategy builders.
 type Strategy func(*sql.Selector)
 
@@ -24,5 +57,14 @@ type StrategyStatus func(*sql.Selector)
 // StrategyTemplate is the predicate function for strategytemplate builders.
 type StrategyTemplate func(*sql.Selector)
 
+// Symbol is the predicate function for symbol builders.
+type Symbol func(*sql.Selector)
+
+// TaxLot is the predicate function for taxlot builders.
+type TaxLot func(*sql.Selector)
+
+// Trade is the predicate function for trade builders.
+type Trade func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
@@ -0,0 +1,336 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/protectionrule"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProtectionRule is the model entity for the ProtectionRule schema.
+type ProtectionRule struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account protectionrule.Account `json:"account,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID *uuid.UUID `json:"strategy_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// StopLoss holds the value of the "stop_loss" field.
+	StopLoss float64 `json:"stop_loss,omitempty"`
+	// TakeProfit holds the value of the "take_profit" field.
+	TakeProfit float64 `json:"take_profit,omitempty"`
+	// TrailingPercent holds the value of the "trailing_percent" field.
+	TrailingPercent float64 `json:"trailing_percent,omitempty"`
+	// TrailingAtr holds the value of the "trailing_atr" field.
+	TrailingAtr float64 `json:"trailing_atr,omitempty"`
+	// AtrPeriod holds the value of the "atr_period" field.
+	AtrPeriod int `json:"atr_period,omitempty"`
+	// MaxHoldMinutes holds the value of the "max_hold_minutes" field.
+	MaxHoldMinutes int `json:"max_hold_minutes,omitempty"`
+	// Active holds the value of the "active" field.
+	Active bool `json:"active,omitempty"`
+	// WaterMark holds the value of the "water_mark" field.
+	WaterMark decimal.Decimal `json:"water_mark,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt *time.Time `json:"opened_at,omitempty"`
+	// TriggeredAt holds the value of the "triggered_at" field.
+	TriggeredAt *time.Time `json:"triggered_at,omitempty"`
+	// TriggerReason holds the value of the "trigger_reason" field.
+	TriggerReason string `json:"trigger_reason,omitempty"`
+	// TriggerPrice holds the value of the "trigger_price" field.
+	TriggerPrice decimal.Decimal `json:"trigger_price,omitempty"`
+	// ExitClientOrderID holds the value of the "exit_client_order_id" field.
+	ExitClientOrderID string `json:"exit_client_order_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ProtectionRule) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case protectionrule.FieldStrategyID:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case protectionrule.FieldWaterMark, protectionrule.FieldTriggerPrice:
+			values[i] = new(decimal.Decimal)
+		case protectionrule.FieldActive:
+			values[i] = new(sql.NullBool)
+		case protectionrule.FieldStopLoss, protectionrule.FieldTakeProfit, protectionrule.FieldTrailingPercent, protectionrule.FieldTrailingAtr:
+			values[i] = new(sql.NullFloat64)
+		case protectionrule.FieldAtrPeriod, protectionrule.FieldMaxHoldMinutes:
+			values[i] = new(sql.NullInt64)
+		case protectionrule.FieldAccount, protectionrule.FieldSymbol, protectionrule.FieldExchange, protectionrule.FieldTriggerReason, protectionrule.FieldExitClientOrderID:
+			values[i] = new(sql.NullString)
+		case protectionrule.FieldOpenedAt, protectionrule.FieldTriggeredAt, protectionrule.FieldCreatedAt, protectionrule.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case protectionrule.FieldID, protectionrule.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ProtectionRule fields.
+func (_m *ProtectionRule) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case protectionrule.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case protectionrule.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case protectionrule.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = protectionrule.Account(value.String)
+			}
+		case protectionrule.FieldStrategyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value.Valid {
+				_m.StrategyID = new(uuid.UUID)
+				*_m.StrategyID = *value.S.(*uuid.UUID)
+			}
+		case protectionrule.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case protectionrule.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case protectionrule.FieldStopLoss:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field stop_loss", values[i])
+			} else if value.Valid {
+				_m.StopLoss = value.Float64
+			}
+		case protectionrule.FieldTakeProfit:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field take_profit", values[i])
+			} else if value.Valid {
+				_m.TakeProfit = value.Float64
+			}
+		case protectionrule.FieldTrailingPercent:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailing_percent", values[i])
+			} else if value.Valid {
+				_m.TrailingPercent = value.Float64
+			}
+		case protectionrule.FieldTrailingAtr:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field trailing_atr", values[i])
+			} else if value.Valid {
+				_m.TrailingAtr = value.Float64
+			}
+		case protectionrule.FieldAtrPeriod:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field atr_period", values[i])
+			} else if value.Valid {
+				_m.AtrPeriod = int(value.Int64)
+			}
+		case protectionrule.FieldMaxHoldMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field max_hold_minutes", values[i])
+			} else if value.Valid {
+				_m.MaxHoldMinutes = int(value.Int64)
+			}
+		case protectionrule.FieldActive:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field active", values[i])
+			} else if value.Valid {
+				_m.Active = value.Bool
+			}
+		case protectionrule.FieldWaterMark:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field water_mark", values[i])
+			} else if value != nil {
+				_m.WaterMark = *value
+			}
+		case protectionrule.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				_m.OpenedAt = new(time.Time)
+				*_m.OpenedAt = value.Time
+			}
+		case protectionrule.FieldTriggeredAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field triggered_at", values[i])
+			} else if value.Valid {
+				_m.TriggeredAt = new(time.Time)
+				*_m.TriggeredAt = value.Time
+			}
+		case protectionrule.FieldTriggerReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_reason", values[i])
+			} else if value.Valid {
+				_m.TriggerReason = value.String
+			}
+		case protectionrule.FieldTriggerPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field trigger_price", values[i])
+			} else if value != nil {
+				_m.TriggerPrice = *value
+			}
+		case protectionrule.FieldExitClientOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exit_client_order_id", values[i])
+			} else if value.Valid {
+				_m.ExitClientOrderID = value.String
+			}
+		case protectionrule.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case protectionrule.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ProtectionRule.
+// This includes values selected through modifiers, order, etc.
+func (_m *ProtectionRule) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this ProtectionRule.
+// Note that you need to call ProtectionRule.Unwrap() before calling this method if this ProtectionRule
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *ProtectionRule) Update() *ProtectionRuleUpdateOne {
+	return NewProtectionRuleClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the ProtectionRule entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *ProtectionRule) Unwrap() *ProtectionRule {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ProtectionRule is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *ProtectionRule) String() string {
+	var builder strings.Builder
+	builder.WriteString("ProtectionRule(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Account))
+	builder.WriteString(", ")
+	if v := _m.StrategyID; v != nil {
+		builder.WriteString("strategy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("stop_loss=")
+	builder.WriteString(fmt.Sprintf("%v", _m.StopLoss))
+	builder.WriteString(", ")
+	builder.WriteString("take_profit=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TakeProfit))
+	builder.WriteString(", ")
+	builder.WriteString("trailing_percent=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrailingPercent))
+	builder.WriteString(", ")
+	builder.WriteString("trailing_atr=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TrailingAtr))
+	builder.WriteString(", ")
+	builder.WriteString("atr_period=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AtrPeriod))
+	builder.WriteString(", ")
+	builder.WriteString("max_hold_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", _m.MaxHoldMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("active=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Active))
+	builder.WriteString(", ")
+	builder.WriteString("water_mark=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WaterMark))
+	builder.WriteString(", ")
+	if v := _m.OpenedAt; v != nil {
+		builder.WriteString("opened_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.TriggeredAt; v != nil {
+		builder.WriteString("triggered_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("trigger_reason=")
+	builder.WriteString(_m.TriggerReason)
+	builder.WriteString(", ")
+	builder.WriteString("trigger_price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TriggerPrice))
+	builder.WriteString(", ")
+	builder.WriteString("exit_client_order_id=")
+	builder.WriteString(_m.ExitClientOrderID)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ProtectionRules is a parsable slice of ProtectionRule.
+type ProtectionRules []*ProtectionRule
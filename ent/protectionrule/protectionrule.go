@@ -0,0 +1,264 @@
+// Code generated by ent, DO NOT EDIT.
+
+package protectionrule
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the protectionrule type in the database.
+	Label = "protection_rule"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldStopLoss holds the string denoting the stop_loss field in the database.
+	FieldStopLoss = "stop_loss"
+	// FieldTakeProfit holds the string denoting the take_profit field in the database.
+	FieldTakeProfit = "take_profit"
+	// FieldTrailingPercent holds the string denoting the trailing_percent field in the database.
+	FieldTrailingPercent = "trailing_percent"
+	// FieldTrailingAtr holds the string denoting the trailing_atr field in the database.
+	FieldTrailingAtr = "trailing_atr"
+	// FieldAtrPeriod holds the string denoting the atr_period field in the database.
+	FieldAtrPeriod = "atr_period"
+	// FieldMaxHoldMinutes holds the string denoting the max_hold_minutes field in the database.
+	FieldMaxHoldMinutes = "max_hold_minutes"
+	// FieldActive holds the string denoting the active field in the database.
+	FieldActive = "active"
+	// FieldWaterMark holds the string denoting the water_mark field in the database.
+	FieldWaterMark = "water_mark"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldTriggeredAt holds the string denoting the triggered_at field in the database.
+	FieldTriggeredAt = "triggered_at"
+	// FieldTriggerReason holds the string denoting the trigger_reason field in the database.
+	FieldTriggerReason = "trigger_reason"
+	// FieldTriggerPrice holds the string denoting the trigger_price field in the database.
+	FieldTriggerPrice = "trigger_price"
+	// FieldExitClientOrderID holds the string denoting the exit_client_order_id field in the database.
+	FieldExitClientOrderID = "exit_client_order_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the protectionrule in the database.
+	Table = "protection_rules"
+)
+
+// Columns holds all SQL columns for protectionrule fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAccount,
+	FieldStrategyID,
+	FieldSymbol,
+	FieldExchange,
+	FieldStopLoss,
+	FieldTakeProfit,
+	FieldTrailingPercent,
+	FieldTrailingAtr,
+	FieldAtrPeriod,
+	FieldMaxHoldMinutes,
+	FieldActive,
+	FieldWaterMark,
+	FieldOpenedAt,
+	FieldTriggeredAt,
+	FieldTriggerReason,
+	FieldTriggerPrice,
+	FieldExitClientOrderID,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// DefaultStopLoss holds the default value on creation for the "stop_loss" field.
+	DefaultStopLoss float64
+	// DefaultTakeProfit holds the default value on creation for the "take_profit" field.
+	DefaultTakeProfit float64
+	// DefaultTrailingPercent holds the default value on creation for the "trailing_percent" field.
+	DefaultTrailingPercent float64
+	// DefaultTrailingAtr holds the default value on creation for the "trailing_atr" field.
+	DefaultTrailingAtr float64
+	// DefaultAtrPeriod holds the default value on creation for the "atr_period" field.
+	DefaultAtrPeriod int
+	// DefaultMaxHoldMinutes holds the default value on creation for the "max_hold_minutes" field.
+	DefaultMaxHoldMinutes int
+	// DefaultActive holds the default value on creation for the "active" field.
+	DefaultActive bool
+	// DefaultWaterMark holds the default value on creation for the "water_mark" field.
+	DefaultWaterMark decimal.Decimal
+	// TriggerReasonValidator is a validator for the "trigger_reason" field. It is called by the builders before save.
+	TriggerReasonValidator func(string) error
+	// DefaultTriggerPrice holds the default value on creation for the "trigger_price" field.
+	DefaultTriggerPrice decimal.Decimal
+	// ExitClientOrderIDValidator is a validator for the "exit_client_order_id" field. It is called by the builders before save.
+	ExitClientOrderIDValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Account defines the type for the "account" enum field.
+type Account string
+
+// Account values.
+const (
+	AccountLIVE  Account = "LIVE"
+	AccountPAPER Account = "PAPER"
+)
+
+func (a Account) String() string {
+	return string(a)
+}
+
+// AccountValidator is a validator for the "account" field enum values. It is called by the builders before save.
+func AccountValidator(a Account) error {
+	switch a {
+	case AccountLIVE, AccountPAPER:
+		return nil
+	default:
+		return fmt.Errorf("protectionrule: invalid enum value for account field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the ProtectionRule queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByStopLoss orders the results by the stop_loss field.
+func ByStopLoss(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStopLoss, opts...).ToFunc()
+}
+
+// ByTakeProfit orders the results by the take_profit field.
+func ByTakeProfit(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTakeProfit, opts...).ToFunc()
+}
+
+// ByTrailingPercent orders the results by the trailing_percent field.
+func ByTrailingPercent(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingPercent, opts...).ToFunc()
+}
+
+// ByTrailingAtr orders the results by the trailing_atr field.
+func ByTrailingAtr(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTrailingAtr, opts...).ToFunc()
+}
+
+// ByAtrPeriod orders the results by the atr_period field.
+func ByAtrPeriod(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAtrPeriod, opts...).ToFunc()
+}
+
+// ByMaxHoldMinutes orders the results by the max_hold_minutes field.
+func ByMaxHoldMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldMaxHoldMinutes, opts...).ToFunc()
+}
+
+// ByActive orders the results by the active field.
+func ByActive(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldActive, opts...).ToFunc()
+}
+
+// ByWaterMark orders the results by the water_mark field.
+func ByWaterMark(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWaterMark, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByTriggeredAt orders the results by the triggered_at field.
+func ByTriggeredAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggeredAt, opts...).ToFunc()
+}
+
+// ByTriggerReason orders the results by the trigger_reason field.
+func ByTriggerReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerReason, opts...).ToFunc()
+}
+
+// ByTriggerPrice orders the results by the trigger_price field.
+func ByTriggerPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTriggerPrice, opts...).ToFunc()
+}
+
+// ByExitClientOrderID orders the results by the exit_client_order_id field.
+func ByExitClientOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExitClientOrderID, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1077 @@
+// Code generated by ent, DO NOT EDIT.
+
+package protectionrule
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldExchange, v))
+}
+
+// StopLoss applies equality check predicate on the "stop_loss" field. It's identical to StopLossEQ.
+func StopLoss(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldStopLoss, v))
+}
+
+// TakeProfit applies equality check predicate on the "take_profit" field. It's identical to TakeProfitEQ.
+func TakeProfit(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTakeProfit, v))
+}
+
+// TrailingPercent applies equality check predicate on the "trailing_percent" field. It's identical to TrailingPercentEQ.
+func TrailingPercent(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTrailingPercent, v))
+}
+
+// TrailingAtr applies equality check predicate on the "trailing_atr" field. It's identical to TrailingAtrEQ.
+func TrailingAtr(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTrailingAtr, v))
+}
+
+// AtrPeriod applies equality check predicate on the "atr_period" field. It's identical to AtrPeriodEQ.
+func AtrPeriod(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldAtrPeriod, v))
+}
+
+// MaxHoldMinutes applies equality check predicate on the "max_hold_minutes" field. It's identical to MaxHoldMinutesEQ.
+func MaxHoldMinutes(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldMaxHoldMinutes, v))
+}
+
+// Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
+func Active(v bool) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldActive, v))
+}
+
+// WaterMark applies equality check predicate on the "water_mark" field. It's identical to WaterMarkEQ.
+func WaterMark(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldWaterMark, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// TriggeredAt applies equality check predicate on the "triggered_at" field. It's identical to TriggeredAtEQ.
+func TriggeredAt(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// TriggerReason applies equality check predicate on the "trigger_reason" field. It's identical to TriggerReasonEQ.
+func TriggerReason(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggerReason, v))
+}
+
+// TriggerPrice applies equality check predicate on the "trigger_price" field. It's identical to TriggerPriceEQ.
+func TriggerPrice(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggerPrice, v))
+}
+
+// ExitClientOrderID applies equality check predicate on the "exit_client_order_id" field. It's identical to ExitClientOrderIDEQ.
+func ExitClientOrderID(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldExitClientOrderID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldUserID, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v Account) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v Account) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...Account) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...Account) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDGT applies the GT predicate on the "strategy_id" field.
+func StrategyIDGT(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldStrategyID, v))
+}
+
+// StrategyIDGTE applies the GTE predicate on the "strategy_id" field.
+func StrategyIDGTE(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldStrategyID, v))
+}
+
+// StrategyIDLT applies the LT predicate on the "strategy_id" field.
+func StrategyIDLT(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldStrategyID, v))
+}
+
+// StrategyIDLTE applies the LTE predicate on the "strategy_id" field.
+func StrategyIDLTE(v uuid.UUID) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldStrategyID, v))
+}
+
+// StrategyIDIsNil applies the IsNil predicate on the "strategy_id" field.
+func StrategyIDIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldStrategyID))
+}
+
+// StrategyIDNotNil applies the NotNil predicate on the "strategy_id" field.
+func StrategyIDNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldStrategyID))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeIsNil applies the IsNil predicate on the "exchange" field.
+func ExchangeIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldExchange))
+}
+
+// ExchangeNotNil applies the NotNil predicate on the "exchange" field.
+func ExchangeNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldExchange))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// StopLossEQ applies the EQ predicate on the "stop_loss" field.
+func StopLossEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldStopLoss, v))
+}
+
+// StopLossNEQ applies the NEQ predicate on the "stop_loss" field.
+func StopLossNEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldStopLoss, v))
+}
+
+// StopLossIn applies the In predicate on the "stop_loss" field.
+func StopLossIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldStopLoss, vs...))
+}
+
+// StopLossNotIn applies the NotIn predicate on the "stop_loss" field.
+func StopLossNotIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldStopLoss, vs...))
+}
+
+// StopLossGT applies the GT predicate on the "stop_loss" field.
+func StopLossGT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldStopLoss, v))
+}
+
+// StopLossGTE applies the GTE predicate on the "stop_loss" field.
+func StopLossGTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldStopLoss, v))
+}
+
+// StopLossLT applies the LT predicate on the "stop_loss" field.
+func StopLossLT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldStopLoss, v))
+}
+
+// StopLossLTE applies the LTE predicate on the "stop_loss" field.
+func StopLossLTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldStopLoss, v))
+}
+
+// TakeProfitEQ applies the EQ predicate on the "take_profit" field.
+func TakeProfitEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTakeProfit, v))
+}
+
+// TakeProfitNEQ applies the NEQ predicate on the "take_profit" field.
+func TakeProfitNEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTakeProfit, v))
+}
+
+// TakeProfitIn applies the In predicate on the "take_profit" field.
+func TakeProfitIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTakeProfit, vs...))
+}
+
+// TakeProfitNotIn applies the NotIn predicate on the "take_profit" field.
+func TakeProfitNotIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTakeProfit, vs...))
+}
+
+// TakeProfitGT applies the GT predicate on the "take_profit" field.
+func TakeProfitGT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTakeProfit, v))
+}
+
+// TakeProfitGTE applies the GTE predicate on the "take_profit" field.
+func TakeProfitGTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTakeProfit, v))
+}
+
+// TakeProfitLT applies the LT predicate on the "take_profit" field.
+func TakeProfitLT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTakeProfit, v))
+}
+
+// TakeProfitLTE applies the LTE predicate on the "take_profit" field.
+func TakeProfitLTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTakeProfit, v))
+}
+
+// TrailingPercentEQ applies the EQ predicate on the "trailing_percent" field.
+func TrailingPercentEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTrailingPercent, v))
+}
+
+// TrailingPercentNEQ applies the NEQ predicate on the "trailing_percent" field.
+func TrailingPercentNEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTrailingPercent, v))
+}
+
+// TrailingPercentIn applies the In predicate on the "trailing_percent" field.
+func TrailingPercentIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTrailingPercent, vs...))
+}
+
+// TrailingPercentNotIn applies the NotIn predicate on the "trailing_percent" field.
+func TrailingPercentNotIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTrailingPercent, vs...))
+}
+
+// TrailingPercentGT applies the GT predicate on the "trailing_percent" field.
+func TrailingPercentGT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTrailingPercent, v))
+}
+
+// TrailingPercentGTE applies the GTE predicate on the "trailing_percent" field.
+func TrailingPercentGTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTrailingPercent, v))
+}
+
+// TrailingPercentLT applies the LT predicate on the "trailing_percent" field.
+func TrailingPercentLT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTrailingPercent, v))
+}
+
+// TrailingPercentLTE applies the LTE predicate on the "trailing_percent" field.
+func TrailingPercentLTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTrailingPercent, v))
+}
+
+// TrailingAtrEQ applies the EQ predicate on the "trailing_atr" field.
+func TrailingAtrEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTrailingAtr, v))
+}
+
+// TrailingAtrNEQ applies the NEQ predicate on the "trailing_atr" field.
+func TrailingAtrNEQ(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTrailingAtr, v))
+}
+
+// TrailingAtrIn applies the In predicate on the "trailing_atr" field.
+func TrailingAtrIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTrailingAtr, vs...))
+}
+
+// TrailingAtrNotIn applies the NotIn predicate on the "trailing_atr" field.
+func TrailingAtrNotIn(vs ...float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTrailingAtr, vs...))
+}
+
+// TrailingAtrGT applies the GT predicate on the "trailing_atr" field.
+func TrailingAtrGT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTrailingAtr, v))
+}
+
+// TrailingAtrGTE applies the GTE predicate on the "trailing_atr" field.
+func TrailingAtrGTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTrailingAtr, v))
+}
+
+// TrailingAtrLT applies the LT predicate on the "trailing_atr" field.
+func TrailingAtrLT(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTrailingAtr, v))
+}
+
+// TrailingAtrLTE applies the LTE predicate on the "trailing_atr" field.
+func TrailingAtrLTE(v float64) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTrailingAtr, v))
+}
+
+// AtrPeriodEQ applies the EQ predicate on the "atr_period" field.
+func AtrPeriodEQ(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldAtrPeriod, v))
+}
+
+// AtrPeriodNEQ applies the NEQ predicate on the "atr_period" field.
+func AtrPeriodNEQ(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldAtrPeriod, v))
+}
+
+// AtrPeriodIn applies the In predicate on the "atr_period" field.
+func AtrPeriodIn(vs ...int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldAtrPeriod, vs...))
+}
+
+// AtrPeriodNotIn applies the NotIn predicate on the "atr_period" field.
+func AtrPeriodNotIn(vs ...int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldAtrPeriod, vs...))
+}
+
+// AtrPeriodGT applies the GT predicate on the "atr_period" field.
+func AtrPeriodGT(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldAtrPeriod, v))
+}
+
+// AtrPeriodGTE applies the GTE predicate on the "atr_period" field.
+func AtrPeriodGTE(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldAtrPeriod, v))
+}
+
+// AtrPeriodLT applies the LT predicate on the "atr_period" field.
+func AtrPeriodLT(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldAtrPeriod, v))
+}
+
+// AtrPeriodLTE applies the LTE predicate on the "atr_period" field.
+func AtrPeriodLTE(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldAtrPeriod, v))
+}
+
+// MaxHoldMinutesEQ applies the EQ predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesEQ(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldMaxHoldMinutes, v))
+}
+
+// MaxHoldMinutesNEQ applies the NEQ predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesNEQ(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldMaxHoldMinutes, v))
+}
+
+// MaxHoldMinutesIn applies the In predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesIn(vs ...int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldMaxHoldMinutes, vs...))
+}
+
+// MaxHoldMinutesNotIn applies the NotIn predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesNotIn(vs ...int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldMaxHoldMinutes, vs...))
+}
+
+// MaxHoldMinutesGT applies the GT predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesGT(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldMaxHoldMinutes, v))
+}
+
+// MaxHoldMinutesGTE applies the GTE predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesGTE(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldMaxHoldMinutes, v))
+}
+
+// MaxHoldMinutesLT applies the LT predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesLT(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldMaxHoldMinutes, v))
+}
+
+// MaxHoldMinutesLTE applies the LTE predicate on the "max_hold_minutes" field.
+func MaxHoldMinutesLTE(v int) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldMaxHoldMinutes, v))
+}
+
+// ActiveEQ applies the EQ predicate on the "active" field.
+func ActiveEQ(v bool) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldActive, v))
+}
+
+// ActiveNEQ applies the NEQ predicate on the "active" field.
+func ActiveNEQ(v bool) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldActive, v))
+}
+
+// WaterMarkEQ applies the EQ predicate on the "water_mark" field.
+func WaterMarkEQ(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldWaterMark, v))
+}
+
+// WaterMarkNEQ applies the NEQ predicate on the "water_mark" field.
+func WaterMarkNEQ(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldWaterMark, v))
+}
+
+// WaterMarkIn applies the In predicate on the "water_mark" field.
+func WaterMarkIn(vs ...decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldWaterMark, vs...))
+}
+
+// WaterMarkNotIn applies the NotIn predicate on the "water_mark" field.
+func WaterMarkNotIn(vs ...decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldWaterMark, vs...))
+}
+
+// WaterMarkGT applies the GT predicate on the "water_mark" field.
+func WaterMarkGT(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldWaterMark, v))
+}
+
+// WaterMarkGTE applies the GTE predicate on the "water_mark" field.
+func WaterMarkGTE(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldWaterMark, v))
+}
+
+// WaterMarkLT applies the LT predicate on the "water_mark" field.
+func WaterMarkLT(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldWaterMark, v))
+}
+
+// WaterMarkLTE applies the LTE predicate on the "water_mark" field.
+func WaterMarkLTE(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldWaterMark, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// OpenedAtIsNil applies the IsNil predicate on the "opened_at" field.
+func OpenedAtIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldOpenedAt))
+}
+
+// OpenedAtNotNil applies the NotNil predicate on the "opened_at" field.
+func OpenedAtNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldOpenedAt))
+}
+
+// TriggeredAtEQ applies the EQ predicate on the "triggered_at" field.
+func TriggeredAtEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtNEQ applies the NEQ predicate on the "triggered_at" field.
+func TriggeredAtNEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIn applies the In predicate on the "triggered_at" field.
+func TriggeredAtIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtNotIn applies the NotIn predicate on the "triggered_at" field.
+func TriggeredAtNotIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTriggeredAt, vs...))
+}
+
+// TriggeredAtGT applies the GT predicate on the "triggered_at" field.
+func TriggeredAtGT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtGTE applies the GTE predicate on the "triggered_at" field.
+func TriggeredAtGTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLT applies the LT predicate on the "triggered_at" field.
+func TriggeredAtLT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTriggeredAt, v))
+}
+
+// TriggeredAtLTE applies the LTE predicate on the "triggered_at" field.
+func TriggeredAtLTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTriggeredAt, v))
+}
+
+// TriggeredAtIsNil applies the IsNil predicate on the "triggered_at" field.
+func TriggeredAtIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldTriggeredAt))
+}
+
+// TriggeredAtNotNil applies the NotNil predicate on the "triggered_at" field.
+func TriggeredAtNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldTriggeredAt))
+}
+
+// TriggerReasonEQ applies the EQ predicate on the "trigger_reason" field.
+func TriggerReasonEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggerReason, v))
+}
+
+// TriggerReasonNEQ applies the NEQ predicate on the "trigger_reason" field.
+func TriggerReasonNEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTriggerReason, v))
+}
+
+// TriggerReasonIn applies the In predicate on the "trigger_reason" field.
+func TriggerReasonIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTriggerReason, vs...))
+}
+
+// TriggerReasonNotIn applies the NotIn predicate on the "trigger_reason" field.
+func TriggerReasonNotIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTriggerReason, vs...))
+}
+
+// TriggerReasonGT applies the GT predicate on the "trigger_reason" field.
+func TriggerReasonGT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTriggerReason, v))
+}
+
+// TriggerReasonGTE applies the GTE predicate on the "trigger_reason" field.
+func TriggerReasonGTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTriggerReason, v))
+}
+
+// TriggerReasonLT applies the LT predicate on the "trigger_reason" field.
+func TriggerReasonLT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTriggerReason, v))
+}
+
+// TriggerReasonLTE applies the LTE predicate on the "trigger_reason" field.
+func TriggerReasonLTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTriggerReason, v))
+}
+
+// TriggerReasonContains applies the Contains predicate on the "trigger_reason" field.
+func TriggerReasonContains(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContains(FieldTriggerReason, v))
+}
+
+// TriggerReasonHasPrefix applies the HasPrefix predicate on the "trigger_reason" field.
+func TriggerReasonHasPrefix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasPrefix(FieldTriggerReason, v))
+}
+
+// TriggerReasonHasSuffix applies the HasSuffix predicate on the "trigger_reason" field.
+func TriggerReasonHasSuffix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasSuffix(FieldTriggerReason, v))
+}
+
+// TriggerReasonIsNil applies the IsNil predicate on the "trigger_reason" field.
+func TriggerReasonIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldTriggerReason))
+}
+
+// TriggerReasonNotNil applies the NotNil predicate on the "trigger_reason" field.
+func TriggerReasonNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldTriggerReason))
+}
+
+// TriggerReasonEqualFold applies the EqualFold predicate on the "trigger_reason" field.
+func TriggerReasonEqualFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEqualFold(FieldTriggerReason, v))
+}
+
+// TriggerReasonContainsFold applies the ContainsFold predicate on the "trigger_reason" field.
+func TriggerReasonContainsFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContainsFold(FieldTriggerReason, v))
+}
+
+// TriggerPriceEQ applies the EQ predicate on the "trigger_price" field.
+func TriggerPriceEQ(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldTriggerPrice, v))
+}
+
+// TriggerPriceNEQ applies the NEQ predicate on the "trigger_price" field.
+func TriggerPriceNEQ(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldTriggerPrice, v))
+}
+
+// TriggerPriceIn applies the In predicate on the "trigger_price" field.
+func TriggerPriceIn(vs ...decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldTriggerPrice, vs...))
+}
+
+// TriggerPriceNotIn applies the NotIn predicate on the "trigger_price" field.
+func TriggerPriceNotIn(vs ...decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldTriggerPrice, vs...))
+}
+
+// TriggerPriceGT applies the GT predicate on the "trigger_price" field.
+func TriggerPriceGT(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldTriggerPrice, v))
+}
+
+// TriggerPriceGTE applies the GTE predicate on the "trigger_price" field.
+func TriggerPriceGTE(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldTriggerPrice, v))
+}
+
+// TriggerPriceLT applies the LT predicate on the "trigger_price" field.
+func TriggerPriceLT(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldTriggerPrice, v))
+}
+
+// TriggerPriceLTE applies the LTE predicate on the "trigger_price" field.
+func TriggerPriceLTE(v decimal.Decimal) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldTriggerPrice, v))
+}
+
+// ExitClientOrderIDEQ applies the EQ predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDNEQ applies the NEQ predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDNEQ(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDIn applies the In predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldExitClientOrderID, vs...))
+}
+
+// ExitClientOrderIDNotIn applies the NotIn predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDNotIn(vs ...string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldExitClientOrderID, vs...))
+}
+
+// ExitClientOrderIDGT applies the GT predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDGT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDGTE applies the GTE predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDGTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDLT applies the LT predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDLT(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDLTE applies the LTE predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDLTE(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDContains applies the Contains predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDContains(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContains(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDHasPrefix applies the HasPrefix predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDHasPrefix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasPrefix(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDHasSuffix applies the HasSuffix predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDHasSuffix(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldHasSuffix(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDIsNil applies the IsNil predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDIsNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIsNull(FieldExitClientOrderID))
+}
+
+// ExitClientOrderIDNotNil applies the NotNil predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDNotNil() predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotNull(FieldExitClientOrderID))
+}
+
+// ExitClientOrderIDEqualFold applies the EqualFold predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDEqualFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEqualFold(FieldExitClientOrderID, v))
+}
+
+// ExitClientOrderIDContainsFold applies the ContainsFold predicate on the "exit_client_order_id" field.
+func ExitClientOrderIDContainsFold(v string) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldContainsFold(FieldExitClientOrderID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ProtectionRule) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ProtectionRule) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ProtectionRule) predicate.ProtectionRule {
+	return predicate.ProtectionRule(sql.NotPredicates(p))
+}
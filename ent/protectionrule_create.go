@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/protectionrule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProtectionRuleCreate is the builder for creating a ProtectionRule entity.
+type ProtectionRuleCreate struct {
+	config
+	mutation *ProtectionRuleMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *ProtectionRuleCreate) SetUserID(v uuid.UUID) *ProtectionRuleCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *ProtectionRuleCreate) SetAccount(v protectionrule.Account) *ProtectionRuleCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *ProtectionRuleCreate) SetStrategyID(v uuid.UUID) *ProtectionRuleCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableStrategyID(v *uuid.UUID) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetStrategyID(*v)
+	}
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *ProtectionRuleCreate) SetSymbol(v string) *ProtectionRuleCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *ProtectionRuleCreate) SetExchange(v string) *ProtectionRuleCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableExchange(v *string) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetExchange(*v)
+	}
+	return _c
+}
+
+// SetStopLoss sets the "stop_loss" field.
+func (_c *ProtectionRuleCreate) SetStopLoss(v float64) *ProtectionRuleCreate {
+	_c.mutation.SetStopLoss(v)
+	return _c
+}
+
+// SetNillableStopLoss sets the "stop_loss" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableStopLoss(v *float64) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetStopLoss(*v)
+	}
+	return _c
+}
+
+// SetTakeProfit sets the "take_profit" field.
+func (_c *ProtectionRuleCreate) SetTakeProfit(v float64) *ProtectionRuleCreate {
+	_c.mutation.SetTakeProfit(v)
+	return _c
+}
+
+// SetNillableTakeProfit sets the "take_profit" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTakeProfit(v *float64) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTakeProfit(*v)
+	}
+	return _c
+}
+
+// SetTrailingPercent sets the "trailing_percent" field.
+func (_c *ProtectionRuleCreate) SetTrailingPercent(v float64) *ProtectionRuleCreate {
+	_c.mutation.SetTrailingPercent(v)
+	return _c
+}
+
+// SetNillableTrailingPercent sets the "trailing_percent" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTrailingPercent(v *float64) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTrailingPercent(*v)
+	}
+	return _c
+}
+
+// SetTrailingAtr sets the "trailing_atr" field.
+func (_c *ProtectionRuleCreate) SetTrailingAtr(v float64) *ProtectionRuleCreate {
+	_c.mutation.SetTrailingAtr(v)
+	return _c
+}
+
+// SetNillableTrailingAtr sets the "trailing_atr" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTrailingAtr(v *float64) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTrailingAtr(*v)
+	}
+	return _c
+}
+
+// SetAtrPeriod sets the "atr_period" field.
+func (_c *ProtectionRuleCreate) SetAtrPeriod(v int) *ProtectionRuleCreate {
+	_c.mutation.SetAtrPeriod(v)
+	return _c
+}
+
+// SetNillableAtrPeriod sets the "atr_period" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableAtrPeriod(v *int) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetAtrPeriod(*v)
+	}
+	return _c
+}
+
+// SetMaxHoldMinutes sets the "max_hold_minutes" field.
+func (_c *ProtectionRuleCreate) SetMaxHoldMinutes(v int) *ProtectionRuleCreate {
+	_c.mutation.SetMaxHoldMinutes(v)
+	return _c
+}
+
+// SetNillableMaxHoldMinutes sets the "max_hold_minutes" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableMaxHoldMinutes(v *int) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetMaxHoldMinutes(*v)
+	}
+	return _c
+}
+
+// SetActive sets the "active" field.
+func (_c *ProtectionRuleCreate) SetActive(v bool) *ProtectionRuleCreate {
+	_c.mutation.SetActive(v)
+	return _c
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableActive(v *bool) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetActive(*v)
+	}
+	return _c
+}
+
+// SetWaterMark sets the "water_mark" field.
+func (_c *ProtectionRuleCreate) SetWaterMark(v decimal.Decimal) *ProtectionRuleCreate {
+	_c.mutation.SetWaterMark(v)
+	return _c
+}
+
+// SetNillableWaterMark sets the "water_mark" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableWaterMark(v *decimal.Decimal) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetWaterMark(*v)
+	}
+	return _c
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_c *ProtectionRuleCreate) SetOpenedAt(v time.Time) *ProtectionRuleCreate {
+	_c.mutation.SetOpenedAt(v)
+	return _c
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableOpenedAt(v *time.Time) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetOpenedAt(*v)
+	}
+	return _c
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_c *ProtectionRuleCreate) SetTriggeredAt(v time.Time) *ProtectionRuleCreate {
+	_c.mutation.SetTriggeredAt(v)
+	return _c
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTriggeredAt(v *time.Time) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTriggeredAt(*v)
+	}
+	return _c
+}
+
+// SetTriggerReason sets the "trigger_reason" field.
+func (_c *ProtectionRuleCreate) SetTriggerReason(v string) *ProtectionRuleCreate {
+	_c.mutation.SetTriggerReason(v)
+	return _c
+}
+
+// SetNillableTriggerReason sets the "trigger_reason" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTriggerReason(v *string) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTriggerReason(*v)
+	}
+	return _c
+}
+
+// SetTriggerPrice sets the "trigger_price" field.
+func (_c *ProtectionRuleCreate) SetTriggerPrice(v decimal.Decimal) *ProtectionRuleCreate {
+	_c.mutation.SetTriggerPrice(v)
+	return _c
+}
+
+// SetNillableTriggerPrice sets the "trigger_price" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableTriggerPrice(v *decimal.Decimal) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetTriggerPrice(*v)
+	}
+	return _c
+}
+
+// SetExitClientOrderID sets the "exit_client_order_id" field.
+func (_c *ProtectionRuleCreate) SetExitClientOrderID(v string) *ProtectionRuleCreate {
+	_c.mutation.SetExitClientOrderID(v)
+	return _c
+}
+
+// SetNillableExitClientOrderID sets the "exit_client_order_id" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableExitClientOrderID(v *string) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetExitClientOrderID(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *ProtectionRuleCreate) SetCreatedAt(v time.Time) *ProtectionRuleCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableCreatedAt(v *time.Time) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *ProtectionRuleCreate) SetUpdatedAt(v time.Time) *ProtectionRuleCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableUpdatedAt(v *time.Time) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *ProtectionRuleCreate) SetID(v uuid.UUID) *ProtectionRuleCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *ProtectionRuleCreate) SetNillableID(v *uuid.UUID) *ProtectionRuleCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the ProtectionRuleMutation object of the builder.
+func (_c *ProtectionRuleCreate) Mutation() *ProtectionRuleMutation {
+	return _c.mutation
+}
+
+// Save creates the ProtectionRule in the database.
+func (_c *ProtectionRuleCreate) Save(ctx context.Context) (*ProtectionRule, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *ProtectionRuleCreate) SaveX(ctx context.Context) *ProtectionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProtectionRuleCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProtectionRuleCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *ProtectionRuleCreate) defaults() {
+	if _, ok := _c.mutation.StopLoss(); !ok {
+		v := protectionrule.DefaultStopLoss
+		_c.mutation.SetStopLoss(v)
+	}
+	if _, ok := _c.mutation.TakeProfit(); !ok {
+		v := protectionrule.DefaultTakeProfit
+		_c.mutation.SetTakeProfit(v)
+	}
+	if _, ok := _c.mutation.TrailingPercent(); !ok {
+		v := protectionrule.DefaultTrailingPercent
+		_c.mutation.SetTrailingPercent(v)
+	}
+	if _, ok := _c.mutation.TrailingAtr(); !ok {
+		v := protectionrule.DefaultTrailingAtr
+		_c.mutation.SetTrailingAtr(v)
+	}
+	if _, ok := _c.mutation.AtrPeriod(); !ok {
+		v := protectionrule.DefaultAtrPeriod
+		_c.mutation.SetAtrPeriod(v)
+	}
+	if _, ok := _c.mutation.MaxHoldMinutes(); !ok {
+		v := protectionrule.DefaultMaxHoldMinutes
+		_c.mutation.SetMaxHoldMinutes(v)
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		v := protectionrule.DefaultActive
+		_c.mutation.SetActive(v)
+	}
+	if _, ok := _c.mutation.WaterMark(); !ok {
+		v := protectionrule.DefaultWaterMark
+		_c.mutation.SetWaterMark(v)
+	}
+	if _, ok := _c.mutation.TriggerPrice(); !ok {
+		v := protectionrule.DefaultTriggerPrice
+		_c.mutation.SetTriggerPrice(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := protectionrule.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := protectionrule.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := protectionrule.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *ProtectionRuleCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "ProtectionRule.user_id"`)}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "ProtectionRule.account"`)}
+	}
+	if v, ok := _c.mutation.Account(); ok {
+		if err := protectionrule.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.account": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "ProtectionRule.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := protectionrule.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := protectionrule.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.StopLoss(); !ok {
+		return &ValidationError{Name: "stop_loss", err: errors.New(`ent: missing required field "ProtectionRule.stop_loss"`)}
+	}
+	if _, ok := _c.mutation.TakeProfit(); !ok {
+		return &ValidationError{Name: "take_profit", err: errors.New(`ent: missing required field "ProtectionRule.take_profit"`)}
+	}
+	if _, ok := _c.mutation.TrailingPercent(); !ok {
+		return &ValidationError{Name: "trailing_percent", err: errors.New(`ent: missing required field "ProtectionRule.trailing_percent"`)}
+	}
+	if _, ok := _c.mutation.TrailingAtr(); !ok {
+		return &ValidationError{Name: "trailing_atr", err: errors.New(`ent: missing required field "ProtectionRule.trailing_atr"`)}
+	}
+	if _, ok := _c.mutation.AtrPeriod(); !ok {
+		return &ValidationError{Name: "atr_period", err: errors.New(`ent: missing required field "ProtectionRule.atr_period"`)}
+	}
+	if _, ok := _c.mutation.MaxHoldMinutes(); !ok {
+		return &ValidationError{Name: "max_hold_minutes", err: errors.New(`ent: missing required field "ProtectionRule.max_hold_minutes"`)}
+	}
+	if _, ok := _c.mutation.Active(); !ok {
+		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "ProtectionRule.active"`)}
+	}
+	if _, ok := _c.mutation.WaterMark(); !ok {
+		return &ValidationError{Name: "water_mark", err: errors.New(`ent: missing required field "ProtectionRule.water_mark"`)}
+	}
+	if v, ok := _c.mutation.TriggerReason(); ok {
+		if err := protectionrule.TriggerReasonValidator(v); err != nil {
+			return &ValidationError{Name: "trigger_reason", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.trigger_reason": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TriggerPrice(); !ok {
+		return &ValidationError{Name: "trigger_price", err: errors.New(`ent: missing required field "ProtectionRule.trigger_price"`)}
+	}
+	if v, ok := _c.mutation.ExitClientOrderID(); ok {
+		if err := protectionrule.ExitClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "exit_client_order_id", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exit_client_order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ProtectionRule.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ProtectionRule.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *ProtectionRuleCreate) sqlSave(ctx context.Context) (*ProtectionRule, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *ProtectionRuleCreate) createSpec() (*ProtectionRule, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ProtectionRule{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(protectionrule.Table, sqlgraph.NewFieldSpec(protectionrule.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(protectionrule.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(protectionrule.FieldAccount, field.TypeEnum, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.StrategyID(); ok {
+		_spec.SetField(protectionrule.FieldStrategyID, field.TypeUUID, value)
+		_node.StrategyID = &value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(protectionrule.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(protectionrule.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.StopLoss(); ok {
+		_spec.SetField(protectionrule.FieldStopLoss, field.TypeFloat64, value)
+		_node.StopLoss = value
+	}
+	if value, ok := _c.mutation.TakeProfit(); ok {
+		_spec.SetField(protectionrule.FieldTakeProfit, field.TypeFloat64, value)
+		_node.TakeProfit = value
+	}
+	if value, ok := _c.mutation.TrailingPercent(); ok {
+		_spec.SetField(protectionrule.FieldTrailingPercent, field.TypeFloat64, value)
+		_node.TrailingPercent = value
+	}
+	if value, ok := _c.mutation.TrailingAtr(); ok {
+		_spec.SetField(protectionrule.FieldTrailingAtr, field.TypeFloat64, value)
+		_node.TrailingAtr = value
+	}
+	if value, ok := _c.mutation.AtrPeriod(); ok {
+		_spec.SetField(protectionrule.FieldAtrPeriod, field.TypeInt, value)
+		_node.AtrPeriod = value
+	}
+	if value, ok := _c.mutation.MaxHoldMinutes(); ok {
+		_spec.SetField(protectionrule.FieldMaxHoldMinutes, field.TypeInt, value)
+		_node.MaxHoldMinutes = value
+	}
+	if value, ok := _c.mutation.Active(); ok {
+		_spec.SetField(protectionrule.FieldActive, field.TypeBool, value)
+		_node.Active = value
+	}
+	if value, ok := _c.mutation.WaterMark(); ok {
+		_spec.SetField(protectionrule.FieldWaterMark, field.TypeOther, value)
+		_node.WaterMark = value
+	}
+	if value, ok := _c.mutation.OpenedAt(); ok {
+		_spec.SetField(protectionrule.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = &value
+	}
+	if value, ok := _c.mutation.TriggeredAt(); ok {
+		_spec.SetField(protectionrule.FieldTriggeredAt, field.TypeTime, value)
+		_node.TriggeredAt = &value
+	}
+	if value, ok := _c.mutation.TriggerReason(); ok {
+		_spec.SetField(protectionrule.FieldTriggerReason, field.TypeString, value)
+		_node.TriggerReason = value
+	}
+	if value, ok := _c.mutation.TriggerPrice(); ok {
+		_spec.SetField(protectionrule.FieldTriggerPrice, field.TypeOther, value)
+		_node.TriggerPrice = value
+	}
+	if value, ok := _c.mutation.ExitClientOrderID(); ok {
+		_spec.SetField(protectionrule.FieldExitClientOrderID, field.TypeString, value)
+		_node.ExitClientOrderID = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(protectionrule.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(protectionrule.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// ProtectionRuleCreateBulk is the builder for creating many ProtectionRule entities in bulk.
+type ProtectionRuleCreateBulk struct {
+	config
+	err      error
+	builders []*ProtectionRuleCreate
+}
+
+// Save creates the ProtectionRule entities in the database.
+func (_c *ProtectionRuleCreateBulk) Save(ctx context.Context) ([]*ProtectionRule, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*ProtectionRule, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ProtectionRuleMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *ProtectionRuleCreateBulk) SaveX(ctx context.Context) []*ProtectionRule {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *ProtectionRuleCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *ProtectionRuleCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1120 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/protectionrule"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProtectionRuleUpdate is the builder for updating ProtectionRule entities.
+type ProtectionRuleUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ProtectionRuleMutation
+}
+
+// Where appends a list predicates to the ProtectionRuleUpdate builder.
+func (_u *ProtectionRuleUpdate) Where(ps ...predicate.ProtectionRule) *ProtectionRuleUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ProtectionRuleUpdate) SetUserID(v uuid.UUID) *ProtectionRuleUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableUserID(v *uuid.UUID) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *ProtectionRuleUpdate) SetAccount(v protectionrule.Account) *ProtectionRuleUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableAccount(v *protectionrule.Account) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *ProtectionRuleUpdate) SetStrategyID(v uuid.UUID) *ProtectionRuleUpdate {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableStrategyID(v *uuid.UUID) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *ProtectionRuleUpdate) ClearStrategyID() *ProtectionRuleUpdate {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *ProtectionRuleUpdate) SetSymbol(v string) *ProtectionRuleUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableSymbol(v *string) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *ProtectionRuleUpdate) SetExchange(v string) *ProtectionRuleUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableExchange(v *string) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *ProtectionRuleUpdate) ClearExchange() *ProtectionRuleUpdate {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetStopLoss sets the "stop_loss" field.
+func (_u *ProtectionRuleUpdate) SetStopLoss(v float64) *ProtectionRuleUpdate {
+	_u.mutation.ResetStopLoss()
+	_u.mutation.SetStopLoss(v)
+	return _u
+}
+
+// SetNillableStopLoss sets the "stop_loss" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableStopLoss(v *float64) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetStopLoss(*v)
+	}
+	return _u
+}
+
+// AddStopLoss adds value to the "stop_loss" field.
+func (_u *ProtectionRuleUpdate) AddStopLoss(v float64) *ProtectionRuleUpdate {
+	_u.mutation.AddStopLoss(v)
+	return _u
+}
+
+// SetTakeProfit sets the "take_profit" field.
+func (_u *ProtectionRuleUpdate) SetTakeProfit(v float64) *ProtectionRuleUpdate {
+	_u.mutation.ResetTakeProfit()
+	_u.mutation.SetTakeProfit(v)
+	return _u
+}
+
+// SetNillableTakeProfit sets the "take_profit" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTakeProfit(v *float64) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTakeProfit(*v)
+	}
+	return _u
+}
+
+// AddTakeProfit adds value to the "take_profit" field.
+func (_u *ProtectionRuleUpdate) AddTakeProfit(v float64) *ProtectionRuleUpdate {
+	_u.mutation.AddTakeProfit(v)
+	return _u
+}
+
+// SetTrailingPercent sets the "trailing_percent" field.
+func (_u *ProtectionRuleUpdate) SetTrailingPercent(v float64) *ProtectionRuleUpdate {
+	_u.mutation.ResetTrailingPercent()
+	_u.mutation.SetTrailingPercent(v)
+	return _u
+}
+
+// SetNillableTrailingPercent sets the "trailing_percent" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTrailingPercent(v *float64) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTrailingPercent(*v)
+	}
+	return _u
+}
+
+// AddTrailingPercent adds value to the "trailing_percent" field.
+func (_u *ProtectionRuleUpdate) AddTrailingPercent(v float64) *ProtectionRuleUpdate {
+	_u.mutation.AddTrailingPercent(v)
+	return _u
+}
+
+// SetTrailingAtr sets the "trailing_atr" field.
+func (_u *ProtectionRuleUpdate) SetTrailingAtr(v float64) *ProtectionRuleUpdate {
+	_u.mutation.ResetTrailingAtr()
+	_u.mutation.SetTrailingAtr(v)
+	return _u
+}
+
+// SetNillableTrailingAtr sets the "trailing_atr" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTrailingAtr(v *float64) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTrailingAtr(*v)
+	}
+	return _u
+}
+
+// AddTrailingAtr adds value to the "trailing_atr" field.
+func (_u *ProtectionRuleUpdate) AddTrailingAtr(v float64) *ProtectionRuleUpdate {
+	_u.mutation.AddTrailingAtr(v)
+	return _u
+}
+
+// SetAtrPeriod sets the "atr_period" field.
+func (_u *ProtectionRuleUpdate) SetAtrPeriod(v int) *ProtectionRuleUpdate {
+	_u.mutation.ResetAtrPeriod()
+	_u.mutation.SetAtrPeriod(v)
+	return _u
+}
+
+// SetNillableAtrPeriod sets the "atr_period" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableAtrPeriod(v *int) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetAtrPeriod(*v)
+	}
+	return _u
+}
+
+// AddAtrPeriod adds value to the "atr_period" field.
+func (_u *ProtectionRuleUpdate) AddAtrPeriod(v int) *ProtectionRuleUpdate {
+	_u.mutation.AddAtrPeriod(v)
+	return _u
+}
+
+// SetMaxHoldMinutes sets the "max_hold_minutes" field.
+func (_u *ProtectionRuleUpdate) SetMaxHoldMinutes(v int) *ProtectionRuleUpdate {
+	_u.mutation.ResetMaxHoldMinutes()
+	_u.mutation.SetMaxHoldMinutes(v)
+	return _u
+}
+
+// SetNillableMaxHoldMinutes sets the "max_hold_minutes" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableMaxHoldMinutes(v *int) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetMaxHoldMinutes(*v)
+	}
+	return _u
+}
+
+// AddMaxHoldMinutes adds value to the "max_hold_minutes" field.
+func (_u *ProtectionRuleUpdate) AddMaxHoldMinutes(v int) *ProtectionRuleUpdate {
+	_u.mutation.AddMaxHoldMinutes(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *ProtectionRuleUpdate) SetActive(v bool) *ProtectionRuleUpdate {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableActive(v *bool) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetWaterMark sets the "water_mark" field.
+func (_u *ProtectionRuleUpdate) SetWaterMark(v decimal.Decimal) *ProtectionRuleUpdate {
+	_u.mutation.SetWaterMark(v)
+	return _u
+}
+
+// SetNillableWaterMark sets the "water_mark" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableWaterMark(v *decimal.Decimal) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetWaterMark(*v)
+	}
+	return _u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *ProtectionRuleUpdate) SetOpenedAt(v time.Time) *ProtectionRuleUpdate {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableOpenedAt(v *time.Time) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (_u *ProtectionRuleUpdate) ClearOpenedAt() *ProtectionRuleUpdate {
+	_u.mutation.ClearOpenedAt()
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ProtectionRuleUpdate) SetTriggeredAt(v time.Time) *ProtectionRuleUpdate {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTriggeredAt(v *time.Time) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (_u *ProtectionRuleUpdate) ClearTriggeredAt() *ProtectionRuleUpdate {
+	_u.mutation.ClearTriggeredAt()
+	return _u
+}
+
+// SetTriggerReason sets the "trigger_reason" field.
+func (_u *ProtectionRuleUpdate) SetTriggerReason(v string) *ProtectionRuleUpdate {
+	_u.mutation.SetTriggerReason(v)
+	return _u
+}
+
+// SetNillableTriggerReason sets the "trigger_reason" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTriggerReason(v *string) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTriggerReason(*v)
+	}
+	return _u
+}
+
+// ClearTriggerReason clears the value of the "trigger_reason" field.
+func (_u *ProtectionRuleUpdate) ClearTriggerReason() *ProtectionRuleUpdate {
+	_u.mutation.ClearTriggerReason()
+	return _u
+}
+
+// SetTriggerPrice sets the "trigger_price" field.
+func (_u *ProtectionRuleUpdate) SetTriggerPrice(v decimal.Decimal) *ProtectionRuleUpdate {
+	_u.mutation.SetTriggerPrice(v)
+	return _u
+}
+
+// SetNillableTriggerPrice sets the "trigger_price" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableTriggerPrice(v *decimal.Decimal) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetTriggerPrice(*v)
+	}
+	return _u
+}
+
+// SetExitClientOrderID sets the "exit_client_order_id" field.
+func (_u *ProtectionRuleUpdate) SetExitClientOrderID(v string) *ProtectionRuleUpdate {
+	_u.mutation.SetExitClientOrderID(v)
+	return _u
+}
+
+// SetNillableExitClientOrderID sets the "exit_client_order_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdate) SetNillableExitClientOrderID(v *string) *ProtectionRuleUpdate {
+	if v != nil {
+		_u.SetExitClientOrderID(*v)
+	}
+	return _u
+}
+
+// ClearExitClientOrderID clears the value of the "exit_client_order_id" field.
+func (_u *ProtectionRuleUpdate) ClearExitClientOrderID() *ProtectionRuleUpdate {
+	_u.mutation.ClearExitClientOrderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProtectionRuleUpdate) SetUpdatedAt(v time.Time) *ProtectionRuleUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProtectionRuleMutation object of the builder.
+func (_u *ProtectionRuleUpdate) Mutation() *ProtectionRuleMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *ProtectionRuleUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProtectionRuleUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *ProtectionRuleUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProtectionRuleUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProtectionRuleUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := protectionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProtectionRuleUpdate) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := protectionrule.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := protectionrule.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := protectionrule.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TriggerReason(); ok {
+		if err := protectionrule.TriggerReasonValidator(v); err != nil {
+			return &ValidationError{Name: "trigger_reason", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.trigger_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExitClientOrderID(); ok {
+		if err := protectionrule.ExitClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "exit_client_order_id", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exit_client_order_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProtectionRuleUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(protectionrule.Table, protectionrule.Columns, sqlgraph.NewFieldSpec(protectionrule.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(protectionrule.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(protectionrule.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(protectionrule.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(protectionrule.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(protectionrule.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(protectionrule.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(protectionrule.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.StopLoss(); ok {
+		_spec.SetField(protectionrule.FieldStopLoss, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStopLoss(); ok {
+		_spec.AddField(protectionrule.FieldStopLoss, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TakeProfit(); ok {
+		_spec.SetField(protectionrule.FieldTakeProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTakeProfit(); ok {
+		_spec.AddField(protectionrule.FieldTakeProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TrailingPercent(); ok {
+		_spec.SetField(protectionrule.FieldTrailingPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingPercent(); ok {
+		_spec.AddField(protectionrule.FieldTrailingPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TrailingAtr(); ok {
+		_spec.SetField(protectionrule.FieldTrailingAtr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingAtr(); ok {
+		_spec.AddField(protectionrule.FieldTrailingAtr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AtrPeriod(); ok {
+		_spec.SetField(protectionrule.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAtrPeriod(); ok {
+		_spec.AddField(protectionrule.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxHoldMinutes(); ok {
+		_spec.SetField(protectionrule.FieldMaxHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxHoldMinutes(); ok {
+		_spec.AddField(protectionrule.FieldMaxHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(protectionrule.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WaterMark(); ok {
+		_spec.SetField(protectionrule.FieldWaterMark, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(protectionrule.FieldOpenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpenedAtCleared() {
+		_spec.ClearField(protectionrule.FieldOpenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(protectionrule.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TriggeredAtCleared() {
+		_spec.ClearField(protectionrule.FieldTriggeredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TriggerReason(); ok {
+		_spec.SetField(protectionrule.FieldTriggerReason, field.TypeString, value)
+	}
+	if _u.mutation.TriggerReasonCleared() {
+		_spec.ClearField(protectionrule.FieldTriggerReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TriggerPrice(); ok {
+		_spec.SetField(protectionrule.FieldTriggerPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExitClientOrderID(); ok {
+		_spec.SetField(protectionrule.FieldExitClientOrderID, field.TypeString, value)
+	}
+	if _u.mutation.ExitClientOrderIDCleared() {
+		_spec.ClearField(protectionrule.FieldExitClientOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(protectionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{protectionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// ProtectionRuleUpdateOne is the builder for updating a single ProtectionRule entity.
+type ProtectionRuleUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ProtectionRuleMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *ProtectionRuleUpdateOne) SetUserID(v uuid.UUID) *ProtectionRuleUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableUserID(v *uuid.UUID) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *ProtectionRuleUpdateOne) SetAccount(v protectionrule.Account) *ProtectionRuleUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableAccount(v *protectionrule.Account) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *ProtectionRuleUpdateOne) SetStrategyID(v uuid.UUID) *ProtectionRuleUpdateOne {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableStrategyID(v *uuid.UUID) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *ProtectionRuleUpdateOne) ClearStrategyID() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *ProtectionRuleUpdateOne) SetSymbol(v string) *ProtectionRuleUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableSymbol(v *string) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *ProtectionRuleUpdateOne) SetExchange(v string) *ProtectionRuleUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableExchange(v *string) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *ProtectionRuleUpdateOne) ClearExchange() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetStopLoss sets the "stop_loss" field.
+func (_u *ProtectionRuleUpdateOne) SetStopLoss(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetStopLoss()
+	_u.mutation.SetStopLoss(v)
+	return _u
+}
+
+// SetNillableStopLoss sets the "stop_loss" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableStopLoss(v *float64) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetStopLoss(*v)
+	}
+	return _u
+}
+
+// AddStopLoss adds value to the "stop_loss" field.
+func (_u *ProtectionRuleUpdateOne) AddStopLoss(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.AddStopLoss(v)
+	return _u
+}
+
+// SetTakeProfit sets the "take_profit" field.
+func (_u *ProtectionRuleUpdateOne) SetTakeProfit(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetTakeProfit()
+	_u.mutation.SetTakeProfit(v)
+	return _u
+}
+
+// SetNillableTakeProfit sets the "take_profit" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTakeProfit(v *float64) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTakeProfit(*v)
+	}
+	return _u
+}
+
+// AddTakeProfit adds value to the "take_profit" field.
+func (_u *ProtectionRuleUpdateOne) AddTakeProfit(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.AddTakeProfit(v)
+	return _u
+}
+
+// SetTrailingPercent sets the "trailing_percent" field.
+func (_u *ProtectionRuleUpdateOne) SetTrailingPercent(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetTrailingPercent()
+	_u.mutation.SetTrailingPercent(v)
+	return _u
+}
+
+// SetNillableTrailingPercent sets the "trailing_percent" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTrailingPercent(v *float64) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTrailingPercent(*v)
+	}
+	return _u
+}
+
+// AddTrailingPercent adds value to the "trailing_percent" field.
+func (_u *ProtectionRuleUpdateOne) AddTrailingPercent(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.AddTrailingPercent(v)
+	return _u
+}
+
+// SetTrailingAtr sets the "trailing_atr" field.
+func (_u *ProtectionRuleUpdateOne) SetTrailingAtr(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetTrailingAtr()
+	_u.mutation.SetTrailingAtr(v)
+	return _u
+}
+
+// SetNillableTrailingAtr sets the "trailing_atr" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTrailingAtr(v *float64) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTrailingAtr(*v)
+	}
+	return _u
+}
+
+// AddTrailingAtr adds value to the "trailing_atr" field.
+func (_u *ProtectionRuleUpdateOne) AddTrailingAtr(v float64) *ProtectionRuleUpdateOne {
+	_u.mutation.AddTrailingAtr(v)
+	return _u
+}
+
+// SetAtrPeriod sets the "atr_period" field.
+func (_u *ProtectionRuleUpdateOne) SetAtrPeriod(v int) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetAtrPeriod()
+	_u.mutation.SetAtrPeriod(v)
+	return _u
+}
+
+// SetNillableAtrPeriod sets the "atr_period" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableAtrPeriod(v *int) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetAtrPeriod(*v)
+	}
+	return _u
+}
+
+// AddAtrPeriod adds value to the "atr_period" field.
+func (_u *ProtectionRuleUpdateOne) AddAtrPeriod(v int) *ProtectionRuleUpdateOne {
+	_u.mutation.AddAtrPeriod(v)
+	return _u
+}
+
+// SetMaxHoldMinutes sets the "max_hold_minutes" field.
+func (_u *ProtectionRuleUpdateOne) SetMaxHoldMinutes(v int) *ProtectionRuleUpdateOne {
+	_u.mutation.ResetMaxHoldMinutes()
+	_u.mutation.SetMaxHoldMinutes(v)
+	return _u
+}
+
+// SetNillableMaxHoldMinutes sets the "max_hold_minutes" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableMaxHoldMinutes(v *int) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetMaxHoldMinutes(*v)
+	}
+	return _u
+}
+
+// AddMaxHoldMinutes adds value to the "max_hold_minutes" field.
+func (_u *ProtectionRuleUpdateOne) AddMaxHoldMinutes(v int) *ProtectionRuleUpdateOne {
+	_u.mutation.AddMaxHoldMinutes(v)
+	return _u
+}
+
+// SetActive sets the "active" field.
+func (_u *ProtectionRuleUpdateOne) SetActive(v bool) *ProtectionRuleUpdateOne {
+	_u.mutation.SetActive(v)
+	return _u
+}
+
+// SetNillableActive sets the "active" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableActive(v *bool) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetActive(*v)
+	}
+	return _u
+}
+
+// SetWaterMark sets the "water_mark" field.
+func (_u *ProtectionRuleUpdateOne) SetWaterMark(v decimal.Decimal) *ProtectionRuleUpdateOne {
+	_u.mutation.SetWaterMark(v)
+	return _u
+}
+
+// SetNillableWaterMark sets the "water_mark" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableWaterMark(v *decimal.Decimal) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetWaterMark(*v)
+	}
+	return _u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *ProtectionRuleUpdateOne) SetOpenedAt(v time.Time) *ProtectionRuleUpdateOne {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableOpenedAt(v *time.Time) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// ClearOpenedAt clears the value of the "opened_at" field.
+func (_u *ProtectionRuleUpdateOne) ClearOpenedAt() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearOpenedAt()
+	return _u
+}
+
+// SetTriggeredAt sets the "triggered_at" field.
+func (_u *ProtectionRuleUpdateOne) SetTriggeredAt(v time.Time) *ProtectionRuleUpdateOne {
+	_u.mutation.SetTriggeredAt(v)
+	return _u
+}
+
+// SetNillableTriggeredAt sets the "triggered_at" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTriggeredAt(v *time.Time) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTriggeredAt(*v)
+	}
+	return _u
+}
+
+// ClearTriggeredAt clears the value of the "triggered_at" field.
+func (_u *ProtectionRuleUpdateOne) ClearTriggeredAt() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearTriggeredAt()
+	return _u
+}
+
+// SetTriggerReason sets the "trigger_reason" field.
+func (_u *ProtectionRuleUpdateOne) SetTriggerReason(v string) *ProtectionRuleUpdateOne {
+	_u.mutation.SetTriggerReason(v)
+	return _u
+}
+
+// SetNillableTriggerReason sets the "trigger_reason" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTriggerReason(v *string) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTriggerReason(*v)
+	}
+	return _u
+}
+
+// ClearTriggerReason clears the value of the "trigger_reason" field.
+func (_u *ProtectionRuleUpdateOne) ClearTriggerReason() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearTriggerReason()
+	return _u
+}
+
+// SetTriggerPrice sets the "trigger_price" field.
+func (_u *ProtectionRuleUpdateOne) SetTriggerPrice(v decimal.Decimal) *ProtectionRuleUpdateOne {
+	_u.mutation.SetTriggerPrice(v)
+	return _u
+}
+
+// SetNillableTriggerPrice sets the "trigger_price" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableTriggerPrice(v *decimal.Decimal) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetTriggerPrice(*v)
+	}
+	return _u
+}
+
+// SetExitClientOrderID sets the "exit_client_order_id" field.
+func (_u *ProtectionRuleUpdateOne) SetExitClientOrderID(v string) *ProtectionRuleUpdateOne {
+	_u.mutation.SetExitClientOrderID(v)
+	return _u
+}
+
+// SetNillableExitClientOrderID sets the "exit_client_order_id" field if the given value is not nil.
+func (_u *ProtectionRuleUpdateOne) SetNillableExitClientOrderID(v *string) *ProtectionRuleUpdateOne {
+	if v != nil {
+		_u.SetExitClientOrderID(*v)
+	}
+	return _u
+}
+
+// ClearExitClientOrderID clears the value of the "exit_client_order_id" field.
+func (_u *ProtectionRuleUpdateOne) ClearExitClientOrderID() *ProtectionRuleUpdateOne {
+	_u.mutation.ClearExitClientOrderID()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *ProtectionRuleUpdateOne) SetUpdatedAt(v time.Time) *ProtectionRuleUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the ProtectionRuleMutation object of the builder.
+func (_u *ProtectionRuleUpdateOne) Mutation() *ProtectionRuleMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the ProtectionRuleUpdate builder.
+func (_u *ProtectionRuleUpdateOne) Where(ps ...predicate.ProtectionRule) *ProtectionRuleUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *ProtectionRuleUpdateOne) Select(field string, fields ...string) *ProtectionRuleUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated ProtectionRule entity.
+func (_u *ProtectionRuleUpdateOne) Save(ctx context.Context) (*ProtectionRule, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *ProtectionRuleUpdateOne) SaveX(ctx context.Context) *ProtectionRule {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *ProtectionRuleUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *ProtectionRuleUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *ProtectionRuleUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := protectionrule.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *ProtectionRuleUpdateOne) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := protectionrule.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := protectionrule.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := protectionrule.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TriggerReason(); ok {
+		if err := protectionrule.TriggerReasonValidator(v); err != nil {
+			return &ValidationError{Name: "trigger_reason", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.trigger_reason": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ExitClientOrderID(); ok {
+		if err := protectionrule.ExitClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "exit_client_order_id", err: fmt.Errorf(`ent: validator failed for field "ProtectionRule.exit_client_order_id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *ProtectionRuleUpdateOne) sqlSave(ctx context.Context) (_node *ProtectionRule, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(protectionrule.Table, protectionrule.Columns, sqlgraph.NewFieldSpec(protectionrule.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ProtectionRule.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, protectionrule.FieldID)
+		for _, f := range fields {
+			if !protectionrule.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != protectionrule.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(protectionrule.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(protectionrule.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(protectionrule.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(protectionrule.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(protectionrule.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(protectionrule.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(protectionrule.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.StopLoss(); ok {
+		_spec.SetField(protectionrule.FieldStopLoss, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedStopLoss(); ok {
+		_spec.AddField(protectionrule.FieldStopLoss, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TakeProfit(); ok {
+		_spec.SetField(protectionrule.FieldTakeProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTakeProfit(); ok {
+		_spec.AddField(protectionrule.FieldTakeProfit, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TrailingPercent(); ok {
+		_spec.SetField(protectionrule.FieldTrailingPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingPercent(); ok {
+		_spec.AddField(protectionrule.FieldTrailingPercent, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.TrailingAtr(); ok {
+		_spec.SetField(protectionrule.FieldTrailingAtr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedTrailingAtr(); ok {
+		_spec.AddField(protectionrule.FieldTrailingAtr, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AtrPeriod(); ok {
+		_spec.SetField(protectionrule.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedAtrPeriod(); ok {
+		_spec.AddField(protectionrule.FieldAtrPeriod, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.MaxHoldMinutes(); ok {
+		_spec.SetField(protectionrule.FieldMaxHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedMaxHoldMinutes(); ok {
+		_spec.AddField(protectionrule.FieldMaxHoldMinutes, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.Active(); ok {
+		_spec.SetField(protectionrule.FieldActive, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.WaterMark(); ok {
+		_spec.SetField(protectionrule.FieldWaterMark, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(protectionrule.FieldOpenedAt, field.TypeTime, value)
+	}
+	if _u.mutation.OpenedAtCleared() {
+		_spec.ClearField(protectionrule.FieldOpenedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TriggeredAt(); ok {
+		_spec.SetField(protectionrule.FieldTriggeredAt, field.TypeTime, value)
+	}
+	if _u.mutation.TriggeredAtCleared() {
+		_spec.ClearField(protectionrule.FieldTriggeredAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.TriggerReason(); ok {
+		_spec.SetField(protectionrule.FieldTriggerReason, field.TypeString, value)
+	}
+	if _u.mutation.TriggerReasonCleared() {
+		_spec.ClearField(protectionrule.FieldTriggerReason, field.TypeString)
+	}
+	if value, ok := _u.mutation.TriggerPrice(); ok {
+		_spec.SetField(protectionrule.FieldTriggerPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.ExitClientOrderID(); ok {
+		_spec.SetField(protectionrule.FieldExitClientOrderID, field.TypeString, value)
+	}
+	if _u.mutation.ExitClientOrderIDCleared() {
+		_spec.ClearField(protectionrule.FieldExitClientOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(protectionrule.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ProtectionRule{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{protectionrule.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
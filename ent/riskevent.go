@@ -0,0 +1,280 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/riskevent"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskEvent is the model entity for the RiskEvent schema.
+type RiskEvent struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account riskevent.Account `json:"account,omitempty"`
+	// Type holds the value of the "type" field.
+	Type riskevent.Type `json:"type,omitempty"`
+	// Rule holds the value of the "rule" field.
+	Rule string `json:"rule,omitempty"`
+	// Reason holds the value of the "reason" field.
+	Reason string `json:"reason,omitempty"`
+	// ClientOrderID holds the value of the "client_order_id" field.
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	// StrategyID holds the value of the "strategy_id" field.
+	StrategyID *uuid.UUID `json:"strategy_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Side holds the value of the "side" field.
+	Side string `json:"side,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Price holds the value of the "price" field.
+	Price decimal.Decimal `json:"price,omitempty"`
+	// Details holds the value of the "details" field.
+	Details map[string]interface{} `json:"details,omitempty"`
+	// AcknowledgedAt holds the value of the "acknowledged_at" field.
+	AcknowledgedAt *time.Time `json:"acknowledged_at,omitempty"`
+	// AcknowledgedBy holds the value of the "acknowledged_by" field.
+	AcknowledgedBy *uuid.UUID `json:"acknowledged_by,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RiskEvent) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case riskevent.FieldStrategyID, riskevent.FieldAcknowledgedBy:
+			values[i] = &sql.NullScanner{S: new(uuid.UUID)}
+		case riskevent.FieldDetails:
+			values[i] = new([]byte)
+		case riskevent.FieldQuantity, riskevent.FieldPrice:
+			values[i] = new(decimal.Decimal)
+		case riskevent.FieldAccount, riskevent.FieldType, riskevent.FieldRule, riskevent.FieldReason, riskevent.FieldClientOrderID, riskevent.FieldSymbol, riskevent.FieldSide:
+			values[i] = new(sql.NullString)
+		case riskevent.FieldAcknowledgedAt, riskevent.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case riskevent.FieldID, riskevent.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RiskEvent fields.
+func (_m *RiskEvent) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case riskevent.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case riskevent.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case riskevent.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = riskevent.Account(value.String)
+			}
+		case riskevent.FieldType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field type", values[i])
+			} else if value.Valid {
+				_m.Type = riskevent.Type(value.String)
+			}
+		case riskevent.FieldRule:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field rule", values[i])
+			} else if value.Valid {
+				_m.Rule = value.String
+			}
+		case riskevent.FieldReason:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field reason", values[i])
+			} else if value.Valid {
+				_m.Reason = value.String
+			}
+		case riskevent.FieldClientOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_order_id", values[i])
+			} else if value.Valid {
+				_m.ClientOrderID = value.String
+			}
+		case riskevent.FieldStrategyID:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field strategy_id", values[i])
+			} else if value.Valid {
+				_m.StrategyID = new(uuid.UUID)
+				*_m.StrategyID = *value.S.(*uuid.UUID)
+			}
+		case riskevent.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case riskevent.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = value.String
+			}
+		case riskevent.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case riskevent.FieldPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case riskevent.FieldDetails:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field details", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.Details); err != nil {
+					return fmt.Errorf("unmarshal field details: %w", err)
+				}
+			}
+		case riskevent.FieldAcknowledgedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_at", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedAt = new(time.Time)
+				*_m.AcknowledgedAt = value.Time
+			}
+		case riskevent.FieldAcknowledgedBy:
+			if value, ok := values[i].(*sql.NullScanner); !ok {
+				return fmt.Errorf("unexpected type %T for field acknowledged_by", values[i])
+			} else if value.Valid {
+				_m.AcknowledgedBy = new(uuid.UUID)
+				*_m.AcknowledgedBy = *value.S.(*uuid.UUID)
+			}
+		case riskevent.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RiskEvent.
+// This includes values selected through modifiers, order, etc.
+func (_m *RiskEvent) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RiskEvent.
+// Note that you need to call RiskEvent.Unwrap() before calling this method if this RiskEvent
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RiskEvent) Update() *RiskEventUpdateOne {
+	return NewRiskEventClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RiskEvent entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RiskEvent) Unwrap() *RiskEvent {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RiskEvent is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RiskEvent) String() string {
+	var builder strings.Builder
+	builder.WriteString("RiskEvent(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Account))
+	builder.WriteString(", ")
+	builder.WriteString("type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Type))
+	builder.WriteString(", ")
+	builder.WriteString("rule=")
+	builder.WriteString(_m.Rule)
+	builder.WriteString(", ")
+	builder.WriteString("reason=")
+	builder.WriteString(_m.Reason)
+	builder.WriteString(", ")
+	builder.WriteString("client_order_id=")
+	builder.WriteString(_m.ClientOrderID)
+	builder.WriteString(", ")
+	if v := _m.StrategyID; v != nil {
+		builder.WriteString("strategy_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(_m.Side)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("details=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Details))
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedAt; v != nil {
+		builder.WriteString("acknowledged_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := _m.AcknowledgedBy; v != nil {
+		builder.WriteString("acknowledged_by=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RiskEvents is a parsable slice of RiskEvent.
+type RiskEvents []*RiskEvent
@@ -0,0 +1,224 @@
+// Code generated by ent, DO NOT EDIT.
+
+package riskevent
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the riskevent type in the database.
+	Label = "risk_event"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldType holds the string denoting the type field in the database.
+	FieldType = "type"
+	// FieldRule holds the string denoting the rule field in the database.
+	FieldRule = "rule"
+	// FieldReason holds the string denoting the reason field in the database.
+	FieldReason = "reason"
+	// FieldClientOrderID holds the string denoting the client_order_id field in the database.
+	FieldClientOrderID = "client_order_id"
+	// FieldStrategyID holds the string denoting the strategy_id field in the database.
+	FieldStrategyID = "strategy_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldDetails holds the string denoting the details field in the database.
+	FieldDetails = "details"
+	// FieldAcknowledgedAt holds the string denoting the acknowledged_at field in the database.
+	FieldAcknowledgedAt = "acknowledged_at"
+	// FieldAcknowledgedBy holds the string denoting the acknowledged_by field in the database.
+	FieldAcknowledgedBy = "acknowledged_by"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the riskevent in the database.
+	Table = "risk_events"
+)
+
+// Columns holds all SQL columns for riskevent fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAccount,
+	FieldType,
+	FieldRule,
+	FieldReason,
+	FieldClientOrderID,
+	FieldStrategyID,
+	FieldSymbol,
+	FieldSide,
+	FieldQuantity,
+	FieldPrice,
+	FieldDetails,
+	FieldAcknowledgedAt,
+	FieldAcknowledgedBy,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	RuleValidator func(string) error
+	// ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	ClientOrderIDValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// SideValidator is a validator for the "side" field. It is called by the builders before save.
+	SideValidator func(string) error
+	// DefaultQuantity holds the default value on creation for the "quantity" field.
+	DefaultQuantity decimal.Decimal
+	// DefaultPrice holds the default value on creation for the "price" field.
+	DefaultPrice decimal.Decimal
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Account defines the type for the "account" enum field.
+type Account string
+
+// Account values.
+const (
+	AccountLIVE  Account = "LIVE"
+	AccountPAPER Account = "PAPER"
+)
+
+func (a Account) String() string {
+	return string(a)
+}
+
+// AccountValidator is a validator for the "account" field enum values. It is called by the builders before save.
+func AccountValidator(a Account) error {
+	switch a {
+	case AccountLIVE, AccountPAPER:
+		return nil
+	default:
+		return fmt.Errorf("riskevent: invalid enum value for account field: %q", a)
+	}
+}
+
+// Type defines the type for the "type" enum field.
+type Type string
+
+// Type values.
+const (
+	TypeORDER_REJECTED Type = "ORDER_REJECTED"
+	TypeDRAWDOWN_HALT  Type = "DRAWDOWN_HALT"
+)
+
+func (_type Type) String() string {
+	return string(_type)
+}
+
+// TypeValidator is a validator for the "type" field enum values. It is called by the builders before save.
+func TypeValidator(_type Type) error {
+	switch _type {
+	case TypeORDER_REJECTED, TypeDRAWDOWN_HALT:
+		return nil
+	default:
+		return fmt.Errorf("riskevent: invalid enum value for type field: %q", _type)
+	}
+}
+
+// OrderOption defines the ordering options for the RiskEvent queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// ByType orders the results by the type field.
+func ByType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldType, opts...).ToFunc()
+}
+
+// ByRule orders the results by the rule field.
+func ByRule(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRule, opts...).ToFunc()
+}
+
+// ByReason orders the results by the reason field.
+func ByReason(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReason, opts...).ToFunc()
+}
+
+// ByClientOrderID orders the results by the client_order_id field.
+func ByClientOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientOrderID, opts...).ToFunc()
+}
+
+// ByStrategyID orders the results by the strategy_id field.
+func ByStrategyID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldStrategyID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByAcknowledgedAt orders the results by the acknowledged_at field.
+func ByAcknowledgedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledgedAt, opts...).ToFunc()
+}
+
+// ByAcknowledgedBy orders the results by the acknowledged_by field.
+func ByAcknowledgedBy(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAcknowledgedBy, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,857 @@
+// Code generated by ent, DO NOT EDIT.
+
+package riskevent
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// Rule applies equality check predicate on the "rule" field. It's identical to RuleEQ.
+func Rule(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldRule, v))
+}
+
+// Reason applies equality check predicate on the "reason" field. It's identical to ReasonEQ.
+func Reason(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ClientOrderID applies equality check predicate on the "client_order_id" field. It's identical to ClientOrderIDEQ.
+func ClientOrderID(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// StrategyID applies equality check predicate on the "strategy_id" field. It's identical to StrategyIDEQ.
+func StrategyID(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Side applies equality check predicate on the "side" field. It's identical to SideEQ.
+func Side(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldSide, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldPrice, v))
+}
+
+// AcknowledgedAt applies equality check predicate on the "acknowledged_at" field. It's identical to AcknowledgedAtEQ.
+func AcknowledgedAt(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedBy applies equality check predicate on the "acknowledged_by" field. It's identical to AcknowledgedByEQ.
+func AcknowledgedBy(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldUserID, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v Account) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v Account) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...Account) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...Account) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// TypeEQ applies the EQ predicate on the "type" field.
+func TypeEQ(v Type) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldType, v))
+}
+
+// TypeNEQ applies the NEQ predicate on the "type" field.
+func TypeNEQ(v Type) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldType, v))
+}
+
+// TypeIn applies the In predicate on the "type" field.
+func TypeIn(vs ...Type) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldType, vs...))
+}
+
+// TypeNotIn applies the NotIn predicate on the "type" field.
+func TypeNotIn(vs ...Type) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldType, vs...))
+}
+
+// RuleEQ applies the EQ predicate on the "rule" field.
+func RuleEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldRule, v))
+}
+
+// RuleNEQ applies the NEQ predicate on the "rule" field.
+func RuleNEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldRule, v))
+}
+
+// RuleIn applies the In predicate on the "rule" field.
+func RuleIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldRule, vs...))
+}
+
+// RuleNotIn applies the NotIn predicate on the "rule" field.
+func RuleNotIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldRule, vs...))
+}
+
+// RuleGT applies the GT predicate on the "rule" field.
+func RuleGT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldRule, v))
+}
+
+// RuleGTE applies the GTE predicate on the "rule" field.
+func RuleGTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldRule, v))
+}
+
+// RuleLT applies the LT predicate on the "rule" field.
+func RuleLT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldRule, v))
+}
+
+// RuleLTE applies the LTE predicate on the "rule" field.
+func RuleLTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldRule, v))
+}
+
+// RuleContains applies the Contains predicate on the "rule" field.
+func RuleContains(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContains(FieldRule, v))
+}
+
+// RuleHasPrefix applies the HasPrefix predicate on the "rule" field.
+func RuleHasPrefix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasPrefix(FieldRule, v))
+}
+
+// RuleHasSuffix applies the HasSuffix predicate on the "rule" field.
+func RuleHasSuffix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasSuffix(FieldRule, v))
+}
+
+// RuleIsNil applies the IsNil predicate on the "rule" field.
+func RuleIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldRule))
+}
+
+// RuleNotNil applies the NotNil predicate on the "rule" field.
+func RuleNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldRule))
+}
+
+// RuleEqualFold applies the EqualFold predicate on the "rule" field.
+func RuleEqualFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEqualFold(FieldRule, v))
+}
+
+// RuleContainsFold applies the ContainsFold predicate on the "rule" field.
+func RuleContainsFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContainsFold(FieldRule, v))
+}
+
+// ReasonEQ applies the EQ predicate on the "reason" field.
+func ReasonEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldReason, v))
+}
+
+// ReasonNEQ applies the NEQ predicate on the "reason" field.
+func ReasonNEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldReason, v))
+}
+
+// ReasonIn applies the In predicate on the "reason" field.
+func ReasonIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldReason, vs...))
+}
+
+// ReasonNotIn applies the NotIn predicate on the "reason" field.
+func ReasonNotIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldReason, vs...))
+}
+
+// ReasonGT applies the GT predicate on the "reason" field.
+func ReasonGT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldReason, v))
+}
+
+// ReasonGTE applies the GTE predicate on the "reason" field.
+func ReasonGTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldReason, v))
+}
+
+// ReasonLT applies the LT predicate on the "reason" field.
+func ReasonLT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldReason, v))
+}
+
+// ReasonLTE applies the LTE predicate on the "reason" field.
+func ReasonLTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldReason, v))
+}
+
+// ReasonContains applies the Contains predicate on the "reason" field.
+func ReasonContains(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContains(FieldReason, v))
+}
+
+// ReasonHasPrefix applies the HasPrefix predicate on the "reason" field.
+func ReasonHasPrefix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasPrefix(FieldReason, v))
+}
+
+// ReasonHasSuffix applies the HasSuffix predicate on the "reason" field.
+func ReasonHasSuffix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasSuffix(FieldReason, v))
+}
+
+// ReasonEqualFold applies the EqualFold predicate on the "reason" field.
+func ReasonEqualFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEqualFold(FieldReason, v))
+}
+
+// ReasonContainsFold applies the ContainsFold predicate on the "reason" field.
+func ReasonContainsFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContainsFold(FieldReason, v))
+}
+
+// ClientOrderIDEQ applies the EQ predicate on the "client_order_id" field.
+func ClientOrderIDEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDNEQ applies the NEQ predicate on the "client_order_id" field.
+func ClientOrderIDNEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDIn applies the In predicate on the "client_order_id" field.
+func ClientOrderIDIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDNotIn applies the NotIn predicate on the "client_order_id" field.
+func ClientOrderIDNotIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDGT applies the GT predicate on the "client_order_id" field.
+func ClientOrderIDGT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDGTE applies the GTE predicate on the "client_order_id" field.
+func ClientOrderIDGTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLT applies the LT predicate on the "client_order_id" field.
+func ClientOrderIDLT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLTE applies the LTE predicate on the "client_order_id" field.
+func ClientOrderIDLTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContains applies the Contains predicate on the "client_order_id" field.
+func ClientOrderIDContains(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContains(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasPrefix applies the HasPrefix predicate on the "client_order_id" field.
+func ClientOrderIDHasPrefix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasPrefix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasSuffix applies the HasSuffix predicate on the "client_order_id" field.
+func ClientOrderIDHasSuffix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasSuffix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDIsNil applies the IsNil predicate on the "client_order_id" field.
+func ClientOrderIDIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldClientOrderID))
+}
+
+// ClientOrderIDNotNil applies the NotNil predicate on the "client_order_id" field.
+func ClientOrderIDNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldClientOrderID))
+}
+
+// ClientOrderIDEqualFold applies the EqualFold predicate on the "client_order_id" field.
+func ClientOrderIDEqualFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEqualFold(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContainsFold applies the ContainsFold predicate on the "client_order_id" field.
+func ClientOrderIDContainsFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContainsFold(FieldClientOrderID, v))
+}
+
+// StrategyIDEQ applies the EQ predicate on the "strategy_id" field.
+func StrategyIDEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldStrategyID, v))
+}
+
+// StrategyIDNEQ applies the NEQ predicate on the "strategy_id" field.
+func StrategyIDNEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldStrategyID, v))
+}
+
+// StrategyIDIn applies the In predicate on the "strategy_id" field.
+func StrategyIDIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDNotIn applies the NotIn predicate on the "strategy_id" field.
+func StrategyIDNotIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldStrategyID, vs...))
+}
+
+// StrategyIDGT applies the GT predicate on the "strategy_id" field.
+func StrategyIDGT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldStrategyID, v))
+}
+
+// StrategyIDGTE applies the GTE predicate on the "strategy_id" field.
+func StrategyIDGTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldStrategyID, v))
+}
+
+// StrategyIDLT applies the LT predicate on the "strategy_id" field.
+func StrategyIDLT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldStrategyID, v))
+}
+
+// StrategyIDLTE applies the LTE predicate on the "strategy_id" field.
+func StrategyIDLTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldStrategyID, v))
+}
+
+// StrategyIDIsNil applies the IsNil predicate on the "strategy_id" field.
+func StrategyIDIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldStrategyID))
+}
+
+// StrategyIDNotNil applies the NotNil predicate on the "strategy_id" field.
+func StrategyIDNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldStrategyID))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolIsNil applies the IsNil predicate on the "symbol" field.
+func SymbolIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldSymbol))
+}
+
+// SymbolNotNil applies the NotNil predicate on the "symbol" field.
+func SymbolNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldSymbol))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// SideGT applies the GT predicate on the "side" field.
+func SideGT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldSide, v))
+}
+
+// SideGTE applies the GTE predicate on the "side" field.
+func SideGTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldSide, v))
+}
+
+// SideLT applies the LT predicate on the "side" field.
+func SideLT(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldSide, v))
+}
+
+// SideLTE applies the LTE predicate on the "side" field.
+func SideLTE(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldSide, v))
+}
+
+// SideContains applies the Contains predicate on the "side" field.
+func SideContains(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContains(FieldSide, v))
+}
+
+// SideHasPrefix applies the HasPrefix predicate on the "side" field.
+func SideHasPrefix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasPrefix(FieldSide, v))
+}
+
+// SideHasSuffix applies the HasSuffix predicate on the "side" field.
+func SideHasSuffix(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldHasSuffix(FieldSide, v))
+}
+
+// SideIsNil applies the IsNil predicate on the "side" field.
+func SideIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldSide))
+}
+
+// SideNotNil applies the NotNil predicate on the "side" field.
+func SideNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldSide))
+}
+
+// SideEqualFold applies the EqualFold predicate on the "side" field.
+func SideEqualFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEqualFold(FieldSide, v))
+}
+
+// SideContainsFold applies the ContainsFold predicate on the "side" field.
+func SideContainsFold(v string) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldContainsFold(FieldSide, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldPrice, v))
+}
+
+// DetailsIsNil applies the IsNil predicate on the "details" field.
+func DetailsIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldDetails))
+}
+
+// DetailsNotNil applies the NotNil predicate on the "details" field.
+func DetailsNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldDetails))
+}
+
+// AcknowledgedAtEQ applies the EQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtEQ(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtNEQ applies the NEQ predicate on the "acknowledged_at" field.
+func AcknowledgedAtNEQ(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIn applies the In predicate on the "acknowledged_at" field.
+func AcknowledgedAtIn(vs ...time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtNotIn applies the NotIn predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotIn(vs ...time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldAcknowledgedAt, vs...))
+}
+
+// AcknowledgedAtGT applies the GT predicate on the "acknowledged_at" field.
+func AcknowledgedAtGT(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtGTE applies the GTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtGTE(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLT applies the LT predicate on the "acknowledged_at" field.
+func AcknowledgedAtLT(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtLTE applies the LTE predicate on the "acknowledged_at" field.
+func AcknowledgedAtLTE(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldAcknowledgedAt, v))
+}
+
+// AcknowledgedAtIsNil applies the IsNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedAtNotNil applies the NotNil predicate on the "acknowledged_at" field.
+func AcknowledgedAtNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldAcknowledgedAt))
+}
+
+// AcknowledgedByEQ applies the EQ predicate on the "acknowledged_by" field.
+func AcknowledgedByEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByNEQ applies the NEQ predicate on the "acknowledged_by" field.
+func AcknowledgedByNEQ(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIn applies the In predicate on the "acknowledged_by" field.
+func AcknowledgedByIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByNotIn applies the NotIn predicate on the "acknowledged_by" field.
+func AcknowledgedByNotIn(vs ...uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldAcknowledgedBy, vs...))
+}
+
+// AcknowledgedByGT applies the GT predicate on the "acknowledged_by" field.
+func AcknowledgedByGT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByGTE applies the GTE predicate on the "acknowledged_by" field.
+func AcknowledgedByGTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLT applies the LT predicate on the "acknowledged_by" field.
+func AcknowledgedByLT(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByLTE applies the LTE predicate on the "acknowledged_by" field.
+func AcknowledgedByLTE(v uuid.UUID) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldAcknowledgedBy, v))
+}
+
+// AcknowledgedByIsNil applies the IsNil predicate on the "acknowledged_by" field.
+func AcknowledgedByIsNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIsNull(FieldAcknowledgedBy))
+}
+
+// AcknowledgedByNotNil applies the NotNil predicate on the "acknowledged_by" field.
+func AcknowledgedByNotNil() predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotNull(FieldAcknowledgedBy))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RiskEvent) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RiskEvent) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RiskEvent) predicate.RiskEvent {
+	return predicate.RiskEvent(sql.NotPredicates(p))
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/riskevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskEventCreate is the builder for creating a RiskEvent entity.
+type RiskEventCreate struct {
+	config
+	mutation *RiskEventMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RiskEventCreate) SetUserID(v uuid.UUID) *RiskEventCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *RiskEventCreate) SetAccount(v riskevent.Account) *RiskEventCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetType sets the "type" field.
+func (_c *RiskEventCreate) SetType(v riskevent.Type) *RiskEventCreate {
+	_c.mutation.SetType(v)
+	return _c
+}
+
+// SetRule sets the "rule" field.
+func (_c *RiskEventCreate) SetRule(v string) *RiskEventCreate {
+	_c.mutation.SetRule(v)
+	return _c
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableRule(v *string) *RiskEventCreate {
+	if v != nil {
+		_c.SetRule(*v)
+	}
+	return _c
+}
+
+// SetReason sets the "reason" field.
+func (_c *RiskEventCreate) SetReason(v string) *RiskEventCreate {
+	_c.mutation.SetReason(v)
+	return _c
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_c *RiskEventCreate) SetClientOrderID(v string) *RiskEventCreate {
+	_c.mutation.SetClientOrderID(v)
+	return _c
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableClientOrderID(v *string) *RiskEventCreate {
+	if v != nil {
+		_c.SetClientOrderID(*v)
+	}
+	return _c
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_c *RiskEventCreate) SetStrategyID(v uuid.UUID) *RiskEventCreate {
+	_c.mutation.SetStrategyID(v)
+	return _c
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableStrategyID(v *uuid.UUID) *RiskEventCreate {
+	if v != nil {
+		_c.SetStrategyID(*v)
+	}
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *RiskEventCreate) SetSymbol(v string) *RiskEventCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableSymbol(v *string) *RiskEventCreate {
+	if v != nil {
+		_c.SetSymbol(*v)
+	}
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *RiskEventCreate) SetSide(v string) *RiskEventCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableSide(v *string) *RiskEventCreate {
+	if v != nil {
+		_c.SetSide(*v)
+	}
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *RiskEventCreate) SetQuantity(v decimal.Decimal) *RiskEventCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableQuantity(v *decimal.Decimal) *RiskEventCreate {
+	if v != nil {
+		_c.SetQuantity(*v)
+	}
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *RiskEventCreate) SetPrice(v decimal.Decimal) *RiskEventCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillablePrice(v *decimal.Decimal) *RiskEventCreate {
+	if v != nil {
+		_c.SetPrice(*v)
+	}
+	return _c
+}
+
+// SetDetails sets the "details" field.
+func (_c *RiskEventCreate) SetDetails(v map[string]interface{}) *RiskEventCreate {
+	_c.mutation.SetDetails(v)
+	return _c
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_c *RiskEventCreate) SetAcknowledgedAt(v time.Time) *RiskEventCreate {
+	_c.mutation.SetAcknowledgedAt(v)
+	return _c
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableAcknowledgedAt(v *time.Time) *RiskEventCreate {
+	if v != nil {
+		_c.SetAcknowledgedAt(*v)
+	}
+	return _c
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_c *RiskEventCreate) SetAcknowledgedBy(v uuid.UUID) *RiskEventCreate {
+	_c.mutation.SetAcknowledgedBy(v)
+	return _c
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableAcknowledgedBy(v *uuid.UUID) *RiskEventCreate {
+	if v != nil {
+		_c.SetAcknowledgedBy(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *RiskEventCreate) SetCreatedAt(v time.Time) *RiskEventCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableCreatedAt(v *time.Time) *RiskEventCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RiskEventCreate) SetID(v uuid.UUID) *RiskEventCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RiskEventCreate) SetNillableID(v *uuid.UUID) *RiskEventCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RiskEventMutation object of the builder.
+func (_c *RiskEventCreate) Mutation() *RiskEventMutation {
+	return _c.mutation
+}
+
+// Save creates the RiskEvent in the database.
+func (_c *RiskEventCreate) Save(ctx context.Context) (*RiskEvent, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RiskEventCreate) SaveX(ctx context.Context) *RiskEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskEventCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskEventCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RiskEventCreate) defaults() {
+	if _, ok := _c.mutation.Quantity(); !ok {
+		v := riskevent.DefaultQuantity
+		_c.mutation.SetQuantity(v)
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		v := riskevent.DefaultPrice
+		_c.mutation.SetPrice(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := riskevent.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := riskevent.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RiskEventCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RiskEvent.user_id"`)}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "RiskEvent.account"`)}
+	}
+	if v, ok := _c.mutation.Account(); ok {
+		if err := riskevent.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.account": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.GetType(); !ok {
+		return &ValidationError{Name: "type", err: errors.New(`ent: missing required field "RiskEvent.type"`)}
+	}
+	if v, ok := _c.mutation.GetType(); ok {
+		if err := riskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Rule(); ok {
+		if err := riskevent.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.rule": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Reason(); !ok {
+		return &ValidationError{Name: "reason", err: errors.New(`ent: missing required field "RiskEvent.reason"`)}
+	}
+	if v, ok := _c.mutation.ClientOrderID(); ok {
+		if err := riskevent.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := riskevent.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := riskevent.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "RiskEvent.quantity"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "RiskEvent.price"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "RiskEvent.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *RiskEventCreate) sqlSave(ctx context.Context) (*RiskEvent, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RiskEventCreate) createSpec() (*RiskEvent, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RiskEvent{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(riskevent.Table, sqlgraph.NewFieldSpec(riskevent.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(riskevent.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(riskevent.FieldAccount, field.TypeEnum, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.GetType(); ok {
+		_spec.SetField(riskevent.FieldType, field.TypeEnum, value)
+		_node.Type = value
+	}
+	if value, ok := _c.mutation.Rule(); ok {
+		_spec.SetField(riskevent.FieldRule, field.TypeString, value)
+		_node.Rule = value
+	}
+	if value, ok := _c.mutation.Reason(); ok {
+		_spec.SetField(riskevent.FieldReason, field.TypeString, value)
+		_node.Reason = value
+	}
+	if value, ok := _c.mutation.ClientOrderID(); ok {
+		_spec.SetField(riskevent.FieldClientOrderID, field.TypeString, value)
+		_node.ClientOrderID = value
+	}
+	if value, ok := _c.mutation.StrategyID(); ok {
+		_spec.SetField(riskevent.FieldStrategyID, field.TypeUUID, value)
+		_node.StrategyID = &value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(riskevent.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(riskevent.FieldSide, field.TypeString, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(riskevent.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(riskevent.FieldPrice, field.TypeOther, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Details(); ok {
+		_spec.SetField(riskevent.FieldDetails, field.TypeJSON, value)
+		_node.Details = value
+	}
+	if value, ok := _c.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedAt, field.TypeTime, value)
+		_node.AcknowledgedAt = &value
+	}
+	if value, ok := _c.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedBy, field.TypeUUID, value)
+		_node.AcknowledgedBy = &value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(riskevent.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// RiskEventCreateBulk is the builder for creating many RiskEvent entities in bulk.
+type RiskEventCreateBulk struct {
+	config
+	err      error
+	builders []*RiskEventCreate
+}
+
+// Save creates the RiskEvent entities in the database.
+func (_c *RiskEventCreateBulk) Save(ctx context.Context) ([]*RiskEvent, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RiskEvent, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RiskEventMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RiskEventCreateBulk) SaveX(ctx context.Context) []*RiskEvent {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskEventCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskEventCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,858 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/riskevent"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskEventUpdate is the builder for updating RiskEvent entities.
+type RiskEventUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RiskEventMutation
+}
+
+// Where appends a list predicates to the RiskEventUpdate builder.
+func (_u *RiskEventUpdate) Where(ps ...predicate.RiskEvent) *RiskEventUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RiskEventUpdate) SetUserID(v uuid.UUID) *RiskEventUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableUserID(v *uuid.UUID) *RiskEventUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *RiskEventUpdate) SetAccount(v riskevent.Account) *RiskEventUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableAccount(v *riskevent.Account) *RiskEventUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RiskEventUpdate) SetType(v riskevent.Type) *RiskEventUpdate {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableType(v *riskevent.Type) *RiskEventUpdate {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *RiskEventUpdate) SetRule(v string) *RiskEventUpdate {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableRule(v *string) *RiskEventUpdate {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// ClearRule clears the value of the "rule" field.
+func (_u *RiskEventUpdate) ClearRule() *RiskEventUpdate {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *RiskEventUpdate) SetReason(v string) *RiskEventUpdate {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableReason(v *string) *RiskEventUpdate {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *RiskEventUpdate) SetClientOrderID(v string) *RiskEventUpdate {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableClientOrderID(v *string) *RiskEventUpdate {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// ClearClientOrderID clears the value of the "client_order_id" field.
+func (_u *RiskEventUpdate) ClearClientOrderID() *RiskEventUpdate {
+	_u.mutation.ClearClientOrderID()
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *RiskEventUpdate) SetStrategyID(v uuid.UUID) *RiskEventUpdate {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableStrategyID(v *uuid.UUID) *RiskEventUpdate {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *RiskEventUpdate) ClearStrategyID() *RiskEventUpdate {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *RiskEventUpdate) SetSymbol(v string) *RiskEventUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableSymbol(v *string) *RiskEventUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (_u *RiskEventUpdate) ClearSymbol() *RiskEventUpdate {
+	_u.mutation.ClearSymbol()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *RiskEventUpdate) SetSide(v string) *RiskEventUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableSide(v *string) *RiskEventUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// ClearSide clears the value of the "side" field.
+func (_u *RiskEventUpdate) ClearSide() *RiskEventUpdate {
+	_u.mutation.ClearSide()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *RiskEventUpdate) SetQuantity(v decimal.Decimal) *RiskEventUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableQuantity(v *decimal.Decimal) *RiskEventUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *RiskEventUpdate) SetPrice(v decimal.Decimal) *RiskEventUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillablePrice(v *decimal.Decimal) *RiskEventUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *RiskEventUpdate) SetDetails(v map[string]interface{}) *RiskEventUpdate {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *RiskEventUpdate) ClearDetails() *RiskEventUpdate {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *RiskEventUpdate) SetAcknowledgedAt(v time.Time) *RiskEventUpdate {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableAcknowledgedAt(v *time.Time) *RiskEventUpdate {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *RiskEventUpdate) ClearAcknowledgedAt() *RiskEventUpdate {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *RiskEventUpdate) SetAcknowledgedBy(v uuid.UUID) *RiskEventUpdate {
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *RiskEventUpdate) SetNillableAcknowledgedBy(v *uuid.UUID) *RiskEventUpdate {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *RiskEventUpdate) ClearAcknowledgedBy() *RiskEventUpdate {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// Mutation returns the RiskEventMutation object of the builder.
+func (_u *RiskEventUpdate) Mutation() *RiskEventMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RiskEventUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskEventUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RiskEventUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskEventUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskEventUpdate) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := riskevent.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := riskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := riskevent.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.rule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := riskevent.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := riskevent.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := riskevent.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.side": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RiskEventUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(riskevent.Table, riskevent.Columns, sqlgraph.NewFieldSpec(riskevent.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(riskevent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(riskevent.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(riskevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(riskevent.FieldRule, field.TypeString, value)
+	}
+	if _u.mutation.RuleCleared() {
+		_spec.ClearField(riskevent.FieldRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(riskevent.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(riskevent.FieldClientOrderID, field.TypeString, value)
+	}
+	if _u.mutation.ClientOrderIDCleared() {
+		_spec.ClearField(riskevent.FieldClientOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(riskevent.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(riskevent.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(riskevent.FieldSymbol, field.TypeString, value)
+	}
+	if _u.mutation.SymbolCleared() {
+		_spec.ClearField(riskevent.FieldSymbol, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(riskevent.FieldSide, field.TypeString, value)
+	}
+	if _u.mutation.SideCleared() {
+		_spec.ClearField(riskevent.FieldSide, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(riskevent.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(riskevent.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(riskevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(riskevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(riskevent.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(riskevent.FieldAcknowledgedBy, field.TypeUUID)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{riskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RiskEventUpdateOne is the builder for updating a single RiskEvent entity.
+type RiskEventUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RiskEventMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RiskEventUpdateOne) SetUserID(v uuid.UUID) *RiskEventUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableUserID(v *uuid.UUID) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *RiskEventUpdateOne) SetAccount(v riskevent.Account) *RiskEventUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableAccount(v *riskevent.Account) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetType sets the "type" field.
+func (_u *RiskEventUpdateOne) SetType(v riskevent.Type) *RiskEventUpdateOne {
+	_u.mutation.SetType(v)
+	return _u
+}
+
+// SetNillableType sets the "type" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableType(v *riskevent.Type) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetType(*v)
+	}
+	return _u
+}
+
+// SetRule sets the "rule" field.
+func (_u *RiskEventUpdateOne) SetRule(v string) *RiskEventUpdateOne {
+	_u.mutation.SetRule(v)
+	return _u
+}
+
+// SetNillableRule sets the "rule" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableRule(v *string) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetRule(*v)
+	}
+	return _u
+}
+
+// ClearRule clears the value of the "rule" field.
+func (_u *RiskEventUpdateOne) ClearRule() *RiskEventUpdateOne {
+	_u.mutation.ClearRule()
+	return _u
+}
+
+// SetReason sets the "reason" field.
+func (_u *RiskEventUpdateOne) SetReason(v string) *RiskEventUpdateOne {
+	_u.mutation.SetReason(v)
+	return _u
+}
+
+// SetNillableReason sets the "reason" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableReason(v *string) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetReason(*v)
+	}
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *RiskEventUpdateOne) SetClientOrderID(v string) *RiskEventUpdateOne {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableClientOrderID(v *string) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// ClearClientOrderID clears the value of the "client_order_id" field.
+func (_u *RiskEventUpdateOne) ClearClientOrderID() *RiskEventUpdateOne {
+	_u.mutation.ClearClientOrderID()
+	return _u
+}
+
+// SetStrategyID sets the "strategy_id" field.
+func (_u *RiskEventUpdateOne) SetStrategyID(v uuid.UUID) *RiskEventUpdateOne {
+	_u.mutation.SetStrategyID(v)
+	return _u
+}
+
+// SetNillableStrategyID sets the "strategy_id" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableStrategyID(v *uuid.UUID) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetStrategyID(*v)
+	}
+	return _u
+}
+
+// ClearStrategyID clears the value of the "strategy_id" field.
+func (_u *RiskEventUpdateOne) ClearStrategyID() *RiskEventUpdateOne {
+	_u.mutation.ClearStrategyID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *RiskEventUpdateOne) SetSymbol(v string) *RiskEventUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableSymbol(v *string) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// ClearSymbol clears the value of the "symbol" field.
+func (_u *RiskEventUpdateOne) ClearSymbol() *RiskEventUpdateOne {
+	_u.mutation.ClearSymbol()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *RiskEventUpdateOne) SetSide(v string) *RiskEventUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableSide(v *string) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// ClearSide clears the value of the "side" field.
+func (_u *RiskEventUpdateOne) ClearSide() *RiskEventUpdateOne {
+	_u.mutation.ClearSide()
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *RiskEventUpdateOne) SetQuantity(v decimal.Decimal) *RiskEventUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableQuantity(v *decimal.Decimal) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *RiskEventUpdateOne) SetPrice(v decimal.Decimal) *RiskEventUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillablePrice(v *decimal.Decimal) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetDetails sets the "details" field.
+func (_u *RiskEventUpdateOne) SetDetails(v map[string]interface{}) *RiskEventUpdateOne {
+	_u.mutation.SetDetails(v)
+	return _u
+}
+
+// ClearDetails clears the value of the "details" field.
+func (_u *RiskEventUpdateOne) ClearDetails() *RiskEventUpdateOne {
+	_u.mutation.ClearDetails()
+	return _u
+}
+
+// SetAcknowledgedAt sets the "acknowledged_at" field.
+func (_u *RiskEventUpdateOne) SetAcknowledgedAt(v time.Time) *RiskEventUpdateOne {
+	_u.mutation.SetAcknowledgedAt(v)
+	return _u
+}
+
+// SetNillableAcknowledgedAt sets the "acknowledged_at" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableAcknowledgedAt(v *time.Time) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedAt(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedAt clears the value of the "acknowledged_at" field.
+func (_u *RiskEventUpdateOne) ClearAcknowledgedAt() *RiskEventUpdateOne {
+	_u.mutation.ClearAcknowledgedAt()
+	return _u
+}
+
+// SetAcknowledgedBy sets the "acknowledged_by" field.
+func (_u *RiskEventUpdateOne) SetAcknowledgedBy(v uuid.UUID) *RiskEventUpdateOne {
+	_u.mutation.SetAcknowledgedBy(v)
+	return _u
+}
+
+// SetNillableAcknowledgedBy sets the "acknowledged_by" field if the given value is not nil.
+func (_u *RiskEventUpdateOne) SetNillableAcknowledgedBy(v *uuid.UUID) *RiskEventUpdateOne {
+	if v != nil {
+		_u.SetAcknowledgedBy(*v)
+	}
+	return _u
+}
+
+// ClearAcknowledgedBy clears the value of the "acknowledged_by" field.
+func (_u *RiskEventUpdateOne) ClearAcknowledgedBy() *RiskEventUpdateOne {
+	_u.mutation.ClearAcknowledgedBy()
+	return _u
+}
+
+// Mutation returns the RiskEventMutation object of the builder.
+func (_u *RiskEventUpdateOne) Mutation() *RiskEventMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RiskEventUpdate builder.
+func (_u *RiskEventUpdateOne) Where(ps ...predicate.RiskEvent) *RiskEventUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RiskEventUpdateOne) Select(field string, fields ...string) *RiskEventUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RiskEvent entity.
+func (_u *RiskEventUpdateOne) Save(ctx context.Context) (*RiskEvent, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskEventUpdateOne) SaveX(ctx context.Context) *RiskEvent {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RiskEventUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskEventUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskEventUpdateOne) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := riskevent.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.GetType(); ok {
+		if err := riskevent.TypeValidator(v); err != nil {
+			return &ValidationError{Name: "type", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.type": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Rule(); ok {
+		if err := riskevent.RuleValidator(v); err != nil {
+			return &ValidationError{Name: "rule", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.rule": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := riskevent.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := riskevent.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := riskevent.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "RiskEvent.side": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RiskEventUpdateOne) sqlSave(ctx context.Context) (_node *RiskEvent, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(riskevent.Table, riskevent.Columns, sqlgraph.NewFieldSpec(riskevent.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RiskEvent.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, riskevent.FieldID)
+		for _, f := range fields {
+			if !riskevent.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != riskevent.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(riskevent.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(riskevent.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.GetType(); ok {
+		_spec.SetField(riskevent.FieldType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Rule(); ok {
+		_spec.SetField(riskevent.FieldRule, field.TypeString, value)
+	}
+	if _u.mutation.RuleCleared() {
+		_spec.ClearField(riskevent.FieldRule, field.TypeString)
+	}
+	if value, ok := _u.mutation.Reason(); ok {
+		_spec.SetField(riskevent.FieldReason, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(riskevent.FieldClientOrderID, field.TypeString, value)
+	}
+	if _u.mutation.ClientOrderIDCleared() {
+		_spec.ClearField(riskevent.FieldClientOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.StrategyID(); ok {
+		_spec.SetField(riskevent.FieldStrategyID, field.TypeUUID, value)
+	}
+	if _u.mutation.StrategyIDCleared() {
+		_spec.ClearField(riskevent.FieldStrategyID, field.TypeUUID)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(riskevent.FieldSymbol, field.TypeString, value)
+	}
+	if _u.mutation.SymbolCleared() {
+		_spec.ClearField(riskevent.FieldSymbol, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(riskevent.FieldSide, field.TypeString, value)
+	}
+	if _u.mutation.SideCleared() {
+		_spec.ClearField(riskevent.FieldSide, field.TypeString)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(riskevent.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(riskevent.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Details(); ok {
+		_spec.SetField(riskevent.FieldDetails, field.TypeJSON, value)
+	}
+	if _u.mutation.DetailsCleared() {
+		_spec.ClearField(riskevent.FieldDetails, field.TypeJSON)
+	}
+	if value, ok := _u.mutation.AcknowledgedAt(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedAt, field.TypeTime, value)
+	}
+	if _u.mutation.AcknowledgedAtCleared() {
+		_spec.ClearField(riskevent.FieldAcknowledgedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.AcknowledgedBy(); ok {
+		_spec.SetField(riskevent.FieldAcknowledgedBy, field.TypeUUID, value)
+	}
+	if _u.mutation.AcknowledgedByCleared() {
+		_spec.ClearField(riskevent.FieldAcknowledgedBy, field.TypeUUID)
+	}
+	_node = &RiskEvent{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{riskevent.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
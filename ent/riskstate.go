@@ -0,0 +1,187 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/riskstate"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskState is the model entity for the RiskState schema.
+type RiskState struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// Account holds the value of the "account" field.
+	Account riskstate.Account `json:"account,omitempty"`
+	// TradingDay holds the value of the "trading_day" field.
+	TradingDay string `json:"trading_day,omitempty"`
+	// DailyRealizedPnl holds the value of the "daily_realized_pnl" field.
+	DailyRealizedPnl decimal.Decimal `json:"daily_realized_pnl,omitempty"`
+	// OpenExposure holds the value of the "open_exposure" field.
+	OpenExposure decimal.Decimal `json:"open_exposure,omitempty"`
+	// Equity holds the value of the "equity" field.
+	Equity decimal.Decimal `json:"equity,omitempty"`
+	// PeakEquity holds the value of the "peak_equity" field.
+	PeakEquity decimal.Decimal `json:"peak_equity,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*RiskState) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case riskstate.FieldDailyRealizedPnl, riskstate.FieldOpenExposure, riskstate.FieldEquity, riskstate.FieldPeakEquity:
+			values[i] = new(decimal.Decimal)
+		case riskstate.FieldAccount, riskstate.FieldTradingDay:
+			values[i] = new(sql.NullString)
+		case riskstate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case riskstate.FieldID, riskstate.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the RiskState fields.
+func (_m *RiskState) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case riskstate.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case riskstate.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case riskstate.FieldAccount:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field account", values[i])
+			} else if value.Valid {
+				_m.Account = riskstate.Account(value.String)
+			}
+		case riskstate.FieldTradingDay:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trading_day", values[i])
+			} else if value.Valid {
+				_m.TradingDay = value.String
+			}
+		case riskstate.FieldDailyRealizedPnl:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field daily_realized_pnl", values[i])
+			} else if value != nil {
+				_m.DailyRealizedPnl = *value
+			}
+		case riskstate.FieldOpenExposure:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field open_exposure", values[i])
+			} else if value != nil {
+				_m.OpenExposure = *value
+			}
+		case riskstate.FieldEquity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field equity", values[i])
+			} else if value != nil {
+				_m.Equity = *value
+			}
+		case riskstate.FieldPeakEquity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field peak_equity", values[i])
+			} else if value != nil {
+				_m.PeakEquity = *value
+			}
+		case riskstate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the RiskState.
+// This includes values selected through modifiers, order, etc.
+func (_m *RiskState) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this RiskState.
+// Note that you need to call RiskState.Unwrap() before calling this method if this RiskState
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *RiskState) Update() *RiskStateUpdateOne {
+	return NewRiskStateClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the RiskState entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *RiskState) Unwrap() *RiskState {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: RiskState is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *RiskState) String() string {
+	var builder strings.Builder
+	builder.WriteString("RiskState(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("account=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Account))
+	builder.WriteString(", ")
+	builder.WriteString("trading_day=")
+	builder.WriteString(_m.TradingDay)
+	builder.WriteString(", ")
+	builder.WriteString("daily_realized_pnl=")
+	builder.WriteString(fmt.Sprintf("%v", _m.DailyRealizedPnl))
+	builder.WriteString(", ")
+	builder.WriteString("open_exposure=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpenExposure))
+	builder.WriteString(", ")
+	builder.WriteString("equity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Equity))
+	builder.WriteString(", ")
+	builder.WriteString("peak_equity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeakEquity))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// RiskStates is a parsable slice of RiskState.
+type RiskStates []*RiskState
@@ -0,0 +1,150 @@
+// Code generated by ent, DO NOT EDIT.
+
+package riskstate
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the riskstate type in the database.
+	Label = "risk_state"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldAccount holds the string denoting the account field in the database.
+	FieldAccount = "account"
+	// FieldTradingDay holds the string denoting the trading_day field in the database.
+	FieldTradingDay = "trading_day"
+	// FieldDailyRealizedPnl holds the string denoting the daily_realized_pnl field in the database.
+	FieldDailyRealizedPnl = "daily_realized_pnl"
+	// FieldOpenExposure holds the string denoting the open_exposure field in the database.
+	FieldOpenExposure = "open_exposure"
+	// FieldEquity holds the string denoting the equity field in the database.
+	FieldEquity = "equity"
+	// FieldPeakEquity holds the string denoting the peak_equity field in the database.
+	FieldPeakEquity = "peak_equity"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the riskstate in the database.
+	Table = "risk_states"
+)
+
+// Columns holds all SQL columns for riskstate fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldAccount,
+	FieldTradingDay,
+	FieldDailyRealizedPnl,
+	FieldOpenExposure,
+	FieldEquity,
+	FieldPeakEquity,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TradingDayValidator is a validator for the "trading_day" field. It is called by the builders before save.
+	TradingDayValidator func(string) error
+	// DefaultDailyRealizedPnl holds the default value on creation for the "daily_realized_pnl" field.
+	DefaultDailyRealizedPnl decimal.Decimal
+	// DefaultOpenExposure holds the default value on creation for the "open_exposure" field.
+	DefaultOpenExposure decimal.Decimal
+	// DefaultEquity holds the default value on creation for the "equity" field.
+	DefaultEquity decimal.Decimal
+	// DefaultPeakEquity holds the default value on creation for the "peak_equity" field.
+	DefaultPeakEquity decimal.Decimal
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Account defines the type for the "account" enum field.
+type Account string
+
+// Account values.
+const (
+	AccountLIVE  Account = "LIVE"
+	AccountPAPER Account = "PAPER"
+)
+
+func (a Account) String() string {
+	return string(a)
+}
+
+// AccountValidator is a validator for the "account" field enum values. It is called by the builders before save.
+func AccountValidator(a Account) error {
+	switch a {
+	case AccountLIVE, AccountPAPER:
+		return nil
+	default:
+		return fmt.Errorf("riskstate: invalid enum value for account field: %q", a)
+	}
+}
+
+// OrderOption defines the ordering options for the RiskState queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByAccount orders the results by the account field.
+func ByAccount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAccount, opts...).ToFunc()
+}
+
+// ByTradingDay orders the results by the trading_day field.
+func ByTradingDay(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradingDay, opts...).ToFunc()
+}
+
+// ByDailyRealizedPnl orders the results by the daily_realized_pnl field.
+func ByDailyRealizedPnl(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldDailyRealizedPnl, opts...).ToFunc()
+}
+
+// ByOpenExposure orders the results by the open_exposure field.
+func ByOpenExposure(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenExposure, opts...).ToFunc()
+}
+
+// ByEquity orders the results by the equity field.
+func ByEquity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEquity, opts...).ToFunc()
+}
+
+// ByPeakEquity orders the results by the peak_equity field.
+func ByPeakEquity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeakEquity, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,432 @@
+// Code generated by ent, DO NOT EDIT.
+
+package riskstate
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldUserID, v))
+}
+
+// TradingDay applies equality check predicate on the "trading_day" field. It's identical to TradingDayEQ.
+func TradingDay(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldTradingDay, v))
+}
+
+// DailyRealizedPnl applies equality check predicate on the "daily_realized_pnl" field. It's identical to DailyRealizedPnlEQ.
+func DailyRealizedPnl(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldDailyRealizedPnl, v))
+}
+
+// OpenExposure applies equality check predicate on the "open_exposure" field. It's identical to OpenExposureEQ.
+func OpenExposure(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldOpenExposure, v))
+}
+
+// Equity applies equality check predicate on the "equity" field. It's identical to EquityEQ.
+func Equity(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldEquity, v))
+}
+
+// PeakEquity applies equality check predicate on the "peak_equity" field. It's identical to PeakEquityEQ.
+func PeakEquity(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldPeakEquity, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldUserID, v))
+}
+
+// AccountEQ applies the EQ predicate on the "account" field.
+func AccountEQ(v Account) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldAccount, v))
+}
+
+// AccountNEQ applies the NEQ predicate on the "account" field.
+func AccountNEQ(v Account) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldAccount, v))
+}
+
+// AccountIn applies the In predicate on the "account" field.
+func AccountIn(vs ...Account) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldAccount, vs...))
+}
+
+// AccountNotIn applies the NotIn predicate on the "account" field.
+func AccountNotIn(vs ...Account) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldAccount, vs...))
+}
+
+// TradingDayEQ applies the EQ predicate on the "trading_day" field.
+func TradingDayEQ(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldTradingDay, v))
+}
+
+// TradingDayNEQ applies the NEQ predicate on the "trading_day" field.
+func TradingDayNEQ(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldTradingDay, v))
+}
+
+// TradingDayIn applies the In predicate on the "trading_day" field.
+func TradingDayIn(vs ...string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldTradingDay, vs...))
+}
+
+// TradingDayNotIn applies the NotIn predicate on the "trading_day" field.
+func TradingDayNotIn(vs ...string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldTradingDay, vs...))
+}
+
+// TradingDayGT applies the GT predicate on the "trading_day" field.
+func TradingDayGT(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldTradingDay, v))
+}
+
+// TradingDayGTE applies the GTE predicate on the "trading_day" field.
+func TradingDayGTE(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldTradingDay, v))
+}
+
+// TradingDayLT applies the LT predicate on the "trading_day" field.
+func TradingDayLT(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldTradingDay, v))
+}
+
+// TradingDayLTE applies the LTE predicate on the "trading_day" field.
+func TradingDayLTE(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldTradingDay, v))
+}
+
+// TradingDayContains applies the Contains predicate on the "trading_day" field.
+func TradingDayContains(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldContains(FieldTradingDay, v))
+}
+
+// TradingDayHasPrefix applies the HasPrefix predicate on the "trading_day" field.
+func TradingDayHasPrefix(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldHasPrefix(FieldTradingDay, v))
+}
+
+// TradingDayHasSuffix applies the HasSuffix predicate on the "trading_day" field.
+func TradingDayHasSuffix(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldHasSuffix(FieldTradingDay, v))
+}
+
+// TradingDayEqualFold applies the EqualFold predicate on the "trading_day" field.
+func TradingDayEqualFold(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEqualFold(FieldTradingDay, v))
+}
+
+// TradingDayContainsFold applies the ContainsFold predicate on the "trading_day" field.
+func TradingDayContainsFold(v string) predicate.RiskState {
+	return predicate.RiskState(sql.FieldContainsFold(FieldTradingDay, v))
+}
+
+// DailyRealizedPnlEQ applies the EQ predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldDailyRealizedPnl, v))
+}
+
+// DailyRealizedPnlNEQ applies the NEQ predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlNEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldDailyRealizedPnl, v))
+}
+
+// DailyRealizedPnlIn applies the In predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldDailyRealizedPnl, vs...))
+}
+
+// DailyRealizedPnlNotIn applies the NotIn predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlNotIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldDailyRealizedPnl, vs...))
+}
+
+// DailyRealizedPnlGT applies the GT predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlGT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldDailyRealizedPnl, v))
+}
+
+// DailyRealizedPnlGTE applies the GTE predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlGTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldDailyRealizedPnl, v))
+}
+
+// DailyRealizedPnlLT applies the LT predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlLT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldDailyRealizedPnl, v))
+}
+
+// DailyRealizedPnlLTE applies the LTE predicate on the "daily_realized_pnl" field.
+func DailyRealizedPnlLTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldDailyRealizedPnl, v))
+}
+
+// OpenExposureEQ applies the EQ predicate on the "open_exposure" field.
+func OpenExposureEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldOpenExposure, v))
+}
+
+// OpenExposureNEQ applies the NEQ predicate on the "open_exposure" field.
+func OpenExposureNEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldOpenExposure, v))
+}
+
+// OpenExposureIn applies the In predicate on the "open_exposure" field.
+func OpenExposureIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldOpenExposure, vs...))
+}
+
+// OpenExposureNotIn applies the NotIn predicate on the "open_exposure" field.
+func OpenExposureNotIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldOpenExposure, vs...))
+}
+
+// OpenExposureGT applies the GT predicate on the "open_exposure" field.
+func OpenExposureGT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldOpenExposure, v))
+}
+
+// OpenExposureGTE applies the GTE predicate on the "open_exposure" field.
+func OpenExposureGTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldOpenExposure, v))
+}
+
+// OpenExposureLT applies the LT predicate on the "open_exposure" field.
+func OpenExposureLT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldOpenExposure, v))
+}
+
+// OpenExposureLTE applies the LTE predicate on the "open_exposure" field.
+func OpenExposureLTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldOpenExposure, v))
+}
+
+// EquityEQ applies the EQ predicate on the "equity" field.
+func EquityEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldEquity, v))
+}
+
+// EquityNEQ applies the NEQ predicate on the "equity" field.
+func EquityNEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldEquity, v))
+}
+
+// EquityIn applies the In predicate on the "equity" field.
+func EquityIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldEquity, vs...))
+}
+
+// EquityNotIn applies the NotIn predicate on the "equity" field.
+func EquityNotIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldEquity, vs...))
+}
+
+// EquityGT applies the GT predicate on the "equity" field.
+func EquityGT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldEquity, v))
+}
+
+// EquityGTE applies the GTE predicate on the "equity" field.
+func EquityGTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldEquity, v))
+}
+
+// EquityLT applies the LT predicate on the "equity" field.
+func EquityLT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldEquity, v))
+}
+
+// EquityLTE applies the LTE predicate on the "equity" field.
+func EquityLTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldEquity, v))
+}
+
+// PeakEquityEQ applies the EQ predicate on the "peak_equity" field.
+func PeakEquityEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldPeakEquity, v))
+}
+
+// PeakEquityNEQ applies the NEQ predicate on the "peak_equity" field.
+func PeakEquityNEQ(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldPeakEquity, v))
+}
+
+// PeakEquityIn applies the In predicate on the "peak_equity" field.
+func PeakEquityIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldPeakEquity, vs...))
+}
+
+// PeakEquityNotIn applies the NotIn predicate on the "peak_equity" field.
+func PeakEquityNotIn(vs ...decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldPeakEquity, vs...))
+}
+
+// PeakEquityGT applies the GT predicate on the "peak_equity" field.
+func PeakEquityGT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldPeakEquity, v))
+}
+
+// PeakEquityGTE applies the GTE predicate on the "peak_equity" field.
+func PeakEquityGTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldPeakEquity, v))
+}
+
+// PeakEquityLT applies the LT predicate on the "peak_equity" field.
+func PeakEquityLT(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldPeakEquity, v))
+}
+
+// PeakEquityLTE applies the LTE predicate on the "peak_equity" field.
+func PeakEquityLTE(v decimal.Decimal) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldPeakEquity, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.RiskState {
+	return predicate.RiskState(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.RiskState) predicate.RiskState {
+	return predicate.RiskState(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.RiskState) predicate.RiskState {
+	return predicate.RiskState(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.RiskState) predicate.RiskState {
+	return predicate.RiskState(sql.NotPredicates(p))
+}
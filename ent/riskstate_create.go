@@ -0,0 +1,376 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/riskstate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskStateCreate is the builder for creating a RiskState entity.
+type RiskStateCreate struct {
+	config
+	mutation *RiskStateMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *RiskStateCreate) SetUserID(v uuid.UUID) *RiskStateCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetAccount sets the "account" field.
+func (_c *RiskStateCreate) SetAccount(v riskstate.Account) *RiskStateCreate {
+	_c.mutation.SetAccount(v)
+	return _c
+}
+
+// SetTradingDay sets the "trading_day" field.
+func (_c *RiskStateCreate) SetTradingDay(v string) *RiskStateCreate {
+	_c.mutation.SetTradingDay(v)
+	return _c
+}
+
+// SetDailyRealizedPnl sets the "daily_realized_pnl" field.
+func (_c *RiskStateCreate) SetDailyRealizedPnl(v decimal.Decimal) *RiskStateCreate {
+	_c.mutation.SetDailyRealizedPnl(v)
+	return _c
+}
+
+// SetNillableDailyRealizedPnl sets the "daily_realized_pnl" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillableDailyRealizedPnl(v *decimal.Decimal) *RiskStateCreate {
+	if v != nil {
+		_c.SetDailyRealizedPnl(*v)
+	}
+	return _c
+}
+
+// SetOpenExposure sets the "open_exposure" field.
+func (_c *RiskStateCreate) SetOpenExposure(v decimal.Decimal) *RiskStateCreate {
+	_c.mutation.SetOpenExposure(v)
+	return _c
+}
+
+// SetNillableOpenExposure sets the "open_exposure" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillableOpenExposure(v *decimal.Decimal) *RiskStateCreate {
+	if v != nil {
+		_c.SetOpenExposure(*v)
+	}
+	return _c
+}
+
+// SetEquity sets the "equity" field.
+func (_c *RiskStateCreate) SetEquity(v decimal.Decimal) *RiskStateCreate {
+	_c.mutation.SetEquity(v)
+	return _c
+}
+
+// SetNillableEquity sets the "equity" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillableEquity(v *decimal.Decimal) *RiskStateCreate {
+	if v != nil {
+		_c.SetEquity(*v)
+	}
+	return _c
+}
+
+// SetPeakEquity sets the "peak_equity" field.
+func (_c *RiskStateCreate) SetPeakEquity(v decimal.Decimal) *RiskStateCreate {
+	_c.mutation.SetPeakEquity(v)
+	return _c
+}
+
+// SetNillablePeakEquity sets the "peak_equity" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillablePeakEquity(v *decimal.Decimal) *RiskStateCreate {
+	if v != nil {
+		_c.SetPeakEquity(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *RiskStateCreate) SetUpdatedAt(v time.Time) *RiskStateCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillableUpdatedAt(v *time.Time) *RiskStateCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *RiskStateCreate) SetID(v uuid.UUID) *RiskStateCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *RiskStateCreate) SetNillableID(v *uuid.UUID) *RiskStateCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the RiskStateMutation object of the builder.
+func (_c *RiskStateCreate) Mutation() *RiskStateMutation {
+	return _c.mutation
+}
+
+// Save creates the RiskState in the database.
+func (_c *RiskStateCreate) Save(ctx context.Context) (*RiskState, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *RiskStateCreate) SaveX(ctx context.Context) *RiskState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskStateCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskStateCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *RiskStateCreate) defaults() {
+	if _, ok := _c.mutation.DailyRealizedPnl(); !ok {
+		v := riskstate.DefaultDailyRealizedPnl
+		_c.mutation.SetDailyRealizedPnl(v)
+	}
+	if _, ok := _c.mutation.OpenExposure(); !ok {
+		v := riskstate.DefaultOpenExposure
+		_c.mutation.SetOpenExposure(v)
+	}
+	if _, ok := _c.mutation.Equity(); !ok {
+		v := riskstate.DefaultEquity
+		_c.mutation.SetEquity(v)
+	}
+	if _, ok := _c.mutation.PeakEquity(); !ok {
+		v := riskstate.DefaultPeakEquity
+		_c.mutation.SetPeakEquity(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := riskstate.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := riskstate.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *RiskStateCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "RiskState.user_id"`)}
+	}
+	if _, ok := _c.mutation.Account(); !ok {
+		return &ValidationError{Name: "account", err: errors.New(`ent: missing required field "RiskState.account"`)}
+	}
+	if v, ok := _c.mutation.Account(); ok {
+		if err := riskstate.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskState.account": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.TradingDay(); !ok {
+		return &ValidationError{Name: "trading_day", err: errors.New(`ent: missing required field "RiskState.trading_day"`)}
+	}
+	if v, ok := _c.mutation.TradingDay(); ok {
+		if err := riskstate.TradingDayValidator(v); err != nil {
+			return &ValidationError{Name: "trading_day", err: fmt.Errorf(`ent: validator failed for field "RiskState.trading_day": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.DailyRealizedPnl(); !ok {
+		return &ValidationError{Name: "daily_realized_pnl", err: errors.New(`ent: missing required field "RiskState.daily_realized_pnl"`)}
+	}
+	if _, ok := _c.mutation.OpenExposure(); !ok {
+		return &ValidationError{Name: "open_exposure", err: errors.New(`ent: missing required field "RiskState.open_exposure"`)}
+	}
+	if _, ok := _c.mutation.Equity(); !ok {
+		return &ValidationError{Name: "equity", err: errors.New(`ent: missing required field "RiskState.equity"`)}
+	}
+	if _, ok := _c.mutation.PeakEquity(); !ok {
+		return &ValidationError{Name: "peak_equity", err: errors.New(`ent: missing required field "RiskState.peak_equity"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "RiskState.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *RiskStateCreate) sqlSave(ctx context.Context) (*RiskState, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *RiskStateCreate) createSpec() (*RiskState, *sqlgraph.CreateSpec) {
+	var (
+		_node = &RiskState{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(riskstate.Table, sqlgraph.NewFieldSpec(riskstate.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(riskstate.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.Account(); ok {
+		_spec.SetField(riskstate.FieldAccount, field.TypeEnum, value)
+		_node.Account = value
+	}
+	if value, ok := _c.mutation.TradingDay(); ok {
+		_spec.SetField(riskstate.FieldTradingDay, field.TypeString, value)
+		_node.TradingDay = value
+	}
+	if value, ok := _c.mutation.DailyRealizedPnl(); ok {
+		_spec.SetField(riskstate.FieldDailyRealizedPnl, field.TypeOther, value)
+		_node.DailyRealizedPnl = value
+	}
+	if value, ok := _c.mutation.OpenExposure(); ok {
+		_spec.SetField(riskstate.FieldOpenExposure, field.TypeOther, value)
+		_node.OpenExposure = value
+	}
+	if value, ok := _c.mutation.Equity(); ok {
+		_spec.SetField(riskstate.FieldEquity, field.TypeOther, value)
+		_node.Equity = value
+	}
+	if value, ok := _c.mutation.PeakEquity(); ok {
+		_spec.SetField(riskstate.FieldPeakEquity, field.TypeOther, value)
+		_node.PeakEquity = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(riskstate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// RiskStateCreateBulk is the builder for creating many RiskState entities in bulk.
+type RiskStateCreateBulk struct {
+	config
+	err      error
+	builders []*RiskStateCreate
+}
+
+// Save creates the RiskState entities in the database.
+func (_c *RiskStateCreateBulk) Save(ctx context.Context) ([]*RiskState, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*RiskState, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*RiskStateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *RiskStateCreateBulk) SaveX(ctx context.Context) []*RiskState {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *RiskStateCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *RiskStateCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,488 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/riskstate"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskStateUpdate is the builder for updating RiskState entities.
+type RiskStateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *RiskStateMutation
+}
+
+// Where appends a list predicates to the RiskStateUpdate builder.
+func (_u *RiskStateUpdate) Where(ps ...predicate.RiskState) *RiskStateUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RiskStateUpdate) SetUserID(v uuid.UUID) *RiskStateUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableUserID(v *uuid.UUID) *RiskStateUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *RiskStateUpdate) SetAccount(v riskstate.Account) *RiskStateUpdate {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableAccount(v *riskstate.Account) *RiskStateUpdate {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetTradingDay sets the "trading_day" field.
+func (_u *RiskStateUpdate) SetTradingDay(v string) *RiskStateUpdate {
+	_u.mutation.SetTradingDay(v)
+	return _u
+}
+
+// SetNillableTradingDay sets the "trading_day" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableTradingDay(v *string) *RiskStateUpdate {
+	if v != nil {
+		_u.SetTradingDay(*v)
+	}
+	return _u
+}
+
+// SetDailyRealizedPnl sets the "daily_realized_pnl" field.
+func (_u *RiskStateUpdate) SetDailyRealizedPnl(v decimal.Decimal) *RiskStateUpdate {
+	_u.mutation.SetDailyRealizedPnl(v)
+	return _u
+}
+
+// SetNillableDailyRealizedPnl sets the "daily_realized_pnl" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableDailyRealizedPnl(v *decimal.Decimal) *RiskStateUpdate {
+	if v != nil {
+		_u.SetDailyRealizedPnl(*v)
+	}
+	return _u
+}
+
+// SetOpenExposure sets the "open_exposure" field.
+func (_u *RiskStateUpdate) SetOpenExposure(v decimal.Decimal) *RiskStateUpdate {
+	_u.mutation.SetOpenExposure(v)
+	return _u
+}
+
+// SetNillableOpenExposure sets the "open_exposure" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableOpenExposure(v *decimal.Decimal) *RiskStateUpdate {
+	if v != nil {
+		_u.SetOpenExposure(*v)
+	}
+	return _u
+}
+
+// SetEquity sets the "equity" field.
+func (_u *RiskStateUpdate) SetEquity(v decimal.Decimal) *RiskStateUpdate {
+	_u.mutation.SetEquity(v)
+	return _u
+}
+
+// SetNillableEquity sets the "equity" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillableEquity(v *decimal.Decimal) *RiskStateUpdate {
+	if v != nil {
+		_u.SetEquity(*v)
+	}
+	return _u
+}
+
+// SetPeakEquity sets the "peak_equity" field.
+func (_u *RiskStateUpdate) SetPeakEquity(v decimal.Decimal) *RiskStateUpdate {
+	_u.mutation.SetPeakEquity(v)
+	return _u
+}
+
+// SetNillablePeakEquity sets the "peak_equity" field if the given value is not nil.
+func (_u *RiskStateUpdate) SetNillablePeakEquity(v *decimal.Decimal) *RiskStateUpdate {
+	if v != nil {
+		_u.SetPeakEquity(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RiskStateUpdate) SetUpdatedAt(v time.Time) *RiskStateUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RiskStateMutation object of the builder.
+func (_u *RiskStateUpdate) Mutation() *RiskStateMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *RiskStateUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskStateUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *RiskStateUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskStateUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RiskStateUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := riskstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskStateUpdate) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := riskstate.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskState.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TradingDay(); ok {
+		if err := riskstate.TradingDayValidator(v); err != nil {
+			return &ValidationError{Name: "trading_day", err: fmt.Errorf(`ent: validator failed for field "RiskState.trading_day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RiskStateUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(riskstate.Table, riskstate.Columns, sqlgraph.NewFieldSpec(riskstate.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(riskstate.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(riskstate.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TradingDay(); ok {
+		_spec.SetField(riskstate.FieldTradingDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DailyRealizedPnl(); ok {
+		_spec.SetField(riskstate.FieldDailyRealizedPnl, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenExposure(); ok {
+		_spec.SetField(riskstate.FieldOpenExposure, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Equity(); ok {
+		_spec.SetField(riskstate.FieldEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.PeakEquity(); ok {
+		_spec.SetField(riskstate.FieldPeakEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(riskstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{riskstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// RiskStateUpdateOne is the builder for updating a single RiskState entity.
+type RiskStateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *RiskStateMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *RiskStateUpdateOne) SetUserID(v uuid.UUID) *RiskStateUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableUserID(v *uuid.UUID) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetAccount sets the "account" field.
+func (_u *RiskStateUpdateOne) SetAccount(v riskstate.Account) *RiskStateUpdateOne {
+	_u.mutation.SetAccount(v)
+	return _u
+}
+
+// SetNillableAccount sets the "account" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableAccount(v *riskstate.Account) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetAccount(*v)
+	}
+	return _u
+}
+
+// SetTradingDay sets the "trading_day" field.
+func (_u *RiskStateUpdateOne) SetTradingDay(v string) *RiskStateUpdateOne {
+	_u.mutation.SetTradingDay(v)
+	return _u
+}
+
+// SetNillableTradingDay sets the "trading_day" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableTradingDay(v *string) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetTradingDay(*v)
+	}
+	return _u
+}
+
+// SetDailyRealizedPnl sets the "daily_realized_pnl" field.
+func (_u *RiskStateUpdateOne) SetDailyRealizedPnl(v decimal.Decimal) *RiskStateUpdateOne {
+	_u.mutation.SetDailyRealizedPnl(v)
+	return _u
+}
+
+// SetNillableDailyRealizedPnl sets the "daily_realized_pnl" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableDailyRealizedPnl(v *decimal.Decimal) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetDailyRealizedPnl(*v)
+	}
+	return _u
+}
+
+// SetOpenExposure sets the "open_exposure" field.
+func (_u *RiskStateUpdateOne) SetOpenExposure(v decimal.Decimal) *RiskStateUpdateOne {
+	_u.mutation.SetOpenExposure(v)
+	return _u
+}
+
+// SetNillableOpenExposure sets the "open_exposure" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableOpenExposure(v *decimal.Decimal) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetOpenExposure(*v)
+	}
+	return _u
+}
+
+// SetEquity sets the "equity" field.
+func (_u *RiskStateUpdateOne) SetEquity(v decimal.Decimal) *RiskStateUpdateOne {
+	_u.mutation.SetEquity(v)
+	return _u
+}
+
+// SetNillableEquity sets the "equity" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillableEquity(v *decimal.Decimal) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetEquity(*v)
+	}
+	return _u
+}
+
+// SetPeakEquity sets the "peak_equity" field.
+func (_u *RiskStateUpdateOne) SetPeakEquity(v decimal.Decimal) *RiskStateUpdateOne {
+	_u.mutation.SetPeakEquity(v)
+	return _u
+}
+
+// SetNillablePeakEquity sets the "peak_equity" field if the given value is not nil.
+func (_u *RiskStateUpdateOne) SetNillablePeakEquity(v *decimal.Decimal) *RiskStateUpdateOne {
+	if v != nil {
+		_u.SetPeakEquity(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *RiskStateUpdateOne) SetUpdatedAt(v time.Time) *RiskStateUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the RiskStateMutation object of the builder.
+func (_u *RiskStateUpdateOne) Mutation() *RiskStateMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the RiskStateUpdate builder.
+func (_u *RiskStateUpdateOne) Where(ps ...predicate.RiskState) *RiskStateUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *RiskStateUpdateOne) Select(field string, fields ...string) *RiskStateUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated RiskState entity.
+func (_u *RiskStateUpdateOne) Save(ctx context.Context) (*RiskState, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *RiskStateUpdateOne) SaveX(ctx context.Context) *RiskState {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *RiskStateUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *RiskStateUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *RiskStateUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := riskstate.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *RiskStateUpdateOne) check() error {
+	if v, ok := _u.mutation.Account(); ok {
+		if err := riskstate.AccountValidator(v); err != nil {
+			return &ValidationError{Name: "account", err: fmt.Errorf(`ent: validator failed for field "RiskState.account": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TradingDay(); ok {
+		if err := riskstate.TradingDayValidator(v); err != nil {
+			return &ValidationError{Name: "trading_day", err: fmt.Errorf(`ent: validator failed for field "RiskState.trading_day": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *RiskStateUpdateOne) sqlSave(ctx context.Context) (_node *RiskState, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(riskstate.Table, riskstate.Columns, sqlgraph.NewFieldSpec(riskstate.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "RiskState.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, riskstate.FieldID)
+		for _, f := range fields {
+			if !riskstate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != riskstate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(riskstate.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Account(); ok {
+		_spec.SetField(riskstate.FieldAccount, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.TradingDay(); ok {
+		_spec.SetField(riskstate.FieldTradingDay, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.DailyRealizedPnl(); ok {
+		_spec.SetField(riskstate.FieldDailyRealizedPnl, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenExposure(); ok {
+		_spec.SetField(riskstate.FieldOpenExposure, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Equity(); ok {
+		_spec.SetField(riskstate.FieldEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.PeakEquity(); ok {
+		_spec.SetField(riskstate.FieldPeakEquity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(riskstate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &RiskState{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{riskstate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
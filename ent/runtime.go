@@ -3,13 +3,27 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/brokeraccount"
+	"auto-trader/ent/candle"
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/order"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
 	"auto-trader/ent/portfolio"
+	"auto-trader/ent/protectionrule"
+	"auto-trader/ent/riskevent"
+	"auto-trader/ent/riskstate"
 	"auto-trader/ent/schema"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
 	"auto-trader/ent/strategyperformance"
 	"auto-trader/ent/strategystatus"
 	"auto-trader/ent/strategytemplate"
+	"auto-trader/ent/symbol"
+	"auto-trader/ent/taxlot"
+	"auto-trader/ent/trade"
 	"auto-trader/ent/user"
 	"time"
 
@@ -21,48 +35,320 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	backtestresultFields := schema.BacktestResult{}.Fields()
+	_ = backtestresultFields
+	// backtestresultDescSymbols is the schema descriptor for symbols field.
+	backtestresultDescSymbols := backtestresultFields[3].Descriptor()
+	// backtestresult.DefaultSymbols holds the default value on creation for the symbols field.
+	backtestresult.DefaultSymbols = backtestresultDescSymbols.Default.([]string)
+	// backtestresultDescInterval is the schema descriptor for interval field.
+	backtestresultDescInterval := backtestresultFields[4].Descriptor()
+	// backtestresult.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	backtestresult.IntervalValidator = backtestresultDescInterval.Validators[0].(func(string) error)
+	// backtestresultDescParameters is the schema descriptor for parameters field.
+	backtestresultDescParameters := backtestresultFields[7].Descriptor()
+	// backtestresult.DefaultParameters holds the default value on creation for the parameters field.
+	backtestresult.DefaultParameters = backtestresultDescParameters.Default.(map[string]interface{})
+	// backtestresultDescSettings is the schema descriptor for settings field.
+	backtestresultDescSettings := backtestresultFields[8].Descriptor()
+	// backtestresult.DefaultSettings holds the default value on creation for the settings field.
+	backtestresult.DefaultSettings = backtestresultDescSettings.Default.(map[string]interface{})
+	// backtestresultDescTotalReturn is the schema descriptor for total_return field.
+	backtestresultDescTotalReturn := backtestresultFields[11].Descriptor()
+	// backtestresult.DefaultTotalReturn holds the default value on creation for the total_return field.
+	backtestresult.DefaultTotalReturn = backtestresultDescTotalReturn.Default.(float64)
+	// backtestresultDescMaxDrawdown is the schema descriptor for max_drawdown field.
+	backtestresultDescMaxDrawdown := backtestresultFields[12].Descriptor()
+	// backtestresult.DefaultMaxDrawdown holds the default value on creation for the max_drawdown field.
+	backtestresult.DefaultMaxDrawdown = backtestresultDescMaxDrawdown.Default.(float64)
+	// backtestresultDescSharpeRatio is the schema descriptor for sharpe_ratio field.
+	backtestresultDescSharpeRatio := backtestresultFields[13].Descriptor()
+	// backtestresult.DefaultSharpeRatio holds the default value on creation for the sharpe_ratio field.
+	backtestresult.DefaultSharpeRatio = backtestresultDescSharpeRatio.Default.(float64)
+	// backtestresultDescWinRate is the schema descriptor for win_rate field.
+	backtestresultDescWinRate := backtestresultFields[14].Descriptor()
+	// backtestresult.DefaultWinRate holds the default value on creation for the win_rate field.
+	backtestresult.DefaultWinRate = backtestresultDescWinRate.Default.(float64)
+	// backtestresultDescTradeCount is the schema descriptor for trade_count field.
+	backtestresultDescTradeCount := backtestresultFields[15].Descriptor()
+	// backtestresult.DefaultTradeCount holds the default value on creation for the trade_count field.
+	backtestresult.DefaultTradeCount = backtestresultDescTradeCount.Default.(int)
+	// backtestresultDescRejectedOrders is the schema descriptor for rejected_orders field.
+	backtestresultDescRejectedOrders := backtestresultFields[16].Descriptor()
+	// backtestresult.DefaultRejectedOrders holds the default value on creation for the rejected_orders field.
+	backtestresult.DefaultRejectedOrders = backtestresultDescRejectedOrders.Default.(int)
+	// backtestresultDescCreatedAt is the schema descriptor for created_at field.
+	backtestresultDescCreatedAt := backtestresultFields[19].Descriptor()
+	// backtestresult.DefaultCreatedAt holds the default value on creation for the created_at field.
+	backtestresult.DefaultCreatedAt = backtestresultDescCreatedAt.Default.(func() time.Time)
+	// backtestresultDescID is the schema descriptor for id field.
+	backtestresultDescID := backtestresultFields[0].Descriptor()
+	// backtestresult.DefaultID holds the default value on creation for the id field.
+	backtestresult.DefaultID = backtestresultDescID.Default.(func() uuid.UUID)
+	brokeraccountFields := schema.BrokerAccount{}.Fields()
+	_ = brokeraccountFields
+	// brokeraccountDescAccountNo is the schema descriptor for account_no field.
+	brokeraccountDescAccountNo := brokeraccountFields[3].Descriptor()
+	// brokeraccount.AccountNoValidator is a validator for the "account_no" field. It is called by the builders before save.
+	brokeraccount.AccountNoValidator = func() func(string) error {
+		validators := brokeraccountDescAccountNo.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(account_no string) error {
+			for _, fn := range fns {
+				if err := fn(account_no); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// brokeraccountDescProductCode is the schema descriptor for product_code field.
+	brokeraccountDescProductCode := brokeraccountFields[4].Descriptor()
+	// brokeraccount.DefaultProductCode holds the default value on creation for the product_code field.
+	brokeraccount.DefaultProductCode = brokeraccountDescProductCode.Default.(string)
+	// brokeraccount.ProductCodeValidator is a validator for the "product_code" field. It is called by the builders before save.
+	brokeraccount.ProductCodeValidator = brokeraccountDescProductCode.Validators[0].(func(string) error)
+	// brokeraccountDescIsDemo is the schema descriptor for is_demo field.
+	brokeraccountDescIsDemo := brokeraccountFields[5].Descriptor()
+	// brokeraccount.DefaultIsDemo holds the default value on creation for the is_demo field.
+	brokeraccount.DefaultIsDemo = brokeraccountDescIsDemo.Default.(bool)
+	// brokeraccountDescKeyID is the schema descriptor for key_id field.
+	brokeraccountDescKeyID := brokeraccountFields[9].Descriptor()
+	// brokeraccount.KeyIDValidator is a validator for the "key_id" field. It is called by the builders before save.
+	brokeraccount.KeyIDValidator = brokeraccountDescKeyID.Validators[0].(func(string) error)
+	// brokeraccountDescAppKeyHint is the schema descriptor for app_key_hint field.
+	brokeraccountDescAppKeyHint := brokeraccountFields[10].Descriptor()
+	// brokeraccount.DefaultAppKeyHint holds the default value on creation for the app_key_hint field.
+	brokeraccount.DefaultAppKeyHint = brokeraccountDescAppKeyHint.Default.(string)
+	// brokeraccount.AppKeyHintValidator is a validator for the "app_key_hint" field. It is called by the builders before save.
+	brokeraccount.AppKeyHintValidator = brokeraccountDescAppKeyHint.Validators[0].(func(string) error)
+	// brokeraccountDescCreatedAt is the schema descriptor for created_at field.
+	brokeraccountDescCreatedAt := brokeraccountFields[11].Descriptor()
+	// brokeraccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	brokeraccount.DefaultCreatedAt = brokeraccountDescCreatedAt.Default.(func() time.Time)
+	// brokeraccountDescUpdatedAt is the schema descriptor for updated_at field.
+	brokeraccountDescUpdatedAt := brokeraccountFields[12].Descriptor()
+	// brokeraccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	brokeraccount.DefaultUpdatedAt = brokeraccountDescUpdatedAt.Default.(func() time.Time)
+	// brokeraccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	brokeraccount.UpdateDefaultUpdatedAt = brokeraccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// brokeraccountDescID is the schema descriptor for id field.
+	brokeraccountDescID := brokeraccountFields[0].Descriptor()
+	// brokeraccount.DefaultID holds the default value on creation for the id field.
+	brokeraccount.DefaultID = brokeraccountDescID.Default.(func() uuid.UUID)
+	candleFields := schema.Candle{}.Fields()
+	_ = candleFields
+	// candleDescSymbol is the schema descriptor for symbol field.
+	candleDescSymbol := candleFields[1].Descriptor()
+	// candle.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	candle.SymbolValidator = candleDescSymbol.Validators[0].(func(string) error)
+	// candleDescExchange is the schema descriptor for exchange field.
+	candleDescExchange := candleFields[2].Descriptor()
+	// candle.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	candle.ExchangeValidator = candleDescExchange.Validators[0].(func(string) error)
+	// candleDescInterval is the schema descriptor for interval field.
+	candleDescInterval := candleFields[3].Descriptor()
+	// candle.IntervalValidator is a validator for the "interval" field. It is called by the builders before save.
+	candle.IntervalValidator = candleDescInterval.Validators[0].(func(string) error)
+	// candleDescVolume is the schema descriptor for volume field.
+	candleDescVolume := candleFields[9].Descriptor()
+	// candle.DefaultVolume holds the default value on creation for the volume field.
+	candle.DefaultVolume = candleDescVolume.Default.(int64)
+	// candleDescCreatedAt is the schema descriptor for created_at field.
+	candleDescCreatedAt := candleFields[10].Descriptor()
+	// candle.DefaultCreatedAt holds the default value on creation for the created_at field.
+	candle.DefaultCreatedAt = candleDescCreatedAt.Default.(func() time.Time)
+	// candleDescID is the schema descriptor for id field.
+	candleDescID := candleFields[0].Descriptor()
+	// candle.DefaultID holds the default value on creation for the id field.
+	candle.DefaultID = candleDescID.Default.(func() uuid.UUID)
+	killswitchFields := schema.KillSwitch{}.Fields()
+	_ = killswitchFields
+	// killswitchDescFlatten is the schema descriptor for flatten field.
+	killswitchDescFlatten := killswitchFields[4].Descriptor()
+	// killswitch.DefaultFlatten holds the default value on creation for the flatten field.
+	killswitch.DefaultFlatten = killswitchDescFlatten.Default.(bool)
+	// killswitchDescStoppedStrategies is the schema descriptor for stopped_strategies field.
+	killswitchDescStoppedStrategies := killswitchFields[5].Descriptor()
+	// killswitch.DefaultStoppedStrategies holds the default value on creation for the stopped_strategies field.
+	killswitch.DefaultStoppedStrategies = killswitchDescStoppedStrategies.Default.(int)
+	// killswitchDescCanceledOrders is the schema descriptor for canceled_orders field.
+	killswitchDescCanceledOrders := killswitchFields[6].Descriptor()
+	// killswitch.DefaultCanceledOrders holds the default value on creation for the canceled_orders field.
+	killswitch.DefaultCanceledOrders = killswitchDescCanceledOrders.Default.(int)
+	// killswitchDescFlattenOrders is the schema descriptor for flatten_orders field.
+	killswitchDescFlattenOrders := killswitchFields[7].Descriptor()
+	// killswitch.DefaultFlattenOrders holds the default value on creation for the flatten_orders field.
+	killswitch.DefaultFlattenOrders = killswitchDescFlattenOrders.Default.(int)
+	// killswitchDescFailedCloses is the schema descriptor for failed_closes field.
+	killswitchDescFailedCloses := killswitchFields[8].Descriptor()
+	// killswitch.DefaultFailedCloses holds the default value on creation for the failed_closes field.
+	killswitch.DefaultFailedCloses = killswitchDescFailedCloses.Default.(int)
+	// killswitchDescCreatedAt is the schema descriptor for created_at field.
+	killswitchDescCreatedAt := killswitchFields[10].Descriptor()
+	// killswitch.DefaultCreatedAt holds the default value on creation for the created_at field.
+	killswitch.DefaultCreatedAt = killswitchDescCreatedAt.Default.(func() time.Time)
+	// killswitchDescID is the schema descriptor for id field.
+	killswitchDescID := killswitchFields[0].Descriptor()
+	// killswitch.DefaultID holds the default value on creation for the id field.
+	killswitch.DefaultID = killswitchDescID.Default.(func() uuid.UUID)
+	orderFields := schema.Order{}.Fields()
+	_ = orderFields
+	// orderDescClientOrderID is the schema descriptor for client_order_id field.
+	orderDescClientOrderID := orderFields[3].Descriptor()
+	// order.ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	order.ClientOrderIDValidator = func() func(string) error {
+		validators := orderDescClientOrderID.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(client_order_id string) error {
+			for _, fn := range fns {
+				if err := fn(client_order_id); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// orderDescBrokerOrderID is the schema descriptor for broker_order_id field.
+	orderDescBrokerOrderID := orderFields[4].Descriptor()
+	// order.BrokerOrderIDValidator is a validator for the "broker_order_id" field. It is called by the builders before save.
+	order.BrokerOrderIDValidator = orderDescBrokerOrderID.Validators[0].(func(string) error)
+	// orderDescSymbol is the schema descriptor for symbol field.
+	orderDescSymbol := orderFields[5].Descriptor()
+	// order.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	order.SymbolValidator = orderDescSymbol.Validators[0].(func(string) error)
+	// orderDescExchange is the schema descriptor for exchange field.
+	orderDescExchange := orderFields[6].Descriptor()
+	// order.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	order.ExchangeValidator = orderDescExchange.Validators[0].(func(string) error)
+	// orderDescFilledQuantity is the schema descriptor for filled_quantity field.
+	orderDescFilledQuantity := orderFields[13].Descriptor()
+	// order.DefaultFilledQuantity holds the default value on creation for the filled_quantity field.
+	order.DefaultFilledQuantity = orderDescFilledQuantity.Default.(decimal.Decimal)
+	// orderDescCreatedAt is the schema descriptor for created_at field.
+	orderDescCreatedAt := orderFields[19].Descriptor()
+	// order.DefaultCreatedAt holds the default value on creation for the created_at field.
+	order.DefaultCreatedAt = orderDescCreatedAt.Default.(func() time.Time)
+	// orderDescUpdatedAt is the schema descriptor for updated_at field.
+	orderDescUpdatedAt := orderFields[20].Descriptor()
+	// order.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	order.DefaultUpdatedAt = orderDescUpdatedAt.Default.(func() time.Time)
+	// order.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	order.UpdateDefaultUpdatedAt = orderDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// orderDescID is the schema descriptor for id field.
+	orderDescID := orderFields[0].Descriptor()
+	// order.DefaultID holds the default value on creation for the id field.
+	order.DefaultID = orderDescID.Default.(func() uuid.UUID)
+	paperaccountFields := schema.PaperAccount{}.Fields()
+	_ = paperaccountFields
+	// paperaccountDescCreatedAt is the schema descriptor for created_at field.
+	paperaccountDescCreatedAt := paperaccountFields[4].Descriptor()
+	// paperaccount.DefaultCreatedAt holds the default value on creation for the created_at field.
+	paperaccount.DefaultCreatedAt = paperaccountDescCreatedAt.Default.(func() time.Time)
+	// paperaccountDescUpdatedAt is the schema descriptor for updated_at field.
+	paperaccountDescUpdatedAt := paperaccountFields[5].Descriptor()
+	// paperaccount.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paperaccount.DefaultUpdatedAt = paperaccountDescUpdatedAt.Default.(func() time.Time)
+	// paperaccount.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paperaccount.UpdateDefaultUpdatedAt = paperaccountDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paperaccountDescID is the schema descriptor for id field.
+	paperaccountDescID := paperaccountFields[0].Descriptor()
+	// paperaccount.DefaultID holds the default value on creation for the id field.
+	paperaccount.DefaultID = paperaccountDescID.Default.(func() uuid.UUID)
+	paperpositionFields := schema.PaperPosition{}.Fields()
+	_ = paperpositionFields
+	// paperpositionDescSymbol is the schema descriptor for symbol field.
+	paperpositionDescSymbol := paperpositionFields[2].Descriptor()
+	// paperposition.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	paperposition.SymbolValidator = paperpositionDescSymbol.Validators[0].(func(string) error)
+	// paperpositionDescUpdatedAt is the schema descriptor for updated_at field.
+	paperpositionDescUpdatedAt := paperpositionFields[5].Descriptor()
+	// paperposition.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	paperposition.DefaultUpdatedAt = paperpositionDescUpdatedAt.Default.(func() time.Time)
+	// paperposition.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	paperposition.UpdateDefaultUpdatedAt = paperpositionDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// paperpositionDescID is the schema descriptor for id field.
+	paperpositionDescID := paperpositionFields[0].Descriptor()
+	// paperposition.DefaultID holds the default value on creation for the id field.
+	paperposition.DefaultID = paperpositionDescID.Default.(func() uuid.UUID)
+	papertradeFields := schema.PaperTrade{}.Fields()
+	_ = papertradeFields
+	// papertradeDescClientOrderID is the schema descriptor for client_order_id field.
+	papertradeDescClientOrderID := papertradeFields[3].Descriptor()
+	// papertrade.ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	papertrade.ClientOrderIDValidator = papertradeDescClientOrderID.Validators[0].(func(string) error)
+	// papertradeDescSymbol is the schema descriptor for symbol field.
+	papertradeDescSymbol := papertradeFields[4].Descriptor()
+	// papertrade.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	papertrade.SymbolValidator = papertradeDescSymbol.Validators[0].(func(string) error)
+	// papertradeDescCommission is the schema descriptor for commission field.
+	papertradeDescCommission := papertradeFields[8].Descriptor()
+	// papertrade.DefaultCommission holds the default value on creation for the commission field.
+	papertrade.DefaultCommission = papertradeDescCommission.Default.(decimal.Decimal)
+	// papertradeDescRealizedPnl is the schema descriptor for realized_pnl field.
+	papertradeDescRealizedPnl := papertradeFields[9].Descriptor()
+	// papertrade.DefaultRealizedPnl holds the default value on creation for the realized_pnl field.
+	papertrade.DefaultRealizedPnl = papertradeDescRealizedPnl.Default.(decimal.Decimal)
+	// papertradeDescExecutedAt is the schema descriptor for executed_at field.
+	papertradeDescExecutedAt := papertradeFields[10].Descriptor()
+	// papertrade.DefaultExecutedAt holds the default value on creation for the executed_at field.
+	papertrade.DefaultExecutedAt = papertradeDescExecutedAt.Default.(func() time.Time)
+	// papertradeDescID is the schema descriptor for id field.
+	papertradeDescID := papertradeFields[0].Descriptor()
+	// papertrade.DefaultID holds the default value on creation for the id field.
+	papertrade.DefaultID = papertradeDescID.Default.(func() uuid.UUID)
 	portfolioFields := schema.Portfolio{}.Fields()
 	_ = portfolioFields
 	// portfolioDescSymbol is the schema descriptor for symbol field.
 	portfolioDescSymbol := portfolioFields[2].Descriptor()
 	// portfolio.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
 	portfolio.SymbolValidator = portfolioDescSymbol.Validators[0].(func(string) error)
+	// portfolioDescExchange is the schema descriptor for exchange field.
+	portfolioDescExchange := portfolioFields[3].Descriptor()
+	// portfolio.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	portfolio.ExchangeValidator = portfolioDescExchange.Validators[0].(func(string) error)
 	// portfolioDescQuantity is the schema descriptor for quantity field.
-	portfolioDescQuantity := portfolioFields[3].Descriptor()
+	portfolioDescQuantity := portfolioFields[4].Descriptor()
 	// portfolio.DefaultQuantity holds the default value on creation for the quantity field.
 	portfolio.DefaultQuantity = portfolioDescQuantity.Default.(decimal.Decimal)
 	// portfolioDescAveragePrice is the schema descriptor for average_price field.
-	portfolioDescAveragePrice := portfolioFields[4].Descriptor()
+	portfolioDescAveragePrice := portfolioFields[5].Descriptor()
 	// portfolio.DefaultAveragePrice holds the default value on creation for the average_price field.
 	portfolio.DefaultAveragePrice = portfolioDescAveragePrice.Default.(decimal.Decimal)
 	// portfolioDescMarketValue is the schema descriptor for market_value field.
-	portfolioDescMarketValue := portfolioFields[6].Descriptor()
+	portfolioDescMarketValue := portfolioFields[7].Descriptor()
 	// portfolio.DefaultMarketValue holds the default value on creation for the market_value field.
 	portfolio.DefaultMarketValue = portfolioDescMarketValue.Default.(decimal.Decimal)
 	// portfolioDescTotalCost is the schema descriptor for total_cost field.
-	portfolioDescTotalCost := portfolioFields[7].Descriptor()
+	portfolioDescTotalCost := portfolioFields[8].Descriptor()
 	// portfolio.DefaultTotalCost holds the default value on creation for the total_cost field.
 	portfolio.DefaultTotalCost = portfolioDescTotalCost.Default.(decimal.Decimal)
 	// portfolioDescUnrealizedPnl is the schema descriptor for unrealized_pnl field.
-	portfolioDescUnrealizedPnl := portfolioFields[8].Descriptor()
+	portfolioDescUnrealizedPnl := portfolioFields[9].Descriptor()
 	// portfolio.DefaultUnrealizedPnl holds the default value on creation for the unrealized_pnl field.
 	portfolio.DefaultUnrealizedPnl = portfolioDescUnrealizedPnl.Default.(decimal.Decimal)
 	// portfolioDescRealizedPnl is the schema descriptor for realized_pnl field.
-	portfolioDescRealizedPnl := portfolioFields[9].Descriptor()
+	portfolioDescRealizedPnl := portfolioFields[10].Descriptor()
 	// portfolio.DefaultRealizedPnl holds the default value on creation for the realized_pnl field.
 	portfolio.DefaultRealizedPnl = portfolioDescRealizedPnl.Default.(decimal.Decimal)
 	// portfolioDescLastUpdated is the schema descriptor for last_updated field.
-	portfolioDescLastUpdated := portfolioFields[10].Descriptor()
+	portfolioDescLastUpdated := portfolioFields[11].Descriptor()
 	// portfolio.DefaultLastUpdated holds the default value on creation for the last_updated field.
 	portfolio.DefaultLastUpdated = portfolioDescLastUpdated.Default.(func() time.Time)
 	// portfolio.UpdateDefaultLastUpdated holds the default value on update for the last_updated field.
 	portfolio.UpdateDefaultLastUpdated = portfolioDescLastUpdated.UpdateDefault.(func() time.Time)
 	// portfolioDescCreatedAt is the schema descriptor for created_at field.
-	portfolioDescCreatedAt := portfolioFields[11].Descriptor()
+	portfolioDescCreatedAt := portfolioFields[12].Descriptor()
 	// portfolio.DefaultCreatedAt holds the default value on creation for the created_at field.
 	portfolio.DefaultCreatedAt = portfolioDescCreatedAt.Default.(func() time.Time)
 	// portfolioDescUpdatedAt is the schema descriptor for updated_at field.
-	portfolioDescUpdatedAt := portfolioFields[12].Descriptor()
+	portfolioDescUpdatedAt := portfolioFields[13].Descriptor()
 	// portfolio.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	portfolio.DefaultUpdatedAt = portfolioDescUpdatedAt.Default.(func() time.Time)
 	// portfolio.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -71,6 +357,140 @@ func init() {
 	portfolioDescID := portfolioFields[0].Descriptor()
 	// portfolio.DefaultID holds the default value on creation for the id field.
 	portfolio.DefaultID = portfolioDescID.Default.(func() uuid.UUID)
+	protectionruleFields := schema.ProtectionRule{}.Fields()
+	_ = protectionruleFields
+	// protectionruleDescSymbol is the schema descriptor for symbol field.
+	protectionruleDescSymbol := protectionruleFields[4].Descriptor()
+	// protectionrule.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	protectionrule.SymbolValidator = protectionruleDescSymbol.Validators[0].(func(string) error)
+	// protectionruleDescExchange is the schema descriptor for exchange field.
+	protectionruleDescExchange := protectionruleFields[5].Descriptor()
+	// protectionrule.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	protectionrule.ExchangeValidator = protectionruleDescExchange.Validators[0].(func(string) error)
+	// protectionruleDescStopLoss is the schema descriptor for stop_loss field.
+	protectionruleDescStopLoss := protectionruleFields[6].Descriptor()
+	// protectionrule.DefaultStopLoss holds the default value on creation for the stop_loss field.
+	protectionrule.DefaultStopLoss = protectionruleDescStopLoss.Default.(float64)
+	// protectionruleDescTakeProfit is the schema descriptor for take_profit field.
+	protectionruleDescTakeProfit := protectionruleFields[7].Descriptor()
+	// protectionrule.DefaultTakeProfit holds the default value on creation for the take_profit field.
+	protectionrule.DefaultTakeProfit = protectionruleDescTakeProfit.Default.(float64)
+	// protectionruleDescTrailingPercent is the schema descriptor for trailing_percent field.
+	protectionruleDescTrailingPercent := protectionruleFields[8].Descriptor()
+	// protectionrule.DefaultTrailingPercent holds the default value on creation for the trailing_percent field.
+	protectionrule.DefaultTrailingPercent = protectionruleDescTrailingPercent.Default.(float64)
+	// protectionruleDescTrailingAtr is the schema descriptor for trailing_atr field.
+	protectionruleDescTrailingAtr := protectionruleFields[9].Descriptor()
+	// protectionrule.DefaultTrailingAtr holds the default value on creation for the trailing_atr field.
+	protectionrule.DefaultTrailingAtr = protectionruleDescTrailingAtr.Default.(float64)
+	// protectionruleDescAtrPeriod is the schema descriptor for atr_period field.
+	protectionruleDescAtrPeriod := protectionruleFields[10].Descriptor()
+	// protectionrule.DefaultAtrPeriod holds the default value on creation for the atr_period field.
+	protectionrule.DefaultAtrPeriod = protectionruleDescAtrPeriod.Default.(int)
+	// protectionruleDescMaxHoldMinutes is the schema descriptor for max_hold_minutes field.
+	protectionruleDescMaxHoldMinutes := protectionruleFields[11].Descriptor()
+	// protectionrule.DefaultMaxHoldMinutes holds the default value on creation for the max_hold_minutes field.
+	protectionrule.DefaultMaxHoldMinutes = protectionruleDescMaxHoldMinutes.Default.(int)
+	// protectionruleDescActive is the schema descriptor for active field.
+	protectionruleDescActive := protectionruleFields[12].Descriptor()
+	// protectionrule.DefaultActive holds the default value on creation for the active field.
+	protectionrule.DefaultActive = protectionruleDescActive.Default.(bool)
+	// protectionruleDescWaterMark is the schema descriptor for water_mark field.
+	protectionruleDescWaterMark := protectionruleFields[13].Descriptor()
+	// protectionrule.DefaultWaterMark holds the default value on creation for the water_mark field.
+	protectionrule.DefaultWaterMark = protectionruleDescWaterMark.Default.(decimal.Decimal)
+	// protectionruleDescTriggerReason is the schema descriptor for trigger_reason field.
+	protectionruleDescTriggerReason := protectionruleFields[16].Descriptor()
+	// protectionrule.TriggerReasonValidator is a validator for the "trigger_reason" field. It is called by the builders before save.
+	protectionrule.TriggerReasonValidator = protectionruleDescTriggerReason.Validators[0].(func(string) error)
+	// protectionruleDescTriggerPrice is the schema descriptor for trigger_price field.
+	protectionruleDescTriggerPrice := protectionruleFields[17].Descriptor()
+	// protectionrule.DefaultTriggerPrice holds the default value on creation for the trigger_price field.
+	protectionrule.DefaultTriggerPrice = protectionruleDescTriggerPrice.Default.(decimal.Decimal)
+	// protectionruleDescExitClientOrderID is the schema descriptor for exit_client_order_id field.
+	protectionruleDescExitClientOrderID := protectionruleFields[18].Descriptor()
+	// protectionrule.ExitClientOrderIDValidator is a validator for the "exit_client_order_id" field. It is called by the builders before save.
+	protectionrule.ExitClientOrderIDValidator = protectionruleDescExitClientOrderID.Validators[0].(func(string) error)
+	// protectionruleDescCreatedAt is the schema descriptor for created_at field.
+	protectionruleDescCreatedAt := protectionruleFields[19].Descriptor()
+	// protectionrule.DefaultCreatedAt holds the default value on creation for the created_at field.
+	protectionrule.DefaultCreatedAt = protectionruleDescCreatedAt.Default.(func() time.Time)
+	// protectionruleDescUpdatedAt is the schema descriptor for updated_at field.
+	protectionruleDescUpdatedAt := protectionruleFields[20].Descriptor()
+	// protectionrule.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	protectionrule.DefaultUpdatedAt = protectionruleDescUpdatedAt.Default.(func() time.Time)
+	// protectionrule.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	protectionrule.UpdateDefaultUpdatedAt = protectionruleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// protectionruleDescID is the schema descriptor for id field.
+	protectionruleDescID := protectionruleFields[0].Descriptor()
+	// protectionrule.DefaultID holds the default value on creation for the id field.
+	protectionrule.DefaultID = protectionruleDescID.Default.(func() uuid.UUID)
+	riskeventFields := schema.RiskEvent{}.Fields()
+	_ = riskeventFields
+	// riskeventDescRule is the schema descriptor for rule field.
+	riskeventDescRule := riskeventFields[4].Descriptor()
+	// riskevent.RuleValidator is a validator for the "rule" field. It is called by the builders before save.
+	riskevent.RuleValidator = riskeventDescRule.Validators[0].(func(string) error)
+	// riskeventDescClientOrderID is the schema descriptor for client_order_id field.
+	riskeventDescClientOrderID := riskeventFields[6].Descriptor()
+	// riskevent.ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	riskevent.ClientOrderIDValidator = riskeventDescClientOrderID.Validators[0].(func(string) error)
+	// riskeventDescSymbol is the schema descriptor for symbol field.
+	riskeventDescSymbol := riskeventFields[8].Descriptor()
+	// riskevent.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	riskevent.SymbolValidator = riskeventDescSymbol.Validators[0].(func(string) error)
+	// riskeventDescSide is the schema descriptor for side field.
+	riskeventDescSide := riskeventFields[9].Descriptor()
+	// riskevent.SideValidator is a validator for the "side" field. It is called by the builders before save.
+	riskevent.SideValidator = riskeventDescSide.Validators[0].(func(string) error)
+	// riskeventDescQuantity is the schema descriptor for quantity field.
+	riskeventDescQuantity := riskeventFields[10].Descriptor()
+	// riskevent.DefaultQuantity holds the default value on creation for the quantity field.
+	riskevent.DefaultQuantity = riskeventDescQuantity.Default.(decimal.Decimal)
+	// riskeventDescPrice is the schema descriptor for price field.
+	riskeventDescPrice := riskeventFields[11].Descriptor()
+	// riskevent.DefaultPrice holds the default value on creation for the price field.
+	riskevent.DefaultPrice = riskeventDescPrice.Default.(decimal.Decimal)
+	// riskeventDescCreatedAt is the schema descriptor for created_at field.
+	riskeventDescCreatedAt := riskeventFields[15].Descriptor()
+	// riskevent.DefaultCreatedAt holds the default value on creation for the created_at field.
+	riskevent.DefaultCreatedAt = riskeventDescCreatedAt.Default.(func() time.Time)
+	// riskeventDescID is the schema descriptor for id field.
+	riskeventDescID := riskeventFields[0].Descriptor()
+	// riskevent.DefaultID holds the default value on creation for the id field.
+	riskevent.DefaultID = riskeventDescID.Default.(func() uuid.UUID)
+	riskstateFields := schema.RiskState{}.Fields()
+	_ = riskstateFields
+	// riskstateDescTradingDay is the schema descriptor for trading_day field.
+	riskstateDescTradingDay := riskstateFields[3].Descriptor()
+	// riskstate.TradingDayValidator is a validator for the "trading_day" field. It is called by the builders before save.
+	riskstate.TradingDayValidator = riskstateDescTradingDay.Validators[0].(func(string) error)
+	// riskstateDescDailyRealizedPnl is the schema descriptor for daily_realized_pnl field.
+	riskstateDescDailyRealizedPnl := riskstateFields[4].Descriptor()
+	// riskstate.DefaultDailyRealizedPnl holds the default value on creation for the daily_realized_pnl field.
+	riskstate.DefaultDailyRealizedPnl = riskstateDescDailyRealizedPnl.Default.(decimal.Decimal)
+	// riskstateDescOpenExposure is the schema descriptor for open_exposure field.
+	riskstateDescOpenExposure := riskstateFields[5].Descriptor()
+	// riskstate.DefaultOpenExposure holds the default value on creation for the open_exposure field.
+	riskstate.DefaultOpenExposure = riskstateDescOpenExposure.Default.(decimal.Decimal)
+	// riskstateDescEquity is the schema descriptor for equity field.
+	riskstateDescEquity := riskstateFields[6].Descriptor()
+	// riskstate.DefaultEquity holds the default value on creation for the equity field.
+	riskstate.DefaultEquity = riskstateDescEquity.Default.(decimal.Decimal)
+	// riskstateDescPeakEquity is the schema descriptor for peak_equity field.
+	riskstateDescPeakEquity := riskstateFields[7].Descriptor()
+	// riskstate.DefaultPeakEquity holds the default value on creation for the peak_equity field.
+	riskstate.DefaultPeakEquity = riskstateDescPeakEquity.Default.(decimal.Decimal)
+	// riskstateDescUpdatedAt is the schema descriptor for updated_at field.
+	riskstateDescUpdatedAt := riskstateFields[8].Descriptor()
+	// riskstate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	riskstate.DefaultUpdatedAt = riskstateDescUpdatedAt.Default.(func() time.Time)
+	// riskstate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	riskstate.UpdateDefaultUpdatedAt = riskstateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// riskstateDescID is the schema descriptor for id field.
+	riskstateDescID := riskstateFields[0].Descriptor()
+	// riskstate.DefaultID holds the default value on creation for the id field.
+	riskstate.DefaultID = riskstateDescID.Default.(func() uuid.UUID)
 	strategyFields := schema.Strategy{}.Fields()
 	_ = strategyFields
 	// strategyDescStrategyID is the schema descriptor for strategy_id field.
@@ -85,24 +505,28 @@ func init() {
 	strategyDescSymbol := strategyFields[6].Descriptor()
 	// strategy.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
 	strategy.SymbolValidator = strategyDescSymbol.Validators[0].(func(string) error)
+	// strategyDescExchange is the schema descriptor for exchange field.
+	strategyDescExchange := strategyFields[7].Descriptor()
+	// strategy.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	strategy.ExchangeValidator = strategyDescExchange.Validators[0].(func(string) error)
 	// strategyDescUserInputs is the schema descriptor for user_inputs field.
-	strategyDescUserInputs := strategyFields[7].Descriptor()
+	strategyDescUserInputs := strategyFields[8].Descriptor()
 	// strategy.DefaultUserInputs holds the default value on creation for the user_inputs field.
 	strategy.DefaultUserInputs = strategyDescUserInputs.Default.(map[string]interface{})
 	// strategyDescSettings is the schema descriptor for settings field.
-	strategyDescSettings := strategyFields[8].Descriptor()
+	strategyDescSettings := strategyFields[9].Descriptor()
 	// strategy.DefaultSettings holds the default value on creation for the settings field.
 	strategy.DefaultSettings = strategyDescSettings.Default.(map[string]interface{})
 	// strategyDescActive is the schema descriptor for active field.
-	strategyDescActive := strategyFields[9].Descriptor()
+	strategyDescActive := strategyFields[10].Descriptor()
 	// strategy.DefaultActive holds the default value on creation for the active field.
 	strategy.DefaultActive = strategyDescActive.Default.(bool)
 	// strategyDescCreatedAt is the schema descriptor for created_at field.
-	strategyDescCreatedAt := strategyFields[10].Descriptor()
+	strategyDescCreatedAt := strategyFields[12].Descriptor()
 	// strategy.DefaultCreatedAt holds the default value on creation for the created_at field.
 	strategy.DefaultCreatedAt = strategyDescCreatedAt.Default.(func() time.Time)
 	// strategyDescUpdatedAt is the schema descriptor for updated_at field.
-	strategyDescUpdatedAt := strategyFields[11].Descriptor()
+	strategyDescUpdatedAt := strategyFields[13].Descriptor()
 	// strategy.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	strategy.DefaultUpdatedAt = strategyDescUpdatedAt.Default.(func() time.Time)
 	// strategy.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -151,12 +575,36 @@ func init() {
 	strategyperformanceDescSharpeRatio := strategyperformanceFields[7].Descriptor()
 	// strategyperformance.DefaultSharpeRatio holds the default value on creation for the sharpe_ratio field.
 	strategyperformance.DefaultSharpeRatio = strategyperformanceDescSharpeRatio.Default.(decimal.Decimal)
+	// strategyperformanceDescClosedTrades is the schema descriptor for closed_trades field.
+	strategyperformanceDescClosedTrades := strategyperformanceFields[8].Descriptor()
+	// strategyperformance.DefaultClosedTrades holds the default value on creation for the closed_trades field.
+	strategyperformance.DefaultClosedTrades = strategyperformanceDescClosedTrades.Default.(int64)
+	// strategyperformanceDescWinningTrades is the schema descriptor for winning_trades field.
+	strategyperformanceDescWinningTrades := strategyperformanceFields[9].Descriptor()
+	// strategyperformance.DefaultWinningTrades holds the default value on creation for the winning_trades field.
+	strategyperformance.DefaultWinningTrades = strategyperformanceDescWinningTrades.Default.(int64)
+	// strategyperformanceDescReturnSum is the schema descriptor for return_sum field.
+	strategyperformanceDescReturnSum := strategyperformanceFields[10].Descriptor()
+	// strategyperformance.DefaultReturnSum holds the default value on creation for the return_sum field.
+	strategyperformance.DefaultReturnSum = strategyperformanceDescReturnSum.Default.(float64)
+	// strategyperformanceDescReturnSqSum is the schema descriptor for return_sq_sum field.
+	strategyperformanceDescReturnSqSum := strategyperformanceFields[11].Descriptor()
+	// strategyperformance.DefaultReturnSqSum holds the default value on creation for the return_sq_sum field.
+	strategyperformance.DefaultReturnSqSum = strategyperformanceDescReturnSqSum.Default.(float64)
+	// strategyperformanceDescEquityIndex is the schema descriptor for equity_index field.
+	strategyperformanceDescEquityIndex := strategyperformanceFields[12].Descriptor()
+	// strategyperformance.DefaultEquityIndex holds the default value on creation for the equity_index field.
+	strategyperformance.DefaultEquityIndex = strategyperformanceDescEquityIndex.Default.(float64)
+	// strategyperformanceDescPeakEquityIndex is the schema descriptor for peak_equity_index field.
+	strategyperformanceDescPeakEquityIndex := strategyperformanceFields[13].Descriptor()
+	// strategyperformance.DefaultPeakEquityIndex holds the default value on creation for the peak_equity_index field.
+	strategyperformance.DefaultPeakEquityIndex = strategyperformanceDescPeakEquityIndex.Default.(float64)
 	// strategyperformanceDescCreatedAt is the schema descriptor for created_at field.
-	strategyperformanceDescCreatedAt := strategyperformanceFields[8].Descriptor()
+	strategyperformanceDescCreatedAt := strategyperformanceFields[15].Descriptor()
 	// strategyperformance.DefaultCreatedAt holds the default value on creation for the created_at field.
 	strategyperformance.DefaultCreatedAt = strategyperformanceDescCreatedAt.Default.(func() time.Time)
 	// strategyperformanceDescUpdatedAt is the schema descriptor for updated_at field.
-	strategyperformanceDescUpdatedAt := strategyperformanceFields[9].Descriptor()
+	strategyperformanceDescUpdatedAt := strategyperformanceFields[16].Descriptor()
 	// strategyperformance.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	strategyperformance.DefaultUpdatedAt = strategyperformanceDescUpdatedAt.Default.(func() time.Time)
 	// strategyperformance.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
@@ -211,6 +659,164 @@ func init() {
 	strategytemplateDescID := strategytemplateFields[0].Descriptor()
 	// strategytemplate.DefaultID holds the default value on creation for the id field.
 	strategytemplate.DefaultID = strategytemplateDescID.Default.(func() uuid.UUID)
+	symbolFields := schema.Symbol{}.Fields()
+	_ = symbolFields
+	// symbolDescTicker is the schema descriptor for ticker field.
+	symbolDescTicker := symbolFields[1].Descriptor()
+	// symbol.TickerValidator is a validator for the "ticker" field. It is called by the builders before save.
+	symbol.TickerValidator = func() func(string) error {
+		validators := symbolDescTicker.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(ticker string) error {
+			for _, fn := range fns {
+				if err := fn(ticker); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// symbolDescCurrency is the schema descriptor for currency field.
+	symbolDescCurrency := symbolFields[3].Descriptor()
+	// symbol.DefaultCurrency holds the default value on creation for the currency field.
+	symbol.DefaultCurrency = symbolDescCurrency.Default.(string)
+	// symbol.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	symbol.CurrencyValidator = symbolDescCurrency.Validators[0].(func(string) error)
+	// symbolDescName is the schema descriptor for name field.
+	symbolDescName := symbolFields[4].Descriptor()
+	// symbol.DefaultName holds the default value on creation for the name field.
+	symbol.DefaultName = symbolDescName.Default.(string)
+	// symbol.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	symbol.NameValidator = symbolDescName.Validators[0].(func(string) error)
+	// symbolDescLotSize is the schema descriptor for lot_size field.
+	symbolDescLotSize := symbolFields[6].Descriptor()
+	// symbol.DefaultLotSize holds the default value on creation for the lot_size field.
+	symbol.DefaultLotSize = symbolDescLotSize.Default.(int)
+	// symbolDescTickSize is the schema descriptor for tick_size field.
+	symbolDescTickSize := symbolFields[7].Descriptor()
+	// symbol.DefaultTickSize holds the default value on creation for the tick_size field.
+	symbol.DefaultTickSize = symbolDescTickSize.Default.(decimal.Decimal)
+	// symbolDescTradable is the schema descriptor for tradable field.
+	symbolDescTradable := symbolFields[8].Descriptor()
+	// symbol.DefaultTradable holds the default value on creation for the tradable field.
+	symbol.DefaultTradable = symbolDescTradable.Default.(bool)
+	// symbolDescCreatedAt is the schema descriptor for created_at field.
+	symbolDescCreatedAt := symbolFields[9].Descriptor()
+	// symbol.DefaultCreatedAt holds the default value on creation for the created_at field.
+	symbol.DefaultCreatedAt = symbolDescCreatedAt.Default.(func() time.Time)
+	// symbolDescUpdatedAt is the schema descriptor for updated_at field.
+	symbolDescUpdatedAt := symbolFields[10].Descriptor()
+	// symbol.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	symbol.DefaultUpdatedAt = symbolDescUpdatedAt.Default.(func() time.Time)
+	// symbol.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	symbol.UpdateDefaultUpdatedAt = symbolDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// symbolDescID is the schema descriptor for id field.
+	symbolDescID := symbolFields[0].Descriptor()
+	// symbol.DefaultID holds the default value on creation for the id field.
+	symbol.DefaultID = symbolDescID.Default.(func() uuid.UUID)
+	taxlotFields := schema.TaxLot{}.Fields()
+	_ = taxlotFields
+	// taxlotDescSymbol is the schema descriptor for symbol field.
+	taxlotDescSymbol := taxlotFields[3].Descriptor()
+	// taxlot.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	taxlot.SymbolValidator = taxlotDescSymbol.Validators[0].(func(string) error)
+	// taxlotDescFxRate is the schema descriptor for fx_rate field.
+	taxlotDescFxRate := taxlotFields[7].Descriptor()
+	// taxlot.DefaultFxRate holds the default value on creation for the fx_rate field.
+	taxlot.DefaultFxRate = taxlotDescFxRate.Default.(decimal.Decimal)
+	// taxlotDescUpdatedAt is the schema descriptor for updated_at field.
+	taxlotDescUpdatedAt := taxlotFields[10].Descriptor()
+	// taxlot.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	taxlot.DefaultUpdatedAt = taxlotDescUpdatedAt.Default.(func() time.Time)
+	// taxlot.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	taxlot.UpdateDefaultUpdatedAt = taxlotDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// taxlotDescID is the schema descriptor for id field.
+	taxlotDescID := taxlotFields[0].Descriptor()
+	// taxlot.DefaultID holds the default value on creation for the id field.
+	taxlot.DefaultID = taxlotDescID.Default.(func() uuid.UUID)
+	tradeFields := schema.Trade{}.Fields()
+	_ = tradeFields
+	// tradeDescClientOrderID is the schema descriptor for client_order_id field.
+	tradeDescClientOrderID := tradeFields[2].Descriptor()
+	// trade.ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	trade.ClientOrderIDValidator = tradeDescClientOrderID.Validators[0].(func(string) error)
+	// tradeDescBrokerOrderID is the schema descriptor for broker_order_id field.
+	tradeDescBrokerOrderID := tradeFields[3].Descriptor()
+	// trade.BrokerOrderIDValidator is a validator for the "broker_order_id" field. It is called by the builders before save.
+	trade.BrokerOrderIDValidator = tradeDescBrokerOrderID.Validators[0].(func(string) error)
+	// tradeDescSymbol is the schema descriptor for symbol field.
+	tradeDescSymbol := tradeFields[4].Descriptor()
+	// trade.SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	trade.SymbolValidator = tradeDescSymbol.Validators[0].(func(string) error)
+	// tradeDescExchange is the schema descriptor for exchange field.
+	tradeDescExchange := tradeFields[5].Descriptor()
+	// trade.ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	trade.ExchangeValidator = tradeDescExchange.Validators[0].(func(string) error)
+	// tradeDescFee is the schema descriptor for fee field.
+	tradeDescFee := tradeFields[9].Descriptor()
+	// trade.DefaultFee holds the default value on creation for the fee field.
+	trade.DefaultFee = tradeDescFee.Default.(decimal.Decimal)
+	// tradeDescCurrency is the schema descriptor for currency field.
+	tradeDescCurrency := tradeFields[10].Descriptor()
+	// trade.DefaultCurrency holds the default value on creation for the currency field.
+	trade.DefaultCurrency = tradeDescCurrency.Default.(string)
+	// trade.CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	trade.CurrencyValidator = tradeDescCurrency.Validators[0].(func(string) error)
+	// tradeDescFxRate is the schema descriptor for fx_rate field.
+	tradeDescFxRate := tradeFields[11].Descriptor()
+	// trade.DefaultFxRate holds the default value on creation for the fx_rate field.
+	trade.DefaultFxRate = tradeDescFxRate.Default.(decimal.Decimal)
+	// tradeDescProceeds is the schema descriptor for proceeds field.
+	tradeDescProceeds := tradeFields[13].Descriptor()
+	// trade.DefaultProceeds holds the default value on creation for the proceeds field.
+	trade.DefaultProceeds = tradeDescProceeds.Default.(decimal.Decimal)
+	// tradeDescCostBasisFifo is the schema descriptor for cost_basis_fifo field.
+	tradeDescCostBasisFifo := tradeFields[14].Descriptor()
+	// trade.DefaultCostBasisFifo holds the default value on creation for the cost_basis_fifo field.
+	trade.DefaultCostBasisFifo = tradeDescCostBasisFifo.Default.(decimal.Decimal)
+	// tradeDescCostBasisFifoKrw is the schema descriptor for cost_basis_fifo_krw field.
+	tradeDescCostBasisFifoKrw := tradeFields[15].Descriptor()
+	// trade.DefaultCostBasisFifoKrw holds the default value on creation for the cost_basis_fifo_krw field.
+	trade.DefaultCostBasisFifoKrw = tradeDescCostBasisFifoKrw.Default.(decimal.Decimal)
+	// tradeDescCostBasisAvg is the schema descriptor for cost_basis_avg field.
+	tradeDescCostBasisAvg := tradeFields[16].Descriptor()
+	// trade.DefaultCostBasisAvg holds the default value on creation for the cost_basis_avg field.
+	trade.DefaultCostBasisAvg = tradeDescCostBasisAvg.Default.(decimal.Decimal)
+	// tradeDescCostBasisAvgKrw is the schema descriptor for cost_basis_avg_krw field.
+	tradeDescCostBasisAvgKrw := tradeFields[17].Descriptor()
+	// trade.DefaultCostBasisAvgKrw holds the default value on creation for the cost_basis_avg_krw field.
+	trade.DefaultCostBasisAvgKrw = tradeDescCostBasisAvgKrw.Default.(decimal.Decimal)
+	// tradeDescEstimatedBasis is the schema descriptor for estimated_basis field.
+	tradeDescEstimatedBasis := tradeFields[18].Descriptor()
+	// trade.DefaultEstimatedBasis holds the default value on creation for the estimated_basis field.
+	trade.DefaultEstimatedBasis = tradeDescEstimatedBasis.Default.(bool)
+	// tradeDescPositionAfter is the schema descriptor for position_after field.
+	tradeDescPositionAfter := tradeFields[19].Descriptor()
+	// trade.DefaultPositionAfter holds the default value on creation for the position_after field.
+	trade.DefaultPositionAfter = tradeDescPositionAfter.Default.(decimal.Decimal)
+	// tradeDescAvgCostAfter is the schema descriptor for avg_cost_after field.
+	tradeDescAvgCostAfter := tradeFields[20].Descriptor()
+	// trade.DefaultAvgCostAfter holds the default value on creation for the avg_cost_after field.
+	trade.DefaultAvgCostAfter = tradeDescAvgCostAfter.Default.(decimal.Decimal)
+	// tradeDescAvgCostKrwAfter is the schema descriptor for avg_cost_krw_after field.
+	tradeDescAvgCostKrwAfter := tradeFields[21].Descriptor()
+	// trade.DefaultAvgCostKrwAfter holds the default value on creation for the avg_cost_krw_after field.
+	trade.DefaultAvgCostKrwAfter = tradeDescAvgCostKrwAfter.Default.(decimal.Decimal)
+	// tradeDescFxPending is the schema descriptor for fx_pending field.
+	tradeDescFxPending := tradeFields[22].Descriptor()
+	// trade.DefaultFxPending holds the default value on creation for the fx_pending field.
+	trade.DefaultFxPending = tradeDescFxPending.Default.(bool)
+	// tradeDescCreatedAt is the schema descriptor for created_at field.
+	tradeDescCreatedAt := tradeFields[24].Descriptor()
+	// trade.DefaultCreatedAt holds the default value on creation for the created_at field.
+	trade.DefaultCreatedAt = tradeDescCreatedAt.Default.(func() time.Time)
+	// tradeDescID is the schema descriptor for id field.
+	tradeDescID := tradeFields[0].Descriptor()
+	// trade.DefaultID holds the default value on creation for the id field.
+	trade.DefaultID = tradeDescID.Default.(func() uuid.UUID)
 	userFields := schema.User{}.Fields()
 	_ = userFields
 	// userDescName is the schema descriptor for name field.
@@ -295,12 +901,16 @@ func init() {
 	userDescIsValid := userFields[5].Descriptor()
 	// user.DefaultIsValid holds the default value on creation for the is_valid field.
 	user.DefaultIsValid = userDescIsValid.Default.(bool)
+	// userDescIsAdmin is the schema descriptor for is_admin field.
+	userDescIsAdmin := userFields[6].Descriptor()
+	// user.DefaultIsAdmin holds the default value on creation for the is_admin field.
+	user.DefaultIsAdmin = userDescIsAdmin.Default.(bool)
 	// userDescCreatedAt is the schema descriptor for created_at field.
-	userDescCreatedAt := userFields[6].Descriptor()
+	userDescCreatedAt := userFields[7].Descriptor()
 	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
 	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
 	// userDescUpdatedAt is the schema descriptor for updated_at field.
-	userDescUpdatedAt := userFields[7].Descriptor()
+	userDescUpdatedAt := userFields[8].Descriptor()
 	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
 	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
 	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
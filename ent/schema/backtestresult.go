@@ -0,0 +1,87 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BacktestResult holds the schema definition for the BacktestResult entity.
+type BacktestResult struct {
+	ent.Schema
+}
+
+// Fields of the BacktestResult.
+func (BacktestResult) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("strategy_id", uuid.UUID{}),
+		field.UUID("user_id", uuid.UUID{}),
+		field.JSON("symbols", []string{}).
+			Default([]string{}),
+		field.String("interval").
+			MaxLen(8),
+		field.Time("period_start"),
+		field.Time("period_end"),
+		field.JSON("parameters", map[string]interface{}{}).
+			Default(map[string]interface{}{}),
+		field.JSON("settings", map[string]interface{}{}).
+			Default(map[string]interface{}{}),
+		field.Other("initial_capital", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("final_equity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Float("total_return").
+			Default(0),
+		field.Float("max_drawdown").
+			Default(0),
+		field.Float("sharpe_ratio").
+			Default(0),
+		field.Float("win_rate").
+			Default(0),
+		field.Int("trade_count").
+			Default(0),
+		field.Int("rejected_orders").
+			Default(0),
+		field.JSON("equity_curve", json.RawMessage{}).
+			Optional(),
+		field.JSON("trades", json.RawMessage{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the BacktestResult.
+func (BacktestResult) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("strategy", Strategy.Type).
+			Ref("backtests").
+			Field("strategy_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the BacktestResult.
+func (BacktestResult) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("strategy_id", "created_at"),
+		index.Fields("user_id"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+)
+
+// BrokerAccount holds the schema definition for the BrokerAccount entity.
+// App key/secret are stored encrypted (envelope encryption with a per-account data key).
+type BrokerAccount struct {
+	ent.Schema
+}
+
+// Fields of the BrokerAccount.
+func (BrokerAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Unique(),
+		field.Enum("broker").
+			Values("kis").
+			Default("kis"),
+		field.String("account_no").
+			MaxLen(8).
+			NotEmpty(),
+		field.String("product_code").
+			MaxLen(2).
+			Default("01"),
+		field.Bool("is_demo").
+			Default(false),
+		field.Bytes("app_key_cipher").
+			Sensitive(),
+		field.Bytes("app_secret_cipher").
+			Sensitive(),
+		field.Bytes("data_key").
+			Sensitive(),
+		field.String("key_id").
+			MaxLen(32),
+		field.String("app_key_hint").
+			MaxLen(8).
+			Default(""),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the BrokerAccount.
+func (BrokerAccount) Edges() []ent.Edge {
+	return nil
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Candle holds the schema definition for the Candle entity.
+type Candle struct {
+	ent.Schema
+}
+
+// Fields of the Candle.
+func (Candle) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("symbol").
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10),
+		field.String("interval").
+			MaxLen(8),
+		field.Time("timestamp"),
+		field.Other("open", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Other("high", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Other("low", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Other("close", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Int64("volume").
+			Default(0),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Candle.
+func (Candle) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("symbol", "exchange", "interval", "timestamp").
+			Unique(),
+		index.Fields("symbol", "interval", "timestamp"),
+	}
+}
@@ -0,0 +1,64 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+)
+
+// KillSwitch holds the schema definition for the KillSwitch entity.
+// One row per activation (audit); rows without released_at are still in force.
+type KillSwitch struct {
+	ent.Schema
+}
+
+// Fields of the KillSwitch.
+func (KillSwitch) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		// 대상 사용자 (비어 있으면 전체 사용자)
+		field.UUID("user_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.UUID("activated_by", uuid.UUID{}),
+		field.Text("reason").
+			Optional(),
+		// 보유 종목 시장가 청산 요청 여부
+		field.Bool("flatten").
+			Default(false),
+		field.Int("stopped_strategies").
+			Default(0),
+		field.Int("canceled_orders").
+			Default(0),
+		field.Int("flatten_orders").
+			Default(0),
+		// 거부되거나 제출하지 못한 청산 주문 수 (보유 종목이 남아 있음)
+		field.Int("failed_closes").
+			Default(0),
+		// 전략 중지/주문 취소/청산 중 실패 내역
+		field.JSON("errors", []string{}).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("released_at").
+			Optional().
+			Nillable(),
+		field.UUID("released_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Indexes of the KillSwitch.
+func (KillSwitch) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("released_at"),
+	}
+}
@@ -0,0 +1,123 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Order holds the schema definition for the Order entity.
+type Order struct {
+	ent.Schema
+}
+
+// Fields of the Order.
+func (Order) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("strategy_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("client_order_id").
+			MaxLen(64).
+			NotEmpty().
+			Unique(),
+		field.String("broker_order_id").
+			MaxLen(32).
+			Optional().
+			Nillable(),
+		field.String("symbol").
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10).
+			Optional(),
+		field.Enum("mode").
+			Values("LIVE", "PAPER").
+			Default("LIVE"),
+		field.Enum("side").
+			Values("BUY", "SELL"),
+		field.Enum("order_type").
+			Values("MARKET", "LIMIT", "MOO", "LOO", "MOC", "LOC"),
+		field.Enum("time_in_force").
+			Values("DAY", "IOC").
+			Default("DAY"),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Optional().
+			Nillable(),
+		field.Other("filled_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("avg_fill_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Optional().
+			Nillable(),
+		field.Enum("status").
+			Values("NEW", "SUBMITTED", "PARTIALLY_FILLED", "FILLED", "CANCELED", "REJECTED").
+			Default("NEW"),
+		field.Text("reject_reason").
+			Optional().
+			Nillable(),
+		field.Time("submitted_at").
+			Optional().
+			Nillable(),
+		field.Time("completed_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Order.
+func (Order) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("orders").
+			Field("user_id").
+			Required().
+			Unique(),
+		edge.From("strategy", Strategy.Type).
+			Ref("orders").
+			Field("strategy_id").
+			Unique(),
+	}
+}
+
+// Indexes of the Order.
+func (Order) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("strategy_id"),
+		index.Fields("symbol"),
+		index.Fields("status"),
+		index.Fields("mode"),
+		index.Fields("created_at"),
+	}
+}
@@ -0,0 +1,53 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperAccount holds the schema definition for the PaperAccount entity.
+type PaperAccount struct {
+	ent.Schema
+}
+
+// Fields of the PaperAccount.
+func (PaperAccount) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}).
+			Unique(),
+		field.Other("initial_cash", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("cash", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable().
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PaperAccount.
+func (PaperAccount) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.To("positions", PaperPosition.Type),
+		edge.To("trades", PaperTrade.Type),
+	}
+}
@@ -0,0 +1,61 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperPosition holds the schema definition for the PaperPosition entity.
+type PaperPosition struct {
+	ent.Schema
+}
+
+// Fields of the PaperPosition.
+func (PaperPosition) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("account_id", uuid.UUID{}),
+		field.String("symbol").
+			MaxLen(20),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("avg_cost", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now).
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the PaperPosition.
+func (PaperPosition) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", PaperAccount.Type).
+			Ref("positions").
+			Field("account_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the PaperPosition.
+func (PaperPosition) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "symbol").
+			Unique(),
+	}
+}
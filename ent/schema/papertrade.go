@@ -0,0 +1,75 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// PaperTrade holds the schema definition for the PaperTrade entity.
+type PaperTrade struct {
+	ent.Schema
+}
+
+// Fields of the PaperTrade.
+func (PaperTrade) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("account_id", uuid.UUID{}),
+		field.UUID("strategy_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("client_order_id").
+			MaxLen(64),
+		field.String("symbol").
+			MaxLen(20),
+		field.Enum("side").
+			Values("BUY", "SELL"),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Other("commission", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("realized_pnl", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Time("executed_at").
+			Default(time.Now),
+	}
+}
+
+// Edges of the PaperTrade.
+func (PaperTrade) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("account", PaperAccount.Type).
+			Ref("trades").
+			Field("account_id").
+			Required().
+			Unique(),
+	}
+}
+
+// Indexes of the PaperTrade.
+func (PaperTrade) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("account_id", "executed_at"),
+		index.Fields("client_order_id"),
+	}
+}
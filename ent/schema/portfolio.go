@@ -24,7 +24,10 @@ func (Portfolio) Fields() []ent.Field {
 			Unique(),
 		field.UUID("user_id", uuid.UUID{}),
 		field.String("symbol").
-			MaxLen(10),
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10).
+			Optional(),
 		field.Other("quantity", decimal.Decimal{}).
 			SchemaType(map[string]string{
 				"postgres": "numeric(15,4)",
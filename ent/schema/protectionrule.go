@@ -0,0 +1,95 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ProtectionRule holds the schema definition for the ProtectionRule entity.
+// A rule protects either one account position (strategy_id empty) or the open position of one strategy.
+// Percentages are fractions (0.05 = 5%) and 0 disables the condition.
+type ProtectionRule struct {
+	ent.Schema
+}
+
+// Fields of the ProtectionRule.
+func (ProtectionRule) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("account").
+			Values("LIVE", "PAPER"),
+		// 전략별 보호 규칙 (비어 있으면 계좌 보유 종목 단위)
+		field.UUID("strategy_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("symbol").
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10).
+			Optional(),
+		field.Float("stop_loss").
+			Default(0),
+		field.Float("take_profit").
+			Default(0),
+		// 추적 손절 (최고가/최저가 대비 비율, ATR 배수 중 더 가까운 가격)
+		field.Float("trailing_percent").
+			Default(0),
+		field.Float("trailing_atr").
+			Default(0),
+		field.Int("atr_period").
+			Default(14),
+		// 보유 기간 만료 청산 (분, 0이면 사용 안 함)
+		field.Int("max_hold_minutes").
+			Default(0),
+		field.Bool("active").
+			Default(true),
+		// 추적 손절 기준가 (롱은 보유 중 최고가, 숏은 최저가, 포지션이 없으면 0)
+		field.Other("water_mark", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		// 보호 규칙이 포지션을 처음 확인한 시각 (보유 기간 기준)
+		field.Time("opened_at").
+			Optional().
+			Nillable(),
+		// 마지막 청산 발동 내역
+		field.Time("triggered_at").
+			Optional().
+			Nillable(),
+		field.String("trigger_reason").
+			MaxLen(20).
+			Optional(),
+		field.Other("trigger_price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		field.String("exit_client_order_id").
+			MaxLen(64).
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the ProtectionRule.
+func (ProtectionRule) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "account", "symbol"),
+		index.Fields("strategy_id"),
+		index.Fields("active"),
+	}
+}
@@ -0,0 +1,79 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskEvent holds the schema definition for the RiskEvent entity.
+// Records risk decisions such as pre-trade order rejections and trading halts.
+type RiskEvent struct {
+	ent.Schema
+}
+
+// Fields of the RiskEvent.
+func (RiskEvent) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("account").
+			Values("LIVE", "PAPER"),
+		field.Enum("type").
+			Values("ORDER_REJECTED", "DRAWDOWN_HALT"),
+		// 위반한 검사 규칙 (max_order_notional, price_collar 등)
+		field.String("rule").
+			MaxLen(50).
+			Optional(),
+		field.Text("reason"),
+		field.String("client_order_id").
+			MaxLen(64).
+			Optional(),
+		field.UUID("strategy_id", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.String("symbol").
+			MaxLen(20).
+			Optional(),
+		field.String("side").
+			MaxLen(10).
+			Optional(),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		// 판단 근거 (최대 낙폭 중지: 평가금액, 최고 평가금액, 낙폭, 중지한 전략 수)
+		field.JSON("details", map[string]interface{}{}).
+			Optional(),
+		// 거래 중지 해제 확인 (확인 전까지 노출을 늘리는 주문 거부)
+		field.Time("acknowledged_at").
+			Optional().
+			Nillable(),
+		field.UUID("acknowledged_by", uuid.UUID{}).
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the RiskEvent.
+func (RiskEvent) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "created_at"),
+		index.Fields("user_id", "type", "created_at"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RiskState holds the schema definition for the RiskState entity.
+// One row per user and account (LIVE broker account or PAPER account).
+type RiskState struct {
+	ent.Schema
+}
+
+// Fields of the RiskState.
+func (RiskState) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.Enum("account").
+			Values("LIVE", "PAPER"),
+		// 일일 손익 기준 거래일 (뉴욕 시간 YYYY-MM-DD)
+		field.String("trading_day").
+			MaxLen(10),
+		field.Other("daily_realized_pnl", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("open_exposure", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("equity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("peak_equity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the RiskState.
+func (RiskState) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "account").
+			Unique(),
+	}
+}
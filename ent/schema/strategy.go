@@ -33,13 +33,19 @@ func (Strategy) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 		field.String("symbol").
-			MaxLen(10),
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10).
+			Optional(),
 		field.JSON("user_inputs", map[string]interface{}{}).
 			Default(map[string]interface{}{}),
 		field.JSON("settings", map[string]interface{}{}).
 			Default(map[string]interface{}{}),
 		field.Bool("active").
 			Default(false),
+		field.Enum("trading_mode").
+			Values("LIVE", "PAPER").
+			Default("LIVE"),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -66,6 +72,8 @@ func (Strategy) Edges() []ent.Edge {
 			Field("template_id").
 			Unique(),
 		edge.To("executions", StrategyExecution.Type),
+		edge.To("orders", Order.Type),
+		edge.To("backtests", BacktestResult.Type),
 		edge.To("performance", StrategyPerformance.Type).
 			Unique(),
 		edge.To("status", StrategyStatus.Type).
@@ -25,7 +25,7 @@ func (StrategyExecution) Fields() []ent.Field {
 			Optional().
 			Nillable(),
 		field.String("symbol").
-			MaxLen(10),
+			MaxLen(20),
 		field.Enum("action").
 			Values("BUY", "SELL", "HOLD"),
 		field.Other("price", decimal.Decimal{}).
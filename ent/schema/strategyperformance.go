@@ -10,6 +10,12 @@ import (
 	"github.com/shopspring/decimal"
 )
 
+// OpenPosition 실현손익 계산용 미청산 보유 수량과 평균 단가
+type OpenPosition struct {
+	Quantity decimal.Decimal `json:"quantity"`
+	AvgCost  decimal.Decimal `json:"avg_cost"`
+}
+
 // StrategyPerformance holds the schema definition for the StrategyPerformance entity.
 type StrategyPerformance struct {
 	ent.Schema
@@ -50,6 +56,21 @@ func (StrategyPerformance) Fields() []ent.Field {
 				"postgres": "numeric(8,4)",
 			}).
 			Default(decimal.Zero),
+		// 증분 계산 상태 (체결마다 갱신)
+		field.Int64("closed_trades").
+			Default(0),
+		field.Int64("winning_trades").
+			Default(0),
+		field.Float("return_sum").
+			Default(0),
+		field.Float("return_sq_sum").
+			Default(0),
+		field.Float("equity_index").
+			Default(1),
+		field.Float("peak_equity_index").
+			Default(1),
+		field.JSON("open_positions", map[string]OpenPosition{}).
+			Optional(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Symbol holds the schema definition for the Symbol entity (종목 마스터).
+type Symbol struct {
+	ent.Schema
+}
+
+// Fields of the Symbol.
+func (Symbol) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.String("ticker").
+			MaxLen(20).
+			NotEmpty(),
+		field.Enum("exchange").
+			Values("NASD", "NYSE", "AMEX"),
+		field.String("currency").
+			MaxLen(3).
+			Default("USD"),
+		field.String("name").
+			MaxLen(200).
+			Default(""),
+		field.Enum("security_type").
+			Values("STOCK", "ETF", "INDEX", "WARRANT", "OTHER").
+			Default("STOCK"),
+		field.Int("lot_size").
+			Default(1),
+		field.Other("tick_size", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,6)",
+			}).
+			Default(decimal.NewFromFloat(0.01)),
+		field.Bool("tradable").
+			Default(true),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the Symbol.
+func (Symbol) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("ticker", "exchange").
+			Unique(),
+		index.Fields("ticker"),
+	}
+}
@@ -0,0 +1,63 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxLot holds the schema definition for the TaxLot entity.
+// A lot is opened by each buy fill and consumed first-in first-out by sells.
+type TaxLot struct {
+	ent.Schema
+}
+
+// Fields of the TaxLot.
+func (TaxLot) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.UUID("trade_id", uuid.UUID{}),
+		field.String("symbol").
+			MaxLen(20),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("remaining_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		// 매수 수수료를 포함한 주당 취득단가
+		field.Other("cost_per_share", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(16,6)",
+			}),
+		field.Other("fx_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		field.Time("opened_at"),
+		field.Time("closed_at").
+			Optional().
+			Nillable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Indexes of the TaxLot.
+func (TaxLot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "symbol", "opened_at"),
+		index.Fields("trade_id"),
+	}
+}
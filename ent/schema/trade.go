@@ -0,0 +1,127 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Trade holds the schema definition for the Trade entity.
+// One row per live fill (trade ledger). Sell rows carry the cost basis matched
+// under both FIFO and moving-average methods, in trade currency and KRW.
+// Fills whose KRW rate is not known yet are stored as fx_pending and matched later.
+type Trade struct {
+	ent.Schema
+}
+
+// Fields of the Trade.
+func (Trade) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", uuid.UUID{}).
+			Default(uuid.New).
+			Unique(),
+		field.UUID("user_id", uuid.UUID{}),
+		field.String("client_order_id").
+			MaxLen(64),
+		field.String("broker_order_id").
+			MaxLen(64).
+			Optional(),
+		field.String("symbol").
+			MaxLen(20),
+		field.String("exchange").
+			MaxLen(10).
+			Optional(),
+		field.Enum("side").
+			Values("BUY", "SELL"),
+		field.Other("quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("price", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}),
+		field.Other("fee", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		field.String("currency").
+			MaxLen(3).
+			Default("USD"),
+		field.Other("fx_rate", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(12,4)",
+			}).
+			Default(decimal.Zero),
+		// 주문 누적 체결 수량 (같은 체결 이벤트 중복 기록 방지용)
+		field.Other("filled_quantity", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}),
+		field.Other("proceeds", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("cost_basis_fifo", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("cost_basis_fifo_krw", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(18,2)",
+			}).
+			Default(decimal.Zero),
+		field.Other("cost_basis_avg", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("cost_basis_avg_krw", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(18,2)",
+			}).
+			Default(decimal.Zero),
+		field.Bool("estimated_basis").
+			Default(false),
+		field.Other("position_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(15,4)",
+			}).
+			Default(decimal.Zero),
+		field.Other("avg_cost_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(16,6)",
+			}).
+			Default(decimal.Zero),
+		field.Other("avg_cost_krw_after", decimal.Decimal{}).
+			SchemaType(map[string]string{
+				"postgres": "numeric(18,4)",
+			}).
+			Default(decimal.Zero),
+		// 환율 미확정 체결 (환율을 확인한 뒤 취득가액 매칭/로트 반영, 그 전까지 손익 집계 제외)
+		field.Bool("fx_pending").
+			Default(false),
+		field.Time("executed_at"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Indexes of the Trade.
+func (Trade) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "executed_at"),
+		index.Fields("user_id", "symbol", "executed_at"),
+		index.Fields("client_order_id", "filled_quantity").
+			Unique(),
+		index.Fields("fx_pending"),
+	}
+}
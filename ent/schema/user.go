@@ -39,6 +39,9 @@ func (User) Fields() []ent.Field {
 			Default(""),
 		field.Bool("is_valid").
 			Default(true),
+		// 관리자 (전체 사용자 긴급 중지 등)
+		field.Bool("is_admin").
+			Default(false),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable().
@@ -57,6 +60,7 @@ func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("strategies", Strategy.Type),
 		edge.To("portfolios", Portfolio.Type),
+		edge.To("orders", Order.Type),
 	}
 }
 
@@ -35,12 +35,16 @@ type Strategy struct {
 	Description *string `json:"description,omitempty"`
 	// Symbol holds the value of the "symbol" field.
 	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
 	// UserInputs holds the value of the "user_inputs" field.
 	UserInputs map[string]interface{} `json:"user_inputs,omitempty"`
 	// Settings holds the value of the "settings" field.
 	Settings map[string]interface{} `json:"settings,omitempty"`
 	// Active holds the value of the "active" field.
 	Active bool `json:"active,omitempty"`
+	// TradingMode holds the value of the "trading_mode" field.
+	TradingMode strategy.TradingMode `json:"trading_mode,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -59,13 +63,17 @@ type StrategyEdges struct {
 	Template *StrategyTemplate `json:"template,omitempty"`
 	// Executions holds the value of the executions edge.
 	Executions []*StrategyExecution `json:"executions,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
+	// Backtests holds the value of the backtests edge.
+	Backtests []*BacktestResult `json:"backtests,omitempty"`
 	// Performance holds the value of the performance edge.
 	Performance *StrategyPerformance `json:"performance,omitempty"`
 	// Status holds the value of the status edge.
 	Status *StrategyStatus `json:"status,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [5]bool
+	loadedTypes [7]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -99,12 +107,30 @@ func (e StrategyEdges) ExecutionsOrErr() ([]*StrategyExecution, error) {
 	return nil, &NotLoadedError{edge: "executions"}
 }
 
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e StrategyEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[3] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
+// BacktestsOrErr returns the Backtests value or an error if the edge
+// was not loaded in eager-loading.
+func (e StrategyEdges) BacktestsOrErr() ([]*BacktestResult, error) {
+	if e.loadedTypes[4] {
+		return e.Backtests, nil
+	}
+	return nil, &NotLoadedError{edge: "backtests"}
+}
+
 // PerformanceOrErr returns the Performance value or an error if the edge
 // was not loaded in eager-loading, or loaded but was not found.
 func (e StrategyEdges) PerformanceOrErr() (*StrategyPerformance, error) {
 	if e.Performance != nil {
 		return e.Performance, nil
-	} else if e.loadedTypes[3] {
+	} else if e.loadedTypes[5] {
 		return nil, &NotFoundError{label: strategyperformance.Label}
 	}
 	return nil, &NotLoadedError{edge: "performance"}
@@ -115,7 +141,7 @@ func (e StrategyEdges) PerformanceOrErr() (*StrategyPerformance, error) {
 func (e StrategyEdges) StatusOrErr() (*StrategyStatus, error) {
 	if e.Status != nil {
 		return e.Status, nil
-	} else if e.loadedTypes[4] {
+	} else if e.loadedTypes[6] {
 		return nil, &NotFoundError{label: strategystatus.Label}
 	}
 	return nil, &NotLoadedError{edge: "status"}
@@ -132,7 +158,7 @@ func (*Strategy) scanValues(columns []string) ([]any, error) {
 			values[i] = new([]byte)
 		case strategy.FieldActive:
 			values[i] = new(sql.NullBool)
-		case strategy.FieldStrategyID, strategy.FieldName, strategy.FieldDescription, strategy.FieldSymbol:
+		case strategy.FieldStrategyID, strategy.FieldName, strategy.FieldDescription, strategy.FieldSymbol, strategy.FieldExchange, strategy.FieldTradingMode:
 			values[i] = new(sql.NullString)
 		case strategy.FieldCreatedAt, strategy.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -197,6 +223,12 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Symbol = value.String
 			}
+		case strategy.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
 		case strategy.FieldUserInputs:
 			if value, ok := values[i].(*[]byte); !ok {
 				return fmt.Errorf("unexpected type %T for field user_inputs", values[i])
@@ -219,6 +251,12 @@ func (_m *Strategy) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.Active = value.Bool
 			}
+		case strategy.FieldTradingMode:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field trading_mode", values[i])
+			} else if value.Valid {
+				_m.TradingMode = strategy.TradingMode(value.String)
+			}
 		case strategy.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -261,6 +299,16 @@ func (_m *Strategy) QueryExecutions() *StrategyExecutionQuery {
 	return NewStrategyClient(_m.config).QueryExecutions(_m)
 }
 
+// QueryOrders queries the "orders" edge of the Strategy entity.
+func (_m *Strategy) QueryOrders() *OrderQuery {
+	return NewStrategyClient(_m.config).QueryOrders(_m)
+}
+
+// QueryBacktests queries the "backtests" edge of the Strategy entity.
+func (_m *Strategy) QueryBacktests() *BacktestResultQuery {
+	return NewStrategyClient(_m.config).QueryBacktests(_m)
+}
+
 // QueryPerformance queries the "performance" edge of the Strategy entity.
 func (_m *Strategy) QueryPerformance() *StrategyPerformanceQuery {
 	return NewStrategyClient(_m.config).QueryPerformance(_m)
@@ -316,6 +364,9 @@ func (_m *Strategy) String() string {
 	builder.WriteString("symbol=")
 	builder.WriteString(_m.Symbol)
 	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
 	builder.WriteString("user_inputs=")
 	builder.WriteString(fmt.Sprintf("%v", _m.UserInputs))
 	builder.WriteString(", ")
@@ -325,6 +376,9 @@ func (_m *Strategy) String() string {
 	builder.WriteString("active=")
 	builder.WriteString(fmt.Sprintf("%v", _m.Active))
 	builder.WriteString(", ")
+	builder.WriteString("trading_mode=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TradingMode))
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
@@ -3,6 +3,7 @@
 package strategy
 
 import (
+	"fmt"
 	"time"
 
 	"entgo.io/ent/dialect/sql"
@@ -27,12 +28,16 @@ const (
 	FieldDescription = "description"
 	// FieldSymbol holds the string denoting the symbol field in the database.
 	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
 	// FieldUserInputs holds the string denoting the user_inputs field in the database.
 	FieldUserInputs = "user_inputs"
 	// FieldSettings holds the string denoting the settings field in the database.
 	FieldSettings = "settings"
 	// FieldActive holds the string denoting the active field in the database.
 	FieldActive = "active"
+	// FieldTradingMode holds the string denoting the trading_mode field in the database.
+	FieldTradingMode = "trading_mode"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -43,6 +48,10 @@ const (
 	EdgeTemplate = "template"
 	// EdgeExecutions holds the string denoting the executions edge name in mutations.
 	EdgeExecutions = "executions"
+	// EdgeOrders holds the string denoting the orders edge name in mutations.
+	EdgeOrders = "orders"
+	// EdgeBacktests holds the string denoting the backtests edge name in mutations.
+	EdgeBacktests = "backtests"
 	// EdgePerformance holds the string denoting the performance edge name in mutations.
 	EdgePerformance = "performance"
 	// EdgeStatus holds the string denoting the status edge name in mutations.
@@ -70,6 +79,20 @@ const (
 	ExecutionsInverseTable = "strategy_executions"
 	// ExecutionsColumn is the table column denoting the executions relation/edge.
 	ExecutionsColumn = "strategy_id"
+	// OrdersTable is the table that holds the orders relation/edge.
+	OrdersTable = "orders"
+	// OrdersInverseTable is the table name for the Order entity.
+	// It exists in this package in order to avoid circular dependency with the "order" package.
+	OrdersInverseTable = "orders"
+	// OrdersColumn is the table column denoting the orders relation/edge.
+	OrdersColumn = "strategy_id"
+	// BacktestsTable is the table that holds the backtests relation/edge.
+	BacktestsTable = "backtest_results"
+	// BacktestsInverseTable is the table name for the BacktestResult entity.
+	// It exists in this package in order to avoid circular dependency with the "backtestresult" package.
+	BacktestsInverseTable = "backtest_results"
+	// BacktestsColumn is the table column denoting the backtests relation/edge.
+	BacktestsColumn = "strategy_id"
 	// PerformanceTable is the table that holds the performance relation/edge.
 	PerformanceTable = "strategy_performances"
 	// PerformanceInverseTable is the table name for the StrategyPerformance entity.
@@ -95,9 +118,11 @@ var Columns = []string{
 	FieldName,
 	FieldDescription,
 	FieldSymbol,
+	FieldExchange,
 	FieldUserInputs,
 	FieldSettings,
 	FieldActive,
+	FieldTradingMode,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -119,6 +144,8 @@ var (
 	NameValidator func(string) error
 	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
 	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
 	// DefaultUserInputs holds the default value on creation for the "user_inputs" field.
 	DefaultUserInputs map[string]interface{}
 	// DefaultSettings holds the default value on creation for the "settings" field.
@@ -135,6 +162,32 @@ var (
 	DefaultID func() uuid.UUID
 )
 
+// TradingMode defines the type for the "trading_mode" enum field.
+type TradingMode string
+
+// TradingModeLIVE is the default value of the TradingMode enum.
+const DefaultTradingMode = TradingModeLIVE
+
+// TradingMode values.
+const (
+	TradingModeLIVE  TradingMode = "LIVE"
+	TradingModePAPER TradingMode = "PAPER"
+)
+
+func (tm TradingMode) String() string {
+	return string(tm)
+}
+
+// TradingModeValidator is a validator for the "trading_mode" field enum values. It is called by the builders before save.
+func TradingModeValidator(tm TradingMode) error {
+	switch tm {
+	case TradingModeLIVE, TradingModePAPER:
+		return nil
+	default:
+		return fmt.Errorf("strategy: invalid enum value for trading_mode field: %q", tm)
+	}
+}
+
 // OrderOption defines the ordering options for the Strategy queries.
 type OrderOption func(*sql.Selector)
 
@@ -173,11 +226,21 @@ func BySymbol(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
 }
 
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
 // ByActive orders the results by the active field.
 func ByActive(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldActive, opts...).ToFunc()
 }
 
+// ByTradingMode orders the results by the trading_mode field.
+func ByTradingMode(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradingMode, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -216,6 +279,34 @@ func ByExecutions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
 	}
 }
 
+// ByOrdersCount orders the results by orders count.
+func ByOrdersCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newOrdersStep(), opts...)
+	}
+}
+
+// ByOrders orders the results by orders terms.
+func ByOrders(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newOrdersStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByBacktestsCount orders the results by backtests count.
+func ByBacktestsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newBacktestsStep(), opts...)
+	}
+}
+
+// ByBacktests orders the results by backtests terms.
+func ByBacktests(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newBacktestsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
 // ByPerformanceField orders the results by performance field.
 func ByPerformanceField(field string, opts ...sql.OrderTermOption) OrderOption {
 	return func(s *sql.Selector) {
@@ -250,6 +341,20 @@ func newExecutionsStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.O2M, false, ExecutionsTable, ExecutionsColumn),
 	)
 }
+func newOrdersStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(OrdersInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+	)
+}
+func newBacktestsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(BacktestsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, BacktestsTable, BacktestsColumn),
+	)
+}
 func newPerformanceStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -86,6 +86,11 @@ func Symbol(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldSymbol, v))
 }
 
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldExchange, v))
+}
+
 // Active applies equality check predicate on the "active" field. It's identical to ActiveEQ.
 func Active(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldActive, v))
@@ -421,6 +426,81 @@ func SymbolContainsFold(v string) predicate.Strategy {
 	return predicate.Strategy(sql.FieldContainsFold(FieldSymbol, v))
 }
 
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeIsNil applies the IsNil predicate on the "exchange" field.
+func ExchangeIsNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldIsNull(FieldExchange))
+}
+
+// ExchangeNotNil applies the NotNil predicate on the "exchange" field.
+func ExchangeNotNil() predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotNull(FieldExchange))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Strategy {
+	return predicate.Strategy(sql.FieldContainsFold(FieldExchange, v))
+}
+
 // ActiveEQ applies the EQ predicate on the "active" field.
 func ActiveEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldActive, v))
@@ -431,6 +511,26 @@ func ActiveNEQ(v bool) predicate.Strategy {
 	return predicate.Strategy(sql.FieldNEQ(FieldActive, v))
 }
 
+// TradingModeEQ applies the EQ predicate on the "trading_mode" field.
+func TradingModeEQ(v TradingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldEQ(FieldTradingMode, v))
+}
+
+// TradingModeNEQ applies the NEQ predicate on the "trading_mode" field.
+func TradingModeNEQ(v TradingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNEQ(FieldTradingMode, v))
+}
+
+// TradingModeIn applies the In predicate on the "trading_mode" field.
+func TradingModeIn(vs ...TradingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldIn(FieldTradingMode, vs...))
+}
+
+// TradingModeNotIn applies the NotIn predicate on the "trading_mode" field.
+func TradingModeNotIn(vs ...TradingMode) predicate.Strategy {
+	return predicate.Strategy(sql.FieldNotIn(FieldTradingMode, vs...))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Strategy {
 	return predicate.Strategy(sql.FieldEQ(FieldCreatedAt, v))
@@ -600,6 +700,52 @@ func HasExecutionsWith(preds ...predicate.StrategyExecution) predicate.Strategy
 	})
 }
 
+// HasOrders applies the HasEdge predicate on the "orders" edge.
+func HasOrders() predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, OrdersTable, OrdersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasOrdersWith applies the HasEdge predicate on the "orders" edge with a given conditions (other predicates).
+func HasOrdersWith(preds ...predicate.Order) predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := newOrdersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasBacktests applies the HasEdge predicate on the "backtests" edge.
+func HasBacktests() predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, BacktestsTable, BacktestsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasBacktestsWith applies the HasEdge predicate on the "backtests" edge with a given conditions (other predicates).
+func HasBacktestsWith(preds ...predicate.BacktestResult) predicate.Strategy {
+	return predicate.Strategy(func(s *sql.Selector) {
+		step := newBacktestsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // HasPerformance applies the HasEdge predicate on the "performance" edge.
 func HasPerformance() predicate.Strategy {
 	return predicate.Strategy(func(s *sql.Selector) {
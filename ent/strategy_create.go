@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/order"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
 	"auto-trader/ent/strategyperformance"
@@ -78,6 +80,20 @@ func (_c *StrategyCreate) SetSymbol(v string) *StrategyCreate {
 	return _c
 }
 
+// SetExchange sets the "exchange" field.
+func (_c *StrategyCreate) SetExchange(v string) *StrategyCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableExchange(v *string) *StrategyCreate {
+	if v != nil {
+		_c.SetExchange(*v)
+	}
+	return _c
+}
+
 // SetUserInputs sets the "user_inputs" field.
 func (_c *StrategyCreate) SetUserInputs(v map[string]interface{}) *StrategyCreate {
 	_c.mutation.SetUserInputs(v)
@@ -104,6 +120,20 @@ func (_c *StrategyCreate) SetNillableActive(v *bool) *StrategyCreate {
 	return _c
 }
 
+// SetTradingMode sets the "trading_mode" field.
+func (_c *StrategyCreate) SetTradingMode(v strategy.TradingMode) *StrategyCreate {
+	_c.mutation.SetTradingMode(v)
+	return _c
+}
+
+// SetNillableTradingMode sets the "trading_mode" field if the given value is not nil.
+func (_c *StrategyCreate) SetNillableTradingMode(v *strategy.TradingMode) *StrategyCreate {
+	if v != nil {
+		_c.SetTradingMode(*v)
+	}
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *StrategyCreate) SetCreatedAt(v time.Time) *StrategyCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -171,6 +201,36 @@ func (_c *StrategyCreate) AddExecutions(v ...*StrategyExecution) *StrategyCreate
 	return _c.AddExecutionIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_c *StrategyCreate) AddOrderIDs(ids ...uuid.UUID) *StrategyCreate {
+	_c.mutation.AddOrderIDs(ids...)
+	return _c
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_c *StrategyCreate) AddOrders(v ...*Order) *StrategyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddOrderIDs(ids...)
+}
+
+// AddBacktestIDs adds the "backtests" edge to the BacktestResult entity by IDs.
+func (_c *StrategyCreate) AddBacktestIDs(ids ...uuid.UUID) *StrategyCreate {
+	_c.mutation.AddBacktestIDs(ids...)
+	return _c
+}
+
+// AddBacktests adds the "backtests" edges to the BacktestResult entity.
+func (_c *StrategyCreate) AddBacktests(v ...*BacktestResult) *StrategyCreate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _c.AddBacktestIDs(ids...)
+}
+
 // SetPerformanceID sets the "performance" edge to the StrategyPerformance entity by ID.
 func (_c *StrategyCreate) SetPerformanceID(id int) *StrategyCreate {
 	_c.mutation.SetPerformanceID(id)
@@ -256,6 +316,10 @@ func (_c *StrategyCreate) defaults() {
 		v := strategy.DefaultActive
 		_c.mutation.SetActive(v)
 	}
+	if _, ok := _c.mutation.TradingMode(); !ok {
+		v := strategy.DefaultTradingMode
+		_c.mutation.SetTradingMode(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := strategy.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -299,6 +363,11 @@ func (_c *StrategyCreate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := strategy.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Strategy.exchange": %w`, err)}
+		}
+	}
 	if _, ok := _c.mutation.UserInputs(); !ok {
 		return &ValidationError{Name: "user_inputs", err: errors.New(`ent: missing required field "Strategy.user_inputs"`)}
 	}
@@ -308,6 +377,14 @@ func (_c *StrategyCreate) check() error {
 	if _, ok := _c.mutation.Active(); !ok {
 		return &ValidationError{Name: "active", err: errors.New(`ent: missing required field "Strategy.active"`)}
 	}
+	if _, ok := _c.mutation.TradingMode(); !ok {
+		return &ValidationError{Name: "trading_mode", err: errors.New(`ent: missing required field "Strategy.trading_mode"`)}
+	}
+	if v, ok := _c.mutation.TradingMode(); ok {
+		if err := strategy.TradingModeValidator(v); err != nil {
+			return &ValidationError{Name: "trading_mode", err: fmt.Errorf(`ent: validator failed for field "Strategy.trading_mode": %w`, err)}
+		}
+	}
 	if len(_c.mutation.UserIDs()) == 0 {
 		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Strategy.user"`)}
 	}
@@ -362,6 +439,10 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 		_node.Symbol = value
 	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(strategy.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
 	if value, ok := _c.mutation.UserInputs(); ok {
 		_spec.SetField(strategy.FieldUserInputs, field.TypeJSON, value)
 		_node.UserInputs = value
@@ -374,6 +455,10 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		_spec.SetField(strategy.FieldActive, field.TypeBool, value)
 		_node.Active = value
 	}
+	if value, ok := _c.mutation.TradingMode(); ok {
+		_spec.SetField(strategy.FieldTradingMode, field.TypeEnum, value)
+		_node.TradingMode = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(strategy.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = &value
@@ -432,6 +517,38 @@ func (_c *StrategyCreate) createSpec() (*Strategy, *sqlgraph.CreateSpec) {
 		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
+	if nodes := _c.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := _c.mutation.BacktestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
 	if nodes := _c.mutation.PerformanceIDs(); len(nodes) > 0 {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
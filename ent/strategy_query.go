@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/order"
 	"auto-trader/ent/predicate"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
@@ -32,6 +34,8 @@ type StrategyQuery struct {
 	withUser        *UserQuery
 	withTemplate    *StrategyTemplateQuery
 	withExecutions  *StrategyExecutionQuery
+	withOrders      *OrderQuery
+	withBacktests   *BacktestResultQuery
 	withPerformance *StrategyPerformanceQuery
 	withStatus      *StrategyStatusQuery
 	// intermediate query (i.e. traversal path).
@@ -136,6 +140,50 @@ func (_q *StrategyQuery) QueryExecutions() *StrategyExecutionQuery {
 	return query
 }
 
+// QueryOrders chains the current query on the "orders" edge.
+func (_q *StrategyQuery) QueryOrders() *OrderQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, selector),
+			sqlgraph.To(order.Table, order.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, strategy.OrdersTable, strategy.OrdersColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryBacktests chains the current query on the "backtests" edge.
+func (_q *StrategyQuery) QueryBacktests() *BacktestResultQuery {
+	query := (&BacktestResultClient{config: _q.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := _q.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := _q.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(strategy.Table, strategy.FieldID, selector),
+			sqlgraph.To(backtestresult.Table, backtestresult.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, strategy.BacktestsTable, strategy.BacktestsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(_q.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // QueryPerformance chains the current query on the "performance" edge.
 func (_q *StrategyQuery) QueryPerformance() *StrategyPerformanceQuery {
 	query := (&StrategyPerformanceClient{config: _q.config}).Query()
@@ -375,6 +423,8 @@ func (_q *StrategyQuery) Clone() *StrategyQuery {
 		withUser:        _q.withUser.Clone(),
 		withTemplate:    _q.withTemplate.Clone(),
 		withExecutions:  _q.withExecutions.Clone(),
+		withOrders:      _q.withOrders.Clone(),
+		withBacktests:   _q.withBacktests.Clone(),
 		withPerformance: _q.withPerformance.Clone(),
 		withStatus:      _q.withStatus.Clone(),
 		// clone intermediate query.
@@ -416,6 +466,28 @@ func (_q *StrategyQuery) WithExecutions(opts ...func(*StrategyExecutionQuery)) *
 	return _q
 }
 
+// WithOrders tells the query-builder to eager-load the nodes that are connected to
+// the "orders" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StrategyQuery) WithOrders(opts ...func(*OrderQuery)) *StrategyQuery {
+	query := (&OrderClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withOrders = query
+	return _q
+}
+
+// WithBacktests tells the query-builder to eager-load the nodes that are connected to
+// the "backtests" edge. The optional arguments are used to configure the query builder of the edge.
+func (_q *StrategyQuery) WithBacktests(opts ...func(*BacktestResultQuery)) *StrategyQuery {
+	query := (&BacktestResultClient{config: _q.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	_q.withBacktests = query
+	return _q
+}
+
 // WithPerformance tells the query-builder to eager-load the nodes that are connected to
 // the "performance" edge. The optional arguments are used to configure the query builder of the edge.
 func (_q *StrategyQuery) WithPerformance(opts ...func(*StrategyPerformanceQuery)) *StrategyQuery {
@@ -516,10 +588,12 @@ func (_q *StrategyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 	var (
 		nodes       = []*Strategy{}
 		_spec       = _q.querySpec()
-		loadedTypes = [5]bool{
+		loadedTypes = [7]bool{
 			_q.withUser != nil,
 			_q.withTemplate != nil,
 			_q.withExecutions != nil,
+			_q.withOrders != nil,
+			_q.withBacktests != nil,
 			_q.withPerformance != nil,
 			_q.withStatus != nil,
 		}
@@ -561,6 +635,20 @@ func (_q *StrategyQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Str
 			return nil, err
 		}
 	}
+	if query := _q.withOrders; query != nil {
+		if err := _q.loadOrders(ctx, query, nodes,
+			func(n *Strategy) { n.Edges.Orders = []*Order{} },
+			func(n *Strategy, e *Order) { n.Edges.Orders = append(n.Edges.Orders, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := _q.withBacktests; query != nil {
+		if err := _q.loadBacktests(ctx, query, nodes,
+			func(n *Strategy) { n.Edges.Backtests = []*BacktestResult{} },
+			func(n *Strategy, e *BacktestResult) { n.Edges.Backtests = append(n.Edges.Backtests, e) }); err != nil {
+			return nil, err
+		}
+	}
 	if query := _q.withPerformance; query != nil {
 		if err := _q.loadPerformance(ctx, query, nodes, nil,
 			func(n *Strategy, e *StrategyPerformance) { n.Edges.Performance = e }); err != nil {
@@ -670,6 +758,69 @@ func (_q *StrategyQuery) loadExecutions(ctx context.Context, query *StrategyExec
 	}
 	return nil
 }
+func (_q *StrategyQuery) loadOrders(ctx context.Context, query *OrderQuery, nodes []*Strategy, init func(*Strategy), assign func(*Strategy, *Order)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Strategy)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(order.FieldStrategyID)
+	}
+	query.Where(predicate.Order(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(strategy.OrdersColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StrategyID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "strategy_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "strategy_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (_q *StrategyQuery) loadBacktests(ctx context.Context, query *BacktestResultQuery, nodes []*Strategy, init func(*Strategy), assign func(*Strategy, *BacktestResult)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uuid.UUID]*Strategy)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(backtestresult.FieldStrategyID)
+	}
+	query.Where(predicate.BacktestResult(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(strategy.BacktestsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.StrategyID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "strategy_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 func (_q *StrategyQuery) loadPerformance(ctx context.Context, query *StrategyPerformanceQuery, nodes []*Strategy, init func(*Strategy), assign func(*Strategy, *StrategyPerformance)) error {
 	fks := make([]driver.Value, 0, len(nodes))
 	nodeids := make(map[uuid.UUID]*Strategy)
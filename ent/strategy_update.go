@@ -3,6 +3,8 @@
 package ent
 
 import (
+	"auto-trader/ent/backtestresult"
+	"auto-trader/ent/order"
 	"auto-trader/ent/predicate"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyexecution"
@@ -130,6 +132,26 @@ func (_u *StrategyUpdate) SetNillableSymbol(v *string) *StrategyUpdate {
 	return _u
 }
 
+// SetExchange sets the "exchange" field.
+func (_u *StrategyUpdate) SetExchange(v string) *StrategyUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableExchange(v *string) *StrategyUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *StrategyUpdate) ClearExchange() *StrategyUpdate {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
 // SetUserInputs sets the "user_inputs" field.
 func (_u *StrategyUpdate) SetUserInputs(v map[string]interface{}) *StrategyUpdate {
 	_u.mutation.SetUserInputs(v)
@@ -156,6 +178,20 @@ func (_u *StrategyUpdate) SetNillableActive(v *bool) *StrategyUpdate {
 	return _u
 }
 
+// SetTradingMode sets the "trading_mode" field.
+func (_u *StrategyUpdate) SetTradingMode(v strategy.TradingMode) *StrategyUpdate {
+	_u.mutation.SetTradingMode(v)
+	return _u
+}
+
+// SetNillableTradingMode sets the "trading_mode" field if the given value is not nil.
+func (_u *StrategyUpdate) SetNillableTradingMode(v *strategy.TradingMode) *StrategyUpdate {
+	if v != nil {
+		_u.SetTradingMode(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *StrategyUpdate) SetUpdatedAt(v time.Time) *StrategyUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -193,6 +229,36 @@ func (_u *StrategyUpdate) AddExecutions(v ...*StrategyExecution) *StrategyUpdate
 	return _u.AddExecutionIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *StrategyUpdate) AddOrderIDs(ids ...uuid.UUID) *StrategyUpdate {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *StrategyUpdate) AddOrders(v ...*Order) *StrategyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// AddBacktestIDs adds the "backtests" edge to the BacktestResult entity by IDs.
+func (_u *StrategyUpdate) AddBacktestIDs(ids ...uuid.UUID) *StrategyUpdate {
+	_u.mutation.AddBacktestIDs(ids...)
+	return _u
+}
+
+// AddBacktests adds the "backtests" edges to the BacktestResult entity.
+func (_u *StrategyUpdate) AddBacktests(v ...*BacktestResult) *StrategyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBacktestIDs(ids...)
+}
+
 // SetPerformanceID sets the "performance" edge to the StrategyPerformance entity by ID.
 func (_u *StrategyUpdate) SetPerformanceID(id int) *StrategyUpdate {
 	_u.mutation.SetPerformanceID(id)
@@ -269,6 +335,48 @@ func (_u *StrategyUpdate) RemoveExecutions(v ...*StrategyExecution) *StrategyUpd
 	return _u.RemoveExecutionIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *StrategyUpdate) ClearOrders() *StrategyUpdate {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *StrategyUpdate) RemoveOrderIDs(ids ...uuid.UUID) *StrategyUpdate {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *StrategyUpdate) RemoveOrders(v ...*Order) *StrategyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// ClearBacktests clears all "backtests" edges to the BacktestResult entity.
+func (_u *StrategyUpdate) ClearBacktests() *StrategyUpdate {
+	_u.mutation.ClearBacktests()
+	return _u
+}
+
+// RemoveBacktestIDs removes the "backtests" edge to BacktestResult entities by IDs.
+func (_u *StrategyUpdate) RemoveBacktestIDs(ids ...uuid.UUID) *StrategyUpdate {
+	_u.mutation.RemoveBacktestIDs(ids...)
+	return _u
+}
+
+// RemoveBacktests removes "backtests" edges to BacktestResult entities.
+func (_u *StrategyUpdate) RemoveBacktests(v ...*BacktestResult) *StrategyUpdate {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBacktestIDs(ids...)
+}
+
 // ClearPerformance clears the "performance" edge to the StrategyPerformance entity.
 func (_u *StrategyUpdate) ClearPerformance() *StrategyUpdate {
 	_u.mutation.ClearPerformance()
@@ -334,6 +442,16 @@ func (_u *StrategyUpdate) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := strategy.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Strategy.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TradingMode(); ok {
+		if err := strategy.TradingModeValidator(v); err != nil {
+			return &ValidationError{Name: "trading_mode", err: fmt.Errorf(`ent: validator failed for field "Strategy.trading_mode": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Strategy.user"`)
 	}
@@ -367,6 +485,12 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Symbol(); ok {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(strategy.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(strategy.FieldExchange, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserInputs(); ok {
 		_spec.SetField(strategy.FieldUserInputs, field.TypeJSON, value)
 	}
@@ -376,6 +500,9 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(strategy.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TradingMode(); ok {
+		_spec.SetField(strategy.FieldTradingMode, field.TypeEnum, value)
+	}
 	if _u.mutation.CreatedAtCleared() {
 		_spec.ClearField(strategy.FieldCreatedAt, field.TypeTime)
 	}
@@ -488,6 +615,96 @@ func (_u *StrategyUpdate) sqlSave(ctx context.Context) (_node int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BacktestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBacktestsIDs(); len(nodes) > 0 && !_u.mutation.BacktestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BacktestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PerformanceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
@@ -662,6 +879,26 @@ func (_u *StrategyUpdateOne) SetNillableSymbol(v *string) *StrategyUpdateOne {
 	return _u
 }
 
+// SetExchange sets the "exchange" field.
+func (_u *StrategyUpdateOne) SetExchange(v string) *StrategyUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableExchange(v *string) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *StrategyUpdateOne) ClearExchange() *StrategyUpdateOne {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
 // SetUserInputs sets the "user_inputs" field.
 func (_u *StrategyUpdateOne) SetUserInputs(v map[string]interface{}) *StrategyUpdateOne {
 	_u.mutation.SetUserInputs(v)
@@ -688,6 +925,20 @@ func (_u *StrategyUpdateOne) SetNillableActive(v *bool) *StrategyUpdateOne {
 	return _u
 }
 
+// SetTradingMode sets the "trading_mode" field.
+func (_u *StrategyUpdateOne) SetTradingMode(v strategy.TradingMode) *StrategyUpdateOne {
+	_u.mutation.SetTradingMode(v)
+	return _u
+}
+
+// SetNillableTradingMode sets the "trading_mode" field if the given value is not nil.
+func (_u *StrategyUpdateOne) SetNillableTradingMode(v *strategy.TradingMode) *StrategyUpdateOne {
+	if v != nil {
+		_u.SetTradingMode(*v)
+	}
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *StrategyUpdateOne) SetUpdatedAt(v time.Time) *StrategyUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -725,6 +976,36 @@ func (_u *StrategyUpdateOne) AddExecutions(v ...*StrategyExecution) *StrategyUpd
 	return _u.AddExecutionIDs(ids...)
 }
 
+// AddOrderIDs adds the "orders" edge to the Order entity by IDs.
+func (_u *StrategyUpdateOne) AddOrderIDs(ids ...uuid.UUID) *StrategyUpdateOne {
+	_u.mutation.AddOrderIDs(ids...)
+	return _u
+}
+
+// AddOrders adds the "orders" edges to the Order entity.
+func (_u *StrategyUpdateOne) AddOrders(v ...*Order) *StrategyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddOrderIDs(ids...)
+}
+
+// AddBacktestIDs adds the "backtests" edge to the BacktestResult entity by IDs.
+func (_u *StrategyUpdateOne) AddBacktestIDs(ids ...uuid.UUID) *StrategyUpdateOne {
+	_u.mutation.AddBacktestIDs(ids...)
+	return _u
+}
+
+// AddBacktests adds the "backtests" edges to the BacktestResult entity.
+func (_u *StrategyUpdateOne) AddBacktests(v ...*BacktestResult) *StrategyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.AddBacktestIDs(ids...)
+}
+
 // SetPerformanceID sets the "performance" edge to the StrategyPerformance entity by ID.
 func (_u *StrategyUpdateOne) SetPerformanceID(id int) *StrategyUpdateOne {
 	_u.mutation.SetPerformanceID(id)
@@ -801,6 +1082,48 @@ func (_u *StrategyUpdateOne) RemoveExecutions(v ...*StrategyExecution) *Strategy
 	return _u.RemoveExecutionIDs(ids...)
 }
 
+// ClearOrders clears all "orders" edges to the Order entity.
+func (_u *StrategyUpdateOne) ClearOrders() *StrategyUpdateOne {
+	_u.mutation.ClearOrders()
+	return _u
+}
+
+// RemoveOrderIDs removes the "orders" edge to Order entities by IDs.
+func (_u *StrategyUpdateOne) RemoveOrderIDs(ids ...uuid.UUID) *StrategyUpdateOne {
+	_u.mutation.RemoveOrderIDs(ids...)
+	return _u
+}
+
+// RemoveOrders removes "orders" edges to Order entities.
+func (_u *StrategyUpdateOne) RemoveOrders(v ...*Order) *StrategyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveOrderIDs(ids...)
+}
+
+// ClearBacktests clears all "backtests" edges to the BacktestResult entity.
+func (_u *StrategyUpdateOne) ClearBacktests() *StrategyUpdateOne {
+	_u.mutation.ClearBacktests()
+	return _u
+}
+
+// RemoveBacktestIDs removes the "backtests" edge to BacktestResult entities by IDs.
+func (_u *StrategyUpdateOne) RemoveBacktestIDs(ids ...uuid.UUID) *StrategyUpdateOne {
+	_u.mutation.RemoveBacktestIDs(ids...)
+	return _u
+}
+
+// RemoveBacktests removes "backtests" edges to BacktestResult entities.
+func (_u *StrategyUpdateOne) RemoveBacktests(v ...*BacktestResult) *StrategyUpdateOne {
+	ids := make([]uuid.UUID, len(v))
+	for i := range v {
+		ids[i] = v[i].ID
+	}
+	return _u.RemoveBacktestIDs(ids...)
+}
+
 // ClearPerformance clears the "performance" edge to the StrategyPerformance entity.
 func (_u *StrategyUpdateOne) ClearPerformance() *StrategyUpdateOne {
 	_u.mutation.ClearPerformance()
@@ -879,6 +1202,16 @@ func (_u *StrategyUpdateOne) check() error {
 			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Strategy.symbol": %w`, err)}
 		}
 	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := strategy.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Strategy.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.TradingMode(); ok {
+		if err := strategy.TradingModeValidator(v); err != nil {
+			return &ValidationError{Name: "trading_mode", err: fmt.Errorf(`ent: validator failed for field "Strategy.trading_mode": %w`, err)}
+		}
+	}
 	if _u.mutation.UserCleared() && len(_u.mutation.UserIDs()) > 0 {
 		return errors.New(`ent: clearing a required unique edge "Strategy.user"`)
 	}
@@ -929,6 +1262,12 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := _u.mutation.Symbol(); ok {
 		_spec.SetField(strategy.FieldSymbol, field.TypeString, value)
 	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(strategy.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(strategy.FieldExchange, field.TypeString)
+	}
 	if value, ok := _u.mutation.UserInputs(); ok {
 		_spec.SetField(strategy.FieldUserInputs, field.TypeJSON, value)
 	}
@@ -938,6 +1277,9 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 	if value, ok := _u.mutation.Active(); ok {
 		_spec.SetField(strategy.FieldActive, field.TypeBool, value)
 	}
+	if value, ok := _u.mutation.TradingMode(); ok {
+		_spec.SetField(strategy.FieldTradingMode, field.TypeEnum, value)
+	}
 	if _u.mutation.CreatedAtCleared() {
 		_spec.ClearField(strategy.FieldCreatedAt, field.TypeTime)
 	}
@@ -1050,6 +1392,96 @@ func (_u *StrategyUpdateOne) sqlSave(ctx context.Context) (_node *Strategy, err
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if _u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedOrdersIDs(); len(nodes) > 0 && !_u.mutation.OrdersCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.OrdersIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.OrdersTable,
+			Columns: []string{strategy.OrdersColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(order.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if _u.mutation.BacktestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.RemovedBacktestsIDs(); len(nodes) > 0 && !_u.mutation.BacktestsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := _u.mutation.BacktestsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   strategy.BacktestsTable,
+			Columns: []string{strategy.BacktestsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(backtestresult.FieldID, field.TypeUUID),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if _u.mutation.PerformanceCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2O,
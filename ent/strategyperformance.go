@@ -3,8 +3,10 @@
 package ent
 
 import (
+	"auto-trader/ent/schema"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyperformance"
+	"encoding/json"
 	"fmt"
 	"strings"
 	"time"
@@ -36,6 +38,20 @@ type StrategyPerformance struct {
 	MaxDrawdown decimal.Decimal `json:"max_drawdown,omitempty"`
 	// SharpeRatio holds the value of the "sharpe_ratio" field.
 	SharpeRatio decimal.Decimal `json:"sharpe_ratio,omitempty"`
+	// ClosedTrades holds the value of the "closed_trades" field.
+	ClosedTrades int64 `json:"closed_trades,omitempty"`
+	// WinningTrades holds the value of the "winning_trades" field.
+	WinningTrades int64 `json:"winning_trades,omitempty"`
+	// ReturnSum holds the value of the "return_sum" field.
+	ReturnSum float64 `json:"return_sum,omitempty"`
+	// ReturnSqSum holds the value of the "return_sq_sum" field.
+	ReturnSqSum float64 `json:"return_sq_sum,omitempty"`
+	// EquityIndex holds the value of the "equity_index" field.
+	EquityIndex float64 `json:"equity_index,omitempty"`
+	// PeakEquityIndex holds the value of the "peak_equity_index" field.
+	PeakEquityIndex float64 `json:"peak_equity_index,omitempty"`
+	// OpenPositions holds the value of the "open_positions" field.
+	OpenPositions map[string]schema.OpenPosition `json:"open_positions,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -71,9 +87,13 @@ func (*StrategyPerformance) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
+		case strategyperformance.FieldOpenPositions:
+			values[i] = new([]byte)
 		case strategyperformance.FieldTotalReturn, strategyperformance.FieldWinRate, strategyperformance.FieldProfitLoss, strategyperformance.FieldMaxDrawdown, strategyperformance.FieldSharpeRatio:
 			values[i] = new(decimal.Decimal)
-		case strategyperformance.FieldID, strategyperformance.FieldTradeCount:
+		case strategyperformance.FieldReturnSum, strategyperformance.FieldReturnSqSum, strategyperformance.FieldEquityIndex, strategyperformance.FieldPeakEquityIndex:
+			values[i] = new(sql.NullFloat64)
+		case strategyperformance.FieldID, strategyperformance.FieldTradeCount, strategyperformance.FieldClosedTrades, strategyperformance.FieldWinningTrades:
 			values[i] = new(sql.NullInt64)
 		case strategyperformance.FieldLastTradeTime, strategyperformance.FieldCreatedAt, strategyperformance.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -149,6 +169,50 @@ func (_m *StrategyPerformance) assignValues(columns []string, values []any) erro
 			} else if value != nil {
 				_m.SharpeRatio = *value
 			}
+		case strategyperformance.FieldClosedTrades:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_trades", values[i])
+			} else if value.Valid {
+				_m.ClosedTrades = value.Int64
+			}
+		case strategyperformance.FieldWinningTrades:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field winning_trades", values[i])
+			} else if value.Valid {
+				_m.WinningTrades = value.Int64
+			}
+		case strategyperformance.FieldReturnSum:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field return_sum", values[i])
+			} else if value.Valid {
+				_m.ReturnSum = value.Float64
+			}
+		case strategyperformance.FieldReturnSqSum:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field return_sq_sum", values[i])
+			} else if value.Valid {
+				_m.ReturnSqSum = value.Float64
+			}
+		case strategyperformance.FieldEquityIndex:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field equity_index", values[i])
+			} else if value.Valid {
+				_m.EquityIndex = value.Float64
+			}
+		case strategyperformance.FieldPeakEquityIndex:
+			if value, ok := values[i].(*sql.NullFloat64); !ok {
+				return fmt.Errorf("unexpected type %T for field peak_equity_index", values[i])
+			} else if value.Valid {
+				_m.PeakEquityIndex = value.Float64
+			}
+		case strategyperformance.FieldOpenPositions:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field open_positions", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &_m.OpenPositions); err != nil {
+					return fmt.Errorf("unmarshal field open_positions: %w", err)
+				}
+			}
 		case strategyperformance.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -228,6 +292,27 @@ func (_m *StrategyPerformance) String() string {
 	builder.WriteString("sharpe_ratio=")
 	builder.WriteString(fmt.Sprintf("%v", _m.SharpeRatio))
 	builder.WriteString(", ")
+	builder.WriteString("closed_trades=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ClosedTrades))
+	builder.WriteString(", ")
+	builder.WriteString("winning_trades=")
+	builder.WriteString(fmt.Sprintf("%v", _m.WinningTrades))
+	builder.WriteString(", ")
+	builder.WriteString("return_sum=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReturnSum))
+	builder.WriteString(", ")
+	builder.WriteString("return_sq_sum=")
+	builder.WriteString(fmt.Sprintf("%v", _m.ReturnSqSum))
+	builder.WriteString(", ")
+	builder.WriteString("equity_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EquityIndex))
+	builder.WriteString(", ")
+	builder.WriteString("peak_equity_index=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PeakEquityIndex))
+	builder.WriteString(", ")
+	builder.WriteString("open_positions=")
+	builder.WriteString(fmt.Sprintf("%v", _m.OpenPositions))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -31,6 +31,20 @@ const (
 	FieldMaxDrawdown = "max_drawdown"
 	// FieldSharpeRatio holds the string denoting the sharpe_ratio field in the database.
 	FieldSharpeRatio = "sharpe_ratio"
+	// FieldClosedTrades holds the string denoting the closed_trades field in the database.
+	FieldClosedTrades = "closed_trades"
+	// FieldWinningTrades holds the string denoting the winning_trades field in the database.
+	FieldWinningTrades = "winning_trades"
+	// FieldReturnSum holds the string denoting the return_sum field in the database.
+	FieldReturnSum = "return_sum"
+	// FieldReturnSqSum holds the string denoting the return_sq_sum field in the database.
+	FieldReturnSqSum = "return_sq_sum"
+	// FieldEquityIndex holds the string denoting the equity_index field in the database.
+	FieldEquityIndex = "equity_index"
+	// FieldPeakEquityIndex holds the string denoting the peak_equity_index field in the database.
+	FieldPeakEquityIndex = "peak_equity_index"
+	// FieldOpenPositions holds the string denoting the open_positions field in the database.
+	FieldOpenPositions = "open_positions"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
@@ -59,6 +73,13 @@ var Columns = []string{
 	FieldLastTradeTime,
 	FieldMaxDrawdown,
 	FieldSharpeRatio,
+	FieldClosedTrades,
+	FieldWinningTrades,
+	FieldReturnSum,
+	FieldReturnSqSum,
+	FieldEquityIndex,
+	FieldPeakEquityIndex,
+	FieldOpenPositions,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
@@ -86,6 +107,18 @@ var (
 	DefaultMaxDrawdown decimal.Decimal
 	// DefaultSharpeRatio holds the default value on creation for the "sharpe_ratio" field.
 	DefaultSharpeRatio decimal.Decimal
+	// DefaultClosedTrades holds the default value on creation for the "closed_trades" field.
+	DefaultClosedTrades int64
+	// DefaultWinningTrades holds the default value on creation for the "winning_trades" field.
+	DefaultWinningTrades int64
+	// DefaultReturnSum holds the default value on creation for the "return_sum" field.
+	DefaultReturnSum float64
+	// DefaultReturnSqSum holds the default value on creation for the "return_sq_sum" field.
+	DefaultReturnSqSum float64
+	// DefaultEquityIndex holds the default value on creation for the "equity_index" field.
+	DefaultEquityIndex float64
+	// DefaultPeakEquityIndex holds the default value on creation for the "peak_equity_index" field.
+	DefaultPeakEquityIndex float64
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -142,6 +175,36 @@ func BySharpeRatio(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldSharpeRatio, opts...).ToFunc()
 }
 
+// ByClosedTrades orders the results by the closed_trades field.
+func ByClosedTrades(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedTrades, opts...).ToFunc()
+}
+
+// ByWinningTrades orders the results by the winning_trades field.
+func ByWinningTrades(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWinningTrades, opts...).ToFunc()
+}
+
+// ByReturnSum orders the results by the return_sum field.
+func ByReturnSum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnSum, opts...).ToFunc()
+}
+
+// ByReturnSqSum orders the results by the return_sq_sum field.
+func ByReturnSqSum(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReturnSqSum, opts...).ToFunc()
+}
+
+// ByEquityIndex orders the results by the equity_index field.
+func ByEquityIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEquityIndex, opts...).ToFunc()
+}
+
+// ByPeakEquityIndex orders the results by the peak_equity_index field.
+func ByPeakEquityIndex(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPeakEquityIndex, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
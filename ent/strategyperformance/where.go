@@ -97,6 +97,36 @@ func SharpeRatio(v decimal.Decimal) predicate.StrategyPerformance {
 	return predicate.StrategyPerformance(sql.FieldEQ(FieldSharpeRatio, v))
 }
 
+// ClosedTrades applies equality check predicate on the "closed_trades" field. It's identical to ClosedTradesEQ.
+func ClosedTrades(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldClosedTrades, v))
+}
+
+// WinningTrades applies equality check predicate on the "winning_trades" field. It's identical to WinningTradesEQ.
+func WinningTrades(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldWinningTrades, v))
+}
+
+// ReturnSum applies equality check predicate on the "return_sum" field. It's identical to ReturnSumEQ.
+func ReturnSum(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldReturnSum, v))
+}
+
+// ReturnSqSum applies equality check predicate on the "return_sq_sum" field. It's identical to ReturnSqSumEQ.
+func ReturnSqSum(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldReturnSqSum, v))
+}
+
+// EquityIndex applies equality check predicate on the "equity_index" field. It's identical to EquityIndexEQ.
+func EquityIndex(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldEquityIndex, v))
+}
+
+// PeakEquityIndex applies equality check predicate on the "peak_equity_index" field. It's identical to PeakEquityIndexEQ.
+func PeakEquityIndex(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldPeakEquityIndex, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.StrategyPerformance {
 	return predicate.StrategyPerformance(sql.FieldEQ(FieldCreatedAt, v))
@@ -417,6 +447,256 @@ func SharpeRatioLTE(v decimal.Decimal) predicate.StrategyPerformance {
 	return predicate.StrategyPerformance(sql.FieldLTE(FieldSharpeRatio, v))
 }
 
+// ClosedTradesEQ applies the EQ predicate on the "closed_trades" field.
+func ClosedTradesEQ(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldClosedTrades, v))
+}
+
+// ClosedTradesNEQ applies the NEQ predicate on the "closed_trades" field.
+func ClosedTradesNEQ(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldClosedTrades, v))
+}
+
+// ClosedTradesIn applies the In predicate on the "closed_trades" field.
+func ClosedTradesIn(vs ...int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldClosedTrades, vs...))
+}
+
+// ClosedTradesNotIn applies the NotIn predicate on the "closed_trades" field.
+func ClosedTradesNotIn(vs ...int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldClosedTrades, vs...))
+}
+
+// ClosedTradesGT applies the GT predicate on the "closed_trades" field.
+func ClosedTradesGT(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldClosedTrades, v))
+}
+
+// ClosedTradesGTE applies the GTE predicate on the "closed_trades" field.
+func ClosedTradesGTE(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldClosedTrades, v))
+}
+
+// ClosedTradesLT applies the LT predicate on the "closed_trades" field.
+func ClosedTradesLT(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldClosedTrades, v))
+}
+
+// ClosedTradesLTE applies the LTE predicate on the "closed_trades" field.
+func ClosedTradesLTE(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldClosedTrades, v))
+}
+
+// WinningTradesEQ applies the EQ predicate on the "winning_trades" field.
+func WinningTradesEQ(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldWinningTrades, v))
+}
+
+// WinningTradesNEQ applies the NEQ predicate on the "winning_trades" field.
+func WinningTradesNEQ(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldWinningTrades, v))
+}
+
+// WinningTradesIn applies the In predicate on the "winning_trades" field.
+func WinningTradesIn(vs ...int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldWinningTrades, vs...))
+}
+
+// WinningTradesNotIn applies the NotIn predicate on the "winning_trades" field.
+func WinningTradesNotIn(vs ...int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldWinningTrades, vs...))
+}
+
+// WinningTradesGT applies the GT predicate on the "winning_trades" field.
+func WinningTradesGT(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldWinningTrades, v))
+}
+
+// WinningTradesGTE applies the GTE predicate on the "winning_trades" field.
+func WinningTradesGTE(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldWinningTrades, v))
+}
+
+// WinningTradesLT applies the LT predicate on the "winning_trades" field.
+func WinningTradesLT(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldWinningTrades, v))
+}
+
+// WinningTradesLTE applies the LTE predicate on the "winning_trades" field.
+func WinningTradesLTE(v int64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldWinningTrades, v))
+}
+
+// ReturnSumEQ applies the EQ predicate on the "return_sum" field.
+func ReturnSumEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldReturnSum, v))
+}
+
+// ReturnSumNEQ applies the NEQ predicate on the "return_sum" field.
+func ReturnSumNEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldReturnSum, v))
+}
+
+// ReturnSumIn applies the In predicate on the "return_sum" field.
+func ReturnSumIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldReturnSum, vs...))
+}
+
+// ReturnSumNotIn applies the NotIn predicate on the "return_sum" field.
+func ReturnSumNotIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldReturnSum, vs...))
+}
+
+// ReturnSumGT applies the GT predicate on the "return_sum" field.
+func ReturnSumGT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldReturnSum, v))
+}
+
+// ReturnSumGTE applies the GTE predicate on the "return_sum" field.
+func ReturnSumGTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldReturnSum, v))
+}
+
+// ReturnSumLT applies the LT predicate on the "return_sum" field.
+func ReturnSumLT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldReturnSum, v))
+}
+
+// ReturnSumLTE applies the LTE predicate on the "return_sum" field.
+func ReturnSumLTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldReturnSum, v))
+}
+
+// ReturnSqSumEQ applies the EQ predicate on the "return_sq_sum" field.
+func ReturnSqSumEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldReturnSqSum, v))
+}
+
+// ReturnSqSumNEQ applies the NEQ predicate on the "return_sq_sum" field.
+func ReturnSqSumNEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldReturnSqSum, v))
+}
+
+// ReturnSqSumIn applies the In predicate on the "return_sq_sum" field.
+func ReturnSqSumIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldReturnSqSum, vs...))
+}
+
+// ReturnSqSumNotIn applies the NotIn predicate on the "return_sq_sum" field.
+func ReturnSqSumNotIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldReturnSqSum, vs...))
+}
+
+// ReturnSqSumGT applies the GT predicate on the "return_sq_sum" field.
+func ReturnSqSumGT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldReturnSqSum, v))
+}
+
+// ReturnSqSumGTE applies the GTE predicate on the "return_sq_sum" field.
+func ReturnSqSumGTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldReturnSqSum, v))
+}
+
+// ReturnSqSumLT applies the LT predicate on the "return_sq_sum" field.
+func ReturnSqSumLT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldReturnSqSum, v))
+}
+
+// ReturnSqSumLTE applies the LTE predicate on the "return_sq_sum" field.
+func ReturnSqSumLTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldReturnSqSum, v))
+}
+
+// EquityIndexEQ applies the EQ predicate on the "equity_index" field.
+func EquityIndexEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldEquityIndex, v))
+}
+
+// EquityIndexNEQ applies the NEQ predicate on the "equity_index" field.
+func EquityIndexNEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldEquityIndex, v))
+}
+
+// EquityIndexIn applies the In predicate on the "equity_index" field.
+func EquityIndexIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldEquityIndex, vs...))
+}
+
+// EquityIndexNotIn applies the NotIn predicate on the "equity_index" field.
+func EquityIndexNotIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldEquityIndex, vs...))
+}
+
+// EquityIndexGT applies the GT predicate on the "equity_index" field.
+func EquityIndexGT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldEquityIndex, v))
+}
+
+// EquityIndexGTE applies the GTE predicate on the "equity_index" field.
+func EquityIndexGTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldEquityIndex, v))
+}
+
+// EquityIndexLT applies the LT predicate on the "equity_index" field.
+func EquityIndexLT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldEquityIndex, v))
+}
+
+// EquityIndexLTE applies the LTE predicate on the "equity_index" field.
+func EquityIndexLTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldEquityIndex, v))
+}
+
+// PeakEquityIndexEQ applies the EQ predicate on the "peak_equity_index" field.
+func PeakEquityIndexEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldEQ(FieldPeakEquityIndex, v))
+}
+
+// PeakEquityIndexNEQ applies the NEQ predicate on the "peak_equity_index" field.
+func PeakEquityIndexNEQ(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNEQ(FieldPeakEquityIndex, v))
+}
+
+// PeakEquityIndexIn applies the In predicate on the "peak_equity_index" field.
+func PeakEquityIndexIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIn(FieldPeakEquityIndex, vs...))
+}
+
+// PeakEquityIndexNotIn applies the NotIn predicate on the "peak_equity_index" field.
+func PeakEquityIndexNotIn(vs ...float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotIn(FieldPeakEquityIndex, vs...))
+}
+
+// PeakEquityIndexGT applies the GT predicate on the "peak_equity_index" field.
+func PeakEquityIndexGT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGT(FieldPeakEquityIndex, v))
+}
+
+// PeakEquityIndexGTE applies the GTE predicate on the "peak_equity_index" field.
+func PeakEquityIndexGTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldGTE(FieldPeakEquityIndex, v))
+}
+
+// PeakEquityIndexLT applies the LT predicate on the "peak_equity_index" field.
+func PeakEquityIndexLT(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLT(FieldPeakEquityIndex, v))
+}
+
+// PeakEquityIndexLTE applies the LTE predicate on the "peak_equity_index" field.
+func PeakEquityIndexLTE(v float64) predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldLTE(FieldPeakEquityIndex, v))
+}
+
+// OpenPositionsIsNil applies the IsNil predicate on the "open_positions" field.
+func OpenPositionsIsNil() predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldIsNull(FieldOpenPositions))
+}
+
+// OpenPositionsNotNil applies the NotNil predicate on the "open_positions" field.
+func OpenPositionsNotNil() predicate.StrategyPerformance {
+	return predicate.StrategyPerformance(sql.FieldNotNull(FieldOpenPositions))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.StrategyPerformance {
 	return predicate.StrategyPerformance(sql.FieldEQ(FieldCreatedAt, v))
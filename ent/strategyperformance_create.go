@@ -3,6 +3,7 @@
 package ent
 
 import (
+	"auto-trader/ent/schema"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyperformance"
 	"context"
@@ -127,6 +128,96 @@ func (_c *StrategyPerformanceCreate) SetNillableSharpeRatio(v *decimal.Decimal)
 	return _c
 }
 
+// SetClosedTrades sets the "closed_trades" field.
+func (_c *StrategyPerformanceCreate) SetClosedTrades(v int64) *StrategyPerformanceCreate {
+	_c.mutation.SetClosedTrades(v)
+	return _c
+}
+
+// SetNillableClosedTrades sets the "closed_trades" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillableClosedTrades(v *int64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetClosedTrades(*v)
+	}
+	return _c
+}
+
+// SetWinningTrades sets the "winning_trades" field.
+func (_c *StrategyPerformanceCreate) SetWinningTrades(v int64) *StrategyPerformanceCreate {
+	_c.mutation.SetWinningTrades(v)
+	return _c
+}
+
+// SetNillableWinningTrades sets the "winning_trades" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillableWinningTrades(v *int64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetWinningTrades(*v)
+	}
+	return _c
+}
+
+// SetReturnSum sets the "return_sum" field.
+func (_c *StrategyPerformanceCreate) SetReturnSum(v float64) *StrategyPerformanceCreate {
+	_c.mutation.SetReturnSum(v)
+	return _c
+}
+
+// SetNillableReturnSum sets the "return_sum" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillableReturnSum(v *float64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetReturnSum(*v)
+	}
+	return _c
+}
+
+// SetReturnSqSum sets the "return_sq_sum" field.
+func (_c *StrategyPerformanceCreate) SetReturnSqSum(v float64) *StrategyPerformanceCreate {
+	_c.mutation.SetReturnSqSum(v)
+	return _c
+}
+
+// SetNillableReturnSqSum sets the "return_sq_sum" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillableReturnSqSum(v *float64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetReturnSqSum(*v)
+	}
+	return _c
+}
+
+// SetEquityIndex sets the "equity_index" field.
+func (_c *StrategyPerformanceCreate) SetEquityIndex(v float64) *StrategyPerformanceCreate {
+	_c.mutation.SetEquityIndex(v)
+	return _c
+}
+
+// SetNillableEquityIndex sets the "equity_index" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillableEquityIndex(v *float64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetEquityIndex(*v)
+	}
+	return _c
+}
+
+// SetPeakEquityIndex sets the "peak_equity_index" field.
+func (_c *StrategyPerformanceCreate) SetPeakEquityIndex(v float64) *StrategyPerformanceCreate {
+	_c.mutation.SetPeakEquityIndex(v)
+	return _c
+}
+
+// SetNillablePeakEquityIndex sets the "peak_equity_index" field if the given value is not nil.
+func (_c *StrategyPerformanceCreate) SetNillablePeakEquityIndex(v *float64) *StrategyPerformanceCreate {
+	if v != nil {
+		_c.SetPeakEquityIndex(*v)
+	}
+	return _c
+}
+
+// SetOpenPositions sets the "open_positions" field.
+func (_c *StrategyPerformanceCreate) SetOpenPositions(v map[string]schema.OpenPosition) *StrategyPerformanceCreate {
+	_c.mutation.SetOpenPositions(v)
+	return _c
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (_c *StrategyPerformanceCreate) SetCreatedAt(v time.Time) *StrategyPerformanceCreate {
 	_c.mutation.SetCreatedAt(v)
@@ -219,6 +310,30 @@ func (_c *StrategyPerformanceCreate) defaults() {
 		v := strategyperformance.DefaultSharpeRatio
 		_c.mutation.SetSharpeRatio(v)
 	}
+	if _, ok := _c.mutation.ClosedTrades(); !ok {
+		v := strategyperformance.DefaultClosedTrades
+		_c.mutation.SetClosedTrades(v)
+	}
+	if _, ok := _c.mutation.WinningTrades(); !ok {
+		v := strategyperformance.DefaultWinningTrades
+		_c.mutation.SetWinningTrades(v)
+	}
+	if _, ok := _c.mutation.ReturnSum(); !ok {
+		v := strategyperformance.DefaultReturnSum
+		_c.mutation.SetReturnSum(v)
+	}
+	if _, ok := _c.mutation.ReturnSqSum(); !ok {
+		v := strategyperformance.DefaultReturnSqSum
+		_c.mutation.SetReturnSqSum(v)
+	}
+	if _, ok := _c.mutation.EquityIndex(); !ok {
+		v := strategyperformance.DefaultEquityIndex
+		_c.mutation.SetEquityIndex(v)
+	}
+	if _, ok := _c.mutation.PeakEquityIndex(); !ok {
+		v := strategyperformance.DefaultPeakEquityIndex
+		_c.mutation.SetPeakEquityIndex(v)
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		v := strategyperformance.DefaultCreatedAt()
 		_c.mutation.SetCreatedAt(v)
@@ -252,6 +367,24 @@ func (_c *StrategyPerformanceCreate) check() error {
 	if _, ok := _c.mutation.SharpeRatio(); !ok {
 		return &ValidationError{Name: "sharpe_ratio", err: errors.New(`ent: missing required field "StrategyPerformance.sharpe_ratio"`)}
 	}
+	if _, ok := _c.mutation.ClosedTrades(); !ok {
+		return &ValidationError{Name: "closed_trades", err: errors.New(`ent: missing required field "StrategyPerformance.closed_trades"`)}
+	}
+	if _, ok := _c.mutation.WinningTrades(); !ok {
+		return &ValidationError{Name: "winning_trades", err: errors.New(`ent: missing required field "StrategyPerformance.winning_trades"`)}
+	}
+	if _, ok := _c.mutation.ReturnSum(); !ok {
+		return &ValidationError{Name: "return_sum", err: errors.New(`ent: missing required field "StrategyPerformance.return_sum"`)}
+	}
+	if _, ok := _c.mutation.ReturnSqSum(); !ok {
+		return &ValidationError{Name: "return_sq_sum", err: errors.New(`ent: missing required field "StrategyPerformance.return_sq_sum"`)}
+	}
+	if _, ok := _c.mutation.EquityIndex(); !ok {
+		return &ValidationError{Name: "equity_index", err: errors.New(`ent: missing required field "StrategyPerformance.equity_index"`)}
+	}
+	if _, ok := _c.mutation.PeakEquityIndex(); !ok {
+		return &ValidationError{Name: "peak_equity_index", err: errors.New(`ent: missing required field "StrategyPerformance.peak_equity_index"`)}
+	}
 	if _, ok := _c.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "StrategyPerformance.created_at"`)}
 	}
@@ -315,6 +448,34 @@ func (_c *StrategyPerformanceCreate) createSpec() (*StrategyPerformance, *sqlgra
 		_spec.SetField(strategyperformance.FieldSharpeRatio, field.TypeOther, value)
 		_node.SharpeRatio = value
 	}
+	if value, ok := _c.mutation.ClosedTrades(); ok {
+		_spec.SetField(strategyperformance.FieldClosedTrades, field.TypeInt64, value)
+		_node.ClosedTrades = value
+	}
+	if value, ok := _c.mutation.WinningTrades(); ok {
+		_spec.SetField(strategyperformance.FieldWinningTrades, field.TypeInt64, value)
+		_node.WinningTrades = value
+	}
+	if value, ok := _c.mutation.ReturnSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSum, field.TypeFloat64, value)
+		_node.ReturnSum = value
+	}
+	if value, ok := _c.mutation.ReturnSqSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSqSum, field.TypeFloat64, value)
+		_node.ReturnSqSum = value
+	}
+	if value, ok := _c.mutation.EquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldEquityIndex, field.TypeFloat64, value)
+		_node.EquityIndex = value
+	}
+	if value, ok := _c.mutation.PeakEquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldPeakEquityIndex, field.TypeFloat64, value)
+		_node.PeakEquityIndex = value
+	}
+	if value, ok := _c.mutation.OpenPositions(); ok {
+		_spec.SetField(strategyperformance.FieldOpenPositions, field.TypeJSON, value)
+		_node.OpenPositions = value
+	}
 	if value, ok := _c.mutation.CreatedAt(); ok {
 		_spec.SetField(strategyperformance.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
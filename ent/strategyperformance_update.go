@@ -4,6 +4,7 @@ package ent
 
 import (
 	"auto-trader/ent/predicate"
+	"auto-trader/ent/schema"
 	"auto-trader/ent/strategy"
 	"auto-trader/ent/strategyperformance"
 	"context"
@@ -156,6 +157,144 @@ func (_u *StrategyPerformanceUpdate) SetNillableSharpeRatio(v *decimal.Decimal)
 	return _u
 }
 
+// SetClosedTrades sets the "closed_trades" field.
+func (_u *StrategyPerformanceUpdate) SetClosedTrades(v int64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetClosedTrades()
+	_u.mutation.SetClosedTrades(v)
+	return _u
+}
+
+// SetNillableClosedTrades sets the "closed_trades" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillableClosedTrades(v *int64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetClosedTrades(*v)
+	}
+	return _u
+}
+
+// AddClosedTrades adds value to the "closed_trades" field.
+func (_u *StrategyPerformanceUpdate) AddClosedTrades(v int64) *StrategyPerformanceUpdate {
+	_u.mutation.AddClosedTrades(v)
+	return _u
+}
+
+// SetWinningTrades sets the "winning_trades" field.
+func (_u *StrategyPerformanceUpdate) SetWinningTrades(v int64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetWinningTrades()
+	_u.mutation.SetWinningTrades(v)
+	return _u
+}
+
+// SetNillableWinningTrades sets the "winning_trades" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillableWinningTrades(v *int64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetWinningTrades(*v)
+	}
+	return _u
+}
+
+// AddWinningTrades adds value to the "winning_trades" field.
+func (_u *StrategyPerformanceUpdate) AddWinningTrades(v int64) *StrategyPerformanceUpdate {
+	_u.mutation.AddWinningTrades(v)
+	return _u
+}
+
+// SetReturnSum sets the "return_sum" field.
+func (_u *StrategyPerformanceUpdate) SetReturnSum(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetReturnSum()
+	_u.mutation.SetReturnSum(v)
+	return _u
+}
+
+// SetNillableReturnSum sets the "return_sum" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillableReturnSum(v *float64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetReturnSum(*v)
+	}
+	return _u
+}
+
+// AddReturnSum adds value to the "return_sum" field.
+func (_u *StrategyPerformanceUpdate) AddReturnSum(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.AddReturnSum(v)
+	return _u
+}
+
+// SetReturnSqSum sets the "return_sq_sum" field.
+func (_u *StrategyPerformanceUpdate) SetReturnSqSum(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetReturnSqSum()
+	_u.mutation.SetReturnSqSum(v)
+	return _u
+}
+
+// SetNillableReturnSqSum sets the "return_sq_sum" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillableReturnSqSum(v *float64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetReturnSqSum(*v)
+	}
+	return _u
+}
+
+// AddReturnSqSum adds value to the "return_sq_sum" field.
+func (_u *StrategyPerformanceUpdate) AddReturnSqSum(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.AddReturnSqSum(v)
+	return _u
+}
+
+// SetEquityIndex sets the "equity_index" field.
+func (_u *StrategyPerformanceUpdate) SetEquityIndex(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetEquityIndex()
+	_u.mutation.SetEquityIndex(v)
+	return _u
+}
+
+// SetNillableEquityIndex sets the "equity_index" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillableEquityIndex(v *float64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetEquityIndex(*v)
+	}
+	return _u
+}
+
+// AddEquityIndex adds value to the "equity_index" field.
+func (_u *StrategyPerformanceUpdate) AddEquityIndex(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.AddEquityIndex(v)
+	return _u
+}
+
+// SetPeakEquityIndex sets the "peak_equity_index" field.
+func (_u *StrategyPerformanceUpdate) SetPeakEquityIndex(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.ResetPeakEquityIndex()
+	_u.mutation.SetPeakEquityIndex(v)
+	return _u
+}
+
+// SetNillablePeakEquityIndex sets the "peak_equity_index" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdate) SetNillablePeakEquityIndex(v *float64) *StrategyPerformanceUpdate {
+	if v != nil {
+		_u.SetPeakEquityIndex(*v)
+	}
+	return _u
+}
+
+// AddPeakEquityIndex adds value to the "peak_equity_index" field.
+func (_u *StrategyPerformanceUpdate) AddPeakEquityIndex(v float64) *StrategyPerformanceUpdate {
+	_u.mutation.AddPeakEquityIndex(v)
+	return _u
+}
+
+// SetOpenPositions sets the "open_positions" field.
+func (_u *StrategyPerformanceUpdate) SetOpenPositions(v map[string]schema.OpenPosition) *StrategyPerformanceUpdate {
+	_u.mutation.SetOpenPositions(v)
+	return _u
+}
+
+// ClearOpenPositions clears the value of the "open_positions" field.
+func (_u *StrategyPerformanceUpdate) ClearOpenPositions() *StrategyPerformanceUpdate {
+	_u.mutation.ClearOpenPositions()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *StrategyPerformanceUpdate) SetUpdatedAt(v time.Time) *StrategyPerformanceUpdate {
 	_u.mutation.SetUpdatedAt(v)
@@ -261,6 +400,48 @@ func (_u *StrategyPerformanceUpdate) sqlSave(ctx context.Context) (_node int, er
 	if value, ok := _u.mutation.SharpeRatio(); ok {
 		_spec.SetField(strategyperformance.FieldSharpeRatio, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.ClosedTrades(); ok {
+		_spec.SetField(strategyperformance.FieldClosedTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClosedTrades(); ok {
+		_spec.AddField(strategyperformance.FieldClosedTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WinningTrades(); ok {
+		_spec.SetField(strategyperformance.FieldWinningTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWinningTrades(); ok {
+		_spec.AddField(strategyperformance.FieldWinningTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ReturnSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReturnSum(); ok {
+		_spec.AddField(strategyperformance.FieldReturnSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReturnSqSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSqSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReturnSqSum(); ok {
+		_spec.AddField(strategyperformance.FieldReturnSqSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEquityIndex(); ok {
+		_spec.AddField(strategyperformance.FieldEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PeakEquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldPeakEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPeakEquityIndex(); ok {
+		_spec.AddField(strategyperformance.FieldPeakEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OpenPositions(); ok {
+		_spec.SetField(strategyperformance.FieldOpenPositions, field.TypeJSON, value)
+	}
+	if _u.mutation.OpenPositionsCleared() {
+		_spec.ClearField(strategyperformance.FieldOpenPositions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(strategyperformance.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -438,6 +619,144 @@ func (_u *StrategyPerformanceUpdateOne) SetNillableSharpeRatio(v *decimal.Decima
 	return _u
 }
 
+// SetClosedTrades sets the "closed_trades" field.
+func (_u *StrategyPerformanceUpdateOne) SetClosedTrades(v int64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetClosedTrades()
+	_u.mutation.SetClosedTrades(v)
+	return _u
+}
+
+// SetNillableClosedTrades sets the "closed_trades" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillableClosedTrades(v *int64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetClosedTrades(*v)
+	}
+	return _u
+}
+
+// AddClosedTrades adds value to the "closed_trades" field.
+func (_u *StrategyPerformanceUpdateOne) AddClosedTrades(v int64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddClosedTrades(v)
+	return _u
+}
+
+// SetWinningTrades sets the "winning_trades" field.
+func (_u *StrategyPerformanceUpdateOne) SetWinningTrades(v int64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetWinningTrades()
+	_u.mutation.SetWinningTrades(v)
+	return _u
+}
+
+// SetNillableWinningTrades sets the "winning_trades" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillableWinningTrades(v *int64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetWinningTrades(*v)
+	}
+	return _u
+}
+
+// AddWinningTrades adds value to the "winning_trades" field.
+func (_u *StrategyPerformanceUpdateOne) AddWinningTrades(v int64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddWinningTrades(v)
+	return _u
+}
+
+// SetReturnSum sets the "return_sum" field.
+func (_u *StrategyPerformanceUpdateOne) SetReturnSum(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetReturnSum()
+	_u.mutation.SetReturnSum(v)
+	return _u
+}
+
+// SetNillableReturnSum sets the "return_sum" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillableReturnSum(v *float64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetReturnSum(*v)
+	}
+	return _u
+}
+
+// AddReturnSum adds value to the "return_sum" field.
+func (_u *StrategyPerformanceUpdateOne) AddReturnSum(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddReturnSum(v)
+	return _u
+}
+
+// SetReturnSqSum sets the "return_sq_sum" field.
+func (_u *StrategyPerformanceUpdateOne) SetReturnSqSum(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetReturnSqSum()
+	_u.mutation.SetReturnSqSum(v)
+	return _u
+}
+
+// SetNillableReturnSqSum sets the "return_sq_sum" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillableReturnSqSum(v *float64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetReturnSqSum(*v)
+	}
+	return _u
+}
+
+// AddReturnSqSum adds value to the "return_sq_sum" field.
+func (_u *StrategyPerformanceUpdateOne) AddReturnSqSum(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddReturnSqSum(v)
+	return _u
+}
+
+// SetEquityIndex sets the "equity_index" field.
+func (_u *StrategyPerformanceUpdateOne) SetEquityIndex(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetEquityIndex()
+	_u.mutation.SetEquityIndex(v)
+	return _u
+}
+
+// SetNillableEquityIndex sets the "equity_index" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillableEquityIndex(v *float64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetEquityIndex(*v)
+	}
+	return _u
+}
+
+// AddEquityIndex adds value to the "equity_index" field.
+func (_u *StrategyPerformanceUpdateOne) AddEquityIndex(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddEquityIndex(v)
+	return _u
+}
+
+// SetPeakEquityIndex sets the "peak_equity_index" field.
+func (_u *StrategyPerformanceUpdateOne) SetPeakEquityIndex(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.ResetPeakEquityIndex()
+	_u.mutation.SetPeakEquityIndex(v)
+	return _u
+}
+
+// SetNillablePeakEquityIndex sets the "peak_equity_index" field if the given value is not nil.
+func (_u *StrategyPerformanceUpdateOne) SetNillablePeakEquityIndex(v *float64) *StrategyPerformanceUpdateOne {
+	if v != nil {
+		_u.SetPeakEquityIndex(*v)
+	}
+	return _u
+}
+
+// AddPeakEquityIndex adds value to the "peak_equity_index" field.
+func (_u *StrategyPerformanceUpdateOne) AddPeakEquityIndex(v float64) *StrategyPerformanceUpdateOne {
+	_u.mutation.AddPeakEquityIndex(v)
+	return _u
+}
+
+// SetOpenPositions sets the "open_positions" field.
+func (_u *StrategyPerformanceUpdateOne) SetOpenPositions(v map[string]schema.OpenPosition) *StrategyPerformanceUpdateOne {
+	_u.mutation.SetOpenPositions(v)
+	return _u
+}
+
+// ClearOpenPositions clears the value of the "open_positions" field.
+func (_u *StrategyPerformanceUpdateOne) ClearOpenPositions() *StrategyPerformanceUpdateOne {
+	_u.mutation.ClearOpenPositions()
+	return _u
+}
+
 // SetUpdatedAt sets the "updated_at" field.
 func (_u *StrategyPerformanceUpdateOne) SetUpdatedAt(v time.Time) *StrategyPerformanceUpdateOne {
 	_u.mutation.SetUpdatedAt(v)
@@ -573,6 +892,48 @@ func (_u *StrategyPerformanceUpdateOne) sqlSave(ctx context.Context) (_node *Str
 	if value, ok := _u.mutation.SharpeRatio(); ok {
 		_spec.SetField(strategyperformance.FieldSharpeRatio, field.TypeOther, value)
 	}
+	if value, ok := _u.mutation.ClosedTrades(); ok {
+		_spec.SetField(strategyperformance.FieldClosedTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedClosedTrades(); ok {
+		_spec.AddField(strategyperformance.FieldClosedTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.WinningTrades(); ok {
+		_spec.SetField(strategyperformance.FieldWinningTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.AddedWinningTrades(); ok {
+		_spec.AddField(strategyperformance.FieldWinningTrades, field.TypeInt64, value)
+	}
+	if value, ok := _u.mutation.ReturnSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReturnSum(); ok {
+		_spec.AddField(strategyperformance.FieldReturnSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.ReturnSqSum(); ok {
+		_spec.SetField(strategyperformance.FieldReturnSqSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedReturnSqSum(); ok {
+		_spec.AddField(strategyperformance.FieldReturnSqSum, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.EquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedEquityIndex(); ok {
+		_spec.AddField(strategyperformance.FieldEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.PeakEquityIndex(); ok {
+		_spec.SetField(strategyperformance.FieldPeakEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.AddedPeakEquityIndex(); ok {
+		_spec.AddField(strategyperformance.FieldPeakEquityIndex, field.TypeFloat64, value)
+	}
+	if value, ok := _u.mutation.OpenPositions(); ok {
+		_spec.SetField(strategyperformance.FieldOpenPositions, field.TypeJSON, value)
+	}
+	if _u.mutation.OpenPositionsCleared() {
+		_spec.ClearField(strategyperformance.FieldOpenPositions, field.TypeJSON)
+	}
 	if value, ok := _u.mutation.UpdatedAt(); ok {
 		_spec.SetField(strategyperformance.FieldUpdatedAt, field.TypeTime, value)
 	}
@@ -0,0 +1,213 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/symbol"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Symbol is the model entity for the Symbol schema.
+type Symbol struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// Ticker holds the value of the "ticker" field.
+	Ticker string `json:"ticker,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange symbol.Exchange `json:"exchange,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// SecurityType holds the value of the "security_type" field.
+	SecurityType symbol.SecurityType `json:"security_type,omitempty"`
+	// LotSize holds the value of the "lot_size" field.
+	LotSize int `json:"lot_size,omitempty"`
+	// TickSize holds the value of the "tick_size" field.
+	TickSize decimal.Decimal `json:"tick_size,omitempty"`
+	// Tradable holds the value of the "tradable" field.
+	Tradable bool `json:"tradable,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Symbol) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case symbol.FieldTickSize:
+			values[i] = new(decimal.Decimal)
+		case symbol.FieldTradable:
+			values[i] = new(sql.NullBool)
+		case symbol.FieldLotSize:
+			values[i] = new(sql.NullInt64)
+		case symbol.FieldTicker, symbol.FieldExchange, symbol.FieldCurrency, symbol.FieldName, symbol.FieldSecurityType:
+			values[i] = new(sql.NullString)
+		case symbol.FieldCreatedAt, symbol.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case symbol.FieldID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Symbol fields.
+func (_m *Symbol) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case symbol.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case symbol.FieldTicker:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field ticker", values[i])
+			} else if value.Valid {
+				_m.Ticker = value.String
+			}
+		case symbol.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = symbol.Exchange(value.String)
+			}
+		case symbol.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case symbol.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				_m.Name = value.String
+			}
+		case symbol.FieldSecurityType:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field security_type", values[i])
+			} else if value.Valid {
+				_m.SecurityType = symbol.SecurityType(value.String)
+			}
+		case symbol.FieldLotSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field lot_size", values[i])
+			} else if value.Valid {
+				_m.LotSize = int(value.Int64)
+			}
+		case symbol.FieldTickSize:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field tick_size", values[i])
+			} else if value != nil {
+				_m.TickSize = *value
+			}
+		case symbol.FieldTradable:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field tradable", values[i])
+			} else if value.Valid {
+				_m.Tradable = value.Bool
+			}
+		case symbol.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		case symbol.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Symbol.
+// This includes values selected through modifiers, order, etc.
+func (_m *Symbol) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Symbol.
+// Note that you need to call Symbol.Unwrap() before calling this method if this Symbol
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Symbol) Update() *SymbolUpdateOne {
+	return NewSymbolClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Symbol entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Symbol) Unwrap() *Symbol {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Symbol is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Symbol) String() string {
+	var builder strings.Builder
+	builder.WriteString("Symbol(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("ticker=")
+	builder.WriteString(_m.Ticker)
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Exchange))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(_m.Name)
+	builder.WriteString(", ")
+	builder.WriteString("security_type=")
+	builder.WriteString(fmt.Sprintf("%v", _m.SecurityType))
+	builder.WriteString(", ")
+	builder.WriteString("lot_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.LotSize))
+	builder.WriteString(", ")
+	builder.WriteString("tick_size=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TickSize))
+	builder.WriteString(", ")
+	builder.WriteString("tradable=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Tradable))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Symbols is a parsable slice of Symbol.
+type Symbols []*Symbol
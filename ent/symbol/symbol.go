@@ -0,0 +1,204 @@
+// Code generated by ent, DO NOT EDIT.
+
+package symbol
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the symbol type in the database.
+	Label = "symbol"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldTicker holds the string denoting the ticker field in the database.
+	FieldTicker = "ticker"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldName holds the string denoting the name field in the database.
+	FieldName = "name"
+	// FieldSecurityType holds the string denoting the security_type field in the database.
+	FieldSecurityType = "security_type"
+	// FieldLotSize holds the string denoting the lot_size field in the database.
+	FieldLotSize = "lot_size"
+	// FieldTickSize holds the string denoting the tick_size field in the database.
+	FieldTickSize = "tick_size"
+	// FieldTradable holds the string denoting the tradable field in the database.
+	FieldTradable = "tradable"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the symbol in the database.
+	Table = "symbols"
+)
+
+// Columns holds all SQL columns for symbol fields.
+var Columns = []string{
+	FieldID,
+	FieldTicker,
+	FieldExchange,
+	FieldCurrency,
+	FieldName,
+	FieldSecurityType,
+	FieldLotSize,
+	FieldTickSize,
+	FieldTradable,
+	FieldCreatedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// TickerValidator is a validator for the "ticker" field. It is called by the builders before save.
+	TickerValidator func(string) error
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultName holds the default value on creation for the "name" field.
+	DefaultName string
+	// NameValidator is a validator for the "name" field. It is called by the builders before save.
+	NameValidator func(string) error
+	// DefaultLotSize holds the default value on creation for the "lot_size" field.
+	DefaultLotSize int
+	// DefaultTickSize holds the default value on creation for the "tick_size" field.
+	DefaultTickSize decimal.Decimal
+	// DefaultTradable holds the default value on creation for the "tradable" field.
+	DefaultTradable bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Exchange defines the type for the "exchange" enum field.
+type Exchange string
+
+// Exchange values.
+const (
+	ExchangeNASD Exchange = "NASD"
+	ExchangeNYSE Exchange = "NYSE"
+	ExchangeAMEX Exchange = "AMEX"
+)
+
+func (e Exchange) String() string {
+	return string(e)
+}
+
+// ExchangeValidator is a validator for the "exchange" field enum values. It is called by the builders before save.
+func ExchangeValidator(e Exchange) error {
+	switch e {
+	case ExchangeNASD, ExchangeNYSE, ExchangeAMEX:
+		return nil
+	default:
+		return fmt.Errorf("symbol: invalid enum value for exchange field: %q", e)
+	}
+}
+
+// SecurityType defines the type for the "security_type" enum field.
+type SecurityType string
+
+// SecurityTypeSTOCK is the default value of the SecurityType enum.
+const DefaultSecurityType = SecurityTypeSTOCK
+
+// SecurityType values.
+const (
+	SecurityTypeSTOCK   SecurityType = "STOCK"
+	SecurityTypeETF     SecurityType = "ETF"
+	SecurityTypeINDEX   SecurityType = "INDEX"
+	SecurityTypeWARRANT SecurityType = "WARRANT"
+	SecurityTypeOTHER   SecurityType = "OTHER"
+)
+
+func (st SecurityType) String() string {
+	return string(st)
+}
+
+// SecurityTypeValidator is a validator for the "security_type" field enum values. It is called by the builders before save.
+func SecurityTypeValidator(st SecurityType) error {
+	switch st {
+	case SecurityTypeSTOCK, SecurityTypeETF, SecurityTypeINDEX, SecurityTypeWARRANT, SecurityTypeOTHER:
+		return nil
+	default:
+		return fmt.Errorf("symbol: invalid enum value for security_type field: %q", st)
+	}
+}
+
+// OrderOption defines the ordering options for the Symbol queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByTicker orders the results by the ticker field.
+func ByTicker(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTicker, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByName orders the results by the name field.
+func ByName(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldName, opts...).ToFunc()
+}
+
+// BySecurityType orders the results by the security_type field.
+func BySecurityType(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSecurityType, opts...).ToFunc()
+}
+
+// ByLotSize orders the results by the lot_size field.
+func ByLotSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLotSize, opts...).ToFunc()
+}
+
+// ByTickSize orders the results by the tick_size field.
+func ByTickSize(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTickSize, opts...).ToFunc()
+}
+
+// ByTradable orders the results by the tradable field.
+func ByTradable(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradable, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,517 @@
+// Code generated by ent, DO NOT EDIT.
+
+package symbol
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldID, id))
+}
+
+// Ticker applies equality check predicate on the "ticker" field. It's identical to TickerEQ.
+func Ticker(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTicker, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldCurrency, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldName, v))
+}
+
+// LotSize applies equality check predicate on the "lot_size" field. It's identical to LotSizeEQ.
+func LotSize(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldLotSize, v))
+}
+
+// TickSize applies equality check predicate on the "tick_size" field. It's identical to TickSizeEQ.
+func TickSize(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTickSize, v))
+}
+
+// Tradable applies equality check predicate on the "tradable" field. It's identical to TradableEQ.
+func Tradable(v bool) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTradable, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// TickerEQ applies the EQ predicate on the "ticker" field.
+func TickerEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTicker, v))
+}
+
+// TickerNEQ applies the NEQ predicate on the "ticker" field.
+func TickerNEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldTicker, v))
+}
+
+// TickerIn applies the In predicate on the "ticker" field.
+func TickerIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldTicker, vs...))
+}
+
+// TickerNotIn applies the NotIn predicate on the "ticker" field.
+func TickerNotIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldTicker, vs...))
+}
+
+// TickerGT applies the GT predicate on the "ticker" field.
+func TickerGT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldTicker, v))
+}
+
+// TickerGTE applies the GTE predicate on the "ticker" field.
+func TickerGTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldTicker, v))
+}
+
+// TickerLT applies the LT predicate on the "ticker" field.
+func TickerLT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldTicker, v))
+}
+
+// TickerLTE applies the LTE predicate on the "ticker" field.
+func TickerLTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldTicker, v))
+}
+
+// TickerContains applies the Contains predicate on the "ticker" field.
+func TickerContains(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContains(FieldTicker, v))
+}
+
+// TickerHasPrefix applies the HasPrefix predicate on the "ticker" field.
+func TickerHasPrefix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasPrefix(FieldTicker, v))
+}
+
+// TickerHasSuffix applies the HasSuffix predicate on the "ticker" field.
+func TickerHasSuffix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasSuffix(FieldTicker, v))
+}
+
+// TickerEqualFold applies the EqualFold predicate on the "ticker" field.
+func TickerEqualFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEqualFold(FieldTicker, v))
+}
+
+// TickerContainsFold applies the ContainsFold predicate on the "ticker" field.
+func TickerContainsFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContainsFold(FieldTicker, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v Exchange) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v Exchange) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...Exchange) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...Exchange) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.Symbol {
+	return predicate.Symbol(sql.FieldContainsFold(FieldName, v))
+}
+
+// SecurityTypeEQ applies the EQ predicate on the "security_type" field.
+func SecurityTypeEQ(v SecurityType) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldSecurityType, v))
+}
+
+// SecurityTypeNEQ applies the NEQ predicate on the "security_type" field.
+func SecurityTypeNEQ(v SecurityType) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldSecurityType, v))
+}
+
+// SecurityTypeIn applies the In predicate on the "security_type" field.
+func SecurityTypeIn(vs ...SecurityType) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldSecurityType, vs...))
+}
+
+// SecurityTypeNotIn applies the NotIn predicate on the "security_type" field.
+func SecurityTypeNotIn(vs ...SecurityType) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldSecurityType, vs...))
+}
+
+// LotSizeEQ applies the EQ predicate on the "lot_size" field.
+func LotSizeEQ(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldLotSize, v))
+}
+
+// LotSizeNEQ applies the NEQ predicate on the "lot_size" field.
+func LotSizeNEQ(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldLotSize, v))
+}
+
+// LotSizeIn applies the In predicate on the "lot_size" field.
+func LotSizeIn(vs ...int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldLotSize, vs...))
+}
+
+// LotSizeNotIn applies the NotIn predicate on the "lot_size" field.
+func LotSizeNotIn(vs ...int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldLotSize, vs...))
+}
+
+// LotSizeGT applies the GT predicate on the "lot_size" field.
+func LotSizeGT(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldLotSize, v))
+}
+
+// LotSizeGTE applies the GTE predicate on the "lot_size" field.
+func LotSizeGTE(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldLotSize, v))
+}
+
+// LotSizeLT applies the LT predicate on the "lot_size" field.
+func LotSizeLT(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldLotSize, v))
+}
+
+// LotSizeLTE applies the LTE predicate on the "lot_size" field.
+func LotSizeLTE(v int) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldLotSize, v))
+}
+
+// TickSizeEQ applies the EQ predicate on the "tick_size" field.
+func TickSizeEQ(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTickSize, v))
+}
+
+// TickSizeNEQ applies the NEQ predicate on the "tick_size" field.
+func TickSizeNEQ(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldTickSize, v))
+}
+
+// TickSizeIn applies the In predicate on the "tick_size" field.
+func TickSizeIn(vs ...decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldTickSize, vs...))
+}
+
+// TickSizeNotIn applies the NotIn predicate on the "tick_size" field.
+func TickSizeNotIn(vs ...decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldTickSize, vs...))
+}
+
+// TickSizeGT applies the GT predicate on the "tick_size" field.
+func TickSizeGT(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldTickSize, v))
+}
+
+// TickSizeGTE applies the GTE predicate on the "tick_size" field.
+func TickSizeGTE(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldTickSize, v))
+}
+
+// TickSizeLT applies the LT predicate on the "tick_size" field.
+func TickSizeLT(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldTickSize, v))
+}
+
+// TickSizeLTE applies the LTE predicate on the "tick_size" field.
+func TickSizeLTE(v decimal.Decimal) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldTickSize, v))
+}
+
+// TradableEQ applies the EQ predicate on the "tradable" field.
+func TradableEQ(v bool) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldTradable, v))
+}
+
+// TradableNEQ applies the NEQ predicate on the "tradable" field.
+func TradableNEQ(v bool) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldTradable, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Symbol {
+	return predicate.Symbol(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Symbol) predicate.Symbol {
+	return predicate.Symbol(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Symbol) predicate.Symbol {
+	return predicate.Symbol(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Symbol) predicate.Symbol {
+	return predicate.Symbol(sql.NotPredicates(p))
+}
@@ -0,0 +1,453 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/symbol"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// SymbolCreate is the builder for creating a Symbol entity.
+type SymbolCreate struct {
+	config
+	mutation *SymbolMutation
+	hooks    []Hook
+}
+
+// SetTicker sets the "ticker" field.
+func (_c *SymbolCreate) SetTicker(v string) *SymbolCreate {
+	_c.mutation.SetTicker(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *SymbolCreate) SetExchange(v symbol.Exchange) *SymbolCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *SymbolCreate) SetCurrency(v string) *SymbolCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableCurrency(v *string) *SymbolCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetName sets the "name" field.
+func (_c *SymbolCreate) SetName(v string) *SymbolCreate {
+	_c.mutation.SetName(v)
+	return _c
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableName(v *string) *SymbolCreate {
+	if v != nil {
+		_c.SetName(*v)
+	}
+	return _c
+}
+
+// SetSecurityType sets the "security_type" field.
+func (_c *SymbolCreate) SetSecurityType(v symbol.SecurityType) *SymbolCreate {
+	_c.mutation.SetSecurityType(v)
+	return _c
+}
+
+// SetNillableSecurityType sets the "security_type" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableSecurityType(v *symbol.SecurityType) *SymbolCreate {
+	if v != nil {
+		_c.SetSecurityType(*v)
+	}
+	return _c
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_c *SymbolCreate) SetLotSize(v int) *SymbolCreate {
+	_c.mutation.SetLotSize(v)
+	return _c
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableLotSize(v *int) *SymbolCreate {
+	if v != nil {
+		_c.SetLotSize(*v)
+	}
+	return _c
+}
+
+// SetTickSize sets the "tick_size" field.
+func (_c *SymbolCreate) SetTickSize(v decimal.Decimal) *SymbolCreate {
+	_c.mutation.SetTickSize(v)
+	return _c
+}
+
+// SetNillableTickSize sets the "tick_size" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableTickSize(v *decimal.Decimal) *SymbolCreate {
+	if v != nil {
+		_c.SetTickSize(*v)
+	}
+	return _c
+}
+
+// SetTradable sets the "tradable" field.
+func (_c *SymbolCreate) SetTradable(v bool) *SymbolCreate {
+	_c.mutation.SetTradable(v)
+	return _c
+}
+
+// SetNillableTradable sets the "tradable" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableTradable(v *bool) *SymbolCreate {
+	if v != nil {
+		_c.SetTradable(*v)
+	}
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *SymbolCreate) SetCreatedAt(v time.Time) *SymbolCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableCreatedAt(v *time.Time) *SymbolCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *SymbolCreate) SetUpdatedAt(v time.Time) *SymbolCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableUpdatedAt(v *time.Time) *SymbolCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *SymbolCreate) SetID(v uuid.UUID) *SymbolCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *SymbolCreate) SetNillableID(v *uuid.UUID) *SymbolCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the SymbolMutation object of the builder.
+func (_c *SymbolCreate) Mutation() *SymbolMutation {
+	return _c.mutation
+}
+
+// Save creates the Symbol in the database.
+func (_c *SymbolCreate) Save(ctx context.Context) (*Symbol, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *SymbolCreate) SaveX(ctx context.Context) *Symbol {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SymbolCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SymbolCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *SymbolCreate) defaults() {
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := symbol.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		v := symbol.DefaultName
+		_c.mutation.SetName(v)
+	}
+	if _, ok := _c.mutation.SecurityType(); !ok {
+		v := symbol.DefaultSecurityType
+		_c.mutation.SetSecurityType(v)
+	}
+	if _, ok := _c.mutation.LotSize(); !ok {
+		v := symbol.DefaultLotSize
+		_c.mutation.SetLotSize(v)
+	}
+	if _, ok := _c.mutation.TickSize(); !ok {
+		v := symbol.DefaultTickSize
+		_c.mutation.SetTickSize(v)
+	}
+	if _, ok := _c.mutation.Tradable(); !ok {
+		v := symbol.DefaultTradable
+		_c.mutation.SetTradable(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := symbol.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := symbol.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := symbol.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *SymbolCreate) check() error {
+	if _, ok := _c.mutation.Ticker(); !ok {
+		return &ValidationError{Name: "ticker", err: errors.New(`ent: missing required field "Symbol.ticker"`)}
+	}
+	if v, ok := _c.mutation.Ticker(); ok {
+		if err := symbol.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Symbol.ticker": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Exchange(); !ok {
+		return &ValidationError{Name: "exchange", err: errors.New(`ent: missing required field "Symbol.exchange"`)}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := symbol.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Symbol.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Symbol.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := symbol.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Symbol.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "Symbol.name"`)}
+	}
+	if v, ok := _c.mutation.Name(); ok {
+		if err := symbol.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Symbol.name": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.SecurityType(); !ok {
+		return &ValidationError{Name: "security_type", err: errors.New(`ent: missing required field "Symbol.security_type"`)}
+	}
+	if v, ok := _c.mutation.SecurityType(); ok {
+		if err := symbol.SecurityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "security_type", err: fmt.Errorf(`ent: validator failed for field "Symbol.security_type": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.LotSize(); !ok {
+		return &ValidationError{Name: "lot_size", err: errors.New(`ent: missing required field "Symbol.lot_size"`)}
+	}
+	if _, ok := _c.mutation.TickSize(); !ok {
+		return &ValidationError{Name: "tick_size", err: errors.New(`ent: missing required field "Symbol.tick_size"`)}
+	}
+	if _, ok := _c.mutation.Tradable(); !ok {
+		return &ValidationError{Name: "tradable", err: errors.New(`ent: missing required field "Symbol.tradable"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Symbol.created_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Symbol.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *SymbolCreate) sqlSave(ctx context.Context) (*Symbol, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *SymbolCreate) createSpec() (*Symbol, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Symbol{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(symbol.Table, sqlgraph.NewFieldSpec(symbol.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.Ticker(); ok {
+		_spec.SetField(symbol.FieldTicker, field.TypeString, value)
+		_node.Ticker = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(symbol.FieldExchange, field.TypeEnum, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(symbol.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.Name(); ok {
+		_spec.SetField(symbol.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := _c.mutation.SecurityType(); ok {
+		_spec.SetField(symbol.FieldSecurityType, field.TypeEnum, value)
+		_node.SecurityType = value
+	}
+	if value, ok := _c.mutation.LotSize(); ok {
+		_spec.SetField(symbol.FieldLotSize, field.TypeInt, value)
+		_node.LotSize = value
+	}
+	if value, ok := _c.mutation.TickSize(); ok {
+		_spec.SetField(symbol.FieldTickSize, field.TypeOther, value)
+		_node.TickSize = value
+	}
+	if value, ok := _c.mutation.Tradable(); ok {
+		_spec.SetField(symbol.FieldTradable, field.TypeBool, value)
+		_node.Tradable = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(symbol.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(symbol.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// SymbolCreateBulk is the builder for creating many Symbol entities in bulk.
+type SymbolCreateBulk struct {
+	config
+	err      error
+	builders []*SymbolCreate
+}
+
+// Save creates the Symbol entities in the database.
+func (_c *SymbolCreateBulk) Save(ctx context.Context) ([]*Symbol, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Symbol, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SymbolMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *SymbolCreateBulk) SaveX(ctx context.Context) []*Symbol {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *SymbolCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *SymbolCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
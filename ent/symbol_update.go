@@ -0,0 +1,571 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/symbol"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/shopspring/decimal"
+)
+
+// SymbolUpdate is the builder for updating Symbol entities.
+type SymbolUpdate struct {
+	config
+	hooks    []Hook
+	mutation *SymbolMutation
+}
+
+// Where appends a list predicates to the SymbolUpdate builder.
+func (_u *SymbolUpdate) Where(ps ...predicate.Symbol) *SymbolUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetTicker sets the "ticker" field.
+func (_u *SymbolUpdate) SetTicker(v string) *SymbolUpdate {
+	_u.mutation.SetTicker(v)
+	return _u
+}
+
+// SetNillableTicker sets the "ticker" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableTicker(v *string) *SymbolUpdate {
+	if v != nil {
+		_u.SetTicker(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *SymbolUpdate) SetExchange(v symbol.Exchange) *SymbolUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableExchange(v *symbol.Exchange) *SymbolUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SymbolUpdate) SetCurrency(v string) *SymbolUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableCurrency(v *string) *SymbolUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SymbolUpdate) SetName(v string) *SymbolUpdate {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableName(v *string) *SymbolUpdate {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecurityType sets the "security_type" field.
+func (_u *SymbolUpdate) SetSecurityType(v symbol.SecurityType) *SymbolUpdate {
+	_u.mutation.SetSecurityType(v)
+	return _u
+}
+
+// SetNillableSecurityType sets the "security_type" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableSecurityType(v *symbol.SecurityType) *SymbolUpdate {
+	if v != nil {
+		_u.SetSecurityType(*v)
+	}
+	return _u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_u *SymbolUpdate) SetLotSize(v int) *SymbolUpdate {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableLotSize(v *int) *SymbolUpdate {
+	if v != nil {
+		_u.SetLotSize(*v)
+	}
+	return _u
+}
+
+// AddLotSize adds value to the "lot_size" field.
+func (_u *SymbolUpdate) AddLotSize(v int) *SymbolUpdate {
+	_u.mutation.AddLotSize(v)
+	return _u
+}
+
+// SetTickSize sets the "tick_size" field.
+func (_u *SymbolUpdate) SetTickSize(v decimal.Decimal) *SymbolUpdate {
+	_u.mutation.SetTickSize(v)
+	return _u
+}
+
+// SetNillableTickSize sets the "tick_size" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableTickSize(v *decimal.Decimal) *SymbolUpdate {
+	if v != nil {
+		_u.SetTickSize(*v)
+	}
+	return _u
+}
+
+// SetTradable sets the "tradable" field.
+func (_u *SymbolUpdate) SetTradable(v bool) *SymbolUpdate {
+	_u.mutation.SetTradable(v)
+	return _u
+}
+
+// SetNillableTradable sets the "tradable" field if the given value is not nil.
+func (_u *SymbolUpdate) SetNillableTradable(v *bool) *SymbolUpdate {
+	if v != nil {
+		_u.SetTradable(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SymbolUpdate) SetUpdatedAt(v time.Time) *SymbolUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SymbolMutation object of the builder.
+func (_u *SymbolUpdate) Mutation() *SymbolMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *SymbolUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SymbolUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *SymbolUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SymbolUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SymbolUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := symbol.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SymbolUpdate) check() error {
+	if v, ok := _u.mutation.Ticker(); ok {
+		if err := symbol.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Symbol.ticker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := symbol.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Symbol.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := symbol.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Symbol.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := symbol.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Symbol.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecurityType(); ok {
+		if err := symbol.SecurityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "security_type", err: fmt.Errorf(`ent: validator failed for field "Symbol.security_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SymbolUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(symbol.Table, symbol.Columns, sqlgraph.NewFieldSpec(symbol.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Ticker(); ok {
+		_spec.SetField(symbol.FieldTicker, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(symbol.FieldExchange, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(symbol.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(symbol.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecurityType(); ok {
+		_spec.SetField(symbol.FieldSecurityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LotSize(); ok {
+		_spec.SetField(symbol.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLotSize(); ok {
+		_spec.AddField(symbol.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TickSize(); ok {
+		_spec.SetField(symbol.FieldTickSize, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Tradable(); ok {
+		_spec.SetField(symbol.FieldTradable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(symbol.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{symbol.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// SymbolUpdateOne is the builder for updating a single Symbol entity.
+type SymbolUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *SymbolMutation
+}
+
+// SetTicker sets the "ticker" field.
+func (_u *SymbolUpdateOne) SetTicker(v string) *SymbolUpdateOne {
+	_u.mutation.SetTicker(v)
+	return _u
+}
+
+// SetNillableTicker sets the "ticker" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableTicker(v *string) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetTicker(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *SymbolUpdateOne) SetExchange(v symbol.Exchange) *SymbolUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableExchange(v *symbol.Exchange) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *SymbolUpdateOne) SetCurrency(v string) *SymbolUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableCurrency(v *string) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetName sets the "name" field.
+func (_u *SymbolUpdateOne) SetName(v string) *SymbolUpdateOne {
+	_u.mutation.SetName(v)
+	return _u
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableName(v *string) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetName(*v)
+	}
+	return _u
+}
+
+// SetSecurityType sets the "security_type" field.
+func (_u *SymbolUpdateOne) SetSecurityType(v symbol.SecurityType) *SymbolUpdateOne {
+	_u.mutation.SetSecurityType(v)
+	return _u
+}
+
+// SetNillableSecurityType sets the "security_type" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableSecurityType(v *symbol.SecurityType) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetSecurityType(*v)
+	}
+	return _u
+}
+
+// SetLotSize sets the "lot_size" field.
+func (_u *SymbolUpdateOne) SetLotSize(v int) *SymbolUpdateOne {
+	_u.mutation.ResetLotSize()
+	_u.mutation.SetLotSize(v)
+	return _u
+}
+
+// SetNillableLotSize sets the "lot_size" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableLotSize(v *int) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetLotSize(*v)
+	}
+	return _u
+}
+
+// AddLotSize adds value to the "lot_size" field.
+func (_u *SymbolUpdateOne) AddLotSize(v int) *SymbolUpdateOne {
+	_u.mutation.AddLotSize(v)
+	return _u
+}
+
+// SetTickSize sets the "tick_size" field.
+func (_u *SymbolUpdateOne) SetTickSize(v decimal.Decimal) *SymbolUpdateOne {
+	_u.mutation.SetTickSize(v)
+	return _u
+}
+
+// SetNillableTickSize sets the "tick_size" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableTickSize(v *decimal.Decimal) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetTickSize(*v)
+	}
+	return _u
+}
+
+// SetTradable sets the "tradable" field.
+func (_u *SymbolUpdateOne) SetTradable(v bool) *SymbolUpdateOne {
+	_u.mutation.SetTradable(v)
+	return _u
+}
+
+// SetNillableTradable sets the "tradable" field if the given value is not nil.
+func (_u *SymbolUpdateOne) SetNillableTradable(v *bool) *SymbolUpdateOne {
+	if v != nil {
+		_u.SetTradable(*v)
+	}
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *SymbolUpdateOne) SetUpdatedAt(v time.Time) *SymbolUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the SymbolMutation object of the builder.
+func (_u *SymbolUpdateOne) Mutation() *SymbolMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the SymbolUpdate builder.
+func (_u *SymbolUpdateOne) Where(ps ...predicate.Symbol) *SymbolUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *SymbolUpdateOne) Select(field string, fields ...string) *SymbolUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Symbol entity.
+func (_u *SymbolUpdateOne) Save(ctx context.Context) (*Symbol, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *SymbolUpdateOne) SaveX(ctx context.Context) *Symbol {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *SymbolUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *SymbolUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *SymbolUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := symbol.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *SymbolUpdateOne) check() error {
+	if v, ok := _u.mutation.Ticker(); ok {
+		if err := symbol.TickerValidator(v); err != nil {
+			return &ValidationError{Name: "ticker", err: fmt.Errorf(`ent: validator failed for field "Symbol.ticker": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := symbol.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Symbol.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := symbol.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Symbol.currency": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Name(); ok {
+		if err := symbol.NameValidator(v); err != nil {
+			return &ValidationError{Name: "name", err: fmt.Errorf(`ent: validator failed for field "Symbol.name": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.SecurityType(); ok {
+		if err := symbol.SecurityTypeValidator(v); err != nil {
+			return &ValidationError{Name: "security_type", err: fmt.Errorf(`ent: validator failed for field "Symbol.security_type": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *SymbolUpdateOne) sqlSave(ctx context.Context) (_node *Symbol, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(symbol.Table, symbol.Columns, sqlgraph.NewFieldSpec(symbol.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Symbol.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, symbol.FieldID)
+		for _, f := range fields {
+			if !symbol.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != symbol.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.Ticker(); ok {
+		_spec.SetField(symbol.FieldTicker, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(symbol.FieldExchange, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(symbol.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Name(); ok {
+		_spec.SetField(symbol.FieldName, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.SecurityType(); ok {
+		_spec.SetField(symbol.FieldSecurityType, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.LotSize(); ok {
+		_spec.SetField(symbol.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.AddedLotSize(); ok {
+		_spec.AddField(symbol.FieldLotSize, field.TypeInt, value)
+	}
+	if value, ok := _u.mutation.TickSize(); ok {
+		_spec.SetField(symbol.FieldTickSize, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Tradable(); ok {
+		_spec.SetField(symbol.FieldTradable, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(symbol.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &Symbol{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{symbol.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
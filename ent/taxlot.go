@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/taxlot"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxLot is the model entity for the TaxLot schema.
+type TaxLot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// TradeID holds the value of the "trade_id" field.
+	TradeID uuid.UUID `json:"trade_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// RemainingQuantity holds the value of the "remaining_quantity" field.
+	RemainingQuantity decimal.Decimal `json:"remaining_quantity,omitempty"`
+	// CostPerShare holds the value of the "cost_per_share" field.
+	CostPerShare decimal.Decimal `json:"cost_per_share,omitempty"`
+	// FxRate holds the value of the "fx_rate" field.
+	FxRate decimal.Decimal `json:"fx_rate,omitempty"`
+	// OpenedAt holds the value of the "opened_at" field.
+	OpenedAt time.Time `json:"opened_at,omitempty"`
+	// ClosedAt holds the value of the "closed_at" field.
+	ClosedAt *time.Time `json:"closed_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt    time.Time `json:"updated_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TaxLot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case taxlot.FieldQuantity, taxlot.FieldRemainingQuantity, taxlot.FieldCostPerShare, taxlot.FieldFxRate:
+			values[i] = new(decimal.Decimal)
+		case taxlot.FieldSymbol:
+			values[i] = new(sql.NullString)
+		case taxlot.FieldOpenedAt, taxlot.FieldClosedAt, taxlot.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		case taxlot.FieldID, taxlot.FieldUserID, taxlot.FieldTradeID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TaxLot fields.
+func (_m *TaxLot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case taxlot.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case taxlot.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case taxlot.FieldTradeID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field trade_id", values[i])
+			} else if value != nil {
+				_m.TradeID = *value
+			}
+		case taxlot.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case taxlot.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case taxlot.FieldRemainingQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field remaining_quantity", values[i])
+			} else if value != nil {
+				_m.RemainingQuantity = *value
+			}
+		case taxlot.FieldCostPerShare:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_per_share", values[i])
+			} else if value != nil {
+				_m.CostPerShare = *value
+			}
+		case taxlot.FieldFxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate", values[i])
+			} else if value != nil {
+				_m.FxRate = *value
+			}
+		case taxlot.FieldOpenedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field opened_at", values[i])
+			} else if value.Valid {
+				_m.OpenedAt = value.Time
+			}
+		case taxlot.FieldClosedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field closed_at", values[i])
+			} else if value.Valid {
+				_m.ClosedAt = new(time.Time)
+				*_m.ClosedAt = value.Time
+			}
+		case taxlot.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				_m.UpdatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TaxLot.
+// This includes values selected through modifiers, order, etc.
+func (_m *TaxLot) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this TaxLot.
+// Note that you need to call TaxLot.Unwrap() before calling this method if this TaxLot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *TaxLot) Update() *TaxLotUpdateOne {
+	return NewTaxLotClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the TaxLot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *TaxLot) Unwrap() *TaxLot {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TaxLot is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *TaxLot) String() string {
+	var builder strings.Builder
+	builder.WriteString("TaxLot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("trade_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.TradeID))
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("remaining_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.RemainingQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("cost_per_share=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostPerShare))
+	builder.WriteString(", ")
+	builder.WriteString("fx_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FxRate))
+	builder.WriteString(", ")
+	builder.WriteString("opened_at=")
+	builder.WriteString(_m.OpenedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := _m.ClosedAt; v != nil {
+		builder.WriteString("closed_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(_m.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TaxLots is a parsable slice of TaxLot.
+type TaxLots []*TaxLot
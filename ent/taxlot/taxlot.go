@@ -0,0 +1,136 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taxlot
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the taxlot type in the database.
+	Label = "tax_lot"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldTradeID holds the string denoting the trade_id field in the database.
+	FieldTradeID = "trade_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldRemainingQuantity holds the string denoting the remaining_quantity field in the database.
+	FieldRemainingQuantity = "remaining_quantity"
+	// FieldCostPerShare holds the string denoting the cost_per_share field in the database.
+	FieldCostPerShare = "cost_per_share"
+	// FieldFxRate holds the string denoting the fx_rate field in the database.
+	FieldFxRate = "fx_rate"
+	// FieldOpenedAt holds the string denoting the opened_at field in the database.
+	FieldOpenedAt = "opened_at"
+	// FieldClosedAt holds the string denoting the closed_at field in the database.
+	FieldClosedAt = "closed_at"
+	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
+	FieldUpdatedAt = "updated_at"
+	// Table holds the table name of the taxlot in the database.
+	Table = "tax_lots"
+)
+
+// Columns holds all SQL columns for taxlot fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldTradeID,
+	FieldSymbol,
+	FieldQuantity,
+	FieldRemainingQuantity,
+	FieldCostPerShare,
+	FieldFxRate,
+	FieldOpenedAt,
+	FieldClosedAt,
+	FieldUpdatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// DefaultFxRate holds the default value on creation for the "fx_rate" field.
+	DefaultFxRate decimal.Decimal
+	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
+	DefaultUpdatedAt func() time.Time
+	// UpdateDefaultUpdatedAt holds the default value on update for the "updated_at" field.
+	UpdateDefaultUpdatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// OrderOption defines the ordering options for the TaxLot queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByTradeID orders the results by the trade_id field.
+func ByTradeID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldTradeID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByRemainingQuantity orders the results by the remaining_quantity field.
+func ByRemainingQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldRemainingQuantity, opts...).ToFunc()
+}
+
+// ByCostPerShare orders the results by the cost_per_share field.
+func ByCostPerShare(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostPerShare, opts...).ToFunc()
+}
+
+// ByFxRate orders the results by the fx_rate field.
+func ByFxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRate, opts...).ToFunc()
+}
+
+// ByOpenedAt orders the results by the opened_at field.
+func ByOpenedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldOpenedAt, opts...).ToFunc()
+}
+
+// ByClosedAt orders the results by the closed_at field.
+func ByClosedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClosedAt, opts...).ToFunc()
+}
+
+// ByUpdatedAt orders the results by the updated_at field.
+func ByUpdatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUpdatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,557 @@
+// Code generated by ent, DO NOT EDIT.
+
+package taxlot
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldUserID, v))
+}
+
+// TradeID applies equality check predicate on the "trade_id" field. It's identical to TradeIDEQ.
+func TradeID(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldTradeID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldQuantity, v))
+}
+
+// RemainingQuantity applies equality check predicate on the "remaining_quantity" field. It's identical to RemainingQuantityEQ.
+func RemainingQuantity(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldRemainingQuantity, v))
+}
+
+// CostPerShare applies equality check predicate on the "cost_per_share" field. It's identical to CostPerShareEQ.
+func CostPerShare(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldCostPerShare, v))
+}
+
+// FxRate applies equality check predicate on the "fx_rate" field. It's identical to FxRateEQ.
+func FxRate(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldFxRate, v))
+}
+
+// OpenedAt applies equality check predicate on the "opened_at" field. It's identical to OpenedAtEQ.
+func OpenedAt(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// ClosedAt applies equality check predicate on the "closed_at" field. It's identical to ClosedAtEQ.
+func ClosedAt(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldUserID, v))
+}
+
+// TradeIDEQ applies the EQ predicate on the "trade_id" field.
+func TradeIDEQ(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldTradeID, v))
+}
+
+// TradeIDNEQ applies the NEQ predicate on the "trade_id" field.
+func TradeIDNEQ(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldTradeID, v))
+}
+
+// TradeIDIn applies the In predicate on the "trade_id" field.
+func TradeIDIn(vs ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldTradeID, vs...))
+}
+
+// TradeIDNotIn applies the NotIn predicate on the "trade_id" field.
+func TradeIDNotIn(vs ...uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldTradeID, vs...))
+}
+
+// TradeIDGT applies the GT predicate on the "trade_id" field.
+func TradeIDGT(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldTradeID, v))
+}
+
+// TradeIDGTE applies the GTE predicate on the "trade_id" field.
+func TradeIDGTE(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldTradeID, v))
+}
+
+// TradeIDLT applies the LT predicate on the "trade_id" field.
+func TradeIDLT(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldTradeID, v))
+}
+
+// TradeIDLTE applies the LTE predicate on the "trade_id" field.
+func TradeIDLTE(v uuid.UUID) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldTradeID, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldQuantity, v))
+}
+
+// RemainingQuantityEQ applies the EQ predicate on the "remaining_quantity" field.
+func RemainingQuantityEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldRemainingQuantity, v))
+}
+
+// RemainingQuantityNEQ applies the NEQ predicate on the "remaining_quantity" field.
+func RemainingQuantityNEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldRemainingQuantity, v))
+}
+
+// RemainingQuantityIn applies the In predicate on the "remaining_quantity" field.
+func RemainingQuantityIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldRemainingQuantity, vs...))
+}
+
+// RemainingQuantityNotIn applies the NotIn predicate on the "remaining_quantity" field.
+func RemainingQuantityNotIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldRemainingQuantity, vs...))
+}
+
+// RemainingQuantityGT applies the GT predicate on the "remaining_quantity" field.
+func RemainingQuantityGT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldRemainingQuantity, v))
+}
+
+// RemainingQuantityGTE applies the GTE predicate on the "remaining_quantity" field.
+func RemainingQuantityGTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldRemainingQuantity, v))
+}
+
+// RemainingQuantityLT applies the LT predicate on the "remaining_quantity" field.
+func RemainingQuantityLT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldRemainingQuantity, v))
+}
+
+// RemainingQuantityLTE applies the LTE predicate on the "remaining_quantity" field.
+func RemainingQuantityLTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldRemainingQuantity, v))
+}
+
+// CostPerShareEQ applies the EQ predicate on the "cost_per_share" field.
+func CostPerShareEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldCostPerShare, v))
+}
+
+// CostPerShareNEQ applies the NEQ predicate on the "cost_per_share" field.
+func CostPerShareNEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldCostPerShare, v))
+}
+
+// CostPerShareIn applies the In predicate on the "cost_per_share" field.
+func CostPerShareIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldCostPerShare, vs...))
+}
+
+// CostPerShareNotIn applies the NotIn predicate on the "cost_per_share" field.
+func CostPerShareNotIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldCostPerShare, vs...))
+}
+
+// CostPerShareGT applies the GT predicate on the "cost_per_share" field.
+func CostPerShareGT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldCostPerShare, v))
+}
+
+// CostPerShareGTE applies the GTE predicate on the "cost_per_share" field.
+func CostPerShareGTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldCostPerShare, v))
+}
+
+// CostPerShareLT applies the LT predicate on the "cost_per_share" field.
+func CostPerShareLT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldCostPerShare, v))
+}
+
+// CostPerShareLTE applies the LTE predicate on the "cost_per_share" field.
+func CostPerShareLTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldCostPerShare, v))
+}
+
+// FxRateEQ applies the EQ predicate on the "fx_rate" field.
+func FxRateEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateNEQ applies the NEQ predicate on the "fx_rate" field.
+func FxRateNEQ(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldFxRate, v))
+}
+
+// FxRateIn applies the In predicate on the "fx_rate" field.
+func FxRateIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldFxRate, vs...))
+}
+
+// FxRateNotIn applies the NotIn predicate on the "fx_rate" field.
+func FxRateNotIn(vs ...decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldFxRate, vs...))
+}
+
+// FxRateGT applies the GT predicate on the "fx_rate" field.
+func FxRateGT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldFxRate, v))
+}
+
+// FxRateGTE applies the GTE predicate on the "fx_rate" field.
+func FxRateGTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldFxRate, v))
+}
+
+// FxRateLT applies the LT predicate on the "fx_rate" field.
+func FxRateLT(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldFxRate, v))
+}
+
+// FxRateLTE applies the LTE predicate on the "fx_rate" field.
+func FxRateLTE(v decimal.Decimal) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldFxRate, v))
+}
+
+// OpenedAtEQ applies the EQ predicate on the "opened_at" field.
+func OpenedAtEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtNEQ applies the NEQ predicate on the "opened_at" field.
+func OpenedAtNEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldOpenedAt, v))
+}
+
+// OpenedAtIn applies the In predicate on the "opened_at" field.
+func OpenedAtIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtNotIn applies the NotIn predicate on the "opened_at" field.
+func OpenedAtNotIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldOpenedAt, vs...))
+}
+
+// OpenedAtGT applies the GT predicate on the "opened_at" field.
+func OpenedAtGT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldOpenedAt, v))
+}
+
+// OpenedAtGTE applies the GTE predicate on the "opened_at" field.
+func OpenedAtGTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldOpenedAt, v))
+}
+
+// OpenedAtLT applies the LT predicate on the "opened_at" field.
+func OpenedAtLT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldOpenedAt, v))
+}
+
+// OpenedAtLTE applies the LTE predicate on the "opened_at" field.
+func OpenedAtLTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldOpenedAt, v))
+}
+
+// ClosedAtEQ applies the EQ predicate on the "closed_at" field.
+func ClosedAtEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldClosedAt, v))
+}
+
+// ClosedAtNEQ applies the NEQ predicate on the "closed_at" field.
+func ClosedAtNEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldClosedAt, v))
+}
+
+// ClosedAtIn applies the In predicate on the "closed_at" field.
+func ClosedAtIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtNotIn applies the NotIn predicate on the "closed_at" field.
+func ClosedAtNotIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldClosedAt, vs...))
+}
+
+// ClosedAtGT applies the GT predicate on the "closed_at" field.
+func ClosedAtGT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldClosedAt, v))
+}
+
+// ClosedAtGTE applies the GTE predicate on the "closed_at" field.
+func ClosedAtGTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldClosedAt, v))
+}
+
+// ClosedAtLT applies the LT predicate on the "closed_at" field.
+func ClosedAtLT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldClosedAt, v))
+}
+
+// ClosedAtLTE applies the LTE predicate on the "closed_at" field.
+func ClosedAtLTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldClosedAt, v))
+}
+
+// ClosedAtIsNil applies the IsNil predicate on the "closed_at" field.
+func ClosedAtIsNil() predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIsNull(FieldClosedAt))
+}
+
+// ClosedAtNotNil applies the NotNil predicate on the "closed_at" field.
+func ClosedAtNotNil() predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotNull(FieldClosedAt))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TaxLot {
+	return predicate.TaxLot(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TaxLot) predicate.TaxLot {
+	return predicate.TaxLot(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TaxLot) predicate.TaxLot {
+	return predicate.TaxLot(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TaxLot) predicate.TaxLot {
+	return predicate.TaxLot(sql.NotPredicates(p))
+}
@@ -0,0 +1,366 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/taxlot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxLotCreate is the builder for creating a TaxLot entity.
+type TaxLotCreate struct {
+	config
+	mutation *TaxLotMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TaxLotCreate) SetUserID(v uuid.UUID) *TaxLotCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetTradeID sets the "trade_id" field.
+func (_c *TaxLotCreate) SetTradeID(v uuid.UUID) *TaxLotCreate {
+	_c.mutation.SetTradeID(v)
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *TaxLotCreate) SetSymbol(v string) *TaxLotCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *TaxLotCreate) SetQuantity(v decimal.Decimal) *TaxLotCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetRemainingQuantity sets the "remaining_quantity" field.
+func (_c *TaxLotCreate) SetRemainingQuantity(v decimal.Decimal) *TaxLotCreate {
+	_c.mutation.SetRemainingQuantity(v)
+	return _c
+}
+
+// SetCostPerShare sets the "cost_per_share" field.
+func (_c *TaxLotCreate) SetCostPerShare(v decimal.Decimal) *TaxLotCreate {
+	_c.mutation.SetCostPerShare(v)
+	return _c
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_c *TaxLotCreate) SetFxRate(v decimal.Decimal) *TaxLotCreate {
+	_c.mutation.SetFxRate(v)
+	return _c
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_c *TaxLotCreate) SetNillableFxRate(v *decimal.Decimal) *TaxLotCreate {
+	if v != nil {
+		_c.SetFxRate(*v)
+	}
+	return _c
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_c *TaxLotCreate) SetOpenedAt(v time.Time) *TaxLotCreate {
+	_c.mutation.SetOpenedAt(v)
+	return _c
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_c *TaxLotCreate) SetClosedAt(v time.Time) *TaxLotCreate {
+	_c.mutation.SetClosedAt(v)
+	return _c
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_c *TaxLotCreate) SetNillableClosedAt(v *time.Time) *TaxLotCreate {
+	if v != nil {
+		_c.SetClosedAt(*v)
+	}
+	return _c
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_c *TaxLotCreate) SetUpdatedAt(v time.Time) *TaxLotCreate {
+	_c.mutation.SetUpdatedAt(v)
+	return _c
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (_c *TaxLotCreate) SetNillableUpdatedAt(v *time.Time) *TaxLotCreate {
+	if v != nil {
+		_c.SetUpdatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TaxLotCreate) SetID(v uuid.UUID) *TaxLotCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TaxLotCreate) SetNillableID(v *uuid.UUID) *TaxLotCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TaxLotMutation object of the builder.
+func (_c *TaxLotCreate) Mutation() *TaxLotMutation {
+	return _c.mutation
+}
+
+// Save creates the TaxLot in the database.
+func (_c *TaxLotCreate) Save(ctx context.Context) (*TaxLot, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TaxLotCreate) SaveX(ctx context.Context) *TaxLot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxLotCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxLotCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TaxLotCreate) defaults() {
+	if _, ok := _c.mutation.FxRate(); !ok {
+		v := taxlot.DefaultFxRate
+		_c.mutation.SetFxRate(v)
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		v := taxlot.DefaultUpdatedAt()
+		_c.mutation.SetUpdatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := taxlot.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TaxLotCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TaxLot.user_id"`)}
+	}
+	if _, ok := _c.mutation.TradeID(); !ok {
+		return &ValidationError{Name: "trade_id", err: errors.New(`ent: missing required field "TaxLot.trade_id"`)}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "TaxLot.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := taxlot.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "TaxLot.symbol": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "TaxLot.quantity"`)}
+	}
+	if _, ok := _c.mutation.RemainingQuantity(); !ok {
+		return &ValidationError{Name: "remaining_quantity", err: errors.New(`ent: missing required field "TaxLot.remaining_quantity"`)}
+	}
+	if _, ok := _c.mutation.CostPerShare(); !ok {
+		return &ValidationError{Name: "cost_per_share", err: errors.New(`ent: missing required field "TaxLot.cost_per_share"`)}
+	}
+	if _, ok := _c.mutation.FxRate(); !ok {
+		return &ValidationError{Name: "fx_rate", err: errors.New(`ent: missing required field "TaxLot.fx_rate"`)}
+	}
+	if _, ok := _c.mutation.OpenedAt(); !ok {
+		return &ValidationError{Name: "opened_at", err: errors.New(`ent: missing required field "TaxLot.opened_at"`)}
+	}
+	if _, ok := _c.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TaxLot.updated_at"`)}
+	}
+	return nil
+}
+
+func (_c *TaxLotCreate) sqlSave(ctx context.Context) (*TaxLot, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TaxLotCreate) createSpec() (*TaxLot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TaxLot{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(taxlot.Table, sqlgraph.NewFieldSpec(taxlot.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(taxlot.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.TradeID(); ok {
+		_spec.SetField(taxlot.FieldTradeID, field.TypeUUID, value)
+		_node.TradeID = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(taxlot.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(taxlot.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.RemainingQuantity(); ok {
+		_spec.SetField(taxlot.FieldRemainingQuantity, field.TypeOther, value)
+		_node.RemainingQuantity = value
+	}
+	if value, ok := _c.mutation.CostPerShare(); ok {
+		_spec.SetField(taxlot.FieldCostPerShare, field.TypeOther, value)
+		_node.CostPerShare = value
+	}
+	if value, ok := _c.mutation.FxRate(); ok {
+		_spec.SetField(taxlot.FieldFxRate, field.TypeOther, value)
+		_node.FxRate = value
+	}
+	if value, ok := _c.mutation.OpenedAt(); ok {
+		_spec.SetField(taxlot.FieldOpenedAt, field.TypeTime, value)
+		_node.OpenedAt = value
+	}
+	if value, ok := _c.mutation.ClosedAt(); ok {
+		_spec.SetField(taxlot.FieldClosedAt, field.TypeTime, value)
+		_node.ClosedAt = &value
+	}
+	if value, ok := _c.mutation.UpdatedAt(); ok {
+		_spec.SetField(taxlot.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	return _node, _spec
+}
+
+// TaxLotCreateBulk is the builder for creating many TaxLot entities in bulk.
+type TaxLotCreateBulk struct {
+	config
+	err      error
+	builders []*TaxLotCreate
+}
+
+// Save creates the TaxLot entities in the database.
+func (_c *TaxLotCreateBulk) Save(ctx context.Context) ([]*TaxLot, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*TaxLot, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TaxLotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TaxLotCreateBulk) SaveX(ctx context.Context) []*TaxLot {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TaxLotCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TaxLotCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,564 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/taxlot"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TaxLotUpdate is the builder for updating TaxLot entities.
+type TaxLotUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TaxLotMutation
+}
+
+// Where appends a list predicates to the TaxLotUpdate builder.
+func (_u *TaxLotUpdate) Where(ps ...predicate.TaxLot) *TaxLotUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TaxLotUpdate) SetUserID(v uuid.UUID) *TaxLotUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableUserID(v *uuid.UUID) *TaxLotUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTradeID sets the "trade_id" field.
+func (_u *TaxLotUpdate) SetTradeID(v uuid.UUID) *TaxLotUpdate {
+	_u.mutation.SetTradeID(v)
+	return _u
+}
+
+// SetNillableTradeID sets the "trade_id" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableTradeID(v *uuid.UUID) *TaxLotUpdate {
+	if v != nil {
+		_u.SetTradeID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TaxLotUpdate) SetSymbol(v string) *TaxLotUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableSymbol(v *string) *TaxLotUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TaxLotUpdate) SetQuantity(v decimal.Decimal) *TaxLotUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableQuantity(v *decimal.Decimal) *TaxLotUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetRemainingQuantity sets the "remaining_quantity" field.
+func (_u *TaxLotUpdate) SetRemainingQuantity(v decimal.Decimal) *TaxLotUpdate {
+	_u.mutation.SetRemainingQuantity(v)
+	return _u
+}
+
+// SetNillableRemainingQuantity sets the "remaining_quantity" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableRemainingQuantity(v *decimal.Decimal) *TaxLotUpdate {
+	if v != nil {
+		_u.SetRemainingQuantity(*v)
+	}
+	return _u
+}
+
+// SetCostPerShare sets the "cost_per_share" field.
+func (_u *TaxLotUpdate) SetCostPerShare(v decimal.Decimal) *TaxLotUpdate {
+	_u.mutation.SetCostPerShare(v)
+	return _u
+}
+
+// SetNillableCostPerShare sets the "cost_per_share" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableCostPerShare(v *decimal.Decimal) *TaxLotUpdate {
+	if v != nil {
+		_u.SetCostPerShare(*v)
+	}
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *TaxLotUpdate) SetFxRate(v decimal.Decimal) *TaxLotUpdate {
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableFxRate(v *decimal.Decimal) *TaxLotUpdate {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *TaxLotUpdate) SetOpenedAt(v time.Time) *TaxLotUpdate {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableOpenedAt(v *time.Time) *TaxLotUpdate {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *TaxLotUpdate) SetClosedAt(v time.Time) *TaxLotUpdate {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *TaxLotUpdate) SetNillableClosedAt(v *time.Time) *TaxLotUpdate {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *TaxLotUpdate) ClearClosedAt() *TaxLotUpdate {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaxLotUpdate) SetUpdatedAt(v time.Time) *TaxLotUpdate {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TaxLotMutation object of the builder.
+func (_u *TaxLotUpdate) Mutation() *TaxLotMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TaxLotUpdate) Save(ctx context.Context) (int, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxLotUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TaxLotUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxLotUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TaxLotUpdate) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := taxlot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxLotUpdate) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := taxlot.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "TaxLot.symbol": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TaxLotUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxlot.Table, taxlot.Columns, sqlgraph.NewFieldSpec(taxlot.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(taxlot.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TradeID(); ok {
+		_spec.SetField(taxlot.FieldTradeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(taxlot.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(taxlot.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.RemainingQuantity(); ok {
+		_spec.SetField(taxlot.FieldRemainingQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostPerShare(); ok {
+		_spec.SetField(taxlot.FieldCostPerShare, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(taxlot.FieldFxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(taxlot.FieldOpenedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(taxlot.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(taxlot.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taxlot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxlot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TaxLotUpdateOne is the builder for updating a single TaxLot entity.
+type TaxLotUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TaxLotMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TaxLotUpdateOne) SetUserID(v uuid.UUID) *TaxLotUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableUserID(v *uuid.UUID) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetTradeID sets the "trade_id" field.
+func (_u *TaxLotUpdateOne) SetTradeID(v uuid.UUID) *TaxLotUpdateOne {
+	_u.mutation.SetTradeID(v)
+	return _u
+}
+
+// SetNillableTradeID sets the "trade_id" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableTradeID(v *uuid.UUID) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetTradeID(*v)
+	}
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TaxLotUpdateOne) SetSymbol(v string) *TaxLotUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableSymbol(v *string) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TaxLotUpdateOne) SetQuantity(v decimal.Decimal) *TaxLotUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableQuantity(v *decimal.Decimal) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetRemainingQuantity sets the "remaining_quantity" field.
+func (_u *TaxLotUpdateOne) SetRemainingQuantity(v decimal.Decimal) *TaxLotUpdateOne {
+	_u.mutation.SetRemainingQuantity(v)
+	return _u
+}
+
+// SetNillableRemainingQuantity sets the "remaining_quantity" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableRemainingQuantity(v *decimal.Decimal) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetRemainingQuantity(*v)
+	}
+	return _u
+}
+
+// SetCostPerShare sets the "cost_per_share" field.
+func (_u *TaxLotUpdateOne) SetCostPerShare(v decimal.Decimal) *TaxLotUpdateOne {
+	_u.mutation.SetCostPerShare(v)
+	return _u
+}
+
+// SetNillableCostPerShare sets the "cost_per_share" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableCostPerShare(v *decimal.Decimal) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetCostPerShare(*v)
+	}
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *TaxLotUpdateOne) SetFxRate(v decimal.Decimal) *TaxLotUpdateOne {
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableFxRate(v *decimal.Decimal) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// SetOpenedAt sets the "opened_at" field.
+func (_u *TaxLotUpdateOne) SetOpenedAt(v time.Time) *TaxLotUpdateOne {
+	_u.mutation.SetOpenedAt(v)
+	return _u
+}
+
+// SetNillableOpenedAt sets the "opened_at" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableOpenedAt(v *time.Time) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetOpenedAt(*v)
+	}
+	return _u
+}
+
+// SetClosedAt sets the "closed_at" field.
+func (_u *TaxLotUpdateOne) SetClosedAt(v time.Time) *TaxLotUpdateOne {
+	_u.mutation.SetClosedAt(v)
+	return _u
+}
+
+// SetNillableClosedAt sets the "closed_at" field if the given value is not nil.
+func (_u *TaxLotUpdateOne) SetNillableClosedAt(v *time.Time) *TaxLotUpdateOne {
+	if v != nil {
+		_u.SetClosedAt(*v)
+	}
+	return _u
+}
+
+// ClearClosedAt clears the value of the "closed_at" field.
+func (_u *TaxLotUpdateOne) ClearClosedAt() *TaxLotUpdateOne {
+	_u.mutation.ClearClosedAt()
+	return _u
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (_u *TaxLotUpdateOne) SetUpdatedAt(v time.Time) *TaxLotUpdateOne {
+	_u.mutation.SetUpdatedAt(v)
+	return _u
+}
+
+// Mutation returns the TaxLotMutation object of the builder.
+func (_u *TaxLotUpdateOne) Mutation() *TaxLotMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TaxLotUpdate builder.
+func (_u *TaxLotUpdateOne) Where(ps ...predicate.TaxLot) *TaxLotUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TaxLotUpdateOne) Select(field string, fields ...string) *TaxLotUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated TaxLot entity.
+func (_u *TaxLotUpdateOne) Save(ctx context.Context) (*TaxLot, error) {
+	_u.defaults()
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TaxLotUpdateOne) SaveX(ctx context.Context) *TaxLot {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TaxLotUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TaxLotUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_u *TaxLotUpdateOne) defaults() {
+	if _, ok := _u.mutation.UpdatedAt(); !ok {
+		v := taxlot.UpdateDefaultUpdatedAt()
+		_u.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TaxLotUpdateOne) check() error {
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := taxlot.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "TaxLot.symbol": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TaxLotUpdateOne) sqlSave(ctx context.Context) (_node *TaxLot, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(taxlot.Table, taxlot.Columns, sqlgraph.NewFieldSpec(taxlot.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TaxLot.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, taxlot.FieldID)
+		for _, f := range fields {
+			if !taxlot.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != taxlot.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(taxlot.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.TradeID(); ok {
+		_spec.SetField(taxlot.FieldTradeID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(taxlot.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(taxlot.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.RemainingQuantity(); ok {
+		_spec.SetField(taxlot.FieldRemainingQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostPerShare(); ok {
+		_spec.SetField(taxlot.FieldCostPerShare, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(taxlot.FieldFxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.OpenedAt(); ok {
+		_spec.SetField(taxlot.FieldOpenedAt, field.TypeTime, value)
+	}
+	if value, ok := _u.mutation.ClosedAt(); ok {
+		_spec.SetField(taxlot.FieldClosedAt, field.TypeTime, value)
+	}
+	if _u.mutation.ClosedAtCleared() {
+		_spec.ClearField(taxlot.FieldClosedAt, field.TypeTime)
+	}
+	if value, ok := _u.mutation.UpdatedAt(); ok {
+		_spec.SetField(taxlot.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &TaxLot{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{taxlot.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
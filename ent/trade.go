@@ -0,0 +1,365 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/trade"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Trade is the model entity for the Trade schema.
+type Trade struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID uuid.UUID `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID uuid.UUID `json:"user_id,omitempty"`
+	// ClientOrderID holds the value of the "client_order_id" field.
+	ClientOrderID string `json:"client_order_id,omitempty"`
+	// BrokerOrderID holds the value of the "broker_order_id" field.
+	BrokerOrderID string `json:"broker_order_id,omitempty"`
+	// Symbol holds the value of the "symbol" field.
+	Symbol string `json:"symbol,omitempty"`
+	// Exchange holds the value of the "exchange" field.
+	Exchange string `json:"exchange,omitempty"`
+	// Side holds the value of the "side" field.
+	Side trade.Side `json:"side,omitempty"`
+	// Quantity holds the value of the "quantity" field.
+	Quantity decimal.Decimal `json:"quantity,omitempty"`
+	// Price holds the value of the "price" field.
+	Price decimal.Decimal `json:"price,omitempty"`
+	// Fee holds the value of the "fee" field.
+	Fee decimal.Decimal `json:"fee,omitempty"`
+	// Currency holds the value of the "currency" field.
+	Currency string `json:"currency,omitempty"`
+	// FxRate holds the value of the "fx_rate" field.
+	FxRate decimal.Decimal `json:"fx_rate,omitempty"`
+	// FilledQuantity holds the value of the "filled_quantity" field.
+	FilledQuantity decimal.Decimal `json:"filled_quantity,omitempty"`
+	// Proceeds holds the value of the "proceeds" field.
+	Proceeds decimal.Decimal `json:"proceeds,omitempty"`
+	// CostBasisFifo holds the value of the "cost_basis_fifo" field.
+	CostBasisFifo decimal.Decimal `json:"cost_basis_fifo,omitempty"`
+	// CostBasisFifoKrw holds the value of the "cost_basis_fifo_krw" field.
+	CostBasisFifoKrw decimal.Decimal `json:"cost_basis_fifo_krw,omitempty"`
+	// CostBasisAvg holds the value of the "cost_basis_avg" field.
+	CostBasisAvg decimal.Decimal `json:"cost_basis_avg,omitempty"`
+	// CostBasisAvgKrw holds the value of the "cost_basis_avg_krw" field.
+	CostBasisAvgKrw decimal.Decimal `json:"cost_basis_avg_krw,omitempty"`
+	// EstimatedBasis holds the value of the "estimated_basis" field.
+	EstimatedBasis bool `json:"estimated_basis,omitempty"`
+	// PositionAfter holds the value of the "position_after" field.
+	PositionAfter decimal.Decimal `json:"position_after,omitempty"`
+	// AvgCostAfter holds the value of the "avg_cost_after" field.
+	AvgCostAfter decimal.Decimal `json:"avg_cost_after,omitempty"`
+	// AvgCostKrwAfter holds the value of the "avg_cost_krw_after" field.
+	AvgCostKrwAfter decimal.Decimal `json:"avg_cost_krw_after,omitempty"`
+	// FxPending holds the value of the "fx_pending" field.
+	FxPending bool `json:"fx_pending,omitempty"`
+	// ExecutedAt holds the value of the "executed_at" field.
+	ExecutedAt time.Time `json:"executed_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt    time.Time `json:"created_at,omitempty"`
+	selectValues sql.SelectValues
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Trade) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case trade.FieldQuantity, trade.FieldPrice, trade.FieldFee, trade.FieldFxRate, trade.FieldFilledQuantity, trade.FieldProceeds, trade.FieldCostBasisFifo, trade.FieldCostBasisFifoKrw, trade.FieldCostBasisAvg, trade.FieldCostBasisAvgKrw, trade.FieldPositionAfter, trade.FieldAvgCostAfter, trade.FieldAvgCostKrwAfter:
+			values[i] = new(decimal.Decimal)
+		case trade.FieldEstimatedBasis, trade.FieldFxPending:
+			values[i] = new(sql.NullBool)
+		case trade.FieldClientOrderID, trade.FieldBrokerOrderID, trade.FieldSymbol, trade.FieldExchange, trade.FieldSide, trade.FieldCurrency:
+			values[i] = new(sql.NullString)
+		case trade.FieldExecutedAt, trade.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case trade.FieldID, trade.FieldUserID:
+			values[i] = new(uuid.UUID)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Trade fields.
+func (_m *Trade) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case trade.FieldID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				_m.ID = *value
+			}
+		case trade.FieldUserID:
+			if value, ok := values[i].(*uuid.UUID); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value != nil {
+				_m.UserID = *value
+			}
+		case trade.FieldClientOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field client_order_id", values[i])
+			} else if value.Valid {
+				_m.ClientOrderID = value.String
+			}
+		case trade.FieldBrokerOrderID:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field broker_order_id", values[i])
+			} else if value.Valid {
+				_m.BrokerOrderID = value.String
+			}
+		case trade.FieldSymbol:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field symbol", values[i])
+			} else if value.Valid {
+				_m.Symbol = value.String
+			}
+		case trade.FieldExchange:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field exchange", values[i])
+			} else if value.Valid {
+				_m.Exchange = value.String
+			}
+		case trade.FieldSide:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field side", values[i])
+			} else if value.Valid {
+				_m.Side = trade.Side(value.String)
+			}
+		case trade.FieldQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field quantity", values[i])
+			} else if value != nil {
+				_m.Quantity = *value
+			}
+		case trade.FieldPrice:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field price", values[i])
+			} else if value != nil {
+				_m.Price = *value
+			}
+		case trade.FieldFee:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fee", values[i])
+			} else if value != nil {
+				_m.Fee = *value
+			}
+		case trade.FieldCurrency:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field currency", values[i])
+			} else if value.Valid {
+				_m.Currency = value.String
+			}
+		case trade.FieldFxRate:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_rate", values[i])
+			} else if value != nil {
+				_m.FxRate = *value
+			}
+		case trade.FieldFilledQuantity:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field filled_quantity", values[i])
+			} else if value != nil {
+				_m.FilledQuantity = *value
+			}
+		case trade.FieldProceeds:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field proceeds", values[i])
+			} else if value != nil {
+				_m.Proceeds = *value
+			}
+		case trade.FieldCostBasisFifo:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_basis_fifo", values[i])
+			} else if value != nil {
+				_m.CostBasisFifo = *value
+			}
+		case trade.FieldCostBasisFifoKrw:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_basis_fifo_krw", values[i])
+			} else if value != nil {
+				_m.CostBasisFifoKrw = *value
+			}
+		case trade.FieldCostBasisAvg:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_basis_avg", values[i])
+			} else if value != nil {
+				_m.CostBasisAvg = *value
+			}
+		case trade.FieldCostBasisAvgKrw:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field cost_basis_avg_krw", values[i])
+			} else if value != nil {
+				_m.CostBasisAvgKrw = *value
+			}
+		case trade.FieldEstimatedBasis:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field estimated_basis", values[i])
+			} else if value.Valid {
+				_m.EstimatedBasis = value.Bool
+			}
+		case trade.FieldPositionAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field position_after", values[i])
+			} else if value != nil {
+				_m.PositionAfter = *value
+			}
+		case trade.FieldAvgCostAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_cost_after", values[i])
+			} else if value != nil {
+				_m.AvgCostAfter = *value
+			}
+		case trade.FieldAvgCostKrwAfter:
+			if value, ok := values[i].(*decimal.Decimal); !ok {
+				return fmt.Errorf("unexpected type %T for field avg_cost_krw_after", values[i])
+			} else if value != nil {
+				_m.AvgCostKrwAfter = *value
+			}
+		case trade.FieldFxPending:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field fx_pending", values[i])
+			} else if value.Valid {
+				_m.FxPending = value.Bool
+			}
+		case trade.FieldExecutedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field executed_at", values[i])
+			} else if value.Valid {
+				_m.ExecutedAt = value.Time
+			}
+		case trade.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				_m.CreatedAt = value.Time
+			}
+		default:
+			_m.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Trade.
+// This includes values selected through modifiers, order, etc.
+func (_m *Trade) Value(name string) (ent.Value, error) {
+	return _m.selectValues.Get(name)
+}
+
+// Update returns a builder for updating this Trade.
+// Note that you need to call Trade.Unwrap() before calling this method if this Trade
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (_m *Trade) Update() *TradeUpdateOne {
+	return NewTradeClient(_m.config).UpdateOne(_m)
+}
+
+// Unwrap unwraps the Trade entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (_m *Trade) Unwrap() *Trade {
+	_tx, ok := _m.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Trade is not a transactional entity")
+	}
+	_m.config.driver = _tx.drv
+	return _m
+}
+
+// String implements the fmt.Stringer.
+func (_m *Trade) String() string {
+	var builder strings.Builder
+	builder.WriteString("Trade(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", _m.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", _m.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("client_order_id=")
+	builder.WriteString(_m.ClientOrderID)
+	builder.WriteString(", ")
+	builder.WriteString("broker_order_id=")
+	builder.WriteString(_m.BrokerOrderID)
+	builder.WriteString(", ")
+	builder.WriteString("symbol=")
+	builder.WriteString(_m.Symbol)
+	builder.WriteString(", ")
+	builder.WriteString("exchange=")
+	builder.WriteString(_m.Exchange)
+	builder.WriteString(", ")
+	builder.WriteString("side=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Side))
+	builder.WriteString(", ")
+	builder.WriteString("quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Quantity))
+	builder.WriteString(", ")
+	builder.WriteString("price=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Price))
+	builder.WriteString(", ")
+	builder.WriteString("fee=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Fee))
+	builder.WriteString(", ")
+	builder.WriteString("currency=")
+	builder.WriteString(_m.Currency)
+	builder.WriteString(", ")
+	builder.WriteString("fx_rate=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FxRate))
+	builder.WriteString(", ")
+	builder.WriteString("filled_quantity=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FilledQuantity))
+	builder.WriteString(", ")
+	builder.WriteString("proceeds=")
+	builder.WriteString(fmt.Sprintf("%v", _m.Proceeds))
+	builder.WriteString(", ")
+	builder.WriteString("cost_basis_fifo=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostBasisFifo))
+	builder.WriteString(", ")
+	builder.WriteString("cost_basis_fifo_krw=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostBasisFifoKrw))
+	builder.WriteString(", ")
+	builder.WriteString("cost_basis_avg=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostBasisAvg))
+	builder.WriteString(", ")
+	builder.WriteString("cost_basis_avg_krw=")
+	builder.WriteString(fmt.Sprintf("%v", _m.CostBasisAvgKrw))
+	builder.WriteString(", ")
+	builder.WriteString("estimated_basis=")
+	builder.WriteString(fmt.Sprintf("%v", _m.EstimatedBasis))
+	builder.WriteString(", ")
+	builder.WriteString("position_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.PositionAfter))
+	builder.WriteString(", ")
+	builder.WriteString("avg_cost_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AvgCostAfter))
+	builder.WriteString(", ")
+	builder.WriteString("avg_cost_krw_after=")
+	builder.WriteString(fmt.Sprintf("%v", _m.AvgCostKrwAfter))
+	builder.WriteString(", ")
+	builder.WriteString("fx_pending=")
+	builder.WriteString(fmt.Sprintf("%v", _m.FxPending))
+	builder.WriteString(", ")
+	builder.WriteString("executed_at=")
+	builder.WriteString(_m.ExecutedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(_m.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Trades is a parsable slice of Trade.
+type Trades []*Trade
@@ -0,0 +1,302 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trade
+
+import (
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+const (
+	// Label holds the string label denoting the trade type in the database.
+	Label = "trade"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_id"
+	// FieldClientOrderID holds the string denoting the client_order_id field in the database.
+	FieldClientOrderID = "client_order_id"
+	// FieldBrokerOrderID holds the string denoting the broker_order_id field in the database.
+	FieldBrokerOrderID = "broker_order_id"
+	// FieldSymbol holds the string denoting the symbol field in the database.
+	FieldSymbol = "symbol"
+	// FieldExchange holds the string denoting the exchange field in the database.
+	FieldExchange = "exchange"
+	// FieldSide holds the string denoting the side field in the database.
+	FieldSide = "side"
+	// FieldQuantity holds the string denoting the quantity field in the database.
+	FieldQuantity = "quantity"
+	// FieldPrice holds the string denoting the price field in the database.
+	FieldPrice = "price"
+	// FieldFee holds the string denoting the fee field in the database.
+	FieldFee = "fee"
+	// FieldCurrency holds the string denoting the currency field in the database.
+	FieldCurrency = "currency"
+	// FieldFxRate holds the string denoting the fx_rate field in the database.
+	FieldFxRate = "fx_rate"
+	// FieldFilledQuantity holds the string denoting the filled_quantity field in the database.
+	FieldFilledQuantity = "filled_quantity"
+	// FieldProceeds holds the string denoting the proceeds field in the database.
+	FieldProceeds = "proceeds"
+	// FieldCostBasisFifo holds the string denoting the cost_basis_fifo field in the database.
+	FieldCostBasisFifo = "cost_basis_fifo"
+	// FieldCostBasisFifoKrw holds the string denoting the cost_basis_fifo_krw field in the database.
+	FieldCostBasisFifoKrw = "cost_basis_fifo_krw"
+	// FieldCostBasisAvg holds the string denoting the cost_basis_avg field in the database.
+	FieldCostBasisAvg = "cost_basis_avg"
+	// FieldCostBasisAvgKrw holds the string denoting the cost_basis_avg_krw field in the database.
+	FieldCostBasisAvgKrw = "cost_basis_avg_krw"
+	// FieldEstimatedBasis holds the string denoting the estimated_basis field in the database.
+	FieldEstimatedBasis = "estimated_basis"
+	// FieldPositionAfter holds the string denoting the position_after field in the database.
+	FieldPositionAfter = "position_after"
+	// FieldAvgCostAfter holds the string denoting the avg_cost_after field in the database.
+	FieldAvgCostAfter = "avg_cost_after"
+	// FieldAvgCostKrwAfter holds the string denoting the avg_cost_krw_after field in the database.
+	FieldAvgCostKrwAfter = "avg_cost_krw_after"
+	// FieldFxPending holds the string denoting the fx_pending field in the database.
+	FieldFxPending = "fx_pending"
+	// FieldExecutedAt holds the string denoting the executed_at field in the database.
+	FieldExecutedAt = "executed_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the trade in the database.
+	Table = "trades"
+)
+
+// Columns holds all SQL columns for trade fields.
+var Columns = []string{
+	FieldID,
+	FieldUserID,
+	FieldClientOrderID,
+	FieldBrokerOrderID,
+	FieldSymbol,
+	FieldExchange,
+	FieldSide,
+	FieldQuantity,
+	FieldPrice,
+	FieldFee,
+	FieldCurrency,
+	FieldFxRate,
+	FieldFilledQuantity,
+	FieldProceeds,
+	FieldCostBasisFifo,
+	FieldCostBasisFifoKrw,
+	FieldCostBasisAvg,
+	FieldCostBasisAvgKrw,
+	FieldEstimatedBasis,
+	FieldPositionAfter,
+	FieldAvgCostAfter,
+	FieldAvgCostKrwAfter,
+	FieldFxPending,
+	FieldExecutedAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// ClientOrderIDValidator is a validator for the "client_order_id" field. It is called by the builders before save.
+	ClientOrderIDValidator func(string) error
+	// BrokerOrderIDValidator is a validator for the "broker_order_id" field. It is called by the builders before save.
+	BrokerOrderIDValidator func(string) error
+	// SymbolValidator is a validator for the "symbol" field. It is called by the builders before save.
+	SymbolValidator func(string) error
+	// ExchangeValidator is a validator for the "exchange" field. It is called by the builders before save.
+	ExchangeValidator func(string) error
+	// DefaultFee holds the default value on creation for the "fee" field.
+	DefaultFee decimal.Decimal
+	// DefaultCurrency holds the default value on creation for the "currency" field.
+	DefaultCurrency string
+	// CurrencyValidator is a validator for the "currency" field. It is called by the builders before save.
+	CurrencyValidator func(string) error
+	// DefaultFxRate holds the default value on creation for the "fx_rate" field.
+	DefaultFxRate decimal.Decimal
+	// DefaultProceeds holds the default value on creation for the "proceeds" field.
+	DefaultProceeds decimal.Decimal
+	// DefaultCostBasisFifo holds the default value on creation for the "cost_basis_fifo" field.
+	DefaultCostBasisFifo decimal.Decimal
+	// DefaultCostBasisFifoKrw holds the default value on creation for the "cost_basis_fifo_krw" field.
+	DefaultCostBasisFifoKrw decimal.Decimal
+	// DefaultCostBasisAvg holds the default value on creation for the "cost_basis_avg" field.
+	DefaultCostBasisAvg decimal.Decimal
+	// DefaultCostBasisAvgKrw holds the default value on creation for the "cost_basis_avg_krw" field.
+	DefaultCostBasisAvgKrw decimal.Decimal
+	// DefaultEstimatedBasis holds the default value on creation for the "estimated_basis" field.
+	DefaultEstimatedBasis bool
+	// DefaultPositionAfter holds the default value on creation for the "position_after" field.
+	DefaultPositionAfter decimal.Decimal
+	// DefaultAvgCostAfter holds the default value on creation for the "avg_cost_after" field.
+	DefaultAvgCostAfter decimal.Decimal
+	// DefaultAvgCostKrwAfter holds the default value on creation for the "avg_cost_krw_after" field.
+	DefaultAvgCostKrwAfter decimal.Decimal
+	// DefaultFxPending holds the default value on creation for the "fx_pending" field.
+	DefaultFxPending bool
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() uuid.UUID
+)
+
+// Side defines the type for the "side" enum field.
+type Side string
+
+// Side values.
+const (
+	SideBUY  Side = "BUY"
+	SideSELL Side = "SELL"
+)
+
+func (s Side) String() string {
+	return string(s)
+}
+
+// SideValidator is a validator for the "side" field enum values. It is called by the builders before save.
+func SideValidator(s Side) error {
+	switch s {
+	case SideBUY, SideSELL:
+		return nil
+	default:
+		return fmt.Errorf("trade: invalid enum value for side field: %q", s)
+	}
+}
+
+// OrderOption defines the ordering options for the Trade queries.
+type OrderOption func(*sql.Selector)
+
+// ByID orders the results by the id field.
+func ByID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldID, opts...).ToFunc()
+}
+
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByClientOrderID orders the results by the client_order_id field.
+func ByClientOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClientOrderID, opts...).ToFunc()
+}
+
+// ByBrokerOrderID orders the results by the broker_order_id field.
+func ByBrokerOrderID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldBrokerOrderID, opts...).ToFunc()
+}
+
+// BySymbol orders the results by the symbol field.
+func BySymbol(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSymbol, opts...).ToFunc()
+}
+
+// ByExchange orders the results by the exchange field.
+func ByExchange(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExchange, opts...).ToFunc()
+}
+
+// BySide orders the results by the side field.
+func BySide(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSide, opts...).ToFunc()
+}
+
+// ByQuantity orders the results by the quantity field.
+func ByQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldQuantity, opts...).ToFunc()
+}
+
+// ByPrice orders the results by the price field.
+func ByPrice(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPrice, opts...).ToFunc()
+}
+
+// ByFee orders the results by the fee field.
+func ByFee(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFee, opts...).ToFunc()
+}
+
+// ByCurrency orders the results by the currency field.
+func ByCurrency(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCurrency, opts...).ToFunc()
+}
+
+// ByFxRate orders the results by the fx_rate field.
+func ByFxRate(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxRate, opts...).ToFunc()
+}
+
+// ByFilledQuantity orders the results by the filled_quantity field.
+func ByFilledQuantity(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFilledQuantity, opts...).ToFunc()
+}
+
+// ByProceeds orders the results by the proceeds field.
+func ByProceeds(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldProceeds, opts...).ToFunc()
+}
+
+// ByCostBasisFifo orders the results by the cost_basis_fifo field.
+func ByCostBasisFifo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostBasisFifo, opts...).ToFunc()
+}
+
+// ByCostBasisFifoKrw orders the results by the cost_basis_fifo_krw field.
+func ByCostBasisFifoKrw(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostBasisFifoKrw, opts...).ToFunc()
+}
+
+// ByCostBasisAvg orders the results by the cost_basis_avg field.
+func ByCostBasisAvg(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostBasisAvg, opts...).ToFunc()
+}
+
+// ByCostBasisAvgKrw orders the results by the cost_basis_avg_krw field.
+func ByCostBasisAvgKrw(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCostBasisAvgKrw, opts...).ToFunc()
+}
+
+// ByEstimatedBasis orders the results by the estimated_basis field.
+func ByEstimatedBasis(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldEstimatedBasis, opts...).ToFunc()
+}
+
+// ByPositionAfter orders the results by the position_after field.
+func ByPositionAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldPositionAfter, opts...).ToFunc()
+}
+
+// ByAvgCostAfter orders the results by the avg_cost_after field.
+func ByAvgCostAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgCostAfter, opts...).ToFunc()
+}
+
+// ByAvgCostKrwAfter orders the results by the avg_cost_krw_after field.
+func ByAvgCostKrwAfter(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldAvgCostKrwAfter, opts...).ToFunc()
+}
+
+// ByFxPending orders the results by the fx_pending field.
+func ByFxPending(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFxPending, opts...).ToFunc()
+}
+
+// ByExecutedAt orders the results by the executed_at field.
+func ByExecutedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldExecutedAt, opts...).ToFunc()
+}
+
+// ByCreatedAt orders the results by the created_at field.
+func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
+}
@@ -0,0 +1,1212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package trade
+
+import (
+	"auto-trader/ent/predicate"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldUserID, v))
+}
+
+// ClientOrderID applies equality check predicate on the "client_order_id" field. It's identical to ClientOrderIDEQ.
+func ClientOrderID(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// BrokerOrderID applies equality check predicate on the "broker_order_id" field. It's identical to BrokerOrderIDEQ.
+func BrokerOrderID(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldBrokerOrderID, v))
+}
+
+// Symbol applies equality check predicate on the "symbol" field. It's identical to SymbolEQ.
+func Symbol(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldSymbol, v))
+}
+
+// Exchange applies equality check predicate on the "exchange" field. It's identical to ExchangeEQ.
+func Exchange(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExchange, v))
+}
+
+// Quantity applies equality check predicate on the "quantity" field. It's identical to QuantityEQ.
+func Quantity(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// Price applies equality check predicate on the "price" field. It's identical to PriceEQ.
+func Price(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldPrice, v))
+}
+
+// Fee applies equality check predicate on the "fee" field. It's identical to FeeEQ.
+func Fee(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFee, v))
+}
+
+// Currency applies equality check predicate on the "currency" field. It's identical to CurrencyEQ.
+func Currency(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCurrency, v))
+}
+
+// FxRate applies equality check predicate on the "fx_rate" field. It's identical to FxRateEQ.
+func FxRate(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FilledQuantity applies equality check predicate on the "filled_quantity" field. It's identical to FilledQuantityEQ.
+func FilledQuantity(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFilledQuantity, v))
+}
+
+// Proceeds applies equality check predicate on the "proceeds" field. It's identical to ProceedsEQ.
+func Proceeds(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldProceeds, v))
+}
+
+// CostBasisFifo applies equality check predicate on the "cost_basis_fifo" field. It's identical to CostBasisFifoEQ.
+func CostBasisFifo(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoKrw applies equality check predicate on the "cost_basis_fifo_krw" field. It's identical to CostBasisFifoKrwEQ.
+func CostBasisFifoKrw(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisAvg applies equality check predicate on the "cost_basis_avg" field. It's identical to CostBasisAvgEQ.
+func CostBasisAvg(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgKrw applies equality check predicate on the "cost_basis_avg_krw" field. It's identical to CostBasisAvgKrwEQ.
+func CostBasisAvgKrw(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisAvgKrw, v))
+}
+
+// EstimatedBasis applies equality check predicate on the "estimated_basis" field. It's identical to EstimatedBasisEQ.
+func EstimatedBasis(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldEstimatedBasis, v))
+}
+
+// PositionAfter applies equality check predicate on the "position_after" field. It's identical to PositionAfterEQ.
+func PositionAfter(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldPositionAfter, v))
+}
+
+// AvgCostAfter applies equality check predicate on the "avg_cost_after" field. It's identical to AvgCostAfterEQ.
+func AvgCostAfter(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldAvgCostAfter, v))
+}
+
+// AvgCostKrwAfter applies equality check predicate on the "avg_cost_krw_after" field. It's identical to AvgCostKrwAfterEQ.
+func AvgCostKrwAfter(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldAvgCostKrwAfter, v))
+}
+
+// FxPending applies equality check predicate on the "fx_pending" field. It's identical to FxPendingEQ.
+func FxPending(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFxPending, v))
+}
+
+// ExecutedAt applies equality check predicate on the "executed_at" field. It's identical to ExecutedAtEQ.
+func ExecutedAt(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// UserIDGT applies the GT predicate on the "user_id" field.
+func UserIDGT(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldUserID, v))
+}
+
+// UserIDGTE applies the GTE predicate on the "user_id" field.
+func UserIDGTE(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldUserID, v))
+}
+
+// UserIDLT applies the LT predicate on the "user_id" field.
+func UserIDLT(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldUserID, v))
+}
+
+// UserIDLTE applies the LTE predicate on the "user_id" field.
+func UserIDLTE(v uuid.UUID) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldUserID, v))
+}
+
+// ClientOrderIDEQ applies the EQ predicate on the "client_order_id" field.
+func ClientOrderIDEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDNEQ applies the NEQ predicate on the "client_order_id" field.
+func ClientOrderIDNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldClientOrderID, v))
+}
+
+// ClientOrderIDIn applies the In predicate on the "client_order_id" field.
+func ClientOrderIDIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDNotIn applies the NotIn predicate on the "client_order_id" field.
+func ClientOrderIDNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldClientOrderID, vs...))
+}
+
+// ClientOrderIDGT applies the GT predicate on the "client_order_id" field.
+func ClientOrderIDGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDGTE applies the GTE predicate on the "client_order_id" field.
+func ClientOrderIDGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLT applies the LT predicate on the "client_order_id" field.
+func ClientOrderIDLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldClientOrderID, v))
+}
+
+// ClientOrderIDLTE applies the LTE predicate on the "client_order_id" field.
+func ClientOrderIDLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContains applies the Contains predicate on the "client_order_id" field.
+func ClientOrderIDContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasPrefix applies the HasPrefix predicate on the "client_order_id" field.
+func ClientOrderIDHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDHasSuffix applies the HasSuffix predicate on the "client_order_id" field.
+func ClientOrderIDHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldClientOrderID, v))
+}
+
+// ClientOrderIDEqualFold applies the EqualFold predicate on the "client_order_id" field.
+func ClientOrderIDEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldClientOrderID, v))
+}
+
+// ClientOrderIDContainsFold applies the ContainsFold predicate on the "client_order_id" field.
+func ClientOrderIDContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldClientOrderID, v))
+}
+
+// BrokerOrderIDEQ applies the EQ predicate on the "broker_order_id" field.
+func BrokerOrderIDEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDNEQ applies the NEQ predicate on the "broker_order_id" field.
+func BrokerOrderIDNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDIn applies the In predicate on the "broker_order_id" field.
+func BrokerOrderIDIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldBrokerOrderID, vs...))
+}
+
+// BrokerOrderIDNotIn applies the NotIn predicate on the "broker_order_id" field.
+func BrokerOrderIDNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldBrokerOrderID, vs...))
+}
+
+// BrokerOrderIDGT applies the GT predicate on the "broker_order_id" field.
+func BrokerOrderIDGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDGTE applies the GTE predicate on the "broker_order_id" field.
+func BrokerOrderIDGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDLT applies the LT predicate on the "broker_order_id" field.
+func BrokerOrderIDLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDLTE applies the LTE predicate on the "broker_order_id" field.
+func BrokerOrderIDLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDContains applies the Contains predicate on the "broker_order_id" field.
+func BrokerOrderIDContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDHasPrefix applies the HasPrefix predicate on the "broker_order_id" field.
+func BrokerOrderIDHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDHasSuffix applies the HasSuffix predicate on the "broker_order_id" field.
+func BrokerOrderIDHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDIsNil applies the IsNil predicate on the "broker_order_id" field.
+func BrokerOrderIDIsNil() predicate.Trade {
+	return predicate.Trade(sql.FieldIsNull(FieldBrokerOrderID))
+}
+
+// BrokerOrderIDNotNil applies the NotNil predicate on the "broker_order_id" field.
+func BrokerOrderIDNotNil() predicate.Trade {
+	return predicate.Trade(sql.FieldNotNull(FieldBrokerOrderID))
+}
+
+// BrokerOrderIDEqualFold applies the EqualFold predicate on the "broker_order_id" field.
+func BrokerOrderIDEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldBrokerOrderID, v))
+}
+
+// BrokerOrderIDContainsFold applies the ContainsFold predicate on the "broker_order_id" field.
+func BrokerOrderIDContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldBrokerOrderID, v))
+}
+
+// SymbolEQ applies the EQ predicate on the "symbol" field.
+func SymbolEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldSymbol, v))
+}
+
+// SymbolNEQ applies the NEQ predicate on the "symbol" field.
+func SymbolNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldSymbol, v))
+}
+
+// SymbolIn applies the In predicate on the "symbol" field.
+func SymbolIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldSymbol, vs...))
+}
+
+// SymbolNotIn applies the NotIn predicate on the "symbol" field.
+func SymbolNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldSymbol, vs...))
+}
+
+// SymbolGT applies the GT predicate on the "symbol" field.
+func SymbolGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldSymbol, v))
+}
+
+// SymbolGTE applies the GTE predicate on the "symbol" field.
+func SymbolGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldSymbol, v))
+}
+
+// SymbolLT applies the LT predicate on the "symbol" field.
+func SymbolLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldSymbol, v))
+}
+
+// SymbolLTE applies the LTE predicate on the "symbol" field.
+func SymbolLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldSymbol, v))
+}
+
+// SymbolContains applies the Contains predicate on the "symbol" field.
+func SymbolContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldSymbol, v))
+}
+
+// SymbolHasPrefix applies the HasPrefix predicate on the "symbol" field.
+func SymbolHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldSymbol, v))
+}
+
+// SymbolHasSuffix applies the HasSuffix predicate on the "symbol" field.
+func SymbolHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldSymbol, v))
+}
+
+// SymbolEqualFold applies the EqualFold predicate on the "symbol" field.
+func SymbolEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldSymbol, v))
+}
+
+// SymbolContainsFold applies the ContainsFold predicate on the "symbol" field.
+func SymbolContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldSymbol, v))
+}
+
+// ExchangeEQ applies the EQ predicate on the "exchange" field.
+func ExchangeEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExchange, v))
+}
+
+// ExchangeNEQ applies the NEQ predicate on the "exchange" field.
+func ExchangeNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldExchange, v))
+}
+
+// ExchangeIn applies the In predicate on the "exchange" field.
+func ExchangeIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldExchange, vs...))
+}
+
+// ExchangeNotIn applies the NotIn predicate on the "exchange" field.
+func ExchangeNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldExchange, vs...))
+}
+
+// ExchangeGT applies the GT predicate on the "exchange" field.
+func ExchangeGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldExchange, v))
+}
+
+// ExchangeGTE applies the GTE predicate on the "exchange" field.
+func ExchangeGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldExchange, v))
+}
+
+// ExchangeLT applies the LT predicate on the "exchange" field.
+func ExchangeLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldExchange, v))
+}
+
+// ExchangeLTE applies the LTE predicate on the "exchange" field.
+func ExchangeLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldExchange, v))
+}
+
+// ExchangeContains applies the Contains predicate on the "exchange" field.
+func ExchangeContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldExchange, v))
+}
+
+// ExchangeHasPrefix applies the HasPrefix predicate on the "exchange" field.
+func ExchangeHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldExchange, v))
+}
+
+// ExchangeHasSuffix applies the HasSuffix predicate on the "exchange" field.
+func ExchangeHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldExchange, v))
+}
+
+// ExchangeIsNil applies the IsNil predicate on the "exchange" field.
+func ExchangeIsNil() predicate.Trade {
+	return predicate.Trade(sql.FieldIsNull(FieldExchange))
+}
+
+// ExchangeNotNil applies the NotNil predicate on the "exchange" field.
+func ExchangeNotNil() predicate.Trade {
+	return predicate.Trade(sql.FieldNotNull(FieldExchange))
+}
+
+// ExchangeEqualFold applies the EqualFold predicate on the "exchange" field.
+func ExchangeEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldExchange, v))
+}
+
+// ExchangeContainsFold applies the ContainsFold predicate on the "exchange" field.
+func ExchangeContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldExchange, v))
+}
+
+// SideEQ applies the EQ predicate on the "side" field.
+func SideEQ(v Side) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldSide, v))
+}
+
+// SideNEQ applies the NEQ predicate on the "side" field.
+func SideNEQ(v Side) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldSide, v))
+}
+
+// SideIn applies the In predicate on the "side" field.
+func SideIn(vs ...Side) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldSide, vs...))
+}
+
+// SideNotIn applies the NotIn predicate on the "side" field.
+func SideNotIn(vs ...Side) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldSide, vs...))
+}
+
+// QuantityEQ applies the EQ predicate on the "quantity" field.
+func QuantityEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldQuantity, v))
+}
+
+// QuantityNEQ applies the NEQ predicate on the "quantity" field.
+func QuantityNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldQuantity, v))
+}
+
+// QuantityIn applies the In predicate on the "quantity" field.
+func QuantityIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldQuantity, vs...))
+}
+
+// QuantityNotIn applies the NotIn predicate on the "quantity" field.
+func QuantityNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldQuantity, vs...))
+}
+
+// QuantityGT applies the GT predicate on the "quantity" field.
+func QuantityGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldQuantity, v))
+}
+
+// QuantityGTE applies the GTE predicate on the "quantity" field.
+func QuantityGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldQuantity, v))
+}
+
+// QuantityLT applies the LT predicate on the "quantity" field.
+func QuantityLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldQuantity, v))
+}
+
+// QuantityLTE applies the LTE predicate on the "quantity" field.
+func QuantityLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldQuantity, v))
+}
+
+// PriceEQ applies the EQ predicate on the "price" field.
+func PriceEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldPrice, v))
+}
+
+// PriceNEQ applies the NEQ predicate on the "price" field.
+func PriceNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldPrice, v))
+}
+
+// PriceIn applies the In predicate on the "price" field.
+func PriceIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldPrice, vs...))
+}
+
+// PriceNotIn applies the NotIn predicate on the "price" field.
+func PriceNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldPrice, vs...))
+}
+
+// PriceGT applies the GT predicate on the "price" field.
+func PriceGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldPrice, v))
+}
+
+// PriceGTE applies the GTE predicate on the "price" field.
+func PriceGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldPrice, v))
+}
+
+// PriceLT applies the LT predicate on the "price" field.
+func PriceLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldPrice, v))
+}
+
+// PriceLTE applies the LTE predicate on the "price" field.
+func PriceLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldPrice, v))
+}
+
+// FeeEQ applies the EQ predicate on the "fee" field.
+func FeeEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFee, v))
+}
+
+// FeeNEQ applies the NEQ predicate on the "fee" field.
+func FeeNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldFee, v))
+}
+
+// FeeIn applies the In predicate on the "fee" field.
+func FeeIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldFee, vs...))
+}
+
+// FeeNotIn applies the NotIn predicate on the "fee" field.
+func FeeNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldFee, vs...))
+}
+
+// FeeGT applies the GT predicate on the "fee" field.
+func FeeGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldFee, v))
+}
+
+// FeeGTE applies the GTE predicate on the "fee" field.
+func FeeGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldFee, v))
+}
+
+// FeeLT applies the LT predicate on the "fee" field.
+func FeeLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldFee, v))
+}
+
+// FeeLTE applies the LTE predicate on the "fee" field.
+func FeeLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldFee, v))
+}
+
+// CurrencyEQ applies the EQ predicate on the "currency" field.
+func CurrencyEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCurrency, v))
+}
+
+// CurrencyNEQ applies the NEQ predicate on the "currency" field.
+func CurrencyNEQ(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCurrency, v))
+}
+
+// CurrencyIn applies the In predicate on the "currency" field.
+func CurrencyIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCurrency, vs...))
+}
+
+// CurrencyNotIn applies the NotIn predicate on the "currency" field.
+func CurrencyNotIn(vs ...string) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCurrency, vs...))
+}
+
+// CurrencyGT applies the GT predicate on the "currency" field.
+func CurrencyGT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCurrency, v))
+}
+
+// CurrencyGTE applies the GTE predicate on the "currency" field.
+func CurrencyGTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCurrency, v))
+}
+
+// CurrencyLT applies the LT predicate on the "currency" field.
+func CurrencyLT(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCurrency, v))
+}
+
+// CurrencyLTE applies the LTE predicate on the "currency" field.
+func CurrencyLTE(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCurrency, v))
+}
+
+// CurrencyContains applies the Contains predicate on the "currency" field.
+func CurrencyContains(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContains(FieldCurrency, v))
+}
+
+// CurrencyHasPrefix applies the HasPrefix predicate on the "currency" field.
+func CurrencyHasPrefix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasPrefix(FieldCurrency, v))
+}
+
+// CurrencyHasSuffix applies the HasSuffix predicate on the "currency" field.
+func CurrencyHasSuffix(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldHasSuffix(FieldCurrency, v))
+}
+
+// CurrencyEqualFold applies the EqualFold predicate on the "currency" field.
+func CurrencyEqualFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldEqualFold(FieldCurrency, v))
+}
+
+// CurrencyContainsFold applies the ContainsFold predicate on the "currency" field.
+func CurrencyContainsFold(v string) predicate.Trade {
+	return predicate.Trade(sql.FieldContainsFold(FieldCurrency, v))
+}
+
+// FxRateEQ applies the EQ predicate on the "fx_rate" field.
+func FxRateEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFxRate, v))
+}
+
+// FxRateNEQ applies the NEQ predicate on the "fx_rate" field.
+func FxRateNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldFxRate, v))
+}
+
+// FxRateIn applies the In predicate on the "fx_rate" field.
+func FxRateIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldFxRate, vs...))
+}
+
+// FxRateNotIn applies the NotIn predicate on the "fx_rate" field.
+func FxRateNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldFxRate, vs...))
+}
+
+// FxRateGT applies the GT predicate on the "fx_rate" field.
+func FxRateGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldFxRate, v))
+}
+
+// FxRateGTE applies the GTE predicate on the "fx_rate" field.
+func FxRateGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldFxRate, v))
+}
+
+// FxRateLT applies the LT predicate on the "fx_rate" field.
+func FxRateLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldFxRate, v))
+}
+
+// FxRateLTE applies the LTE predicate on the "fx_rate" field.
+func FxRateLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldFxRate, v))
+}
+
+// FilledQuantityEQ applies the EQ predicate on the "filled_quantity" field.
+func FilledQuantityEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFilledQuantity, v))
+}
+
+// FilledQuantityNEQ applies the NEQ predicate on the "filled_quantity" field.
+func FilledQuantityNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldFilledQuantity, v))
+}
+
+// FilledQuantityIn applies the In predicate on the "filled_quantity" field.
+func FilledQuantityIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldFilledQuantity, vs...))
+}
+
+// FilledQuantityNotIn applies the NotIn predicate on the "filled_quantity" field.
+func FilledQuantityNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldFilledQuantity, vs...))
+}
+
+// FilledQuantityGT applies the GT predicate on the "filled_quantity" field.
+func FilledQuantityGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldFilledQuantity, v))
+}
+
+// FilledQuantityGTE applies the GTE predicate on the "filled_quantity" field.
+func FilledQuantityGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldFilledQuantity, v))
+}
+
+// FilledQuantityLT applies the LT predicate on the "filled_quantity" field.
+func FilledQuantityLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldFilledQuantity, v))
+}
+
+// FilledQuantityLTE applies the LTE predicate on the "filled_quantity" field.
+func FilledQuantityLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldFilledQuantity, v))
+}
+
+// ProceedsEQ applies the EQ predicate on the "proceeds" field.
+func ProceedsEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldProceeds, v))
+}
+
+// ProceedsNEQ applies the NEQ predicate on the "proceeds" field.
+func ProceedsNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldProceeds, v))
+}
+
+// ProceedsIn applies the In predicate on the "proceeds" field.
+func ProceedsIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldProceeds, vs...))
+}
+
+// ProceedsNotIn applies the NotIn predicate on the "proceeds" field.
+func ProceedsNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldProceeds, vs...))
+}
+
+// ProceedsGT applies the GT predicate on the "proceeds" field.
+func ProceedsGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldProceeds, v))
+}
+
+// ProceedsGTE applies the GTE predicate on the "proceeds" field.
+func ProceedsGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldProceeds, v))
+}
+
+// ProceedsLT applies the LT predicate on the "proceeds" field.
+func ProceedsLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldProceeds, v))
+}
+
+// ProceedsLTE applies the LTE predicate on the "proceeds" field.
+func ProceedsLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldProceeds, v))
+}
+
+// CostBasisFifoEQ applies the EQ predicate on the "cost_basis_fifo" field.
+func CostBasisFifoEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoNEQ applies the NEQ predicate on the "cost_basis_fifo" field.
+func CostBasisFifoNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoIn applies the In predicate on the "cost_basis_fifo" field.
+func CostBasisFifoIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCostBasisFifo, vs...))
+}
+
+// CostBasisFifoNotIn applies the NotIn predicate on the "cost_basis_fifo" field.
+func CostBasisFifoNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCostBasisFifo, vs...))
+}
+
+// CostBasisFifoGT applies the GT predicate on the "cost_basis_fifo" field.
+func CostBasisFifoGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoGTE applies the GTE predicate on the "cost_basis_fifo" field.
+func CostBasisFifoGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoLT applies the LT predicate on the "cost_basis_fifo" field.
+func CostBasisFifoLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoLTE applies the LTE predicate on the "cost_basis_fifo" field.
+func CostBasisFifoLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCostBasisFifo, v))
+}
+
+// CostBasisFifoKrwEQ applies the EQ predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisFifoKrwNEQ applies the NEQ predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisFifoKrwIn applies the In predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCostBasisFifoKrw, vs...))
+}
+
+// CostBasisFifoKrwNotIn applies the NotIn predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCostBasisFifoKrw, vs...))
+}
+
+// CostBasisFifoKrwGT applies the GT predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisFifoKrwGTE applies the GTE predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisFifoKrwLT applies the LT predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisFifoKrwLTE applies the LTE predicate on the "cost_basis_fifo_krw" field.
+func CostBasisFifoKrwLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCostBasisFifoKrw, v))
+}
+
+// CostBasisAvgEQ applies the EQ predicate on the "cost_basis_avg" field.
+func CostBasisAvgEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgNEQ applies the NEQ predicate on the "cost_basis_avg" field.
+func CostBasisAvgNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgIn applies the In predicate on the "cost_basis_avg" field.
+func CostBasisAvgIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCostBasisAvg, vs...))
+}
+
+// CostBasisAvgNotIn applies the NotIn predicate on the "cost_basis_avg" field.
+func CostBasisAvgNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCostBasisAvg, vs...))
+}
+
+// CostBasisAvgGT applies the GT predicate on the "cost_basis_avg" field.
+func CostBasisAvgGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgGTE applies the GTE predicate on the "cost_basis_avg" field.
+func CostBasisAvgGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgLT applies the LT predicate on the "cost_basis_avg" field.
+func CostBasisAvgLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgLTE applies the LTE predicate on the "cost_basis_avg" field.
+func CostBasisAvgLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCostBasisAvg, v))
+}
+
+// CostBasisAvgKrwEQ applies the EQ predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCostBasisAvgKrw, v))
+}
+
+// CostBasisAvgKrwNEQ applies the NEQ predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCostBasisAvgKrw, v))
+}
+
+// CostBasisAvgKrwIn applies the In predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCostBasisAvgKrw, vs...))
+}
+
+// CostBasisAvgKrwNotIn applies the NotIn predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCostBasisAvgKrw, vs...))
+}
+
+// CostBasisAvgKrwGT applies the GT predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCostBasisAvgKrw, v))
+}
+
+// CostBasisAvgKrwGTE applies the GTE predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCostBasisAvgKrw, v))
+}
+
+// CostBasisAvgKrwLT applies the LT predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCostBasisAvgKrw, v))
+}
+
+// CostBasisAvgKrwLTE applies the LTE predicate on the "cost_basis_avg_krw" field.
+func CostBasisAvgKrwLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCostBasisAvgKrw, v))
+}
+
+// EstimatedBasisEQ applies the EQ predicate on the "estimated_basis" field.
+func EstimatedBasisEQ(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldEstimatedBasis, v))
+}
+
+// EstimatedBasisNEQ applies the NEQ predicate on the "estimated_basis" field.
+func EstimatedBasisNEQ(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldEstimatedBasis, v))
+}
+
+// PositionAfterEQ applies the EQ predicate on the "position_after" field.
+func PositionAfterEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldPositionAfter, v))
+}
+
+// PositionAfterNEQ applies the NEQ predicate on the "position_after" field.
+func PositionAfterNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldPositionAfter, v))
+}
+
+// PositionAfterIn applies the In predicate on the "position_after" field.
+func PositionAfterIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldPositionAfter, vs...))
+}
+
+// PositionAfterNotIn applies the NotIn predicate on the "position_after" field.
+func PositionAfterNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldPositionAfter, vs...))
+}
+
+// PositionAfterGT applies the GT predicate on the "position_after" field.
+func PositionAfterGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldPositionAfter, v))
+}
+
+// PositionAfterGTE applies the GTE predicate on the "position_after" field.
+func PositionAfterGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldPositionAfter, v))
+}
+
+// PositionAfterLT applies the LT predicate on the "position_after" field.
+func PositionAfterLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldPositionAfter, v))
+}
+
+// PositionAfterLTE applies the LTE predicate on the "position_after" field.
+func PositionAfterLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldPositionAfter, v))
+}
+
+// AvgCostAfterEQ applies the EQ predicate on the "avg_cost_after" field.
+func AvgCostAfterEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldAvgCostAfter, v))
+}
+
+// AvgCostAfterNEQ applies the NEQ predicate on the "avg_cost_after" field.
+func AvgCostAfterNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldAvgCostAfter, v))
+}
+
+// AvgCostAfterIn applies the In predicate on the "avg_cost_after" field.
+func AvgCostAfterIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldAvgCostAfter, vs...))
+}
+
+// AvgCostAfterNotIn applies the NotIn predicate on the "avg_cost_after" field.
+func AvgCostAfterNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldAvgCostAfter, vs...))
+}
+
+// AvgCostAfterGT applies the GT predicate on the "avg_cost_after" field.
+func AvgCostAfterGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldAvgCostAfter, v))
+}
+
+// AvgCostAfterGTE applies the GTE predicate on the "avg_cost_after" field.
+func AvgCostAfterGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldAvgCostAfter, v))
+}
+
+// AvgCostAfterLT applies the LT predicate on the "avg_cost_after" field.
+func AvgCostAfterLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldAvgCostAfter, v))
+}
+
+// AvgCostAfterLTE applies the LTE predicate on the "avg_cost_after" field.
+func AvgCostAfterLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldAvgCostAfter, v))
+}
+
+// AvgCostKrwAfterEQ applies the EQ predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldAvgCostKrwAfter, v))
+}
+
+// AvgCostKrwAfterNEQ applies the NEQ predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterNEQ(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldAvgCostKrwAfter, v))
+}
+
+// AvgCostKrwAfterIn applies the In predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldAvgCostKrwAfter, vs...))
+}
+
+// AvgCostKrwAfterNotIn applies the NotIn predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterNotIn(vs ...decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldAvgCostKrwAfter, vs...))
+}
+
+// AvgCostKrwAfterGT applies the GT predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterGT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldAvgCostKrwAfter, v))
+}
+
+// AvgCostKrwAfterGTE applies the GTE predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterGTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldAvgCostKrwAfter, v))
+}
+
+// AvgCostKrwAfterLT applies the LT predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterLT(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldAvgCostKrwAfter, v))
+}
+
+// AvgCostKrwAfterLTE applies the LTE predicate on the "avg_cost_krw_after" field.
+func AvgCostKrwAfterLTE(v decimal.Decimal) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldAvgCostKrwAfter, v))
+}
+
+// FxPendingEQ applies the EQ predicate on the "fx_pending" field.
+func FxPendingEQ(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldFxPending, v))
+}
+
+// FxPendingNEQ applies the NEQ predicate on the "fx_pending" field.
+func FxPendingNEQ(v bool) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldFxPending, v))
+}
+
+// ExecutedAtEQ applies the EQ predicate on the "executed_at" field.
+func ExecutedAtEQ(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtNEQ applies the NEQ predicate on the "executed_at" field.
+func ExecutedAtNEQ(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldExecutedAt, v))
+}
+
+// ExecutedAtIn applies the In predicate on the "executed_at" field.
+func ExecutedAtIn(vs ...time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtNotIn applies the NotIn predicate on the "executed_at" field.
+func ExecutedAtNotIn(vs ...time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldExecutedAt, vs...))
+}
+
+// ExecutedAtGT applies the GT predicate on the "executed_at" field.
+func ExecutedAtGT(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldExecutedAt, v))
+}
+
+// ExecutedAtGTE applies the GTE predicate on the "executed_at" field.
+func ExecutedAtGTE(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldExecutedAt, v))
+}
+
+// ExecutedAtLT applies the LT predicate on the "executed_at" field.
+func ExecutedAtLT(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldExecutedAt, v))
+}
+
+// ExecutedAtLTE applies the LTE predicate on the "executed_at" field.
+func ExecutedAtLTE(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldExecutedAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Trade {
+	return predicate.Trade(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Trade) predicate.Trade {
+	return predicate.Trade(sql.NotPredicates(p))
+}
@@ -0,0 +1,722 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/trade"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TradeCreate is the builder for creating a Trade entity.
+type TradeCreate struct {
+	config
+	mutation *TradeMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (_c *TradeCreate) SetUserID(v uuid.UUID) *TradeCreate {
+	_c.mutation.SetUserID(v)
+	return _c
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_c *TradeCreate) SetClientOrderID(v string) *TradeCreate {
+	_c.mutation.SetClientOrderID(v)
+	return _c
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_c *TradeCreate) SetBrokerOrderID(v string) *TradeCreate {
+	_c.mutation.SetBrokerOrderID(v)
+	return _c
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableBrokerOrderID(v *string) *TradeCreate {
+	if v != nil {
+		_c.SetBrokerOrderID(*v)
+	}
+	return _c
+}
+
+// SetSymbol sets the "symbol" field.
+func (_c *TradeCreate) SetSymbol(v string) *TradeCreate {
+	_c.mutation.SetSymbol(v)
+	return _c
+}
+
+// SetExchange sets the "exchange" field.
+func (_c *TradeCreate) SetExchange(v string) *TradeCreate {
+	_c.mutation.SetExchange(v)
+	return _c
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableExchange(v *string) *TradeCreate {
+	if v != nil {
+		_c.SetExchange(*v)
+	}
+	return _c
+}
+
+// SetSide sets the "side" field.
+func (_c *TradeCreate) SetSide(v trade.Side) *TradeCreate {
+	_c.mutation.SetSide(v)
+	return _c
+}
+
+// SetQuantity sets the "quantity" field.
+func (_c *TradeCreate) SetQuantity(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetQuantity(v)
+	return _c
+}
+
+// SetPrice sets the "price" field.
+func (_c *TradeCreate) SetPrice(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetPrice(v)
+	return _c
+}
+
+// SetFee sets the "fee" field.
+func (_c *TradeCreate) SetFee(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetFee(v)
+	return _c
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableFee(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetFee(*v)
+	}
+	return _c
+}
+
+// SetCurrency sets the "currency" field.
+func (_c *TradeCreate) SetCurrency(v string) *TradeCreate {
+	_c.mutation.SetCurrency(v)
+	return _c
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCurrency(v *string) *TradeCreate {
+	if v != nil {
+		_c.SetCurrency(*v)
+	}
+	return _c
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_c *TradeCreate) SetFxRate(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetFxRate(v)
+	return _c
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableFxRate(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetFxRate(*v)
+	}
+	return _c
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_c *TradeCreate) SetFilledQuantity(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetFilledQuantity(v)
+	return _c
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_c *TradeCreate) SetProceeds(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetProceeds(v)
+	return _c
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableProceeds(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetProceeds(*v)
+	}
+	return _c
+}
+
+// SetCostBasisFifo sets the "cost_basis_fifo" field.
+func (_c *TradeCreate) SetCostBasisFifo(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetCostBasisFifo(v)
+	return _c
+}
+
+// SetNillableCostBasisFifo sets the "cost_basis_fifo" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCostBasisFifo(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetCostBasisFifo(*v)
+	}
+	return _c
+}
+
+// SetCostBasisFifoKrw sets the "cost_basis_fifo_krw" field.
+func (_c *TradeCreate) SetCostBasisFifoKrw(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetCostBasisFifoKrw(v)
+	return _c
+}
+
+// SetNillableCostBasisFifoKrw sets the "cost_basis_fifo_krw" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCostBasisFifoKrw(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetCostBasisFifoKrw(*v)
+	}
+	return _c
+}
+
+// SetCostBasisAvg sets the "cost_basis_avg" field.
+func (_c *TradeCreate) SetCostBasisAvg(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetCostBasisAvg(v)
+	return _c
+}
+
+// SetNillableCostBasisAvg sets the "cost_basis_avg" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCostBasisAvg(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetCostBasisAvg(*v)
+	}
+	return _c
+}
+
+// SetCostBasisAvgKrw sets the "cost_basis_avg_krw" field.
+func (_c *TradeCreate) SetCostBasisAvgKrw(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetCostBasisAvgKrw(v)
+	return _c
+}
+
+// SetNillableCostBasisAvgKrw sets the "cost_basis_avg_krw" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCostBasisAvgKrw(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetCostBasisAvgKrw(*v)
+	}
+	return _c
+}
+
+// SetEstimatedBasis sets the "estimated_basis" field.
+func (_c *TradeCreate) SetEstimatedBasis(v bool) *TradeCreate {
+	_c.mutation.SetEstimatedBasis(v)
+	return _c
+}
+
+// SetNillableEstimatedBasis sets the "estimated_basis" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableEstimatedBasis(v *bool) *TradeCreate {
+	if v != nil {
+		_c.SetEstimatedBasis(*v)
+	}
+	return _c
+}
+
+// SetPositionAfter sets the "position_after" field.
+func (_c *TradeCreate) SetPositionAfter(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetPositionAfter(v)
+	return _c
+}
+
+// SetNillablePositionAfter sets the "position_after" field if the given value is not nil.
+func (_c *TradeCreate) SetNillablePositionAfter(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetPositionAfter(*v)
+	}
+	return _c
+}
+
+// SetAvgCostAfter sets the "avg_cost_after" field.
+func (_c *TradeCreate) SetAvgCostAfter(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetAvgCostAfter(v)
+	return _c
+}
+
+// SetNillableAvgCostAfter sets the "avg_cost_after" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableAvgCostAfter(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetAvgCostAfter(*v)
+	}
+	return _c
+}
+
+// SetAvgCostKrwAfter sets the "avg_cost_krw_after" field.
+func (_c *TradeCreate) SetAvgCostKrwAfter(v decimal.Decimal) *TradeCreate {
+	_c.mutation.SetAvgCostKrwAfter(v)
+	return _c
+}
+
+// SetNillableAvgCostKrwAfter sets the "avg_cost_krw_after" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableAvgCostKrwAfter(v *decimal.Decimal) *TradeCreate {
+	if v != nil {
+		_c.SetAvgCostKrwAfter(*v)
+	}
+	return _c
+}
+
+// SetFxPending sets the "fx_pending" field.
+func (_c *TradeCreate) SetFxPending(v bool) *TradeCreate {
+	_c.mutation.SetFxPending(v)
+	return _c
+}
+
+// SetNillableFxPending sets the "fx_pending" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableFxPending(v *bool) *TradeCreate {
+	if v != nil {
+		_c.SetFxPending(*v)
+	}
+	return _c
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_c *TradeCreate) SetExecutedAt(v time.Time) *TradeCreate {
+	_c.mutation.SetExecutedAt(v)
+	return _c
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (_c *TradeCreate) SetCreatedAt(v time.Time) *TradeCreate {
+	_c.mutation.SetCreatedAt(v)
+	return _c
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableCreatedAt(v *time.Time) *TradeCreate {
+	if v != nil {
+		_c.SetCreatedAt(*v)
+	}
+	return _c
+}
+
+// SetID sets the "id" field.
+func (_c *TradeCreate) SetID(v uuid.UUID) *TradeCreate {
+	_c.mutation.SetID(v)
+	return _c
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (_c *TradeCreate) SetNillableID(v *uuid.UUID) *TradeCreate {
+	if v != nil {
+		_c.SetID(*v)
+	}
+	return _c
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (_c *TradeCreate) Mutation() *TradeMutation {
+	return _c.mutation
+}
+
+// Save creates the Trade in the database.
+func (_c *TradeCreate) Save(ctx context.Context) (*Trade, error) {
+	_c.defaults()
+	return withHooks(ctx, _c.sqlSave, _c.mutation, _c.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (_c *TradeCreate) SaveX(ctx context.Context) *Trade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TradeCreate) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TradeCreate) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (_c *TradeCreate) defaults() {
+	if _, ok := _c.mutation.Fee(); !ok {
+		v := trade.DefaultFee
+		_c.mutation.SetFee(v)
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		v := trade.DefaultCurrency
+		_c.mutation.SetCurrency(v)
+	}
+	if _, ok := _c.mutation.FxRate(); !ok {
+		v := trade.DefaultFxRate
+		_c.mutation.SetFxRate(v)
+	}
+	if _, ok := _c.mutation.Proceeds(); !ok {
+		v := trade.DefaultProceeds
+		_c.mutation.SetProceeds(v)
+	}
+	if _, ok := _c.mutation.CostBasisFifo(); !ok {
+		v := trade.DefaultCostBasisFifo
+		_c.mutation.SetCostBasisFifo(v)
+	}
+	if _, ok := _c.mutation.CostBasisFifoKrw(); !ok {
+		v := trade.DefaultCostBasisFifoKrw
+		_c.mutation.SetCostBasisFifoKrw(v)
+	}
+	if _, ok := _c.mutation.CostBasisAvg(); !ok {
+		v := trade.DefaultCostBasisAvg
+		_c.mutation.SetCostBasisAvg(v)
+	}
+	if _, ok := _c.mutation.CostBasisAvgKrw(); !ok {
+		v := trade.DefaultCostBasisAvgKrw
+		_c.mutation.SetCostBasisAvgKrw(v)
+	}
+	if _, ok := _c.mutation.EstimatedBasis(); !ok {
+		v := trade.DefaultEstimatedBasis
+		_c.mutation.SetEstimatedBasis(v)
+	}
+	if _, ok := _c.mutation.PositionAfter(); !ok {
+		v := trade.DefaultPositionAfter
+		_c.mutation.SetPositionAfter(v)
+	}
+	if _, ok := _c.mutation.AvgCostAfter(); !ok {
+		v := trade.DefaultAvgCostAfter
+		_c.mutation.SetAvgCostAfter(v)
+	}
+	if _, ok := _c.mutation.AvgCostKrwAfter(); !ok {
+		v := trade.DefaultAvgCostKrwAfter
+		_c.mutation.SetAvgCostKrwAfter(v)
+	}
+	if _, ok := _c.mutation.FxPending(); !ok {
+		v := trade.DefaultFxPending
+		_c.mutation.SetFxPending(v)
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		v := trade.DefaultCreatedAt()
+		_c.mutation.SetCreatedAt(v)
+	}
+	if _, ok := _c.mutation.ID(); !ok {
+		v := trade.DefaultID()
+		_c.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_c *TradeCreate) check() error {
+	if _, ok := _c.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Trade.user_id"`)}
+	}
+	if _, ok := _c.mutation.ClientOrderID(); !ok {
+		return &ValidationError{Name: "client_order_id", err: errors.New(`ent: missing required field "Trade.client_order_id"`)}
+	}
+	if v, ok := _c.mutation.ClientOrderID(); ok {
+		if err := trade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.BrokerOrderID(); ok {
+		if err := trade.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.broker_order_id": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Symbol(); !ok {
+		return &ValidationError{Name: "symbol", err: errors.New(`ent: missing required field "Trade.symbol"`)}
+	}
+	if v, ok := _c.mutation.Symbol(); ok {
+		if err := trade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Trade.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _c.mutation.Exchange(); ok {
+		if err := trade.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Trade.exchange": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Side(); !ok {
+		return &ValidationError{Name: "side", err: errors.New(`ent: missing required field "Trade.side"`)}
+	}
+	if v, ok := _c.mutation.Side(); ok {
+		if err := trade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Trade.side": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.Quantity(); !ok {
+		return &ValidationError{Name: "quantity", err: errors.New(`ent: missing required field "Trade.quantity"`)}
+	}
+	if _, ok := _c.mutation.Price(); !ok {
+		return &ValidationError{Name: "price", err: errors.New(`ent: missing required field "Trade.price"`)}
+	}
+	if _, ok := _c.mutation.Fee(); !ok {
+		return &ValidationError{Name: "fee", err: errors.New(`ent: missing required field "Trade.fee"`)}
+	}
+	if _, ok := _c.mutation.Currency(); !ok {
+		return &ValidationError{Name: "currency", err: errors.New(`ent: missing required field "Trade.currency"`)}
+	}
+	if v, ok := _c.mutation.Currency(); ok {
+		if err := trade.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Trade.currency": %w`, err)}
+		}
+	}
+	if _, ok := _c.mutation.FxRate(); !ok {
+		return &ValidationError{Name: "fx_rate", err: errors.New(`ent: missing required field "Trade.fx_rate"`)}
+	}
+	if _, ok := _c.mutation.FilledQuantity(); !ok {
+		return &ValidationError{Name: "filled_quantity", err: errors.New(`ent: missing required field "Trade.filled_quantity"`)}
+	}
+	if _, ok := _c.mutation.Proceeds(); !ok {
+		return &ValidationError{Name: "proceeds", err: errors.New(`ent: missing required field "Trade.proceeds"`)}
+	}
+	if _, ok := _c.mutation.CostBasisFifo(); !ok {
+		return &ValidationError{Name: "cost_basis_fifo", err: errors.New(`ent: missing required field "Trade.cost_basis_fifo"`)}
+	}
+	if _, ok := _c.mutation.CostBasisFifoKrw(); !ok {
+		return &ValidationError{Name: "cost_basis_fifo_krw", err: errors.New(`ent: missing required field "Trade.cost_basis_fifo_krw"`)}
+	}
+	if _, ok := _c.mutation.CostBasisAvg(); !ok {
+		return &ValidationError{Name: "cost_basis_avg", err: errors.New(`ent: missing required field "Trade.cost_basis_avg"`)}
+	}
+	if _, ok := _c.mutation.CostBasisAvgKrw(); !ok {
+		return &ValidationError{Name: "cost_basis_avg_krw", err: errors.New(`ent: missing required field "Trade.cost_basis_avg_krw"`)}
+	}
+	if _, ok := _c.mutation.EstimatedBasis(); !ok {
+		return &ValidationError{Name: "estimated_basis", err: errors.New(`ent: missing required field "Trade.estimated_basis"`)}
+	}
+	if _, ok := _c.mutation.PositionAfter(); !ok {
+		return &ValidationError{Name: "position_after", err: errors.New(`ent: missing required field "Trade.position_after"`)}
+	}
+	if _, ok := _c.mutation.AvgCostAfter(); !ok {
+		return &ValidationError{Name: "avg_cost_after", err: errors.New(`ent: missing required field "Trade.avg_cost_after"`)}
+	}
+	if _, ok := _c.mutation.AvgCostKrwAfter(); !ok {
+		return &ValidationError{Name: "avg_cost_krw_after", err: errors.New(`ent: missing required field "Trade.avg_cost_krw_after"`)}
+	}
+	if _, ok := _c.mutation.FxPending(); !ok {
+		return &ValidationError{Name: "fx_pending", err: errors.New(`ent: missing required field "Trade.fx_pending"`)}
+	}
+	if _, ok := _c.mutation.ExecutedAt(); !ok {
+		return &ValidationError{Name: "executed_at", err: errors.New(`ent: missing required field "Trade.executed_at"`)}
+	}
+	if _, ok := _c.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Trade.created_at"`)}
+	}
+	return nil
+}
+
+func (_c *TradeCreate) sqlSave(ctx context.Context) (*Trade, error) {
+	if err := _c.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := _c.createSpec()
+	if err := sqlgraph.CreateNode(ctx, _c.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*uuid.UUID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	_c.mutation.id = &_node.ID
+	_c.mutation.done = true
+	return _node, nil
+}
+
+func (_c *TradeCreate) createSpec() (*Trade, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Trade{config: _c.config}
+		_spec = sqlgraph.NewCreateSpec(trade.Table, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeUUID))
+	)
+	if id, ok := _c.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := _c.mutation.UserID(); ok {
+		_spec.SetField(trade.FieldUserID, field.TypeUUID, value)
+		_node.UserID = value
+	}
+	if value, ok := _c.mutation.ClientOrderID(); ok {
+		_spec.SetField(trade.FieldClientOrderID, field.TypeString, value)
+		_node.ClientOrderID = value
+	}
+	if value, ok := _c.mutation.BrokerOrderID(); ok {
+		_spec.SetField(trade.FieldBrokerOrderID, field.TypeString, value)
+		_node.BrokerOrderID = value
+	}
+	if value, ok := _c.mutation.Symbol(); ok {
+		_spec.SetField(trade.FieldSymbol, field.TypeString, value)
+		_node.Symbol = value
+	}
+	if value, ok := _c.mutation.Exchange(); ok {
+		_spec.SetField(trade.FieldExchange, field.TypeString, value)
+		_node.Exchange = value
+	}
+	if value, ok := _c.mutation.Side(); ok {
+		_spec.SetField(trade.FieldSide, field.TypeEnum, value)
+		_node.Side = value
+	}
+	if value, ok := _c.mutation.Quantity(); ok {
+		_spec.SetField(trade.FieldQuantity, field.TypeOther, value)
+		_node.Quantity = value
+	}
+	if value, ok := _c.mutation.Price(); ok {
+		_spec.SetField(trade.FieldPrice, field.TypeOther, value)
+		_node.Price = value
+	}
+	if value, ok := _c.mutation.Fee(); ok {
+		_spec.SetField(trade.FieldFee, field.TypeOther, value)
+		_node.Fee = value
+	}
+	if value, ok := _c.mutation.Currency(); ok {
+		_spec.SetField(trade.FieldCurrency, field.TypeString, value)
+		_node.Currency = value
+	}
+	if value, ok := _c.mutation.FxRate(); ok {
+		_spec.SetField(trade.FieldFxRate, field.TypeOther, value)
+		_node.FxRate = value
+	}
+	if value, ok := _c.mutation.FilledQuantity(); ok {
+		_spec.SetField(trade.FieldFilledQuantity, field.TypeOther, value)
+		_node.FilledQuantity = value
+	}
+	if value, ok := _c.mutation.Proceeds(); ok {
+		_spec.SetField(trade.FieldProceeds, field.TypeOther, value)
+		_node.Proceeds = value
+	}
+	if value, ok := _c.mutation.CostBasisFifo(); ok {
+		_spec.SetField(trade.FieldCostBasisFifo, field.TypeOther, value)
+		_node.CostBasisFifo = value
+	}
+	if value, ok := _c.mutation.CostBasisFifoKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisFifoKrw, field.TypeOther, value)
+		_node.CostBasisFifoKrw = value
+	}
+	if value, ok := _c.mutation.CostBasisAvg(); ok {
+		_spec.SetField(trade.FieldCostBasisAvg, field.TypeOther, value)
+		_node.CostBasisAvg = value
+	}
+	if value, ok := _c.mutation.CostBasisAvgKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisAvgKrw, field.TypeOther, value)
+		_node.CostBasisAvgKrw = value
+	}
+	if value, ok := _c.mutation.EstimatedBasis(); ok {
+		_spec.SetField(trade.FieldEstimatedBasis, field.TypeBool, value)
+		_node.EstimatedBasis = value
+	}
+	if value, ok := _c.mutation.PositionAfter(); ok {
+		_spec.SetField(trade.FieldPositionAfter, field.TypeOther, value)
+		_node.PositionAfter = value
+	}
+	if value, ok := _c.mutation.AvgCostAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostAfter, field.TypeOther, value)
+		_node.AvgCostAfter = value
+	}
+	if value, ok := _c.mutation.AvgCostKrwAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostKrwAfter, field.TypeOther, value)
+		_node.AvgCostKrwAfter = value
+	}
+	if value, ok := _c.mutation.FxPending(); ok {
+		_spec.SetField(trade.FieldFxPending, field.TypeBool, value)
+		_node.FxPending = value
+	}
+	if value, ok := _c.mutation.ExecutedAt(); ok {
+		_spec.SetField(trade.FieldExecutedAt, field.TypeTime, value)
+		_node.ExecutedAt = value
+	}
+	if value, ok := _c.mutation.CreatedAt(); ok {
+		_spec.SetField(trade.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// TradeCreateBulk is the builder for creating many Trade entities in bulk.
+type TradeCreateBulk struct {
+	config
+	err      error
+	builders []*TradeCreate
+}
+
+// Save creates the Trade entities in the database.
+func (_c *TradeCreateBulk) Save(ctx context.Context) ([]*Trade, error) {
+	if _c.err != nil {
+		return nil, _c.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(_c.builders))
+	nodes := make([]*Trade, len(_c.builders))
+	mutators := make([]Mutator, len(_c.builders))
+	for i := range _c.builders {
+		func(i int, root context.Context) {
+			builder := _c.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TradeMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, _c.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, _c.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, _c.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_c *TradeCreateBulk) SaveX(ctx context.Context) []*Trade {
+	v, err := _c.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (_c *TradeCreateBulk) Exec(ctx context.Context) error {
+	_, err := _c.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_c *TradeCreateBulk) ExecX(ctx context.Context) {
+	if err := _c.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
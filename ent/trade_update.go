@@ -0,0 +1,1072 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"auto-trader/ent/predicate"
+	"auto-trader/ent/trade"
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TradeUpdate is the builder for updating Trade entities.
+type TradeUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TradeMutation
+}
+
+// Where appends a list predicates to the TradeUpdate builder.
+func (_u *TradeUpdate) Where(ps ...predicate.Trade) *TradeUpdate {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TradeUpdate) SetUserID(v uuid.UUID) *TradeUpdate {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableUserID(v *uuid.UUID) *TradeUpdate {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *TradeUpdate) SetClientOrderID(v string) *TradeUpdate {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableClientOrderID(v *string) *TradeUpdate {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_u *TradeUpdate) SetBrokerOrderID(v string) *TradeUpdate {
+	_u.mutation.SetBrokerOrderID(v)
+	return _u
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableBrokerOrderID(v *string) *TradeUpdate {
+	if v != nil {
+		_u.SetBrokerOrderID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (_u *TradeUpdate) ClearBrokerOrderID() *TradeUpdate {
+	_u.mutation.ClearBrokerOrderID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TradeUpdate) SetSymbol(v string) *TradeUpdate {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableSymbol(v *string) *TradeUpdate {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *TradeUpdate) SetExchange(v string) *TradeUpdate {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableExchange(v *string) *TradeUpdate {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *TradeUpdate) ClearExchange() *TradeUpdate {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *TradeUpdate) SetSide(v trade.Side) *TradeUpdate {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableSide(v *trade.Side) *TradeUpdate {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TradeUpdate) SetQuantity(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableQuantity(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *TradeUpdate) SetPrice(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillablePrice(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *TradeUpdate) SetFee(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableFee(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TradeUpdate) SetCurrency(v string) *TradeUpdate {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableCurrency(v *string) *TradeUpdate {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *TradeUpdate) SetFxRate(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableFxRate(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_u *TradeUpdate) SetFilledQuantity(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetFilledQuantity(v)
+	return _u
+}
+
+// SetNillableFilledQuantity sets the "filled_quantity" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableFilledQuantity(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetFilledQuantity(*v)
+	}
+	return _u
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_u *TradeUpdate) SetProceeds(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetProceeds(v)
+	return _u
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableProceeds(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetProceeds(*v)
+	}
+	return _u
+}
+
+// SetCostBasisFifo sets the "cost_basis_fifo" field.
+func (_u *TradeUpdate) SetCostBasisFifo(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetCostBasisFifo(v)
+	return _u
+}
+
+// SetNillableCostBasisFifo sets the "cost_basis_fifo" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableCostBasisFifo(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetCostBasisFifo(*v)
+	}
+	return _u
+}
+
+// SetCostBasisFifoKrw sets the "cost_basis_fifo_krw" field.
+func (_u *TradeUpdate) SetCostBasisFifoKrw(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetCostBasisFifoKrw(v)
+	return _u
+}
+
+// SetNillableCostBasisFifoKrw sets the "cost_basis_fifo_krw" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableCostBasisFifoKrw(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetCostBasisFifoKrw(*v)
+	}
+	return _u
+}
+
+// SetCostBasisAvg sets the "cost_basis_avg" field.
+func (_u *TradeUpdate) SetCostBasisAvg(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetCostBasisAvg(v)
+	return _u
+}
+
+// SetNillableCostBasisAvg sets the "cost_basis_avg" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableCostBasisAvg(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetCostBasisAvg(*v)
+	}
+	return _u
+}
+
+// SetCostBasisAvgKrw sets the "cost_basis_avg_krw" field.
+func (_u *TradeUpdate) SetCostBasisAvgKrw(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetCostBasisAvgKrw(v)
+	return _u
+}
+
+// SetNillableCostBasisAvgKrw sets the "cost_basis_avg_krw" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableCostBasisAvgKrw(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetCostBasisAvgKrw(*v)
+	}
+	return _u
+}
+
+// SetEstimatedBasis sets the "estimated_basis" field.
+func (_u *TradeUpdate) SetEstimatedBasis(v bool) *TradeUpdate {
+	_u.mutation.SetEstimatedBasis(v)
+	return _u
+}
+
+// SetNillableEstimatedBasis sets the "estimated_basis" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableEstimatedBasis(v *bool) *TradeUpdate {
+	if v != nil {
+		_u.SetEstimatedBasis(*v)
+	}
+	return _u
+}
+
+// SetPositionAfter sets the "position_after" field.
+func (_u *TradeUpdate) SetPositionAfter(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetPositionAfter(v)
+	return _u
+}
+
+// SetNillablePositionAfter sets the "position_after" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillablePositionAfter(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetPositionAfter(*v)
+	}
+	return _u
+}
+
+// SetAvgCostAfter sets the "avg_cost_after" field.
+func (_u *TradeUpdate) SetAvgCostAfter(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetAvgCostAfter(v)
+	return _u
+}
+
+// SetNillableAvgCostAfter sets the "avg_cost_after" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableAvgCostAfter(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetAvgCostAfter(*v)
+	}
+	return _u
+}
+
+// SetAvgCostKrwAfter sets the "avg_cost_krw_after" field.
+func (_u *TradeUpdate) SetAvgCostKrwAfter(v decimal.Decimal) *TradeUpdate {
+	_u.mutation.SetAvgCostKrwAfter(v)
+	return _u
+}
+
+// SetNillableAvgCostKrwAfter sets the "avg_cost_krw_after" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableAvgCostKrwAfter(v *decimal.Decimal) *TradeUpdate {
+	if v != nil {
+		_u.SetAvgCostKrwAfter(*v)
+	}
+	return _u
+}
+
+// SetFxPending sets the "fx_pending" field.
+func (_u *TradeUpdate) SetFxPending(v bool) *TradeUpdate {
+	_u.mutation.SetFxPending(v)
+	return _u
+}
+
+// SetNillableFxPending sets the "fx_pending" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableFxPending(v *bool) *TradeUpdate {
+	if v != nil {
+		_u.SetFxPending(*v)
+	}
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *TradeUpdate) SetExecutedAt(v time.Time) *TradeUpdate {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *TradeUpdate) SetNillableExecutedAt(v *time.Time) *TradeUpdate {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (_u *TradeUpdate) Mutation() *TradeMutation {
+	return _u.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (_u *TradeUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TradeUpdate) SaveX(ctx context.Context) int {
+	affected, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (_u *TradeUpdate) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TradeUpdate) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TradeUpdate) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := trade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BrokerOrderID(); ok {
+		if err := trade.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.broker_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := trade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Trade.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := trade.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Trade.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := trade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Trade.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := trade.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Trade.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TradeUpdate) sqlSave(ctx context.Context) (_node int, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trade.Table, trade.Columns, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeUUID))
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(trade.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(trade.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BrokerOrderID(); ok {
+		_spec.SetField(trade.FieldBrokerOrderID, field.TypeString, value)
+	}
+	if _u.mutation.BrokerOrderIDCleared() {
+		_spec.ClearField(trade.FieldBrokerOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(trade.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(trade.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(trade.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(trade.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(trade.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(trade.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(trade.FieldFee, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(trade.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(trade.FieldFxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FilledQuantity(); ok {
+		_spec.SetField(trade.FieldFilledQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Proceeds(); ok {
+		_spec.SetField(trade.FieldProceeds, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisFifo(); ok {
+		_spec.SetField(trade.FieldCostBasisFifo, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisFifoKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisFifoKrw, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisAvg(); ok {
+		_spec.SetField(trade.FieldCostBasisAvg, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisAvgKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisAvgKrw, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EstimatedBasis(); ok {
+		_spec.SetField(trade.FieldEstimatedBasis, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PositionAfter(); ok {
+		_spec.SetField(trade.FieldPositionAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCostAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCostKrwAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostKrwAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxPending(); ok {
+		_spec.SetField(trade.FieldFxPending, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(trade.FieldExecutedAt, field.TypeTime, value)
+	}
+	if _node, err = sqlgraph.UpdateNodes(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
+
+// TradeUpdateOne is the builder for updating a single Trade entity.
+type TradeUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TradeMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (_u *TradeUpdateOne) SetUserID(v uuid.UUID) *TradeUpdateOne {
+	_u.mutation.SetUserID(v)
+	return _u
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableUserID(v *uuid.UUID) *TradeUpdateOne {
+	if v != nil {
+		_u.SetUserID(*v)
+	}
+	return _u
+}
+
+// SetClientOrderID sets the "client_order_id" field.
+func (_u *TradeUpdateOne) SetClientOrderID(v string) *TradeUpdateOne {
+	_u.mutation.SetClientOrderID(v)
+	return _u
+}
+
+// SetNillableClientOrderID sets the "client_order_id" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableClientOrderID(v *string) *TradeUpdateOne {
+	if v != nil {
+		_u.SetClientOrderID(*v)
+	}
+	return _u
+}
+
+// SetBrokerOrderID sets the "broker_order_id" field.
+func (_u *TradeUpdateOne) SetBrokerOrderID(v string) *TradeUpdateOne {
+	_u.mutation.SetBrokerOrderID(v)
+	return _u
+}
+
+// SetNillableBrokerOrderID sets the "broker_order_id" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableBrokerOrderID(v *string) *TradeUpdateOne {
+	if v != nil {
+		_u.SetBrokerOrderID(*v)
+	}
+	return _u
+}
+
+// ClearBrokerOrderID clears the value of the "broker_order_id" field.
+func (_u *TradeUpdateOne) ClearBrokerOrderID() *TradeUpdateOne {
+	_u.mutation.ClearBrokerOrderID()
+	return _u
+}
+
+// SetSymbol sets the "symbol" field.
+func (_u *TradeUpdateOne) SetSymbol(v string) *TradeUpdateOne {
+	_u.mutation.SetSymbol(v)
+	return _u
+}
+
+// SetNillableSymbol sets the "symbol" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableSymbol(v *string) *TradeUpdateOne {
+	if v != nil {
+		_u.SetSymbol(*v)
+	}
+	return _u
+}
+
+// SetExchange sets the "exchange" field.
+func (_u *TradeUpdateOne) SetExchange(v string) *TradeUpdateOne {
+	_u.mutation.SetExchange(v)
+	return _u
+}
+
+// SetNillableExchange sets the "exchange" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableExchange(v *string) *TradeUpdateOne {
+	if v != nil {
+		_u.SetExchange(*v)
+	}
+	return _u
+}
+
+// ClearExchange clears the value of the "exchange" field.
+func (_u *TradeUpdateOne) ClearExchange() *TradeUpdateOne {
+	_u.mutation.ClearExchange()
+	return _u
+}
+
+// SetSide sets the "side" field.
+func (_u *TradeUpdateOne) SetSide(v trade.Side) *TradeUpdateOne {
+	_u.mutation.SetSide(v)
+	return _u
+}
+
+// SetNillableSide sets the "side" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableSide(v *trade.Side) *TradeUpdateOne {
+	if v != nil {
+		_u.SetSide(*v)
+	}
+	return _u
+}
+
+// SetQuantity sets the "quantity" field.
+func (_u *TradeUpdateOne) SetQuantity(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetQuantity(v)
+	return _u
+}
+
+// SetNillableQuantity sets the "quantity" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableQuantity(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetQuantity(*v)
+	}
+	return _u
+}
+
+// SetPrice sets the "price" field.
+func (_u *TradeUpdateOne) SetPrice(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetPrice(v)
+	return _u
+}
+
+// SetNillablePrice sets the "price" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillablePrice(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetPrice(*v)
+	}
+	return _u
+}
+
+// SetFee sets the "fee" field.
+func (_u *TradeUpdateOne) SetFee(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetFee(v)
+	return _u
+}
+
+// SetNillableFee sets the "fee" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableFee(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetFee(*v)
+	}
+	return _u
+}
+
+// SetCurrency sets the "currency" field.
+func (_u *TradeUpdateOne) SetCurrency(v string) *TradeUpdateOne {
+	_u.mutation.SetCurrency(v)
+	return _u
+}
+
+// SetNillableCurrency sets the "currency" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableCurrency(v *string) *TradeUpdateOne {
+	if v != nil {
+		_u.SetCurrency(*v)
+	}
+	return _u
+}
+
+// SetFxRate sets the "fx_rate" field.
+func (_u *TradeUpdateOne) SetFxRate(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetFxRate(v)
+	return _u
+}
+
+// SetNillableFxRate sets the "fx_rate" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableFxRate(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetFxRate(*v)
+	}
+	return _u
+}
+
+// SetFilledQuantity sets the "filled_quantity" field.
+func (_u *TradeUpdateOne) SetFilledQuantity(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetFilledQuantity(v)
+	return _u
+}
+
+// SetNillableFilledQuantity sets the "filled_quantity" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableFilledQuantity(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetFilledQuantity(*v)
+	}
+	return _u
+}
+
+// SetProceeds sets the "proceeds" field.
+func (_u *TradeUpdateOne) SetProceeds(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetProceeds(v)
+	return _u
+}
+
+// SetNillableProceeds sets the "proceeds" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableProceeds(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetProceeds(*v)
+	}
+	return _u
+}
+
+// SetCostBasisFifo sets the "cost_basis_fifo" field.
+func (_u *TradeUpdateOne) SetCostBasisFifo(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetCostBasisFifo(v)
+	return _u
+}
+
+// SetNillableCostBasisFifo sets the "cost_basis_fifo" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableCostBasisFifo(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetCostBasisFifo(*v)
+	}
+	return _u
+}
+
+// SetCostBasisFifoKrw sets the "cost_basis_fifo_krw" field.
+func (_u *TradeUpdateOne) SetCostBasisFifoKrw(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetCostBasisFifoKrw(v)
+	return _u
+}
+
+// SetNillableCostBasisFifoKrw sets the "cost_basis_fifo_krw" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableCostBasisFifoKrw(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetCostBasisFifoKrw(*v)
+	}
+	return _u
+}
+
+// SetCostBasisAvg sets the "cost_basis_avg" field.
+func (_u *TradeUpdateOne) SetCostBasisAvg(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetCostBasisAvg(v)
+	return _u
+}
+
+// SetNillableCostBasisAvg sets the "cost_basis_avg" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableCostBasisAvg(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetCostBasisAvg(*v)
+	}
+	return _u
+}
+
+// SetCostBasisAvgKrw sets the "cost_basis_avg_krw" field.
+func (_u *TradeUpdateOne) SetCostBasisAvgKrw(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetCostBasisAvgKrw(v)
+	return _u
+}
+
+// SetNillableCostBasisAvgKrw sets the "cost_basis_avg_krw" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableCostBasisAvgKrw(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetCostBasisAvgKrw(*v)
+	}
+	return _u
+}
+
+// SetEstimatedBasis sets the "estimated_basis" field.
+func (_u *TradeUpdateOne) SetEstimatedBasis(v bool) *TradeUpdateOne {
+	_u.mutation.SetEstimatedBasis(v)
+	return _u
+}
+
+// SetNillableEstimatedBasis sets the "estimated_basis" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableEstimatedBasis(v *bool) *TradeUpdateOne {
+	if v != nil {
+		_u.SetEstimatedBasis(*v)
+	}
+	return _u
+}
+
+// SetPositionAfter sets the "position_after" field.
+func (_u *TradeUpdateOne) SetPositionAfter(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetPositionAfter(v)
+	return _u
+}
+
+// SetNillablePositionAfter sets the "position_after" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillablePositionAfter(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetPositionAfter(*v)
+	}
+	return _u
+}
+
+// SetAvgCostAfter sets the "avg_cost_after" field.
+func (_u *TradeUpdateOne) SetAvgCostAfter(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetAvgCostAfter(v)
+	return _u
+}
+
+// SetNillableAvgCostAfter sets the "avg_cost_after" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableAvgCostAfter(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetAvgCostAfter(*v)
+	}
+	return _u
+}
+
+// SetAvgCostKrwAfter sets the "avg_cost_krw_after" field.
+func (_u *TradeUpdateOne) SetAvgCostKrwAfter(v decimal.Decimal) *TradeUpdateOne {
+	_u.mutation.SetAvgCostKrwAfter(v)
+	return _u
+}
+
+// SetNillableAvgCostKrwAfter sets the "avg_cost_krw_after" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableAvgCostKrwAfter(v *decimal.Decimal) *TradeUpdateOne {
+	if v != nil {
+		_u.SetAvgCostKrwAfter(*v)
+	}
+	return _u
+}
+
+// SetFxPending sets the "fx_pending" field.
+func (_u *TradeUpdateOne) SetFxPending(v bool) *TradeUpdateOne {
+	_u.mutation.SetFxPending(v)
+	return _u
+}
+
+// SetNillableFxPending sets the "fx_pending" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableFxPending(v *bool) *TradeUpdateOne {
+	if v != nil {
+		_u.SetFxPending(*v)
+	}
+	return _u
+}
+
+// SetExecutedAt sets the "executed_at" field.
+func (_u *TradeUpdateOne) SetExecutedAt(v time.Time) *TradeUpdateOne {
+	_u.mutation.SetExecutedAt(v)
+	return _u
+}
+
+// SetNillableExecutedAt sets the "executed_at" field if the given value is not nil.
+func (_u *TradeUpdateOne) SetNillableExecutedAt(v *time.Time) *TradeUpdateOne {
+	if v != nil {
+		_u.SetExecutedAt(*v)
+	}
+	return _u
+}
+
+// Mutation returns the TradeMutation object of the builder.
+func (_u *TradeUpdateOne) Mutation() *TradeMutation {
+	return _u.mutation
+}
+
+// Where appends a list predicates to the TradeUpdate builder.
+func (_u *TradeUpdateOne) Where(ps ...predicate.Trade) *TradeUpdateOne {
+	_u.mutation.Where(ps...)
+	return _u
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (_u *TradeUpdateOne) Select(field string, fields ...string) *TradeUpdateOne {
+	_u.fields = append([]string{field}, fields...)
+	return _u
+}
+
+// Save executes the query and returns the updated Trade entity.
+func (_u *TradeUpdateOne) Save(ctx context.Context) (*Trade, error) {
+	return withHooks(ctx, _u.sqlSave, _u.mutation, _u.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (_u *TradeUpdateOne) SaveX(ctx context.Context) *Trade {
+	node, err := _u.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (_u *TradeUpdateOne) Exec(ctx context.Context) error {
+	_, err := _u.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (_u *TradeUpdateOne) ExecX(ctx context.Context) {
+	if err := _u.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (_u *TradeUpdateOne) check() error {
+	if v, ok := _u.mutation.ClientOrderID(); ok {
+		if err := trade.ClientOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "client_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.client_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.BrokerOrderID(); ok {
+		if err := trade.BrokerOrderIDValidator(v); err != nil {
+			return &ValidationError{Name: "broker_order_id", err: fmt.Errorf(`ent: validator failed for field "Trade.broker_order_id": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Symbol(); ok {
+		if err := trade.SymbolValidator(v); err != nil {
+			return &ValidationError{Name: "symbol", err: fmt.Errorf(`ent: validator failed for field "Trade.symbol": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Exchange(); ok {
+		if err := trade.ExchangeValidator(v); err != nil {
+			return &ValidationError{Name: "exchange", err: fmt.Errorf(`ent: validator failed for field "Trade.exchange": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Side(); ok {
+		if err := trade.SideValidator(v); err != nil {
+			return &ValidationError{Name: "side", err: fmt.Errorf(`ent: validator failed for field "Trade.side": %w`, err)}
+		}
+	}
+	if v, ok := _u.mutation.Currency(); ok {
+		if err := trade.CurrencyValidator(v); err != nil {
+			return &ValidationError{Name: "currency", err: fmt.Errorf(`ent: validator failed for field "Trade.currency": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (_u *TradeUpdateOne) sqlSave(ctx context.Context) (_node *Trade, err error) {
+	if err := _u.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(trade.Table, trade.Columns, sqlgraph.NewFieldSpec(trade.FieldID, field.TypeUUID))
+	id, ok := _u.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Trade.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := _u.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, trade.FieldID)
+		for _, f := range fields {
+			if !trade.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != trade.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := _u.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := _u.mutation.UserID(); ok {
+		_spec.SetField(trade.FieldUserID, field.TypeUUID, value)
+	}
+	if value, ok := _u.mutation.ClientOrderID(); ok {
+		_spec.SetField(trade.FieldClientOrderID, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.BrokerOrderID(); ok {
+		_spec.SetField(trade.FieldBrokerOrderID, field.TypeString, value)
+	}
+	if _u.mutation.BrokerOrderIDCleared() {
+		_spec.ClearField(trade.FieldBrokerOrderID, field.TypeString)
+	}
+	if value, ok := _u.mutation.Symbol(); ok {
+		_spec.SetField(trade.FieldSymbol, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.Exchange(); ok {
+		_spec.SetField(trade.FieldExchange, field.TypeString, value)
+	}
+	if _u.mutation.ExchangeCleared() {
+		_spec.ClearField(trade.FieldExchange, field.TypeString)
+	}
+	if value, ok := _u.mutation.Side(); ok {
+		_spec.SetField(trade.FieldSide, field.TypeEnum, value)
+	}
+	if value, ok := _u.mutation.Quantity(); ok {
+		_spec.SetField(trade.FieldQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Price(); ok {
+		_spec.SetField(trade.FieldPrice, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Fee(); ok {
+		_spec.SetField(trade.FieldFee, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Currency(); ok {
+		_spec.SetField(trade.FieldCurrency, field.TypeString, value)
+	}
+	if value, ok := _u.mutation.FxRate(); ok {
+		_spec.SetField(trade.FieldFxRate, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FilledQuantity(); ok {
+		_spec.SetField(trade.FieldFilledQuantity, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.Proceeds(); ok {
+		_spec.SetField(trade.FieldProceeds, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisFifo(); ok {
+		_spec.SetField(trade.FieldCostBasisFifo, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisFifoKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisFifoKrw, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisAvg(); ok {
+		_spec.SetField(trade.FieldCostBasisAvg, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.CostBasisAvgKrw(); ok {
+		_spec.SetField(trade.FieldCostBasisAvgKrw, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.EstimatedBasis(); ok {
+		_spec.SetField(trade.FieldEstimatedBasis, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.PositionAfter(); ok {
+		_spec.SetField(trade.FieldPositionAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCostAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.AvgCostKrwAfter(); ok {
+		_spec.SetField(trade.FieldAvgCostKrwAfter, field.TypeOther, value)
+	}
+	if value, ok := _u.mutation.FxPending(); ok {
+		_spec.SetField(trade.FieldFxPending, field.TypeBool, value)
+	}
+	if value, ok := _u.mutation.ExecutedAt(); ok {
+		_spec.SetField(trade.FieldExecutedAt, field.TypeTime, value)
+	}
+	_node = &Trade{config: _u.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, _u.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{trade.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	_u.mutation.done = true
+	return _node, nil
+}
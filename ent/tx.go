@@ -12,8 +12,30 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// BacktestResult is the client for interacting with the BacktestResult builders.
+	BacktestResult *BacktestResultClient
+	// BrokerAccount is the client for interacting with the BrokerAccount builders.
+	BrokerAccount *BrokerAccountClient
+	// Candle is the client for interacting with the Candle builders.
+	Candle *CandleClient
+	// KillSwitch is the client for interacting with the KillSwitch builders.
+	KillSwitch *KillSwitchClient
+	// Order is the client for interacting with the Order builders.
+	Order *OrderClient
+	// PaperAccount is the client for interacting with the PaperAccount builders.
+	PaperAccount *PaperAccountClient
+	// PaperPosition is the client for interacting with the PaperPosition builders.
+	PaperPosition *PaperPositionClient
+	// PaperTrade is the client for interacting with the PaperTrade builders.
+	PaperTrade *PaperTradeClient
 	// Portfolio is the client for interacting with the Portfolio builders.
 	Portfolio *PortfolioClient
+	// ProtectionRule is the client for interacting with the ProtectionRule builders.
+	ProtectionRule *ProtectionRuleClient
+	// RiskEvent is the client for interacting with the RiskEvent builders.
+	RiskEvent *RiskEventClient
+	// RiskState is the client for interacting with the RiskState builders.
+	RiskState *RiskStateClient
 	// Strategy is the client for interacting with the Strategy builders.
 	Strategy *StrategyClient
 	// StrategyExecution is the client for interacting with the StrategyExecution builders.
@@ -24,6 +46,12 @@ type Tx struct {
 	StrategyStatus *StrategyStatusClient
 	// StrategyTemplate is the client for interacting with the StrategyTemplate builders.
 	StrategyTemplate *StrategyTemplateClient
+	// Symbol is the client for interacting with the Symbol builders.
+	Symbol *SymbolClient
+	// TaxLot is the client for interacting with the TaxLot builders.
+	TaxLot *TaxLotClient
+	// Trade is the client for interacting with the Trade builders.
+	Trade *TradeClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -157,12 +185,26 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.BacktestResult = NewBacktestResultClient(tx.config)
+	tx.BrokerAccount = NewBrokerAccountClient(tx.config)
+	tx.Candle = NewCandleClient(tx.config)
+	tx.KillSwitch = NewKillSwitchClient(tx.config)
+	tx.Order = NewOrderClient(tx.config)
+	tx.PaperAccount = NewPaperAccountClient(tx.config)
+	tx.PaperPosition = NewPaperPositionClient(tx.config)
+	tx.PaperTrade = NewPaperTradeClient(tx.config)
 	tx.Portfolio = NewPortfolioClient(tx.config)
+	tx.ProtectionRule = NewProtectionRuleClient(tx.config)
+	tx.RiskEvent = NewRiskEventClient(tx.config)
+	tx.RiskState = NewRiskStateClient(tx.config)
 	tx.Strategy = NewStrategyClient(tx.config)
 	tx.StrategyExecution = NewStrategyExecutionClient(tx.config)
 	tx.StrategyPerformance = NewStrategyPerformanceClient(tx.config)
 	tx.StrategyStatus = NewStrategyStatusClient(tx.config)
 	tx.StrategyTemplate = NewStrategyTemplateClient(tx.config)
+	tx.Symbol = NewSymbolClient(tx.config)
+	tx.TaxLot = NewTaxLotClient(tx.config)
+	tx.Trade = NewTradeClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -173,7 +215,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Portfolio.QueryXXX(), the query will be executed
+// applies a query, for example: BacktestResult.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
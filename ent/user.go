@@ -28,6 +28,8 @@ type User struct {
 	Password string `json:"password,omitempty"`
 	// IsValid holds the value of the "is_valid" field.
 	IsValid bool `json:"is_valid,omitempty"`
+	// IsAdmin holds the value of the "is_admin" field.
+	IsAdmin bool `json:"is_admin,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt *time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -44,9 +46,11 @@ type UserEdges struct {
 	Strategies []*Strategy `json:"strategies,omitempty"`
 	// Portfolios holds the value of the portfolios edge.
 	Portfolios []*Portfolio `json:"portfolios,omitempty"`
+	// Orders holds the value of the orders edge.
+	Orders []*Order `json:"orders,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [2]bool
+	loadedTypes [3]bool
 }
 
 // StrategiesOrErr returns the Strategies value or an error if the edge
@@ -67,12 +71,21 @@ func (e UserEdges) PortfoliosOrErr() ([]*Portfolio, error) {
 	return nil, &NotLoadedError{edge: "portfolios"}
 }
 
+// OrdersOrErr returns the Orders value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) OrdersOrErr() ([]*Order, error) {
+	if e.loadedTypes[2] {
+		return e.Orders, nil
+	}
+	return nil, &NotLoadedError{edge: "orders"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case user.FieldIsValid:
+		case user.FieldIsValid, user.FieldIsAdmin:
 			values[i] = new(sql.NullBool)
 		case user.FieldName, user.FieldNickname, user.FieldEmail, user.FieldPassword:
 			values[i] = new(sql.NullString)
@@ -131,6 +144,12 @@ func (_m *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				_m.IsValid = value.Bool
 			}
+		case user.FieldIsAdmin:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_admin", values[i])
+			} else if value.Valid {
+				_m.IsAdmin = value.Bool
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -168,6 +187,11 @@ func (_m *User) QueryPortfolios() *PortfolioQuery {
 	return NewUserClient(_m.config).QueryPortfolios(_m)
 }
 
+// QueryOrders queries the "orders" edge of the User entity.
+func (_m *User) QueryOrders() *OrderQuery {
+	return NewUserClient(_m.config).QueryOrders(_m)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -206,6 +230,9 @@ func (_m *User) String() string {
 	builder.WriteString("is_valid=")
 	builder.WriteString(fmt.Sprintf("%v", _m.IsValid))
 	builder.WriteString(", ")
+	builder.WriteString("is_admin=")
+	builder.WriteString(fmt.Sprintf("%v", _m.IsAdmin))
+	builder.WriteString(", ")
 	if v := _m.CreatedAt; v != nil {
 		builder.WriteString("created_at=")
 		builder.WriteString(v.Format(time.ANSIC))
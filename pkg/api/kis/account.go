@@ -0,0 +1,228 @@
+package kis
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/domain/brokerage"
+	"auto-trader/pkg/domain/portfolio"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// REST API 접속 주소 (사용자 계좌의 실전/모의 구분에 따라 선택)
+const (
+	baseURLReal = "https://openapi.koreainvestment.com:9443"
+	baseURLDemo = "https://openapivts.koreainvestment.com:29443"
+)
+
+// Account 사용자 연결 계좌 (해당 사용자 자격 증명으로 만든 클라이언트 포함)
+type Account struct {
+	UserID      string
+	Client      *Client
+	AccountNo   string
+	ProductCode string
+}
+
+// key 같은 계좌를 구분하는 키 (체결 내역은 계좌 단위로 조회)
+func (a *Account) key() string {
+	return fmt.Sprintf("%p/%s/%s", a.Client, a.AccountNo, a.ProductCode)
+}
+
+// AccountResolver 사용자 연결 계좌 조회
+type AccountResolver interface {
+	ResolveAccount(userID string) (*Account, error)
+}
+
+// CredentialSource 사용자 계좌 자격 증명 조회 (연결된 계좌가 없으면 NotFound)
+type CredentialSource interface {
+	Credentials(userID string) (*brokerage.Credentials, error)
+}
+
+// AccountPool 사용자 계좌별 KIS 클라이언트 관리
+// 클라이언트는 계좌마다 따로 두어 접근토큰을 해당 앱키로 발급/캐시하고, 자격 증명이 바뀌면 새로 만든다.
+type AccountPool struct {
+	credentials CredentialSource
+	tokenStore  TokenStore
+	symbols     SymbolResolver
+
+	clients map[uuid.UUID]*Client // 계좌 ID → 클라이언트
+	mutex   sync.Mutex
+}
+
+// NewAccountPool 새로운 사용자 계좌 풀 생성
+func NewAccountPool(credentials CredentialSource, tokenStore TokenStore) *AccountPool {
+	return &AccountPool{
+		credentials: credentials,
+		tokenStore:  tokenStore,
+		clients:     make(map[uuid.UUID]*Client),
+	}
+}
+
+// SetSymbolResolver 종목별 거래소 조회 설정 (이미 생성된 클라이언트에도 적용)
+func (p *AccountPool) SetSymbolResolver(resolver SymbolResolver) {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	p.symbols = resolver
+	for _, client := range p.clients {
+		client.SetSymbolResolver(resolver)
+	}
+}
+
+// ResolveAccount 사용자 연결 계좌와 해당 자격 증명의 클라이언트 조회
+func (p *AccountPool) ResolveAccount(userID string) (*Account, error) {
+	creds, err := p.credentials.Credentials(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Account{
+		UserID:      userID,
+		Client:      p.client(creds),
+		AccountNo:   creds.AccountNo,
+		ProductCode: defaultProductCode(creds.ProductCode),
+	}, nil
+}
+
+func (p *AccountPool) client(creds *brokerage.Credentials) *Client {
+	p.mutex.Lock()
+	defer p.mutex.Unlock()
+
+	if client, ok := p.clients[creds.AccountID]; ok &&
+		client.AppKey == creds.AppKey && client.AppSecret == creds.AppSecret && client.IsDemo == creds.IsDemo {
+		return client
+	}
+
+	baseURL := baseURLReal
+	if creds.IsDemo {
+		baseURL = baseURLDemo
+	}
+	client := NewClient(creds.AppKey, creds.AppSecret, baseURL, creds.IsDemo)
+	if p.tokenStore != nil {
+		client.SetTokenStore(p.tokenStore)
+	}
+	if p.symbols != nil {
+		client.SetSymbolResolver(p.symbols)
+	}
+	p.clients[creds.AccountID] = client
+	return client
+}
+
+// AccountBalanceSource 사용자 연결 계좌 잔고 조회 (portfolio.BalanceSource 구현)
+type AccountBalanceSource struct {
+	accounts AccountResolver
+	adapter  *Adapter
+}
+
+// NewAccountBalanceSource 새로운 계좌 잔고 조회 생성
+func NewAccountBalanceSource(accounts AccountResolver) *AccountBalanceSource {
+	return &AccountBalanceSource{
+		accounts: accounts,
+		adapter:  NewAdapter(),
+	}
+}
+
+// GetBalance 사용자 연결 계좌의 전체 보유 종목 조회
+func (s *AccountBalanceSource) GetBalance(ctx context.Context, userID string) ([]*portfolio.Position, error) {
+	account, err := s.accounts.ResolveAccount(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	balanceResp, err := account.Client.GetBalance(ctx, account.AccountNo, account.ProductCode)
+	if err != nil {
+		return nil, fmt.Errorf("KIS API 잔고 조회 실패: %w", err)
+	}
+
+	positions := make([]*portfolio.Position, 0, len(balanceResp.Output1))
+	for _, kisBalance := range balanceResp.Output1 {
+		position, err := s.adapter.ConvertBalanceToPosition(kisBalance)
+		if err != nil {
+			return nil, fmt.Errorf("포지션 변환 실패: %w", err)
+		}
+		positions = append(positions, position)
+	}
+
+	return positions, nil
+}
+
+// AccountFXSource 사용자 연결 계좌로 원화 환율 조회 (portfolio.FXRateSource 구현)
+// 최초고시환율은 하루 동안 바뀌지 않으므로 통화별로 한국 날짜 단위 캐시한다.
+type AccountFXSource struct {
+	accounts AccountResolver
+
+	rates map[string]fxRate // 통화 → 당일 환율
+	mutex sync.Mutex
+}
+
+type fxRate struct {
+	date string // 고시 기준일 (YYYYMMDD, 한국 시간)
+	rate decimal.Decimal
+}
+
+// NewAccountFXSource 새로운 계좌 환율 조회 생성
+func NewAccountFXSource(accounts AccountResolver) *AccountFXSource {
+	return &AccountFXSource{
+		accounts: accounts,
+		rates:    make(map[string]fxRate),
+	}
+}
+
+// ExchangeRate 통화의 당일 최초고시환율 (1 통화 = N 원)
+func (s *AccountFXSource) ExchangeRate(ctx context.Context, userID, currency string) (decimal.Decimal, error) {
+	now := time.Now()
+	if loc, err := time.LoadLocation("Asia/Seoul"); err == nil {
+		now = now.In(loc)
+	}
+	today := now.Format("20060102")
+
+	s.mutex.Lock()
+	cached, ok := s.rates[currency]
+	s.mutex.Unlock()
+	if ok && cached.date == today {
+		return cached.rate, nil
+	}
+
+	account, err := s.accounts.ResolveAccount(userID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	rate, err := account.Client.GetExchangeRate(ctx, account.AccountNo, account.ProductCode, currency)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("KIS API 환율 조회 실패: %w", err)
+	}
+
+	s.mutex.Lock()
+	s.rates[currency] = fxRate{date: today, rate: rate}
+	s.mutex.Unlock()
+	return rate, nil
+}
+
+// AccountCashSource 사용자 연결 계좌 외화 예수금 조회 (risk.CashSource 구현)
+type AccountCashSource struct {
+	accounts AccountResolver
+}
+
+// NewAccountCashSource 새로운 계좌 예수금 조회 생성
+func NewAccountCashSource(accounts AccountResolver) *AccountCashSource {
+	return &AccountCashSource{accounts: accounts}
+}
+
+// Cash 통화의 외화 예수금
+func (s *AccountCashSource) Cash(ctx context.Context, userID, currency string) (decimal.Decimal, error) {
+	account, err := s.accounts.ResolveAccount(userID)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	cash, err := account.Client.GetForeignCash(ctx, account.AccountNo, account.ProductCode, currency)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("KIS API 예수금 조회 실패: %w", err)
+	}
+	return cash, nil
+}
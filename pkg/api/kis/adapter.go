@@ -46,8 +46,15 @@ func (a *Adapter) ConvertBalanceToPosition(kisBalance KISBalanceOutput1) (*portf
 		return nil, fmt.Errorf("평가손익률 파싱 실패: %w", err)
 	}
 
-	// 총 가치 계산
-	totalValue := quantity.Mul(currentPrice)
+	// 평가금액/매입금액 (응답에 없으면 수량 기준으로 계산)
+	totalValue, err := decimal.NewFromString(kisBalance.OvrsStckEvluAmt)
+	if err != nil {
+		totalValue = quantity.Mul(currentPrice)
+	}
+	totalCost, err := decimal.NewFromString(kisBalance.FrcrPchsAmt1)
+	if err != nil {
+		totalCost = quantity.Mul(avgPrice)
+	}
 
 	// 일일 수익은 별도 API로 조회 필요
 	dailyProfit := decimal.Zero
@@ -55,11 +62,13 @@ func (a *Adapter) ConvertBalanceToPosition(kisBalance KISBalanceOutput1) (*portf
 
 	return &portfolio.Position{
 		Symbol:          kisBalance.OvrsPdno,
+		Exchange:        kisBalance.OvrsExcgCd,
 		CompanyName:     kisBalance.OvrsItemName,
 		Quantity:        quantity,
 		AveragePrice:    avgPrice,
 		CurrentPrice:    currentPrice,
 		TotalValue:      totalValue,
+		TotalCost:       totalCost,
 		TotalProfit:     profitAmount,
 		ProfitRate:      profitRate,
 		DailyProfit:     dailyProfit,
@@ -0,0 +1,202 @@
+package kis
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"auto-trader/pkg/api/kis/dto"
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/shared/utils"
+)
+
+// GetDailyChart 해외주식 기간별시세(일봉) 조회 (to부터 from까지 역순으로 페이지 조회)
+// 한 번에 최대 100건이 내려오므로 가장 오래된 일자 전날을 기준일자로 다시 조회한다.
+func (c *Client) GetDailyChart(ctx context.Context, exchange, symbol string, from, to time.Time) ([]KISDailyChartOutput, error) {
+	loc := marketdata.MarketLocation()
+	fromDate := from.In(loc).Format("20060102")
+
+	requestBody := dto.NewDailyChartRequest(exchange, symbol, to.In(loc).Format("20060102"))
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	var result []KISDailyChartOutput
+	for page := 0; page < maxContinuationPages; page++ {
+		var chartResp KISDailyChartResponse
+		if _, err := c.getChart(ctx, "dailyprice", requestBody.ToQuery(), dto.TrIDOverseasDailyChart, "", &chartResp); err != nil {
+			return nil, err
+		}
+		if chartResp.RtCd != "0" {
+			return nil, fmt.Errorf("API 오류: %s - %s", chartResp.MsgCd, chartResp.Msg1)
+		}
+
+		oldest := ""
+		for _, row := range chartResp.Output2 {
+			if row.Xymd == "" {
+				continue
+			}
+			result = append(result, row)
+			oldest = row.Xymd
+		}
+		if oldest == "" || oldest <= fromDate {
+			break
+		}
+
+		oldestDate, err := time.ParseInLocation("20060102", oldest, loc)
+		if err != nil {
+			return nil, fmt.Errorf("일자 파싱 실패: %w", err)
+		}
+		requestBody.BYMD = oldestDate.AddDate(0, 0, -1).Format("20060102")
+	}
+
+	return result, nil
+}
+
+// GetMinuteChart 해외주식 분봉 조회 (to부터 from까지 연속조회키로 역순 페이지 조회)
+func (c *Client) GetMinuteChart(ctx context.Context, exchange, symbol string, minutes int, from, to time.Time) ([]KISMinuteChartOutput, error) {
+	loc := marketdata.MarketLocation()
+	fromKey := from.In(loc).Format("20060102150405")
+
+	requestBody := dto.NewMinuteChartRequest(exchange, symbol, minutes)
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	// 과거 구간은 종료 시각을 연속조회키로 지정해 그 이전부터 조회
+	if time.Since(to) > time.Duration(minutes)*time.Minute {
+		requestBody.NEXT = "1"
+		requestBody.KEYB = to.In(loc).Format("20060102150405")
+	}
+
+	var result []KISMinuteChartOutput
+	trCont := ""
+	for page := 0; page < maxContinuationPages; page++ {
+		var chartResp KISMinuteChartResponse
+		respHeader, err := c.getChart(ctx, "inquire-time-itemchartprice", requestBody.ToQuery(), dto.TrIDOverseasMinuteChart, trCont, &chartResp)
+		if err != nil {
+			return nil, err
+		}
+		if chartResp.RtCd != "0" {
+			return nil, fmt.Errorf("API 오류: %s - %s", chartResp.MsgCd, chartResp.Msg1)
+		}
+
+		oldest := ""
+		for _, row := range chartResp.Output2 {
+			if row.Xymd == "" || row.Xhms == "" {
+				continue
+			}
+			result = append(result, row)
+			oldest = row.Xymd + row.Xhms
+		}
+		if oldest == "" || oldest <= fromKey {
+			break
+		}
+
+		// 다음 페이지가 없으면 종료 (응답 next 또는 헤더 tr_cont로 판단)
+		if chartResp.Output1.Next != "1" && !hasNextPage(respHeader.Get("tr_cont")) {
+			break
+		}
+		trCont = "N"
+		requestBody.NEXT = "1"
+		requestBody.KEYB = oldest
+	}
+
+	return result, nil
+}
+
+// getChart 시세 조회 GET 요청 실행
+func (c *Client) getChart(ctx context.Context, path, query, trID, trCont string, out interface{}) (http.Header, error) {
+	url := fmt.Sprintf("%s/uapi/overseas-price/v1/quotations/%s?%s", c.BaseURL, path, query)
+
+	body, respHeader, err := c.execute(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		}
+
+		headers := dto.NewChartHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), trID, trCont)
+		if err := headers.Validate(); err != nil {
+			return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+		}
+		headers.ApplyToRequest(req)
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return nil, fmt.Errorf("응답 파싱 실패: %w", err)
+	}
+	return respHeader, nil
+}
+
+// ChartFetcher KIS 차트 API를 marketdata.Fetcher 인터페이스에 맞게 변환
+type ChartFetcher struct {
+	client *Client
+}
+
+// NewChartFetcher 새로운 차트 조회기 생성
+func NewChartFetcher(client *Client) *ChartFetcher {
+	return &ChartFetcher{client: client}
+}
+
+// FetchBars from~to 구간의 봉 조회 (시간순)
+func (f *ChartFetcher) FetchBars(ctx context.Context, symbol, exchange string, interval marketdata.Interval, from, to time.Time) ([]marketdata.Bar, error) {
+	loc := marketdata.MarketLocation()
+
+	var bars []marketdata.Bar
+	if interval.IsIntraday() {
+		rows, err := f.client.GetMinuteChart(ctx, exchange, symbol, interval.Minutes(), from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			timestamp, err := time.ParseInLocation("20060102150405", row.Xymd+row.Xhms, loc)
+			if err != nil {
+				continue
+			}
+			bars = append(bars, newBar(symbol, exchange, interval, timestamp, row.Open, row.High, row.Low, row.Last, row.Evol))
+		}
+	} else {
+		rows, err := f.client.GetDailyChart(ctx, exchange, symbol, from, to)
+		if err != nil {
+			return nil, err
+		}
+		for _, row := range rows {
+			timestamp, err := time.ParseInLocation("20060102", row.Xymd, loc)
+			if err != nil {
+				continue
+			}
+			bars = append(bars, newBar(symbol, exchange, interval, timestamp, row.Open, row.High, row.Low, row.Clos, row.Tvol))
+		}
+	}
+
+	// API는 최근 봉부터 내려오므로 구간 필터 후 시간순 정렬
+	result := make([]marketdata.Bar, 0, len(bars))
+	for i := len(bars) - 1; i >= 0; i-- {
+		bar := bars[i]
+		if bar.Timestamp.Before(from.Truncate(interval.Duration())) || bar.Timestamp.After(to) || !bar.Close.IsPositive() {
+			continue
+		}
+		result = append(result, bar)
+	}
+	return result, nil
+}
+
+func newBar(symbol, exchange string, interval marketdata.Interval, timestamp time.Time, open, high, low, closePrice, volume string) marketdata.Bar {
+	return marketdata.Bar{
+		Symbol:    symbol,
+		Exchange:  exchange,
+		Interval:  interval,
+		Timestamp: timestamp,
+		Open:      parseDecimal(open),
+		High:      parseDecimal(high),
+		Low:       parseDecimal(low),
+		Close:     parseDecimal(closePrice),
+		Volume:    parseDecimal(volume).IntPart(),
+	}
+}
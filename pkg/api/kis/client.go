@@ -10,10 +10,11 @@ import (
 	"encoding/base64"
 	"encoding/json"
 	"fmt"
-	"io"
 	"net/http"
+	"sync"
 	"time"
 
+	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 )
 
@@ -25,6 +26,16 @@ type Client struct {
 	BaseURL     string
 	IsDemo      bool
 	HTTPClient  *http.Client
+
+	// 접근토큰/웹소켓 접속키 관리
+	tokenExpiresAt    time.Time
+	tokenStore        TokenStore
+	approvalKey       string
+	approvalExpiresAt time.Time
+	renewalStop       chan struct{}
+	symbols           SymbolResolver // 종목별 거래소 조회 (tokenMutex로 보호)
+	tokenMutex        sync.RWMutex
+	issueMutex        sync.Mutex
 }
 
 // NewClient 새로운 KIS API 클라이언트 생성
@@ -52,72 +63,145 @@ func (c *Client) generateHashkey(body string) (string, error) {
 	return base64.StdEncoding.EncodeToString(hash), nil
 }
 
-// GetBalance 해외주식 잔고 조회
-func (c *Client) GetBalance(accountNo string) (*KISBalanceResponse, error) {
-	// API 엔드포인트
-	url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/inquire-balance", c.BaseURL)
-	logrus.Infof("GetBalance URL: %s", url)
-	// 요청 바디
-	requestBody := dto.NewBalanceRequest(accountNo)
+// GetBalance 해외주식 잔고 조회 (연속 조회 키를 따라 전체 페이지 조회)
+// 실전은 NASD 조회로 미국 전체 잔고가 내려오고, 모의투자는 거래소별로 조회해 합친다.
+func (c *Client) GetBalance(ctx context.Context, accountNo, productCode string) (*KISBalanceResponse, error) {
+	exchanges := []string{ExchangeNASDAQ}
+	if c.IsDemo {
+		exchanges = []string{ExchangeNASDAQ, ExchangeNYSE, ExchangeAMEX}
+	}
 
-	// DTO 검증
-	if err := requestBody.Validate(); err != nil {
-		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	result := &KISBalanceResponse{RtCd: "0"}
+	for _, exchange := range exchanges {
+		requestBody := dto.NewBalanceRequest(accountNo, defaultProductCode(productCode), exchange)
+
+		// DTO 검증
+		if err := requestBody.Validate(); err != nil {
+			return nil, utils.WrapValidationError(err, "요청 검증 실패")
+		}
+
+		trCont := ""
+		for page := 0; page < maxContinuationPages; page++ {
+			url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/inquire-balance?%s", c.BaseURL, requestBody.ToQuery())
+
+			// 요청 실행 (접근토큰 자동 발급/갱신)
+			body, respHeader, err := c.execute(ctx, func() (*http.Request, error) {
+				req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+				if err != nil {
+					return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+				}
+
+				headers := dto.NewBalanceHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), trCont, c.IsDemo)
+				if err := headers.Validate(); err != nil {
+					return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+				}
+				headers.ApplyToRequest(req)
+				return req, nil
+			})
+			if err != nil {
+				return nil, err
+			}
+
+			// 응답 파싱
+			var balanceResp KISBalanceResponse
+			if err := json.Unmarshal(body, &balanceResp); err != nil {
+				return nil, fmt.Errorf("응답 파싱 실패: %w", err)
+			}
+			if balanceResp.RtCd != "0" {
+				return nil, fmt.Errorf("API 오류: %s - %s", balanceResp.MsgCd, balanceResp.Msg1)
+			}
+
+			result.Output1 = append(result.Output1, balanceResp.Output1...)
+			result.Output2 = balanceResp.Output2
+			result.MsgCd = balanceResp.MsgCd
+			result.Msg1 = balanceResp.Msg1
+
+			// 응답 헤더 tr_cont가 M/F면 다음 페이지 존재
+			if !hasNextPage(respHeader.Get("tr_cont")) {
+				break
+			}
+			trCont = "N"
+			requestBody.CTX_AREA_FK200 = balanceResp.CtxAreaFk200
+			requestBody.CTX_AREA_NK200 = balanceResp.CtxAreaNk200
+		}
 	}
 
-	jsonBody, err := json.Marshal(requestBody)
+	logrus.Debugf("GetBalance 완료: %d개 종목", len(result.Output1))
+	return result, nil
+}
+
+// GetExchangeRate 통화의 당일 최초고시환율 조회 (체결기준현재잔고 API, 1 통화 = N 원)
+func (c *Client) GetExchangeRate(ctx context.Context, accountNo, productCode, currency string) (decimal.Decimal, error) {
+	output, err := c.getPresentBalanceCurrency(ctx, accountNo, productCode, currency)
 	if err != nil {
-		return nil, fmt.Errorf("요청 바디 마샬링 실패: %w", err)
+		return decimal.Zero, err
 	}
 
-	// Hashkey 생성
-	hashkey, err := c.generateHashkey(string(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("hashkey 생성 실패: %w", err)
+	rate, err := decimal.NewFromString(output.FrstBltnExrt)
+	if err != nil || !rate.IsPositive() {
+		return decimal.Zero, fmt.Errorf("환율 값이 올바르지 않습니다: %q", output.FrstBltnExrt)
 	}
+	return rate, nil
+}
 
-	// HTTP 요청 생성
-	ctx := context.Background()
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+// GetForeignCash 통화의 외화 예수금 조회 (체결기준현재잔고 API)
+func (c *Client) GetForeignCash(ctx context.Context, accountNo, productCode, currency string) (decimal.Decimal, error) {
+	output, err := c.getPresentBalanceCurrency(ctx, accountNo, productCode, currency)
 	if err != nil {
-		return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		return decimal.Zero, err
 	}
 
-	// 공통 헤더 설정
-	headers := dto.NewBalanceHeaders(c.AppKey, c.AppSecret, c.AccessToken, hashkey, c.IsDemo)
-
-	// 헤더 검증
-	if err := headers.Validate(); err != nil {
-		return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+	cash, err := decimal.NewFromString(output.FrcrDnclAmt2)
+	if err != nil || cash.IsNegative() {
+		return decimal.Zero, fmt.Errorf("외화 예수금 값이 올바르지 않습니다: %q", output.FrcrDnclAmt2)
 	}
+	return cash, nil
+}
 
-	headers.ApplyToRequest(req)
+// getPresentBalanceCurrency 체결기준현재잔고의 통화별 예수금/환율 정보 조회
+func (c *Client) getPresentBalanceCurrency(ctx context.Context, accountNo, productCode, currency string) (*KISPresentBalanceOutput2, error) {
+	requestBody := dto.NewPresentBalanceRequest(accountNo, defaultProductCode(productCode))
 
-	// 요청 실행
-	resp, err := c.HTTPClient.Do(req)
-	if err != nil {
-		return nil, fmt.Errorf("API 요청 실패: %w", err)
+	// DTO 검증
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
 	}
-	defer func() { _ = resp.Body.Close() }()
 
-	// 응답 읽기
-	body, err := io.ReadAll(resp.Body)
+	url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/inquire-present-balance?%s", c.BaseURL, requestBody.ToQuery())
+
+	// 요청 실행 (접근토큰 자동 발급/갱신)
+	body, _, err := c.execute(ctx, func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		}
+
+		headers := dto.NewPresentBalanceHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), c.IsDemo)
+		if err := headers.Validate(); err != nil {
+			return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+		}
+		headers.ApplyToRequest(req)
+		return req, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("응답 읽기 실패: %w", err)
+		return nil, err
 	}
 
 	// 응답 파싱
-	var balanceResp KISBalanceResponse
-	if err := json.Unmarshal(body, &balanceResp); err != nil {
+	var presentResp KISPresentBalanceResponse
+	if err := json.Unmarshal(body, &presentResp); err != nil {
 		return nil, fmt.Errorf("응답 파싱 실패: %w", err)
 	}
-	logrus.Infof("GetBalance Response: %+v", balanceResp)
-	// 에러 체크
-	if balanceResp.RtCd != "0" {
-		return nil, fmt.Errorf("API 오류: %s - %s", balanceResp.MsgCd, balanceResp.Msg1)
+	if presentResp.RtCd != "0" {
+		return nil, fmt.Errorf("API 오류: %s - %s", presentResp.MsgCd, presentResp.Msg1)
 	}
 
-	return &balanceResp, nil
+	for i := range presentResp.Output2 {
+		if presentResp.Output2[i].CrcyCd == currency {
+			return &presentResp.Output2[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%s 통화 정보가 없습니다", currency)
 }
 
 // GetCurrentPrice 해외주식 현재가 조회
@@ -126,7 +210,7 @@ func (c *Client) GetCurrentPrice(symbol string) (*KISPriceResponse, error) {
 	url := fmt.Sprintf("%s/uapi/overseas-price/v1/quotations/price", c.BaseURL)
 
 	// 요청 바디
-	requestBody := dto.NewPriceRequest(symbol)
+	requestBody := dto.NewPriceRequest(c.quoteExchange(symbol), symbol)
 
 	// DTO 검증
 	if err := requestBody.Validate(); err != nil {
@@ -144,34 +228,28 @@ func (c *Client) GetCurrentPrice(symbol string) (*KISPriceResponse, error) {
 		return nil, fmt.Errorf("hashkey 생성 실패: %w", err)
 	}
 
-	// HTTP 요청 생성
+	// 요청 실행 (접근토큰 자동 발급/갱신)
 	ctx := context.Background()
-	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
-	if err != nil {
-		return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
-	}
-
-	// 공통 헤더 설정
-	headers := dto.NewPriceHeaders(c.AppKey, c.AppSecret, c.AccessToken, hashkey, c.IsDemo)
-
-	// 헤더 검증
-	if err := headers.Validate(); err != nil {
-		return nil, utils.WrapValidationError(err, "헤더 검증 실패")
-	}
-
-	headers.ApplyToRequest(req)
-
-	// 요청 실행
-	resp, err := c.HTTPClient.Do(req)
+	body, _, err := c.execute(ctx, func() (*http.Request, error) {
+		// HTTP 요청 생성
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+		if err != nil {
+			return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		}
+
+		// 공통 헤더 설정
+		headers := dto.NewPriceHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), hashkey, c.IsDemo)
+
+		// 헤더 검증
+		if err := headers.Validate(); err != nil {
+			return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+		}
+
+		headers.ApplyToRequest(req)
+		return req, nil
+	})
 	if err != nil {
-		return nil, fmt.Errorf("API 요청 실패: %w", err)
-	}
-	defer func() { _ = resp.Body.Close() }()
-
-	// 응답 읽기
-	body, err := io.ReadAll(resp.Body)
-	if err != nil {
-		return nil, fmt.Errorf("응답 읽기 실패: %w", err)
+		return nil, err
 	}
 
 	// 응답 파싱
@@ -188,7 +266,11 @@ func (c *Client) GetCurrentPrice(symbol string) (*KISPriceResponse, error) {
 	return &priceResp, nil
 }
 
-// SetAccessToken Access Token 설정
+// SetAccessToken Access Token 설정 (만료 시각을 모르므로 만료 응답 시 자동 재발급)
 func (c *Client) SetAccessToken(token string) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
 	c.AccessToken = token
+	c.tokenExpiresAt = time.Time{}
 }
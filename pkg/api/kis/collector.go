@@ -0,0 +1,60 @@
+package kis
+
+import (
+	"fmt"
+	"time"
+
+	"auto-trader/pkg/domain/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+// PriceCollector KIS 현재가 API 기반 가격 수집기 (요청 시점 REST 조회)
+type PriceCollector struct {
+	client *Client
+}
+
+// NewPriceCollector 새로운 가격 수집기 생성
+func NewPriceCollector(client *Client) *PriceCollector {
+	return &PriceCollector{client: client}
+}
+
+// StartPriceStream REST 조회 방식이므로 별도 스트림 없음
+func (p *PriceCollector) StartPriceStream(symbols []string) {}
+
+// Stop REST 조회 방식이므로 정리할 자원 없음
+func (p *PriceCollector) Stop() {}
+
+// GetCurrentPrice 현재가 조회
+func (p *PriceCollector) GetCurrentPrice(symbol string) (*strategy.PriceData, error) {
+	resp, err := p.client.GetCurrentPrice(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("현재가 조회 실패: %w", err)
+	}
+
+	price, err := decimal.NewFromString(resp.Output.Last)
+	if err != nil {
+		return nil, fmt.Errorf("현재가 파싱 실패: %w", err)
+	}
+
+	return &strategy.PriceData{Price: price, Timestamp: time.Now()}, nil
+}
+
+// GetDailyProfit 전일 종가 대비 당일 등락률(%) 조회
+func (p *PriceCollector) GetDailyProfit(symbol string) (decimal.Decimal, error) {
+	resp, err := p.client.GetCurrentPrice(symbol)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("현재가 조회 실패: %w", err)
+	}
+
+	last, err := decimal.NewFromString(resp.Output.Last)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("현재가 파싱 실패: %w", err)
+	}
+	base, err := decimal.NewFromString(resp.Output.Base)
+	if err != nil || base.IsZero() {
+		return decimal.Zero, fmt.Errorf("전일 종가 파싱 실패: %s", resp.Output.Base)
+	}
+
+	return last.Sub(base).Div(base).Mul(decimal.NewFromInt(100)), nil
+}
@@ -2,6 +2,7 @@ package kis
 
 import (
 	"auto-trader/pkg/domain/portfolio"
+	"context"
 	"fmt"
 	"strconv"
 	"time"
@@ -11,8 +12,9 @@ import (
 
 // DataAdapter KIS API를 portfolio 도메인의 ExternalAPI 인터페이스에 맞게 어댑터
 type DataAdapter struct {
-	client  *Client
-	adapter *Adapter
+	client   *Client
+	adapter  *Adapter
+	accounts AccountResolver // 사용자별 연결 계좌 (잔고 조회용)
 }
 
 // NewDataAdapter 새로운 데이터 어댑터 생성
@@ -28,6 +30,11 @@ func (d *DataAdapter) SetAccessToken(token string) {
 	d.client.SetAccessToken(token)
 }
 
+// SetAccountResolver 사용자별 연결 계좌 조회 설정 (미설정 시 잔고 조회 불가)
+func (d *DataAdapter) SetAccountResolver(accounts AccountResolver) {
+	d.accounts = accounts
+}
+
 // GetCurrentPrice 현재가 조회
 func (d *DataAdapter) GetCurrentPrice(symbol string) (*portfolio.StockPrice, error) {
 	// KIS API 호출
@@ -62,11 +69,16 @@ func (d *DataAdapter) GetCurrentPrices(symbols []string) ([]portfolio.StockPrice
 
 // GetUserPositions 사용자 보유 주식 조회
 func (d *DataAdapter) GetUserPositions(userID string) ([]portfolio.Position, error) {
-	// TODO: 사용자별 계좌번호 매핑 필요
-	accountNo := "계좌번호" // 실제로는 사용자별 계좌번호를 가져와야 함
+	if d.accounts == nil {
+		return nil, fmt.Errorf("사용자 계좌 조회가 설정되지 않았습니다")
+	}
+	account, err := d.accounts.ResolveAccount(userID)
+	if err != nil {
+		return nil, err
+	}
 
-	// KIS API 호출
-	balanceResp, err := d.client.GetBalance(accountNo)
+	// KIS API 호출 (사용자 자격 증명)
+	balanceResp, err := account.Client.GetBalance(context.Background(), account.AccountNo, account.ProductCode)
 	if err != nil {
 		return nil, fmt.Errorf("KIS API 잔고 조회 실패: %w", err)
 	}
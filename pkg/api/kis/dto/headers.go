@@ -53,22 +53,53 @@ func (h *KISHeaders) ApplyToRequest(req *http.Request) {
 // TR IDs 상수 정의
 const (
 	// 실전투자 TR IDs
-	TrIDOverseasBalanceReal = "TTTS3012R"     // 해외주식 잔고조회 (실전)
-	TrIDOverseasPriceReal   = "HHDFS00000300" // 해외주식 현재가 (실전)
+	TrIDOverseasBalanceReal  = "TTTS3012R"     // 해외주식 잔고조회 (실전)
+	TrIDOverseasPriceReal    = "HHDFS00000300" // 해외주식 현재가 (실전)
+	TrIDOverseasBuyReal      = "TTTT1002U"     // 미국 매수 주문 (실전)
+	TrIDOverseasSellReal     = "TTTT1006U"     // 미국 매도 주문 (실전)
+	TrIDOverseasRevisionReal = "TTTT1004U"     // 미국 정정/취소 주문 (실전)
+	TrIDOverseasCcnlReal     = "TTTS3035R"     // 해외주식 주문체결내역 (실전)
+	TrIDOverseasPresentReal  = "CTRP6504R"     // 해외주식 체결기준현재잔고 (실전)
 
 	// 모의투자 TR IDs
-	TrIDOverseasBalanceDemo = "VTTS3012R"     // 해외주식 잔고조회 (모의)
-	TrIDOverseasPriceDemo   = "HHDFS00000300" // 해외주식 현재가 (모의)
+	TrIDOverseasBalanceDemo  = "VTTS3012R"     // 해외주식 잔고조회 (모의)
+	TrIDOverseasPriceDemo    = "HHDFS00000300" // 해외주식 현재가 (모의)
+	TrIDOverseasBuyDemo      = "VTTT1002U"     // 미국 매수 주문 (모의)
+	TrIDOverseasSellDemo     = "VTTT1001U"     // 미국 매도 주문 (모의)
+	TrIDOverseasRevisionDemo = "VTTT1004U"     // 미국 정정/취소 주문 (모의)
+	TrIDOverseasCcnlDemo     = "VTTS3035R"     // 해외주식 주문체결내역 (모의)
+	TrIDOverseasPresentDemo  = "VTRP6504R"     // 해외주식 체결기준현재잔고 (모의)
+
+	// 시세 조회 TR IDs (실전/모의 공통)
+	TrIDOverseasDailyChart  = "HHDFS76240000" // 해외주식 기간별시세
+	TrIDOverseasMinuteChart = "HHDFS76950200" // 해외주식 분봉조회
+
+	// 웹소켓 실시간 TR IDs
+	TrIDRealtimeOverseasPrice      = "HDFSCNT0" // 해외주식 실시간지연체결가
+	TrIDRealtimeOverseasNoticeReal = "H0GSCNI0" // 해외주식 실시간체결통보 (실전)
+	TrIDRealtimeOverseasNoticeDemo = "H0GSCNI9" // 해외주식 실시간체결통보 (모의)
 )
 
 // NewBalanceHeaders 잔고 조회용 헤더 생성
-func NewBalanceHeaders(appKey, appSecret, accessToken, hashKey string, isDemo bool) *KISHeaders {
+func NewBalanceHeaders(appKey, appSecret, accessToken, trCont string, isDemo bool) *KISHeaders {
 	trID := TrIDOverseasBalanceReal
 	if isDemo {
 		trID = TrIDOverseasBalanceDemo
 	}
 
-	return NewKISHeaders(appKey, appSecret, accessToken, trID, hashKey)
+	headers := NewKISHeaders(appKey, appSecret, accessToken, trID, "")
+	headers.TrCont = trCont // 연속 조회 시 "N"
+	return headers
+}
+
+// NewPresentBalanceHeaders 체결기준현재잔고 조회용 헤더 생성
+func NewPresentBalanceHeaders(appKey, appSecret, accessToken string, isDemo bool) *KISHeaders {
+	trID := TrIDOverseasPresentReal
+	if isDemo {
+		trID = TrIDOverseasPresentDemo
+	}
+
+	return NewKISHeaders(appKey, appSecret, accessToken, trID, "")
 }
 
 // NewPriceHeaders 현재가 조회용 헤더 생성
@@ -80,3 +111,77 @@ func NewPriceHeaders(appKey, appSecret, accessToken, hashKey string, isDemo bool
 
 	return NewKISHeaders(appKey, appSecret, accessToken, trID, hashKey)
 }
+
+// NewOrderHeaders 주문용 헤더 생성 (매수/매도에 따라 TR ID 결정)
+func NewOrderHeaders(appKey, appSecret, accessToken, hashKey string, isBuy, isDemo bool) *KISHeaders {
+	var trID string
+	switch {
+	case isBuy && isDemo:
+		trID = TrIDOverseasBuyDemo
+	case isBuy:
+		trID = TrIDOverseasBuyReal
+	case isDemo:
+		trID = TrIDOverseasSellDemo
+	default:
+		trID = TrIDOverseasSellReal
+	}
+
+	return NewKISHeaders(appKey, appSecret, accessToken, trID, hashKey)
+}
+
+// NewRevisionHeaders 정정/취소 주문용 헤더 생성
+func NewRevisionHeaders(appKey, appSecret, accessToken, hashKey string, isDemo bool) *KISHeaders {
+	trID := TrIDOverseasRevisionReal
+	if isDemo {
+		trID = TrIDOverseasRevisionDemo
+	}
+
+	return NewKISHeaders(appKey, appSecret, accessToken, trID, hashKey)
+}
+
+// NewOrderHistoryHeaders 주문체결내역 조회용 헤더 생성
+func NewOrderHistoryHeaders(appKey, appSecret, accessToken, trCont string, isDemo bool) *KISHeaders {
+	trID := TrIDOverseasCcnlReal
+	if isDemo {
+		trID = TrIDOverseasCcnlDemo
+	}
+
+	headers := NewKISHeaders(appKey, appSecret, accessToken, trID, "")
+	headers.TrCont = trCont // 연속 조회 시 "N"
+	return headers
+}
+
+// NewChartHeaders 기간별시세/분봉 조회용 헤더 생성
+func NewChartHeaders(appKey, appSecret, accessToken, trID, trCont string) *KISHeaders {
+	headers := NewKISHeaders(appKey, appSecret, accessToken, trID, "")
+	headers.TrCont = trCont // 연속 조회 시 "N"
+	return headers
+}
+
+// HashkeyHeaders Hashkey 발급 API 헤더 (토큰 없이 앱키/시크릿만 사용)
+type HashkeyHeaders struct {
+	ContentType string `json:"content-type"`
+	AppKey      string `json:"appkey" validate:"required,min=1,max=36"`
+	AppSecret   string `json:"appsecret" validate:"required,min=1,max=180"`
+}
+
+// NewHashkeyHeaders 새로운 Hashkey 발급 헤더 생성
+func NewHashkeyHeaders(appKey, appSecret string) *HashkeyHeaders {
+	return &HashkeyHeaders{
+		ContentType: "application/json; charset=utf-8",
+		AppKey:      appKey,
+		AppSecret:   appSecret,
+	}
+}
+
+// Validate HashkeyHeaders 검증
+func (h *HashkeyHeaders) Validate() error {
+	return utils.ValidateStruct(h)
+}
+
+// ApplyToRequest HTTP 요청에 Hashkey 헤더 적용
+func (h *HashkeyHeaders) ApplyToRequest(req *http.Request) {
+	req.Header.Set("Content-Type", h.ContentType)
+	req.Header.Set("appKey", h.AppKey)
+	req.Header.Set("appSecret", h.AppSecret)
+}
@@ -1,24 +1,28 @@
 package dto
 
-import "auto-trader/pkg/shared/utils"
+import (
+	"auto-trader/pkg/shared/utils"
+	"net/url"
+	"strconv"
+)
 
-// BalanceRequest 해외주식 잔고 조회 요청
+// BalanceRequest 해외주식 잔고 조회 요청 (GET 쿼리 파라미터)
 type BalanceRequest struct {
-	CANO           string `json:"CANO" validate:"required,min=1,max=20"`        // 종합계좌번호
-	ACNT_PRDT_CD   string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"` // 계좌상품코드
-	OVRS_EXCG_CD   string `json:"OVRS_EXCG_CD"`                                 // 해외거래소코드
-	TR_CRCY_CD     string `json:"TR_CRCY_CD"`                                   // 거래통화코드
-	CTX_AREA_FK200 string `json:"CTX_AREA_FK200"`                               // 연속조회검색조건
-	CTX_AREA_NK200 string `json:"CTX_AREA_NK200"`                               // 연속조회키
+	CANO           string `json:"CANO" validate:"required,min=1,max=20"`                // 종합계좌번호
+	ACNT_PRDT_CD   string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"`         // 계좌상품코드
+	OVRS_EXCG_CD   string `json:"OVRS_EXCG_CD" validate:"required,enum=NASD,NYSE,AMEX"` // 해외거래소코드 (실전 NASD: 미국 전체)
+	TR_CRCY_CD     string `json:"TR_CRCY_CD" validate:"required"`                       // 거래통화코드
+	CTX_AREA_FK200 string `json:"CTX_AREA_FK200"`                                       // 연속조회검색조건
+	CTX_AREA_NK200 string `json:"CTX_AREA_NK200"`                                       // 연속조회키
 }
 
 // NewBalanceRequest 새로운 잔고 조회 요청 생성
-func NewBalanceRequest(accountNo string) *BalanceRequest {
+func NewBalanceRequest(accountNo, productCode, exchange string) *BalanceRequest {
 	return &BalanceRequest{
 		CANO:           accountNo,
-		ACNT_PRDT_CD:   "01", // 기본값
-		OVRS_EXCG_CD:   "",   // 전체
-		TR_CRCY_CD:     "",   // 전체
+		ACNT_PRDT_CD:   productCode,
+		OVRS_EXCG_CD:   exchange,
+		TR_CRCY_CD:     "USD",
 		CTX_AREA_FK200: "",
 		CTX_AREA_NK200: "",
 	}
@@ -29,6 +33,57 @@ func (r *BalanceRequest) Validate() error {
 	return utils.ValidateStruct(r)
 }
 
+// ToQuery GET 요청용 쿼리 문자열 생성
+func (r *BalanceRequest) ToQuery() string {
+	q := url.Values{}
+	q.Set("CANO", r.CANO)
+	q.Set("ACNT_PRDT_CD", r.ACNT_PRDT_CD)
+	q.Set("OVRS_EXCG_CD", r.OVRS_EXCG_CD)
+	q.Set("TR_CRCY_CD", r.TR_CRCY_CD)
+	q.Set("CTX_AREA_FK200", r.CTX_AREA_FK200)
+	q.Set("CTX_AREA_NK200", r.CTX_AREA_NK200)
+	return q.Encode()
+}
+
+// PresentBalanceRequest 해외주식 체결기준현재잔고 조회 요청 (GET 쿼리 파라미터, 환율 조회용)
+type PresentBalanceRequest struct {
+	CANO              string `json:"CANO" validate:"required,min=1,max=8"`                 // 종합계좌번호
+	ACNT_PRDT_CD      string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"`         // 계좌상품코드
+	WCRC_FRCR_DVSN_CD string `json:"WCRC_FRCR_DVSN_CD" validate:"required,enum=01,02"`     // 원화외화구분코드 (01: 원화, 02: 외화)
+	NATN_CD           string `json:"NATN_CD" validate:"required"`                          // 국가코드 (840: 미국)
+	TR_MKET_CD        string `json:"TR_MKET_CD" validate:"required"`                       // 거래시장코드 (00: 전체)
+	INQR_DVSN_CD      string `json:"INQR_DVSN_CD" validate:"required,enum=00,01,02,03,04"` // 조회구분코드 (00: 전체)
+}
+
+// NewPresentBalanceRequest 새로운 체결기준현재잔고 조회 요청 생성 (미국 전체 시장)
+func NewPresentBalanceRequest(accountNo, productCode string) *PresentBalanceRequest {
+	return &PresentBalanceRequest{
+		CANO:              accountNo,
+		ACNT_PRDT_CD:      productCode,
+		WCRC_FRCR_DVSN_CD: "02",
+		NATN_CD:           "840",
+		TR_MKET_CD:        "00",
+		INQR_DVSN_CD:      "00",
+	}
+}
+
+// Validate PresentBalanceRequest 검증
+func (r *PresentBalanceRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// ToQuery GET 요청용 쿼리 문자열 생성
+func (r *PresentBalanceRequest) ToQuery() string {
+	q := url.Values{}
+	q.Set("CANO", r.CANO)
+	q.Set("ACNT_PRDT_CD", r.ACNT_PRDT_CD)
+	q.Set("WCRC_FRCR_DVSN_CD", r.WCRC_FRCR_DVSN_CD)
+	q.Set("NATN_CD", r.NATN_CD)
+	q.Set("TR_MKET_CD", r.TR_MKET_CD)
+	q.Set("INQR_DVSN_CD", r.INQR_DVSN_CD)
+	return q.Encode()
+}
+
 // PriceRequest 현재가 조회 요청
 type PriceRequest struct {
 	AUTH string `json:"AUTH"`                                  // 인증 정보
@@ -36,11 +91,11 @@ type PriceRequest struct {
 	SYMB string `json:"SYMB" validate:"required,min=1,max=20"` // 종목 심볼
 }
 
-// NewPriceRequest 새로운 현재가 조회 요청 생성
-func NewPriceRequest(symbol string) *PriceRequest {
+// NewPriceRequest 새로운 현재가 조회 요청 생성 (exchange: 시세 거래소코드 NAS, NYS, AMS)
+func NewPriceRequest(exchange, symbol string) *PriceRequest {
 	return &PriceRequest{
 		AUTH: "",
-		EXCD: "NAS", // NASDAQ 기본값
+		EXCD: exchange,
 		SYMB: symbol,
 	}
 }
@@ -50,21 +105,55 @@ func (r *PriceRequest) Validate() error {
 	return utils.ValidateStruct(r)
 }
 
-// OrderRequest 주문 요청 (향후 확장용)
+// 해외주식 주문구분 (ORD_DVSN) 코드
+const (
+	OrderDivisionLimit = "00" // 지정가
+	OrderDivisionMOO   = "31" // 장개시시장가 (매도 전용)
+	OrderDivisionLOO   = "32" // 장개시지정가
+	OrderDivisionMOC   = "33" // 장마감시장가 (매도 전용)
+	OrderDivisionLOC   = "34" // 장마감지정가
+)
+
+// 정정취소구분코드 (RVSE_CNCL_DVSN_CD)
+const (
+	RevisionCodeAmend  = "01" // 정정
+	RevisionCodeCancel = "02" // 취소
+)
+
+// OrderRequest 해외주식 주문 요청
 type OrderRequest struct {
-	Symbol    string `json:"symbol" validate:"required,min=1,max=20"`     // 종목 심볼
-	Quantity  int    `json:"quantity" validate:"required"`                // 수량
-	Price     string `json:"price" validate:"required,min=1,max=20"`      // 가격
-	OrderType string `json:"orderType" validate:"required,enum=BUY,SELL"` // 주문 타입 (BUY, SELL)
+	CANO            string `json:"CANO" validate:"required,min=1,max=8"`                 // 종합계좌번호
+	ACNT_PRDT_CD    string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"`         // 계좌상품코드
+	OVRS_EXCG_CD    string `json:"OVRS_EXCG_CD" validate:"required,enum=NASD,NYSE,AMEX"` // 해외거래소코드
+	PDNO            string `json:"PDNO" validate:"required,min=1,max=12"`                // 상품번호 (종목 심볼)
+	ORD_QTY         string `json:"ORD_QTY" validate:"required,min=1,max=10"`             // 주문수량
+	OVRS_ORD_UNPR   string `json:"OVRS_ORD_UNPR" validate:"required,min=1,max=32"`       // 해외주문단가
+	CTAC_TLNO       string `json:"CTAC_TLNO"`                                            // 연락전화번호
+	MGCO_APTM_ODNO  string `json:"MGCO_APTM_ODNO"`                                       // 운용사지정주문번호
+	SLL_TYPE        string `json:"SLL_TYPE"`                                             // 판매유형 (매도: 00, 매수: 빈값)
+	ORD_SVR_DVSN_CD string `json:"ORD_SVR_DVSN_CD"`                                      // 주문서버구분코드
+	ORD_DVSN        string `json:"ORD_DVSN" validate:"required,enum=00,31,32,33,34"`     // 주문구분
 }
 
-// NewOrderRequest 새로운 주문 요청 생성
-func NewOrderRequest(symbol string, quantity int, price string, orderType string) *OrderRequest {
+// NewOrderRequest 새로운 해외주식 주문 요청 생성
+func NewOrderRequest(accountNo, productCode, exchange, symbol, quantity, price, orderDivision string, isSell bool) *OrderRequest {
+	sellType := ""
+	if isSell {
+		sellType = "00"
+	}
+
 	return &OrderRequest{
-		Symbol:    symbol,
-		Quantity:  quantity,
-		Price:     price,
-		OrderType: orderType,
+		CANO:            accountNo,
+		ACNT_PRDT_CD:    productCode,
+		OVRS_EXCG_CD:    exchange,
+		PDNO:            symbol,
+		ORD_QTY:         quantity,
+		OVRS_ORD_UNPR:   price,
+		CTAC_TLNO:       "",
+		MGCO_APTM_ODNO:  "",
+		SLL_TYPE:        sellType,
+		ORD_SVR_DVSN_CD: "0", // 기본값
+		ORD_DVSN:        orderDivision,
 	}
 }
 
@@ -72,3 +161,287 @@ func NewOrderRequest(symbol string, quantity int, price string, orderType string
 func (r *OrderRequest) Validate() error {
 	return utils.ValidateStruct(r)
 }
+
+// RevisionRequest 해외주식 정정/취소 주문 요청
+type RevisionRequest struct {
+	CANO              string `json:"CANO" validate:"required,min=1,max=8"`                 // 종합계좌번호
+	ACNT_PRDT_CD      string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"`         // 계좌상품코드
+	OVRS_EXCG_CD      string `json:"OVRS_EXCG_CD" validate:"required,enum=NASD,NYSE,AMEX"` // 해외거래소코드
+	PDNO              string `json:"PDNO" validate:"required,min=1,max=12"`                // 상품번호 (종목 심볼)
+	ORGN_ODNO         string `json:"ORGN_ODNO" validate:"required,min=1,max=10"`           // 원주문번호
+	RVSE_CNCL_DVSN_CD string `json:"RVSE_CNCL_DVSN_CD" validate:"required,enum=01,02"`     // 정정취소구분코드
+	ORD_QTY           string `json:"ORD_QTY" validate:"required,min=1,max=10"`             // 주문수량
+	OVRS_ORD_UNPR     string `json:"OVRS_ORD_UNPR" validate:"required,min=1,max=32"`       // 해외주문단가 (취소 시 0)
+	MGCO_APTM_ODNO    string `json:"MGCO_APTM_ODNO"`                                       // 운용사지정주문번호
+	ORD_SVR_DVSN_CD   string `json:"ORD_SVR_DVSN_CD"`                                      // 주문서버구분코드
+}
+
+// NewRevisionRequest 새로운 정정/취소 주문 요청 생성
+func NewRevisionRequest(accountNo, productCode, exchange, symbol, originalOrderNo, revisionCode, quantity, price string) *RevisionRequest {
+	return &RevisionRequest{
+		CANO:              accountNo,
+		ACNT_PRDT_CD:      productCode,
+		OVRS_EXCG_CD:      exchange,
+		PDNO:              symbol,
+		ORGN_ODNO:         originalOrderNo,
+		RVSE_CNCL_DVSN_CD: revisionCode,
+		ORD_QTY:           quantity,
+		OVRS_ORD_UNPR:     price,
+		MGCO_APTM_ODNO:    "",
+		ORD_SVR_DVSN_CD:   "0", // 기본값
+	}
+}
+
+// Validate RevisionRequest 검증
+func (r *RevisionRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// OrderHistoryRequest 해외주식 주문체결내역 조회 요청 (GET 쿼리 파라미터)
+type OrderHistoryRequest struct {
+	CANO           string `json:"CANO" validate:"required,min=1,max=8"`           // 종합계좌번호
+	ACNT_PRDT_CD   string `json:"ACNT_PRDT_CD" validate:"required,min=1,max=2"`   // 계좌상품코드
+	PDNO           string `json:"PDNO"`                                           // 상품번호 (전체: 빈값)
+	ORD_STRT_DT    string `json:"ORD_STRT_DT" validate:"required,min=8,max=8"`    // 주문시작일자 (YYYYMMDD)
+	ORD_END_DT     string `json:"ORD_END_DT" validate:"required,min=8,max=8"`     // 주문종료일자 (YYYYMMDD)
+	SLL_BUY_DVSN   string `json:"SLL_BUY_DVSN" validate:"required,enum=00,01,02"` // 매도매수구분 (00: 전체)
+	CCLD_NCCS_DVSN string `json:"CCLD_NCCS_DVSN" validate:"required"`             // 체결미체결구분 (00: 전체)
+	OVRS_EXCG_CD   string `json:"OVRS_EXCG_CD"`                                   // 해외거래소코드 (전체: 빈값)
+	SORT_SQN       string `json:"SORT_SQN"`                                       // 정렬순서 (DS: 정순)
+	ORD_DT         string `json:"ORD_DT"`                                         // 주문일자
+	ORD_GNO_BRNO   string `json:"ORD_GNO_BRNO"`                                   // 주문채번지점번호
+	ODNO           string `json:"ODNO"`                                           // 주문번호
+	CTX_AREA_NK200 string `json:"CTX_AREA_NK200"`                                 // 연속조회키
+	CTX_AREA_FK200 string `json:"CTX_AREA_FK200"`                                 // 연속조회검색조건
+}
+
+// NewOrderHistoryRequest 새로운 주문체결내역 조회 요청 생성
+func NewOrderHistoryRequest(accountNo, productCode, startDate, endDate string) *OrderHistoryRequest {
+	return &OrderHistoryRequest{
+		CANO:           accountNo,
+		ACNT_PRDT_CD:   productCode,
+		PDNO:           "",
+		ORD_STRT_DT:    startDate,
+		ORD_END_DT:     endDate,
+		SLL_BUY_DVSN:   "00", // 전체
+		CCLD_NCCS_DVSN: "00", // 전체
+		OVRS_EXCG_CD:   "",
+		SORT_SQN:       "DS",
+		ORD_DT:         "",
+		ORD_GNO_BRNO:   "",
+		ODNO:           "",
+		CTX_AREA_NK200: "",
+		CTX_AREA_FK200: "",
+	}
+}
+
+// Validate OrderHistoryRequest 검증
+func (r *OrderHistoryRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// ToQuery GET 요청용 쿼리 문자열 생성
+func (r *OrderHistoryRequest) ToQuery() string {
+	q := url.Values{}
+	q.Set("CANO", r.CANO)
+	q.Set("ACNT_PRDT_CD", r.ACNT_PRDT_CD)
+	q.Set("PDNO", r.PDNO)
+	q.Set("ORD_STRT_DT", r.ORD_STRT_DT)
+	q.Set("ORD_END_DT", r.ORD_END_DT)
+	q.Set("SLL_BUY_DVSN", r.SLL_BUY_DVSN)
+	q.Set("CCLD_NCCS_DVSN", r.CCLD_NCCS_DVSN)
+	q.Set("OVRS_EXCG_CD", r.OVRS_EXCG_CD)
+	q.Set("SORT_SQN", r.SORT_SQN)
+	q.Set("ORD_DT", r.ORD_DT)
+	q.Set("ORD_GNO_BRNO", r.ORD_GNO_BRNO)
+	q.Set("ODNO", r.ODNO)
+	q.Set("CTX_AREA_NK200", r.CTX_AREA_NK200)
+	q.Set("CTX_AREA_FK200", r.CTX_AREA_FK200)
+	return q.Encode()
+}
+
+// DailyChartRequest 해외주식 기간별시세 조회 요청 (GET 쿼리 파라미터)
+type DailyChartRequest struct {
+	AUTH string `json:"AUTH"`                                  // 사용자권한정보 (빈값)
+	EXCD string `json:"EXCD" validate:"required,min=1,max=4"`  // 거래소코드 (NAS, NYS, AMS)
+	SYMB string `json:"SYMB" validate:"required,min=1,max=20"` // 종목코드
+	GUBN string `json:"GUBN" validate:"required,enum=0,1,2"`   // 일/주/월 구분 (0: 일)
+	BYMD string `json:"BYMD"`                                  // 조회기준일자 (YYYYMMDD, 빈값: 오늘)
+	MODP string `json:"MODP" validate:"required,enum=0,1"`     // 수정주가반영여부 (1: 반영)
+	KEYB string `json:"KEYB"`                                  // NEXT KEY BUFF (연속조회키)
+}
+
+// NewDailyChartRequest 새로운 기간별시세 조회 요청 생성
+func NewDailyChartRequest(exchange, symbol, baseDate string) *DailyChartRequest {
+	return &DailyChartRequest{
+		AUTH: "",
+		EXCD: exchange,
+		SYMB: symbol,
+		GUBN: "0",
+		BYMD: baseDate,
+		MODP: "1",
+		KEYB: "",
+	}
+}
+
+// Validate DailyChartRequest 검증
+func (r *DailyChartRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// ToQuery GET 요청용 쿼리 문자열 생성
+func (r *DailyChartRequest) ToQuery() string {
+	q := url.Values{}
+	q.Set("AUTH", r.AUTH)
+	q.Set("EXCD", r.EXCD)
+	q.Set("SYMB", r.SYMB)
+	q.Set("GUBN", r.GUBN)
+	q.Set("BYMD", r.BYMD)
+	q.Set("MODP", r.MODP)
+	q.Set("KEYB", r.KEYB)
+	return q.Encode()
+}
+
+// MinuteChartRequest 해외주식 분봉 조회 요청 (GET 쿼리 파라미터)
+type MinuteChartRequest struct {
+	AUTH string `json:"AUTH"`                                  // 사용자권한정보 (빈값)
+	EXCD string `json:"EXCD" validate:"required,min=1,max=4"`  // 거래소코드 (NAS, NYS, AMS)
+	SYMB string `json:"SYMB" validate:"required,min=1,max=20"` // 종목코드
+	NMIN string `json:"NMIN" validate:"required,min=1,max=3"`  // 분갭 (1, 5, 15, 30, 60)
+	PINC string `json:"PINC" validate:"required,enum=0,1"`     // 전일포함여부 (1: 포함)
+	NEXT string `json:"NEXT"`                                  // 다음여부 (처음: 빈값, 다음: 1)
+	NREC string `json:"NREC" validate:"required,min=1,max=3"`  // 요청개수 (최대 120)
+	FILL string `json:"FILL"`                                  // 미체결채움구분 (빈값)
+	KEYB string `json:"KEYB"`                                  // NEXT KEY BUFF (YYYYMMDDHHMMSS)
+}
+
+// NewMinuteChartRequest 새로운 분봉 조회 요청 생성
+func NewMinuteChartRequest(exchange, symbol string, minutes int) *MinuteChartRequest {
+	return &MinuteChartRequest{
+		AUTH: "",
+		EXCD: exchange,
+		SYMB: symbol,
+		NMIN: strconv.Itoa(minutes),
+		PINC: "1",
+		NEXT: "",
+		NREC: "120",
+		FILL: "",
+		KEYB: "",
+	}
+}
+
+// Validate MinuteChartRequest 검증
+func (r *MinuteChartRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// ToQuery GET 요청용 쿼리 문자열 생성
+func (r *MinuteChartRequest) ToQuery() string {
+	q := url.Values{}
+	q.Set("AUTH", r.AUTH)
+	q.Set("EXCD", r.EXCD)
+	q.Set("SYMB", r.SYMB)
+	q.Set("NMIN", r.NMIN)
+	q.Set("PINC", r.PINC)
+	q.Set("NEXT", r.NEXT)
+	q.Set("NREC", r.NREC)
+	q.Set("FILL", r.FILL)
+	q.Set("KEYB", r.KEYB)
+	return q.Encode()
+}
+
+// TokenRequest 접근토큰 발급 요청 (/oauth2/tokenP)
+type TokenRequest struct {
+	GrantType string `json:"grant_type" validate:"required"`
+	AppKey    string `json:"appkey" validate:"required,min=1,max=36"`
+	AppSecret string `json:"appsecret" validate:"required,min=1,max=180"`
+}
+
+// NewTokenRequest 새로운 접근토큰 발급 요청 생성
+func NewTokenRequest(appKey, appSecret string) *TokenRequest {
+	return &TokenRequest{
+		GrantType: "client_credentials",
+		AppKey:    appKey,
+		AppSecret: appSecret,
+	}
+}
+
+// Validate TokenRequest 검증
+func (r *TokenRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// ApprovalRequest 웹소켓 접속키 발급 요청 (/oauth2/Approval)
+type ApprovalRequest struct {
+	GrantType string `json:"grant_type" validate:"required"`
+	AppKey    string `json:"appkey" validate:"required,min=1,max=36"`
+	SecretKey string `json:"secretkey" validate:"required,min=1,max=180"`
+}
+
+// NewApprovalRequest 새로운 웹소켓 접속키 발급 요청 생성
+func NewApprovalRequest(appKey, appSecret string) *ApprovalRequest {
+	return &ApprovalRequest{
+		GrantType: "client_credentials",
+		AppKey:    appKey,
+		SecretKey: appSecret,
+	}
+}
+
+// Validate ApprovalRequest 검증
+func (r *ApprovalRequest) Validate() error {
+	return utils.ValidateStruct(r)
+}
+
+// 실시간 등록/해제 구분 (tr_type)
+const (
+	RealtimeRegister   = "1" // 등록
+	RealtimeUnregister = "2" // 해제
+)
+
+// RealtimeRequest 웹소켓 실시간 등록/해제 요청
+type RealtimeRequest struct {
+	Header RealtimeRequestHeader `json:"header"`
+	Body   RealtimeRequestBody   `json:"body"`
+}
+
+// RealtimeRequestHeader 웹소켓 요청 헤더
+type RealtimeRequestHeader struct {
+	ApprovalKey string `json:"approval_key" validate:"required"`
+	CustType    string `json:"custtype" validate:"required,enum=P,B"`
+	TrType      string `json:"tr_type" validate:"required,enum=1,2"`
+	ContentType string `json:"content-type"`
+}
+
+// RealtimeRequestBody 웹소켓 요청 바디
+type RealtimeRequestBody struct {
+	Input RealtimeRequestInput `json:"input"`
+}
+
+// RealtimeRequestInput 실시간 TR 및 키
+type RealtimeRequestInput struct {
+	TrID  string `json:"tr_id"`
+	TrKey string `json:"tr_key"`
+}
+
+// NewRealtimeRequest 새로운 웹소켓 실시간 등록/해제 요청 생성
+func NewRealtimeRequest(approvalKey, trType, trID, trKey string) *RealtimeRequest {
+	return &RealtimeRequest{
+		Header: RealtimeRequestHeader{
+			ApprovalKey: approvalKey,
+			CustType:    "P", // 개인
+			TrType:      trType,
+			ContentType: "utf-8",
+		},
+		Body: RealtimeRequestBody{
+			Input: RealtimeRequestInput{
+				TrID:  trID,
+				TrKey: trKey,
+			},
+		},
+	}
+}
+
+// Validate RealtimeRequest 검증
+func (r *RealtimeRequest) Validate() error {
+	return utils.ValidateStruct(r.Header)
+}
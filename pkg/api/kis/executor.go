@@ -0,0 +1,784 @@
+package kis
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultPollInterval 체결 내역 폴링 주기
+	defaultPollInterval = 3 * time.Second
+	// defaultOrderTimeout IOC 주문 미체결 잔량 자동 취소까지 대기 시간
+	defaultOrderTimeout = 30 * time.Second
+)
+
+// marketableLimitBuffer 시장가 주문을 지정가로 변환할 때 기준가에 더하는 여유 폭 (0.5%)
+// 미국 주식은 정규장 시장가 주문이 없으므로 즉시 체결 가능한 지정가로 대체한다
+var marketableLimitBuffer = decimal.NewFromFloat(0.005)
+
+// trackedOrder 실행기가 추적 중인 주문
+type trackedOrder struct {
+	account         *Account // 주문을 제출한 사용자 계좌
+	update          order.Update
+	submittedAt     time.Time
+	filledAmount    decimal.Decimal // 누적 체결 금액 (직전 체결 단가 계산용)
+	cancelRequested bool
+}
+
+// queuedOrder 거래 세션 외에 접수되어 다음 개장에 제출할 주문
+type queuedOrder struct {
+	account *Account
+	update  order.Update
+	timer   *time.Timer
+}
+
+// OrderExecutor KIS API 기반 주문 실행기 (주문 사용자의 연결 계좌/자격 증명으로 제출)
+type OrderExecutor struct {
+	accounts     AccountResolver
+	pollInterval time.Duration
+	orderTimeout time.Duration
+	guard        *order.SessionGuard   // 거래 세션 외 주문 거부/대기 규칙
+	risk         order.RiskChecker     // 주문 제출 전 리스크 검사
+	openOrders   order.OpenOrderSource // 재시작 시 추적 복구할 미체결 주문
+
+	orders    map[string]*trackedOrder // client order id → 주문
+	queued    map[string]*queuedOrder  // client order id → 개장 대기 주문
+	handlers  []order.UpdateHandler
+	mutex     sync.RWMutex
+	syncMutex sync.Mutex // 폴링/체결통보에 의한 동시 동기화 방지
+	stopChan  chan struct{}
+	isRunning bool
+}
+
+// NewOrderExecutor 새로운 KIS 주문 실행기 생성
+func NewOrderExecutor(accounts AccountResolver, orderTimeout time.Duration) *OrderExecutor {
+	if orderTimeout <= 0 {
+		orderTimeout = defaultOrderTimeout
+	}
+
+	return &OrderExecutor{
+		accounts:     accounts,
+		pollInterval: defaultPollInterval,
+		orderTimeout: orderTimeout,
+		orders:       make(map[string]*trackedOrder),
+		queued:       make(map[string]*queuedOrder),
+		stopChan:     make(chan struct{}),
+	}
+}
+
+// SetSessionGuard 거래 세션 외 주문 처리 규칙 설정 (nil이면 세션 확인 없이 제출)
+func (e *OrderExecutor) SetSessionGuard(guard *order.SessionGuard) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.guard = guard
+}
+
+// SetRiskChecker 주문 제출 전 리스크 검사 설정 (nil이면 검사 없이 제출)
+func (e *OrderExecutor) SetRiskChecker(checker order.RiskChecker) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.risk = checker
+}
+
+// SetOpenOrderSource 재시작 시 추적을 복구할 미체결 주문 조회 설정 (nil이면 복구하지 않음)
+func (e *OrderExecutor) SetOpenOrderSource(source order.OpenOrderSource) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.openOrders = source
+}
+
+// Start 저장된 미체결 주문 추적 복구 후 체결 내역 폴링 시작
+func (e *OrderExecutor) Start() {
+	e.mutex.Lock()
+	if e.isRunning {
+		e.mutex.Unlock()
+		return
+	}
+
+	e.stopChan = make(chan struct{})
+	e.isRunning = true
+	source := e.openOrders
+	e.mutex.Unlock()
+
+	if source != nil {
+		e.restore(source)
+	}
+	go e.pollLoop()
+
+	logrus.Info("🚀 KIS 주문 실행기 시작됨")
+}
+
+// restore 이전 실행에서 제출/대기 중이던 실계좌 주문 추적 복구
+// 제출된 주문은 주문번호로 체결 내역 폴링을 재개한다.
+// 접수(NEW) 상태 주문은 제출 직후 재시작됐을 수 있으므로 KIS 주문 내역에서 먼저 찾아 추적하고,
+// 내역에 없을 때만 거래 세션 규칙에 따라 다시 대기시킨다 (제출 시 리스크 검사를 다시 거침).
+func (e *OrderExecutor) restore(source order.OpenOrderSource) {
+	updates, err := source.OpenOrders(order.ModeLive)
+	if err != nil {
+		logrus.Errorf("❌ 미체결 주문 복구 실패: %v", err)
+		return
+	}
+
+	e.mutex.RLock()
+	guard := e.guard
+	e.mutex.RUnlock()
+
+	// 주문번호가 있는 주문을 먼저 추적해 NEW 주문이 이미 추적 중인 주문번호를 차지하지 않게 한다
+	var pending []order.Update
+	tracked, adopted, queued := 0, 0, 0
+	for _, update := range updates {
+		if e.isKnown(update.ClientOrderID) {
+			continue
+		}
+		if update.Status == order.StatusNew {
+			pending = append(pending, update)
+			continue
+		}
+
+		account, err := e.accounts.ResolveAccount(update.UserID)
+		if err != nil {
+			logrus.Errorf("❌ 미체결 주문 복구 실패 (%s): 증권 계좌 확인 실패: %v", update.ClientOrderID, err)
+			continue
+		}
+		if update.BrokerOrderID == "" {
+			logrus.Errorf("❌ 미체결 주문 복구 실패 (%s): 주문번호가 없습니다", update.ClientOrderID)
+			continue
+		}
+
+		e.track(account, update, update.FilledQuantity.Mul(update.AvgFillPrice))
+		tracked++
+	}
+
+	histories := make(map[string][]KISOrderHistoryOutput)
+	for _, update := range pending {
+		account, err := e.accounts.ResolveAccount(update.UserID)
+		if err != nil {
+			e.reject(update, fmt.Sprintf("증권 계좌 확인 실패: %v", err))
+			continue
+		}
+
+		row, err := e.findSubmitted(account, update, histories)
+		if err != nil {
+			e.reject(update, fmt.Sprintf("재시작 전 주문 제출 여부 확인 실패: %v", err))
+			continue
+		}
+		if row != nil {
+			update.BrokerOrderID = row.Odno
+			update.Status = order.StatusSubmitted
+			update.Timestamp = time.Now()
+			e.track(account, update, decimal.Zero)
+			e.emit(update)
+			logrus.Infof("♻️  제출 확인된 주문 추적 복구: %s (주문번호: %s)", update.ClientOrderID, row.Odno)
+			adopted++
+			continue
+		}
+
+		releaseAt, err := guard.Admit(time.Now(), update.Type, update.TimeInForce)
+		if err != nil {
+			e.reject(update, err.Error())
+			continue
+		}
+		if releaseAt.IsZero() {
+			releaseAt = time.Now()
+		}
+		e.queue(account, update, releaseAt)
+		queued++
+	}
+
+	if tracked > 0 || adopted > 0 || queued > 0 {
+		logrus.Infof("♻️  미체결 주문 추적 복구: 제출 %d건, 제출 확인 %d건, 개장 대기 %d건", tracked, adopted, queued)
+	}
+}
+
+// findSubmitted 접수(NEW) 상태로 남은 주문이 KIS에 이미 제출됐는지 주문 내역에서 확인 (없으면 nil)
+// KIS 주문에는 클라이언트 주문 ID가 없으므로 종목/매매 방향/수량/주문가가 같고 아직 추적 중이지 않은 주문번호를 찾는다.
+func (e *OrderExecutor) findSubmitted(account *Account, update order.Update, histories map[string][]KISOrderHistoryOutput) (*KISOrderHistoryOutput, error) {
+	key := account.key()
+	history, ok := histories[key]
+	if !ok {
+		ctx, cancel := context.WithTimeout(context.Background(), e.orderTimeout)
+		defer cancel()
+
+		startDate, endDate := historyRange(update.Timestamp)
+		rows, err := account.Client.GetOrderHistory(ctx, account.AccountNo, account.ProductCode, startDate, endDate)
+		if err != nil {
+			return nil, err
+		}
+		history = rows
+		histories[key] = history
+	}
+
+	side := "02"
+	if update.Side == order.SideSell {
+		side = "01"
+	}
+	for i := range history {
+		row := &history[i]
+		if row.Pdno != update.Symbol || row.SllBuyDvsnCd != side || row.RvseCnclDvsn == "02" {
+			continue
+		}
+		quantity, err := decimal.NewFromString(row.FtOrdQty)
+		if err != nil || !quantity.Equal(update.Quantity) {
+			continue
+		}
+		if update.Price.IsPositive() {
+			if price, err := decimal.NewFromString(row.FtOrdUnpr3); err != nil || !price.Equal(update.Price) {
+				continue
+			}
+		}
+		if e.isTracked(row.Odno) {
+			continue
+		}
+		return row, nil
+	}
+	return nil, nil
+}
+
+// track 제출된 주문 체결 추적 등록
+func (e *OrderExecutor) track(account *Account, update order.Update, filledAmount decimal.Decimal) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.orders[update.ClientOrderID] = &trackedOrder{
+		account:      account,
+		update:       update,
+		submittedAt:  update.Timestamp,
+		filledAmount: filledAmount,
+	}
+}
+
+// historyRange 체결 내역 조회 기간 (해외주식 주문일자는 현지(미국 동부) 기준, since 전날부터 오늘까지)
+func historyRange(since time.Time) (string, string) {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		loc = time.UTC
+	}
+	return since.In(loc).AddDate(0, 0, -1).Format("20060102"), time.Now().In(loc).Format("20060102")
+}
+
+// isKnown 이미 추적 중이거나 개장 대기 중인 주문인지 확인
+func (e *OrderExecutor) isKnown(clientOrderID string) bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	_, tracked := e.orders[clientOrderID]
+	_, queued := e.queued[clientOrderID]
+	return tracked || queued
+}
+
+// Stop 체결 내역 폴링 중지
+func (e *OrderExecutor) Stop() {
+	e.mutex.Lock()
+	if !e.isRunning {
+		e.mutex.Unlock()
+		return
+	}
+
+	close(e.stopChan)
+	e.isRunning = false
+
+	// 개장 대기 주문은 메모리에만 있으므로 취소로 마감
+	queued := make([]*queuedOrder, 0, len(e.queued))
+	for id, q := range e.queued {
+		q.timer.Stop()
+		queued = append(queued, q)
+		delete(e.queued, id)
+	}
+	e.mutex.Unlock()
+
+	for _, q := range queued {
+		e.cancelQueued(q.update)
+	}
+
+	logrus.Info("⏹️  KIS 주문 실행기 중지됨")
+}
+
+// OnOrderUpdate 주문 상태 변경 콜백 등록
+func (e *OrderExecutor) OnOrderUpdate(handler order.UpdateHandler) {
+	e.mutex.Lock()
+	defer e.mutex.Unlock()
+
+	e.handlers = append(e.handlers, handler)
+}
+
+// ListenFillNotices 실시간 체결통보 수신 시 즉시 체결 내역 동기화
+// 통보 채널이 닫히면 종료되며, 폴링은 통보 누락에 대비해 계속 동작한다.
+func (e *OrderExecutor) ListenFillNotices(notices <-chan FillNotice) {
+	go func() {
+		for notice := range notices {
+			if !e.isTracked(notice.OrderNo, notice.OriginalOrderNo) {
+				continue
+			}
+			logrus.Debugf("체결통보 수신 (주문번호: %s, 체결수량: %s)", notice.OrderNo, notice.FillQuantity)
+			e.syncOrders()
+		}
+	}()
+}
+
+func (e *OrderExecutor) isTracked(orderNos ...string) bool {
+	e.mutex.RLock()
+	defer e.mutex.RUnlock()
+
+	// 체결통보 주문번호는 자릿수가 다를 수 있어 앞자리 0을 제외하고 비교
+	for _, tracked := range e.orders {
+		brokerOrderID := strings.TrimLeft(tracked.update.BrokerOrderID, "0")
+		for _, orderNo := range orderNos {
+			if orderNo != "" && brokerOrderID == strings.TrimLeft(orderNo, "0") {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// ExecuteOrder 주문 제출 (NEW → SUBMITTED 또는 REJECTED)
+func (e *OrderExecutor) ExecuteOrder(ctx context.Context, req *order.Request) (*order.Update, error) {
+	if req.Symbol == "" {
+		return nil, utils.BadRequest("종목 코드가 필요합니다")
+	}
+	if req.Side != order.SideBuy && req.Side != order.SideSell {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 방향: %s", req.Side))
+	}
+
+	clientOrderID := req.ClientOrderID
+	if clientOrderID == "" {
+		clientOrderID = uuid.New().String()
+	}
+
+	orderType := req.Type
+	if orderType == "" {
+		orderType = order.TypeMarket
+	}
+	timeInForce := req.TimeInForce
+	if timeInForce == "" {
+		timeInForce = order.TimeInForceDay
+	}
+
+	// 해외주식은 소수점 주문 불가 - 내림 처리
+	quantity := req.Quantity.Floor()
+	price := e.resolveOrderPrice(req.Side, orderType, req.Price)
+
+	// 주문 사용자의 연결 계좌 (없으면 접수 후 거부)
+	account, accountErr := e.accounts.ResolveAccount(req.UserID)
+	exchange := req.Exchange
+	if accountErr == nil {
+		exchange = account.Client.orderExchange(req.Symbol, req.Exchange)
+	}
+
+	update := order.Update{
+		ClientOrderID: clientOrderID,
+		UserID:        req.UserID,
+		StrategyID:    req.StrategyID,
+		Symbol:        req.Symbol,
+		Exchange:      exchange,
+		Mode:          order.ModeLive,
+		Side:          req.Side,
+		Type:          orderType,
+		TimeInForce:   timeInForce,
+		Quantity:      quantity,
+		Price:         price,
+		Status:        order.StatusNew,
+		Liquidation:   req.Liquidation,
+		HeldQuantity:  req.HeldQuantity,
+		Timestamp:     time.Now(),
+	}
+	e.emit(update)
+
+	if accountErr != nil {
+		return e.reject(update, fmt.Sprintf("증권 계좌 확인 실패: %v", accountErr))
+	}
+	if !quantity.IsPositive() {
+		return e.reject(update, fmt.Sprintf("주문 수량이 1주 미만입니다: %s", req.Quantity.String()))
+	}
+
+	e.mutex.RLock()
+	guard := e.guard
+	risk := e.risk
+	e.mutex.RUnlock()
+
+	if risk != nil {
+		if err := risk.CheckOrder(update); err != nil {
+			return e.reject(update, err.Error())
+		}
+	}
+
+	releaseAt, err := guard.Admit(time.Now(), orderType, timeInForce)
+	if err != nil {
+		return e.reject(update, err.Error())
+	}
+	if !releaseAt.IsZero() {
+		return e.queue(account, update, releaseAt)
+	}
+
+	return e.submit(ctx, account, update)
+}
+
+// submit 접수된 주문을 KIS에 제출하고 체결 추적 시작
+func (e *OrderExecutor) submit(ctx context.Context, account *Account, update order.Update) (*order.Update, error) {
+	resp, err := account.Client.PlaceOrder(ctx, &PlaceOrderInput{
+		AccountNo:   account.AccountNo,
+		ProductCode: account.ProductCode,
+		Exchange:    update.Exchange,
+		Symbol:      update.Symbol,
+		Side:        OrderSide(update.Side),
+		Type:        OrderType(update.Type),
+		Quantity:    update.Quantity,
+		Price:       update.Price,
+	})
+	if err != nil {
+		return e.reject(update, err.Error())
+	}
+
+	update.BrokerOrderID = resp.Output.Odno
+	update.Status = order.StatusSubmitted
+	update.Timestamp = time.Now()
+	e.track(account, update, decimal.Zero)
+
+	e.emit(update)
+	return &update, nil
+}
+
+// queue 거래 세션 외 주문을 releaseAt(다음 개장)까지 대기 (상태는 NEW 유지)
+func (e *OrderExecutor) queue(account *Account, update order.Update, releaseAt time.Time) (*order.Update, error) {
+	e.mutex.Lock()
+	e.queued[update.ClientOrderID] = &queuedOrder{
+		account: account,
+		update:  update,
+		timer: time.AfterFunc(time.Until(releaseAt), func() {
+			e.release(update.ClientOrderID)
+		}),
+	}
+	e.mutex.Unlock()
+
+	logrus.Infof("⏳ 거래 세션 외 주문 대기: %s %s %s @ %s (제출 예정: %s)",
+		update.Side, update.Quantity.String(), update.Symbol, update.Price.String(), releaseAt.Format(time.RFC3339))
+	return &update, nil
+}
+
+// release 개장 시각이 된 대기 주문 제출
+// 대기 중 긴급 중지/거래 중지/한도 변화가 있을 수 있으므로 제출 직전에 리스크 검사를 다시 한다.
+func (e *OrderExecutor) release(clientOrderID string) {
+	e.mutex.Lock()
+	q, exists := e.queued[clientOrderID]
+	delete(e.queued, clientOrderID)
+	risk := e.risk
+	e.mutex.Unlock()
+
+	if !exists {
+		return
+	}
+
+	if risk != nil {
+		if err := risk.CheckOrder(q.update); err != nil {
+			e.reject(q.update, err.Error())
+			return
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.orderTimeout)
+	defer cancel()
+
+	if _, err := e.submit(ctx, q.account, q.update); err != nil {
+		logrus.Errorf("❌ 대기 주문 제출 실패 (%s): %v", clientOrderID, err)
+	}
+}
+
+// cancelQueued 개장 대기 주문 취소 처리
+func (e *OrderExecutor) cancelQueued(update order.Update) {
+	update.Status = order.StatusCanceled
+	update.Timestamp = time.Now()
+	e.emit(update)
+
+	logrus.Infof("🚫 대기 주문 취소: %s", update.ClientOrderID)
+}
+
+// CancelOrder 미체결 잔량 취소 요청 (결과는 체결 내역 폴링으로 반영, 개장 대기 주문은 즉시 취소)
+func (e *OrderExecutor) CancelOrder(ctx context.Context, clientOrderID string) error {
+	e.mutex.Lock()
+	if q, queued := e.queued[clientOrderID]; queued {
+		q.timer.Stop()
+		delete(e.queued, clientOrderID)
+		e.mutex.Unlock()
+
+		e.cancelQueued(q.update)
+		return nil
+	}
+	e.mutex.Unlock()
+
+	e.mutex.RLock()
+	tracked, exists := e.orders[clientOrderID]
+	var snapshot order.Update
+	if exists {
+		snapshot = tracked.update
+	}
+	e.mutex.RUnlock()
+
+	if !exists {
+		return utils.NotFound("order", "주문을 찾을 수 없습니다")
+	}
+	if snapshot.Status.IsTerminal() {
+		return utils.BadRequest(fmt.Sprintf("이미 종료된 주문입니다: %s", snapshot.Status))
+	}
+
+	if _, err := tracked.account.Client.CancelOrder(ctx, &CancelOrderInput{
+		AccountNo:       tracked.account.AccountNo,
+		ProductCode:     tracked.account.ProductCode,
+		Exchange:        snapshot.Exchange,
+		Symbol:          snapshot.Symbol,
+		OriginalOrderNo: snapshot.BrokerOrderID,
+		Quantity:        snapshot.RemainingQuantity(),
+	}); err != nil {
+		return fmt.Errorf("주문 취소 실패: %w", err)
+	}
+
+	e.mutex.Lock()
+	tracked.cancelRequested = true
+	e.mutex.Unlock()
+
+	logrus.Infof("🚫 주문 취소 요청: %s (주문번호: %s)", clientOrderID, snapshot.BrokerOrderID)
+	return nil
+}
+
+// resolveOrderPrice 시장가 주문은 기준가에 여유 폭을 더한 지정가로 변환
+func (e *OrderExecutor) resolveOrderPrice(side order.Side, orderType order.Type, price decimal.Decimal) decimal.Decimal {
+	if orderType != order.TypeMarket || !price.IsPositive() {
+		return price
+	}
+
+	if side == order.SideBuy {
+		return roundToTick(price.Mul(decimal.NewFromInt(1).Add(marketableLimitBuffer)), true)
+	}
+	return roundToTick(price.Mul(decimal.NewFromInt(1).Sub(marketableLimitBuffer)), false)
+}
+
+// roundToTick 미국 주식 호가 단위로 반올림 ($1 이상 $0.01, 미만 $0.0001)
+func roundToTick(price decimal.Decimal, up bool) decimal.Decimal {
+	places := int32(2)
+	if price.LessThan(decimal.NewFromInt(1)) {
+		places = 4
+	}
+
+	if up {
+		return price.RoundCeil(places)
+	}
+	return price.RoundFloor(places)
+}
+
+// reject 주문 거부 처리
+func (e *OrderExecutor) reject(update order.Update, reason string) (*order.Update, error) {
+	update.Status = order.StatusRejected
+	update.RejectReason = reason
+	update.Timestamp = time.Now()
+	e.emit(update)
+
+	logrus.Warnf("⚠️  주문 거부: %s %s %s (%s)", update.Side, update.Quantity.String(), update.Symbol, reason)
+	return &update, fmt.Errorf("주문 거부: %s", reason)
+}
+
+// emit 등록된 콜백에 주문 상태 전달
+func (e *OrderExecutor) emit(update order.Update) {
+	e.mutex.RLock()
+	handlers := make([]order.UpdateHandler, len(e.handlers))
+	copy(handlers, e.handlers)
+	e.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
+func (e *OrderExecutor) pollLoop() {
+	ticker := time.NewTicker(e.pollInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			e.syncOrders()
+		case <-e.stopChan:
+			return
+		}
+	}
+}
+
+// syncOrders 체결 내역을 조회해 추적 중인 주문 상태 갱신 (계좌별로 조회)
+func (e *OrderExecutor) syncOrders() {
+	e.syncMutex.Lock()
+	defer e.syncMutex.Unlock()
+
+	e.mutex.RLock()
+	if len(e.orders) == 0 {
+		e.mutex.RUnlock()
+		return
+	}
+	accounts := make(map[string]*Account)
+	earliest := make(map[string]time.Time)
+	for _, tracked := range e.orders {
+		key := tracked.account.key()
+		accounts[key] = tracked.account
+		if first, ok := earliest[key]; !ok || tracked.submittedAt.Before(first) {
+			earliest[key] = tracked.submittedAt
+		}
+	}
+	e.mutex.RUnlock()
+
+	ctx, cancel := context.WithTimeout(context.Background(), e.pollInterval*3)
+	defer cancel()
+
+	// 조회에 성공한 계좌의 주문만 갱신 (한 계좌 실패가 다른 계좌 추적에 영향을 주지 않음)
+	histories := make(map[string]map[string]KISOrderHistoryOutput, len(accounts))
+	canceled := make(map[string]map[string]bool, len(accounts))
+	for key, account := range accounts {
+		startDate, endDate := historyRange(earliest[key])
+		history, err := account.Client.GetOrderHistory(ctx, account.AccountNo, account.ProductCode, startDate, endDate)
+		if err != nil {
+			logrus.Errorf("❌ 체결 내역 조회 실패 (계좌 ****%s): %v", tail(account.AccountNo), err)
+			continue
+		}
+
+		byOrderNo := make(map[string]KISOrderHistoryOutput, len(history))
+		for _, row := range history {
+			byOrderNo[row.Odno] = row
+		}
+		histories[key] = byOrderNo
+		canceled[key] = canceledOrders(history)
+	}
+
+	var updates []order.Update
+	var expired []string
+
+	e.mutex.Lock()
+	for clientOrderID, tracked := range e.orders {
+		byOrderNo, ok := histories[tracked.account.key()]
+		if !ok {
+			continue
+		}
+		if row, ok := byOrderNo[tracked.update.BrokerOrderID]; ok {
+			if next, changed := applyOrderHistory(tracked, row, canceled[tracked.account.key()][tracked.update.BrokerOrderID]); changed {
+				tracked.update = next
+				updates = append(updates, next)
+			}
+		}
+
+		if tracked.update.Status.IsTerminal() {
+			delete(e.orders, clientOrderID)
+			continue
+		}
+
+		// IOC 주문은 타임아웃 후 잔량 취소
+		if tracked.update.TimeInForce == order.TimeInForceIOC && !tracked.cancelRequested &&
+			time.Since(tracked.submittedAt) > e.orderTimeout {
+			expired = append(expired, clientOrderID)
+		}
+	}
+	e.mutex.Unlock()
+
+	for _, update := range updates {
+		e.emit(update)
+	}
+
+	for _, clientOrderID := range expired {
+		if err := e.CancelOrder(ctx, clientOrderID); err != nil {
+			logrus.Errorf("❌ IOC 잔량 취소 실패 (%s): %v", clientOrderID, err)
+		}
+	}
+}
+
+// tail 로그 마스킹용 계좌번호 끝 4자리
+func tail(accountNo string) string {
+	if len(accountNo) <= 4 {
+		return accountNo
+	}
+	return accountNo[len(accountNo)-4:]
+}
+
+// canceledOrders 체결 내역의 취소 주문 행이 가리키는 원주문번호 (거부된 취소 요청 제외)
+func canceledOrders(history []KISOrderHistoryOutput) map[string]bool {
+	canceled := make(map[string]bool)
+	for _, row := range history {
+		if row.RvseCnclDvsn != "02" || row.OrgnOdno == "" || row.RjctRson != "" || row.RjctRsonName != "" {
+			continue
+		}
+		canceled[row.OrgnOdno] = true
+	}
+	return canceled
+}
+
+// applyOrderHistory 체결 내역 한 건을 추적 중인 주문 상태에 반영
+// 수량을 해석할 수 없는 행은 상태를 알 수 없으므로 건너뛰고,
+// 취소는 내역이 취소(주문 행 또는 원주문번호를 가리키는 취소 행)를 명시하고 미체결 잔량이 0일 때만 반영한다.
+func applyOrderHistory(tracked *trackedOrder, row KISOrderHistoryOutput, canceled bool) (order.Update, bool) {
+	prev := tracked.update
+	next := prev
+
+	filledQty, err := decimal.NewFromString(row.FtCcldQty)
+	if err != nil {
+		logrus.Warnf("⚠️ 체결 내역 체결수량 해석 실패 (주문번호: %s): %q", row.Odno, row.FtCcldQty)
+		return prev, false
+	}
+	remaining, err := decimal.NewFromString(row.NccsQty)
+	if err != nil {
+		logrus.Warnf("⚠️ 체결 내역 미체결수량 해석 실패 (주문번호: %s): %q", row.Odno, row.NccsQty)
+		return prev, false
+	}
+	filledAmount := parseDecimal(row.FtCcldAmt3)
+	canceled = canceled || row.RvseCnclDvsn == "02"
+
+	next.LastFillQuantity = decimal.Zero
+	next.LastFillPrice = decimal.Zero
+
+	if filledQty.GreaterThan(prev.FilledQuantity) {
+		delta := filledQty.Sub(prev.FilledQuantity)
+		next.LastFillQuantity = delta
+		next.LastFillPrice = filledAmount.Sub(tracked.filledAmount).Div(delta)
+		if !next.LastFillPrice.IsPositive() {
+			next.LastFillPrice = parseDecimal(row.FtCcldUnpr3)
+		}
+		next.FilledQuantity = filledQty
+		next.AvgFillPrice = filledAmount.Div(filledQty)
+		tracked.filledAmount = filledAmount
+	}
+
+	switch {
+	case row.RjctRson != "" || row.RjctRsonName != "":
+		next.Status = order.StatusRejected
+		next.RejectReason = row.RjctRsonName
+	case next.FilledQuantity.GreaterThanOrEqual(next.Quantity):
+		next.Status = order.StatusFilled
+	case canceled && remaining.IsZero():
+		next.Status = order.StatusCanceled
+	case next.FilledQuantity.IsPositive():
+		next.Status = order.StatusPartiallyFilled
+	default:
+		next.Status = order.StatusSubmitted
+	}
+
+	if next.Status == prev.Status && next.LastFillQuantity.IsZero() {
+		return prev, false
+	}
+
+	next.Timestamp = time.Now()
+	return next, true
+}
+
+// parseDecimal 문자열 숫자 파싱 (실패 시 0)
+func parseDecimal(value string) decimal.Decimal {
+	d, err := decimal.NewFromString(value)
+	if err != nil {
+		return decimal.Zero
+	}
+	return d
+}
@@ -1,6 +1,7 @@
 package kis
 
 import (
+	"context"
 	"fmt"
 	"time"
 
@@ -29,9 +30,9 @@ func (k *KISDataSource) SetAccessToken(token string) {
 }
 
 // GetBalance 잔고 조회
-func (k *KISDataSource) GetBalance(accountNo string) ([]*portfolio.Position, error) {
+func (k *KISDataSource) GetBalance(ctx context.Context, accountNo string) ([]*portfolio.Position, error) {
 	// KIS API 호출
-	balanceResp, err := k.client.GetBalance(accountNo)
+	balanceResp, err := k.client.GetBalance(ctx, accountNo, "")
 	if err != nil {
 		return nil, fmt.Errorf("KIS API 잔고 조회 실패: %w", err)
 	}
@@ -84,7 +85,7 @@ func (k *KISDataSource) GetCurrentPrices(symbols []string) ([]*portfolio.StockPr
 // GetPortfolioSummary 포트폴리오 요약 조회
 func (k *KISDataSource) GetPortfolioSummary(userID, accountNo string) (*portfolio.PortfolioSummary, error) {
 	// 잔고 조회
-	positions, err := k.GetBalance(accountNo)
+	positions, err := k.GetBalance(context.Background(), accountNo)
 	if err != nil {
 		return nil, fmt.Errorf("잔고 조회 실패: %w", err)
 	}
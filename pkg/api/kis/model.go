@@ -1,5 +1,11 @@
 package kis
 
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
 // KISBalanceResponse 한국투자증권 해외주식 잔고 조회 응답
 type KISBalanceResponse struct {
 	RtCd         string              `json:"rt_cd"`
@@ -46,6 +52,21 @@ type KISBalanceOutput2 struct {
 	FrcrBuyAmtSmtl2  string `json:"frcr_buy_amt_smtl2"`  // 외화매수금액합계2
 }
 
+// KISPresentBalanceResponse 한국투자증권 해외주식 체결기준현재잔고 응답 (통화별 예수금/환율만 사용)
+type KISPresentBalanceResponse struct {
+	RtCd    string                     `json:"rt_cd"`
+	MsgCd   string                     `json:"msg_cd"`
+	Msg1    string                     `json:"msg1"`
+	Output2 []KISPresentBalanceOutput2 `json:"output2"`
+}
+
+// KISPresentBalanceOutput2 통화별 예수금/환율 정보
+type KISPresentBalanceOutput2 struct {
+	CrcyCd       string `json:"crcy_cd"`         // 통화코드
+	FrstBltnExrt string `json:"frst_bltn_exrt"`  // 최초고시환율
+	FrcrDnclAmt2 string `json:"frcr_dncl_amt_2"` // 외화예수금액2
+}
+
 // KISPriceResponse 한국투자증권 해외주식 현재가 조회 응답
 type KISPriceResponse struct {
 	RtCd   string         `json:"rt_cd"`
@@ -98,3 +119,248 @@ type KISPriceOutput struct {
 	Tamt   string `json:"tamt"`    // 거래대금
 	EtypNm string `json:"etyp_nm"` // ETP 분류명
 }
+
+// OrderSide 주문 방향
+type OrderSide string
+
+const (
+	OrderSideBuy  OrderSide = "BUY"
+	OrderSideSell OrderSide = "SELL"
+)
+
+// OrderType 주문 유형
+type OrderType string
+
+const (
+	OrderTypeLimit OrderType = "LIMIT" // 지정가
+	// OrderTypeMarket 시장가
+	// 미국 주식은 API 시장가 주문을 지원하지 않으므로 호출자가 지정한 체결 가능 가격의 지정가 주문으로 대체된다.
+	OrderTypeMarket OrderType = "MARKET"
+	OrderTypeMOO    OrderType = "MOO" // 장개시시장가 (매도 전용)
+	OrderTypeLOO    OrderType = "LOO" // 장개시지정가
+	OrderTypeMOC    OrderType = "MOC" // 장마감시장가 (매도 전용)
+	OrderTypeLOC    OrderType = "LOC" // 장마감지정가
+)
+
+// 해외거래소코드 (주문/잔고 API용 OVRS_EXCG_CD)
+const (
+	ExchangeNASDAQ = "NASD"
+	ExchangeNYSE   = "NYSE"
+	ExchangeAMEX   = "AMEX"
+)
+
+// PlaceOrderInput 해외주식 주문 입력값
+type PlaceOrderInput struct {
+	AccountNo   string          // 종합계좌번호 (8자리)
+	ProductCode string          // 계좌상품코드 (기본값 "01")
+	Exchange    string          // 해외거래소코드 (NASD, NYSE, AMEX)
+	Symbol      string          // 종목 심볼
+	Side        OrderSide       // 매수/매도
+	Type        OrderType       // 주문 유형
+	Quantity    decimal.Decimal // 주문 수량 (정수)
+	Price       decimal.Decimal // 주문 단가 (시장가 계열은 무시)
+}
+
+// AmendOrderInput 해외주식 정정 주문 입력값
+type AmendOrderInput struct {
+	AccountNo       string
+	ProductCode     string
+	Exchange        string
+	Symbol          string
+	OriginalOrderNo string          // 원주문번호
+	Quantity        decimal.Decimal // 정정 수량
+	Price           decimal.Decimal // 정정 단가
+}
+
+// CancelOrderInput 해외주식 취소 주문 입력값
+type CancelOrderInput struct {
+	AccountNo       string
+	ProductCode     string
+	Exchange        string
+	Symbol          string
+	OriginalOrderNo string          // 원주문번호
+	Quantity        decimal.Decimal // 취소 수량 (미체결 잔량)
+}
+
+// KISOrderResponse 한국투자증권 해외주식 주문/정정/취소 응답
+type KISOrderResponse struct {
+	RtCd   string         `json:"rt_cd"`
+	MsgCd  string         `json:"msg_cd"`
+	Msg1   string         `json:"msg1"`
+	Output KISOrderOutput `json:"output"`
+}
+
+// KISOrderOutput 주문 결과 정보
+type KISOrderOutput struct {
+	KrxFwdgOrdOrgno string `json:"KRX_FWDG_ORD_ORGNO"` // 한국거래소전송주문조직번호
+	Odno            string `json:"ODNO"`               // 주문번호
+	OrdTmd          string `json:"ORD_TMD"`            // 주문시각
+}
+
+// KISHashkeyResponse Hashkey 발급 응답
+type KISHashkeyResponse struct {
+	Hash string `json:"HASH"`
+}
+
+// KISOrderHistoryResponse 한국투자증권 해외주식 주문체결내역 조회 응답
+type KISOrderHistoryResponse struct {
+	RtCd         string                  `json:"rt_cd"`
+	MsgCd        string                  `json:"msg_cd"`
+	Msg1         string                  `json:"msg1"`
+	CtxAreaFk200 string                  `json:"ctx_area_fk200"`
+	CtxAreaNk200 string                  `json:"ctx_area_nk200"`
+	Output       []KISOrderHistoryOutput `json:"output"`
+}
+
+// KISOrderHistoryOutput 개별 주문체결 정보
+type KISOrderHistoryOutput struct {
+	OrdDt            string `json:"ord_dt"`              // 주문일자
+	OrdGnoBrno       string `json:"ord_gno_brno"`        // 주문채번지점번호
+	Odno             string `json:"odno"`                // 주문번호
+	OrgnOdno         string `json:"orgn_odno"`           // 원주문번호
+	SllBuyDvsnCd     string `json:"sll_buy_dvsn_cd"`     // 매도매수구분코드 (01: 매도, 02: 매수)
+	RvseCnclDvsn     string `json:"rvse_cncl_dvsn"`      // 정정취소구분 (01: 정정, 02: 취소)
+	RvseCnclDvsnName string `json:"rvse_cncl_dvsn_name"` // 정정취소구분명
+	Pdno             string `json:"pdno"`                // 상품번호
+	PrdtName         string `json:"prdt_name"`           // 상품명
+	FtOrdQty         string `json:"ft_ord_qty"`          // FT주문수량
+	FtOrdUnpr3       string `json:"ft_ord_unpr3"`        // FT주문단가3
+	FtCcldQty        string `json:"ft_ccld_qty"`         // FT체결수량
+	FtCcldUnpr3      string `json:"ft_ccld_unpr3"`       // FT체결단가3
+	FtCcldAmt3       string `json:"ft_ccld_amt3"`        // FT체결금액3
+	NccsQty          string `json:"nccs_qty"`            // 미체결수량
+	PrcsStatName     string `json:"prcs_stat_name"`      // 처리상태명 (완료, 거부, 전송 등)
+	RjctRson         string `json:"rjct_rson"`           // 거부사유
+	RjctRsonName     string `json:"rjct_rson_name"`      // 거부사유명
+	OrdTmd           string `json:"ord_tmd"`             // 주문시각
+	OvrsExcgCd       string `json:"ovrs_excg_cd"`        // 해외거래소코드
+	TrCrcyCd         string `json:"tr_crcy_cd"`          // 거래통화코드
+}
+
+// KISDailyChartResponse 해외주식 기간별시세 응답
+type KISDailyChartResponse struct {
+	RtCd    string `json:"rt_cd"`
+	MsgCd   string `json:"msg_cd"`
+	Msg1    string `json:"msg1"`
+	Output1 struct {
+		Rsym string `json:"rsym"` // 실시간조회종목코드
+		Zdiv string `json:"zdiv"` // 소수점자리수
+		Nrec string `json:"nrec"` // 전일종가
+	} `json:"output1"`
+	Output2 []KISDailyChartOutput `json:"output2"`
+}
+
+// KISDailyChartOutput 일봉 (최근 날짜부터 역순)
+type KISDailyChartOutput struct {
+	Xymd string `json:"xymd"` // 일자 (YYYYMMDD, 현지)
+	Clos string `json:"clos"` // 종가
+	Sign string `json:"sign"` // 대비기호
+	Diff string `json:"diff"` // 대비
+	Rate string `json:"rate"` // 등락율
+	Open string `json:"open"` // 시가
+	High string `json:"high"` // 고가
+	Low  string `json:"low"`  // 저가
+	Tvol string `json:"tvol"` // 거래량
+	Tamt string `json:"tamt"` // 거래대금
+}
+
+// KISMinuteChartResponse 해외주식 분봉 응답
+type KISMinuteChartResponse struct {
+	RtCd    string `json:"rt_cd"`
+	MsgCd   string `json:"msg_cd"`
+	Msg1    string `json:"msg1"`
+	Output1 struct {
+		Rsym string `json:"rsym"` // 실시간조회종목코드
+		Zdiv string `json:"zdiv"` // 소수점자리수
+		Next string `json:"next"` // 다음가능여부 (1: 가능)
+		More string `json:"more"` // 추가데이타여부 (1: 있음)
+		Nrec string `json:"nrec"` // 레코드갯수
+	} `json:"output1"`
+	Output2 []KISMinuteChartOutput `json:"output2"`
+}
+
+// KISMinuteChartOutput 분봉 (최근 시각부터 역순)
+type KISMinuteChartOutput struct {
+	Tymd string `json:"tymd"` // 현지영업일자
+	Xymd string `json:"xymd"` // 현지기준일자 (YYYYMMDD)
+	Xhms string `json:"xhms"` // 현지기준시간 (HHMMSS)
+	Kymd string `json:"kymd"` // 한국기준일자
+	Khms string `json:"khms"` // 한국기준시간
+	Open string `json:"open"` // 시가
+	High string `json:"high"` // 고가
+	Low  string `json:"low"`  // 저가
+	Last string `json:"last"` // 종가
+	Evol string `json:"evol"` // 체결량
+	Eamt string `json:"eamt"` // 체결대금
+}
+
+// KISTokenResponse 접근토큰 발급 응답
+type KISTokenResponse struct {
+	AccessToken        string `json:"access_token"`
+	TokenType          string `json:"token_type"`
+	ExpiresIn          int64  `json:"expires_in"`                 // 유효기간 (초)
+	AccessTokenExpired string `json:"access_token_token_expired"` // 만료일시 (YYYY-MM-DD HH:MM:SS, KST)
+	ErrorCode          string `json:"error_code"`
+	ErrorDescription   string `json:"error_description"`
+}
+
+// KISApprovalResponse 웹소켓 접속키 발급 응답
+type KISApprovalResponse struct {
+	ApprovalKey      string `json:"approval_key"`
+	ErrorCode        string `json:"error_code"`
+	ErrorDescription string `json:"error_description"`
+}
+
+// KISErrorResponse 공통 오류 응답 (토큰 만료 판별용)
+type KISErrorResponse struct {
+	RtCd  string `json:"rt_cd"`
+	MsgCd string `json:"msg_cd"`
+	Msg1  string `json:"msg1"`
+}
+
+// KISRealtimeResponse 웹소켓 제어 메시지 (등록 응답, PINGPONG)
+type KISRealtimeResponse struct {
+	Header struct {
+		TrID    string `json:"tr_id"`
+		TrKey   string `json:"tr_key"`
+		Encrypt string `json:"encrypt"`
+	} `json:"header"`
+	Body struct {
+		RtCd   string `json:"rt_cd"`
+		MsgCd  string `json:"msg_cd"`
+		Msg1   string `json:"msg1"`
+		Output struct {
+			IV  string `json:"iv"`
+			Key string `json:"key"`
+		} `json:"output"`
+	} `json:"body"`
+}
+
+// Quote 실시간 체결가
+type Quote struct {
+	Symbol     string
+	Exchange   string // 시세 거래소코드 (NAS, NYS, AMS)
+	Price      decimal.Decimal
+	Open       decimal.Decimal
+	High       decimal.Decimal
+	Low        decimal.Decimal
+	ChangeRate decimal.Decimal // 전일 대비 등락률 (%)
+	Volume     decimal.Decimal // 체결량
+	TotalVol   decimal.Decimal // 누적 거래량
+	Timestamp  time.Time
+}
+
+// FillNotice 실시간 체결통보
+type FillNotice struct {
+	AccountNo       string
+	OrderNo         string
+	OriginalOrderNo string
+	Symbol          string
+	Side            OrderSide
+	OrderQuantity   decimal.Decimal
+	FillQuantity    decimal.Decimal
+	FillPrice       decimal.Decimal
+	Filled          bool // true: 체결, false: 접수/정정/취소/거부
+	Rejected        bool
+	Timestamp       time.Time
+}
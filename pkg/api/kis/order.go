@@ -0,0 +1,328 @@
+package kis
+
+import (
+	"auto-trader/pkg/api/kis/dto"
+	"auto-trader/pkg/shared/utils"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// PlaceOrder 해외주식 매수/매도 주문
+func (c *Client) PlaceOrder(ctx context.Context, input *PlaceOrderInput) (*KISOrderResponse, error) {
+	// API 엔드포인트
+	url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/order", c.BaseURL)
+
+	orderDivision, err := c.resolveOrderDivision(input.Side, input.Type)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := validateOrderQuantity(input.Quantity); err != nil {
+		return nil, err
+	}
+
+	// 시장가 계열 주문은 단가 0으로 전송
+	price := input.Price
+	if orderDivision == dto.OrderDivisionMOO || orderDivision == dto.OrderDivisionMOC {
+		price = decimal.Zero
+	} else if !price.IsPositive() {
+		return nil, utils.BadRequest("지정가 주문은 0보다 큰 단가가 필요합니다")
+	}
+
+	// 요청 바디
+	requestBody := dto.NewOrderRequest(
+		input.AccountNo,
+		defaultProductCode(input.ProductCode),
+		c.orderExchange(input.Symbol, input.Exchange),
+		input.Symbol,
+		input.Quantity.String(),
+		price.String(),
+		orderDivision,
+		input.Side == OrderSideSell,
+	)
+
+	// DTO 검증
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	orderResp, err := c.postOrder(ctx, url, requestBody, func(hashkey string) *dto.KISHeaders {
+		return dto.NewOrderHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), hashkey, input.Side == OrderSideBuy, c.IsDemo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("PlaceOrder 완료: %s %s %s @ %s (주문번호: %s)",
+		input.Side, input.Quantity.String(), input.Symbol, price.String(), orderResp.Output.Odno)
+	return orderResp, nil
+}
+
+// AmendOrder 해외주식 정정 주문
+func (c *Client) AmendOrder(ctx context.Context, input *AmendOrderInput) (*KISOrderResponse, error) {
+	if err := validateOrderQuantity(input.Quantity); err != nil {
+		return nil, err
+	}
+	if !input.Price.IsPositive() {
+		return nil, utils.BadRequest("정정 주문은 0보다 큰 단가가 필요합니다")
+	}
+
+	requestBody := dto.NewRevisionRequest(
+		input.AccountNo,
+		defaultProductCode(input.ProductCode),
+		c.orderExchange(input.Symbol, input.Exchange),
+		input.Symbol,
+		input.OriginalOrderNo,
+		dto.RevisionCodeAmend,
+		input.Quantity.String(),
+		input.Price.String(),
+	)
+
+	return c.revise(ctx, requestBody)
+}
+
+// CancelOrder 해외주식 취소 주문
+func (c *Client) CancelOrder(ctx context.Context, input *CancelOrderInput) (*KISOrderResponse, error) {
+	if err := validateOrderQuantity(input.Quantity); err != nil {
+		return nil, err
+	}
+
+	requestBody := dto.NewRevisionRequest(
+		input.AccountNo,
+		defaultProductCode(input.ProductCode),
+		c.orderExchange(input.Symbol, input.Exchange),
+		input.Symbol,
+		input.OriginalOrderNo,
+		dto.RevisionCodeCancel,
+		input.Quantity.String(),
+		"0", // 취소 시 단가는 0
+	)
+
+	return c.revise(ctx, requestBody)
+}
+
+// revise 정정/취소 공통 처리
+func (c *Client) revise(ctx context.Context, requestBody *dto.RevisionRequest) (*KISOrderResponse, error) {
+	url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/order-rvsecncl", c.BaseURL)
+
+	// DTO 검증
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	orderResp, err := c.postOrder(ctx, url, requestBody, func(hashkey string) *dto.KISHeaders {
+		return dto.NewRevisionHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), hashkey, c.IsDemo)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("ReviseOrder 완료: 원주문 %s (구분: %s, 주문번호: %s)",
+		requestBody.ORGN_ODNO, requestBody.RVSE_CNCL_DVSN_CD, orderResp.Output.Odno)
+	return orderResp, nil
+}
+
+// GetOrderHistory 해외주식 주문체결내역 조회 (연속 조회 키를 따라 전체 페이지 조회)
+func (c *Client) GetOrderHistory(ctx context.Context, accountNo, productCode, startDate, endDate string) ([]KISOrderHistoryOutput, error) {
+	requestBody := dto.NewOrderHistoryRequest(accountNo, defaultProductCode(productCode), startDate, endDate)
+
+	// DTO 검증
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	var result []KISOrderHistoryOutput
+	trCont := ""
+	for page := 0; page < maxContinuationPages; page++ {
+		url := fmt.Sprintf("%s/uapi/overseas-stock/v1/trading/inquire-ccnl?%s", c.BaseURL, requestBody.ToQuery())
+
+		body, respHeader, err := c.execute(ctx, func() (*http.Request, error) {
+			req, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+			if err != nil {
+				return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+			}
+
+			headers := dto.NewOrderHistoryHeaders(c.AppKey, c.AppSecret, c.currentAccessToken(), trCont, c.IsDemo)
+			if err := headers.Validate(); err != nil {
+				return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+			}
+			headers.ApplyToRequest(req)
+			return req, nil
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		var historyResp KISOrderHistoryResponse
+		if err := json.Unmarshal(body, &historyResp); err != nil {
+			return nil, fmt.Errorf("응답 파싱 실패: %w", err)
+		}
+		if historyResp.RtCd != "0" {
+			return nil, fmt.Errorf("API 오류: %s - %s", historyResp.MsgCd, historyResp.Msg1)
+		}
+
+		result = append(result, historyResp.Output...)
+
+		// 응답 헤더 tr_cont가 M/F면 다음 페이지 존재
+		if !hasNextPage(respHeader.Get("tr_cont")) {
+			break
+		}
+		trCont = "N"
+		requestBody.CTX_AREA_FK200 = historyResp.CtxAreaFk200
+		requestBody.CTX_AREA_NK200 = historyResp.CtxAreaNk200
+	}
+
+	return result, nil
+}
+
+// postOrder 주문 계열 POST 요청 공통 처리 (hashkey 발급 → 헤더 적용 → 응답 파싱)
+func (c *Client) postOrder(ctx context.Context, url string, requestBody interface{}, newHeaders func(hashkey string) *dto.KISHeaders) (*KISOrderResponse, error) {
+	jsonBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return nil, fmt.Errorf("요청 바디 마샬링 실패: %w", err)
+	}
+
+	// 주문 API는 서버에서 발급한 hashkey를 요구함
+	hashkey, err := c.requestHashkey(ctx, jsonBody)
+	if err != nil {
+		return nil, fmt.Errorf("hashkey 발급 실패: %w", err)
+	}
+
+	// 요청 실행 (접근토큰 자동 발급/갱신)
+	body, _, err := c.execute(ctx, func() (*http.Request, error) {
+		// HTTP 요청 생성
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+		if err != nil {
+			return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		}
+
+		// 공통 헤더 설정
+		headers := newHeaders(hashkey)
+
+		// 헤더 검증
+		if err := headers.Validate(); err != nil {
+			return nil, utils.WrapValidationError(err, "헤더 검증 실패")
+		}
+
+		headers.ApplyToRequest(req)
+		return req, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 응답 파싱
+	var orderResp KISOrderResponse
+	if err := json.Unmarshal(body, &orderResp); err != nil {
+		return nil, fmt.Errorf("응답 파싱 실패: %w", err)
+	}
+
+	// 에러 체크
+	if orderResp.RtCd != "0" {
+		return nil, fmt.Errorf("API 오류: %s - %s", orderResp.MsgCd, orderResp.Msg1)
+	}
+
+	return &orderResp, nil
+}
+
+// requestHashkey /uapi/hashkey 엔드포인트에서 요청 바디의 hashkey 발급
+func (c *Client) requestHashkey(ctx context.Context, jsonBody []byte) (string, error) {
+	url := fmt.Sprintf("%s/uapi/hashkey", c.BaseURL)
+
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+	if err != nil {
+		return "", fmt.Errorf("http 요청 생성 실패: %w", err)
+	}
+
+	headers := dto.NewHashkeyHeaders(c.AppKey, c.AppSecret)
+	if err := headers.Validate(); err != nil {
+		return "", utils.WrapValidationError(err, "헤더 검증 실패")
+	}
+	headers.ApplyToRequest(req)
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("API 요청 실패: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return "", fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+
+	var hashResp KISHashkeyResponse
+	if err := json.Unmarshal(body, &hashResp); err != nil {
+		return "", fmt.Errorf("응답 파싱 실패: %w", err)
+	}
+	if hashResp.Hash == "" {
+		return "", fmt.Errorf("hashkey 응답이 비어 있습니다 (status: %d)", resp.StatusCode)
+	}
+
+	return hashResp.Hash, nil
+}
+
+// maxContinuationPages 연속 조회 최대 페이지 수 (무한 루프 방지)
+const maxContinuationPages = 50
+
+// hasNextPage 응답 헤더 tr_cont 값으로 다음 페이지 존재 여부 판단
+func hasNextPage(trCont string) bool {
+	return trCont == "M" || trCont == "F"
+}
+
+// resolveOrderDivision 주문 유형을 KIS 주문구분 코드로 변환
+// 모의투자는 지정가만 지원하며, 장개시/장마감 시장가는 매도 주문에서만 사용할 수 있다.
+func (c *Client) resolveOrderDivision(side OrderSide, orderType OrderType) (string, error) {
+	if side != OrderSideBuy && side != OrderSideSell {
+		return "", utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 방향: %s", side))
+	}
+
+	var division string
+	switch orderType {
+	case OrderTypeLimit, OrderTypeMarket, "":
+		division = dto.OrderDivisionLimit
+	case OrderTypeMOO:
+		division = dto.OrderDivisionMOO
+	case OrderTypeLOO:
+		division = dto.OrderDivisionLOO
+	case OrderTypeMOC:
+		division = dto.OrderDivisionMOC
+	case OrderTypeLOC:
+		division = dto.OrderDivisionLOC
+	default:
+		return "", utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 유형: %s", orderType))
+	}
+
+	if (division == dto.OrderDivisionMOO || division == dto.OrderDivisionMOC) && side != OrderSideSell {
+		return "", utils.BadRequest(fmt.Sprintf("%s 주문은 매도만 가능합니다", orderType))
+	}
+
+	if c.IsDemo && division != dto.OrderDivisionLimit {
+		return "", utils.BadRequest(fmt.Sprintf("모의투자에서는 %s 주문을 지원하지 않습니다", orderType))
+	}
+
+	return division, nil
+}
+
+// validateOrderQuantity 주문 수량 검증 (1주 이상 정수)
+func validateOrderQuantity(quantity decimal.Decimal) error {
+	if !quantity.IsPositive() || !quantity.IsInteger() {
+		return utils.BadRequest(fmt.Sprintf("주문 수량은 1 이상의 정수여야 합니다: %s", quantity.String()))
+	}
+	return nil
+}
+
+// defaultProductCode 계좌상품코드 기본값 적용
+func defaultProductCode(productCode string) string {
+	if productCode == "" {
+		return "01"
+	}
+	return productCode
+}
@@ -0,0 +1,69 @@
+package kis
+
+import (
+	"fmt"
+
+	"auto-trader/pkg/domain/portfolio"
+)
+
+// PriceSource 실시간 수집기를 portfolio.PriceSource 인터페이스에 맞게 변환
+type PriceSource struct {
+	stream *StreamCollector
+}
+
+// NewPriceSource 새로운 포트폴리오 시세 공급자 생성
+func NewPriceSource(stream *StreamCollector) *PriceSource {
+	return &PriceSource{stream: stream}
+}
+
+// GetLatestPrice 최신 실시간 시세 조회 (없으면 REST 현재가 조회)
+func (p *PriceSource) GetLatestPrice(symbol string) (*portfolio.StockPrice, error) {
+	if quote, ok := p.stream.LatestQuote(symbol); ok {
+		price := quoteToStockPrice(quote)
+		return &price, nil
+	}
+
+	priceResp, err := p.stream.client.GetCurrentPrice(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("KIS API 현재가 조회 실패: %w", err)
+	}
+
+	price, err := NewAdapter().ConvertPriceToStockPrice(priceResp.Output)
+	if err != nil {
+		return nil, err
+	}
+	// 실시간 조회 심볼(Rsym)은 거래소 접두어가 붙어 있어 요청 심볼로 통일
+	price.Symbol = symbol
+	return price, nil
+}
+
+// SubscribePrices 실시간 시세 구독 (반환된 함수로 구독 해제)
+func (p *PriceSource) SubscribePrices(symbols []string) (<-chan portfolio.StockPrice, func()) {
+	quotes, unsubscribe := p.stream.SubscribeQuotes(symbols)
+
+	prices := make(chan portfolio.StockPrice, subscriberBuffer)
+	go func() {
+		defer close(prices)
+		for quote := range quotes {
+			select {
+			case prices <- quoteToStockPrice(quote):
+			default:
+			}
+		}
+	}()
+
+	return prices, unsubscribe
+}
+
+func quoteToStockPrice(quote Quote) portfolio.StockPrice {
+	return portfolio.StockPrice{
+		Symbol:     quote.Symbol,
+		Price:      quote.Price,
+		ChangeRate: quote.ChangeRate,
+		Volume:     quote.TotalVol.IntPart(),
+		High:       quote.High,
+		Low:        quote.Low,
+		Open:       quote.Open,
+		Timestamp:  quote.Timestamp,
+	}
+}
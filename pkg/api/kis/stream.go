@@ -0,0 +1,678 @@
+package kis
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/api/kis/dto"
+	"auto-trader/pkg/domain/strategy"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+	"golang.org/x/net/websocket"
+)
+
+const (
+	// 웹소켓 접속 주소
+	websocketURLReal = "ws://ops.koreainvestment.com:21000"
+	websocketURLDemo = "ws://ops.koreainvestment.com:31000"
+
+	// 재접속 대기 시간 (지수 증가)
+	reconnectMinDelay = 1 * time.Second
+	reconnectMaxDelay = 1 * time.Minute
+
+	// quoteStaleAfter 이 시간보다 오래된 실시간 시세는 REST로 재조회
+	quoteStaleAfter = 1 * time.Minute
+
+	// subscriberBuffer 구독자 채널 버퍼 (느린 구독자는 최신 시세만 놓침)
+	subscriberBuffer = 256
+
+	// 실시간 레코드 필드 수
+	quoteFieldCount  = 26
+	noticeFieldCount = 25
+)
+
+type quoteSubscriber struct {
+	ch      chan Quote
+	symbols map[string]bool // 비어 있으면 전체 종목
+}
+
+// StreamCollector KIS 웹소켓 기반 실시간 시세/체결통보 수집기
+type StreamCollector struct {
+	client *Client
+	rest   *PriceCollector
+	url    string
+	htsID  string // 체결통보 구독용 HTS ID (비어 있으면 체결통보 미구독)
+
+	// resolveExchange 종목의 시세 거래소코드 (NAS, NYS, AMS) 조회
+	resolveExchange func(symbol string) string
+
+	symbols       map[string]int // 종목별 구독 참조 수
+	streamSymbols []string       // StartPriceStream으로 구독한 전략 종목
+	quotes        map[string]Quote
+	quoteSubs     map[int]*quoteSubscriber
+	fillSubs      map[int]chan FillNotice
+	nextSubID     int
+	conn          *websocket.Conn
+	noticeKey     []byte
+	noticeIV      []byte
+	mutex         sync.RWMutex
+	writeMutex    sync.Mutex
+	stopChan      chan struct{}
+	isRunning     bool
+}
+
+// NewStreamCollector 새로운 실시간 수집기 생성
+func NewStreamCollector(client *Client, websocketURL, htsID string) *StreamCollector {
+	if websocketURL == "" {
+		websocketURL = websocketURLReal
+		if client.IsDemo {
+			websocketURL = websocketURLDemo
+		}
+	}
+
+	return &StreamCollector{
+		client:          client,
+		rest:            NewPriceCollector(client),
+		url:             websocketURL,
+		htsID:           htsID,
+		resolveExchange: client.quoteExchange,
+		symbols:         make(map[string]int),
+		quotes:          make(map[string]Quote),
+		quoteSubs:       make(map[int]*quoteSubscriber),
+		fillSubs:        make(map[int]chan FillNotice),
+		stopChan:        make(chan struct{}),
+	}
+}
+
+// SetExchangeResolver 종목별 시세 거래소코드 조회 함수 설정
+func (s *StreamCollector) SetExchangeResolver(resolve func(symbol string) string) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.resolveExchange = resolve
+}
+
+// Connect 웹소켓 접속 및 자동 재접속 루프 시작
+func (s *StreamCollector) Connect() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.isRunning {
+		return
+	}
+
+	s.stopChan = make(chan struct{})
+	s.isRunning = true
+	go s.run(s.stopChan)
+
+	logrus.Info("🚀 KIS 실시간 수집기 시작됨")
+}
+
+// Close 웹소켓 접속 종료 (모든 구독자 공용이므로 애플리케이션 종료 시에만 호출)
+func (s *StreamCollector) Close() {
+	s.mutex.Lock()
+	if !s.isRunning {
+		s.mutex.Unlock()
+		return
+	}
+	close(s.stopChan)
+	s.isRunning = false
+	conn := s.conn
+	s.conn = nil
+	s.mutex.Unlock()
+
+	if conn != nil {
+		_ = conn.Close()
+	}
+
+	logrus.Info("⏹️  KIS 실시간 수집기 중지됨")
+}
+
+// StartPriceStream 전략용 종목 시세 구독 후 수집 시작 (strategy.Collector 구현)
+func (s *StreamCollector) StartPriceStream(symbols []string) {
+	s.mutex.Lock()
+	previous := s.streamSymbols
+	s.streamSymbols = symbols
+	s.mutex.Unlock()
+
+	for _, symbol := range symbols {
+		s.Subscribe(symbol)
+	}
+	for _, symbol := range previous {
+		s.Unsubscribe(symbol)
+	}
+	s.Connect()
+}
+
+// Stop 전략용 종목 구독 해제 (strategy.Collector 구현)
+// 연결은 포트폴리오/주문 실행기와 공유하므로 유지한다.
+func (s *StreamCollector) Stop() {
+	s.mutex.Lock()
+	symbols := s.streamSymbols
+	s.streamSymbols = nil
+	s.mutex.Unlock()
+
+	for _, symbol := range symbols {
+		s.Unsubscribe(symbol)
+	}
+}
+
+// GetCurrentPrice 최신 실시간 시세 조회 (없거나 오래되면 REST 조회)
+func (s *StreamCollector) GetCurrentPrice(symbol string) (*strategy.PriceData, error) {
+	if quote, ok := s.LatestQuote(symbol); ok {
+		return &strategy.PriceData{
+			Price:     quote.Price,
+			Volume:    quote.Volume,
+			Timestamp: quote.Timestamp,
+		}, nil
+	}
+	return s.rest.GetCurrentPrice(symbol)
+}
+
+// GetDailyProfit 전일 대비 등락률(%) 조회
+func (s *StreamCollector) GetDailyProfit(symbol string) (decimal.Decimal, error) {
+	if quote, ok := s.LatestQuote(symbol); ok {
+		return quote.ChangeRate, nil
+	}
+	return s.rest.GetDailyProfit(symbol)
+}
+
+// LatestQuote 최근 실시간 시세 조회
+func (s *StreamCollector) LatestQuote(symbol string) (Quote, bool) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	quote, ok := s.quotes[symbol]
+	if !ok || time.Since(quote.Timestamp) > quoteStaleAfter {
+		return Quote{}, false
+	}
+	return quote, true
+}
+
+// Subscribe 종목 실시간 시세 구독 (참조 수 관리)
+func (s *StreamCollector) Subscribe(symbol string) {
+	s.mutex.Lock()
+	s.symbols[symbol]++
+	first := s.symbols[symbol] == 1
+	s.mutex.Unlock()
+
+	if first {
+		if err := s.sendPriceRequest(dto.RealtimeRegister, symbol); err != nil {
+			logrus.Warnf("⚠️  실시간 시세 등록 보류 (%s): %v", symbol, err)
+		}
+	}
+}
+
+// Unsubscribe 종목 실시간 시세 구독 해제 (마지막 구독자일 때 해제 요청)
+func (s *StreamCollector) Unsubscribe(symbol string) {
+	s.mutex.Lock()
+	count, ok := s.symbols[symbol]
+	if !ok {
+		s.mutex.Unlock()
+		return
+	}
+	last := count <= 1
+	if last {
+		delete(s.symbols, symbol)
+		delete(s.quotes, symbol)
+	} else {
+		s.symbols[symbol] = count - 1
+	}
+	s.mutex.Unlock()
+
+	if last {
+		if err := s.sendPriceRequest(dto.RealtimeUnregister, symbol); err != nil {
+			logrus.Warnf("⚠️  실시간 시세 해제 실패 (%s): %v", symbol, err)
+		}
+	}
+}
+
+// SubscribeQuotes 실시간 시세 수신 채널 등록 (symbols가 비어 있으면 전체 종목 수신)
+// 반환된 함수를 호출하면 구독이 해제되고 채널이 닫힌다.
+func (s *StreamCollector) SubscribeQuotes(symbols []string) (<-chan Quote, func()) {
+	sub := &quoteSubscriber{
+		ch:      make(chan Quote, subscriberBuffer),
+		symbols: make(map[string]bool, len(symbols)),
+	}
+	for _, symbol := range symbols {
+		sub.symbols[symbol] = true
+		s.Subscribe(symbol)
+	}
+
+	s.mutex.Lock()
+	id := s.nextSubID
+	s.nextSubID++
+	s.quoteSubs[id] = sub
+	s.mutex.Unlock()
+
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			delete(s.quoteSubs, id)
+			close(sub.ch)
+			s.mutex.Unlock()
+
+			for _, symbol := range symbols {
+				s.Unsubscribe(symbol)
+			}
+		})
+	}
+}
+
+// SubscribeTicks 종목 체결 수신 알림 구독 (strategy.TickSource 구현, 밀린 알림은 버림)
+func (s *StreamCollector) SubscribeTicks(symbols []string) (<-chan string, func()) {
+	quotes, unsubscribe := s.SubscribeQuotes(symbols)
+
+	ticks := make(chan string, 1)
+	go func() {
+		defer close(ticks)
+		for quote := range quotes {
+			select {
+			case ticks <- quote.Symbol:
+			default:
+			}
+		}
+	}()
+
+	return ticks, unsubscribe
+}
+
+// SubscribeFills 실시간 체결통보 수신 채널 등록
+func (s *StreamCollector) SubscribeFills() (<-chan FillNotice, func()) {
+	ch := make(chan FillNotice, subscriberBuffer)
+
+	s.mutex.Lock()
+	id := s.nextSubID
+	s.nextSubID++
+	s.fillSubs[id] = ch
+	s.mutex.Unlock()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			s.mutex.Lock()
+			delete(s.fillSubs, id)
+			close(ch)
+			s.mutex.Unlock()
+		})
+	}
+}
+
+// run 접속이 끊기면 지수 백오프로 재접속
+func (s *StreamCollector) run(stopChan chan struct{}) {
+	delay := reconnectMinDelay
+
+	for {
+		connectedAt := time.Now()
+		if err := s.connectAndServe(stopChan); err != nil {
+			logrus.Errorf("❌ KIS 웹소켓 연결 오류: %v", err)
+		}
+
+		select {
+		case <-stopChan:
+			return
+		default:
+		}
+
+		// 일정 시간 이상 유지된 연결이었다면 대기 시간 초기화
+		if time.Since(connectedAt) > reconnectMaxDelay {
+			delay = reconnectMinDelay
+		}
+
+		logrus.Warnf("🔄 KIS 웹소켓 재접속 대기: %s", delay)
+		select {
+		case <-time.After(delay):
+		case <-stopChan:
+			return
+		}
+
+		delay *= 2
+		if delay > reconnectMaxDelay {
+			delay = reconnectMaxDelay
+		}
+	}
+}
+
+// connectAndServe 접속 → 기존 구독 재등록 → 메시지 수신
+func (s *StreamCollector) connectAndServe(stopChan chan struct{}) error {
+	ctx, cancel := context.WithTimeout(context.Background(), s.client.HTTPClient.Timeout)
+	defer cancel()
+
+	approvalKey, err := s.client.GetApprovalKey(ctx)
+	if err != nil {
+		return err
+	}
+
+	config, err := websocket.NewConfig(s.url, "http://localhost")
+	if err != nil {
+		return fmt.Errorf("웹소켓 설정 실패: %w", err)
+	}
+	conn, err := config.DialContext(ctx)
+	if err != nil {
+		return fmt.Errorf("웹소켓 접속 실패: %w", err)
+	}
+
+	s.mutex.Lock()
+	select {
+	case <-stopChan:
+		s.mutex.Unlock()
+		_ = conn.Close()
+		return nil
+	default:
+	}
+	s.conn = conn
+	symbols := make([]string, 0, len(s.symbols))
+	for symbol := range s.symbols {
+		symbols = append(symbols, symbol)
+	}
+	s.mutex.Unlock()
+
+	defer func() {
+		s.mutex.Lock()
+		if s.conn == conn {
+			s.conn = nil
+		}
+		s.mutex.Unlock()
+		_ = conn.Close()
+	}()
+
+	logrus.Infof("🔌 KIS 웹소켓 연결됨 (구독 종목 %d개)", len(symbols))
+
+	// 재접속 시 기존 구독 복구
+	for _, symbol := range symbols {
+		if err := s.sendPriceRequest(dto.RealtimeRegister, symbol); err != nil {
+			return err
+		}
+	}
+	if s.htsID != "" {
+		if err := s.send(conn, dto.NewRealtimeRequest(approvalKey, dto.RealtimeRegister, s.noticeTrID(), s.htsID)); err != nil {
+			return err
+		}
+	}
+
+	for {
+		var message string
+		if err := websocket.Message.Receive(conn, &message); err != nil {
+			select {
+			case <-stopChan:
+				return nil
+			default:
+				return fmt.Errorf("메시지 수신 실패: %w", err)
+			}
+		}
+		s.handleMessage(conn, message)
+	}
+}
+
+// sendPriceRequest 현재 연결에 시세 등록/해제 요청 (미연결 시 재접속 때 등록됨)
+func (s *StreamCollector) sendPriceRequest(trType, symbol string) error {
+	s.mutex.RLock()
+	conn := s.conn
+	resolve := s.resolveExchange
+	s.mutex.RUnlock()
+
+	if conn == nil {
+		return nil
+	}
+
+	approvalKey, err := s.client.GetApprovalKey(context.Background())
+	if err != nil {
+		return err
+	}
+
+	// 실시간 키: D + 거래소코드 + 종목 (예: DNASAAPL)
+	trKey := "D" + resolve(symbol) + symbol
+	return s.send(conn, dto.NewRealtimeRequest(approvalKey, trType, dto.TrIDRealtimeOverseasPrice, trKey))
+}
+
+func (s *StreamCollector) send(conn *websocket.Conn, request *dto.RealtimeRequest) error {
+	if err := request.Validate(); err != nil {
+		return fmt.Errorf("실시간 요청 검증 실패: %w", err)
+	}
+
+	s.writeMutex.Lock()
+	defer s.writeMutex.Unlock()
+
+	if err := websocket.JSON.Send(conn, request); err != nil {
+		return fmt.Errorf("실시간 요청 전송 실패: %w", err)
+	}
+	return nil
+}
+
+func (s *StreamCollector) noticeTrID() string {
+	if s.client.IsDemo {
+		return dto.TrIDRealtimeOverseasNoticeDemo
+	}
+	return dto.TrIDRealtimeOverseasNoticeReal
+}
+
+// handleMessage 수신 메시지 처리
+// 실시간 데이터: "암호화여부|TR_ID|건수|필드^필드^..." / 제어 메시지: JSON
+func (s *StreamCollector) handleMessage(conn *websocket.Conn, message string) {
+	if len(message) == 0 {
+		return
+	}
+
+	if message[0] == '0' || message[0] == '1' {
+		parts := strings.SplitN(message, "|", 4)
+		if len(parts) != 4 {
+			logrus.Warnf("⚠️  알 수 없는 실시간 메시지 형식: %.50s", message)
+			return
+		}
+
+		payload := parts[3]
+		if parts[0] == "1" {
+			decrypted, err := s.decrypt(payload)
+			if err != nil {
+				logrus.Errorf("❌ 실시간 메시지 복호화 실패 (%s): %v", parts[1], err)
+				return
+			}
+			payload = decrypted
+		}
+
+		count, _ := strconv.Atoi(parts[2])
+		switch parts[1] {
+		case dto.TrIDRealtimeOverseasPrice:
+			s.handleQuotes(payload, count)
+		case dto.TrIDRealtimeOverseasNoticeReal, dto.TrIDRealtimeOverseasNoticeDemo:
+			s.handleNotices(payload, count)
+		}
+		return
+	}
+
+	var control KISRealtimeResponse
+	if err := json.Unmarshal([]byte(message), &control); err != nil {
+		logrus.Warnf("⚠️  제어 메시지 파싱 실패: %v", err)
+		return
+	}
+
+	if control.Header.TrID == "PINGPONG" {
+		s.writeMutex.Lock()
+		err := websocket.Message.Send(conn, message)
+		s.writeMutex.Unlock()
+		if err != nil {
+			logrus.Warnf("⚠️  PINGPONG 응답 실패: %v", err)
+		}
+		return
+	}
+
+	if control.Body.RtCd != "0" {
+		logrus.Warnf("⚠️  실시간 요청 실패 (%s %s): %s - %s",
+			control.Header.TrID, control.Header.TrKey, control.Body.MsgCd, control.Body.Msg1)
+		return
+	}
+
+	// 체결통보 등록 응답에 포함된 복호화 키 보관
+	if control.Body.Output.Key != "" {
+		s.mutex.Lock()
+		s.noticeKey = []byte(control.Body.Output.Key)
+		s.noticeIV = []byte(control.Body.Output.IV)
+		s.mutex.Unlock()
+	}
+
+	logrus.Debugf("실시간 요청 처리됨 (%s %s): %s", control.Header.TrID, control.Header.TrKey, control.Body.Msg1)
+}
+
+// decrypt 체결통보 AES-256-CBC 복호화
+func (s *StreamCollector) decrypt(payload string) (string, error) {
+	s.mutex.RLock()
+	key, iv := s.noticeKey, s.noticeIV
+	s.mutex.RUnlock()
+
+	if len(key) == 0 {
+		return "", fmt.Errorf("복호화 키가 없습니다")
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return "", fmt.Errorf("base64 디코딩 실패: %w", err)
+	}
+
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return "", fmt.Errorf("AES 키 오류: %w", err)
+	}
+	if len(iv) != block.BlockSize() || len(ciphertext) == 0 || len(ciphertext)%block.BlockSize() != 0 {
+		return "", fmt.Errorf("암호문 길이 오류")
+	}
+
+	plaintext := make([]byte, len(ciphertext))
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(plaintext, ciphertext)
+
+	// PKCS#7 패딩 제거
+	padding := int(plaintext[len(plaintext)-1])
+	if padding == 0 || padding > block.BlockSize() || padding > len(plaintext) {
+		return "", fmt.Errorf("패딩 오류")
+	}
+	return string(plaintext[:len(plaintext)-padding]), nil
+}
+
+// handleQuotes HDFSCNT0 체결가 레코드 처리
+func (s *StreamCollector) handleQuotes(payload string, count int) {
+	for _, fields := range splitRecords(payload, count, quoteFieldCount) {
+		// RSYM(D+거래소+종목), SYMB, ZDIV, TYMD, XYMD, XHMS, KYMD, KHMS, OPEN, HIGH, LOW, LAST, SIGN, DIFF, RATE, ...
+		quote := Quote{
+			Symbol:     fields[1],
+			Price:      parseDecimal(fields[11]),
+			Open:       parseDecimal(fields[8]),
+			High:       parseDecimal(fields[9]),
+			Low:        parseDecimal(fields[10]),
+			ChangeRate: parseDecimal(fields[14]),
+			Volume:     parseDecimal(fields[19]),
+			TotalVol:   parseDecimal(fields[20]),
+			Timestamp:  parseKSTTime(fields[6], fields[7]),
+		}
+		if len(fields[0]) > 4 {
+			quote.Exchange = fields[0][1:4]
+		}
+		if !quote.Price.IsPositive() {
+			continue
+		}
+
+		s.publishQuote(quote)
+	}
+}
+
+// handleNotices 체결통보 레코드 처리
+func (s *StreamCollector) handleNotices(payload string, count int) {
+	for _, fields := range splitRecords(payload, count, noticeFieldCount) {
+		// CUST_ID, ACNT_NO, ODER_NO, OODER_NO, SELN_BYOV_CLS, RCTF_CLS, ODER_KIND2, STCK_SHRN_ISCD,
+		// CNTG_QTY, CNTG_UNPR, STCK_CNTG_HOUR, RFUS_YN, CNTG_YN, ACPT_YN, BRNC_NO, ODER_QTY, ...
+		side := OrderSideBuy
+		if fields[4] == "01" {
+			side = OrderSideSell
+		}
+
+		notice := FillNotice{
+			AccountNo:       fields[1],
+			OrderNo:         fields[2],
+			OriginalOrderNo: fields[3],
+			Symbol:          fields[7],
+			Side:            side,
+			FillQuantity:    parseDecimal(fields[8]),
+			FillPrice:       parseDecimal(fields[9]),
+			Rejected:        fields[11] == "1",
+			Filled:          fields[12] == "2",
+			OrderQuantity:   parseDecimal(fields[15]),
+			Timestamp:       time.Now(),
+		}
+
+		s.publishFill(notice)
+	}
+}
+
+func (s *StreamCollector) publishQuote(quote Quote) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	s.quotes[quote.Symbol] = quote
+	for _, sub := range s.quoteSubs {
+		if len(sub.symbols) > 0 && !sub.symbols[quote.Symbol] {
+			continue
+		}
+		select {
+		case sub.ch <- quote:
+		default:
+			// 느린 구독자는 건너뜀 (최신 시세는 LatestQuote로 조회 가능)
+		}
+	}
+}
+
+func (s *StreamCollector) publishFill(notice FillNotice) {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	for _, ch := range s.fillSubs {
+		select {
+		case ch <- notice:
+		default:
+			logrus.Warnf("⚠️  체결통보 구독자 지연으로 통보 누락 (주문번호: %s)", notice.OrderNo)
+		}
+	}
+}
+
+// splitRecords '^' 구분 필드를 레코드 단위로 분리
+func splitRecords(payload string, count, fieldCount int) [][]string {
+	fields := strings.Split(payload, "^")
+	if count <= 0 {
+		count = 1
+	}
+
+	var records [][]string
+	for i := 0; i < count; i++ {
+		start := i * fieldCount
+		end := start + fieldCount
+		if end > len(fields) {
+			break
+		}
+		records = append(records, fields[start:end])
+	}
+
+	if len(records) == 0 {
+		logrus.Warnf("⚠️  실시간 레코드 필드 수 부족: %d", len(fields))
+	}
+	return records
+}
+
+// parseKSTTime 한국 일자/시각(YYYYMMDD, HHMMSS) 파싱
+func parseKSTTime(date, clock string) time.Time {
+	loc, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		return time.Now()
+	}
+	t, err := time.ParseInLocation("20060102150405", date+clock, loc)
+	if err != nil {
+		return time.Now()
+	}
+	return t
+}
@@ -0,0 +1,121 @@
+package kis
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// 거래소를 알 수 없을 때 사용하는 기본 거래소코드
+const (
+	defaultQuoteExchange = "NAS"
+	defaultOrderExchange = ExchangeNASDAQ
+)
+
+// SymbolResolver 종목 코드의 상장 거래소 조회 (종목 마스터 기반, 알 수 없으면 빈 값)
+type SymbolResolver interface {
+	QuoteExchange(symbol string) string // 시세 API용 EXCD (NAS, NYS, AMS)
+	OrderExchange(symbol string) string // 주문 API용 OVRS_EXCG_CD (NASD, NYSE, AMEX)
+}
+
+// SetSymbolResolver 종목별 거래소 조회 설정 (미설정 시 NASDAQ으로 요청)
+func (c *Client) SetSymbolResolver(resolver SymbolResolver) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	c.symbols = resolver
+}
+
+func (c *Client) symbolResolver() SymbolResolver {
+	c.tokenMutex.RLock()
+	defer c.tokenMutex.RUnlock()
+
+	return c.symbols
+}
+
+// quoteExchange 종목의 시세 거래소코드 (종목 마스터에 없으면 NAS)
+func (c *Client) quoteExchange(symbol string) string {
+	if resolver := c.symbolResolver(); resolver != nil {
+		if exchange := resolver.QuoteExchange(symbol); exchange != "" {
+			return exchange
+		}
+	}
+	return defaultQuoteExchange
+}
+
+// orderExchange 주문 거래소코드 (지정값 우선, 없으면 종목 마스터, 그래도 없으면 NASD)
+func (c *Client) orderExchange(symbol, exchange string) string {
+	if exchange != "" {
+		return exchange
+	}
+	if resolver := c.symbolResolver(); resolver != nil {
+		if resolved := resolver.OrderExchange(symbol); resolved != "" {
+			return resolved
+		}
+	}
+	return defaultOrderExchange
+}
+
+// masterFiles 거래소별 종목 마스터 파일 이름
+var masterFiles = map[string]string{
+	ExchangeNASDAQ: "nasmst.cod.zip",
+	ExchangeNYSE:   "nysmst.cod.zip",
+	ExchangeAMEX:   "amsmst.cod.zip",
+}
+
+// MasterDownloader KIS 해외주식 종목 마스터 파일 다운로드
+type MasterDownloader struct {
+	baseURL    string
+	httpClient *http.Client
+}
+
+// NewMasterDownloader 새로운 종목 마스터 다운로더 생성
+func NewMasterDownloader(baseURL string) *MasterDownloader {
+	return &MasterDownloader{
+		baseURL:    strings.TrimRight(baseURL, "/"),
+		httpClient: &http.Client{Timeout: 2 * time.Minute},
+	}
+}
+
+// FetchMaster 거래소 마스터 파일(zip)을 내려받아 압축 해제된 내용 반환
+func (d *MasterDownloader) FetchMaster(ctx context.Context, exchange string) (io.ReadCloser, error) {
+	file, ok := masterFiles[exchange]
+	if !ok {
+		return nil, fmt.Errorf("지원하지 않는 거래소: %s", exchange)
+	}
+
+	url := fmt.Sprintf("%s/%s", d.baseURL, file)
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+	}
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("마스터 파일 요청 실패: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("마스터 파일 응답 오류: %s (%s)", resp.Status, url)
+	}
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("마스터 파일 수신 실패: %w", err)
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(body), int64(len(body)))
+	if err != nil {
+		return nil, fmt.Errorf("마스터 파일 압축 해제 실패: %w", err)
+	}
+	if len(archive.File) == 0 {
+		return nil, fmt.Errorf("마스터 파일이 비어 있습니다: %s", file)
+	}
+	return archive.File[0].Open()
+}
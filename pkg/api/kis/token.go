@@ -0,0 +1,311 @@
+package kis
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"auto-trader/pkg/api/kis/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// tokenRenewBefore 만료 전 선제 갱신 시점
+	tokenRenewBefore = 1 * time.Hour
+	// tokenRenewInterval 백그라운드 만료 확인 주기
+	tokenRenewInterval = 10 * time.Minute
+	// approvalKeyTTL 웹소켓 접속키 재사용 기간 (발급 후 24시간 유효)
+	approvalKeyTTL = 23 * time.Hour
+)
+
+// tokenExpiredMsgCodes 접근토큰 만료/무효 응답 코드
+var tokenExpiredMsgCodes = map[string]bool{
+	"EGW00121": true, // 유효하지 않은 token
+	"EGW00123": true, // 기간이 만료된 token
+}
+
+// SetTokenStore 접근토큰 영속화 저장소 설정
+func (c *Client) SetTokenStore(store TokenStore) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	c.tokenStore = store
+}
+
+// currentAccessToken 현재 접근토큰 조회
+func (c *Client) currentAccessToken() string {
+	c.tokenMutex.RLock()
+	defer c.tokenMutex.RUnlock()
+
+	return c.AccessToken
+}
+
+// EnsureAccessToken 유효한 접근토큰 확보 (저장소 → 신규 발급 순)
+func (c *Client) EnsureAccessToken(ctx context.Context) (string, error) {
+	c.tokenMutex.RLock()
+	token, expiresAt := c.AccessToken, c.tokenExpiresAt
+	c.tokenMutex.RUnlock()
+
+	// 만료 시각을 모르는 토큰(수동 설정)은 만료 응답을 받을 때까지 사용
+	if token != "" && (expiresAt.IsZero() || time.Until(expiresAt) > tokenRenewBefore) {
+		return token, nil
+	}
+
+	return c.renewAccessToken(ctx, token)
+}
+
+// renewAccessToken stale 토큰을 새 토큰으로 교체 (동시 요청 시 한 번만 발급)
+func (c *Client) renewAccessToken(ctx context.Context, stale string) (string, error) {
+	c.issueMutex.Lock()
+	defer c.issueMutex.Unlock()
+
+	// 대기하는 동안 다른 요청이 이미 갱신했다면 재사용
+	c.tokenMutex.RLock()
+	current, expiresAt, store := c.AccessToken, c.tokenExpiresAt, c.tokenStore
+	c.tokenMutex.RUnlock()
+	if current != "" && current != stale && (expiresAt.IsZero() || time.Until(expiresAt) > tokenRenewBefore) {
+		return current, nil
+	}
+
+	// 재시작 직후에는 저장된 토큰 재사용 (발급 횟수 제한 대응)
+	if store != nil {
+		saved, err := store.Load(tokenKey(c.AppKey))
+		if err != nil {
+			logrus.Warnf("⚠️  저장된 KIS 토큰 조회 실패: %v", err)
+		} else if saved.Valid(tokenRenewBefore) && saved.AccessToken != stale {
+			c.setToken(saved)
+			logrus.Infof("🔑 저장된 KIS 접근토큰 사용 (만료: %s)", saved.ExpiresAt.Format(time.RFC3339))
+			return saved.AccessToken, nil
+		}
+	}
+
+	issued, err := c.IssueAccessToken(ctx)
+	if err != nil {
+		return "", err
+	}
+	c.setToken(issued)
+
+	if store != nil {
+		if err := store.Save(tokenKey(c.AppKey), issued); err != nil {
+			logrus.Warnf("⚠️  KIS 토큰 저장 실패: %v", err)
+		}
+	}
+
+	logrus.Infof("🔑 KIS 접근토큰 발급 완료 (만료: %s)", issued.ExpiresAt.Format(time.RFC3339))
+	return issued.AccessToken, nil
+}
+
+func (c *Client) setToken(token *Token) {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	c.AccessToken = token.AccessToken
+	c.tokenExpiresAt = token.ExpiresAt
+}
+
+// IssueAccessToken /oauth2/tokenP 엔드포인트에서 접근토큰 발급
+func (c *Client) IssueAccessToken(ctx context.Context) (*Token, error) {
+	url := fmt.Sprintf("%s/oauth2/tokenP", c.BaseURL)
+
+	requestBody := dto.NewTokenRequest(c.AppKey, c.AppSecret)
+	if err := requestBody.Validate(); err != nil {
+		return nil, utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	var tokenResp KISTokenResponse
+	if err := c.postOAuth(ctx, url, requestBody, &tokenResp); err != nil {
+		return nil, fmt.Errorf("접근토큰 발급 실패: %w", err)
+	}
+	if tokenResp.AccessToken == "" {
+		return nil, fmt.Errorf("접근토큰 발급 실패: %s - %s", tokenResp.ErrorCode, tokenResp.ErrorDescription)
+	}
+
+	return &Token{
+		AccessToken: tokenResp.AccessToken,
+		ExpiresAt:   parseTokenExpiry(&tokenResp),
+	}, nil
+}
+
+// GetApprovalKey 웹소켓 접속키 조회 (캐시 후 만료 시 재발급)
+func (c *Client) GetApprovalKey(ctx context.Context) (string, error) {
+	c.tokenMutex.RLock()
+	key, expiresAt := c.approvalKey, c.approvalExpiresAt
+	c.tokenMutex.RUnlock()
+	if key != "" && time.Now().Before(expiresAt) {
+		return key, nil
+	}
+
+	url := fmt.Sprintf("%s/oauth2/Approval", c.BaseURL)
+
+	requestBody := dto.NewApprovalRequest(c.AppKey, c.AppSecret)
+	if err := requestBody.Validate(); err != nil {
+		return "", utils.WrapValidationError(err, "요청 검증 실패")
+	}
+
+	var approvalResp KISApprovalResponse
+	if err := c.postOAuth(ctx, url, requestBody, &approvalResp); err != nil {
+		return "", fmt.Errorf("웹소켓 접속키 발급 실패: %w", err)
+	}
+	if approvalResp.ApprovalKey == "" {
+		return "", fmt.Errorf("웹소켓 접속키 발급 실패: %s - %s", approvalResp.ErrorCode, approvalResp.ErrorDescription)
+	}
+
+	c.tokenMutex.Lock()
+	c.approvalKey = approvalResp.ApprovalKey
+	c.approvalExpiresAt = time.Now().Add(approvalKeyTTL)
+	c.tokenMutex.Unlock()
+
+	logrus.Info("🔑 KIS 웹소켓 접속키 발급 완료")
+	return approvalResp.ApprovalKey, nil
+}
+
+// StartTokenRenewal 만료 전 접근토큰 선제 갱신 시작
+func (c *Client) StartTokenRenewal() {
+	c.tokenMutex.Lock()
+	if c.renewalStop != nil {
+		c.tokenMutex.Unlock()
+		return
+	}
+	stopChan := make(chan struct{})
+	c.renewalStop = stopChan
+	c.tokenMutex.Unlock()
+
+	go func() {
+		// 시작 시점에 바로 확보해 첫 주문 지연 방지
+		c.renewIfNeeded()
+
+		ticker := time.NewTicker(tokenRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.renewIfNeeded()
+			case <-stopChan:
+				return
+			}
+		}
+	}()
+}
+
+// StopTokenRenewal 접근토큰 선제 갱신 중지
+func (c *Client) StopTokenRenewal() {
+	c.tokenMutex.Lock()
+	defer c.tokenMutex.Unlock()
+
+	if c.renewalStop != nil {
+		close(c.renewalStop)
+		c.renewalStop = nil
+	}
+}
+
+func (c *Client) renewIfNeeded() {
+	ctx, cancel := context.WithTimeout(context.Background(), c.HTTPClient.Timeout)
+	defer cancel()
+
+	if _, err := c.EnsureAccessToken(ctx); err != nil {
+		logrus.Errorf("❌ KIS 접근토큰 갱신 실패: %v", err)
+	}
+}
+
+// execute 인증이 필요한 요청 실행 (토큰 만료 응답 시 한 번 재발급 후 재시도)
+// newRequest는 호출 시점의 접근토큰으로 헤더를 구성해야 한다.
+func (c *Client) execute(ctx context.Context, newRequest func() (*http.Request, error)) ([]byte, http.Header, error) {
+	token, err := c.EnsureAccessToken(ctx)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	body, header, err := c.send(newRequest)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if !isTokenExpiredResponse(body) {
+		return body, header, nil
+	}
+
+	logrus.Warn("⚠️  KIS 접근토큰 만료 응답 - 재발급 후 재시도")
+	if _, err := c.renewAccessToken(ctx, token); err != nil {
+		return nil, nil, err
+	}
+
+	return c.send(newRequest)
+}
+
+func (c *Client) send(newRequest func() (*http.Request, error)) ([]byte, http.Header, error) {
+	req, err := newRequest()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	resp, err := c.HTTPClient.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("API 요청 실패: %w", err)
+	}
+	defer func() { _ = resp.Body.Close() }()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, nil, fmt.Errorf("응답 읽기 실패: %w", err)
+	}
+
+	return body, resp.Header, nil
+}
+
+// postOAuth 인증 엔드포인트 POST 요청 (접근토큰 불필요)
+func (c *Client) postOAuth(ctx context.Context, url string, requestBody interface{}, out interface{}) error {
+	jsonBody, err := json.Marshal(requestBody)
+	if err != nil {
+		return fmt.Errorf("요청 바디 마샬링 실패: %w", err)
+	}
+
+	body, _, err := c.send(func() (*http.Request, error) {
+		req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewBuffer(jsonBody))
+		if err != nil {
+			return nil, fmt.Errorf("http 요청 생성 실패: %w", err)
+		}
+		req.Header.Set("Content-Type", "application/json; charset=utf-8")
+		return req, nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := json.Unmarshal(body, out); err != nil {
+		return fmt.Errorf("응답 파싱 실패: %w", err)
+	}
+	return nil
+}
+
+// isTokenExpiredResponse 접근토큰 만료/무효 오류 응답인지 확인
+func isTokenExpiredResponse(body []byte) bool {
+	var errResp KISErrorResponse
+	if err := json.Unmarshal(body, &errResp); err != nil {
+		return false
+	}
+	return errResp.RtCd != "0" && tokenExpiredMsgCodes[errResp.MsgCd]
+}
+
+// parseTokenExpiry 토큰 만료 시각 계산 (만료일시 우선, 없으면 유효기간 사용)
+func parseTokenExpiry(resp *KISTokenResponse) time.Time {
+	if resp.AccessTokenExpired != "" {
+		if loc, err := time.LoadLocation("Asia/Seoul"); err == nil {
+			if expiresAt, err := time.ParseInLocation("2006-01-02 15:04:05", resp.AccessTokenExpired, loc); err == nil {
+				return expiresAt
+			}
+		}
+	}
+
+	expiresIn := resp.ExpiresIn
+	if expiresIn <= 0 {
+		expiresIn = int64((24 * time.Hour).Seconds())
+	}
+	return time.Now().Add(time.Duration(expiresIn) * time.Second)
+}
@@ -0,0 +1,175 @@
+package kis
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/shared/envelope"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Token 발급받은 접근토큰
+type Token struct {
+	AccessToken string    `json:"access_token"`
+	ExpiresAt   time.Time `json:"expires_at"`
+}
+
+// Valid 주어진 여유 시간 이후에도 유효한지 확인
+func (t *Token) Valid(margin time.Duration) bool {
+	return t != nil && t.AccessToken != "" && time.Until(t.ExpiresAt) > margin
+}
+
+// TokenStore 접근토큰 영속화 인터페이스 (재시작 시 재발급 방지, key는 앱키 지문)
+type TokenStore interface {
+	Load(key string) (*Token, error)
+	Save(key string, token *Token) error
+}
+
+// tokenKey 토큰 저장 키 (파일에 앱키가 남지 않도록 SHA-256 지문 사용)
+func tokenKey(appKey string) string {
+	sum := sha256.Sum256([]byte(appKey))
+	return hex.EncodeToString(sum[:16])
+}
+
+// storedToken 파일에 기록하는 토큰 (마스터 키가 있으면 봉투 암호화, 없으면 평문)
+type storedToken struct {
+	AccessToken string    `json:"access_token,omitempty"`
+	ExpiresAt   time.Time `json:"expires_at"`
+	KeyID       string    `json:"key_id,omitempty"`
+	DataKey     []byte    `json:"data_key,omitempty"`
+	Sealed      []byte    `json:"sealed_token,omitempty"`
+}
+
+// FileTokenStore JSON 파일 기반 토큰 저장소 (앱키 지문별로 저장)
+// 접근토큰은 마스터 키로 봉투 암호화해 기록하며(앱키 지문을 AAD로 묶음), 평문으로 남아 있던 토큰도 다음 저장 시 암호화한다.
+type FileTokenStore struct {
+	path   string
+	sealer *envelope.Sealer
+	mutex  sync.Mutex
+}
+
+// NewFileTokenStore 새로운 파일 토큰 저장소 생성 (sealer가 nil이면 평문 저장)
+func NewFileTokenStore(path string, sealer *envelope.Sealer) *FileTokenStore {
+	return &FileTokenStore{path: path, sealer: sealer}
+}
+
+// Load 키에 해당하는 토큰 조회 (없거나 복호화할 수 없으면 nil → 재발급)
+func (s *FileTokenStore) Load(key string) (*Token, error) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tokens, err := s.readAll()
+	if err != nil {
+		return nil, err
+	}
+
+	stored, ok := tokens[key]
+	if !ok {
+		return nil, nil
+	}
+	if stored.Sealed == nil {
+		return &Token{AccessToken: stored.AccessToken, ExpiresAt: stored.ExpiresAt}, nil
+	}
+
+	if s.sealer == nil {
+		logrus.Warn("⚠️  암호화된 KIS 접근토큰을 복호화할 마스터 키가 없어 재발급합니다")
+		return nil, nil
+	}
+	plaintexts, err := s.sealer.Open([]byte(key), &envelope.Envelope{
+		KeyID:       stored.KeyID,
+		DataKey:     stored.DataKey,
+		Ciphertexts: [][]byte{stored.Sealed},
+	})
+	if err != nil {
+		logrus.Warnf("⚠️  KIS 접근토큰 복호화 실패로 재발급합니다: %v", err)
+		return nil, nil
+	}
+	return &Token{AccessToken: string(plaintexts[0]), ExpiresAt: stored.ExpiresAt}, nil
+}
+
+// Save 키에 해당하는 토큰 저장
+func (s *FileTokenStore) Save(key string, token *Token) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	tokens, err := s.readAll()
+	if err != nil {
+		return err
+	}
+	tokens[key] = &storedToken{AccessToken: token.AccessToken, ExpiresAt: token.ExpiresAt}
+
+	// 평문 토큰은 모두 암호화해 기록
+	if s.sealer != nil {
+		for k, stored := range tokens {
+			if stored.Sealed != nil {
+				continue
+			}
+			if tokens[k], err = s.seal(k, stored); err != nil {
+				return err
+			}
+		}
+	}
+
+	data, err := json.MarshalIndent(tokens, "", "  ")
+	if err != nil {
+		return fmt.Errorf("토큰 마샬링 실패: %w", err)
+	}
+
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return fmt.Errorf("토큰 저장 디렉토리 생성 실패: %w", err)
+	}
+
+	// 임시 파일에 기록 후 교체 (기록 도중 종료되어도 기존 파일 보존)
+	tmpPath := s.path + ".tmp"
+	if err := os.WriteFile(tmpPath, data, 0o600); err != nil {
+		return fmt.Errorf("토큰 파일 기록 실패: %w", err)
+	}
+	if err := os.Rename(tmpPath, s.path); err != nil {
+		return fmt.Errorf("토큰 파일 교체 실패: %w", err)
+	}
+
+	return nil
+}
+
+// seal 접근토큰을 봉투 암호화 (앱키 지문을 AAD로 사용해 다른 키의 항목으로 옮겨도 복호화되지 않음)
+func (s *FileTokenStore) seal(key string, stored *storedToken) (*storedToken, error) {
+	sealed, err := s.sealer.Seal([]byte(key), []byte(stored.AccessToken))
+	if err != nil {
+		return nil, fmt.Errorf("토큰 암호화 실패: %w", err)
+	}
+	return &storedToken{
+		ExpiresAt: stored.ExpiresAt,
+		KeyID:     sealed.KeyID,
+		DataKey:   sealed.DataKey,
+		Sealed:    sealed.Ciphertexts[0],
+	}, nil
+}
+
+func (s *FileTokenStore) readAll() (map[string]*storedToken, error) {
+	tokens := make(map[string]*storedToken)
+
+	data, err := os.ReadFile(s.path)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return tokens, nil
+		}
+		return nil, fmt.Errorf("토큰 파일 읽기 실패: %w", err)
+	}
+
+	if len(data) == 0 {
+		return tokens, nil
+	}
+	if err := json.Unmarshal(data, &tokens); err != nil {
+		return nil, fmt.Errorf("토큰 파일 파싱 실패: %w", err)
+	}
+
+	return tokens, nil
+}
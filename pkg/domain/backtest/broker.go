@@ -0,0 +1,289 @@
+package backtest
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/shopspring/decimal"
+)
+
+// Broker 가상 잔고로 주문을 모의 체결하는 실행기 (strategy.Executor 구현)
+type Broker struct {
+	collector *Collector
+	fill      order.FillSimulator
+
+	account  *order.SimAccount
+	pending  []*pendingOrder
+	trades   []Trade
+	handlers []order.UpdateHandler
+	sequence int
+	rejected int
+	mutex    sync.Mutex
+}
+
+// pendingOrder 다음 봉에서 체결을 기다리는 주문
+type pendingOrder struct {
+	update  order.Update
+	session string // 처음 체결을 시도한 거래일 (당일 유효 주문 만료 판단)
+}
+
+// NewBroker 새로운 모의 실행기 생성
+func NewBroker(collector *Collector, initialCash decimal.Decimal, fill order.FillSimulator) *Broker {
+	return &Broker{
+		collector: collector,
+		fill:      fill,
+		account:   order.NewSimAccount(initialCash),
+	}
+}
+
+// OnOrderUpdate 주문 상태 변경 콜백 등록
+func (b *Broker) OnOrderUpdate(handler order.UpdateHandler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers = append(b.handlers, handler)
+}
+
+// ExecuteOrder 주문 접수 (체결 시점은 FillModel에 따름)
+func (b *Broker) ExecuteOrder(ctx context.Context, req *order.Request) (*order.Update, error) {
+	if req.Symbol == "" {
+		return nil, utils.BadRequest("종목 코드가 필요합니다")
+	}
+	if req.Side != order.SideBuy && req.Side != order.SideSell {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 방향: %s", req.Side))
+	}
+
+	quote, ok := b.collector.Quote(req.Symbol)
+	if !ok {
+		return nil, utils.BadRequest(fmt.Sprintf("시세가 없는 종목입니다: %s", req.Symbol))
+	}
+
+	b.mutex.Lock()
+	b.sequence++
+	update := order.Update{
+		ClientOrderID: req.ClientOrderID,
+		BrokerOrderID: fmt.Sprintf("BT%08d", b.sequence),
+		UserID:        req.UserID,
+		StrategyID:    req.StrategyID,
+		Symbol:        req.Symbol,
+		Exchange:      req.Exchange,
+		Side:          req.Side,
+		Type:          req.Type,
+		TimeInForce:   req.TimeInForce,
+		Quantity:      req.Quantity.Floor(),
+		Price:         req.Price,
+		Status:        order.StatusSubmitted,
+		Timestamp:     quote.Timestamp,
+	}
+	b.mutex.Unlock()
+
+	if update.ClientOrderID == "" {
+		update.ClientOrderID = update.BrokerOrderID
+	}
+	if update.Type == "" {
+		update.Type = order.TypeMarket
+	}
+	if update.TimeInForce == "" {
+		update.TimeInForce = order.TimeInForceDay
+	}
+
+	if !update.Quantity.IsPositive() {
+		return b.reject(update, fmt.Sprintf("주문 수량이 1주 미만입니다: %s", req.Quantity.String()))
+	}
+	b.emit(update)
+
+	// 종가 체결 모델은 주문 시점 봉에서 바로 체결 시도
+	if b.fill.Model == order.FillOnClose {
+		if price, ok := b.fill.Match(requestOf(update), quote, false); ok {
+			return b.execute(update, price, quote)
+		}
+		if update.TimeInForce == order.TimeInForceIOC {
+			return b.cancel(update, quote)
+		}
+	}
+
+	b.mutex.Lock()
+	b.pending = append(b.pending, &pendingOrder{update: update})
+	b.mutex.Unlock()
+
+	return &update, nil
+}
+
+// CancelOrder 미체결 주문 취소
+func (b *Broker) CancelOrder(ctx context.Context, clientOrderID string) error {
+	b.mutex.Lock()
+	var target *pendingOrder
+	for i, p := range b.pending {
+		if p.update.ClientOrderID == clientOrderID {
+			target = p
+			b.pending = append(b.pending[:i], b.pending[i+1:]...)
+			break
+		}
+	}
+	b.mutex.Unlock()
+
+	if target == nil {
+		return utils.NotFound("order", "주문을 찾을 수 없습니다")
+	}
+
+	quote, _ := b.collector.Quote(target.update.Symbol)
+	_, err := b.cancel(target.update, quote)
+	return err
+}
+
+// OnBar 새 봉 시세로 대기 주문 체결 처리
+// 처음 체결을 시도하는 주문은 시가 기준으로, 이후에는 봉 고가/저가로 지정가 도달 여부를 판단한다.
+func (b *Broker) OnBar(bar marketdata.Bar) {
+	quote := quoteFromBar(bar)
+	session := bar.Timestamp.In(marketdata.MarketLocation()).Format("20060102")
+
+	b.mutex.Lock()
+	var due []*pendingOrder
+	remaining := b.pending[:0]
+	for _, p := range b.pending {
+		if p.update.Symbol == bar.Symbol {
+			due = append(due, p)
+		} else {
+			remaining = append(remaining, p)
+		}
+	}
+	b.pending = remaining
+	b.mutex.Unlock()
+
+	for _, p := range due {
+		firstAttempt := p.session == ""
+		if !firstAttempt && p.session != session {
+			// 당일 유효 주문은 거래일이 바뀌면 만료
+			_, _ = b.cancel(p.update, quote)
+			continue
+		}
+
+		if price, ok := b.fill.Match(requestOf(p.update), quote, firstAttempt); ok {
+			_, _ = b.execute(p.update, price, quote)
+			continue
+		}
+		if p.update.TimeInForce == order.TimeInForceIOC {
+			_, _ = b.cancel(p.update, quote)
+			continue
+		}
+
+		p.session = session
+		b.mutex.Lock()
+		b.pending = append(b.pending, p)
+		b.mutex.Unlock()
+	}
+}
+
+// CancelAll 남은 대기 주문 일괄 취소 (백테스트 종료 시)
+func (b *Broker) CancelAll() {
+	b.mutex.Lock()
+	pending := b.pending
+	b.pending = nil
+	b.mutex.Unlock()
+
+	for _, p := range pending {
+		quote, _ := b.collector.Quote(p.update.Symbol)
+		_, _ = b.cancel(p.update, quote)
+	}
+}
+
+// Equity 현금과 보유 종목 평가금액 합계
+func (b *Broker) Equity() (equity, cash decimal.Decimal) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.account.Cash.Add(b.account.MarketValue(b.collector.LastPrice)), b.account.Cash
+}
+
+// Trades 체결 내역
+func (b *Broker) Trades() []Trade {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	trades := make([]Trade, len(b.trades))
+	copy(trades, b.trades)
+	return trades
+}
+
+// RejectedCount 거부된 주문 수
+func (b *Broker) RejectedCount() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return b.rejected
+}
+
+// execute 잔고/보유 수량을 확인해 체결 반영 (매도는 보유 수량까지만 체결)
+func (b *Broker) execute(update order.Update, price decimal.Decimal, quote order.Quote) (*order.Update, error) {
+	b.mutex.Lock()
+	fill, err := b.account.ApplyFill(b.fill, update.Symbol, update.Side, update.Quantity, price)
+	if err != nil {
+		b.mutex.Unlock()
+		return b.reject(update, err.Error())
+	}
+	b.trades = append(b.trades, Trade{
+		Time:          quote.Timestamp,
+		ClientOrderID: update.ClientOrderID,
+		Symbol:        update.Symbol,
+		Side:          update.Side,
+		Quantity:      fill.Quantity,
+		Price:         fill.Price,
+		Commission:    fill.Commission,
+		RealizedPnL:   fill.RealizedPnL,
+	})
+	b.mutex.Unlock()
+
+	update.Status = order.StatusFilled
+	update.FilledQuantity = fill.Quantity
+	update.AvgFillPrice = fill.Price
+	update.LastFillQuantity = fill.Quantity
+	update.LastFillPrice = fill.Price
+	update.Timestamp = quote.Timestamp
+	b.emit(update)
+
+	return &update, nil
+}
+
+func (b *Broker) cancel(update order.Update, quote order.Quote) (*order.Update, error) {
+	update.Status = order.StatusCanceled
+	update.Timestamp = quote.Timestamp
+	b.emit(update)
+	return &update, nil
+}
+
+func (b *Broker) reject(update order.Update, reason string) (*order.Update, error) {
+	b.mutex.Lock()
+	b.rejected++
+	b.mutex.Unlock()
+
+	update.Status = order.StatusRejected
+	update.RejectReason = reason
+	b.emit(update)
+	return &update, fmt.Errorf("주문 거부: %s", reason)
+}
+
+// emit 등록된 콜백에 주문 상태 전달
+func (b *Broker) emit(update order.Update) {
+	b.mutex.Lock()
+	handlers := make([]order.UpdateHandler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
+// requestOf 체결 판단에 필요한 주문 조건만 추출
+func requestOf(update order.Update) *order.Request {
+	return &order.Request{
+		Side:  update.Side,
+		Type:  update.Type,
+		Price: update.Price,
+	}
+}
@@ -0,0 +1,108 @@
+package backtest
+
+import (
+	"fmt"
+	"sync"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+// Collector 재생 중인 봉을 현재 시세로 제공하는 모의 시세 수집기
+type Collector struct {
+	bars        map[string]marketdata.Bar  // 종목별 현재 봉
+	baseClose   map[string]decimal.Decimal // 종목별 전 거래일 종가
+	sessionDate map[string]string
+	mutex       sync.RWMutex
+}
+
+// NewCollector 새로운 모의 시세 수집기 생성
+func NewCollector() *Collector {
+	return &Collector{
+		bars:        make(map[string]marketdata.Bar),
+		baseClose:   make(map[string]decimal.Decimal),
+		sessionDate: make(map[string]string),
+	}
+}
+
+// Advance 종목의 현재 봉 갱신 (거래일이 바뀌면 직전 봉 종가를 전일 종가로 사용)
+func (c *Collector) Advance(bar marketdata.Bar) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	date := bar.Timestamp.In(marketdata.MarketLocation()).Format("20060102")
+	if prev, ok := c.bars[bar.Symbol]; ok && c.sessionDate[bar.Symbol] != date {
+		c.baseClose[bar.Symbol] = prev.Close
+	}
+	c.sessionDate[bar.Symbol] = date
+	c.bars[bar.Symbol] = bar
+}
+
+// StartPriceStream 재생 방식이므로 별도 스트림 없음
+func (c *Collector) StartPriceStream(symbols []string) {}
+
+// Stop 재생 방식이므로 정리할 스트림 없음
+func (c *Collector) Stop() {}
+
+// GetCurrentPrice 현재 봉 종가 (봉 마감 시각 기준)
+func (c *Collector) GetCurrentPrice(symbol string) (*strategy.PriceData, error) {
+	bar, ok := c.currentBar(symbol)
+	if !ok {
+		return nil, fmt.Errorf("재생된 봉이 없습니다: %s", symbol)
+	}
+
+	return &strategy.PriceData{
+		Price:     bar.Close,
+		Volume:    decimal.NewFromInt(bar.Volume),
+		Timestamp: bar.Timestamp.Add(bar.Interval.Duration()),
+	}, nil
+}
+
+// GetDailyProfit 전 거래일 종가 대비 현재 봉 종가 등락률(%)
+func (c *Collector) GetDailyProfit(symbol string) (decimal.Decimal, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	bar, ok := c.bars[symbol]
+	base, hasBase := c.baseClose[symbol]
+	if !ok || !hasBase || base.IsZero() {
+		return decimal.Zero, fmt.Errorf("전일 종가가 없습니다: %s", symbol)
+	}
+	return bar.Close.Sub(base).Div(base).Mul(decimal.NewFromInt(100)), nil
+}
+
+// Quote 현재 봉을 모의 체결용 시세로 변환
+func (c *Collector) Quote(symbol string) (order.Quote, bool) {
+	bar, ok := c.currentBar(symbol)
+	if !ok {
+		return order.Quote{}, false
+	}
+	return quoteFromBar(bar), true
+}
+
+// LastPrice 현재 봉 종가 (평가금액 계산용)
+func (c *Collector) LastPrice(symbol string) (decimal.Decimal, bool) {
+	bar, ok := c.currentBar(symbol)
+	return bar.Close, ok
+}
+
+func (c *Collector) currentBar(symbol string) (marketdata.Bar, bool) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	bar, ok := c.bars[symbol]
+	return bar, ok
+}
+
+func quoteFromBar(bar marketdata.Bar) order.Quote {
+	return order.Quote{
+		Open:      bar.Open,
+		High:      bar.High,
+		Low:       bar.Low,
+		Close:     bar.Close,
+		Timestamp: bar.Timestamp.Add(bar.Interval.Duration()),
+	}
+}
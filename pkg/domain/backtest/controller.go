@@ -0,0 +1,131 @@
+package backtest
+
+import (
+	"auto-trader/pkg/domain/backtest/dto"
+	"auto-trader/pkg/shared/types"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 백테스트 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 백테스트 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// RunBacktest 백테스트 실행
+// @Summary 백테스트 실행
+// @Description 전략의 조건/액션 설정을 과거 봉에 재생해 모의 체결 결과를 계산하고 저장합니다
+// @Tags strategies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "전략 ID"
+// @Param backtest body dto.RunBacktestBody true "백테스트 설정"
+// @Success 201 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategies/{id}/backtest [post]
+func (ctrl *Controller) RunBacktest(c *fiber.Ctx) error {
+	var path types.Id
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	var req dto.RunBacktestBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Interval == "" {
+		req.Interval = "1d"
+	}
+	if req.FillModel == "" {
+		req.FillModel = "next_open"
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	result, err := ctrl.service.RunBacktest(utils.GetUserID(c), path.ID, req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "백테스트 실행 실패")
+	}
+
+	return utils.SuccessResponse(c, result, fiber.StatusCreated)
+}
+
+// GetBacktests 백테스트 결과 목록 조회
+// @Summary 백테스트 결과 목록 조회
+// @Description 전략의 백테스트 결과 요약을 최신순으로 조회합니다 (파라미터 조합 간 비교용)
+// @Tags strategies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "전략 ID"
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategies/{id}/backtests [get]
+func (ctrl *Controller) GetBacktests(c *fiber.Ctx) error {
+	var path types.Id
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	var q dto.GetBacktestListQuery
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	results, err := ctrl.service.GetBacktests(utils.GetUserID(c), path.ID, q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "백테스트 결과 목록 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, results)
+}
+
+// GetBacktest 백테스트 결과 상세 조회
+// @Summary 백테스트 결과 상세 조회
+// @Description 평가금액 곡선과 체결 내역을 포함한 백테스트 결과를 조회합니다
+// @Tags strategies
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "전략 ID"
+// @Param backtestId path string true "백테스트 결과 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategies/{id}/backtests/{backtestId} [get]
+func (ctrl *Controller) GetBacktest(c *fiber.Ctx) error {
+	var path dto.BacktestPath
+	path.StrategyID = c.Params("id")
+	path.BacktestID = c.Params("backtestId")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	result, err := ctrl.service.GetBacktest(utils.GetUserID(c), path)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "백테스트 결과 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, result)
+}
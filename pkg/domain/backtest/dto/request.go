@@ -0,0 +1,33 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// RunBacktestBody 백테스트 실행 요청
+type RunBacktestBody struct {
+	From           string                 `json:"from" validate:"required"` // YYYY-MM-DD 또는 RFC3339
+	To             string                 `json:"to,omitempty"`             // 비어 있으면 현재 시각
+	Interval       string                 `json:"interval" validate:"enum=1m,5m,15m,30m,1h,1d"`
+	Exchange       string                 `json:"exchange,omitempty" validate:"max=10"` // 시세 거래소코드 (NAS, NYS, AMS, 비어 있으면 종목 마스터 기준)
+	InitialCapital float64                `json:"initial_capital"`
+	SlippageBps    float64                `json:"slippage_bps"`    // 시장가 체결 슬리피지 (1bp = 0.01%)
+	CommissionRate float64                `json:"commission_rate"` // 체결 금액 대비 수수료율 (예: 0.0025)
+	MinCommission  float64                `json:"min_commission"`  // 건당 최소 수수료
+	FillModel      string                 `json:"fill_model" validate:"enum=next_open,close"`
+	Parameters     map[string]interface{} `json:"parameters,omitempty"` // 전략 파라미터 덮어쓰기
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetBacktestListQuery 백테스트 결과 목록 조회 쿼리 파라미터
+type GetBacktestListQuery struct {
+	Limit  int `query:"limit" validate:"min=1,max=100"`
+	Offset int `query:"offset" validate:"min=0"`
+}
+
+// Path DTOs (URL 경로 파라미터)
+
+// BacktestPath 백테스트 결과 경로 파라미터
+type BacktestPath struct {
+	StrategyID string `param:"id" validate:"required"`
+	BacktestID string `param:"backtestId" validate:"required"`
+}
@@ -0,0 +1,64 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// BacktestSummaryResponse 백테스트 결과 요약 (파라미터 조합 간 비교용)
+type BacktestSummaryResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	StrategyID     uuid.UUID              `json:"strategy_id"`
+	Symbols        []string               `json:"symbols"`
+	Interval       string                 `json:"interval"`
+	From           time.Time              `json:"from"`
+	To             time.Time              `json:"to"`
+	Parameters     map[string]interface{} `json:"parameters"`
+	Settings       map[string]interface{} `json:"settings"`
+	InitialCapital decimal.Decimal        `json:"initial_capital"`
+	FinalEquity    decimal.Decimal        `json:"final_equity"`
+	TotalReturn    float64                `json:"total_return"` // %
+	MaxDrawdown    float64                `json:"max_drawdown"` // %
+	SharpeRatio    float64                `json:"sharpe_ratio"`
+	WinRate        float64                `json:"win_rate"` // %
+	TradeCount     int                    `json:"trade_count"`
+	RejectedOrders int                    `json:"rejected_orders"`
+	CreatedAt      *time.Time             `json:"created_at,omitempty"`
+}
+
+// BacktestResponse 백테스트 결과 상세
+type BacktestResponse struct {
+	BacktestSummaryResponse
+	EquityCurve []EquityPointResponse `json:"equity_curve"`
+	Trades      []TradeResponse       `json:"trades"`
+}
+
+// EquityPointResponse 평가금액 곡선의 한 지점
+type EquityPointResponse struct {
+	Time     time.Time       `json:"time"`
+	Equity   decimal.Decimal `json:"equity"`
+	Cash     decimal.Decimal `json:"cash"`
+	Drawdown float64         `json:"drawdown"`
+}
+
+// TradeResponse 모의 체결 내역
+type TradeResponse struct {
+	Time          time.Time       `json:"time"`
+	ClientOrderID string          `json:"client_order_id"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Price         decimal.Decimal `json:"price"`
+	Commission    decimal.Decimal `json:"commission"`
+	RealizedPnL   decimal.Decimal `json:"realized_pnl"`
+}
+
+// BacktestListResponse 백테스트 결과 목록
+type BacktestListResponse struct {
+	Backtests []*BacktestSummaryResponse `json:"backtests"`
+	Total     int                        `json:"total"`
+	Limit     int                        `json:"limit"`
+	Offset    int                        `json:"offset"`
+}
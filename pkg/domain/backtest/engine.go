@@ -0,0 +1,120 @@
+package backtest
+
+import (
+	"sort"
+	"time"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/indicator"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// Engine 과거 봉을 동적 전략에 재생하는 백테스트 엔진
+type Engine struct {
+	appConfig *config.Config
+}
+
+// NewEngine 새로운 백테스트 엔진 생성
+func NewEngine(appConfig *config.Config) *Engine {
+	return &Engine{appConfig: appConfig}
+}
+
+// Run 봉을 시간순으로 재생해 결과 생성 (start 이전 봉은 지표 워밍업에만 사용)
+// 같은 시각의 봉은 모든 종목의 대기 주문을 먼저 체결한 뒤 전략 조건을 평가한다.
+func (e *Engine) Run(strategyConfig *strategy.StrategyConfig, interval marketdata.Interval, bars []marketdata.Bar, start time.Time, settings Settings) (*Report, error) {
+	if !settings.InitialCapital.IsPositive() {
+		return nil, utils.BadRequest("초기 자본은 0보다 커야 합니다")
+	}
+
+	sorted := make([]marketdata.Bar, len(bars))
+	copy(sorted, bars)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].Timestamp.Equal(sorted[j].Timestamp) {
+			return sorted[i].Symbol < sorted[j].Symbol
+		}
+		return sorted[i].Timestamp.Before(sorted[j].Timestamp)
+	})
+
+	collector := NewCollector()
+	broker := NewBroker(collector, settings.InitialCapital, settings.Fill)
+	replayer := strategy.NewReplayStrategy(collector, broker, e.appConfig, replayConfig(strategyConfig, interval))
+	broker.OnOrderUpdate(replayer.ApplyFill)
+
+	report := &Report{InitialCapital: settings.InitialCapital}
+	for i := 0; i < len(sorted); {
+		// 같은 시각의 봉 묶음
+		j := i
+		for j < len(sorted) && sorted[j].Timestamp.Equal(sorted[i].Timestamp) {
+			j++
+		}
+		group := sorted[i:j]
+		timestamp := sorted[i].Timestamp
+		i = j
+
+		if timestamp.Before(start) {
+			for _, bar := range group {
+				collector.Advance(bar)
+				replayer.PreloadCandles(bar.Symbol, []indicator.Candle{bar.ToCandle()})
+			}
+			continue
+		}
+
+		for _, bar := range group {
+			collector.Advance(bar)
+			broker.OnBar(bar)
+		}
+		for _, bar := range group {
+			if err := replayer.ReplayCandle(bar.Symbol, bar.ToCandle()); err != nil {
+				logrus.Debugf("백테스트 전략 실행 오류 (%s %s): %v", bar.Symbol, timestamp.Format(time.RFC3339), err)
+			}
+		}
+
+		if report.From.IsZero() {
+			report.From = timestamp
+		}
+		report.To = timestamp.Add(interval.Duration())
+
+		equity, cash := broker.Equity()
+		report.EquityCurve = append(report.EquityCurve, EquityPoint{
+			Time:   report.To,
+			Equity: equity.Round(4),
+			Cash:   cash.Round(4),
+		})
+	}
+
+	if len(report.EquityCurve) == 0 {
+		return nil, utils.BadRequest("백테스트 구간에 봉 데이터가 없습니다")
+	}
+	broker.CancelAll()
+
+	report.FinalEquity = report.EquityCurve[len(report.EquityCurve)-1].Equity
+	report.TotalReturn, _ = report.FinalEquity.Sub(settings.InitialCapital).Div(settings.InitialCapital).Mul(decimal.NewFromInt(100)).Float64()
+	report.MaxDrawdown = applyDrawdown(report.EquityCurve)
+	report.SharpeRatio = sharpeRatio(report.EquityCurve, interval)
+	report.Trades = broker.Trades()
+	report.TradeCount = len(report.Trades)
+	report.WinRate = winRate(report.Trades)
+	report.RejectedOrders = broker.RejectedCount()
+
+	return report, nil
+}
+
+// replayConfig 전략 설정 복사본 (지표 봉 주기를 백테스트 봉 주기에 맞춤)
+func replayConfig(strategyConfig *strategy.StrategyConfig, interval marketdata.Interval) *strategy.StrategyConfig {
+	parameters := make(map[string]interface{}, len(strategyConfig.Parameters)+1)
+	for key, value := range strategyConfig.Parameters {
+		parameters[key] = value
+	}
+	parameters["candle_interval"] = interval.Duration().String()
+
+	return &strategy.StrategyConfig{
+		ID:         strategyConfig.ID,
+		Enabled:    true,
+		Parameters: parameters,
+	}
+}
@@ -0,0 +1,99 @@
+package backtest
+
+import (
+	"math"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+)
+
+const (
+	// tradingDaysPerYear 연율화에 사용하는 연간 거래일 수
+	tradingDaysPerYear = 252
+	// sessionMinutes 미국 정규장 거래 시간(분)
+	sessionMinutes = 390
+)
+
+// applyDrawdown 평가금액 곡선에 고점 대비 하락률을 채우고 최대 낙폭(%) 반환
+func applyDrawdown(curve []EquityPoint) float64 {
+	maxDrawdown := 0.0
+	peak := 0.0
+	for i := range curve {
+		equity, _ := curve[i].Equity.Float64()
+		if equity > peak {
+			peak = equity
+		}
+		if peak <= 0 {
+			continue
+		}
+		drawdown := (peak - equity) / peak * 100
+		curve[i].Drawdown = drawdown
+		if drawdown > maxDrawdown {
+			maxDrawdown = drawdown
+		}
+	}
+	return maxDrawdown
+}
+
+// sharpeRatio 봉 단위 수익률로 계산한 연율화 샤프 지수 (무위험 수익률 0)
+func sharpeRatio(curve []EquityPoint, interval marketdata.Interval) float64 {
+	if len(curve) < 3 {
+		return 0
+	}
+
+	returns := make([]float64, 0, len(curve)-1)
+	for i := 1; i < len(curve); i++ {
+		prev, _ := curve[i-1].Equity.Float64()
+		next, _ := curve[i].Equity.Float64()
+		if prev <= 0 {
+			continue
+		}
+		returns = append(returns, next/prev-1)
+	}
+	if len(returns) < 2 {
+		return 0
+	}
+
+	mean := 0.0
+	for _, r := range returns {
+		mean += r
+	}
+	mean /= float64(len(returns))
+
+	variance := 0.0
+	for _, r := range returns {
+		variance += (r - mean) * (r - mean)
+	}
+	stddev := math.Sqrt(variance / float64(len(returns)-1))
+	if stddev == 0 {
+		return 0
+	}
+
+	return mean / stddev * math.Sqrt(periodsPerYear(interval))
+}
+
+// periodsPerYear 봉 주기별 연간 봉 개수 (분봉은 정규장 기준)
+func periodsPerYear(interval marketdata.Interval) float64 {
+	if !interval.IsIntraday() {
+		return tradingDaysPerYear
+	}
+	return tradingDaysPerYear * float64(sessionMinutes) / float64(interval.Minutes())
+}
+
+// winRate 실현손익이 발생한 매도 체결 중 수익 거래 비율(%)
+func winRate(trades []Trade) float64 {
+	closed, wins := 0, 0
+	for _, trade := range trades {
+		if trade.Side != order.SideSell {
+			continue
+		}
+		closed++
+		if trade.RealizedPnL.IsPositive() {
+			wins++
+		}
+	}
+	if closed == 0 {
+		return 0
+	}
+	return float64(wins) / float64(closed) * 100
+}
@@ -0,0 +1,64 @@
+package backtest
+
+import (
+	"time"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Settings 백테스트 실행 설정
+type Settings struct {
+	InitialCapital decimal.Decimal
+	Fill           order.FillSimulator
+}
+
+// Trade 모의 체결 내역
+type Trade struct {
+	Time          time.Time       `json:"time"`
+	ClientOrderID string          `json:"client_order_id"`
+	Symbol        string          `json:"symbol"`
+	Side          order.Side      `json:"side"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Price         decimal.Decimal `json:"price"`
+	Commission    decimal.Decimal `json:"commission"`
+	RealizedPnL   decimal.Decimal `json:"realized_pnl"` // 매도 체결의 실현손익 (수수료 포함)
+}
+
+// EquityPoint 봉 마감 시점의 평가금액
+type EquityPoint struct {
+	Time     time.Time       `json:"time"`
+	Equity   decimal.Decimal `json:"equity"`
+	Cash     decimal.Decimal `json:"cash"`
+	Drawdown float64         `json:"drawdown"` // 직전 고점 대비 하락률(%)
+}
+
+// Report 백테스트 결과
+type Report struct {
+	From           time.Time
+	To             time.Time
+	InitialCapital decimal.Decimal
+	FinalEquity    decimal.Decimal
+	TotalReturn    float64 // 총 수익률(%)
+	MaxDrawdown    float64 // 최대 낙폭(%)
+	SharpeRatio    float64 // 연율화 샤프 지수 (무위험 수익률 0)
+	WinRate        float64 // 매도 체결 중 수익 거래 비율(%)
+	TradeCount     int
+	RejectedOrders int
+	EquityCurve    []EquityPoint
+	Trades         []Trade
+}
+
+// Run 저장할 백테스트 실행 정보
+type Run struct {
+	StrategyID uuid.UUID
+	UserID     uuid.UUID
+	Symbols    []string
+	Interval   marketdata.Interval
+	Parameters map[string]interface{} // 실행에 사용한 전략 파라미터
+	Settings   map[string]interface{} // 자본/체결 모델 설정
+	Report     *Report
+}
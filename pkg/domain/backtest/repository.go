@@ -0,0 +1,131 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/ent/backtestresult"
+
+	"github.com/google/uuid"
+)
+
+// Repository 백테스트 결과 데이터 접근 인터페이스
+type Repository interface {
+	Create(run *Run) (*ent.BacktestResult, error)
+	GetByID(id uuid.UUID) (*ent.BacktestResult, error)
+	GetByStrategyID(strategyID uuid.UUID, limit, offset int) ([]*ent.BacktestResult, error)
+	CountByStrategyID(strategyID uuid.UUID) (int, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// Create 백테스트 결과 저장
+func (r *EntRepository) Create(run *Run) (*ent.BacktestResult, error) {
+	report := run.Report
+
+	equityCurve, err := json.Marshal(report.EquityCurve)
+	if err != nil {
+		return nil, fmt.Errorf("평가금액 곡선 직렬화 실패: %w", err)
+	}
+	trades, err := json.Marshal(report.Trades)
+	if err != nil {
+		return nil, fmt.Errorf("체결 내역 직렬화 실패: %w", err)
+	}
+
+	result, err := r.client.BacktestResult.Create().
+		SetStrategyID(run.StrategyID).
+		SetUserID(run.UserID).
+		SetSymbols(run.Symbols).
+		SetInterval(string(run.Interval)).
+		SetPeriodStart(report.From).
+		SetPeriodEnd(report.To).
+		SetParameters(run.Parameters).
+		SetSettings(run.Settings).
+		SetInitialCapital(report.InitialCapital).
+		SetFinalEquity(report.FinalEquity).
+		SetTotalReturn(report.TotalReturn).
+		SetMaxDrawdown(report.MaxDrawdown).
+		SetSharpeRatio(report.SharpeRatio).
+		SetWinRate(report.WinRate).
+		SetTradeCount(report.TradeCount).
+		SetRejectedOrders(report.RejectedOrders).
+		SetEquityCurve(equityCurve).
+		SetTrades(trades).
+		Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("백테스트 결과 저장 실패: %w", err)
+	}
+	return result, nil
+}
+
+// GetByID ID로 백테스트 결과 조회
+func (r *EntRepository) GetByID(id uuid.UUID) (*ent.BacktestResult, error) {
+	result, err := r.client.BacktestResult.Get(r.getContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("백테스트 결과 조회 실패: %w", err)
+	}
+	return result, nil
+}
+
+// GetByStrategyID 전략별 백테스트 결과 목록 조회 (최신순, 상세 곡선/체결 내역 제외)
+func (r *EntRepository) GetByStrategyID(strategyID uuid.UUID, limit, offset int) ([]*ent.BacktestResult, error) {
+	results, err := r.client.BacktestResult.Query().
+		Where(backtestresult.StrategyID(strategyID)).
+		Order(ent.Desc(backtestresult.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		Select(
+			backtestresult.FieldID,
+			backtestresult.FieldStrategyID,
+			backtestresult.FieldUserID,
+			backtestresult.FieldSymbols,
+			backtestresult.FieldInterval,
+			backtestresult.FieldPeriodStart,
+			backtestresult.FieldPeriodEnd,
+			backtestresult.FieldParameters,
+			backtestresult.FieldSettings,
+			backtestresult.FieldInitialCapital,
+			backtestresult.FieldFinalEquity,
+			backtestresult.FieldTotalReturn,
+			backtestresult.FieldMaxDrawdown,
+			backtestresult.FieldSharpeRatio,
+			backtestresult.FieldWinRate,
+			backtestresult.FieldTradeCount,
+			backtestresult.FieldRejectedOrders,
+			backtestresult.FieldCreatedAt,
+		).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("백테스트 결과 목록 조회 실패: %w", err)
+	}
+	return results, nil
+}
+
+// CountByStrategyID 전략별 백테스트 결과 수
+func (r *EntRepository) CountByStrategyID(strategyID uuid.UUID) (int, error) {
+	count, err := r.client.BacktestResult.Query().
+		Where(backtestresult.StrategyID(strategyID)).
+		Count(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("백테스트 결과 수 조회 실패: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,359 @@
+package backtest
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/backtest/dto"
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultInitialCapital 초기 자본 미지정 시 기본값 (USD)
+	defaultInitialCapital = 10000
+	// warmupBars 백테스트 시작 전 지표 워밍업에 사용하는 봉 개수
+	warmupBars = 200
+	// backtestFetchTimeout 과거 봉 조회(백필 포함) 제한 시간
+	backtestFetchTimeout = 2 * time.Minute
+)
+
+// Service 백테스트 서비스 인터페이스
+type Service interface {
+	RunBacktest(userID, strategyID string, body dto.RunBacktestBody) (*dto.BacktestResponse, error)
+	GetBacktests(userID, strategyID string, q dto.GetBacktestListQuery) (*dto.BacktestListResponse, error)
+	GetBacktest(userID string, path dto.BacktestPath) (*dto.BacktestResponse, error)
+}
+
+// ServiceImpl 백테스트 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	strategies strategy.Repository
+	marketData marketdata.Service
+	engine     *Engine
+}
+
+// NewService 새로운 백테스트 서비스 생성
+func NewService(repository Repository, strategies strategy.Repository, marketData marketdata.Service, engine *Engine) Service {
+	return &ServiceImpl{
+		repository: repository,
+		strategies: strategies,
+		marketData: marketData,
+		engine:     engine,
+	}
+}
+
+// RunBacktest 전략 설정을 과거 봉에 재생하고 결과 저장
+func (s *ServiceImpl) RunBacktest(userID, strategyID string, body dto.RunBacktestBody) (*dto.BacktestResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	strategyRow, err := s.getOwnedStrategy(uid, strategyID)
+	if err != nil {
+		return nil, err
+	}
+
+	interval, err := marketdata.ParseInterval(body.Interval)
+	if err != nil {
+		return nil, utils.BadRequest(err.Error())
+	}
+	from, to, err := parsePeriod(body.From, body.To)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := buildSettings(body)
+	if err != nil {
+		return nil, err
+	}
+
+	// 요청 파라미터로 저장된 전략 설정 덮어쓰기
+	strategyConfig := strategy.BuildStrategyConfig(strategyRow)
+	for key, value := range body.Parameters {
+		if key == "user_id" {
+			continue
+		}
+		strategyConfig.Parameters[key] = value
+	}
+	if raw, ok := strategyConfig.Parameters["rules"]; ok && raw != nil {
+		if _, err := strategy.ParseRuleSet(raw); err != nil {
+			return nil, err
+		}
+	}
+	symbols, err := configSymbols(strategyConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	bars, err := s.loadBars(symbols, body.Exchange, interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := s.engine.Run(strategyConfig, interval, bars, from, settings)
+	if err != nil {
+		return nil, err
+	}
+
+	saved, err := s.repository.Create(&Run{
+		StrategyID: strategyRow.ID,
+		UserID:     uid,
+		Symbols:    symbols,
+		Interval:   interval,
+		Parameters: strategyConfig.Parameters,
+		Settings:   s.settingsMap(body, symbols, settings),
+		Report:     report,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("🧪 백테스트 완료: %s (수익률 %.2f%%, MDD %.2f%%, 체결 %d건)", strategyRow.Name, report.TotalReturn, report.MaxDrawdown, report.TradeCount)
+	return toBacktestResponse(saved)
+}
+
+// GetBacktests 전략별 백테스트 결과 요약 목록 조회
+func (s *ServiceImpl) GetBacktests(userID, strategyID string, q dto.GetBacktestListQuery) (*dto.BacktestListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	strategyRow, err := s.getOwnedStrategy(uid, strategyID)
+	if err != nil {
+		return nil, err
+	}
+
+	results, err := s.repository.GetByStrategyID(strategyRow.ID, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repository.CountByStrategyID(strategyRow.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.BacktestListResponse{
+		Backtests: make([]*dto.BacktestSummaryResponse, 0, len(results)),
+		Total:     total,
+		Limit:     q.Limit,
+		Offset:    q.Offset,
+	}
+	for _, result := range results {
+		summary := toSummaryResponse(result)
+		response.Backtests = append(response.Backtests, &summary)
+	}
+	return response, nil
+}
+
+// GetBacktest 백테스트 결과 상세 조회
+func (s *ServiceImpl) GetBacktest(userID string, path dto.BacktestPath) (*dto.BacktestResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	strategyRow, err := s.getOwnedStrategy(uid, path.StrategyID)
+	if err != nil {
+		return nil, err
+	}
+
+	id, err := uuid.Parse(path.BacktestID)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 백테스트 ID 형식입니다")
+	}
+	result, err := s.repository.GetByID(id)
+	if err != nil {
+		return nil, err
+	}
+	if result == nil || result.StrategyID != strategyRow.ID {
+		return nil, utils.NotFound("backtest", "백테스트 결과를 찾을 수 없습니다")
+	}
+
+	return toBacktestResponse(result)
+}
+
+// getOwnedStrategy 사용자 소유 전략 조회
+func (s *ServiceImpl) getOwnedStrategy(userID uuid.UUID, strategyID string) (*ent.Strategy, error) {
+	id, err := uuid.Parse(strategyID)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 전략 ID 형식입니다")
+	}
+
+	strategyRow, err := s.strategies.GetByID(id)
+	if err != nil {
+		return nil, fmt.Errorf("전략 조회 실패: %w", err)
+	}
+	if strategyRow == nil || strategyRow.UserID != userID {
+		return nil, utils.NotFound("strategy", "전략을 찾을 수 없습니다")
+	}
+	return strategyRow, nil
+}
+
+// loadBars 종목별 과거 봉 조회 (워밍업 구간 포함, 집계가 끝나지 않은 봉 제외)
+func (s *ServiceImpl) loadBars(symbols []string, exchange string, interval marketdata.Interval, from, to time.Time) ([]marketdata.Bar, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), backtestFetchTimeout)
+	defer cancel()
+
+	now := time.Now()
+	warmupFrom := from.Add(-warmupPeriod(interval))
+
+	var bars []marketdata.Bar
+	for _, symbol := range symbols {
+		symbolBars, err := s.marketData.GetBars(ctx, symbol, exchange, interval, warmupFrom, to)
+		if err != nil {
+			return nil, fmt.Errorf("과거 봉 조회 실패 (%s): %w", symbol, err)
+		}
+		for _, bar := range symbolBars {
+			if bar.Timestamp.Add(interval.Duration()).After(now) {
+				continue
+			}
+			bars = append(bars, bar)
+		}
+	}
+	return bars, nil
+}
+
+// parsePeriod 백테스트 구간 파싱 (종료 시각은 현재 시각을 넘지 않음)
+func parsePeriod(rawFrom, rawTo string) (time.Time, time.Time, error) {
+	from, err := marketdata.ParseTime(rawFrom, false)
+	if err != nil {
+		return time.Time{}, time.Time{}, utils.BadRequest("from은 YYYY-MM-DD 또는 RFC3339 형식이어야 합니다")
+	}
+
+	now := time.Now()
+	to := now
+	if rawTo != "" {
+		if to, err = marketdata.ParseTime(rawTo, true); err != nil {
+			return time.Time{}, time.Time{}, utils.BadRequest("to는 YYYY-MM-DD 또는 RFC3339 형식이어야 합니다")
+		}
+		if to.After(now) {
+			to = now
+		}
+	}
+
+	if !from.Before(to) {
+		return time.Time{}, time.Time{}, utils.BadRequest("시작 시각은 종료 시각보다 이전이어야 합니다")
+	}
+	return from, to, nil
+}
+
+// buildSettings 요청 값을 실행 설정으로 변환
+func buildSettings(body dto.RunBacktestBody) (Settings, error) {
+	if body.InitialCapital < 0 || body.SlippageBps < 0 || body.CommissionRate < 0 || body.MinCommission < 0 {
+		return Settings{}, utils.BadRequest("자본, 슬리피지, 수수료는 0 이상이어야 합니다")
+	}
+	if body.CommissionRate >= 1 {
+		return Settings{}, utils.BadRequest("commission_rate는 비율(예: 0.0025)로 입력해야 합니다")
+	}
+
+	capital := body.InitialCapital
+	if capital == 0 {
+		capital = defaultInitialCapital
+	}
+
+	return Settings{
+		InitialCapital: decimal.NewFromFloat(capital),
+		Fill: order.FillSimulator{
+			Model:          order.FillModel(body.FillModel),
+			SlippageBps:    decimal.NewFromFloat(body.SlippageBps),
+			CommissionRate: decimal.NewFromFloat(body.CommissionRate),
+			MinCommission:  decimal.NewFromFloat(body.MinCommission),
+		},
+	}, nil
+}
+
+// settingsMap 결과 비교용으로 저장할 실행 설정 (거래소는 종목별로 실제 조회한 코드)
+func (s *ServiceImpl) settingsMap(body dto.RunBacktestBody, symbols []string, settings Settings) map[string]interface{} {
+	exchanges := make(map[string]interface{}, len(symbols))
+	for _, symbol := range symbols {
+		exchanges[symbol] = s.marketData.ResolveExchange(symbol, body.Exchange)
+	}
+
+	capital, _ := settings.InitialCapital.Float64()
+	return map[string]interface{}{
+		"exchanges":       exchanges,
+		"initial_capital": capital,
+		"slippage_bps":    body.SlippageBps,
+		"commission_rate": body.CommissionRate,
+		"min_commission":  body.MinCommission,
+		"fill_model":      string(settings.Fill.Model),
+	}
+}
+
+// configSymbols 전략 설정의 대상 종목 목록
+func configSymbols(strategyConfig *strategy.StrategyConfig) ([]string, error) {
+	raw, _ := strategyConfig.Parameters["symbols"].([]interface{})
+
+	symbols := make([]string, 0, len(raw))
+	for _, value := range raw {
+		symbol, ok := value.(string)
+		if !ok || symbol == "" {
+			return nil, utils.BadRequest("symbols는 종목 코드 문자열 배열이어야 합니다")
+		}
+		symbols = append(symbols, symbol)
+	}
+	if len(symbols) == 0 {
+		return nil, utils.BadRequest("백테스트할 종목이 없습니다")
+	}
+	return symbols, nil
+}
+
+// warmupPeriod 워밍업 봉 개수를 채우는 데 필요한 달력 기간 (주말/장외 시간 고려)
+func warmupPeriod(interval marketdata.Interval) time.Duration {
+	tradingDays := warmupBars
+	if interval.IsIntraday() {
+		tradingDays = warmupBars*interval.Minutes()/sessionMinutes + 1
+	}
+	calendarDays := tradingDays*7/5 + 3
+	return time.Duration(calendarDays) * 24 * time.Hour
+}
+
+func toSummaryResponse(result *ent.BacktestResult) dto.BacktestSummaryResponse {
+	return dto.BacktestSummaryResponse{
+		ID:             result.ID,
+		StrategyID:     result.StrategyID,
+		Symbols:        result.Symbols,
+		Interval:       result.Interval,
+		From:           result.PeriodStart,
+		To:             result.PeriodEnd,
+		Parameters:     result.Parameters,
+		Settings:       result.Settings,
+		InitialCapital: result.InitialCapital,
+		FinalEquity:    result.FinalEquity,
+		TotalReturn:    result.TotalReturn,
+		MaxDrawdown:    result.MaxDrawdown,
+		SharpeRatio:    result.SharpeRatio,
+		WinRate:        result.WinRate,
+		TradeCount:     result.TradeCount,
+		RejectedOrders: result.RejectedOrders,
+		CreatedAt:      result.CreatedAt,
+	}
+}
+
+func toBacktestResponse(result *ent.BacktestResult) (*dto.BacktestResponse, error) {
+	response := &dto.BacktestResponse{
+		BacktestSummaryResponse: toSummaryResponse(result),
+		EquityCurve:             []dto.EquityPointResponse{},
+		Trades:                  []dto.TradeResponse{},
+	}
+
+	if len(result.EquityCurve) > 0 {
+		if err := json.Unmarshal(result.EquityCurve, &response.EquityCurve); err != nil {
+			return nil, fmt.Errorf("평가금액 곡선 파싱 실패: %w", err)
+		}
+	}
+	if len(result.Trades) > 0 {
+		if err := json.Unmarshal(result.Trades, &response.Trades); err != nil {
+			return nil, fmt.Errorf("체결 내역 파싱 실패: %w", err)
+		}
+	}
+	return response, nil
+}
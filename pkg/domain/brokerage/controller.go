@@ -0,0 +1,116 @@
+package brokerage
+
+import (
+	"auto-trader/pkg/domain/brokerage/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 증권 계좌 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 증권 계좌 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// LinkAccount 증권 계좌 연결
+// @Summary 증권 계좌 연결
+// @Description 한국투자증권 계좌번호, 앱키/시크릿을 등록합니다 (자격 증명은 암호화 저장, 사용자당 1개)
+// @Tags brokerage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account body dto.LinkAccountBody true "계좌 정보"
+// @Success 201 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /brokerage/account [post]
+func (ctrl *Controller) LinkAccount(c *fiber.Ctx) error {
+	var req dto.LinkAccountBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	account, err := ctrl.service.LinkAccount(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "증권 계좌 연결 실패")
+	}
+
+	return utils.SuccessResponse(c, account, fiber.StatusCreated)
+}
+
+// GetAccount 연결된 증권 계좌 조회
+// @Summary 연결된 증권 계좌 조회
+// @Description 연결된 계좌 정보를 조회합니다 (계좌번호/앱키는 끝 4자리만 표시)
+// @Tags brokerage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /brokerage/account [get]
+func (ctrl *Controller) GetAccount(c *fiber.Ctx) error {
+	account, err := ctrl.service.GetAccount(utils.GetUserID(c))
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "증권 계좌 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, account)
+}
+
+// UpdateAccount 증권 계좌 수정
+// @Summary 증권 계좌 수정
+// @Description 계좌번호, 상품코드, 앱키/시크릿(함께 변경), 모의투자 여부를 수정합니다
+// @Tags brokerage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account body dto.UpdateAccountBody true "수정할 항목"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /brokerage/account [put]
+func (ctrl *Controller) UpdateAccount(c *fiber.Ctx) error {
+	var req dto.UpdateAccountBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+
+	account, err := ctrl.service.UpdateAccount(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "증권 계좌 수정 실패")
+	}
+
+	return utils.SuccessResponse(c, account)
+}
+
+// UnlinkAccount 증권 계좌 연결 해제
+// @Summary 증권 계좌 연결 해제
+// @Description 저장된 계좌 정보와 자격 증명을 삭제합니다
+// @Tags brokerage
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /brokerage/account [delete]
+func (ctrl *Controller) UnlinkAccount(c *fiber.Ctx) error {
+	if err := ctrl.service.UnlinkAccount(utils.GetUserID(c)); err != nil {
+		return utils.CommonErrorResponse(c, err, "증권 계좌 연결 해제 실패")
+	}
+
+	return utils.SuccessResponse(c, fiber.Map{"message": "증권 계좌 연결이 해제되었습니다"})
+}
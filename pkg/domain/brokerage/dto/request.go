@@ -0,0 +1,21 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// LinkAccountBody 증권 계좌 연결 요청
+type LinkAccountBody struct {
+	AccountNo   string `json:"account_no" validate:"required,min=8,max=8"` // 종합계좌번호 앞 8자리
+	ProductCode string `json:"product_code" validate:"max=2"`              // 계좌상품코드 (기본 01)
+	AppKey      string `json:"app_key" validate:"required,max=100"`
+	AppSecret   string `json:"app_secret" validate:"required,max=400"`
+	IsDemo      bool   `json:"is_demo"` // 모의투자 계좌 여부
+}
+
+// UpdateAccountBody 증권 계좌 수정 요청 (지정한 항목만 변경, 앱키/시크릿은 함께 변경)
+type UpdateAccountBody struct {
+	AccountNo   *string `json:"account_no,omitempty"`
+	ProductCode *string `json:"product_code,omitempty"`
+	AppKey      *string `json:"app_key,omitempty"`
+	AppSecret   *string `json:"app_secret,omitempty"`
+	IsDemo      *bool   `json:"is_demo,omitempty"`
+}
@@ -0,0 +1,17 @@
+package dto
+
+import (
+	"time"
+)
+
+// AccountResponse 증권 계좌 응답 데이터 (자격 증명은 마스킹)
+type AccountResponse struct {
+	ID          string     `json:"id"`
+	Broker      string     `json:"broker"`
+	AccountNo   string     `json:"account_no"` // 뒤 4자리만 표시
+	ProductCode string     `json:"product_code"`
+	AppKeyHint  string     `json:"app_key_hint"` // 앱키 뒤 4자리
+	IsDemo      bool       `json:"is_demo"`
+	CreatedAt   *time.Time `json:"created_at,omitempty"`
+	UpdatedAt   *time.Time `json:"updated_at,omitempty"`
+}
@@ -0,0 +1,33 @@
+package brokerage
+
+import (
+	"github.com/google/uuid"
+)
+
+// Credentials 복호화된 증권 계좌 자격 증명 (메모리에서만 사용, 응답/로그에 노출 금지)
+type Credentials struct {
+	AccountID   uuid.UUID
+	UserID      uuid.UUID
+	AccountNo   string // 종합계좌번호 (CANO, 8자리)
+	ProductCode string // 계좌상품코드 (2자리)
+	AppKey      string
+	AppSecret   string
+	IsDemo      bool
+}
+
+// AccountInput 저장할 계좌 정보
+type AccountInput struct {
+	AccountNo   string
+	ProductCode string
+	IsDemo      bool
+	Sealed      Sealed
+}
+
+// Sealed 암호화된 자격 증명 (저장용)
+type Sealed struct {
+	AppKey     []byte
+	AppSecret  []byte
+	DataKey    []byte // 마스터 키로 암호화된 데이터 키
+	KeyID      string
+	AppKeyHint string
+}
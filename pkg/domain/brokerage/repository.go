@@ -0,0 +1,110 @@
+package brokerage
+
+import (
+	"context"
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/ent/brokeraccount"
+
+	"github.com/google/uuid"
+)
+
+// Repository 증권 계좌 데이터 접근 인터페이스
+type Repository interface {
+	Create(userID uuid.UUID, input AccountInput) (*ent.BrokerAccount, error)
+	GetByUserID(userID uuid.UUID) (*ent.BrokerAccount, error)
+	Update(id uuid.UUID, input AccountInput) (*ent.BrokerAccount, error)
+	Delete(id uuid.UUID) error
+	GetUserIDs() ([]uuid.UUID, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// Create 증권 계좌 생성
+func (r *EntRepository) Create(userID uuid.UUID, input AccountInput) (*ent.BrokerAccount, error) {
+	account, err := r.client.BrokerAccount.Create().
+		SetUserID(userID).
+		SetAccountNo(input.AccountNo).
+		SetProductCode(input.ProductCode).
+		SetIsDemo(input.IsDemo).
+		SetAppKeyCipher(input.Sealed.AppKey).
+		SetAppSecretCipher(input.Sealed.AppSecret).
+		SetDataKey(input.Sealed.DataKey).
+		SetKeyID(input.Sealed.KeyID).
+		SetAppKeyHint(input.Sealed.AppKeyHint).
+		Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create broker account: %w", err)
+	}
+	return account, nil
+}
+
+// GetByUserID 사용자 증권 계좌 조회
+func (r *EntRepository) GetByUserID(userID uuid.UUID) (*ent.BrokerAccount, error) {
+	account, err := r.client.BrokerAccount.Query().
+		Where(brokeraccount.UserID(userID)).
+		Only(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get broker account by user: %w", err)
+	}
+	return account, nil
+}
+
+// Update 증권 계좌 정보 교체
+func (r *EntRepository) Update(id uuid.UUID, input AccountInput) (*ent.BrokerAccount, error) {
+	account, err := r.client.BrokerAccount.UpdateOneID(id).
+		SetAccountNo(input.AccountNo).
+		SetProductCode(input.ProductCode).
+		SetIsDemo(input.IsDemo).
+		SetAppKeyCipher(input.Sealed.AppKey).
+		SetAppSecretCipher(input.Sealed.AppSecret).
+		SetDataKey(input.Sealed.DataKey).
+		SetKeyID(input.Sealed.KeyID).
+		SetAppKeyHint(input.Sealed.AppKeyHint).
+		Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update broker account: %w", err)
+	}
+	return account, nil
+}
+
+// Delete 증권 계좌 삭제
+func (r *EntRepository) Delete(id uuid.UUID) error {
+	if err := r.client.BrokerAccount.DeleteOneID(id).Exec(r.getContext()); err != nil {
+		return fmt.Errorf("failed to delete broker account: %w", err)
+	}
+	return nil
+}
+
+// GetUserIDs 계좌를 연결한 사용자 ID 목록
+func (r *EntRepository) GetUserIDs() ([]uuid.UUID, error) {
+	accounts, err := r.client.BrokerAccount.Query().
+		Select(brokeraccount.FieldUserID).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get broker account users: %w", err)
+	}
+
+	userIDs := make([]uuid.UUID, len(accounts))
+	for i, account := range accounts {
+		userIDs[i] = account.UserID
+	}
+	return userIDs, nil
+}
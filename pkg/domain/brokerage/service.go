@@ -0,0 +1,331 @@
+package brokerage
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/brokerage/dto"
+	"auto-trader/pkg/shared/envelope"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultProductCode 계좌상품코드 기본값 (종합계좌)
+const defaultProductCode = "01"
+
+// Service 증권 계좌 연결 서비스 인터페이스
+type Service interface {
+	// 계좌 관리
+	LinkAccount(userID string, body dto.LinkAccountBody) (*dto.AccountResponse, error)
+	GetAccount(userID string) (*dto.AccountResponse, error)
+	UpdateAccount(userID string, body dto.UpdateAccountBody) (*dto.AccountResponse, error)
+	UnlinkAccount(userID string) error
+
+	// 자격 증명 조회 (KIS 호출용)
+	Credentials(userID string) (*Credentials, error)
+	LinkedUserIDs() ([]uuid.UUID, error)
+}
+
+// ServiceImpl 증권 계좌 연결 서비스 구현체
+// 앱키/시크릿은 봉투 암호화로 저장하고, 복호화한 자격 증명은 주문마다 복호화하지 않도록 메모리에 캐시한다.
+type ServiceImpl struct {
+	repository Repository
+	sealer     *envelope.Sealer
+
+	mu    sync.RWMutex
+	cache map[uuid.UUID]*Credentials
+}
+
+// NewService 새로운 증권 계좌 서비스 생성 (sealer가 nil이면 계좌 연결/조회 불가)
+func NewService(repository Repository, sealer *envelope.Sealer) Service {
+	return &ServiceImpl{
+		repository: repository,
+		sealer:     sealer,
+		cache:      make(map[uuid.UUID]*Credentials),
+	}
+}
+
+// LinkAccount 사용자 증권 계좌 연결 (사용자당 1개)
+func (s *ServiceImpl) LinkAccount(userID string, body dto.LinkAccountBody) (*dto.AccountResponse, error) {
+	userUUID, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := s.repository.GetByUserID(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 조회 실패: %w", err)
+	}
+	if existing != nil {
+		return nil, utils.Conflict("account", "이미 연결된 증권 계좌가 있습니다")
+	}
+
+	creds := Credentials{
+		UserID:      userUUID,
+		AccountNo:   strings.TrimSpace(body.AccountNo),
+		ProductCode: strings.TrimSpace(body.ProductCode),
+		AppKey:      strings.TrimSpace(body.AppKey),
+		AppSecret:   strings.TrimSpace(body.AppSecret),
+		IsDemo:      body.IsDemo,
+	}
+	input, err := s.seal(creds)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.repository.Create(userUUID, *input)
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 연결 실패: %w", err)
+	}
+	s.forget(userUUID)
+
+	logrus.Infof("🔐 증권 계좌 연결 (%s): ****%s", userUUID, lastDigits(account.AccountNo))
+	return toResponse(account), nil
+}
+
+// GetAccount 연결된 증권 계좌 조회 (자격 증명은 마스킹)
+func (s *ServiceImpl) GetAccount(userID string) (*dto.AccountResponse, error) {
+	account, err := s.getOwnedAccount(userID)
+	if err != nil {
+		return nil, err
+	}
+	return toResponse(account), nil
+}
+
+// UpdateAccount 연결된 증권 계좌 수정 (자격 증명은 새 데이터 키로 다시 암호화)
+func (s *ServiceImpl) UpdateAccount(userID string, body dto.UpdateAccountBody) (*dto.AccountResponse, error) {
+	account, err := s.getOwnedAccount(userID)
+	if err != nil {
+		return nil, err
+	}
+	if (body.AppKey == nil) != (body.AppSecret == nil) {
+		return nil, utils.BadRequest("앱키와 앱시크릿은 함께 변경해야 합니다")
+	}
+
+	creds, err := s.open(account)
+	if err != nil {
+		return nil, err
+	}
+	if body.AccountNo != nil {
+		creds.AccountNo = strings.TrimSpace(*body.AccountNo)
+	}
+	if body.ProductCode != nil {
+		creds.ProductCode = strings.TrimSpace(*body.ProductCode)
+	}
+	if body.AppKey != nil {
+		creds.AppKey = strings.TrimSpace(*body.AppKey)
+		creds.AppSecret = strings.TrimSpace(*body.AppSecret)
+	}
+	if body.IsDemo != nil {
+		creds.IsDemo = *body.IsDemo
+	}
+
+	input, err := s.seal(*creds)
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repository.Update(account.ID, *input)
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 수정 실패: %w", err)
+	}
+	s.forget(account.UserID)
+
+	logrus.Infof("🔐 증권 계좌 수정 (%s): ****%s", account.UserID, lastDigits(updated.AccountNo))
+	return toResponse(updated), nil
+}
+
+// UnlinkAccount 증권 계좌 연결 해제 (저장된 자격 증명 삭제)
+func (s *ServiceImpl) UnlinkAccount(userID string) error {
+	account, err := s.getOwnedAccount(userID)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(account.ID); err != nil {
+		return fmt.Errorf("증권 계좌 연결 해제 실패: %w", err)
+	}
+	s.forget(account.UserID)
+
+	logrus.Infof("🔓 증권 계좌 연결 해제 (%s)", account.UserID)
+	return nil
+}
+
+// Credentials 사용자 계좌 자격 증명 복호화 (연결된 계좌가 없으면 NotFound)
+func (s *ServiceImpl) Credentials(userID string) (*Credentials, error) {
+	userUUID, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.RLock()
+	cached, ok := s.cache[userUUID]
+	s.mu.RUnlock()
+	if ok {
+		creds := *cached
+		return &creds, nil
+	}
+
+	account, err := s.repository.GetByUserID(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 조회 실패: %w", err)
+	}
+	if account == nil {
+		return nil, utils.NotFound("account", "연결된 증권 계좌가 없습니다")
+	}
+
+	creds, err := s.open(account)
+	if err != nil {
+		return nil, err
+	}
+
+	s.mu.Lock()
+	s.cache[userUUID] = creds
+	s.mu.Unlock()
+
+	copied := *creds
+	return &copied, nil
+}
+
+// LinkedUserIDs 증권 계좌를 연결한 사용자 목록
+func (s *ServiceImpl) LinkedUserIDs() ([]uuid.UUID, error) {
+	userIDs, err := s.repository.GetUserIDs()
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 사용자 조회 실패: %w", err)
+	}
+	return userIDs, nil
+}
+
+func (s *ServiceImpl) getOwnedAccount(userID string) (*ent.BrokerAccount, error) {
+	userUUID, err := parseUserID(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := s.repository.GetByUserID(userUUID)
+	if err != nil {
+		return nil, fmt.Errorf("증권 계좌 조회 실패: %w", err)
+	}
+	if account == nil {
+		return nil, utils.NotFound("account", "연결된 증권 계좌가 없습니다")
+	}
+	return account, nil
+}
+
+// seal 자격 증명 검증 후 암호화 (사용자 ID를 AAD로 묶어 다른 계좌 행으로 옮겨도 복호화되지 않음)
+func (s *ServiceImpl) seal(creds Credentials) (*AccountInput, error) {
+	if s.sealer == nil {
+		return nil, utils.Internal("security.master_key가 설정되지 않아 증권 계좌를 저장할 수 없습니다", nil)
+	}
+	if creds.ProductCode == "" {
+		creds.ProductCode = defaultProductCode
+	}
+	if !isDigits(creds.AccountNo, 8) {
+		return nil, utils.BadRequest("계좌번호는 숫자 8자리여야 합니다")
+	}
+	if !isDigits(creds.ProductCode, 2) {
+		return nil, utils.BadRequest("계좌상품코드는 숫자 2자리여야 합니다")
+	}
+	if creds.AppKey == "" || creds.AppSecret == "" {
+		return nil, utils.BadRequest("앱키와 앱시크릿을 입력해주세요")
+	}
+
+	sealed, err := s.sealer.Seal(creds.UserID[:], []byte(creds.AppKey), []byte(creds.AppSecret))
+	if err != nil {
+		return nil, utils.Internal("자격 증명 암호화 실패", err)
+	}
+
+	return &AccountInput{
+		AccountNo:   creds.AccountNo,
+		ProductCode: creds.ProductCode,
+		IsDemo:      creds.IsDemo,
+		Sealed: Sealed{
+			AppKey:     sealed.Ciphertexts[0],
+			AppSecret:  sealed.Ciphertexts[1],
+			DataKey:    sealed.DataKey,
+			KeyID:      sealed.KeyID,
+			AppKeyHint: lastDigits(creds.AppKey),
+		},
+	}, nil
+}
+
+// open 저장된 자격 증명 복호화
+func (s *ServiceImpl) open(account *ent.BrokerAccount) (*Credentials, error) {
+	if s.sealer == nil {
+		return nil, utils.Internal("security.master_key가 설정되지 않아 증권 계좌를 사용할 수 없습니다", nil)
+	}
+
+	plaintexts, err := s.sealer.Open(account.UserID[:], &envelope.Envelope{
+		KeyID:       account.KeyID,
+		DataKey:     account.DataKey,
+		Ciphertexts: [][]byte{account.AppKeyCipher, account.AppSecretCipher},
+	})
+	if err != nil {
+		if errors.Is(err, envelope.ErrKeyMismatch) {
+			return nil, utils.Internal("마스터 키가 변경되어 증권 계좌를 복호화할 수 없습니다 (계좌를 다시 연결해주세요)", err)
+		}
+		return nil, utils.Internal("자격 증명 복호화 실패", err)
+	}
+
+	return &Credentials{
+		AccountID:   account.ID,
+		UserID:      account.UserID,
+		AccountNo:   account.AccountNo,
+		ProductCode: account.ProductCode,
+		AppKey:      string(plaintexts[0]),
+		AppSecret:   string(plaintexts[1]),
+		IsDemo:      account.IsDemo,
+	}, nil
+}
+
+func (s *ServiceImpl) forget(userID uuid.UUID) {
+	s.mu.Lock()
+	delete(s.cache, userID)
+	s.mu.Unlock()
+}
+
+func parseUserID(userID string) (uuid.UUID, error) {
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, utils.BadRequest("잘못된 사용자 ID 형식입니다")
+	}
+	return userUUID, nil
+}
+
+func isDigits(value string, length int) bool {
+	if len(value) != length {
+		return false
+	}
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// lastDigits 마스킹 표시용 끝 4자리
+func lastDigits(value string) string {
+	if len(value) <= 4 {
+		return value
+	}
+	return value[len(value)-4:]
+}
+
+func toResponse(account *ent.BrokerAccount) *dto.AccountResponse {
+	return &dto.AccountResponse{
+		ID:          account.ID.String(),
+		Broker:      string(account.Broker),
+		AccountNo:   "****" + lastDigits(account.AccountNo),
+		ProductCode: account.ProductCode,
+		AppKeyHint:  account.AppKeyHint,
+		IsDemo:      account.IsDemo,
+		CreatedAt:   account.CreatedAt,
+		UpdatedAt:   account.UpdatedAt,
+	}
+}
@@ -0,0 +1,80 @@
+package market
+
+import (
+	"auto-trader/pkg/domain/market/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 장 운영 시간 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 장 운영 시간 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// GetClock 장 운영 상태 조회
+// @Summary 장 운영 상태 조회
+// @Description 현재(또는 기준 시각) 거래 세션과 지정 세션의 다음 개장/폐장 시각을 조회합니다
+// @Tags market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param exchange query string false "거래소 (NYSE, NASDAQ, AMEX)"
+// @Param sessions query string false "쉼표 구분 세션 (pre, regular, post, 기본 regular)"
+// @Param at query string false "기준 시각 (RFC3339)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Router /market/clock [get]
+func (ctrl *Controller) GetClock(c *fiber.Ctx) error {
+	var q dto.GetClockQuery
+	q.Exchange = c.Query("exchange")
+	q.Sessions = c.Query("sessions")
+	q.At = c.Query("at")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	clock, err := ctrl.service.GetClock(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "장 운영 상태 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, clock)
+}
+
+// GetCalendar 거래 일정 조회
+// @Summary 거래 일정 조회
+// @Description 기간별 거래일, 휴장일, 조기 폐장일과 세션 시각(미국 동부 시간)을 조회합니다
+// @Tags market
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param exchange query string false "거래소 (NYSE, NASDAQ, AMEX)"
+// @Param from query string false "시작일 (YYYY-MM-DD, 기본 오늘)"
+// @Param to query string false "종료일 (YYYY-MM-DD, 기본 시작일 + 30일, 최대 366일)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Router /market/calendar [get]
+func (ctrl *Controller) GetCalendar(c *fiber.Ctx) error {
+	var q dto.GetCalendarQuery
+	q.Exchange = c.Query("exchange")
+	q.From = c.Query("from")
+	q.To = c.Query("to")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	days, err := ctrl.service.GetCalendar(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "거래 일정 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, days)
+}
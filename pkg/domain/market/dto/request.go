@@ -0,0 +1,17 @@
+package dto
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetClockQuery 장 운영 상태 조회 쿼리 파라미터
+type GetClockQuery struct {
+	Exchange string `query:"exchange,omitempty" validate:"max=10"` // NYSE, NASDAQ, AMEX (같은 거래 일정)
+	Sessions string `query:"sessions,omitempty" validate:"max=50"` // 쉼표 구분 세션 (pre, regular, post, 기본 regular)
+	At       string `query:"at,omitempty"`                         // 기준 시각 (RFC3339, 기본 현재)
+}
+
+// GetCalendarQuery 거래 일정 조회 쿼리 파라미터
+type GetCalendarQuery struct {
+	Exchange string `query:"exchange,omitempty" validate:"max=10"` // NYSE, NASDAQ, AMEX (같은 거래 일정)
+	From     string `query:"from,omitempty"`                       // 시작일 (YYYY-MM-DD, 기본 오늘)
+	To       string `query:"to,omitempty"`                         // 종료일 (YYYY-MM-DD, 기본 시작일 + 30일)
+}
@@ -0,0 +1,33 @@
+package dto
+
+import "time"
+
+// ClockResponse 장 운영 상태 응답 데이터
+type ClockResponse struct {
+	Exchange  string    `json:"exchange"`
+	Timestamp time.Time `json:"timestamp"`
+	Session   string    `json:"session"`  // 현재 세션 (pre, regular, post, closed)
+	Sessions  []string  `json:"sessions"` // next_open/next_close 기준 세션
+	IsOpen    bool      `json:"is_open"`  // 기준 세션 중 하나가 진행 중인지
+	NextOpen  time.Time `json:"next_open"`
+	NextClose time.Time `json:"next_close"`
+}
+
+// DayResponse 하루 거래 일정 응답 데이터 (휴장일이면 세션 시각 없음)
+type DayResponse struct {
+	Date         string     `json:"date"`
+	TradingDay   bool       `json:"trading_day"`
+	Holiday      string     `json:"holiday,omitempty"`
+	EarlyClose   bool       `json:"early_close"`
+	PreOpen      *time.Time `json:"pre_open,omitempty"`
+	RegularOpen  *time.Time `json:"regular_open,omitempty"`
+	RegularClose *time.Time `json:"regular_close,omitempty"`
+	PostClose    *time.Time `json:"post_close,omitempty"`
+}
+
+// CalendarResponse 거래 일정 응답 데이터
+type CalendarResponse struct {
+	Exchange string         `json:"exchange"`
+	Timezone string         `json:"timezone"`
+	Days     []*DayResponse `json:"days"`
+}
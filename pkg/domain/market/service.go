@@ -0,0 +1,147 @@
+package market
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"auto-trader/pkg/domain/market/dto"
+	"auto-trader/pkg/shared/calendar"
+	"auto-trader/pkg/shared/utils"
+)
+
+const (
+	// defaultCalendarDays 종료일 미지정 시 조회 기간
+	defaultCalendarDays = 30
+	// maxCalendarDays 한 번에 조회할 수 있는 최대 기간
+	maxCalendarDays = 366
+)
+
+// Service 장 운영 시간 서비스 인터페이스
+type Service interface {
+	GetClock(q dto.GetClockQuery) (*dto.ClockResponse, error)
+	GetCalendar(q dto.GetCalendarQuery) (*dto.CalendarResponse, error)
+}
+
+// ServiceImpl 장 운영 시간 서비스 구현체
+type ServiceImpl struct {
+	calendar *calendar.Calendar
+}
+
+// NewService 새로운 장 운영 시간 서비스 생성
+func NewService(calendar *calendar.Calendar) Service {
+	return &ServiceImpl{
+		calendar: calendar,
+	}
+}
+
+// GetClock 기준 시각의 세션과 다음 개장/폐장 시각 조회
+func (s *ServiceImpl) GetClock(q dto.GetClockQuery) (*dto.ClockResponse, error) {
+	exchange, err := exchangeOf(q.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	if q.At != "" {
+		at, err := time.Parse(time.RFC3339, q.At)
+		if err != nil {
+			return nil, utils.BadRequest(fmt.Sprintf("잘못된 기준 시각: %q (RFC3339)", q.At))
+		}
+		now = at
+	}
+
+	var raw []string
+	if q.Sessions != "" {
+		raw = strings.Split(q.Sessions, ",")
+	}
+	sessions, err := calendar.ParseSessions(raw)
+	if err != nil {
+		return nil, utils.BadRequest(err.Error())
+	}
+
+	names := make([]string, len(sessions))
+	for i, session := range sessions {
+		names[i] = string(session)
+	}
+
+	return &dto.ClockResponse{
+		Exchange:  exchange,
+		Timestamp: now.In(s.calendar.Location()),
+		Session:   string(s.calendar.SessionAt(now)),
+		Sessions:  names,
+		IsOpen:    s.calendar.IsOpen(now, sessions...),
+		NextOpen:  s.calendar.NextOpen(now, sessions...),
+		NextClose: s.calendar.NextClose(now, sessions...),
+	}, nil
+}
+
+// GetCalendar 기간별 거래일/휴장일/조기 폐장 일정 조회
+func (s *ServiceImpl) GetCalendar(q dto.GetCalendarQuery) (*dto.CalendarResponse, error) {
+	exchange, err := exchangeOf(q.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	location := s.calendar.Location()
+
+	from := time.Now().In(location)
+	if q.From != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", q.From, location)
+		if err != nil {
+			return nil, utils.BadRequest(fmt.Sprintf("잘못된 시작일: %q (YYYY-MM-DD)", q.From))
+		}
+		from = parsed
+	}
+	to := from.AddDate(0, 0, defaultCalendarDays)
+	if q.To != "" {
+		parsed, err := time.ParseInLocation("2006-01-02", q.To, location)
+		if err != nil {
+			return nil, utils.BadRequest(fmt.Sprintf("잘못된 종료일: %q (YYYY-MM-DD)", q.To))
+		}
+		to = parsed
+	}
+	if to.Before(from) {
+		return nil, utils.BadRequest("종료일은 시작일 이후여야 합니다")
+	}
+	if to.Sub(from) > maxCalendarDays*24*time.Hour {
+		return nil, utils.BadRequest(fmt.Sprintf("조회 기간은 최대 %d일입니다", maxCalendarDays))
+	}
+
+	days := s.calendar.Days(from, to)
+	response := &dto.CalendarResponse{
+		Exchange: exchange,
+		Timezone: location.String(),
+		Days:     make([]*dto.DayResponse, len(days)),
+	}
+	for i, day := range days {
+		response.Days[i] = toDayResponse(day)
+	}
+	return response, nil
+}
+
+func toDayResponse(day calendar.Day) *dto.DayResponse {
+	response := &dto.DayResponse{
+		Date:       day.Date,
+		TradingDay: day.TradingDay,
+		Holiday:    day.Holiday,
+		EarlyClose: day.EarlyClose,
+	}
+	if day.TradingDay {
+		response.PreOpen = &day.PreOpen
+		response.RegularOpen = &day.RegularOpen
+		response.RegularClose = &day.RegularClose
+		response.PostClose = &day.PostClose
+	}
+	return response
+}
+
+// exchangeOf 거래소 확인 (미지정 시 NYSE, NYSE/NASDAQ/AMEX는 같은 일정)
+func exchangeOf(exchange string) (string, error) {
+	switch strings.ToUpper(exchange) {
+	case "":
+		return calendar.ExchangeNYSE, nil
+	case calendar.ExchangeNYSE, calendar.ExchangeNASDAQ, calendar.ExchangeAMEX:
+		return strings.ToUpper(exchange), nil
+	}
+	return "", utils.BadRequest(fmt.Sprintf("지원하지 않는 거래소: %q (NYSE, NASDAQ, AMEX)", exchange))
+}
@@ -0,0 +1,141 @@
+package marketdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/pkg/shared/indicator"
+
+	"github.com/shopspring/decimal"
+)
+
+// DefaultExchange 거래소를 알 수 없을 때 사용하는 시세 거래소코드
+const DefaultExchange = "NAS"
+
+// ExchangeResolver 종목의 시세 거래소코드 (NAS, NYS, AMS) 조회 (알 수 없으면 빈 값)
+type ExchangeResolver interface {
+	QuoteExchange(symbol string) string
+}
+
+// Interval 봉 주기
+type Interval string
+
+const (
+	Interval1m  Interval = "1m"
+	Interval5m  Interval = "5m"
+	Interval15m Interval = "15m"
+	Interval30m Interval = "30m"
+	Interval1h  Interval = "1h"
+	Interval1d  Interval = "1d"
+)
+
+var intervalDurations = map[Interval]time.Duration{
+	Interval1m:  time.Minute,
+	Interval5m:  5 * time.Minute,
+	Interval15m: 15 * time.Minute,
+	Interval30m: 30 * time.Minute,
+	Interval1h:  time.Hour,
+	Interval1d:  24 * time.Hour,
+}
+
+// ParseInterval 문자열을 봉 주기로 변환
+func ParseInterval(raw string) (Interval, error) {
+	interval := Interval(raw)
+	if _, ok := intervalDurations[interval]; !ok {
+		return "", fmt.Errorf("지원하지 않는 봉 주기: %s", raw)
+	}
+	return interval, nil
+}
+
+// IntervalFromDuration 기간에 해당하는 봉 주기
+func IntervalFromDuration(d time.Duration) (Interval, bool) {
+	for interval, duration := range intervalDurations {
+		if duration == d {
+			return interval, true
+		}
+	}
+	return "", false
+}
+
+// Duration 봉 하나의 기간
+func (i Interval) Duration() time.Duration {
+	return intervalDurations[i]
+}
+
+// IsIntraday 분봉 여부
+func (i Interval) IsIntraday() bool {
+	return i != Interval1d
+}
+
+// Minutes 분봉 주기 (분 단위)
+func (i Interval) Minutes() int {
+	return int(i.Duration() / time.Minute)
+}
+
+// Bar OHLCV 봉
+type Bar struct {
+	Symbol    string
+	Exchange  string
+	Interval  Interval
+	Timestamp time.Time // 봉 시작 시각 (일봉은 현지 날짜 0시)
+	Open      decimal.Decimal
+	High      decimal.Decimal
+	Low       decimal.Decimal
+	Close     decimal.Decimal
+	Volume    int64
+}
+
+// ToCandle 지표 계산용 봉으로 변환
+func (b Bar) ToCandle() indicator.Candle {
+	open, _ := b.Open.Float64()
+	high, _ := b.High.Float64()
+	low, _ := b.Low.Float64()
+	closePrice, _ := b.Close.Float64()
+
+	return indicator.Candle{
+		Time:   b.Timestamp,
+		Open:   open,
+		High:   high,
+		Low:    low,
+		Close:  closePrice,
+		Volume: float64(b.Volume),
+	}
+}
+
+// Gap 저장된 봉 사이의 누락 구간 [From, To)
+type Gap struct {
+	From time.Time
+	To   time.Time
+}
+
+// Fetcher 외부 차트 데이터 조회 인터페이스 (from~to 구간의 봉을 반환)
+type Fetcher interface {
+	FetchBars(ctx context.Context, symbol, exchange string, interval Interval, from, to time.Time) ([]Bar, error)
+}
+
+// MarketLocation 미국 시장 시간대 (일봉 날짜와 세션 구분 기준)
+func MarketLocation() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// ParseTime 날짜(YYYY-MM-DD, 미국 동부 기준) 또는 RFC3339 시각 파싱
+// 종료일로 쓰인 날짜는 그날의 마지막 시각으로 변환한다.
+func ParseTime(raw string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+
+	t, err := time.ParseInLocation("2006-01-02", raw, MarketLocation())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Second)
+	}
+	return t, nil
+}
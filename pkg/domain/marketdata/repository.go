@@ -0,0 +1,217 @@
+package marketdata
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/candle"
+)
+
+// Repository 봉 데이터 접근 인터페이스
+type Repository interface {
+	UpsertBars(bars []Bar) (int, error)
+	GetBars(symbol, exchange string, interval Interval, from, to time.Time) ([]Bar, error)
+	GetRecentBars(symbol, exchange string, interval Interval, limit int) ([]Bar, error)
+	GetBounds(symbol, exchange string, interval Interval) (earliest, latest *time.Time, err error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// UpsertBars 봉 저장 (이미 있는 봉은 OHLCV 갱신), 신규 저장 개수 반환
+func (r *EntRepository) UpsertBars(bars []Bar) (int, error) {
+	if len(bars) == 0 {
+		return 0, nil
+	}
+
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("트랜잭션 시작 실패: %w", err)
+	}
+
+	created, err := upsertBars(ctx, tx, bars)
+	if err != nil {
+		_ = tx.Rollback()
+		return 0, err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+	return created, nil
+}
+
+func upsertBars(ctx context.Context, tx *ent.Tx, bars []Bar) (int, error) {
+	first := bars[0]
+	from, to := first.Timestamp, first.Timestamp
+	for _, bar := range bars {
+		if bar.Timestamp.Before(from) {
+			from = bar.Timestamp
+		}
+		if bar.Timestamp.After(to) {
+			to = bar.Timestamp
+		}
+	}
+
+	existing, err := tx.Candle.Query().
+		Where(
+			candle.Symbol(first.Symbol),
+			candle.Exchange(first.Exchange),
+			candle.Interval(string(first.Interval)),
+			candle.TimestampGTE(from),
+			candle.TimestampLTE(to),
+		).
+		All(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("기존 봉 조회 실패: %w", err)
+	}
+
+	byTime := make(map[int64]*ent.Candle, len(existing))
+	for _, c := range existing {
+		byTime[c.Timestamp.Unix()] = c
+	}
+
+	var creates []*ent.CandleCreate
+	for _, bar := range bars {
+		if c, ok := byTime[bar.Timestamp.Unix()]; ok {
+			// 같은 배치 안의 중복 봉은 한 번만 저장
+			if c == nil {
+				continue
+			}
+			if c.Close.Equal(bar.Close) && c.High.Equal(bar.High) && c.Low.Equal(bar.Low) && c.Volume == bar.Volume {
+				continue
+			}
+			// 집계 중이던 마지막 봉은 이후 조회에서 값이 바뀔 수 있음
+			if err := tx.Candle.UpdateOneID(c.ID).
+				SetOpen(bar.Open).
+				SetHigh(bar.High).
+				SetLow(bar.Low).
+				SetClose(bar.Close).
+				SetVolume(bar.Volume).
+				Exec(ctx); err != nil {
+				return 0, fmt.Errorf("봉 갱신 실패: %w", err)
+			}
+			continue
+		}
+
+		byTime[bar.Timestamp.Unix()] = nil
+		creates = append(creates, tx.Candle.Create().
+			SetSymbol(bar.Symbol).
+			SetExchange(bar.Exchange).
+			SetInterval(string(bar.Interval)).
+			SetTimestamp(bar.Timestamp).
+			SetOpen(bar.Open).
+			SetHigh(bar.High).
+			SetLow(bar.Low).
+			SetClose(bar.Close).
+			SetVolume(bar.Volume))
+	}
+
+	if len(creates) > 0 {
+		if _, err := tx.Candle.CreateBulk(creates...).Save(ctx); err != nil {
+			return 0, fmt.Errorf("봉 저장 실패: %w", err)
+		}
+	}
+	return len(creates), nil
+}
+
+// GetBars 구간 내 봉 조회 (시간순)
+func (r *EntRepository) GetBars(symbol, exchange string, interval Interval, from, to time.Time) ([]Bar, error) {
+	candles, err := r.client.Candle.Query().
+		Where(
+			candle.Symbol(symbol),
+			candle.Exchange(exchange),
+			candle.Interval(string(interval)),
+			candle.TimestampGTE(from),
+			candle.TimestampLTE(to),
+		).
+		Order(ent.Asc(candle.FieldTimestamp)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("봉 조회 실패: %w", err)
+	}
+
+	return toBars(candles), nil
+}
+
+// GetRecentBars 최근 봉 limit개 조회 (시간순)
+func (r *EntRepository) GetRecentBars(symbol, exchange string, interval Interval, limit int) ([]Bar, error) {
+	candles, err := r.client.Candle.Query().
+		Where(
+			candle.Symbol(symbol),
+			candle.Exchange(exchange),
+			candle.Interval(string(interval)),
+		).
+		Order(ent.Desc(candle.FieldTimestamp)).
+		Limit(limit).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("최근 봉 조회 실패: %w", err)
+	}
+
+	bars := toBars(candles)
+	for i, j := 0, len(bars)-1; i < j; i, j = i+1, j-1 {
+		bars[i], bars[j] = bars[j], bars[i]
+	}
+	return bars, nil
+}
+
+// GetBounds 저장된 가장 오래된/최근 봉 시각 (없으면 nil)
+func (r *EntRepository) GetBounds(symbol, exchange string, interval Interval) (*time.Time, *time.Time, error) {
+	query := func() *ent.CandleQuery {
+		return r.client.Candle.Query().
+			Where(
+				candle.Symbol(symbol),
+				candle.Exchange(exchange),
+				candle.Interval(string(interval)),
+			)
+	}
+
+	earliest, err := query().Order(ent.Asc(candle.FieldTimestamp)).First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, fmt.Errorf("봉 범위 조회 실패: %w", err)
+	}
+
+	latest, err := query().Order(ent.Desc(candle.FieldTimestamp)).First(r.getContext())
+	if err != nil {
+		return nil, nil, fmt.Errorf("봉 범위 조회 실패: %w", err)
+	}
+
+	return &earliest.Timestamp, &latest.Timestamp, nil
+}
+
+func toBars(candles []*ent.Candle) []Bar {
+	bars := make([]Bar, len(candles))
+	for i, c := range candles {
+		bars[i] = Bar{
+			Symbol:    c.Symbol,
+			Exchange:  c.Exchange,
+			Interval:  Interval(c.Interval),
+			Timestamp: c.Timestamp,
+			Open:      c.Open,
+			High:      c.High,
+			Low:       c.Low,
+			Close:     c.Close,
+			Volume:    c.Volume,
+		}
+	}
+	return bars
+}
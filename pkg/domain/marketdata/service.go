@@ -0,0 +1,259 @@
+package marketdata
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/shared/indicator"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// defaultDailyHistory 저장된 봉이 없을 때 일봉 최초 적재 기간
+	defaultDailyHistory = 365 * 24 * time.Hour
+	// defaultIntradayHistory 저장된 봉이 없을 때 분봉 최초 적재 기간
+	defaultIntradayHistory = 5 * 24 * time.Hour
+	// maxGapFills 한 번의 백필에서 메우는 최대 누락 구간 수
+	maxGapFills = 20
+)
+
+// Service 과거 봉 데이터 서비스 인터페이스
+type Service interface {
+	// GetBars 구간 내 봉 조회 (저장소에 없는 구간은 먼저 백필)
+	GetBars(ctx context.Context, symbol, exchange string, interval Interval, from, to time.Time) ([]Bar, error)
+	// Backfill from 이후 누락된 봉을 외부 API에서 적재, 신규 저장 개수 반환
+	Backfill(ctx context.Context, symbol, exchange string, interval Interval, from time.Time) (int, error)
+	// FindGaps 저장된 봉 사이의 누락 구간 조회
+	FindGaps(symbol, exchange string, interval Interval, from, to time.Time) ([]Gap, error)
+	// GetRecentCandles 지표 워밍업용 최근 봉 조회
+	GetRecentCandles(symbol string, interval time.Duration, count int) ([]indicator.Candle, error)
+	// ResolveExchange 시세 거래소코드 결정 (지정값 우선, 없으면 종목 마스터, 그래도 없으면 NAS)
+	ResolveExchange(symbol, exchange string) string
+}
+
+// ServiceImpl 과거 봉 데이터 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	fetcher    Fetcher
+	exchanges  ExchangeResolver
+
+	// 같은 종목/주기에 대한 동시 백필 방지
+	backfillLocks map[string]*sync.Mutex
+	mutex         sync.Mutex
+}
+
+// NewService 새로운 봉 데이터 서비스 생성 (exchanges가 nil이면 거래소 미지정 시 NAS)
+func NewService(repository Repository, fetcher Fetcher, exchanges ExchangeResolver) Service {
+	return &ServiceImpl{
+		repository:    repository,
+		fetcher:       fetcher,
+		exchanges:     exchanges,
+		backfillLocks: make(map[string]*sync.Mutex),
+	}
+}
+
+// GetBars 구간 내 봉 조회 (저장소에 없는 구간은 먼저 백필)
+func (s *ServiceImpl) GetBars(ctx context.Context, symbol, exchange string, interval Interval, from, to time.Time) ([]Bar, error) {
+	if !from.Before(to) {
+		return nil, utils.BadRequest("조회 시작 시각은 종료 시각보다 이전이어야 합니다")
+	}
+	exchange = s.ResolveExchange(symbol, exchange)
+
+	earliest, latest, err := s.repository.GetBounds(symbol, exchange, interval)
+	if err != nil {
+		return nil, err
+	}
+
+	// 요청 구간이 저장 범위를 벗어나면 백필 (실패해도 저장된 봉은 반환)
+	stale := latest == nil || earliest.After(from) || latest.Before(minTime(to, time.Now()).Add(-interval.Duration()))
+	if stale {
+		if _, err := s.Backfill(ctx, symbol, exchange, interval, from); err != nil {
+			logrus.Warnf("⚠️  봉 백필 실패 (%s %s): %v", symbol, interval, err)
+		}
+	}
+
+	return s.repository.GetBars(symbol, exchange, interval, from, to)
+}
+
+// Backfill from 이후 누락된 봉을 외부 API에서 적재
+// 저장 범위 이전, 최근 봉 이후, 저장 범위 내 누락 구간 순으로 조회한다.
+func (s *ServiceImpl) Backfill(ctx context.Context, symbol, exchange string, interval Interval, from time.Time) (int, error) {
+	if s.fetcher == nil {
+		return 0, utils.Internal("차트 데이터 조회기가 설정되지 않았습니다", nil)
+	}
+	exchange = s.ResolveExchange(symbol, exchange)
+
+	lock := s.backfillLock(symbol, exchange, interval)
+	lock.Lock()
+	defer lock.Unlock()
+
+	now := time.Now()
+	earliest, latest, err := s.repository.GetBounds(symbol, exchange, interval)
+	if err != nil {
+		return 0, err
+	}
+
+	var ranges []Gap
+	if latest == nil {
+		ranges = append(ranges, Gap{From: from, To: now})
+	} else {
+		if from.Before(*earliest) {
+			ranges = append(ranges, Gap{From: from, To: *earliest})
+		}
+		// 마지막 봉은 집계 중이었을 수 있어 다시 조회
+		ranges = append(ranges, Gap{From: *latest, To: now})
+
+		gaps, err := s.FindGaps(symbol, exchange, interval, maxTime(from, *earliest), *latest)
+		if err != nil {
+			return 0, err
+		}
+		if len(gaps) > maxGapFills {
+			gaps = gaps[len(gaps)-maxGapFills:]
+		}
+		ranges = append(ranges, gaps...)
+	}
+
+	total := 0
+	for _, r := range ranges {
+		bars, err := s.fetcher.FetchBars(ctx, symbol, exchange, interval, r.From, r.To)
+		if err != nil {
+			return total, fmt.Errorf("차트 데이터 조회 실패: %w", err)
+		}
+		created, err := s.repository.UpsertBars(bars)
+		if err != nil {
+			return total, err
+		}
+		total += created
+	}
+
+	if total > 0 {
+		logrus.Infof("📥 봉 백필 완료: %s %s (%d개)", symbol, interval, total)
+	}
+	return total, nil
+}
+
+// FindGaps 저장된 봉 사이의 누락 구간 조회
+func (s *ServiceImpl) FindGaps(symbol, exchange string, interval Interval, from, to time.Time) ([]Gap, error) {
+	bars, err := s.repository.GetBars(symbol, s.ResolveExchange(symbol, exchange), interval, from, to)
+	if err != nil {
+		return nil, err
+	}
+	return DetectGaps(bars, interval), nil
+}
+
+// GetRecentCandles 지표 워밍업용 최근 봉 조회 (저장된 봉이 부족하면 백필)
+func (s *ServiceImpl) GetRecentCandles(symbol string, interval time.Duration, count int) ([]indicator.Candle, error) {
+	barInterval, ok := IntervalFromDuration(interval)
+	if !ok {
+		return nil, fmt.Errorf("지원하지 않는 봉 주기: %s", interval)
+	}
+
+	exchange := s.ResolveExchange(symbol, "")
+	bars, err := s.repository.GetRecentBars(symbol, exchange, barInterval, count)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(bars) < count && s.fetcher != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+		defer cancel()
+
+		if _, err := s.Backfill(ctx, symbol, exchange, barInterval, time.Now().Add(-historyFor(barInterval))); err != nil {
+			logrus.Warnf("⚠️  지표 워밍업 백필 실패 (%s %s): %v", symbol, barInterval, err)
+		} else if bars, err = s.repository.GetRecentBars(symbol, exchange, barInterval, count); err != nil {
+			return nil, err
+		}
+	}
+
+	candles := make([]indicator.Candle, len(bars))
+	for i, bar := range bars {
+		candles[i] = bar.ToCandle()
+	}
+	return candles, nil
+}
+
+// ResolveExchange 시세 거래소코드 결정 (지정값 우선, 없으면 종목 마스터, 그래도 없으면 NAS)
+func (s *ServiceImpl) ResolveExchange(symbol, exchange string) string {
+	if exchange != "" {
+		return exchange
+	}
+	if s.exchanges != nil {
+		if resolved := s.exchanges.QuoteExchange(symbol); resolved != "" {
+			return resolved
+		}
+	}
+	return DefaultExchange
+}
+
+func (s *ServiceImpl) backfillLock(symbol, exchange string, interval Interval) *sync.Mutex {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	key := symbol + ":" + exchange + ":" + string(interval)
+	lock, ok := s.backfillLocks[key]
+	if !ok {
+		lock = &sync.Mutex{}
+		s.backfillLocks[key] = lock
+	}
+	return lock
+}
+
+// DetectGaps 연속된 봉 사이의 누락 구간 탐지
+// 일봉은 주말을 제외하고, 분봉은 같은 거래일 안에서만 누락으로 본다.
+func DetectGaps(bars []Bar, interval Interval) []Gap {
+	var gaps []Gap
+	loc := MarketLocation()
+
+	for i := 1; i < len(bars); i++ {
+		prev, next := bars[i-1].Timestamp, bars[i].Timestamp
+
+		var expected time.Time
+		if interval.IsIntraday() {
+			if prev.In(loc).Format("20060102") != next.In(loc).Format("20060102") {
+				continue
+			}
+			expected = prev.Add(interval.Duration())
+		} else {
+			expected = nextWeekday(prev.In(loc))
+		}
+
+		if next.After(expected) {
+			gaps = append(gaps, Gap{From: expected, To: next})
+		}
+	}
+
+	return gaps
+}
+
+func nextWeekday(t time.Time) time.Time {
+	next := t.AddDate(0, 0, 1)
+	for next.Weekday() == time.Saturday || next.Weekday() == time.Sunday {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next
+}
+
+func historyFor(interval Interval) time.Duration {
+	if interval.IsIntraday() {
+		return defaultIntradayHistory
+	}
+	return defaultDailyHistory
+}
+
+func minTime(a, b time.Time) time.Time {
+	if a.Before(b) {
+		return a
+	}
+	return b
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
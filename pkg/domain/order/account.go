@@ -0,0 +1,90 @@
+package order
+
+import (
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// Position 모의 계좌 보유 종목 (평균 단가는 매수 수수료 포함)
+type Position struct {
+	Quantity decimal.Decimal
+	AvgCost  decimal.Decimal
+}
+
+// Fill 모의 체결 결과
+type Fill struct {
+	Quantity    decimal.Decimal
+	Price       decimal.Decimal
+	Commission  decimal.Decimal
+	RealizedPnL decimal.Decimal // 매도 체결의 실현손익 (수수료 포함)
+}
+
+// SimAccount 모의 체결용 가상 계좌 (백테스트/모의투자 공용)
+type SimAccount struct {
+	Cash      decimal.Decimal
+	Positions map[string]*Position
+}
+
+// NewSimAccount 새로운 가상 계좌 생성
+func NewSimAccount(cash decimal.Decimal) *SimAccount {
+	return &SimAccount{
+		Cash:      cash,
+		Positions: make(map[string]*Position),
+	}
+}
+
+// ApplyFill 체결을 현금/보유 수량에 반영
+// 매수는 수수료 포함 금액이 현금을 넘으면 거부하고, 매도는 보유 수량까지만 체결한다 (공매도 불가).
+func (a *SimAccount) ApplyFill(sim FillSimulator, symbol string, side Side, quantity, price decimal.Decimal) (Fill, error) {
+	pos := a.Positions[symbol]
+
+	if side == SideBuy {
+		commission := sim.Commission(quantity, price)
+		cost := quantity.Mul(price).Add(commission)
+		if cost.GreaterThan(a.Cash) {
+			return Fill{}, fmt.Errorf("주문 가능 금액 부족: 필요 %s, 보유 %s", cost.StringFixed(2), a.Cash.StringFixed(2))
+		}
+
+		if pos == nil {
+			pos = &Position{}
+			a.Positions[symbol] = pos
+		}
+		totalCost := pos.AvgCost.Mul(pos.Quantity).Add(cost)
+		pos.Quantity = pos.Quantity.Add(quantity)
+		pos.AvgCost = totalCost.Div(pos.Quantity).Round(4)
+		a.Cash = a.Cash.Sub(cost)
+
+		return Fill{Quantity: quantity, Price: price, Commission: commission}, nil
+	}
+
+	if pos == nil || !pos.Quantity.IsPositive() {
+		return Fill{}, fmt.Errorf("보유 수량이 없습니다: %s", symbol)
+	}
+	if quantity.GreaterThan(pos.Quantity) {
+		quantity = pos.Quantity
+	}
+
+	commission := sim.Commission(quantity, price)
+	realized := price.Sub(pos.AvgCost).Mul(quantity).Sub(commission)
+	pos.Quantity = pos.Quantity.Sub(quantity)
+	if !pos.Quantity.IsPositive() {
+		delete(a.Positions, symbol)
+	}
+	a.Cash = a.Cash.Add(quantity.Mul(price)).Sub(commission)
+
+	return Fill{Quantity: quantity, Price: price, Commission: commission, RealizedPnL: realized}, nil
+}
+
+// MarketValue 보유 종목 평가금액 (가격을 알 수 없는 종목은 평균 단가로 평가)
+func (a *SimAccount) MarketValue(priceOf func(symbol string) (decimal.Decimal, bool)) decimal.Decimal {
+	value := decimal.Zero
+	for symbol, pos := range a.Positions {
+		price, ok := priceOf(symbol)
+		if !ok {
+			price = pos.AvgCost
+		}
+		value = value.Add(pos.Quantity.Mul(price))
+	}
+	return value
+}
@@ -0,0 +1,155 @@
+package order
+
+import (
+	"auto-trader/pkg/domain/order/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 주문 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 주문 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// PlaceOrder 수동 주문
+// @Summary 수동 주문
+// @Description 주문을 제출합니다. 전략 주문과 같은 사전 리스크 검사(주문 금액, 포지션 크기, 일일 손실, 미체결 주문 수, 가격 범위)와 거래 세션 규칙이 적용되며, 거부된 주문은 REJECTED 상태로 저장되고 거부 사유가 400 응답으로 반환됩니다
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param order body dto.PlaceOrderBody true "주문 정보"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /orders [post]
+func (ctrl *Controller) PlaceOrder(c *fiber.Ctx) error {
+	var req dto.PlaceOrderBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Mode == "" {
+		req.Mode = string(ModeLive)
+	}
+	if req.Type == "" {
+		req.Type = string(TypeMarket)
+	}
+	if req.TimeInForce == "" {
+		req.TimeInForce = string(TimeInForceDay)
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	o, err := ctrl.service.PlaceOrder(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "주문 제출 실패")
+	}
+
+	return utils.SuccessResponse(c, o)
+}
+
+// GetOrders 주문 목록 조회
+// @Summary 주문 목록 조회
+// @Description 사용자의 주문 목록을 최신순으로 조회합니다
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param status query string false "주문 상태 (NEW, SUBMITTED, PARTIALLY_FILLED, FILLED, CANCELED, REJECTED)"
+// @Param mode query string false "실행 모드 (LIVE, PAPER)"
+// @Param symbol query string false "종목 심볼"
+// @Param strategy_id query string false "전략 ID"
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /orders [get]
+func (ctrl *Controller) GetOrders(c *fiber.Ctx) error {
+	var q dto.GetOrderListQuery
+	userID := utils.GetUserID(c)
+	q.Status = c.Query("status")
+	q.Mode = c.Query("mode")
+	q.Symbol = c.Query("symbol")
+	q.StrategyID = c.Query("strategy_id")
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	orders, err := ctrl.service.GetOrders(userID, q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "주문 목록 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, orders)
+}
+
+// GetOrder 주문 상세 조회
+// @Summary 주문 상세 조회
+// @Description ID로 주문 상세 정보를 조회합니다
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "주문 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /orders/{id} [get]
+func (ctrl *Controller) GetOrder(c *fiber.Ctx) error {
+	var path dto.OrderPath
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	o, err := ctrl.service.GetOrder(utils.GetUserID(c), path.ID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "주문 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, o)
+}
+
+// CancelOrder 주문 취소
+// @Summary 주문 취소
+// @Description 미체결 주문의 잔량 취소를 요청합니다
+// @Tags orders
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "주문 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /orders/{id}/cancel [post]
+func (ctrl *Controller) CancelOrder(c *fiber.Ctx) error {
+	var path dto.OrderPath
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	o, err := ctrl.service.CancelOrder(utils.GetUserID(c), path.ID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "주문 취소 실패")
+	}
+
+	return utils.SuccessResponse(c, o)
+}
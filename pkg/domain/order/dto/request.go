@@ -0,0 +1,34 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// PlaceOrderBody 수동 주문 요청 (전략 주문과 같은 사전 리스크 검사를 거침)
+type PlaceOrderBody struct {
+	Symbol      string  `json:"symbol" validate:"required,max=20"`
+	Exchange    string  `json:"exchange,omitempty" validate:"max=10"`
+	Mode        string  `json:"mode" validate:"enum=LIVE,PAPER"`                   // 기본 LIVE
+	Side        string  `json:"side" validate:"required,enum=BUY,SELL"`            // 주문 방향
+	Type        string  `json:"type" validate:"enum=MARKET,LIMIT,MOO,LOO,MOC,LOC"` // 기본 MARKET
+	TimeInForce string  `json:"time_in_force" validate:"enum=DAY,IOC"`             // 기본 DAY
+	Quantity    float64 `json:"quantity"`                                          // 주문 수량 (소수점 이하 버림)
+	Price       float64 `json:"price,omitempty"`                                   // 지정가 (시장가 주문은 비우면 최근 체결가를 기준가로 사용)
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetOrderListQuery 주문 목록 조회 쿼리 파라미터
+type GetOrderListQuery struct {
+	Status     string `query:"status,omitempty"` // NEW, SUBMITTED, PARTIALLY_FILLED, FILLED, CANCELED, REJECTED
+	Mode       string `query:"mode,omitempty"`   // LIVE, PAPER
+	Symbol     string `query:"symbol,omitempty" validate:"max=20"`
+	StrategyID string `query:"strategy_id,omitempty"`
+	Limit      int    `query:"limit" validate:"min=1,max=100"`
+	Offset     int    `query:"offset" validate:"min=0"`
+}
+
+// Path DTOs (URL 경로 파라미터)
+
+// OrderPath 주문 ID 경로 파라미터
+type OrderPath struct {
+	ID string `param:"id" validate:"required"`
+}
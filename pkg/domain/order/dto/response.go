@@ -0,0 +1,41 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// OrderResponse 주문 응답 데이터
+type OrderResponse struct {
+	ID             uuid.UUID        `json:"id"`
+	UserID         uuid.UUID        `json:"user_id"`
+	StrategyID     *uuid.UUID       `json:"strategy_id,omitempty"`
+	ClientOrderID  string           `json:"client_order_id"`
+	BrokerOrderID  *string          `json:"broker_order_id,omitempty"`
+	Symbol         string           `json:"symbol"`
+	Exchange       string           `json:"exchange"`
+	Mode           string           `json:"mode"`
+	Side           string           `json:"side"`
+	Type           string           `json:"type"`
+	TimeInForce    string           `json:"time_in_force"`
+	Quantity       decimal.Decimal  `json:"quantity"`
+	Price          *decimal.Decimal `json:"price,omitempty"`
+	FilledQuantity decimal.Decimal  `json:"filled_quantity"`
+	AvgFillPrice   *decimal.Decimal `json:"avg_fill_price,omitempty"`
+	Status         string           `json:"status"`
+	RejectReason   *string          `json:"reject_reason,omitempty"`
+	SubmittedAt    *time.Time       `json:"submitted_at,omitempty"`
+	CompletedAt    *time.Time       `json:"completed_at,omitempty"`
+	CreatedAt      *time.Time       `json:"created_at,omitempty"`
+	UpdatedAt      *time.Time       `json:"updated_at,omitempty"`
+}
+
+// OrderListResponse 주문 목록 응답 데이터
+type OrderListResponse struct {
+	Orders []*OrderResponse `json:"orders"`
+	Total  int              `json:"total"`
+	Limit  int              `json:"limit"`
+	Offset int              `json:"offset"`
+}
@@ -0,0 +1,88 @@
+package order
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// FillModel 모의 체결 시점
+type FillModel string
+
+const (
+	FillOnNextOpen FillModel = "next_open" // 주문 다음 봉 시가에 체결 (미래 정보 사용 방지)
+	FillOnClose    FillModel = "close"     // 주문 시점 시세(봉 종가)에 즉시 체결
+)
+
+// Quote 모의 체결 판단용 시세 (봉 또는 실시간 체결가)
+type Quote struct {
+	Open      decimal.Decimal
+	High      decimal.Decimal
+	Low       decimal.Decimal
+	Close     decimal.Decimal
+	Timestamp time.Time
+}
+
+// QuoteFromPrice 단일 체결가를 시세로 변환 (실시간 모의 체결용)
+func QuoteFromPrice(price decimal.Decimal, timestamp time.Time) Quote {
+	return Quote{Open: price, High: price, Low: price, Close: price, Timestamp: timestamp}
+}
+
+// FillSimulator 슬리피지/수수료를 반영한 모의 체결 계산기 (백테스트/모의투자 공용)
+type FillSimulator struct {
+	Model          FillModel
+	SlippageBps    decimal.Decimal // 시장가 체결가에 불리하게 반영할 슬리피지 (1bp = 0.01%)
+	CommissionRate decimal.Decimal // 체결 금액 대비 수수료율 (예: 0.0025 = 0.25%)
+	MinCommission  decimal.Decimal // 건당 최소 수수료
+}
+
+var bpsDivisor = decimal.NewFromInt(10000)
+
+// Match 시세에 대한 주문 체결 여부와 체결가 계산
+// 시장가는 기준가(시가 또는 종가)에 슬리피지를 반영하고,
+// 지정가는 시세가 지정가에 닿은 경우에만 지정가(시가가 더 유리하면 시가)로 체결한다.
+func (f FillSimulator) Match(req *Request, quote Quote, atOpen bool) (decimal.Decimal, bool) {
+	reference := quote.Close
+	if atOpen {
+		reference = quote.Open
+	}
+	if !reference.IsPositive() {
+		return decimal.Zero, false
+	}
+
+	switch req.Type {
+	case TypeLimit, TypeLOO, TypeLOC:
+		limit := req.Price
+		if req.Side == SideBuy {
+			if atOpen && quote.Open.LessThanOrEqual(limit) {
+				return quote.Open, true
+			}
+			if quote.Low.LessThanOrEqual(limit) {
+				return limit, true
+			}
+			return decimal.Zero, false
+		}
+		if atOpen && quote.Open.GreaterThanOrEqual(limit) {
+			return quote.Open, true
+		}
+		if quote.High.GreaterThanOrEqual(limit) {
+			return limit, true
+		}
+		return decimal.Zero, false
+	default:
+		slippage := reference.Mul(f.SlippageBps).Div(bpsDivisor)
+		if req.Side == SideBuy {
+			return reference.Add(slippage).Round(4), true
+		}
+		return reference.Sub(slippage).Round(4), true
+	}
+}
+
+// Commission 체결 금액에 대한 수수료
+func (f FillSimulator) Commission(quantity, price decimal.Decimal) decimal.Decimal {
+	commission := quantity.Mul(price).Mul(f.CommissionRate)
+	if quantity.IsPositive() && commission.LessThan(f.MinCommission) {
+		commission = f.MinCommission
+	}
+	return commission.Round(4)
+}
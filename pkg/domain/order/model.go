@@ -0,0 +1,121 @@
+package order
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Side 주문 방향
+type Side string
+
+const (
+	SideBuy  Side = "BUY"
+	SideSell Side = "SELL"
+)
+
+// Type 주문 유형
+type Type string
+
+const (
+	TypeMarket Type = "MARKET" // 시장가
+	TypeLimit  Type = "LIMIT"  // 지정가
+	TypeMOO    Type = "MOO"    // 장개시시장가
+	TypeLOO    Type = "LOO"    // 장개시지정가
+	TypeMOC    Type = "MOC"    // 장마감시장가
+	TypeLOC    Type = "LOC"    // 장마감지정가
+)
+
+// TimeInForce 주문 유효 기간
+type TimeInForce string
+
+const (
+	TimeInForceDay TimeInForce = "DAY" // 당일 유효
+	TimeInForceIOC TimeInForce = "IOC" // 주문 타임아웃 내 미체결 잔량 자동 취소
+)
+
+// Mode 주문 실행 모드
+type Mode string
+
+const (
+	ModeLive  Mode = "LIVE"  // 실계좌 주문 (KIS)
+	ModePaper Mode = "PAPER" // 프로세스 내 모의 체결
+)
+
+// Status 주문 상태
+type Status string
+
+const (
+	StatusNew             Status = "NEW"
+	StatusSubmitted       Status = "SUBMITTED"
+	StatusPartiallyFilled Status = "PARTIALLY_FILLED"
+	StatusFilled          Status = "FILLED"
+	StatusCanceled        Status = "CANCELED"
+	StatusRejected        Status = "REJECTED"
+)
+
+// IsTerminal 더 이상 상태가 바뀌지 않는 최종 상태인지 확인
+func (s Status) IsTerminal() bool {
+	return s == StatusFilled || s == StatusCanceled || s == StatusRejected
+}
+
+// Request 주문 요청
+type Request struct {
+	ClientOrderID string          `json:"client_order_id"` // 비어 있으면 실행기가 생성
+	UserID        string          `json:"user_id"`
+	StrategyID    string          `json:"strategy_id"`
+	Symbol        string          `json:"symbol"`
+	Exchange      string          `json:"exchange"`
+	Mode          Mode            `json:"mode"` // 비어 있으면 LIVE
+	Side          Side            `json:"side"`
+	Type          Type            `json:"type"`
+	TimeInForce   TimeInForce     `json:"time_in_force"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Price         decimal.Decimal `json:"price"`                 // 시장가 주문은 기준가(최근 체결가)
+	Liquidation   bool            `json:"liquidation,omitempty"` // 긴급 청산 주문 (노출을 줄이면 주문 금액/가격 범위/미체결 주문 수 검사 제외)
+	HeldQuantity  decimal.Decimal `json:"held_quantity"`         // 청산 주문의 증권사/저장소 기준 보유 수량 (리스크 상태에 포지션이 없을 때 청산 한도)
+}
+
+// Update 주문 상태 변경 이벤트 (실행기가 호출자에게 보고)
+type Update struct {
+	ClientOrderID    string          `json:"client_order_id"`
+	BrokerOrderID    string          `json:"broker_order_id"`
+	UserID           string          `json:"user_id"`
+	StrategyID       string          `json:"strategy_id"`
+	Symbol           string          `json:"symbol"`
+	Exchange         string          `json:"exchange"`
+	Mode             Mode            `json:"mode"`
+	Side             Side            `json:"side"`
+	Type             Type            `json:"type"`
+	TimeInForce      TimeInForce     `json:"time_in_force"`
+	Quantity         decimal.Decimal `json:"quantity"`
+	Price            decimal.Decimal `json:"price"`
+	Status           Status          `json:"status"`
+	FilledQuantity   decimal.Decimal `json:"filled_quantity"`
+	AvgFillPrice     decimal.Decimal `json:"avg_fill_price"`
+	LastFillQuantity decimal.Decimal `json:"last_fill_quantity"` // 이번 이벤트에서 새로 체결된 수량
+	LastFillPrice    decimal.Decimal `json:"last_fill_price"`
+	RejectReason     string          `json:"reject_reason,omitempty"`
+	Liquidation      bool            `json:"liquidation,omitempty"`
+	HeldQuantity     decimal.Decimal `json:"held_quantity"`
+	Timestamp        time.Time       `json:"timestamp"`
+}
+
+// RemainingQuantity 미체결 잔량
+func (u *Update) RemainingQuantity() decimal.Decimal {
+	return u.Quantity.Sub(u.FilledQuantity)
+}
+
+// UpdateHandler 주문 상태 변경 콜백
+type UpdateHandler func(update Update)
+
+// RiskChecker 주문 제출 전 리스크 검사 (허용하지 않으면 거부 사유를 오류로 반환)
+// 실행기는 접수(NEW) 직후 검사하고, 거부된 주문은 REJECTED로 마감한다.
+type RiskChecker interface {
+	CheckOrder(update Update) error
+}
+
+// OpenOrderSource 재시작 후 추적을 복구할 미체결 주문 조회 (주문 서비스가 구현)
+type OpenOrderSource interface {
+	OpenOrders(mode Mode) ([]Update, error)
+}
@@ -0,0 +1,250 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/order"
+	"auto-trader/ent/predicate"
+
+	"github.com/google/uuid"
+)
+
+// Filter 주문 목록 조회 조건
+type Filter struct {
+	Status     Status
+	Mode       Mode
+	Symbol     string
+	StrategyID *uuid.UUID
+}
+
+// Repository 주문 데이터 접근 인터페이스
+type Repository interface {
+	// 기본 CRUD
+	Create(update *Update) (*ent.Order, error)
+	GetByID(id uuid.UUID) (*ent.Order, error)
+	GetByClientOrderID(clientOrderID string) (*ent.Order, error)
+	ApplyUpdate(id uuid.UUID, update *Update) (*ent.Order, error)
+
+	// 주문 특화 메서드
+	GetByUserID(userID uuid.UUID, filter Filter, limit, offset int) ([]*ent.Order, error)
+	CountByUser(userID uuid.UUID, filter Filter) (int, error)
+	GetOpenOrders(userID uuid.UUID) ([]*ent.Order, error)
+	GetOpenOrdersByMode(mode Mode) ([]*ent.Order, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// Create 실행기에서 받은 최초 주문 상태로 주문 생성
+func (r *EntRepository) Create(update *Update) (*ent.Order, error) {
+	userID, err := uuid.Parse(update.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 사용자 ID 형식: %w", err)
+	}
+
+	create := r.client.Order.Create().
+		SetUserID(userID).
+		SetClientOrderID(update.ClientOrderID).
+		SetSymbol(update.Symbol).
+		SetExchange(update.Exchange).
+		SetMode(order.Mode(modeOrDefault(update.Mode))).
+		SetSide(order.Side(update.Side)).
+		SetOrderType(order.OrderType(update.Type)).
+		SetTimeInForce(order.TimeInForce(update.TimeInForce)).
+		SetQuantity(update.Quantity).
+		SetFilledQuantity(update.FilledQuantity).
+		SetStatus(order.Status(update.Status))
+
+	if strategyID, err := uuid.Parse(update.StrategyID); err == nil {
+		create.SetStrategyID(strategyID)
+	}
+	if update.BrokerOrderID != "" {
+		create.SetBrokerOrderID(update.BrokerOrderID)
+	}
+	if update.Price.IsPositive() {
+		create.SetPrice(update.Price)
+	}
+	if update.RejectReason != "" {
+		create.SetRejectReason(update.RejectReason)
+	}
+	if update.Status == StatusSubmitted {
+		create.SetSubmittedAt(update.Timestamp)
+	}
+	if update.Status.IsTerminal() {
+		create.SetCompletedAt(update.Timestamp)
+	}
+
+	o, err := create.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create order: %w", err)
+	}
+
+	return o, nil
+}
+
+// GetByID ID로 주문 조회
+func (r *EntRepository) GetByID(id uuid.UUID) (*ent.Order, error) {
+	o, err := r.client.Order.Get(r.getContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get order by id: %w", err)
+	}
+	return o, nil
+}
+
+// GetByClientOrderID 클라이언트 주문 ID로 주문 조회
+func (r *EntRepository) GetByClientOrderID(clientOrderID string) (*ent.Order, error) {
+	o, err := r.client.Order.Query().
+		Where(order.ClientOrderID(clientOrderID)).
+		Only(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get order by client order id: %w", err)
+	}
+	return o, nil
+}
+
+// ApplyUpdate 주문 상태/체결 정보 갱신
+func (r *EntRepository) ApplyUpdate(id uuid.UUID, update *Update) (*ent.Order, error) {
+	updateQuery := r.client.Order.UpdateOneID(id).
+		SetStatus(order.Status(update.Status)).
+		SetFilledQuantity(update.FilledQuantity)
+
+	if update.BrokerOrderID != "" {
+		updateQuery.SetBrokerOrderID(update.BrokerOrderID)
+	}
+	if update.AvgFillPrice.IsPositive() {
+		updateQuery.SetAvgFillPrice(update.AvgFillPrice)
+	}
+	if update.RejectReason != "" {
+		updateQuery.SetRejectReason(update.RejectReason)
+	}
+
+	timestamp := update.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	if update.Status == StatusSubmitted {
+		updateQuery.SetSubmittedAt(timestamp)
+	}
+	if update.Status.IsTerminal() {
+		updateQuery.SetCompletedAt(timestamp)
+	}
+
+	o, err := updateQuery.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update order: %w", err)
+	}
+
+	return o, nil
+}
+
+// GetByUserID 사용자별 주문 조회 (최신순, 페이지네이션)
+func (r *EntRepository) GetByUserID(userID uuid.UUID, filter Filter, limit, offset int) ([]*ent.Order, error) {
+	orders, err := r.client.Order.Query().
+		Where(filterPredicates(userID, filter)...).
+		Limit(limit).
+		Offset(offset).
+		Order(ent.Desc(order.FieldCreatedAt)).
+		All(r.getContext())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get orders by user: %w", err)
+	}
+
+	return orders, nil
+}
+
+// CountByUser 사용자별 주문 수 조회
+func (r *EntRepository) CountByUser(userID uuid.UUID, filter Filter) (int, error) {
+	count, err := r.client.Order.Query().
+		Where(filterPredicates(userID, filter)...).
+		Count(r.getContext())
+
+	if err != nil {
+		return 0, fmt.Errorf("failed to count orders by user: %w", err)
+	}
+
+	return count, nil
+}
+
+// GetOpenOrders 사용자의 미체결 주문 전체 조회 (접수/제출/부분 체결)
+func (r *EntRepository) GetOpenOrders(userID uuid.UUID) ([]*ent.Order, error) {
+	orders, err := r.client.Order.Query().
+		Where(
+			order.UserID(userID),
+			order.StatusIn(order.StatusNEW, order.StatusSUBMITTED, order.StatusPARTIALLY_FILLED),
+		).
+		Order(ent.Asc(order.FieldCreatedAt)).
+		All(r.getContext())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open orders: %w", err)
+	}
+
+	return orders, nil
+}
+
+// GetOpenOrdersByMode 실행 모드의 전체 사용자 미체결 주문 조회 (접수/제출/부분 체결, 실행기 재시작 복구용)
+func (r *EntRepository) GetOpenOrdersByMode(mode Mode) ([]*ent.Order, error) {
+	orders, err := r.client.Order.Query().
+		Where(
+			order.ModeEQ(order.Mode(mode)),
+			order.StatusIn(order.StatusNEW, order.StatusSUBMITTED, order.StatusPARTIALLY_FILLED),
+		).
+		Order(ent.Asc(order.FieldCreatedAt)).
+		All(r.getContext())
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open orders by mode: %w", err)
+	}
+
+	return orders, nil
+}
+
+// filterPredicates 조회 조건을 ent predicate로 변환
+func filterPredicates(userID uuid.UUID, filter Filter) []predicate.Order {
+	predicates := []predicate.Order{order.UserID(userID)}
+
+	if filter.Status != "" {
+		predicates = append(predicates, order.StatusEQ(order.Status(filter.Status)))
+	}
+	if filter.Mode != "" {
+		predicates = append(predicates, order.ModeEQ(order.Mode(filter.Mode)))
+	}
+	if filter.Symbol != "" {
+		predicates = append(predicates, order.Symbol(filter.Symbol))
+	}
+	if filter.StrategyID != nil {
+		predicates = append(predicates, order.StrategyID(*filter.StrategyID))
+	}
+
+	return predicates
+}
+
+// modeOrDefault 실행 모드 미지정 주문은 실계좌 주문으로 저장
+func modeOrDefault(mode Mode) Mode {
+	if mode == "" {
+		return ModeLive
+	}
+	return mode
+}
@@ -0,0 +1,418 @@
+package order
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/order/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// Canceler 브로커 주문 취소 인터페이스 (주문 실행기가 구현)
+type Canceler interface {
+	CancelOrder(ctx context.Context, clientOrderID string) error
+}
+
+// Executor 브로커 주문 제출/취소 인터페이스 (실행 모드별 주문 실행기가 구현)
+type Executor interface {
+	ExecuteOrder(ctx context.Context, req *Request) (*Update, error)
+	Canceler
+}
+
+// ReferencePrice 시장가 주문 기준가 조회 (최근 체결가)
+type ReferencePrice interface {
+	LatestPrice(symbol string) (decimal.Decimal, bool)
+}
+
+// Service 주문 서비스 인터페이스
+type Service interface {
+	PlaceOrder(userID string, body dto.PlaceOrderBody) (*dto.OrderResponse, error)
+	GetOrders(userID string, q dto.GetOrderListQuery) (*dto.OrderListResponse, error)
+	GetOrder(userID, id string) (*dto.OrderResponse, error)
+	CancelOrder(userID, id string) (*dto.OrderResponse, error)
+
+	// 긴급 중지용 (미체결 주문 일괄 취소, 보유 종목 시장가 청산)
+	CancelOpenOrders(userID uuid.UUID) (int, error)
+	ClosePosition(userID uuid.UUID, mode Mode, symbol, exchange string, quantity decimal.Decimal) (*dto.OrderResponse, error)
+
+	// SubmitExit 손절/익절 보호 청산 주문 (숏 포지션은 매수로 청산, 전략 포지션은 전략 주문으로 기록)
+	SubmitExit(req *Request) (*dto.OrderResponse, error)
+
+	// HandleUpdate 실행기 주문 상태 변경을 상태 머신에 따라 저장
+	HandleUpdate(update Update)
+
+	// OpenOrders 실행기 재시작 시 추적을 복구할 미체결 주문 (저장된 마지막 상태)
+	OpenOrders(mode Mode) ([]Update, error)
+}
+
+// ServiceImpl 주문 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	executors  map[Mode]Executor // 실행 모드별 주문 제출/취소 담당 실행기
+	prices     ReferencePrice
+}
+
+// NewService 새로운 주문 서비스 생성
+func NewService(repository Repository, executors map[Mode]Executor, prices ReferencePrice) Service {
+	return &ServiceImpl{
+		repository: repository,
+		executors:  executors,
+		prices:     prices,
+	}
+}
+
+// PlaceOrder 수동 주문 제출 (실행기의 사전 리스크 검사/거래 세션 규칙을 전략 주문과 동일하게 적용)
+// 거부된 주문도 거부 사유와 함께 REJECTED 상태로 저장되며, 요청에는 거부 사유를 오류로 반환한다.
+func (s *ServiceImpl) PlaceOrder(userID string, body dto.PlaceOrderBody) (*dto.OrderResponse, error) {
+	if _, err := uuid.Parse(userID); err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	if body.Quantity <= 0 {
+		return nil, utils.BadRequest("주문 수량은 0보다 커야 합니다")
+	}
+
+	req := &Request{
+		UserID:      userID,
+		Symbol:      body.Symbol,
+		Exchange:    body.Exchange,
+		Mode:        Mode(body.Mode),
+		Side:        Side(body.Side),
+		Type:        Type(body.Type),
+		TimeInForce: TimeInForce(body.TimeInForce),
+		Quantity:    decimal.NewFromFloat(body.Quantity),
+		Price:       decimal.NewFromFloat(body.Price),
+	}
+	if req.Type != TypeMarket && req.Type != TypeMOO && req.Type != TypeMOC && !req.Price.IsPositive() {
+		return nil, utils.BadRequest("지정가 주문에는 주문 가격이 필요합니다")
+	}
+	if !req.Price.IsPositive() && s.prices != nil {
+		if price, ok := s.prices.LatestPrice(req.Symbol); ok {
+			req.Price = price
+		}
+	}
+
+	o, err := s.submit(req)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Infof("🧾 수동 주문 접수: %s %s %s (%s, %s)", o.Side, o.Quantity.String(), o.Symbol, o.Mode, o.Status)
+	return toOrderResponse(o), nil
+}
+
+// ClosePosition 보유 종목 시장가 청산 주문 (긴급 청산, 노출을 줄이는 주문이라 주문 금액/가격 범위 검사 제외)
+// quantity는 증권사 잔고/모의 계좌 기준 보유 수량이며, 리스크 상태에 포지션이 없어도 이 수량까지는 청산으로 본다.
+func (s *ServiceImpl) ClosePosition(userID uuid.UUID, mode Mode, symbol, exchange string, quantity decimal.Decimal) (*dto.OrderResponse, error) {
+	return s.SubmitExit(&Request{
+		UserID:       userID.String(),
+		Symbol:       symbol,
+		Exchange:     exchange,
+		Mode:         mode,
+		Side:         SideSell,
+		Quantity:     quantity,
+		HeldQuantity: quantity,
+	})
+}
+
+// SubmitExit 보유 포지션 시장가 청산 주문 (노출을 줄이는 방향이면 주문 금액/가격 범위/미체결 주문 수 검사 제외)
+func (s *ServiceImpl) SubmitExit(req *Request) (*dto.OrderResponse, error) {
+	req.Type = TypeMarket
+	req.TimeInForce = TimeInForceDay
+	req.Liquidation = true
+	if s.prices != nil {
+		if price, ok := s.prices.LatestPrice(req.Symbol); ok {
+			req.Price = price
+		}
+	}
+
+	o, err := s.submit(req)
+	if err != nil {
+		return nil, err
+	}
+	logrus.Warnf("🧯 청산 주문 접수: %s %s %s (%s, %s)", o.Side, o.Quantity.String(), o.Symbol, o.Mode, o.Status)
+	return toOrderResponse(o), nil
+}
+
+// submit 실행 모드의 실행기로 주문 제출 후 저장된 주문 조회 (거부되면 거부 사유를 BadRequest로 반환)
+func (s *ServiceImpl) submit(req *Request) (*ent.Order, error) {
+	executor := s.executors[req.Mode]
+	if executor == nil {
+		return nil, utils.Internal("주문 제출 실패", fmt.Errorf("%s 주문 실행기가 설정되지 않았습니다", req.Mode))
+	}
+
+	update, err := executor.ExecuteOrder(context.Background(), req)
+	if update != nil && update.Status == StatusRejected {
+		return nil, utils.BadRequest(fmt.Sprintf("주문 거부: %s", update.RejectReason))
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	o, err := s.repository.GetByClientOrderID(update.ClientOrderID)
+	if err != nil {
+		return nil, fmt.Errorf("주문 조회 실패: %w", err)
+	}
+	if o == nil {
+		return nil, utils.Internal("주문 제출 실패", fmt.Errorf("주문 %s 저장 내역이 없습니다", update.ClientOrderID))
+	}
+	return o, nil
+}
+
+// GetOrders 사용자 주문 목록 조회
+func (s *ServiceImpl) GetOrders(userID string, q dto.GetOrderListQuery) (*dto.OrderListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	filter := Filter{
+		Status: Status(q.Status),
+		Mode:   Mode(q.Mode),
+		Symbol: q.Symbol,
+	}
+	if q.Status != "" && !isKnownStatus(filter.Status) {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 상태: %s", q.Status))
+	}
+	if q.Mode != "" && filter.Mode != ModeLive && filter.Mode != ModePaper {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 모드: %s", q.Mode))
+	}
+	if q.StrategyID != "" {
+		strategyID, err := uuid.Parse(q.StrategyID)
+		if err != nil {
+			return nil, utils.BadRequest("잘못된 전략 ID 형식입니다")
+		}
+		filter.StrategyID = &strategyID
+	}
+
+	orders, err := s.repository.GetByUserID(uid, filter, q.Limit, q.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("주문 목록 조회 실패: %w", err)
+	}
+
+	total, err := s.repository.CountByUser(uid, filter)
+	if err != nil {
+		return nil, fmt.Errorf("주문 수 조회 실패: %w", err)
+	}
+
+	response := &dto.OrderListResponse{
+		Orders: make([]*dto.OrderResponse, 0, len(orders)),
+		Total:  total,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+	for _, o := range orders {
+		response.Orders = append(response.Orders, toOrderResponse(o))
+	}
+
+	return response, nil
+}
+
+// GetOrder 주문 상세 조회
+func (s *ServiceImpl) GetOrder(userID, id string) (*dto.OrderResponse, error) {
+	o, err := s.getOwnedOrder(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toOrderResponse(o), nil
+}
+
+// CancelOrder 미체결 주문 취소 요청
+func (s *ServiceImpl) CancelOrder(userID, id string) (*dto.OrderResponse, error) {
+	o, err := s.getOwnedOrder(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := ValidateTransition(Status(o.Status), StatusCanceled); err != nil {
+		return nil, err
+	}
+	canceler := s.executors[Mode(o.Mode)]
+	if canceler == nil {
+		return nil, utils.Internal("주문 취소 실패", fmt.Errorf("%s 주문 실행기가 설정되지 않았습니다", o.Mode))
+	}
+
+	if err := canceler.CancelOrder(context.Background(), o.ClientOrderID); err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("🚫 주문 취소 요청: %s (%s)", o.ID, o.ClientOrderID)
+	// 최종 상태는 실행기의 체결 내역 동기화를 통해 반영됨
+	return toOrderResponse(o), nil
+}
+
+// CancelOpenOrders 사용자의 미체결 주문 전체 취소 요청 (실계좌/모의투자)
+// 한 주문의 취소 실패가 나머지 취소를 막지 않으며, 취소 요청한 주문 수를 반환한다.
+func (s *ServiceImpl) CancelOpenOrders(userID uuid.UUID) (int, error) {
+	orders, err := s.repository.GetOpenOrders(userID)
+	if err != nil {
+		return 0, fmt.Errorf("미체결 주문 조회 실패: %w", err)
+	}
+
+	canceled := 0
+	var failed []string
+	for _, o := range orders {
+		canceler := s.executors[Mode(o.Mode)]
+		if canceler == nil {
+			failed = append(failed, o.ClientOrderID)
+			continue
+		}
+		if err := canceler.CancelOrder(context.Background(), o.ClientOrderID); err != nil {
+			logrus.Errorf("❌ 주문 취소 실패 (%s): %v", o.ClientOrderID, err)
+			failed = append(failed, o.ClientOrderID)
+			continue
+		}
+		canceled++
+	}
+
+	if len(failed) > 0 {
+		return canceled, fmt.Errorf("주문 %d건 취소 실패: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return canceled, nil
+}
+
+// HandleUpdate 실행기 주문 상태 변경 저장
+func (s *ServiceImpl) HandleUpdate(update Update) {
+	existing, err := s.repository.GetByClientOrderID(update.ClientOrderID)
+	if err != nil {
+		logrus.Errorf("❌ 주문 조회 실패 (%s): %v", update.ClientOrderID, err)
+		return
+	}
+
+	if existing == nil {
+		if _, err := s.repository.Create(&update); err != nil {
+			logrus.Errorf("❌ 주문 저장 실패 (%s): %v", update.ClientOrderID, err)
+		}
+		return
+	}
+
+	current := Status(existing.Status)
+	if current == update.Status && update.LastFillQuantity.IsZero() {
+		return
+	}
+	if err := ValidateTransition(current, update.Status); err != nil {
+		logrus.Warnf("⚠️  주문 상태 변경 무시 (%s): %v", update.ClientOrderID, err)
+		return
+	}
+
+	if _, err := s.repository.ApplyUpdate(existing.ID, &update); err != nil {
+		logrus.Errorf("❌ 주문 상태 갱신 실패 (%s): %v", update.ClientOrderID, err)
+	}
+}
+
+// OpenOrders 실행 모드의 전체 사용자 미체결 주문을 실행기 주문 상태로 변환
+func (s *ServiceImpl) OpenOrders(mode Mode) ([]Update, error) {
+	orders, err := s.repository.GetOpenOrdersByMode(mode)
+	if err != nil {
+		return nil, fmt.Errorf("미체결 주문 조회 실패: %w", err)
+	}
+
+	updates := make([]Update, 0, len(orders))
+	for _, o := range orders {
+		updates = append(updates, toUpdate(o))
+	}
+	return updates, nil
+}
+
+// getOwnedOrder 요청 사용자 소유의 주문 조회
+func (s *ServiceImpl) getOwnedOrder(userID, id string) (*ent.Order, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	orderID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 주문 ID 형식입니다")
+	}
+
+	o, err := s.repository.GetByID(orderID)
+	if err != nil {
+		return nil, fmt.Errorf("주문 조회 실패: %w", err)
+	}
+	if o == nil || o.UserID != uid {
+		return nil, utils.NotFound("order", "주문을 찾을 수 없습니다")
+	}
+
+	return o, nil
+}
+
+// isKnownStatus 정의된 주문 상태인지 확인
+func isKnownStatus(status Status) bool {
+	switch status {
+	case StatusNew, StatusSubmitted, StatusPartiallyFilled, StatusFilled, StatusCanceled, StatusRejected:
+		return true
+	}
+	return false
+}
+
+// toOrderResponse ent.Order를 응답 DTO로 변환
+func toOrderResponse(o *ent.Order) *dto.OrderResponse {
+	return &dto.OrderResponse{
+		ID:             o.ID,
+		UserID:         o.UserID,
+		StrategyID:     o.StrategyID,
+		ClientOrderID:  o.ClientOrderID,
+		BrokerOrderID:  o.BrokerOrderID,
+		Symbol:         o.Symbol,
+		Exchange:       o.Exchange,
+		Mode:           string(o.Mode),
+		Side:           string(o.Side),
+		Type:           string(o.OrderType),
+		TimeInForce:    string(o.TimeInForce),
+		Quantity:       o.Quantity,
+		Price:          o.Price,
+		FilledQuantity: o.FilledQuantity,
+		AvgFillPrice:   o.AvgFillPrice,
+		Status:         string(o.Status),
+		RejectReason:   o.RejectReason,
+		SubmittedAt:    o.SubmittedAt,
+		CompletedAt:    o.CompletedAt,
+		CreatedAt:      o.CreatedAt,
+		UpdatedAt:      o.UpdatedAt,
+	}
+}
+
+// toUpdate 저장된 주문을 실행기 주문 상태로 변환 (Timestamp는 제출 시각, 미제출이면 접수 시각)
+func toUpdate(o *ent.Order) Update {
+	update := Update{
+		ClientOrderID:  o.ClientOrderID,
+		UserID:         o.UserID.String(),
+		Symbol:         o.Symbol,
+		Exchange:       o.Exchange,
+		Mode:           Mode(o.Mode),
+		Side:           Side(o.Side),
+		Type:           Type(o.OrderType),
+		TimeInForce:    TimeInForce(o.TimeInForce),
+		Quantity:       o.Quantity,
+		Status:         Status(o.Status),
+		FilledQuantity: o.FilledQuantity,
+	}
+	if o.StrategyID != nil {
+		update.StrategyID = o.StrategyID.String()
+	}
+	if o.BrokerOrderID != nil {
+		update.BrokerOrderID = *o.BrokerOrderID
+	}
+	if o.Price != nil {
+		update.Price = *o.Price
+	}
+	if o.AvgFillPrice != nil {
+		update.AvgFillPrice = *o.AvgFillPrice
+	}
+
+	switch {
+	case o.SubmittedAt != nil:
+		update.Timestamp = *o.SubmittedAt
+	case o.CreatedAt != nil:
+		update.Timestamp = *o.CreatedAt
+	default:
+		update.Timestamp = time.Now()
+	}
+	return update
+}
@@ -0,0 +1,53 @@
+package order
+
+import (
+	"fmt"
+	"time"
+
+	"auto-trader/pkg/shared/calendar"
+	"auto-trader/pkg/shared/config"
+)
+
+// SessionGuard 거래 세션 외 주문 처리 규칙 (trading.sessions, trading.queue_off_session)
+//
+// 허용 세션 안의 주문은 바로 제출하고, 세션 밖에서는 당일 유효 지정가 주문만
+// 다음 개장까지 대기시킨다. 시장가/IOC 주문은 기준가가 의미 없어지므로 거부한다.
+type SessionGuard struct {
+	Calendar *calendar.Calendar
+	Sessions []calendar.Session
+	Queue    bool
+}
+
+// NewSessionGuard 트레이딩 설정으로 세션 규칙 생성
+func NewSessionGuard(cfg config.TradingConfig) (*SessionGuard, error) {
+	sessions, err := calendar.ParseSessions(cfg.Sessions)
+	if err != nil {
+		return nil, fmt.Errorf("주문 허용 세션(trading.sessions) 해석 실패: %w", err)
+	}
+	return &SessionGuard{
+		Calendar: calendar.New(),
+		Sessions: sessions,
+		Queue:    cfg.QueueOffSession,
+	}, nil
+}
+
+// Admit now 시점의 주문 처리 방법
+// 바로 제출할 수 있으면 zero 시각, 대기해야 하면 제출할 시각, 받을 수 없으면 오류(거부 사유)를 반환한다.
+func (g *SessionGuard) Admit(now time.Time, orderType Type, timeInForce TimeInForce) (time.Time, error) {
+	if g.Open(now) {
+		return time.Time{}, nil
+	}
+
+	next := g.Calendar.NextOpen(now, g.Sessions...)
+	session := g.Calendar.SessionAt(now)
+	if g.Queue && orderType == TypeLimit && timeInForce == TimeInForceDay && !next.IsZero() {
+		return next, nil
+	}
+	return time.Time{}, fmt.Errorf("주문 가능한 거래 세션이 아닙니다 (현재: %s, 다음 개장: %s)",
+		session, next.In(g.Calendar.Location()).Format("2006-01-02 15:04 MST"))
+}
+
+// Open now 시점에 주문 허용 세션이 진행 중인지 (nil이면 항상 허용)
+func (g *SessionGuard) Open(now time.Time) bool {
+	return g == nil || g.Calendar.IsOpen(now, g.Sessions...)
+}
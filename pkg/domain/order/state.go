@@ -0,0 +1,33 @@
+package order
+
+import (
+	"fmt"
+
+	"auto-trader/pkg/shared/utils"
+)
+
+// transitions 허용되는 주문 상태 전이
+// NEW → SUBMITTED → PARTIALLY_FILLED → FILLED / CANCELED / REJECTED
+var transitions = map[Status][]Status{
+	StatusNew:             {StatusSubmitted, StatusCanceled, StatusRejected},
+	StatusSubmitted:       {StatusPartiallyFilled, StatusFilled, StatusCanceled, StatusRejected},
+	StatusPartiallyFilled: {StatusPartiallyFilled, StatusFilled, StatusCanceled},
+}
+
+// CanTransition from 상태에서 to 상태로 전이 가능한지 확인
+func CanTransition(from, to Status) bool {
+	for _, next := range transitions[from] {
+		if next == to {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateTransition 상태 전이 검증
+func ValidateTransition(from, to Status) error {
+	if !CanTransition(from, to) {
+		return utils.BadRequest(fmt.Sprintf("허용되지 않는 주문 상태 전이: %s → %s", from, to))
+	}
+	return nil
+}
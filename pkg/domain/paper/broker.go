@@ -0,0 +1,676 @@
+package paper
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// defaultMatchInterval 미체결 지정가 주문 기본 확인 주기
+const defaultMatchInterval = 2 * time.Second
+
+// Broker 가상 계좌로 주문을 체결하는 프로세스 내 모의투자 실행기 (strategy.Executor 구현)
+// 실시간 시세로 체결하고, 실시간 시세가 없으면 저장된 최근 1분봉 종가를 사용한다.
+type Broker struct {
+	repository    Repository
+	quotes        QuoteSource
+	history       strategy.HistoryProvider
+	fill          order.FillSimulator
+	initialCash   decimal.Decimal
+	matchInterval time.Duration
+	guard         *order.SessionGuard
+	risk          order.RiskChecker
+	openOrders    order.OpenOrderSource // 재시작 시 복구할 미체결 주문
+
+	accounts map[uuid.UUID]*account
+	pending  map[string]*pendingOrder // clientOrderID → 미체결 지정가 주문
+	handlers []order.UpdateHandler
+	sequence int64
+	stopChan chan struct{}
+	running  bool
+	mutex    sync.Mutex
+}
+
+// account 메모리에 적재된 사용자 모의투자 계좌
+type account struct {
+	id          uuid.UUID
+	initialCash decimal.Decimal
+	sim         *order.SimAccount
+}
+
+// pendingOrder 시세가 지정가에 닿기를 기다리는 주문
+type pendingOrder struct {
+	update  order.Update
+	userID  uuid.UUID
+	session string // 주문 거래일 (당일 유효 주문 만료 판단)
+}
+
+// NewBroker 새로운 모의투자 실행기 생성
+func NewBroker(repository Repository, quotes QuoteSource, history strategy.HistoryProvider, cfg config.PaperConfig) *Broker {
+	matchInterval := cfg.MatchInterval
+	if matchInterval <= 0 {
+		matchInterval = defaultMatchInterval
+	}
+
+	return &Broker{
+		repository: repository,
+		quotes:     quotes,
+		history:    history,
+		fill: order.FillSimulator{
+			Model:          order.FillOnClose,
+			SlippageBps:    decimal.NewFromFloat(cfg.SlippageBps),
+			CommissionRate: decimal.NewFromFloat(cfg.CommissionRate),
+			MinCommission:  decimal.NewFromFloat(cfg.MinCommission),
+		},
+		initialCash:   decimal.NewFromFloat(cfg.InitialCash),
+		matchInterval: matchInterval,
+		accounts:      make(map[uuid.UUID]*account),
+		pending:       make(map[string]*pendingOrder),
+		sequence:      time.Now().Unix(),
+		stopChan:      make(chan struct{}),
+	}
+}
+
+// SetSessionGuard 거래 세션 외 주문 처리 규칙 설정 (nil이면 세션 확인 없이 체결)
+func (b *Broker) SetSessionGuard(guard *order.SessionGuard) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.guard = guard
+}
+
+// SetRiskChecker 주문 체결 전 리스크 검사 설정 (nil이면 검사 없이 체결)
+func (b *Broker) SetRiskChecker(checker order.RiskChecker) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.risk = checker
+}
+
+// SetOpenOrderSource 재시작 시 복구할 미체결 모의 주문 조회 설정 (nil이면 복구하지 않음)
+func (b *Broker) SetOpenOrderSource(source order.OpenOrderSource) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.openOrders = source
+}
+
+// Start 저장된 미체결 주문 복구 후 미체결 지정가 주문 확인 루프 시작
+func (b *Broker) Start() {
+	b.mutex.Lock()
+	if b.running {
+		b.mutex.Unlock()
+		return
+	}
+	b.running = true
+	source := b.openOrders
+	b.mutex.Unlock()
+
+	if source != nil {
+		b.restore(source)
+	}
+	go b.matchLoop()
+	logrus.Info("🧾 모의투자 실행기 시작")
+}
+
+// restore 이전 실행에서 대기 중이던 모의 주문 복구
+// 체결은 저장됐지만 상태를 전달하지 못한 주문은 저장된 체결 내역으로 마무리하고,
+// 제출된 지정가 주문은 원래 거래일 기준으로 다시 대기시킨다 (지난 거래일 주문은 다음 확인 때 만료).
+// 접수(NEW) 상태 주문은 리스크 검사와 거래 세션 규칙을 다시 거쳐 대기시킨다.
+func (b *Broker) restore(source order.OpenOrderSource) {
+	updates, err := source.OpenOrders(order.ModePaper)
+	if err != nil {
+		logrus.Errorf("❌ 미체결 모의 주문 복구 실패: %v", err)
+		return
+	}
+
+	restored, filled := 0, 0
+	for _, update := range updates {
+		userID, err := uuid.Parse(update.UserID)
+		if err != nil {
+			_, _ = b.reject(update, "모의투자 주문에 사용자 ID가 없습니다")
+			continue
+		}
+
+		trade, err := b.repository.FindTrade(update.ClientOrderID)
+		if err != nil {
+			logrus.Errorf("❌ 미체결 모의 주문 복구 실패 (%s): %v", update.ClientOrderID, err)
+			continue
+		}
+		if trade != nil {
+			b.settle(update, trade.Quantity, trade.Price, trade.ExecutedAt)
+			filled++
+			continue
+		}
+
+		session := b.sessionOf(update)
+		if update.Status == order.StatusNew {
+			var ok bool
+			if update, session, ok = b.resubmit(update); !ok {
+				continue
+			}
+		}
+		b.addPending(update, userID, session)
+		restored++
+	}
+
+	if restored > 0 || filled > 0 {
+		logrus.Infof("♻️  미체결 모의 주문 복구: 대기 %d건, 체결 확인 %d건", restored, filled)
+	}
+}
+
+// resubmit 접수(NEW) 상태로 남은 주문을 리스크 검사와 거래 세션 규칙을 거쳐 다시 제출
+// 즉시 체결 조건(IOC) 주문은 접수 시점의 체결 기회가 지났으므로 취소한다.
+func (b *Broker) resubmit(update order.Update) (order.Update, string, bool) {
+	if update.TimeInForce == order.TimeInForceIOC {
+		_, _ = b.cancel(update)
+		return update, "", false
+	}
+
+	b.mutex.Lock()
+	guard := b.guard
+	risk := b.risk
+	b.mutex.Unlock()
+
+	if risk != nil {
+		if err := risk.CheckOrder(update); err != nil {
+			_, _ = b.reject(update, err.Error())
+			return update, "", false
+		}
+	}
+
+	now := time.Now()
+	releaseAt, err := guard.Admit(now, update.Type, update.TimeInForce)
+	if err != nil {
+		_, _ = b.reject(update, err.Error())
+		return update, "", false
+	}
+	if releaseAt.IsZero() {
+		releaseAt = now
+	}
+
+	update.Status = order.StatusSubmitted
+	update.Timestamp = now
+	b.emit(update)
+	return update, sessionDate(releaseAt), true
+}
+
+// sessionOf 제출된 주문의 체결 대기 거래일 (세션 외에 제출됐으면 다음 개장 거래일)
+func (b *Broker) sessionOf(update order.Update) string {
+	b.mutex.Lock()
+	guard := b.guard
+	b.mutex.Unlock()
+
+	if releaseAt, err := guard.Admit(update.Timestamp, update.Type, update.TimeInForce); err == nil && !releaseAt.IsZero() {
+		return sessionDate(releaseAt)
+	}
+	return sessionDate(update.Timestamp)
+}
+
+// Stop 확인 루프 중지
+func (b *Broker) Stop() {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if !b.running {
+		return
+	}
+	b.running = false
+	close(b.stopChan)
+	b.stopChan = make(chan struct{})
+}
+
+// OnOrderUpdate 주문 상태 변경 콜백 등록
+func (b *Broker) OnOrderUpdate(handler order.UpdateHandler) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.handlers = append(b.handlers, handler)
+}
+
+// ExecuteOrder 주문 접수 후 현재 시세로 즉시 체결 시도 (NEW → SUBMITTED → FILLED/CANCELED/REJECTED)
+// 체결되지 않은 당일 유효 지정가 주문은 시세가 지정가에 닿을 때까지 대기한다.
+func (b *Broker) ExecuteOrder(ctx context.Context, req *order.Request) (*order.Update, error) {
+	if req.Symbol == "" {
+		return nil, utils.BadRequest("종목 코드가 필요합니다")
+	}
+	if req.Side != order.SideBuy && req.Side != order.SideSell {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 주문 방향: %s", req.Side))
+	}
+	userID, err := uuid.Parse(req.UserID)
+	if err != nil {
+		return nil, utils.BadRequest("모의투자 주문에는 사용자 ID가 필요합니다")
+	}
+
+	clientOrderID := req.ClientOrderID
+	if clientOrderID == "" {
+		clientOrderID = uuid.New().String()
+	}
+	orderType := req.Type
+	if orderType == "" {
+		orderType = order.TypeMarket
+	}
+	timeInForce := req.TimeInForce
+	if timeInForce == "" {
+		timeInForce = order.TimeInForceDay
+	}
+
+	b.mutex.Lock()
+	b.sequence++
+	brokerOrderID := fmt.Sprintf("P%d", b.sequence)
+	b.mutex.Unlock()
+
+	update := order.Update{
+		ClientOrderID: clientOrderID,
+		BrokerOrderID: brokerOrderID,
+		UserID:        req.UserID,
+		StrategyID:    req.StrategyID,
+		Symbol:        req.Symbol,
+		Exchange:      req.Exchange,
+		Mode:          order.ModePaper,
+		Side:          req.Side,
+		Type:          orderType,
+		TimeInForce:   timeInForce,
+		Quantity:      req.Quantity.Floor(),
+		Price:         req.Price,
+		Status:        order.StatusNew,
+		Liquidation:   req.Liquidation,
+		HeldQuantity:  req.HeldQuantity,
+		Timestamp:     time.Now(),
+	}
+	b.emit(update)
+
+	if !update.Quantity.IsPositive() {
+		return b.reject(update, fmt.Sprintf("주문 수량이 1주 미만입니다: %s", req.Quantity.String()))
+	}
+
+	b.mutex.Lock()
+	guard := b.guard
+	risk := b.risk
+	b.mutex.Unlock()
+
+	if risk != nil {
+		if err := risk.CheckOrder(update); err != nil {
+			return b.reject(update, err.Error())
+		}
+	}
+
+	releaseAt, err := guard.Admit(time.Now(), orderType, timeInForce)
+	if err != nil {
+		return b.reject(update, err.Error())
+	}
+
+	update.Status = order.StatusSubmitted
+	update.Timestamp = time.Now()
+	b.emit(update)
+
+	// 세션 외 지정가 주문은 개장 거래일까지 체결 확인 없이 대기
+	if !releaseAt.IsZero() {
+		b.addPending(update, userID, sessionDate(releaseAt))
+		logrus.Infof("⏳ 거래 세션 외 모의 주문 대기: %s %s %s @ %s (개장: %s)",
+			update.Side, update.Quantity.String(), update.Symbol, update.Price.String(), releaseAt.Format(time.RFC3339))
+		return &update, nil
+	}
+
+	quote, err := b.quote(req.Symbol)
+	if err != nil {
+		return b.reject(update, err.Error())
+	}
+
+	if price, ok := b.fill.Match(requestOf(update), quote, true); ok {
+		return b.execute(userID, update, price, quote)
+	}
+	if timeInForce == order.TimeInForceIOC {
+		return b.cancel(update)
+	}
+
+	b.addPending(update, userID, sessionDate(time.Now()))
+
+	logrus.Infof("🧾 모의 지정가 주문 대기: %s %s %s @ %s", update.Side, update.Quantity.String(), update.Symbol, update.Price.String())
+	return &update, nil
+}
+
+// addPending 지정가 주문을 session 거래일 동안 체결 대기 목록에 추가
+func (b *Broker) addPending(update order.Update, userID uuid.UUID, session string) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.pending[update.ClientOrderID] = &pendingOrder{
+		update:  update,
+		userID:  userID,
+		session: session,
+	}
+}
+
+// CancelOrder 미체결 주문 취소
+func (b *Broker) CancelOrder(ctx context.Context, clientOrderID string) error {
+	b.mutex.Lock()
+	p, exists := b.pending[clientOrderID]
+	if exists {
+		delete(b.pending, clientOrderID)
+	}
+	b.mutex.Unlock()
+
+	if !exists {
+		return utils.NotFound("order", "주문을 찾을 수 없습니다")
+	}
+
+	_, err := b.cancel(p.update)
+	return err
+}
+
+// Snapshot 사용자 모의투자 계좌 현황 (현금, 보유 종목 복사본)
+func (b *Broker) Snapshot(userID uuid.UUID) (*order.SimAccount, decimal.Decimal, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	acct, err := b.loadAccount(userID)
+	if err != nil {
+		return nil, decimal.Zero, err
+	}
+
+	snapshot := order.NewSimAccount(acct.sim.Cash)
+	for symbol, pos := range acct.sim.Positions {
+		copied := *pos
+		snapshot.Positions[symbol] = &copied
+	}
+	return snapshot, acct.initialCash, nil
+}
+
+// AccountID 사용자 모의투자 계좌 ID (없으면 생성)
+func (b *Broker) AccountID(userID uuid.UUID) (uuid.UUID, error) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	acct, err := b.loadAccount(userID)
+	if err != nil {
+		return uuid.Nil, err
+	}
+	return acct.id, nil
+}
+
+// ResetAccount 계좌를 새 현금으로 초기화하고 사용자의 미체결 주문 취소
+func (b *Broker) ResetAccount(userID uuid.UUID, cash decimal.Decimal) error {
+	if !cash.IsPositive() {
+		cash = b.initialCash
+	}
+
+	b.mutex.Lock()
+	acct, err := b.loadAccount(userID)
+	if err != nil {
+		b.mutex.Unlock()
+		return err
+	}
+	if err := b.repository.ResetAccount(acct.id, cash); err != nil {
+		b.mutex.Unlock()
+		return err
+	}
+	acct.initialCash = cash
+	acct.sim = order.NewSimAccount(cash)
+
+	var canceled []*pendingOrder
+	for clientOrderID, p := range b.pending {
+		if p.userID == userID {
+			canceled = append(canceled, p)
+			delete(b.pending, clientOrderID)
+		}
+	}
+	b.mutex.Unlock()
+
+	for _, p := range canceled {
+		_, _ = b.cancel(p.update)
+	}
+
+	logrus.Infof("🧾 모의투자 계좌 초기화: %s (현금 %s)", userID, cash.StringFixed(2))
+	return nil
+}
+
+// LatestPrice 평가용 현재가 (실시간 시세가 없으면 저장된 최근 봉 종가)
+func (b *Broker) LatestPrice(symbol string) (decimal.Decimal, bool) {
+	quote, err := b.quote(symbol)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return quote.Close, true
+}
+
+// loadAccount 메모리 계좌 조회 (없으면 DB에서 적재, mutex 보유 상태에서 호출)
+func (b *Broker) loadAccount(userID uuid.UUID) (*account, error) {
+	if acct, ok := b.accounts[userID]; ok {
+		return acct, nil
+	}
+
+	record, positions, err := b.repository.GetOrCreateAccount(userID, b.initialCash)
+	if err != nil {
+		return nil, err
+	}
+
+	sim := order.NewSimAccount(record.Cash)
+	for _, pos := range positions {
+		sim.Positions[pos.Symbol] = &order.Position{Quantity: pos.Quantity, AvgCost: pos.AvgCost}
+	}
+	acct := &account{id: record.ID, initialCash: record.InitialCash, sim: sim}
+	b.accounts[userID] = acct
+	return acct, nil
+}
+
+// execute 가상 계좌에 체결 반영 후 저장 (저장 실패 시 계좌 상태 복원 후 거부)
+func (b *Broker) execute(userID uuid.UUID, update order.Update, price decimal.Decimal, quote order.Quote) (*order.Update, error) {
+	b.mutex.Lock()
+	acct, err := b.loadAccount(userID)
+	if err != nil {
+		b.mutex.Unlock()
+		return b.reject(update, err.Error())
+	}
+
+	prevCash := acct.sim.Cash
+	var prevPos *order.Position
+	if pos, ok := acct.sim.Positions[update.Symbol]; ok {
+		copied := *pos
+		prevPos = &copied
+	}
+
+	fill, err := acct.sim.ApplyFill(b.fill, update.Symbol, update.Side, update.Quantity, price)
+	if err != nil {
+		b.mutex.Unlock()
+		return b.reject(update, err.Error())
+	}
+
+	var position *order.Position
+	if pos, ok := acct.sim.Positions[update.Symbol]; ok {
+		copied := *pos
+		position = &copied
+	}
+	trade := &Trade{
+		StrategyID:    update.StrategyID,
+		ClientOrderID: update.ClientOrderID,
+		Symbol:        update.Symbol,
+		Side:          update.Side,
+		Quantity:      fill.Quantity,
+		Price:         fill.Price,
+		Commission:    fill.Commission,
+		RealizedPnL:   fill.RealizedPnL,
+		ExecutedAt:    time.Now(),
+	}
+	if err := b.repository.RecordFill(acct.id, acct.sim.Cash, update.Symbol, position, trade); err != nil {
+		acct.sim.Cash = prevCash
+		if prevPos != nil {
+			acct.sim.Positions[update.Symbol] = prevPos
+		} else {
+			delete(acct.sim.Positions, update.Symbol)
+		}
+		b.mutex.Unlock()
+		return b.reject(update, err.Error())
+	}
+	b.mutex.Unlock()
+
+	logrus.Infof("🧾 모의 체결: %s %s %s @ %s (수수료 %s)", update.Side, fill.Quantity.String(), update.Symbol, fill.Price.String(), fill.Commission.String())
+	return b.settle(update, fill.Quantity, fill.Price, trade.ExecutedAt), nil
+}
+
+// settle 체결 결과 전달 (보유 수량까지만 매도돼 수량이 모자라면 부분 체결 후 잔량 취소)
+func (b *Broker) settle(update order.Update, quantity, price decimal.Decimal, executedAt time.Time) *order.Update {
+	update.Status = order.StatusFilled
+	update.FilledQuantity = quantity
+	update.AvgFillPrice = price
+	update.LastFillQuantity = quantity
+	update.LastFillPrice = price
+	update.Timestamp = executedAt
+	if quantity.GreaterThanOrEqual(update.Quantity) {
+		b.emit(update)
+		return &update
+	}
+
+	update.Status = order.StatusPartiallyFilled
+	b.emit(update)
+
+	logrus.Warnf("⚠️  보유 수량 초과 모의 매도 잔량 취소: %s %s/%s", update.Symbol, quantity.String(), update.Quantity.String())
+	update.LastFillQuantity = decimal.Zero
+	update.LastFillPrice = decimal.Zero
+	canceled, _ := b.cancel(update)
+	return canceled
+}
+
+func (b *Broker) matchLoop() {
+	b.mutex.Lock()
+	stopChan := b.stopChan
+	b.mutex.Unlock()
+
+	ticker := time.NewTicker(b.matchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			b.matchPending()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// matchPending 대기 중인 지정가 주문을 현재 시세로 체결 시도 (거래일이 지난 당일 유효 주문은 만료)
+func (b *Broker) matchPending() {
+	b.mutex.Lock()
+	pending := make([]*pendingOrder, 0, len(b.pending))
+	for _, p := range b.pending {
+		pending = append(pending, p)
+	}
+	guard := b.guard
+	b.mutex.Unlock()
+
+	now := time.Now()
+	today := sessionDate(now)
+	open := guard.Open(now)
+	for _, p := range pending {
+		if p.session < today {
+			if b.take(p.update.ClientOrderID) {
+				_, _ = b.cancel(p.update)
+			}
+			continue
+		}
+		// 개장 대기 주문(이후 거래일)과 세션 외 시간에는 체결하지 않음
+		if p.session > today || !open {
+			continue
+		}
+
+		quote, err := b.quote(p.update.Symbol)
+		if err != nil {
+			continue
+		}
+		price, ok := b.fill.Match(requestOf(p.update), quote, true)
+		if !ok || !b.take(p.update.ClientOrderID) {
+			continue
+		}
+		_, _ = b.execute(p.userID, p.update, price, quote)
+	}
+}
+
+// take 대기 주문을 목록에서 꺼냄 (취소와 동시에 체결되지 않도록 한 번만 성공)
+func (b *Broker) take(clientOrderID string) bool {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if _, ok := b.pending[clientOrderID]; !ok {
+		return false
+	}
+	delete(b.pending, clientOrderID)
+	return true
+}
+
+// quote 체결 기준 시세 (실시간 시세 → 저장된 최근 1분봉 종가 순)
+func (b *Broker) quote(symbol string) (order.Quote, error) {
+	if b.quotes != nil {
+		if data, err := b.quotes.GetCurrentPrice(symbol); err == nil && data.Price.IsPositive() {
+			timestamp := data.Timestamp
+			if timestamp.IsZero() {
+				timestamp = time.Now()
+			}
+			return order.QuoteFromPrice(data.Price, timestamp), nil
+		}
+	}
+
+	if b.history != nil {
+		candles, err := b.history.GetRecentCandles(symbol, time.Minute, 1)
+		if err == nil && len(candles) > 0 {
+			last := candles[len(candles)-1]
+			return order.QuoteFromPrice(decimal.NewFromFloat(last.Close), last.Time.Add(time.Minute)), nil
+		}
+	}
+
+	return order.Quote{}, fmt.Errorf("시세를 조회할 수 없습니다: %s", symbol)
+}
+
+func (b *Broker) cancel(update order.Update) (*order.Update, error) {
+	update.Status = order.StatusCanceled
+	update.Timestamp = time.Now()
+	b.emit(update)
+	return &update, nil
+}
+
+func (b *Broker) reject(update order.Update, reason string) (*order.Update, error) {
+	update.Status = order.StatusRejected
+	update.RejectReason = reason
+	update.Timestamp = time.Now()
+	b.emit(update)
+
+	logrus.Warnf("⚠️  모의 주문 거부: %s %s %s (%s)", update.Side, update.Quantity.String(), update.Symbol, reason)
+	return &update, fmt.Errorf("주문 거부: %s", reason)
+}
+
+// emit 등록된 콜백에 주문 상태 전달
+func (b *Broker) emit(update order.Update) {
+	b.mutex.Lock()
+	handlers := make([]order.UpdateHandler, len(b.handlers))
+	copy(handlers, b.handlers)
+	b.mutex.Unlock()
+
+	for _, handler := range handlers {
+		handler(update)
+	}
+}
+
+// requestOf 체결 판단에 필요한 주문 조건만 추출
+func requestOf(update order.Update) *order.Request {
+	return &order.Request{
+		Side:  update.Side,
+		Type:  update.Type,
+		Price: update.Price,
+	}
+}
+
+// sessionDate 미국 시장 기준 거래일
+func sessionDate(t time.Time) string {
+	return t.In(marketdata.MarketLocation()).Format("20060102")
+}
@@ -0,0 +1,102 @@
+package paper
+
+import (
+	"auto-trader/pkg/domain/paper/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 모의투자 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 모의투자 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// GetAccount 모의투자 계좌 조회
+// @Summary 모의투자 계좌 조회
+// @Description 가상 현금, 보유 종목 평가금액과 수익률을 조회합니다 (계좌가 없으면 기본 초기 현금으로 생성)
+// @Tags paper
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Success 200 {object} utils.Response
+// @Failure 401 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /paper/account [get]
+func (ctrl *Controller) GetAccount(c *fiber.Ctx) error {
+	account, err := ctrl.service.GetAccount(utils.GetUserID(c))
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "모의투자 계좌 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, account)
+}
+
+// ResetAccount 모의투자 계좌 초기화
+// @Summary 모의투자 계좌 초기화
+// @Description 보유 종목을 비우고 가상 현금을 다시 설정합니다 (체결 내역은 보존, 미체결 주문은 취소)
+// @Tags paper
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param reset body dto.ResetAccountBody false "초기 현금"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /paper/account/reset [post]
+func (ctrl *Controller) ResetAccount(c *fiber.Ctx) error {
+	var req dto.ResetAccountBody
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "잘못된 요청 형식")
+		}
+	}
+
+	account, err := ctrl.service.ResetAccount(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "모의투자 계좌 초기화 실패")
+	}
+
+	return utils.SuccessResponse(c, account)
+}
+
+// GetTrades 모의 체결 내역 조회
+// @Summary 모의 체결 내역 조회
+// @Description 모의투자 체결 내역을 최신순으로 조회합니다
+// @Tags paper
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param symbol query string false "종목 심볼"
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /paper/trades [get]
+func (ctrl *Controller) GetTrades(c *fiber.Ctx) error {
+	var q dto.GetTradeListQuery
+	q.Symbol = c.Query("symbol")
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	trades, err := ctrl.service.GetTrades(utils.GetUserID(c), q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "모의 체결 내역 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, trades)
+}
@@ -0,0 +1,17 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// ResetAccountBody 모의투자 계좌 초기화 요청
+type ResetAccountBody struct {
+	InitialCash float64 `json:"initial_cash"` // 0이면 설정된 기본 초기 현금
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetTradeListQuery 모의 체결 내역 조회 쿼리 파라미터
+type GetTradeListQuery struct {
+	Symbol string `query:"symbol,omitempty" validate:"max=20"`
+	Limit  int    `query:"limit" validate:"min=1,max=100"`
+	Offset int    `query:"offset" validate:"min=0"`
+}
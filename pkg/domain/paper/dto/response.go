@@ -0,0 +1,50 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// AccountResponse 모의투자 계좌 현황
+type AccountResponse struct {
+	InitialCash decimal.Decimal     `json:"initial_cash"`
+	Cash        decimal.Decimal     `json:"cash"`
+	MarketValue decimal.Decimal     `json:"market_value"`
+	Equity      decimal.Decimal     `json:"equity"`
+	TotalReturn float64             `json:"total_return"` // 초기 현금 대비 수익률(%)
+	Positions   []*PositionResponse `json:"positions"`
+}
+
+// PositionResponse 모의투자 보유 종목
+type PositionResponse struct {
+	Symbol        string          `json:"symbol"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	AvgCost       decimal.Decimal `json:"avg_cost"`
+	CurrentPrice  decimal.Decimal `json:"current_price"`
+	MarketValue   decimal.Decimal `json:"market_value"`
+	UnrealizedPnL decimal.Decimal `json:"unrealized_pnl"`
+}
+
+// TradeResponse 모의 체결 내역
+type TradeResponse struct {
+	ID            uuid.UUID       `json:"id"`
+	StrategyID    *uuid.UUID      `json:"strategy_id,omitempty"`
+	ClientOrderID string          `json:"client_order_id"`
+	Symbol        string          `json:"symbol"`
+	Side          string          `json:"side"`
+	Quantity      decimal.Decimal `json:"quantity"`
+	Price         decimal.Decimal `json:"price"`
+	Commission    decimal.Decimal `json:"commission"`
+	RealizedPnL   decimal.Decimal `json:"realized_pnl"`
+	ExecutedAt    time.Time       `json:"executed_at"`
+}
+
+// TradeListResponse 모의 체결 내역 목록
+type TradeListResponse struct {
+	Trades []*TradeResponse `json:"trades"`
+	Total  int              `json:"total"`
+	Limit  int              `json:"limit"`
+	Offset int              `json:"offset"`
+}
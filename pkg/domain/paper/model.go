@@ -0,0 +1,28 @@
+package paper
+
+import (
+	"time"
+
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/strategy"
+
+	"github.com/shopspring/decimal"
+)
+
+// QuoteSource 모의 체결 기준 실시간 시세 공급자 (strategy.Collector와 같은 형태)
+type QuoteSource interface {
+	GetCurrentPrice(symbol string) (*strategy.PriceData, error)
+}
+
+// Trade 모의 체결 내역
+type Trade struct {
+	StrategyID    string
+	ClientOrderID string
+	Symbol        string
+	Side          order.Side
+	Quantity      decimal.Decimal
+	Price         decimal.Decimal
+	Commission    decimal.Decimal
+	RealizedPnL   decimal.Decimal
+	ExecutedAt    time.Time
+}
@@ -0,0 +1,220 @@
+package paper
+
+import (
+	"context"
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
+	"auto-trader/pkg/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Repository 모의투자 계좌 데이터 접근 인터페이스
+type Repository interface {
+	GetOrCreateAccount(userID uuid.UUID, initialCash decimal.Decimal) (*ent.PaperAccount, []*ent.PaperPosition, error)
+	RecordFill(accountID uuid.UUID, cash decimal.Decimal, symbol string, position *order.Position, trade *Trade) error
+	ResetAccount(accountID uuid.UUID, cash decimal.Decimal) error
+	GetTrades(accountID uuid.UUID, symbol string, limit, offset int) ([]*ent.PaperTrade, error)
+	FindTrade(clientOrderID string) (*ent.PaperTrade, error)
+	CountTrades(accountID uuid.UUID, symbol string) (int, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// GetOrCreateAccount 사용자 모의투자 계좌와 보유 종목 조회 (없으면 초기 현금으로 생성)
+func (r *EntRepository) GetOrCreateAccount(userID uuid.UUID, initialCash decimal.Decimal) (*ent.PaperAccount, []*ent.PaperPosition, error) {
+	ctx := r.getContext()
+
+	account, err := r.client.PaperAccount.Query().
+		Where(paperaccount.UserID(userID)).
+		Only(ctx)
+	if err != nil {
+		if !ent.IsNotFound(err) {
+			return nil, nil, fmt.Errorf("모의투자 계좌 조회 실패: %w", err)
+		}
+
+		account, err = r.client.PaperAccount.Create().
+			SetUserID(userID).
+			SetInitialCash(initialCash).
+			SetCash(initialCash).
+			Save(ctx)
+		if err != nil {
+			return nil, nil, fmt.Errorf("모의투자 계좌 생성 실패: %w", err)
+		}
+		return account, nil, nil
+	}
+
+	positions, err := r.client.PaperPosition.Query().
+		Where(paperposition.AccountID(account.ID)).
+		All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("모의투자 보유 종목 조회 실패: %w", err)
+	}
+	return account, positions, nil
+}
+
+// RecordFill 체결 후 현금/보유 종목을 갱신하고 체결 내역 저장 (position이 nil이면 전량 매도)
+func (r *EntRepository) RecordFill(accountID uuid.UUID, cash decimal.Decimal, symbol string, position *order.Position, trade *Trade) error {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("트랜잭션 시작 실패: %w", err)
+	}
+
+	if err := recordFill(ctx, tx, accountID, cash, symbol, position, trade); err != nil {
+		_ = tx.Rollback()
+		return err
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+	return nil
+}
+
+func recordFill(ctx context.Context, tx *ent.Tx, accountID uuid.UUID, cash decimal.Decimal, symbol string, position *order.Position, trade *Trade) error {
+	if err := tx.PaperAccount.UpdateOneID(accountID).
+		SetCash(cash).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("모의투자 현금 갱신 실패: %w", err)
+	}
+
+	if position == nil {
+		if _, err := tx.PaperPosition.Delete().
+			Where(paperposition.AccountID(accountID), paperposition.Symbol(symbol)).
+			Exec(ctx); err != nil {
+			return fmt.Errorf("모의투자 보유 종목 삭제 실패: %w", err)
+		}
+	} else {
+		updated, err := tx.PaperPosition.Update().
+			Where(paperposition.AccountID(accountID), paperposition.Symbol(symbol)).
+			SetQuantity(position.Quantity).
+			SetAvgCost(position.AvgCost).
+			Save(ctx)
+		if err != nil {
+			return fmt.Errorf("모의투자 보유 종목 갱신 실패: %w", err)
+		}
+		if updated == 0 {
+			if err := tx.PaperPosition.Create().
+				SetAccountID(accountID).
+				SetSymbol(symbol).
+				SetQuantity(position.Quantity).
+				SetAvgCost(position.AvgCost).
+				Exec(ctx); err != nil {
+				return fmt.Errorf("모의투자 보유 종목 저장 실패: %w", err)
+			}
+		}
+	}
+
+	create := tx.PaperTrade.Create().
+		SetAccountID(accountID).
+		SetClientOrderID(trade.ClientOrderID).
+		SetSymbol(trade.Symbol).
+		SetSide(papertrade.Side(trade.Side)).
+		SetQuantity(trade.Quantity).
+		SetPrice(trade.Price).
+		SetCommission(trade.Commission).
+		SetRealizedPnl(trade.RealizedPnL).
+		SetExecutedAt(trade.ExecutedAt)
+	if strategyID, err := uuid.Parse(trade.StrategyID); err == nil {
+		create.SetStrategyID(strategyID)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("모의투자 체결 내역 저장 실패: %w", err)
+	}
+	return nil
+}
+
+// ResetAccount 현금을 다시 설정하고 보유 종목 전체 삭제 (체결 내역은 보존)
+func (r *EntRepository) ResetAccount(accountID uuid.UUID, cash decimal.Decimal) error {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("트랜잭션 시작 실패: %w", err)
+	}
+
+	if _, err := tx.PaperPosition.Delete().
+		Where(paperposition.AccountID(accountID)).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("모의투자 보유 종목 삭제 실패: %w", err)
+	}
+	if err := tx.PaperAccount.UpdateOneID(accountID).
+		SetInitialCash(cash).
+		SetCash(cash).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("모의투자 계좌 초기화 실패: %w", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("트랜잭션 커밋 실패: %w", err)
+	}
+	return nil
+}
+
+// GetTrades 모의 체결 내역 조회 (최신순)
+func (r *EntRepository) GetTrades(accountID uuid.UUID, symbol string, limit, offset int) ([]*ent.PaperTrade, error) {
+	query := r.client.PaperTrade.Query().
+		Where(papertrade.AccountID(accountID))
+	if symbol != "" {
+		query.Where(papertrade.Symbol(symbol))
+	}
+
+	trades, err := query.
+		Order(ent.Desc(papertrade.FieldExecutedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("모의 체결 내역 조회 실패: %w", err)
+	}
+	return trades, nil
+}
+
+// FindTrade 주문의 모의 체결 내역 조회 (체결되지 않았으면 nil)
+func (r *EntRepository) FindTrade(clientOrderID string) (*ent.PaperTrade, error) {
+	trade, err := r.client.PaperTrade.Query().
+		Where(papertrade.ClientOrderID(clientOrderID)).
+		First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("모의 체결 내역 조회 실패: %w", err)
+	}
+	return trade, nil
+}
+
+// CountTrades 모의 체결 내역 수
+func (r *EntRepository) CountTrades(accountID uuid.UUID, symbol string) (int, error) {
+	query := r.client.PaperTrade.Query().
+		Where(papertrade.AccountID(accountID))
+	if symbol != "" {
+		query.Where(papertrade.Symbol(symbol))
+	}
+
+	count, err := query.Count(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("모의 체결 내역 수 조회 실패: %w", err)
+	}
+	return count, nil
+}
@@ -0,0 +1,139 @@
+package paper
+
+import (
+	"sort"
+
+	"auto-trader/pkg/domain/paper/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Service 모의투자 계좌 서비스 인터페이스
+type Service interface {
+	GetAccount(userID string) (*dto.AccountResponse, error)
+	ResetAccount(userID string, body dto.ResetAccountBody) (*dto.AccountResponse, error)
+	GetTrades(userID string, q dto.GetTradeListQuery) (*dto.TradeListResponse, error)
+}
+
+// ServiceImpl 모의투자 계좌 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	broker     *Broker
+}
+
+// NewService 새로운 모의투자 계좌 서비스 생성
+func NewService(repository Repository, broker *Broker) Service {
+	return &ServiceImpl{
+		repository: repository,
+		broker:     broker,
+	}
+}
+
+// GetAccount 모의투자 계좌 현황 조회 (보유 종목은 현재가로 평가)
+func (s *ServiceImpl) GetAccount(userID string) (*dto.AccountResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	account, initialCash, err := s.broker.Snapshot(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.AccountResponse{
+		InitialCash: initialCash,
+		Cash:        account.Cash,
+		MarketValue: decimal.Zero,
+		Positions:   make([]*dto.PositionResponse, 0, len(account.Positions)),
+	}
+	for symbol, pos := range account.Positions {
+		price, ok := s.broker.LatestPrice(symbol)
+		if !ok {
+			price = pos.AvgCost
+		}
+		marketValue := pos.Quantity.Mul(price)
+
+		response.MarketValue = response.MarketValue.Add(marketValue)
+		response.Positions = append(response.Positions, &dto.PositionResponse{
+			Symbol:        symbol,
+			Quantity:      pos.Quantity,
+			AvgCost:       pos.AvgCost,
+			CurrentPrice:  price,
+			MarketValue:   marketValue.Round(4),
+			UnrealizedPnL: price.Sub(pos.AvgCost).Mul(pos.Quantity).Round(4),
+		})
+	}
+	sort.Slice(response.Positions, func(i, j int) bool {
+		return response.Positions[i].Symbol < response.Positions[j].Symbol
+	})
+
+	response.MarketValue = response.MarketValue.Round(4)
+	response.Equity = response.Cash.Add(response.MarketValue)
+	if initialCash.IsPositive() {
+		response.TotalReturn, _ = response.Equity.Sub(initialCash).Div(initialCash).Mul(decimal.NewFromInt(100)).Float64()
+	}
+	return response, nil
+}
+
+// ResetAccount 모의투자 계좌 초기화 (보유 종목 삭제, 미체결 주문 취소)
+func (s *ServiceImpl) ResetAccount(userID string, body dto.ResetAccountBody) (*dto.AccountResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	if body.InitialCash < 0 {
+		return nil, utils.BadRequest("초기 현금은 0 이상이어야 합니다")
+	}
+
+	if err := s.broker.ResetAccount(uid, decimal.NewFromFloat(body.InitialCash)); err != nil {
+		return nil, err
+	}
+	return s.GetAccount(userID)
+}
+
+// GetTrades 모의 체결 내역 조회
+func (s *ServiceImpl) GetTrades(userID string, q dto.GetTradeListQuery) (*dto.TradeListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	accountID, err := s.broker.AccountID(uid)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := s.repository.GetTrades(accountID, q.Symbol, q.Limit, q.Offset)
+	if err != nil {
+		return nil, err
+	}
+	total, err := s.repository.CountTrades(accountID, q.Symbol)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &dto.TradeListResponse{
+		Trades: make([]*dto.TradeResponse, 0, len(trades)),
+		Total:  total,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+	for _, t := range trades {
+		response.Trades = append(response.Trades, &dto.TradeResponse{
+			ID:            t.ID,
+			StrategyID:    t.StrategyID,
+			ClientOrderID: t.ClientOrderID,
+			Symbol:        t.Symbol,
+			Side:          string(t.Side),
+			Quantity:      t.Quantity,
+			Price:         t.Price,
+			Commission:    t.Commission,
+			RealizedPnL:   t.RealizedPnl,
+			ExecutedAt:    t.ExecutedAt,
+		})
+	}
+	return response, nil
+}
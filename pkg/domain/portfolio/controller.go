@@ -1,7 +1,11 @@
 package portfolio
 
 import (
+	"bufio"
+	"encoding/json"
+	"fmt"
 	"strings"
+	"time"
 
 	"auto-trader/pkg/domain/portfolio/dto"
 	"auto-trader/pkg/shared/utils"
@@ -9,6 +13,9 @@ import (
 	"github.com/gofiber/fiber/v2"
 )
 
+// streamHeartbeatInterval 실시간 스트림 keep-alive 주기
+const streamHeartbeatInterval = 15 * time.Second
+
 // Controller 포트폴리오 컨트롤러
 type Controller struct {
 	service Service
@@ -206,15 +213,115 @@ func (ctrl *Controller) GetCurrentPrices(c *fiber.Ctx) error {
 	return utils.SuccessResponse(c, prices)
 }
 
+// StreamPrices 실시간 현재가 스트림
+// @Summary 실시간 현재가 스트림
+// @Description 여러 종목의 실시간 체결가를 Server-Sent Events로 전달합니다
+// @Tags portfolio
+// @Produce text/event-stream
+// @Security BearerAuth
+// @Param symbols query string true "종목 심볼들 (쉼표로 구분)"
+// @Success 200 {object} dto.StockPrice
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /portfolio/prices/stream [get]
+func (ctrl *Controller) StreamPrices(c *fiber.Ctx) error {
+	var q dto.GetCurrentPricesQuery
+	q.Symbols = c.Query("symbols")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	prices, unsubscribe, err := ctrl.service.SubscribeToPriceUpdates(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "실시간 현재가 구독 실패")
+	}
+
+	c.Set("Content-Type", "text/event-stream")
+	c.Set("Cache-Control", "no-cache")
+	c.Set("Connection", "keep-alive")
+
+	c.Context().SetBodyStreamWriter(func(w *bufio.Writer) {
+		defer unsubscribe()
+
+		// 시세가 없는 동안에도 연결 끊김을 감지하도록 주기적으로 keep-alive 전송
+		heartbeat := time.NewTicker(streamHeartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case price, ok := <-prices:
+				if !ok {
+					return
+				}
+				data, err := json.Marshal(price)
+				if err != nil {
+					continue
+				}
+				if _, err := fmt.Fprintf(w, "event: price\ndata: %s\n\n", data); err != nil {
+					return
+				}
+			case <-heartbeat.C:
+				if _, err := fmt.Fprint(w, ": keep-alive\n\n"); err != nil {
+					return
+				}
+			}
+
+			// 클라이언트 연결이 끊기면 Flush 오류로 종료
+			if err := w.Flush(); err != nil {
+				return
+			}
+		}
+	})
+
+	return nil
+}
+
+// GetChartData 차트 데이터 조회
+// @Summary 차트 데이터 조회
+// @Description 종목의 과거 OHLCV 봉을 조회합니다 (저장되지 않은 구간은 KIS에서 받아 저장)
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param symbol path string true "종목 심볼"
+// @Param interval query string false "봉 주기 (1m, 5m, 15m, 30m, 1h, 1d)" default(1d)
+// @Param exchange query string false "시세 거래소코드 (NAS, NYS, AMS)"
+// @Param from query string false "시작일 (YYYY-MM-DD 또는 RFC3339)"
+// @Param to query string false "종료일 (YYYY-MM-DD 또는 RFC3339)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /portfolio/charts/{symbol} [get]
+func (ctrl *Controller) GetChartData(c *fiber.Ctx) error {
+	var q dto.GetChartDataQuery
+	q.Symbol = c.Params("symbol")
+	q.Exchange = c.Query("exchange")
+	q.Interval = c.Query("interval", "1d")
+	q.From = c.Query("from")
+	q.To = c.Query("to")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	chart, err := ctrl.service.GetChartData(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "차트 데이터 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, chart)
+}
+
 // GetTradeHistory 거래 내역 조회
 // @Summary 거래 내역 조회
-// @Description 사용자의 거래 내역을 조회합니다
+// @Description 체결 원장에 기록된 실계좌 거래 내역을 최신순으로 조회합니다 (매도는 실현손익 포함)
 // @Tags portfolio
 // @Accept json
 // @Produce json
+// @Security BearerAuth
 // @Param symbol query string false "특정 종목 심볼"
-// @Param start_date query string false "시작 날짜 (YYYY-MM-DD)"
-// @Param end_date query string false "종료 날짜 (YYYY-MM-DD)"
+// @Param side query string false "매매 구분 (BUY, SELL)"
+// @Param start_date query string false "시작 날짜 (YYYY-MM-DD, 한국 시간)"
+// @Param end_date query string false "종료 날짜 (YYYY-MM-DD, 한국 시간)"
 // @Param limit query int false "조회 개수 제한"
 // @Param offset query int false "오프셋"
 // @Success 200 {object} utils.Response
@@ -225,6 +332,9 @@ func (ctrl *Controller) GetTradeHistory(c *fiber.Ctx) error {
 	var q dto.GetTradeHistoryQuery
 	userID := utils.GetUserID(c)
 	q.Symbol = c.Query("symbol")
+	q.Side = c.Query("side")
+	q.StartDate = c.Query("start_date")
+	q.EndDate = c.Query("end_date")
 	q.Limit = c.QueryInt("limit", 100)
 	q.Offset = c.QueryInt("offset", 0)
 	if err := utils.ValidateStruct(q); err != nil {
@@ -233,15 +343,103 @@ func (ctrl *Controller) GetTradeHistory(c *fiber.Ctx) error {
 
 	trades, err := ctrl.service.GetTradeHistory(userID, q)
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, "거래 내역 조회 실패", err)
+		return utils.CommonErrorResponse(c, err, "거래 내역 조회 실패")
 	}
 
 	return utils.SuccessResponse(c, trades)
 }
 
+// GetPnL 종목별 손익 조회
+// @Summary 종목별 손익 조회
+// @Description 기간 내 매도 실현손익(거래 통화/원화 환산)과 현재 보유분 미실현손익을 종목별로 조회합니다
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param method query string false "취득가액 산정 방법 (fifo, average)"
+// @Param from query string false "시작일 (YYYY-MM-DD, 한국 시간)"
+// @Param to query string false "종료일 (YYYY-MM-DD, 한국 시간)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /portfolio/pnl [get]
+func (ctrl *Controller) GetPnL(c *fiber.Ctx) error {
+	var q dto.GetPnLQuery
+	userID := utils.GetUserID(c)
+	q.Method = c.Query("method")
+	q.From = c.Query("from")
+	q.To = c.Query("to")
+
+	report, err := ctrl.service.GetPnL(userID, q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "손익 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, report)
+}
+
+// GetPeriodPnL 기간별 실현손익 조회
+// @Summary 기간별 실현손익 조회
+// @Description 매도 실현손익을 월/연 단위(한국 시간)로 집계합니다 (해외주식 양도소득 신고용 원화 환산 포함)
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param period query string false "집계 단위 (month, year)" default(year)
+// @Param method query string false "취득가액 산정 방법 (fifo, average)"
+// @Param from query string false "시작일 (YYYY-MM-DD, 한국 시간)"
+// @Param to query string false "종료일 (YYYY-MM-DD, 한국 시간)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /portfolio/pnl/periods [get]
+func (ctrl *Controller) GetPeriodPnL(c *fiber.Ctx) error {
+	var q dto.GetPeriodPnLQuery
+	userID := utils.GetUserID(c)
+	q.Method = c.Query("method")
+	q.Period = c.Query("period", "year")
+	q.From = c.Query("from")
+	q.To = c.Query("to")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	report, err := ctrl.service.GetPeriodPnL(userID, q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "기간별 실현손익 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, report)
+}
+
+// GetTaxLots 미청산 매수 로트 조회
+// @Summary 미청산 매수 로트 조회
+// @Description 선입선출 매칭에 쓰이는 미청산 매수 로트를 오래된 순으로 조회합니다
+// @Tags portfolio
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param symbol query string false "특정 종목 심볼"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /portfolio/lots [get]
+func (ctrl *Controller) GetTaxLots(c *fiber.Ctx) error {
+	var q dto.GetTaxLotsQuery
+	userID := utils.GetUserID(c)
+	q.Symbol = c.Query("symbol")
+
+	lots, err := ctrl.service.GetTaxLots(userID, q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "매수 로트 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, lots)
+}
+
 // RefreshPortfolio 포트폴리오 새로고침
 // @Summary 포트폴리오 새로고침
-// @Description 포트폴리오 데이터를 강제로 새로고침합니다
+// @Description 증권사 잔고를 조회해 보유 종목을 동기화합니다 (청산된 종목은 삭제)
 // @Tags portfolio
 // @Accept json
 // @Produce json
@@ -251,12 +449,10 @@ func (ctrl *Controller) GetTradeHistory(c *fiber.Ctx) error {
 // @Router /portfolio/refresh [post]
 func (ctrl *Controller) RefreshPortfolio(c *fiber.Ctx) error {
 	userID := utils.GetUserID(c)
-	if err := ctrl.service.RefreshPortfolio(userID); err != nil {
-		return utils.InternalServerErrorResponse(c, "포트폴리오 새로고침 실패", err)
+	result, err := ctrl.service.RefreshPortfolio(userID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "포트폴리오 새로고침 실패")
 	}
 
-	return utils.SuccessResponse(c, fiber.Map{
-		"message": "포트폴리오가 성공적으로 새로고침되었습니다",
-		"userID":  userID,
-	})
+	return utils.SuccessResponse(c, result)
 }
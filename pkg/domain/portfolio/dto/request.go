@@ -9,6 +9,7 @@ import (
 // CreatePortfolioBody 포트폴리오 생성 요청 데이터
 type CreatePortfolioBody struct {
 	Symbol   string    `json:"symbol" validate:"required,min=1,max=20"`
+	Exchange string    `json:"exchange,omitempty" validate:"max=10"` // NASD, NYSE, AMEX
 	Quantity float64   `json:"quantity" validate:"required,gt=0"`
 	UserID   uuid.UUID `json:"user_id" validate:"required"`
 }
@@ -44,18 +45,48 @@ type GetPositionsQuery struct {
 type GetTradeHistoryQuery struct {
 	UserID    uuid.UUID `query:"user_id,omitempty"`
 	Symbol    string    `query:"symbol,omitempty"`
-	StartDate string    `query:"start_date,omitempty"`
-	EndDate   string    `query:"end_date,omitempty"`
+	Side      string    `query:"side,omitempty"`       // BUY, SELL
+	StartDate string    `query:"start_date,omitempty"` // YYYY-MM-DD (한국 시간)
+	EndDate   string    `query:"end_date,omitempty"`   // YYYY-MM-DD (한국 시간)
 	Limit     int       `query:"limit" validate:"min=1,max=100"`
 	Offset    int       `query:"offset" validate:"min=0"`
 }
 
+// GetPnLQuery 종목별 손익 조회 쿼리 파라미터
+type GetPnLQuery struct {
+	Method string `query:"method"` // 취득가액 산정 방법 (fifo, average, 비어 있으면 설정값)
+	From   string `query:"from"`   // 매도 시작일 (YYYY-MM-DD, 한국 시간)
+	To     string `query:"to"`     // 매도 종료일 (YYYY-MM-DD, 한국 시간)
+}
+
+// GetPeriodPnLQuery 기간별 실현손익 조회 쿼리 파라미터
+type GetPeriodPnLQuery struct {
+	Method string `query:"method"`                            // 취득가액 산정 방법 (fifo, average)
+	Period string `query:"period" validate:"enum=month,year"` // 집계 단위
+	From   string `query:"from"`                              // 매도 시작일 (YYYY-MM-DD, 한국 시간)
+	To     string `query:"to"`                                // 매도 종료일 (YYYY-MM-DD, 한국 시간)
+}
+
+// GetTaxLotsQuery 미청산 매수 로트 조회 쿼리 파라미터
+type GetTaxLotsQuery struct {
+	Symbol string `query:"symbol,omitempty"`
+}
+
 // GetCurrentPricesQuery 여러 종목 현재가 조회 쿼리 파라미터
 type GetCurrentPricesQuery struct {
 	Symbols      string `query:"symbols" validate:"required,min=1"`
 	ForceRefresh bool   `query:"forceRefresh"`
 }
 
+// GetChartDataQuery 차트 데이터 조회 쿼리 파라미터
+type GetChartDataQuery struct {
+	Symbol   string `param:"symbol" validate:"required,min=1,max=20"`
+	Exchange string `query:"exchange"`                                              // 시세 거래소코드 (NAS, NYS, AMS)
+	Interval string `query:"interval" validate:"required,enum=1m,5m,15m,30m,1h,1d"` // 봉 주기
+	From     string `query:"from"`                                                  // 시작일 (YYYY-MM-DD 또는 RFC3339)
+	To       string `query:"to"`                                                    // 종료일 (YYYY-MM-DD 또는 RFC3339)
+}
+
 // Path DTOs (URL 경로 파라미터)
 
 // PortfolioPath 포트폴리오 ID 경로 파라미터
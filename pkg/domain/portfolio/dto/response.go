@@ -50,6 +50,13 @@ type PortfolioSummary struct {
 	TotalPositions     int             `json:"total_positions"`
 }
 
+// PortfolioSync 잔고 동기화 응답 데이터
+type PortfolioSync struct {
+	Updated  int       `json:"updated"`
+	Removed  int       `json:"removed"`
+	SyncedAt time.Time `json:"synced_at"`
+}
+
 // CurrentPrice 현재가 응답 데이터
 type CurrentPrice struct {
 	Symbol    string          `json:"symbol"`
@@ -69,13 +76,24 @@ type StockPrice struct {
 
 // TradeHistory 거래 내역 응답 데이터
 type TradeHistory struct {
-	ID        uuid.UUID       `json:"id"`
-	Symbol    string          `json:"symbol"`
-	Type      string          `json:"type"` // "buy" or "sell"
-	Quantity  decimal.Decimal `json:"quantity"`
-	Price     decimal.Decimal `json:"price"`
-	Amount    decimal.Decimal `json:"amount"`
-	Timestamp time.Time       `json:"timestamp"`
+	ID             uuid.UUID       `json:"id"`
+	ClientOrderID  string          `json:"client_order_id"`
+	Symbol         string          `json:"symbol"`
+	Exchange       string          `json:"exchange,omitempty"`
+	Type           string          `json:"type"` // "buy" or "sell"
+	Quantity       decimal.Decimal `json:"quantity"`
+	Price          decimal.Decimal `json:"price"`
+	Amount         decimal.Decimal `json:"amount"`
+	Fee            decimal.Decimal `json:"fee"`
+	Currency       string          `json:"currency"`
+	FXRate         decimal.Decimal `json:"fx_rate"`
+	Method         string          `json:"method"`                 // 실현손익 취득가액 산정 방법
+	CostBasis      decimal.Decimal `json:"cost_basis,omitempty"`   // 매도 취득가액
+	RealizedPnL    decimal.Decimal `json:"realized_pnl,omitempty"` // 매도 실현손익 (수수료 반영)
+	RealizedPnLKRW decimal.Decimal `json:"realized_pnl_krw,omitempty"`
+	EstimatedBasis bool            `json:"estimated_basis,omitempty"` // 원장 이전 보유분을 증권사 평균단가로 추정
+	FXPending      bool            `json:"fx_pending,omitempty"`      // 환율 미확정 (확인 후 취득가액/실현손익 반영)
+	Timestamp      time.Time       `json:"timestamp"`
 }
 
 // TradeHistoryList 거래 내역 목록 응답 데이터
@@ -86,6 +104,78 @@ type TradeHistoryList struct {
 	Offset int             `json:"offset"`
 }
 
+// SymbolPnL 종목별 실현/미실현 손익 응답 데이터
+type SymbolPnL struct {
+	Symbol         string          `json:"symbol"`
+	SoldQuantity   decimal.Decimal `json:"sold_quantity"`
+	Proceeds       decimal.Decimal `json:"proceeds"` // 매도 순수령액 (수수료 차감)
+	ProceedsKRW    decimal.Decimal `json:"proceeds_krw"`
+	CostBasis      decimal.Decimal `json:"cost_basis"`
+	CostBasisKRW   decimal.Decimal `json:"cost_basis_krw"`
+	Fees           decimal.Decimal `json:"fees"` // 기간 내 매수/매도 수수료 합계
+	RealizedPnL    decimal.Decimal `json:"realized_pnl"`
+	RealizedPnLKRW decimal.Decimal `json:"realized_pnl_krw"`
+	HeldQuantity   decimal.Decimal `json:"held_quantity"`
+	HeldCost       decimal.Decimal `json:"held_cost"`
+	MarketValue    decimal.Decimal `json:"market_value"`
+	UnrealizedPnL  decimal.Decimal `json:"unrealized_pnl"`
+	EstimatedBasis bool            `json:"estimated_basis"` // 원장 이전 보유분이 섞여 취득가가 추정값인지 여부
+}
+
+// PnLReport 손익 내역 응답 데이터 (실현손익은 기간 내 매도, 미실현손익은 현재 보유분 기준)
+type PnLReport struct {
+	Method         string          `json:"method"`
+	Currency       string          `json:"currency"`
+	From           *time.Time      `json:"from,omitempty"`
+	To             *time.Time      `json:"to,omitempty"`
+	Proceeds       decimal.Decimal `json:"proceeds"`
+	ProceedsKRW    decimal.Decimal `json:"proceeds_krw"`
+	CostBasis      decimal.Decimal `json:"cost_basis"`
+	CostBasisKRW   decimal.Decimal `json:"cost_basis_krw"`
+	Fees           decimal.Decimal `json:"fees"`
+	RealizedPnL    decimal.Decimal `json:"realized_pnl"`
+	RealizedPnLKRW decimal.Decimal `json:"realized_pnl_krw"`
+	UnrealizedPnL  decimal.Decimal `json:"unrealized_pnl"`
+	Symbols        []*SymbolPnL    `json:"symbols"`
+}
+
+// PeriodPnL 기간별 실현손익 응답 데이터
+type PeriodPnL struct {
+	Period         string          `json:"period"` // 2026 또는 2026-03 (한국 시간)
+	SellCount      int             `json:"sell_count"`
+	Proceeds       decimal.Decimal `json:"proceeds"`
+	ProceedsKRW    decimal.Decimal `json:"proceeds_krw"`
+	CostBasis      decimal.Decimal `json:"cost_basis"`
+	CostBasisKRW   decimal.Decimal `json:"cost_basis_krw"`
+	Fees           decimal.Decimal `json:"fees"`
+	RealizedPnL    decimal.Decimal `json:"realized_pnl"`
+	RealizedPnLKRW decimal.Decimal `json:"realized_pnl_krw"`
+}
+
+// PeriodPnLReport 기간별 실현손익 목록 응답 데이터
+type PeriodPnLReport struct {
+	Method         string          `json:"method"`
+	Period         string          `json:"period"`
+	Currency       string          `json:"currency"`
+	RealizedPnL    decimal.Decimal `json:"realized_pnl"`
+	RealizedPnLKRW decimal.Decimal `json:"realized_pnl_krw"`
+	Periods        []*PeriodPnL    `json:"periods"`
+}
+
+// TaxLot 미청산 매수 로트 응답 데이터
+type TaxLot struct {
+	ID                uuid.UUID       `json:"id"`
+	TradeID           uuid.UUID       `json:"trade_id"`
+	Symbol            string          `json:"symbol"`
+	Quantity          decimal.Decimal `json:"quantity"`
+	RemainingQuantity decimal.Decimal `json:"remaining_quantity"`
+	CostPerShare      decimal.Decimal `json:"cost_per_share"` // 매수 수수료 포함
+	FXRate            decimal.Decimal `json:"fx_rate"`
+	CostBasis         decimal.Decimal `json:"cost_basis"` // 잔량 기준 취득가액
+	CostBasisKRW      decimal.Decimal `json:"cost_basis_krw"`
+	OpenedAt          time.Time       `json:"opened_at"`
+}
+
 // CompanyInfo 회사 정보 응답 데이터
 type CompanyInfo struct {
 	Symbol      string `json:"symbol"`
@@ -0,0 +1,535 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/trade"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// CostMethod 실현손익 계산용 취득가액 산정 방법
+type CostMethod string
+
+const (
+	CostMethodFIFO    CostMethod = "fifo"    // 선입선출법
+	CostMethodAverage CostMethod = "average" // 이동평균법
+)
+
+// ParseCostMethod 취득가액 산정 방법 파싱 (비어 있으면 기본값 사용)
+func ParseCostMethod(value string, fallback CostMethod) (CostMethod, error) {
+	switch CostMethod(strings.ToLower(strings.TrimSpace(value))) {
+	case "":
+		return fallback, nil
+	case CostMethodFIFO:
+		return CostMethodFIFO, nil
+	case CostMethodAverage:
+		return CostMethodAverage, nil
+	}
+	return "", utils.BadRequest(fmt.Sprintf("지원하지 않는 취득가액 산정 방법: %s (fifo, average)", value))
+}
+
+const (
+	ledgerCurrency  = "USD"            // 원장 거래 통화 (미국 주식만 거래)
+	fxTimeout       = 10 * time.Second // 체결 시 환율 조회 제한 시간
+	fxRetryInterval = time.Minute      // 환율 미확정 체결 재처리 주기
+)
+
+// FXRateSource 거래 통화의 원화 환율 조회 (1 거래 통화 = N 원)
+type FXRateSource interface {
+	ExchangeRate(ctx context.Context, userID, currency string) (decimal.Decimal, error)
+}
+
+// Fill 원장에 기록할 체결 (수수료는 체결 금액과 같은 거래 통화)
+type Fill struct {
+	UserID         uuid.UUID
+	ClientOrderID  string
+	BrokerOrderID  string
+	Symbol         string
+	Exchange       string
+	Side           order.Side
+	Quantity       decimal.Decimal
+	Price          decimal.Decimal
+	Fee            decimal.Decimal
+	Currency       string
+	FXRate         decimal.Decimal // 체결 시점 원화 환율 (알 수 없으면 0 → 환율 미확정으로 보류)
+	FilledQuantity decimal.Decimal // 주문 누적 체결 수량 (중복 기록 방지 키)
+	ExecutedAt     time.Time
+}
+
+// TaxLot 미청산 매수 로트
+type TaxLot struct {
+	ID           uuid.UUID
+	TradeID      uuid.UUID
+	Symbol       string
+	Quantity     decimal.Decimal
+	Remaining    decimal.Decimal
+	CostPerShare decimal.Decimal // 매수 수수료 포함 주당 취득단가
+	FXRate       decimal.Decimal
+	OpenedAt     time.Time
+}
+
+// AverageCost 이동평균법 보유 상태 (마지막 체결 직후 기준)
+type AverageCost struct {
+	Quantity        decimal.Decimal
+	CostPerShare    decimal.Decimal
+	CostPerShareKRW decimal.Decimal
+}
+
+// LotUsage 매도로 차감된 로트
+type LotUsage struct {
+	LotID     uuid.UUID
+	Quantity  decimal.Decimal // 이번 매도로 차감된 수량
+	Remaining decimal.Decimal // 차감 후 잔량 (0이면 로트 종료)
+}
+
+// TradeEntry 원장 기록 단위 (체결 + 두 산정 방법의 취득가액 매칭 결과)
+type TradeEntry struct {
+	Fill
+	Proceeds         decimal.Decimal // 매도 순수령액 (수수료 차감)
+	CostBasisFIFO    decimal.Decimal
+	CostBasisFIFOKRW decimal.Decimal
+	CostBasisAvg     decimal.Decimal
+	CostBasisAvgKRW  decimal.Decimal
+	EstimatedBasis   bool // 원장에 없는 보유분을 증권사 평균단가로 추정했는지 여부
+	After            AverageCost
+	OpenedLot        *TaxLot    // 매수로 새로 생긴 로트
+	ClosedLots       []LotUsage // 매도로 차감된 로트 (선입선출)
+	PendingID        *uuid.UUID // 환율 확정으로 교체하는 보류 체결
+}
+
+// Match 체결을 로트/이동평균 상태에 반영한 원장 항목 계산
+// 매수는 수수료를 취득가에 더해 새 로트를 만들고, 매도는 오래된 로트부터 차감한다.
+// 매도 수량이 원장 보유분보다 많으면(원장 기록 이전부터 보유한 주식 등) 부족분은 fallbackCost로 취득가를 추정한다.
+func Match(fill Fill, lots []*TaxLot, state AverageCost, fallbackCost decimal.Decimal) *TradeEntry {
+	entry := &TradeEntry{Fill: fill}
+
+	if fill.Side == order.SideBuy {
+		costPerShare := fill.Quantity.Mul(fill.Price).Add(fill.Fee).Div(fill.Quantity).Round(6)
+		entry.OpenedLot = &TaxLot{
+			Symbol:       fill.Symbol,
+			Quantity:     fill.Quantity,
+			Remaining:    fill.Quantity,
+			CostPerShare: costPerShare,
+			FXRate:       fill.FXRate,
+			OpenedAt:     fill.ExecutedAt,
+		}
+
+		held := decimal.Max(state.Quantity, decimal.Zero)
+		quantity := held.Add(fill.Quantity)
+		entry.After = AverageCost{
+			Quantity:        quantity,
+			CostPerShare:    held.Mul(state.CostPerShare).Add(fill.Quantity.Mul(costPerShare)).Div(quantity).Round(6),
+			CostPerShareKRW: held.Mul(state.CostPerShareKRW).Add(fill.Quantity.Mul(costPerShare).Mul(fill.FXRate)).Div(quantity).Round(4),
+		}
+		return entry
+	}
+
+	entry.Proceeds = fill.Quantity.Mul(fill.Price).Sub(fill.Fee)
+
+	// 선입선출: 오래된 로트부터 차감
+	left := fill.Quantity
+	for _, lot := range lots {
+		if !left.IsPositive() {
+			break
+		}
+		if !lot.Remaining.IsPositive() {
+			continue
+		}
+		used := decimal.Min(lot.Remaining, left)
+		cost := used.Mul(lot.CostPerShare)
+		entry.CostBasisFIFO = entry.CostBasisFIFO.Add(cost)
+		entry.CostBasisFIFOKRW = entry.CostBasisFIFOKRW.Add(cost.Mul(lot.FXRate))
+		entry.ClosedLots = append(entry.ClosedLots, LotUsage{
+			LotID:     lot.ID,
+			Quantity:  used,
+			Remaining: lot.Remaining.Sub(used),
+		})
+		left = left.Sub(used)
+	}
+	if left.IsPositive() {
+		cost := left.Mul(fallbackCost)
+		entry.CostBasisFIFO = entry.CostBasisFIFO.Add(cost)
+		entry.CostBasisFIFOKRW = entry.CostBasisFIFOKRW.Add(cost.Mul(fill.FXRate))
+		entry.EstimatedBasis = true
+	}
+
+	// 이동평균: 평균단가는 그대로 두고 수량만 줄임
+	held := decimal.Max(state.Quantity, decimal.Zero)
+	matched := decimal.Min(held, fill.Quantity)
+	unmatched := fill.Quantity.Sub(matched)
+	entry.CostBasisAvg = matched.Mul(state.CostPerShare).Add(unmatched.Mul(fallbackCost))
+	entry.CostBasisAvgKRW = matched.Mul(state.CostPerShareKRW).Add(unmatched.Mul(fallbackCost).Mul(fill.FXRate))
+	if unmatched.IsPositive() {
+		entry.EstimatedBasis = true
+	}
+
+	entry.After = AverageCost{Quantity: held.Sub(matched)}
+	if entry.After.Quantity.IsPositive() {
+		entry.After.CostPerShare = state.CostPerShare
+		entry.After.CostPerShareKRW = state.CostPerShareKRW
+	}
+
+	entry.CostBasisFIFO = entry.CostBasisFIFO.Round(4)
+	entry.CostBasisFIFOKRW = entry.CostBasisFIFOKRW.Round(2)
+	entry.CostBasisAvg = entry.CostBasisAvg.Round(4)
+	entry.CostBasisAvgKRW = entry.CostBasisAvgKRW.Round(2)
+	return entry
+}
+
+// RealizedPnL 매도 체결의 실현손익 (거래 통화, 원화 환산)
+// 원화 손익은 매도 금액을 매도 시점 환율로, 취득가액을 매수 시점 환율로 환산한 차이다.
+func RealizedPnL(t *ent.Trade, method CostMethod) (decimal.Decimal, decimal.Decimal) {
+	if t.Side != trade.SideSELL {
+		return decimal.Zero, decimal.Zero
+	}
+	cost, costKRW := CostBasis(t, method)
+	return t.Proceeds.Sub(cost), ProceedsKRW(t).Sub(costKRW)
+}
+
+// ProceedsKRW 매도 순수령액의 원화 환산 (매도 시점 환율)
+func ProceedsKRW(t *ent.Trade) decimal.Decimal {
+	return t.Proceeds.Mul(t.FxRate).Round(2)
+}
+
+// CostBasis 매도 체결의 취득가액 (거래 통화, 원화 환산)
+func CostBasis(t *ent.Trade, method CostMethod) (decimal.Decimal, decimal.Decimal) {
+	if method == CostMethodAverage {
+		return t.CostBasisAvg, t.CostBasisAvgKrw
+	}
+	return t.CostBasisFifo, t.CostBasisFifoKrw
+}
+
+// Ledger 체결 원장
+// 실계좌 체결을 거래 내역으로 기록하고 종목별 매수 로트(선입선출)와 이동평균 단가를 함께 관리한다.
+// 체결은 주문 실행기 콜백에서 대기열에 넣고 별도 고루틴에서 순서대로 기록하므로 환율 조회가 체결 동기화를 막지 않는다.
+// 환율을 알 수 없는 체결은 0 환율로 원화 금액을 계산하지 않고 보류했다가 환율을 확인하면 순서대로 반영한다.
+type Ledger struct {
+	repository LedgerRepository
+	positions  Repository
+	fx         FXRateSource
+	fees       order.FillSimulator // 수수료 계산만 사용 (체결 통보에 수수료가 없어 요율로 산정)
+	method     CostMethod
+
+	mutex sync.Mutex // 같은 종목 체결이 동시에 들어와도 로트 차감 순서를 보장
+
+	queue      []order.Update // 기록 대기 체결 (도착 순)
+	queueMutex sync.Mutex
+	signal     chan struct{}
+	stopChan   chan struct{}
+	running    bool
+	runMutex   sync.Mutex
+}
+
+// NewLedger 새로운 체결 원장 생성 (fx가 nil이면 마지막으로 기록된 환율 사용)
+func NewLedger(repository LedgerRepository, positions Repository, fx FXRateSource, fees order.FillSimulator, method CostMethod) *Ledger {
+	return &Ledger{
+		repository: repository,
+		positions:  positions,
+		fx:         fx,
+		fees:       fees,
+		method:     method,
+		signal:     make(chan struct{}, 1),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Method 포트폴리오 실현손익에 쓰는 기본 취득가액 산정 방법
+func (l *Ledger) Method() CostMethod {
+	return l.method
+}
+
+// Start 체결 기록 고루틴 시작 (시작 전에 들어온 체결도 대기열에 남아 순서대로 기록)
+func (l *Ledger) Start() {
+	l.runMutex.Lock()
+	defer l.runMutex.Unlock()
+
+	if l.running {
+		return
+	}
+	l.running = true
+	l.stopChan = make(chan struct{})
+	go l.recordLoop(l.stopChan)
+}
+
+// Stop 체결 기록 중지 (대기열의 체결은 다음 시작 시 기록)
+func (l *Ledger) Stop() {
+	l.runMutex.Lock()
+	defer l.runMutex.Unlock()
+
+	if !l.running {
+		return
+	}
+	l.running = false
+	close(l.stopChan)
+}
+
+// HandleUpdate 실계좌 주문 상태 변경 중 체결분을 기록 대기열에 추가 (order.UpdateHandler)
+func (l *Ledger) HandleUpdate(update order.Update) {
+	if !update.LastFillQuantity.IsPositive() || update.Mode == order.ModePaper {
+		return
+	}
+
+	l.queueMutex.Lock()
+	l.queue = append(l.queue, update)
+	l.queueMutex.Unlock()
+
+	select {
+	case l.signal <- struct{}{}:
+	default:
+	}
+}
+
+func (l *Ledger) recordLoop(stopChan chan struct{}) {
+	ticker := time.NewTicker(fxRetryInterval)
+	defer ticker.Stop()
+
+	// 재시작 전 환율 미확정으로 보류된 체결부터 반영
+	l.resolvePending()
+	l.drain()
+
+	for {
+		select {
+		case <-l.signal:
+			l.drain()
+		case <-ticker.C:
+			l.resolvePending()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// drain 대기열의 체결을 도착 순서대로 기록
+func (l *Ledger) drain() {
+	for {
+		l.queueMutex.Lock()
+		if len(l.queue) == 0 {
+			l.queueMutex.Unlock()
+			return
+		}
+		update := l.queue[0]
+		l.queue = l.queue[1:]
+		l.queueMutex.Unlock()
+
+		l.record(update)
+	}
+}
+
+// record 주문 체결분을 원장에 기록
+func (l *Ledger) record(update order.Update) {
+	userID, err := uuid.Parse(update.UserID)
+	if err != nil {
+		logrus.Warnf("⚠️  체결 원장 기록 생략 (%s): 잘못된 사용자 ID", update.ClientOrderID)
+		return
+	}
+
+	price := update.LastFillPrice.Round(4)
+	executedAt := update.Timestamp
+	if executedAt.IsZero() {
+		executedAt = time.Now()
+	}
+
+	fill := Fill{
+		UserID:         userID,
+		ClientOrderID:  update.ClientOrderID,
+		BrokerOrderID:  update.BrokerOrderID,
+		Symbol:         update.Symbol,
+		Exchange:       update.Exchange,
+		Side:           update.Side,
+		Quantity:       update.LastFillQuantity,
+		Price:          price,
+		Fee:            l.fees.Commission(update.LastFillQuantity, price),
+		Currency:       ledgerCurrency,
+		FXRate:         l.exchangeRate(userID),
+		FilledQuantity: update.FilledQuantity,
+		ExecutedAt:     executedAt,
+	}
+
+	if _, err := l.Record(fill); err != nil {
+		logrus.Errorf("❌ 체결 원장 기록 실패 (%s): %v", update.ClientOrderID, err)
+	}
+}
+
+// Record 체결을 원장에 기록 (이미 기록된 체결이면 nil 반환)
+// 환율을 모르거나 같은 종목에 환율 미확정 체결이 남아 있으면 매칭 없이 보류 기록한다 (로트 차감 순서 유지).
+func (l *Ledger) Record(fill Fill) (*ent.Trade, error) {
+	l.mutex.Lock()
+	defer l.mutex.Unlock()
+
+	exists, err := l.repository.HasTrade(fill.ClientOrderID, fill.FilledQuantity)
+	if err != nil {
+		return nil, fmt.Errorf("체결 중복 확인 실패: %w", err)
+	}
+	if exists {
+		return nil, nil
+	}
+
+	pending, err := l.repository.HasPendingFX(fill.UserID, fill.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("환율 미확정 체결 확인 실패: %w", err)
+	}
+	if pending || !fill.FXRate.IsPositive() {
+		recorded, err := l.repository.RecordPending(fill)
+		if err != nil {
+			return nil, fmt.Errorf("환율 미확정 체결 저장 실패: %w", err)
+		}
+		logrus.Warnf("⏸️  환율 미확정 체결 보류: %s %s %s @ %s (환율 확인 후 원장 반영)",
+			fill.Side, fill.Quantity.String(), fill.Symbol, fill.Price.String())
+		return recorded, nil
+	}
+
+	return l.match(fill, nil)
+}
+
+// match 체결을 로트/이동평균 상태와 매칭해 저장 (pendingID가 있으면 보류 체결 교체)
+func (l *Ledger) match(fill Fill, pendingID *uuid.UUID) (*ent.Trade, error) {
+	rows, err := l.repository.GetOpenLots(fill.UserID, fill.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("매수 로트 조회 실패: %w", err)
+	}
+	state, err := l.repository.GetAverageCost(fill.UserID, fill.Symbol)
+	if err != nil {
+		return nil, fmt.Errorf("평균단가 조회 실패: %w", err)
+	}
+
+	fallbackCost := decimal.Zero
+	if fill.Side == order.SideSell {
+		if row, err := l.positions.GetByUserAndSymbol(fill.UserID, fill.Symbol); err == nil && row != nil {
+			fallbackCost = row.AveragePrice
+		}
+	}
+
+	entry := Match(fill, toTaxLots(rows), state, fallbackCost)
+	entry.PendingID = pendingID
+	if entry.EstimatedBasis {
+		logrus.Warnf("⚠️  원장 보유분 부족 (%s %s): 취득가를 증권사 평균단가 %s로 추정", fill.UserID, fill.Symbol, fallbackCost.String())
+	}
+
+	recorded, err := l.repository.RecordTrade(entry, l.method)
+	if err != nil {
+		return nil, fmt.Errorf("거래 내역 저장 실패: %w", err)
+	}
+
+	realized, _ := RealizedPnL(recorded, l.method)
+	logrus.Infof("📒 체결 원장 기록: %s %s %s @ %s (수수료 %s, 실현손익 %s)",
+		fill.Side, fill.Quantity.String(), fill.Symbol, fill.Price.String(), fill.Fee.String(), realized.StringFixed(2))
+	return recorded, nil
+}
+
+// resolvePending 환율 미확정 체결을 체결 순서대로 반영
+// 환율은 체결 당시 확인된 값이 있으면 그대로 쓰고, 없으면 현재 조회한 환율(실패 시 마지막 기록 환율)을 쓴다.
+// 한 종목에서 환율을 확인하지 못하면 그 종목의 이후 체결도 다음 주기까지 보류한다.
+func (l *Ledger) resolvePending() {
+	trades, err := l.repository.GetPendingFX()
+	if err != nil {
+		logrus.Errorf("❌ 환율 미확정 체결 조회 실패: %v", err)
+		return
+	}
+
+	rates := make(map[uuid.UUID]decimal.Decimal)
+	blocked := make(map[string]bool)
+	resolved := 0
+	for _, t := range trades {
+		key := t.UserID.String() + "|" + t.Symbol
+		if blocked[key] {
+			continue
+		}
+
+		fill := toFill(t)
+		if !fill.FXRate.IsPositive() {
+			rate, ok := rates[t.UserID]
+			if !ok {
+				rate = l.exchangeRate(t.UserID)
+				rates[t.UserID] = rate
+			}
+			fill.FXRate = rate
+		}
+		if !fill.FXRate.IsPositive() {
+			blocked[key] = true
+			continue
+		}
+
+		l.mutex.Lock()
+		_, err := l.match(fill, &t.ID)
+		l.mutex.Unlock()
+		if err != nil {
+			logrus.Errorf("❌ 환율 미확정 체결 반영 실패 (%s): %v", t.ClientOrderID, err)
+			blocked[key] = true
+			continue
+		}
+		resolved++
+	}
+
+	if resolved > 0 {
+		logrus.Infof("📒 환율 미확정 체결 %d건 원장 반영", resolved)
+	}
+	if left := len(trades) - resolved; left > 0 {
+		logrus.Warnf("⏸️  환율 미확정 체결 %d건 대기 중 (%s 후 재시도)", left, fxRetryInterval)
+	}
+}
+
+// exchangeRate 체결 시점 원화 환율 (조회 실패 시 마지막으로 기록된 환율, 둘 다 없으면 0)
+func (l *Ledger) exchangeRate(userID uuid.UUID) decimal.Decimal {
+	if l.fx != nil {
+		ctx, cancel := context.WithTimeout(context.Background(), fxTimeout)
+		rate, err := l.fx.ExchangeRate(ctx, userID.String(), ledgerCurrency)
+		cancel()
+		if err == nil && rate.IsPositive() {
+			return rate
+		}
+		logrus.Warnf("⚠️  환율 조회 실패 (%s): %v", userID, err)
+	}
+
+	rate, err := l.repository.LatestFXRate(userID, ledgerCurrency)
+	if err != nil {
+		logrus.Warnf("⚠️  최근 환율 조회 실패 (%s): %v", userID, err)
+		return decimal.Zero
+	}
+	return rate
+}
+
+// toFill 보류 기록된 체결을 원장 체결로 변환
+func toFill(t *ent.Trade) Fill {
+	return Fill{
+		UserID:         t.UserID,
+		ClientOrderID:  t.ClientOrderID,
+		BrokerOrderID:  t.BrokerOrderID,
+		Symbol:         t.Symbol,
+		Exchange:       t.Exchange,
+		Side:           order.Side(t.Side),
+		Quantity:       t.Quantity,
+		Price:          t.Price,
+		Fee:            t.Fee,
+		Currency:       t.Currency,
+		FXRate:         t.FxRate,
+		FilledQuantity: t.FilledQuantity,
+		ExecutedAt:     t.ExecutedAt,
+	}
+}
+
+func toTaxLots(rows []*ent.TaxLot) []*TaxLot {
+	lots := make([]*TaxLot, 0, len(rows))
+	for _, row := range rows {
+		lots = append(lots, &TaxLot{
+			ID:           row.ID,
+			TradeID:      row.TradeID,
+			Symbol:       row.Symbol,
+			Quantity:     row.Quantity,
+			Remaining:    row.RemainingQuantity,
+			CostPerShare: row.CostPerShare,
+			FXRate:       row.FxRate,
+			OpenedAt:     row.OpenedAt,
+		})
+	}
+	return lots
+}
@@ -0,0 +1,367 @@
+package portfolio
+
+import (
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/portfolio"
+	"auto-trader/ent/taxlot"
+	"auto-trader/ent/trade"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// TradeFilter 거래 내역 조회 조건 (빈 값은 조건 없음)
+type TradeFilter struct {
+	Symbol string
+	Side   trade.Side
+	From   time.Time
+	To     time.Time
+}
+
+// LedgerRepository 체결 원장(거래 내역, 매수 로트) 데이터 접근 인터페이스
+type LedgerRepository interface {
+	// 원장 기록
+	HasTrade(clientOrderID string, filledQuantity decimal.Decimal) (bool, error)
+	RecordTrade(entry *TradeEntry, method CostMethod) (*ent.Trade, error)
+
+	// 환율 미확정 체결
+	RecordPending(fill Fill) (*ent.Trade, error)
+	HasPendingFX(userID uuid.UUID, symbol string) (bool, error)
+	GetPendingFX() ([]*ent.Trade, error)
+
+	// 보유 상태
+	GetOpenLots(userID uuid.UUID, symbol string) ([]*ent.TaxLot, error)
+	GetAverageCost(userID uuid.UUID, symbol string) (AverageCost, error)
+	LatestFXRate(userID uuid.UUID, currency string) (decimal.Decimal, error)
+
+	// 거래 내역 조회
+	GetTrades(userID uuid.UUID, filter TradeFilter, limit, offset int) ([]*ent.Trade, error)
+	CountTrades(userID uuid.UUID, filter TradeFilter) (int, error)
+}
+
+// NewLedgerRepository ent 기반 LedgerRepository 생성
+func NewLedgerRepository(client *ent.Client) LedgerRepository {
+	return &EntRepository{client: client}
+}
+
+// HasTrade 같은 주문의 같은 누적 체결 수량이 이미 기록됐는지 확인
+func (r *EntRepository) HasTrade(clientOrderID string, filledQuantity decimal.Decimal) (bool, error) {
+	exists, err := r.client.Trade.Query().
+		Where(
+			trade.ClientOrderID(clientOrderID),
+			trade.FilledQuantity(filledQuantity),
+		).
+		Exist(r.getContext())
+	if err != nil {
+		return false, fmt.Errorf("failed to check trade: %w", err)
+	}
+	return exists, nil
+}
+
+// RecordTrade 체결 기록과 로트 변경, 포트폴리오 실현손익 반영을 한 트랜잭션으로 저장
+// 포트폴리오에 없는 종목을 매수하면 다음 잔고 동기화 전까지 원장 기준 수량/평균단가로 행을 만든다.
+// 환율 미확정으로 보류했던 체결(entry.PendingID)은 같은 트랜잭션에서 보류 행을 매칭 결과로 교체한다.
+func (r *EntRepository) RecordTrade(entry *TradeEntry, method CostMethod) (*ent.Trade, error) {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if entry.PendingID != nil {
+		if err := tx.Trade.DeleteOneID(*entry.PendingID).Exec(ctx); err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to replace pending trade: %w", err))
+		}
+	}
+
+	create := tx.Trade.Create().
+		SetUserID(entry.UserID).
+		SetClientOrderID(entry.ClientOrderID).
+		SetSymbol(entry.Symbol).
+		SetSide(trade.Side(entry.Side)).
+		SetQuantity(entry.Quantity).
+		SetPrice(entry.Price).
+		SetFee(entry.Fee).
+		SetCurrency(entry.Currency).
+		SetFxRate(entry.FXRate).
+		SetFilledQuantity(entry.FilledQuantity).
+		SetProceeds(entry.Proceeds).
+		SetCostBasisFifo(entry.CostBasisFIFO).
+		SetCostBasisFifoKrw(entry.CostBasisFIFOKRW).
+		SetCostBasisAvg(entry.CostBasisAvg).
+		SetCostBasisAvgKrw(entry.CostBasisAvgKRW).
+		SetEstimatedBasis(entry.EstimatedBasis).
+		SetPositionAfter(entry.After.Quantity).
+		SetAvgCostAfter(entry.After.CostPerShare).
+		SetAvgCostKrwAfter(entry.After.CostPerShareKRW).
+		SetExecutedAt(entry.ExecutedAt)
+	if entry.BrokerOrderID != "" {
+		create.SetBrokerOrderID(entry.BrokerOrderID)
+	}
+	if entry.Exchange != "" {
+		create.SetExchange(entry.Exchange)
+	}
+	recorded, err := create.Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, fmt.Errorf("failed to create trade: %w", err))
+	}
+
+	if lot := entry.OpenedLot; lot != nil {
+		err := tx.TaxLot.Create().
+			SetUserID(entry.UserID).
+			SetTradeID(recorded.ID).
+			SetSymbol(lot.Symbol).
+			SetQuantity(lot.Quantity).
+			SetRemainingQuantity(lot.Remaining).
+			SetCostPerShare(lot.CostPerShare).
+			SetFxRate(lot.FXRate).
+			SetOpenedAt(lot.OpenedAt).
+			Exec(ctx)
+		if err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to create tax lot: %w", err))
+		}
+	}
+
+	for _, usage := range entry.ClosedLots {
+		update := tx.TaxLot.UpdateOneID(usage.LotID).
+			SetRemainingQuantity(usage.Remaining)
+		if !usage.Remaining.IsPositive() {
+			update.SetClosedAt(entry.ExecutedAt)
+		}
+		if err := update.Exec(ctx); err != nil {
+			return nil, rollback(tx, fmt.Errorf("failed to update tax lot %s: %w", usage.LotID, err))
+		}
+	}
+
+	if err := r.applyRealized(tx, entry, method); err != nil {
+		return nil, rollback(tx, err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return nil, fmt.Errorf("failed to commit trade: %w", err)
+	}
+	return recorded, nil
+}
+
+// RecordPending 환율을 알 수 없는 체결을 매칭 없이 보류 기록 (중복 기록 방지 키는 일반 체결과 같음)
+func (r *EntRepository) RecordPending(fill Fill) (*ent.Trade, error) {
+	create := r.client.Trade.Create().
+		SetUserID(fill.UserID).
+		SetClientOrderID(fill.ClientOrderID).
+		SetSymbol(fill.Symbol).
+		SetSide(trade.Side(fill.Side)).
+		SetQuantity(fill.Quantity).
+		SetPrice(fill.Price).
+		SetFee(fill.Fee).
+		SetCurrency(fill.Currency).
+		SetFxRate(fill.FXRate).
+		SetFilledQuantity(fill.FilledQuantity).
+		SetFxPending(true).
+		SetExecutedAt(fill.ExecutedAt)
+	if fill.BrokerOrderID != "" {
+		create.SetBrokerOrderID(fill.BrokerOrderID)
+	}
+	if fill.Exchange != "" {
+		create.SetExchange(fill.Exchange)
+	}
+
+	recorded, err := create.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create pending trade: %w", err)
+	}
+	return recorded, nil
+}
+
+// HasPendingFX 종목에 환율 미확정 체결이 남아 있는지 확인 (있으면 이후 체결도 순서대로 보류)
+func (r *EntRepository) HasPendingFX(userID uuid.UUID, symbol string) (bool, error) {
+	exists, err := r.client.Trade.Query().
+		Where(
+			trade.UserID(userID),
+			trade.Symbol(symbol),
+			trade.FxPending(true),
+		).
+		Exist(r.getContext())
+	if err != nil {
+		return false, fmt.Errorf("failed to check pending trades: %w", err)
+	}
+	return exists, nil
+}
+
+// GetPendingFX 전체 사용자의 환율 미확정 체결 (체결 순)
+func (r *EntRepository) GetPendingFX() ([]*ent.Trade, error) {
+	trades, err := r.client.Trade.Query().
+		Where(trade.FxPending(true)).
+		Order(ent.Asc(trade.FieldExecutedAt), ent.Asc(trade.FieldCreatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending trades: %w", err)
+	}
+	return trades, nil
+}
+
+// applyRealized 종목 누적 실현손익을 포트폴리오 행에 반영
+func (r *EntRepository) applyRealized(tx *ent.Tx, entry *TradeEntry, method CostMethod) error {
+	ctx := r.getContext()
+
+	sells, err := tx.Trade.Query().
+		Where(
+			trade.UserID(entry.UserID),
+			trade.Symbol(entry.Symbol),
+			trade.SideEQ(trade.SideSELL),
+		).
+		All(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to get sell trades: %w", err)
+	}
+	realized := decimal.Zero
+	for _, sell := range sells {
+		pnl, _ := RealizedPnL(sell, method)
+		realized = realized.Add(pnl)
+	}
+
+	row, err := tx.Portfolio.Query().
+		Where(
+			portfolio.UserID(entry.UserID),
+			portfolio.Symbol(entry.Symbol),
+		).
+		Only(ctx)
+	if err != nil && !ent.IsNotFound(err) {
+		return fmt.Errorf("failed to get portfolio %s: %w", entry.Symbol, err)
+	}
+
+	if row != nil {
+		if err := tx.Portfolio.UpdateOneID(row.ID).SetRealizedPnl(realized).Exec(ctx); err != nil {
+			return fmt.Errorf("failed to update realized pnl %s: %w", entry.Symbol, err)
+		}
+		return nil
+	}
+	if !entry.After.Quantity.IsPositive() {
+		return nil
+	}
+
+	create := tx.Portfolio.Create().
+		SetUserID(entry.UserID).
+		SetSymbol(entry.Symbol).
+		SetQuantity(entry.After.Quantity).
+		SetAveragePrice(entry.After.CostPerShare).
+		SetCurrentPrice(entry.Price).
+		SetMarketValue(entry.After.Quantity.Mul(entry.Price)).
+		SetTotalCost(entry.After.Quantity.Mul(entry.After.CostPerShare)).
+		SetUnrealizedPnl(entry.After.Quantity.Mul(entry.Price.Sub(entry.After.CostPerShare))).
+		SetRealizedPnl(realized)
+	if entry.Exchange != "" {
+		create.SetExchange(entry.Exchange)
+	}
+	if err := create.Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create portfolio %s: %w", entry.Symbol, err)
+	}
+	return nil
+}
+
+// GetOpenLots 미청산 매수 로트 조회 (오래된 순, symbol이 비어 있으면 전체 종목)
+func (r *EntRepository) GetOpenLots(userID uuid.UUID, symbol string) ([]*ent.TaxLot, error) {
+	query := r.client.TaxLot.Query().
+		Where(
+			taxlot.UserID(userID),
+			taxlot.ClosedAtIsNil(),
+		)
+	if symbol != "" {
+		query.Where(taxlot.Symbol(symbol))
+	}
+
+	lots, err := query.
+		Order(ent.Asc(taxlot.FieldOpenedAt), ent.Asc(taxlot.FieldUpdatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open tax lots: %w", err)
+	}
+	return lots, nil
+}
+
+// GetAverageCost 종목의 마지막 체결 직후 이동평균 상태 (체결이 없으면 0, 환율 미확정 체결 제외)
+func (r *EntRepository) GetAverageCost(userID uuid.UUID, symbol string) (AverageCost, error) {
+	last, err := r.client.Trade.Query().
+		Where(
+			trade.UserID(userID),
+			trade.Symbol(symbol),
+			trade.FxPending(false),
+		).
+		Order(ent.Desc(trade.FieldExecutedAt), ent.Desc(trade.FieldCreatedAt)).
+		First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return AverageCost{}, nil
+		}
+		return AverageCost{}, fmt.Errorf("failed to get last trade: %w", err)
+	}
+
+	return AverageCost{
+		Quantity:        last.PositionAfter,
+		CostPerShare:    last.AvgCostAfter,
+		CostPerShareKRW: last.AvgCostKrwAfter,
+	}, nil
+}
+
+// LatestFXRate 사용자 원장에 마지막으로 기록된 환율 (없으면 0)
+func (r *EntRepository) LatestFXRate(userID uuid.UUID, currency string) (decimal.Decimal, error) {
+	last, err := r.client.Trade.Query().
+		Where(
+			trade.UserID(userID),
+			trade.Currency(currency),
+			trade.FxRateGT(decimal.Zero),
+		).
+		Order(ent.Desc(trade.FieldExecutedAt)).
+		First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return decimal.Zero, nil
+		}
+		return decimal.Zero, fmt.Errorf("failed to get latest fx rate: %w", err)
+	}
+	return last.FxRate, nil
+}
+
+// GetTrades 거래 내역 조회 (최신순, limit이 0 이하면 전체)
+func (r *EntRepository) GetTrades(userID uuid.UUID, filter TradeFilter, limit, offset int) ([]*ent.Trade, error) {
+	query := r.tradeQuery(userID, filter).
+		Order(ent.Desc(trade.FieldExecutedAt), ent.Desc(trade.FieldCreatedAt))
+	if limit > 0 {
+		query.Limit(limit).Offset(offset)
+	}
+
+	trades, err := query.All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get trades: %w", err)
+	}
+	return trades, nil
+}
+
+// CountTrades 조건에 맞는 거래 수
+func (r *EntRepository) CountTrades(userID uuid.UUID, filter TradeFilter) (int, error) {
+	count, err := r.tradeQuery(userID, filter).Count(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count trades: %w", err)
+	}
+	return count, nil
+}
+
+func (r *EntRepository) tradeQuery(userID uuid.UUID, filter TradeFilter) *ent.TradeQuery {
+	query := r.client.Trade.Query().
+		Where(trade.UserID(userID))
+	if filter.Symbol != "" {
+		query.Where(trade.Symbol(filter.Symbol))
+	}
+	if filter.Side != "" {
+		query.Where(trade.SideEQ(filter.Side))
+	}
+	if !filter.From.IsZero() {
+		query.Where(trade.ExecutedAtGTE(filter.From))
+	}
+	if !filter.To.IsZero() {
+		query.Where(trade.ExecutedAtLTE(filter.To))
+	}
+	return query
+}
@@ -0,0 +1,406 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/trade"
+	"auto-trader/pkg/domain/order"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+func d(value string) decimal.Decimal {
+	return decimal.RequireFromString(value)
+}
+
+func assertDecimal(t *testing.T, field string, got decimal.Decimal, want string) {
+	t.Helper()
+	if !got.Equal(d(want)) {
+		t.Errorf("%s = %s, want %s", field, got.String(), want)
+	}
+}
+
+func TestMatchBuy(t *testing.T) {
+	tests := []struct {
+		name          string
+		state         AverageCost
+		quantity      string
+		price         string
+		fee           string
+		fxRate        string
+		lotCost       string
+		afterQuantity string
+		afterCost     string
+		afterCostKRW  string
+	}{
+		{"첫 매수는 수수료를 취득가에 포함", AverageCost{}, "10", "100", "1", "1300", "100.1", "10", "100.1", "130130"},
+		{"보유분과 가중 평균", AverageCost{Quantity: d("10"), CostPerShare: d("100"), CostPerShareKRW: d("130000")},
+			"10", "120", "0", "1200", "120", "20", "110", "137000"},
+		{"주당 취득단가는 소수 6자리", AverageCost{}, "3", "10", "1", "1000", "10.333333", "3", "10.333333", "10333.333"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fill := Fill{Side: order.SideBuy, Symbol: "AAPL", Quantity: d(tt.quantity), Price: d(tt.price), Fee: d(tt.fee), FXRate: d(tt.fxRate)}
+			entry := Match(fill, nil, tt.state, decimal.Zero)
+
+			if entry.OpenedLot == nil {
+				t.Fatal("매수 로트가 생성되지 않음")
+			}
+			assertDecimal(t, "OpenedLot.CostPerShare", entry.OpenedLot.CostPerShare, tt.lotCost)
+			assertDecimal(t, "OpenedLot.Remaining", entry.OpenedLot.Remaining, tt.quantity)
+			assertDecimal(t, "OpenedLot.FXRate", entry.OpenedLot.FXRate, tt.fxRate)
+			assertDecimal(t, "After.Quantity", entry.After.Quantity, tt.afterQuantity)
+			assertDecimal(t, "After.CostPerShare", entry.After.CostPerShare, tt.afterCost)
+			assertDecimal(t, "After.CostPerShareKRW", entry.After.CostPerShareKRW, tt.afterCostKRW)
+		})
+	}
+}
+
+func TestMatchSell(t *testing.T) {
+	first, second := uuid.New(), uuid.New()
+	lots := func() []*TaxLot {
+		return []*TaxLot{
+			{ID: uuid.New(), Quantity: d("5"), Remaining: d("0"), CostPerShare: d("80"), FXRate: d("1250")}, // 이미 청산된 로트
+			{ID: first, Quantity: d("10"), Remaining: d("10"), CostPerShare: d("100"), FXRate: d("1300")},
+			{ID: second, Quantity: d("5"), Remaining: d("5"), CostPerShare: d("110"), FXRate: d("1350")},
+		}
+	}
+	// 두 로트의 이동평균: (1000 + 550) / 15, (1,300,000 + 742,500) / 15
+	state := AverageCost{Quantity: d("15"), CostPerShare: d("103.333333"), CostPerShareKRW: d("136166.6667")}
+
+	tests := []struct {
+		name          string
+		quantity      string
+		fee           string
+		proceeds      string
+		fifo          string
+		fifoKRW       string
+		avg           string
+		avgKRW        string
+		estimated     bool
+		closed        []LotUsage
+		afterQuantity string
+	}{
+		{"첫 로트 일부 차감", "4", "1", "479", "400", "520000", "413.3333", "544666.67", false,
+			[]LotUsage{{LotID: first, Quantity: d("4"), Remaining: d("6")}}, "11"},
+		{"로트 경계를 넘는 차감", "12", "1", "1439", "1220", "1597000", "1240", "1634000", false,
+			[]LotUsage{{LotID: first, Quantity: d("10"), Remaining: d("0")}, {LotID: second, Quantity: d("2"), Remaining: d("3")}}, "3"},
+		{"보유분 초과는 증권사 평균단가로 추정", "17", "0", "2040", "1730", "2294500", "1730", "2294500", true,
+			[]LotUsage{{LotID: first, Quantity: d("10"), Remaining: d("0")}, {LotID: second, Quantity: d("5"), Remaining: d("0")}}, "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fill := Fill{Side: order.SideSell, Symbol: "AAPL", Quantity: d(tt.quantity), Price: d("120"), Fee: d(tt.fee), FXRate: d("1400")}
+			entry := Match(fill, lots(), state, d("90"))
+
+			assertDecimal(t, "Proceeds", entry.Proceeds, tt.proceeds)
+			assertDecimal(t, "CostBasisFIFO", entry.CostBasisFIFO, tt.fifo)
+			assertDecimal(t, "CostBasisFIFOKRW", entry.CostBasisFIFOKRW, tt.fifoKRW)
+			assertDecimal(t, "CostBasisAvg", entry.CostBasisAvg, tt.avg)
+			assertDecimal(t, "CostBasisAvgKRW", entry.CostBasisAvgKRW, tt.avgKRW)
+			assertDecimal(t, "After.Quantity", entry.After.Quantity, tt.afterQuantity)
+			if entry.EstimatedBasis != tt.estimated {
+				t.Errorf("EstimatedBasis = %v, want %v", entry.EstimatedBasis, tt.estimated)
+			}
+
+			if len(entry.ClosedLots) != len(tt.closed) {
+				t.Fatalf("ClosedLots = %d건, want %d건", len(entry.ClosedLots), len(tt.closed))
+			}
+			for i, want := range tt.closed {
+				got := entry.ClosedLots[i]
+				if got.LotID != want.LotID || !got.Quantity.Equal(want.Quantity) || !got.Remaining.Equal(want.Remaining) {
+					t.Errorf("ClosedLots[%d] = {%s, %s, %s}, want {%s, %s, %s}", i,
+						got.LotID, got.Quantity, got.Remaining, want.LotID, want.Quantity, want.Remaining)
+				}
+			}
+		})
+	}
+}
+
+func TestMatchMixedBuysAndSells(t *testing.T) {
+	steps := []struct {
+		side         order.Side
+		quantity     string
+		price        string
+		fxRate       string
+		fifo         string
+		fifoKRW      string
+		avg          string
+		avgKRW       string
+		afterQty     string
+		afterCost    string
+		afterCostKRW string
+	}{
+		{order.SideBuy, "10", "100", "1300", "0", "0", "0", "0", "10", "100", "130000"},
+		{order.SideBuy, "10", "120", "1200", "0", "0", "0", "0", "20", "110", "137000"},
+		// 이동평균은 평균단가 유지, 선입선출은 첫 로트에서 차감
+		{order.SideSell, "5", "130", "1250", "500", "650000", "550", "685000", "15", "110", "137000"},
+		{order.SideBuy, "5", "130", "1250", "0", "0", "0", "0", "20", "115", "143375"},
+		// 남은 로트(100×5, 120×10, 130×5)를 각 매수 시점 환율로 환산
+		{order.SideSell, "20", "140", "1300", "2350", "2902500", "2300", "2867500", "0", "0", "0"},
+	}
+
+	var lots []*TaxLot
+	var state AverageCost
+	for i, step := range steps {
+		fill := Fill{Side: step.side, Symbol: "AAPL", Quantity: d(step.quantity), Price: d(step.price), Fee: decimal.Zero, FXRate: d(step.fxRate)}
+		entry := Match(fill, lots, state, decimal.Zero)
+
+		if step.side == order.SideSell {
+			assertDecimal(t, "CostBasisFIFO", entry.CostBasisFIFO, step.fifo)
+			assertDecimal(t, "CostBasisFIFOKRW", entry.CostBasisFIFOKRW, step.fifoKRW)
+			assertDecimal(t, "CostBasisAvg", entry.CostBasisAvg, step.avg)
+			assertDecimal(t, "CostBasisAvgKRW", entry.CostBasisAvgKRW, step.avgKRW)
+			if entry.EstimatedBasis {
+				t.Errorf("step %d: 원장 보유분 내 매도가 추정으로 표시됨", i)
+			}
+		}
+		assertDecimal(t, "After.Quantity", entry.After.Quantity, step.afterQty)
+		assertDecimal(t, "After.CostPerShare", entry.After.CostPerShare, step.afterCost)
+		assertDecimal(t, "After.CostPerShareKRW", entry.After.CostPerShareKRW, step.afterCostKRW)
+
+		// 저장소가 하는 것처럼 로트와 평균 상태 반영
+		if entry.OpenedLot != nil {
+			entry.OpenedLot.ID = uuid.New()
+			lots = append(lots, entry.OpenedLot)
+		}
+		for _, usage := range entry.ClosedLots {
+			for _, lot := range lots {
+				if lot.ID == usage.LotID {
+					lot.Remaining = usage.Remaining
+				}
+			}
+		}
+		state = entry.After
+	}
+}
+
+func TestRealizedPnL(t *testing.T) {
+	tests := []struct {
+		name        string
+		trade       *ent.Trade
+		method      CostMethod
+		realized    string
+		realizedKRW string
+	}{
+		{"선입선출", &ent.Trade{Side: trade.SideSELL, Proceeds: d("1439"), FxRate: d("1400"),
+			CostBasisFifo: d("1220"), CostBasisFifoKrw: d("1597000"), CostBasisAvg: d("1240"), CostBasisAvgKrw: d("1634000")},
+			CostMethodFIFO, "219", "417600"},
+		{"이동평균", &ent.Trade{Side: trade.SideSELL, Proceeds: d("1439"), FxRate: d("1400"),
+			CostBasisFifo: d("1220"), CostBasisFifoKrw: d("1597000"), CostBasisAvg: d("1240"), CostBasisAvgKrw: d("1634000")},
+			CostMethodAverage, "199", "380600"},
+		// 달러 기준 이익이어도 매수 시점보다 환율이 내려가면 원화 기준 손실
+		{"환차손", &ent.Trade{Side: trade.SideSELL, Proceeds: d("1000"), FxRate: d("1200"),
+			CostBasisFifo: d("950"), CostBasisFifoKrw: d("1282500")},
+			CostMethodFIFO, "50", "-82500"},
+		{"매수는 실현손익 없음", &ent.Trade{Side: trade.SideBUY, Proceeds: d("1000"), FxRate: d("1300")},
+			CostMethodFIFO, "0", "0"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			realized, realizedKRW := RealizedPnL(tt.trade, tt.method)
+			assertDecimal(t, "realized", realized, tt.realized)
+			assertDecimal(t, "realizedKRW", realizedKRW, tt.realizedKRW)
+		})
+	}
+}
+
+// memoryLedger 테스트용 메모리 원장 저장소
+type memoryLedger struct {
+	trades []*ent.Trade
+	lots   []*ent.TaxLot
+	state  AverageCost
+}
+
+func (m *memoryLedger) HasTrade(clientOrderID string, filledQuantity decimal.Decimal) (bool, error) {
+	for _, t := range m.trades {
+		if t.ClientOrderID == clientOrderID && t.FilledQuantity.Equal(filledQuantity) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryLedger) RecordTrade(entry *TradeEntry, method CostMethod) (*ent.Trade, error) {
+	if entry.PendingID != nil {
+		for i, t := range m.trades {
+			if t.ID == *entry.PendingID {
+				m.trades = append(m.trades[:i], m.trades[i+1:]...)
+				break
+			}
+		}
+	}
+
+	recorded := m.toTrade(entry.Fill, false)
+	recorded.Proceeds = entry.Proceeds
+	recorded.CostBasisFifo, recorded.CostBasisFifoKrw = entry.CostBasisFIFO, entry.CostBasisFIFOKRW
+	recorded.CostBasisAvg, recorded.CostBasisAvgKrw = entry.CostBasisAvg, entry.CostBasisAvgKRW
+	m.trades = append(m.trades, recorded)
+
+	if lot := entry.OpenedLot; lot != nil {
+		m.lots = append(m.lots, &ent.TaxLot{ID: uuid.New(), TradeID: recorded.ID, Symbol: lot.Symbol, Quantity: lot.Quantity,
+			RemainingQuantity: lot.Remaining, CostPerShare: lot.CostPerShare, FxRate: lot.FXRate, OpenedAt: lot.OpenedAt})
+	}
+	for _, usage := range entry.ClosedLots {
+		for _, lot := range m.lots {
+			if lot.ID == usage.LotID {
+				lot.RemainingQuantity = usage.Remaining
+			}
+		}
+	}
+	m.state = entry.After
+	return recorded, nil
+}
+
+func (m *memoryLedger) RecordPending(fill Fill) (*ent.Trade, error) {
+	recorded := m.toTrade(fill, true)
+	m.trades = append(m.trades, recorded)
+	return recorded, nil
+}
+
+func (m *memoryLedger) HasPendingFX(userID uuid.UUID, symbol string) (bool, error) {
+	for _, t := range m.trades {
+		if t.FxPending && t.UserID == userID && t.Symbol == symbol {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (m *memoryLedger) GetPendingFX() ([]*ent.Trade, error) {
+	var pending []*ent.Trade
+	for _, t := range m.trades {
+		if t.FxPending {
+			pending = append(pending, t)
+		}
+	}
+	return pending, nil
+}
+
+func (m *memoryLedger) GetOpenLots(userID uuid.UUID, symbol string) ([]*ent.TaxLot, error) {
+	var open []*ent.TaxLot
+	for _, lot := range m.lots {
+		if lot.RemainingQuantity.IsPositive() {
+			open = append(open, lot)
+		}
+	}
+	return open, nil
+}
+
+func (m *memoryLedger) GetAverageCost(userID uuid.UUID, symbol string) (AverageCost, error) {
+	return m.state, nil
+}
+
+func (m *memoryLedger) LatestFXRate(userID uuid.UUID, currency string) (decimal.Decimal, error) {
+	return decimal.Zero, nil
+}
+
+func (m *memoryLedger) GetTrades(userID uuid.UUID, filter TradeFilter, limit, offset int) ([]*ent.Trade, error) {
+	return m.trades, nil
+}
+
+func (m *memoryLedger) CountTrades(userID uuid.UUID, filter TradeFilter) (int, error) {
+	return len(m.trades), nil
+}
+
+func (m *memoryLedger) toTrade(fill Fill, pending bool) *ent.Trade {
+	return &ent.Trade{
+		ID:             uuid.New(),
+		UserID:         fill.UserID,
+		ClientOrderID:  fill.ClientOrderID,
+		Symbol:         fill.Symbol,
+		Side:           trade.Side(fill.Side),
+		Quantity:       fill.Quantity,
+		Price:          fill.Price,
+		Fee:            fill.Fee,
+		Currency:       fill.Currency,
+		FxRate:         fill.FXRate,
+		FxPending:      pending,
+		FilledQuantity: fill.FilledQuantity,
+		ExecutedAt:     fill.ExecutedAt,
+	}
+}
+
+// stubFX 테스트용 환율 조회 (rate가 0이면 조회 실패)
+type stubFX struct {
+	rate decimal.Decimal
+}
+
+func (s *stubFX) ExchangeRate(ctx context.Context, userID, currency string) (decimal.Decimal, error) {
+	if !s.rate.IsPositive() {
+		return decimal.Zero, errors.New("환율 조회 실패")
+	}
+	return s.rate, nil
+}
+
+func TestLedgerPendingFX(t *testing.T) {
+	repository := &memoryLedger{}
+	fx := &stubFX{}
+	ledger := NewLedger(repository, nil, fx, order.FillSimulator{}, CostMethodFIFO)
+
+	userID := uuid.New()
+	executedAt := time.Date(2024, time.March, 11, 14, 0, 0, 0, time.UTC)
+	buy := func(clientOrderID, quantity, price, fxRate string, offset time.Duration) Fill {
+		return Fill{UserID: userID, ClientOrderID: clientOrderID, Symbol: "AAPL", Side: order.SideBuy,
+			Quantity: d(quantity), Price: d(price), Fee: decimal.Zero, Currency: ledgerCurrency,
+			FXRate: d(fxRate), FilledQuantity: d(quantity), ExecutedAt: executedAt.Add(offset)}
+	}
+
+	// 환율을 모르는 체결은 0 환율로 매칭하지 않고 보류
+	if _, err := ledger.Record(buy("order-1", "10", "100", "0", 0)); err != nil {
+		t.Fatalf("Record 오류: %v", err)
+	}
+	// 같은 종목에 보류 체결이 있으면 환율을 알아도 순서를 지키기 위해 보류
+	if _, err := ledger.Record(buy("order-2", "10", "120", "1200", time.Minute)); err != nil {
+		t.Fatalf("Record 오류: %v", err)
+	}
+	// 같은 누적 체결 수량은 중복 기록하지 않음
+	if recorded, err := ledger.Record(buy("order-1", "10", "100", "0", 0)); err != nil || recorded != nil {
+		t.Fatalf("중복 Record = %v, %v, want nil, nil", recorded, err)
+	}
+
+	pending, _ := repository.GetPendingFX()
+	if len(pending) != 2 || len(repository.lots) != 0 || !repository.state.Quantity.IsZero() {
+		t.Fatalf("보류 %d건, 로트 %d건, 보유 %s, want 2건, 0건, 0", len(pending), len(repository.lots), repository.state.Quantity)
+	}
+
+	// 환율을 여전히 모르면 그대로 보류
+	ledger.resolvePending()
+	if pending, _ = repository.GetPendingFX(); len(pending) != 2 {
+		t.Fatalf("환율 미확정 상태에서 보류 %d건 반영됨", 2-len(pending))
+	}
+
+	// 환율을 확인하면 체결 순서대로 반영 (체결 당시 환율이 있으면 그 환율 유지)
+	fx.rate = d("1300")
+	ledger.resolvePending()
+	if pending, _ = repository.GetPendingFX(); len(pending) != 0 {
+		t.Fatalf("환율 확인 후 보류 %d건 남음", len(pending))
+	}
+	if len(repository.lots) != 2 {
+		t.Fatalf("로트 %d건, want 2건", len(repository.lots))
+	}
+	assertDecimal(t, "lots[0].CostPerShare", repository.lots[0].CostPerShare, "100")
+	assertDecimal(t, "lots[0].FxRate", repository.lots[0].FxRate, "1300")
+	assertDecimal(t, "lots[1].CostPerShare", repository.lots[1].CostPerShare, "120")
+	assertDecimal(t, "lots[1].FxRate", repository.lots[1].FxRate, "1200")
+	assertDecimal(t, "state.Quantity", repository.state.Quantity, "20")
+	assertDecimal(t, "state.CostPerShareKRW", repository.state.CostPerShareKRW, "137000")
+
+	// 보류가 모두 풀리면 이후 체결은 바로 매칭
+	recorded, err := ledger.Record(buy("order-3", "5", "130", "1250", 2*time.Minute))
+	if err != nil {
+		t.Fatalf("Record 오류: %v", err)
+	}
+	if recorded == nil || recorded.FxPending {
+		t.Fatalf("보류 해제 후 체결이 다시 보류됨")
+	}
+	assertDecimal(t, "state.Quantity", repository.state.Quantity, "25")
+}
@@ -22,11 +22,13 @@ type Position struct {
 	ID              string          `json:"id" db:"id"`
 	UserID          string          `json:"user_id" db:"user_id"`
 	Symbol          string          `json:"symbol" db:"symbol"`
+	Exchange        string          `json:"exchange" db:"exchange"` // 해외거래소코드 (NASD, NYSE, AMEX)
 	CompanyName     string          `json:"company_name" db:"company_name"`
 	Quantity        decimal.Decimal `json:"quantity" db:"quantity"`
 	AveragePrice    decimal.Decimal `json:"average_price" db:"average_price"`
 	CurrentPrice    decimal.Decimal `json:"current_price" db:"current_price"`
 	TotalValue      decimal.Decimal `json:"total_value" db:"total_value"`
+	TotalCost       decimal.Decimal `json:"total_cost" db:"total_cost"`
 	TotalProfit     decimal.Decimal `json:"total_profit" db:"total_profit"`
 	ProfitRate      decimal.Decimal `json:"profit_rate" db:"profit_rate"`
 	DailyProfit     decimal.Decimal `json:"daily_profit" db:"daily_profit"`
@@ -37,6 +37,9 @@ type Repository interface {
 	CountByUser(userID uuid.UUID) (int, error)
 	CountBySymbol(symbol string) (int, error)
 	GetTotalValueByUser(userID uuid.UUID) (float64, error)
+
+	// 잔고 동기화
+	SyncPositions(userID uuid.UUID, positions []*Position) (int, int, error)
 }
 
 // EntRepository ent 기반 구현체
@@ -56,11 +59,15 @@ func (r *EntRepository) getContext() context.Context {
 
 // Create 포트폴리오 생성
 func (r *EntRepository) Create(input dto.CreatePortfolioBody) (*ent.Portfolio, error) {
-	portfolio, err := r.client.Portfolio.Create().
+	createQuery := r.client.Portfolio.Create().
 		SetSymbol(input.Symbol).
 		SetQuantity(decimal.NewFromFloat(input.Quantity)).
-		SetUserID(input.UserID).
-		Save(r.getContext())
+		SetUserID(input.UserID)
+	if input.Exchange != "" {
+		createQuery.SetExchange(input.Exchange)
+	}
+
+	portfolio, err := createQuery.Save(r.getContext())
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create portfolio: %w", err)
@@ -230,12 +237,98 @@ func (r *EntRepository) GetTotalValueByUser(userID uuid.UUID) (float64, error) {
 		return 0, fmt.Errorf("failed to get portfolios for total value calculation: %w", err)
 	}
 
-	var totalValue float64
+	totalValue := decimal.Zero
 	for _, p := range portfolios {
-		// decimal.Decimal을 float64로 변환
-		quantity, _ := p.Quantity.Float64()
-		totalValue += quantity
+		totalValue = totalValue.Add(p.MarketValue)
+	}
+
+	value, _ := totalValue.Float64()
+	return value, nil
+}
+
+// SyncPositions 사용자 보유 종목을 잔고와 일치시킴 (반영 건수, 삭제 건수 반환)
+// 잔고에 있는 종목은 갱신/생성하고, 잔고에 없거나 수량이 0인 종목은 삭제한다.
+func (r *EntRepository) SyncPositions(userID uuid.UUID, positions []*Position) (int, int, error) {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	existing, err := tx.Portfolio.Query().
+		Where(portfolio.UserID(userID)).
+		All(ctx)
+	if err != nil {
+		return 0, 0, rollback(tx, fmt.Errorf("failed to get portfolios for sync: %w", err))
+	}
+	bySymbol := make(map[string]*ent.Portfolio, len(existing))
+	for _, row := range existing {
+		bySymbol[row.Symbol] = row
 	}
 
-	return totalValue, nil
+	upserted := 0
+	held := make(map[string]bool, len(positions))
+	for _, position := range positions {
+		if position.Symbol == "" || !position.Quantity.IsPositive() || held[position.Symbol] {
+			continue
+		}
+		held[position.Symbol] = true
+
+		if row, ok := bySymbol[position.Symbol]; ok {
+			update := tx.Portfolio.UpdateOneID(row.ID).
+				SetQuantity(position.Quantity).
+				SetAveragePrice(position.AveragePrice).
+				SetCurrentPrice(position.CurrentPrice).
+				SetMarketValue(position.TotalValue).
+				SetTotalCost(position.TotalCost).
+				SetUnrealizedPnl(position.TotalProfit)
+			if position.Exchange != "" {
+				update.SetExchange(position.Exchange)
+			}
+			if err := update.Exec(ctx); err != nil {
+				return 0, 0, rollback(tx, fmt.Errorf("failed to update portfolio %s: %w", position.Symbol, err))
+			}
+		} else {
+			create := tx.Portfolio.Create().
+				SetUserID(userID).
+				SetSymbol(position.Symbol).
+				SetQuantity(position.Quantity).
+				SetAveragePrice(position.AveragePrice).
+				SetCurrentPrice(position.CurrentPrice).
+				SetMarketValue(position.TotalValue).
+				SetTotalCost(position.TotalCost).
+				SetUnrealizedPnl(position.TotalProfit)
+			if position.Exchange != "" {
+				create.SetExchange(position.Exchange)
+			}
+			if err := create.Exec(ctx); err != nil {
+				return 0, 0, rollback(tx, fmt.Errorf("failed to create portfolio %s: %w", position.Symbol, err))
+			}
+		}
+		upserted++
+	}
+
+	var closed []uuid.UUID
+	for _, row := range existing {
+		if !held[row.Symbol] {
+			closed = append(closed, row.ID)
+		}
+	}
+	if len(closed) > 0 {
+		if _, err := tx.Portfolio.Delete().Where(portfolio.IDIn(closed...)).Exec(ctx); err != nil {
+			return 0, 0, rollback(tx, fmt.Errorf("failed to delete closed portfolios: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, 0, fmt.Errorf("failed to commit portfolio sync: %w", err)
+	}
+	return upserted, len(closed), nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w (rollback: %v)", err, rerr)
+	}
+	return err
 }
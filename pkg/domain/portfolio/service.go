@@ -1,9 +1,16 @@
 package portfolio
 
 import (
+	"auto-trader/ent"
+	"auto-trader/ent/trade"
+	"auto-trader/pkg/domain/marketdata"
 	"auto-trader/pkg/domain/portfolio/dto"
+	"auto-trader/pkg/shared/utils"
+	"context"
 	"fmt"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/google/uuid"
@@ -24,33 +31,60 @@ type Service interface {
 	GetCurrentPrice(q dto.GetCurrentPricesQuery) (*dto.StockPrice, error)
 	GetCurrentPrices(q dto.GetCurrentPricesQuery) ([]*dto.StockPrice, error)
 
-	// 거래 내역 관련
-	GetTradeHistory(userID string, q dto.GetTradeHistoryQuery) ([]*dto.TradeHistory, error)
+	// 거래 내역 관련 (체결 원장)
+	GetTradeHistory(userID string, q dto.GetTradeHistoryQuery) (*dto.TradeHistoryList, error)
+	GetPnL(userID string, q dto.GetPnLQuery) (*dto.PnLReport, error)
+	GetPeriodPnL(userID string, q dto.GetPeriodPnLQuery) (*dto.PeriodPnLReport, error)
+	GetTaxLots(userID string, q dto.GetTaxLotsQuery) ([]*dto.TaxLot, error)
 
 	// 회사 정보 관련
 	GetCompanyInfo(q dto.SymbolPath) (*dto.CompanyInfo, error)
 
 	// 차트 데이터 관련
-	GetChartData(q dto.SymbolPath) ([]*dto.ChartData, error)
+	GetChartData(q dto.GetChartDataQuery) ([]*dto.ChartData, error)
 
-	// 실시간 데이터 (향후 WebSocket 구현)
-	SubscribeToPriceUpdates(q dto.GetCurrentPricesQuery) (<-chan dto.StockPrice, error)
+	// 실시간 데이터 (반환된 함수로 구독 해제)
+	SubscribeToPriceUpdates(q dto.GetCurrentPricesQuery) (<-chan dto.StockPrice, func(), error)
 
 	// 캐시 관리
-	RefreshPortfolio(userID string) error
+	RefreshPortfolio(userID string) (*dto.PortfolioSync, error)
 	RefreshPositions(userID string) error
 	RefreshPrices(q dto.GetCurrentPricesQuery) error
 }
 
+// chartFetchTimeout 차트 조회 시 백필 대기 한도
+const chartFetchTimeout = 30 * time.Second
+
+// PriceSource 실시간 시세 공급자 인터페이스
+type PriceSource interface {
+	GetLatestPrice(symbol string) (*StockPrice, error)
+	SubscribePrices(symbols []string) (<-chan StockPrice, func())
+}
+
+// SymbolValidator 종목 마스터 기반 종목 검증 (거래 가능한 상장 거래소 반환)
+type SymbolValidator interface {
+	ResolveListing(ticker, exchange string) (string, error)
+}
+
 // ServiceImpl 포트폴리오 서비스 구현체
 type ServiceImpl struct {
-	repository Repository
+	repository  Repository
+	priceSource PriceSource
+	marketData  marketdata.Service
+	symbols     SymbolValidator
+	syncer      *Syncer
+	ledger      *Ledger
 }
 
-// NewService 새로운 포트폴리오 서비스 생성
-func NewService(repository Repository) Service {
+// NewService 새로운 포트폴리오 서비스 생성 (syncer가 nil이면 잔고 동기화, ledger가 nil이면 거래/손익 내역 조회 불가)
+func NewService(repository Repository, priceSource PriceSource, marketData marketdata.Service, symbols SymbolValidator, syncer *Syncer, ledger *Ledger) Service {
 	return &ServiceImpl{
-		repository: repository,
+		repository:  repository,
+		priceSource: priceSource,
+		marketData:  marketData,
+		symbols:     symbols,
+		syncer:      syncer,
+		ledger:      ledger,
 	}
 }
 
@@ -80,21 +114,16 @@ func (s *ServiceImpl) GetPortfolio(userID string, q dto.GetPortfolioQuery) (*dto
 		}, nil
 	}
 
-	// 포트폴리오 계산
+	// 포트폴리오 계산 (평가금액/손익은 잔고 동기화 값 사용)
 	totalValue := decimal.Zero
+	totalCost := decimal.Zero
 	totalProfit := decimal.Zero
 	for _, pos := range portfolios {
-		// ent.Portfolio의 Quantity를 float64로 변환하여 계산
-		quantity, _ := pos.Quantity.Float64()
-		totalValue = totalValue.Add(decimal.NewFromFloat(quantity))
-		// 수익률은 임시로 0으로 설정 (실제로는 가격 정보 필요)
-		totalProfit = totalProfit.Add(decimal.Zero)
-	}
-
-	profitRate := decimal.Zero
-	if totalValue.GreaterThan(decimal.Zero) {
-		profitRate = totalProfit.Div(totalValue).Mul(decimal.NewFromInt(100))
+		totalValue = totalValue.Add(pos.MarketValue)
+		totalCost = totalCost.Add(pos.TotalCost)
+		totalProfit = totalProfit.Add(pos.UnrealizedPnl)
 	}
+	profitRate := returnRate(totalProfit, totalCost)
 
 	portfolio := &dto.Portfolio{
 		ID:          uuid.New().String(),
@@ -123,16 +152,25 @@ func (s *ServiceImpl) GetPortfolioSummary(userID string, q dto.GetPortfolioSumma
 		return nil, fmt.Errorf("포트폴리오 수 조회 실패: %w", err)
 	}
 
-	totalValue, err := s.repository.GetTotalValueByUser(userUUID)
+	portfolios, err := s.repository.GetByUserID(userUUID, count, 0)
 	if err != nil {
-		return nil, fmt.Errorf("총 포트폴리오 가치 조회 실패: %w", err)
+		return nil, fmt.Errorf("포트폴리오 조회 실패: %w", err)
 	}
 
 	summary := &dto.PortfolioSummary{
-		TotalPositions: count,
-		TotalValue:     decimal.NewFromFloat(totalValue),
-		LastUpdated:    time.Now(),
+		TotalValue:         decimal.Zero,
+		TotalCost:          decimal.Zero,
+		TotalUnrealizedPnL: decimal.Zero,
+		PositionCount:      count,
+		TotalPositions:     count,
+		LastUpdated:        time.Now(),
+	}
+	for _, pos := range portfolios {
+		summary.TotalValue = summary.TotalValue.Add(pos.MarketValue)
+		summary.TotalCost = summary.TotalCost.Add(pos.TotalCost)
+		summary.TotalUnrealizedPnL = summary.TotalUnrealizedPnL.Add(pos.UnrealizedPnl)
 	}
+	summary.TotalReturn = returnRate(summary.TotalUnrealizedPnL, summary.TotalCost)
 
 	return summary, nil
 }
@@ -154,13 +192,16 @@ func (s *ServiceImpl) GetPositions(userID string, q dto.GetPositionsQuery) ([]*d
 	// ent.Portfolio를 Position으로 변환
 	var positions []*dto.Position
 	for _, portfolio := range portfolios {
-		quantity, _ := portfolio.Quantity.Float64()
 		position := &dto.Position{
-			Symbol:      portfolio.Symbol,
-			Quantity:    decimal.NewFromFloat(quantity),
-			TotalValue:  decimal.NewFromFloat(quantity), // 임시로 수량과 동일하게 설정
-			TotalProfit: decimal.Zero,                   // 임시로 0으로 설정
-			LastUpdated: time.Now(),
+			Symbol:        portfolio.Symbol,
+			Quantity:      portfolio.Quantity,
+			AvgPrice:      portfolio.AveragePrice,
+			MarketValue:   portfolio.MarketValue,
+			UnrealizedPnL: portfolio.UnrealizedPnl,
+			Return:        returnRate(portfolio.UnrealizedPnl, portfolio.TotalCost),
+			TotalValue:    portfolio.MarketValue,
+			TotalProfit:   portfolio.UnrealizedPnl,
+			LastUpdated:   portfolio.LastUpdated,
 		}
 		positions = append(positions, position)
 	}
@@ -195,27 +236,16 @@ func (s *ServiceImpl) GetPosition(userID string, q dto.GetPositionsQuery) (*dto.
 
 // GetCurrentPrice 현재가 조회
 func (s *ServiceImpl) GetCurrentPrice(q dto.GetCurrentPricesQuery) (*dto.StockPrice, error) {
-	// TODO: 외부 API에서 현재가 조회 구현
-	// 현재는 임시 데이터 반환
-	price := &dto.StockPrice{
-		Symbol:    q.Symbols,
-		Price:     decimal.NewFromFloat(100.0), // 임시 가격
-		Timestamp: time.Now(),
-	}
-
-	return price, nil
+	return s.getPrice(strings.TrimSpace(q.Symbols))
 }
 
 // GetCurrentPrices 여러 종목 현재가 조회
 func (s *ServiceImpl) GetCurrentPrices(q dto.GetCurrentPricesQuery) ([]*dto.StockPrice, error) {
-	symbols := strings.Split(q.Symbols, ",")
 	var prices []*dto.StockPrice
-
-	for _, symbol := range symbols {
-		price := &dto.StockPrice{
-			Symbol:    symbol,
-			Price:     decimal.NewFromFloat(100.0), // 임시 가격
-			Timestamp: time.Now(),
+	for _, symbol := range splitSymbols(q.Symbols) {
+		price, err := s.getPrice(symbol)
+		if err != nil {
+			return nil, err
 		}
 		prices = append(prices, price)
 	}
@@ -223,17 +253,343 @@ func (s *ServiceImpl) GetCurrentPrices(q dto.GetCurrentPricesQuery) ([]*dto.Stoc
 	return prices, nil
 }
 
+// getPrice 시세 공급자에서 현재가 조회
+func (s *ServiceImpl) getPrice(symbol string) (*dto.StockPrice, error) {
+	if s.priceSource == nil {
+		return nil, utils.Internal("시세 공급자가 설정되지 않았습니다", nil)
+	}
+	if err := s.validateSymbol(symbol); err != nil {
+		return nil, err
+	}
+
+	price, err := s.priceSource.GetLatestPrice(symbol)
+	if err != nil {
+		return nil, fmt.Errorf("종목 %s 현재가 조회 실패: %w", symbol, err)
+	}
+
+	return toStockPriceResponse(*price), nil
+}
+
+// validateSymbol 종목 마스터에 있는 거래 가능 종목인지 확인 (종목 마스터 미설정 시 생략)
+func (s *ServiceImpl) validateSymbol(symbol string) error {
+	if s.symbols == nil {
+		return nil
+	}
+	_, err := s.symbols.ResolveListing(symbol, "")
+	return err
+}
+
 // GetDailyProfit 일일 수익 조회
 func (s *ServiceImpl) GetDailyProfit(q dto.SymbolPath) (decimal.Decimal, error) {
 	// TODO: 일일 수익 계산 로직 구현
 	return decimal.Zero, nil
 }
 
-// GetTradeHistory 거래 내역 조회
-func (s *ServiceImpl) GetTradeHistory(userID string, q dto.GetTradeHistoryQuery) ([]*dto.TradeHistory, error) {
-	// TODO: 거래 내역 조회 로직 구현
-	// 현재는 빈 배열 반환
-	return []*dto.TradeHistory{}, nil
+// GetTradeHistory 거래 내역 조회 (최신순)
+func (s *ServiceImpl) GetTradeHistory(userID string, q dto.GetTradeHistoryQuery) (*dto.TradeHistoryList, error) {
+	userUUID, err := s.ledgerUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := TradeFilter{Symbol: strings.ToUpper(strings.TrimSpace(q.Symbol))}
+	switch side := strings.ToUpper(strings.TrimSpace(q.Side)); side {
+	case "":
+	case string(trade.SideBUY), string(trade.SideSELL):
+		filter.Side = trade.Side(side)
+	default:
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 매매 구분: %s (BUY, SELL)", q.Side))
+	}
+	if filter.From, filter.To, err = parseReportRange(q.StartDate, q.EndDate); err != nil {
+		return nil, err
+	}
+
+	trades, err := s.ledger.repository.GetTrades(userUUID, filter, q.Limit, q.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("거래 내역 조회 실패: %w", err)
+	}
+	total, err := s.ledger.repository.CountTrades(userUUID, filter)
+	if err != nil {
+		return nil, fmt.Errorf("거래 수 조회 실패: %w", err)
+	}
+
+	method := s.ledger.Method()
+	list := &dto.TradeHistoryList{
+		Trades: make([]*dto.TradeHistory, 0, len(trades)),
+		Total:  total,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+	for _, t := range trades {
+		list.Trades = append(list.Trades, toTradeHistoryResponse(t, method))
+	}
+
+	return list, nil
+}
+
+// GetPnL 종목별 실현/미실현 손익 조회
+// 실현손익은 기간 내 매도 체결, 미실현손익은 현재 보유분(원장 로트 또는 이동평균 단가)을 기준으로 계산한다.
+func (s *ServiceImpl) GetPnL(userID string, q dto.GetPnLQuery) (*dto.PnLReport, error) {
+	userUUID, err := s.ledgerUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	method, err := ParseCostMethod(q.Method, s.ledger.Method())
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := parseReportRange(q.From, q.To)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := s.ledger.repository.GetTrades(userUUID, TradeFilter{From: from, To: to}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("거래 내역 조회 실패: %w", err)
+	}
+
+	symbols := make(map[string]*dto.SymbolPnL)
+	symbolOf := func(symbol string) *dto.SymbolPnL {
+		row, ok := symbols[symbol]
+		if !ok {
+			row = &dto.SymbolPnL{Symbol: symbol}
+			symbols[symbol] = row
+		}
+		return row
+	}
+
+	for _, t := range trades {
+		row := symbolOf(t.Symbol)
+		row.Fees = row.Fees.Add(t.Fee)
+		// 환율 미확정 매도는 취득가액 매칭 전이라 실현손익에서 제외
+		if t.Side != trade.SideSELL || t.FxPending {
+			continue
+		}
+		cost, costKRW := CostBasis(t, method)
+		realized, realizedKRW := RealizedPnL(t, method)
+		row.SoldQuantity = row.SoldQuantity.Add(t.Quantity)
+		row.Proceeds = row.Proceeds.Add(t.Proceeds)
+		row.ProceedsKRW = row.ProceedsKRW.Add(ProceedsKRW(t))
+		row.CostBasis = row.CostBasis.Add(cost)
+		row.CostBasisKRW = row.CostBasisKRW.Add(costKRW)
+		row.RealizedPnL = row.RealizedPnL.Add(realized)
+		row.RealizedPnLKRW = row.RealizedPnLKRW.Add(realizedKRW)
+		row.EstimatedBasis = row.EstimatedBasis || t.EstimatedBasis
+	}
+
+	if err := s.applyHoldings(userUUID, method, symbolOf); err != nil {
+		return nil, err
+	}
+
+	report := &dto.PnLReport{
+		Method:   string(method),
+		Currency: ledgerCurrency,
+		Symbols:  make([]*dto.SymbolPnL, 0, len(symbols)),
+	}
+	if !from.IsZero() {
+		report.From = &from
+	}
+	if !to.IsZero() {
+		report.To = &to
+	}
+	for _, row := range symbols {
+		report.Proceeds = report.Proceeds.Add(row.Proceeds)
+		report.ProceedsKRW = report.ProceedsKRW.Add(row.ProceedsKRW)
+		report.CostBasis = report.CostBasis.Add(row.CostBasis)
+		report.CostBasisKRW = report.CostBasisKRW.Add(row.CostBasisKRW)
+		report.Fees = report.Fees.Add(row.Fees)
+		report.RealizedPnL = report.RealizedPnL.Add(row.RealizedPnL)
+		report.RealizedPnLKRW = report.RealizedPnLKRW.Add(row.RealizedPnLKRW)
+		report.UnrealizedPnL = report.UnrealizedPnL.Add(row.UnrealizedPnL)
+		report.Symbols = append(report.Symbols, row)
+	}
+	sort.Slice(report.Symbols, func(i, j int) bool {
+		return report.Symbols[i].Symbol < report.Symbols[j].Symbol
+	})
+
+	return report, nil
+}
+
+// applyHoldings 현재 보유분의 취득가액/평가금액/미실현손익 반영
+// 원장보다 증권사 잔고가 많으면(원장 기록 이전 보유분) 차이는 증권사 평균단가로 취득가를 추정한다.
+func (s *ServiceImpl) applyHoldings(userID uuid.UUID, method CostMethod, symbolOf func(string) *dto.SymbolPnL) error {
+	lots, err := s.ledger.repository.GetOpenLots(userID, "")
+	if err != nil {
+		return fmt.Errorf("매수 로트 조회 실패: %w", err)
+	}
+	count, err := s.repository.CountByUser(userID)
+	if err != nil {
+		return fmt.Errorf("포트폴리오 수 조회 실패: %w", err)
+	}
+	rows, err := s.repository.GetByUserID(userID, count, 0)
+	if err != nil {
+		return fmt.Errorf("포트폴리오 조회 실패: %w", err)
+	}
+
+	// 원장 보유 수량과 취득가액 (선입선출은 로트 잔량 합계, 이동평균은 마지막 평균단가 기준)
+	type holding struct{ quantity, cost decimal.Decimal }
+	held := make(map[string]holding)
+	for _, lot := range lots {
+		h := held[lot.Symbol]
+		h.quantity = h.quantity.Add(lot.RemainingQuantity)
+		h.cost = h.cost.Add(lot.RemainingQuantity.Mul(lot.CostPerShare))
+		held[lot.Symbol] = h
+	}
+	if method == CostMethodAverage {
+		for symbol := range held {
+			state, err := s.ledger.repository.GetAverageCost(userID, symbol)
+			if err != nil {
+				return fmt.Errorf("평균단가 조회 실패: %w", err)
+			}
+			held[symbol] = holding{quantity: state.Quantity, cost: state.Quantity.Mul(state.CostPerShare)}
+		}
+	}
+
+	for _, row := range rows {
+		pnl := symbolOf(row.Symbol)
+		h := held[row.Symbol]
+		delete(held, row.Symbol)
+
+		quantity, cost := h.quantity, h.cost
+		if row.Quantity.GreaterThan(quantity) {
+			cost = cost.Add(row.Quantity.Sub(quantity).Mul(row.AveragePrice))
+			quantity = row.Quantity
+			pnl.EstimatedBasis = true
+		}
+
+		price := row.AveragePrice
+		if row.CurrentPrice != nil {
+			price = *row.CurrentPrice
+		}
+		pnl.HeldQuantity = quantity
+		pnl.HeldCost = cost.Round(4)
+		pnl.MarketValue = quantity.Mul(price).Round(4)
+		pnl.UnrealizedPnL = pnl.MarketValue.Sub(pnl.HeldCost)
+	}
+
+	// 잔고 동기화 전이거나 증권사 잔고에 없는 원장 보유분은 취득가로 평가
+	for symbol, h := range held {
+		if !h.quantity.IsPositive() {
+			continue
+		}
+		pnl := symbolOf(symbol)
+		pnl.HeldQuantity = h.quantity
+		pnl.HeldCost = h.cost.Round(4)
+		pnl.MarketValue = pnl.HeldCost
+	}
+	return nil
+}
+
+// GetPeriodPnL 월/연 단위 실현손익 조회 (해외주식 양도소득 신고용, 한국 시간 기준)
+func (s *ServiceImpl) GetPeriodPnL(userID string, q dto.GetPeriodPnLQuery) (*dto.PeriodPnLReport, error) {
+	userUUID, err := s.ledgerUser(userID)
+	if err != nil {
+		return nil, err
+	}
+	method, err := ParseCostMethod(q.Method, s.ledger.Method())
+	if err != nil {
+		return nil, err
+	}
+	from, to, err := parseReportRange(q.From, q.To)
+	if err != nil {
+		return nil, err
+	}
+
+	layout := "2006"
+	if q.Period == "month" {
+		layout = "2006-01"
+	}
+
+	trades, err := s.ledger.repository.GetTrades(userUUID, TradeFilter{From: from, To: to}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("거래 내역 조회 실패: %w", err)
+	}
+
+	loc := reportLocation()
+	periods := make(map[string]*dto.PeriodPnL)
+	for _, t := range trades {
+		key := t.ExecutedAt.In(loc).Format(layout)
+		period, ok := periods[key]
+		if !ok {
+			period = &dto.PeriodPnL{Period: key}
+			periods[key] = period
+		}
+		period.Fees = period.Fees.Add(t.Fee)
+		// 환율 미확정 매도는 취득가액 매칭 전이라 실현손익에서 제외
+		if t.Side != trade.SideSELL || t.FxPending {
+			continue
+		}
+		cost, costKRW := CostBasis(t, method)
+		realized, realizedKRW := RealizedPnL(t, method)
+		period.SellCount++
+		period.Proceeds = period.Proceeds.Add(t.Proceeds)
+		period.ProceedsKRW = period.ProceedsKRW.Add(ProceedsKRW(t))
+		period.CostBasis = period.CostBasis.Add(cost)
+		period.CostBasisKRW = period.CostBasisKRW.Add(costKRW)
+		period.RealizedPnL = period.RealizedPnL.Add(realized)
+		period.RealizedPnLKRW = period.RealizedPnLKRW.Add(realizedKRW)
+	}
+
+	report := &dto.PeriodPnLReport{
+		Method:   string(method),
+		Period:   q.Period,
+		Currency: ledgerCurrency,
+		Periods:  make([]*dto.PeriodPnL, 0, len(periods)),
+	}
+	for _, period := range periods {
+		report.RealizedPnL = report.RealizedPnL.Add(period.RealizedPnL)
+		report.RealizedPnLKRW = report.RealizedPnLKRW.Add(period.RealizedPnLKRW)
+		report.Periods = append(report.Periods, period)
+	}
+	sort.Slice(report.Periods, func(i, j int) bool {
+		return report.Periods[i].Period < report.Periods[j].Period
+	})
+
+	return report, nil
+}
+
+// GetTaxLots 미청산 매수 로트 조회 (오래된 순)
+func (s *ServiceImpl) GetTaxLots(userID string, q dto.GetTaxLotsQuery) ([]*dto.TaxLot, error) {
+	userUUID, err := s.ledgerUser(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := s.ledger.repository.GetOpenLots(userUUID, strings.ToUpper(strings.TrimSpace(q.Symbol)))
+	if err != nil {
+		return nil, fmt.Errorf("매수 로트 조회 실패: %w", err)
+	}
+
+	response := make([]*dto.TaxLot, 0, len(lots))
+	for _, lot := range lots {
+		cost := lot.RemainingQuantity.Mul(lot.CostPerShare).Round(4)
+		response = append(response, &dto.TaxLot{
+			ID:                lot.ID,
+			TradeID:           lot.TradeID,
+			Symbol:            lot.Symbol,
+			Quantity:          lot.Quantity,
+			RemainingQuantity: lot.RemainingQuantity,
+			CostPerShare:      lot.CostPerShare,
+			FXRate:            lot.FxRate,
+			CostBasis:         cost,
+			CostBasisKRW:      cost.Mul(lot.FxRate).Round(2),
+			OpenedAt:          lot.OpenedAt,
+		})
+	}
+
+	return response, nil
+}
+
+// ledgerUser 원장 설정 확인 후 사용자 ID 파싱
+func (s *ServiceImpl) ledgerUser(userID string) (uuid.UUID, error) {
+	if s.ledger == nil {
+		return uuid.Nil, utils.Internal("체결 원장이 설정되지 않았습니다", nil)
+	}
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, utils.BadRequest("잘못된 사용자 ID 형식입니다")
+	}
+	return userUUID, nil
 }
 
 // GetCompanyInfo 회사 정보 조회
@@ -243,23 +599,120 @@ func (s *ServiceImpl) GetCompanyInfo(q dto.SymbolPath) (*dto.CompanyInfo, error)
 }
 
 // GetChartData 차트 데이터 조회
-func (s *ServiceImpl) GetChartData(q dto.SymbolPath) ([]*dto.ChartData, error) {
-	// TODO: 외부 API에서 차트 데이터 조회 구현
-	return nil, fmt.Errorf("not implemented")
+func (s *ServiceImpl) GetChartData(q dto.GetChartDataQuery) ([]*dto.ChartData, error) {
+	if s.marketData == nil {
+		return nil, utils.Internal("차트 데이터 서비스가 설정되지 않았습니다", nil)
+	}
+
+	interval, err := marketdata.ParseInterval(q.Interval)
+	if err != nil {
+		return nil, utils.BadRequest(err.Error())
+	}
+	if err := s.validateSymbol(q.Symbol); err != nil {
+		return nil, err
+	}
+
+	to := time.Now()
+	if q.To != "" {
+		if to, err = marketdata.ParseTime(q.To, true); err != nil {
+			return nil, utils.BadRequest("종료일 형식이 올바르지 않습니다 (YYYY-MM-DD 또는 RFC3339)")
+		}
+	}
+
+	// 기본 조회 기간: 일봉 6개월, 분봉 1일
+	from := to.AddDate(0, -6, 0)
+	if interval.IsIntraday() {
+		from = to.AddDate(0, 0, -1)
+	}
+	if q.From != "" {
+		if from, err = marketdata.ParseTime(q.From, false); err != nil {
+			return nil, utils.BadRequest("시작일 형식이 올바르지 않습니다 (YYYY-MM-DD 또는 RFC3339)")
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), chartFetchTimeout)
+	defer cancel()
+
+	bars, err := s.marketData.GetBars(ctx, q.Symbol, q.Exchange, interval, from, to)
+	if err != nil {
+		return nil, fmt.Errorf("차트 데이터 조회 실패: %w", err)
+	}
+
+	chart := make([]*dto.ChartData, 0, len(bars))
+	for _, bar := range bars {
+		chart = append(chart, &dto.ChartData{
+			Symbol:    bar.Symbol,
+			Timestamp: bar.Timestamp,
+			Open:      bar.Open,
+			High:      bar.High,
+			Low:       bar.Low,
+			Close:     bar.Close,
+			Volume:    bar.Volume,
+		})
+	}
+
+	return chart, nil
 }
 
 // SubscribeToPriceUpdates 실시간 가격 업데이트 구독
-func (s *ServiceImpl) SubscribeToPriceUpdates(q dto.GetCurrentPricesQuery) (<-chan dto.StockPrice, error) {
-	// TODO: WebSocket을 통한 실시간 데이터 구독 구현
+func (s *ServiceImpl) SubscribeToPriceUpdates(q dto.GetCurrentPricesQuery) (<-chan dto.StockPrice, func(), error) {
+	if s.priceSource == nil {
+		return nil, nil, utils.Internal("시세 공급자가 설정되지 않았습니다", nil)
+	}
+
+	symbols := splitSymbols(q.Symbols)
+	if len(symbols) == 0 {
+		return nil, nil, utils.BadRequest("구독할 종목을 입력해주세요")
+	}
+
+	prices, unsubscribe := s.priceSource.SubscribePrices(symbols)
+
 	ch := make(chan dto.StockPrice)
-	close(ch)
-	return ch, nil
+	done := make(chan struct{})
+	go func() {
+		defer close(ch)
+		for price := range prices {
+			select {
+			case ch <- *toStockPriceResponse(price):
+			case <-done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return ch, func() {
+		once.Do(func() {
+			close(done)
+			unsubscribe()
+		})
+	}, nil
 }
 
-// RefreshPortfolio 포트폴리오 새로고침
-func (s *ServiceImpl) RefreshPortfolio(userID string) error {
-	_, err := s.GetPortfolio(userID, dto.GetPortfolioQuery{})
-	return err
+// RefreshPortfolio 증권사 잔고로 보유 종목 동기화
+func (s *ServiceImpl) RefreshPortfolio(userID string) (*dto.PortfolioSync, error) {
+	if s.syncer == nil {
+		return nil, utils.Internal("잔고 동기화가 설정되지 않았습니다", nil)
+	}
+
+	userUUID, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 사용자 ID 형식입니다")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+
+	result, err := s.syncer.Sync(ctx, userUUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &dto.PortfolioSync{
+		Updated:  result.Updated,
+		Removed:  result.Removed,
+		SyncedAt: result.SyncedAt,
+	}, nil
 }
 
 // RefreshPositions 포지션 새로고침
@@ -275,3 +728,98 @@ func (s *ServiceImpl) RefreshPrices(q dto.GetCurrentPricesQuery) error {
 	_, err := s.GetCurrentPrices(q)
 	return err
 }
+
+// returnRate 매입금액 대비 손익률 (%)
+func returnRate(profit, cost decimal.Decimal) decimal.Decimal {
+	if !cost.IsPositive() {
+		return decimal.Zero
+	}
+	return profit.Div(cost).Mul(decimal.NewFromInt(100))
+}
+
+// reportLocation 손익 집계 기준 시간대 (국내 양도소득 신고 기준)
+func reportLocation() *time.Location {
+	loc, err := time.LoadLocation("Asia/Seoul")
+	if err != nil {
+		return time.FixedZone("KST", 9*60*60)
+	}
+	return loc
+}
+
+// parseReportRange 조회 기간 파싱 (YYYY-MM-DD는 한국 시간 하루 전체, RFC3339도 허용)
+func parseReportRange(fromRaw, toRaw string) (time.Time, time.Time, error) {
+	var from, to time.Time
+	var err error
+	if fromRaw = strings.TrimSpace(fromRaw); fromRaw != "" {
+		if from, err = parseReportDate(fromRaw, false); err != nil {
+			return from, to, utils.BadRequest("시작일 형식이 올바르지 않습니다 (YYYY-MM-DD 또는 RFC3339)")
+		}
+	}
+	if toRaw = strings.TrimSpace(toRaw); toRaw != "" {
+		if to, err = parseReportDate(toRaw, true); err != nil {
+			return from, to, utils.BadRequest("종료일 형식이 올바르지 않습니다 (YYYY-MM-DD 또는 RFC3339)")
+		}
+	}
+	if !from.IsZero() && !to.IsZero() && from.After(to) {
+		return from, to, utils.BadRequest("시작일이 종료일보다 늦습니다")
+	}
+	return from, to, nil
+}
+
+func parseReportDate(raw string, endOfDay bool) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, raw); err == nil {
+		return t, nil
+	}
+	t, err := time.ParseInLocation("2006-01-02", raw, reportLocation())
+	if err != nil {
+		return time.Time{}, err
+	}
+	if endOfDay {
+		t = t.AddDate(0, 0, 1).Add(-time.Nanosecond)
+	}
+	return t, nil
+}
+
+func toTradeHistoryResponse(t *ent.Trade, method CostMethod) *dto.TradeHistory {
+	response := &dto.TradeHistory{
+		ID:             t.ID,
+		ClientOrderID:  t.ClientOrderID,
+		Symbol:         t.Symbol,
+		Exchange:       t.Exchange,
+		Type:           strings.ToLower(string(t.Side)),
+		Quantity:       t.Quantity,
+		Price:          t.Price,
+		Amount:         t.Quantity.Mul(t.Price),
+		Fee:            t.Fee,
+		Currency:       t.Currency,
+		FXRate:         t.FxRate,
+		Method:         string(method),
+		EstimatedBasis: t.EstimatedBasis,
+		FXPending:      t.FxPending,
+		Timestamp:      t.ExecutedAt,
+	}
+	if t.Side == trade.SideSELL && !t.FxPending {
+		response.CostBasis, _ = CostBasis(t, method)
+		response.RealizedPnL, response.RealizedPnLKRW = RealizedPnL(t, method)
+	}
+	return response
+}
+
+// splitSymbols 콤마로 구분된 종목 목록 파싱
+func splitSymbols(raw string) []string {
+	var symbols []string
+	for _, symbol := range strings.Split(raw, ",") {
+		if symbol = strings.TrimSpace(symbol); symbol != "" {
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
+
+func toStockPriceResponse(price StockPrice) *dto.StockPrice {
+	return &dto.StockPrice{
+		Symbol:    price.Symbol,
+		Price:     price.Price,
+		Timestamp: price.Timestamp,
+	}
+}
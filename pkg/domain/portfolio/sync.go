@@ -0,0 +1,131 @@
+package portfolio
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// syncTimeout 사용자 1명 잔고 동기화 제한 시간 (연속조회 포함)
+const syncTimeout = 30 * time.Second
+
+// BalanceSource 사용자 연결 계좌의 증권사 잔고 조회 (연속조회를 모두 따라간 전체 보유 종목)
+type BalanceSource interface {
+	GetBalance(ctx context.Context, userID string) ([]*Position, error)
+}
+
+// AccountDirectory 증권 계좌를 연결한 사용자 목록 (주기 동기화 대상)
+type AccountDirectory interface {
+	LinkedUserIDs() ([]uuid.UUID, error)
+}
+
+// SyncResult 잔고 동기화 결과
+type SyncResult struct {
+	Updated  int
+	Removed  int
+	SyncedAt time.Time
+}
+
+// Syncer 증권 계좌를 연결한 사용자의 잔고를 Portfolio 테이블에 주기적으로 반영
+type Syncer struct {
+	repository Repository
+	balance    BalanceSource
+	accounts   AccountDirectory
+	interval   time.Duration
+
+	stopChan chan struct{}
+	running  bool
+	mutex    sync.Mutex
+}
+
+// NewSyncer 잔고 동기화 생성 (interval이 0 이하면 주기 동기화 없이 새로고침 요청만 처리)
+func NewSyncer(repository Repository, balance BalanceSource, accounts AccountDirectory, interval time.Duration) *Syncer {
+	return &Syncer{
+		repository: repository,
+		balance:    balance,
+		accounts:   accounts,
+		interval:   interval,
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Start 주기 동기화 시작
+func (s *Syncer) Start() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.running || s.interval <= 0 {
+		return
+	}
+	s.running = true
+	go s.syncLoop()
+	logrus.Infof("💼 포트폴리오 잔고 동기화 시작 (주기: %s)", s.interval)
+}
+
+// Stop 주기 동기화 중지
+func (s *Syncer) Stop() {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if !s.running {
+		return
+	}
+	s.running = false
+	close(s.stopChan)
+	s.stopChan = make(chan struct{})
+}
+
+// Sync 사용자 보유 종목을 연결 계좌 잔고와 일치시킴
+func (s *Syncer) Sync(ctx context.Context, userID uuid.UUID) (*SyncResult, error) {
+	positions, err := s.balance.GetBalance(ctx, userID.String())
+	if err != nil {
+		return nil, fmt.Errorf("잔고 조회 실패: %w", err)
+	}
+
+	updated, removed, err := s.repository.SyncPositions(userID, positions)
+	if err != nil {
+		return nil, fmt.Errorf("보유 종목 저장 실패: %w", err)
+	}
+
+	logrus.Debugf("💼 잔고 동기화 (%s): 반영 %d건, 삭제 %d건", userID, updated, removed)
+	return &SyncResult{Updated: updated, Removed: removed, SyncedAt: time.Now()}, nil
+}
+
+func (s *Syncer) syncLoop() {
+	s.mutex.Lock()
+	stopChan := s.stopChan
+	s.mutex.Unlock()
+
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			s.syncAll()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// syncAll 대상 사용자 전체 동기화 (한 사용자의 실패가 다른 사용자에 영향을 주지 않음)
+func (s *Syncer) syncAll() {
+	userIDs, err := s.accounts.LinkedUserIDs()
+	if err != nil {
+		logrus.Errorf("❌ 잔고 동기화 대상 조회 실패: %v", err)
+		return
+	}
+
+	for _, userID := range userIDs {
+		ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+		if _, err := s.Sync(ctx, userID); err != nil {
+			logrus.Errorf("❌ 잔고 동기화 실패 (%s): %v", userID, err)
+		}
+		cancel()
+	}
+}
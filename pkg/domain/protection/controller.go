@@ -0,0 +1,168 @@
+package protection
+
+import (
+	"auto-trader/pkg/domain/protection/dto"
+	"auto-trader/pkg/shared/middleware"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 포지션 보호 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 포지션 보호 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// CreateRule 보호 규칙 생성
+// @Summary 보호 규칙 생성
+// @Description 계좌 보유 종목(symbol) 또는 전략 포지션(strategy_id)에 손절/익절/추적 손절(비율, ATR 배수)/최대 보유 기간 조건을 설정합니다. 조건에 도달하면 포지션 반대 방향 시장가 주문으로 청산합니다 (비율은 0.05 = 5%)
+// @Tags protection
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.CreateRuleBody true "보호 대상과 조건"
+// @Success 201 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /protection/rules [post]
+func (ctrl *Controller) CreateRule(c *fiber.Ctx) error {
+	var req dto.CreateRuleBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Account == "" {
+		req.Account = middleware.AccountLive
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	rule, err := ctrl.service.CreateRule(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "보호 규칙 생성 실패")
+	}
+
+	return utils.SuccessResponse(c, rule, fiber.StatusCreated)
+}
+
+// GetRules 보호 규칙 목록 조회
+// @Summary 보호 규칙 목록 조회
+// @Description 보호 규칙과 감시 중인 포지션의 현재 손절/익절/추적 손절 가격, 보유 기간 만료 시각, 마지막 청산 발동 내역을 조회합니다
+// @Tags protection
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account query string false "계좌 구분 (LIVE, PAPER)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /protection/rules [get]
+func (ctrl *Controller) GetRules(c *fiber.Ctx) error {
+	var q dto.GetRuleListQuery
+	q.Account = c.Query("account")
+
+	rules, err := ctrl.service.GetRules(utils.GetUserID(c), q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "보호 규칙 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, rules)
+}
+
+// GetRule 보호 규칙 조회
+// @Summary 보호 규칙 조회
+// @Description 보호 규칙과 현재 청산 가격을 조회합니다
+// @Tags protection
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "보호 규칙 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /protection/rules/{id} [get]
+func (ctrl *Controller) GetRule(c *fiber.Ctx) error {
+	var path dto.RulePath
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	rule, err := ctrl.service.GetRule(utils.GetUserID(c), path.ID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "보호 규칙 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, rule)
+}
+
+// UpdateRule 보호 규칙 수정
+// @Summary 보호 규칙 수정
+// @Description 보호 조건을 수정하거나 감시를 켜고 끕니다 (지정한 항목만 변경, 대상은 변경 불가). 감시를 끄면 추적 손절 기준가가 초기화됩니다
+// @Tags protection
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "보호 규칙 ID"
+// @Param body body dto.UpdateRuleBody true "수정할 조건"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /protection/rules/{id} [put]
+func (ctrl *Controller) UpdateRule(c *fiber.Ctx) error {
+	var path dto.RulePath
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	var req dto.UpdateRuleBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+
+	rule, err := ctrl.service.UpdateRule(utils.GetUserID(c), path.ID, req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "보호 규칙 수정 실패")
+	}
+
+	return utils.SuccessResponse(c, rule)
+}
+
+// DeleteRule 보호 규칙 삭제
+// @Summary 보호 규칙 삭제
+// @Description 보호 규칙을 삭제합니다 (이미 접수된 청산 주문은 취소하지 않음)
+// @Tags protection
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "보호 규칙 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /protection/rules/{id} [delete]
+func (ctrl *Controller) DeleteRule(c *fiber.Ctx) error {
+	var path dto.RulePath
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	if err := ctrl.service.DeleteRule(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "보호 규칙 삭제 실패")
+	}
+
+	return utils.SuccessResponse(c, fiber.Map{"message": "보호 규칙이 삭제되었습니다"})
+}
@@ -0,0 +1,44 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// CreateRuleBody 보호 규칙 생성 요청 (strategy_id를 지정하면 전략 포지션, 아니면 계좌 보유 종목)
+// 비율은 0.05 = 5%이며 0이면 해당 조건을 사용하지 않는다.
+type CreateRuleBody struct {
+	Account         string  `json:"account" validate:"enum=LIVE,PAPER"` // 기본 LIVE (전략 규칙은 전략 실행 모드)
+	Symbol          string  `json:"symbol,omitempty" validate:"max=20"` // 계좌 보유 종목 규칙에 필요
+	Exchange        string  `json:"exchange,omitempty" validate:"max=10"`
+	StrategyID      string  `json:"strategy_id,omitempty"`
+	StopLoss        float64 `json:"stop_loss"`        // 평균단가 대비 손절 비율
+	TakeProfit      float64 `json:"take_profit"`      // 평균단가 대비 익절 비율
+	TrailingPercent float64 `json:"trailing_percent"` // 최고가(숏은 최저가) 대비 추적 손절 비율
+	TrailingATR     float64 `json:"trailing_atr"`     // 최고가(숏은 최저가) 대비 ATR 배수 추적 손절
+	ATRPeriod       int     `json:"atr_period"`       // 기본 14
+	MaxHoldMinutes  int     `json:"max_hold_minutes"` // 보유 기간 만료 청산 (분)
+	Active          *bool   `json:"active,omitempty"` // 기본 true
+}
+
+// UpdateRuleBody 보호 규칙 수정 요청 (지정한 항목만 변경, 대상은 변경 불가)
+type UpdateRuleBody struct {
+	StopLoss        *float64 `json:"stop_loss,omitempty"`
+	TakeProfit      *float64 `json:"take_profit,omitempty"`
+	TrailingPercent *float64 `json:"trailing_percent,omitempty"`
+	TrailingATR     *float64 `json:"trailing_atr,omitempty"`
+	ATRPeriod       *int     `json:"atr_period,omitempty"`
+	MaxHoldMinutes  *int     `json:"max_hold_minutes,omitempty"`
+	Active          *bool    `json:"active,omitempty"`
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetRuleListQuery 보호 규칙 목록 조회 쿼리 파라미터
+type GetRuleListQuery struct {
+	Account string `query:"account,omitempty"` // LIVE, PAPER (비어 있으면 전체 계좌)
+}
+
+// Path DTOs (URL 경로 파라미터)
+
+// RulePath 보호 규칙 ID 경로 파라미터
+type RulePath struct {
+	ID string `param:"id" validate:"required"`
+}
@@ -0,0 +1,58 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// RuleResponse 포지션 보호 규칙
+type RuleResponse struct {
+	ID              uuid.UUID        `json:"id"`
+	Account         string           `json:"account"`
+	StrategyID      *uuid.UUID       `json:"strategy_id,omitempty"` // 전략 포지션 규칙
+	Symbol          string           `json:"symbol"`
+	Exchange        string           `json:"exchange,omitempty"`
+	StopLoss        float64          `json:"stop_loss"`
+	TakeProfit      float64          `json:"take_profit"`
+	TrailingPercent float64          `json:"trailing_percent"`
+	TrailingATR     float64          `json:"trailing_atr"`
+	ATRPeriod       int              `json:"atr_period"`
+	MaxHoldMinutes  int              `json:"max_hold_minutes"`
+	Active          bool             `json:"active"`
+	Levels          *LevelsResponse  `json:"levels,omitempty"`       // 현재 포지션 기준 청산 가격 (포지션이 없으면 생략)
+	LastTrigger     *TriggerResponse `json:"last_trigger,omitempty"` // 마지막 청산 발동
+	CreatedAt       time.Time        `json:"created_at"`
+	UpdatedAt       time.Time        `json:"updated_at"`
+}
+
+// LevelsResponse 현재 포지션 기준 청산 가격 (설정하지 않은 조건은 생략)
+type LevelsResponse struct {
+	Side         string           `json:"side"` // long, short
+	Quantity     decimal.Decimal  `json:"quantity"`
+	AvgPrice     decimal.Decimal  `json:"avg_price"`
+	LastPrice    decimal.Decimal  `json:"last_price"`
+	StopLoss     *decimal.Decimal `json:"stop_loss,omitempty"`
+	TakeProfit   *decimal.Decimal `json:"take_profit,omitempty"`
+	TrailingStop *decimal.Decimal `json:"trailing_stop,omitempty"`
+	WaterMark    decimal.Decimal  `json:"water_mark"` // 롱은 보유 중 최고가, 숏은 최저가
+	ATR          *decimal.Decimal `json:"atr,omitempty"`
+	OpenedAt     *time.Time       `json:"opened_at,omitempty"` // 보호 규칙이 포지션을 처음 확인한 시각
+	ExitAt       *time.Time       `json:"exit_at,omitempty"`   // 보유 기간 만료 시각
+	UpdatedAt    time.Time        `json:"updated_at"`
+}
+
+// TriggerResponse 청산 발동 내역
+type TriggerResponse struct {
+	Reason        string          `json:"reason"` // STOP_LOSS, TAKE_PROFIT, TRAILING_STOP, MAX_HOLD
+	Price         decimal.Decimal `json:"price"`
+	ClientOrderID string          `json:"client_order_id"`
+	TriggeredAt   time.Time       `json:"triggered_at"`
+}
+
+// RuleListResponse 포지션 보호 규칙 목록
+type RuleListResponse struct {
+	Rules []*RuleResponse `json:"rules"`
+	Total int             `json:"total"`
+}
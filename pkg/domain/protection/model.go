@@ -0,0 +1,180 @@
+package protection
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// 청산 발동 사유
+const (
+	ExitStopLoss        = "STOP_LOSS"         // 평균단가 대비 손절
+	ExitTakeProfit      = "TAKE_PROFIT"       // 평균단가 대비 익절
+	ExitTrailingStop    = "TRAILING_STOP"     // 최고가/최저가 대비 추적 손절
+	ExitMaxHold         = "MAX_HOLD"          // 보유 기간 만료
+	ExitDefaultStopLoss = "DEFAULT_STOP_LOSS" // 보호 규칙이 없는 보유 종목의 계좌 기본 손절
+)
+
+// 포지션 방향 (리스크 상태 포지션과 같은 값)
+const (
+	SideLong  = "long"
+	SideShort = "short"
+)
+
+// Settings 보호 조건 (비율은 0.05 = 5%, 0이면 사용 안 함)
+type Settings struct {
+	StopLoss        float64
+	TakeProfit      float64
+	TrailingPercent float64
+	TrailingATR     float64 // ATR 배수
+	ATRPeriod       int
+	MaxHoldMinutes  int
+	Active          bool
+}
+
+// Rule 보호 규칙 생성 입력 (StrategyID가 있으면 전략 포지션, 없으면 계좌 보유 종목 단위)
+type Rule struct {
+	UserID     uuid.UUID
+	Account    string
+	StrategyID *uuid.UUID
+	Symbol     string
+	Exchange   string
+	Settings
+}
+
+// Position 보호 대상 포지션
+type Position struct {
+	Side     string
+	Quantity decimal.Decimal
+	AvgPrice decimal.Decimal
+}
+
+// Levels 현재 포지션 기준 청산 가격 (0이면 해당 조건 없음)
+type Levels struct {
+	Position
+	StopLoss     decimal.Decimal
+	TakeProfit   decimal.Decimal
+	TrailingStop decimal.Decimal
+	WaterMark    decimal.Decimal // 롱은 보유 중 최고가, 숏은 최저가
+	ATR          decimal.Decimal
+	OpenedAt     *time.Time // 보호 규칙이 포지션을 처음 확인한 시각
+	ExitAt       *time.Time // 보유 기간 만료 시각
+	LastPrice    decimal.Decimal
+	UpdatedAt    time.Time
+}
+
+// ComputeLevels 보호 조건과 포지션으로 청산 가격 계산
+// 추적 손절은 비율과 ATR 배수 중 현재가에 더 가까운 가격을 쓴다.
+func ComputeLevels(settings Settings, position Position, waterMark, atr decimal.Decimal, openedAt *time.Time) Levels {
+	levels := Levels{Position: position, WaterMark: waterMark, ATR: atr, OpenedAt: openedAt}
+	one := decimal.NewFromInt(1)
+	long := position.Side != SideShort
+
+	if settings.StopLoss > 0 {
+		pct := decimal.NewFromFloat(settings.StopLoss)
+		if long {
+			levels.StopLoss = position.AvgPrice.Mul(one.Sub(pct))
+		} else {
+			levels.StopLoss = position.AvgPrice.Mul(one.Add(pct))
+		}
+	}
+	if settings.TakeProfit > 0 {
+		pct := decimal.NewFromFloat(settings.TakeProfit)
+		if long {
+			levels.TakeProfit = position.AvgPrice.Mul(one.Add(pct))
+		} else {
+			levels.TakeProfit = position.AvgPrice.Mul(one.Sub(pct))
+		}
+	}
+
+	if waterMark.IsPositive() {
+		var candidates []decimal.Decimal
+		if settings.TrailingPercent > 0 {
+			pct := decimal.NewFromFloat(settings.TrailingPercent)
+			if long {
+				candidates = append(candidates, waterMark.Mul(one.Sub(pct)))
+			} else {
+				candidates = append(candidates, waterMark.Mul(one.Add(pct)))
+			}
+		}
+		if settings.TrailingATR > 0 && atr.IsPositive() {
+			distance := atr.Mul(decimal.NewFromFloat(settings.TrailingATR))
+			if long {
+				candidates = append(candidates, waterMark.Sub(distance))
+			} else {
+				candidates = append(candidates, waterMark.Add(distance))
+			}
+		}
+		for _, candidate := range candidates {
+			switch {
+			case levels.TrailingStop.IsZero():
+				levels.TrailingStop = candidate
+			case long:
+				levels.TrailingStop = decimal.Max(levels.TrailingStop, candidate)
+			default:
+				levels.TrailingStop = decimal.Min(levels.TrailingStop, candidate)
+			}
+		}
+		if long && levels.TrailingStop.IsNegative() {
+			levels.TrailingStop = decimal.Zero
+		}
+	}
+
+	if settings.MaxHoldMinutes > 0 && openedAt != nil {
+		exitAt := openedAt.Add(time.Duration(settings.MaxHoldMinutes) * time.Minute)
+		levels.ExitAt = &exitAt
+	}
+
+	levels.round()
+	return levels
+}
+
+// round 표시용 가격 자릿수 정리
+func (l *Levels) round() {
+	l.StopLoss = l.StopLoss.Round(4)
+	l.TakeProfit = l.TakeProfit.Round(4)
+	l.TrailingStop = l.TrailingStop.Round(4)
+	l.ATR = l.ATR.Round(4)
+}
+
+// Hit 현재가로 도달한 청산 조건 (없으면 빈 문자열)
+// 손절 → 추적 손절 → 익절 → 보유 기간 순으로 확인한다.
+func (l *Levels) Hit(price decimal.Decimal, now time.Time) string {
+	long := l.Side != SideShort
+	beyond := func(level decimal.Decimal, adverse bool) bool {
+		if !level.IsPositive() {
+			return false
+		}
+		// 롱은 손절가 이하/익절가 이상, 숏은 반대
+		if long == adverse {
+			return price.LessThanOrEqual(level)
+		}
+		return price.GreaterThanOrEqual(level)
+	}
+
+	switch {
+	case beyond(l.StopLoss, true):
+		return ExitStopLoss
+	case beyond(l.TrailingStop, true):
+		return ExitTrailingStop
+	case beyond(l.TakeProfit, false):
+		return ExitTakeProfit
+	case l.ExitAt != nil && !now.Before(*l.ExitAt):
+		return ExitMaxHold
+	}
+	return ""
+}
+
+// ExtendWaterMark 현재가로 추적 손절 기준가 갱신 (롱은 최고가, 숏은 최저가), 바뀌었으면 true
+func ExtendWaterMark(side string, waterMark, price decimal.Decimal) (decimal.Decimal, bool) {
+	if !price.IsPositive() {
+		return waterMark, false
+	}
+	if waterMark.IsZero() ||
+		(side != SideShort && price.GreaterThan(waterMark)) ||
+		(side == SideShort && price.LessThan(waterMark)) {
+		return price, true
+	}
+	return waterMark, false
+}
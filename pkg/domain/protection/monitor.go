@@ -0,0 +1,548 @@
+package protection
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/schema"
+	"auto-trader/pkg/domain/order"
+	orderdto "auto-trader/pkg/domain/order/dto"
+	"auto-trader/pkg/domain/portfolio"
+	"auto-trader/pkg/shared/indicator"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	retryDelay = time.Minute      // 청산 주문 실패/거부 후 재시도 대기
+	atrTTL     = 15 * time.Minute // ATR 재계산 주기
+)
+
+// PriceStream 실시간 시세 구독 (반환된 함수로 구독 해제)
+type PriceStream interface {
+	SubscribePrices(symbols []string) (<-chan portfolio.StockPrice, func())
+}
+
+// LastPriceSource 최근 체결가 조회 (시세 수신이 없는 동안 보유 기간 만료 확인)
+type LastPriceSource interface {
+	LatestPrice(symbol string) (decimal.Decimal, bool)
+}
+
+// ExitSubmitter 청산 주문 제출 (실행 모드의 실행기로 제출하고 주문 내역 저장)
+type ExitSubmitter interface {
+	SubmitExit(req *order.Request) (*orderdto.OrderResponse, error)
+}
+
+// HistoryProvider 과거 봉 조회 (ATR 계산용)
+type HistoryProvider interface {
+	GetRecentCandles(symbol string, interval time.Duration, count int) ([]indicator.Candle, error)
+}
+
+// watch 감시 중인 포지션 (보호 규칙이 없으면 계좌 기본 손절 대상)
+type watch struct {
+	rule      *ent.ProtectionRule
+	settings  Settings
+	scope     middleware.Scope
+	symbol    string
+	exchange  string
+	position  *Position
+	waterMark decimal.Decimal
+	openedAt  *time.Time
+	atr       decimal.Decimal
+	lastPrice decimal.Decimal
+	dirty     bool // 추적 상태 저장 필요
+}
+
+// exitKey 청산 주문 중복 방지 단위 (사용자 계좌 + 종목)
+type exitKey struct {
+	scope  middleware.Scope
+	symbol string
+}
+
+// pendingExit 접수된 청산 주문 (clientOrderID가 비어 있으면 재시도 대기)
+type pendingExit struct {
+	clientOrderID string
+	retryAt       time.Time
+}
+
+type atrValue struct {
+	value decimal.Decimal
+	at    time.Time
+}
+
+// Monitor 실시간 시세로 보유 포지션의 손절/익절/추적 손절/보유 기간 조건을 감시하고 도달하면 시장가 청산
+// 보호 규칙과 포지션은 주기적으로(또는 규칙 변경 시) 다시 읽고, 시세는 포지션이 있는 종목만 구독한다.
+// 같은 계좌/종목에는 청산 주문을 하나만 접수하며, 주문이 마감되면 남은 수량을 다시 감시한다.
+type Monitor struct {
+	repository      Repository
+	manager         *middleware.Manager
+	prices          PriceStream
+	lastPrices      LastPriceSource
+	orders          ExitSubmitter
+	history         HistoryProvider
+	interval        time.Duration
+	atrInterval     time.Duration
+	defaultStopLoss bool
+
+	// 감시 루프 전용
+	watches  map[uuid.UUID]*watch
+	bySymbol map[string][]*watch
+	atrs     map[string]atrValue
+
+	// API/주문 상태 변경과 공유
+	levels     map[uuid.UUID]Levels
+	pending    map[exitKey]pendingExit
+	stateMutex sync.Mutex
+
+	reload   chan struct{}
+	stopChan chan struct{}
+	running  bool
+	mutex    sync.Mutex
+}
+
+// NewMonitor 포지션 보호 감시 생성 (interval이 0 이하면 감시하지 않음)
+func NewMonitor(repository Repository, manager *middleware.Manager, prices PriceStream, lastPrices LastPriceSource, orders ExitSubmitter, history HistoryProvider, interval, atrInterval time.Duration, defaultStopLoss bool) *Monitor {
+	return &Monitor{
+		repository:      repository,
+		manager:         manager,
+		prices:          prices,
+		lastPrices:      lastPrices,
+		orders:          orders,
+		history:         history,
+		interval:        interval,
+		atrInterval:     atrInterval,
+		defaultStopLoss: defaultStopLoss,
+		watches:         make(map[uuid.UUID]*watch),
+		bySymbol:        make(map[string][]*watch),
+		atrs:            make(map[string]atrValue),
+		levels:          make(map[uuid.UUID]Levels),
+		pending:         make(map[exitKey]pendingExit),
+		reload:          make(chan struct{}, 1),
+		stopChan:        make(chan struct{}),
+	}
+}
+
+// Start 감시 시작
+func (m *Monitor) Start() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.running || m.interval <= 0 {
+		return
+	}
+	m.running = true
+	go m.monitorLoop()
+	logrus.Infof("⛑️  포지션 보호 감시 시작 (주기: %s)", m.interval)
+}
+
+// Stop 감시 중지
+func (m *Monitor) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.running {
+		return
+	}
+	m.running = false
+	close(m.stopChan)
+	m.stopChan = make(chan struct{})
+}
+
+// Reload 보호 규칙 변경 즉시 반영 요청 (다음 주기를 기다리지 않음)
+func (m *Monitor) Reload() {
+	select {
+	case m.reload <- struct{}{}:
+	default:
+	}
+}
+
+// Levels 보호 규칙의 현재 청산 가격 (포지션이 없거나 아직 확인 전이면 false)
+func (m *Monitor) Levels(ruleID uuid.UUID) (Levels, bool) {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+	levels, ok := m.levels[ruleID]
+	return levels, ok
+}
+
+// HandleUpdate 청산 주문 마감 반영 (체결 완료면 대기 해제, 취소/거부면 잠시 후 재시도)
+func (m *Monitor) HandleUpdate(update order.Update) {
+	if !update.Liquidation || !update.Status.IsTerminal() {
+		return
+	}
+
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+	for key, exit := range m.pending {
+		if exit.clientOrderID != update.ClientOrderID {
+			continue
+		}
+		if update.Status == order.StatusFilled {
+			delete(m.pending, key)
+		} else {
+			m.pending[key] = pendingExit{retryAt: time.Now().Add(retryDelay)}
+		}
+	}
+}
+
+func (m *Monitor) monitorLoop() {
+	m.mutex.Lock()
+	stopChan := m.stopChan
+	m.mutex.Unlock()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	var prices <-chan portfolio.StockPrice
+	unsubscribe := func() {}
+	subscribed := ""
+	defer func() { unsubscribe() }()
+
+	refresh := func() {
+		m.refresh()
+
+		// 포지션이 있는 종목이 바뀌면 다시 구독 (빈 목록은 전체 종목 구독이므로 구독하지 않음)
+		symbols := m.watchedSymbols()
+		if key := strings.Join(symbols, ","); key != subscribed {
+			unsubscribe()
+			prices, unsubscribe, subscribed = nil, func() {}, key
+			if len(symbols) > 0 {
+				prices, unsubscribe = m.prices.SubscribePrices(symbols)
+			}
+		}
+		m.evaluateAll()
+	}
+
+	refresh()
+	for {
+		select {
+		case price, ok := <-prices:
+			if !ok {
+				prices, subscribed = nil, ""
+				continue
+			}
+			m.evaluateSymbol(price.Symbol, price.Price)
+		case <-ticker.C:
+			refresh()
+		case <-m.reload:
+			refresh()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// refresh 보호 규칙과 포지션을 다시 읽고 추적 상태 저장
+func (m *Monitor) refresh() {
+	rules, err := m.repository.GetActive()
+	if err != nil {
+		logrus.Errorf("❌ 보호 규칙 조회 실패: %v", err)
+		return
+	}
+
+	strategyIDs := []uuid.UUID{}
+	for _, rule := range rules {
+		if rule.StrategyID != nil {
+			strategyIDs = append(strategyIDs, *rule.StrategyID)
+		}
+	}
+	strategyPositions, err := m.repository.GetStrategyPositions(strategyIDs)
+	if err != nil {
+		logrus.Errorf("❌ 전략 보유 수량 조회 실패: %v", err)
+		return
+	}
+
+	now := time.Now()
+	watches := make(map[uuid.UUID]*watch, len(rules))
+	bySymbol := make(map[string][]*watch)
+	levels := make(map[uuid.UUID]Levels, len(rules))
+	covered := make(map[exitKey]bool, len(rules))
+
+	for _, rule := range rules {
+		w := m.track(rule, m.watches[rule.ID], strategyPositions, now)
+		covered[exitKey{scope: w.scope, symbol: w.symbol}] = true
+		if w.dirty {
+			if err := m.repository.SaveTracking(rule.ID, w.waterMark, w.openedAt); err != nil {
+				logrus.Warnf("⚠️  보호 규칙 추적 상태 저장 실패 (%s): %v", rule.ID, err)
+			} else {
+				w.dirty = false
+			}
+		}
+
+		watches[rule.ID] = w
+		if w.position == nil {
+			continue
+		}
+		if w.settings.TrailingATR > 0 {
+			w.atr = m.atr(w.symbol, w.settings.ATRPeriod)
+		}
+		levels[rule.ID] = m.levelsOf(w, now)
+		bySymbol[w.symbol] = append(bySymbol[w.symbol], w)
+	}
+
+	// 보호 규칙이 없는 보유 종목은 계좌 기본 손절 비율로 감시
+	if m.defaultStopLoss {
+		for _, scope := range m.manager.Scopes() {
+			for symbol, position := range m.manager.GetPositions(scope) {
+				if covered[exitKey{scope: scope, symbol: symbol}] {
+					continue
+				}
+				bySymbol[symbol] = append(bySymbol[symbol], &watch{
+					scope:    scope,
+					symbol:   symbol,
+					position: &Position{Side: position.Side, Quantity: position.Quantity, AvgPrice: position.AvgPrice},
+				})
+			}
+		}
+	}
+
+	m.watches = watches
+	m.bySymbol = bySymbol
+
+	m.stateMutex.Lock()
+	m.levels = levels
+	m.stateMutex.Unlock()
+}
+
+// track 보호 규칙의 현재 포지션과 추적 상태 (이전 감시 상태가 있으면 이어서 사용)
+// 포지션이 새로 생기거나 방향이 바뀌면 평균단가를 기준가로 추적을 다시 시작하고, 포지션이 없어지면 초기화한다.
+func (m *Monitor) track(rule *ent.ProtectionRule, previous *watch, strategyPositions map[uuid.UUID]map[string]schema.OpenPosition, now time.Time) *watch {
+	w := &watch{
+		rule:      rule,
+		settings:  settingsOf(rule),
+		scope:     middleware.Scope{UserID: rule.UserID, Account: string(rule.Account)},
+		symbol:    rule.Symbol,
+		exchange:  rule.Exchange,
+		waterMark: rule.WaterMark,
+		openedAt:  rule.OpenedAt,
+	}
+	if previous != nil {
+		w.waterMark, w.openedAt, w.dirty = previous.waterMark, previous.openedAt, previous.dirty
+		w.lastPrice = previous.lastPrice
+	}
+
+	if rule.StrategyID != nil {
+		if held, ok := strategyPositions[*rule.StrategyID][rule.Symbol]; ok && held.Quantity.IsPositive() {
+			w.position = &Position{Side: SideLong, Quantity: held.Quantity, AvgPrice: held.AvgCost}
+		}
+	} else if position, ok := m.manager.GetPositions(w.scope)[rule.Symbol]; ok && position.Quantity.IsPositive() {
+		w.position = &Position{Side: position.Side, Quantity: position.Quantity, AvgPrice: position.AvgPrice}
+	}
+
+	flipped := previous != nil && previous.position != nil && w.position != nil && previous.position.Side != w.position.Side
+	switch {
+	case w.position == nil && (w.openedAt != nil || !w.waterMark.IsZero()):
+		w.waterMark, w.openedAt, w.dirty = decimal.Zero, nil, true
+	case w.position != nil && (w.openedAt == nil || flipped):
+		opened := now
+		w.waterMark, w.openedAt, w.dirty = w.position.AvgPrice, &opened, true
+	}
+	return w
+}
+
+// watchedSymbols 포지션이 있는 감시 종목 (정렬)
+func (m *Monitor) watchedSymbols() []string {
+	symbols := make([]string, 0, len(m.bySymbol))
+	for symbol := range m.bySymbol {
+		symbols = append(symbols, symbol)
+	}
+	sort.Strings(symbols)
+	return symbols
+}
+
+// evaluateAll 최근 체결가로 전체 감시 포지션 확인 (시세 수신이 없어도 보유 기간 만료 청산)
+func (m *Monitor) evaluateAll() {
+	for symbol := range m.bySymbol {
+		if price, ok := m.lastPrices.LatestPrice(symbol); ok {
+			m.evaluateSymbol(symbol, price)
+		}
+	}
+}
+
+// evaluateSymbol 종목 시세로 감시 포지션의 청산 조건 확인
+func (m *Monitor) evaluateSymbol(symbol string, price decimal.Decimal) {
+	if !price.IsPositive() {
+		return
+	}
+	now := time.Now()
+	for _, w := range m.bySymbol[symbol] {
+		m.evaluate(w, price, now)
+	}
+}
+
+func (m *Monitor) evaluate(w *watch, price decimal.Decimal, now time.Time) {
+	if w.position == nil || m.isPending(exitKey{scope: w.scope, symbol: w.symbol}, now) {
+		return
+	}
+	w.lastPrice = price
+
+	if w.rule == nil {
+		if m.manager.CheckStopLoss(w.scope, w.symbol, price) {
+			m.exit(w, ExitDefaultStopLoss, price)
+		}
+		return
+	}
+
+	if mark, changed := ExtendWaterMark(w.position.Side, w.waterMark, price); changed {
+		w.waterMark, w.dirty = mark, true
+	}
+	levels := m.levelsOf(w, now)
+	m.stateMutex.Lock()
+	m.levels[w.rule.ID] = levels
+	m.stateMutex.Unlock()
+
+	if reason := levels.Hit(price, now); reason != "" {
+		m.exit(w, reason, price)
+	}
+}
+
+func (m *Monitor) levelsOf(w *watch, now time.Time) Levels {
+	levels := ComputeLevels(w.settings, *w.position, w.waterMark, w.atr, w.openedAt)
+	levels.LastPrice = w.lastPrice
+	levels.UpdatedAt = now
+	return levels
+}
+
+// exit 현재 보유 수량을 다시 확인하고 포지션 반대 방향 시장가 청산 주문 제출
+func (m *Monitor) exit(w *watch, reason string, price decimal.Decimal) {
+	key := exitKey{scope: w.scope, symbol: w.symbol}
+	position := m.currentPosition(w)
+	if position == nil {
+		w.position = nil
+		return
+	}
+	quantity := position.Quantity.Floor()
+	if !quantity.IsPositive() {
+		return
+	}
+
+	side := order.SideSell
+	if position.Side == SideShort {
+		side = order.SideBuy
+	}
+	req := &order.Request{
+		ClientOrderID: uuid.New().String(),
+		UserID:        w.scope.UserID.String(),
+		Symbol:        w.symbol,
+		Exchange:      w.exchange,
+		Mode:          order.Mode(w.scope.Account),
+		Side:          side,
+		Quantity:      quantity,
+	}
+	if w.rule != nil && w.rule.StrategyID != nil {
+		req.StrategyID = w.rule.StrategyID.String()
+	}
+
+	logrus.Warnf("⛑️  포지션 보호 청산 발동 (%s/%s): %s %s %s @ %s - %s",
+		w.scope.UserID, w.scope.Account, side, quantity.String(), w.symbol, price.String(), reason)
+
+	// 실행기가 주문 상태를 동기로 보고할 수 있어 제출 전에 대기 등록
+	m.setPending(key, pendingExit{clientOrderID: req.ClientOrderID})
+	submitted, err := m.orders.SubmitExit(req)
+	if err != nil {
+		logrus.Errorf("❌ 보호 청산 주문 실패 (%s/%s %s): %v", w.scope.UserID, w.scope.Account, w.symbol, err)
+		m.setPending(key, pendingExit{retryAt: time.Now().Add(retryDelay)})
+		return
+	}
+	if order.Status(submitted.Status).IsTerminal() {
+		m.HandleUpdate(order.Update{ClientOrderID: req.ClientOrderID, Status: order.Status(submitted.Status), Liquidation: true})
+	}
+
+	if w.rule != nil {
+		if err := m.repository.RecordTrigger(w.rule.ID, reason, price, req.ClientOrderID); err != nil {
+			logrus.Errorf("❌ 보호 청산 발동 기록 실패 (%s): %v", w.rule.ID, err)
+		}
+	}
+}
+
+// currentPosition 청산 직전 보유 수량 (전략 포지션은 계좌 보유 수량을 넘지 않게 제한)
+func (m *Monitor) currentPosition(w *watch) *Position {
+	account, ok := m.manager.GetPositions(w.scope)[w.symbol]
+	if !ok || !account.Quantity.IsPositive() {
+		return nil
+	}
+	if w.rule == nil || w.rule.StrategyID == nil {
+		return &Position{Side: account.Side, Quantity: account.Quantity, AvgPrice: account.AvgPrice}
+	}
+
+	positions, err := m.repository.GetStrategyPositions([]uuid.UUID{*w.rule.StrategyID})
+	if err != nil {
+		logrus.Errorf("❌ 전략 보유 수량 조회 실패 (%s): %v", *w.rule.StrategyID, err)
+		return nil
+	}
+	held, ok := positions[*w.rule.StrategyID][w.symbol]
+	if !ok || !held.Quantity.IsPositive() || account.Side != SideLong {
+		return nil
+	}
+	return &Position{Side: SideLong, Quantity: decimal.Min(held.Quantity, account.Quantity), AvgPrice: held.AvgCost}
+}
+
+func (m *Monitor) isPending(key exitKey, now time.Time) bool {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+
+	exit, ok := m.pending[key]
+	if !ok {
+		return false
+	}
+	if exit.clientOrderID == "" && !now.Before(exit.retryAt) {
+		delete(m.pending, key)
+		return false
+	}
+	return true
+}
+
+func (m *Monitor) setPending(key exitKey, exit pendingExit) {
+	m.stateMutex.Lock()
+	defer m.stateMutex.Unlock()
+	m.pending[key] = exit
+}
+
+// atr 종목 ATR (봉이 부족하거나 조회에 실패하면 이전 값, 없으면 0)
+func (m *Monitor) atr(symbol string, period int) decimal.Decimal {
+	if m.history == nil || m.atrInterval <= 0 {
+		return decimal.Zero
+	}
+	key := fmt.Sprintf("%s:%d", symbol, period)
+	cached, ok := m.atrs[key]
+	if ok && time.Since(cached.at) < atrTTL {
+		return cached.value
+	}
+
+	candles, err := m.history.GetRecentCandles(symbol, m.atrInterval, period*3)
+	if err != nil {
+		logrus.Warnf("⚠️  ATR 계산용 봉 조회 실패 (%s): %v", symbol, err)
+		m.atrs[key] = atrValue{value: cached.value, at: time.Now()}
+		return cached.value
+	}
+
+	atr := indicator.NewATR(period)
+	for _, candle := range candles {
+		atr.Update(candle)
+	}
+	value := decimal.Zero
+	if atr.Ready() {
+		value = decimal.NewFromFloat(atr.Value())
+	}
+	m.atrs[key] = atrValue{value: value, at: time.Now()}
+	return value
+}
+
+// settingsOf 저장된 보호 규칙의 보호 조건
+func settingsOf(rule *ent.ProtectionRule) Settings {
+	return Settings{
+		StopLoss:        rule.StopLoss,
+		TakeProfit:      rule.TakeProfit,
+		TrailingPercent: rule.TrailingPercent,
+		TrailingATR:     rule.TrailingAtr,
+		ATRPeriod:       rule.AtrPeriod,
+		MaxHoldMinutes:  rule.MaxHoldMinutes,
+		Active:          rule.Active,
+	}
+}
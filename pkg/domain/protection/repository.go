@@ -0,0 +1,231 @@
+package protection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/protectionrule"
+	"auto-trader/ent/schema"
+	"auto-trader/ent/strategyperformance"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Repository 포지션 보호 규칙 데이터 접근 인터페이스
+type Repository interface {
+	// 보호 규칙
+	Create(rule *Rule) (*ent.ProtectionRule, error)
+	GetByID(id uuid.UUID) (*ent.ProtectionRule, error)
+	GetByUser(userID uuid.UUID, account string) ([]*ent.ProtectionRule, error)
+	FindByTarget(userID uuid.UUID, account, symbol string, strategyID *uuid.UUID) (*ent.ProtectionRule, error)
+	GetActive() ([]*ent.ProtectionRule, error)
+	UpdateSettings(id uuid.UUID, settings Settings) (*ent.ProtectionRule, error)
+	Delete(id uuid.UUID) error
+
+	// 감시 상태 (추적 손절 기준가, 포지션 확인 시각, 청산 발동 내역)
+	SaveTracking(id uuid.UUID, waterMark decimal.Decimal, openedAt *time.Time) error
+	RecordTrigger(id uuid.UUID, reason string, price decimal.Decimal, clientOrderID string) error
+
+	// 전략 (전략별 보호 대상과 미청산 보유 수량)
+	GetStrategy(id uuid.UUID) (*ent.Strategy, error)
+	GetStrategyPositions(strategyIDs []uuid.UUID) (map[uuid.UUID]map[string]schema.OpenPosition, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// Create 보호 규칙 생성
+func (r *EntRepository) Create(rule *Rule) (*ent.ProtectionRule, error) {
+	created, err := r.client.ProtectionRule.Create().
+		SetUserID(rule.UserID).
+		SetAccount(protectionrule.Account(rule.Account)).
+		SetNillableStrategyID(rule.StrategyID).
+		SetSymbol(rule.Symbol).
+		SetExchange(rule.Exchange).
+		SetStopLoss(rule.StopLoss).
+		SetTakeProfit(rule.TakeProfit).
+		SetTrailingPercent(rule.TrailingPercent).
+		SetTrailingAtr(rule.TrailingATR).
+		SetAtrPeriod(rule.ATRPeriod).
+		SetMaxHoldMinutes(rule.MaxHoldMinutes).
+		SetActive(rule.Active).
+		Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create protection rule: %w", err)
+	}
+	return created, nil
+}
+
+// GetByID 보호 규칙 조회 (없으면 nil)
+func (r *EntRepository) GetByID(id uuid.UUID) (*ent.ProtectionRule, error) {
+	rule, err := r.client.ProtectionRule.Get(r.getContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get protection rule: %w", err)
+	}
+	return rule, nil
+}
+
+// GetByUser 사용자 보호 규칙 조회 (account가 비어 있으면 전체 계좌)
+func (r *EntRepository) GetByUser(userID uuid.UUID, account string) ([]*ent.ProtectionRule, error) {
+	query := r.client.ProtectionRule.Query().
+		Where(protectionrule.UserID(userID))
+	if account != "" {
+		query = query.Where(protectionrule.AccountEQ(protectionrule.Account(account)))
+	}
+
+	rules, err := query.
+		Order(ent.Asc(protectionrule.FieldSymbol), ent.Asc(protectionrule.FieldCreatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get protection rules: %w", err)
+	}
+	return rules, nil
+}
+
+// FindByTarget 같은 대상(계좌 보유 종목 또는 전략)의 보호 규칙 조회 (없으면 nil)
+func (r *EntRepository) FindByTarget(userID uuid.UUID, account, symbol string, strategyID *uuid.UUID) (*ent.ProtectionRule, error) {
+	query := r.client.ProtectionRule.Query().
+		Where(
+			protectionrule.UserID(userID),
+			protectionrule.AccountEQ(protectionrule.Account(account)),
+		)
+	if strategyID != nil {
+		query = query.Where(protectionrule.StrategyID(*strategyID))
+	} else {
+		query = query.Where(protectionrule.Symbol(symbol), protectionrule.StrategyIDIsNil())
+	}
+
+	rule, err := query.First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to find protection rule: %w", err)
+	}
+	return rule, nil
+}
+
+// GetActive 감시 중인 보호 규칙 전체 조회
+func (r *EntRepository) GetActive() ([]*ent.ProtectionRule, error) {
+	rules, err := r.client.ProtectionRule.Query().
+		Where(protectionrule.Active(true)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active protection rules: %w", err)
+	}
+	return rules, nil
+}
+
+// UpdateSettings 보호 조건 변경 (비활성화하면 감시 상태 초기화)
+func (r *EntRepository) UpdateSettings(id uuid.UUID, settings Settings) (*ent.ProtectionRule, error) {
+	update := r.client.ProtectionRule.UpdateOneID(id).
+		SetStopLoss(settings.StopLoss).
+		SetTakeProfit(settings.TakeProfit).
+		SetTrailingPercent(settings.TrailingPercent).
+		SetTrailingAtr(settings.TrailingATR).
+		SetAtrPeriod(settings.ATRPeriod).
+		SetMaxHoldMinutes(settings.MaxHoldMinutes).
+		SetActive(settings.Active)
+	if !settings.Active {
+		update = update.SetWaterMark(decimal.Zero).ClearOpenedAt()
+	}
+
+	updated, err := update.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update protection rule: %w", err)
+	}
+	return updated, nil
+}
+
+// Delete 보호 규칙 삭제
+func (r *EntRepository) Delete(id uuid.UUID) error {
+	if err := r.client.ProtectionRule.DeleteOneID(id).Exec(r.getContext()); err != nil {
+		return fmt.Errorf("failed to delete protection rule: %w", err)
+	}
+	return nil
+}
+
+// SaveTracking 추적 손절 기준가와 포지션 확인 시각 저장 (openedAt이 nil이면 포지션 없음)
+func (r *EntRepository) SaveTracking(id uuid.UUID, waterMark decimal.Decimal, openedAt *time.Time) error {
+	update := r.client.ProtectionRule.UpdateOneID(id).
+		SetWaterMark(waterMark)
+	if openedAt != nil {
+		update = update.SetOpenedAt(*openedAt)
+	} else {
+		update = update.ClearOpenedAt()
+	}
+
+	if err := update.Exec(r.getContext()); err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to save protection tracking: %w", err)
+	}
+	return nil
+}
+
+// RecordTrigger 청산 발동 내역 저장
+func (r *EntRepository) RecordTrigger(id uuid.UUID, reason string, price decimal.Decimal, clientOrderID string) error {
+	err := r.client.ProtectionRule.UpdateOneID(id).
+		SetTriggeredAt(time.Now()).
+		SetTriggerReason(reason).
+		SetTriggerPrice(price).
+		SetExitClientOrderID(clientOrderID).
+		Exec(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to record protection trigger: %w", err)
+	}
+	return nil
+}
+
+// GetStrategy 전략 조회 (없으면 nil)
+func (r *EntRepository) GetStrategy(id uuid.UUID) (*ent.Strategy, error) {
+	strategy, err := r.client.Strategy.Get(r.getContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get strategy: %w", err)
+	}
+	return strategy, nil
+}
+
+// GetStrategyPositions 전략별 미청산 보유 수량 (성과 기록이 없는 전략은 제외)
+func (r *EntRepository) GetStrategyPositions(strategyIDs []uuid.UUID) (map[uuid.UUID]map[string]schema.OpenPosition, error) {
+	positions := make(map[uuid.UUID]map[string]schema.OpenPosition, len(strategyIDs))
+	if len(strategyIDs) == 0 {
+		return positions, nil
+	}
+
+	rows, err := r.client.StrategyPerformance.Query().
+		Where(strategyperformance.StrategyIDIn(strategyIDs...)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strategy positions: %w", err)
+	}
+	for _, row := range rows {
+		positions[row.StrategyID] = row.OpenPositions
+	}
+	return positions, nil
+}
@@ -0,0 +1,306 @@
+package protection
+
+import (
+	"fmt"
+	"strings"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/protection/dto"
+	"auto-trader/pkg/shared/middleware"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// defaultATRPeriod ATR 추적 손절 기본 기간
+const defaultATRPeriod = 14
+
+// Watcher 보호 규칙 감시 (현재 청산 가격 조회, 규칙 변경 반영)
+type Watcher interface {
+	Levels(ruleID uuid.UUID) (Levels, bool)
+	Reload()
+}
+
+// Service 포지션 보호 서비스 인터페이스
+type Service interface {
+	CreateRule(userID string, body dto.CreateRuleBody) (*dto.RuleResponse, error)
+	GetRules(userID string, q dto.GetRuleListQuery) (*dto.RuleListResponse, error)
+	GetRule(userID, id string) (*dto.RuleResponse, error)
+	UpdateRule(userID, id string, body dto.UpdateRuleBody) (*dto.RuleResponse, error)
+	DeleteRule(userID, id string) error
+}
+
+// ServiceImpl 포지션 보호 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	watcher    Watcher
+}
+
+// NewService 새로운 포지션 보호 서비스 생성
+func NewService(repository Repository, watcher Watcher) Service {
+	return &ServiceImpl{
+		repository: repository,
+		watcher:    watcher,
+	}
+}
+
+// CreateRule 보호 규칙 생성 (대상별 규칙은 하나, 전략 규칙은 전략의 종목/실행 모드를 따름)
+func (s *ServiceImpl) CreateRule(userID string, body dto.CreateRuleBody) (*dto.RuleResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	rule := &Rule{
+		UserID:   uid,
+		Account:  body.Account,
+		Symbol:   strings.ToUpper(strings.TrimSpace(body.Symbol)),
+		Exchange: body.Exchange,
+		Settings: Settings{
+			StopLoss:        body.StopLoss,
+			TakeProfit:      body.TakeProfit,
+			TrailingPercent: body.TrailingPercent,
+			TrailingATR:     body.TrailingATR,
+			ATRPeriod:       body.ATRPeriod,
+			MaxHoldMinutes:  body.MaxHoldMinutes,
+			Active:          body.Active == nil || *body.Active,
+		},
+	}
+	if rule.ATRPeriod == 0 {
+		rule.ATRPeriod = defaultATRPeriod
+	}
+	if err := validateSettings(rule.Settings); err != nil {
+		return nil, err
+	}
+
+	if body.StrategyID != "" {
+		strategyID, err := uuid.Parse(body.StrategyID)
+		if err != nil {
+			return nil, utils.BadRequest("잘못된 전략 ID 형식입니다")
+		}
+		strategy, err := s.repository.GetStrategy(strategyID)
+		if err != nil {
+			return nil, fmt.Errorf("전략 조회 실패: %w", err)
+		}
+		if strategy == nil || strategy.UserID != uid {
+			return nil, utils.NotFound("strategy", "전략을 찾을 수 없습니다")
+		}
+		rule.StrategyID = &strategy.ID
+		rule.Account = string(strategy.TradingMode)
+		rule.Symbol = strategy.Symbol
+		rule.Exchange = strategy.Exchange
+	} else if rule.Symbol == "" {
+		return nil, utils.BadRequest("보호할 종목 또는 전략을 입력해주세요")
+	}
+
+	existing, err := s.repository.FindByTarget(uid, rule.Account, rule.Symbol, rule.StrategyID)
+	if err != nil {
+		return nil, fmt.Errorf("보호 규칙 조회 실패: %w", err)
+	}
+	if existing != nil {
+		return nil, utils.Conflict("protection", "같은 대상의 보호 규칙이 이미 있습니다")
+	}
+
+	created, err := s.repository.Create(rule)
+	if err != nil {
+		return nil, fmt.Errorf("보호 규칙 생성 실패: %w", err)
+	}
+	s.watcher.Reload()
+	return s.toRuleResponse(created), nil
+}
+
+// GetRules 사용자 보호 규칙과 현재 청산 가격 조회
+func (s *ServiceImpl) GetRules(userID string, q dto.GetRuleListQuery) (*dto.RuleListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	if q.Account != "" && q.Account != middleware.AccountLive && q.Account != middleware.AccountPaper {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 계좌 구분: %s", q.Account))
+	}
+
+	rules, err := s.repository.GetByUser(uid, q.Account)
+	if err != nil {
+		return nil, fmt.Errorf("보호 규칙 조회 실패: %w", err)
+	}
+
+	response := &dto.RuleListResponse{
+		Rules: make([]*dto.RuleResponse, 0, len(rules)),
+		Total: len(rules),
+	}
+	for _, rule := range rules {
+		response.Rules = append(response.Rules, s.toRuleResponse(rule))
+	}
+	return response, nil
+}
+
+// GetRule 보호 규칙과 현재 청산 가격 조회
+func (s *ServiceImpl) GetRule(userID, id string) (*dto.RuleResponse, error) {
+	rule, err := s.getOwnedRule(userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.toRuleResponse(rule), nil
+}
+
+// UpdateRule 보호 조건 수정 (다음 시세부터 새 조건 적용)
+func (s *ServiceImpl) UpdateRule(userID, id string, body dto.UpdateRuleBody) (*dto.RuleResponse, error) {
+	rule, err := s.getOwnedRule(userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	settings := settingsOf(rule)
+	if body.StopLoss != nil {
+		settings.StopLoss = *body.StopLoss
+	}
+	if body.TakeProfit != nil {
+		settings.TakeProfit = *body.TakeProfit
+	}
+	if body.TrailingPercent != nil {
+		settings.TrailingPercent = *body.TrailingPercent
+	}
+	if body.TrailingATR != nil {
+		settings.TrailingATR = *body.TrailingATR
+	}
+	if body.ATRPeriod != nil {
+		settings.ATRPeriod = *body.ATRPeriod
+	}
+	if body.MaxHoldMinutes != nil {
+		settings.MaxHoldMinutes = *body.MaxHoldMinutes
+	}
+	if body.Active != nil {
+		settings.Active = *body.Active
+	}
+	if err := validateSettings(settings); err != nil {
+		return nil, err
+	}
+
+	updated, err := s.repository.UpdateSettings(rule.ID, settings)
+	if err != nil {
+		return nil, fmt.Errorf("보호 규칙 수정 실패: %w", err)
+	}
+	s.watcher.Reload()
+	return s.toRuleResponse(updated), nil
+}
+
+// DeleteRule 보호 규칙 삭제 (이미 접수된 청산 주문은 취소하지 않음)
+func (s *ServiceImpl) DeleteRule(userID, id string) error {
+	rule, err := s.getOwnedRule(userID, id)
+	if err != nil {
+		return err
+	}
+
+	if err := s.repository.Delete(rule.ID); err != nil {
+		return fmt.Errorf("보호 규칙 삭제 실패: %w", err)
+	}
+	s.watcher.Reload()
+	return nil
+}
+
+// getOwnedRule 요청 사용자의 보호 규칙 조회
+func (s *ServiceImpl) getOwnedRule(userID, id string) (*ent.ProtectionRule, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	ruleID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 보호 규칙 ID 형식입니다")
+	}
+
+	rule, err := s.repository.GetByID(ruleID)
+	if err != nil {
+		return nil, fmt.Errorf("보호 규칙 조회 실패: %w", err)
+	}
+	if rule == nil || rule.UserID != uid {
+		return nil, utils.NotFound("protection", "보호 규칙을 찾을 수 없습니다")
+	}
+
+	return rule, nil
+}
+
+// validateSettings 보호 조건 범위 확인 (활성 규칙은 조건이 하나 이상 필요)
+func validateSettings(settings Settings) error {
+	switch {
+	case settings.StopLoss < 0 || settings.StopLoss >= 1:
+		return utils.BadRequest("손절 비율은 0 이상 1 미만이어야 합니다")
+	case settings.TakeProfit < 0:
+		return utils.BadRequest("익절 비율은 0 이상이어야 합니다")
+	case settings.TrailingPercent < 0 || settings.TrailingPercent >= 1:
+		return utils.BadRequest("추적 손절 비율은 0 이상 1 미만이어야 합니다")
+	case settings.TrailingATR < 0:
+		return utils.BadRequest("ATR 배수는 0 이상이어야 합니다")
+	case settings.ATRPeriod < 1 || settings.ATRPeriod > 200:
+		return utils.BadRequest("ATR 기간은 1~200이어야 합니다")
+	case settings.MaxHoldMinutes < 0:
+		return utils.BadRequest("최대 보유 기간은 0 이상이어야 합니다")
+	}
+
+	if settings.Active && settings.StopLoss == 0 && settings.TakeProfit == 0 &&
+		settings.TrailingPercent == 0 && settings.TrailingATR == 0 && settings.MaxHoldMinutes == 0 {
+		return utils.BadRequest("손절, 익절, 추적 손절, 최대 보유 기간 중 하나 이상을 설정해주세요")
+	}
+	return nil
+}
+
+// toRuleResponse 보호 규칙 응답 (감시 중인 포지션이 있으면 현재 청산 가격 포함)
+func (s *ServiceImpl) toRuleResponse(rule *ent.ProtectionRule) *dto.RuleResponse {
+	response := &dto.RuleResponse{
+		ID:              rule.ID,
+		Account:         string(rule.Account),
+		StrategyID:      rule.StrategyID,
+		Symbol:          rule.Symbol,
+		Exchange:        rule.Exchange,
+		StopLoss:        rule.StopLoss,
+		TakeProfit:      rule.TakeProfit,
+		TrailingPercent: rule.TrailingPercent,
+		TrailingATR:     rule.TrailingAtr,
+		ATRPeriod:       rule.AtrPeriod,
+		MaxHoldMinutes:  rule.MaxHoldMinutes,
+		Active:          rule.Active,
+		CreatedAt:       rule.CreatedAt,
+		UpdatedAt:       rule.UpdatedAt,
+	}
+	if rule.TriggeredAt != nil {
+		response.LastTrigger = &dto.TriggerResponse{
+			Reason:        rule.TriggerReason,
+			Price:         rule.TriggerPrice,
+			ClientOrderID: rule.ExitClientOrderID,
+			TriggeredAt:   *rule.TriggeredAt,
+		}
+	}
+	if !rule.Active {
+		return response
+	}
+	if levels, ok := s.watcher.Levels(rule.ID); ok {
+		response.Levels = toLevelsResponse(levels)
+	}
+	return response
+}
+
+func toLevelsResponse(levels Levels) *dto.LevelsResponse {
+	return &dto.LevelsResponse{
+		Side:         levels.Side,
+		Quantity:     levels.Quantity,
+		AvgPrice:     levels.AvgPrice,
+		LastPrice:    levels.LastPrice,
+		StopLoss:     positiveOrNil(levels.StopLoss),
+		TakeProfit:   positiveOrNil(levels.TakeProfit),
+		TrailingStop: positiveOrNil(levels.TrailingStop),
+		WaterMark:    levels.WaterMark,
+		ATR:          positiveOrNil(levels.ATR),
+		OpenedAt:     levels.OpenedAt,
+		ExitAt:       levels.ExitAt,
+		UpdatedAt:    levels.UpdatedAt,
+	}
+}
+
+func positiveOrNil(value decimal.Decimal) *decimal.Decimal {
+	if !value.IsPositive() {
+		return nil
+	}
+	return &value
+}
@@ -0,0 +1,216 @@
+package risk
+
+import (
+	"auto-trader/pkg/domain/risk/dto"
+	"auto-trader/pkg/shared/middleware"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 리스크 관리 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 리스크 관리 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// GetState 리스크 상태 조회
+// @Summary 리스크 상태 조회
+// @Description 계좌별 당일 실현손익, 보유 노출 금액, 미체결 주문 수, 평가금액/낙폭, 거래 중지 상태와 사전 리스크 검사 한도를 조회합니다
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account query string false "계좌 구분 (LIVE, PAPER)" default(LIVE)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/state [get]
+func (ctrl *Controller) GetState(c *fiber.Ctx) error {
+	var q dto.GetStateQuery
+	q.Account = c.Query("account", middleware.AccountLive)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	state, err := ctrl.service.GetState(utils.GetUserID(c), q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "리스크 상태 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, state)
+}
+
+// GetEvents 리스크 이벤트 조회
+// @Summary 리스크 이벤트 조회
+// @Description 사전 리스크 검사에서 거부된 주문, 최대 낙폭 초과 거래 중지 등 리스크 이벤트를 사유와 함께 최신순으로 조회합니다
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param account query string false "계좌 구분 (LIVE, PAPER)"
+// @Param type query string false "이벤트 유형 (ORDER_REJECTED, DRAWDOWN_HALT)"
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/events [get]
+func (ctrl *Controller) GetEvents(c *fiber.Ctx) error {
+	var q dto.GetEventListQuery
+	q.Account = c.Query("account")
+	q.Type = c.Query("type")
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	events, err := ctrl.service.GetEvents(utils.GetUserID(c), q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "리스크 이벤트 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, events)
+}
+
+// AcknowledgeHalt 거래 중지 해제 확인
+// @Summary 거래 중지 해제 확인
+// @Description 최대 낙폭 초과로 걸린 거래 중지를 해제합니다. 현재 평가금액이 새 최고 평가금액이 되며, 중지된 전략은 직접 다시 시작해야 합니다
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.AcknowledgeHaltBody true "해제할 계좌"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/halt/acknowledge [post]
+func (ctrl *Controller) AcknowledgeHalt(c *fiber.Ctx) error {
+	var req dto.AcknowledgeHaltBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Account == "" {
+		req.Account = middleware.AccountLive
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	state, err := ctrl.service.AcknowledgeHalt(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "거래 중지 해제 실패")
+	}
+
+	return utils.SuccessResponse(c, state)
+}
+
+// ActivateKillSwitch 긴급 중지 발동
+// @Summary 긴급 중지 발동
+// @Description 전략을 모두 중지하고 미체결 주문을 취소합니다. flatten이면 보유 종목을 전량 시장가 매도합니다. 해제 전까지(재시작 후에도) 노출을 늘리는 주문은 거부되며, 발동마다 조치 결과가 기록됩니다. scope=ALL은 관리자만 사용할 수 있습니다
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.KillSwitchBody true "긴급 중지 범위와 청산 여부"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 403 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/kill-switch [post]
+func (ctrl *Controller) ActivateKillSwitch(c *fiber.Ctx) error {
+	var req dto.KillSwitchBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Scope == "" {
+		req.Scope = KillScopeUser
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	record, err := ctrl.service.ActivateKillSwitch(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "긴급 중지 발동 실패")
+	}
+
+	return utils.SuccessResponse(c, record)
+}
+
+// ReleaseKillSwitch 긴급 중지 해제
+// @Summary 긴급 중지 해제
+// @Description 긴급 중지를 해제합니다. 중지된 전략은 직접 다시 시작해야 하며, scope=ALL(전체 사용자 긴급 중지 해제)은 관리자만 사용할 수 있습니다
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param body body dto.ReleaseKillSwitchBody true "해제할 긴급 중지 범위"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 403 {object} utils.Response
+// @Failure 409 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/kill-switch/release [post]
+func (ctrl *Controller) ReleaseKillSwitch(c *fiber.Ctx) error {
+	var req dto.ReleaseKillSwitchBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if req.Scope == "" {
+		req.Scope = KillScopeUser
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	result, err := ctrl.service.ReleaseKillSwitch(utils.GetUserID(c), req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "긴급 중지 해제 실패")
+	}
+
+	return utils.SuccessResponse(c, result)
+}
+
+// GetKillSwitches 긴급 중지 발동 기록 조회
+// @Summary 긴급 중지 발동 기록 조회
+// @Description 긴급 중지 발동/해제 기록과 조치 결과를 최신순으로 조회합니다 (관리자는 전체, 사용자는 본인 대상과 전체 사용자 대상)
+// @Tags risk
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /risk/kill-switch [get]
+func (ctrl *Controller) GetKillSwitches(c *fiber.Ctx) error {
+	var q dto.GetKillSwitchListQuery
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	records, err := ctrl.service.GetKillSwitches(utils.GetUserID(c), q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "긴급 중지 기록 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, records)
+}
@@ -0,0 +1,39 @@
+package dto
+
+// AcknowledgeHaltBody 거래 중지 해제 확인 요청
+type AcknowledgeHaltBody struct {
+	Account string `json:"account" validate:"enum=LIVE,PAPER"` // 기본 LIVE
+}
+
+// KillSwitchBody 긴급 중지 발동 요청
+type KillSwitchBody struct {
+	Scope   string `json:"scope" validate:"enum=USER,ALL"` // 기본 USER (ALL은 관리자 전용)
+	Flatten bool   `json:"flatten"`                        // 보유 종목 전량 시장가 매도
+	Reason  string `json:"reason,omitempty" validate:"max=500"`
+}
+
+// ReleaseKillSwitchBody 긴급 중지 해제 요청
+type ReleaseKillSwitchBody struct {
+	Scope string `json:"scope" validate:"enum=USER,ALL"` // 기본 USER (ALL은 관리자 전용)
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetStateQuery 리스크 상태 조회 쿼리 파라미터
+type GetStateQuery struct {
+	Account string `query:"account" validate:"enum=LIVE,PAPER"` // 기본 LIVE
+}
+
+// GetEventListQuery 리스크 이벤트 조회 쿼리 파라미터
+type GetEventListQuery struct {
+	Account string `query:"account,omitempty"` // LIVE, PAPER
+	Type    string `query:"type,omitempty"`    // ORDER_REJECTED, DRAWDOWN_HALT
+	Limit   int    `query:"limit" validate:"min=1,max=100"`
+	Offset  int    `query:"offset" validate:"min=0"`
+}
+
+// GetKillSwitchListQuery 긴급 중지 발동 기록 조회 쿼리 파라미터
+type GetKillSwitchListQuery struct {
+	Limit  int `query:"limit" validate:"min=1,max=100"`
+	Offset int `query:"offset" validate:"min=0"`
+}
@@ -0,0 +1,110 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// StateResponse 사용자 계좌 리스크 상태
+type StateResponse struct {
+	Account          string              `json:"account"`
+	TradingDay       string              `json:"trading_day"` // 일일 손익 기준 거래일 (뉴욕 시간)
+	DailyRealizedPnL decimal.Decimal     `json:"daily_realized_pnl"`
+	DailyLoss        decimal.Decimal     `json:"daily_loss"`
+	OpenExposure     decimal.Decimal     `json:"open_exposure"`
+	OpenOrders       int                 `json:"open_orders"`
+	Equity           decimal.Decimal     `json:"equity"`
+	PeakEquity       decimal.Decimal     `json:"peak_equity"`
+	Drawdown         decimal.Decimal     `json:"drawdown"` // 최고 평가금액 대비 낙폭 비율
+	Halt             *HaltResponse       `json:"halt,omitempty"`
+	KillSwitch       *HaltResponse       `json:"kill_switch,omitempty"` // 사용자 또는 전체 사용자 긴급 중지
+	Positions        []*PositionResponse `json:"positions"`
+	Limits           LimitsResponse      `json:"limits"`
+}
+
+// HaltResponse 거래 중지 상태 (해제 확인 전까지 노출을 늘리는 주문 거부)
+type HaltResponse struct {
+	Rule   string    `json:"rule"`
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+}
+
+// PositionResponse 리스크 상태 보유 포지션
+type PositionResponse struct {
+	Symbol   string          `json:"symbol"`
+	Side     string          `json:"side"`
+	Quantity decimal.Decimal `json:"quantity"`
+	AvgPrice decimal.Decimal `json:"avg_price"`
+	Exposure decimal.Decimal `json:"exposure"`
+}
+
+// LimitsResponse 사전 리스크 검사 한도 (0은 검사 안 함)
+type LimitsResponse struct {
+	MaxOrderNotional float64 `json:"max_order_notional"`
+	MaxPositionSize  float64 `json:"max_position_size"`
+	MaxDailyLoss     float64 `json:"max_daily_loss"`
+	MaxOpenOrders    int     `json:"max_open_orders"`
+	PriceCollar      float64 `json:"price_collar"`
+	MaxDrawdown      float64 `json:"max_drawdown"`
+}
+
+// EventResponse 리스크 이벤트
+type EventResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	Account        string                 `json:"account"`
+	Type           string                 `json:"type"`
+	Rule           string                 `json:"rule,omitempty"`
+	Reason         string                 `json:"reason"`
+	ClientOrderID  string                 `json:"client_order_id,omitempty"`
+	StrategyID     *uuid.UUID             `json:"strategy_id,omitempty"`
+	Symbol         string                 `json:"symbol,omitempty"`
+	Side           string                 `json:"side,omitempty"`
+	Quantity       decimal.Decimal        `json:"quantity"`
+	Price          decimal.Decimal        `json:"price"`
+	Details        map[string]interface{} `json:"details,omitempty"`
+	AcknowledgedAt *time.Time             `json:"acknowledged_at,omitempty"`
+	AcknowledgedBy *uuid.UUID             `json:"acknowledged_by,omitempty"`
+	CreatedAt      time.Time              `json:"created_at"`
+}
+
+// EventListResponse 리스크 이벤트 목록
+type EventListResponse struct {
+	Events []*EventResponse `json:"events"`
+	Total  int              `json:"total"`
+	Limit  int              `json:"limit"`
+	Offset int              `json:"offset"`
+}
+
+// KillSwitchResponse 긴급 중지 발동 기록
+type KillSwitchResponse struct {
+	ID                uuid.UUID  `json:"id"`
+	Scope             string     `json:"scope"`             // USER, ALL
+	UserID            *uuid.UUID `json:"user_id,omitempty"` // 대상 사용자 (ALL이면 없음)
+	ActivatedBy       uuid.UUID  `json:"activated_by"`
+	Reason            string     `json:"reason,omitempty"`
+	Flatten           bool       `json:"flatten"`
+	StoppedStrategies int        `json:"stopped_strategies"`
+	CanceledOrders    int        `json:"canceled_orders"`
+	FlattenOrders     int        `json:"flatten_orders"`
+	FailedCloses      int        `json:"failed_closes"` // 거부되거나 제출하지 못한 청산 주문 (보유 종목이 남아 있음)
+	Errors            []string   `json:"errors,omitempty"`
+	CreatedAt         time.Time  `json:"created_at"`
+	ReleasedAt        *time.Time `json:"released_at,omitempty"`
+	ReleasedBy        *uuid.UUID `json:"released_by,omitempty"`
+}
+
+// KillSwitchListResponse 긴급 중지 발동 기록 목록
+type KillSwitchListResponse struct {
+	KillSwitches []*KillSwitchResponse `json:"kill_switches"`
+	Total        int                   `json:"total"`
+	Limit        int                   `json:"limit"`
+	Offset       int                   `json:"offset"`
+}
+
+// ReleaseKillSwitchResponse 긴급 중지 해제 결과
+type ReleaseKillSwitchResponse struct {
+	Scope    string `json:"scope"`
+	Released int    `json:"released"` // 해제한 발동 기록 수
+}
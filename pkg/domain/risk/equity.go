@@ -0,0 +1,78 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+
+	paperdto "auto-trader/pkg/domain/paper/dto"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// equityCurrency 실계좌 평가금액 통화 (미국 주식 계좌)
+const equityCurrency = "USD"
+
+// CashSource 사용자 연결 계좌 외화 예수금 조회
+type CashSource interface {
+	Cash(ctx context.Context, userID, currency string) (decimal.Decimal, error)
+}
+
+// PaperAccountSource 모의투자 계좌 현황 조회 (현금 + 현재가 평가금액)
+type PaperAccountSource interface {
+	GetAccount(userID string) (*paperdto.AccountResponse, error)
+}
+
+// AccountEquity 계좌 평가금액 계산 (최대 낙폭 감시와 시작 시 재구성이 같은 기준을 쓰도록 공용)
+// 실계좌는 잔고 동기화 직후의 외화 예수금 + 보유 종목 평가금액, 모의투자는 현금 + 현재가 평가금액이다.
+type AccountEquity struct {
+	repository Repository
+	balances   BalanceSyncer
+	cash       CashSource
+	paper      PaperAccountSource
+}
+
+// NewAccountEquity 계좌 평가금액 계산 생성
+func NewAccountEquity(repository Repository, balances BalanceSyncer, cash CashSource, paper PaperAccountSource) *AccountEquity {
+	return &AccountEquity{
+		repository: repository,
+		balances:   balances,
+		cash:       cash,
+		paper:      paper,
+	}
+}
+
+// Live 실계좌 평가금액 (외화 예수금 + 보유 종목 평가금액)
+// 매수 직후 예수금만 줄고 보유 종목 평가금액이 반영되지 않아 낙폭이 부풀려지지 않도록 잔고를 먼저 동기화하며,
+// 동기화나 예수금 조회에 실패하면 어긋난 값으로 낙폭을 계산하지 않도록 오류를 반환한다.
+func (e *AccountEquity) Live(userID uuid.UUID) (decimal.Decimal, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), syncTimeout)
+	defer cancel()
+
+	if _, err := e.balances.Sync(ctx, userID); err != nil {
+		return decimal.Zero, fmt.Errorf("잔고 동기화 실패: %w", err)
+	}
+
+	equity, err := e.cash.Cash(ctx, userID.String(), equityCurrency)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("예수금 조회 실패: %w", err)
+	}
+
+	rows, err := e.repository.GetLivePositions(userID)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("보유 종목 조회 실패: %w", err)
+	}
+	for _, row := range rows {
+		equity = equity.Add(row.MarketValue)
+	}
+	return equity, nil
+}
+
+// Paper 모의투자 평가금액 (현금 + 현재가 평가금액, 시세가 없으면 평균 단가)
+func (e *AccountEquity) Paper(userID uuid.UUID) (decimal.Decimal, error) {
+	snapshot, err := e.paper.GetAccount(userID.String())
+	if err != nil {
+		return decimal.Zero, err
+	}
+	return snapshot.Equity, nil
+}
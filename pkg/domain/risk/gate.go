@@ -0,0 +1,124 @@
+package risk
+
+import (
+	"fmt"
+
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// LastPriceSource 최근 체결가 조회 (가격 범위 검사, 시장가 주문 금액 산정)
+type LastPriceSource interface {
+	LatestPrice(symbol string) (decimal.Decimal, bool)
+}
+
+// Gate 모든 주문 경로 공용 사전 리스크 검사 (order.RiskChecker 구현)
+// 실계좌/모의투자 실행기가 주문 접수 직후 호출하므로 전략/수동/API 주문이 같은 검사를 거친다.
+// 실행기 주문 상태 변경을 받아 미체결 주문 수와 체결에 따른 포지션/당일 실현손익을 갱신한다.
+type Gate struct {
+	manager    *middleware.Manager
+	repository Repository
+	prices     LastPriceSource
+}
+
+// NewGate 사전 리스크 검사 생성
+func NewGate(manager *middleware.Manager, repository Repository, prices LastPriceSource) *Gate {
+	return &Gate{
+		manager:    manager,
+		repository: repository,
+		prices:     prices,
+	}
+}
+
+// CheckOrder 주문 리스크 검사 (거부 시 사유를 리스크 이벤트로 기록하고 오류 반환)
+func (g *Gate) CheckOrder(update order.Update) error {
+	scope, err := scopeOf(update)
+	if err != nil {
+		return err
+	}
+
+	lastPrice, _ := g.prices.LatestPrice(update.Symbol)
+	price := update.Price
+	if !price.IsPositive() {
+		price = lastPrice
+	}
+
+	result := g.manager.CheckOrderRisk(scope, middleware.OrderCheck{
+		ClientOrderID: update.ClientOrderID,
+		Symbol:        update.Symbol,
+		Side:          string(update.Side),
+		Quantity:      update.Quantity,
+		Price:         price,
+		LastPrice:     lastPrice,
+		Liquidation:   update.Liquidation,
+		HeldQuantity:  update.HeldQuantity,
+	})
+	if result.Allowed {
+		return nil
+	}
+
+	logrus.Warnf("🛑 리스크 검사 주문 거부 (%s/%s): %s %s %s @ %s - %s",
+		scope.UserID, scope.Account, update.Side, update.Quantity.String(), update.Symbol, price.String(), result.Reason)
+
+	event := &Event{
+		UserID:        scope.UserID,
+		Account:       scope.Account,
+		Type:          EventOrderRejected,
+		Rule:          result.Rule,
+		Reason:        result.Reason,
+		ClientOrderID: update.ClientOrderID,
+		Symbol:        update.Symbol,
+		Side:          string(update.Side),
+		Quantity:      update.Quantity,
+		Price:         price,
+	}
+	if strategyID, err := uuid.Parse(update.StrategyID); err == nil {
+		event.StrategyID = &strategyID
+	}
+	if _, err := g.repository.RecordEvent(event); err != nil {
+		logrus.Errorf("❌ 리스크 거부 기록 실패 (%s): %v", update.ClientOrderID, err)
+	}
+
+	return fmt.Errorf("리스크 검사 거부: %s", result.Reason)
+}
+
+// HandleUpdate 실행기 주문 상태 변경을 리스크 상태에 반영 (실계좌/모의투자 공통)
+func (g *Gate) HandleUpdate(update order.Update) {
+	scope, err := scopeOf(update)
+	if err != nil {
+		return
+	}
+
+	if update.Status.IsTerminal() {
+		g.manager.OrderClosed(scope, update.ClientOrderID)
+	} else {
+		g.manager.OrderOpened(scope, update.ClientOrderID)
+	}
+
+	if !update.LastFillQuantity.IsPositive() {
+		return
+	}
+	side := "long"
+	if update.Side == order.SideSell {
+		side = "short"
+	}
+	g.manager.UpdatePosition(scope, update.Symbol, side, update.LastFillQuantity, update.LastFillPrice)
+}
+
+// scopeOf 주문의 리스크 상태 범위 (사용자 + 실행 모드)
+func scopeOf(update order.Update) (middleware.Scope, error) {
+	userID, err := uuid.Parse(update.UserID)
+	if err != nil {
+		return middleware.Scope{}, fmt.Errorf("주문 사용자를 확인할 수 없습니다: %s", update.UserID)
+	}
+
+	account := middleware.AccountLive
+	if update.Mode == order.ModePaper {
+		account = middleware.AccountPaper
+	}
+	return middleware.Scope{UserID: userID, Account: account}, nil
+}
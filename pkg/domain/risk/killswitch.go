@@ -0,0 +1,162 @@
+package risk
+
+import (
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/order"
+	orderdto "auto-trader/pkg/domain/order/dto"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// OrderController 긴급 중지 주문 조치 (미체결 주문 취소, 보유 종목 시장가 청산)
+type OrderController interface {
+	CancelOpenOrders(userID uuid.UUID) (int, error)
+	ClosePosition(userID uuid.UUID, mode order.Mode, symbol, exchange string, quantity decimal.Decimal) (*orderdto.OrderResponse, error)
+}
+
+// KillSwitch 긴급 중지 (사용자 또는 전체 사용자의 전략 중지, 미체결 주문 취소, 선택적 시장가 청산)
+// 발동 기록을 조치보다 먼저 저장하므로 조치 도중 재시작해도 해제 전까지 노출을 늘리는 주문은 거부된다.
+type KillSwitch struct {
+	repository Repository
+	manager    *middleware.Manager
+	strategies StrategyPauser
+	orders     OrderController
+}
+
+// NewKillSwitch 긴급 중지 생성
+func NewKillSwitch(repository Repository, manager *middleware.Manager, strategies StrategyPauser, orders OrderController) *KillSwitch {
+	return &KillSwitch{
+		repository: repository,
+		manager:    manager,
+		strategies: strategies,
+		orders:     orders,
+	}
+}
+
+// Activate 긴급 중지 발동 (target이 nil이면 전체 사용자)
+// 한 사용자/주문의 조치 실패가 나머지 조치를 막지 않으며, 실패 내역은 발동 기록에 남긴다.
+func (k *KillSwitch) Activate(activatedBy uuid.UUID, target *uuid.UUID, reason string, flatten bool) (*ent.KillSwitch, error) {
+	record, err := k.repository.CreateKillSwitch(target, activatedBy, reason, flatten)
+	if err != nil {
+		return nil, fmt.Errorf("긴급 중지 기록 실패: %w", err)
+	}
+	k.manager.Kill(keyOf(target), haltOf(record))
+
+	users := []uuid.UUID{}
+	if target != nil {
+		users = append(users, *target)
+	} else if users, err = k.repository.GetUserIDs(); err != nil {
+		return nil, fmt.Errorf("사용자 목록 조회 실패: %w", err)
+	}
+
+	result := &KillSwitchResult{}
+	for _, userID := range users {
+		k.apply(userID, flatten, result)
+	}
+
+	logrus.Warnf("🚨 긴급 중지 발동 (%s, 요청 %s): 전략 %d개 중지, 주문 %d건 취소, 청산 주문 %d건, 실패 %d건",
+		targetLabel(target), activatedBy, result.StoppedStrategies, result.CanceledOrders, result.FlattenOrders, len(result.Errors))
+	if result.FailedCloses > 0 {
+		logrus.Errorf("❌ 긴급 청산 실패 %d건 (%s): 보유 종목이 남아 있어 직접 확인이 필요합니다", result.FailedCloses, targetLabel(target))
+	}
+
+	updated, err := k.repository.SaveKillSwitchResult(record.ID, result)
+	if err != nil {
+		return nil, fmt.Errorf("긴급 중지 결과 기록 실패: %w", err)
+	}
+	return updated, nil
+}
+
+// Release 긴급 중지 해제 (target이 nil이면 전체 사용자 긴급 중지), 해제한 발동 기록 수 반환
+// 중지된 전략은 자동으로 다시 시작하지 않는다.
+func (k *KillSwitch) Release(releasedBy uuid.UUID, target *uuid.UUID) (int, error) {
+	released, err := k.repository.ReleaseKillSwitches(target, releasedBy)
+	if err != nil {
+		return 0, fmt.Errorf("긴급 중지 해제 기록 실패: %w", err)
+	}
+	if k.manager.Release(keyOf(target)) || released > 0 {
+		logrus.Infof("▶️  긴급 중지 해제 (%s, 요청 %s)", targetLabel(target), releasedBy)
+	}
+	return released, nil
+}
+
+// apply 사용자 전략 중지 → 미체결 주문 취소 → (선택) 보유 종목 청산
+func (k *KillSwitch) apply(userID uuid.UUID, flatten bool, result *KillSwitchResult) {
+	stopped, err := k.strategies.StopUserStrategies(userID)
+	result.StoppedStrategies += stopped
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", userID, err))
+	}
+
+	canceled, err := k.orders.CancelOpenOrders(userID)
+	result.CanceledOrders += canceled
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: %v", userID, err))
+	}
+
+	if flatten {
+		k.flatten(userID, result)
+	}
+}
+
+// flatten 실계좌(동기화된 잔고)와 모의투자 보유 종목 전량 시장가 매도
+func (k *KillSwitch) flatten(userID uuid.UUID, result *KillSwitchResult) {
+	live, err := k.repository.GetLivePositions(userID)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: 보유 종목 조회 실패: %v", userID, err))
+	}
+	for _, row := range live {
+		k.close(userID, order.ModeLive, row.Symbol, row.Exchange, row.Quantity, result)
+	}
+
+	paper, err := k.repository.GetPaperPositions(userID)
+	if err != nil {
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: 모의투자 보유 종목 조회 실패: %v", userID, err))
+	}
+	for _, position := range paper {
+		k.close(userID, order.ModePaper, position.Symbol, "", position.Quantity, result)
+	}
+}
+
+func (k *KillSwitch) close(userID uuid.UUID, mode order.Mode, symbol, exchange string, quantity decimal.Decimal, result *KillSwitchResult) {
+	quantity = quantity.Floor()
+	if !quantity.IsPositive() {
+		return
+	}
+	if _, err := k.orders.ClosePosition(userID, mode, symbol, exchange, quantity); err != nil {
+		result.FailedCloses++
+		result.Errors = append(result.Errors, fmt.Sprintf("%s: %s %s %s주 청산 실패: %v", userID, mode, symbol, quantity.String(), err))
+		logrus.Errorf("❌ 긴급 청산 실패 (%s): %s %s %s주 - %v", userID, mode, symbol, quantity.String(), err)
+		return
+	}
+	result.FlattenOrders++
+}
+
+// keyOf 긴급 중지 대상 키 (전체 사용자는 uuid.Nil)
+func keyOf(target *uuid.UUID) uuid.UUID {
+	if target == nil {
+		return uuid.Nil
+	}
+	return *target
+}
+
+// haltOf 발동 기록의 거래 중지 상태 (사유가 없으면 기본 사유)
+func haltOf(record *ent.KillSwitch) middleware.Halt {
+	reason := record.Reason
+	if reason == "" {
+		reason = "긴급 중지 발동"
+	}
+	return middleware.Halt{Rule: middleware.RuleKillSwitch, Reason: reason, Since: record.CreatedAt}
+}
+
+func targetLabel(target *uuid.UUID) string {
+	if target == nil {
+		return "전체 사용자"
+	}
+	return target.String()
+}
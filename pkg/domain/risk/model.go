@@ -0,0 +1,43 @@
+package risk
+
+import (
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// 리스크 이벤트 유형
+const (
+	EventOrderRejected = "ORDER_REJECTED" // 사전 리스크 검사 주문 거부
+	EventDrawdownHalt  = "DRAWDOWN_HALT"  // 최대 낙폭 초과 거래 중지 (해제 확인 필요)
+)
+
+// Event 리스크 이벤트 기록 입력
+type Event struct {
+	UserID        uuid.UUID
+	Account       string // LIVE, PAPER
+	Type          string
+	Rule          string // 위반한 검사 규칙
+	Reason        string
+	ClientOrderID string
+	StrategyID    *uuid.UUID
+	Symbol        string
+	Side          string
+	Quantity      decimal.Decimal
+	Price         decimal.Decimal
+	Details       map[string]interface{} // 판단 근거 (평가금액, 낙폭 등)
+}
+
+// EventFilter 리스크 이벤트 조회 조건 (빈 값은 조건 없음)
+type EventFilter struct {
+	Account string
+	Type    string
+}
+
+// KillSwitchResult 긴급 중지 조치 결과 (전략 중지, 미체결 주문 취소, 청산 주문)
+type KillSwitchResult struct {
+	StoppedStrategies int
+	CanceledOrders    int
+	FlattenOrders     int
+	FailedCloses      int // 거부되거나 제출하지 못한 청산 주문 (상세는 Errors)
+	Errors            []string
+}
@@ -0,0 +1,218 @@
+package risk
+
+import (
+	"sync"
+	"time"
+
+	"auto-trader/pkg/domain/portfolio"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// StrategyPauser 사용자 전략 일괄 중지
+type StrategyPauser interface {
+	StopUserStrategies(userID uuid.UUID) (int, error)
+}
+
+// DrawdownMonitor 계좌 평가금액을 주기적으로 반영하고 최대 낙폭을 넘으면 거래 중지
+// 평가금액은 시작 시 재구성과 같은 AccountEquity 기준으로 계산한다.
+// 중지되면 사용자 전략을 모두 멈추고, 해제 확인 전까지 노출을 늘리는 주문은 사전 리스크 검사에서 거부된다.
+// 중지는 즉시 적용하고 이벤트를 기록해 재시작해도 복구되게 하며, 기록에 실패하면 매 주기 기록만 다시 시도한다.
+type DrawdownMonitor struct {
+	repository Repository
+	manager    *middleware.Manager
+	accounts   portfolio.AccountDirectory
+	equity     *AccountEquity
+	strategies StrategyPauser
+	interval   time.Duration
+
+	pending      map[middleware.Scope]*Event // 기록하지 못한 거래 중지 이벤트 (중지는 이미 적용됨)
+	pendingMutex sync.Mutex
+
+	stopChan chan struct{}
+	running  bool
+	mutex    sync.Mutex
+}
+
+// NewDrawdownMonitor 최대 낙폭 감시 생성 (interval이 0 이하면 감시하지 않음)
+func NewDrawdownMonitor(repository Repository, manager *middleware.Manager, accounts portfolio.AccountDirectory, equity *AccountEquity, strategies StrategyPauser, interval time.Duration) *DrawdownMonitor {
+	return &DrawdownMonitor{
+		repository: repository,
+		manager:    manager,
+		accounts:   accounts,
+		equity:     equity,
+		strategies: strategies,
+		interval:   interval,
+		pending:    make(map[middleware.Scope]*Event),
+		stopChan:   make(chan struct{}),
+	}
+}
+
+// Start 주기 감시 시작
+func (m *DrawdownMonitor) Start() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if m.running || m.interval <= 0 {
+		return
+	}
+	m.running = true
+	go m.monitorLoop()
+	logrus.Infof("📉 최대 낙폭 감시 시작 (주기: %s)", m.interval)
+}
+
+// Stop 주기 감시 중지
+func (m *DrawdownMonitor) Stop() {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if !m.running {
+		return
+	}
+	m.running = false
+	close(m.stopChan)
+	m.stopChan = make(chan struct{})
+}
+
+func (m *DrawdownMonitor) monitorLoop() {
+	m.mutex.Lock()
+	stopChan := m.stopChan
+	m.mutex.Unlock()
+
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+			m.evaluateAll()
+		case <-stopChan:
+			return
+		}
+	}
+}
+
+// evaluateAll 실계좌/모의투자 계좌 평가금액 반영 (한 계좌의 실패가 다른 계좌에 영향을 주지 않음)
+func (m *DrawdownMonitor) evaluateAll() {
+	m.retryPending()
+
+	userIDs, err := m.accounts.LinkedUserIDs()
+	if err != nil {
+		logrus.Errorf("❌ 평가금액 확인 대상 조회 실패: %v", err)
+	}
+	for _, userID := range userIDs {
+		scope := middleware.Scope{UserID: userID, Account: middleware.AccountLive}
+		equity, err := m.equity.Live(userID)
+		if err != nil {
+			logrus.Warnf("⚠️ 계좌 평가금액 확인 실패 (%s/%s): %v", scope.UserID, scope.Account, err)
+			continue
+		}
+		m.evaluate(scope, equity)
+	}
+
+	accounts, err := m.repository.GetPaperAccounts()
+	if err != nil {
+		logrus.Errorf("❌ 모의투자 계좌 조회 실패: %v", err)
+		return
+	}
+	for _, account := range accounts {
+		scope := middleware.Scope{UserID: account.UserID, Account: middleware.AccountPaper}
+		equity, err := m.equity.Paper(account.UserID)
+		if err != nil {
+			logrus.Warnf("⚠️ 계좌 평가금액 확인 실패 (%s/%s): %v", scope.UserID, scope.Account, err)
+			continue
+		}
+		m.evaluate(scope, equity)
+	}
+}
+
+// evaluate 평가금액 반영 후 새로 최대 낙폭을 넘었으면 거래 중지 처리
+func (m *DrawdownMonitor) evaluate(scope middleware.Scope, equity decimal.Decimal) {
+	if !equity.IsPositive() {
+		return
+	}
+	halt := m.manager.UpdateEquity(scope, equity)
+	if halt == nil {
+		return
+	}
+	m.halt(scope, halt)
+}
+
+// retryPending 기록하지 못한 거래 중지 이벤트 재기록 (중지는 이미 적용돼 있으므로 기록만 다시 시도)
+// 기록 전에 해제된 중지는 재시작 시 다시 걸리지 않도록 버린다.
+func (m *DrawdownMonitor) retryPending() {
+	m.pendingMutex.Lock()
+	pending := make(map[middleware.Scope]*Event, len(m.pending))
+	for scope, event := range m.pending {
+		pending[scope] = event
+	}
+	m.pendingMutex.Unlock()
+
+	for scope, event := range pending {
+		if m.manager.GetState(scope).Halt == nil {
+			m.forget(scope, event)
+			continue
+		}
+		m.record(scope, event)
+	}
+}
+
+// halt 거래 중지를 즉시 적용하고 사용자 전략 전체 중지 후 해제 확인이 필요한 이벤트 기록
+// 저장소 오류로 낙폭 보호가 꺼지지 않도록 기록보다 중지를 먼저 적용한다.
+func (m *DrawdownMonitor) halt(scope middleware.Scope, halt *middleware.Halt) {
+	if !m.manager.Halt(scope, *halt) {
+		return
+	}
+	logrus.Errorf("🚨 최대 낙폭 초과로 거래 중지 (%s/%s): %s", scope.UserID, scope.Account, halt.Reason)
+
+	state := m.manager.GetState(scope)
+	m.record(scope, &Event{
+		UserID:  scope.UserID,
+		Account: scope.Account,
+		Type:    EventDrawdownHalt,
+		Rule:    halt.Rule,
+		Reason:  halt.Reason,
+		Details: map[string]interface{}{
+			"equity":      state.Equity.String(),
+			"peak_equity": state.PeakEquity.String(),
+			"drawdown":    state.Drawdown().StringFixed(4),
+			"breached_at": halt.Since.Format(time.RFC3339),
+		},
+	})
+
+	stopped, err := m.strategies.StopUserStrategies(scope.UserID)
+	if err != nil {
+		logrus.Errorf("❌ 거래 중지 전략 중지 실패 (%s): %v", scope.UserID, err)
+		return
+	}
+	logrus.Warnf("⏹️  거래 중지로 전략 %d개 중지 (%s/%s)", stopped, scope.UserID, scope.Account)
+}
+
+// record 거래 중지 이벤트 기록 (실패하면 대기 목록에 남겨 다음 주기에 다시 시도)
+func (m *DrawdownMonitor) record(scope middleware.Scope, event *Event) {
+	_, err := m.repository.RecordEvent(event)
+
+	m.pendingMutex.Lock()
+	if err != nil {
+		m.pending[scope] = event
+	} else if m.pending[scope] == event {
+		delete(m.pending, scope)
+	}
+	m.pendingMutex.Unlock()
+
+	if err != nil {
+		logrus.Errorf("❌ 거래 중지 기록 실패 (%s/%s, 중지는 유지하고 %s 후 재시도): %v", scope.UserID, scope.Account, m.interval, err)
+	}
+}
+
+func (m *DrawdownMonitor) forget(scope middleware.Scope, event *Event) {
+	m.pendingMutex.Lock()
+	defer m.pendingMutex.Unlock()
+
+	if m.pending[scope] == event {
+		delete(m.pending, scope)
+	}
+}
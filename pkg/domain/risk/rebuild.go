@@ -0,0 +1,237 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/trade"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/portfolio"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
+)
+
+// syncTimeout 평가금액 계산 전 사용자 1명 잔고 동기화 제한 시간
+const syncTimeout = 30 * time.Second
+
+// BalanceSyncer 증권사 잔고를 포트폴리오에 반영 (평가금액 계산 전 보유 종목 최신화)
+type BalanceSyncer interface {
+	Sync(ctx context.Context, userID uuid.UUID) (*portfolio.SyncResult, error)
+}
+
+// Rebuilder 시작 시 체결 원장과 증권사 잔고로 사용자 계좌별 리스크 상태 재구성
+// 당일 실현손익은 원장(모의투자는 모의 체결 내역), 보유 포지션은 잔고, 평가금액은 최대 낙폭 감시와 같은 AccountEquity,
+// 최고 평가금액은 저장된 상태, 미체결 주문은 주문 테이블을 기준으로 한다.
+type Rebuilder struct {
+	repository Repository
+	manager    *middleware.Manager
+	ledger     portfolio.LedgerRepository
+	method     portfolio.CostMethod
+	accounts   portfolio.AccountDirectory
+	equity     *AccountEquity
+	orders     order.OpenOrderSource
+}
+
+// NewRebuilder 리스크 상태 재구성 생성
+func NewRebuilder(repository Repository, manager *middleware.Manager, ledger portfolio.LedgerRepository, method portfolio.CostMethod, accounts portfolio.AccountDirectory, equity *AccountEquity, orders order.OpenOrderSource) *Rebuilder {
+	return &Rebuilder{
+		repository: repository,
+		manager:    manager,
+		ledger:     ledger,
+		method:     method,
+		accounts:   accounts,
+		equity:     equity,
+		orders:     orders,
+	}
+}
+
+// Rebuild 전체 사용자 계좌 리스크 상태 재구성 (한 계좌의 실패가 다른 계좌에 영향을 주지 않음)
+// 해제되지 않은 거래 중지/긴급 중지를 가장 먼저 복구해 재시작으로 거래가 재개되지 않게 한다.
+func (b *Rebuilder) Rebuild() error {
+	halts, kills, err := b.restoreHalts()
+	if err != nil {
+		return err
+	}
+
+	stored, err := b.repository.GetStates()
+	if err != nil {
+		return fmt.Errorf("저장된 리스크 상태 조회 실패: %w", err)
+	}
+	peaks := make(map[middleware.Scope]decimal.Decimal, len(stored))
+	equities := make(map[middleware.Scope]decimal.Decimal, len(stored))
+	liveUsers := make(map[uuid.UUID]bool)
+	for _, state := range stored {
+		scope := middleware.Scope{UserID: state.UserID, Account: string(state.Account)}
+		peaks[scope] = state.PeakEquity
+		equities[scope] = state.Equity
+		if scope.Account == middleware.AccountLive {
+			liveUsers[state.UserID] = true
+		}
+	}
+
+	linked, err := b.accounts.LinkedUserIDs()
+	if err != nil {
+		return fmt.Errorf("증권 계좌 사용자 조회 실패: %w", err)
+	}
+	for _, userID := range linked {
+		liveUsers[userID] = true
+	}
+
+	since := b.manager.TradingDayStart(time.Now())
+	live, paper := 0, 0
+
+	for userID := range liveUsers {
+		scope := middleware.Scope{UserID: userID, Account: middleware.AccountLive}
+		state, err := b.rebuildLive(scope, since, equities[scope])
+		if err != nil {
+			logrus.Errorf("❌ 리스크 상태 재구성 실패 (%s/%s): %v", scope.UserID, scope.Account, err)
+			continue
+		}
+		state.PeakEquity = peaks[scope]
+		b.manager.Restore(state)
+		live++
+	}
+
+	accounts, err := b.repository.GetPaperAccounts()
+	if err != nil {
+		return fmt.Errorf("모의투자 계좌 조회 실패: %w", err)
+	}
+	for _, account := range accounts {
+		scope := middleware.Scope{UserID: account.UserID, Account: middleware.AccountPaper}
+		state, err := b.rebuildPaper(scope, account, since, equities[scope])
+		if err != nil {
+			logrus.Errorf("❌ 리스크 상태 재구성 실패 (%s/%s): %v", scope.UserID, scope.Account, err)
+			continue
+		}
+		state.PeakEquity = peaks[scope]
+		b.manager.Restore(state)
+		paper++
+	}
+
+	opened, err := b.restoreOpenOrders()
+	if err != nil {
+		return err
+	}
+
+	logrus.Infof("🛡️ 리스크 상태 재구성 완료: 실계좌 %d개, 모의투자 %d개, 미체결 주문 %d건, 거래 중지 %d건, 긴급 중지 %d건", live, paper, opened, halts, kills)
+	return nil
+}
+
+// restoreOpenOrders 주문 테이블의 미체결 주문을 미체결 주문 수 한도 기준에 다시 등록 (실계좌/모의투자)
+func (b *Rebuilder) restoreOpenOrders() (int, error) {
+	opened := 0
+	for _, mode := range []order.Mode{order.ModeLive, order.ModePaper} {
+		updates, err := b.orders.OpenOrders(mode)
+		if err != nil {
+			return 0, fmt.Errorf("미체결 주문 조회 실패 (%s): %w", mode, err)
+		}
+		for _, update := range updates {
+			scope, err := scopeOf(update)
+			if err != nil {
+				logrus.Warnf("⚠️ 미체결 주문 복구 제외 (%s): %v", update.ClientOrderID, err)
+				continue
+			}
+			b.manager.OrderOpened(scope, update.ClientOrderID)
+			opened++
+		}
+	}
+	return opened, nil
+}
+
+// restoreHalts 해제 확인되지 않은 최대 낙폭 거래 중지와 해제되지 않은 긴급 중지 복구
+func (b *Rebuilder) restoreHalts() (int, int, error) {
+	halts, err := b.repository.GetOpenHalts()
+	if err != nil {
+		return 0, 0, fmt.Errorf("거래 중지 이벤트 조회 실패: %w", err)
+	}
+	for _, event := range halts {
+		scope := middleware.Scope{UserID: event.UserID, Account: string(event.Account)}
+		b.manager.Halt(scope, middleware.Halt{Rule: event.Rule, Reason: event.Reason, Since: event.CreatedAt})
+	}
+
+	kills, err := b.repository.GetActiveKillSwitches()
+	if err != nil {
+		return 0, 0, fmt.Errorf("긴급 중지 기록 조회 실패: %w", err)
+	}
+	for _, record := range kills {
+		b.manager.Kill(keyOf(record.UserID), haltOf(record))
+	}
+	return len(halts), len(kills), nil
+}
+
+// rebuildLive 연결 계좌 잔고와 당일 원장 매도 실현손익으로 상태 구성
+// 평가금액을 계산하지 못하면(잔고 동기화 실패 포함) 저장된 평가금액과 마지막으로 동기화된 보유 종목을 사용한다.
+func (b *Rebuilder) rebuildLive(scope middleware.Scope, since time.Time, stored decimal.Decimal) (*middleware.State, error) {
+	equity, err := b.equity.Live(scope.UserID)
+	if err != nil {
+		logrus.Warnf("⚠️ 평가금액 계산 실패 (%s/%s), 저장된 평가금액으로 재구성합니다: %v", scope.UserID, scope.Account, err)
+		equity = stored
+	}
+
+	rows, err := b.repository.GetLivePositions(scope.UserID)
+	if err != nil {
+		return nil, fmt.Errorf("보유 종목 조회 실패: %w", err)
+	}
+	sells, err := b.ledger.GetTrades(scope.UserID, portfolio.TradeFilter{Side: trade.SideSELL, From: since}, 0, 0)
+	if err != nil {
+		return nil, fmt.Errorf("당일 체결 원장 조회 실패: %w", err)
+	}
+
+	state := b.newState(scope)
+	state.Equity = equity
+	for _, sell := range sells {
+		pnl, _ := portfolio.RealizedPnL(sell, b.method)
+		state.DailyRealizedPnL = state.DailyRealizedPnL.Add(pnl)
+	}
+	for _, row := range rows {
+		state.Positions[row.Symbol] = longPosition(row.Symbol, row.Quantity, row.AveragePrice)
+	}
+	return state, nil
+}
+
+// rebuildPaper 모의투자 보유 종목과 당일 모의 체결 실현손익으로 상태 구성
+// 평가금액을 계산하지 못하면 저장된 평가금액을 사용한다.
+func (b *Rebuilder) rebuildPaper(scope middleware.Scope, account *ent.PaperAccount, since time.Time, stored decimal.Decimal) (*middleware.State, error) {
+	realized, err := b.repository.GetPaperRealizedPnL(account.ID, since)
+	if err != nil {
+		return nil, fmt.Errorf("당일 모의 체결 조회 실패: %w", err)
+	}
+	equity, err := b.equity.Paper(scope.UserID)
+	if err != nil {
+		logrus.Warnf("⚠️ 평가금액 계산 실패 (%s/%s), 저장된 평가금액으로 재구성합니다: %v", scope.UserID, scope.Account, err)
+		equity = stored
+	}
+
+	state := b.newState(scope)
+	state.DailyRealizedPnL = realized
+	state.Equity = equity
+	for _, position := range account.Edges.Positions {
+		state.Positions[position.Symbol] = longPosition(position.Symbol, position.Quantity, position.AvgCost)
+	}
+	return state, nil
+}
+
+func (b *Rebuilder) newState(scope middleware.Scope) *middleware.State {
+	now := time.Now()
+	return &middleware.State{
+		Scope:      scope,
+		TradingDay: b.manager.TradingDay(now),
+		Positions:  make(map[string]*middleware.Position),
+		UpdatedAt:  now,
+	}
+}
+
+func longPosition(symbol string, quantity, avgPrice decimal.Decimal) *middleware.Position {
+	return &middleware.Position{
+		Symbol:    symbol,
+		Quantity:  quantity,
+		AvgPrice:  avgPrice,
+		Side:      "long",
+		Timestamp: time.Now(),
+	}
+}
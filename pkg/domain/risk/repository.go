@@ -0,0 +1,404 @@
+package risk
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/killswitch"
+	"auto-trader/ent/paperaccount"
+	"auto-trader/ent/paperposition"
+	"auto-trader/ent/papertrade"
+	"auto-trader/ent/portfolio"
+	"auto-trader/ent/riskevent"
+	"auto-trader/ent/riskstate"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
+)
+
+// Repository 리스크 상태 데이터 접근 인터페이스 (middleware.StateStore 구현)
+type Repository interface {
+	// 리스크 상태
+	GetStates() ([]*ent.RiskState, error)
+	SaveState(state *middleware.State) error
+
+	// 리스크 이벤트 (주문 거부 등)
+	RecordEvent(event *Event) (*ent.RiskEvent, error)
+	GetEvents(userID uuid.UUID, filter EventFilter, limit, offset int) ([]*ent.RiskEvent, error)
+	CountEvents(userID uuid.UUID, filter EventFilter) (int, error)
+
+	// 거래 중지 (해제 확인 전까지 유지)
+	GetOpenHalts() ([]*ent.RiskEvent, error)
+	AcknowledgeHalts(userID uuid.UUID, account string, acknowledgedBy uuid.UUID) (int, error)
+
+	// 긴급 중지 (발동 기록, 해제 전까지 유지)
+	CreateKillSwitch(target *uuid.UUID, activatedBy uuid.UUID, reason string, flatten bool) (*ent.KillSwitch, error)
+	SaveKillSwitchResult(id uuid.UUID, result *KillSwitchResult) (*ent.KillSwitch, error)
+	GetActiveKillSwitches() ([]*ent.KillSwitch, error)
+	ReleaseKillSwitches(target *uuid.UUID, releasedBy uuid.UUID) (int, error)
+	GetKillSwitches(userID *uuid.UUID, limit, offset int) ([]*ent.KillSwitch, error)
+	CountKillSwitches(userID *uuid.UUID) (int, error)
+
+	// 사용자 (관리자 확인, 전체 긴급 중지 대상)
+	IsAdmin(userID uuid.UUID) (bool, error)
+	GetUserIDs() ([]uuid.UUID, error)
+
+	// 재구성 원천 (실계좌 잔고, 모의투자 계좌)
+	GetLivePositions(userID uuid.UUID) ([]*ent.Portfolio, error)
+	GetPaperAccounts() ([]*ent.PaperAccount, error)
+	GetPaperPositions(userID uuid.UUID) ([]*ent.PaperPosition, error)
+	GetPaperRealizedPnL(accountID uuid.UUID, from time.Time) (decimal.Decimal, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// GetStates 저장된 전체 리스크 상태 조회
+func (r *EntRepository) GetStates() ([]*ent.RiskState, error) {
+	states, err := r.client.RiskState.Query().All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get risk states: %w", err)
+	}
+	return states, nil
+}
+
+// SaveState 사용자 계좌 리스크 상태 저장 (없으면 생성)
+func (r *EntRepository) SaveState(state *middleware.State) error {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	updated, err := tx.RiskState.Update().
+		Where(
+			riskstate.UserID(state.UserID),
+			riskstate.AccountEQ(riskstate.Account(state.Account)),
+		).
+		SetTradingDay(state.TradingDay).
+		SetDailyRealizedPnl(state.DailyRealizedPnL).
+		SetOpenExposure(state.OpenExposure()).
+		SetEquity(state.Equity).
+		SetPeakEquity(state.PeakEquity).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update risk state: %w", err)
+	}
+	if updated == 0 {
+		if err := tx.RiskState.Create().
+			SetUserID(state.UserID).
+			SetAccount(riskstate.Account(state.Account)).
+			SetTradingDay(state.TradingDay).
+			SetDailyRealizedPnl(state.DailyRealizedPnL).
+			SetOpenExposure(state.OpenExposure()).
+			SetEquity(state.Equity).
+			SetPeakEquity(state.PeakEquity).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to create risk state: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit risk state: %w", err)
+	}
+	return nil
+}
+
+// RecordEvent 리스크 이벤트 저장
+func (r *EntRepository) RecordEvent(event *Event) (*ent.RiskEvent, error) {
+	create := r.client.RiskEvent.Create().
+		SetUserID(event.UserID).
+		SetAccount(riskevent.Account(event.Account)).
+		SetType(riskevent.Type(event.Type)).
+		SetReason(event.Reason).
+		SetNillableStrategyID(event.StrategyID).
+		SetQuantity(event.Quantity).
+		SetPrice(event.Price)
+	if event.Rule != "" {
+		create.SetRule(event.Rule)
+	}
+	if event.ClientOrderID != "" {
+		create.SetClientOrderID(event.ClientOrderID)
+	}
+	if event.Symbol != "" {
+		create.SetSymbol(event.Symbol)
+	}
+	if event.Side != "" {
+		create.SetSide(event.Side)
+	}
+	if len(event.Details) > 0 {
+		create.SetDetails(event.Details)
+	}
+
+	recorded, err := create.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create risk event: %w", err)
+	}
+	return recorded, nil
+}
+
+// GetEvents 사용자 리스크 이벤트 조회 (최신순)
+func (r *EntRepository) GetEvents(userID uuid.UUID, filter EventFilter, limit, offset int) ([]*ent.RiskEvent, error) {
+	events, err := r.eventQuery(userID, filter).
+		Order(ent.Desc(riskevent.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get risk events: %w", err)
+	}
+	return events, nil
+}
+
+// CountEvents 조건에 맞는 리스크 이벤트 수
+func (r *EntRepository) CountEvents(userID uuid.UUID, filter EventFilter) (int, error) {
+	count, err := r.eventQuery(userID, filter).Count(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count risk events: %w", err)
+	}
+	return count, nil
+}
+
+func (r *EntRepository) eventQuery(userID uuid.UUID, filter EventFilter) *ent.RiskEventQuery {
+	query := r.client.RiskEvent.Query().
+		Where(riskevent.UserID(userID))
+	if filter.Account != "" {
+		query.Where(riskevent.AccountEQ(riskevent.Account(filter.Account)))
+	}
+	if filter.Type != "" {
+		query.Where(riskevent.TypeEQ(riskevent.Type(filter.Type)))
+	}
+	return query
+}
+
+// GetOpenHalts 해제 확인되지 않은 전체 거래 중지 이벤트 조회 (재시작 시 복구)
+func (r *EntRepository) GetOpenHalts() ([]*ent.RiskEvent, error) {
+	halts, err := r.client.RiskEvent.Query().
+		Where(
+			riskevent.TypeEQ(riskevent.TypeDRAWDOWN_HALT),
+			riskevent.AcknowledgedAtIsNil(),
+		).
+		Order(ent.Asc(riskevent.FieldCreatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get open halts: %w", err)
+	}
+	return halts, nil
+}
+
+// AcknowledgeHalts 사용자 계좌의 해제되지 않은 거래 중지 이벤트를 해제 확인 처리
+func (r *EntRepository) AcknowledgeHalts(userID uuid.UUID, account string, acknowledgedBy uuid.UUID) (int, error) {
+	updated, err := r.client.RiskEvent.Update().
+		Where(
+			riskevent.UserID(userID),
+			riskevent.AccountEQ(riskevent.Account(account)),
+			riskevent.TypeEQ(riskevent.TypeDRAWDOWN_HALT),
+			riskevent.AcknowledgedAtIsNil(),
+		).
+		SetAcknowledgedAt(time.Now()).
+		SetAcknowledgedBy(acknowledgedBy).
+		Save(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("failed to acknowledge halts: %w", err)
+	}
+	return updated, nil
+}
+
+// CreateKillSwitch 긴급 중지 발동 기록 (target이 nil이면 전체 사용자)
+func (r *EntRepository) CreateKillSwitch(target *uuid.UUID, activatedBy uuid.UUID, reason string, flatten bool) (*ent.KillSwitch, error) {
+	create := r.client.KillSwitch.Create().
+		SetNillableUserID(target).
+		SetActivatedBy(activatedBy).
+		SetFlatten(flatten)
+	if reason != "" {
+		create.SetReason(reason)
+	}
+
+	record, err := create.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kill switch: %w", err)
+	}
+	return record, nil
+}
+
+// SaveKillSwitchResult 긴급 중지 조치 결과 저장
+func (r *EntRepository) SaveKillSwitchResult(id uuid.UUID, result *KillSwitchResult) (*ent.KillSwitch, error) {
+	update := r.client.KillSwitch.UpdateOneID(id).
+		SetStoppedStrategies(result.StoppedStrategies).
+		SetCanceledOrders(result.CanceledOrders).
+		SetFlattenOrders(result.FlattenOrders).
+		SetFailedCloses(result.FailedCloses)
+	if len(result.Errors) > 0 {
+		update.SetErrors(result.Errors)
+	}
+
+	record, err := update.Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update kill switch: %w", err)
+	}
+	return record, nil
+}
+
+// GetActiveKillSwitches 해제되지 않은 긴급 중지 조회 (재시작 시 복구)
+func (r *EntRepository) GetActiveKillSwitches() ([]*ent.KillSwitch, error) {
+	records, err := r.client.KillSwitch.Query().
+		Where(killswitch.ReleasedAtIsNil()).
+		Order(ent.Asc(killswitch.FieldCreatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active kill switches: %w", err)
+	}
+	return records, nil
+}
+
+// ReleaseKillSwitches 대상의 해제되지 않은 긴급 중지 해제 처리 (target이 nil이면 전체 사용자 긴급 중지)
+func (r *EntRepository) ReleaseKillSwitches(target *uuid.UUID, releasedBy uuid.UUID) (int, error) {
+	update := r.client.KillSwitch.Update().
+		Where(killswitch.ReleasedAtIsNil())
+	if target == nil {
+		update.Where(killswitch.UserIDIsNil())
+	} else {
+		update.Where(killswitch.UserID(*target))
+	}
+
+	released, err := update.
+		SetReleasedAt(time.Now()).
+		SetReleasedBy(releasedBy).
+		Save(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("failed to release kill switches: %w", err)
+	}
+	return released, nil
+}
+
+// GetKillSwitches 긴급 중지 발동 기록 조회 (최신순, userID가 nil이면 전체)
+func (r *EntRepository) GetKillSwitches(userID *uuid.UUID, limit, offset int) ([]*ent.KillSwitch, error) {
+	records, err := r.killSwitchQuery(userID).
+		Order(ent.Desc(killswitch.FieldCreatedAt)).
+		Limit(limit).
+		Offset(offset).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get kill switches: %w", err)
+	}
+	return records, nil
+}
+
+// CountKillSwitches 긴급 중지 발동 기록 수
+func (r *EntRepository) CountKillSwitches(userID *uuid.UUID) (int, error) {
+	count, err := r.killSwitchQuery(userID).Count(r.getContext())
+	if err != nil {
+		return 0, fmt.Errorf("failed to count kill switches: %w", err)
+	}
+	return count, nil
+}
+
+// killSwitchQuery 사용자에게 적용되는 긴급 중지 (사용자 대상 + 전체 대상)
+func (r *EntRepository) killSwitchQuery(userID *uuid.UUID) *ent.KillSwitchQuery {
+	query := r.client.KillSwitch.Query()
+	if userID != nil {
+		query.Where(killswitch.Or(
+			killswitch.UserID(*userID),
+			killswitch.UserIDIsNil(),
+		))
+	}
+	return query
+}
+
+// IsAdmin 관리자 여부
+func (r *EntRepository) IsAdmin(userID uuid.UUID) (bool, error) {
+	u, err := r.client.User.Get(r.getContext(), userID)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get user: %w", err)
+	}
+	return u.IsAdmin, nil
+}
+
+// GetUserIDs 전체 사용자 ID 조회
+func (r *EntRepository) GetUserIDs() ([]uuid.UUID, error) {
+	ids, err := r.client.User.Query().IDs(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user ids: %w", err)
+	}
+	return ids, nil
+}
+
+// GetLivePositions 증권사 잔고로 동기화된 사용자 보유 종목 전체 조회
+func (r *EntRepository) GetLivePositions(userID uuid.UUID) ([]*ent.Portfolio, error) {
+	positions, err := r.client.Portfolio.Query().
+		Where(
+			portfolio.UserID(userID),
+			portfolio.QuantityGT(decimal.Zero),
+		).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get portfolios by user: %w", err)
+	}
+	return positions, nil
+}
+
+// GetPaperAccounts 보유 종목을 포함한 전체 모의투자 계좌 조회
+func (r *EntRepository) GetPaperAccounts() ([]*ent.PaperAccount, error) {
+	accounts, err := r.client.PaperAccount.Query().
+		WithPositions().
+		Order(ent.Asc(paperaccount.FieldCreatedAt)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paper accounts: %w", err)
+	}
+	return accounts, nil
+}
+
+// GetPaperPositions 사용자 모의투자 보유 종목 조회
+func (r *EntRepository) GetPaperPositions(userID uuid.UUID) ([]*ent.PaperPosition, error) {
+	positions, err := r.client.PaperPosition.Query().
+		Where(
+			paperposition.HasAccountWith(paperaccount.UserID(userID)),
+			paperposition.QuantityGT(decimal.Zero),
+		).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get paper positions: %w", err)
+	}
+	return positions, nil
+}
+
+// GetPaperRealizedPnL 모의투자 계좌의 기간 실현손익 합계
+func (r *EntRepository) GetPaperRealizedPnL(accountID uuid.UUID, from time.Time) (decimal.Decimal, error) {
+	trades, err := r.client.PaperTrade.Query().
+		Where(
+			papertrade.AccountID(accountID),
+			papertrade.SideEQ(papertrade.SideSELL),
+			papertrade.ExecutedAtGTE(from),
+		).
+		All(r.getContext())
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("failed to get paper trades: %w", err)
+	}
+
+	realized := decimal.Zero
+	for _, t := range trades {
+		realized = realized.Add(t.RealizedPnl)
+	}
+	return realized, nil
+}
@@ -0,0 +1,297 @@
+package risk
+
+import (
+	"fmt"
+	"sort"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/risk/dto"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+)
+
+// Service 리스크 관리 서비스 인터페이스
+type Service interface {
+	GetState(userID string, q dto.GetStateQuery) (*dto.StateResponse, error)
+	GetEvents(userID string, q dto.GetEventListQuery) (*dto.EventListResponse, error)
+	AcknowledgeHalt(userID string, body dto.AcknowledgeHaltBody) (*dto.StateResponse, error)
+
+	// 긴급 중지
+	ActivateKillSwitch(userID string, body dto.KillSwitchBody) (*dto.KillSwitchResponse, error)
+	ReleaseKillSwitch(userID string, body dto.ReleaseKillSwitchBody) (*dto.ReleaseKillSwitchResponse, error)
+	GetKillSwitches(userID string, q dto.GetKillSwitchListQuery) (*dto.KillSwitchListResponse, error)
+}
+
+// 긴급 중지 범위
+const (
+	KillScopeUser = "USER" // 요청 사용자
+	KillScopeAll  = "ALL"  // 전체 사용자 (관리자 전용)
+)
+
+// ServiceImpl 리스크 관리 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	manager    *middleware.Manager
+	killSwitch *KillSwitch
+	limits     config.RiskConfig
+}
+
+// NewService 새로운 리스크 관리 서비스 생성
+func NewService(repository Repository, manager *middleware.Manager, killSwitch *KillSwitch, limits config.RiskConfig) Service {
+	return &ServiceImpl{
+		repository: repository,
+		manager:    manager,
+		killSwitch: killSwitch,
+		limits:     limits,
+	}
+}
+
+// GetState 사용자 계좌 리스크 상태와 검사 한도 조회
+func (s *ServiceImpl) GetState(userID string, q dto.GetStateQuery) (*dto.StateResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	state := s.manager.GetState(middleware.Scope{UserID: uid, Account: q.Account})
+	response := &dto.StateResponse{
+		Account:          q.Account,
+		TradingDay:       state.TradingDay,
+		DailyRealizedPnL: state.DailyRealizedPnL,
+		DailyLoss:        state.DailyLoss(),
+		OpenExposure:     state.OpenExposure(),
+		OpenOrders:       len(state.OpenOrders),
+		Equity:           state.Equity,
+		PeakEquity:       state.PeakEquity,
+		Drawdown:         state.Drawdown().Round(4),
+		Positions:        make([]*dto.PositionResponse, 0, len(state.Positions)),
+		Limits: dto.LimitsResponse{
+			MaxOrderNotional: s.limits.MaxOrderNotional,
+			MaxPositionSize:  s.limits.MaxPositionSize,
+			MaxDailyLoss:     s.limits.MaxDailyLoss,
+			MaxOpenOrders:    s.limits.MaxOpenOrders,
+			PriceCollar:      s.limits.PriceCollar,
+			MaxDrawdown:      s.limits.MaxDrawdown,
+		},
+	}
+	if state.Halt != nil {
+		response.Halt = toHaltResponse(state.Halt)
+	}
+	if kill := s.manager.KillSwitch(uid); kill != nil {
+		response.KillSwitch = toHaltResponse(kill)
+	}
+	for _, position := range state.Positions {
+		response.Positions = append(response.Positions, &dto.PositionResponse{
+			Symbol:   position.Symbol,
+			Side:     position.Side,
+			Quantity: position.Quantity,
+			AvgPrice: position.AvgPrice,
+			Exposure: position.Quantity.Mul(position.AvgPrice),
+		})
+	}
+	sort.Slice(response.Positions, func(i, j int) bool {
+		return response.Positions[i].Symbol < response.Positions[j].Symbol
+	})
+
+	return response, nil
+}
+
+// GetEvents 사용자 리스크 이벤트 조회 (최신순)
+func (s *ServiceImpl) GetEvents(userID string, q dto.GetEventListQuery) (*dto.EventListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	if q.Account != "" && q.Account != middleware.AccountLive && q.Account != middleware.AccountPaper {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 계좌 구분: %s", q.Account))
+	}
+	if q.Type != "" && q.Type != EventOrderRejected && q.Type != EventDrawdownHalt {
+		return nil, utils.BadRequest(fmt.Sprintf("지원하지 않는 이벤트 유형: %s", q.Type))
+	}
+
+	filter := EventFilter{Account: q.Account, Type: q.Type}
+	events, err := s.repository.GetEvents(uid, filter, q.Limit, q.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("리스크 이벤트 조회 실패: %w", err)
+	}
+	total, err := s.repository.CountEvents(uid, filter)
+	if err != nil {
+		return nil, fmt.Errorf("리스크 이벤트 수 조회 실패: %w", err)
+	}
+
+	response := &dto.EventListResponse{
+		Events: make([]*dto.EventResponse, 0, len(events)),
+		Total:  total,
+		Limit:  q.Limit,
+		Offset: q.Offset,
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, toEventResponse(event))
+	}
+	return response, nil
+}
+
+// AcknowledgeHalt 거래 중지 해제 확인 (현재 평가금액을 새 최고 평가금액으로 삼아 거래 재개)
+// 중지로 멈춘 전략은 자동으로 다시 시작하지 않는다.
+func (s *ServiceImpl) AcknowledgeHalt(userID string, body dto.AcknowledgeHaltBody) (*dto.StateResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	scope := middleware.Scope{UserID: uid, Account: body.Account}
+	if s.manager.GetState(scope).Halt == nil {
+		return nil, utils.Conflict("account", "거래 중지 상태가 아닙니다")
+	}
+
+	if _, err := s.repository.AcknowledgeHalts(uid, body.Account, uid); err != nil {
+		return nil, fmt.Errorf("거래 중지 해제 기록 실패: %w", err)
+	}
+	s.manager.Resume(scope)
+
+	return s.GetState(userID, dto.GetStateQuery{Account: body.Account})
+}
+
+// ActivateKillSwitch 긴급 중지 발동 (USER: 요청 사용자, ALL: 전체 사용자, 관리자 전용)
+func (s *ServiceImpl) ActivateKillSwitch(userID string, body dto.KillSwitchBody) (*dto.KillSwitchResponse, error) {
+	uid, target, err := s.killTarget(userID, body.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	record, err := s.killSwitch.Activate(uid, target, body.Reason, body.Flatten)
+	if err != nil {
+		return nil, err
+	}
+	return toKillSwitchResponse(record), nil
+}
+
+// ReleaseKillSwitch 긴급 중지 해제 (사용자는 전체 사용자 긴급 중지를 해제할 수 없음)
+func (s *ServiceImpl) ReleaseKillSwitch(userID string, body dto.ReleaseKillSwitchBody) (*dto.ReleaseKillSwitchResponse, error) {
+	uid, target, err := s.killTarget(userID, body.Scope)
+	if err != nil {
+		return nil, err
+	}
+
+	released, err := s.killSwitch.Release(uid, target)
+	if err != nil {
+		return nil, err
+	}
+	if released == 0 {
+		return nil, utils.Conflict("scope", "해제할 긴급 중지가 없습니다")
+	}
+	return &dto.ReleaseKillSwitchResponse{Scope: body.Scope, Released: released}, nil
+}
+
+// GetKillSwitches 긴급 중지 발동 기록 조회 (관리자는 전체, 사용자는 본인 대상과 전체 대상)
+func (s *ServiceImpl) GetKillSwitches(userID string, q dto.GetKillSwitchListQuery) (*dto.KillSwitchListResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	admin, err := s.repository.IsAdmin(uid)
+	if err != nil {
+		return nil, fmt.Errorf("사용자 권한 확인 실패: %w", err)
+	}
+
+	filter := &uid
+	if admin {
+		filter = nil
+	}
+	records, err := s.repository.GetKillSwitches(filter, q.Limit, q.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("긴급 중지 기록 조회 실패: %w", err)
+	}
+	total, err := s.repository.CountKillSwitches(filter)
+	if err != nil {
+		return nil, fmt.Errorf("긴급 중지 기록 수 조회 실패: %w", err)
+	}
+
+	response := &dto.KillSwitchListResponse{
+		KillSwitches: make([]*dto.KillSwitchResponse, 0, len(records)),
+		Total:        total,
+		Limit:        q.Limit,
+		Offset:       q.Offset,
+	}
+	for _, record := range records {
+		response.KillSwitches = append(response.KillSwitches, toKillSwitchResponse(record))
+	}
+	return response, nil
+}
+
+// killTarget 요청 사용자와 긴급 중지 대상 (ALL이면 nil, 관리자만 허용)
+func (s *ServiceImpl) killTarget(userID, scope string) (uuid.UUID, *uuid.UUID, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return uuid.Nil, nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	if scope != KillScopeAll {
+		return uid, &uid, nil
+	}
+
+	admin, err := s.repository.IsAdmin(uid)
+	if err != nil {
+		return uuid.Nil, nil, fmt.Errorf("사용자 권한 확인 실패: %w", err)
+	}
+	if !admin {
+		return uuid.Nil, nil, utils.Forbidden("전체 사용자 긴급 중지는 관리자만 사용할 수 있습니다")
+	}
+	return uid, nil, nil
+}
+
+// toHaltResponse 거래 중지 상태를 응답 DTO로 변환
+func toHaltResponse(halt *middleware.Halt) *dto.HaltResponse {
+	return &dto.HaltResponse{
+		Rule:   halt.Rule,
+		Reason: halt.Reason,
+		Since:  halt.Since,
+	}
+}
+
+// toKillSwitchResponse ent.KillSwitch를 응답 DTO로 변환
+func toKillSwitchResponse(record *ent.KillSwitch) *dto.KillSwitchResponse {
+	scope := KillScopeUser
+	if record.UserID == nil {
+		scope = KillScopeAll
+	}
+	return &dto.KillSwitchResponse{
+		ID:                record.ID,
+		Scope:             scope,
+		UserID:            record.UserID,
+		ActivatedBy:       record.ActivatedBy,
+		Reason:            record.Reason,
+		Flatten:           record.Flatten,
+		StoppedStrategies: record.StoppedStrategies,
+		CanceledOrders:    record.CanceledOrders,
+		FlattenOrders:     record.FlattenOrders,
+		FailedCloses:      record.FailedCloses,
+		Errors:            record.Errors,
+		CreatedAt:         record.CreatedAt,
+		ReleasedAt:        record.ReleasedAt,
+		ReleasedBy:        record.ReleasedBy,
+	}
+}
+
+// toEventResponse ent.RiskEvent를 응답 DTO로 변환
+func toEventResponse(event *ent.RiskEvent) *dto.EventResponse {
+	return &dto.EventResponse{
+		ID:             event.ID,
+		Account:        string(event.Account),
+		Type:           string(event.Type),
+		Rule:           event.Rule,
+		Reason:         event.Reason,
+		ClientOrderID:  event.ClientOrderID,
+		StrategyID:     event.StrategyID,
+		Symbol:         event.Symbol,
+		Side:           event.Side,
+		Quantity:       event.Quantity,
+		Price:          event.Price,
+		Details:        event.Details,
+		AcknowledgedAt: event.AcknowledgedAt,
+		AcknowledgedBy: event.AcknowledgedBy,
+		CreatedAt:      event.CreatedAt,
+	}
+}
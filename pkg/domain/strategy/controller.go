@@ -6,6 +6,7 @@ import (
 	"auto-trader/pkg/shared/utils"
 
 	"github.com/gofiber/fiber/v2"
+	"github.com/google/uuid"
 )
 
 // Controller 전략 컨트롤러
@@ -22,7 +23,7 @@ func NewController(service Service) *Controller {
 
 // GetAllStrategies 모든 전략 조회
 // @Summary 모든 전략 조회
-// @Description 사용자의 모든 전략 목록을 조회합니다
+// @Description 요청 사용자의 전략 목록을 조회합니다
 // @Tags strategies
 // @Accept json
 // @Produce json
@@ -30,9 +31,9 @@ func NewController(service Service) *Controller {
 // @Failure 500 {object} utils.Response
 // @Router /strategies [get]
 func (ctrl *Controller) GetAllStrategies(c *fiber.Ctx) error {
-	strategies, err := ctrl.service.GetAllStrategies()
+	strategies, err := ctrl.service.GetAllStrategies(utils.GetUserID(c))
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, "전략 목록 조회 실패", err)
+		return utils.CommonErrorResponse(c, err, "전략 목록 조회 실패")
 	}
 
 	return utils.SuccessResponse(c, strategies)
@@ -56,6 +57,9 @@ func (ctrl *Controller) GetStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	strategy, err := ctrl.service.GetStrategy(path.ID)
 	if err != nil {
@@ -85,9 +89,15 @@ func (ctrl *Controller) CreateStrategy(c *fiber.Ctx) error {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
 
+	userID, err := uuid.Parse(utils.GetUserID(c))
+	if err != nil {
+		return utils.UnauthorizedResponse(c, "사용자 정보를 확인할 수 없습니다")
+	}
+	req.UserID = userID
+
 	strategy, err := ctrl.service.CreateStrategy(&req)
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, "전략 생성 실패", err)
+		return utils.CommonErrorResponse(c, err, "전략 생성 실패")
 	}
 
 	return utils.SuccessResponse(c, strategy, fiber.StatusCreated)
@@ -112,6 +122,9 @@ func (ctrl *Controller) UpdateStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	var req dto.UpdateStrategyBody
 	if err := c.BodyParser(&req); err != nil {
@@ -121,7 +134,7 @@ func (ctrl *Controller) UpdateStrategy(c *fiber.Ctx) error {
 
 	strategy, err := ctrl.service.UpdateStrategy(path.ID, &req)
 	if err != nil {
-		return utils.InternalServerErrorResponse(c, "전략 수정 실패", err)
+		return utils.CommonErrorResponse(c, err, "전략 수정 실패")
 	}
 
 	return utils.SuccessResponse(c, strategy)
@@ -145,6 +158,9 @@ func (ctrl *Controller) DeleteStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	if err := ctrl.service.DeleteStrategy(path.ID); err != nil {
 		return utils.InternalServerErrorResponse(c, "전략 삭제 실패", err)
@@ -171,6 +187,9 @@ func (ctrl *Controller) GetStrategyStatus(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	status, err := ctrl.service.GetStrategyStatus(path.ID)
 	if err != nil {
@@ -180,6 +199,36 @@ func (ctrl *Controller) GetStrategyStatus(c *fiber.Ctx) error {
 	return utils.SuccessResponse(c, status)
 }
 
+// GetStrategyConfig 전략 실행 설정 조회
+// @Summary 전략 실행 설정 조회
+// @Description 저장된 종목, 조건/규칙, 평가 주기, 주문 수량 설정과 템플릿 입력값을 조회합니다
+// @Tags strategies
+// @Accept json
+// @Produce json
+// @Param id path string true "전략 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategies/{id}/config [get]
+func (ctrl *Controller) GetStrategyConfig(c *fiber.Ctx) error {
+	var path types.Id
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
+
+	config, err := ctrl.service.GetStrategyConfig(path.ID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 설정 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, config)
+}
+
 // StartStrategy 전략 시작
 // @Summary 전략 시작
 // @Description 전략을 시작합니다
@@ -198,6 +247,9 @@ func (ctrl *Controller) StartStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	if err := ctrl.service.StartStrategy(path.ID); err != nil {
 		return utils.InternalServerErrorResponse(c, "전략 시작 실패", err)
@@ -227,6 +279,9 @@ func (ctrl *Controller) StopStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	if err := ctrl.service.StopStrategy(path.ID); err != nil {
 		return utils.InternalServerErrorResponse(c, "전략 중지 실패", err)
@@ -256,6 +311,9 @@ func (ctrl *Controller) RestartStrategy(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	if err := ctrl.service.RestartStrategy(path.ID); err != nil {
 		return utils.InternalServerErrorResponse(c, "전략 재시작 실패", err)
@@ -285,6 +343,9 @@ func (ctrl *Controller) GetStrategyPerformance(c *fiber.Ctx) error {
 	if err := utils.ValidateStruct(path); err != nil {
 		return utils.ValidationErrorResponse(c, err.Error())
 	}
+	if err := ctrl.service.CheckOwner(utils.GetUserID(c), path.ID); err != nil {
+		return utils.CommonErrorResponse(c, err, "전략 조회 실패")
+	}
 
 	performance, err := ctrl.service.GetStrategyPerformance(path.ID)
 	if err != nil {
@@ -8,19 +8,58 @@ import (
 
 // CreateStrategyBody 전략 생성 요청 데이터
 type CreateStrategyBody struct {
-	Name        string    `json:"name" validate:"required,min=1,max=100"`
-	Symbol      string    `json:"symbol" validate:"required,min=1,max=20"`
-	Description *string   `json:"description,omitempty" validate:"omitempty,max=500"`
-	UserID      uuid.UUID `json:"user_id" validate:"required"`
-	Active      bool      `json:"active"`
+	Name        string                 `json:"name" validate:"required,min=1,max=100"`
+	Symbol      string                 `json:"symbol" validate:"required,min=1,max=20"`
+	Exchange    string                 `json:"exchange,omitempty" validate:"max=10"` // NASD, NYSE, AMEX (비어 있으면 종목 마스터 기준)
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=500"`
+	UserID      uuid.UUID              `json:"-"` // 요청 사용자 (JWT 기준, 본문 값은 무시)
+	Active      bool                   `json:"active"`
+	TradingMode string                 `json:"trading_mode,omitempty"` // LIVE(기본값) 또는 PAPER
+	Rules       map[string]interface{} `json:"rules,omitempty"`        // 타입 규칙 (version, rules)
+	TemplateID  *uuid.UUID             `json:"template_id,omitempty"`
+	UserInputs  map[string]interface{} `json:"user_inputs,omitempty"` // 템플릿 입력값
+	Settings    *StrategySettingsBody  `json:"settings,omitempty"`
 }
 
 // UpdateStrategyBody 전략 수정 요청 데이터
 type UpdateStrategyBody struct {
-	Name        *string `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
-	Symbol      *string `json:"symbol,omitempty" validate:"omitempty,min=1,max=20"`
-	Description *string `json:"description,omitempty" validate:"omitempty,max=500"`
-	Active      *bool   `json:"active,omitempty"`
+	Name        *string                `json:"name,omitempty" validate:"omitempty,min=1,max=100"`
+	Symbol      *string                `json:"symbol,omitempty" validate:"omitempty,min=1,max=20"`
+	Exchange    *string                `json:"exchange,omitempty" validate:"omitempty,max=10"` // NASD, NYSE, AMEX
+	Description *string                `json:"description,omitempty" validate:"omitempty,max=500"`
+	Active      *bool                  `json:"active,omitempty"`
+	TradingMode *string                `json:"trading_mode,omitempty"` // LIVE 또는 PAPER
+	Rules       map[string]interface{} `json:"rules,omitempty"`        // 타입 규칙 (지정 시 전체 교체)
+	UserInputs  map[string]interface{} `json:"user_inputs,omitempty"`  // 지정 시 전체 교체
+	Settings    *StrategySettingsBody  `json:"settings,omitempty"`     // 지정한 항목만 교체
+}
+
+// StrategySettingsBody 전략 실행 설정 (settings JSON에 저장되어 런타임 설정으로 사용)
+type StrategySettingsBody struct {
+	Symbols        []string                 `json:"symbols,omitempty"`         // symbol 외 추가 종목
+	Conditions     []map[string]interface{} `json:"conditions,omitempty"`      // 개별 조건 목록 (rules가 없을 때 사용)
+	CandleInterval string                   `json:"candle_interval,omitempty"` // 지표 봉 주기 (예: 1m, 5m, 1h)
+	Schedule       *ScheduleBody            `json:"schedule,omitempty"`
+	Sessions       []string                 `json:"sessions,omitempty"` // 실행 허용 거래 세션 (pre, regular, post, 기본 regular)
+	Sizing         *SizingBody              `json:"sizing,omitempty"`
+}
+
+// ScheduleBody 전략 실행 트리거 (trigger 미지정 시 interval)
+type ScheduleBody struct {
+	Trigger     string `json:"trigger,omitempty"`      // interval, cron, bar_close, tick
+	Interval    string `json:"interval,omitempty"`     // interval: 평가 주기 (예: 30s, 5m, 최소 5s)
+	Cron        string `json:"cron,omitempty"`         // cron: 분 시 일 월 요일 (예: */15 9-15 * * MON-FRI)
+	Timezone    string `json:"timezone,omitempty"`     // cron/bar_close 기준 시간대 (기본 America/New_York)
+	BarInterval string `json:"bar_interval,omitempty"` // bar_close: 봉 주기 (기본 candle_interval)
+	MinGap      string `json:"min_gap,omitempty"`      // tick: 연속 실행 최소 간격 (기본 1s)
+	Timeout     string `json:"timeout,omitempty"`      // 1회 실행 제한 시간 (기본 30s)
+	Jitter      string `json:"jitter,omitempty"`       // 실행 전 임의 지연 상한 (기본 0)
+}
+
+// SizingBody 주문 수량 산정 설정
+type SizingBody struct {
+	OrderAmount float64 `json:"order_amount"`           // 금액이 지정되지 않은 매수 액션의 주문 금액
+	MaxQuantity float64 `json:"max_quantity,omitempty"` // 주문 1건 최대 수량 (0이면 제한 없음)
 }
 
 // Query DTOs (URL 쿼리 파라미터)
@@ -38,6 +38,16 @@ type StrategyDetails struct {
 	UpdatedAt   time.Time `json:"updated_at"`
 }
 
+// StrategyConfigResponse 전략 실행 설정 (DB에 저장되어 런타임이 그대로 사용하는 값)
+type StrategyConfigResponse struct {
+	ID          uuid.UUID              `json:"id"`
+	Symbols     []string               `json:"symbols"` // symbol + settings.symbols
+	TradingMode string                 `json:"trading_mode"`
+	TemplateID  *uuid.UUID             `json:"template_id,omitempty"`
+	UserInputs  map[string]interface{} `json:"user_inputs"`
+	Settings    map[string]interface{} `json:"settings"`
+}
+
 // StrategyStatus 전략 상태 정보
 type StrategyStatus struct {
 	ID     uuid.UUID `json:"id"`
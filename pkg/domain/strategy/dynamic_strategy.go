@@ -2,10 +2,16 @@ package strategy
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
+	"sync"
+	"time"
 
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/calendar"
 	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/indicator"
 	"auto-trader/pkg/shared/middleware"
 
 	"github.com/shopspring/decimal"
@@ -16,6 +22,7 @@ import (
 type DynamicStrategy struct {
 	// 의존성들
 	dataCollector Collector
+	history       HistoryProvider
 	executor      Executor
 	riskManager   *middleware.Manager
 	appConfig     *config.Config
@@ -24,25 +31,86 @@ type DynamicStrategy struct {
 	strategyConfig *StrategyConfig
 
 	// 런타임 상태
-	stopChan chan struct{}
+	stopChan    chan struct{}
+	stopOnce    sync.Once
+	series      map[string]*indicator.Series // 종목별 봉/지표
+	crossStates map[string]float64           // 교차 조건별 직전 차이값
+	stateMutex  sync.Mutex
+
+	// 액션 실행 기록 콜백 (백테스트 재생에서는 등록하지 않음)
+	executionHandler ExecutionHandler
+
+	// 타입 규칙 (settings.rules가 있으면 기존 conditions 대신 사용)
+	ruleSet  *RuleSet
+	rulesErr error
+
+	// 전략 체결 기준 종목별 보유 수량/평균 단가 (position 피연산자)
+	positions map[string]order.Position
+
+	// 실행 허용 거래 세션 (settings.sessions, 기본 정규장)
+	calendar *calendar.Calendar
+	sessions []calendar.Session
 }
 
+const (
+	// defaultCandleInterval 지표 계산용 기본 봉 주기
+	defaultCandleInterval = time.Minute
+	// warmupCandles 지표 워밍업을 위해 불러오는 과거 봉 개수
+	warmupCandles = 200
+	// strategyTick 기본 평가 주기 (interval 트리거의 최소값)
+	strategyTick = 5 * time.Second
+	// defaultOrderAmount 주문 금액이 지정되지 않은 매수 액션의 기본 주문 금액
+	defaultOrderAmount = 1000.0
+)
+
 // NewDynamicStrategy 동적 전략 생성
 func NewDynamicStrategy(
 	dataCollector Collector,
+	history HistoryProvider,
 	executor Executor,
 	riskManager *middleware.Manager,
 	appConfig *config.Config,
 	strategyConfig *StrategyConfig,
 ) Strategy {
-	return &DynamicStrategy{
+	s := &DynamicStrategy{
 		dataCollector:  dataCollector,
+		history:        history,
 		executor:       executor,
 		riskManager:    riskManager,
 		appConfig:      appConfig,
 		strategyConfig: strategyConfig,
 		stopChan:       make(chan struct{}),
+		series:         make(map[string]*indicator.Series),
+		crossStates:    make(map[string]float64),
+		positions:      make(map[string]order.Position),
+		calendar:       calendar.New(),
+	}
+
+	sessions, err := calendar.ParseSessions(sessionSettings(strategyConfig.Parameters["sessions"]))
+	if err != nil {
+		logrus.Errorf("❌ 거래 세션 해석 실패 (%s): %v", strategyConfig.ID, err)
+		sessions = []calendar.Session{calendar.SessionRegular}
+	}
+	s.sessions = sessions
+
+	// 저장 시 검증되지만, 이전 버전으로 저장된 규칙은 실행 시 오류로 보고
+	if raw, ok := strategyConfig.Parameters["rules"]; ok && raw != nil {
+		s.ruleSet, s.rulesErr = ParseRuleSet(raw)
+		if s.rulesErr != nil {
+			logrus.Errorf("❌ 전략 규칙 해석 실패 (%s): %v", strategyConfig.ID, s.rulesErr)
+		}
 	}
+	return s
+}
+
+// NewReplayStrategy 과거 봉 재생용 동적 전략 생성 (저장된 봉 워밍업 없이 주입된 봉만 사용)
+func NewReplayStrategy(dataCollector Collector, executor Executor, appConfig *config.Config, strategyConfig *StrategyConfig) Replayer {
+	return NewDynamicStrategy(dataCollector, nil, executor, nil, appConfig, strategyConfig).(*DynamicStrategy)
+}
+
+// OnExecution 액션 실행 기록 콜백 등록
+func (s *DynamicStrategy) OnExecution(handler ExecutionHandler) {
+	s.executionHandler = handler
 }
 
 func (s *DynamicStrategy) ID() string {
@@ -68,12 +136,30 @@ func (s *DynamicStrategy) Symbols() []string {
 }
 
 func (s *DynamicStrategy) Execute() error {
+	return s.ExecuteContext(context.Background())
+}
+
+// ExecuteContext 제한 시간/취소가 전달되는 실행 (주문 요청에도 같은 ctx 사용)
+func (s *DynamicStrategy) ExecuteContext(ctx context.Context) error {
 	if !s.strategyConfig.Enabled {
 		return nil
 	}
 
+	// 중지(교체)된 인스턴스는 평가하지 않음
+	select {
+	case <-s.stopChan:
+		return nil
+	default:
+	}
+
+	// 허용된 거래 세션이 아니면 (주말/휴장일 포함) 평가하지 않음
+	if now := time.Now(); !s.calendar.IsOpen(now, s.sessions...) {
+		logrus.Debugf("거래 세션 외 시간이라 건너뜀 (%s): %s", s.Name(), s.calendar.SessionAt(now))
+		return nil
+	}
+
 	// DB에서 로드한 전략 로직을 동적으로 실행
-	return s.executeStrategyLogic()
+	return s.executeStrategyLogic(ctx)
 }
 
 func (s *DynamicStrategy) Start() error {
@@ -82,37 +168,82 @@ func (s *DynamicStrategy) Start() error {
 }
 
 func (s *DynamicStrategy) Stop() error {
-	close(s.stopChan)
-	logrus.Infof("🛑 동적 전략 중지: %s", s.Name())
+	s.stopOnce.Do(func() {
+		close(s.stopChan)
+		logrus.Infof("🛑 동적 전략 중지: %s", s.Name())
+	})
 	return nil
 }
 
-// executeStrategyLogic DB에 저장된 전략 로직을 동적으로 실행
-func (s *DynamicStrategy) executeStrategyLogic() error {
+// executeStrategyLogic DB에 저장된 전략 로직을 동적으로 실행 (종목별 오류는 모아서 반환)
+func (s *DynamicStrategy) executeStrategyLogic(ctx context.Context) error {
 	symbols := s.Symbols()
 
+	var errs []error
 	for _, symbol := range symbols {
-		if err := s.executeForSymbol(symbol); err != nil {
-			logrus.Errorf("전략 실행 오류 (%s): %v", symbol, err)
+		// 제한 시간이 지나면 남은 종목은 평가하지 않음
+		if err := ctx.Err(); err != nil {
+			errs = append(errs, err)
+			break
+		}
+		if err := s.executeForSymbol(ctx, symbol); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", symbol, err))
 		}
 	}
-	return nil
+	return errors.Join(errs...)
 }
 
 // executeForSymbol 특정 심볼에 대해 전략 실행
-func (s *DynamicStrategy) executeForSymbol(symbol string) error {
+func (s *DynamicStrategy) executeForSymbol(ctx context.Context, symbol string) error {
 	// 현재가 조회
 	priceData, err := s.dataCollector.GetCurrentPrice(symbol)
 	if err != nil {
 		return fmt.Errorf("현재가 조회 실패: %w", err)
 	}
 
-	// 전략 조건들을 DB에서 로드하여 평가
+	// 체결가를 봉으로 집계해 지표 갱신
+	s.recordTick(symbol, priceData)
+
+	return s.evaluate(ctx, symbol, priceData)
+}
+
+// ReplayCandle 완성된 봉을 지표에 반영한 뒤 봉 종가 기준으로 조건 평가 (백테스트용)
+func (s *DynamicStrategy) ReplayCandle(symbol string, c indicator.Candle) error {
+	series := s.seriesFor(symbol)
+	series.AddCandle(c)
+
+	return s.evaluate(context.Background(), symbol, &PriceData{
+		Price:     decimal.NewFromFloat(c.Close),
+		Volume:    decimal.NewFromFloat(c.Volume),
+		Timestamp: c.Time.Add(series.Interval()),
+	})
+}
+
+// PreloadCandles 조건 평가 없이 과거 봉을 지표에 적재 (백테스트 시작 전 워밍업용)
+func (s *DynamicStrategy) PreloadCandles(symbol string, candles []indicator.Candle) {
+	series := s.seriesFor(symbol)
+	for _, c := range candles {
+		series.AddCandle(c)
+	}
+}
+
+// evaluate 전략 조건들을 DB에서 로드하여 평가하고 충족된 액션 실행
+func (s *DynamicStrategy) evaluate(ctx context.Context, symbol string, priceData *PriceData) error {
+	if s.rulesErr != nil {
+		return s.rulesErr
+	}
+	if s.ruleSet != nil {
+		return s.evaluateRules(ctx, symbol, priceData)
+	}
+
 	conditions := s.getConditions()
 
 	for _, condition := range conditions {
 		if s.evaluateCondition(condition, symbol, priceData) {
-			if err := s.executeAction(condition.Action, symbol, priceData); err != nil {
+			update, err := s.executeAction(ctx, condition.Action, symbol, priceData)
+			reasoning := fmt.Sprintf("%s %s %v 충족", condition.Type, condition.Operator, condition.Value)
+			s.recordExecution(condition.Action, reasoning, symbol, priceData, update, err)
+			if err != nil {
 				return fmt.Errorf("액션 실행 실패: %w", err)
 			}
 		}
@@ -121,6 +252,292 @@ func (s *DynamicStrategy) executeForSymbol(symbol string) error {
 	return nil
 }
 
+// evaluateRules 타입 규칙 평가 (규칙마다 조건 트리가 참이면 액션 실행)
+func (s *DynamicStrategy) evaluateRules(ctx context.Context, symbol string, priceData *PriceData) error {
+	for i, rule := range s.ruleSet.Rules {
+		matched, reasoning := s.evaluateNode(rule.When, symbol, priceData)
+		if !matched {
+			continue
+		}
+
+		action := Action{Type: rule.Action.Type, Quantity: rule.Action.Quantity, Price: rule.Action.Price}
+		if rule.Name != "" {
+			reasoning = fmt.Sprintf("[%s] %s", rule.Name, reasoning)
+		}
+
+		update, err := s.executeAction(ctx, action, symbol, priceData)
+		s.recordExecution(action, reasoning, symbol, priceData, update, err)
+		if err != nil {
+			return fmt.Errorf("규칙 %d 액션 실행 실패: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// evaluateNode 조건 트리 평가 (참/거짓과 근거 문자열)
+// 교차 연산자의 직전 값이 매 평가마다 갱신되도록 그룹은 단락 평가하지 않는다.
+func (s *DynamicStrategy) evaluateNode(node *RuleNode, symbol string, priceData *PriceData) (bool, string) {
+	switch {
+	case node.All != nil:
+		return s.evaluateGroup(node.All, symbol, priceData, true)
+	case node.Any != nil:
+		return s.evaluateGroup(node.Any, symbol, priceData, false)
+	case node.Not != nil:
+		matched, reasoning := s.evaluateNode(node.Not, symbol, priceData)
+		return !matched, "NOT (" + reasoning + ")"
+	}
+
+	left, leftReady := s.operandValue(node.Left, symbol, priceData)
+	right, rightReady := s.operandValue(node.Right, symbol, priceData)
+	reasoning := fmt.Sprintf("%s %g %s %s %g", node.Left, left, node.Op, node.Right, right)
+	if !leftReady || !rightReady {
+		return false, reasoning + " (지표 준비 중)"
+	}
+
+	switch node.Op {
+	case OpCrossAbove:
+		return s.crossed(symbol, node.key, left-right, true), reasoning
+	case OpCrossBelow:
+		return s.crossed(symbol, node.key, left-right, false), reasoning
+	case "==":
+		return left == right, reasoning
+	case "!=":
+		return left != right, reasoning
+	default:
+		return s.compare(left, node.Op, right), reasoning
+	}
+}
+
+func (s *DynamicStrategy) evaluateGroup(nodes []*RuleNode, symbol string, priceData *PriceData, all bool) (bool, string) {
+	matched := all
+	reasons := make([]string, 0, len(nodes))
+	for _, child := range nodes {
+		ok, reasoning := s.evaluateNode(child, symbol, priceData)
+		if all {
+			matched = matched && ok
+		} else {
+			matched = matched || ok
+		}
+		reasons = append(reasons, reasoning)
+	}
+
+	separator := " AND "
+	if !all {
+		separator = " OR "
+	}
+	return matched, "(" + strings.Join(reasons, separator) + ")"
+}
+
+// operandValue 피연산자 현재 값 (지표가 준비되지 않았으면 false)
+func (s *DynamicStrategy) operandValue(operand *Operand, symbol string, priceData *PriceData) (float64, bool) {
+	switch operand.Type {
+	case OperandConst:
+		return *operand.Value, true
+	case OperandPrice:
+		return s.priceValue(operand.Field, symbol, priceData)
+	case OperandPosition:
+		return s.positionValue(operand.Field, symbol, priceData), true
+	case OperandIndicator:
+		return s.indicatorValue(operand, symbol)
+	default:
+		return 0, false
+	}
+}
+
+func (s *DynamicStrategy) priceValue(field, symbol string, priceData *PriceData) (float64, bool) {
+	switch field {
+	case "last":
+		price, _ := priceData.Price.Float64()
+		return price, true
+	case "volume":
+		volume, _ := priceData.Volume.Float64()
+		return volume, true
+	case "daily_change_pct":
+		change, err := s.dataCollector.GetDailyProfit(symbol)
+		if err != nil {
+			return 0, false
+		}
+		value, _ := change.Float64()
+		return value, true
+	}
+
+	candle, ok := s.seriesFor(symbol).Current()
+	if !ok {
+		return 0, false
+	}
+	switch field {
+	case "open":
+		return candle.Open, true
+	case "high":
+		return candle.High, true
+	case "low":
+		return candle.Low, true
+	default:
+		return 0, false
+	}
+}
+
+func (s *DynamicStrategy) positionValue(field, symbol string, priceData *PriceData) float64 {
+	s.stateMutex.Lock()
+	pos := s.positions[symbol]
+	s.stateMutex.Unlock()
+
+	switch field {
+	case "quantity":
+		value, _ := pos.Quantity.Float64()
+		return value
+	case "avg_cost":
+		value, _ := pos.AvgCost.Float64()
+		return value
+	case "unrealized_pct":
+		if !pos.Quantity.IsPositive() || !pos.AvgCost.IsPositive() {
+			return 0
+		}
+		value, _ := priceData.Price.Sub(pos.AvgCost).Div(pos.AvgCost).Mul(decimal.NewFromInt(100)).Float64()
+		return value
+	default:
+		return 0
+	}
+}
+
+// indicatorValue 지표 출력값 (시리즈에는 지표 이름과 파라미터로 구분해 보관)
+func (s *DynamicStrategy) indicatorValue(operand *Operand, symbol string) (float64, bool) {
+	series := s.seriesFor(symbol)
+	key := fmt.Sprintf("%s:%s", operand.Name, formatParams(operand))
+
+	switch operand.Name {
+	case "sma":
+		sma := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewSMA(int(operand.param("period")))
+		}).(*indicator.SMA)
+		return sma.Value(), sma.Ready()
+	case "ema":
+		ema := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewEMA(int(operand.param("period")))
+		}).(*indicator.EMA)
+		return ema.Value(), ema.Ready()
+	case "rsi":
+		rsi := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewRSI(int(operand.param("period")))
+		}).(*indicator.RSI)
+		return rsi.Value(), rsi.Ready()
+	case "macd":
+		macd := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewMACD(int(operand.param("fast")), int(operand.param("slow")), int(operand.param("signal")))
+		}).(*indicator.MACD)
+		switch operand.output() {
+		case "signal":
+			return macd.Signal(), macd.Ready()
+		case "histogram":
+			return macd.Histogram(), macd.Ready()
+		default:
+			return macd.MACD(), macd.Ready()
+		}
+	case "bollinger":
+		bands := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewBollinger(int(operand.param("period")), operand.param("stddev"))
+		}).(*indicator.Bollinger)
+		switch operand.output() {
+		case "upper":
+			return bands.Upper(), bands.Ready()
+		case "lower":
+			return bands.Lower(), bands.Ready()
+		default:
+			return bands.Middle(), bands.Ready()
+		}
+	case "atr":
+		atr := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewATR(int(operand.param("period")))
+		}).(*indicator.ATR)
+		return atr.Value(), atr.Ready()
+	case "stochastic":
+		stoch := series.Indicator(key, func() indicator.Indicator {
+			return indicator.NewStochastic(int(operand.param("k_period")), int(operand.param("d_period")))
+		}).(*indicator.Stochastic)
+		if operand.output() == "d" {
+			return stoch.D(), stoch.Ready()
+		}
+		return stoch.K(), stoch.Ready()
+	case "vwap":
+		vwap := series.Indicator("vwap", func() indicator.Indicator {
+			return indicator.NewVWAP(marketLocation())
+		}).(*indicator.VWAP)
+		return vwap.Value(), vwap.Ready()
+	case "obv":
+		obv := series.Indicator("obv", func() indicator.Indicator {
+			return indicator.NewOBV()
+		}).(*indicator.OBV)
+		return obv.Value(), obv.Ready()
+	default:
+		return 0, false
+	}
+}
+
+// ApplyFill 전략 주문 체결을 보유 수량/평균 단가에 반영
+func (s *DynamicStrategy) ApplyFill(update order.Update) {
+	if update.StrategyID != s.strategyConfig.ID || !update.LastFillQuantity.IsPositive() {
+		return
+	}
+
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	pos := s.positions[update.Symbol]
+	if update.Side == order.SideBuy {
+		total := pos.AvgCost.Mul(pos.Quantity).Add(update.LastFillPrice.Mul(update.LastFillQuantity))
+		pos.Quantity = pos.Quantity.Add(update.LastFillQuantity)
+		pos.AvgCost = total.Div(pos.Quantity).Round(4)
+	} else {
+		pos.Quantity = pos.Quantity.Sub(update.LastFillQuantity)
+	}
+
+	if pos.Quantity.IsPositive() {
+		s.positions[update.Symbol] = pos
+	} else {
+		delete(s.positions, update.Symbol)
+	}
+}
+
+// SeedPosition 재시작 시 저장된 보유 수량/평균 단가 복원
+func (s *DynamicStrategy) SeedPosition(symbol string, quantity, avgCost decimal.Decimal) {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	s.positions[symbol] = order.Position{Quantity: quantity, AvgCost: avgCost}
+}
+
+// recordExecution 충족된 조건과 액션 결과를 실행 기록으로 전달
+func (s *DynamicStrategy) recordExecution(action Action, reasoning string, symbol string, priceData *PriceData, update *order.Update, err error) {
+	if s.executionHandler == nil {
+		return
+	}
+
+	reasoning = fmt.Sprintf("%s (현재가 %s)", reasoning, priceData.Price.String())
+	if err != nil {
+		reasoning += fmt.Sprintf(" - 실행 실패: %v", err)
+	}
+
+	execution := Execution{
+		StrategyID: s.strategyConfig.ID,
+		Symbol:     symbol,
+		Action:     action.Type,
+		Price:      &priceData.Price,
+		Reasoning:  reasoning,
+		ExecutedAt: priceData.Timestamp,
+	}
+	if update != nil {
+		price := update.Price
+		quantity := int(update.Quantity.IntPart())
+		execution.Price = &price
+		execution.Quantity = &quantity
+	}
+	if execution.ExecutedAt.IsZero() {
+		execution.ExecutedAt = time.Now()
+	}
+
+	s.executionHandler(execution)
+}
+
 // Condition 전략 조건 구조체
 type Condition struct {
 	Type     string                 `json:"type"`
@@ -157,6 +574,9 @@ func (s *DynamicStrategy) getConditions() []Condition {
 					},
 					Priority: s.getInt(condMap, "priority", 0),
 				}
+				if metadata, ok := condMap["metadata"].(map[string]interface{}); ok {
+					condition.Metadata = metadata
+				}
 				conditions = append(conditions, condition)
 			}
 		}
@@ -180,6 +600,16 @@ func (s *DynamicStrategy) evaluateCondition(condition Condition, symbol string,
 		return s.evaluateMACondition(condition, symbol, priceData)
 	case "bollinger_bands":
 		return s.evaluateBBCondition(condition, symbol, priceData)
+	case "macd":
+		return s.evaluateMACDCondition(condition, symbol, priceData)
+	case "atr":
+		return s.evaluateATRCondition(condition, symbol, priceData)
+	case "stochastic":
+		return s.evaluateStochasticCondition(condition, symbol, priceData)
+	case "vwap":
+		return s.evaluateVWAPCondition(condition, symbol, priceData)
+	case "obv":
+		return s.evaluateOBVCondition(condition, symbol, priceData)
 	default:
 		logrus.Warnf("지원하지 않는 조건 타입: %s", condition.Type)
 		return false
@@ -251,109 +681,404 @@ func (s *DynamicStrategy) evaluatePriceCondition(condition Condition, symbol str
 	}
 }
 
-// evaluateRSICondition RSI 조건 평가 (임시)
+// evaluateRSICondition RSI 조건 평가 (metadata: period=14)
 func (s *DynamicStrategy) evaluateRSICondition(condition Condition, symbol string, priceData *PriceData) bool {
-	rsi := 50.0 // TODO: 실제 RSI 계산
-	threshold := s.getFloat(condition.Value, 0.0)
-
-	switch condition.Operator {
-	case ">=":
-		return rsi >= threshold
-	case "<=":
-		return rsi <= threshold
-	default:
+	period := s.metaInt(condition, "period", 14)
+	rsi := s.seriesFor(symbol).Indicator(fmt.Sprintf("rsi:%d", period), func() indicator.Indicator {
+		return indicator.NewRSI(period)
+	}).(*indicator.RSI)
+	if !rsi.Ready() {
 		return false
 	}
+
+	return s.compare(rsi.Value(), condition.Operator, s.getFloat(condition.Value, 0.0))
 }
 
-// evaluateMACondition 이동평균 조건 평가 (임시)
+// evaluateMACondition 이동평균 조건 평가 (metadata: short_period=5, long_period=20, ma_type=sma|ema)
 func (s *DynamicStrategy) evaluateMACondition(condition Condition, symbol string, priceData *PriceData) bool {
-	shortMA := 100.0 // TODO: 실제 단기 이동평균 계산
-	longMA := 105.0  // TODO: 실제 장기 이동평균 계산
+	shortPeriod := s.metaInt(condition, "short_period", 5)
+	longPeriod := s.metaInt(condition, "long_period", 20)
+	maType := strings.ToLower(s.metaString(condition, "ma_type", "sma"))
+
+	shortMA, shortReady := s.movingAverage(symbol, maType, shortPeriod)
+	longMA, longReady := s.movingAverage(symbol, maType, longPeriod)
+	if !shortReady || !longReady {
+		return false
+	}
 
+	key := fmt.Sprintf("ma:%s:%d:%d", maType, shortPeriod, longPeriod)
 	switch condition.Operator {
 	case "CROSS_ABOVE":
-		return shortMA > longMA
+		return s.crossed(symbol, key, shortMA-longMA, true)
 	case "CROSS_BELOW":
+		return s.crossed(symbol, key, shortMA-longMA, false)
+	case "ABOVE":
+		return shortMA > longMA
+	case "BELOW":
 		return shortMA < longMA
 	default:
 		return false
 	}
 }
 
-// evaluateBBCondition 볼린저 밴드 조건 평가 (임시)
+// evaluateBBCondition 볼린저 밴드 조건 평가 (metadata: period=20, stddev=2)
 func (s *DynamicStrategy) evaluateBBCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	period := s.metaInt(condition, "period", 20)
+	stdDev := s.metaFloat(condition, "stddev", 2.0)
+	bands := s.seriesFor(symbol).Indicator(fmt.Sprintf("bb:%d:%g", period, stdDev), func() indicator.Indicator {
+		return indicator.NewBollinger(period, stdDev)
+	}).(*indicator.Bollinger)
+	if !bands.Ready() {
+		return false
+	}
+
 	currentPrice, _ := priceData.Price.Float64()
-	upperBand := 110.0 // TODO: 실제 상단 밴드 계산
-	lowerBand := 90.0  // TODO: 실제 하단 밴드 계산
 
 	switch condition.Operator {
 	case "TOUCH_UPPER":
-		return currentPrice >= upperBand
+		return currentPrice >= bands.Upper()
 	case "TOUCH_LOWER":
-		return currentPrice <= lowerBand
+		return currentPrice <= bands.Lower()
+	default:
+		return false
+	}
+}
+
+// evaluateMACDCondition MACD 조건 평가 (metadata: fast=12, slow=26, signal=9)
+// CROSS_ABOVE/CROSS_BELOW는 MACD선과 시그널선의 교차, 비교 연산자는 히스토그램 기준
+func (s *DynamicStrategy) evaluateMACDCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	fast := s.metaInt(condition, "fast", 12)
+	slow := s.metaInt(condition, "slow", 26)
+	signal := s.metaInt(condition, "signal", 9)
+	key := fmt.Sprintf("macd:%d:%d:%d", fast, slow, signal)
+	macd := s.seriesFor(symbol).Indicator(key, func() indicator.Indicator {
+		return indicator.NewMACD(fast, slow, signal)
+	}).(*indicator.MACD)
+	if !macd.Ready() {
+		return false
+	}
+
+	switch condition.Operator {
+	case "CROSS_ABOVE":
+		return s.crossed(symbol, key, macd.Histogram(), true)
+	case "CROSS_BELOW":
+		return s.crossed(symbol, key, macd.Histogram(), false)
+	default:
+		return s.compare(macd.Histogram(), condition.Operator, s.getFloat(condition.Value, 0.0))
+	}
+}
+
+// evaluateATRCondition ATR 조건 평가 (metadata: period=14)
+func (s *DynamicStrategy) evaluateATRCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	period := s.metaInt(condition, "period", 14)
+	atr := s.seriesFor(symbol).Indicator(fmt.Sprintf("atr:%d", period), func() indicator.Indicator {
+		return indicator.NewATR(period)
+	}).(*indicator.ATR)
+	if !atr.Ready() {
+		return false
+	}
+
+	return s.compare(atr.Value(), condition.Operator, s.getFloat(condition.Value, 0.0))
+}
+
+// evaluateStochasticCondition 스토캐스틱 조건 평가 (metadata: k_period=14, d_period=3)
+// CROSS_ABOVE/CROSS_BELOW는 %K와 %D의 교차, 비교 연산자는 %K 기준
+func (s *DynamicStrategy) evaluateStochasticCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	kPeriod := s.metaInt(condition, "k_period", 14)
+	dPeriod := s.metaInt(condition, "d_period", 3)
+	key := fmt.Sprintf("stoch:%d:%d", kPeriod, dPeriod)
+	stoch := s.seriesFor(symbol).Indicator(key, func() indicator.Indicator {
+		return indicator.NewStochastic(kPeriod, dPeriod)
+	}).(*indicator.Stochastic)
+	if !stoch.Ready() {
+		return false
+	}
+
+	switch condition.Operator {
+	case "CROSS_ABOVE":
+		return s.crossed(symbol, key, stoch.K()-stoch.D(), true)
+	case "CROSS_BELOW":
+		return s.crossed(symbol, key, stoch.K()-stoch.D(), false)
+	default:
+		return s.compare(stoch.K(), condition.Operator, s.getFloat(condition.Value, 0.0))
+	}
+}
+
+// evaluateVWAPCondition VWAP 대비 현재가 조건 평가 (ABOVE, BELOW)
+func (s *DynamicStrategy) evaluateVWAPCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	vwap := s.seriesFor(symbol).Indicator("vwap", func() indicator.Indicator {
+		return indicator.NewVWAP(marketLocation())
+	}).(*indicator.VWAP)
+	if !vwap.Ready() {
+		return false
+	}
+
+	currentPrice, _ := priceData.Price.Float64()
+
+	switch condition.Operator {
+	case "ABOVE":
+		return currentPrice > vwap.Value()
+	case "BELOW":
+		return currentPrice < vwap.Value()
 	default:
 		return false
 	}
 }
 
-// executeAction 액션 실행
-func (s *DynamicStrategy) executeAction(action Action, symbol string, priceData *PriceData) error {
+// evaluateOBVCondition OBV 조건 평가
+func (s *DynamicStrategy) evaluateOBVCondition(condition Condition, symbol string, priceData *PriceData) bool {
+	obv := s.seriesFor(symbol).Indicator("obv", func() indicator.Indicator {
+		return indicator.NewOBV()
+	}).(*indicator.OBV)
+	if !obv.Ready() {
+		return false
+	}
+
+	return s.compare(obv.Value(), condition.Operator, s.getFloat(condition.Value, 0.0))
+}
+
+// movingAverage 단순/지수 이동평균 조회
+func (s *DynamicStrategy) movingAverage(symbol, maType string, period int) (float64, bool) {
+	series := s.seriesFor(symbol)
+	if maType == "ema" {
+		ema := series.Indicator(fmt.Sprintf("ema:%d", period), func() indicator.Indicator {
+			return indicator.NewEMA(period)
+		}).(*indicator.EMA)
+		return ema.Value(), ema.Ready()
+	}
+
+	sma := series.Indicator(fmt.Sprintf("sma:%d", period), func() indicator.Indicator {
+		return indicator.NewSMA(period)
+	}).(*indicator.SMA)
+	return sma.Value(), sma.Ready()
+}
+
+// crossed 직전 평가 대비 차이값의 부호가 바뀌었는지 확인 (첫 평가는 교차로 보지 않음)
+func (s *DynamicStrategy) crossed(symbol, key string, diff float64, above bool) bool {
+	s.stateMutex.Lock()
+	defer s.stateMutex.Unlock()
+
+	stateKey := symbol + ":" + key
+	prev, ok := s.crossStates[stateKey]
+	s.crossStates[stateKey] = diff
+	if !ok {
+		return false
+	}
+
+	if above {
+		return prev <= 0 && diff > 0
+	}
+	return prev >= 0 && diff < 0
+}
+
+// recordTick 현재가를 종목별 봉 시리즈에 반영
+func (s *DynamicStrategy) recordTick(symbol string, priceData *PriceData) {
+	timestamp := priceData.Timestamp
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	}
+	price, _ := priceData.Price.Float64()
+	volume, _ := priceData.Volume.Float64()
+
+	s.seriesFor(symbol).AddTick(price, volume, timestamp)
+}
+
+// seriesFor 종목별 봉 시리즈 조회 (없으면 생성 후 과거 봉으로 워밍업)
+func (s *DynamicStrategy) seriesFor(symbol string) *indicator.Series {
+	s.stateMutex.Lock()
+	series, ok := s.series[symbol]
+	if !ok {
+		series = indicator.NewSeries(s.candleInterval())
+		s.series[symbol] = series
+	}
+	s.stateMutex.Unlock()
+
+	if !ok {
+		s.warmUp(symbol, series)
+	}
+	return series
+}
+
+// warmUp 저장된 과거 봉을 시리즈에 적재 (집계가 끝나지 않은 마지막 봉은 제외)
+func (s *DynamicStrategy) warmUp(symbol string, series *indicator.Series) {
+	if s.history == nil {
+		return
+	}
+
+	candles, err := s.history.GetRecentCandles(symbol, series.Interval(), warmupCandles)
+	if err != nil {
+		logrus.Warnf("⚠️  지표 워밍업 실패 (%s): %v", symbol, err)
+		return
+	}
+
+	now := time.Now()
+	for _, c := range candles {
+		if c.Time.Add(series.Interval()).After(now) {
+			continue
+		}
+		series.AddCandle(c)
+	}
+	logrus.Debugf("지표 워밍업 완료 (%s): 봉 %d개", symbol, series.Len())
+}
+
+// candleInterval 지표 계산용 봉 주기 (parameters.candle_interval, 예: "1m", "5m")
+func (s *DynamicStrategy) candleInterval() time.Duration {
+	if raw, ok := s.strategyConfig.Parameters["candle_interval"].(string); ok {
+		if interval, err := time.ParseDuration(raw); err == nil && interval > 0 {
+			return interval
+		}
+		logrus.Warnf("잘못된 봉 주기 설정 (%s): %s", s.Name(), raw)
+	}
+	return defaultCandleInterval
+}
+
+// Trigger 실행 트리거 (settings.schedule, 미설정 시 strategyTick 간격)
+func (s *DynamicStrategy) Trigger() (*Trigger, error) {
+	schedule, _ := s.strategyConfig.Parameters["schedule"].(map[string]interface{})
+	return ParseTrigger(schedule, s.candleInterval())
+}
+
+// sessionSettings settings.sessions 값을 문자열 목록으로 변환
+func sessionSettings(raw interface{}) []string {
+	switch values := raw.(type) {
+	case []string:
+		return values
+	case []interface{}:
+		sessions := make([]string, 0, len(values))
+		for _, value := range values {
+			if session, ok := value.(string); ok {
+				sessions = append(sessions, session)
+			}
+		}
+		return sessions
+	}
+	return nil
+}
+
+// sizing 주문 수량 산정 설정 (settings.sizing)
+func (s *DynamicStrategy) sizing() (orderAmount, maxQuantity float64) {
+	sizing, _ := s.strategyConfig.Parameters["sizing"].(map[string]interface{})
+	orderAmount = s.getFloat(sizing["order_amount"], defaultOrderAmount)
+	if orderAmount <= 0 {
+		orderAmount = defaultOrderAmount
+	}
+	return orderAmount, s.getFloat(sizing["max_quantity"], 0)
+}
+
+// marketLocation 미국 시장 시간대 (VWAP 세션 구분)
+func marketLocation() *time.Location {
+	loc, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		return time.UTC
+	}
+	return loc
+}
+
+// executeAction 액션 실행 (매수/매도는 접수된 주문 반환)
+func (s *DynamicStrategy) executeAction(ctx context.Context, action Action, symbol string, priceData *PriceData) (*order.Update, error) {
 	switch action.Type {
 	case "BUY":
-		return s.executeBuyAction(action, symbol, priceData)
+		return s.executeBuyAction(ctx, action, symbol, priceData)
 	case "SELL":
-		return s.executeSellAction(action, symbol, priceData)
+		return s.executeSellAction(ctx, action, symbol, priceData)
 	case "HOLD":
 		logrus.Infof("📊 홀드: %s", symbol)
-		return nil
+		return nil, nil
 	default:
-		return fmt.Errorf("지원하지 않는 액션 타입: %s", action.Type)
+		return nil, fmt.Errorf("지원하지 않는 액션 타입: %s", action.Type)
 	}
 }
 
 // executeBuyAction 매수 액션 실행
-func (s *DynamicStrategy) executeBuyAction(action Action, symbol string, priceData *PriceData) error {
-	quantity := s.calculateQuantity(action.Quantity, priceData.Price)
+func (s *DynamicStrategy) executeBuyAction(ctx context.Context, action Action, symbol string, priceData *PriceData) (*order.Update, error) {
+	quantity := s.calculateQuantity(action.Quantity, symbol, order.SideBuy, priceData.Price)
 	orderPrice := s.calculatePrice(action.Price, priceData.Price)
 
-	_, err := s.executor.ExecuteOrder(context.Background(), symbol, "BUY", quantity, orderPrice, "MARKET")
+	update, err := s.executor.ExecuteOrder(ctx, s.buildOrderRequest(action, symbol, order.SideBuy, quantity, orderPrice))
 	if err != nil {
-		return fmt.Errorf("매수 주문 실패: %w", err)
+		return nil, fmt.Errorf("매수 주문 실패: %w", err)
 	}
 
-	logrus.Infof("📈 매수 실행: %s, 수량: %s, 가격: %s", symbol, quantity.String(), orderPrice.String())
-	return nil
+	logrus.Infof("📈 매수 실행: %s, 수량: %s, 가격: %s (주문번호: %s)", symbol, update.Quantity.String(), update.Price.String(), update.BrokerOrderID)
+	return update, nil
 }
 
 // executeSellAction 매도 액션 실행
-func (s *DynamicStrategy) executeSellAction(action Action, symbol string, priceData *PriceData) error {
-	quantity := s.calculateQuantity(action.Quantity, priceData.Price)
+func (s *DynamicStrategy) executeSellAction(ctx context.Context, action Action, symbol string, priceData *PriceData) (*order.Update, error) {
+	quantity := s.calculateQuantity(action.Quantity, symbol, order.SideSell, priceData.Price)
 	orderPrice := s.calculatePrice(action.Price, priceData.Price)
 
-	_, err := s.executor.ExecuteOrder(context.Background(), symbol, "SELL", quantity, orderPrice, "MARKET")
+	update, err := s.executor.ExecuteOrder(ctx, s.buildOrderRequest(action, symbol, order.SideSell, quantity, orderPrice))
 	if err != nil {
-		return fmt.Errorf("매도 주문 실패: %w", err)
+		return nil, fmt.Errorf("매도 주문 실패: %w", err)
 	}
 
-	logrus.Infof("📉 매도 실행: %s, 수량: %s, 가격: %s", symbol, quantity.String(), orderPrice.String())
-	return nil
+	logrus.Infof("📉 매도 실행: %s, 수량: %s, 가격: %s (주문번호: %s)", symbol, update.Quantity.String(), update.Price.String(), update.BrokerOrderID)
+	return update, nil
+}
+
+// buildOrderRequest 액션을 주문 요청으로 변환 (숫자 가격은 지정가, 그 외는 시장가)
+func (s *DynamicStrategy) buildOrderRequest(action Action, symbol string, side order.Side, quantity, price decimal.Decimal) *order.Request {
+	orderType := order.TypeMarket
+	timeInForce := order.TimeInForceIOC
+	if _, ok := action.Price.(float64); ok {
+		orderType = order.TypeLimit
+		timeInForce = order.TimeInForceDay
+	}
+
+	userID, _ := s.strategyConfig.Parameters["user_id"].(string)
+	mode, _ := s.strategyConfig.Parameters["trading_mode"].(string)
+	if mode == "" {
+		mode = string(order.ModeLive)
+	}
+
+	return &order.Request{
+		UserID:      userID,
+		StrategyID:  s.strategyConfig.ID,
+		Mode:        order.Mode(mode),
+		Symbol:      symbol,
+		Side:        side,
+		Type:        orderType,
+		TimeInForce: timeInForce,
+		Quantity:    quantity,
+		Price:       price,
+	}
 }
 
 // calculateQuantity 수량 계산
-func (s *DynamicStrategy) calculateQuantity(quantity interface{}, price decimal.Decimal) decimal.Decimal {
+// 숫자는 주문 금액, "ALL"/"50%"는 매도 시 전략 보유 수량 기준, 매수 시 sizing.order_amount 기준이다.
+func (s *DynamicStrategy) calculateQuantity(quantity interface{}, symbol string, side order.Side, price decimal.Decimal) decimal.Decimal {
+	orderAmount, maxQuantity := s.sizing()
+
+	fraction := 1.0
+	amount := decimal.NewFromFloat(orderAmount)
 	switch q := quantity.(type) {
 	case float64:
-		return decimal.NewFromFloat(q).Div(price)
+		amount = decimal.NewFromFloat(q)
+		fraction = 0
 	case string:
-		if q == "ALL" {
-			return decimal.NewFromFloat(1000.0).Div(price) // TODO: 실제 보유 수량 조회
-		} else if strings.HasSuffix(q, "%") {
-			percent := s.getFloat(q, 100.0)
-			return decimal.NewFromFloat(percent / 100.0 * 1000.0).Div(price) // TODO: 실제 보유 수량 조회
+		if strings.HasSuffix(q, "%") {
+			fraction = s.getFloat(strings.TrimSuffix(q, "%"), 100.0) / 100.0
+		}
+	}
+
+	var result decimal.Decimal
+	if side == order.SideSell && fraction > 0 {
+		s.stateMutex.Lock()
+		held := s.positions[symbol].Quantity
+		s.stateMutex.Unlock()
+		result = held.Mul(decimal.NewFromFloat(fraction))
+	} else {
+		if fraction > 0 {
+			amount = amount.Mul(decimal.NewFromFloat(fraction))
 		}
+		result = amount.Div(price)
+	}
+
+	if maxQuantity > 0 {
+		result = decimal.Min(result, decimal.NewFromFloat(maxQuantity))
 	}
-	return decimal.NewFromFloat(1000.0).Div(price) // 기본값
+	return result
 }
 
 // calculatePrice 주문 가격 계산
@@ -394,6 +1119,46 @@ func (s *DynamicStrategy) getFloat(value interface{}, defaultValue float64) floa
 	return defaultValue
 }
 
+// compare 비교 연산자 평가
+func (s *DynamicStrategy) compare(value float64, operator string, threshold float64) bool {
+	switch operator {
+	case ">=":
+		return value >= threshold
+	case "<=":
+		return value <= threshold
+	case ">":
+		return value > threshold
+	case "<":
+		return value < threshold
+	default:
+		return false
+	}
+}
+
+// metaInt 조건 metadata의 정수 파라미터 (없거나 0 이하이면 기본값)
+func (s *DynamicStrategy) metaInt(condition Condition, key string, defaultValue int) int {
+	if v := int(s.getFloat(condition.Metadata[key], 0)); v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
+// metaFloat 조건 metadata의 실수 파라미터 (없거나 0 이하이면 기본값)
+func (s *DynamicStrategy) metaFloat(condition Condition, key string, defaultValue float64) float64 {
+	if v := s.getFloat(condition.Metadata[key], 0); v > 0 {
+		return v
+	}
+	return defaultValue
+}
+
+// metaString 조건 metadata의 문자열 파라미터
+func (s *DynamicStrategy) metaString(condition Condition, key string, defaultValue string) string {
+	if v, ok := condition.Metadata[key].(string); ok && v != "" {
+		return v
+	}
+	return defaultValue
+}
+
 func (s *DynamicStrategy) getInt(value interface{}, key string, defaultValue int) int {
 	if m, ok := value.(map[string]interface{}); ok {
 		if v, ok := m[key].(int); ok {
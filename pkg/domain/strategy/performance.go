@@ -0,0 +1,148 @@
+package strategy
+
+import (
+	"math"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/ent/schema"
+	"auto-trader/pkg/domain/order"
+
+	"github.com/shopspring/decimal"
+)
+
+// Execution 평가 결과 충족된 액션 기록
+type Execution struct {
+	StrategyID string
+	Symbol     string
+	Action     string // BUY, SELL, HOLD
+	Price      *decimal.Decimal
+	Quantity   *int
+	Reasoning  string
+	ExecutedAt time.Time
+}
+
+// ExecutionHandler 액션 실행 기록 콜백
+type ExecutionHandler func(execution Execution)
+
+// PerformanceState 체결마다 증분 갱신하는 전략 성과 상태
+// 수익률/낙폭/샤프 지수는 매도 체결(청산) 단위 수익률로 계산하며, 샤프 지수는 연율화하지 않는다.
+type PerformanceState struct {
+	TradeCount      int64
+	ClosedTrades    int64
+	WinningTrades   int64
+	ProfitLoss      decimal.Decimal
+	ReturnSum       float64
+	ReturnSqSum     float64
+	EquityIndex     float64 // 청산 수익률을 복리로 누적한 지수 (시작 1)
+	PeakEquityIndex float64
+	MaxDrawdown     float64 // %
+	LastTradeTime   *time.Time
+	OpenPositions   map[string]schema.OpenPosition
+}
+
+// newPerformanceState 저장된 성과 행으로 상태 복원 (없으면 초기 상태)
+func newPerformanceState(row *ent.StrategyPerformance) *PerformanceState {
+	state := &PerformanceState{
+		ProfitLoss:      decimal.Zero,
+		EquityIndex:     1,
+		PeakEquityIndex: 1,
+		OpenPositions:   make(map[string]schema.OpenPosition),
+	}
+	if row == nil {
+		return state
+	}
+
+	state.TradeCount = row.TradeCount
+	state.ClosedTrades = row.ClosedTrades
+	state.WinningTrades = row.WinningTrades
+	state.ProfitLoss = row.ProfitLoss
+	state.ReturnSum = row.ReturnSum
+	state.ReturnSqSum = row.ReturnSqSum
+	state.EquityIndex = row.EquityIndex
+	state.PeakEquityIndex = row.PeakEquityIndex
+	state.MaxDrawdown, _ = row.MaxDrawdown.Float64()
+	state.LastTradeTime = row.LastTradeTime
+	for symbol, pos := range row.OpenPositions {
+		state.OpenPositions[symbol] = pos
+	}
+	return state
+}
+
+// ApplyFill 체결 1건 반영 (매수는 평균 단가 갱신, 매도는 실현손익/승률/낙폭 갱신)
+func (p *PerformanceState) ApplyFill(symbol string, side order.Side, quantity, price decimal.Decimal, at time.Time) {
+	if !quantity.IsPositive() {
+		return
+	}
+	p.TradeCount++
+	p.LastTradeTime = &at
+
+	pos := p.OpenPositions[symbol]
+	if side == order.SideBuy {
+		total := pos.AvgCost.Mul(pos.Quantity).Add(price.Mul(quantity))
+		pos.Quantity = pos.Quantity.Add(quantity)
+		pos.AvgCost = total.Div(pos.Quantity).Round(4)
+		p.OpenPositions[symbol] = pos
+		return
+	}
+
+	// 전략 밖에서 매수한 수량은 원가를 알 수 없으므로 보유 수량까지만 손익 계산
+	closed := decimal.Min(quantity, pos.Quantity)
+	if !closed.IsPositive() {
+		return
+	}
+
+	realized := price.Sub(pos.AvgCost).Mul(closed)
+	p.ProfitLoss = p.ProfitLoss.Add(realized)
+	p.ClosedTrades++
+	if realized.IsPositive() {
+		p.WinningTrades++
+	}
+
+	if cost := pos.AvgCost.Mul(closed); cost.IsPositive() {
+		r, _ := realized.Div(cost).Float64()
+		p.ReturnSum += r
+		p.ReturnSqSum += r * r
+		p.EquityIndex *= 1 + r
+		if p.EquityIndex > p.PeakEquityIndex {
+			p.PeakEquityIndex = p.EquityIndex
+		}
+		if drawdown := (p.PeakEquityIndex - p.EquityIndex) / p.PeakEquityIndex * 100; drawdown > p.MaxDrawdown {
+			p.MaxDrawdown = drawdown
+		}
+	}
+
+	pos.Quantity = pos.Quantity.Sub(closed)
+	if pos.Quantity.IsPositive() {
+		p.OpenPositions[symbol] = pos
+	} else {
+		delete(p.OpenPositions, symbol)
+	}
+}
+
+// TotalReturn 청산 수익률 복리 누적(%)
+func (p *PerformanceState) TotalReturn() float64 {
+	return (p.EquityIndex - 1) * 100
+}
+
+// WinRate 이익 청산 비율(%)
+func (p *PerformanceState) WinRate() float64 {
+	if p.ClosedTrades == 0 {
+		return 0
+	}
+	return float64(p.WinningTrades) / float64(p.ClosedTrades) * 100
+}
+
+// SharpeRatio 청산 수익률 평균/표준편차 (표본 2건 미만이면 0)
+func (p *PerformanceState) SharpeRatio() float64 {
+	n := float64(p.ClosedTrades)
+	if n < 2 {
+		return 0
+	}
+	mean := p.ReturnSum / n
+	variance := (p.ReturnSqSum - n*mean*mean) / (n - 1)
+	if variance <= 0 {
+		return 0
+	}
+	return mean / math.Sqrt(variance)
+}
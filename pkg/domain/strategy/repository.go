@@ -6,9 +6,13 @@ import (
 
 	"auto-trader/ent"
 	"auto-trader/ent/strategy"
+	"auto-trader/ent/strategyexecution"
+	"auto-trader/ent/strategyperformance"
+	"auto-trader/ent/strategystatus"
 	"auto-trader/pkg/domain/strategy/dto"
 
 	"github.com/google/uuid"
+	"github.com/shopspring/decimal"
 )
 
 // Repository 전략 데이터 접근 인터페이스
@@ -35,6 +39,13 @@ type Repository interface {
 	// 통계
 	CountByUser(userID uuid.UUID) (int, error)
 	CountBySymbol(symbol string) (int, error)
+
+	// 런타임 상태/실행/성과 기록
+	GetStatus(strategyID uuid.UUID) (*ent.StrategyStatus, error)
+	SaveStatus(strategyID uuid.UUID, status strategystatus.Status, errorMessage *string, uptimeDelta int64) error
+	RecordExecution(execution Execution) error
+	GetPerformance(strategyID uuid.UUID) (*ent.StrategyPerformance, error)
+	SavePerformance(strategyID uuid.UUID, state *PerformanceState) error
 }
 
 // EntRepository ent 기반 구현체
@@ -54,13 +65,29 @@ func (r *EntRepository) getContext() context.Context {
 
 // Create 전략 생성
 func (r *EntRepository) Create(input dto.CreateStrategyBody) (*ent.Strategy, error) {
-	strategy, err := r.client.Strategy.Create().
+	createQuery := r.client.Strategy.Create().
 		SetName(input.Name).
 		SetSymbol(input.Symbol).
 		SetDescription(*input.Description).
 		SetUserID(input.UserID).
-		SetActive(input.Active).
-		Save(r.getContext())
+		SetActive(input.Active)
+	if input.Exchange != "" {
+		createQuery.SetExchange(input.Exchange)
+	}
+	if input.TradingMode != "" {
+		createQuery.SetTradingMode(strategy.TradingMode(input.TradingMode))
+	}
+	if input.TemplateID != nil {
+		createQuery.SetTemplateID(*input.TemplateID)
+	}
+	if input.UserInputs != nil {
+		createQuery.SetUserInputs(input.UserInputs)
+	}
+	if settings := mergeSettings(nil, input.Settings, input.Rules); len(settings) > 0 {
+		createQuery.SetSettings(settings)
+	}
+
+	strategy, err := createQuery.Save(r.getContext())
 
 	if err != nil {
 		return nil, fmt.Errorf("failed to create strategy: %w", err)
@@ -69,6 +96,74 @@ func (r *EntRepository) Create(input dto.CreateStrategyBody) (*ent.Strategy, err
 	return strategy, nil
 }
 
+// mergeSettings 기존 settings에 요청된 항목만 덮어쓴 새 settings 생성
+func mergeSettings(current map[string]interface{}, body *dto.StrategySettingsBody, rules map[string]interface{}) map[string]interface{} {
+	settings := make(map[string]interface{}, len(current)+6)
+	for key, value := range current {
+		settings[key] = value
+	}
+
+	if body != nil {
+		if body.Symbols != nil {
+			symbols := make([]interface{}, len(body.Symbols))
+			for i, symbol := range body.Symbols {
+				symbols[i] = symbol
+			}
+			settings["symbols"] = symbols
+		}
+		if body.Conditions != nil {
+			conditions := make([]interface{}, len(body.Conditions))
+			for i, condition := range body.Conditions {
+				conditions[i] = condition
+			}
+			settings["conditions"] = conditions
+		}
+		if body.CandleInterval != "" {
+			settings["candle_interval"] = body.CandleInterval
+		}
+		if body.Schedule != nil {
+			settings["schedule"] = scheduleSettings(body.Schedule)
+		}
+		if body.Sessions != nil {
+			sessions := make([]interface{}, len(body.Sessions))
+			for i, session := range body.Sessions {
+				sessions[i] = session
+			}
+			settings["sessions"] = sessions
+		}
+		if body.Sizing != nil {
+			settings["sizing"] = map[string]interface{}{
+				"order_amount": body.Sizing.OrderAmount,
+				"max_quantity": body.Sizing.MaxQuantity,
+			}
+		}
+	}
+	if rules != nil {
+		settings["rules"] = rules
+	}
+	return settings
+}
+
+// scheduleSettings 지정된 트리거 항목만 settings.schedule 값으로 변환
+func scheduleSettings(body *dto.ScheduleBody) map[string]interface{} {
+	schedule := make(map[string]interface{}, 8)
+	for key, value := range map[string]string{
+		"trigger":      body.Trigger,
+		"interval":     body.Interval,
+		"cron":         body.Cron,
+		"timezone":     body.Timezone,
+		"bar_interval": body.BarInterval,
+		"min_gap":      body.MinGap,
+		"timeout":      body.Timeout,
+		"jitter":       body.Jitter,
+	} {
+		if value != "" {
+			schedule[key] = value
+		}
+	}
+	return schedule
+}
+
 // GetByID ID로 전략 조회
 func (r *EntRepository) GetByID(id uuid.UUID) (*ent.Strategy, error) {
 	strategy, err := r.client.Strategy.Get(r.getContext(), id)
@@ -91,12 +186,28 @@ func (r *EntRepository) Update(id uuid.UUID, input dto.UpdateStrategyBody) (*ent
 	if input.Symbol != nil {
 		updateQuery.SetSymbol(*input.Symbol)
 	}
+	if input.Exchange != nil {
+		updateQuery.SetExchange(*input.Exchange)
+	}
 	if input.Description != nil {
 		updateQuery.SetDescription(*input.Description)
 	}
 	if input.Active != nil {
 		updateQuery.SetActive(*input.Active)
 	}
+	if input.TradingMode != nil {
+		updateQuery.SetTradingMode(strategy.TradingMode(*input.TradingMode))
+	}
+	if input.UserInputs != nil {
+		updateQuery.SetUserInputs(input.UserInputs)
+	}
+	if input.Settings != nil || input.Rules != nil {
+		current, err := r.client.Strategy.Get(r.getContext(), id)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get strategy settings: %w", err)
+		}
+		updateQuery.SetSettings(mergeSettings(current.Settings, input.Settings, input.Rules))
+	}
 
 	strategy, err := updateQuery.Save(r.getContext())
 	if err != nil {
@@ -234,3 +345,174 @@ func (r *EntRepository) CountBySymbol(symbol string) (int, error) {
 	}
 	return count, nil
 }
+
+// GetStatus 전략 런타임 상태 조회
+func (r *EntRepository) GetStatus(strategyID uuid.UUID) (*ent.StrategyStatus, error) {
+	status, err := r.client.StrategyStatus.Query().
+		Where(strategystatus.StrategyID(strategyID)).
+		Only(r.getContext())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get strategy status: %w", err)
+	}
+	return status, nil
+}
+
+// SaveStatus 전략 상태 전환 기록 (행이 없으면 생성, 가동 시간은 누적)
+func (r *EntRepository) SaveStatus(strategyID uuid.UUID, status strategystatus.Status, errorMessage *string, uptimeDelta int64) error {
+	ctx := r.getContext()
+
+	update := r.client.StrategyStatus.Update().
+		Where(strategystatus.StrategyID(strategyID)).
+		SetStatus(status).
+		AddUptimeSeconds(uptimeDelta)
+	if errorMessage != nil {
+		update.SetErrorMessage(*errorMessage)
+	} else {
+		update.ClearErrorMessage()
+	}
+
+	updated, err := update.Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update strategy status: %w", err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	if err := r.client.StrategyStatus.Create().
+		SetStrategyID(strategyID).
+		SetStatus(status).
+		SetNillableErrorMessage(errorMessage).
+		SetUptimeSeconds(uptimeDelta).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create strategy status: %w", err)
+	}
+	return nil
+}
+
+// RecordExecution 액션 실행 기록 저장 및 상태 행의 실행 횟수/마지막 실행 시각 갱신
+func (r *EntRepository) RecordExecution(execution Execution) error {
+	strategyID, err := uuid.Parse(execution.StrategyID)
+	if err != nil {
+		return fmt.Errorf("invalid strategy id: %w", err)
+	}
+
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	if err := tx.StrategyExecution.Create().
+		SetStrategyID(strategyID).
+		SetSymbol(execution.Symbol).
+		SetAction(strategyexecution.Action(execution.Action)).
+		SetNillablePrice(execution.Price).
+		SetNillableQuantity(execution.Quantity).
+		SetReasoning(execution.Reasoning).
+		SetExecutedAt(execution.ExecutedAt).
+		Exec(ctx); err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to create strategy execution: %w", err)
+	}
+
+	updated, err := tx.StrategyStatus.Update().
+		Where(strategystatus.StrategyID(strategyID)).
+		AddExecutionCount(1).
+		SetLastExecution(execution.ExecutedAt).
+		Save(ctx)
+	if err != nil {
+		_ = tx.Rollback()
+		return fmt.Errorf("failed to update strategy status: %w", err)
+	}
+	if updated == 0 {
+		if err := tx.StrategyStatus.Create().
+			SetStrategyID(strategyID).
+			SetStatus(strategystatus.StatusActive).
+			SetExecutionCount(1).
+			SetLastExecution(execution.ExecutedAt).
+			Exec(ctx); err != nil {
+			_ = tx.Rollback()
+			return fmt.Errorf("failed to create strategy status: %w", err)
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("failed to commit strategy execution: %w", err)
+	}
+	return nil
+}
+
+// GetPerformance 전략 성과 조회
+func (r *EntRepository) GetPerformance(strategyID uuid.UUID) (*ent.StrategyPerformance, error) {
+	performance, err := r.client.StrategyPerformance.Query().
+		Where(strategyperformance.StrategyID(strategyID)).
+		Only(r.getContext())
+
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get strategy performance: %w", err)
+	}
+	return performance, nil
+}
+
+// SavePerformance 전략 성과 저장 (행이 없으면 생성)
+func (r *EntRepository) SavePerformance(strategyID uuid.UUID, state *PerformanceState) error {
+	ctx := r.getContext()
+
+	totalReturn := decimal.NewFromFloat(state.TotalReturn()).Round(4)
+	winRate := decimal.NewFromFloat(state.WinRate()).Round(2)
+	maxDrawdown := decimal.NewFromFloat(state.MaxDrawdown).Round(4)
+	sharpeRatio := decimal.NewFromFloat(state.SharpeRatio()).Round(4)
+
+	updated, err := r.client.StrategyPerformance.Update().
+		Where(strategyperformance.StrategyID(strategyID)).
+		SetTotalReturn(totalReturn).
+		SetWinRate(winRate).
+		SetProfitLoss(state.ProfitLoss.Round(4)).
+		SetTradeCount(state.TradeCount).
+		SetNillableLastTradeTime(state.LastTradeTime).
+		SetMaxDrawdown(maxDrawdown).
+		SetSharpeRatio(sharpeRatio).
+		SetClosedTrades(state.ClosedTrades).
+		SetWinningTrades(state.WinningTrades).
+		SetReturnSum(state.ReturnSum).
+		SetReturnSqSum(state.ReturnSqSum).
+		SetEquityIndex(state.EquityIndex).
+		SetPeakEquityIndex(state.PeakEquityIndex).
+		SetOpenPositions(state.OpenPositions).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to update strategy performance: %w", err)
+	}
+	if updated > 0 {
+		return nil
+	}
+
+	if err := r.client.StrategyPerformance.Create().
+		SetStrategyID(strategyID).
+		SetTotalReturn(totalReturn).
+		SetWinRate(winRate).
+		SetProfitLoss(state.ProfitLoss.Round(4)).
+		SetTradeCount(state.TradeCount).
+		SetNillableLastTradeTime(state.LastTradeTime).
+		SetMaxDrawdown(maxDrawdown).
+		SetSharpeRatio(sharpeRatio).
+		SetClosedTrades(state.ClosedTrades).
+		SetWinningTrades(state.WinningTrades).
+		SetReturnSum(state.ReturnSum).
+		SetReturnSqSum(state.ReturnSqSum).
+		SetEquityIndex(state.EquityIndex).
+		SetPeakEquityIndex(state.PeakEquityIndex).
+		SetOpenPositions(state.OpenPositions).
+		Exec(ctx); err != nil {
+		return fmt.Errorf("failed to create strategy performance: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,360 @@
+package strategy
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"auto-trader/pkg/shared/utils"
+)
+
+// RuleSetVersion 현재 지원하는 규칙 스키마 버전
+const RuleSetVersion = 1
+
+// RuleSet 버전이 지정된 전략 규칙 묶음 (settings.rules에 저장)
+//
+//	{
+//	  "version": 1,
+//	  "rules": [{
+//	    "name": "과매도 + 장기 추세 위",
+//	    "when": {"all": [
+//	      {"left": {"type": "indicator", "name": "rsi", "params": {"period": 14}}, "op": "<", "right": {"type": "const", "value": 30}},
+//	      {"left": {"type": "price", "field": "last"}, "op": ">", "right": {"type": "indicator", "name": "sma", "params": {"period": 200}}}
+//	    ]},
+//	    "action": {"type": "BUY", "quantity": 1000, "price": "MARKET"}
+//	  }]
+//	}
+type RuleSet struct {
+	Version int     `json:"version"`
+	Rules   []*Rule `json:"rules"`
+}
+
+// Rule 조건 트리가 참일 때 실행할 액션
+type Rule struct {
+	Name   string     `json:"name,omitempty"`
+	When   *RuleNode  `json:"when"`
+	Action RuleAction `json:"action"`
+}
+
+// RuleNode 조건 트리 노드 (all/any/not 그룹 또는 left op right 비교 중 하나)
+type RuleNode struct {
+	All []*RuleNode `json:"all,omitempty"`
+	Any []*RuleNode `json:"any,omitempty"`
+	Not *RuleNode   `json:"not,omitempty"`
+
+	Left  *Operand `json:"left,omitempty"`
+	Op    string   `json:"op,omitempty"`
+	Right *Operand `json:"right,omitempty"`
+
+	key string // 교차 연산자의 직전 값 추적 키 (검증 시 경로로 부여)
+}
+
+// Operand 비교 피연산자
+type Operand struct {
+	Type   string             `json:"type"`             // indicator, price, position, const
+	Name   string             `json:"name,omitempty"`   // 지표 이름 (indicator)
+	Params map[string]float64 `json:"params,omitempty"` // 지표 파라미터 (indicator)
+	Field  string             `json:"field,omitempty"`  // 지표 출력값/가격/포지션 항목
+	Value  *float64           `json:"value,omitempty"`  // 상수 (const)
+}
+
+// RuleAction 규칙 충족 시 액션
+type RuleAction struct {
+	Type     string      `json:"type"`            // BUY, SELL, HOLD
+	Quantity interface{} `json:"quantity"`        // 금액(숫자), "ALL", "50%"
+	Price    interface{} `json:"price,omitempty"` // "MARKET" 또는 지정가(숫자)
+}
+
+// 피연산자 유형
+const (
+	OperandIndicator = "indicator"
+	OperandPrice     = "price"
+	OperandPosition  = "position"
+	OperandConst     = "const"
+)
+
+// 교차 연산자 (직전 평가 대비 left-right 부호 변화)
+const (
+	OpCrossAbove = "cross_above"
+	OpCrossBelow = "cross_below"
+)
+
+// comparisonOps 지원하는 비교 연산자
+var comparisonOps = map[string]bool{
+	">": true, ">=": true, "<": true, "<=": true, "==": true, "!=": true,
+	OpCrossAbove: true, OpCrossBelow: true,
+}
+
+// indicatorSpec 지표별 허용 파라미터(기본값)와 출력 항목 (첫 항목이 기본 출력)
+type indicatorSpec struct {
+	params map[string]float64
+	fields []string
+}
+
+var indicatorSpecs = map[string]indicatorSpec{
+	"sma":        {params: map[string]float64{"period": 20}, fields: []string{"value"}},
+	"ema":        {params: map[string]float64{"period": 20}, fields: []string{"value"}},
+	"rsi":        {params: map[string]float64{"period": 14}, fields: []string{"value"}},
+	"macd":       {params: map[string]float64{"fast": 12, "slow": 26, "signal": 9}, fields: []string{"macd", "signal", "histogram"}},
+	"bollinger":  {params: map[string]float64{"period": 20, "stddev": 2}, fields: []string{"middle", "upper", "lower"}},
+	"atr":        {params: map[string]float64{"period": 14}, fields: []string{"value"}},
+	"stochastic": {params: map[string]float64{"k_period": 14, "d_period": 3}, fields: []string{"k", "d"}},
+	"vwap":       {params: map[string]float64{}, fields: []string{"value"}},
+	"obv":        {params: map[string]float64{}, fields: []string{"value"}},
+}
+
+// priceFields 가격 피연산자 항목 (last: 현재가, daily_change_pct: 전일 종가 대비 등락률)
+var priceFields = []string{"last", "open", "high", "low", "volume", "daily_change_pct"}
+
+// positionFields 포지션 피연산자 항목 (전략 체결 기준, 미보유 시 0)
+var positionFields = []string{"quantity", "avg_cost", "unrealized_pct"}
+
+// ParseRuleSet 저장/요청된 규칙 JSON을 해석하고 검증 (알 수 없는 필드도 오류)
+func ParseRuleSet(raw interface{}) (*RuleSet, error) {
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, utils.BadRequest(fmt.Sprintf("규칙 형식 오류: %v", err))
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.DisallowUnknownFields()
+
+	var ruleSet RuleSet
+	if err := decoder.Decode(&ruleSet); err != nil {
+		return nil, utils.BadRequest(fmt.Sprintf("규칙 형식 오류: %v", err))
+	}
+	if err := ruleSet.Validate(); err != nil {
+		return nil, err
+	}
+	return &ruleSet, nil
+}
+
+// Validate 규칙 구조/연산자/피연산자 검증 (교차 연산자 추적 키도 함께 부여)
+func (rs *RuleSet) Validate() error {
+	if rs.Version != RuleSetVersion {
+		return ruleError("version", fmt.Sprintf("지원하지 않는 규칙 버전입니다: %d (지원: %d)", rs.Version, RuleSetVersion))
+	}
+	if len(rs.Rules) == 0 {
+		return ruleError("rules", "규칙이 최소 1개 필요합니다")
+	}
+
+	for i, rule := range rs.Rules {
+		path := fmt.Sprintf("rules[%d]", i)
+		if rule == nil {
+			return ruleError(path, "빈 규칙입니다")
+		}
+		if rule.When == nil {
+			return ruleError(path+".when", "조건이 필요합니다")
+		}
+		if err := rule.When.validate(path + ".when"); err != nil {
+			return err
+		}
+		if err := rule.Action.validate(path + ".action"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (n *RuleNode) validate(path string) error {
+	n.key = path
+
+	kinds := 0
+	if n.All != nil {
+		kinds++
+	}
+	if n.Any != nil {
+		kinds++
+	}
+	if n.Not != nil {
+		kinds++
+	}
+	isCompare := n.Left != nil || n.Op != "" || n.Right != nil
+	if isCompare {
+		kinds++
+	}
+	if kinds != 1 {
+		return ruleError(path, "all, any, not, 비교(left/op/right) 중 정확히 하나만 지정해야 합니다")
+	}
+
+	switch {
+	case n.All != nil:
+		return validateGroup(path+".all", n.All)
+	case n.Any != nil:
+		return validateGroup(path+".any", n.Any)
+	case n.Not != nil:
+		return n.Not.validate(path + ".not")
+	}
+
+	if !comparisonOps[n.Op] {
+		return ruleError(path+".op", fmt.Sprintf("지원하지 않는 연산자: %q", n.Op))
+	}
+	if n.Left == nil || n.Right == nil {
+		return ruleError(path, "비교에는 left와 right가 모두 필요합니다")
+	}
+	if err := n.Left.validate(path + ".left"); err != nil {
+		return err
+	}
+	if err := n.Right.validate(path + ".right"); err != nil {
+		return err
+	}
+	if n.Left.Type == OperandConst && n.Right.Type == OperandConst {
+		return ruleError(path, "상수끼리는 비교할 수 없습니다")
+	}
+	return nil
+}
+
+func validateGroup(path string, nodes []*RuleNode) error {
+	if len(nodes) == 0 {
+		return ruleError(path, "그룹에 조건이 최소 1개 필요합니다")
+	}
+	for i, node := range nodes {
+		childPath := fmt.Sprintf("%s[%d]", path, i)
+		if node == nil {
+			return ruleError(childPath, "빈 조건입니다")
+		}
+		if err := node.validate(childPath); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (o *Operand) validate(path string) error {
+	switch o.Type {
+	case OperandConst:
+		if o.Value == nil {
+			return ruleError(path+".value", "상수 값이 필요합니다")
+		}
+		if o.Name != "" || o.Field != "" || len(o.Params) > 0 {
+			return ruleError(path, "상수에는 value만 지정할 수 있습니다")
+		}
+	case OperandPrice:
+		if !contains(priceFields, o.Field) {
+			return ruleError(path+".field", fmt.Sprintf("지원하지 않는 가격 항목: %q (%s)", o.Field, strings.Join(priceFields, ", ")))
+		}
+	case OperandPosition:
+		if !contains(positionFields, o.Field) {
+			return ruleError(path+".field", fmt.Sprintf("지원하지 않는 포지션 항목: %q (%s)", o.Field, strings.Join(positionFields, ", ")))
+		}
+	case OperandIndicator:
+		spec, ok := indicatorSpecs[o.Name]
+		if !ok {
+			return ruleError(path+".name", fmt.Sprintf("지원하지 않는 지표: %q", o.Name))
+		}
+		for key, value := range o.Params {
+			if _, ok := spec.params[key]; !ok {
+				return ruleError(path+".params."+key, fmt.Sprintf("%s 지표에 없는 파라미터입니다", o.Name))
+			}
+			if value <= 0 {
+				return ruleError(path+".params."+key, "파라미터는 0보다 커야 합니다")
+			}
+		}
+		if o.Field != "" && !contains(spec.fields, o.Field) {
+			return ruleError(path+".field", fmt.Sprintf("%s 지표 출력 항목이 아닙니다: %q (%s)", o.Name, o.Field, strings.Join(spec.fields, ", ")))
+		}
+	default:
+		return ruleError(path+".type", fmt.Sprintf("지원하지 않는 피연산자 유형: %q (indicator, price, position, const)", o.Type))
+	}
+	return nil
+}
+
+func (a *RuleAction) validate(path string) error {
+	switch a.Type {
+	case "BUY", "SELL", "HOLD":
+	default:
+		return ruleError(path+".type", fmt.Sprintf("지원하지 않는 액션: %q (BUY, SELL, HOLD)", a.Type))
+	}
+	if a.Type == "HOLD" {
+		return nil
+	}
+
+	switch q := a.Quantity.(type) {
+	case float64:
+		if q <= 0 {
+			return ruleError(path+".quantity", "주문 금액은 0보다 커야 합니다")
+		}
+	case string:
+		if q != "ALL" && !strings.HasSuffix(q, "%") {
+			return ruleError(path+".quantity", fmt.Sprintf("지원하지 않는 수량 형식: %q (금액, ALL, 50%%)", q))
+		}
+	default:
+		return ruleError(path+".quantity", "수량이 필요합니다")
+	}
+
+	switch p := a.Price.(type) {
+	case nil:
+	case float64:
+		if p <= 0 {
+			return ruleError(path+".price", "지정가는 0보다 커야 합니다")
+		}
+	case string:
+		if p != "MARKET" {
+			return ruleError(path+".price", fmt.Sprintf("지원하지 않는 가격 형식: %q (MARKET 또는 숫자)", p))
+		}
+	default:
+		return ruleError(path+".price", "가격은 MARKET 또는 숫자여야 합니다")
+	}
+	return nil
+}
+
+// param 지표 파라미터 (미지정 시 기본값)
+func (o *Operand) param(key string) float64 {
+	if v, ok := o.Params[key]; ok {
+		return v
+	}
+	return indicatorSpecs[o.Name].params[key]
+}
+
+// output 지표 출력 항목 (미지정 시 기본 출력)
+func (o *Operand) output() string {
+	if o.Field != "" {
+		return o.Field
+	}
+	return indicatorSpecs[o.Name].fields[0]
+}
+
+// String 실행 근거 기록용 표현
+func (o *Operand) String() string {
+	switch o.Type {
+	case OperandConst:
+		return fmt.Sprintf("%g", *o.Value)
+	case OperandIndicator:
+		return fmt.Sprintf("%s(%s).%s", o.Name, formatParams(o), o.output())
+	default:
+		return o.Type + "." + o.Field
+	}
+}
+
+func formatParams(o *Operand) string {
+	spec := indicatorSpecs[o.Name]
+	parts := make([]string, 0, len(spec.params))
+	for _, key := range sortedKeys(spec.params) {
+		parts = append(parts, fmt.Sprintf("%s=%g", key, o.param(key)))
+	}
+	return strings.Join(parts, ",")
+}
+
+func ruleError(path, message string) error {
+	return utils.BadRequest(fmt.Sprintf("잘못된 전략 규칙 (%s): %s", path, message))
+}
+
+func contains(values []string, target string) bool {
+	for _, v := range values {
+		if v == target {
+			return true
+		}
+	}
+	return false
+}
+
+func sortedKeys(m map[string]float64) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -0,0 +1,167 @@
+package strategy
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TickSource 종목 체결 수신 알림 구독 (tick 트리거용, 반환 함수로 해제)
+type TickSource interface {
+	SubscribeTicks(symbols []string) (<-chan string, func())
+}
+
+// ContextExecutor 제한 시간/취소를 전달받아 실행할 수 있는 전략
+type ContextExecutor interface {
+	ExecuteContext(ctx context.Context) error
+}
+
+// runner 전략 1개의 트리거 루프 (한 번에 하나의 실행만 허용)
+type runner struct {
+	id       string
+	strategy Strategy
+	trigger  *Trigger
+	ticks    TickSource
+	report   func(id string, err error)
+
+	busy     atomic.Bool
+	lastRun  time.Time // tick 트리거 최소 간격 확인용 (루프 고루틴에서만 접근)
+	stopChan chan struct{}
+	stopOnce sync.Once
+}
+
+func newRunner(id string, strategy Strategy, trigger *Trigger, ticks TickSource, report func(string, error)) *runner {
+	return &runner{
+		id:       id,
+		strategy: strategy,
+		trigger:  trigger,
+		ticks:    ticks,
+		report:   report,
+		stopChan: make(chan struct{}),
+	}
+}
+
+// start 트리거 루프 시작
+func (r *runner) start() {
+	if r.trigger.Type == TriggerTick {
+		go r.tickLoop()
+		return
+	}
+	go r.timerLoop()
+}
+
+// stop 트리거 루프 중지 (진행 중인 실행은 제한 시간 안에서 끝까지 진행)
+func (r *runner) stop() {
+	r.stopOnce.Do(func() {
+		close(r.stopChan)
+	})
+}
+
+// timerLoop interval/cron/bar_close 트리거
+func (r *runner) timerLoop() {
+	next := r.trigger.Next(time.Now())
+	for {
+		if next.IsZero() {
+			logrus.Warnf("⚠️  다음 실행 시각이 없어 전략 트리거를 종료합니다 (%s): %s", r.id, r.trigger)
+			return
+		}
+
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			r.fire()
+			// 실행 지연으로 지나간 시각은 건너뜀
+			next = r.trigger.Next(maxTime(next, time.Now()))
+		case <-r.stopChan:
+			timer.Stop()
+			return
+		}
+	}
+}
+
+// tickLoop tick 트리거 (체결 수신마다 실행, 최소 간격 이내 수신은 무시)
+func (r *runner) tickLoop() {
+	if r.ticks == nil {
+		logrus.Warnf("⚠️  체결 구독을 지원하지 않는 수집기라 tick 트리거를 사용할 수 없습니다 (%s)", r.id)
+		r.report(r.id, fmt.Errorf("tick 트리거를 사용할 수 없습니다: 체결 구독 미지원"))
+		return
+	}
+
+	ticks, unsubscribe := r.ticks.SubscribeTicks(r.strategy.Symbols())
+	defer unsubscribe()
+
+	for {
+		select {
+		case _, ok := <-ticks:
+			if !ok {
+				return
+			}
+			if now := time.Now(); now.Sub(r.lastRun) >= r.trigger.MinGap {
+				r.lastRun = now
+				r.fire()
+			}
+		case <-r.stopChan:
+			return
+		}
+	}
+}
+
+// fire 실행 중이 아니면 지터 후 제한 시간 안에서 전략 실행
+func (r *runner) fire() {
+	if !r.busy.CompareAndSwap(false, true) {
+		logrus.Debugf("이전 실행이 진행 중이라 건너뜀 (%s)", r.id)
+		return
+	}
+
+	go func() {
+		if r.trigger.Jitter > 0 {
+			delay := time.Duration(rand.Int63n(int64(r.trigger.Jitter)))
+			select {
+			case <-time.After(delay):
+			case <-r.stopChan:
+				r.busy.Store(false)
+				return
+			}
+		}
+
+		ctx, cancel := context.WithTimeout(context.Background(), r.trigger.Timeout)
+		defer cancel()
+
+		// 실행 고루틴이 끝나야 다음 실행을 허용 (제한 시간 초과 후에도 겹치지 않음)
+		done := make(chan error, 1)
+		go func() {
+			defer r.busy.Store(false)
+			done <- r.execute(ctx)
+		}()
+
+		var err error
+		select {
+		case err = <-done:
+		case <-ctx.Done():
+			err = fmt.Errorf("실행 제한 시간 초과 (%s)", r.trigger.Timeout)
+		}
+		if err != nil {
+			logrus.Errorf("❌ 전략 실행 오류 (%s): %v", r.id, err)
+		}
+		r.report(r.id, err)
+	}()
+}
+
+func (r *runner) execute(ctx context.Context) error {
+	if executor, ok := r.strategy.(ContextExecutor); ok {
+		return executor.ExecuteContext(ctx)
+	}
+	return r.strategy.Execute()
+}
+
+func maxTime(a, b time.Time) time.Time {
+	if a.After(b) {
+		return a
+	}
+	return b
+}
@@ -1,21 +1,29 @@
 package strategy
 
 import (
+	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"time"
 
 	"auto-trader/ent"
+	entstrategy "auto-trader/ent/strategy"
+	"auto-trader/ent/strategystatus"
+	"auto-trader/pkg/domain/order"
 	"auto-trader/pkg/domain/strategy/dto"
+	"auto-trader/pkg/shared/calendar"
 	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/indicator"
 	"auto-trader/pkg/shared/middleware"
+	"auto-trader/pkg/shared/utils"
 
 	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 )
 
-// 임시 인터페이스들 (data, order 도메인이 완성되면 제거)
+// 임시 인터페이스 (data 도메인이 완성되면 제거)
 type Collector interface {
 	StartPriceStream(symbols []string)
 	Stop()
@@ -23,29 +31,54 @@ type Collector interface {
 	GetDailyProfit(symbol string) (decimal.Decimal, error)
 }
 
+// Executor 주문 실행기 인터페이스 (제출 결과와 이후 체결 상태를 order.Update로 보고)
 type Executor interface {
-	// TODO: order 도메인 완성 시 실제 메서드 정의
-	ExecuteOrder(symbol, action string, quantity, price decimal.Decimal, orderType string) (string, error)
+	ExecuteOrder(ctx context.Context, req *order.Request) (*order.Update, error)
+	CancelOrder(ctx context.Context, clientOrderID string) error
+	OnOrderUpdate(handler order.UpdateHandler)
+}
+
+// HistoryProvider 과거 봉 조회 인터페이스 (지표 워밍업용)
+type HistoryProvider interface {
+	GetRecentCandles(symbol string, interval time.Duration, count int) ([]indicator.Candle, error)
+}
+
+// SymbolValidator 종목 마스터 기반 종목 검증 (거래 가능한 상장 거래소 반환)
+type SymbolValidator interface {
+	ResolveListing(ticker, exchange string) (string, error)
+}
+
+// Replayer 과거 봉을 직접 주입받아 평가하는 전략 (백테스트용)
+type Replayer interface {
+	Strategy
+	ReplayCandle(symbol string, c indicator.Candle) error
+	ApplyFill(update order.Update)
+	PreloadCandles(symbol string, candles []indicator.Candle)
 }
 
 // PriceData 가격 데이터 구조체
 type PriceData struct {
-	Price decimal.Decimal
+	Price     decimal.Decimal
+	Volume    decimal.Decimal // 직전 체결량 (알 수 없으면 0)
+	Timestamp time.Time       // 체결 시각
 }
 
 // Service 전략 서비스 인터페이스
 type Service interface {
 	// 기존 CRUD 메서드들
-	GetAllStrategies() ([]*StrategyDetails, error)
+	GetAllStrategies(userID string) ([]*StrategyDetails, error)
+	CheckOwner(userID, id string) error
 	GetStrategy(id string) (*StrategyDetails, error)
 	GetStrategyStatus(id string) (*StrategyStatus, error)
 	StartStrategy(id string) error
 	StopStrategy(id string) error
+	StopUserStrategies(userID uuid.UUID) (int, error)
 	RestartStrategy(id string) error
 	CreateStrategy(req *dto.CreateStrategyBody) (*StrategyDetails, error)
 	UpdateStrategy(id string, req *dto.UpdateStrategyBody) (*StrategyDetails, error)
 	DeleteStrategy(id string) error
 	GetStrategyPerformance(id string) (*StrategyPerformance, error)
+	GetStrategyConfig(id string) (*dto.StrategyConfigResponse, error)
 
 	// Manager에서 이전한 메서드들
 	Start() error
@@ -60,36 +93,197 @@ type ServiceImpl struct {
 
 	// 외부 의존성들
 	dataCollector Collector
+	history       HistoryProvider
+	symbols       SymbolValidator
 	executor      Executor
+	paperExecutor Executor // 모의투자(PAPER) 전략용 실행기
 	riskManager   *middleware.Manager
 	config        *config.Config
 
-	// 메모리 상태 관리 (런타임 전략 인스턴스)
+	// 메모리 상태 관리 (런타임 전략 인스턴스와 트리거 루프)
 	strategies       map[string]Strategy
 	activeStrategies map[string]bool
+	runners          map[string]*runner
 	mutex            sync.RWMutex
 	stopChan         chan struct{}
 	isRunning        bool
+
+	// 상태 전환 기록용 (가동 시작 시각, 오류 상태 여부)
+	startedAt   map[string]time.Time
+	failing     map[string]bool
+	statusMutex sync.Mutex
+
+	// 체결별 성과 갱신 직렬화
+	performanceMutex sync.Mutex
 }
 
 // NewService 새로운 전략 서비스 생성
 func NewService(
 	repository Repository,
 	dataCollector Collector,
+	history HistoryProvider,
+	symbols SymbolValidator,
 	executor Executor,
+	paperExecutor Executor,
 	riskManager *middleware.Manager,
 	config *config.Config,
 ) Service {
-	return &ServiceImpl{
+	service := &ServiceImpl{
 		repository:       repository,
 		dataCollector:    dataCollector,
+		history:          history,
+		symbols:          symbols,
 		executor:         executor,
+		paperExecutor:    paperExecutor,
 		riskManager:      riskManager,
 		config:           config,
 		strategies:       make(map[string]Strategy),
 		activeStrategies: make(map[string]bool),
+		runners:          make(map[string]*runner),
 		stopChan:         make(chan struct{}),
 		isRunning:        false,
+		startedAt:        make(map[string]time.Time),
+		failing:          make(map[string]bool),
+	}
+
+	// 주문 상태 변경 수신
+	if executor != nil {
+		executor.OnOrderUpdate(service.handleOrderUpdate)
+	}
+	if paperExecutor != nil {
+		paperExecutor.OnOrderUpdate(service.handleOrderUpdate)
+	}
+
+	return service
+}
+
+// handleOrderUpdate 실행기로부터 받은 주문 상태 변경 처리
+func (s *ServiceImpl) handleOrderUpdate(update order.Update) {
+	switch update.Status {
+	case order.StatusRejected:
+		logrus.Warnf("⚠️  주문 거부 (전략: %s): %s %s %s - %s",
+			update.StrategyID, update.Side, update.Quantity.String(), update.Symbol, update.RejectReason)
+	case order.StatusPartiallyFilled, order.StatusFilled:
+		logrus.Infof("✅ 주문 체결 (전략: %s): %s %s %s @ %s (%s/%s, %s)",
+			update.StrategyID, update.Side, update.LastFillQuantity.String(), update.Symbol, update.LastFillPrice.String(),
+			update.FilledQuantity.String(), update.Quantity.String(), update.Status)
+		s.recordFill(update)
+		s.routeFill(update)
+	default:
+		logrus.Debugf("주문 상태 변경 (전략: %s): %s %s → %s",
+			update.StrategyID, update.Symbol, update.ClientOrderID, update.Status)
+	}
+}
+
+// recordFill 체결을 전략 성과에 증분 반영
+func (s *ServiceImpl) recordFill(update order.Update) {
+	strategyID, err := uuid.Parse(update.StrategyID)
+	if err != nil {
+		return
+	}
+
+	s.performanceMutex.Lock()
+	defer s.performanceMutex.Unlock()
+
+	row, err := s.repository.GetPerformance(strategyID)
+	if err != nil {
+		logrus.Errorf("❌ 전략 성과 조회 실패 (%s): %v", update.StrategyID, err)
+		return
+	}
+
+	state := newPerformanceState(row)
+	state.ApplyFill(update.Symbol, update.Side, update.LastFillQuantity, update.LastFillPrice, update.Timestamp)
+	if err := s.repository.SavePerformance(strategyID, state); err != nil {
+		logrus.Errorf("❌ 전략 성과 저장 실패 (%s): %v", update.StrategyID, err)
+	}
+}
+
+// routeFill 체결을 해당 전략 인스턴스의 보유 수량에 반영 (position 피연산자)
+func (s *ServiceImpl) routeFill(update order.Update) {
+	s.mutex.RLock()
+	instance, ok := s.strategies[update.StrategyID]
+	s.mutex.RUnlock()
+
+	if dynamic, isDynamic := instance.(*DynamicStrategy); ok && isDynamic {
+		dynamic.ApplyFill(update)
+	}
+}
+
+// seedPositions 저장된 성과의 미청산 보유 수량으로 전략 포지션 복원
+func (s *ServiceImpl) seedPositions(strategy *ent.Strategy, dynamic *DynamicStrategy) {
+	row, err := s.repository.GetPerformance(strategy.ID)
+	if err != nil {
+		logrus.Warnf("⚠️  전략 보유 수량 복원 실패 (%s): %v", strategy.ID, err)
+		return
+	}
+	if row == nil {
+		return
+	}
+	for symbol, pos := range row.OpenPositions {
+		dynamic.SeedPosition(symbol, pos.Quantity, pos.AvgCost)
+	}
+}
+
+// handleExecution 전략 액션 실행 기록 저장
+func (s *ServiceImpl) handleExecution(execution Execution) {
+	if err := s.repository.RecordExecution(execution); err != nil {
+		logrus.Errorf("❌ 전략 실행 기록 저장 실패 (%s): %v", execution.StrategyID, err)
+	}
+}
+
+// markActive 전략 가동 시작 기록
+func (s *ServiceImpl) markActive(id string) {
+	s.statusMutex.Lock()
+	if _, running := s.startedAt[id]; !running {
+		s.startedAt[id] = time.Now()
+	}
+	delete(s.failing, id)
+	s.statusMutex.Unlock()
+
+	s.saveStatus(id, strategystatus.StatusActive, nil, 0)
+}
+
+// markInactive 전략 중지 기록 (가동 시간 누적)
+func (s *ServiceImpl) markInactive(id string) {
+	s.statusMutex.Lock()
+	var uptime int64
+	if startedAt, running := s.startedAt[id]; running {
+		uptime = int64(time.Since(startedAt).Seconds())
+		delete(s.startedAt, id)
+	}
+	delete(s.failing, id)
+	s.statusMutex.Unlock()
+
+	s.saveStatus(id, strategystatus.StatusInactive, nil, uptime)
+}
+
+// markExecutionResult 실행 결과에 따라 오류 상태 진입/복구 기록 (상태가 바뀔 때만 저장)
+func (s *ServiceImpl) markExecutionResult(id string, execErr error) {
+	s.statusMutex.Lock()
+	wasFailing := s.failing[id]
+	if execErr != nil {
+		s.failing[id] = true
+	} else {
+		delete(s.failing, id)
+	}
+	s.statusMutex.Unlock()
+
+	switch {
+	case execErr != nil && !wasFailing:
+		message := execErr.Error()
+		s.saveStatus(id, strategystatus.StatusError, &message, 0)
+	case execErr == nil && wasFailing:
+		s.saveStatus(id, strategystatus.StatusActive, nil, 0)
+	}
+}
+
+func (s *ServiceImpl) saveStatus(id string, status strategystatus.Status, errorMessage *string, uptimeDelta int64) {
+	strategyID, err := uuid.Parse(id)
+	if err != nil {
+		return
+	}
+	if err := s.repository.SaveStatus(strategyID, status, errorMessage, uptimeDelta); err != nil {
+		logrus.Errorf("❌ 전략 상태 저장 실패 (%s → %s): %v", id, status, err)
 	}
 }
 
@@ -125,13 +319,15 @@ func (s *ServiceImpl) convertToStrategyDetails(strategy *ent.Strategy) *Strategy
 // Start 전략 서비스 시작
 func (s *ServiceImpl) Start() error {
 	s.mutex.Lock()
-	defer s.mutex.Unlock()
-
 	if s.isRunning {
+		s.mutex.Unlock()
 		return nil
 	}
+	s.isRunning = true
+	s.stopChan = make(chan struct{})
+	s.mutex.Unlock()
 
-	// 기본 전략들 등록
+	// 기본 전략들 등록 (RegisterStrategy가 잠금을 획득하므로 잠금 밖에서 호출)
 	s.registerDefaultStrategies()
 
 	// 가격 스트림 시작
@@ -142,11 +338,6 @@ func (s *ServiceImpl) Start() error {
 		}
 	}
 
-	// 전략 실행 루프 시작
-	s.stopChan = make(chan struct{})
-	go s.strategyLoop()
-
-	s.isRunning = true
 	logrus.Info("🚀 전략 서비스 시작됨")
 	return nil
 }
@@ -166,6 +357,17 @@ func (s *ServiceImpl) Stop() error {
 	}
 
 	s.isRunning = false
+	for id, r := range s.runners {
+		r.stop()
+		delete(s.runners, id)
+	}
+	for id := range s.activeStrategies {
+		if instance, ok := s.strategies[id]; ok {
+			_ = instance.Stop()
+		}
+		delete(s.activeStrategies, id)
+		s.markInactive(id)
+	}
 	logrus.Info("⏹️  전략 서비스 중지됨")
 	return nil
 }
@@ -180,45 +382,15 @@ func (s *ServiceImpl) RegisterStrategy(strategy Strategy) error {
 	return nil
 }
 
-func (s *ServiceImpl) strategyLoop() {
-	ticker := time.NewTicker(5 * time.Second)
-	defer ticker.Stop()
-
-	for {
-		select {
-		case <-ticker.C:
-			s.executeActiveStrategies()
-		case <-s.stopChan:
-			return
-		}
-	}
-}
-
-func (s *ServiceImpl) executeActiveStrategies() {
-	s.mutex.RLock()
-	activeStrategies := make(map[string]Strategy)
-	for id, strategy := range s.strategies {
-		if s.activeStrategies[id] {
-			activeStrategies[id] = strategy
-		}
-	}
-	s.mutex.RUnlock()
-
-	for id, strategy := range activeStrategies {
-		go func(strategyID string, strat Strategy) {
-			if err := strat.Execute(); err != nil {
-				logrus.Errorf("❌ 전략 실행 오류 (%s): %v", strategyID, err)
-			}
-		}(id, strategy)
-	}
-}
-
 func (s *ServiceImpl) getAllSymbols() []string {
 	s.mutex.RLock()
 	defer s.mutex.RUnlock()
 
 	symbolMap := make(map[string]bool)
-	for _, strategy := range s.strategies {
+	for id, strategy := range s.strategies {
+		if !s.activeStrategies[id] {
+			continue
+		}
 		for _, symbol := range strategy.Symbols() {
 			symbolMap[symbol] = true
 		}
@@ -234,13 +406,13 @@ func (s *ServiceImpl) getAllSymbols() []string {
 // registerDefaultStrategies DB에서 활성 전략들을 동적으로 로드
 func (s *ServiceImpl) registerDefaultStrategies() {
 	// 현재는 nil 체크로 안전하게 처리 (향후 의존성 완성 시 활성화)
-	if s.dataCollector == nil || s.executor == nil {
+	if s.dataCollector == nil || (s.executor == nil && s.paperExecutor == nil) {
 		logrus.Warn("⚠️  전략 의존성이 완전하지 않음 - 기본 전략 등록 스킵")
 		return
 	}
 
 	// DB에서 활성 전략들 조회
-	strategies, err := s.repository.GetAll(100, 0) // 적절한 limit, offset 설정
+	strategies, err := s.repository.GetActiveStrategies()
 	if err != nil {
 		logrus.Errorf("❌ 활성 전략 조회 실패: %v", err)
 		return
@@ -249,40 +421,271 @@ func (s *ServiceImpl) registerDefaultStrategies() {
 	// 활성 전략들을 동적 전략으로 등록
 	activeCount := 0
 	for _, strategy := range strategies {
-		if strategy.Active {
-			// 전략 설정 로드 (이 메서드는 Repository에 없으므로 주석 처리)
-			// config, err := s.repository.GetConfig(strategy.ID)
-			// if err != nil {
-			// 	logrus.Errorf("❌ 전략 설정 로드 실패 (%s): %v", strategy.ID, err)
-			// 	continue
-			// }
-
-			// 동적 전략 생성 (임시로 기본값 사용)
-			dynamicStrategy := NewDynamicStrategy(
-				s.dataCollector,
-				s.executor,
-				s.riskManager,
-				s.config,
-				&StrategyConfig{}, // 임시 기본 설정
-			)
-
-			// 전략 등록
-			if err := s.RegisterStrategy(dynamicStrategy); err != nil {
-				logrus.Errorf("❌ 동적 전략 등록 실패 (%s): %v", strategy.ID, err)
-				continue
-			}
-
-			logrus.Infof("✅ 동적 전략 등록: %s (%s)", strategy.Name, strategy.ID)
-			activeCount++
+		if err := s.loadStrategy(strategy); err != nil {
+			logrus.Warnf("⚠️  %v", err)
+			continue
 		}
+		activeCount++
 	}
 
 	logrus.Infof("🎯 총 %d개의 활성 전략이 동적으로 로드되었습니다", activeCount)
 }
 
-// GetAllStrategies 모든 전략 조회 (Repository 활용)
-func (s *ServiceImpl) GetAllStrategies() ([]*StrategyDetails, error) {
-	strategies, err := s.repository.GetAll(100, 0) // 적절한 limit, offset 설정
+// loadStrategy DB 레코드로 동적 전략을 생성해 등록하고 가동 (이미 등록된 인스턴스는 교체)
+func (s *ServiceImpl) loadStrategy(strategy *ent.Strategy) error {
+	if s.dataCollector == nil {
+		return fmt.Errorf("시세 수집기가 없어 전략을 가동할 수 없습니다: %s (%s)", strategy.Name, strategy.ID)
+	}
+
+	// 거래 모드에 맞는 실행기 선택
+	executor := s.executorFor(strategy)
+	if executor == nil {
+		return fmt.Errorf("%s 실행기가 없어 전략을 가동할 수 없습니다: %s (%s)", strategy.TradingMode, strategy.Name, strategy.ID)
+	}
+
+	dynamicStrategy := NewDynamicStrategy(
+		s.dataCollector,
+		s.history,
+		executor,
+		s.riskManager,
+		s.config,
+		BuildStrategyConfig(strategy),
+	).(*DynamicStrategy)
+	trigger, err := dynamicStrategy.Trigger()
+	if err != nil {
+		return fmt.Errorf("전략 실행 트리거 설정 오류 (%s): %w", strategy.ID, err)
+	}
+	dynamicStrategy.OnExecution(s.handleExecution)
+	s.seedPositions(strategy, dynamicStrategy)
+
+	id := dynamicStrategy.ID()
+	ticks, _ := s.dataCollector.(TickSource)
+	next := newRunner(id, dynamicStrategy, trigger, ticks, s.markExecutionResult)
+
+	s.mutex.Lock()
+	previous, replaced := s.strategies[id]
+	previousRunner := s.runners[id]
+	wasActive := s.activeStrategies[id]
+	s.strategies[id] = dynamicStrategy
+	s.activeStrategies[id] = true
+	s.runners[id] = next
+	s.mutex.Unlock()
+
+	// 이전 인스턴스는 교체 후 중지 (실행 중이던 평가는 제한 시간 안에서 마무리)
+	if previousRunner != nil {
+		previousRunner.stop()
+	}
+	if replaced {
+		_ = previous.Stop()
+	}
+	_ = dynamicStrategy.Start()
+	next.start()
+	if !wasActive {
+		s.markActive(id)
+	}
+
+	if replaced {
+		logrus.Infof("🔁 동적 전략 재구성: %s (%s, %s)", strategy.Name, strategy.ID, trigger)
+	} else {
+		logrus.Infof("✅ 동적 전략 등록: %s (%s, %s)", strategy.Name, strategy.ID, trigger)
+	}
+	return nil
+}
+
+// unloadStrategy 등록된 전략 인스턴스를 중지하고 제거 (반환값: 제거 여부)
+func (s *ServiceImpl) unloadStrategy(id string) bool {
+	s.mutex.Lock()
+	instance, exists := s.strategies[id]
+	r := s.runners[id]
+	wasActive := s.activeStrategies[id]
+	delete(s.strategies, id)
+	delete(s.activeStrategies, id)
+	delete(s.runners, id)
+	s.mutex.Unlock()
+
+	if r != nil {
+		r.stop()
+	}
+	if !exists {
+		return false
+	}
+	_ = instance.Stop()
+	if wasActive {
+		s.markInactive(id)
+	}
+	return true
+}
+
+// running 전략 서비스 가동 여부 (가동 전 변경은 Start 시 DB에서 로드)
+func (s *ServiceImpl) running() bool {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	return s.isRunning
+}
+
+// refreshPriceStream 가동 중인 전략 종목으로 시세 구독 갱신
+func (s *ServiceImpl) refreshPriceStream() {
+	if s.dataCollector == nil || !s.running() {
+		return
+	}
+	s.dataCollector.StartPriceStream(s.getAllSymbols())
+}
+
+// syncStrategy 저장된 전략 상태에 맞춰 런타임 인스턴스를 재구성 또는 제거하고 시세 구독 갱신
+func (s *ServiceImpl) syncStrategy(strategy *ent.Strategy) error {
+	if !s.running() {
+		return nil
+	}
+
+	var err error
+	if strategy.Active {
+		err = s.loadStrategy(strategy)
+	} else {
+		s.unloadStrategy(strategy.ID.String())
+	}
+	s.refreshPriceStream()
+	return err
+}
+
+// executorFor 전략 거래 모드(LIVE/PAPER)에 해당하는 주문 실행기
+func (s *ServiceImpl) executorFor(strategy *ent.Strategy) Executor {
+	if strategy.TradingMode == entstrategy.TradingModePAPER {
+		return s.paperExecutor
+	}
+	return s.executor
+}
+
+// validateTradingMode 거래 모드 값 확인 (빈 값은 기본값 LIVE)
+func validateTradingMode(mode string) error {
+	if mode == "" {
+		return nil
+	}
+	if err := entstrategy.TradingModeValidator(entstrategy.TradingMode(mode)); err != nil {
+		return utils.BadRequest(fmt.Sprintf("지원하지 않는 거래 모드: %s (LIVE, PAPER)", mode))
+	}
+	return nil
+}
+
+// legacyConditionTypes settings.conditions에서 지원하는 조건 타입
+var legacyConditionTypes = map[string]bool{
+	"profit_percentage": true, "daily_profit": true, "price_level": true,
+	"rsi": true, "moving_average": true, "bollinger_bands": true, "macd": true,
+	"atr": true, "stochastic": true, "vwap": true, "obv": true,
+}
+
+// validateSettings 전략 실행 설정 검증
+func validateSettings(settings *dto.StrategySettingsBody) error {
+	if settings == nil {
+		return nil
+	}
+
+	for i, symbol := range settings.Symbols {
+		if symbol == "" || len(symbol) > 20 {
+			return utils.BadRequest(fmt.Sprintf("잘못된 종목 코드 (settings.symbols[%d]): %q", i, symbol))
+		}
+	}
+	for i, condition := range settings.Conditions {
+		conditionType, _ := condition["type"].(string)
+		if !legacyConditionTypes[conditionType] {
+			return utils.BadRequest(fmt.Sprintf("지원하지 않는 조건 타입 (settings.conditions[%d]): %q", i, conditionType))
+		}
+		switch actionType, _ := condition["action_type"].(string); actionType {
+		case "BUY", "SELL", "HOLD":
+		default:
+			return utils.BadRequest(fmt.Sprintf("지원하지 않는 액션 (settings.conditions[%d].action_type): %q", i, actionType))
+		}
+	}
+	candleInterval := defaultCandleInterval
+	if settings.CandleInterval != "" {
+		interval, err := time.ParseDuration(settings.CandleInterval)
+		if err != nil || interval <= 0 {
+			return utils.BadRequest(fmt.Sprintf("잘못된 봉 주기: %q (예: 1m, 5m, 1h)", settings.CandleInterval))
+		}
+		candleInterval = interval
+	}
+	if settings.Schedule != nil {
+		if _, err := ParseTrigger(scheduleSettings(settings.Schedule), candleInterval); err != nil {
+			return err
+		}
+	}
+	for i, session := range settings.Sessions {
+		if _, err := calendar.ParseSession(session); err != nil {
+			return utils.BadRequest(fmt.Sprintf("잘못된 거래 세션 (settings.sessions[%d]): %v", i, err))
+		}
+	}
+	if settings.Sizing != nil {
+		if settings.Sizing.OrderAmount <= 0 {
+			return utils.BadRequest("주문 금액(sizing.order_amount)은 0보다 커야 합니다")
+		}
+		if settings.Sizing.MaxQuantity < 0 {
+			return utils.BadRequest("최대 수량(sizing.max_quantity)은 0 이상이어야 합니다")
+		}
+	}
+	return nil
+}
+
+// validateSymbols 대표 종목과 settings.symbols가 종목 마스터에 있는지 확인하고 대표 종목의 상장 거래소 반환
+func (s *ServiceImpl) validateSymbols(symbol, exchange string, settings *dto.StrategySettingsBody) (string, error) {
+	if s.symbols == nil {
+		return exchange, nil
+	}
+
+	resolved, err := s.symbols.ResolveListing(symbol, exchange)
+	if err != nil {
+		return "", err
+	}
+	if settings != nil {
+		for i, extra := range settings.Symbols {
+			if _, err := s.symbols.ResolveListing(extra, ""); err != nil {
+				return "", utils.BadRequest(fmt.Sprintf("잘못된 종목 (settings.symbols[%d]): %v", i, err))
+			}
+		}
+	}
+	return resolved, nil
+}
+
+// BuildStrategyConfig DB 전략 레코드로 런타임 전략 설정 생성
+func BuildStrategyConfig(strategy *ent.Strategy) *StrategyConfig {
+	parameters := make(map[string]interface{}, len(strategy.Settings)+4)
+	for key, value := range strategy.Settings {
+		parameters[key] = value
+	}
+	parameters["name"] = strategy.Name
+	parameters["symbols"] = configSymbols(strategy)
+	parameters["user_id"] = strategy.UserID.String()
+	parameters["trading_mode"] = string(strategy.TradingMode)
+
+	return &StrategyConfig{
+		ID:         strategy.ID.String(),
+		Enabled:    strategy.Active,
+		Parameters: parameters,
+	}
+}
+
+// configSymbols 대표 종목과 settings.symbols를 합친 실행 종목 (중복 제거)
+func configSymbols(strategy *ent.Strategy) []interface{} {
+	symbols := []interface{}{strategy.Symbol}
+	seen := map[string]bool{strategy.Symbol: true}
+
+	extra, _ := strategy.Settings["symbols"].([]interface{})
+	for _, raw := range extra {
+		if symbol, ok := raw.(string); ok && symbol != "" && !seen[symbol] {
+			seen[symbol] = true
+			symbols = append(symbols, symbol)
+		}
+	}
+	return symbols
+}
+
+// GetAllStrategies 사용자 전략 목록 조회 (Repository 활용)
+func (s *ServiceImpl) GetAllStrategies(userID string) ([]*StrategyDetails, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	strategies, err := s.repository.GetByUserID(uid, 100, 0) // 적절한 limit, offset 설정
 	if err != nil {
 		return nil, fmt.Errorf("전략 목록 조회 실패: %w", err)
 	}
@@ -302,6 +705,28 @@ func (s *ServiceImpl) GetAllStrategies() ([]*StrategyDetails, error) {
 	return result, nil
 }
 
+// CheckOwner 요청 사용자의 전략인지 확인 (다른 사용자의 전략은 찾을 수 없음으로 처리)
+// 전략 주문은 전략 소유자의 연결 계좌로 제출되므로 전략 API는 모두 소유자만 사용할 수 있다.
+func (s *ServiceImpl) CheckOwner(userID, id string) error {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+	strategyID, err := uuid.Parse(id)
+	if err != nil {
+		return utils.BadRequest("잘못된 전략 ID 형식입니다")
+	}
+
+	strategy, err := s.repository.GetByID(strategyID)
+	if err != nil {
+		return fmt.Errorf("전략 조회 실패: %w", err)
+	}
+	if strategy == nil || strategy.UserID != uid {
+		return utils.NotFound("strategy", "전략을 찾을 수 없습니다")
+	}
+	return nil
+}
+
 // GetStrategy 특정 전략 조회 (Repository 활용)
 func (s *ServiceImpl) GetStrategy(id string) (*StrategyDetails, error) {
 	// UUID 변환
@@ -323,6 +748,36 @@ func (s *ServiceImpl) GetStrategy(id string) (*StrategyDetails, error) {
 	return s.convertToStrategyDetails(strategy), nil
 }
 
+// GetStrategyConfig 전략 실행 설정 조회
+func (s *ServiceImpl) GetStrategyConfig(id string) (*dto.StrategyConfigResponse, error) {
+	strategyID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 전략 ID 형식입니다")
+	}
+
+	strategy, err := s.repository.GetByID(strategyID)
+	if err != nil {
+		return nil, fmt.Errorf("전략 조회 실패: %w", err)
+	}
+	if strategy == nil {
+		return nil, utils.NotFound("strategy", "전략을 찾을 수 없습니다")
+	}
+
+	symbols := make([]string, 0, 1)
+	for _, symbol := range configSymbols(strategy) {
+		symbols = append(symbols, symbol.(string))
+	}
+
+	return &dto.StrategyConfigResponse{
+		ID:          strategy.ID,
+		Symbols:     symbols,
+		TradingMode: string(strategy.TradingMode),
+		TemplateID:  strategy.TemplateID,
+		UserInputs:  strategy.UserInputs,
+		Settings:    strategy.Settings,
+	}, nil
+}
+
 // GetStrategyStatus 전략 상태 조회 (Repository 활용)
 func (s *ServiceImpl) GetStrategyStatus(id string) (*StrategyStatus, error) {
 	// 전략 존재 확인
@@ -331,19 +786,38 @@ func (s *ServiceImpl) GetStrategyStatus(id string) (*StrategyStatus, error) {
 		return nil, fmt.Errorf("전략을 찾을 수 없습니다: %w", err)
 	}
 
-	// 상태 조회 (이 메서드는 Repository에 없으므로 임시 반환)
 	uuid, err := uuid.Parse(id)
 	if err != nil {
 		return nil, fmt.Errorf("잘못된 전략 ID 형식: %w", err)
 	}
 
+	row, err := s.repository.GetStatus(uuid)
+	if err != nil {
+		return nil, fmt.Errorf("전략 상태 조회 실패: %w", err)
+	}
+
+	// 한 번도 가동되지 않은 전략
 	status := &StrategyStatus{
-		ID:             uuid,
-		Status:         "unknown",
-		LastExecution:  time.Now(),
-		ExecutionCount: 0,
-		Uptime:         0,
+		ID:     uuid,
+		Status: string(strategystatus.DefaultStatus),
+	}
+	if row == nil {
+		return status, nil
+	}
+
+	status.Status = string(row.Status)
+	status.ExecutionCount = row.ExecutionCount
+	status.Uptime = row.UptimeSeconds
+	if row.LastExecution != nil {
+		status.LastExecution = *row.LastExecution
+	}
+
+	// 가동 중이면 현재 구간 가동 시간 합산
+	s.statusMutex.Lock()
+	if startedAt, running := s.startedAt[id]; running {
+		status.Uptime += int64(time.Since(startedAt).Seconds())
 	}
+	s.statusMutex.Unlock()
 
 	return status, nil
 }
@@ -367,17 +841,15 @@ func (s *ServiceImpl) StartStrategy(id string) error {
 		Active: &[]bool{true}[0],
 	}
 
-	if _, err := s.repository.Update(uuid, updateInput); err != nil {
+	updated, err := s.repository.Update(uuid, updateInput)
+	if err != nil {
 		return fmt.Errorf("전략 활성화 실패: %w", err)
 	}
 
-	// 메모리 상태 업데이트
-	s.mutex.Lock()
-	if strategyInstance, exists := s.strategies[id]; exists {
-		s.activeStrategies[id] = true
-		_ = strategyInstance.Start()
+	// 저장된 최신 설정으로 런타임 인스턴스 생성 (서비스 가동 전이면 Start 시 로드)
+	if err := s.syncStrategy(updated); err != nil {
+		return fmt.Errorf("전략 가동 실패: %w", err)
 	}
-	s.mutex.Unlock()
 
 	logrus.Infof("▶️  전략 시작: %s (%s)", strategy.Name, id)
 	return nil
@@ -402,22 +874,48 @@ func (s *ServiceImpl) StopStrategy(id string) error {
 		Active: &[]bool{false}[0],
 	}
 
-	if _, err := s.repository.Update(uuid, updateInput); err != nil {
+	updated, err := s.repository.Update(uuid, updateInput)
+	if err != nil {
 		return fmt.Errorf("전략 비활성화 실패: %w", err)
 	}
 
-	// 메모리 상태 업데이트
-	s.mutex.Lock()
-	if strategyInstance, exists := s.strategies[id]; exists {
-		delete(s.activeStrategies, id)
-		_ = strategyInstance.Stop()
+	// 런타임 인스턴스 제거 및 시세 구독 갱신
+	if err := s.syncStrategy(updated); err != nil {
+		return err
 	}
-	s.mutex.Unlock()
 
 	logrus.Infof("⏸️  전략 중지: %s (%s)", strategy.Name, id)
 	return nil
 }
 
+// StopUserStrategies 사용자의 실행 중인 전략 전체 중지 (리스크 거래 중지 시 사용)
+// 한 전략의 중지 실패가 나머지 중지를 막지 않으며, 중지한 전략 수를 반환한다.
+func (s *ServiceImpl) StopUserStrategies(userID uuid.UUID) (int, error) {
+	strategies, err := s.repository.GetActiveStrategies()
+	if err != nil {
+		return 0, fmt.Errorf("활성 전략 조회 실패: %w", err)
+	}
+
+	stopped := 0
+	var failed []string
+	for _, strategy := range strategies {
+		if strategy.UserID != userID {
+			continue
+		}
+		if err := s.StopStrategy(strategy.ID.String()); err != nil {
+			logrus.Errorf("❌ 전략 중지 실패 (%s): %v", strategy.ID, err)
+			failed = append(failed, strategy.ID.String())
+			continue
+		}
+		stopped++
+	}
+
+	if len(failed) > 0 {
+		return stopped, fmt.Errorf("전략 %d개 중지 실패: %s", len(failed), strings.Join(failed, ", "))
+	}
+	return stopped, nil
+}
+
 // RestartStrategy 전략 재시작
 func (s *ServiceImpl) RestartStrategy(id string) error {
 	if err := s.StopStrategy(id); err != nil {
@@ -438,16 +936,30 @@ func (s *ServiceImpl) GetStrategyPerformance(id string) (*StrategyPerformance, e
 		return nil, fmt.Errorf("전략을 찾을 수 없습니다: %w", err)
 	}
 
-	// 성과 조회 (이 메서드는 Repository에 없으므로 임시 반환)
-	performance := &StrategyPerformance{
-		StrategyID:    id,
-		TotalReturn:   0.0,
-		WinRate:       0.0,
-		ProfitLoss:    0.0,
-		TradeCount:    0,
-		LastTradeTime: time.Now(),
-		MaxDrawdown:   0.0,
-		SharpeRatio:   0.0,
+	uuid, err := uuid.Parse(id)
+	if err != nil {
+		return nil, fmt.Errorf("잘못된 전략 ID 형식: %w", err)
+	}
+
+	row, err := s.repository.GetPerformance(uuid)
+	if err != nil {
+		return nil, fmt.Errorf("전략 성과 조회 실패: %w", err)
+	}
+
+	// 체결이 없으면 0으로 채운 성과 반환
+	performance := &StrategyPerformance{StrategyID: id}
+	if row == nil {
+		return performance, nil
+	}
+
+	performance.TotalReturn, _ = row.TotalReturn.Float64()
+	performance.WinRate, _ = row.WinRate.Float64()
+	performance.ProfitLoss, _ = row.ProfitLoss.Float64()
+	performance.TradeCount = row.TradeCount
+	performance.MaxDrawdown, _ = row.MaxDrawdown.Float64()
+	performance.SharpeRatio, _ = row.SharpeRatio.Float64()
+	if row.LastTradeTime != nil {
+		performance.LastTradeTime = *row.LastTradeTime
 	}
 
 	return performance, nil
@@ -455,13 +967,35 @@ func (s *ServiceImpl) GetStrategyPerformance(id string) (*StrategyPerformance, e
 
 // CreateStrategy 새로운 전략 생성 (Repository 활용)
 func (s *ServiceImpl) CreateStrategy(req *dto.CreateStrategyBody) (*StrategyDetails, error) {
+	if err := validateTradingMode(req.TradingMode); err != nil {
+		return nil, err
+	}
+	if req.Rules != nil {
+		if _, err := ParseRuleSet(req.Rules); err != nil {
+			return nil, err
+		}
+	}
+	if err := validateSettings(req.Settings); err != nil {
+		return nil, err
+	}
+	exchange, err := s.validateSymbols(req.Symbol, req.Exchange, req.Settings)
+	if err != nil {
+		return nil, err
+	}
+
 	// 전략 생성
 	createInput := dto.CreateStrategyBody{
 		Name:        req.Name,
 		Description: req.Description,
 		Symbol:      req.Symbol, // req.Symbols 대신 req.Symbol 사용
+		Exchange:    exchange,
 		UserID:      req.UserID, // 임시 사용자 ID 대신 req.UserID 사용
 		Active:      req.Active,
+		TradingMode: req.TradingMode,
+		Rules:       req.Rules,
+		TemplateID:  req.TemplateID,
+		UserInputs:  req.UserInputs,
+		Settings:    req.Settings,
 	}
 
 	// DB에 저장
@@ -471,13 +1005,18 @@ func (s *ServiceImpl) CreateStrategy(req *dto.CreateStrategyBody) (*StrategyDeta
 	}
 
 	logrus.Infof("✅ 전략 생성 완료: %s (%s)", strategy.Name, strategy.ID)
+
+	// 활성 상태로 생성된 전략은 바로 가동
+	if err := s.syncStrategy(strategy); err != nil {
+		logrus.Warnf("⚠️  생성된 전략 가동 실패: %v", err)
+	}
 	return s.convertToStrategyDetails(strategy), nil
 }
 
 // UpdateStrategy 전략 수정 (Repository 활용)
 func (s *ServiceImpl) UpdateStrategy(id string, req *dto.UpdateStrategyBody) (*StrategyDetails, error) {
 	// 기존 전략 조회
-	_, err := s.GetStrategy(id)
+	current, err := s.GetStrategy(id)
 	if err != nil {
 		return nil, fmt.Errorf("전략을 찾을 수 없습니다: %w", err)
 	}
@@ -497,8 +1036,48 @@ func (s *ServiceImpl) UpdateStrategy(id string, req *dto.UpdateStrategyBody) (*S
 	if req.Description != nil {
 		updateInput.Description = req.Description
 	}
-	if req.Symbol != nil {
-		updateInput.Symbol = req.Symbol
+	if req.Symbol != nil || req.Exchange != nil || req.Settings != nil {
+		// 종목/거래소 변경 시 상장 거래소를 다시 확인
+		symbol := current.Symbols[0]
+		if req.Symbol != nil {
+			symbol = *req.Symbol
+		}
+		requested := ""
+		if req.Exchange != nil {
+			requested = *req.Exchange
+		}
+		exchange, err := s.validateSymbols(symbol, requested, req.Settings)
+		if err != nil {
+			return nil, err
+		}
+		if req.Symbol != nil || req.Exchange != nil {
+			updateInput.Symbol = req.Symbol
+			updateInput.Exchange = &exchange
+		}
+	}
+	if req.Active != nil {
+		updateInput.Active = req.Active
+	}
+	if req.TradingMode != nil {
+		if err := validateTradingMode(*req.TradingMode); err != nil {
+			return nil, err
+		}
+		updateInput.TradingMode = req.TradingMode
+	}
+	if req.Rules != nil {
+		if _, err := ParseRuleSet(req.Rules); err != nil {
+			return nil, err
+		}
+		updateInput.Rules = req.Rules
+	}
+	if req.Settings != nil {
+		if err := validateSettings(req.Settings); err != nil {
+			return nil, err
+		}
+		updateInput.Settings = req.Settings
+	}
+	if req.UserInputs != nil {
+		updateInput.UserInputs = req.UserInputs
 	}
 
 	// DB에 저장
@@ -508,6 +1087,11 @@ func (s *ServiceImpl) UpdateStrategy(id string, req *dto.UpdateStrategyBody) (*S
 	}
 
 	logrus.Infof("✅ 전략 수정 완료: %s (%s)", strategy.Name, strategy.ID)
+
+	// 변경된 설정/활성 상태를 런타임 인스턴스에 반영
+	if err := s.syncStrategy(strategy); err != nil {
+		logrus.Warnf("⚠️  수정된 전략 재구성 실패: %v", err)
+	}
 	return s.convertToStrategyDetails(strategy), nil
 }
 
@@ -538,10 +1122,9 @@ func (s *ServiceImpl) DeleteStrategy(id string) error {
 	}
 
 	// 메모리에서도 제거
-	s.mutex.Lock()
-	delete(s.strategies, id)
-	delete(s.activeStrategies, id)
-	s.mutex.Unlock()
+	if s.unloadStrategy(id) {
+		s.refreshPriceStream()
+	}
 
 	logrus.Infof("🗑️  전략 삭제 완료: %s (%s)", strategy.Name, id)
 	return nil
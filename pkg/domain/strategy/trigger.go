@@ -0,0 +1,165 @@
+package strategy
+
+import (
+	"fmt"
+	"time"
+
+	"auto-trader/pkg/shared/schedule"
+	"auto-trader/pkg/shared/utils"
+)
+
+// 실행 트리거 유형 (settings.schedule.trigger)
+const (
+	TriggerInterval = "interval"  // 고정 간격
+	TriggerCron     = "cron"      // cron 표현식 (분 시 일 월 요일)
+	TriggerBarClose = "bar_close" // 봉 마감 시각마다
+	TriggerTick     = "tick"      // 종목 체결 수신마다
+)
+
+const (
+	// defaultExecutionTimeout 1회 실행 제한 시간 기본값
+	defaultExecutionTimeout = 30 * time.Second
+	// defaultTickMinGap tick 트리거의 연속 실행 최소 간격 기본값
+	defaultTickMinGap = time.Second
+	// barCloseDelay 봉 마감 후 마지막 체결이 집계되도록 기다리는 시간
+	barCloseDelay = time.Second
+	// defaultTriggerTimezone cron/봉 마감 기준 시간대
+	defaultTriggerTimezone = "America/New_York"
+)
+
+// Trigger 전략 실행 시점과 실행 제한 설정
+type Trigger struct {
+	Type        string
+	Interval    time.Duration  // interval
+	Cron        *schedule.Cron // cron
+	BarInterval time.Duration  // bar_close
+	MinGap      time.Duration  // tick
+	Timeout     time.Duration  // 1회 실행 제한 시간
+	Jitter      time.Duration  // 실행 전 임의 지연 상한 (동시 주문 분산)
+	Location    *time.Location // cron/bar_close 기준 시간대
+}
+
+// ParseTrigger settings.schedule 해석 (trigger 미지정 시 interval, interval 미지정 시 strategyTick)
+//
+//	{"trigger": "cron", "cron": "*/15 9-15 * * MON-FRI", "timeout": "20s", "jitter": "3s"}
+//	{"trigger": "bar_close", "bar_interval": "5m"}
+//	{"trigger": "tick", "min_gap": "2s"}
+func ParseTrigger(raw map[string]interface{}, candleInterval time.Duration) (*Trigger, error) {
+	trigger := &Trigger{
+		Type:     TriggerInterval,
+		Interval: strategyTick,
+		Timeout:  defaultExecutionTimeout,
+		MinGap:   defaultTickMinGap,
+	}
+	if kind, _ := raw["trigger"].(string); kind != "" {
+		trigger.Type = kind
+	}
+
+	var err error
+	if trigger.Timeout, err = triggerDuration(raw, "timeout", defaultExecutionTimeout); err != nil {
+		return nil, err
+	}
+	if trigger.Timeout <= 0 {
+		return nil, triggerError("timeout", "0보다 커야 합니다")
+	}
+	if trigger.Jitter, err = triggerDuration(raw, "jitter", 0); err != nil {
+		return nil, err
+	}
+	if trigger.Jitter < 0 {
+		return nil, triggerError("jitter", "0 이상이어야 합니다")
+	}
+
+	timezone, _ := raw["timezone"].(string)
+	if timezone == "" {
+		timezone = defaultTriggerTimezone
+	}
+	if trigger.Location, err = time.LoadLocation(timezone); err != nil {
+		return nil, triggerError("timezone", fmt.Sprintf("알 수 없는 시간대: %q", timezone))
+	}
+
+	switch trigger.Type {
+	case TriggerInterval:
+		if trigger.Interval, err = triggerDuration(raw, "interval", strategyTick); err != nil {
+			return nil, err
+		}
+		if trigger.Interval < strategyTick {
+			return nil, triggerError("interval", fmt.Sprintf("%s 이상이어야 합니다", strategyTick))
+		}
+	case TriggerCron:
+		expr, _ := raw["cron"].(string)
+		if expr == "" {
+			return nil, triggerError("cron", "cron 트리거에는 cron 표현식이 필요합니다")
+		}
+		if trigger.Cron, err = schedule.ParseCron(expr, trigger.Location); err != nil {
+			return nil, triggerError("cron", err.Error())
+		}
+	case TriggerBarClose:
+		if trigger.BarInterval, err = triggerDuration(raw, "bar_interval", candleInterval); err != nil {
+			return nil, err
+		}
+		if trigger.BarInterval < time.Minute || trigger.BarInterval > 24*time.Hour {
+			return nil, triggerError("bar_interval", "1m 이상 24h 이하여야 합니다")
+		}
+	case TriggerTick:
+		if trigger.MinGap, err = triggerDuration(raw, "min_gap", defaultTickMinGap); err != nil {
+			return nil, err
+		}
+		if trigger.MinGap < 0 {
+			return nil, triggerError("min_gap", "0 이상이어야 합니다")
+		}
+	default:
+		return nil, triggerError("trigger", fmt.Sprintf("지원하지 않는 트리거: %q (interval, cron, bar_close, tick)", trigger.Type))
+	}
+
+	return trigger, nil
+}
+
+// Next after 이후 다음 실행 시각 (tick 트리거는 시각 기반이 아니므로 zero)
+func (t *Trigger) Next(after time.Time) time.Time {
+	switch t.Type {
+	case TriggerInterval:
+		return after.Add(t.Interval)
+	case TriggerCron:
+		return t.Cron.Next(after)
+	case TriggerBarClose:
+		// 기준 시간대 자정부터 봉 주기 단위로 마감 시각 계산
+		local := after.In(t.Location)
+		midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, t.Location)
+		elapsed := local.Sub(midnight) - barCloseDelay
+		bars := elapsed / t.BarInterval
+		if elapsed < 0 {
+			bars = -1 // 자정 직후 전일 마지막 봉 마감
+		}
+		return midnight.Add((bars+1)*t.BarInterval + barCloseDelay)
+	}
+	return time.Time{}
+}
+
+// String 로그용 트리거 설명
+func (t *Trigger) String() string {
+	switch t.Type {
+	case TriggerInterval:
+		return fmt.Sprintf("interval %s", t.Interval)
+	case TriggerCron:
+		return fmt.Sprintf("cron %q (%s)", t.Cron.String(), t.Location)
+	case TriggerBarClose:
+		return fmt.Sprintf("bar_close %s", t.BarInterval)
+	}
+	return fmt.Sprintf("tick (min_gap %s)", t.MinGap)
+}
+
+func triggerDuration(raw map[string]interface{}, key string, defaultValue time.Duration) (time.Duration, error) {
+	text, _ := raw[key].(string)
+	if text == "" {
+		return defaultValue, nil
+	}
+	value, err := time.ParseDuration(text)
+	if err != nil {
+		return 0, triggerError(key, fmt.Sprintf("잘못된 시간 형식: %q (예: 30s, 5m)", text))
+	}
+	return value, nil
+}
+
+func triggerError(key, message string) error {
+	return utils.BadRequest(fmt.Sprintf("잘못된 실행 트리거 (settings.schedule.%s): %s", key, message))
+}
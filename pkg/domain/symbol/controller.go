@@ -0,0 +1,114 @@
+package symbol
+
+import (
+	"auto-trader/pkg/domain/symbol/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 종목 마스터 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 종목 마스터 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// SearchSymbols 종목 검색
+// @Summary 종목 검색
+// @Description 종목 코드 접두어 또는 종목명으로 종목 마스터를 검색합니다
+// @Tags symbols
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param q query string false "종목 코드 접두어 또는 종목명"
+// @Param exchange query string false "거래소 (NASD, NYSE, AMEX)"
+// @Param limit query int false "조회 개수" default(20)
+// @Param offset query int false "시작 위치" default(0)
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /symbols [get]
+func (ctrl *Controller) SearchSymbols(c *fiber.Ctx) error {
+	var q dto.SearchSymbolsQuery
+	q.Query = c.Query("q")
+	q.Exchange = c.Query("exchange")
+	q.Limit = c.QueryInt("limit", 20)
+	q.Offset = c.QueryInt("offset", 0)
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+	if q.Limit < 1 || q.Limit > 100 || q.Offset < 0 {
+		return utils.ValidationErrorResponse(c, "limit은 1~100, offset은 0 이상이어야 합니다")
+	}
+
+	symbols, err := ctrl.service.Search(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "종목 검색 실패")
+	}
+
+	return utils.SuccessResponse(c, symbols)
+}
+
+// GetSymbol 종목 상장 정보 조회
+// @Summary 종목 상장 정보 조회
+// @Description 종목 코드의 거래소별 상장 정보(통화, 호가 단위, 매매 단위, 거래 가능 여부)를 조회합니다
+// @Tags symbols
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param ticker path string true "종목 코드"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Router /symbols/{ticker} [get]
+func (ctrl *Controller) GetSymbol(c *fiber.Ctx) error {
+	var path dto.SymbolPath
+	path.Ticker = c.Params("ticker")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	symbols, err := ctrl.service.GetSymbol(path.Ticker)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "종목 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, symbols)
+}
+
+// SyncSymbols 종목 마스터 갱신
+// @Summary 종목 마스터 갱신
+// @Description KIS 종목 마스터 파일을 내려받아 거래소별 종목 목록을 갱신합니다
+// @Tags symbols
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param request body dto.SyncSymbolsBody false "갱신할 거래소 (비어 있으면 전체)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /symbols/sync [post]
+func (ctrl *Controller) SyncSymbols(c *fiber.Ctx) error {
+	var req dto.SyncSymbolsBody
+	if len(c.Body()) > 0 {
+		if err := c.BodyParser(&req); err != nil {
+			return utils.BadRequestResponse(c, "잘못된 요청 형식")
+		}
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	result, err := ctrl.service.Sync(c.UserContext(), req.Exchanges)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "종목 마스터 갱신 실패")
+	}
+
+	return utils.SuccessResponse(c, result)
+}
@@ -0,0 +1,25 @@
+package dto
+
+// Body DTOs (요청 본문)
+
+// SyncSymbolsBody 종목 마스터 갱신 요청
+type SyncSymbolsBody struct {
+	Exchanges []string `json:"exchanges,omitempty" validate:"max=3"` // NASD, NYSE, AMEX (비어 있으면 전체 거래소)
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// SearchSymbolsQuery 종목 검색 쿼리 파라미터
+type SearchSymbolsQuery struct {
+	Query    string `query:"q,omitempty" validate:"max=50"`        // 종목 코드 접두어 또는 종목명
+	Exchange string `query:"exchange,omitempty" validate:"max=10"` // NASD, NYSE, AMEX
+	Limit    int    `query:"limit" validate:"min=1,max=100"`
+	Offset   int    `query:"offset" validate:"min=0"`
+}
+
+// Path DTOs (URL 경로 파라미터)
+
+// SymbolPath 종목 코드 경로 파라미터
+type SymbolPath struct {
+	Ticker string `param:"ticker" validate:"required,max=20"`
+}
@@ -0,0 +1,42 @@
+package dto
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// SymbolResponse 종목 마스터 응답 데이터
+type SymbolResponse struct {
+	Ticker        string          `json:"ticker"`
+	Exchange      string          `json:"exchange"`       // 주문 거래소코드 (NASD, NYSE, AMEX)
+	QuoteExchange string          `json:"quote_exchange"` // 시세 거래소코드 (NAS, NYS, AMS)
+	Currency      string          `json:"currency"`
+	Name          string          `json:"name"`
+	SecurityType  string          `json:"security_type"`
+	LotSize       int             `json:"lot_size"`
+	TickSize      decimal.Decimal `json:"tick_size"`
+	Tradable      bool            `json:"tradable"`
+	UpdatedAt     time.Time       `json:"updated_at"`
+}
+
+// SymbolListResponse 종목 검색 응답 데이터
+type SymbolListResponse struct {
+	Symbols []*SymbolResponse `json:"symbols"`
+	Total   int               `json:"total"`
+	Limit   int               `json:"limit"`
+	Offset  int               `json:"offset"`
+}
+
+// ExchangeSyncResult 거래소별 종목 마스터 갱신 결과
+type ExchangeSyncResult struct {
+	Exchange string `json:"exchange"`
+	Count    int    `json:"count"`
+	Error    string `json:"error,omitempty"`
+}
+
+// SyncResponse 종목 마스터 갱신 응답 데이터
+type SyncResponse struct {
+	Results []ExchangeSyncResult `json:"results"`
+	Total   int                  `json:"total"` // 갱신 후 캐시된 종목 수
+}
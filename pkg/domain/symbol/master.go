@@ -0,0 +1,107 @@
+package symbol
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// KIS 해외주식 종목 마스터 파일(NASMST/NYSMST/AMSMST.COD) 열 위치 (탭 구분)
+const (
+	masterColExchangeCode = 2  // 거래소코드 (NAS, NYS, AMS)
+	masterColSymbol       = 4  // 종목 심볼
+	masterColEnglishName  = 7  // 영문명
+	masterColSecurityType = 8  // 1: 지수, 2: 주식, 3: ETP, 4: 워런트
+	masterColCurrency     = 9  // 통화
+	masterColBasePrice    = 12 // 기준가
+	masterColBidLot       = 13 // 매수 주문 단위
+	masterColumns         = 14 // 해석에 필요한 최소 열 개수
+)
+
+// ParseMaster KIS 종목 마스터 파일 해석
+// 한글명 열은 EUC-KR이지만 사용하지 않으므로 바이트 단위로 탭만 구분한다.
+// 다른 거래소 행, 열이 부족한 행, 중복 종목 코드는 건너뛴다.
+func ParseMaster(exchange string, r io.Reader) ([]Symbol, error) {
+	quoteExchange := QuoteExchangeOf(exchange)
+	if quoteExchange == "" {
+		return nil, fmt.Errorf("지원하지 않는 거래소: %s", exchange)
+	}
+
+	var symbols []Symbol
+	seen := make(map[string]bool)
+	scanner := bufio.NewScanner(r)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		cols := strings.Split(strings.TrimRight(scanner.Text(), "\r"), "\t")
+		if len(cols) < masterColumns {
+			continue
+		}
+		if code := strings.TrimSpace(cols[masterColExchangeCode]); code != "" && code != quoteExchange {
+			continue
+		}
+		ticker := NormalizeTicker(cols[masterColSymbol])
+		if ticker == "" || len(ticker) > 20 || seen[ticker] {
+			continue
+		}
+		seen[ticker] = true
+
+		securityType := securityTypeOf(strings.TrimSpace(cols[masterColSecurityType]))
+		basePrice, _ := decimal.NewFromString(strings.TrimSpace(cols[masterColBasePrice]))
+		lotSize, err := strconv.Atoi(strings.TrimSpace(cols[masterColBidLot]))
+		if err != nil || lotSize < 1 {
+			lotSize = 1
+		}
+		currency := strings.TrimSpace(cols[masterColCurrency])
+		if currency == "" {
+			currency = "USD"
+		}
+
+		symbols = append(symbols, Symbol{
+			Ticker:       ticker,
+			Exchange:     exchange,
+			Currency:     currency,
+			Name:         truncate(strings.TrimSpace(cols[masterColEnglishName]), 200),
+			SecurityType: securityType,
+			LotSize:      lotSize,
+			TickSize:     tickSizeFor(basePrice),
+			Tradable:     securityType != SecurityIndex,
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("종목 마스터 파일 읽기 실패: %w", err)
+	}
+	return symbols, nil
+}
+
+func securityTypeOf(code string) string {
+	switch code {
+	case "1":
+		return SecurityIndex
+	case "2":
+		return SecurityStock
+	case "3":
+		return SecurityETF
+	case "4":
+		return SecurityWarrant
+	}
+	return SecurityOther
+}
+
+// tickSizeFor 미국 주식 호가 단위 ($1 이상 $0.01, 미만 $0.0001)
+func tickSizeFor(price decimal.Decimal) decimal.Decimal {
+	if price.IsPositive() && price.LessThan(decimal.NewFromInt(1)) {
+		return decimal.New(1, -4)
+	}
+	return decimal.New(1, -2)
+}
+
+func truncate(text string, max int) string {
+	if len(text) <= max {
+		return text
+	}
+	return text[:max]
+}
@@ -0,0 +1,80 @@
+package symbol
+
+import (
+	"context"
+	"io"
+	"strings"
+
+	"github.com/shopspring/decimal"
+)
+
+// 해외거래소코드 (주문/잔고 API용 OVRS_EXCG_CD, 종목 마스터 저장 기준)
+const (
+	ExchangeNASDAQ = "NASD"
+	ExchangeNYSE   = "NYSE"
+	ExchangeAMEX   = "AMEX"
+)
+
+// Exchanges 종목 마스터를 관리하는 거래소
+var Exchanges = []string{ExchangeNASDAQ, ExchangeNYSE, ExchangeAMEX}
+
+// quoteExchanges 주문 거래소코드 → 시세 API용 거래소코드 (EXCD)
+var quoteExchanges = map[string]string{
+	ExchangeNASDAQ: "NAS",
+	ExchangeNYSE:   "NYS",
+	ExchangeAMEX:   "AMS",
+}
+
+// 종목 구분
+const (
+	SecurityStock   = "STOCK"
+	SecurityETF     = "ETF"
+	SecurityIndex   = "INDEX"
+	SecurityWarrant = "WARRANT"
+	SecurityOther   = "OTHER"
+)
+
+// Symbol 종목 마스터 항목
+type Symbol struct {
+	Ticker       string
+	Exchange     string // NASD, NYSE, AMEX
+	Currency     string
+	Name         string
+	SecurityType string
+	LotSize      int
+	TickSize     decimal.Decimal
+	Tradable     bool
+}
+
+// QuoteExchange 시세 API용 거래소코드 (NAS, NYS, AMS)
+func (s Symbol) QuoteExchange() string {
+	return QuoteExchangeOf(s.Exchange)
+}
+
+// QuoteExchangeOf 주문 거래소코드를 시세 거래소코드로 변환 (알 수 없으면 빈 값)
+func QuoteExchangeOf(exchange string) string {
+	return quoteExchanges[exchange]
+}
+
+// NormalizeExchange 거래소 표기(NASD/NAS/NASDAQ, NYSE/NYS, AMEX/AMS)를 주문 거래소코드로 변환
+func NormalizeExchange(raw string) (string, bool) {
+	switch strings.ToUpper(strings.TrimSpace(raw)) {
+	case "NASD", "NAS", "NASDAQ":
+		return ExchangeNASDAQ, true
+	case "NYSE", "NYS":
+		return ExchangeNYSE, true
+	case "AMEX", "AMS":
+		return ExchangeAMEX, true
+	}
+	return "", false
+}
+
+// NormalizeTicker 종목 코드 표기 통일 (대문자, 공백 제거)
+func NormalizeTicker(ticker string) string {
+	return strings.ToUpper(strings.TrimSpace(ticker))
+}
+
+// MasterSource 거래소별 종목 마스터 파일 제공자 (KIS 마스터 파일 다운로드)
+type MasterSource interface {
+	FetchMaster(ctx context.Context, exchange string) (io.ReadCloser, error)
+}
@@ -0,0 +1,142 @@
+package symbol
+
+import (
+	"context"
+	"fmt"
+
+	"auto-trader/ent"
+	entsymbol "auto-trader/ent/symbol"
+)
+
+// Repository 종목 마스터 데이터 접근 인터페이스
+type Repository interface {
+	GetAll() ([]*ent.Symbol, error)
+	Search(query, exchange string, limit, offset int) ([]*ent.Symbol, int, error)
+	GetByTicker(ticker string) ([]*ent.Symbol, error)
+	// ReplaceExchange 거래소 종목 목록 저장 (목록에 없는 기존 종목은 거래 불가로 표시), 저장 개수 반환
+	ReplaceExchange(exchange string, symbols []Symbol) (int, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// upsertBatchSize 한 번에 저장하는 종목 수
+const upsertBatchSize = 500
+
+// GetAll 전체 종목 조회
+func (r *EntRepository) GetAll() ([]*ent.Symbol, error) {
+	symbols, err := r.client.Symbol.Query().All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get symbols: %w", err)
+	}
+	return symbols, nil
+}
+
+// Search 종목 코드 접두어/이름으로 검색
+func (r *EntRepository) Search(query, exchange string, limit, offset int) ([]*ent.Symbol, int, error) {
+	q := r.client.Symbol.Query()
+	if query != "" {
+		q.Where(entsymbol.Or(
+			entsymbol.TickerHasPrefix(NormalizeTicker(query)),
+			entsymbol.NameContainsFold(query),
+		))
+	}
+	if exchange != "" {
+		q.Where(entsymbol.ExchangeEQ(entsymbol.Exchange(exchange)))
+	}
+
+	total, err := q.Clone().Count(r.getContext())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count symbols: %w", err)
+	}
+
+	symbols, err := q.
+		Order(ent.Asc(entsymbol.FieldTicker), ent.Asc(entsymbol.FieldExchange)).
+		Limit(limit).
+		Offset(offset).
+		All(r.getContext())
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to search symbols: %w", err)
+	}
+	return symbols, total, nil
+}
+
+// GetByTicker 종목 코드로 조회 (거래소별로 여러 건일 수 있음)
+func (r *EntRepository) GetByTicker(ticker string) ([]*ent.Symbol, error) {
+	symbols, err := r.client.Symbol.Query().
+		Where(entsymbol.Ticker(NormalizeTicker(ticker))).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get symbol by ticker: %w", err)
+	}
+	return symbols, nil
+}
+
+// ReplaceExchange 거래소 종목 목록 저장 (같은 종목 코드는 새 값으로 교체)
+func (r *EntRepository) ReplaceExchange(exchange string, symbols []Symbol) (int, error) {
+	ctx := r.getContext()
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to start transaction: %w", err)
+	}
+
+	// 마스터 파일에서 빠진 종목(상장폐지 등)은 삭제하지 않고 거래 불가로 표시
+	if _, err := tx.Symbol.Update().
+		Where(entsymbol.ExchangeEQ(entsymbol.Exchange(exchange))).
+		SetTradable(false).
+		Save(ctx); err != nil {
+		return 0, rollback(tx, fmt.Errorf("failed to reset symbols: %w", err))
+	}
+
+	for start := 0; start < len(symbols); start += upsertBatchSize {
+		batch := symbols[start:min(start+upsertBatchSize, len(symbols))]
+
+		tickers := make([]string, len(batch))
+		builders := make([]*ent.SymbolCreate, len(batch))
+		for i, s := range batch {
+			tickers[i] = s.Ticker
+			builders[i] = tx.Symbol.Create().
+				SetTicker(s.Ticker).
+				SetExchange(entsymbol.Exchange(exchange)).
+				SetCurrency(s.Currency).
+				SetName(s.Name).
+				SetSecurityType(entsymbol.SecurityType(s.SecurityType)).
+				SetLotSize(s.LotSize).
+				SetTickSize(s.TickSize).
+				SetTradable(s.Tradable)
+		}
+
+		if _, err := tx.Symbol.Delete().
+			Where(entsymbol.ExchangeEQ(entsymbol.Exchange(exchange)), entsymbol.TickerIn(tickers...)).
+			Exec(ctx); err != nil {
+			return 0, rollback(tx, fmt.Errorf("failed to replace symbols: %w", err))
+		}
+		if err := tx.Symbol.CreateBulk(builders...).Exec(ctx); err != nil {
+			return 0, rollback(tx, fmt.Errorf("failed to save symbols: %w", err))
+		}
+	}
+
+	if err := tx.Commit(); err != nil {
+		return 0, fmt.Errorf("failed to commit symbols: %w", err)
+	}
+	return len(symbols), nil
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w (rollback: %v)", err, rerr)
+	}
+	return err
+}
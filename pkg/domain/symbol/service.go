@@ -0,0 +1,298 @@
+package symbol
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/symbol/dto"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/sirupsen/logrus"
+)
+
+// Service 종목 마스터 서비스 인터페이스
+type Service interface {
+	// 조회
+	Search(q dto.SearchSymbolsQuery) (*dto.SymbolListResponse, error)
+	GetSymbol(ticker string) ([]*dto.SymbolResponse, error)
+
+	// 마스터 갱신
+	Sync(ctx context.Context, exchanges []string) (*dto.SyncResponse, error)
+	Load(exchange string, r io.Reader) (int, error)
+	Refresh() error
+	Count() int
+
+	// 거래소 해석
+	ResolveListing(ticker, exchange string) (string, error)
+	QuoteExchange(ticker string) string
+	OrderExchange(ticker string) string
+}
+
+// ServiceImpl 종목 마스터 서비스 구현체
+// 조회가 잦은 거래소 해석은 메모리 캐시(종목 코드 → 상장 목록)로 처리한다.
+type ServiceImpl struct {
+	repository Repository
+	source     MasterSource
+
+	mu      sync.RWMutex
+	symbols map[string][]Symbol
+}
+
+// NewService 새로운 종목 마스터 서비스 생성 (source가 nil이면 마스터 다운로드 불가)
+func NewService(repository Repository, source MasterSource) Service {
+	return &ServiceImpl{
+		repository: repository,
+		source:     source,
+		symbols:    make(map[string][]Symbol),
+	}
+}
+
+// Search 종목 검색
+func (s *ServiceImpl) Search(q dto.SearchSymbolsQuery) (*dto.SymbolListResponse, error) {
+	exchange := ""
+	if q.Exchange != "" {
+		normalized, ok := NormalizeExchange(q.Exchange)
+		if !ok {
+			return nil, unsupportedExchange(q.Exchange)
+		}
+		exchange = normalized
+	}
+
+	symbols, total, err := s.repository.Search(strings.TrimSpace(q.Query), exchange, q.Limit, q.Offset)
+	if err != nil {
+		return nil, fmt.Errorf("종목 검색 실패: %w", err)
+	}
+
+	response := &dto.SymbolListResponse{
+		Symbols: make([]*dto.SymbolResponse, len(symbols)),
+		Total:   total,
+		Limit:   q.Limit,
+		Offset:  q.Offset,
+	}
+	for i, entity := range symbols {
+		response.Symbols[i] = toResponse(entity)
+	}
+	return response, nil
+}
+
+// GetSymbol 종목 코드의 상장 정보 조회 (거래소별로 여러 건일 수 있음)
+func (s *ServiceImpl) GetSymbol(ticker string) ([]*dto.SymbolResponse, error) {
+	symbols, err := s.repository.GetByTicker(ticker)
+	if err != nil {
+		return nil, fmt.Errorf("종목 조회 실패: %w", err)
+	}
+	if len(symbols) == 0 {
+		return nil, utils.NotFound("symbol", fmt.Sprintf("종목을 찾을 수 없습니다: %s", NormalizeTicker(ticker)))
+	}
+
+	responses := make([]*dto.SymbolResponse, len(symbols))
+	for i, entity := range symbols {
+		responses[i] = toResponse(entity)
+	}
+	return responses, nil
+}
+
+// Sync KIS 마스터 파일을 내려받아 거래소별 종목 목록 갱신 (거래소 미지정 시 전체)
+func (s *ServiceImpl) Sync(ctx context.Context, exchanges []string) (*dto.SyncResponse, error) {
+	if s.source == nil {
+		return nil, utils.Internal("종목 마스터 다운로드가 설정되지 않았습니다", nil)
+	}
+
+	targets := Exchanges
+	if len(exchanges) > 0 {
+		targets = make([]string, 0, len(exchanges))
+		for _, raw := range exchanges {
+			exchange, ok := NormalizeExchange(raw)
+			if !ok {
+				return nil, unsupportedExchange(raw)
+			}
+			targets = append(targets, exchange)
+		}
+	}
+
+	response := &dto.SyncResponse{Results: make([]dto.ExchangeSyncResult, 0, len(targets))}
+	for _, exchange := range targets {
+		result := dto.ExchangeSyncResult{Exchange: exchange}
+		count, err := s.syncExchange(ctx, exchange)
+		if err != nil {
+			logrus.Errorf("❌ 종목 마스터 갱신 실패 (%s): %v", exchange, err)
+			result.Error = err.Error()
+		} else {
+			result.Count = count
+		}
+		response.Results = append(response.Results, result)
+	}
+
+	if err := s.Refresh(); err != nil {
+		return nil, err
+	}
+	response.Total = s.Count()
+	return response, nil
+}
+
+func (s *ServiceImpl) syncExchange(ctx context.Context, exchange string) (int, error) {
+	body, err := s.source.FetchMaster(ctx, exchange)
+	if err != nil {
+		return 0, fmt.Errorf("마스터 파일 다운로드 실패: %w", err)
+	}
+	defer body.Close()
+
+	return s.Load(exchange, body)
+}
+
+// Load 마스터 파일 내용을 해석해 거래소 종목 목록 저장 (캐시는 Refresh로 갱신)
+func (s *ServiceImpl) Load(exchange string, r io.Reader) (int, error) {
+	symbols, err := ParseMaster(exchange, r)
+	if err != nil {
+		return 0, fmt.Errorf("마스터 파일 해석 실패: %w", err)
+	}
+	if len(symbols) == 0 {
+		// 빈 파일로 기존 목록 전체가 거래 불가 처리되는 것을 방지
+		return 0, fmt.Errorf("마스터 파일에 %s 종목이 없습니다", exchange)
+	}
+
+	count, err := s.repository.ReplaceExchange(exchange, symbols)
+	if err != nil {
+		return 0, fmt.Errorf("종목 마스터 저장 실패: %w", err)
+	}
+	logrus.Infof("📇 종목 마스터 갱신 (%s): %d개", exchange, count)
+	return count, nil
+}
+
+// Refresh 저장된 종목 마스터로 메모리 캐시 재구성
+func (s *ServiceImpl) Refresh() error {
+	entities, err := s.repository.GetAll()
+	if err != nil {
+		return fmt.Errorf("종목 마스터 조회 실패: %w", err)
+	}
+
+	symbols := make(map[string][]Symbol, len(entities))
+	for _, entity := range entities {
+		symbols[entity.Ticker] = append(symbols[entity.Ticker], fromEntity(entity))
+	}
+
+	s.mu.Lock()
+	s.symbols = symbols
+	s.mu.Unlock()
+	return nil
+}
+
+// Count 캐시된 종목 코드 수
+func (s *ServiceImpl) Count() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.symbols)
+}
+
+// ResolveListing 거래 가능한 종목인지 확인하고 주문 거래소코드 반환
+// exchange를 지정하면 해당 거래소 상장 여부를 확인한다. 종목 마스터가 비어 있으면 검증을 건너뛴다.
+func (s *ServiceImpl) ResolveListing(ticker, exchange string) (string, error) {
+	ticker = NormalizeTicker(ticker)
+	if ticker == "" {
+		return "", utils.BadRequest("종목 코드가 비어 있습니다")
+	}
+
+	wanted := ""
+	if exchange != "" {
+		normalized, ok := NormalizeExchange(exchange)
+		if !ok {
+			return "", unsupportedExchange(exchange)
+		}
+		wanted = normalized
+	}
+
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	if len(s.symbols) == 0 {
+		return wanted, nil
+	}
+
+	listings, ok := s.symbols[ticker]
+	if !ok {
+		return "", utils.BadRequest(fmt.Sprintf("알 수 없는 종목: %s", ticker))
+	}
+	if wanted != "" {
+		for _, listing := range listings {
+			if listing.Exchange != wanted {
+				continue
+			}
+			if !listing.Tradable {
+				return "", utils.BadRequest(fmt.Sprintf("거래할 수 없는 종목: %s (%s)", ticker, wanted))
+			}
+			return wanted, nil
+		}
+		return "", utils.BadRequest(fmt.Sprintf("%s 거래소에 상장되지 않은 종목: %s", wanted, ticker))
+	}
+
+	listing, ok := primary(listings)
+	if !ok {
+		return "", utils.BadRequest(fmt.Sprintf("거래할 수 없는 종목: %s", ticker))
+	}
+	return listing.Exchange, nil
+}
+
+// QuoteExchange 시세 API용 거래소코드 (NAS, NYS, AMS, 알 수 없으면 빈 값)
+func (s *ServiceImpl) QuoteExchange(ticker string) string {
+	return QuoteExchangeOf(s.OrderExchange(ticker))
+}
+
+// OrderExchange 주문 API용 거래소코드 (NASD, NYSE, AMEX, 알 수 없으면 빈 값)
+func (s *ServiceImpl) OrderExchange(ticker string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	listing, ok := primary(s.symbols[NormalizeTicker(ticker)])
+	if !ok {
+		return ""
+	}
+	return listing.Exchange
+}
+
+// primary 여러 거래소 상장 시 거래 가능한 상장을 NASD, NYSE, AMEX 순으로 선택
+func primary(listings []Symbol) (Symbol, bool) {
+	for _, exchange := range Exchanges {
+		for _, listing := range listings {
+			if listing.Exchange == exchange && listing.Tradable {
+				return listing, true
+			}
+		}
+	}
+	return Symbol{}, false
+}
+
+func unsupportedExchange(exchange string) error {
+	return utils.BadRequest(fmt.Sprintf("지원하지 않는 거래소: %q (NASD, NYSE, AMEX)", exchange))
+}
+
+func fromEntity(entity *ent.Symbol) Symbol {
+	return Symbol{
+		Ticker:       entity.Ticker,
+		Exchange:     string(entity.Exchange),
+		Currency:     entity.Currency,
+		Name:         entity.Name,
+		SecurityType: string(entity.SecurityType),
+		LotSize:      entity.LotSize,
+		TickSize:     entity.TickSize,
+		Tradable:     entity.Tradable,
+	}
+}
+
+func toResponse(entity *ent.Symbol) *dto.SymbolResponse {
+	return &dto.SymbolResponse{
+		Ticker:        entity.Ticker,
+		Exchange:      string(entity.Exchange),
+		QuoteExchange: QuoteExchangeOf(string(entity.Exchange)),
+		Currency:      entity.Currency,
+		Name:          entity.Name,
+		SecurityType:  string(entity.SecurityType),
+		LotSize:       entity.LotSize,
+		TickSize:      entity.TickSize,
+		Tradable:      entity.Tradable,
+		UpdatedAt:     entity.UpdatedAt,
+	}
+}
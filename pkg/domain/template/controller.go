@@ -0,0 +1,113 @@
+package template
+
+import (
+	"auto-trader/pkg/domain/template/dto"
+	"auto-trader/pkg/shared/types"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// Controller 전략 템플릿 컨트롤러
+type Controller struct {
+	service Service
+}
+
+// NewController 새로운 전략 템플릿 컨트롤러 생성
+func NewController(service Service) *Controller {
+	return &Controller{
+		service: service,
+	}
+}
+
+// GetTemplates 전략 템플릿 목록 조회
+// @Summary 전략 템플릿 목록 조회
+// @Description 기본 제공 전략 템플릿과 입력 스키마를 조회합니다
+// @Tags strategy-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param category query string false "분류 (trend, mean_reversion, volatility, risk_management)"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategy-templates [get]
+func (ctrl *Controller) GetTemplates(c *fiber.Ctx) error {
+	var q dto.GetTemplateListQuery
+	q.Category = c.Query("category")
+	if err := utils.ValidateStruct(q); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	templates, err := ctrl.service.GetTemplates(q)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "템플릿 목록 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, templates)
+}
+
+// GetTemplate 전략 템플릿 상세 조회
+// @Summary 전략 템플릿 상세 조회
+// @Description 템플릿 설정(입력값 참조 포함)과 입력 스키마를 조회합니다
+// @Tags strategy-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "템플릿 ID"
+// @Success 200 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategy-templates/{id} [get]
+func (ctrl *Controller) GetTemplate(c *fiber.Ctx) error {
+	var path types.Id
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	template, err := ctrl.service.GetTemplate(path.ID)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "템플릿 조회 실패")
+	}
+
+	return utils.SuccessResponse(c, template)
+}
+
+// Instantiate 템플릿으로 전략 생성
+// @Summary 템플릿으로 전략 생성
+// @Description 입력값을 템플릿 입력 스키마로 검증하고 템플릿 설정과 병합한 전략을 생성합니다
+// @Tags strategy-templates
+// @Accept json
+// @Produce json
+// @Security BearerAuth
+// @Param id path string true "템플릿 ID"
+// @Param body body dto.InstantiateTemplateBody true "전략 정보와 템플릿 입력값"
+// @Success 201 {object} utils.Response
+// @Failure 400 {object} utils.Response
+// @Failure 404 {object} utils.Response
+// @Failure 500 {object} utils.Response
+// @Router /strategy-templates/{id}/instantiate [post]
+func (ctrl *Controller) Instantiate(c *fiber.Ctx) error {
+	var path types.Id
+	path.ID = c.Params("id")
+	if err := utils.ValidateStruct(path); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	var req dto.InstantiateTemplateBody
+	if err := c.BodyParser(&req); err != nil {
+		return utils.BadRequestResponse(c, "잘못된 요청 형식")
+	}
+	if err := utils.ValidateStruct(req); err != nil {
+		return utils.ValidationErrorResponse(c, err.Error())
+	}
+
+	result, err := ctrl.service.Instantiate(utils.GetUserID(c), path.ID, req)
+	if err != nil {
+		return utils.CommonErrorResponse(c, err, "템플릿 전략 생성 실패")
+	}
+
+	return utils.SuccessResponse(c, result, fiber.StatusCreated)
+}
@@ -0,0 +1,26 @@
+package dto
+
+import (
+	strategydto "auto-trader/pkg/domain/strategy/dto"
+)
+
+// Body DTOs (요청 본문)
+
+// InstantiateTemplateBody 템플릿으로 전략 생성 요청
+type InstantiateTemplateBody struct {
+	Name        string                            `json:"name" validate:"required,min=1,max=100"`
+	Symbol      string                            `json:"symbol" validate:"required,min=1,max=20"`
+	Exchange    string                            `json:"exchange,omitempty" validate:"max=10"`               // NASD, NYSE, AMEX (비어 있으면 종목 마스터 기준)
+	Description *string                           `json:"description,omitempty" validate:"omitempty,max=500"` // 없으면 템플릿 설명 사용
+	Active      bool                              `json:"active"`
+	TradingMode string                            `json:"trading_mode,omitempty"` // LIVE(기본값) 또는 PAPER
+	Inputs      map[string]interface{}            `json:"inputs,omitempty"`       // 템플릿 input_schema 기준 입력값
+	Settings    *strategydto.StrategySettingsBody `json:"settings,omitempty"`     // 템플릿 실행 설정 위에 덮어쓸 항목
+}
+
+// Query DTOs (URL 쿼리 파라미터)
+
+// GetTemplateListQuery 템플릿 목록 조회 쿼리 파라미터
+type GetTemplateListQuery struct {
+	Category string `query:"category,omitempty" validate:"max=50"`
+}
@@ -0,0 +1,35 @@
+package dto
+
+import (
+	"time"
+
+	"auto-trader/pkg/domain/strategy"
+	strategydto "auto-trader/pkg/domain/strategy/dto"
+
+	"github.com/google/uuid"
+)
+
+// TemplateResponse 전략 템플릿 응답 데이터
+type TemplateResponse struct {
+	ID             uuid.UUID              `json:"id"`
+	Name           string                 `json:"name"`
+	Description    *string                `json:"description,omitempty"`
+	Category       string                 `json:"category"`
+	Version        string                 `json:"version"`
+	InputSchema    map[string]interface{} `json:"input_schema"`
+	TemplateConfig map[string]interface{} `json:"template_config,omitempty"` // 상세 조회에서만 포함
+	CreatedAt      time.Time              `json:"created_at"`
+	UpdatedAt      time.Time              `json:"updated_at"`
+}
+
+// TemplateListResponse 전략 템플릿 목록 응답 데이터
+type TemplateListResponse struct {
+	Templates []*TemplateResponse `json:"templates"`
+	Total     int                 `json:"total"`
+}
+
+// InstantiateResponse 템플릿으로 생성된 전략과 병합된 실행 설정
+type InstantiateResponse struct {
+	Strategy *strategy.StrategyDetails           `json:"strategy"`
+	Config   *strategydto.StrategyConfigResponse `json:"config"`
+}
@@ -0,0 +1,100 @@
+package template
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	"auto-trader/pkg/shared/utils"
+)
+
+// placeholderPattern 문자열 안의 입력값 참조 ("{{sell_percentage}}%")
+var placeholderPattern = regexp.MustCompile(`\{\{\s*([a-zA-Z0-9_]+)\s*\}\}`)
+
+// Render template_config의 입력값 참조를 실제 입력값으로 치환한 새 설정 생성
+//
+//   - {"$input": "fast_period"}              → 입력값 그대로 (타입 유지)
+//   - {"$input": "loss_threshold", "scale": -1} → 숫자 입력값 × scale
+//   - "{{sell_percentage}}%"                 → 문자열 안에서 치환
+func Render(config map[string]interface{}, inputs map[string]interface{}) (map[string]interface{}, error) {
+	rendered, err := renderValue(config, inputs)
+	if err != nil {
+		return nil, err
+	}
+	result, _ := rendered.(map[string]interface{})
+	return result, nil
+}
+
+func renderValue(value interface{}, inputs map[string]interface{}) (interface{}, error) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if name, ok := v["$input"].(string); ok {
+			return resolveInput(name, v, inputs)
+		}
+		result := make(map[string]interface{}, len(v))
+		for key, item := range v {
+			rendered, err := renderValue(item, inputs)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = rendered
+		}
+		return result, nil
+	case []interface{}:
+		result := make([]interface{}, len(v))
+		for i, item := range v {
+			rendered, err := renderValue(item, inputs)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = rendered
+		}
+		return result, nil
+	case string:
+		return renderString(v, inputs)
+	}
+	return value, nil
+}
+
+// resolveInput {"$input": ...} 참조 치환
+func resolveInput(name string, ref map[string]interface{}, inputs map[string]interface{}) (interface{}, error) {
+	value, ok := inputs[name]
+	if !ok {
+		return nil, templateError(fmt.Sprintf("입력값 %q가 정의되지 않았습니다", name))
+	}
+	scale, ok := toNumber(ref["scale"])
+	if !ok {
+		return value, nil
+	}
+	number, ok := toNumber(value)
+	if !ok {
+		return nil, templateError(fmt.Sprintf("입력값 %q는 숫자가 아니어서 scale을 적용할 수 없습니다", name))
+	}
+	return number * scale, nil
+}
+
+// renderString 문자열 안의 {{name}} 치환
+func renderString(text string, inputs map[string]interface{}) (string, error) {
+	var missing string
+	rendered := placeholderPattern.ReplaceAllStringFunc(text, func(match string) string {
+		name := placeholderPattern.FindStringSubmatch(match)[1]
+		value, ok := inputs[name]
+		if !ok {
+			missing = name
+			return match
+		}
+		if number, ok := toNumber(value); ok {
+			return strconv.FormatFloat(number, 'f', -1, 64)
+		}
+		return fmt.Sprint(value)
+	})
+	if missing != "" {
+		return "", templateError(fmt.Sprintf("입력값 %q가 정의되지 않았습니다", missing))
+	}
+	return rendered, nil
+}
+
+// templateError 템플릿 자체의 설정 오류 (사용자 입력 문제가 아님)
+func templateError(message string) error {
+	return utils.Internal("템플릿 설정 오류: "+message, nil)
+}
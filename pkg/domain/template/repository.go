@@ -0,0 +1,114 @@
+package template
+
+import (
+	"context"
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/ent/strategytemplate"
+
+	"github.com/google/uuid"
+)
+
+// Repository 전략 템플릿 데이터 접근 인터페이스
+type Repository interface {
+	GetAll(category string) ([]*ent.StrategyTemplate, error)
+	GetByID(id uuid.UUID) (*ent.StrategyTemplate, error)
+	GetByName(name string) (*ent.StrategyTemplate, error)
+	Save(seed Seed) (*ent.StrategyTemplate, error)
+}
+
+// EntRepository ent 기반 구현체
+type EntRepository struct {
+	client *ent.Client
+}
+
+// NewEntRepository ent 기반 Repository 생성
+func NewEntRepository(client *ent.Client) Repository {
+	return &EntRepository{client: client}
+}
+
+// 헬퍼 함수들
+func (r *EntRepository) getContext() context.Context {
+	return context.Background()
+}
+
+// GetAll 템플릿 목록 조회 (category가 비어 있으면 전체)
+func (r *EntRepository) GetAll(category string) ([]*ent.StrategyTemplate, error) {
+	query := r.client.StrategyTemplate.Query()
+	if category != "" {
+		query.Where(strategytemplate.Category(category))
+	}
+
+	templates, err := query.
+		Order(ent.Asc(strategytemplate.FieldCategory), ent.Asc(strategytemplate.FieldName)).
+		All(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to get strategy templates: %w", err)
+	}
+
+	return templates, nil
+}
+
+// GetByID ID로 템플릿 조회 (없으면 nil)
+func (r *EntRepository) GetByID(id uuid.UUID) (*ent.StrategyTemplate, error) {
+	template, err := r.client.StrategyTemplate.Get(r.getContext(), id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get strategy template: %w", err)
+	}
+
+	return template, nil
+}
+
+// GetByName 이름으로 템플릿 조회 (없으면 nil)
+func (r *EntRepository) GetByName(name string) (*ent.StrategyTemplate, error) {
+	template, err := r.client.StrategyTemplate.Query().
+		Where(strategytemplate.Name(name)).
+		First(r.getContext())
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get strategy template by name: %w", err)
+	}
+
+	return template, nil
+}
+
+// Save 같은 이름의 템플릿이 있으면 갱신하고 없으면 생성
+func (r *EntRepository) Save(seed Seed) (*ent.StrategyTemplate, error) {
+	existing, err := r.GetByName(seed.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if existing == nil {
+		template, err := r.client.StrategyTemplate.Create().
+			SetName(seed.Name).
+			SetDescription(seed.Description).
+			SetCategory(seed.Category).
+			SetTemplateConfig(seed.Config).
+			SetInputSchema(seed.InputSchema).
+			SetVersion(seed.Version).
+			Save(r.getContext())
+		if err != nil {
+			return nil, fmt.Errorf("failed to create strategy template: %w", err)
+		}
+		return template, nil
+	}
+
+	template, err := existing.Update().
+		SetDescription(seed.Description).
+		SetCategory(seed.Category).
+		SetTemplateConfig(seed.Config).
+		SetInputSchema(seed.InputSchema).
+		SetVersion(seed.Version).
+		Save(r.getContext())
+	if err != nil {
+		return nil, fmt.Errorf("failed to update strategy template: %w", err)
+	}
+	return template, nil
+}
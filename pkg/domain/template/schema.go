@@ -0,0 +1,147 @@
+package template
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"auto-trader/pkg/shared/utils"
+)
+
+// ValidateInputs 템플릿 input_schema(JSON Schema 부분집합)로 사용자 입력값을 검증하고 기본값을 채운 입력값 반환
+//
+// 지원 키워드: type(object 루트, number/integer/string/boolean 속성), properties, required,
+// additionalProperties(false), default, enum, minimum, maximum, exclusiveMinimum, exclusiveMaximum,
+// minLength, maxLength
+func ValidateInputs(schema map[string]interface{}, inputs map[string]interface{}) (map[string]interface{}, error) {
+	properties, _ := schema["properties"].(map[string]interface{})
+	resolved := make(map[string]interface{}, len(properties))
+
+	for key, value := range inputs {
+		propertySchema, ok := properties[key].(map[string]interface{})
+		if !ok {
+			if additional, ok := schema["additionalProperties"].(bool); ok && !additional {
+				return nil, inputError(key, "정의되지 않은 입력값입니다")
+			}
+			resolved[key] = value
+			continue
+		}
+		normalized, err := validateValue(key, propertySchema, value)
+		if err != nil {
+			return nil, err
+		}
+		resolved[key] = normalized
+	}
+
+	for _, key := range sortedKeys(properties) {
+		if _, ok := resolved[key]; ok {
+			continue
+		}
+		propertySchema, _ := properties[key].(map[string]interface{})
+		if value, ok := propertySchema["default"]; ok {
+			resolved[key] = value
+		}
+	}
+
+	required, _ := schema["required"].([]interface{})
+	for _, item := range required {
+		key, _ := item.(string)
+		if _, ok := resolved[key]; !ok {
+			return nil, inputError(key, "필수 입력값입니다")
+		}
+	}
+
+	return resolved, nil
+}
+
+// validateValue 단일 속성 값 검증 (정수형은 float64로 정규화)
+func validateValue(key string, schema map[string]interface{}, value interface{}) (interface{}, error) {
+	switch schemaType, _ := schema["type"].(string); schemaType {
+	case "number", "integer":
+		number, ok := toNumber(value)
+		if !ok {
+			return nil, inputError(key, "숫자여야 합니다")
+		}
+		if schemaType == "integer" && number != math.Trunc(number) {
+			return nil, inputError(key, "정수여야 합니다")
+		}
+		if limit, ok := toNumber(schema["minimum"]); ok && number < limit {
+			return nil, inputError(key, fmt.Sprintf("%v 이상이어야 합니다", limit))
+		}
+		if limit, ok := toNumber(schema["maximum"]); ok && number > limit {
+			return nil, inputError(key, fmt.Sprintf("%v 이하여야 합니다", limit))
+		}
+		if limit, ok := toNumber(schema["exclusiveMinimum"]); ok && number <= limit {
+			return nil, inputError(key, fmt.Sprintf("%v보다 커야 합니다", limit))
+		}
+		if limit, ok := toNumber(schema["exclusiveMaximum"]); ok && number >= limit {
+			return nil, inputError(key, fmt.Sprintf("%v보다 작아야 합니다", limit))
+		}
+		value = number
+	case "string":
+		text, ok := value.(string)
+		if !ok {
+			return nil, inputError(key, "문자열이어야 합니다")
+		}
+		if limit, ok := toNumber(schema["minLength"]); ok && float64(len(text)) < limit {
+			return nil, inputError(key, fmt.Sprintf("%v자 이상이어야 합니다", limit))
+		}
+		if limit, ok := toNumber(schema["maxLength"]); ok && float64(len(text)) > limit {
+			return nil, inputError(key, fmt.Sprintf("%v자 이하여야 합니다", limit))
+		}
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			return nil, inputError(key, "true 또는 false여야 합니다")
+		}
+	case "":
+	default:
+		return nil, utils.Internal(fmt.Sprintf("템플릿 입력 스키마 오류 (%s): 지원하지 않는 타입 %q", key, schemaType), nil)
+	}
+
+	if options, ok := schema["enum"].([]interface{}); ok {
+		for _, option := range options {
+			if equalValue(option, value) {
+				return value, nil
+			}
+		}
+		return nil, inputError(key, fmt.Sprintf("허용되지 않는 값입니다 (허용: %v)", options))
+	}
+	return value, nil
+}
+
+// toNumber JSON 숫자 값을 float64로 변환
+func toNumber(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case float64:
+		return v, true
+	case float32:
+		return float64(v), true
+	case int:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	}
+	return 0, false
+}
+
+// equalValue enum 비교 (숫자는 타입과 무관하게 값으로 비교)
+func equalValue(a, b interface{}) bool {
+	if x, ok := toNumber(a); ok {
+		y, ok := toNumber(b)
+		return ok && x == y
+	}
+	return a == b
+}
+
+func inputError(key, message string) error {
+	return utils.BadRequest(fmt.Sprintf("입력값 오류 (inputs.%s): %s", key, message))
+}
+
+func sortedKeys(values map[string]interface{}) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
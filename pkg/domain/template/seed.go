@@ -0,0 +1,314 @@
+package template
+
+import (
+	"auto-trader/pkg/shared/config"
+)
+
+// Seed 기본 제공 템플릿 정의 (이름 기준으로 생성/갱신)
+type Seed struct {
+	Name        string
+	Description string
+	Category    string
+	Version     string
+	Config      map[string]interface{} // {"rules": RuleSet, "settings": 실행 설정} (입력값 참조 포함)
+	InputSchema map[string]interface{} // JSON Schema (object)
+}
+
+// 템플릿 분류
+const (
+	CategoryTrend          = "trend"
+	CategoryMeanReversion  = "mean_reversion"
+	CategoryVolatility     = "volatility"
+	CategoryRiskManagement = "risk_management"
+)
+
+// candleIntervals 템플릿에서 선택 가능한 봉 주기
+var candleIntervals = []interface{}{"1m", "5m", "15m", "30m", "1h", "1d"}
+
+// DefaultSeeds 기본 제공 템플릿 (수익 관리 템플릿의 기본값은 profit_management 설정 사용)
+func DefaultSeeds(profit config.ProfitManagementConfig) []Seed {
+	return []Seed{
+		maCrossoverSeed(),
+		rsiMeanReversionSeed(),
+		bollingerSeed(),
+		profitManagementSeed(profit),
+	}
+}
+
+func maCrossoverSeed() Seed {
+	fast := indicator("sma", map[string]interface{}{"period": input("fast_period")})
+	slow := indicator("sma", map[string]interface{}{"period": input("slow_period")})
+
+	return Seed{
+		Name:        "이동평균 크로스오버",
+		Description: "단기 이동평균이 장기 이동평균을 상향 돌파하면 매수하고, 하향 돌파하면 전량 매도합니다",
+		Category:    CategoryTrend,
+		Version:     "1.0.0",
+		Config: map[string]interface{}{
+			"rules": ruleSet(
+				rule("골든크로스 매수", all(compare(fast, "cross_above", slow), flat()), buy()),
+				rule("데드크로스 매도", all(compare(fast, "cross_below", slow), holding()), sellAll()),
+			),
+			"settings": settings(),
+		},
+		InputSchema: objectSchema(
+			[]string{"fast_period", "slow_period", "order_amount"},
+			map[string]interface{}{
+				"fast_period":     integerInput("단기 이동평균 기간", 2, 200, 5),
+				"slow_period":     integerInput("장기 이동평균 기간", 5, 400, 20),
+				"order_amount":    amountInput("1회 매수 금액", 1000),
+				"candle_interval": candleIntervalInput("5m"),
+			},
+		),
+	}
+}
+
+func rsiMeanReversionSeed() Seed {
+	rsi := indicator("rsi", map[string]interface{}{"period": input("rsi_period")})
+
+	return Seed{
+		Name:        "RSI 평균회귀",
+		Description: "RSI가 과매도 구간이면 매수하고, 과매수 구간에 도달하면 전량 매도합니다",
+		Category:    CategoryMeanReversion,
+		Version:     "1.0.0",
+		Config: map[string]interface{}{
+			"rules": ruleSet(
+				rule("과매도 매수", all(compare(rsi, "<", input("oversold")), flat()), buy()),
+				rule("과매수 매도", all(compare(rsi, ">", input("overbought")), holding()), sellAll()),
+			),
+			"settings": settings(),
+		},
+		InputSchema: objectSchema(
+			[]string{"rsi_period", "oversold", "overbought", "order_amount"},
+			map[string]interface{}{
+				"rsi_period":      integerInput("RSI 기간", 2, 100, 14),
+				"oversold":        numberInput("과매도 기준", 1, 50, 30),
+				"overbought":      numberInput("과매수 기준", 50, 99, 70),
+				"order_amount":    amountInput("1회 매수 금액", 1000),
+				"candle_interval": candleIntervalInput("5m"),
+			},
+		),
+	}
+}
+
+func bollingerSeed() Seed {
+	params := map[string]interface{}{"period": input("period"), "stddev": input("stddev")}
+	price := map[string]interface{}{"type": "price", "field": "last"}
+	lower := indicatorField("bollinger", params, "lower")
+	exit := indicatorField("bollinger", params, "{{exit_band}}")
+
+	exitBand := stringInput("청산 기준 밴드", []interface{}{"middle", "upper"}, "middle")
+
+	return Seed{
+		Name:        "볼린저 밴드 반등",
+		Description: "현재가가 하단 밴드 아래로 내려가면 매수하고, 중심선(또는 상단 밴드) 위로 회복하면 전량 매도합니다",
+		Category:    CategoryVolatility,
+		Version:     "1.0.0",
+		Config: map[string]interface{}{
+			"rules": ruleSet(
+				rule("하단 밴드 이탈 매수", all(compare(price, "<", lower), flat()), buy()),
+				rule("밴드 회복 매도", all(compare(price, ">", exit), holding()), sellAll()),
+			),
+			"settings": settings(),
+		},
+		InputSchema: objectSchema(
+			[]string{"period", "stddev", "order_amount"},
+			map[string]interface{}{
+				"period":          integerInput("이동평균 기간", 5, 200, 20),
+				"stddev":          numberInput("표준편차 배수", 0.5, 5, 2),
+				"exit_band":       exitBand,
+				"order_amount":    amountInput("1회 매수 금액", 1000),
+				"candle_interval": candleIntervalInput("5m"),
+			},
+		),
+	}
+}
+
+// profitManagementSeed ProfitManagementConfig 규칙을 보유 수익률 기준 규칙으로 표현
+//
+// 최대 수익/손실 도달 시 전량 매도, 목표 수익/손실 기준 도달 시 sell_percentage만큼 분할 매도,
+// 미보유 시 당일 등락률이 일일 손실/수익 기준 안에 있을 때만 안전 매수 금액으로 매수
+func profitManagementSeed(profit config.ProfitManagementConfig) Seed {
+	unrealized := map[string]interface{}{"type": "position", "field": "unrealized_pct"}
+	dailyChange := map[string]interface{}{"type": "price", "field": "daily_change_pct"}
+	partialSell := map[string]interface{}{"type": "SELL", "quantity": "{{sell_percentage}}%", "price": "MARKET"}
+
+	safeBuy := amountInput("안전 매수 금액", profit.SafeBuyAmount)
+	if profit.MinBuyAmount > 0 {
+		safeBuy["minimum"] = profit.MinBuyAmount
+	}
+	if profit.MaxBuyAmount > 0 {
+		safeBuy["maximum"] = profit.MaxBuyAmount
+	}
+
+	return Seed{
+		Name:        "수익 관리",
+		Description: "보유 수익률 기준으로 목표 수익/손실 도달 시 분할 매도, 최대 수익/손실 도달 시 전량 매도하고 미보유 시 안전 매수합니다",
+		Category:    CategoryRiskManagement,
+		Version:     "1.0.0",
+		Config: map[string]interface{}{
+			"rules": ruleSet(
+				rule("최대 수익 도달 전량 매도", all(holding(), compare(unrealized, ">=", input("max_profit_threshold"))), sellAll()),
+				rule("최대 손실 도달 전량 매도", all(holding(), compare(unrealized, "<=", negated("max_loss_threshold"))), sellAll()),
+				rule("목표 수익 분할 매도", all(holding(), compare(unrealized, ">=", input("profit_target_percent"))), partialSell),
+				rule("손실 기준 분할 매도", all(holding(), compare(unrealized, "<=", negated("loss_threshold_percent"))), partialSell),
+				rule("안전 매수", all(
+					flat(),
+					compare(dailyChange, ">", negated("daily_loss_threshold")),
+					compare(dailyChange, "<", input("daily_profit_threshold")),
+				), map[string]interface{}{"type": "BUY", "quantity": input("safe_buy_amount"), "price": "MARKET"}),
+			),
+			"settings": settings(),
+		},
+		InputSchema: objectSchema(
+			[]string{
+				"profit_target_percent", "loss_threshold_percent", "sell_percentage",
+				"max_profit_threshold", "max_loss_threshold",
+				"daily_loss_threshold", "daily_profit_threshold", "safe_buy_amount",
+			},
+			map[string]interface{}{
+				"profit_target_percent":  percentInput("목표 수익률 (%)", profit.ProfitTargetPercent),
+				"loss_threshold_percent": percentInput("분할 매도 손실 기준 (%)", profit.LossThresholdPercent),
+				"sell_percentage":        percentInput("분할 매도 비율 (%)", profit.SellPercentage),
+				"max_profit_threshold":   percentInput("전량 매도 최대 수익률 (%)", profit.MaxProfitThreshold),
+				"max_loss_threshold":     percentInput("전량 매도 최대 손실률 (%)", profit.MaxLossThreshold),
+				"daily_loss_threshold":   percentInput("매수 허용 당일 최대 하락률 (%)", profit.DailyLossThreshold),
+				"daily_profit_threshold": percentInput("매수 허용 당일 최대 상승률 (%)", profit.DailyProfitThreshold),
+				"safe_buy_amount":        safeBuy,
+				"candle_interval":        candleIntervalInput("5m"),
+			},
+		),
+	}
+}
+
+// 규칙 구성 헬퍼
+
+func ruleSet(rules ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"version": 1, "rules": rules}
+}
+
+func rule(name string, when, action map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"name": name, "when": when, "action": action}
+}
+
+func all(nodes ...interface{}) map[string]interface{} {
+	return map[string]interface{}{"all": nodes}
+}
+
+func compare(left interface{}, op string, right interface{}) map[string]interface{} {
+	if ref, ok := right.(map[string]interface{}); ok && ref["$input"] != nil {
+		right = map[string]interface{}{"type": "const", "value": ref}
+	}
+	return map[string]interface{}{"left": left, "op": op, "right": right}
+}
+
+func indicator(name string, params map[string]interface{}) map[string]interface{} {
+	return map[string]interface{}{"type": "indicator", "name": name, "params": params}
+}
+
+func indicatorField(name string, params map[string]interface{}, field string) map[string]interface{} {
+	operand := indicator(name, params)
+	operand["field"] = field
+	return operand
+}
+
+// flat 미보유 조건, holding 보유 조건
+func flat() map[string]interface{} {
+	return compare(map[string]interface{}{"type": "position", "field": "quantity"}, "==", map[string]interface{}{"type": "const", "value": 0})
+}
+
+func holding() map[string]interface{} {
+	return compare(map[string]interface{}{"type": "position", "field": "quantity"}, ">", map[string]interface{}{"type": "const", "value": 0})
+}
+
+func buy() map[string]interface{} {
+	return map[string]interface{}{"type": "BUY", "quantity": input("order_amount"), "price": "MARKET"}
+}
+
+func sellAll() map[string]interface{} {
+	return map[string]interface{}{"type": "SELL", "quantity": "ALL", "price": "MARKET"}
+}
+
+func input(name string) map[string]interface{} {
+	return map[string]interface{}{"$input": name}
+}
+
+func negated(name string) map[string]interface{} {
+	return map[string]interface{}{"$input": name, "scale": -1}
+}
+
+// settings 공통 실행 설정 (봉 주기는 입력값 사용)
+func settings() map[string]interface{} {
+	return map[string]interface{}{"candle_interval": "{{candle_interval}}"}
+}
+
+// 입력 스키마 헬퍼
+
+func objectSchema(required []string, properties map[string]interface{}) map[string]interface{} {
+	requiredList := make([]interface{}, len(required))
+	for i, key := range required {
+		requiredList[i] = key
+	}
+	return map[string]interface{}{
+		"type":                 "object",
+		"required":             requiredList,
+		"properties":           properties,
+		"additionalProperties": false,
+	}
+}
+
+func integerInput(title string, minimum, maximum, defaultValue float64) map[string]interface{} {
+	property := numberInput(title, minimum, maximum, defaultValue)
+	property["type"] = "integer"
+	return property
+}
+
+func numberInput(title string, minimum, maximum, defaultValue float64) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "number",
+		"title":   title,
+		"minimum": minimum,
+		"maximum": maximum,
+		"default": defaultValue,
+	}
+}
+
+// percentInput 0 초과 100 이하 비율 (설정값이 없으면 기본값 없이 필수 입력)
+func percentInput(title string, defaultValue float64) map[string]interface{} {
+	property := map[string]interface{}{
+		"type":             "number",
+		"title":            title,
+		"exclusiveMinimum": 0.0,
+		"maximum":          100.0,
+	}
+	if defaultValue > 0 {
+		property["default"] = defaultValue
+	}
+	return property
+}
+
+// amountInput 0 초과 금액 (기본값이 없으면 필수 입력)
+func amountInput(title string, defaultValue float64) map[string]interface{} {
+	property := map[string]interface{}{
+		"type":             "number",
+		"title":            title,
+		"exclusiveMinimum": 0.0,
+	}
+	if defaultValue > 0 {
+		property["default"] = defaultValue
+	}
+	return property
+}
+
+func stringInput(title string, options []interface{}, defaultValue string) map[string]interface{} {
+	return map[string]interface{}{
+		"type":    "string",
+		"title":   title,
+		"enum":    options,
+		"default": defaultValue,
+	}
+}
+
+func candleIntervalInput(defaultValue string) map[string]interface{} {
+	return stringInput("지표 봉 주기", candleIntervals, defaultValue)
+}
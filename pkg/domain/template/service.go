@@ -0,0 +1,218 @@
+package template
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/strategy"
+	strategydto "auto-trader/pkg/domain/strategy/dto"
+	"auto-trader/pkg/domain/template/dto"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/utils"
+
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+)
+
+// Service 전략 템플릿 서비스 인터페이스
+type Service interface {
+	GetTemplates(q dto.GetTemplateListQuery) (*dto.TemplateListResponse, error)
+	GetTemplate(id string) (*dto.TemplateResponse, error)
+	Instantiate(userID, id string, body dto.InstantiateTemplateBody) (*dto.InstantiateResponse, error)
+	SeedDefaults() error
+}
+
+// ServiceImpl 전략 템플릿 서비스 구현체
+type ServiceImpl struct {
+	repository Repository
+	strategies strategy.Service
+	profit     config.ProfitManagementConfig
+}
+
+// NewService 새로운 전략 템플릿 서비스 생성
+func NewService(repository Repository, strategies strategy.Service, cfg *config.Config) Service {
+	return &ServiceImpl{
+		repository: repository,
+		strategies: strategies,
+		profit:     cfg.ProfitManagement,
+	}
+}
+
+// SeedDefaults 기본 제공 템플릿을 이름 기준으로 생성/갱신
+func (s *ServiceImpl) SeedDefaults() error {
+	for _, seed := range DefaultSeeds(s.profit) {
+		if _, err := s.repository.Save(seed); err != nil {
+			return fmt.Errorf("기본 템플릿 저장 실패 (%s): %w", seed.Name, err)
+		}
+	}
+	return nil
+}
+
+// GetTemplates 템플릿 목록 조회 (입력 스키마 포함, 규칙 본문 제외)
+func (s *ServiceImpl) GetTemplates(q dto.GetTemplateListQuery) (*dto.TemplateListResponse, error) {
+	templates, err := s.repository.GetAll(q.Category)
+	if err != nil {
+		return nil, fmt.Errorf("템플릿 목록 조회 실패: %w", err)
+	}
+
+	response := &dto.TemplateListResponse{
+		Templates: make([]*dto.TemplateResponse, 0, len(templates)),
+		Total:     len(templates),
+	}
+	for _, template := range templates {
+		response.Templates = append(response.Templates, toTemplateResponse(template, false))
+	}
+	return response, nil
+}
+
+// GetTemplate 템플릿 상세 조회
+func (s *ServiceImpl) GetTemplate(id string) (*dto.TemplateResponse, error) {
+	template, err := s.getTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+	return toTemplateResponse(template, true), nil
+}
+
+// Instantiate 입력값을 검증하고 템플릿 설정과 병합해 전략 생성
+func (s *ServiceImpl) Instantiate(userID, id string, body dto.InstantiateTemplateBody) (*dto.InstantiateResponse, error) {
+	uid, err := uuid.Parse(userID)
+	if err != nil {
+		return nil, utils.Unauthorized("사용자 정보를 확인할 수 없습니다")
+	}
+
+	template, err := s.getTemplate(id)
+	if err != nil {
+		return nil, err
+	}
+
+	inputs, err := ValidateInputs(template.InputSchema, body.Inputs)
+	if err != nil {
+		return nil, err
+	}
+	rendered, err := Render(template.TemplateConfig, inputs)
+	if err != nil {
+		return nil, err
+	}
+
+	settings, err := decodeSettings(rendered["settings"])
+	if err != nil {
+		return nil, err
+	}
+	settings = overrideSettings(settings, body.Settings)
+	rules, _ := rendered["rules"].(map[string]interface{})
+
+	description := body.Description
+	if description == nil {
+		description = template.Description
+	}
+	if description == nil {
+		empty := ""
+		description = &empty
+	}
+
+	// 규칙/실행 설정 검증은 전략 생성과 동일하게 적용
+	details, err := s.strategies.CreateStrategy(&strategydto.CreateStrategyBody{
+		Name:        body.Name,
+		Symbol:      body.Symbol,
+		Exchange:    body.Exchange,
+		Description: description,
+		UserID:      uid,
+		Active:      body.Active,
+		TradingMode: body.TradingMode,
+		Rules:       rules,
+		TemplateID:  &template.ID,
+		UserInputs:  inputs,
+		Settings:    settings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	strategyConfig, err := s.strategies.GetStrategyConfig(details.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	logrus.Infof("🧩 템플릿으로 전략 생성: %s ← %s (v%s)", details.Name, template.Name, template.Version)
+	return &dto.InstantiateResponse{
+		Strategy: details,
+		Config:   strategyConfig,
+	}, nil
+}
+
+func (s *ServiceImpl) getTemplate(id string) (*ent.StrategyTemplate, error) {
+	templateID, err := uuid.Parse(id)
+	if err != nil {
+		return nil, utils.BadRequest("잘못된 템플릿 ID 형식입니다")
+	}
+
+	template, err := s.repository.GetByID(templateID)
+	if err != nil {
+		return nil, fmt.Errorf("템플릿 조회 실패: %w", err)
+	}
+	if template == nil {
+		return nil, utils.NotFound("template", "템플릿을 찾을 수 없습니다")
+	}
+	return template, nil
+}
+
+// decodeSettings 치환된 템플릿 settings를 실행 설정 구조로 변환
+func decodeSettings(raw interface{}) (*strategydto.StrategySettingsBody, error) {
+	if raw == nil {
+		return &strategydto.StrategySettingsBody{}, nil
+	}
+
+	data, err := json.Marshal(raw)
+	if err != nil {
+		return nil, templateError(fmt.Sprintf("settings 형식 오류: %v", err))
+	}
+	var settings strategydto.StrategySettingsBody
+	if err := json.Unmarshal(data, &settings); err != nil {
+		return nil, templateError(fmt.Sprintf("settings 형식 오류: %v", err))
+	}
+	return &settings, nil
+}
+
+// overrideSettings 요청에서 지정한 실행 설정 항목만 템플릿 값 위에 덮어쓰기
+func overrideSettings(base, override *strategydto.StrategySettingsBody) *strategydto.StrategySettingsBody {
+	if override == nil {
+		return base
+	}
+
+	merged := *base
+	if override.Symbols != nil {
+		merged.Symbols = override.Symbols
+	}
+	if override.Conditions != nil {
+		merged.Conditions = override.Conditions
+	}
+	if override.CandleInterval != "" {
+		merged.CandleInterval = override.CandleInterval
+	}
+	if override.Schedule != nil {
+		merged.Schedule = override.Schedule
+	}
+	if override.Sizing != nil {
+		merged.Sizing = override.Sizing
+	}
+	return &merged
+}
+
+func toTemplateResponse(template *ent.StrategyTemplate, withConfig bool) *dto.TemplateResponse {
+	response := &dto.TemplateResponse{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: template.Description,
+		Category:    template.Category,
+		Version:     template.Version,
+		InputSchema: template.InputSchema,
+		CreatedAt:   template.CreatedAt,
+		UpdatedAt:   template.UpdatedAt,
+	}
+	if withConfig {
+		response.TemplateConfig = template.TemplateConfig
+	}
+	return response
+}
@@ -0,0 +1,244 @@
+package calendar
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// Session 미국 주식 거래 세션
+type Session string
+
+const (
+	SessionClosed  Session = "closed"  // 휴장 / 세션 외 시간
+	SessionPre     Session = "pre"     // 프리마켓 04:00 ~ 09:30
+	SessionRegular Session = "regular" // 정규장 09:30 ~ 16:00 (조기 폐장일 13:00)
+	SessionPost    Session = "post"    // 애프터마켓 16:00 ~ 20:00 (조기 폐장일 13:00 ~ 17:00)
+)
+
+// 지원 거래소 (NYSE/NASDAQ/AMEX는 같은 거래 일정을 사용)
+const (
+	ExchangeNYSE   = "NYSE"
+	ExchangeNASDAQ = "NASDAQ"
+	ExchangeAMEX   = "AMEX"
+)
+
+// 세션 시각 (미국 동부 현지 시각, 자정 기준 경과 시간)
+const (
+	preOpen        = 4 * time.Hour
+	regularOpen    = 9*time.Hour + 30*time.Minute
+	regularClose   = 16 * time.Hour
+	postClose      = 20 * time.Hour
+	earlyClose     = 13 * time.Hour
+	earlyPostClose = 17 * time.Hour
+)
+
+// searchDays 다음 개장/폐장 탐색 최대 일수 (연휴가 길어도 충분한 기간)
+const searchDays = 14
+
+// Day 하루의 거래 일정 (휴장일이면 세션 시각이 zero)
+type Day struct {
+	Date         string // YYYY-MM-DD (미국 동부 기준)
+	TradingDay   bool
+	Holiday      string // 휴장 사유 (주말은 빈 값)
+	EarlyClose   bool
+	PreOpen      time.Time
+	RegularOpen  time.Time
+	RegularClose time.Time
+	PostClose    time.Time
+}
+
+// Calendar 미국 주식시장(NYSE/NASDAQ/AMEX) 거래 일정
+// 모든 시각은 America/New_York 기준으로 계산하므로 서머타임 전환이 자동 반영된다.
+type Calendar struct {
+	location *time.Location
+}
+
+// New 미국 주식시장 거래 일정 생성
+func New() *Calendar {
+	location, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		location = time.UTC
+	}
+	return &Calendar{location: location}
+}
+
+// Location 거래 일정 기준 시간대
+func (c *Calendar) Location() *time.Location {
+	return c.location
+}
+
+// ParseSession 세션 이름 해석
+func ParseSession(raw string) (Session, error) {
+	switch Session(strings.ToLower(strings.TrimSpace(raw))) {
+	case SessionPre:
+		return SessionPre, nil
+	case SessionRegular:
+		return SessionRegular, nil
+	case SessionPost:
+		return SessionPost, nil
+	}
+	return "", fmt.Errorf("지원하지 않는 세션: %q (pre, regular, post)", raw)
+}
+
+// ParseSessions 세션 목록 해석 (빈 목록은 정규장만)
+func ParseSessions(raw []string) ([]Session, error) {
+	if len(raw) == 0 {
+		return []Session{SessionRegular}, nil
+	}
+	sessions := make([]Session, 0, len(raw))
+	for _, item := range raw {
+		session, err := ParseSession(item)
+		if err != nil {
+			return nil, err
+		}
+		sessions = append(sessions, session)
+	}
+	return sessions, nil
+}
+
+// Day t가 속한 날짜(미국 동부 기준)의 거래 일정
+func (c *Calendar) Day(t time.Time) Day {
+	local := t.In(c.location)
+	midnight := time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, c.location)
+	day := Day{Date: midnight.Format("2006-01-02")}
+
+	if weekend(midnight) {
+		return day
+	}
+	if name := Holiday(midnight); name != "" {
+		day.Holiday = name
+		return day
+	}
+
+	day.TradingDay = true
+	day.EarlyClose = IsEarlyClose(midnight)
+	day.PreOpen = at(midnight, preOpen)
+	day.RegularOpen = at(midnight, regularOpen)
+	day.RegularClose = at(midnight, regularClose)
+	day.PostClose = at(midnight, postClose)
+	if day.EarlyClose {
+		day.RegularClose = at(midnight, earlyClose)
+		day.PostClose = at(midnight, earlyPostClose)
+	}
+	return day
+}
+
+// Days from ~ to 기간(양 끝 포함)의 거래 일정
+func (c *Calendar) Days(from, to time.Time) []Day {
+	start := from.In(c.location)
+	end := to.In(c.location)
+	last := time.Date(end.Year(), end.Month(), end.Day(), 12, 0, 0, 0, c.location)
+
+	var days []Day
+	for d := time.Date(start.Year(), start.Month(), start.Day(), 12, 0, 0, 0, c.location); !d.After(last); d = d.AddDate(0, 0, 1) {
+		days = append(days, c.Day(d))
+	}
+	return days
+}
+
+// SessionAt t 시점의 거래 세션
+func (c *Calendar) SessionAt(t time.Time) Session {
+	day := c.Day(t)
+	if !day.TradingDay {
+		return SessionClosed
+	}
+	for _, session := range []Session{SessionPre, SessionRegular, SessionPost} {
+		start, end := day.bounds(session)
+		if !t.Before(start) && t.Before(end) {
+			return session
+		}
+	}
+	return SessionClosed
+}
+
+// IsOpen t 시점이 sessions 중 하나에 속하는지 (sessions 미지정 시 정규장)
+func (c *Calendar) IsOpen(t time.Time, sessions ...Session) bool {
+	if len(sessions) == 0 {
+		sessions = []Session{SessionRegular}
+	}
+	current := c.SessionAt(t)
+	for _, session := range sessions {
+		if session == current {
+			return true
+		}
+	}
+	return false
+}
+
+// NextOpen t 이후 sessions 중 가장 먼저 시작하는 세션의 시작 시각 (이미 열려 있으면 다음 시작 시각)
+func (c *Calendar) NextOpen(t time.Time, sessions ...Session) time.Time {
+	return c.next(t, sessions, true)
+}
+
+// NextClose sessions 중 현재 진행 중이거나 다음에 열리는 세션의 종료 시각
+func (c *Calendar) NextClose(t time.Time, sessions ...Session) time.Time {
+	return c.next(t, sessions, false)
+}
+
+func (c *Calendar) next(t time.Time, sessions []Session, open bool) time.Time {
+	if len(sessions) == 0 {
+		sessions = []Session{SessionRegular}
+	}
+	local := t.In(c.location)
+	for i := 0; i <= searchDays; i++ {
+		day := c.Day(time.Date(local.Year(), local.Month(), local.Day()+i, 12, 0, 0, 0, c.location))
+		if !day.TradingDay {
+			continue
+		}
+
+		var best time.Time
+		for _, session := range sessions {
+			start, end := day.bounds(session)
+			edge := end
+			if open {
+				edge = start
+			}
+			// 이어지는 세션 경계(예: pre → regular)는 개장/폐장으로 보지 않음
+			if day.joined(edge, sessions) {
+				continue
+			}
+			if edge.After(t) && (best.IsZero() || edge.Before(best)) {
+				best = edge
+			}
+		}
+		if !best.IsZero() {
+			return best
+		}
+	}
+	return time.Time{}
+}
+
+// bounds 세션 시작/종료 시각
+func (d Day) bounds(session Session) (time.Time, time.Time) {
+	switch session {
+	case SessionPre:
+		return d.PreOpen, d.RegularOpen
+	case SessionRegular:
+		return d.RegularOpen, d.RegularClose
+	case SessionPost:
+		return d.RegularClose, d.PostClose
+	}
+	return time.Time{}, time.Time{}
+}
+
+// joined edge에서 끝나는 세션과 시작하는 세션이 모두 sessions에 포함되는지
+func (d Day) joined(edge time.Time, sessions []Session) bool {
+	var ends, starts bool
+	for _, session := range sessions {
+		start, end := d.bounds(session)
+		starts = starts || start.Equal(edge)
+		ends = ends || end.Equal(edge)
+	}
+	return starts && ends
+}
+
+func at(midnight time.Time, offset time.Duration) time.Time {
+	// 서머타임 전환일에도 현지 벽시계 시각이 되도록 시/분으로 생성
+	return time.Date(midnight.Year(), midnight.Month(), midnight.Day(),
+		int(offset/time.Hour), int(offset%time.Hour/time.Minute), 0, 0, midnight.Location())
+}
+
+func weekend(t time.Time) bool {
+	return t.Weekday() == time.Saturday || t.Weekday() == time.Sunday
+}
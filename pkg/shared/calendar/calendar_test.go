@@ -0,0 +1,137 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func newCalendar(t *testing.T) *Calendar {
+	t.Helper()
+	c := New()
+	if c.Location() == time.UTC {
+		t.Skip("America/New_York 시간대 정보 없음")
+	}
+	return c
+}
+
+func utc(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("잘못된 테스트 시각 %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestCalendarDay(t *testing.T) {
+	c := newCalendar(t)
+
+	tests := []struct {
+		name         string
+		at           string
+		tradingDay   bool
+		holiday      string
+		earlyClose   bool
+		regularOpen  string
+		regularClose string
+		postClose    string
+	}{
+		{"서머타임 전 평일", "2024-03-08T15:00:00Z", true, "", false, "2024-03-08T14:30:00Z", "2024-03-08T21:00:00Z", "2024-03-09T01:00:00Z"},
+		{"서머타임 후 평일", "2024-03-11T15:00:00Z", true, "", false, "2024-03-11T13:30:00Z", "2024-03-11T20:00:00Z", "2024-03-12T00:00:00Z"},
+		{"조기 폐장 (추수감사절 다음 날)", "2024-11-29T15:00:00Z", true, "", true, "2024-11-29T14:30:00Z", "2024-11-29T18:00:00Z", "2024-11-29T22:00:00Z"},
+		{"조기 폐장 (독립기념일 전날)", "2024-07-03T15:00:00Z", true, "", true, "2024-07-03T13:30:00Z", "2024-07-03T17:00:00Z", "2024-07-03T21:00:00Z"},
+		{"대체 휴장일", "2022-06-20T15:00:00Z", false, "Juneteenth", false, "", "", ""},
+		{"주말", "2024-03-09T15:00:00Z", false, "", false, "", "", ""},
+		// UTC로는 다음 날이지만 미국 동부 기준 성금요일
+		{"현지 날짜 기준", "2024-03-30T02:00:00Z", false, "Good Friday", false, "", "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			day := c.Day(utc(t, tt.at))
+			if day.TradingDay != tt.tradingDay || day.Holiday != tt.holiday || day.EarlyClose != tt.earlyClose {
+				t.Fatalf("Day(%s) = {TradingDay: %v, Holiday: %q, EarlyClose: %v}, want {%v, %q, %v}",
+					tt.at, day.TradingDay, day.Holiday, day.EarlyClose, tt.tradingDay, tt.holiday, tt.earlyClose)
+			}
+			if !tt.tradingDay {
+				return
+			}
+			for _, check := range []struct {
+				field string
+				got   time.Time
+				want  string
+			}{
+				{"RegularOpen", day.RegularOpen, tt.regularOpen},
+				{"RegularClose", day.RegularClose, tt.regularClose},
+				{"PostClose", day.PostClose, tt.postClose},
+			} {
+				if want := utc(t, check.want); !check.got.Equal(want) {
+					t.Errorf("Day(%s).%s = %s, want %s", tt.at, check.field, check.got.UTC().Format(time.RFC3339), check.want)
+				}
+			}
+		})
+	}
+}
+
+func TestCalendarSessionAt(t *testing.T) {
+	c := newCalendar(t)
+
+	tests := []struct {
+		name string
+		at   string
+		want Session
+	}{
+		{"프리마켓 시작", "2024-03-11T08:00:00Z", SessionPre},
+		{"정규장 시작", "2024-03-11T13:30:00Z", SessionRegular},
+		{"정규장 종료 직전", "2024-03-11T19:59:59Z", SessionRegular},
+		{"애프터마켓", "2024-03-11T20:00:00Z", SessionPost},
+		{"애프터마켓 종료", "2024-03-12T00:00:00Z", SessionClosed},
+		{"조기 폐장 후 애프터마켓", "2024-11-29T19:00:00Z", SessionPost},
+		{"조기 폐장일 애프터마켓 종료", "2024-11-29T22:30:00Z", SessionClosed},
+		{"휴장일", "2024-03-29T15:00:00Z", SessionClosed},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := c.SessionAt(utc(t, tt.at)); got != tt.want {
+				t.Errorf("SessionAt(%s) = %s, want %s", tt.at, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalendarNextOpenClose(t *testing.T) {
+	c := newCalendar(t)
+	regular := []Session{SessionRegular}
+	extended := []Session{SessionPre, SessionRegular}
+
+	tests := []struct {
+		name     string
+		at       string
+		sessions []Session
+		open     bool
+		want     string
+	}{
+		{"성금요일과 주말을 건너뛴 다음 개장", "2024-03-28T21:00:00Z", regular, true, "2024-04-01T13:30:00Z"},
+		{"서머타임 전환 주말 이후 개장", "2024-03-08T22:00:00Z", regular, true, "2024-03-11T13:30:00Z"},
+		{"개장 중이면 다음 날 개장", "2024-04-01T14:00:00Z", regular, true, "2024-04-02T13:30:00Z"},
+		{"조기 폐장일 폐장", "2024-11-29T15:00:00Z", regular, false, "2024-11-29T18:00:00Z"},
+		{"이어지는 세션 경계는 개장으로 보지 않음", "2024-04-01T09:00:00Z", extended, true, "2024-04-02T08:00:00Z"},
+		{"이어지는 세션 경계는 폐장으로 보지 않음", "2024-04-01T09:00:00Z", extended, false, "2024-04-01T20:00:00Z"},
+		{"연말 휴장 이후 개장", "2024-12-31T22:00:00Z", regular, true, "2025-01-02T14:30:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got time.Time
+			if tt.open {
+				got = c.NextOpen(utc(t, tt.at), tt.sessions...)
+			} else {
+				got = c.NextClose(utc(t, tt.at), tt.sessions...)
+			}
+			if want := utc(t, tt.want); !got.Equal(want) {
+				t.Errorf("next(%s, open=%v) = %s, want %s", tt.at, tt.open, got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
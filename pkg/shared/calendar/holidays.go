@@ -0,0 +1,110 @@
+package calendar
+
+import "time"
+
+// Holiday 미국 주식시장 휴장일 이름 (휴장일이 아니면 빈 값)
+//
+// 토요일 공휴일은 전날 금요일, 일요일 공휴일은 다음 날 월요일에 휴장한다.
+// 단, 새해 첫날이 토요일이면 전년도 12월 31일에 휴장하지 않는다 (NYSE 규정).
+func Holiday(date time.Time) string {
+	year, month, day := date.Date()
+	for _, h := range holidays(year) {
+		y, m, d := h.date.Date()
+		if y == year && m == month && d == day {
+			return h.name
+		}
+	}
+	return ""
+}
+
+// IsEarlyClose 13:00 조기 폐장일 여부
+// 독립기념일 전날(7/3, 평일이고 휴장일이 아닐 때), 추수감사절 다음 날, 크리스마스이브(평일일 때)
+func IsEarlyClose(date time.Time) bool {
+	if weekend(date) || Holiday(date) != "" {
+		return false
+	}
+	year, month, day := date.Date()
+	switch {
+	case month == time.July && day == 3:
+		return true
+	case month == time.December && day == 24:
+		return true
+	case month == time.November:
+		thanksgiving := nthWeekday(year, time.November, time.Thursday, 4, date.Location())
+		return day == thanksgiving.Day()+1
+	}
+	return false
+}
+
+type holiday struct {
+	name string
+	date time.Time
+}
+
+func holidays(year int) []holiday {
+	loc := time.UTC
+	list := []holiday{
+		{"New Year's Day", observed(time.Date(year, time.January, 1, 0, 0, 0, 0, loc))},
+		{"Martin Luther King Jr. Day", nthWeekday(year, time.January, time.Monday, 3, loc)},
+		{"Washington's Birthday", nthWeekday(year, time.February, time.Monday, 3, loc)},
+		{"Good Friday", easter(year, loc).AddDate(0, 0, -2)},
+		{"Memorial Day", lastWeekday(year, time.May, time.Monday, loc)},
+		{"Independence Day", observed(time.Date(year, time.July, 4, 0, 0, 0, 0, loc))},
+		{"Labor Day", nthWeekday(year, time.September, time.Monday, 1, loc)},
+		{"Thanksgiving Day", nthWeekday(year, time.November, time.Thursday, 4, loc)},
+		{"Christmas Day", observed(time.Date(year, time.December, 25, 0, 0, 0, 0, loc))},
+	}
+	// 준틴스는 2022년부터 휴장
+	if year >= 2022 {
+		list = append(list, holiday{"Juneteenth", observed(time.Date(year, time.June, 19, 0, 0, 0, 0, loc))})
+	}
+	// 새해 첫날이 토요일이면 대체 휴장 없음
+	if first := list[0].date; first.Year() != year {
+		list = list[1:]
+	}
+	return list
+}
+
+// observed 주말 공휴일의 대체 휴장일
+func observed(date time.Time) time.Time {
+	switch date.Weekday() {
+	case time.Saturday:
+		return date.AddDate(0, 0, -1)
+	case time.Sunday:
+		return date.AddDate(0, 0, 1)
+	}
+	return date
+}
+
+// nthWeekday month의 n번째 weekday
+func nthWeekday(year int, month time.Month, weekday time.Weekday, n int, loc *time.Location) time.Time {
+	first := time.Date(year, month, 1, 0, 0, 0, 0, loc)
+	offset := (int(weekday) - int(first.Weekday()) + 7) % 7
+	return first.AddDate(0, 0, offset+7*(n-1))
+}
+
+// lastWeekday month의 마지막 weekday
+func lastWeekday(year int, month time.Month, weekday time.Weekday, loc *time.Location) time.Time {
+	last := time.Date(year, month+1, 0, 0, 0, 0, 0, loc)
+	offset := (int(last.Weekday()) - int(weekday) + 7) % 7
+	return last.AddDate(0, 0, -offset)
+}
+
+// easter 부활절 (그레고리력, Anonymous Gregorian algorithm)
+func easter(year int, loc *time.Location) time.Time {
+	a := year % 19
+	b := year / 100
+	c := year % 100
+	d := b / 4
+	e := b % 4
+	f := (b + 8) / 25
+	g := (b - f + 1) / 3
+	h := (19*a + b - d - g + 15) % 30
+	i := c / 4
+	k := c % 4
+	l := (32 + 2*e + 2*i - h - k) % 7
+	m := (a + 11*h + 22*l) / 451
+	month := (h + l - 7*m + 114) / 31
+	day := (h+l-7*m+114)%31 + 1
+	return time.Date(year, time.Month(month), day, 0, 0, 0, 0, loc)
+}
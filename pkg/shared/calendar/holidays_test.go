@@ -0,0 +1,73 @@
+package calendar
+
+import (
+	"testing"
+	"time"
+)
+
+func date(year int, month time.Month, day int) time.Time {
+	return time.Date(year, month, day, 0, 0, 0, 0, time.UTC)
+}
+
+func TestHoliday(t *testing.T) {
+	tests := []struct {
+		name string
+		date time.Time
+		want string
+	}{
+		{"신년", date(2024, time.January, 1), "New Year's Day"},
+		{"일요일 신년은 월요일 대체 휴장", date(2023, time.January, 2), "New Year's Day"},
+		{"토요일 신년은 전년도 12/31 대체 없음", date(2021, time.December, 31), ""},
+		{"토요일 신년은 전년도 12/31 대체 없음 (2027)", date(2027, time.December, 31), ""},
+		{"마틴 루터 킹 데이 (1월 셋째 월요일)", date(2024, time.January, 15), "Martin Luther King Jr. Day"},
+		{"대통령의 날 (2월 셋째 월요일)", date(2024, time.February, 19), "Washington's Birthday"},
+		{"성금요일 2024", date(2024, time.March, 29), "Good Friday"},
+		{"성금요일 2025", date(2025, time.April, 18), "Good Friday"},
+		{"메모리얼 데이 (5월 마지막 월요일)", date(2024, time.May, 27), "Memorial Day"},
+		{"준틴스", date(2024, time.June, 19), "Juneteenth"},
+		{"일요일 준틴스는 월요일 대체 휴장", date(2022, time.June, 20), "Juneteenth"},
+		{"2022년 이전 준틴스는 개장", date(2021, time.June, 18), ""},
+		{"토요일 독립기념일은 금요일 대체 휴장", date(2020, time.July, 3), "Independence Day"},
+		{"일요일 독립기념일은 월요일 대체 휴장", date(2021, time.July, 5), "Independence Day"},
+		{"노동절 (9월 첫째 월요일)", date(2024, time.September, 2), "Labor Day"},
+		{"추수감사절 (11월 넷째 목요일)", date(2024, time.November, 28), "Thanksgiving Day"},
+		{"토요일 크리스마스는 금요일 대체 휴장", date(2021, time.December, 24), "Christmas Day"},
+		{"일요일 크리스마스는 월요일 대체 휴장", date(2022, time.December, 26), "Christmas Day"},
+		{"평일", date(2024, time.March, 28), ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := Holiday(tt.date); got != tt.want {
+				t.Errorf("Holiday(%s) = %q, want %q", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
+
+func TestIsEarlyClose(t *testing.T) {
+	tests := []struct {
+		name string
+		date time.Time
+		want bool
+	}{
+		{"독립기념일 전날", date(2024, time.July, 3), true},
+		{"7/3이 대체 휴장일이면 조기 폐장 아님", date(2020, time.July, 3), false},
+		{"7/3이 주말이면 조기 폐장 아님", date(2021, time.July, 3), false},
+		{"추수감사절 다음 날", date(2024, time.November, 29), true},
+		{"추수감사절 다음 날 (11월 말 목요일)", date(2023, time.November, 24), true},
+		{"추수감사절 당일은 휴장", date(2024, time.November, 28), false},
+		{"크리스마스이브 (평일)", date(2024, time.December, 24), true},
+		{"크리스마스이브가 대체 휴장일", date(2021, time.December, 24), false},
+		{"크리스마스이브가 주말", date(2022, time.December, 24), false},
+		{"평일", date(2024, time.December, 23), false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := IsEarlyClose(tt.date); got != tt.want {
+				t.Errorf("IsEarlyClose(%s) = %v, want %v", tt.date.Format("2006-01-02"), got, tt.want)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ type Config struct {
 	ProfitManagement ProfitManagementConfig `mapstructure:"profit_management"`
 	KIS              KISConfig              `mapstructure:"kis"`
 	JWT              JWTConfig              `mapstructure:"jwt"`
+	Security         SecurityConfig         `mapstructure:"security"`
 }
 
 // ServerConfig 서버 설정
@@ -37,10 +38,17 @@ type DatabaseConfig struct {
 
 // RiskConfig 리스크 관리 설정
 type RiskConfig struct {
-	MaxPositionSize    float64 `mapstructure:"max_position_size"`
-	MaxDailyLoss       float64 `mapstructure:"max_daily_loss"`
-	MaxDrawdown        float64 `mapstructure:"max_drawdown"`
-	StopLossPercentage float64 `mapstructure:"stop_loss_percentage"`
+	MaxPositionSize    float64       `mapstructure:"max_position_size"`
+	MaxDailyLoss       float64       `mapstructure:"max_daily_loss"`
+	MaxDrawdown        float64       `mapstructure:"max_drawdown"` // 최고 평가금액 대비 허용 낙폭 비율 (초과 시 거래 중지, 0이면 검사 안 함)
+	StopLossPercentage float64       `mapstructure:"stop_loss_percentage"`
+	DefaultStopLoss    bool          `mapstructure:"default_stop_loss"`   // 보호 규칙이 없는 보유 종목에 stop_loss_percentage 손절 적용
+	MaxOrderNotional   float64       `mapstructure:"max_order_notional"`  // 주문 1건 최대 금액 (0이면 검사 안 함)
+	MaxOpenOrders      int           `mapstructure:"max_open_orders"`     // 사용자 계좌별 최대 미체결 주문 수 (0이면 검사 안 함)
+	PriceCollar        float64       `mapstructure:"price_collar"`        // 최근 체결가 대비 허용 주문가 범위 비율 (0이면 검사 안 함)
+	EquityInterval     time.Duration `mapstructure:"equity_interval"`     // 계좌 평가금액/낙폭 확인 주기 (0이면 비활성)
+	ProtectionInterval time.Duration `mapstructure:"protection_interval"` // 손절/익절 보호 규칙과 보유 포지션 재확인 주기 (0이면 비활성)
+	ATRInterval        time.Duration `mapstructure:"atr_interval"`        // ATR 추적 손절 계산 봉 주기
 }
 
 // TradingConfig 트레이딩 설정
@@ -48,6 +56,27 @@ type TradingConfig struct {
 	DefaultQuantity float64       `mapstructure:"default_quantity"`
 	OrderTimeout    time.Duration `mapstructure:"order_timeout"`
 	RetryAttempts   int           `mapstructure:"retry_attempts"`
+	Sessions        []string      `mapstructure:"sessions"`                // 주문 허용 거래 세션 (pre, regular, post)
+	QueueOffSession bool          `mapstructure:"queue_off_session"`       // 세션 외 당일 유효 지정가 주문을 다음 개장까지 대기
+	PortfolioSync   time.Duration `mapstructure:"portfolio_sync_interval"` // 증권사 잔고 → 포트폴리오 동기화 주기 (0이면 비활성)
+	Paper           PaperConfig   `mapstructure:"paper"`
+	Ledger          LedgerConfig  `mapstructure:"ledger"`
+}
+
+// PaperConfig 모의투자(프로세스 내 모의 체결) 설정
+type PaperConfig struct {
+	InitialCash    float64       `mapstructure:"initial_cash"`    // 계좌 최초 생성 시 가상 현금 (USD)
+	SlippageBps    float64       `mapstructure:"slippage_bps"`    // 시장가 체결 슬리피지 (1bp = 0.01%)
+	CommissionRate float64       `mapstructure:"commission_rate"` // 체결 금액 대비 수수료율
+	MinCommission  float64       `mapstructure:"min_commission"`  // 건당 최소 수수료
+	MatchInterval  time.Duration `mapstructure:"match_interval"`  // 미체결 지정가 주문 확인 주기
+}
+
+// LedgerConfig 체결 원장(실현손익/매수 로트) 설정
+type LedgerConfig struct {
+	CostMethod     string  `mapstructure:"cost_method"`     // 포트폴리오 실현손익 기본 취득가액 산정 방법 (fifo, average)
+	CommissionRate float64 `mapstructure:"commission_rate"` // 실계좌 체결 수수료율 (체결 통보에 수수료가 없어 요율로 산정)
+	MinCommission  float64 `mapstructure:"min_commission"`  // 건당 최소 수수료
 }
 
 // ProfitManagementConfig 수익 관리 전략 설정
@@ -66,11 +95,16 @@ type ProfitManagementConfig struct {
 
 // KISConfig 한국투자증권 API 설정
 type KISConfig struct {
-	AppKey      string `mapstructure:"app_key"`
-	AppSecret   string `mapstructure:"app_secret"`
-	BaseURL     string `mapstructure:"base_url"`
-	AccessToken string `mapstructure:"access_token"`
-	IsDemo      bool   `mapstructure:"is_demo"`
+	AppKey       string `mapstructure:"app_key"`
+	AppSecret    string `mapstructure:"app_secret"`
+	BaseURL      string `mapstructure:"base_url"`
+	AccessToken  string `mapstructure:"access_token"`
+	IsDemo       bool   `mapstructure:"is_demo"`
+	TokenPath    string `mapstructure:"token_path"`    // 발급받은 접근토큰 저장 파일 경로
+	WebsocketURL string `mapstructure:"websocket_url"` // 실시간 웹소켓 주소 (비어 있으면 실전/모의 기본값)
+	HTSID        string `mapstructure:"hts_id"`        // 체결통보 구독용 HTS ID
+	MasterURL    string `mapstructure:"master_url"`    // 해외주식 종목 마스터 파일 다운로드 주소
+	MasterSync   bool   `mapstructure:"master_sync"`   // 종목 마스터가 비어 있으면 시작 시 다운로드
 }
 
 // JWTConfig JWT 설정
@@ -80,6 +114,11 @@ type JWTConfig struct {
 	RefreshTTL time.Duration `mapstructure:"refresh_ttl"`
 }
 
+// SecurityConfig 보안 설정
+type SecurityConfig struct {
+	MasterKey string `mapstructure:"master_key"` // 증권 계좌 자격 증명 암호화 마스터 키 (base64, 32바이트)
+}
+
 // Load 설정 로드
 func Load() (*Config, error) {
 	viper.SetConfigName("config")
@@ -129,13 +168,36 @@ func setDefaults() {
 	viper.SetDefault("kis.base_url", "https://openapi.koreainvestment.com:9443")
 	viper.SetDefault("kis.access_token", "")
 	viper.SetDefault("kis.is_demo", true)
+	viper.SetDefault("kis.token_path", "./data/kis_token.json")
+	viper.SetDefault("kis.websocket_url", "")
+	viper.SetDefault("kis.hts_id", "")
+	viper.SetDefault("kis.master_url", "https://new.real.download.dws.co.kr/common/master")
+	viper.SetDefault("kis.master_sync", true)
 	viper.SetDefault("risk.max_position_size", 10000.0)
 	viper.SetDefault("risk.max_daily_loss", 1000.0)
 	viper.SetDefault("risk.max_drawdown", 0.1)
 	viper.SetDefault("risk.stop_loss_percentage", 0.05)
+	viper.SetDefault("risk.max_order_notional", 5000.0)
+	viper.SetDefault("risk.max_open_orders", 20)
+	viper.SetDefault("risk.price_collar", 0.1)
+	viper.SetDefault("risk.equity_interval", "1m")
+	viper.SetDefault("risk.default_stop_loss", false)
+	viper.SetDefault("risk.protection_interval", "15s")
+	viper.SetDefault("risk.atr_interval", "24h")
 	viper.SetDefault("trading.default_quantity", 100.0)
 	viper.SetDefault("trading.order_timeout", "30s")
 	viper.SetDefault("trading.retry_attempts", 3)
+	viper.SetDefault("trading.sessions", []string{"regular"})
+	viper.SetDefault("trading.queue_off_session", true)
+	viper.SetDefault("trading.portfolio_sync_interval", "1m")
+	viper.SetDefault("trading.paper.initial_cash", 100000.0)
+	viper.SetDefault("trading.paper.slippage_bps", 5.0)
+	viper.SetDefault("trading.paper.commission_rate", 0.0025)
+	viper.SetDefault("trading.paper.min_commission", 0.0)
+	viper.SetDefault("trading.paper.match_interval", "2s")
+	viper.SetDefault("trading.ledger.cost_method", "fifo")
+	viper.SetDefault("trading.ledger.commission_rate", 0.0025)
+	viper.SetDefault("trading.ledger.min_commission", 0.0)
 
 	// 수익 관리 전략 기본값
 	viper.SetDefault("profit_management.profit_target_percent", 3.0)
@@ -153,4 +215,7 @@ func setDefaults() {
 	viper.SetDefault("jwt.secret", "dev-secret-change-me")
 	viper.SetDefault("jwt.access_ttl", "15m")
 	viper.SetDefault("jwt.refresh_ttl", "168h") // 7d
+
+	// 보안 기본값 (마스터 키는 환경변수 SECURITY_MASTER_KEY 등으로 주입)
+	viper.SetDefault("security.master_key", "")
 }
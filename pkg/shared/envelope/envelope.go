@@ -0,0 +1,144 @@
+package envelope
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// keySize AES-256 키 길이 (마스터 키/데이터 키 공통)
+const keySize = 32
+
+// ErrKeyMismatch 다른 마스터 키로 암호화된 데이터
+var ErrKeyMismatch = errors.New("암호화에 사용된 마스터 키가 현재 설정과 다릅니다")
+
+// Envelope 봉투 암호화 결과 (데이터 키는 마스터 키로 암호화되어 저장)
+type Envelope struct {
+	KeyID       string   // 마스터 키 식별자 (키 교체 감지용)
+	DataKey     []byte   // 마스터 키로 암호화된 데이터 키
+	Ciphertexts [][]byte // 데이터 키로 암호화된 평문들 (입력 순서 유지)
+}
+
+// Sealer 봉투 암호화기
+// 레코드마다 새 데이터 키를 만들어 평문을 AES-256-GCM으로 암호화하고, 데이터 키는 마스터 키로 다시 암호화한다.
+type Sealer struct {
+	master cipher.AEAD
+	keyID  string
+}
+
+// New base64로 인코딩된 32바이트 마스터 키로 암호화기 생성
+func New(masterKey string) (*Sealer, error) {
+	key, err := base64.StdEncoding.DecodeString(strings.TrimSpace(masterKey))
+	if err != nil {
+		return nil, fmt.Errorf("마스터 키 디코딩 실패 (base64): %w", err)
+	}
+	if len(key) != keySize {
+		return nil, fmt.Errorf("마스터 키는 %d바이트여야 합니다 (현재 %d바이트)", keySize, len(key))
+	}
+
+	master, err := newAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	fingerprint := sha256.Sum256(key)
+	return &Sealer{
+		master: master,
+		keyID:  hex.EncodeToString(fingerprint[:8]),
+	}, nil
+}
+
+// KeyID 마스터 키 식별자 (키 SHA-256 앞 8바이트)
+func (s *Sealer) KeyID() string {
+	return s.keyID
+}
+
+// Seal 새 데이터 키로 평문들을 암호화 (aad는 복호화 시 같은 값을 요구해 레코드에 묶는 용도)
+func (s *Sealer) Seal(aad []byte, plaintexts ...[]byte) (*Envelope, error) {
+	dataKey := make([]byte, keySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, fmt.Errorf("데이터 키 생성 실패: %w", err)
+	}
+
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	wrapped, err := seal(s.master, dataKey, []byte(s.keyID))
+	if err != nil {
+		return nil, fmt.Errorf("데이터 키 암호화 실패: %w", err)
+	}
+
+	envelope := &Envelope{
+		KeyID:       s.keyID,
+		DataKey:     wrapped,
+		Ciphertexts: make([][]byte, len(plaintexts)),
+	}
+	for i, plaintext := range plaintexts {
+		if envelope.Ciphertexts[i], err = seal(data, plaintext, aad); err != nil {
+			return nil, fmt.Errorf("암호화 실패: %w", err)
+		}
+	}
+	return envelope, nil
+}
+
+// Open 봉투를 풀어 평문들을 반환 (Seal과 같은 aad 필요)
+func (s *Sealer) Open(aad []byte, envelope *Envelope) ([][]byte, error) {
+	if envelope.KeyID != s.keyID {
+		return nil, ErrKeyMismatch
+	}
+
+	dataKey, err := open(s.master, envelope.DataKey, []byte(s.keyID))
+	if err != nil {
+		return nil, fmt.Errorf("데이터 키 복호화 실패: %w", err)
+	}
+
+	data, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	plaintexts := make([][]byte, len(envelope.Ciphertexts))
+	for i, ciphertext := range envelope.Ciphertexts {
+		if plaintexts[i], err = open(data, ciphertext, aad); err != nil {
+			return nil, fmt.Errorf("복호화 실패: %w", err)
+		}
+	}
+	return plaintexts, nil
+}
+
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, fmt.Errorf("AES 초기화 실패: %w", err)
+	}
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		return nil, fmt.Errorf("GCM 초기화 실패: %w", err)
+	}
+	return aead, nil
+}
+
+// seal nonce를 앞에 붙인 암호문 생성
+func seal(aead cipher.AEAD, plaintext, aad []byte) ([]byte, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return aead.Seal(nonce, nonce, plaintext, aad), nil
+}
+
+func open(aead cipher.AEAD, ciphertext, aad []byte) ([]byte, error) {
+	if len(ciphertext) < aead.NonceSize() {
+		return nil, errors.New("암호문 길이가 올바르지 않습니다")
+	}
+	nonce, body := ciphertext[:aead.NonceSize()], ciphertext[aead.NonceSize():]
+	return aead.Open(nil, nonce, body, aad)
+}
@@ -0,0 +1,142 @@
+package indicator
+
+import "time"
+
+// Candle 봉 데이터
+type Candle struct {
+	Time   time.Time // 봉 시작 시각
+	Open   float64
+	High   float64
+	Low    float64
+	Close  float64
+	Volume float64
+}
+
+// Window 최근 봉을 보관하는 고정 크기 버퍼
+type Window struct {
+	candles  []Candle
+	capacity int
+	start    int
+	size     int
+}
+
+// NewWindow 새로운 봉 버퍼 생성
+func NewWindow(capacity int) *Window {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &Window{
+		candles:  make([]Candle, capacity),
+		capacity: capacity,
+	}
+}
+
+// Add 봉 추가 (가득 차면 가장 오래된 봉 제거)
+func (w *Window) Add(c Candle) {
+	if w.size < w.capacity {
+		w.candles[(w.start+w.size)%w.capacity] = c
+		w.size++
+		return
+	}
+	w.candles[w.start] = c
+	w.start = (w.start + 1) % w.capacity
+}
+
+// Len 보관 중인 봉 개수
+func (w *Window) Len() int {
+	return w.size
+}
+
+// At 오래된 순서 기준 i번째 봉
+func (w *Window) At(i int) Candle {
+	return w.candles[(w.start+i)%w.capacity]
+}
+
+// Last 가장 최근 봉
+func (w *Window) Last() (Candle, bool) {
+	if w.size == 0 {
+		return Candle{}, false
+	}
+	return w.At(w.size - 1), true
+}
+
+// Candles 오래된 순서로 정렬된 봉 목록 복사본
+func (w *Window) Candles() []Candle {
+	result := make([]Candle, w.size)
+	for i := 0; i < w.size; i++ {
+		result[i] = w.At(i)
+	}
+	return result
+}
+
+// Builder 체결가를 일정 주기의 봉으로 집계
+type Builder struct {
+	interval time.Duration
+	current  *Candle
+	lastTick time.Time
+}
+
+// NewBuilder 새로운 봉 집계기 생성
+func NewBuilder(interval time.Duration) *Builder {
+	if interval <= 0 {
+		interval = time.Minute
+	}
+	return &Builder{interval: interval}
+}
+
+// Interval 봉 주기
+func (b *Builder) Interval() time.Duration {
+	return b.interval
+}
+
+// Add 체결가 반영 (새 구간이 시작되면 직전 봉을 완성해 반환)
+// 같은 시각의 체결이 반복 전달되면 거래량은 한 번만 더한다.
+func (b *Builder) Add(price, volume float64, t time.Time) (Candle, bool) {
+	bucket := t.Truncate(b.interval)
+
+	if b.current == nil {
+		b.start(price, volume, bucket)
+		b.lastTick = t
+		return Candle{}, false
+	}
+
+	// 이전 구간의 늦게 도착한 체결은 현재 봉에 반영
+	if bucket.After(b.current.Time) {
+		closed := *b.current
+		b.start(price, volume, bucket)
+		b.lastTick = t
+		return closed, true
+	}
+
+	b.current.Close = price
+	if price > b.current.High {
+		b.current.High = price
+	}
+	if price < b.current.Low {
+		b.current.Low = price
+	}
+	if t.After(b.lastTick) {
+		b.current.Volume += volume
+		b.lastTick = t
+	}
+	return Candle{}, false
+}
+
+// Current 집계 중인 봉
+func (b *Builder) Current() (Candle, bool) {
+	if b.current == nil {
+		return Candle{}, false
+	}
+	return *b.current, true
+}
+
+func (b *Builder) start(price, volume float64, bucket time.Time) {
+	b.current = &Candle{
+		Time:   bucket,
+		Open:   price,
+		High:   price,
+		Low:    price,
+		Close:  price,
+		Volume: volume,
+	}
+}
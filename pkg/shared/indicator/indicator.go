@@ -0,0 +1,158 @@
+// Package indicator 봉 데이터 기반 기술적 지표
+//
+// 모든 지표는 완성된 봉을 하나씩 받아 증분 계산하며,
+// 계산에 필요한 봉이 모두 쌓이기 전에는 Ready가 false를 반환한다.
+package indicator
+
+import (
+	"sync"
+	"time"
+)
+
+// defaultWindowSize 종목별 보관 봉 개수 (신규 지표 워밍업용)
+const defaultWindowSize = 500
+
+// Indicator 기술적 지표 인터페이스
+type Indicator interface {
+	Update(c Candle)
+	Ready() bool
+}
+
+// Series 종목 하나의 봉 집계와 지표 계산을 관리
+type Series struct {
+	builder    *Builder
+	window     *Window
+	indicators map[string]Indicator
+	mutex      sync.Mutex
+}
+
+// NewSeries 새로운 지표 시리즈 생성
+func NewSeries(interval time.Duration) *Series {
+	return &Series{
+		builder:    NewBuilder(interval),
+		window:     NewWindow(defaultWindowSize),
+		indicators: make(map[string]Indicator),
+	}
+}
+
+// Interval 봉 주기
+func (s *Series) Interval() time.Duration {
+	return s.builder.Interval()
+}
+
+// AddTick 체결가 반영 (봉이 완성되면 지표를 갱신하고 true 반환)
+func (s *Series) AddTick(price, volume float64, t time.Time) bool {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	closed, ok := s.builder.Add(price, volume, t)
+	if !ok {
+		return false
+	}
+	// 과거 데이터로 이미 채워진 구간이면 무시
+	if last, exists := s.window.Last(); exists && !closed.Time.After(last.Time) {
+		return false
+	}
+	s.addCandle(closed)
+	return true
+}
+
+// AddCandle 완성된 봉 반영 (과거 데이터 적재용)
+func (s *Series) AddCandle(c Candle) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if last, ok := s.window.Last(); ok && !c.Time.After(last.Time) {
+		return
+	}
+	s.addCandle(c)
+}
+
+// Len 완성된 봉 개수
+func (s *Series) Len() int {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.window.Len()
+}
+
+// Candles 완성된 봉 목록
+func (s *Series) Candles() []Candle {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	return s.window.Candles()
+}
+
+// Current 집계 중인 봉 (없으면 마지막 완성 봉)
+func (s *Series) Current() (Candle, bool) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if c, ok := s.builder.Current(); ok {
+		return c, true
+	}
+	return s.window.Last()
+}
+
+// Indicator key에 해당하는 지표 조회 (없으면 생성 후 보관 중인 봉으로 워밍업)
+func (s *Series) Indicator(key string, create func() Indicator) Indicator {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if ind, ok := s.indicators[key]; ok {
+		return ind
+	}
+
+	ind := create()
+	for i := 0; i < s.window.Len(); i++ {
+		ind.Update(s.window.At(i))
+	}
+	s.indicators[key] = ind
+	return ind
+}
+
+func (s *Series) addCandle(c Candle) {
+	s.window.Add(c)
+	for _, ind := range s.indicators {
+		ind.Update(c)
+	}
+}
+
+// ring 고정 크기 실수 버퍼
+type ring struct {
+	values []float64
+	start  int
+	size   int
+}
+
+func newRing(capacity int) *ring {
+	if capacity < 1 {
+		capacity = 1
+	}
+	return &ring{values: make([]float64, capacity)}
+}
+
+// push 값 추가 (가득 찬 경우 밀려난 값과 true 반환)
+func (r *ring) push(v float64) (float64, bool) {
+	capacity := len(r.values)
+	if r.size < capacity {
+		r.values[(r.start+r.size)%capacity] = v
+		r.size++
+		return 0, false
+	}
+	evicted := r.values[r.start]
+	r.values[r.start] = v
+	r.start = (r.start + 1) % capacity
+	return evicted, true
+}
+
+func (r *ring) full() bool {
+	return r.size == len(r.values)
+}
+
+func (r *ring) each(fn func(v float64)) {
+	for i := 0; i < r.size; i++ {
+		fn(r.values[(r.start+i)%len(r.values)])
+	}
+}
@@ -0,0 +1,84 @@
+package indicator
+
+// SMA 단순 이동평균
+type SMA struct {
+	values *ring
+	sum    float64
+}
+
+// NewSMA 새로운 단순 이동평균 생성
+func NewSMA(period int) *SMA {
+	return &SMA{values: newRing(period)}
+}
+
+// Update 종가 반영
+func (m *SMA) Update(c Candle) {
+	m.Add(c.Close)
+}
+
+// Add 값 반영
+func (m *SMA) Add(v float64) {
+	if evicted, ok := m.values.push(v); ok {
+		m.sum -= evicted
+	}
+	m.sum += v
+}
+
+// Ready 기간만큼 값이 쌓였는지 여부
+func (m *SMA) Ready() bool {
+	return m.values.full()
+}
+
+// Value 이동평균 값
+func (m *SMA) Value() float64 {
+	if m.values.size == 0 {
+		return 0
+	}
+	return m.sum / float64(m.values.size)
+}
+
+// EMA 지수 이동평균 (첫 값은 기간 단순평균으로 시작)
+type EMA struct {
+	period int
+	alpha  float64
+	count  int
+	sum    float64
+	value  float64
+}
+
+// NewEMA 새로운 지수 이동평균 생성
+func NewEMA(period int) *EMA {
+	if period < 1 {
+		period = 1
+	}
+	return &EMA{
+		period: period,
+		alpha:  2 / float64(period+1),
+	}
+}
+
+// Update 종가 반영
+func (m *EMA) Update(c Candle) {
+	m.Add(c.Close)
+}
+
+// Add 값 반영
+func (m *EMA) Add(v float64) {
+	m.count++
+	if m.count <= m.period {
+		m.sum += v
+		m.value = m.sum / float64(m.count)
+		return
+	}
+	m.value += m.alpha * (v - m.value)
+}
+
+// Ready 기간만큼 값이 쌓였는지 여부
+func (m *EMA) Ready() bool {
+	return m.count >= m.period
+}
+
+// Value 이동평균 값
+func (m *EMA) Value() float64 {
+	return m.value
+}
@@ -0,0 +1,171 @@
+package indicator
+
+// RSI 상대강도지수 (Wilder 평활)
+type RSI struct {
+	period    int
+	count     int
+	prevClose float64
+	avgGain   float64
+	avgLoss   float64
+}
+
+// NewRSI 새로운 RSI 생성
+func NewRSI(period int) *RSI {
+	if period < 1 {
+		period = 1
+	}
+	return &RSI{period: period}
+}
+
+// Update 종가 반영
+func (r *RSI) Update(c Candle) {
+	r.count++
+	if r.count == 1 {
+		r.prevClose = c.Close
+		return
+	}
+
+	change := c.Close - r.prevClose
+	r.prevClose = c.Close
+
+	gain, loss := 0.0, 0.0
+	if change > 0 {
+		gain = change
+	} else {
+		loss = -change
+	}
+
+	// 첫 기간은 단순평균, 이후 Wilder 평활
+	n := float64(r.period)
+	if r.count <= r.period+1 {
+		r.avgGain += gain / n
+		r.avgLoss += loss / n
+		return
+	}
+	r.avgGain = (r.avgGain*(n-1) + gain) / n
+	r.avgLoss = (r.avgLoss*(n-1) + loss) / n
+}
+
+// Ready 기간만큼 변화량이 쌓였는지 여부
+func (r *RSI) Ready() bool {
+	return r.count > r.period
+}
+
+// Value RSI 값 (0~100)
+func (r *RSI) Value() float64 {
+	if r.avgLoss == 0 {
+		if r.avgGain == 0 {
+			return 50
+		}
+		return 100
+	}
+	rs := r.avgGain / r.avgLoss
+	return 100 - 100/(1+rs)
+}
+
+// MACD 이동평균 수렴확산
+type MACD struct {
+	fast   *EMA
+	slow   *EMA
+	signal *EMA
+	macd   float64
+}
+
+// NewMACD 새로운 MACD 생성
+func NewMACD(fastPeriod, slowPeriod, signalPeriod int) *MACD {
+	return &MACD{
+		fast:   NewEMA(fastPeriod),
+		slow:   NewEMA(slowPeriod),
+		signal: NewEMA(signalPeriod),
+	}
+}
+
+// Update 종가 반영
+func (m *MACD) Update(c Candle) {
+	m.fast.Update(c)
+	m.slow.Update(c)
+	if !m.fast.Ready() || !m.slow.Ready() {
+		return
+	}
+	m.macd = m.fast.Value() - m.slow.Value()
+	m.signal.Add(m.macd)
+}
+
+// Ready 시그널선까지 계산되었는지 여부
+func (m *MACD) Ready() bool {
+	return m.signal.Ready()
+}
+
+// MACD MACD선 (단기 EMA - 장기 EMA)
+func (m *MACD) MACD() float64 {
+	return m.macd
+}
+
+// Signal 시그널선
+func (m *MACD) Signal() float64 {
+	return m.signal.Value()
+}
+
+// Histogram MACD선 - 시그널선
+func (m *MACD) Histogram() float64 {
+	return m.macd - m.signal.Value()
+}
+
+// Stochastic 스토캐스틱 (%K, %D)
+type Stochastic struct {
+	highs *ring
+	lows  *ring
+	d     *SMA
+	k     float64
+}
+
+// NewStochastic 새로운 스토캐스틱 생성
+func NewStochastic(kPeriod, dPeriod int) *Stochastic {
+	return &Stochastic{
+		highs: newRing(kPeriod),
+		lows:  newRing(kPeriod),
+		d:     NewSMA(dPeriod),
+	}
+}
+
+// Update 고가/저가/종가 반영
+func (s *Stochastic) Update(c Candle) {
+	s.highs.push(c.High)
+	s.lows.push(c.Low)
+	if !s.highs.full() {
+		return
+	}
+
+	highest, lowest := c.High, c.Low
+	s.highs.each(func(v float64) {
+		if v > highest {
+			highest = v
+		}
+	})
+	s.lows.each(func(v float64) {
+		if v < lowest {
+			lowest = v
+		}
+	})
+
+	s.k = 50
+	if highest > lowest {
+		s.k = (c.Close - lowest) / (highest - lowest) * 100
+	}
+	s.d.Add(s.k)
+}
+
+// Ready %D까지 계산되었는지 여부
+func (s *Stochastic) Ready() bool {
+	return s.d.Ready()
+}
+
+// K %K 값 (0~100)
+func (s *Stochastic) K() float64 {
+	return s.k
+}
+
+// D %D 값 (%K의 단순 이동평균)
+func (s *Stochastic) D() float64 {
+	return s.d.Value()
+}
@@ -0,0 +1,105 @@
+package indicator
+
+import "math"
+
+// Bollinger 볼린저 밴드
+type Bollinger struct {
+	closes *ring
+	sum    float64
+	stdDev float64
+}
+
+// NewBollinger 새로운 볼린저 밴드 생성 (stdDev: 표준편차 배수)
+func NewBollinger(period int, stdDev float64) *Bollinger {
+	return &Bollinger{
+		closes: newRing(period),
+		stdDev: stdDev,
+	}
+}
+
+// Update 종가 반영
+func (b *Bollinger) Update(c Candle) {
+	if evicted, ok := b.closes.push(c.Close); ok {
+		b.sum -= evicted
+	}
+	b.sum += c.Close
+}
+
+// Ready 기간만큼 값이 쌓였는지 여부
+func (b *Bollinger) Ready() bool {
+	return b.closes.full()
+}
+
+// Middle 중심선 (단순 이동평균)
+func (b *Bollinger) Middle() float64 {
+	if b.closes.size == 0 {
+		return 0
+	}
+	return b.sum / float64(b.closes.size)
+}
+
+// Upper 상단 밴드
+func (b *Bollinger) Upper() float64 {
+	return b.Middle() + b.stdDev*b.deviation()
+}
+
+// Lower 하단 밴드
+func (b *Bollinger) Lower() float64 {
+	return b.Middle() - b.stdDev*b.deviation()
+}
+
+// deviation 모표준편차 (누적 제곱합 대신 매번 계산해 오차 누적 방지)
+func (b *Bollinger) deviation() float64 {
+	if b.closes.size == 0 {
+		return 0
+	}
+	mean := b.Middle()
+	variance := 0.0
+	b.closes.each(func(v float64) {
+		variance += (v - mean) * (v - mean)
+	})
+	return math.Sqrt(variance / float64(b.closes.size))
+}
+
+// ATR 평균 실제 범위 (Wilder 평활)
+type ATR struct {
+	period    int
+	count     int
+	prevClose float64
+	value     float64
+}
+
+// NewATR 새로운 ATR 생성
+func NewATR(period int) *ATR {
+	if period < 1 {
+		period = 1
+	}
+	return &ATR{period: period}
+}
+
+// Update 고가/저가/종가 반영
+func (a *ATR) Update(c Candle) {
+	trueRange := c.High - c.Low
+	if a.count > 0 {
+		trueRange = math.Max(trueRange, math.Max(math.Abs(c.High-a.prevClose), math.Abs(c.Low-a.prevClose)))
+	}
+	a.prevClose = c.Close
+	a.count++
+
+	n := float64(a.period)
+	if a.count <= a.period {
+		a.value += trueRange / n
+		return
+	}
+	a.value = (a.value*(n-1) + trueRange) / n
+}
+
+// Ready 기간만큼 값이 쌓였는지 여부
+func (a *ATR) Ready() bool {
+	return a.count >= a.period
+}
+
+// Value ATR 값
+func (a *ATR) Value() float64 {
+	return a.value
+}
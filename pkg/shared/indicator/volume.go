@@ -0,0 +1,82 @@
+package indicator
+
+import "time"
+
+// VWAP 거래량 가중 평균가 (세션 단위로 초기화)
+type VWAP struct {
+	location  *time.Location
+	session   string
+	cumPV     float64
+	cumVolume float64
+}
+
+// NewVWAP 새로운 VWAP 생성 (location 기준 날짜가 바뀌면 누적값 초기화)
+func NewVWAP(location *time.Location) *VWAP {
+	if location == nil {
+		location = time.UTC
+	}
+	return &VWAP{location: location}
+}
+
+// Update 대표가격(고가+저가+종가)/3 과 거래량 반영
+func (v *VWAP) Update(c Candle) {
+	session := c.Time.In(v.location).Format("20060102")
+	if session != v.session {
+		v.session = session
+		v.cumPV = 0
+		v.cumVolume = 0
+	}
+
+	typical := (c.High + c.Low + c.Close) / 3
+	v.cumPV += typical * c.Volume
+	v.cumVolume += c.Volume
+}
+
+// Ready 현재 세션에 거래량이 있는지 여부
+func (v *VWAP) Ready() bool {
+	return v.cumVolume > 0
+}
+
+// Value VWAP 값
+func (v *VWAP) Value() float64 {
+	if v.cumVolume == 0 {
+		return 0
+	}
+	return v.cumPV / v.cumVolume
+}
+
+// OBV 누적 거래량 지표
+type OBV struct {
+	count     int
+	prevClose float64
+	value     float64
+}
+
+// NewOBV 새로운 OBV 생성
+func NewOBV() *OBV {
+	return &OBV{}
+}
+
+// Update 종가 방향에 따라 거래량 가감
+func (o *OBV) Update(c Candle) {
+	if o.count > 0 {
+		switch {
+		case c.Close > o.prevClose:
+			o.value += c.Volume
+		case c.Close < o.prevClose:
+			o.value -= c.Volume
+		}
+	}
+	o.prevClose = c.Close
+	o.count++
+}
+
+// Ready 비교할 직전 봉이 있는지 여부
+func (o *OBV) Ready() bool {
+	return o.count > 1
+}
+
+// Value OBV 값
+func (o *OBV) Value() float64 {
+	return o.value
+}
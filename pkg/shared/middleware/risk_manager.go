@@ -1,21 +1,108 @@
 package middleware
 
 import (
+	"fmt"
 	"sync"
 	"time"
 
+	"auto-trader/pkg/shared/calendar"
 	"auto-trader/pkg/shared/config"
 
+	"github.com/google/uuid"
 	"github.com/shopspring/decimal"
 	"github.com/sirupsen/logrus"
 )
 
+// 리스크 상태 계좌 구분
+const (
+	AccountLive  = "LIVE"  // 사용자 연결 증권 계좌
+	AccountPaper = "PAPER" // 모의투자 계좌
+)
+
+// Scope 리스크 상태 적용 단위 (사용자 + 계좌)
+// 한 사용자의 손실이 다른 사용자나 같은 사용자의 다른 계좌 주문을 막지 않도록 상태를 나눈다.
+type Scope struct {
+	UserID  uuid.UUID
+	Account string
+}
+
+// State 사용자 계좌별 리스크 상태
+type State struct {
+	Scope
+	TradingDay       string               `json:"trading_day"` // 일일 손익 기준 거래일 (뉴욕 시간 YYYY-MM-DD)
+	DailyRealizedPnL decimal.Decimal      `json:"daily_realized_pnl"`
+	Equity           decimal.Decimal      `json:"equity"`
+	PeakEquity       decimal.Decimal      `json:"peak_equity"`
+	Positions        map[string]*Position `json:"positions"`
+	OpenOrders       map[string]bool      `json:"-"` // 미체결 주문 (client order id, 재시작 시 주문 테이블로 재구성)
+	Halt             *Halt                `json:"halt,omitempty"`
+	UpdatedAt        time.Time            `json:"updated_at"`
+}
+
+// Halt 거래 중지 상태 (해제 확인 전까지 노출을 늘리는 주문 거부, 청산 주문은 허용)
+type Halt struct {
+	Rule   string    `json:"rule"`
+	Reason string    `json:"reason"`
+	Since  time.Time `json:"since"`
+}
+
+// DailyLoss 당일 실현 손실 (수익이면 0)
+func (s *State) DailyLoss() decimal.Decimal {
+	if s.DailyRealizedPnL.IsNegative() {
+		return s.DailyRealizedPnL.Neg()
+	}
+	return decimal.Zero
+}
+
+// OpenExposure 보유 포지션 노출 금액 (평균단가 기준)
+func (s *State) OpenExposure() decimal.Decimal {
+	exposure := decimal.Zero
+	for _, position := range s.Positions {
+		exposure = exposure.Add(position.Quantity.Mul(position.AvgPrice))
+	}
+	return exposure
+}
+
+// Drawdown 최고 평가금액 대비 현재 낙폭 비율 (최고 평가금액이 없으면 0)
+func (s *State) Drawdown() decimal.Decimal {
+	if !s.PeakEquity.IsPositive() || s.Equity.GreaterThanOrEqual(s.PeakEquity) {
+		return decimal.Zero
+	}
+	return s.PeakEquity.Sub(s.Equity).Div(s.PeakEquity)
+}
+
+// clone 잠금 밖에서 읽거나 저장할 수 있는 복사본
+func (s *State) clone() *State {
+	copied := *s
+	copied.Positions = make(map[string]*Position, len(s.Positions))
+	for symbol, position := range s.Positions {
+		p := *position
+		copied.Positions[symbol] = &p
+	}
+	copied.OpenOrders = make(map[string]bool, len(s.OpenOrders))
+	for id := range s.OpenOrders {
+		copied.OpenOrders[id] = true
+	}
+	if s.Halt != nil {
+		halt := *s.Halt
+		copied.Halt = &halt
+	}
+	return &copied
+}
+
+// StateStore 리스크 상태 저장소 (재시작 후 재구성 기준)
+type StateStore interface {
+	SaveState(state *State) error
+}
+
 type Manager struct {
-	config        *config.Config
-	dailyLoss     decimal.Decimal
-	positions     map[string]*Position
-	mutex         sync.RWMutex
-	lastResetDate time.Time
+	config   *config.Config
+	store    StateStore
+	location *time.Location
+	states   map[Scope]*State
+	kills    map[uuid.UUID]*Halt // 긴급 중지 (사용자 ID, uuid.Nil은 전체 사용자)
+	mutex    sync.RWMutex
+	saving   sync.Mutex // 저장 순서 보장
 }
 
 type Position struct {
@@ -28,65 +115,256 @@ type Position struct {
 
 type RiskCheck struct {
 	Allowed bool   `json:"allowed"`
+	Rule    string `json:"rule,omitempty"`
 	Reason  string `json:"reason,omitempty"`
 }
 
+// 사전 리스크 검사 규칙 (거부 기록의 rule 값)
+const (
+	RuleOrderNotional = "max_order_notional"
+	RulePriceCollar   = "price_collar"
+	RuleOpenOrders    = "max_open_orders"
+	RuleDailyLoss     = "max_daily_loss"
+	RulePositionSize  = "max_position_size"
+	RuleMaxDrawdown   = "max_drawdown"
+	RuleKillSwitch    = "kill_switch"
+)
+
+// OrderCheck 사전 리스크 검사 대상 주문
+type OrderCheck struct {
+	ClientOrderID string
+	Symbol        string
+	Side          string // "BUY" or "SELL"
+	Quantity      decimal.Decimal
+	Price         decimal.Decimal // 지정가 또는 시장가 주문의 기준가
+	LastPrice     decimal.Decimal // 최근 체결가 (0이면 가격 범위 검사 생략)
+	Liquidation   bool            // 긴급 청산 주문
+	HeldQuantity  decimal.Decimal // 청산 주문의 증권사/저장소 기준 보유 수량 (리스크 상태에 포지션이 없을 때 청산 한도)
+}
+
 func NewManager(cfg *config.Config) *Manager {
 	return &Manager{
-		config:        cfg,
-		dailyLoss:     decimal.Zero,
-		positions:     make(map[string]*Position),
-		lastResetDate: time.Now(),
+		config:   cfg,
+		location: calendar.New().Location(),
+		states:   make(map[Scope]*State),
+		kills:    make(map[uuid.UUID]*Halt),
 	}
 }
 
-func (m *Manager) CheckOrderRisk(symbol string, side string, quantity decimal.Decimal, price decimal.Decimal) *RiskCheck {
+// SetStore 리스크 상태 저장소 설정 (설정 전에는 메모리에만 유지)
+func (m *Manager) SetStore(store StateStore) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+	m.store = store
+}
 
-	// 일일 손실 한도 체크
-	if m.dailyLoss.GreaterThanOrEqual(decimal.NewFromFloat(m.config.Risk.MaxDailyLoss)) {
-		return &RiskCheck{
-			Allowed: false,
-			Reason:  "일일 손실 한도 초과",
+// TradingDay 시각이 속한 일일 손익 기준 거래일 (뉴욕 시간)
+func (m *Manager) TradingDay(t time.Time) string {
+	return t.In(m.location).Format("2006-01-02")
+}
+
+// TradingDayStart 시각이 속한 거래일 시작 시각 (뉴욕 자정)
+func (m *Manager) TradingDayStart(t time.Time) time.Time {
+	local := t.In(m.location)
+	return time.Date(local.Year(), local.Month(), local.Day(), 0, 0, 0, 0, m.location)
+}
+
+// Restore 재구성한 상태로 교체 (시작 시 원장/잔고 기준 복구)
+func (m *Manager) Restore(state *State) {
+	restored := state.clone()
+	if restored.TradingDay != m.TradingDay(time.Now()) {
+		restored.TradingDay = m.TradingDay(time.Now())
+		restored.DailyRealizedPnL = decimal.Zero
+	}
+	if restored.Equity.GreaterThan(restored.PeakEquity) {
+		restored.PeakEquity = restored.Equity
+	}
+
+	m.mutex.Lock()
+	if current, exists := m.states[restored.Scope]; exists {
+		// 재구성 중 접수된 미체결 주문과 거래 중지 상태는 유지
+		restored.OpenOrders = current.OpenOrders
+		if restored.Halt == nil {
+			restored.Halt = current.Halt
 		}
 	}
+	m.states[restored.Scope] = restored
+	m.mutex.Unlock()
 
-	// 포지션 크기 체크
-	orderValue := quantity.Mul(price)
-	if orderValue.GreaterThan(decimal.NewFromFloat(m.config.Risk.MaxPositionSize)) {
-		return &RiskCheck{
-			Allowed: false,
-			Reason:  "최대 포지션 크기 초과",
+	m.save(restored.Scope)
+}
+
+// state 범위 상태 조회 (없으면 생성, 거래일이 바뀌었으면 일일 손익 초기화)
+func (m *Manager) state(scope Scope) *State {
+	today := m.TradingDay(time.Now())
+
+	state, exists := m.states[scope]
+	if !exists {
+		state = &State{
+			Scope:      scope,
+			TradingDay: today,
+			Positions:  make(map[string]*Position),
+			OpenOrders: make(map[string]bool),
 		}
+		m.states[scope] = state
+		return state
+	}
+
+	if state.TradingDay != today {
+		logrus.Infof("🔄 일일 손익 카운터 리셋 (%s/%s): %s → %s", scope.UserID, scope.Account, state.TradingDay, today)
+		state.TradingDay = today
+		state.DailyRealizedPnL = decimal.Zero
+	}
+	return state
+}
+
+// save 범위의 최신 상태 저장 (실패해도 메모리 상태는 유지하고 다음 변경 시 다시 저장)
+// 저장 직전에 다시 복사하므로 동시에 갱신돼도 마지막 저장이 최신 상태가 된다.
+func (m *Manager) save(scope Scope) {
+	m.saving.Lock()
+	defer m.saving.Unlock()
+
+	m.mutex.RLock()
+	store := m.store
+	var state *State
+	if current, exists := m.states[scope]; exists {
+		state = current.clone()
+	}
+	m.mutex.RUnlock()
+	if store == nil || state == nil {
+		return
+	}
+
+	if err := store.SaveState(state); err != nil {
+		logrus.Errorf("❌ 리스크 상태 저장 실패 (%s/%s): %v", state.UserID, state.Account, err)
+	}
+}
+
+// CheckOrderRisk 주문 제출 전 리스크 검사
+// 주문 금액/가격 범위/미체결 주문 수는 모든 주문에, 긴급 중지/거래 중지/일일 손실/포지션 크기는 노출을 늘리는 주문에만 적용한다.
+// 노출을 줄이는 긴급 청산 주문은 검사 없이 허용한다 (리스크 상태에 없는 보유분은 호출 측이 전달한 보유 수량까지).
+func (m *Manager) CheckOrderRisk(scope Scope, check OrderCheck) *RiskCheck {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	state := m.state(scope)
+	risk := m.config.Risk
+	orderValue := check.Quantity.Mul(check.Price)
+	existing := state.Positions[check.Symbol]
+
+	if check.Liquidation && reducesHolding(existing, check) {
+		return &RiskCheck{Allowed: true}
+	}
+
+	// 주문 금액 체크
+	if risk.MaxOrderNotional > 0 && orderValue.GreaterThan(decimal.NewFromFloat(risk.MaxOrderNotional)) {
+		return reject(RuleOrderNotional, fmt.Sprintf("주문 금액 한도 초과 (%s > %s)",
+			orderValue.StringFixed(2), decimal.NewFromFloat(risk.MaxOrderNotional).StringFixed(2)))
+	}
+
+	// 최근 체결가 대비 주문가 범위 체크
+	if risk.PriceCollar > 0 && check.LastPrice.IsPositive() && check.Price.IsPositive() {
+		deviation := check.Price.Sub(check.LastPrice).Abs().Div(check.LastPrice)
+		if deviation.GreaterThan(decimal.NewFromFloat(risk.PriceCollar)) {
+			return reject(RulePriceCollar, fmt.Sprintf("주문가가 최근 체결가 대비 허용 범위를 벗어남 (주문가 %s, 최근 체결가 %s)",
+				check.Price.String(), check.LastPrice.String()))
+		}
+	}
+
+	// 미체결 주문 수 체크
+	if risk.MaxOpenOrders > 0 {
+		open := len(state.OpenOrders)
+		if state.OpenOrders[check.ClientOrderID] {
+			open--
+		}
+		if open >= risk.MaxOpenOrders {
+			return reject(RuleOpenOrders, fmt.Sprintf("미체결 주문 수 한도 초과 (%d건)", open))
+		}
+	}
+
+	if !increasesExposure(existing, check) {
+		return &RiskCheck{Allowed: true}
+	}
+
+	// 긴급 중지 체크 (해제 전까지 청산 주문만 허용)
+	if kill := m.killSwitch(scope.UserID); kill != nil {
+		return reject(RuleKillSwitch, fmt.Sprintf("긴급 중지 상태: %s", kill.Reason))
+	}
+
+	// 거래 중지 체크 (해제 확인 전까지 청산 주문만 허용)
+	if state.Halt != nil {
+		return reject(state.Halt.Rule, fmt.Sprintf("거래 중지 상태: %s", state.Halt.Reason))
+	}
+
+	// 일일 손실 한도 체크
+	if state.DailyLoss().GreaterThanOrEqual(decimal.NewFromFloat(risk.MaxDailyLoss)) {
+		return reject(RuleDailyLoss, "일일 손실 한도 초과")
+	}
+
+	// 포지션 크기 체크
+	if orderValue.GreaterThan(decimal.NewFromFloat(risk.MaxPositionSize)) {
+		return reject(RulePositionSize, "최대 포지션 크기 초과")
 	}
 
 	// 기존 포지션과의 총 크기 체크
-	if existing, exists := m.positions[symbol]; exists {
+	if existing != nil {
 		totalValue := existing.Quantity.Mul(existing.AvgPrice).Add(orderValue)
-		if totalValue.GreaterThan(decimal.NewFromFloat(m.config.Risk.MaxPositionSize)) {
-			return &RiskCheck{
-				Allowed: false,
-				Reason:  "심볼별 최대 포지션 크기 초과",
-			}
+		if totalValue.GreaterThan(decimal.NewFromFloat(risk.MaxPositionSize)) {
+			return reject(RulePositionSize, "심볼별 최대 포지션 크기 초과")
 		}
 	}
 
 	return &RiskCheck{Allowed: true}
 }
 
-func (m *Manager) UpdatePosition(symbol string, side string, quantity decimal.Decimal, price decimal.Decimal) {
+// increasesExposure 주문이 기존 포지션을 늘리거나 새로 여는지 (청산 주문은 손실 한도 중에도 허용)
+func increasesExposure(existing *Position, check OrderCheck) bool {
+	if existing == nil {
+		return true
+	}
+	opening := "long"
+	if check.Side == "SELL" {
+		opening = "short"
+	}
+	return existing.Side == opening || check.Quantity.GreaterThan(existing.Quantity)
+}
+
+// reducesHolding 청산 주문이 보유분 안에서 노출을 줄이는지
+// 리스크 상태의 포지션이 없거나 증권사 잔고보다 적으면(재구성 이전 보유분 등) 전달된 보유 수량을 한도로 삼는다.
+func reducesHolding(existing *Position, check OrderCheck) bool {
+	if existing != nil && !increasesExposure(existing, check) {
+		return true
+	}
+	if check.Side != "SELL" || (existing != nil && existing.Side == "short") {
+		return false
+	}
+	return check.HeldQuantity.IsPositive() && check.Quantity.LessThanOrEqual(check.HeldQuantity)
+}
+
+func reject(rule, reason string) *RiskCheck {
+	return &RiskCheck{Allowed: false, Rule: rule, Reason: reason}
+}
+
+// OrderOpened 미체결 주문 등록 (미체결 주문 수 한도 기준)
+func (m *Manager) OrderOpened(scope Scope, clientOrderID string) {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
+	m.state(scope).OpenOrders[clientOrderID] = true
+}
 
-	// 일일 손실 리셋 체크
-	if time.Since(m.lastResetDate) > 24*time.Hour {
-		m.dailyLoss = decimal.Zero
-		m.lastResetDate = time.Now()
-		logrus.Info("일일 손실 카운터 리셋")
-	}
+// OrderClosed 체결 완료/취소/거부된 주문 해제
+func (m *Manager) OrderClosed(scope Scope, clientOrderID string) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	delete(m.state(scope).OpenOrders, clientOrderID)
+}
+
+func (m *Manager) UpdatePosition(scope Scope, symbol string, side string, quantity decimal.Decimal, price decimal.Decimal) {
+	m.mutex.Lock()
+	state := m.state(scope)
+	positions := state.Positions
 
-	if existing, exists := m.positions[symbol]; exists {
+	if existing, exists := positions[symbol]; exists {
 		// 기존 포지션 업데이트
 		if existing.Side == side {
 			// 같은 방향 포지션 추가
@@ -95,19 +373,26 @@ func (m *Manager) UpdatePosition(symbol string, side string, quantity decimal.De
 			existing.AvgPrice = totalValue.Div(totalQuantity)
 			existing.Quantity = totalQuantity
 		} else {
-			// 반대 방향 포지션 (청산)
+			// 반대 방향 포지션 (청산) - 청산 수량만큼 평균단가 기준 실현손익 반영
+			closed := decimal.Min(existing.Quantity, quantity)
+			realized := price.Sub(existing.AvgPrice).Mul(closed)
+			if existing.Side == "short" {
+				realized = realized.Neg()
+			}
+			state.DailyRealizedPnL = state.DailyRealizedPnL.Add(realized)
+
 			if existing.Quantity.GreaterThanOrEqual(quantity) {
 				// 부분 청산
 				existing.Quantity = existing.Quantity.Sub(quantity)
 				if existing.Quantity.IsZero() {
-					delete(m.positions, symbol)
+					delete(positions, symbol)
 				}
 			} else {
 				// 전체 청산 후 반대 포지션
 				remainingQuantity := quantity.Sub(existing.Quantity)
-				delete(m.positions, symbol)
+				delete(positions, symbol)
 				if !remainingQuantity.IsZero() {
-					m.positions[symbol] = &Position{
+					positions[symbol] = &Position{
 						Symbol:    symbol,
 						Quantity:  remainingQuantity,
 						AvgPrice:  price,
@@ -119,7 +404,7 @@ func (m *Manager) UpdatePosition(symbol string, side string, quantity decimal.De
 		}
 	} else {
 		// 새 포지션 생성
-		m.positions[symbol] = &Position{
+		positions[symbol] = &Position{
 			Symbol:    symbol,
 			Quantity:  quantity,
 			AvgPrice:  price,
@@ -127,48 +412,186 @@ func (m *Manager) UpdatePosition(symbol string, side string, quantity decimal.De
 			Timestamp: time.Now(),
 		}
 	}
+	state.UpdatedAt = time.Now()
+	m.mutex.Unlock()
+
+	logrus.Infof("포지션 업데이트 (%s/%s): %s %s %s @ %s", scope.UserID, scope.Account, side, quantity.String(), symbol, price.String())
+	m.save(scope)
+}
 
-	logrus.Infof("포지션 업데이트: %s %s %s @ %s", side, quantity.String(), symbol, price.String())
+// RecordRealizedPnL 실현손익을 당일 누계에 반영 (손실은 음수)
+func (m *Manager) RecordRealizedPnL(scope Scope, pnl decimal.Decimal) {
+	m.mutex.Lock()
+	state := m.state(scope)
+	state.DailyRealizedPnL = state.DailyRealizedPnL.Add(pnl)
+	state.UpdatedAt = time.Now()
+	total := state.DailyRealizedPnL
+	m.mutex.Unlock()
+
+	if pnl.IsNegative() {
+		logrus.Warnf("일일 손실 업데이트 (%s/%s): %s (당일 실현손익: %s)", scope.UserID, scope.Account, pnl.String(), total.String())
+	}
+	m.save(scope)
 }
 
-func (m *Manager) UpdateDailyLoss(loss decimal.Decimal) {
+// UpdateEquity 계좌 평가금액 반영 (최고 평가금액 갱신, 최대 낙폭 검사)
+// 낙폭이 한도 이상이면 걸어야 할 중지 상태를 반환한다 (이미 중지 중이면 nil).
+// 중지는 여기서 적용하지 않으며, 호출 측이 중지 이벤트를 기록한 뒤 Halt로 적용한다.
+func (m *Manager) UpdateEquity(scope Scope, equity decimal.Decimal) *Halt {
+	m.mutex.Lock()
+	state := m.state(scope)
+	state.Equity = equity
+	if equity.GreaterThan(state.PeakEquity) {
+		state.PeakEquity = equity
+	}
+	state.UpdatedAt = time.Now()
+
+	var halted *Halt
+	limit := decimal.NewFromFloat(m.config.Risk.MaxDrawdown)
+	drawdown := state.Drawdown()
+	if state.Halt == nil && limit.IsPositive() && drawdown.GreaterThanOrEqual(limit) {
+		halted = &Halt{
+			Rule: RuleMaxDrawdown,
+			Reason: fmt.Sprintf("최대 낙폭 한도 초과 (%s%% ≥ %s%%, 평가금액 %s / 최고 %s)",
+				drawdown.Mul(decimal.NewFromInt(100)).StringFixed(2), limit.Mul(decimal.NewFromInt(100)).StringFixed(2),
+				equity.StringFixed(2), state.PeakEquity.StringFixed(2)),
+			Since: time.Now(),
+		}
+	}
+	m.mutex.Unlock()
+
+	m.save(scope)
+	return halted
+}
+
+// Halt 거래 중지 (이미 중지 중이면 기존 상태 유지, 재시작 시 해제되지 않은 중지 복구에도 사용)
+// 새로 중지했으면 true를 반환한다.
+func (m *Manager) Halt(scope Scope, halt Halt) bool {
+	m.mutex.Lock()
+	state := m.state(scope)
+	if state.Halt != nil {
+		m.mutex.Unlock()
+		return false
+	}
+	state.Halt = &halt
+	state.UpdatedAt = time.Now()
+	m.mutex.Unlock()
+
+	m.save(scope)
+	return true
+}
+
+// Resume 거래 중지 해제 (현재 평가금액을 새 최고 평가금액으로 삼아 같은 낙폭으로 다시 중지되지 않게 함)
+// 중지 상태가 아니었으면 false를 반환한다.
+func (m *Manager) Resume(scope Scope) bool {
+	m.mutex.Lock()
+	state := m.state(scope)
+	if state.Halt == nil {
+		m.mutex.Unlock()
+		return false
+	}
+	state.Halt = nil
+	state.PeakEquity = state.Equity
+	state.UpdatedAt = time.Now()
+	m.mutex.Unlock()
+
+	logrus.Infof("▶️  거래 중지 해제 (%s/%s)", scope.UserID, scope.Account)
+	m.save(scope)
+	return true
+}
+
+// Kill 긴급 중지 (userID가 uuid.Nil이면 전체 사용자, 해제 전까지 노출을 늘리는 주문 거부)
+func (m *Manager) Kill(userID uuid.UUID, halt Halt) {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	if _, exists := m.kills[userID]; !exists {
+		m.kills[userID] = &halt
+	}
+}
+
+// Release 긴급 중지 해제 (전체 사용자 긴급 중지는 uuid.Nil로만 해제), 중지 상태가 아니었으면 false
+func (m *Manager) Release(userID uuid.UUID) bool {
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	m.dailyLoss = m.dailyLoss.Add(loss)
-	logrus.Warnf("일일 손실 업데이트: %s (총: %s)", loss.String(), m.dailyLoss.String())
+	if _, exists := m.kills[userID]; !exists {
+		return false
+	}
+	delete(m.kills, userID)
+	return true
 }
 
-func (m *Manager) GetPositions() map[string]*Position {
+// KillSwitch 사용자에게 적용 중인 긴급 중지 (사용자 본인 → 전체 순, 없으면 nil)
+func (m *Manager) KillSwitch(userID uuid.UUID) *Halt {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
-	result := make(map[string]*Position)
-	for k, v := range m.positions {
-		result[k] = v
+	if kill := m.killSwitch(userID); kill != nil {
+		copied := *kill
+		return &copied
 	}
-	return result
+	return nil
 }
 
-func (m *Manager) GetDailyLoss() decimal.Decimal {
+// killSwitch 잠금 보유 상태에서 적용 중인 긴급 중지 조회
+func (m *Manager) killSwitch(userID uuid.UUID) *Halt {
+	if kill, exists := m.kills[userID]; exists {
+		return kill
+	}
+	return m.kills[uuid.Nil]
+}
+
+// GetState 범위 상태 복사본 조회
+func (m *Manager) GetState(scope Scope) *State {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+	return m.state(scope).clone()
+}
+
+// Scopes 리스크 상태가 있는 사용자 계좌 목록
+func (m *Manager) Scopes() []Scope {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
-	return m.dailyLoss
+
+	scopes := make([]Scope, 0, len(m.states))
+	for scope := range m.states {
+		scopes = append(scopes, scope)
+	}
+	return scopes
+}
+
+func (m *Manager) GetPositions(scope Scope) map[string]*Position {
+	return m.GetState(scope).Positions
+}
+
+func (m *Manager) GetDailyLoss(scope Scope) decimal.Decimal {
+	return m.GetState(scope).DailyLoss()
 }
 
-func (m *Manager) CheckStopLoss(symbol string, currentPrice decimal.Decimal) bool {
+// CheckStopLoss 계좌 기본 손절 비율 도달 여부 (롱은 평균단가 아래, 숏은 평균단가 위로 벗어나면 손절)
+func (m *Manager) CheckStopLoss(scope Scope, symbol string, currentPrice decimal.Decimal) bool {
 	m.mutex.RLock()
 	defer m.mutex.RUnlock()
 
-	if position, exists := m.positions[symbol]; exists {
-		stopLossThreshold := position.AvgPrice.Mul(decimal.NewFromFloat(1 - m.config.Risk.StopLossPercentage))
+	if m.config.Risk.StopLossPercentage <= 0 {
+		return false
+	}
+
+	state, exists := m.states[scope]
+	if !exists {
+		return false
+	}
+
+	if position, exists := state.Positions[symbol]; exists {
+		pct := decimal.NewFromFloat(m.config.Risk.StopLossPercentage)
 
-		if position.Side == "long" && currentPrice.LessThan(stopLossThreshold) {
+		if position.Side == "long" && currentPrice.LessThan(position.AvgPrice.Mul(decimal.NewFromInt(1).Sub(pct))) {
 			logrus.Warnf("롱 포지션 스탑로스: %s @ %s (진입가: %s)", symbol, currentPrice.String(), position.AvgPrice.String())
 			return true
 		}
 
-		if position.Side == "short" && currentPrice.GreaterThan(stopLossThreshold) {
+		if position.Side == "short" && currentPrice.GreaterThan(position.AvgPrice.Mul(decimal.NewFromInt(1).Add(pct))) {
 			logrus.Warnf("숏 포지션 스탑로스: %s @ %s (진입가: %s)", symbol, currentPrice.String(), position.AvgPrice.String())
 			return true
 		}
@@ -0,0 +1,32 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/backtest"
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/shared/config"
+)
+
+// BacktestModule 백테스트 모듈
+type BacktestModule struct {
+	Repository backtest.Repository
+	Service    backtest.Service
+	Controller *backtest.Controller
+	cfg        *config.Config
+}
+
+// NewBacktestModule 백테스트 모듈 초기화
+func NewBacktestModule(entClient *ent.Client, strategyRepo strategy.Repository, marketData marketdata.Service, cfg *config.Config) *BacktestModule {
+	// Repository -> Service -> Controller 순서로 초기화 (과거 봉은 MarketData 서비스에서 조회)
+	repo := backtest.NewEntRepository(entClient)
+	service := backtest.NewService(repo, strategyRepo, marketData, backtest.NewEngine(cfg))
+	controller := backtest.NewController(service)
+
+	return &BacktestModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		cfg:        cfg,
+	}
+}
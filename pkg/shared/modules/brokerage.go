@@ -0,0 +1,50 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/brokerage"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/envelope"
+
+	"github.com/sirupsen/logrus"
+)
+
+// BrokerageModule 증권 계좌 연결 모듈
+type BrokerageModule struct {
+	Repository brokerage.Repository
+	Service    brokerage.Service
+	Controller *brokerage.Controller
+	Accounts   *kis.AccountPool // 사용자 자격 증명별 KIS 클라이언트 (주문/잔고 조회용)
+	cfg        *config.Config
+}
+
+// NewBrokerageModule 증권 계좌 연결 모듈 초기화 (마스터 키가 없으면 계좌 연결/사용 불가)
+func NewBrokerageModule(entClient *ent.Client, tokenStore kis.TokenStore, sealer *envelope.Sealer, cfg *config.Config) *BrokerageModule {
+	// Repository -> Service -> Controller 순서로 초기화
+	repo := brokerage.NewEntRepository(entClient)
+	service := brokerage.NewService(repo, sealer)
+	controller := brokerage.NewController(service)
+
+	return &BrokerageModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		Accounts:   kis.NewAccountPool(service, tokenStore),
+		cfg:        cfg,
+	}
+}
+
+// newSealer 자격 증명/접근토큰 봉투 암호화기 (security.master_key가 없으면 nil)
+func newSealer(cfg *config.Config) *envelope.Sealer {
+	if cfg.Security.MasterKey == "" {
+		logrus.Warn("⚠️ security.master_key가 설정되지 않아 증권 계좌를 연결할 수 없고 KIS 접근토큰이 평문으로 저장됩니다")
+		return nil
+	}
+
+	sealer, err := envelope.New(cfg.Security.MasterKey)
+	if err != nil {
+		logrus.Fatalf("❌ 마스터 키 설정 오류: %v", err)
+	}
+	return sealer
+}
@@ -0,0 +1,44 @@
+package modules
+
+import (
+	"auto-trader/pkg/domain/market"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/calendar"
+	"auto-trader/pkg/shared/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+// MarketModule 장 운영 시간 모듈
+type MarketModule struct {
+	Calendar     *calendar.Calendar
+	SessionGuard *order.SessionGuard // 실계좌/모의투자 실행기 공용 주문 세션 규칙
+	Service      market.Service
+	Controller   *market.Controller
+	cfg          *config.Config
+}
+
+// NewMarketModule 장 운영 시간 모듈 초기화
+func NewMarketModule(cfg *config.Config) *MarketModule {
+	cal := calendar.New()
+	service := market.NewService(cal)
+	controller := market.NewController(service)
+
+	guard, err := order.NewSessionGuard(cfg.Trading)
+	if err != nil {
+		logrus.Warnf("⚠️ %v - 정규장 주문만 허용합니다", err)
+		guard = &order.SessionGuard{
+			Calendar: cal,
+			Sessions: []calendar.Session{calendar.SessionRegular},
+			Queue:    cfg.Trading.QueueOffSession,
+		}
+	}
+
+	return &MarketModule{
+		Calendar:     cal,
+		SessionGuard: guard,
+		Service:      service,
+		Controller:   controller,
+		cfg:          cfg,
+	}
+}
@@ -0,0 +1,28 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/shared/config"
+)
+
+// MarketDataModule 과거 봉 데이터 모듈
+type MarketDataModule struct {
+	Repository marketdata.Repository
+	Service    marketdata.Service
+	cfg        *config.Config
+}
+
+// NewMarketDataModule 봉 데이터 모듈 초기화
+func NewMarketDataModule(entClient *ent.Client, kisClient *kis.Client, exchanges marketdata.ExchangeResolver, cfg *config.Config) *MarketDataModule {
+	// Repository -> Service 순서로 초기화 (KIS 차트 API로 백필, 종목별 거래소는 종목 마스터 기준)
+	repo := marketdata.NewEntRepository(entClient)
+	service := marketdata.NewService(repo, kis.NewChartFetcher(kisClient), exchanges)
+
+	return &MarketDataModule{
+		Repository: repo,
+		Service:    service,
+		cfg:        cfg,
+	}
+}
@@ -2,6 +2,7 @@ package modules
 
 import (
 	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
 	"auto-trader/pkg/shared/config"
 	"auto-trader/pkg/shared/middleware"
 
@@ -9,10 +10,22 @@ import (
 )
 
 type Modules struct {
-	User      *UserModule
-	Auth      *AuthModule
-	Strategy  *StrategyModule
-	Portfolio *PortfolioModule
+	User       *UserModule
+	Auth       *AuthModule
+	Strategy   *StrategyModule
+	Portfolio  *PortfolioModule
+	Order      *OrderModule
+	MarketData *MarketDataModule
+	Backtest   *BacktestModule
+	Paper      *PaperModule
+	Template   *TemplateModule
+	Market     *MarketModule
+	Symbol     *SymbolModule
+	Brokerage  *BrokerageModule
+	Risk       *RiskModule
+	Protection *ProtectionModule
+	KIS        *kis.Client
+	Stream     *kis.StreamCollector
 }
 
 // InitializeModules 모듈 초기화
@@ -27,18 +40,99 @@ func InitializeModules(entClient *ent.Client, riskManager *middleware.Manager, c
 	authModule := NewAuthModule(entClient, cfg)
 	logrus.Info("✅ Auth 모듈 초기화 완료")
 
-	// 3. Strategy 모듈 초기화
-	strategyModule := NewStrategyModule(entClient, riskManager, cfg)
+	// 3. KIS 클라이언트 초기화 (시세/차트/실시간 수신 공용, 서버 설정 자격 증명 사용)
+	// 접근토큰과 사용자 계좌 자격 증명은 같은 마스터 키로 봉투 암호화
+	sealer := newSealer(cfg)
+	tokenStore := kis.NewFileTokenStore(cfg.KIS.TokenPath, sealer)
+	kisClient := kis.NewClient(cfg.KIS.AppKey, cfg.KIS.AppSecret, cfg.KIS.BaseURL, cfg.KIS.IsDemo)
+	kisClient.SetTokenStore(tokenStore)
+	if cfg.KIS.AccessToken != "" {
+		// 설정된 토큰은 초기값으로만 사용하고 만료 시 자동 재발급
+		kisClient.SetAccessToken(cfg.KIS.AccessToken)
+	}
+
+	// 4. Brokerage 모듈 초기화 (주문/잔고 조회는 사용자별 연결 계좌 자격 증명 사용)
+	brokerageModule := NewBrokerageModule(entClient, tokenStore, sealer, cfg)
+	logrus.Info("✅ Brokerage 모듈 초기화 완료")
+
+	// 5. Symbol 모듈 초기화 (KIS 시세/주문 요청의 거래소코드를 종목 마스터로 결정)
+	symbolModule := NewSymbolModule(entClient, cfg)
+	kisClient.SetSymbolResolver(symbolModule.Service)
+	brokerageModule.Accounts.SetSymbolResolver(symbolModule.Service)
+	logrus.Info("✅ Symbol 모듈 초기화 완료")
+
+	// 실시간 시세/체결통보 수집기 (전략/포트폴리오/주문 실행기 공용)
+	stream := kis.NewStreamCollector(kisClient, cfg.KIS.WebsocketURL, cfg.KIS.HTSID)
+
+	// 6. MarketData 모듈 초기화 (지표 워밍업/차트 조회용 과거 봉)
+	marketDataModule := NewMarketDataModule(entClient, kisClient, symbolModule.Service, cfg)
+	logrus.Info("✅ MarketData 모듈 초기화 완료")
+
+	// 7. Paper 모듈 초기화 (모의투자 실행기, 주문/전략 모듈 공용)
+	paperModule := NewPaperModule(entClient, stream, marketDataModule.Service, cfg)
+	logrus.Info("✅ Paper 모듈 초기화 완료")
+
+	// 8. Order 모듈 초기화
+	orderModule := NewOrderModule(entClient, brokerageModule.Accounts, paperModule.Broker, cfg)
+	fills, _ := stream.SubscribeFills()
+	orderModule.Executor.ListenFillNotices(fills)
+	logrus.Info("✅ Order 모듈 초기화 완료")
+
+	// 9. Strategy 모듈 초기화
+	strategyModule := NewStrategyModule(entClient, riskManager, stream, marketDataModule.Service, symbolModule.Service, orderModule.Executor, paperModule.Broker, cfg)
 	logrus.Info("✅ Strategy 모듈 초기화 완료")
 
-	// 4. Portfolio 모듈 초기화
-	portfolioModule := NewPortfolioModule(entClient, cfg)
+	// 10. Backtest 모듈 초기화
+	backtestModule := NewBacktestModule(entClient, strategyModule.Repository, marketDataModule.Service, cfg)
+	logrus.Info("✅ Backtest 모듈 초기화 완료")
+
+	// 11. Portfolio 모듈 초기화 (실계좌 체결은 체결 원장에 기록)
+	portfolioModule := NewPortfolioModule(entClient, brokerageModule, kis.NewPriceSource(stream), marketDataModule.Service, symbolModule.Service, cfg)
+	orderModule.Executor.OnOrderUpdate(portfolioModule.Ledger.HandleUpdate)
 	logrus.Info("✅ Portfolio 모듈 초기화 완료")
 
+	// 12. Template 모듈 초기화 (기본 템플릿 등록, 전략 생성은 Strategy 서비스 사용)
+	templateModule := NewTemplateModule(entClient, strategyModule.Service, cfg)
+	logrus.Info("✅ Template 모듈 초기화 완료")
+
+	// 13. Market 모듈 초기화 (거래 일정 조회, 거래 세션 외 주문 거부/대기)
+	marketModule := NewMarketModule(cfg)
+	orderModule.Executor.SetSessionGuard(marketModule.SessionGuard)
+	paperModule.Broker.SetSessionGuard(marketModule.SessionGuard)
+	logrus.Info("✅ Market 모듈 초기화 완료")
+
+	// 14. Risk 모듈 초기화 (사용자/계좌별 리스크 상태 저장, 시작 시 원장/잔고로 재구성)
+	// 모든 주문은 실행기에서 사전 리스크 검사를 거치고, 주문 상태/체결은 리스크 상태에 반영
+	// 계좌 평가금액이 최대 낙폭을 넘으면 사용자 전략을 중지하고 해제 확인 전까지 신규 진입 주문 거부
+	riskModule := NewRiskModule(entClient, riskManager, portfolioModule, brokerageModule, paperModule, orderModule, strategyModule, cfg)
+	orderModule.Executor.SetRiskChecker(riskModule.Gate)
+	paperModule.Broker.SetRiskChecker(riskModule.Gate)
+	orderModule.Executor.OnOrderUpdate(riskModule.Gate.HandleUpdate)
+	paperModule.Broker.OnOrderUpdate(riskModule.Gate.HandleUpdate)
+	logrus.Info("✅ Risk 모듈 초기화 완료")
+
+	// 15. Protection 모듈 초기화 (보유 포지션 손절/익절/추적 손절/보유 기간 감시, 도달 시 실행기로 시장가 청산)
+	protectionModule := NewProtectionModule(entClient, riskManager, stream, paperModule, orderModule, marketDataModule, cfg)
+	orderModule.Executor.OnOrderUpdate(protectionModule.Monitor.HandleUpdate)
+	paperModule.Broker.OnOrderUpdate(protectionModule.Monitor.HandleUpdate)
+	logrus.Info("✅ Protection 모듈 초기화 완료")
+
 	return &Modules{
-		User:      userModule,
-		Auth:      authModule,
-		Strategy:  strategyModule,
-		Portfolio: portfolioModule,
+		User:       userModule,
+		Auth:       authModule,
+		Strategy:   strategyModule,
+		Portfolio:  portfolioModule,
+		Order:      orderModule,
+		MarketData: marketDataModule,
+		Backtest:   backtestModule,
+		Paper:      paperModule,
+		Template:   templateModule,
+		Market:     marketModule,
+		Symbol:     symbolModule,
+		Brokerage:  brokerageModule,
+		Risk:       riskModule,
+		Protection: protectionModule,
+		KIS:        kisClient,
+		Stream:     stream,
 	}
 }
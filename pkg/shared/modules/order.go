@@ -0,0 +1,49 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/paper"
+	"auto-trader/pkg/shared/config"
+)
+
+// OrderModule 주문 모듈
+type OrderModule struct {
+	Repository order.Repository
+	Service    order.Service
+	Controller *order.Controller
+	Executor   *kis.OrderExecutor
+	cfg        *config.Config
+}
+
+// NewOrderModule 주문 모듈 초기화
+func NewOrderModule(entClient *ent.Client, accounts kis.AccountResolver, paperBroker *paper.Broker, cfg *config.Config) *OrderModule {
+	// KIS 주문 실행기 (주문 사용자의 연결 계좌로 제출)
+	executor := kis.NewOrderExecutor(accounts, cfg.Trading.OrderTimeout)
+
+	// Repository -> Service -> Controller 순서로 초기화
+	repo := order.NewEntRepository(entClient)
+	// 시장가 수동 주문 기준가는 모의투자 실행기의 최근 체결가 사용
+	service := order.NewService(repo, map[order.Mode]order.Executor{
+		order.ModeLive:  executor,
+		order.ModePaper: paperBroker,
+	}, paperBroker)
+	controller := order.NewController(service)
+
+	// 실행기 주문 상태 변경을 DB에 반영 (실계좌/모의투자 공통)
+	executor.OnOrderUpdate(service.HandleUpdate)
+	paperBroker.OnOrderUpdate(service.HandleUpdate)
+
+	// 재시작 전 제출/대기 중이던 주문은 실행기 시작 시 추적 복구 (실계좌/모의투자)
+	executor.SetOpenOrderSource(service)
+	paperBroker.SetOpenOrderSource(service)
+
+	return &OrderModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		Executor:   executor,
+		cfg:        cfg,
+	}
+}
@@ -0,0 +1,34 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/paper"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/shared/config"
+)
+
+// PaperModule 모의투자 모듈
+type PaperModule struct {
+	Repository paper.Repository
+	Service    paper.Service
+	Controller *paper.Controller
+	Broker     *paper.Broker
+	cfg        *config.Config
+}
+
+// NewPaperModule 모의투자 모듈 초기화
+func NewPaperModule(entClient *ent.Client, quotes paper.QuoteSource, history strategy.HistoryProvider, cfg *config.Config) *PaperModule {
+	// Repository -> Broker -> Service -> Controller 순서로 초기화
+	repo := paper.NewEntRepository(entClient)
+	broker := paper.NewBroker(repo, quotes, history, cfg.Trading.Paper)
+	service := paper.NewService(repo, broker)
+	controller := paper.NewController(service)
+
+	return &PaperModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		Broker:     broker,
+		cfg:        cfg,
+	}
+}
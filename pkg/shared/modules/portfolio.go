@@ -2,8 +2,14 @@ package modules
 
 import (
 	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/marketdata"
+	"auto-trader/pkg/domain/order"
 	"auto-trader/pkg/domain/portfolio"
 	"auto-trader/pkg/shared/config"
+
+	"github.com/shopspring/decimal"
+	"github.com/sirupsen/logrus"
 )
 
 // PortfolioModule 포트폴리오 모듈
@@ -11,20 +17,39 @@ type PortfolioModule struct {
 	Repository portfolio.Repository
 	Service    portfolio.Service
 	Controller *portfolio.Controller
+	Syncer     *portfolio.Syncer
+	Ledger     *portfolio.Ledger
 	cfg        *config.Config
 }
 
 // NewPortfolioModule 포트폴리오 모듈 초기화
-func NewPortfolioModule(entClient *ent.Client, cfg *config.Config) *PortfolioModule {
+func NewPortfolioModule(entClient *ent.Client, brokerageModule *BrokerageModule, priceSource portfolio.PriceSource, marketData marketdata.Service, symbols portfolio.SymbolValidator, cfg *config.Config) *PortfolioModule {
 	// Repository -> Service -> Controller 순서로 초기화
 	repo := portfolio.NewEntRepository(entClient)
-	service := portfolio.NewService(repo)
+	balance := kis.NewAccountBalanceSource(brokerageModule.Accounts)
+	syncer := portfolio.NewSyncer(repo, balance, brokerageModule.Service, cfg.Trading.PortfolioSync)
+
+	// 체결 원장 (실현손익 산정 방법과 실계좌 수수료율은 설정값 사용)
+	method, err := portfolio.ParseCostMethod(cfg.Trading.Ledger.CostMethod, portfolio.CostMethodFIFO)
+	if err != nil {
+		logrus.Warnf("⚠️  %v, 선입선출법으로 계산합니다", err)
+		method = portfolio.CostMethodFIFO
+	}
+	fees := order.FillSimulator{
+		CommissionRate: decimal.NewFromFloat(cfg.Trading.Ledger.CommissionRate),
+		MinCommission:  decimal.NewFromFloat(cfg.Trading.Ledger.MinCommission),
+	}
+	ledger := portfolio.NewLedger(portfolio.NewLedgerRepository(entClient), repo, kis.NewAccountFXSource(brokerageModule.Accounts), fees, method)
+
+	service := portfolio.NewService(repo, priceSource, marketData, symbols, syncer, ledger)
 	controller := portfolio.NewController(service)
 
 	return &PortfolioModule{
 		Repository: repo,
 		Service:    service,
 		Controller: controller,
+		Syncer:     syncer,
+		Ledger:     ledger,
 		cfg:        cfg,
 	}
 }
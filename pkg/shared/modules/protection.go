@@ -0,0 +1,45 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/protection"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+)
+
+// ProtectionModule 포지션 보호(손절/익절/추적 손절/보유 기간) 모듈
+type ProtectionModule struct {
+	Repository protection.Repository
+	Service    protection.Service
+	Controller *protection.Controller
+	Monitor    *protection.Monitor
+	cfg        *config.Config
+}
+
+// NewProtectionModule 포지션 보호 모듈 초기화 (실시간 시세로 감시하고 청산 주문은 주문 서비스로 제출)
+func NewProtectionModule(entClient *ent.Client, riskManager *middleware.Manager, stream *kis.StreamCollector, paperModule *PaperModule, orderModule *OrderModule, marketDataModule *MarketDataModule, cfg *config.Config) *ProtectionModule {
+	repo := protection.NewEntRepository(entClient)
+
+	monitor := protection.NewMonitor(
+		repo,
+		riskManager,
+		kis.NewPriceSource(stream),
+		paperModule.Broker,
+		orderModule.Service,
+		marketDataModule.Service,
+		cfg.Risk.ProtectionInterval,
+		cfg.Risk.ATRInterval,
+		cfg.Risk.DefaultStopLoss,
+	)
+	service := protection.NewService(repo, monitor)
+	controller := protection.NewController(service)
+
+	return &ProtectionModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		Monitor:    monitor,
+		cfg:        cfg,
+	}
+}
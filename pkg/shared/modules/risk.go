@@ -0,0 +1,76 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/portfolio"
+	"auto-trader/pkg/domain/risk"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+)
+
+// RiskModule 리스크 관리 모듈
+type RiskModule struct {
+	Repository risk.Repository
+	Service    risk.Service
+	Controller *risk.Controller
+	Manager    *middleware.Manager
+	Gate       *risk.Gate
+	KillSwitch *risk.KillSwitch
+	Rebuilder  *risk.Rebuilder
+	Monitor    *risk.DrawdownMonitor
+	cfg        *config.Config
+}
+
+// NewRiskModule 리스크 관리 모듈 초기화 (사용자 계좌별 상태를 DB에 저장하고 시작 시 원장/잔고로 재구성)
+func NewRiskModule(entClient *ent.Client, riskManager *middleware.Manager, portfolioModule *PortfolioModule, brokerageModule *BrokerageModule, paperModule *PaperModule, orderModule *OrderModule, strategyModule *StrategyModule, cfg *config.Config) *RiskModule {
+	repo := risk.NewEntRepository(entClient)
+	riskManager.SetStore(repo)
+
+	// 사전 리스크 검사 (실계좌/모의투자 실행기 공용)
+	gate := risk.NewGate(riskManager, repo, paperModule.Broker)
+	// 긴급 중지 (전략 중지, 미체결 주문 취소, 선택적 시장가 청산)
+	killSwitch := risk.NewKillSwitch(repo, riskManager, strategyModule.Service, orderModule.Service)
+	service := risk.NewService(repo, riskManager, killSwitch, cfg.Risk)
+	controller := risk.NewController(service)
+
+	// 계좌 평가금액 (최대 낙폭 감시와 시작 시 재구성 공용)
+	equity := risk.NewAccountEquity(
+		repo,
+		portfolioModule.Syncer,
+		kis.NewAccountCashSource(brokerageModule.Accounts),
+		paperModule.Service,
+	)
+
+	rebuilder := risk.NewRebuilder(
+		repo,
+		riskManager,
+		portfolio.NewLedgerRepository(entClient),
+		portfolioModule.Ledger.Method(),
+		brokerageModule.Service,
+		equity,
+		orderModule.Service,
+	)
+
+	// 계좌 평가금액 주기 반영, 최대 낙폭 초과 시 사용자 전략 중지
+	monitor := risk.NewDrawdownMonitor(
+		repo,
+		riskManager,
+		brokerageModule.Service,
+		equity,
+		strategyModule.Service,
+		cfg.Risk.EquityInterval,
+	)
+
+	return &RiskModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		Manager:    riskManager,
+		Gate:       gate,
+		KillSwitch: killSwitch,
+		Rebuilder:  rebuilder,
+		Monitor:    monitor,
+		cfg:        cfg,
+	}
+}
@@ -2,6 +2,7 @@ package modules
 
 import (
 	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
 	"auto-trader/pkg/domain/strategy"
 	"auto-trader/pkg/shared/config"
 	"auto-trader/pkg/shared/middleware"
@@ -16,19 +17,18 @@ type StrategyModule struct {
 }
 
 // NewStrategyModule 전략 모듈 초기화
-func NewStrategyModule(entClient *ent.Client, riskManager *middleware.Manager, cfg *config.Config) *StrategyModule {
+func NewStrategyModule(entClient *ent.Client, riskManager *middleware.Manager, stream *kis.StreamCollector, history strategy.HistoryProvider, symbols strategy.SymbolValidator, executor strategy.Executor, paperExecutor strategy.Executor, cfg *config.Config) *StrategyModule {
 	// Repository 초기화
 	repo := strategy.NewEntRepository(entClient)
 
-	// 현재는 portfolio/order 도메인이 없으므로 nil
-	var dataCollector strategy.Collector = nil
-	var executor strategy.Executor = nil
-
-	// Service 초기화
+	// Service 초기화 (KIS 실시간 시세 수집기 사용, 종목 마스터로 종목 검증, 거래 모드별 실행기)
 	service := strategy.NewService(
 		repo,
-		dataCollector, // TODO: portfolio 도메인 완성 후 연결
-		executor,      // TODO: order 도메인 완성 후 연결
+		stream,
+		history,
+		symbols,
+		executor,
+		paperExecutor,
 		riskManager,
 		cfg,
 	)
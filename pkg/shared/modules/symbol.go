@@ -0,0 +1,53 @@
+package modules
+
+import (
+	"context"
+	"time"
+
+	"auto-trader/ent"
+	"auto-trader/pkg/api/kis"
+	"auto-trader/pkg/domain/symbol"
+	"auto-trader/pkg/shared/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+// masterSyncTimeout 시작 시 종목 마스터 다운로드 제한 시간
+const masterSyncTimeout = 5 * time.Minute
+
+// SymbolModule 종목 마스터 모듈
+type SymbolModule struct {
+	Repository symbol.Repository
+	Service    symbol.Service
+	Controller *symbol.Controller
+	cfg        *config.Config
+}
+
+// NewSymbolModule 종목 마스터 모듈 초기화 (저장된 종목으로 캐시 구성, 비어 있으면 백그라운드 다운로드)
+func NewSymbolModule(entClient *ent.Client, cfg *config.Config) *SymbolModule {
+	// Repository -> Service -> Controller 순서로 초기화 (KIS 마스터 파일로 갱신)
+	repo := symbol.NewEntRepository(entClient)
+	service := symbol.NewService(repo, kis.NewMasterDownloader(cfg.KIS.MasterURL))
+	controller := symbol.NewController(service)
+
+	if err := service.Refresh(); err != nil {
+		logrus.Warnf("⚠️ 종목 마스터 로드 실패: %v", err)
+	}
+	if service.Count() == 0 && cfg.KIS.MasterSync {
+		go func() {
+			ctx, cancel := context.WithTimeout(context.Background(), masterSyncTimeout)
+			defer cancel()
+
+			if _, err := service.Sync(ctx, nil); err != nil {
+				logrus.Warnf("⚠️ 종목 마스터 다운로드 실패: %v", err)
+			}
+		}()
+	}
+
+	return &SymbolModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		cfg:        cfg,
+	}
+}
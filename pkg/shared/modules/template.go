@@ -0,0 +1,36 @@
+package modules
+
+import (
+	"auto-trader/ent"
+	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/domain/template"
+	"auto-trader/pkg/shared/config"
+
+	"github.com/sirupsen/logrus"
+)
+
+// TemplateModule 전략 템플릿 모듈
+type TemplateModule struct {
+	Repository template.Repository
+	Service    template.Service
+	Controller *template.Controller
+	cfg        *config.Config
+}
+
+// NewTemplateModule 전략 템플릿 모듈 초기화 (기본 템플릿 시드 포함)
+func NewTemplateModule(entClient *ent.Client, strategies strategy.Service, cfg *config.Config) *TemplateModule {
+	repo := template.NewEntRepository(entClient)
+	service := template.NewService(repo, strategies, cfg)
+	controller := template.NewController(service)
+
+	if err := service.SeedDefaults(); err != nil {
+		logrus.Warnf("⚠️ 기본 전략 템플릿 등록 실패: %v", err)
+	}
+
+	return &TemplateModule{
+		Repository: repo,
+		Service:    service,
+		Controller: controller,
+		cfg:        cfg,
+	}
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/brokerage"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupBrokerageRoutes 증권 계좌 연결 관련 라우트 설정
+func SetupBrokerageRoutes(v1 fiber.Router, controller *brokerage.Controller, cfg *config.Config) {
+	brokerageGroup := v1.Group("/brokerage")
+	protected := brokerageGroup.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 연결된 계좌 조회
+	protected.Get("/account", controller.GetAccount)
+
+	// 계좌 연결
+	protected.Post("/account", controller.LinkAccount)
+
+	// 계좌 수정
+	protected.Put("/account", controller.UpdateAccount)
+
+	// 계좌 연결 해제
+	protected.Delete("/account", controller.UnlinkAccount)
+}
@@ -0,0 +1,21 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/market"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupMarketRoutes 장 운영 시간 관련 라우트 설정
+func SetupMarketRoutes(v1 fiber.Router, controller *market.Controller, cfg *config.Config) {
+	markets := v1.Group("/market")
+	protected := markets.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 현재 세션과 다음 개장/폐장 시각
+	protected.Get("/clock", controller.GetClock)
+
+	// 기간별 거래 일정
+	protected.Get("/calendar", controller.GetCalendar)
+}
@@ -0,0 +1,27 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupOrderRoutes 주문 관련 라우트 설정
+func SetupOrderRoutes(v1 fiber.Router, controller *order.Controller, cfg *config.Config) {
+	orders := v1.Group("/orders")
+	protected := orders.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 수동 주문 (사전 리스크 검사 적용)
+	protected.Post("/", controller.PlaceOrder)
+
+	// 주문 목록 조회
+	protected.Get("/", controller.GetOrders)
+
+	// 주문 상세 조회
+	protected.Get("/:id", controller.GetOrder)
+
+	// 주문 취소
+	protected.Post("/:id/cancel", controller.CancelOrder)
+}
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/paper"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupPaperRoutes 모의투자 관련 라우트 설정
+func SetupPaperRoutes(v1 fiber.Router, controller *paper.Controller, cfg *config.Config) {
+	paperGroup := v1.Group("/paper")
+	protected := paperGroup.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 모의투자 계좌 조회
+	protected.Get("/account", controller.GetAccount)
+
+	// 모의투자 계좌 초기화
+	protected.Post("/account/reset", controller.ResetAccount)
+
+	// 모의 체결 내역 조회
+	protected.Get("/trades", controller.GetTrades)
+}
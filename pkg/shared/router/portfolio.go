@@ -30,9 +30,21 @@ func SetupPortfolioRoutes(v1 fiber.Router, controller *portfolio.Controller, cfg
 	// 가격 관련
 	prices := protected.Group("/prices")
 	prices.Get("/", controller.GetCurrentPrices)       // 여러 종목 현재가 조회
+	prices.Get("/stream", controller.StreamPrices)     // 실시간 현재가 스트림 (SSE)
 	prices.Get("/:symbol", controller.GetCurrentPrice) // 특정 종목 현재가 조회
 
+	// 차트 데이터
+	protected.Get("/charts/:symbol", controller.GetChartData) // 과거 봉 조회
+
 	// 거래 내역
 	trades := protected.Group("/trades")
 	trades.Get("/", controller.GetTradeHistory) // 거래 내역 조회
+
+	// 손익 내역 (체결 원장 기준)
+	pnl := protected.Group("/pnl")
+	pnl.Get("/", controller.GetPnL)              // 종목별 실현/미실현 손익
+	pnl.Get("/periods", controller.GetPeriodPnL) // 월/연 단위 실현손익
+
+	// 미청산 매수 로트
+	protected.Get("/lots", controller.GetTaxLots)
 }
@@ -0,0 +1,26 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/protection"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupProtectionRoutes 포지션 보호(손절/익절/추적 손절) 관련 라우트 설정
+func SetupProtectionRoutes(v1 fiber.Router, controller *protection.Controller, cfg *config.Config) {
+	protections := v1.Group("/protection")
+	protected := protections.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 보호 규칙 목록과 현재 청산 가격
+	protected.Get("/rules", controller.GetRules)
+
+	// 보호 규칙 생성
+	protected.Post("/rules", controller.CreateRule)
+
+	// 보호 규칙 조회/수정/삭제
+	protected.Get("/rules/:id", controller.GetRule)
+	protected.Put("/rules/:id", controller.UpdateRule)
+	protected.Delete("/rules/:id", controller.DeleteRule)
+}
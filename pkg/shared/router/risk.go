@@ -0,0 +1,29 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/risk"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupRiskRoutes 리스크 관리 관련 라우트 설정
+func SetupRiskRoutes(v1 fiber.Router, controller *risk.Controller, cfg *config.Config) {
+	risks := v1.Group("/risk")
+	protected := risks.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 계좌별 리스크 상태와 검사 한도
+	protected.Get("/state", controller.GetState)
+
+	// 주문 거부, 거래 중지 등 리스크 이벤트
+	protected.Get("/events", controller.GetEvents)
+
+	// 최대 낙폭 초과 거래 중지 해제 확인
+	protected.Post("/halt/acknowledge", controller.AcknowledgeHalt)
+
+	// 긴급 중지 (전략 중지, 미체결 주문 취소, 선택적 청산) 발동/해제/기록
+	protected.Post("/kill-switch", controller.ActivateKillSwitch)
+	protected.Post("/kill-switch/release", controller.ReleaseKillSwitch)
+	protected.Get("/kill-switch", controller.GetKillSwitches)
+}
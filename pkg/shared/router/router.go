@@ -2,8 +2,17 @@ package router
 
 import (
 	"auto-trader/pkg/domain/auth"
+	"auto-trader/pkg/domain/backtest"
+	"auto-trader/pkg/domain/brokerage"
+	"auto-trader/pkg/domain/market"
+	"auto-trader/pkg/domain/order"
+	"auto-trader/pkg/domain/paper"
 	"auto-trader/pkg/domain/portfolio"
+	"auto-trader/pkg/domain/protection"
+	"auto-trader/pkg/domain/risk"
 	"auto-trader/pkg/domain/strategy"
+	"auto-trader/pkg/domain/symbol"
+	"auto-trader/pkg/domain/template"
 	"auto-trader/pkg/domain/user"
 	"auto-trader/pkg/shared/config"
 	"auto-trader/pkg/shared/middleware"
@@ -39,6 +48,15 @@ func (r *Router) SetupRoutes(
 	portfolioController *portfolio.Controller,
 	authController *auth.Controller,
 	userController *user.Controller,
+	orderController *order.Controller,
+	backtestController *backtest.Controller,
+	paperController *paper.Controller,
+	templateController *template.Controller,
+	marketController *market.Controller,
+	symbolController *symbol.Controller,
+	brokerageController *brokerage.Controller,
+	riskController *risk.Controller,
+	protectionController *protection.Controller,
 	cfg *config.Config,
 ) {
 	// 글로벌 미들웨어 설정
@@ -51,9 +69,17 @@ func (r *Router) SetupRoutes(
 	v1 := r.app.Group("/api/v1")
 	// 각 도메인 라우트 설정
 	SetupAuthRoutes(v1, authController)
-	SetupStrategyRoutes(v1, strategyController, cfg)
+	SetupStrategyRoutes(v1, strategyController, backtestController, cfg)
 	SetupPortfolioRoutes(v1, portfolioController, cfg)
 	SetupUserRoutes(v1, userController, cfg)
+	SetupOrderRoutes(v1, orderController, cfg)
+	SetupPaperRoutes(v1, paperController, cfg)
+	SetupTemplateRoutes(v1, templateController, cfg)
+	SetupMarketRoutes(v1, marketController, cfg)
+	SetupSymbolRoutes(v1, symbolController, cfg)
+	SetupBrokerageRoutes(v1, brokerageController, cfg)
+	SetupRiskRoutes(v1, riskController, cfg)
+	SetupProtectionRoutes(v1, protectionController, cfg)
 
 	r.app.Use(middleware.SetupNotFoundHandler())
 }
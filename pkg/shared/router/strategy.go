@@ -1,6 +1,7 @@
 package router
 
 import (
+	"auto-trader/pkg/domain/backtest"
 	"auto-trader/pkg/domain/strategy"
 	"auto-trader/pkg/shared/config"
 	"auto-trader/pkg/shared/middleware"
@@ -9,7 +10,7 @@ import (
 )
 
 // SetupStrategyRoutes 전략 관련 라우트 설정
-func SetupStrategyRoutes(v1 fiber.Router, controller *strategy.Controller, cfg *config.Config) {
+func SetupStrategyRoutes(v1 fiber.Router, controller *strategy.Controller, backtestController *backtest.Controller, cfg *config.Config) {
 	strategies := v1.Group("/strategies")
 	protected := strategies.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
 
@@ -22,9 +23,16 @@ func SetupStrategyRoutes(v1 fiber.Router, controller *strategy.Controller, cfg *
 	// 전략 상태 조회
 	protected.Get("/:id/status", controller.GetStrategyStatus)
 
+	// 전략 실행 설정 조회
+	protected.Get("/:id/config", controller.GetStrategyConfig)
+
 	// 전략 성과 조회
 	protected.Get("/:id/performance", controller.GetStrategyPerformance)
 
+	// 백테스트 결과 목록/상세 조회
+	protected.Get("/:id/backtests", backtestController.GetBacktests)
+	protected.Get("/:id/backtests/:backtestId", backtestController.GetBacktest)
+
 	// 전략 시작
 	protected.Post("/:id/start", controller.StartStrategy)
 
@@ -34,6 +42,9 @@ func SetupStrategyRoutes(v1 fiber.Router, controller *strategy.Controller, cfg *
 	// 전략 재시작
 	protected.Post("/:id/restart", controller.RestartStrategy)
 
+	// 백테스트 실행
+	protected.Post("/:id/backtest", backtestController.RunBacktest)
+
 	// 전략 생성
 	protected.Post("/", controller.CreateStrategy)
 
@@ -0,0 +1,24 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/symbol"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupSymbolRoutes 종목 마스터 관련 라우트 설정
+func SetupSymbolRoutes(v1 fiber.Router, controller *symbol.Controller, cfg *config.Config) {
+	symbols := v1.Group("/symbols")
+	protected := symbols.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 종목 검색
+	protected.Get("/", controller.SearchSymbols)
+
+	// KIS 마스터 파일로 종목 목록 갱신
+	protected.Post("/sync", controller.SyncSymbols)
+
+	// 종목 상장 정보
+	protected.Get("/:ticker", controller.GetSymbol)
+}
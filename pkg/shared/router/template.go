@@ -0,0 +1,24 @@
+package router
+
+import (
+	"auto-trader/pkg/domain/template"
+	"auto-trader/pkg/shared/config"
+	"auto-trader/pkg/shared/middleware"
+
+	"github.com/gofiber/fiber/v2"
+)
+
+// SetupTemplateRoutes 전략 템플릿 관련 라우트 설정
+func SetupTemplateRoutes(v1 fiber.Router, controller *template.Controller, cfg *config.Config) {
+	templates := v1.Group("/strategy-templates")
+	protected := templates.Group("/", middleware.AuthMiddleware(cfg.JWT.Secret, cfg.JWT.AccessTTL, cfg.JWT.RefreshTTL))
+
+	// 템플릿 목록 조회
+	protected.Get("/", controller.GetTemplates)
+
+	// 템플릿 상세 조회
+	protected.Get("/:id", controller.GetTemplate)
+
+	// 템플릿으로 전략 생성
+	protected.Post("/:id/instantiate", controller.Instantiate)
+}
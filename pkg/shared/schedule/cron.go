@@ -0,0 +1,194 @@
+package schedule
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Cron 5필드 cron 표현식 (분 시 일 월 요일)
+//
+// 각 필드는 *, 숫자, 범위(a-b), 목록(a,b), 간격(*/n, a-b/n)을 지원하며
+// 월/요일은 JAN-DEC, SUN-SAT 이름도 허용한다. 일과 요일이 모두 지정되면 둘 중 하나만 맞아도 실행하고,
+// 둘 중 하나가 *로 시작하면(*/2 포함) 두 조건을 모두 만족해야 실행한다.
+//
+// 서머타임이 시작되어 건너뛴 시각은 그날 실행하지 않고, 서머타임이 끝나 반복되는 시각은
+// 시 필드가 지정된 경우 처음 한 번만 실행한다.
+type Cron struct {
+	expr     string
+	minute   uint64
+	hour     uint64
+	dom      uint64
+	month    uint64
+	dow      uint64
+	hourStar bool
+	domStar  bool
+	dowStar  bool
+	location *time.Location
+}
+
+type cronField struct {
+	name     string
+	min, max int
+	names    map[string]int
+}
+
+var cronFields = []cronField{
+	{name: "분", min: 0, max: 59},
+	{name: "시", min: 0, max: 23},
+	{name: "일", min: 1, max: 31},
+	{name: "월", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	{name: "요일", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// ParseCron cron 표현식 해석 (location 기준으로 시각 계산, nil이면 UTC)
+func ParseCron(expr string, location *time.Location) (*Cron, error) {
+	parts := strings.Fields(expr)
+	if len(parts) != len(cronFields) {
+		return nil, fmt.Errorf("cron 표현식은 5개 필드(분 시 일 월 요일)여야 합니다: %q", expr)
+	}
+	if location == nil {
+		location = time.UTC
+	}
+
+	masks := make([]uint64, len(parts))
+	for i, part := range parts {
+		mask, err := parseCronField(part, cronFields[i])
+		if err != nil {
+			return nil, err
+		}
+		masks[i] = mask
+	}
+
+	// 요일 7은 일요일(0)과 같음
+	if masks[4]&(1<<7) != 0 {
+		masks[4] |= 1
+	}
+
+	return &Cron{
+		expr:     expr,
+		minute:   masks[0],
+		hour:     masks[1],
+		dom:      masks[2],
+		month:    masks[3],
+		dow:      masks[4],
+		hourStar: strings.HasPrefix(parts[1], "*"),
+		domStar:  strings.HasPrefix(parts[2], "*"),
+		dowStar:  strings.HasPrefix(parts[4], "*"),
+		location: location,
+	}, nil
+}
+
+func parseCronField(part string, field cronField) (uint64, error) {
+	var mask uint64
+	for _, item := range strings.Split(part, ",") {
+		rangePart, step := item, 1
+		if i := strings.Index(item, "/"); i >= 0 {
+			rangePart = item[:i]
+			n, err := strconv.Atoi(item[i+1:])
+			if err != nil || n <= 0 {
+				return 0, fmt.Errorf("잘못된 cron %s 간격: %q", field.name, item)
+			}
+			step = n
+		}
+
+		low, high := field.min, field.max
+		if rangePart != "*" {
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = field.value(bounds[0]); err != nil {
+				return 0, err
+			}
+			high = low
+			if len(bounds) == 2 {
+				if high, err = field.value(bounds[1]); err != nil {
+					return 0, err
+				}
+			} else if step > 1 {
+				high = field.max
+			}
+			if low > high {
+				return 0, fmt.Errorf("잘못된 cron %s 범위: %q", field.name, item)
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			mask |= 1 << uint(v)
+		}
+	}
+	return mask, nil
+}
+
+func (f cronField) value(text string) (int, error) {
+	if v, ok := f.names[strings.ToUpper(text)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(text)
+	if err != nil || v < f.min || v > f.max {
+		return 0, fmt.Errorf("잘못된 cron %s 값: %q (%d-%d)", f.name, text, f.min, f.max)
+	}
+	return v, nil
+}
+
+// String 원본 표현식
+func (c *Cron) String() string {
+	return c.expr
+}
+
+// Next after 이후(같은 분 제외) 처음 일치하는 시각
+func (c *Cron) Next(after time.Time) time.Time {
+	t := after.In(c.location).Truncate(time.Minute).Add(time.Minute)
+
+	// 일치하는 시각이 없는 표현식(예: 2월 30일)은 5년 탐색 후 포기
+	limit := t.AddDate(5, 0, 0)
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = forward(t, time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, c.location))
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = forward(t, time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, c.location))
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			// 서머타임 시작으로 없는 시각(02:00)은 time.Date가 이전 시각으로 정규화하므로 경과 시간으로 진행
+			t = t.Add(time.Hour - time.Duration(t.Minute())*time.Minute)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 || (!c.hourStar && repeatedWallClock(t)) {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c *Cron) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
+
+// forward 현지 시각으로 계산한 다음 탐색 시각 (서머타임 전환으로 뒤로 가면 한 시간 뒤)
+func forward(t, next time.Time) time.Time {
+	if next.After(t) {
+		return next
+	}
+	return t.Add(time.Hour)
+}
+
+// repeatedWallClock 서머타임 종료로 한 시간 전과 같은 현지 시각이 다시 오는 경우 (두 번째 시각)
+func repeatedWallClock(t time.Time) bool {
+	prev := t.Add(-time.Hour)
+	return prev.Day() == t.Day() && prev.Hour() == t.Hour() && prev.Minute() == t.Minute()
+}
@@ -0,0 +1,142 @@
+package schedule
+
+import (
+	"testing"
+	"time"
+)
+
+func mustLocation(t *testing.T, name string) *time.Location {
+	t.Helper()
+	location, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skipf("시간대 정보 없음 (%s): %v", name, err)
+	}
+	return location
+}
+
+func mustTime(t *testing.T, value string) time.Time {
+	t.Helper()
+	parsed, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		t.Fatalf("잘못된 테스트 시각 %q: %v", value, err)
+	}
+	return parsed
+}
+
+func TestCronNextDayOfMonthAndWeek(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  string
+		after string
+		want  string
+	}{
+		{"요일만 지정", "0 9 * * 1", "2024-01-01T10:00:00Z", "2024-01-08T09:00:00Z"},
+		{"일만 지정", "0 9 15 * *", "2024-01-01T10:00:00Z", "2024-01-15T09:00:00Z"},
+		{"일과 요일 모두 지정하면 OR", "0 9 15 * 5", "2024-01-01T10:00:00Z", "2024-01-05T09:00:00Z"},
+		{"일 간격(*/2)은 *로 시작하므로 요일과 AND", "0 9 */2 * 1", "2024-01-01T10:00:00Z", "2024-01-15T09:00:00Z"},
+		{"요일 간격(*/2)은 *로 시작하므로 일과 AND", "0 9 1-7 * */2", "2024-01-02T10:00:00Z", "2024-01-04T09:00:00Z"},
+		{"간격이 *로 시작하지 않으면 OR", "0 9 1-31/2 * 1", "2024-01-01T10:00:00Z", "2024-01-03T09:00:00Z"},
+		{"일 범위와 요일 목록 OR", "0 9 20-21 * SAT,SUN", "2024-01-01T10:00:00Z", "2024-01-06T09:00:00Z"},
+		{"요일 7은 일요일", "0 0 * * 7", "2024-01-01T00:00:00Z", "2024-01-07T00:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr, time.UTC)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) 오류: %v", tt.expr, err)
+			}
+			got := cron.Next(mustTime(t, tt.after))
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextMonthEdges(t *testing.T) {
+	tests := []struct {
+		name  string
+		expr  string
+		after string
+		want  string // 빈 값이면 일치하는 시각 없음
+	}{
+		{"31일이 없는 달은 건너뜀", "0 0 31 * *", "2024-01-31T00:00:00Z", "2024-03-31T00:00:00Z"},
+		{"윤년 2월 29일", "0 0 29 2 *", "2024-03-01T00:00:00Z", "2028-02-29T00:00:00Z"},
+		{"연말에서 다음 해로", "0 0 1 * *", "2024-12-31T23:59:00Z", "2025-01-01T00:00:00Z"},
+		{"같은 분은 제외", "30 12 * * *", "2024-05-10T12:30:00Z", "2024-05-11T12:30:00Z"},
+		{"월 이름과 간격", "0 0 1 JAN-DEC/3 *", "2024-02-15T00:00:00Z", "2024-04-01T00:00:00Z"},
+		{"존재하지 않는 날짜", "0 0 30 2 *", "2024-01-01T00:00:00Z", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr, time.UTC)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) 오류: %v", tt.expr, err)
+			}
+			got := cron.Next(mustTime(t, tt.after))
+			if tt.want == "" {
+				if !got.IsZero() {
+					t.Errorf("Next(%s) = %s, want zero", tt.after, got.UTC().Format(time.RFC3339))
+				}
+				return
+			}
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestCronNextDaylightSaving(t *testing.T) {
+	newYork := mustLocation(t, "America/New_York")
+
+	tests := []struct {
+		name  string
+		expr  string
+		after string
+		want  string
+	}{
+		// 2024-03-10 02:00 EST → 03:00 EDT
+		{"서머타임 시작 전날 기준 현지 시각 유지", "0 9 * * *", "2024-03-09T15:00:00Z", "2024-03-10T13:00:00Z"},
+		{"건너뛴 시각은 그날 실행하지 않음", "30 2 * * *", "2024-03-09T08:00:00Z", "2024-03-11T06:30:00Z"},
+		{"시작 직후 시각", "0 3 * * *", "2024-03-10T05:00:00Z", "2024-03-10T07:00:00Z"},
+		// 2024-11-03 02:00 EDT → 01:00 EST
+		{"반복되는 시각은 처음 한 번", "30 1 * * *", "2024-11-03T04:00:00Z", "2024-11-03T05:30:00Z"},
+		{"반복되는 두 번째 시각은 건너뜀", "30 1 * * *", "2024-11-03T05:30:00Z", "2024-11-04T06:30:00Z"},
+		{"시 필드가 *이면 반복 구간도 실행", "*/30 * * * *", "2024-11-03T05:30:00Z", "2024-11-03T06:00:00Z"},
+		{"서머타임 종료 후 현지 시각 유지", "0 9 * * *", "2024-11-03T14:00:00Z", "2024-11-04T14:00:00Z"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cron, err := ParseCron(tt.expr, newYork)
+			if err != nil {
+				t.Fatalf("ParseCron(%q) 오류: %v", tt.expr, err)
+			}
+			got := cron.Next(mustTime(t, tt.after))
+			if want := mustTime(t, tt.want); !got.Equal(want) {
+				t.Errorf("Next(%s) = %s, want %s", tt.after, got.UTC().Format(time.RFC3339), tt.want)
+			}
+		})
+	}
+}
+
+func TestParseCronErrors(t *testing.T) {
+	tests := []string{
+		"0 9 * *",
+		"60 * * * *",
+		"0 24 * * *",
+		"0 9 0 * *",
+		"0 9 5-1 * *",
+		"*/0 * * * *",
+		"0 9 * FOO *",
+	}
+
+	for _, expr := range tests {
+		if _, err := ParseCron(expr, nil); err == nil {
+			t.Errorf("ParseCron(%q) 오류 없음, 오류 기대", expr)
+		}
+	}
+}
@@ -75,8 +75,11 @@ func ParseToken(tokenString, secret string) (*UserClaims, error) {
 // GetUserID Fiber 컨텍스트에서 유저ID 가져오기
 func GetUserID(c *fiber.Ctx) string {
 	if v := c.Locals("userID"); v != nil {
-		if s, ok := v.(string); ok {
-			return s
+		switch id := v.(type) {
+		case string:
+			return id
+		case uuid.UUID:
+			return id.String()
 		}
 	}
 